@@ -2,13 +2,24 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/shammianand/go-auth/ent"
 	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/users"
 	"github.com/shammianand/go-auth/internal/auth"
+	"github.com/shammianand/go-auth/internal/modules/auth/importer"
+	authservice "github.com/shammianand/go-auth/internal/modules/auth/service"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+	rbacservice "github.com/shammianand/go-auth/internal/modules/rbac/service"
 	"github.com/shammianand/go-auth/internal/storage"
 	"github.com/spf13/cobra"
 )
@@ -18,6 +29,31 @@ var (
 	adminPassword  string
 	adminFirstName string
 	adminLastName  string
+
+	unlockEmail string
+	unlockIP    string
+
+	benchmarkTarget      time.Duration
+	benchmarkMaxMemory   int
+	benchmarkParallelism int
+	benchmarkSamples     int
+
+	importFile   string
+	importFormat string
+	importDryRun bool
+	importReport string
+
+	permBenchEmail       string
+	permBenchConcurrency int
+	permBenchDuration    time.Duration
+
+	exportEmail  string
+	exportOutput string
+
+	deleteEmail  string
+	deleteNow    bool
+	deleteCancel bool
+	deleteReason string
 )
 
 var adminCmd = &cobra.Command{
@@ -34,9 +70,83 @@ Only one super-admin can exist in the system at a time.`,
 	RunE: createSuperuser,
 }
 
+var unlockUserCmd = &cobra.Command{
+	Use:   "unlock-user",
+	Short: "Clear a signin lockout for an account or IP address",
+	Long: `Removes the lockout, progressive delay and failed attempt counters
+for an email address and/or an IP address.`,
+	RunE: unlockUser,
+}
+
+var hashBenchmarkCmd = &cobra.Command{
+	Use:   "hash-benchmark",
+	Short: "Benchmark password hashing parameters on this machine",
+	Long: `Measures argon2id and bcrypt hashing time for a range of parameters and
+recommends the strongest settings that stay within the target duration.`,
+	RunE: runHashBenchmark,
+}
+
+var importUsersCmd = &cobra.Command{
+	Use:   "import-users",
+	Short: "Import users with pre-hashed passwords from CSV or JSONL",
+	Long: `Imports users exported from another system. Password hashes are stored as-is
+and verified through the legacy hasher registry (pbkdf2_sha256, scrypt, md5-crypt,
+bcrypt) at signin, then upgraded to the current algorithm on first successful login.
+
+CSV files need a header row with the columns:
+  email,password_hash,first_name,last_name,roles,email_verified,is_active,metadata
+where roles is a semicolon separated list of role codes and metadata is a JSON object.
+JSONL files contain one object per line with the same keys (roles as an array).`,
+	RunE: runImportUsers,
+}
+
+var permissionBenchmarkCmd = &cobra.Command{
+	Use:   "permission-benchmark",
+	Short: "Benchmark effective permission resolution",
+	Long: `Resolves a user's effective permissions under concurrent load, first from
+the database, then through the Redis cache and finally through the in-process
+cache, and reports throughput and latency percentiles for each path.`,
+	RunE: runPermissionBenchmark,
+}
+
+var exportUserCmd = &cobra.Command{
+	Use:   "export-user",
+	Short: "Export everything stored about a user as JSON",
+	Long: `Writes the same export users get from GET /api/v1/auth/me/export: profile,
+roles, memberships, session and API keys, verification history, email changes,
+email logs and the audit logs of actions the user performed.`,
+	RunE: runExportUser,
+}
+
+var deleteUserCmd = &cobra.Command{
+	Use:   "delete-user",
+	Short: "Schedule, cancel or run the deletion of a user account",
+	Long: `Schedules a user's account for deletion after ACCOUNT_DELETION_GRACE_PERIOD,
+like a self-service request. --cancel cancels a scheduled deletion and --now
+deletes the account immediately. Deleted accounts keep their audit and email
+logs with email addresses, IP addresses and user agents redacted.`,
+	RunE: runDeleteUser,
+}
+
+var purgeDeletionsCmd = &cobra.Command{
+	Use:   "purge-deletions",
+	Short: "Delete accounts whose deletion grace period has ended",
+	Long: `Deletes every account scheduled for deletion whose grace period has ended.
+The server does this every ACCOUNT_DELETION_CHECK_INTERVAL; this command runs
+it once, for deployments that disable the interval and schedule it externally.`,
+	RunE: runPurgeDeletions,
+}
+
 func init() {
 	rootCmd.AddCommand(adminCmd)
 	adminCmd.AddCommand(createSuperuserCmd)
+	adminCmd.AddCommand(unlockUserCmd)
+	adminCmd.AddCommand(hashBenchmarkCmd)
+	adminCmd.AddCommand(importUsersCmd)
+	adminCmd.AddCommand(permissionBenchmarkCmd)
+	adminCmd.AddCommand(exportUserCmd)
+	adminCmd.AddCommand(deleteUserCmd)
+	adminCmd.AddCommand(purgeDeletionsCmd)
 
 	createSuperuserCmd.Flags().StringVar(&adminEmail, "email", "", "Admin email (required)")
 	createSuperuserCmd.Flags().StringVar(&adminPassword, "password", "", "Admin password (required)")
@@ -47,6 +157,36 @@ func init() {
 	createSuperuserCmd.MarkFlagRequired("password")
 	createSuperuserCmd.MarkFlagRequired("first-name")
 	createSuperuserCmd.MarkFlagRequired("last-name")
+
+	unlockUserCmd.Flags().StringVar(&unlockEmail, "email", "", "Email address to unlock")
+	unlockUserCmd.Flags().StringVar(&unlockIP, "ip", "", "IP address to unlock")
+
+	hashBenchmarkCmd.Flags().DurationVar(&benchmarkTarget, "target", 250*time.Millisecond, "Maximum acceptable time per hash")
+	hashBenchmarkCmd.Flags().IntVar(&benchmarkMaxMemory, "max-memory", 256*1024, "Maximum argon2id memory in KiB")
+	hashBenchmarkCmd.Flags().IntVar(&benchmarkParallelism, "parallelism", 2, "argon2id parallelism (threads)")
+	hashBenchmarkCmd.Flags().IntVar(&benchmarkSamples, "samples", 3, "Hashes per measurement")
+
+	importUsersCmd.Flags().StringVarP(&importFile, "file", "f", "", "Path to CSV or JSONL file (required)")
+	importUsersCmd.Flags().StringVar(&importFormat, "format", "", "Input format: csv or jsonl (default from file extension)")
+	importUsersCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Validate the input without creating users")
+	importUsersCmd.Flags().StringVar(&importReport, "report", "", "Write a JSONL report of every line to this path")
+	importUsersCmd.MarkFlagRequired("file")
+
+	permissionBenchmarkCmd.Flags().StringVar(&permBenchEmail, "email", "", "Email of the user whose permissions are resolved (required)")
+	permissionBenchmarkCmd.Flags().IntVar(&permBenchConcurrency, "concurrency", 50, "Concurrent workers")
+	permissionBenchmarkCmd.Flags().DurationVar(&permBenchDuration, "duration", 10*time.Second, "Duration of each run")
+	permissionBenchmarkCmd.MarkFlagRequired("email")
+
+	exportUserCmd.Flags().StringVar(&exportEmail, "email", "", "Email of the user to export (required)")
+	exportUserCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "Write the export to this path instead of stdout")
+	exportUserCmd.MarkFlagRequired("email")
+
+	deleteUserCmd.Flags().StringVar(&deleteEmail, "email", "", "Email of the user to delete (required)")
+	deleteUserCmd.Flags().BoolVar(&deleteNow, "now", false, "Delete immediately instead of after the grace period")
+	deleteUserCmd.Flags().BoolVar(&deleteCancel, "cancel", false, "Cancel a scheduled deletion")
+	deleteUserCmd.Flags().StringVar(&deleteReason, "reason", "", "Reason recorded in the audit log with --now")
+	deleteUserCmd.MarkFlagsMutuallyExclusive("now", "cancel")
+	deleteUserCmd.MarkFlagRequired("email")
 }
 
 func createSuperuser(cmd *cobra.Command, args []string) error {
@@ -129,3 +269,342 @@ func createSuperuser(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func unlockUser(cmd *cobra.Command, args []string) error {
+	if unlockEmail == "" && unlockIP == "" {
+		return fmt.Errorf("at least one of --email or --ip is required")
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}))
+
+	redisClient := storage.GetRedisClient()
+	ctx := context.Background()
+
+	if _, err := redisClient.Ping(ctx).Result(); err != nil {
+		return fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	lockout := authservice.NewLockoutService(redisClient, nil, authservice.DefaultLockoutPolicy(), logger)
+
+	if unlockEmail != "" {
+		if err := lockout.UnlockAccount(ctx, unlockEmail); err != nil {
+			return err
+		}
+		fmt.Printf("\n✅ Account unlocked: %s\n", unlockEmail)
+	}
+
+	if unlockIP != "" {
+		if err := lockout.UnlockIP(ctx, unlockIP); err != nil {
+			return err
+		}
+		fmt.Printf("\n✅ IP address unlocked: %s\n", unlockIP)
+	}
+
+	fmt.Println()
+	return nil
+}
+
+func runHashBenchmark(cmd *cobra.Command, args []string) error {
+	if benchmarkSamples < 1 {
+		return fmt.Errorf("samples must be at least 1")
+	}
+	if err := auth.CheckArgon2Params(benchmarkMaxMemory, 1, benchmarkParallelism); err != nil {
+		return fmt.Errorf("invalid --max-memory or --parallelism: %w", err)
+	}
+
+	const password = "correct horse battery staple"
+
+	measure := func(hasher auth.PasswordHasher) (time.Duration, error) {
+		start := time.Now()
+		for i := 0; i < benchmarkSamples; i++ {
+			if _, err := hasher.Hash(password); err != nil {
+				return 0, err
+			}
+		}
+		return time.Since(start) / time.Duration(benchmarkSamples), nil
+	}
+
+	fmt.Printf("\nTarget: %s per hash, %d samples per measurement\n\n", benchmarkTarget, benchmarkSamples)
+	fmt.Printf("argon2id (parallelism %d)\n", benchmarkParallelism)
+	fmt.Printf("   %-12s %-12s %s\n", "memory", "iterations", "time")
+
+	var best *auth.Argon2Params
+	for memory := 16 * 1024; memory <= benchmarkMaxMemory; memory *= 2 {
+		for iterations := 1; iterations <= 10; iterations++ {
+			params := auth.DefaultArgon2Params()
+			params.Memory = uint32(memory)
+			params.Iterations = uint32(iterations)
+			params.Parallelism = uint8(benchmarkParallelism)
+
+			elapsed, err := measure(auth.NewArgon2idHasher(params))
+			if err != nil {
+				return fmt.Errorf("argon2id benchmark failed: %w", err)
+			}
+			fmt.Printf("   %-12s %-12d %s\n", fmt.Sprintf("%d MiB", memory/1024), iterations, elapsed.Round(time.Millisecond))
+
+			if elapsed > benchmarkTarget {
+				break
+			}
+			// OWASP recommends at least 2 iterations; prefer more memory over more iterations
+			if iterations >= 2 && (best == nil || params.Memory > best.Memory || (params.Memory == best.Memory && params.Iterations > best.Iterations)) {
+				candidate := params
+				best = &candidate
+			}
+		}
+	}
+
+	fmt.Printf("\nbcrypt\n")
+	fmt.Printf("   %-12s %s\n", "cost", "time")
+
+	bestCost := 0
+	for cost := 10; cost <= 16; cost++ {
+		elapsed, err := measure(auth.NewBcryptHasher(cost))
+		if err != nil {
+			return fmt.Errorf("bcrypt benchmark failed: %w", err)
+		}
+		fmt.Printf("   %-12d %s\n", cost, elapsed.Round(time.Millisecond))
+
+		if elapsed > benchmarkTarget {
+			break
+		}
+		bestCost = cost
+	}
+
+	fmt.Printf("\nRecommended settings:\n")
+	if best != nil {
+		fmt.Printf("   PASSWORD_HASH_ALGORITHM=argon2id\n")
+		fmt.Printf("   ARGON2_MEMORY_KIB=%d\n", best.Memory)
+		fmt.Printf("   ARGON2_ITERATIONS=%d\n", best.Iterations)
+		fmt.Printf("   ARGON2_PARALLELISM=%d\n", best.Parallelism)
+	} else {
+		fmt.Printf("   No argon2id parameters fit the target; consider raising --target\n")
+	}
+	if bestCost > 0 {
+		fmt.Printf("   BCRYPT_COST=%d (if using bcrypt)\n", bestCost)
+	}
+	fmt.Println()
+
+	return nil
+}
+
+func runImportUsers(cmd *cobra.Command, args []string) error {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}))
+
+	format := importFormat
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(importFile)), ".")
+	}
+
+	file, err := os.Open(importFile)
+	if err != nil {
+		return fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer file.Close()
+
+	records, parseFailures, err := importer.ReadRecords(file, format)
+	if err != nil {
+		return err
+	}
+
+	entClient, err := storage.DBConnect()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer entClient.Close()
+
+	ctx := context.Background()
+
+	importService := importer.NewImportService(entClient, logger)
+	summary, err := importService.Import(ctx, records, importer.Options{
+		DryRun: importDryRun,
+		Source: filepath.Base(importFile),
+	})
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+
+	summary.Results = append(summary.Results, parseFailures...)
+	sort.Slice(summary.Results, func(i, j int) bool {
+		return summary.Results[i].Line < summary.Results[j].Line
+	})
+	summary.Failed += len(parseFailures)
+	summary.Total = len(summary.Results)
+
+	if importReport != "" {
+		if err := writeImportReport(importReport, summary.Results); err != nil {
+			return err
+		}
+	}
+
+	if importDryRun {
+		fmt.Printf("\n🔎 Dry run - no users were created\n")
+	} else {
+		fmt.Printf("\n✅ Import completed\n")
+	}
+	fmt.Printf("   Total: %d\n", summary.Total)
+	if importDryRun {
+		fmt.Printf("   Would create: %d\n", summary.Created)
+	} else {
+		fmt.Printf("   Created: %d\n", summary.Created)
+	}
+	fmt.Printf("   Skipped: %d\n", summary.Skipped)
+	fmt.Printf("   Failed: %d\n", summary.Failed)
+
+	for _, result := range summary.Results {
+		if result.Status == importer.StatusFailed {
+			fmt.Printf("   line %d (%s): %s\n", result.Line, result.Email, result.Error)
+		}
+	}
+	if importReport != "" {
+		fmt.Printf("   Report: %s\n", importReport)
+	}
+	fmt.Println()
+
+	if summary.Failed > 0 {
+		return fmt.Errorf("%d records failed to import", summary.Failed)
+	}
+	return nil
+}
+
+func writeImportReport(path string, results []importer.Result) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create report file: %w", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	for _, result := range results {
+		if err := encoder.Encode(result); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+	}
+	return nil
+}
+
+func runPermissionBenchmark(cmd *cobra.Command, args []string) error {
+	if permBenchConcurrency < 1 {
+		return fmt.Errorf("concurrency must be at least 1")
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	entClient, err := storage.DBConnect()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer entClient.Close()
+
+	redisClient := storage.GetRedisClient()
+	defer redisClient.Close()
+
+	ctx := context.Background()
+	if err := redisClient.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	user, err := entClient.Users.Query().
+		Where(users.EmailEqualFold(permBenchEmail)).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find user %s: %w", permBenchEmail, err)
+	}
+
+	rbacSvc := rbacservice.NewRBACService(entClient, redisClient, nil, nil, logger)
+	load := func(ctx context.Context) ([]models.PermissionResponse, error) {
+		return rbacSvc.LoadUserPermissions(ctx, user.ID)
+	}
+
+	redisOnly := rbacservice.NewPermissionCache(redisClient, time.Minute, 0, logger)
+	layered := rbacservice.NewPermissionCache(redisClient, time.Minute, time.Minute, logger)
+
+	runs := []struct {
+		name    string
+		resolve func(context.Context) error
+	}{
+		{"database", func(ctx context.Context) error {
+			_, err := load(ctx)
+			return err
+		}},
+		{"redis", func(ctx context.Context) error {
+			_, err := redisOnly.Get(ctx, user.ID, load)
+			return err
+		}},
+		{"in-process", func(ctx context.Context) error {
+			_, err := layered.Get(ctx, user.ID, load)
+			return err
+		}},
+	}
+
+	fmt.Printf("\nResolving permissions for %s with %d workers for %s per run\n\n", user.Email, permBenchConcurrency, permBenchDuration)
+	fmt.Printf("   %-12s %10s %12s %10s %10s %10s %8s\n", "path", "ops", "ops/sec", "p50", "p95", "p99", "errors")
+
+	for _, run := range runs {
+		// Warm the cache so each run measures its own path
+		if err := run.resolve(ctx); err != nil {
+			return fmt.Errorf("%s warm-up failed: %w", run.name, err)
+		}
+
+		latencies, failures := benchmarkConcurrently(ctx, permBenchConcurrency, permBenchDuration, run.resolve)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+		percentile := func(p float64) time.Duration {
+			if len(latencies) == 0 {
+				return 0
+			}
+			return latencies[int(p*float64(len(latencies)-1))]
+		}
+
+		fmt.Printf("   %-12s %10d %12.0f %10s %10s %10s %8d\n",
+			run.name,
+			len(latencies),
+			float64(len(latencies))/permBenchDuration.Seconds(),
+			percentile(0.50).Round(time.Microsecond),
+			percentile(0.95).Round(time.Microsecond),
+			percentile(0.99).Round(time.Microsecond),
+			failures,
+		)
+	}
+	fmt.Println()
+
+	return nil
+}
+
+// benchmarkConcurrently calls fn from concurrent workers until duration has
+// elapsed and returns the latency of every successful call
+func benchmarkConcurrently(ctx context.Context, workers int, duration time.Duration, fn func(context.Context) error) ([]time.Duration, int) {
+	deadline := time.Now().Add(duration)
+	results := make([][]time.Duration, workers)
+	failures := make([]int, workers)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for time.Now().Before(deadline) {
+				start := time.Now()
+				if err := fn(ctx); err != nil {
+					failures[w]++
+					continue
+				}
+				results[w] = append(results[w], time.Since(start))
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	var latencies []time.Duration
+	total := 0
+	for w := range results {
+		latencies = append(latencies, results[w]...)
+		total += failures[w]
+	}
+	return latencies, total
+}
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/users"
+	apikeysservice "github.com/shammianand/go-auth/internal/modules/apikeys/service"
+	authservice "github.com/shammianand/go-auth/internal/modules/auth/service"
+	"github.com/shammianand/go-auth/internal/modules/email/provider"
+	emailservice "github.com/shammianand/go-auth/internal/modules/email/service"
+	rbacservice "github.com/shammianand/go-auth/internal/modules/rbac/service"
+	usersservice "github.com/shammianand/go-auth/internal/modules/users/service"
+	"github.com/shammianand/go-auth/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func runExportUser(cmd *cobra.Command, args []string) error {
+	return withUsersService(func(ctx context.Context, client *ent.Client, usersSvc *usersservice.UsersService) error {
+		user, err := findUserByEmail(ctx, client, exportEmail)
+		if err != nil {
+			return err
+		}
+
+		export, err := usersSvc.ExportUser(ctx, user.ID)
+		if err != nil {
+			return fmt.Errorf("failed to export user: %w", err)
+		}
+
+		data, err := json.MarshalIndent(export, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode export: %w", err)
+		}
+
+		if exportOutput == "" {
+			fmt.Println(string(data))
+			return nil
+		}
+
+		// The export is personal data, so keep it private to the operator
+		if err := os.WriteFile(exportOutput, append(data, '\n'), 0600); err != nil {
+			return fmt.Errorf("failed to write export: %w", err)
+		}
+
+		fmt.Printf("\n✅ Exported %s to %s\n\n", user.Email, exportOutput)
+		return nil
+	})
+}
+
+func runDeleteUser(cmd *cobra.Command, args []string) error {
+	return withUsersService(func(ctx context.Context, client *ent.Client, usersSvc *usersservice.UsersService) error {
+		user, err := findUserByEmail(ctx, client, deleteEmail)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case deleteCancel:
+			if err := usersSvc.CancelDeletion(ctx, user.ID, nil); err != nil {
+				return fmt.Errorf("failed to cancel deletion: %w", err)
+			}
+			fmt.Printf("\n✅ Deletion of %s cancelled\n\n", user.Email)
+		case deleteNow:
+			reason := deleteReason
+			if reason == "" {
+				reason = "deleted from the CLI"
+			}
+			if err := usersSvc.PurgeUser(ctx, user.ID, nil, reason); err != nil {
+				return fmt.Errorf("failed to delete user: %w", err)
+			}
+			fmt.Printf("\n✅ %s deleted\n\n", user.Email)
+		default:
+			resp, err := usersSvc.ScheduleDeletion(ctx, user.ID, nil)
+			if err != nil {
+				return fmt.Errorf("failed to schedule deletion: %w", err)
+			}
+			fmt.Printf("\n✅ %s scheduled for deletion at %s\n\n", user.Email, resp.ScheduledAt.Format(time.RFC3339))
+		}
+		return nil
+	})
+}
+
+func runPurgeDeletions(cmd *cobra.Command, args []string) error {
+	return withUsersService(func(ctx context.Context, client *ent.Client, usersSvc *usersservice.UsersService) error {
+		purged, err := usersSvc.PurgeDueDeletions(ctx)
+		if err != nil {
+			return fmt.Errorf("purge failed after %d accounts: %w", purged, err)
+		}
+
+		fmt.Printf("\n✅ %d accounts deleted\n\n", purged)
+		return nil
+	})
+}
+
+// withUsersService connects to the database and Redis and builds a users
+// service the way the server does
+func withUsersService(run func(ctx context.Context, client *ent.Client, usersSvc *usersservice.UsersService) error) error {
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	entClient, err := storage.DBConnect()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer entClient.Close()
+
+	redisClient := storage.GetRedisClient()
+	defer redisClient.Close()
+
+	ctx := context.Background()
+	if err := redisClient.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+
+	return run(ctx, entClient, newUsersService(entClient, redisClient, logger))
+}
+
+func newUsersService(entClient *ent.Client, redisClient *redis.Client, logger *slog.Logger) *usersservice.UsersService {
+	emailProvider := provider.NewMailhogProvider(
+		"localhost", // TODO: from config
+		"1025",      // TODO: from config
+		"noreply@go-auth.local",
+		logger,
+	)
+	emailSvc := emailservice.NewEmailService(
+		emailProvider,
+		entClient,
+		logger,
+		"noreply@go-auth.local",
+		"Go-Auth",
+	)
+
+	rbacSvc := rbacservice.NewRBACService(entClient, redisClient, emailSvc, nil, logger)
+	lockout := authservice.NewLockoutService(redisClient, emailSvc, authservice.DefaultLockoutPolicy(), logger)
+	apiKeySvc := apikeysservice.NewAPIKeyService(entClient, rbacSvc, logger)
+	return usersservice.NewUsersService(entClient, redisClient, emailSvc, rbacSvc, lockout, apiKeySvc, logger)
+}
+
+func findUserByEmail(ctx context.Context, client *ent.Client, email string) (*ent.Users, error) {
+	user, err := client.Users.Query().
+		Where(users.EmailEqualFold(email)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found: %s", email)
+		}
+		return nil, fmt.Errorf("failed to find user %s: %w", email, err)
+	}
+	return user, nil
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/shammianand/go-auth/internal/modules/rbac/bootstrap"
+	rbacservice "github.com/shammianand/go-auth/internal/modules/rbac/service"
 	"github.com/shammianand/go-auth/internal/storage"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -87,9 +88,26 @@ func runInit(cmd *cobra.Command, args []string) error {
 		"total", len(config.Roles),
 	)
 
+	logger.Info("Bootstrapping separation-of-duties constraints", "count", len(config.SeparationOfDuties))
+	createdConstraints, updatedConstraints, err := bootstrapService.BootstrapConstraints(ctx, config.SeparationOfDuties)
+	if err != nil {
+		return fmt.Errorf("failed to bootstrap constraints: %w", err)
+	}
+	logger.Info("Constraints bootstrapped",
+		"created", createdConstraints,
+		"updated", updatedConstraints,
+		"total", len(config.SeparationOfDuties),
+	)
+
+	// Running servers drop cached permissions computed from the old config
+	redisClient := storage.GetRedisClient()
+	defer redisClient.Close()
+	rbacservice.NewPermissionCache(redisClient, 0, 0, logger).InvalidateAll(ctx)
+
 	fmt.Printf("   RBAC initialization completed successfully!\n\n")
 	fmt.Printf("   Permissions: %d created, %d updated\n", createdPerms, updatedPerms)
-	fmt.Printf("   Roles: %d created, %d updated\n\n", createdRoles, updatedRoles)
+	fmt.Printf("   Roles: %d created, %d updated\n", createdRoles, updatedRoles)
+	fmt.Printf("   Constraints: %d created, %d updated\n\n", createdConstraints, updatedConstraints)
 
 	return nil
 }
@@ -123,6 +141,83 @@ func validateConfig(config *bootstrap.RBACConfig) error {
 			return fmt.Errorf("duplicate role code: %s", role.Code)
 		}
 		roleCodes[role.Code] = true
+
+		switch role.BreakGlass {
+		case "", "disabled", "auto", "approval":
+		default:
+			return fmt.Errorf("role %s has invalid break_glass policy: %s", role.Code, role.BreakGlass)
+		}
+		if role.BreakGlassMaxHours != nil && *role.BreakGlassMaxHours < 1 {
+			return fmt.Errorf("role %s break_glass_max_hours must be at least 1", role.Code)
+		}
+	}
+
+	// Resolve inherits to indexes so the runtime cycle check can be reused
+	roleIndex := make(map[string]int)
+	for i, role := range config.Roles {
+		roleIndex[role.Code] = i
+	}
+
+	hierarchy := make(rbacservice.RoleHierarchy)
+	for i, role := range config.Roles {
+		for _, parent := range role.Inherits {
+			parentIndex, ok := roleIndex[parent]
+			if !ok {
+				return fmt.Errorf("role %s inherits from undefined role: %s", role.Code, parent)
+			}
+			hierarchy[i] = append(hierarchy[i], parentIndex)
+		}
+	}
+
+	for _, role := range config.Roles {
+		listed := make(map[string]bool, len(role.Permissions))
+		for _, code := range role.Permissions {
+			listed[code] = true
+		}
+		for code, condition := range role.Conditions {
+			if !listed[code] {
+				return fmt.Errorf("role %s has a condition for unlisted permission: %s", role.Code, code)
+			}
+			if _, err := rbacservice.CompileCondition(condition); err != nil {
+				return fmt.Errorf("role %s permission %s: %w", role.Code, code, err)
+			}
+		}
+	}
+
+	for _, role := range config.Roles {
+		for _, approver := range role.Approvers {
+			if _, ok := roleIndex[approver]; !ok {
+				return fmt.Errorf("role %s lists undefined approver role: %s", role.Code, approver)
+			}
+		}
+	}
+
+	for i, role := range config.Roles {
+		if hierarchy.WouldCycle(i, hierarchy[i]) {
+			return fmt.Errorf("role inheritance cycle involving role: %s", role.Code)
+		}
+	}
+
+	constraintCodes := make(map[string]bool)
+	for _, constraint := range config.SeparationOfDuties {
+		if constraint.Code == "" {
+			return fmt.Errorf("constraint code cannot be empty")
+		}
+		if constraintCodes[constraint.Code] {
+			return fmt.Errorf("duplicate constraint code: %s", constraint.Code)
+		}
+		constraintCodes[constraint.Code] = true
+
+		distinct := make(map[string]bool)
+		for _, code := range constraint.Roles {
+			if _, ok := roleIndex[code]; !ok {
+				return fmt.Errorf("constraint %s lists undefined role: %s", constraint.Code, code)
+			}
+			distinct[code] = true
+		}
+		if len(distinct) < 2 {
+			return fmt.Errorf("constraint %s needs at least two roles", constraint.Code)
+		}
 	}
 
 	hasDefault := false
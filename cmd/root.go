@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/shammianand/go-auth/internal/auth"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +18,9 @@ var rootCmd = &cobra.Command{
   - Multi-backend support through JWKS
 
 Perfect for microservice architectures requiring centralized authentication.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return auth.CheckArgon2Config()
+	},
 }
 
 // Execute runs the root command
@@ -14,10 +14,18 @@ import (
 	"github.com/shammianand/go-auth/internal/auth"
 	"github.com/shammianand/go-auth/internal/common/middleware"
 	"github.com/shammianand/go-auth/internal/config"
+	apikeysmodule "github.com/shammianand/go-auth/internal/modules/apikeys"
+	apikeysservice "github.com/shammianand/go-auth/internal/modules/apikeys/service"
 	authmodule "github.com/shammianand/go-auth/internal/modules/auth"
+	authservice "github.com/shammianand/go-auth/internal/modules/auth/service"
+	consentmodule "github.com/shammianand/go-auth/internal/modules/consent"
+	consentservice "github.com/shammianand/go-auth/internal/modules/consent/service"
 	"github.com/shammianand/go-auth/internal/modules/email/provider"
 	emailservice "github.com/shammianand/go-auth/internal/modules/email/service"
 	rbacmodule "github.com/shammianand/go-auth/internal/modules/rbac"
+	rbacservice "github.com/shammianand/go-auth/internal/modules/rbac/service"
+	usersmodule "github.com/shammianand/go-auth/internal/modules/users"
+	usersservice "github.com/shammianand/go-auth/internal/modules/users/service"
 	"github.com/shammianand/go-auth/internal/storage"
 	"github.com/spf13/cobra"
 )
@@ -96,6 +104,31 @@ func runServer(cmd *cobra.Command, args []string) error {
 		"Go-Auth",
 	)
 
+	consentSvc := consentservice.NewConsentService(entClient, logger)
+
+	// Shared so every module reads the same permission cache
+	rbacSvc := rbacservice.NewRBACService(entClient, redisClient, emailSvc, consentSvc, logger)
+	if config.RelationSchemaFile != "" {
+		relationSchema, err := rbacservice.LoadRelationSchema(config.RelationSchemaFile)
+		if err != nil {
+			return err
+		}
+		rbacSvc.SetRelationSchema(relationSchema)
+	}
+
+	listenCtx, stopListening := context.WithCancel(context.Background())
+	defer stopListening()
+	go rbacSvc.ListenForInvalidations(listenCtx)
+	go rbacSvc.RunRoleExpiry(listenCtx, config.RoleExpiryCheckInterval)
+
+	apiKeySvc := apikeysservice.NewAPIKeyService(entClient, rbacSvc, logger)
+
+	// Installed before the route groups so it wraps every route
+	lockout := authservice.NewLockoutService(redisClient, emailSvc, authservice.DefaultLockoutPolicy(), logger)
+	usersSvc := usersservice.NewUsersService(entClient, redisClient, emailSvc, rbacSvc, lockout, apiKeySvc, logger)
+	router.Use(middleware.AuditImpersonation(usersSvc))
+	go usersSvc.RunDeletionPurge(listenCtx, config.AccountDeletionCheckInterval)
+
 	v1 := router.Group("/api/v1")
 	{
 		v1.GET("/.well-known/jwks.json", func(c *gin.Context) {
@@ -108,8 +141,11 @@ func runServer(cmd *cobra.Command, args []string) error {
 			c.String(200, jwksJSON)
 		})
 
-		authmodule.RegisterRoutes(v1, entClient, redisClient, emailSvc, logger)
-		rbacmodule.RegisterRoutes(v1, entClient, redisClient, logger)
+		authmodule.RegisterRoutes(v1, entClient, redisClient, emailSvc, rbacSvc, apiKeySvc, consentSvc, logger)
+		rbacmodule.RegisterRoutes(v1, rbacSvc, redisClient, apiKeySvc, logger)
+		usersmodule.RegisterRoutes(v1, usersSvc, redisClient, apiKeySvc, rbacSvc, logger)
+		apikeysmodule.RegisterRoutes(v1, apiKeySvc, redisClient, rbacSvc, logger)
+		consentmodule.RegisterRoutes(v1, consentSvc, redisClient, apiKeySvc, rbacSvc, logger)
 	}
 
 	srv := &http.Server{
@@ -0,0 +1,1157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/apikeys"
+)
+
+// APIKeysCreate is the builder for creating a APIKeys entity.
+type APIKeysCreate struct {
+	config
+	mutation *APIKeysMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (akc *APIKeysCreate) SetUserID(u uuid.UUID) *APIKeysCreate {
+	akc.mutation.SetUserID(u)
+	return akc
+}
+
+// SetName sets the "name" field.
+func (akc *APIKeysCreate) SetName(s string) *APIKeysCreate {
+	akc.mutation.SetName(s)
+	return akc
+}
+
+// SetPrefix sets the "prefix" field.
+func (akc *APIKeysCreate) SetPrefix(s string) *APIKeysCreate {
+	akc.mutation.SetPrefix(s)
+	return akc
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (akc *APIKeysCreate) SetKeyHash(s string) *APIKeysCreate {
+	akc.mutation.SetKeyHash(s)
+	return akc
+}
+
+// SetScopes sets the "scopes" field.
+func (akc *APIKeysCreate) SetScopes(s []string) *APIKeysCreate {
+	akc.mutation.SetScopes(s)
+	return akc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (akc *APIKeysCreate) SetExpiresAt(t time.Time) *APIKeysCreate {
+	akc.mutation.SetExpiresAt(t)
+	return akc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (akc *APIKeysCreate) SetNillableExpiresAt(t *time.Time) *APIKeysCreate {
+	if t != nil {
+		akc.SetExpiresAt(*t)
+	}
+	return akc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akc *APIKeysCreate) SetLastUsedAt(t time.Time) *APIKeysCreate {
+	akc.mutation.SetLastUsedAt(t)
+	return akc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akc *APIKeysCreate) SetNillableLastUsedAt(t *time.Time) *APIKeysCreate {
+	if t != nil {
+		akc.SetLastUsedAt(*t)
+	}
+	return akc
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (akc *APIKeysCreate) SetLastUsedIP(s string) *APIKeysCreate {
+	akc.mutation.SetLastUsedIP(s)
+	return akc
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (akc *APIKeysCreate) SetNillableLastUsedIP(s *string) *APIKeysCreate {
+	if s != nil {
+		akc.SetLastUsedIP(*s)
+	}
+	return akc
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (akc *APIKeysCreate) SetRevokedBy(u uuid.UUID) *APIKeysCreate {
+	akc.mutation.SetRevokedBy(u)
+	return akc
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (akc *APIKeysCreate) SetNillableRevokedBy(u *uuid.UUID) *APIKeysCreate {
+	if u != nil {
+		akc.SetRevokedBy(*u)
+	}
+	return akc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (akc *APIKeysCreate) SetRevokedAt(t time.Time) *APIKeysCreate {
+	akc.mutation.SetRevokedAt(t)
+	return akc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (akc *APIKeysCreate) SetNillableRevokedAt(t *time.Time) *APIKeysCreate {
+	if t != nil {
+		akc.SetRevokedAt(*t)
+	}
+	return akc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (akc *APIKeysCreate) SetCreatedAt(t time.Time) *APIKeysCreate {
+	akc.mutation.SetCreatedAt(t)
+	return akc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (akc *APIKeysCreate) SetNillableCreatedAt(t *time.Time) *APIKeysCreate {
+	if t != nil {
+		akc.SetCreatedAt(*t)
+	}
+	return akc
+}
+
+// SetID sets the "id" field.
+func (akc *APIKeysCreate) SetID(u uuid.UUID) *APIKeysCreate {
+	akc.mutation.SetID(u)
+	return akc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (akc *APIKeysCreate) SetNillableID(u *uuid.UUID) *APIKeysCreate {
+	if u != nil {
+		akc.SetID(*u)
+	}
+	return akc
+}
+
+// Mutation returns the APIKeysMutation object of the builder.
+func (akc *APIKeysCreate) Mutation() *APIKeysMutation {
+	return akc.mutation
+}
+
+// Save creates the APIKeys in the database.
+func (akc *APIKeysCreate) Save(ctx context.Context) (*APIKeys, error) {
+	akc.defaults()
+	return withHooks(ctx, akc.sqlSave, akc.mutation, akc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (akc *APIKeysCreate) SaveX(ctx context.Context) *APIKeys {
+	v, err := akc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (akc *APIKeysCreate) Exec(ctx context.Context) error {
+	_, err := akc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akc *APIKeysCreate) ExecX(ctx context.Context) {
+	if err := akc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (akc *APIKeysCreate) defaults() {
+	if _, ok := akc.mutation.CreatedAt(); !ok {
+		v := apikeys.DefaultCreatedAt()
+		akc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := akc.mutation.ID(); !ok {
+		v := apikeys.DefaultID()
+		akc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (akc *APIKeysCreate) check() error {
+	if _, ok := akc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "APIKeys.user_id"`)}
+	}
+	if _, ok := akc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "APIKeys.name"`)}
+	}
+	if v, ok := akc.mutation.Name(); ok {
+		if err := apikeys.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKeys.name": %w`, err)}
+		}
+	}
+	if _, ok := akc.mutation.Prefix(); !ok {
+		return &ValidationError{Name: "prefix", err: errors.New(`ent: missing required field "APIKeys.prefix"`)}
+	}
+	if v, ok := akc.mutation.Prefix(); ok {
+		if err := apikeys.PrefixValidator(v); err != nil {
+			return &ValidationError{Name: "prefix", err: fmt.Errorf(`ent: validator failed for field "APIKeys.prefix": %w`, err)}
+		}
+	}
+	if _, ok := akc.mutation.KeyHash(); !ok {
+		return &ValidationError{Name: "key_hash", err: errors.New(`ent: missing required field "APIKeys.key_hash"`)}
+	}
+	if v, ok := akc.mutation.KeyHash(); ok {
+		if err := apikeys.KeyHashValidator(v); err != nil {
+			return &ValidationError{Name: "key_hash", err: fmt.Errorf(`ent: validator failed for field "APIKeys.key_hash": %w`, err)}
+		}
+	}
+	if _, ok := akc.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "APIKeys.scopes"`)}
+	}
+	if _, ok := akc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "APIKeys.created_at"`)}
+	}
+	return nil
+}
+
+func (akc *APIKeysCreate) sqlSave(ctx context.Context) (*APIKeys, error) {
+	if err := akc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := akc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, akc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	akc.mutation.id = &_node.ID
+	akc.mutation.done = true
+	return _node, nil
+}
+
+func (akc *APIKeysCreate) createSpec() (*APIKeys, *sqlgraph.CreateSpec) {
+	var (
+		_node = &APIKeys{config: akc.config}
+		_spec = sqlgraph.NewCreateSpec(apikeys.Table, sqlgraph.NewFieldSpec(apikeys.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = akc.conflict
+	if id, ok := akc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := akc.mutation.UserID(); ok {
+		_spec.SetField(apikeys.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := akc.mutation.Name(); ok {
+		_spec.SetField(apikeys.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := akc.mutation.Prefix(); ok {
+		_spec.SetField(apikeys.FieldPrefix, field.TypeString, value)
+		_node.Prefix = value
+	}
+	if value, ok := akc.mutation.KeyHash(); ok {
+		_spec.SetField(apikeys.FieldKeyHash, field.TypeString, value)
+		_node.KeyHash = value
+	}
+	if value, ok := akc.mutation.Scopes(); ok {
+		_spec.SetField(apikeys.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := akc.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikeys.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := akc.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikeys.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := akc.mutation.LastUsedIP(); ok {
+		_spec.SetField(apikeys.FieldLastUsedIP, field.TypeString, value)
+		_node.LastUsedIP = value
+	}
+	if value, ok := akc.mutation.RevokedBy(); ok {
+		_spec.SetField(apikeys.FieldRevokedBy, field.TypeUUID, value)
+		_node.RevokedBy = &value
+	}
+	if value, ok := akc.mutation.RevokedAt(); ok {
+		_spec.SetField(apikeys.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := akc.mutation.CreatedAt(); ok {
+		_spec.SetField(apikeys.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APIKeys.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APIKeysUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (akc *APIKeysCreate) OnConflict(opts ...sql.ConflictOption) *APIKeysUpsertOne {
+	akc.conflict = opts
+	return &APIKeysUpsertOne{
+		create: akc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APIKeys.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (akc *APIKeysCreate) OnConflictColumns(columns ...string) *APIKeysUpsertOne {
+	akc.conflict = append(akc.conflict, sql.ConflictColumns(columns...))
+	return &APIKeysUpsertOne{
+		create: akc,
+	}
+}
+
+type (
+	// APIKeysUpsertOne is the builder for "upsert"-ing
+	//  one APIKeys node.
+	APIKeysUpsertOne struct {
+		create *APIKeysCreate
+	}
+
+	// APIKeysUpsert is the "OnConflict" setter.
+	APIKeysUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *APIKeysUpsert) SetUserID(v uuid.UUID) *APIKeysUpsert {
+	u.Set(apikeys.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *APIKeysUpsert) UpdateUserID() *APIKeysUpsert {
+	u.SetExcluded(apikeys.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *APIKeysUpsert) SetName(v string) *APIKeysUpsert {
+	u.Set(apikeys.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *APIKeysUpsert) UpdateName() *APIKeysUpsert {
+	u.SetExcluded(apikeys.FieldName)
+	return u
+}
+
+// SetPrefix sets the "prefix" field.
+func (u *APIKeysUpsert) SetPrefix(v string) *APIKeysUpsert {
+	u.Set(apikeys.FieldPrefix, v)
+	return u
+}
+
+// UpdatePrefix sets the "prefix" field to the value that was provided on create.
+func (u *APIKeysUpsert) UpdatePrefix() *APIKeysUpsert {
+	u.SetExcluded(apikeys.FieldPrefix)
+	return u
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (u *APIKeysUpsert) SetKeyHash(v string) *APIKeysUpsert {
+	u.Set(apikeys.FieldKeyHash, v)
+	return u
+}
+
+// UpdateKeyHash sets the "key_hash" field to the value that was provided on create.
+func (u *APIKeysUpsert) UpdateKeyHash() *APIKeysUpsert {
+	u.SetExcluded(apikeys.FieldKeyHash)
+	return u
+}
+
+// SetScopes sets the "scopes" field.
+func (u *APIKeysUpsert) SetScopes(v []string) *APIKeysUpsert {
+	u.Set(apikeys.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *APIKeysUpsert) UpdateScopes() *APIKeysUpsert {
+	u.SetExcluded(apikeys.FieldScopes)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIKeysUpsert) SetExpiresAt(v time.Time) *APIKeysUpsert {
+	u.Set(apikeys.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIKeysUpsert) UpdateExpiresAt() *APIKeysUpsert {
+	u.SetExcluded(apikeys.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *APIKeysUpsert) ClearExpiresAt() *APIKeysUpsert {
+	u.SetNull(apikeys.FieldExpiresAt)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *APIKeysUpsert) SetLastUsedAt(v time.Time) *APIKeysUpsert {
+	u.Set(apikeys.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *APIKeysUpsert) UpdateLastUsedAt() *APIKeysUpsert {
+	u.SetExcluded(apikeys.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *APIKeysUpsert) ClearLastUsedAt() *APIKeysUpsert {
+	u.SetNull(apikeys.FieldLastUsedAt)
+	return u
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (u *APIKeysUpsert) SetLastUsedIP(v string) *APIKeysUpsert {
+	u.Set(apikeys.FieldLastUsedIP, v)
+	return u
+}
+
+// UpdateLastUsedIP sets the "last_used_ip" field to the value that was provided on create.
+func (u *APIKeysUpsert) UpdateLastUsedIP() *APIKeysUpsert {
+	u.SetExcluded(apikeys.FieldLastUsedIP)
+	return u
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (u *APIKeysUpsert) ClearLastUsedIP() *APIKeysUpsert {
+	u.SetNull(apikeys.FieldLastUsedIP)
+	return u
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (u *APIKeysUpsert) SetRevokedBy(v uuid.UUID) *APIKeysUpsert {
+	u.Set(apikeys.FieldRevokedBy, v)
+	return u
+}
+
+// UpdateRevokedBy sets the "revoked_by" field to the value that was provided on create.
+func (u *APIKeysUpsert) UpdateRevokedBy() *APIKeysUpsert {
+	u.SetExcluded(apikeys.FieldRevokedBy)
+	return u
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (u *APIKeysUpsert) ClearRevokedBy() *APIKeysUpsert {
+	u.SetNull(apikeys.FieldRevokedBy)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *APIKeysUpsert) SetRevokedAt(v time.Time) *APIKeysUpsert {
+	u.Set(apikeys.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *APIKeysUpsert) UpdateRevokedAt() *APIKeysUpsert {
+	u.SetExcluded(apikeys.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *APIKeysUpsert) ClearRevokedAt() *APIKeysUpsert {
+	u.SetNull(apikeys.FieldRevokedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.APIKeys.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apikeys.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APIKeysUpsertOne) UpdateNewValues() *APIKeysUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(apikeys.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(apikeys.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APIKeys.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *APIKeysUpsertOne) Ignore() *APIKeysUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APIKeysUpsertOne) DoNothing() *APIKeysUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APIKeysCreate.OnConflict
+// documentation for more info.
+func (u *APIKeysUpsertOne) Update(set func(*APIKeysUpsert)) *APIKeysUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APIKeysUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *APIKeysUpsertOne) SetUserID(v uuid.UUID) *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *APIKeysUpsertOne) UpdateUserID() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *APIKeysUpsertOne) SetName(v string) *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *APIKeysUpsertOne) UpdateName() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPrefix sets the "prefix" field.
+func (u *APIKeysUpsertOne) SetPrefix(v string) *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetPrefix(v)
+	})
+}
+
+// UpdatePrefix sets the "prefix" field to the value that was provided on create.
+func (u *APIKeysUpsertOne) UpdatePrefix() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdatePrefix()
+	})
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (u *APIKeysUpsertOne) SetKeyHash(v string) *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetKeyHash(v)
+	})
+}
+
+// UpdateKeyHash sets the "key_hash" field to the value that was provided on create.
+func (u *APIKeysUpsertOne) UpdateKeyHash() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateKeyHash()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *APIKeysUpsertOne) SetScopes(v []string) *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *APIKeysUpsertOne) UpdateScopes() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIKeysUpsertOne) SetExpiresAt(v time.Time) *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIKeysUpsertOne) UpdateExpiresAt() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *APIKeysUpsertOne) ClearExpiresAt() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *APIKeysUpsertOne) SetLastUsedAt(v time.Time) *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *APIKeysUpsertOne) UpdateLastUsedAt() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *APIKeysUpsertOne) ClearLastUsedAt() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (u *APIKeysUpsertOne) SetLastUsedIP(v string) *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetLastUsedIP(v)
+	})
+}
+
+// UpdateLastUsedIP sets the "last_used_ip" field to the value that was provided on create.
+func (u *APIKeysUpsertOne) UpdateLastUsedIP() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateLastUsedIP()
+	})
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (u *APIKeysUpsertOne) ClearLastUsedIP() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.ClearLastUsedIP()
+	})
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (u *APIKeysUpsertOne) SetRevokedBy(v uuid.UUID) *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetRevokedBy(v)
+	})
+}
+
+// UpdateRevokedBy sets the "revoked_by" field to the value that was provided on create.
+func (u *APIKeysUpsertOne) UpdateRevokedBy() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateRevokedBy()
+	})
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (u *APIKeysUpsertOne) ClearRevokedBy() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.ClearRevokedBy()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *APIKeysUpsertOne) SetRevokedAt(v time.Time) *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *APIKeysUpsertOne) UpdateRevokedAt() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *APIKeysUpsertOne) ClearRevokedAt() *APIKeysUpsertOne {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *APIKeysUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APIKeysCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APIKeysUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *APIKeysUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: APIKeysUpsertOne.ID is not supported by MySQL driver. Use APIKeysUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *APIKeysUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// APIKeysCreateBulk is the builder for creating many APIKeys entities in bulk.
+type APIKeysCreateBulk struct {
+	config
+	err      error
+	builders []*APIKeysCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the APIKeys entities in the database.
+func (akcb *APIKeysCreateBulk) Save(ctx context.Context) ([]*APIKeys, error) {
+	if akcb.err != nil {
+		return nil, akcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(akcb.builders))
+	nodes := make([]*APIKeys, len(akcb.builders))
+	mutators := make([]Mutator, len(akcb.builders))
+	for i := range akcb.builders {
+		func(i int, root context.Context) {
+			builder := akcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*APIKeysMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, akcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = akcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, akcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, akcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (akcb *APIKeysCreateBulk) SaveX(ctx context.Context) []*APIKeys {
+	v, err := akcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (akcb *APIKeysCreateBulk) Exec(ctx context.Context) error {
+	_, err := akcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akcb *APIKeysCreateBulk) ExecX(ctx context.Context) {
+	if err := akcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.APIKeys.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.APIKeysUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (akcb *APIKeysCreateBulk) OnConflict(opts ...sql.ConflictOption) *APIKeysUpsertBulk {
+	akcb.conflict = opts
+	return &APIKeysUpsertBulk{
+		create: akcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.APIKeys.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (akcb *APIKeysCreateBulk) OnConflictColumns(columns ...string) *APIKeysUpsertBulk {
+	akcb.conflict = append(akcb.conflict, sql.ConflictColumns(columns...))
+	return &APIKeysUpsertBulk{
+		create: akcb,
+	}
+}
+
+// APIKeysUpsertBulk is the builder for "upsert"-ing
+// a bulk of APIKeys nodes.
+type APIKeysUpsertBulk struct {
+	create *APIKeysCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.APIKeys.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(apikeys.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *APIKeysUpsertBulk) UpdateNewValues() *APIKeysUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(apikeys.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(apikeys.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.APIKeys.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *APIKeysUpsertBulk) Ignore() *APIKeysUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *APIKeysUpsertBulk) DoNothing() *APIKeysUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the APIKeysCreateBulk.OnConflict
+// documentation for more info.
+func (u *APIKeysUpsertBulk) Update(set func(*APIKeysUpsert)) *APIKeysUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&APIKeysUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *APIKeysUpsertBulk) SetUserID(v uuid.UUID) *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *APIKeysUpsertBulk) UpdateUserID() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *APIKeysUpsertBulk) SetName(v string) *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *APIKeysUpsertBulk) UpdateName() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPrefix sets the "prefix" field.
+func (u *APIKeysUpsertBulk) SetPrefix(v string) *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetPrefix(v)
+	})
+}
+
+// UpdatePrefix sets the "prefix" field to the value that was provided on create.
+func (u *APIKeysUpsertBulk) UpdatePrefix() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdatePrefix()
+	})
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (u *APIKeysUpsertBulk) SetKeyHash(v string) *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetKeyHash(v)
+	})
+}
+
+// UpdateKeyHash sets the "key_hash" field to the value that was provided on create.
+func (u *APIKeysUpsertBulk) UpdateKeyHash() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateKeyHash()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *APIKeysUpsertBulk) SetScopes(v []string) *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *APIKeysUpsertBulk) UpdateScopes() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *APIKeysUpsertBulk) SetExpiresAt(v time.Time) *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *APIKeysUpsertBulk) UpdateExpiresAt() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *APIKeysUpsertBulk) ClearExpiresAt() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *APIKeysUpsertBulk) SetLastUsedAt(v time.Time) *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *APIKeysUpsertBulk) UpdateLastUsedAt() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *APIKeysUpsertBulk) ClearLastUsedAt() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (u *APIKeysUpsertBulk) SetLastUsedIP(v string) *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetLastUsedIP(v)
+	})
+}
+
+// UpdateLastUsedIP sets the "last_used_ip" field to the value that was provided on create.
+func (u *APIKeysUpsertBulk) UpdateLastUsedIP() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateLastUsedIP()
+	})
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (u *APIKeysUpsertBulk) ClearLastUsedIP() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.ClearLastUsedIP()
+	})
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (u *APIKeysUpsertBulk) SetRevokedBy(v uuid.UUID) *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetRevokedBy(v)
+	})
+}
+
+// UpdateRevokedBy sets the "revoked_by" field to the value that was provided on create.
+func (u *APIKeysUpsertBulk) UpdateRevokedBy() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateRevokedBy()
+	})
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (u *APIKeysUpsertBulk) ClearRevokedBy() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.ClearRevokedBy()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *APIKeysUpsertBulk) SetRevokedAt(v time.Time) *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *APIKeysUpsertBulk) UpdateRevokedAt() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *APIKeysUpsertBulk) ClearRevokedAt() *APIKeysUpsertBulk {
+	return u.Update(func(s *APIKeysUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *APIKeysUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the APIKeysCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for APIKeysCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *APIKeysUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/apikeys"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// APIKeysUpdate is the builder for updating APIKeys entities.
+type APIKeysUpdate struct {
+	config
+	hooks    []Hook
+	mutation *APIKeysMutation
+}
+
+// Where appends a list predicates to the APIKeysUpdate builder.
+func (aku *APIKeysUpdate) Where(ps ...predicate.APIKeys) *APIKeysUpdate {
+	aku.mutation.Where(ps...)
+	return aku
+}
+
+// SetUserID sets the "user_id" field.
+func (aku *APIKeysUpdate) SetUserID(u uuid.UUID) *APIKeysUpdate {
+	aku.mutation.SetUserID(u)
+	return aku
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aku *APIKeysUpdate) SetNillableUserID(u *uuid.UUID) *APIKeysUpdate {
+	if u != nil {
+		aku.SetUserID(*u)
+	}
+	return aku
+}
+
+// SetName sets the "name" field.
+func (aku *APIKeysUpdate) SetName(s string) *APIKeysUpdate {
+	aku.mutation.SetName(s)
+	return aku
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aku *APIKeysUpdate) SetNillableName(s *string) *APIKeysUpdate {
+	if s != nil {
+		aku.SetName(*s)
+	}
+	return aku
+}
+
+// SetPrefix sets the "prefix" field.
+func (aku *APIKeysUpdate) SetPrefix(s string) *APIKeysUpdate {
+	aku.mutation.SetPrefix(s)
+	return aku
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (aku *APIKeysUpdate) SetNillablePrefix(s *string) *APIKeysUpdate {
+	if s != nil {
+		aku.SetPrefix(*s)
+	}
+	return aku
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (aku *APIKeysUpdate) SetKeyHash(s string) *APIKeysUpdate {
+	aku.mutation.SetKeyHash(s)
+	return aku
+}
+
+// SetNillableKeyHash sets the "key_hash" field if the given value is not nil.
+func (aku *APIKeysUpdate) SetNillableKeyHash(s *string) *APIKeysUpdate {
+	if s != nil {
+		aku.SetKeyHash(*s)
+	}
+	return aku
+}
+
+// SetScopes sets the "scopes" field.
+func (aku *APIKeysUpdate) SetScopes(s []string) *APIKeysUpdate {
+	aku.mutation.SetScopes(s)
+	return aku
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (aku *APIKeysUpdate) AppendScopes(s []string) *APIKeysUpdate {
+	aku.mutation.AppendScopes(s)
+	return aku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (aku *APIKeysUpdate) SetExpiresAt(t time.Time) *APIKeysUpdate {
+	aku.mutation.SetExpiresAt(t)
+	return aku
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (aku *APIKeysUpdate) SetNillableExpiresAt(t *time.Time) *APIKeysUpdate {
+	if t != nil {
+		aku.SetExpiresAt(*t)
+	}
+	return aku
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (aku *APIKeysUpdate) ClearExpiresAt() *APIKeysUpdate {
+	aku.mutation.ClearExpiresAt()
+	return aku
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (aku *APIKeysUpdate) SetLastUsedAt(t time.Time) *APIKeysUpdate {
+	aku.mutation.SetLastUsedAt(t)
+	return aku
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (aku *APIKeysUpdate) SetNillableLastUsedAt(t *time.Time) *APIKeysUpdate {
+	if t != nil {
+		aku.SetLastUsedAt(*t)
+	}
+	return aku
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (aku *APIKeysUpdate) ClearLastUsedAt() *APIKeysUpdate {
+	aku.mutation.ClearLastUsedAt()
+	return aku
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (aku *APIKeysUpdate) SetLastUsedIP(s string) *APIKeysUpdate {
+	aku.mutation.SetLastUsedIP(s)
+	return aku
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (aku *APIKeysUpdate) SetNillableLastUsedIP(s *string) *APIKeysUpdate {
+	if s != nil {
+		aku.SetLastUsedIP(*s)
+	}
+	return aku
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (aku *APIKeysUpdate) ClearLastUsedIP() *APIKeysUpdate {
+	aku.mutation.ClearLastUsedIP()
+	return aku
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (aku *APIKeysUpdate) SetRevokedBy(u uuid.UUID) *APIKeysUpdate {
+	aku.mutation.SetRevokedBy(u)
+	return aku
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (aku *APIKeysUpdate) SetNillableRevokedBy(u *uuid.UUID) *APIKeysUpdate {
+	if u != nil {
+		aku.SetRevokedBy(*u)
+	}
+	return aku
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (aku *APIKeysUpdate) ClearRevokedBy() *APIKeysUpdate {
+	aku.mutation.ClearRevokedBy()
+	return aku
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (aku *APIKeysUpdate) SetRevokedAt(t time.Time) *APIKeysUpdate {
+	aku.mutation.SetRevokedAt(t)
+	return aku
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (aku *APIKeysUpdate) SetNillableRevokedAt(t *time.Time) *APIKeysUpdate {
+	if t != nil {
+		aku.SetRevokedAt(*t)
+	}
+	return aku
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (aku *APIKeysUpdate) ClearRevokedAt() *APIKeysUpdate {
+	aku.mutation.ClearRevokedAt()
+	return aku
+}
+
+// Mutation returns the APIKeysMutation object of the builder.
+func (aku *APIKeysUpdate) Mutation() *APIKeysMutation {
+	return aku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aku *APIKeysUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aku.sqlSave, aku.mutation, aku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aku *APIKeysUpdate) SaveX(ctx context.Context) int {
+	affected, err := aku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aku *APIKeysUpdate) Exec(ctx context.Context) error {
+	_, err := aku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aku *APIKeysUpdate) ExecX(ctx context.Context) {
+	if err := aku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aku *APIKeysUpdate) check() error {
+	if v, ok := aku.mutation.Name(); ok {
+		if err := apikeys.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKeys.name": %w`, err)}
+		}
+	}
+	if v, ok := aku.mutation.Prefix(); ok {
+		if err := apikeys.PrefixValidator(v); err != nil {
+			return &ValidationError{Name: "prefix", err: fmt.Errorf(`ent: validator failed for field "APIKeys.prefix": %w`, err)}
+		}
+	}
+	if v, ok := aku.mutation.KeyHash(); ok {
+		if err := apikeys.KeyHashValidator(v); err != nil {
+			return &ValidationError{Name: "key_hash", err: fmt.Errorf(`ent: validator failed for field "APIKeys.key_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aku *APIKeysUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikeys.Table, apikeys.Columns, sqlgraph.NewFieldSpec(apikeys.FieldID, field.TypeUUID))
+	if ps := aku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aku.mutation.UserID(); ok {
+		_spec.SetField(apikeys.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := aku.mutation.Name(); ok {
+		_spec.SetField(apikeys.FieldName, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.Prefix(); ok {
+		_spec.SetField(apikeys.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.KeyHash(); ok {
+		_spec.SetField(apikeys.FieldKeyHash, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.Scopes(); ok {
+		_spec.SetField(apikeys.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := aku.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikeys.FieldScopes, value)
+		})
+	}
+	if value, ok := aku.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikeys.FieldExpiresAt, field.TypeTime, value)
+	}
+	if aku.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apikeys.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := aku.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikeys.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if aku.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikeys.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := aku.mutation.LastUsedIP(); ok {
+		_spec.SetField(apikeys.FieldLastUsedIP, field.TypeString, value)
+	}
+	if aku.mutation.LastUsedIPCleared() {
+		_spec.ClearField(apikeys.FieldLastUsedIP, field.TypeString)
+	}
+	if value, ok := aku.mutation.RevokedBy(); ok {
+		_spec.SetField(apikeys.FieldRevokedBy, field.TypeUUID, value)
+	}
+	if aku.mutation.RevokedByCleared() {
+		_spec.ClearField(apikeys.FieldRevokedBy, field.TypeUUID)
+	}
+	if value, ok := aku.mutation.RevokedAt(); ok {
+		_spec.SetField(apikeys.FieldRevokedAt, field.TypeTime, value)
+	}
+	if aku.mutation.RevokedAtCleared() {
+		_spec.ClearField(apikeys.FieldRevokedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikeys.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aku.mutation.done = true
+	return n, nil
+}
+
+// APIKeysUpdateOne is the builder for updating a single APIKeys entity.
+type APIKeysUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *APIKeysMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (akuo *APIKeysUpdateOne) SetUserID(u uuid.UUID) *APIKeysUpdateOne {
+	akuo.mutation.SetUserID(u)
+	return akuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (akuo *APIKeysUpdateOne) SetNillableUserID(u *uuid.UUID) *APIKeysUpdateOne {
+	if u != nil {
+		akuo.SetUserID(*u)
+	}
+	return akuo
+}
+
+// SetName sets the "name" field.
+func (akuo *APIKeysUpdateOne) SetName(s string) *APIKeysUpdateOne {
+	akuo.mutation.SetName(s)
+	return akuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (akuo *APIKeysUpdateOne) SetNillableName(s *string) *APIKeysUpdateOne {
+	if s != nil {
+		akuo.SetName(*s)
+	}
+	return akuo
+}
+
+// SetPrefix sets the "prefix" field.
+func (akuo *APIKeysUpdateOne) SetPrefix(s string) *APIKeysUpdateOne {
+	akuo.mutation.SetPrefix(s)
+	return akuo
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (akuo *APIKeysUpdateOne) SetNillablePrefix(s *string) *APIKeysUpdateOne {
+	if s != nil {
+		akuo.SetPrefix(*s)
+	}
+	return akuo
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (akuo *APIKeysUpdateOne) SetKeyHash(s string) *APIKeysUpdateOne {
+	akuo.mutation.SetKeyHash(s)
+	return akuo
+}
+
+// SetNillableKeyHash sets the "key_hash" field if the given value is not nil.
+func (akuo *APIKeysUpdateOne) SetNillableKeyHash(s *string) *APIKeysUpdateOne {
+	if s != nil {
+		akuo.SetKeyHash(*s)
+	}
+	return akuo
+}
+
+// SetScopes sets the "scopes" field.
+func (akuo *APIKeysUpdateOne) SetScopes(s []string) *APIKeysUpdateOne {
+	akuo.mutation.SetScopes(s)
+	return akuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (akuo *APIKeysUpdateOne) AppendScopes(s []string) *APIKeysUpdateOne {
+	akuo.mutation.AppendScopes(s)
+	return akuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (akuo *APIKeysUpdateOne) SetExpiresAt(t time.Time) *APIKeysUpdateOne {
+	akuo.mutation.SetExpiresAt(t)
+	return akuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (akuo *APIKeysUpdateOne) SetNillableExpiresAt(t *time.Time) *APIKeysUpdateOne {
+	if t != nil {
+		akuo.SetExpiresAt(*t)
+	}
+	return akuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (akuo *APIKeysUpdateOne) ClearExpiresAt() *APIKeysUpdateOne {
+	akuo.mutation.ClearExpiresAt()
+	return akuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akuo *APIKeysUpdateOne) SetLastUsedAt(t time.Time) *APIKeysUpdateOne {
+	akuo.mutation.SetLastUsedAt(t)
+	return akuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akuo *APIKeysUpdateOne) SetNillableLastUsedAt(t *time.Time) *APIKeysUpdateOne {
+	if t != nil {
+		akuo.SetLastUsedAt(*t)
+	}
+	return akuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (akuo *APIKeysUpdateOne) ClearLastUsedAt() *APIKeysUpdateOne {
+	akuo.mutation.ClearLastUsedAt()
+	return akuo
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (akuo *APIKeysUpdateOne) SetLastUsedIP(s string) *APIKeysUpdateOne {
+	akuo.mutation.SetLastUsedIP(s)
+	return akuo
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (akuo *APIKeysUpdateOne) SetNillableLastUsedIP(s *string) *APIKeysUpdateOne {
+	if s != nil {
+		akuo.SetLastUsedIP(*s)
+	}
+	return akuo
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (akuo *APIKeysUpdateOne) ClearLastUsedIP() *APIKeysUpdateOne {
+	akuo.mutation.ClearLastUsedIP()
+	return akuo
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (akuo *APIKeysUpdateOne) SetRevokedBy(u uuid.UUID) *APIKeysUpdateOne {
+	akuo.mutation.SetRevokedBy(u)
+	return akuo
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (akuo *APIKeysUpdateOne) SetNillableRevokedBy(u *uuid.UUID) *APIKeysUpdateOne {
+	if u != nil {
+		akuo.SetRevokedBy(*u)
+	}
+	return akuo
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (akuo *APIKeysUpdateOne) ClearRevokedBy() *APIKeysUpdateOne {
+	akuo.mutation.ClearRevokedBy()
+	return akuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (akuo *APIKeysUpdateOne) SetRevokedAt(t time.Time) *APIKeysUpdateOne {
+	akuo.mutation.SetRevokedAt(t)
+	return akuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (akuo *APIKeysUpdateOne) SetNillableRevokedAt(t *time.Time) *APIKeysUpdateOne {
+	if t != nil {
+		akuo.SetRevokedAt(*t)
+	}
+	return akuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (akuo *APIKeysUpdateOne) ClearRevokedAt() *APIKeysUpdateOne {
+	akuo.mutation.ClearRevokedAt()
+	return akuo
+}
+
+// Mutation returns the APIKeysMutation object of the builder.
+func (akuo *APIKeysUpdateOne) Mutation() *APIKeysMutation {
+	return akuo.mutation
+}
+
+// Where appends a list predicates to the APIKeysUpdate builder.
+func (akuo *APIKeysUpdateOne) Where(ps ...predicate.APIKeys) *APIKeysUpdateOne {
+	akuo.mutation.Where(ps...)
+	return akuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (akuo *APIKeysUpdateOne) Select(field string, fields ...string) *APIKeysUpdateOne {
+	akuo.fields = append([]string{field}, fields...)
+	return akuo
+}
+
+// Save executes the query and returns the updated APIKeys entity.
+func (akuo *APIKeysUpdateOne) Save(ctx context.Context) (*APIKeys, error) {
+	return withHooks(ctx, akuo.sqlSave, akuo.mutation, akuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (akuo *APIKeysUpdateOne) SaveX(ctx context.Context) *APIKeys {
+	node, err := akuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (akuo *APIKeysUpdateOne) Exec(ctx context.Context) error {
+	_, err := akuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akuo *APIKeysUpdateOne) ExecX(ctx context.Context) {
+	if err := akuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (akuo *APIKeysUpdateOne) check() error {
+	if v, ok := akuo.mutation.Name(); ok {
+		if err := apikeys.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "APIKeys.name": %w`, err)}
+		}
+	}
+	if v, ok := akuo.mutation.Prefix(); ok {
+		if err := apikeys.PrefixValidator(v); err != nil {
+			return &ValidationError{Name: "prefix", err: fmt.Errorf(`ent: validator failed for field "APIKeys.prefix": %w`, err)}
+		}
+	}
+	if v, ok := akuo.mutation.KeyHash(); ok {
+		if err := apikeys.KeyHashValidator(v); err != nil {
+			return &ValidationError{Name: "key_hash", err: fmt.Errorf(`ent: validator failed for field "APIKeys.key_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (akuo *APIKeysUpdateOne) sqlSave(ctx context.Context) (_node *APIKeys, err error) {
+	if err := akuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikeys.Table, apikeys.Columns, sqlgraph.NewFieldSpec(apikeys.FieldID, field.TypeUUID))
+	id, ok := akuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APIKeys.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := akuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apikeys.FieldID)
+		for _, f := range fields {
+			if !apikeys.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apikeys.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := akuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := akuo.mutation.UserID(); ok {
+		_spec.SetField(apikeys.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := akuo.mutation.Name(); ok {
+		_spec.SetField(apikeys.FieldName, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.Prefix(); ok {
+		_spec.SetField(apikeys.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.KeyHash(); ok {
+		_spec.SetField(apikeys.FieldKeyHash, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.Scopes(); ok {
+		_spec.SetField(apikeys.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := akuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikeys.FieldScopes, value)
+		})
+	}
+	if value, ok := akuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikeys.FieldExpiresAt, field.TypeTime, value)
+	}
+	if akuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(apikeys.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := akuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikeys.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikeys.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := akuo.mutation.LastUsedIP(); ok {
+		_spec.SetField(apikeys.FieldLastUsedIP, field.TypeString, value)
+	}
+	if akuo.mutation.LastUsedIPCleared() {
+		_spec.ClearField(apikeys.FieldLastUsedIP, field.TypeString)
+	}
+	if value, ok := akuo.mutation.RevokedBy(); ok {
+		_spec.SetField(apikeys.FieldRevokedBy, field.TypeUUID, value)
+	}
+	if akuo.mutation.RevokedByCleared() {
+		_spec.ClearField(apikeys.FieldRevokedBy, field.TypeUUID)
+	}
+	if value, ok := akuo.mutation.RevokedAt(); ok {
+		_spec.SetField(apikeys.FieldRevokedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(apikeys.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &APIKeys{config: akuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, akuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikeys.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	akuo.mutation.done = true
+	return _node, nil
+}
@@ -31,6 +31,8 @@ type AuditLogs struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// Before/after values for updates
 	Changes map[string]interface{} `json:"changes,omitempty"`
+	// Organization the action was taken in
+	OrgID *uuid.UUID `json:"org_id,omitempty"`
 	// IPAddress holds the value of the "ip_address" field.
 	IPAddress string `json:"ip_address,omitempty"`
 	// UserAgent holds the value of the "user_agent" field.
@@ -45,7 +47,7 @@ func (*AuditLogs) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case auditlogs.FieldActorID:
+		case auditlogs.FieldActorID, auditlogs.FieldOrgID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case auditlogs.FieldMetadata, auditlogs.FieldChanges:
 			values[i] = new([]byte)
@@ -117,6 +119,13 @@ func (al *AuditLogs) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field changes: %w", err)
 				}
 			}
+		case auditlogs.FieldOrgID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value.Valid {
+				al.OrgID = new(uuid.UUID)
+				*al.OrgID = *value.S.(*uuid.UUID)
+			}
 		case auditlogs.FieldIPAddress:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
@@ -191,6 +200,11 @@ func (al *AuditLogs) String() string {
 	builder.WriteString("changes=")
 	builder.WriteString(fmt.Sprintf("%v", al.Changes))
 	builder.WriteString(", ")
+	if v := al.OrgID; v != nil {
+		builder.WriteString("org_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("ip_address=")
 	builder.WriteString(al.IPAddress)
 	builder.WriteString(", ")
@@ -75,6 +75,11 @@ func ResourceID(v string) predicate.AuditLogs {
 	return predicate.AuditLogs(sql.FieldEQ(FieldResourceID, v))
 }
 
+// OrgID applies equality check predicate on the "org_id" field. It's identical to OrgIDEQ.
+func OrgID(v uuid.UUID) predicate.AuditLogs {
+	return predicate.AuditLogs(sql.FieldEQ(FieldOrgID, v))
+}
+
 // IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
 func IPAddress(v string) predicate.AuditLogs {
 	return predicate.AuditLogs(sql.FieldEQ(FieldIPAddress, v))
@@ -365,6 +370,56 @@ func ChangesNotNil() predicate.AuditLogs {
 	return predicate.AuditLogs(sql.FieldNotNull(FieldChanges))
 }
 
+// OrgIDEQ applies the EQ predicate on the "org_id" field.
+func OrgIDEQ(v uuid.UUID) predicate.AuditLogs {
+	return predicate.AuditLogs(sql.FieldEQ(FieldOrgID, v))
+}
+
+// OrgIDNEQ applies the NEQ predicate on the "org_id" field.
+func OrgIDNEQ(v uuid.UUID) predicate.AuditLogs {
+	return predicate.AuditLogs(sql.FieldNEQ(FieldOrgID, v))
+}
+
+// OrgIDIn applies the In predicate on the "org_id" field.
+func OrgIDIn(vs ...uuid.UUID) predicate.AuditLogs {
+	return predicate.AuditLogs(sql.FieldIn(FieldOrgID, vs...))
+}
+
+// OrgIDNotIn applies the NotIn predicate on the "org_id" field.
+func OrgIDNotIn(vs ...uuid.UUID) predicate.AuditLogs {
+	return predicate.AuditLogs(sql.FieldNotIn(FieldOrgID, vs...))
+}
+
+// OrgIDGT applies the GT predicate on the "org_id" field.
+func OrgIDGT(v uuid.UUID) predicate.AuditLogs {
+	return predicate.AuditLogs(sql.FieldGT(FieldOrgID, v))
+}
+
+// OrgIDGTE applies the GTE predicate on the "org_id" field.
+func OrgIDGTE(v uuid.UUID) predicate.AuditLogs {
+	return predicate.AuditLogs(sql.FieldGTE(FieldOrgID, v))
+}
+
+// OrgIDLT applies the LT predicate on the "org_id" field.
+func OrgIDLT(v uuid.UUID) predicate.AuditLogs {
+	return predicate.AuditLogs(sql.FieldLT(FieldOrgID, v))
+}
+
+// OrgIDLTE applies the LTE predicate on the "org_id" field.
+func OrgIDLTE(v uuid.UUID) predicate.AuditLogs {
+	return predicate.AuditLogs(sql.FieldLTE(FieldOrgID, v))
+}
+
+// OrgIDIsNil applies the IsNil predicate on the "org_id" field.
+func OrgIDIsNil() predicate.AuditLogs {
+	return predicate.AuditLogs(sql.FieldIsNull(FieldOrgID))
+}
+
+// OrgIDNotNil applies the NotNil predicate on the "org_id" field.
+func OrgIDNotNil() predicate.AuditLogs {
+	return predicate.AuditLogs(sql.FieldNotNull(FieldOrgID))
+}
+
 // IPAddressEQ applies the EQ predicate on the "ip_address" field.
 func IPAddressEQ(v string) predicate.AuditLogs {
 	return predicate.AuditLogs(sql.FieldEQ(FieldIPAddress, v))
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -19,6 +21,7 @@ type AuditLogsCreate struct {
 	config
 	mutation *AuditLogsMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetActorID sets the "actor_id" field.
@@ -73,6 +76,20 @@ func (alc *AuditLogsCreate) SetChanges(m map[string]interface{}) *AuditLogsCreat
 	return alc
 }
 
+// SetOrgID sets the "org_id" field.
+func (alc *AuditLogsCreate) SetOrgID(u uuid.UUID) *AuditLogsCreate {
+	alc.mutation.SetOrgID(u)
+	return alc
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (alc *AuditLogsCreate) SetNillableOrgID(u *uuid.UUID) *AuditLogsCreate {
+	if u != nil {
+		alc.SetOrgID(*u)
+	}
+	return alc
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (alc *AuditLogsCreate) SetIPAddress(s string) *AuditLogsCreate {
 	alc.mutation.SetIPAddress(s)
@@ -226,6 +243,7 @@ func (alc *AuditLogsCreate) createSpec() (*AuditLogs, *sqlgraph.CreateSpec) {
 		_node = &AuditLogs{config: alc.config}
 		_spec = sqlgraph.NewCreateSpec(auditlogs.Table, sqlgraph.NewFieldSpec(auditlogs.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = alc.conflict
 	if id, ok := alc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -254,6 +272,10 @@ func (alc *AuditLogsCreate) createSpec() (*AuditLogs, *sqlgraph.CreateSpec) {
 		_spec.SetField(auditlogs.FieldChanges, field.TypeJSON, value)
 		_node.Changes = value
 	}
+	if value, ok := alc.mutation.OrgID(); ok {
+		_spec.SetField(auditlogs.FieldOrgID, field.TypeUUID, value)
+		_node.OrgID = &value
+	}
 	if value, ok := alc.mutation.IPAddress(); ok {
 		_spec.SetField(auditlogs.FieldIPAddress, field.TypeString, value)
 		_node.IPAddress = value
@@ -269,11 +291,475 @@ func (alc *AuditLogsCreate) createSpec() (*AuditLogs, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLogs.Create().
+//		SetActorID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogsUpsert) {
+//			SetActorID(v+v).
+//		}).
+//		Exec(ctx)
+func (alc *AuditLogsCreate) OnConflict(opts ...sql.ConflictOption) *AuditLogsUpsertOne {
+	alc.conflict = opts
+	return &AuditLogsUpsertOne{
+		create: alc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLogs.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alc *AuditLogsCreate) OnConflictColumns(columns ...string) *AuditLogsUpsertOne {
+	alc.conflict = append(alc.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogsUpsertOne{
+		create: alc,
+	}
+}
+
+type (
+	// AuditLogsUpsertOne is the builder for "upsert"-ing
+	//  one AuditLogs node.
+	AuditLogsUpsertOne struct {
+		create *AuditLogsCreate
+	}
+
+	// AuditLogsUpsert is the "OnConflict" setter.
+	AuditLogsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditLogsUpsert) SetActorID(v uuid.UUID) *AuditLogsUpsert {
+	u.Set(auditlogs.FieldActorID, v)
+	return u
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditLogsUpsert) UpdateActorID() *AuditLogsUpsert {
+	u.SetExcluded(auditlogs.FieldActorID)
+	return u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditLogsUpsert) ClearActorID() *AuditLogsUpsert {
+	u.SetNull(auditlogs.FieldActorID)
+	return u
+}
+
+// SetActionType sets the "action_type" field.
+func (u *AuditLogsUpsert) SetActionType(v string) *AuditLogsUpsert {
+	u.Set(auditlogs.FieldActionType, v)
+	return u
+}
+
+// UpdateActionType sets the "action_type" field to the value that was provided on create.
+func (u *AuditLogsUpsert) UpdateActionType() *AuditLogsUpsert {
+	u.SetExcluded(auditlogs.FieldActionType)
+	return u
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *AuditLogsUpsert) SetResourceType(v string) *AuditLogsUpsert {
+	u.Set(auditlogs.FieldResourceType, v)
+	return u
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *AuditLogsUpsert) UpdateResourceType() *AuditLogsUpsert {
+	u.SetExcluded(auditlogs.FieldResourceType)
+	return u
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *AuditLogsUpsert) SetResourceID(v string) *AuditLogsUpsert {
+	u.Set(auditlogs.FieldResourceID, v)
+	return u
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *AuditLogsUpsert) UpdateResourceID() *AuditLogsUpsert {
+	u.SetExcluded(auditlogs.FieldResourceID)
+	return u
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (u *AuditLogsUpsert) ClearResourceID() *AuditLogsUpsert {
+	u.SetNull(auditlogs.FieldResourceID)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AuditLogsUpsert) SetMetadata(v map[string]interface{}) *AuditLogsUpsert {
+	u.Set(auditlogs.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AuditLogsUpsert) UpdateMetadata() *AuditLogsUpsert {
+	u.SetExcluded(auditlogs.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AuditLogsUpsert) ClearMetadata() *AuditLogsUpsert {
+	u.SetNull(auditlogs.FieldMetadata)
+	return u
+}
+
+// SetChanges sets the "changes" field.
+func (u *AuditLogsUpsert) SetChanges(v map[string]interface{}) *AuditLogsUpsert {
+	u.Set(auditlogs.FieldChanges, v)
+	return u
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *AuditLogsUpsert) UpdateChanges() *AuditLogsUpsert {
+	u.SetExcluded(auditlogs.FieldChanges)
+	return u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (u *AuditLogsUpsert) ClearChanges() *AuditLogsUpsert {
+	u.SetNull(auditlogs.FieldChanges)
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *AuditLogsUpsert) SetOrgID(v uuid.UUID) *AuditLogsUpsert {
+	u.Set(auditlogs.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AuditLogsUpsert) UpdateOrgID() *AuditLogsUpsert {
+	u.SetExcluded(auditlogs.FieldOrgID)
+	return u
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *AuditLogsUpsert) ClearOrgID() *AuditLogsUpsert {
+	u.SetNull(auditlogs.FieldOrgID)
+	return u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *AuditLogsUpsert) SetIPAddress(v string) *AuditLogsUpsert {
+	u.Set(auditlogs.FieldIPAddress, v)
+	return u
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *AuditLogsUpsert) UpdateIPAddress() *AuditLogsUpsert {
+	u.SetExcluded(auditlogs.FieldIPAddress)
+	return u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *AuditLogsUpsert) ClearIPAddress() *AuditLogsUpsert {
+	u.SetNull(auditlogs.FieldIPAddress)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditLogsUpsert) SetUserAgent(v string) *AuditLogsUpsert {
+	u.Set(auditlogs.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditLogsUpsert) UpdateUserAgent() *AuditLogsUpsert {
+	u.SetExcluded(auditlogs.FieldUserAgent)
+	return u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *AuditLogsUpsert) ClearUserAgent() *AuditLogsUpsert {
+	u.SetNull(auditlogs.FieldUserAgent)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditLogs.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlogs.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogsUpsertOne) UpdateNewValues() *AuditLogsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditlogs.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(auditlogs.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLogs.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditLogsUpsertOne) Ignore() *AuditLogsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogsUpsertOne) DoNothing() *AuditLogsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogsCreate.OnConflict
+// documentation for more info.
+func (u *AuditLogsUpsertOne) Update(set func(*AuditLogsUpsert)) *AuditLogsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditLogsUpsertOne) SetActorID(v uuid.UUID) *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetActorID(v)
+	})
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditLogsUpsertOne) UpdateActorID() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateActorID()
+	})
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditLogsUpsertOne) ClearActorID() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearActorID()
+	})
+}
+
+// SetActionType sets the "action_type" field.
+func (u *AuditLogsUpsertOne) SetActionType(v string) *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetActionType(v)
+	})
+}
+
+// UpdateActionType sets the "action_type" field to the value that was provided on create.
+func (u *AuditLogsUpsertOne) UpdateActionType() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateActionType()
+	})
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *AuditLogsUpsertOne) SetResourceType(v string) *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetResourceType(v)
+	})
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *AuditLogsUpsertOne) UpdateResourceType() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateResourceType()
+	})
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *AuditLogsUpsertOne) SetResourceID(v string) *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetResourceID(v)
+	})
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *AuditLogsUpsertOne) UpdateResourceID() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateResourceID()
+	})
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (u *AuditLogsUpsertOne) ClearResourceID() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearResourceID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AuditLogsUpsertOne) SetMetadata(v map[string]interface{}) *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AuditLogsUpsertOne) UpdateMetadata() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AuditLogsUpsertOne) ClearMetadata() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetChanges sets the "changes" field.
+func (u *AuditLogsUpsertOne) SetChanges(v map[string]interface{}) *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetChanges(v)
+	})
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *AuditLogsUpsertOne) UpdateChanges() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateChanges()
+	})
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (u *AuditLogsUpsertOne) ClearChanges() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearChanges()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *AuditLogsUpsertOne) SetOrgID(v uuid.UUID) *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AuditLogsUpsertOne) UpdateOrgID() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *AuditLogsUpsertOne) ClearOrgID() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearOrgID()
+	})
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *AuditLogsUpsertOne) SetIPAddress(v string) *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *AuditLogsUpsertOne) UpdateIPAddress() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *AuditLogsUpsertOne) ClearIPAddress() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditLogsUpsertOne) SetUserAgent(v string) *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditLogsUpsertOne) UpdateUserAgent() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *AuditLogsUpsertOne) ClearUserAgent() *AuditLogsUpsertOne {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditLogsUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AuditLogsUpsertOne.ID is not supported by MySQL driver. Use AuditLogsUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditLogsUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // AuditLogsCreateBulk is the builder for creating many AuditLogs entities in bulk.
 type AuditLogsCreateBulk struct {
 	config
 	err      error
 	builders []*AuditLogsCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the AuditLogs entities in the database.
@@ -303,6 +789,7 @@ func (alcb *AuditLogsCreateBulk) Save(ctx context.Context) ([]*AuditLogs, error)
 					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = alcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -352,3 +839,295 @@ func (alcb *AuditLogsCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLogs.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogsUpsert) {
+//			SetActorID(v+v).
+//		}).
+//		Exec(ctx)
+func (alcb *AuditLogsCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditLogsUpsertBulk {
+	alcb.conflict = opts
+	return &AuditLogsUpsertBulk{
+		create: alcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLogs.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alcb *AuditLogsCreateBulk) OnConflictColumns(columns ...string) *AuditLogsUpsertBulk {
+	alcb.conflict = append(alcb.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogsUpsertBulk{
+		create: alcb,
+	}
+}
+
+// AuditLogsUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditLogs nodes.
+type AuditLogsUpsertBulk struct {
+	create *AuditLogsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditLogs.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlogs.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogsUpsertBulk) UpdateNewValues() *AuditLogsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditlogs.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(auditlogs.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLogs.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditLogsUpsertBulk) Ignore() *AuditLogsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogsUpsertBulk) DoNothing() *AuditLogsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogsCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditLogsUpsertBulk) Update(set func(*AuditLogsUpsert)) *AuditLogsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditLogsUpsertBulk) SetActorID(v uuid.UUID) *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetActorID(v)
+	})
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditLogsUpsertBulk) UpdateActorID() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateActorID()
+	})
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditLogsUpsertBulk) ClearActorID() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearActorID()
+	})
+}
+
+// SetActionType sets the "action_type" field.
+func (u *AuditLogsUpsertBulk) SetActionType(v string) *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetActionType(v)
+	})
+}
+
+// UpdateActionType sets the "action_type" field to the value that was provided on create.
+func (u *AuditLogsUpsertBulk) UpdateActionType() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateActionType()
+	})
+}
+
+// SetResourceType sets the "resource_type" field.
+func (u *AuditLogsUpsertBulk) SetResourceType(v string) *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetResourceType(v)
+	})
+}
+
+// UpdateResourceType sets the "resource_type" field to the value that was provided on create.
+func (u *AuditLogsUpsertBulk) UpdateResourceType() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateResourceType()
+	})
+}
+
+// SetResourceID sets the "resource_id" field.
+func (u *AuditLogsUpsertBulk) SetResourceID(v string) *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetResourceID(v)
+	})
+}
+
+// UpdateResourceID sets the "resource_id" field to the value that was provided on create.
+func (u *AuditLogsUpsertBulk) UpdateResourceID() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateResourceID()
+	})
+}
+
+// ClearResourceID clears the value of the "resource_id" field.
+func (u *AuditLogsUpsertBulk) ClearResourceID() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearResourceID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *AuditLogsUpsertBulk) SetMetadata(v map[string]interface{}) *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *AuditLogsUpsertBulk) UpdateMetadata() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *AuditLogsUpsertBulk) ClearMetadata() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetChanges sets the "changes" field.
+func (u *AuditLogsUpsertBulk) SetChanges(v map[string]interface{}) *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetChanges(v)
+	})
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *AuditLogsUpsertBulk) UpdateChanges() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateChanges()
+	})
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (u *AuditLogsUpsertBulk) ClearChanges() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearChanges()
+	})
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *AuditLogsUpsertBulk) SetOrgID(v uuid.UUID) *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *AuditLogsUpsertBulk) UpdateOrgID() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (u *AuditLogsUpsertBulk) ClearOrgID() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearOrgID()
+	})
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *AuditLogsUpsertBulk) SetIPAddress(v string) *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *AuditLogsUpsertBulk) UpdateIPAddress() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *AuditLogsUpsertBulk) ClearIPAddress() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditLogsUpsertBulk) SetUserAgent(v string) *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditLogsUpsertBulk) UpdateUserAgent() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *AuditLogsUpsertBulk) ClearUserAgent() *AuditLogsUpsertBulk {
+	return u.Update(func(s *AuditLogsUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditLogsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -22,6 +23,7 @@ type AuditLogsQuery struct {
 	order      []auditlogs.OrderOption
 	inters     []Interceptor
 	predicates []predicate.AuditLogs
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -343,6 +345,9 @@ func (alq *AuditLogsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*A
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	if len(alq.modifiers) > 0 {
+		_spec.Modifiers = alq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -357,6 +362,9 @@ func (alq *AuditLogsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*A
 
 func (alq *AuditLogsQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := alq.querySpec()
+	if len(alq.modifiers) > 0 {
+		_spec.Modifiers = alq.modifiers
+	}
 	_spec.Node.Columns = alq.ctx.Fields
 	if len(alq.ctx.Fields) > 0 {
 		_spec.Unique = alq.ctx.Unique != nil && *alq.ctx.Unique
@@ -419,6 +427,9 @@ func (alq *AuditLogsQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if alq.ctx.Unique != nil && *alq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range alq.modifiers {
+		m(selector)
+	}
 	for _, p := range alq.predicates {
 		p(selector)
 	}
@@ -436,6 +447,32 @@ func (alq *AuditLogsQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (alq *AuditLogsQuery) ForUpdate(opts ...sql.LockOption) *AuditLogsQuery {
+	if alq.driver.Dialect() == dialect.Postgres {
+		alq.Unique(false)
+	}
+	alq.modifiers = append(alq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return alq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (alq *AuditLogsQuery) ForShare(opts ...sql.LockOption) *AuditLogsQuery {
+	if alq.driver.Dialect() == dialect.Postgres {
+		alq.Unique(false)
+	}
+	alq.modifiers = append(alq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return alq
+}
+
 // AuditLogsGroupBy is the group-by builder for AuditLogs entities.
 type AuditLogsGroupBy struct {
 	selector
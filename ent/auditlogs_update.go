@@ -120,6 +120,26 @@ func (alu *AuditLogsUpdate) ClearChanges() *AuditLogsUpdate {
 	return alu
 }
 
+// SetOrgID sets the "org_id" field.
+func (alu *AuditLogsUpdate) SetOrgID(u uuid.UUID) *AuditLogsUpdate {
+	alu.mutation.SetOrgID(u)
+	return alu
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (alu *AuditLogsUpdate) SetNillableOrgID(u *uuid.UUID) *AuditLogsUpdate {
+	if u != nil {
+		alu.SetOrgID(*u)
+	}
+	return alu
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (alu *AuditLogsUpdate) ClearOrgID() *AuditLogsUpdate {
+	alu.mutation.ClearOrgID()
+	return alu
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (alu *AuditLogsUpdate) SetIPAddress(s string) *AuditLogsUpdate {
 	alu.mutation.SetIPAddress(s)
@@ -249,6 +269,12 @@ func (alu *AuditLogsUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if alu.mutation.ChangesCleared() {
 		_spec.ClearField(auditlogs.FieldChanges, field.TypeJSON)
 	}
+	if value, ok := alu.mutation.OrgID(); ok {
+		_spec.SetField(auditlogs.FieldOrgID, field.TypeUUID, value)
+	}
+	if alu.mutation.OrgIDCleared() {
+		_spec.ClearField(auditlogs.FieldOrgID, field.TypeUUID)
+	}
 	if value, ok := alu.mutation.IPAddress(); ok {
 		_spec.SetField(auditlogs.FieldIPAddress, field.TypeString, value)
 	}
@@ -373,6 +399,26 @@ func (aluo *AuditLogsUpdateOne) ClearChanges() *AuditLogsUpdateOne {
 	return aluo
 }
 
+// SetOrgID sets the "org_id" field.
+func (aluo *AuditLogsUpdateOne) SetOrgID(u uuid.UUID) *AuditLogsUpdateOne {
+	aluo.mutation.SetOrgID(u)
+	return aluo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (aluo *AuditLogsUpdateOne) SetNillableOrgID(u *uuid.UUID) *AuditLogsUpdateOne {
+	if u != nil {
+		aluo.SetOrgID(*u)
+	}
+	return aluo
+}
+
+// ClearOrgID clears the value of the "org_id" field.
+func (aluo *AuditLogsUpdateOne) ClearOrgID() *AuditLogsUpdateOne {
+	aluo.mutation.ClearOrgID()
+	return aluo
+}
+
 // SetIPAddress sets the "ip_address" field.
 func (aluo *AuditLogsUpdateOne) SetIPAddress(s string) *AuditLogsUpdateOne {
 	aluo.mutation.SetIPAddress(s)
@@ -532,6 +578,12 @@ func (aluo *AuditLogsUpdateOne) sqlSave(ctx context.Context) (_node *AuditLogs,
 	if aluo.mutation.ChangesCleared() {
 		_spec.ClearField(auditlogs.FieldChanges, field.TypeJSON)
 	}
+	if value, ok := aluo.mutation.OrgID(); ok {
+		_spec.SetField(auditlogs.FieldOrgID, field.TypeUUID, value)
+	}
+	if aluo.mutation.OrgIDCleared() {
+		_spec.ClearField(auditlogs.FieldOrgID, field.TypeUUID)
+	}
 	if value, ok := aluo.mutation.IPAddress(); ok {
 		_spec.SetField(auditlogs.FieldIPAddress, field.TypeString, value)
 	}
@@ -16,13 +16,32 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shammianand/go-auth/ent/apikeys"
 	"github.com/shammianand/go-auth/ent/auditlogs"
+	"github.com/shammianand/go-auth/ent/consentacceptances"
+	"github.com/shammianand/go-auth/ent/consentdocuments"
+	"github.com/shammianand/go-auth/ent/emailchanges"
 	"github.com/shammianand/go-auth/ent/emaillogs"
 	"github.com/shammianand/go-auth/ent/emailverifications"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/invitations"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/passwordhistories"
 	"github.com/shammianand/go-auth/ent/passwordresets"
 	"github.com/shammianand/go-auth/ent/permissions"
+	"github.com/shammianand/go-auth/ent/relationtuples"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roleparents"
 	"github.com/shammianand/go-auth/ent/rolepermissions"
+	"github.com/shammianand/go-auth/ent/rolerequests"
 	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
 	"github.com/shammianand/go-auth/ent/userroles"
 	"github.com/shammianand/go-auth/ent/users"
 )
@@ -32,20 +51,58 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKeys is the client for interacting with the APIKeys builders.
+	APIKeys *APIKeysClient
 	// AuditLogs is the client for interacting with the AuditLogs builders.
 	AuditLogs *AuditLogsClient
+	// ConsentAcceptances is the client for interacting with the ConsentAcceptances builders.
+	ConsentAcceptances *ConsentAcceptancesClient
+	// ConsentDocuments is the client for interacting with the ConsentDocuments builders.
+	ConsentDocuments *ConsentDocumentsClient
+	// EmailChanges is the client for interacting with the EmailChanges builders.
+	EmailChanges *EmailChangesClient
 	// EmailLogs is the client for interacting with the EmailLogs builders.
 	EmailLogs *EmailLogsClient
 	// EmailVerifications is the client for interacting with the EmailVerifications builders.
 	EmailVerifications *EmailVerificationsClient
+	// GroupMembers is the client for interacting with the GroupMembers builders.
+	GroupMembers *GroupMembersClient
+	// GroupParents is the client for interacting with the GroupParents builders.
+	GroupParents *GroupParentsClient
+	// GroupRoles is the client for interacting with the GroupRoles builders.
+	GroupRoles *GroupRolesClient
+	// Groups is the client for interacting with the Groups builders.
+	Groups *GroupsClient
+	// Invitations is the client for interacting with the Invitations builders.
+	Invitations *InvitationsClient
+	// OrgMembers is the client for interacting with the OrgMembers builders.
+	OrgMembers *OrgMembersClient
+	// OrgRoles is the client for interacting with the OrgRoles builders.
+	OrgRoles *OrgRolesClient
+	// Organizations is the client for interacting with the Organizations builders.
+	Organizations *OrganizationsClient
+	// PasswordHistories is the client for interacting with the PasswordHistories builders.
+	PasswordHistories *PasswordHistoriesClient
 	// PasswordResets is the client for interacting with the PasswordResets builders.
 	PasswordResets *PasswordResetsClient
 	// Permissions is the client for interacting with the Permissions builders.
 	Permissions *PermissionsClient
+	// RelationTuples is the client for interacting with the RelationTuples builders.
+	RelationTuples *RelationTuplesClient
+	// RoleApprovers is the client for interacting with the RoleApprovers builders.
+	RoleApprovers *RoleApproversClient
+	// RoleParents is the client for interacting with the RoleParents builders.
+	RoleParents *RoleParentsClient
 	// RolePermissions is the client for interacting with the RolePermissions builders.
 	RolePermissions *RolePermissionsClient
+	// RoleRequests is the client for interacting with the RoleRequests builders.
+	RoleRequests *RoleRequestsClient
 	// Roles is the client for interacting with the Roles builders.
 	Roles *RolesClient
+	// SodConstraintRoles is the client for interacting with the SodConstraintRoles builders.
+	SodConstraintRoles *SodConstraintRolesClient
+	// SodConstraints is the client for interacting with the SodConstraints builders.
+	SodConstraints *SodConstraintsClient
 	// UserRoles is the client for interacting with the UserRoles builders.
 	UserRoles *UserRolesClient
 	// Users is the client for interacting with the Users builders.
@@ -61,13 +118,32 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKeys = NewAPIKeysClient(c.config)
 	c.AuditLogs = NewAuditLogsClient(c.config)
+	c.ConsentAcceptances = NewConsentAcceptancesClient(c.config)
+	c.ConsentDocuments = NewConsentDocumentsClient(c.config)
+	c.EmailChanges = NewEmailChangesClient(c.config)
 	c.EmailLogs = NewEmailLogsClient(c.config)
 	c.EmailVerifications = NewEmailVerificationsClient(c.config)
+	c.GroupMembers = NewGroupMembersClient(c.config)
+	c.GroupParents = NewGroupParentsClient(c.config)
+	c.GroupRoles = NewGroupRolesClient(c.config)
+	c.Groups = NewGroupsClient(c.config)
+	c.Invitations = NewInvitationsClient(c.config)
+	c.OrgMembers = NewOrgMembersClient(c.config)
+	c.OrgRoles = NewOrgRolesClient(c.config)
+	c.Organizations = NewOrganizationsClient(c.config)
+	c.PasswordHistories = NewPasswordHistoriesClient(c.config)
 	c.PasswordResets = NewPasswordResetsClient(c.config)
 	c.Permissions = NewPermissionsClient(c.config)
+	c.RelationTuples = NewRelationTuplesClient(c.config)
+	c.RoleApprovers = NewRoleApproversClient(c.config)
+	c.RoleParents = NewRoleParentsClient(c.config)
 	c.RolePermissions = NewRolePermissionsClient(c.config)
+	c.RoleRequests = NewRoleRequestsClient(c.config)
 	c.Roles = NewRolesClient(c.config)
+	c.SodConstraintRoles = NewSodConstraintRolesClient(c.config)
+	c.SodConstraints = NewSodConstraintsClient(c.config)
 	c.UserRoles = NewUserRolesClient(c.config)
 	c.Users = NewUsersClient(c.config)
 }
@@ -162,13 +238,32 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                ctx,
 		config:             cfg,
+		APIKeys:            NewAPIKeysClient(cfg),
 		AuditLogs:          NewAuditLogsClient(cfg),
+		ConsentAcceptances: NewConsentAcceptancesClient(cfg),
+		ConsentDocuments:   NewConsentDocumentsClient(cfg),
+		EmailChanges:       NewEmailChangesClient(cfg),
 		EmailLogs:          NewEmailLogsClient(cfg),
 		EmailVerifications: NewEmailVerificationsClient(cfg),
+		GroupMembers:       NewGroupMembersClient(cfg),
+		GroupParents:       NewGroupParentsClient(cfg),
+		GroupRoles:         NewGroupRolesClient(cfg),
+		Groups:             NewGroupsClient(cfg),
+		Invitations:        NewInvitationsClient(cfg),
+		OrgMembers:         NewOrgMembersClient(cfg),
+		OrgRoles:           NewOrgRolesClient(cfg),
+		Organizations:      NewOrganizationsClient(cfg),
+		PasswordHistories:  NewPasswordHistoriesClient(cfg),
 		PasswordResets:     NewPasswordResetsClient(cfg),
 		Permissions:        NewPermissionsClient(cfg),
+		RelationTuples:     NewRelationTuplesClient(cfg),
+		RoleApprovers:      NewRoleApproversClient(cfg),
+		RoleParents:        NewRoleParentsClient(cfg),
 		RolePermissions:    NewRolePermissionsClient(cfg),
+		RoleRequests:       NewRoleRequestsClient(cfg),
 		Roles:              NewRolesClient(cfg),
+		SodConstraintRoles: NewSodConstraintRolesClient(cfg),
+		SodConstraints:     NewSodConstraintsClient(cfg),
 		UserRoles:          NewUserRolesClient(cfg),
 		Users:              NewUsersClient(cfg),
 	}, nil
@@ -190,13 +285,32 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                ctx,
 		config:             cfg,
+		APIKeys:            NewAPIKeysClient(cfg),
 		AuditLogs:          NewAuditLogsClient(cfg),
+		ConsentAcceptances: NewConsentAcceptancesClient(cfg),
+		ConsentDocuments:   NewConsentDocumentsClient(cfg),
+		EmailChanges:       NewEmailChangesClient(cfg),
 		EmailLogs:          NewEmailLogsClient(cfg),
 		EmailVerifications: NewEmailVerificationsClient(cfg),
+		GroupMembers:       NewGroupMembersClient(cfg),
+		GroupParents:       NewGroupParentsClient(cfg),
+		GroupRoles:         NewGroupRolesClient(cfg),
+		Groups:             NewGroupsClient(cfg),
+		Invitations:        NewInvitationsClient(cfg),
+		OrgMembers:         NewOrgMembersClient(cfg),
+		OrgRoles:           NewOrgRolesClient(cfg),
+		Organizations:      NewOrganizationsClient(cfg),
+		PasswordHistories:  NewPasswordHistoriesClient(cfg),
 		PasswordResets:     NewPasswordResetsClient(cfg),
 		Permissions:        NewPermissionsClient(cfg),
+		RelationTuples:     NewRelationTuplesClient(cfg),
+		RoleApprovers:      NewRoleApproversClient(cfg),
+		RoleParents:        NewRoleParentsClient(cfg),
 		RolePermissions:    NewRolePermissionsClient(cfg),
+		RoleRequests:       NewRoleRequestsClient(cfg),
 		Roles:              NewRolesClient(cfg),
+		SodConstraintRoles: NewSodConstraintRolesClient(cfg),
+		SodConstraints:     NewSodConstraintsClient(cfg),
 		UserRoles:          NewUserRolesClient(cfg),
 		Users:              NewUsersClient(cfg),
 	}, nil
@@ -205,7 +319,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AuditLogs.
+//		APIKeys.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -228,8 +342,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AuditLogs, c.EmailLogs, c.EmailVerifications, c.PasswordResets, c.Permissions,
-		c.RolePermissions, c.Roles, c.UserRoles, c.Users,
+		c.APIKeys, c.AuditLogs, c.ConsentAcceptances, c.ConsentDocuments,
+		c.EmailChanges, c.EmailLogs, c.EmailVerifications, c.GroupMembers,
+		c.GroupParents, c.GroupRoles, c.Groups, c.Invitations, c.OrgMembers,
+		c.OrgRoles, c.Organizations, c.PasswordHistories, c.PasswordResets,
+		c.Permissions, c.RelationTuples, c.RoleApprovers, c.RoleParents,
+		c.RolePermissions, c.RoleRequests, c.Roles, c.SodConstraintRoles,
+		c.SodConstraints, c.UserRoles, c.Users,
 	} {
 		n.Use(hooks...)
 	}
@@ -239,8 +358,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AuditLogs, c.EmailLogs, c.EmailVerifications, c.PasswordResets, c.Permissions,
-		c.RolePermissions, c.Roles, c.UserRoles, c.Users,
+		c.APIKeys, c.AuditLogs, c.ConsentAcceptances, c.ConsentDocuments,
+		c.EmailChanges, c.EmailLogs, c.EmailVerifications, c.GroupMembers,
+		c.GroupParents, c.GroupRoles, c.Groups, c.Invitations, c.OrgMembers,
+		c.OrgRoles, c.Organizations, c.PasswordHistories, c.PasswordResets,
+		c.Permissions, c.RelationTuples, c.RoleApprovers, c.RoleParents,
+		c.RolePermissions, c.RoleRequests, c.Roles, c.SodConstraintRoles,
+		c.SodConstraints, c.UserRoles, c.Users,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -249,20 +373,58 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeysMutation:
+		return c.APIKeys.mutate(ctx, m)
 	case *AuditLogsMutation:
 		return c.AuditLogs.mutate(ctx, m)
+	case *ConsentAcceptancesMutation:
+		return c.ConsentAcceptances.mutate(ctx, m)
+	case *ConsentDocumentsMutation:
+		return c.ConsentDocuments.mutate(ctx, m)
+	case *EmailChangesMutation:
+		return c.EmailChanges.mutate(ctx, m)
 	case *EmailLogsMutation:
 		return c.EmailLogs.mutate(ctx, m)
 	case *EmailVerificationsMutation:
 		return c.EmailVerifications.mutate(ctx, m)
+	case *GroupMembersMutation:
+		return c.GroupMembers.mutate(ctx, m)
+	case *GroupParentsMutation:
+		return c.GroupParents.mutate(ctx, m)
+	case *GroupRolesMutation:
+		return c.GroupRoles.mutate(ctx, m)
+	case *GroupsMutation:
+		return c.Groups.mutate(ctx, m)
+	case *InvitationsMutation:
+		return c.Invitations.mutate(ctx, m)
+	case *OrgMembersMutation:
+		return c.OrgMembers.mutate(ctx, m)
+	case *OrgRolesMutation:
+		return c.OrgRoles.mutate(ctx, m)
+	case *OrganizationsMutation:
+		return c.Organizations.mutate(ctx, m)
+	case *PasswordHistoriesMutation:
+		return c.PasswordHistories.mutate(ctx, m)
 	case *PasswordResetsMutation:
 		return c.PasswordResets.mutate(ctx, m)
 	case *PermissionsMutation:
 		return c.Permissions.mutate(ctx, m)
+	case *RelationTuplesMutation:
+		return c.RelationTuples.mutate(ctx, m)
+	case *RoleApproversMutation:
+		return c.RoleApprovers.mutate(ctx, m)
+	case *RoleParentsMutation:
+		return c.RoleParents.mutate(ctx, m)
 	case *RolePermissionsMutation:
 		return c.RolePermissions.mutate(ctx, m)
+	case *RoleRequestsMutation:
+		return c.RoleRequests.mutate(ctx, m)
 	case *RolesMutation:
 		return c.Roles.mutate(ctx, m)
+	case *SodConstraintRolesMutation:
+		return c.SodConstraintRoles.mutate(ctx, m)
+	case *SodConstraintsMutation:
+		return c.SodConstraints.mutate(ctx, m)
 	case *UserRolesMutation:
 		return c.UserRoles.mutate(ctx, m)
 	case *UsersMutation:
@@ -272,6 +434,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// APIKeysClient is a client for the APIKeys schema.
+type APIKeysClient struct {
+	config
+}
+
+// NewAPIKeysClient returns a client for the APIKeys from the given config.
+func NewAPIKeysClient(c config) *APIKeysClient {
+	return &APIKeysClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikeys.Hooks(f(g(h())))`.
+func (c *APIKeysClient) Use(hooks ...Hook) {
+	c.hooks.APIKeys = append(c.hooks.APIKeys, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikeys.Intercept(f(g(h())))`.
+func (c *APIKeysClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKeys = append(c.inters.APIKeys, interceptors...)
+}
+
+// Create returns a builder for creating a APIKeys entity.
+func (c *APIKeysClient) Create() *APIKeysCreate {
+	mutation := newAPIKeysMutation(c.config, OpCreate)
+	return &APIKeysCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIKeys entities.
+func (c *APIKeysClient) CreateBulk(builders ...*APIKeysCreate) *APIKeysCreateBulk {
+	return &APIKeysCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIKeysClient) MapCreateBulk(slice any, setFunc func(*APIKeysCreate, int)) *APIKeysCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIKeysCreateBulk{err: fmt.Errorf("calling to APIKeysClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIKeysCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIKeysCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIKeys.
+func (c *APIKeysClient) Update() *APIKeysUpdate {
+	mutation := newAPIKeysMutation(c.config, OpUpdate)
+	return &APIKeysUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIKeysClient) UpdateOne(ak *APIKeys) *APIKeysUpdateOne {
+	mutation := newAPIKeysMutation(c.config, OpUpdateOne, withAPIKeys(ak))
+	return &APIKeysUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIKeysClient) UpdateOneID(id uuid.UUID) *APIKeysUpdateOne {
+	mutation := newAPIKeysMutation(c.config, OpUpdateOne, withAPIKeysID(id))
+	return &APIKeysUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIKeys.
+func (c *APIKeysClient) Delete() *APIKeysDelete {
+	mutation := newAPIKeysMutation(c.config, OpDelete)
+	return &APIKeysDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIKeysClient) DeleteOne(ak *APIKeys) *APIKeysDeleteOne {
+	return c.DeleteOneID(ak.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIKeysClient) DeleteOneID(id uuid.UUID) *APIKeysDeleteOne {
+	builder := c.Delete().Where(apikeys.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIKeysDeleteOne{builder}
+}
+
+// Query returns a query builder for APIKeys.
+func (c *APIKeysClient) Query() *APIKeysQuery {
+	return &APIKeysQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIKeys},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIKeys entity by its id.
+func (c *APIKeysClient) Get(ctx context.Context, id uuid.UUID) (*APIKeys, error) {
+	return c.Query().Where(apikeys.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIKeysClient) GetX(ctx context.Context, id uuid.UUID) *APIKeys {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *APIKeysClient) Hooks() []Hook {
+	return c.hooks.APIKeys
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIKeysClient) Interceptors() []Interceptor {
+	return c.inters.APIKeys
+}
+
+func (c *APIKeysClient) mutate(ctx context.Context, m *APIKeysMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIKeysCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIKeysUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIKeysUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIKeysDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown APIKeys mutation op: %q", m.Op())
+	}
+}
+
 // AuditLogsClient is a client for the AuditLogs schema.
 type AuditLogsClient struct {
 	config
@@ -405,107 +700,107 @@ func (c *AuditLogsClient) mutate(ctx context.Context, m *AuditLogsMutation) (Val
 	}
 }
 
-// EmailLogsClient is a client for the EmailLogs schema.
-type EmailLogsClient struct {
+// ConsentAcceptancesClient is a client for the ConsentAcceptances schema.
+type ConsentAcceptancesClient struct {
 	config
 }
 
-// NewEmailLogsClient returns a client for the EmailLogs from the given config.
-func NewEmailLogsClient(c config) *EmailLogsClient {
-	return &EmailLogsClient{config: c}
+// NewConsentAcceptancesClient returns a client for the ConsentAcceptances from the given config.
+func NewConsentAcceptancesClient(c config) *ConsentAcceptancesClient {
+	return &ConsentAcceptancesClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emaillogs.Hooks(f(g(h())))`.
-func (c *EmailLogsClient) Use(hooks ...Hook) {
-	c.hooks.EmailLogs = append(c.hooks.EmailLogs, hooks...)
+// A call to `Use(f, g, h)` equals to `consentacceptances.Hooks(f(g(h())))`.
+func (c *ConsentAcceptancesClient) Use(hooks ...Hook) {
+	c.hooks.ConsentAcceptances = append(c.hooks.ConsentAcceptances, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emaillogs.Intercept(f(g(h())))`.
-func (c *EmailLogsClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EmailLogs = append(c.inters.EmailLogs, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `consentacceptances.Intercept(f(g(h())))`.
+func (c *ConsentAcceptancesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConsentAcceptances = append(c.inters.ConsentAcceptances, interceptors...)
 }
 
-// Create returns a builder for creating a EmailLogs entity.
-func (c *EmailLogsClient) Create() *EmailLogsCreate {
-	mutation := newEmailLogsMutation(c.config, OpCreate)
-	return &EmailLogsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ConsentAcceptances entity.
+func (c *ConsentAcceptancesClient) Create() *ConsentAcceptancesCreate {
+	mutation := newConsentAcceptancesMutation(c.config, OpCreate)
+	return &ConsentAcceptancesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EmailLogs entities.
-func (c *EmailLogsClient) CreateBulk(builders ...*EmailLogsCreate) *EmailLogsCreateBulk {
-	return &EmailLogsCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ConsentAcceptances entities.
+func (c *ConsentAcceptancesClient) CreateBulk(builders ...*ConsentAcceptancesCreate) *ConsentAcceptancesCreateBulk {
+	return &ConsentAcceptancesCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailLogsClient) MapCreateBulk(slice any, setFunc func(*EmailLogsCreate, int)) *EmailLogsCreateBulk {
+func (c *ConsentAcceptancesClient) MapCreateBulk(slice any, setFunc func(*ConsentAcceptancesCreate, int)) *ConsentAcceptancesCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailLogsCreateBulk{err: fmt.Errorf("calling to EmailLogsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ConsentAcceptancesCreateBulk{err: fmt.Errorf("calling to ConsentAcceptancesClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailLogsCreate, rv.Len())
+	builders := make([]*ConsentAcceptancesCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailLogsCreateBulk{config: c.config, builders: builders}
+	return &ConsentAcceptancesCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EmailLogs.
-func (c *EmailLogsClient) Update() *EmailLogsUpdate {
-	mutation := newEmailLogsMutation(c.config, OpUpdate)
-	return &EmailLogsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ConsentAcceptances.
+func (c *ConsentAcceptancesClient) Update() *ConsentAcceptancesUpdate {
+	mutation := newConsentAcceptancesMutation(c.config, OpUpdate)
+	return &ConsentAcceptancesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailLogsClient) UpdateOne(el *EmailLogs) *EmailLogsUpdateOne {
-	mutation := newEmailLogsMutation(c.config, OpUpdateOne, withEmailLogs(el))
-	return &EmailLogsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConsentAcceptancesClient) UpdateOne(ca *ConsentAcceptances) *ConsentAcceptancesUpdateOne {
+	mutation := newConsentAcceptancesMutation(c.config, OpUpdateOne, withConsentAcceptances(ca))
+	return &ConsentAcceptancesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailLogsClient) UpdateOneID(id uuid.UUID) *EmailLogsUpdateOne {
-	mutation := newEmailLogsMutation(c.config, OpUpdateOne, withEmailLogsID(id))
-	return &EmailLogsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConsentAcceptancesClient) UpdateOneID(id uuid.UUID) *ConsentAcceptancesUpdateOne {
+	mutation := newConsentAcceptancesMutation(c.config, OpUpdateOne, withConsentAcceptancesID(id))
+	return &ConsentAcceptancesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailLogs.
-func (c *EmailLogsClient) Delete() *EmailLogsDelete {
-	mutation := newEmailLogsMutation(c.config, OpDelete)
-	return &EmailLogsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ConsentAcceptances.
+func (c *ConsentAcceptancesClient) Delete() *ConsentAcceptancesDelete {
+	mutation := newConsentAcceptancesMutation(c.config, OpDelete)
+	return &ConsentAcceptancesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailLogsClient) DeleteOne(el *EmailLogs) *EmailLogsDeleteOne {
-	return c.DeleteOneID(el.ID)
+func (c *ConsentAcceptancesClient) DeleteOne(ca *ConsentAcceptances) *ConsentAcceptancesDeleteOne {
+	return c.DeleteOneID(ca.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailLogsClient) DeleteOneID(id uuid.UUID) *EmailLogsDeleteOne {
-	builder := c.Delete().Where(emaillogs.ID(id))
+func (c *ConsentAcceptancesClient) DeleteOneID(id uuid.UUID) *ConsentAcceptancesDeleteOne {
+	builder := c.Delete().Where(consentacceptances.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailLogsDeleteOne{builder}
+	return &ConsentAcceptancesDeleteOne{builder}
 }
 
-// Query returns a query builder for EmailLogs.
-func (c *EmailLogsClient) Query() *EmailLogsQuery {
-	return &EmailLogsQuery{
+// Query returns a query builder for ConsentAcceptances.
+func (c *ConsentAcceptancesClient) Query() *ConsentAcceptancesQuery {
+	return &ConsentAcceptancesQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmailLogs},
+		ctx:    &QueryContext{Type: TypeConsentAcceptances},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EmailLogs entity by its id.
-func (c *EmailLogsClient) Get(ctx context.Context, id uuid.UUID) (*EmailLogs, error) {
-	return c.Query().Where(emaillogs.ID(id)).Only(ctx)
+// Get returns a ConsentAcceptances entity by its id.
+func (c *ConsentAcceptancesClient) Get(ctx context.Context, id uuid.UUID) (*ConsentAcceptances, error) {
+	return c.Query().Where(consentacceptances.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailLogsClient) GetX(ctx context.Context, id uuid.UUID) *EmailLogs {
+func (c *ConsentAcceptancesClient) GetX(ctx context.Context, id uuid.UUID) *ConsentAcceptances {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -514,131 +809,131 @@ func (c *EmailLogsClient) GetX(ctx context.Context, id uuid.UUID) *EmailLogs {
 }
 
 // Hooks returns the client hooks.
-func (c *EmailLogsClient) Hooks() []Hook {
-	return c.hooks.EmailLogs
+func (c *ConsentAcceptancesClient) Hooks() []Hook {
+	return c.hooks.ConsentAcceptances
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailLogsClient) Interceptors() []Interceptor {
-	return c.inters.EmailLogs
+func (c *ConsentAcceptancesClient) Interceptors() []Interceptor {
+	return c.inters.ConsentAcceptances
 }
 
-func (c *EmailLogsClient) mutate(ctx context.Context, m *EmailLogsMutation) (Value, error) {
+func (c *ConsentAcceptancesClient) mutate(ctx context.Context, m *ConsentAcceptancesMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailLogsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConsentAcceptancesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailLogsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConsentAcceptancesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailLogsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConsentAcceptancesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailLogsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ConsentAcceptancesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EmailLogs mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ConsentAcceptances mutation op: %q", m.Op())
 	}
 }
 
-// EmailVerificationsClient is a client for the EmailVerifications schema.
-type EmailVerificationsClient struct {
+// ConsentDocumentsClient is a client for the ConsentDocuments schema.
+type ConsentDocumentsClient struct {
 	config
 }
 
-// NewEmailVerificationsClient returns a client for the EmailVerifications from the given config.
-func NewEmailVerificationsClient(c config) *EmailVerificationsClient {
-	return &EmailVerificationsClient{config: c}
+// NewConsentDocumentsClient returns a client for the ConsentDocuments from the given config.
+func NewConsentDocumentsClient(c config) *ConsentDocumentsClient {
+	return &ConsentDocumentsClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emailverifications.Hooks(f(g(h())))`.
-func (c *EmailVerificationsClient) Use(hooks ...Hook) {
-	c.hooks.EmailVerifications = append(c.hooks.EmailVerifications, hooks...)
+// A call to `Use(f, g, h)` equals to `consentdocuments.Hooks(f(g(h())))`.
+func (c *ConsentDocumentsClient) Use(hooks ...Hook) {
+	c.hooks.ConsentDocuments = append(c.hooks.ConsentDocuments, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emailverifications.Intercept(f(g(h())))`.
-func (c *EmailVerificationsClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EmailVerifications = append(c.inters.EmailVerifications, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `consentdocuments.Intercept(f(g(h())))`.
+func (c *ConsentDocumentsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConsentDocuments = append(c.inters.ConsentDocuments, interceptors...)
 }
 
-// Create returns a builder for creating a EmailVerifications entity.
-func (c *EmailVerificationsClient) Create() *EmailVerificationsCreate {
-	mutation := newEmailVerificationsMutation(c.config, OpCreate)
-	return &EmailVerificationsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ConsentDocuments entity.
+func (c *ConsentDocumentsClient) Create() *ConsentDocumentsCreate {
+	mutation := newConsentDocumentsMutation(c.config, OpCreate)
+	return &ConsentDocumentsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EmailVerifications entities.
-func (c *EmailVerificationsClient) CreateBulk(builders ...*EmailVerificationsCreate) *EmailVerificationsCreateBulk {
-	return &EmailVerificationsCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ConsentDocuments entities.
+func (c *ConsentDocumentsClient) CreateBulk(builders ...*ConsentDocumentsCreate) *ConsentDocumentsCreateBulk {
+	return &ConsentDocumentsCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailVerificationsClient) MapCreateBulk(slice any, setFunc func(*EmailVerificationsCreate, int)) *EmailVerificationsCreateBulk {
+func (c *ConsentDocumentsClient) MapCreateBulk(slice any, setFunc func(*ConsentDocumentsCreate, int)) *ConsentDocumentsCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailVerificationsCreateBulk{err: fmt.Errorf("calling to EmailVerificationsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ConsentDocumentsCreateBulk{err: fmt.Errorf("calling to ConsentDocumentsClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailVerificationsCreate, rv.Len())
+	builders := make([]*ConsentDocumentsCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailVerificationsCreateBulk{config: c.config, builders: builders}
+	return &ConsentDocumentsCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EmailVerifications.
-func (c *EmailVerificationsClient) Update() *EmailVerificationsUpdate {
-	mutation := newEmailVerificationsMutation(c.config, OpUpdate)
-	return &EmailVerificationsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ConsentDocuments.
+func (c *ConsentDocumentsClient) Update() *ConsentDocumentsUpdate {
+	mutation := newConsentDocumentsMutation(c.config, OpUpdate)
+	return &ConsentDocumentsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailVerificationsClient) UpdateOne(ev *EmailVerifications) *EmailVerificationsUpdateOne {
-	mutation := newEmailVerificationsMutation(c.config, OpUpdateOne, withEmailVerifications(ev))
-	return &EmailVerificationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConsentDocumentsClient) UpdateOne(cd *ConsentDocuments) *ConsentDocumentsUpdateOne {
+	mutation := newConsentDocumentsMutation(c.config, OpUpdateOne, withConsentDocuments(cd))
+	return &ConsentDocumentsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailVerificationsClient) UpdateOneID(id uuid.UUID) *EmailVerificationsUpdateOne {
-	mutation := newEmailVerificationsMutation(c.config, OpUpdateOne, withEmailVerificationsID(id))
-	return &EmailVerificationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConsentDocumentsClient) UpdateOneID(id uuid.UUID) *ConsentDocumentsUpdateOne {
+	mutation := newConsentDocumentsMutation(c.config, OpUpdateOne, withConsentDocumentsID(id))
+	return &ConsentDocumentsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailVerifications.
-func (c *EmailVerificationsClient) Delete() *EmailVerificationsDelete {
-	mutation := newEmailVerificationsMutation(c.config, OpDelete)
-	return &EmailVerificationsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ConsentDocuments.
+func (c *ConsentDocumentsClient) Delete() *ConsentDocumentsDelete {
+	mutation := newConsentDocumentsMutation(c.config, OpDelete)
+	return &ConsentDocumentsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailVerificationsClient) DeleteOne(ev *EmailVerifications) *EmailVerificationsDeleteOne {
-	return c.DeleteOneID(ev.ID)
+func (c *ConsentDocumentsClient) DeleteOne(cd *ConsentDocuments) *ConsentDocumentsDeleteOne {
+	return c.DeleteOneID(cd.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailVerificationsClient) DeleteOneID(id uuid.UUID) *EmailVerificationsDeleteOne {
-	builder := c.Delete().Where(emailverifications.ID(id))
+func (c *ConsentDocumentsClient) DeleteOneID(id uuid.UUID) *ConsentDocumentsDeleteOne {
+	builder := c.Delete().Where(consentdocuments.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailVerificationsDeleteOne{builder}
+	return &ConsentDocumentsDeleteOne{builder}
 }
 
-// Query returns a query builder for EmailVerifications.
-func (c *EmailVerificationsClient) Query() *EmailVerificationsQuery {
-	return &EmailVerificationsQuery{
+// Query returns a query builder for ConsentDocuments.
+func (c *ConsentDocumentsClient) Query() *ConsentDocumentsQuery {
+	return &ConsentDocumentsQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmailVerifications},
+		ctx:    &QueryContext{Type: TypeConsentDocuments},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EmailVerifications entity by its id.
-func (c *EmailVerificationsClient) Get(ctx context.Context, id uuid.UUID) (*EmailVerifications, error) {
-	return c.Query().Where(emailverifications.ID(id)).Only(ctx)
+// Get returns a ConsentDocuments entity by its id.
+func (c *ConsentDocumentsClient) Get(ctx context.Context, id uuid.UUID) (*ConsentDocuments, error) {
+	return c.Query().Where(consentdocuments.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailVerificationsClient) GetX(ctx context.Context, id uuid.UUID) *EmailVerifications {
+func (c *ConsentDocumentsClient) GetX(ctx context.Context, id uuid.UUID) *ConsentDocuments {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -647,131 +942,131 @@ func (c *EmailVerificationsClient) GetX(ctx context.Context, id uuid.UUID) *Emai
 }
 
 // Hooks returns the client hooks.
-func (c *EmailVerificationsClient) Hooks() []Hook {
-	return c.hooks.EmailVerifications
+func (c *ConsentDocumentsClient) Hooks() []Hook {
+	return c.hooks.ConsentDocuments
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailVerificationsClient) Interceptors() []Interceptor {
-	return c.inters.EmailVerifications
+func (c *ConsentDocumentsClient) Interceptors() []Interceptor {
+	return c.inters.ConsentDocuments
 }
 
-func (c *EmailVerificationsClient) mutate(ctx context.Context, m *EmailVerificationsMutation) (Value, error) {
+func (c *ConsentDocumentsClient) mutate(ctx context.Context, m *ConsentDocumentsMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailVerificationsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConsentDocumentsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailVerificationsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConsentDocumentsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailVerificationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConsentDocumentsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailVerificationsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ConsentDocumentsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EmailVerifications mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ConsentDocuments mutation op: %q", m.Op())
 	}
 }
 
-// PasswordResetsClient is a client for the PasswordResets schema.
-type PasswordResetsClient struct {
+// EmailChangesClient is a client for the EmailChanges schema.
+type EmailChangesClient struct {
 	config
 }
 
-// NewPasswordResetsClient returns a client for the PasswordResets from the given config.
-func NewPasswordResetsClient(c config) *PasswordResetsClient {
-	return &PasswordResetsClient{config: c}
+// NewEmailChangesClient returns a client for the EmailChanges from the given config.
+func NewEmailChangesClient(c config) *EmailChangesClient {
+	return &EmailChangesClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `passwordresets.Hooks(f(g(h())))`.
-func (c *PasswordResetsClient) Use(hooks ...Hook) {
-	c.hooks.PasswordResets = append(c.hooks.PasswordResets, hooks...)
+// A call to `Use(f, g, h)` equals to `emailchanges.Hooks(f(g(h())))`.
+func (c *EmailChangesClient) Use(hooks ...Hook) {
+	c.hooks.EmailChanges = append(c.hooks.EmailChanges, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `passwordresets.Intercept(f(g(h())))`.
-func (c *PasswordResetsClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PasswordResets = append(c.inters.PasswordResets, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `emailchanges.Intercept(f(g(h())))`.
+func (c *EmailChangesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailChanges = append(c.inters.EmailChanges, interceptors...)
 }
 
-// Create returns a builder for creating a PasswordResets entity.
-func (c *PasswordResetsClient) Create() *PasswordResetsCreate {
-	mutation := newPasswordResetsMutation(c.config, OpCreate)
-	return &PasswordResetsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EmailChanges entity.
+func (c *EmailChangesClient) Create() *EmailChangesCreate {
+	mutation := newEmailChangesMutation(c.config, OpCreate)
+	return &EmailChangesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PasswordResets entities.
-func (c *PasswordResetsClient) CreateBulk(builders ...*PasswordResetsCreate) *PasswordResetsCreateBulk {
-	return &PasswordResetsCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EmailChanges entities.
+func (c *EmailChangesClient) CreateBulk(builders ...*EmailChangesCreate) *EmailChangesCreateBulk {
+	return &EmailChangesCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PasswordResetsClient) MapCreateBulk(slice any, setFunc func(*PasswordResetsCreate, int)) *PasswordResetsCreateBulk {
+func (c *EmailChangesClient) MapCreateBulk(slice any, setFunc func(*EmailChangesCreate, int)) *EmailChangesCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PasswordResetsCreateBulk{err: fmt.Errorf("calling to PasswordResetsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmailChangesCreateBulk{err: fmt.Errorf("calling to EmailChangesClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PasswordResetsCreate, rv.Len())
+	builders := make([]*EmailChangesCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PasswordResetsCreateBulk{config: c.config, builders: builders}
+	return &EmailChangesCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PasswordResets.
-func (c *PasswordResetsClient) Update() *PasswordResetsUpdate {
-	mutation := newPasswordResetsMutation(c.config, OpUpdate)
-	return &PasswordResetsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EmailChanges.
+func (c *EmailChangesClient) Update() *EmailChangesUpdate {
+	mutation := newEmailChangesMutation(c.config, OpUpdate)
+	return &EmailChangesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PasswordResetsClient) UpdateOne(pr *PasswordResets) *PasswordResetsUpdateOne {
-	mutation := newPasswordResetsMutation(c.config, OpUpdateOne, withPasswordResets(pr))
-	return &PasswordResetsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailChangesClient) UpdateOne(ec *EmailChanges) *EmailChangesUpdateOne {
+	mutation := newEmailChangesMutation(c.config, OpUpdateOne, withEmailChanges(ec))
+	return &EmailChangesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PasswordResetsClient) UpdateOneID(id uuid.UUID) *PasswordResetsUpdateOne {
-	mutation := newPasswordResetsMutation(c.config, OpUpdateOne, withPasswordResetsID(id))
-	return &PasswordResetsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailChangesClient) UpdateOneID(id uuid.UUID) *EmailChangesUpdateOne {
+	mutation := newEmailChangesMutation(c.config, OpUpdateOne, withEmailChangesID(id))
+	return &EmailChangesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PasswordResets.
-func (c *PasswordResetsClient) Delete() *PasswordResetsDelete {
-	mutation := newPasswordResetsMutation(c.config, OpDelete)
-	return &PasswordResetsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EmailChanges.
+func (c *EmailChangesClient) Delete() *EmailChangesDelete {
+	mutation := newEmailChangesMutation(c.config, OpDelete)
+	return &EmailChangesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PasswordResetsClient) DeleteOne(pr *PasswordResets) *PasswordResetsDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *EmailChangesClient) DeleteOne(ec *EmailChanges) *EmailChangesDeleteOne {
+	return c.DeleteOneID(ec.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PasswordResetsClient) DeleteOneID(id uuid.UUID) *PasswordResetsDeleteOne {
-	builder := c.Delete().Where(passwordresets.ID(id))
+func (c *EmailChangesClient) DeleteOneID(id uuid.UUID) *EmailChangesDeleteOne {
+	builder := c.Delete().Where(emailchanges.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PasswordResetsDeleteOne{builder}
+	return &EmailChangesDeleteOne{builder}
 }
 
-// Query returns a query builder for PasswordResets.
-func (c *PasswordResetsClient) Query() *PasswordResetsQuery {
-	return &PasswordResetsQuery{
+// Query returns a query builder for EmailChanges.
+func (c *EmailChangesClient) Query() *EmailChangesQuery {
+	return &EmailChangesQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePasswordResets},
+		ctx:    &QueryContext{Type: TypeEmailChanges},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PasswordResets entity by its id.
-func (c *PasswordResetsClient) Get(ctx context.Context, id uuid.UUID) (*PasswordResets, error) {
-	return c.Query().Where(passwordresets.ID(id)).Only(ctx)
+// Get returns a EmailChanges entity by its id.
+func (c *EmailChangesClient) Get(ctx context.Context, id uuid.UUID) (*EmailChanges, error) {
+	return c.Query().Where(emailchanges.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PasswordResetsClient) GetX(ctx context.Context, id uuid.UUID) *PasswordResets {
+func (c *EmailChangesClient) GetX(ctx context.Context, id uuid.UUID) *EmailChanges {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -780,280 +1075,3186 @@ func (c *PasswordResetsClient) GetX(ctx context.Context, id uuid.UUID) *Password
 }
 
 // Hooks returns the client hooks.
-func (c *PasswordResetsClient) Hooks() []Hook {
-	return c.hooks.PasswordResets
+func (c *EmailChangesClient) Hooks() []Hook {
+	return c.hooks.EmailChanges
 }
 
 // Interceptors returns the client interceptors.
-func (c *PasswordResetsClient) Interceptors() []Interceptor {
-	return c.inters.PasswordResets
+func (c *EmailChangesClient) Interceptors() []Interceptor {
+	return c.inters.EmailChanges
 }
 
-func (c *PasswordResetsClient) mutate(ctx context.Context, m *PasswordResetsMutation) (Value, error) {
+func (c *EmailChangesClient) mutate(ctx context.Context, m *EmailChangesMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PasswordResetsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailChangesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PasswordResetsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailChangesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PasswordResetsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailChangesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PasswordResetsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EmailChangesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PasswordResets mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown EmailChanges mutation op: %q", m.Op())
 	}
 }
 
-// PermissionsClient is a client for the Permissions schema.
-type PermissionsClient struct {
+// EmailLogsClient is a client for the EmailLogs schema.
+type EmailLogsClient struct {
 	config
 }
 
-// NewPermissionsClient returns a client for the Permissions from the given config.
+// NewEmailLogsClient returns a client for the EmailLogs from the given config.
+func NewEmailLogsClient(c config) *EmailLogsClient {
+	return &EmailLogsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emaillogs.Hooks(f(g(h())))`.
+func (c *EmailLogsClient) Use(hooks ...Hook) {
+	c.hooks.EmailLogs = append(c.hooks.EmailLogs, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emaillogs.Intercept(f(g(h())))`.
+func (c *EmailLogsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailLogs = append(c.inters.EmailLogs, interceptors...)
+}
+
+// Create returns a builder for creating a EmailLogs entity.
+func (c *EmailLogsClient) Create() *EmailLogsCreate {
+	mutation := newEmailLogsMutation(c.config, OpCreate)
+	return &EmailLogsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailLogs entities.
+func (c *EmailLogsClient) CreateBulk(builders ...*EmailLogsCreate) *EmailLogsCreateBulk {
+	return &EmailLogsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailLogsClient) MapCreateBulk(slice any, setFunc func(*EmailLogsCreate, int)) *EmailLogsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailLogsCreateBulk{err: fmt.Errorf("calling to EmailLogsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailLogsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailLogsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailLogs.
+func (c *EmailLogsClient) Update() *EmailLogsUpdate {
+	mutation := newEmailLogsMutation(c.config, OpUpdate)
+	return &EmailLogsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailLogsClient) UpdateOne(el *EmailLogs) *EmailLogsUpdateOne {
+	mutation := newEmailLogsMutation(c.config, OpUpdateOne, withEmailLogs(el))
+	return &EmailLogsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailLogsClient) UpdateOneID(id uuid.UUID) *EmailLogsUpdateOne {
+	mutation := newEmailLogsMutation(c.config, OpUpdateOne, withEmailLogsID(id))
+	return &EmailLogsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailLogs.
+func (c *EmailLogsClient) Delete() *EmailLogsDelete {
+	mutation := newEmailLogsMutation(c.config, OpDelete)
+	return &EmailLogsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailLogsClient) DeleteOne(el *EmailLogs) *EmailLogsDeleteOne {
+	return c.DeleteOneID(el.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailLogsClient) DeleteOneID(id uuid.UUID) *EmailLogsDeleteOne {
+	builder := c.Delete().Where(emaillogs.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailLogsDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailLogs.
+func (c *EmailLogsClient) Query() *EmailLogsQuery {
+	return &EmailLogsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailLogs},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailLogs entity by its id.
+func (c *EmailLogsClient) Get(ctx context.Context, id uuid.UUID) (*EmailLogs, error) {
+	return c.Query().Where(emaillogs.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailLogsClient) GetX(ctx context.Context, id uuid.UUID) *EmailLogs {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmailLogsClient) Hooks() []Hook {
+	return c.hooks.EmailLogs
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailLogsClient) Interceptors() []Interceptor {
+	return c.inters.EmailLogs
+}
+
+func (c *EmailLogsClient) mutate(ctx context.Context, m *EmailLogsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailLogsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailLogsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailLogsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailLogsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailLogs mutation op: %q", m.Op())
+	}
+}
+
+// EmailVerificationsClient is a client for the EmailVerifications schema.
+type EmailVerificationsClient struct {
+	config
+}
+
+// NewEmailVerificationsClient returns a client for the EmailVerifications from the given config.
+func NewEmailVerificationsClient(c config) *EmailVerificationsClient {
+	return &EmailVerificationsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailverifications.Hooks(f(g(h())))`.
+func (c *EmailVerificationsClient) Use(hooks ...Hook) {
+	c.hooks.EmailVerifications = append(c.hooks.EmailVerifications, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailverifications.Intercept(f(g(h())))`.
+func (c *EmailVerificationsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailVerifications = append(c.inters.EmailVerifications, interceptors...)
+}
+
+// Create returns a builder for creating a EmailVerifications entity.
+func (c *EmailVerificationsClient) Create() *EmailVerificationsCreate {
+	mutation := newEmailVerificationsMutation(c.config, OpCreate)
+	return &EmailVerificationsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailVerifications entities.
+func (c *EmailVerificationsClient) CreateBulk(builders ...*EmailVerificationsCreate) *EmailVerificationsCreateBulk {
+	return &EmailVerificationsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailVerificationsClient) MapCreateBulk(slice any, setFunc func(*EmailVerificationsCreate, int)) *EmailVerificationsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailVerificationsCreateBulk{err: fmt.Errorf("calling to EmailVerificationsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailVerificationsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailVerificationsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailVerifications.
+func (c *EmailVerificationsClient) Update() *EmailVerificationsUpdate {
+	mutation := newEmailVerificationsMutation(c.config, OpUpdate)
+	return &EmailVerificationsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailVerificationsClient) UpdateOne(ev *EmailVerifications) *EmailVerificationsUpdateOne {
+	mutation := newEmailVerificationsMutation(c.config, OpUpdateOne, withEmailVerifications(ev))
+	return &EmailVerificationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailVerificationsClient) UpdateOneID(id uuid.UUID) *EmailVerificationsUpdateOne {
+	mutation := newEmailVerificationsMutation(c.config, OpUpdateOne, withEmailVerificationsID(id))
+	return &EmailVerificationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailVerifications.
+func (c *EmailVerificationsClient) Delete() *EmailVerificationsDelete {
+	mutation := newEmailVerificationsMutation(c.config, OpDelete)
+	return &EmailVerificationsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailVerificationsClient) DeleteOne(ev *EmailVerifications) *EmailVerificationsDeleteOne {
+	return c.DeleteOneID(ev.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailVerificationsClient) DeleteOneID(id uuid.UUID) *EmailVerificationsDeleteOne {
+	builder := c.Delete().Where(emailverifications.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailVerificationsDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailVerifications.
+func (c *EmailVerificationsClient) Query() *EmailVerificationsQuery {
+	return &EmailVerificationsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailVerifications},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailVerifications entity by its id.
+func (c *EmailVerificationsClient) Get(ctx context.Context, id uuid.UUID) (*EmailVerifications, error) {
+	return c.Query().Where(emailverifications.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailVerificationsClient) GetX(ctx context.Context, id uuid.UUID) *EmailVerifications {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmailVerificationsClient) Hooks() []Hook {
+	return c.hooks.EmailVerifications
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailVerificationsClient) Interceptors() []Interceptor {
+	return c.inters.EmailVerifications
+}
+
+func (c *EmailVerificationsClient) mutate(ctx context.Context, m *EmailVerificationsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailVerificationsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailVerificationsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailVerificationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailVerificationsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailVerifications mutation op: %q", m.Op())
+	}
+}
+
+// GroupMembersClient is a client for the GroupMembers schema.
+type GroupMembersClient struct {
+	config
+}
+
+// NewGroupMembersClient returns a client for the GroupMembers from the given config.
+func NewGroupMembersClient(c config) *GroupMembersClient {
+	return &GroupMembersClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupmembers.Hooks(f(g(h())))`.
+func (c *GroupMembersClient) Use(hooks ...Hook) {
+	c.hooks.GroupMembers = append(c.hooks.GroupMembers, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupmembers.Intercept(f(g(h())))`.
+func (c *GroupMembersClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupMembers = append(c.inters.GroupMembers, interceptors...)
+}
+
+// Create returns a builder for creating a GroupMembers entity.
+func (c *GroupMembersClient) Create() *GroupMembersCreate {
+	mutation := newGroupMembersMutation(c.config, OpCreate)
+	return &GroupMembersCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupMembers entities.
+func (c *GroupMembersClient) CreateBulk(builders ...*GroupMembersCreate) *GroupMembersCreateBulk {
+	return &GroupMembersCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupMembersClient) MapCreateBulk(slice any, setFunc func(*GroupMembersCreate, int)) *GroupMembersCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupMembersCreateBulk{err: fmt.Errorf("calling to GroupMembersClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupMembersCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupMembersCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupMembers.
+func (c *GroupMembersClient) Update() *GroupMembersUpdate {
+	mutation := newGroupMembersMutation(c.config, OpUpdate)
+	return &GroupMembersUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupMembersClient) UpdateOne(gm *GroupMembers) *GroupMembersUpdateOne {
+	mutation := newGroupMembersMutation(c.config, OpUpdateOne, withGroupMembers(gm))
+	return &GroupMembersUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupMembersClient) UpdateOneID(id uuid.UUID) *GroupMembersUpdateOne {
+	mutation := newGroupMembersMutation(c.config, OpUpdateOne, withGroupMembersID(id))
+	return &GroupMembersUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupMembers.
+func (c *GroupMembersClient) Delete() *GroupMembersDelete {
+	mutation := newGroupMembersMutation(c.config, OpDelete)
+	return &GroupMembersDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupMembersClient) DeleteOne(gm *GroupMembers) *GroupMembersDeleteOne {
+	return c.DeleteOneID(gm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupMembersClient) DeleteOneID(id uuid.UUID) *GroupMembersDeleteOne {
+	builder := c.Delete().Where(groupmembers.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupMembersDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupMembers.
+func (c *GroupMembersClient) Query() *GroupMembersQuery {
+	return &GroupMembersQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupMembers},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupMembers entity by its id.
+func (c *GroupMembersClient) Get(ctx context.Context, id uuid.UUID) (*GroupMembers, error) {
+	return c.Query().Where(groupmembers.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupMembersClient) GetX(ctx context.Context, id uuid.UUID) *GroupMembers {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a GroupMembers.
+func (c *GroupMembersClient) QueryGroup(gm *GroupMembers) *GroupsQuery {
+	query := (&GroupsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmembers.Table, groupmembers.FieldID, id),
+			sqlgraph.To(groups.Table, groups.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupmembers.GroupTable, groupmembers.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(gm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a GroupMembers.
+func (c *GroupMembersClient) QueryUser(gm *GroupMembers) *UsersQuery {
+	query := (&UsersClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmembers.Table, groupmembers.FieldID, id),
+			sqlgraph.To(users.Table, users.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupmembers.UserTable, groupmembers.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(gm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupMembersClient) Hooks() []Hook {
+	return c.hooks.GroupMembers
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupMembersClient) Interceptors() []Interceptor {
+	return c.inters.GroupMembers
+}
+
+func (c *GroupMembersClient) mutate(ctx context.Context, m *GroupMembersMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupMembersCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupMembersUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupMembersUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupMembersDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupMembers mutation op: %q", m.Op())
+	}
+}
+
+// GroupParentsClient is a client for the GroupParents schema.
+type GroupParentsClient struct {
+	config
+}
+
+// NewGroupParentsClient returns a client for the GroupParents from the given config.
+func NewGroupParentsClient(c config) *GroupParentsClient {
+	return &GroupParentsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupparents.Hooks(f(g(h())))`.
+func (c *GroupParentsClient) Use(hooks ...Hook) {
+	c.hooks.GroupParents = append(c.hooks.GroupParents, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupparents.Intercept(f(g(h())))`.
+func (c *GroupParentsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupParents = append(c.inters.GroupParents, interceptors...)
+}
+
+// Create returns a builder for creating a GroupParents entity.
+func (c *GroupParentsClient) Create() *GroupParentsCreate {
+	mutation := newGroupParentsMutation(c.config, OpCreate)
+	return &GroupParentsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupParents entities.
+func (c *GroupParentsClient) CreateBulk(builders ...*GroupParentsCreate) *GroupParentsCreateBulk {
+	return &GroupParentsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupParentsClient) MapCreateBulk(slice any, setFunc func(*GroupParentsCreate, int)) *GroupParentsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupParentsCreateBulk{err: fmt.Errorf("calling to GroupParentsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupParentsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupParentsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupParents.
+func (c *GroupParentsClient) Update() *GroupParentsUpdate {
+	mutation := newGroupParentsMutation(c.config, OpUpdate)
+	return &GroupParentsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupParentsClient) UpdateOne(gp *GroupParents) *GroupParentsUpdateOne {
+	mutation := newGroupParentsMutation(c.config, OpUpdateOne, withGroupParents(gp))
+	return &GroupParentsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupParentsClient) UpdateOneID(id int) *GroupParentsUpdateOne {
+	mutation := newGroupParentsMutation(c.config, OpUpdateOne, withGroupParentsID(id))
+	return &GroupParentsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupParents.
+func (c *GroupParentsClient) Delete() *GroupParentsDelete {
+	mutation := newGroupParentsMutation(c.config, OpDelete)
+	return &GroupParentsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupParentsClient) DeleteOne(gp *GroupParents) *GroupParentsDeleteOne {
+	return c.DeleteOneID(gp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupParentsClient) DeleteOneID(id int) *GroupParentsDeleteOne {
+	builder := c.Delete().Where(groupparents.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupParentsDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupParents.
+func (c *GroupParentsClient) Query() *GroupParentsQuery {
+	return &GroupParentsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupParents},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupParents entity by its id.
+func (c *GroupParentsClient) Get(ctx context.Context, id int) (*GroupParents, error) {
+	return c.Query().Where(groupparents.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupParentsClient) GetX(ctx context.Context, id int) *GroupParents {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a GroupParents.
+func (c *GroupParentsClient) QueryGroup(gp *GroupParents) *GroupsQuery {
+	query := (&GroupsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupparents.Table, groupparents.FieldID, id),
+			sqlgraph.To(groups.Table, groups.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupparents.GroupTable, groupparents.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(gp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a GroupParents.
+func (c *GroupParentsClient) QueryParent(gp *GroupParents) *GroupsQuery {
+	query := (&GroupsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupparents.Table, groupparents.FieldID, id),
+			sqlgraph.To(groups.Table, groups.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupparents.ParentTable, groupparents.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(gp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupParentsClient) Hooks() []Hook {
+	return c.hooks.GroupParents
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupParentsClient) Interceptors() []Interceptor {
+	return c.inters.GroupParents
+}
+
+func (c *GroupParentsClient) mutate(ctx context.Context, m *GroupParentsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupParentsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupParentsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupParentsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupParentsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupParents mutation op: %q", m.Op())
+	}
+}
+
+// GroupRolesClient is a client for the GroupRoles schema.
+type GroupRolesClient struct {
+	config
+}
+
+// NewGroupRolesClient returns a client for the GroupRoles from the given config.
+func NewGroupRolesClient(c config) *GroupRolesClient {
+	return &GroupRolesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `grouproles.Hooks(f(g(h())))`.
+func (c *GroupRolesClient) Use(hooks ...Hook) {
+	c.hooks.GroupRoles = append(c.hooks.GroupRoles, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `grouproles.Intercept(f(g(h())))`.
+func (c *GroupRolesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupRoles = append(c.inters.GroupRoles, interceptors...)
+}
+
+// Create returns a builder for creating a GroupRoles entity.
+func (c *GroupRolesClient) Create() *GroupRolesCreate {
+	mutation := newGroupRolesMutation(c.config, OpCreate)
+	return &GroupRolesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupRoles entities.
+func (c *GroupRolesClient) CreateBulk(builders ...*GroupRolesCreate) *GroupRolesCreateBulk {
+	return &GroupRolesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupRolesClient) MapCreateBulk(slice any, setFunc func(*GroupRolesCreate, int)) *GroupRolesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupRolesCreateBulk{err: fmt.Errorf("calling to GroupRolesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupRolesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupRolesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupRoles.
+func (c *GroupRolesClient) Update() *GroupRolesUpdate {
+	mutation := newGroupRolesMutation(c.config, OpUpdate)
+	return &GroupRolesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupRolesClient) UpdateOne(gr *GroupRoles) *GroupRolesUpdateOne {
+	mutation := newGroupRolesMutation(c.config, OpUpdateOne, withGroupRoles(gr))
+	return &GroupRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupRolesClient) UpdateOneID(id int) *GroupRolesUpdateOne {
+	mutation := newGroupRolesMutation(c.config, OpUpdateOne, withGroupRolesID(id))
+	return &GroupRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupRoles.
+func (c *GroupRolesClient) Delete() *GroupRolesDelete {
+	mutation := newGroupRolesMutation(c.config, OpDelete)
+	return &GroupRolesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupRolesClient) DeleteOne(gr *GroupRoles) *GroupRolesDeleteOne {
+	return c.DeleteOneID(gr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupRolesClient) DeleteOneID(id int) *GroupRolesDeleteOne {
+	builder := c.Delete().Where(grouproles.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupRolesDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupRoles.
+func (c *GroupRolesClient) Query() *GroupRolesQuery {
+	return &GroupRolesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupRoles},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupRoles entity by its id.
+func (c *GroupRolesClient) Get(ctx context.Context, id int) (*GroupRoles, error) {
+	return c.Query().Where(grouproles.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupRolesClient) GetX(ctx context.Context, id int) *GroupRoles {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroup queries the group edge of a GroupRoles.
+func (c *GroupRolesClient) QueryGroup(gr *GroupRoles) *GroupsQuery {
+	query := (&GroupsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouproles.Table, grouproles.FieldID, id),
+			sqlgraph.To(groups.Table, groups.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, grouproles.GroupTable, grouproles.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRole queries the role edge of a GroupRoles.
+func (c *GroupRolesClient) QueryRole(gr *GroupRoles) *RolesQuery {
+	query := (&RolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouproles.Table, grouproles.FieldID, id),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, grouproles.RoleTable, grouproles.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupRolesClient) Hooks() []Hook {
+	return c.hooks.GroupRoles
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupRolesClient) Interceptors() []Interceptor {
+	return c.inters.GroupRoles
+}
+
+func (c *GroupRolesClient) mutate(ctx context.Context, m *GroupRolesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupRolesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupRolesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupRolesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupRoles mutation op: %q", m.Op())
+	}
+}
+
+// GroupsClient is a client for the Groups schema.
+type GroupsClient struct {
+	config
+}
+
+// NewGroupsClient returns a client for the Groups from the given config.
+func NewGroupsClient(c config) *GroupsClient {
+	return &GroupsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groups.Hooks(f(g(h())))`.
+func (c *GroupsClient) Use(hooks ...Hook) {
+	c.hooks.Groups = append(c.hooks.Groups, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groups.Intercept(f(g(h())))`.
+func (c *GroupsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Groups = append(c.inters.Groups, interceptors...)
+}
+
+// Create returns a builder for creating a Groups entity.
+func (c *GroupsClient) Create() *GroupsCreate {
+	mutation := newGroupsMutation(c.config, OpCreate)
+	return &GroupsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Groups entities.
+func (c *GroupsClient) CreateBulk(builders ...*GroupsCreate) *GroupsCreateBulk {
+	return &GroupsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupsClient) MapCreateBulk(slice any, setFunc func(*GroupsCreate, int)) *GroupsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupsCreateBulk{err: fmt.Errorf("calling to GroupsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Groups.
+func (c *GroupsClient) Update() *GroupsUpdate {
+	mutation := newGroupsMutation(c.config, OpUpdate)
+	return &GroupsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupsClient) UpdateOne(gr *Groups) *GroupsUpdateOne {
+	mutation := newGroupsMutation(c.config, OpUpdateOne, withGroups(gr))
+	return &GroupsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupsClient) UpdateOneID(id int) *GroupsUpdateOne {
+	mutation := newGroupsMutation(c.config, OpUpdateOne, withGroupsID(id))
+	return &GroupsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Groups.
+func (c *GroupsClient) Delete() *GroupsDelete {
+	mutation := newGroupsMutation(c.config, OpDelete)
+	return &GroupsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupsClient) DeleteOne(gr *Groups) *GroupsDeleteOne {
+	return c.DeleteOneID(gr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupsClient) DeleteOneID(id int) *GroupsDeleteOne {
+	builder := c.Delete().Where(groups.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupsDeleteOne{builder}
+}
+
+// Query returns a query builder for Groups.
+func (c *GroupsClient) Query() *GroupsQuery {
+	return &GroupsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroups},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Groups entity by its id.
+func (c *GroupsClient) Get(ctx context.Context, id int) (*Groups, error) {
+	return c.Query().Where(groups.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupsClient) GetX(ctx context.Context, id int) *Groups {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMembers queries the members edge of a Groups.
+func (c *GroupsClient) QueryMembers(gr *Groups) *GroupMembersQuery {
+	query := (&GroupMembersClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groups.Table, groups.FieldID, id),
+			sqlgraph.To(groupmembers.Table, groupmembers.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, groups.MembersTable, groups.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroupRoles queries the group_roles edge of a Groups.
+func (c *GroupsClient) QueryGroupRoles(gr *Groups) *GroupRolesQuery {
+	query := (&GroupRolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groups.Table, groups.FieldID, id),
+			sqlgraph.To(grouproles.Table, grouproles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, groups.GroupRolesTable, groups.GroupRolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParentLinks queries the parent_links edge of a Groups.
+func (c *GroupsClient) QueryParentLinks(gr *Groups) *GroupParentsQuery {
+	query := (&GroupParentsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groups.Table, groups.FieldID, id),
+			sqlgraph.To(groupparents.Table, groupparents.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, groups.ParentLinksTable, groups.ParentLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildLinks queries the child_links edge of a Groups.
+func (c *GroupsClient) QueryChildLinks(gr *Groups) *GroupParentsQuery {
+	query := (&GroupParentsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groups.Table, groups.FieldID, id),
+			sqlgraph.To(groupparents.Table, groupparents.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, groups.ChildLinksTable, groups.ChildLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupsClient) Hooks() []Hook {
+	return c.hooks.Groups
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupsClient) Interceptors() []Interceptor {
+	return c.inters.Groups
+}
+
+func (c *GroupsClient) mutate(ctx context.Context, m *GroupsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Groups mutation op: %q", m.Op())
+	}
+}
+
+// InvitationsClient is a client for the Invitations schema.
+type InvitationsClient struct {
+	config
+}
+
+// NewInvitationsClient returns a client for the Invitations from the given config.
+func NewInvitationsClient(c config) *InvitationsClient {
+	return &InvitationsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invitations.Hooks(f(g(h())))`.
+func (c *InvitationsClient) Use(hooks ...Hook) {
+	c.hooks.Invitations = append(c.hooks.Invitations, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invitations.Intercept(f(g(h())))`.
+func (c *InvitationsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invitations = append(c.inters.Invitations, interceptors...)
+}
+
+// Create returns a builder for creating a Invitations entity.
+func (c *InvitationsClient) Create() *InvitationsCreate {
+	mutation := newInvitationsMutation(c.config, OpCreate)
+	return &InvitationsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invitations entities.
+func (c *InvitationsClient) CreateBulk(builders ...*InvitationsCreate) *InvitationsCreateBulk {
+	return &InvitationsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvitationsClient) MapCreateBulk(slice any, setFunc func(*InvitationsCreate, int)) *InvitationsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvitationsCreateBulk{err: fmt.Errorf("calling to InvitationsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvitationsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvitationsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invitations.
+func (c *InvitationsClient) Update() *InvitationsUpdate {
+	mutation := newInvitationsMutation(c.config, OpUpdate)
+	return &InvitationsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvitationsClient) UpdateOne(i *Invitations) *InvitationsUpdateOne {
+	mutation := newInvitationsMutation(c.config, OpUpdateOne, withInvitations(i))
+	return &InvitationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvitationsClient) UpdateOneID(id uuid.UUID) *InvitationsUpdateOne {
+	mutation := newInvitationsMutation(c.config, OpUpdateOne, withInvitationsID(id))
+	return &InvitationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invitations.
+func (c *InvitationsClient) Delete() *InvitationsDelete {
+	mutation := newInvitationsMutation(c.config, OpDelete)
+	return &InvitationsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvitationsClient) DeleteOne(i *Invitations) *InvitationsDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvitationsClient) DeleteOneID(id uuid.UUID) *InvitationsDeleteOne {
+	builder := c.Delete().Where(invitations.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvitationsDeleteOne{builder}
+}
+
+// Query returns a query builder for Invitations.
+func (c *InvitationsClient) Query() *InvitationsQuery {
+	return &InvitationsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvitations},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invitations entity by its id.
+func (c *InvitationsClient) Get(ctx context.Context, id uuid.UUID) (*Invitations, error) {
+	return c.Query().Where(invitations.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvitationsClient) GetX(ctx context.Context, id uuid.UUID) *Invitations {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvitationsClient) Hooks() []Hook {
+	return c.hooks.Invitations
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvitationsClient) Interceptors() []Interceptor {
+	return c.inters.Invitations
+}
+
+func (c *InvitationsClient) mutate(ctx context.Context, m *InvitationsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvitationsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvitationsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvitationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvitationsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Invitations mutation op: %q", m.Op())
+	}
+}
+
+// OrgMembersClient is a client for the OrgMembers schema.
+type OrgMembersClient struct {
+	config
+}
+
+// NewOrgMembersClient returns a client for the OrgMembers from the given config.
+func NewOrgMembersClient(c config) *OrgMembersClient {
+	return &OrgMembersClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orgmembers.Hooks(f(g(h())))`.
+func (c *OrgMembersClient) Use(hooks ...Hook) {
+	c.hooks.OrgMembers = append(c.hooks.OrgMembers, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orgmembers.Intercept(f(g(h())))`.
+func (c *OrgMembersClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrgMembers = append(c.inters.OrgMembers, interceptors...)
+}
+
+// Create returns a builder for creating a OrgMembers entity.
+func (c *OrgMembersClient) Create() *OrgMembersCreate {
+	mutation := newOrgMembersMutation(c.config, OpCreate)
+	return &OrgMembersCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrgMembers entities.
+func (c *OrgMembersClient) CreateBulk(builders ...*OrgMembersCreate) *OrgMembersCreateBulk {
+	return &OrgMembersCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrgMembersClient) MapCreateBulk(slice any, setFunc func(*OrgMembersCreate, int)) *OrgMembersCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrgMembersCreateBulk{err: fmt.Errorf("calling to OrgMembersClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrgMembersCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrgMembersCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrgMembers.
+func (c *OrgMembersClient) Update() *OrgMembersUpdate {
+	mutation := newOrgMembersMutation(c.config, OpUpdate)
+	return &OrgMembersUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrgMembersClient) UpdateOne(om *OrgMembers) *OrgMembersUpdateOne {
+	mutation := newOrgMembersMutation(c.config, OpUpdateOne, withOrgMembers(om))
+	return &OrgMembersUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrgMembersClient) UpdateOneID(id uuid.UUID) *OrgMembersUpdateOne {
+	mutation := newOrgMembersMutation(c.config, OpUpdateOne, withOrgMembersID(id))
+	return &OrgMembersUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrgMembers.
+func (c *OrgMembersClient) Delete() *OrgMembersDelete {
+	mutation := newOrgMembersMutation(c.config, OpDelete)
+	return &OrgMembersDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrgMembersClient) DeleteOne(om *OrgMembers) *OrgMembersDeleteOne {
+	return c.DeleteOneID(om.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrgMembersClient) DeleteOneID(id uuid.UUID) *OrgMembersDeleteOne {
+	builder := c.Delete().Where(orgmembers.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrgMembersDeleteOne{builder}
+}
+
+// Query returns a query builder for OrgMembers.
+func (c *OrgMembersClient) Query() *OrgMembersQuery {
+	return &OrgMembersQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrgMembers},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrgMembers entity by its id.
+func (c *OrgMembersClient) Get(ctx context.Context, id uuid.UUID) (*OrgMembers, error) {
+	return c.Query().Where(orgmembers.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrgMembersClient) GetX(ctx context.Context, id uuid.UUID) *OrgMembers {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrg queries the org edge of a OrgMembers.
+func (c *OrgMembersClient) QueryOrg(om *OrgMembers) *OrganizationsQuery {
+	query := (&OrganizationsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := om.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgmembers.Table, orgmembers.FieldID, id),
+			sqlgraph.To(organizations.Table, organizations.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgmembers.OrgTable, orgmembers.OrgColumn),
+		)
+		fromV = sqlgraph.Neighbors(om.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a OrgMembers.
+func (c *OrgMembersClient) QueryUser(om *OrgMembers) *UsersQuery {
+	query := (&UsersClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := om.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgmembers.Table, orgmembers.FieldID, id),
+			sqlgraph.To(users.Table, users.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgmembers.UserTable, orgmembers.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(om.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrgMembersClient) Hooks() []Hook {
+	return c.hooks.OrgMembers
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrgMembersClient) Interceptors() []Interceptor {
+	return c.inters.OrgMembers
+}
+
+func (c *OrgMembersClient) mutate(ctx context.Context, m *OrgMembersMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrgMembersCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrgMembersUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrgMembersUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrgMembersDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrgMembers mutation op: %q", m.Op())
+	}
+}
+
+// OrgRolesClient is a client for the OrgRoles schema.
+type OrgRolesClient struct {
+	config
+}
+
+// NewOrgRolesClient returns a client for the OrgRoles from the given config.
+func NewOrgRolesClient(c config) *OrgRolesClient {
+	return &OrgRolesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orgroles.Hooks(f(g(h())))`.
+func (c *OrgRolesClient) Use(hooks ...Hook) {
+	c.hooks.OrgRoles = append(c.hooks.OrgRoles, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orgroles.Intercept(f(g(h())))`.
+func (c *OrgRolesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrgRoles = append(c.inters.OrgRoles, interceptors...)
+}
+
+// Create returns a builder for creating a OrgRoles entity.
+func (c *OrgRolesClient) Create() *OrgRolesCreate {
+	mutation := newOrgRolesMutation(c.config, OpCreate)
+	return &OrgRolesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrgRoles entities.
+func (c *OrgRolesClient) CreateBulk(builders ...*OrgRolesCreate) *OrgRolesCreateBulk {
+	return &OrgRolesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrgRolesClient) MapCreateBulk(slice any, setFunc func(*OrgRolesCreate, int)) *OrgRolesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrgRolesCreateBulk{err: fmt.Errorf("calling to OrgRolesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrgRolesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrgRolesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrgRoles.
+func (c *OrgRolesClient) Update() *OrgRolesUpdate {
+	mutation := newOrgRolesMutation(c.config, OpUpdate)
+	return &OrgRolesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrgRolesClient) UpdateOne(or *OrgRoles) *OrgRolesUpdateOne {
+	mutation := newOrgRolesMutation(c.config, OpUpdateOne, withOrgRoles(or))
+	return &OrgRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrgRolesClient) UpdateOneID(id int) *OrgRolesUpdateOne {
+	mutation := newOrgRolesMutation(c.config, OpUpdateOne, withOrgRolesID(id))
+	return &OrgRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrgRoles.
+func (c *OrgRolesClient) Delete() *OrgRolesDelete {
+	mutation := newOrgRolesMutation(c.config, OpDelete)
+	return &OrgRolesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrgRolesClient) DeleteOne(or *OrgRoles) *OrgRolesDeleteOne {
+	return c.DeleteOneID(or.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrgRolesClient) DeleteOneID(id int) *OrgRolesDeleteOne {
+	builder := c.Delete().Where(orgroles.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrgRolesDeleteOne{builder}
+}
+
+// Query returns a query builder for OrgRoles.
+func (c *OrgRolesClient) Query() *OrgRolesQuery {
+	return &OrgRolesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrgRoles},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrgRoles entity by its id.
+func (c *OrgRolesClient) Get(ctx context.Context, id int) (*OrgRoles, error) {
+	return c.Query().Where(orgroles.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrgRolesClient) GetX(ctx context.Context, id int) *OrgRoles {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrg queries the org edge of a OrgRoles.
+func (c *OrgRolesClient) QueryOrg(or *OrgRoles) *OrganizationsQuery {
+	query := (&OrganizationsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := or.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgroles.Table, orgroles.FieldID, id),
+			sqlgraph.To(organizations.Table, organizations.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgroles.OrgTable, orgroles.OrgColumn),
+		)
+		fromV = sqlgraph.Neighbors(or.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a OrgRoles.
+func (c *OrgRolesClient) QueryUser(or *OrgRoles) *UsersQuery {
+	query := (&UsersClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := or.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgroles.Table, orgroles.FieldID, id),
+			sqlgraph.To(users.Table, users.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgroles.UserTable, orgroles.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(or.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRole queries the role edge of a OrgRoles.
+func (c *OrgRolesClient) QueryRole(or *OrgRoles) *RolesQuery {
+	query := (&RolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := or.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgroles.Table, orgroles.FieldID, id),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgroles.RoleTable, orgroles.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(or.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrgRolesClient) Hooks() []Hook {
+	return c.hooks.OrgRoles
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrgRolesClient) Interceptors() []Interceptor {
+	return c.inters.OrgRoles
+}
+
+func (c *OrgRolesClient) mutate(ctx context.Context, m *OrgRolesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrgRolesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrgRolesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrgRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrgRolesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrgRoles mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationsClient is a client for the Organizations schema.
+type OrganizationsClient struct {
+	config
+}
+
+// NewOrganizationsClient returns a client for the Organizations from the given config.
+func NewOrganizationsClient(c config) *OrganizationsClient {
+	return &OrganizationsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organizations.Hooks(f(g(h())))`.
+func (c *OrganizationsClient) Use(hooks ...Hook) {
+	c.hooks.Organizations = append(c.hooks.Organizations, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organizations.Intercept(f(g(h())))`.
+func (c *OrganizationsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organizations = append(c.inters.Organizations, interceptors...)
+}
+
+// Create returns a builder for creating a Organizations entity.
+func (c *OrganizationsClient) Create() *OrganizationsCreate {
+	mutation := newOrganizationsMutation(c.config, OpCreate)
+	return &OrganizationsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Organizations entities.
+func (c *OrganizationsClient) CreateBulk(builders ...*OrganizationsCreate) *OrganizationsCreateBulk {
+	return &OrganizationsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationsClient) MapCreateBulk(slice any, setFunc func(*OrganizationsCreate, int)) *OrganizationsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationsCreateBulk{err: fmt.Errorf("calling to OrganizationsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Organizations.
+func (c *OrganizationsClient) Update() *OrganizationsUpdate {
+	mutation := newOrganizationsMutation(c.config, OpUpdate)
+	return &OrganizationsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationsClient) UpdateOne(o *Organizations) *OrganizationsUpdateOne {
+	mutation := newOrganizationsMutation(c.config, OpUpdateOne, withOrganizations(o))
+	return &OrganizationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationsClient) UpdateOneID(id uuid.UUID) *OrganizationsUpdateOne {
+	mutation := newOrganizationsMutation(c.config, OpUpdateOne, withOrganizationsID(id))
+	return &OrganizationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Organizations.
+func (c *OrganizationsClient) Delete() *OrganizationsDelete {
+	mutation := newOrganizationsMutation(c.config, OpDelete)
+	return &OrganizationsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrganizationsClient) DeleteOne(o *Organizations) *OrganizationsDeleteOne {
+	return c.DeleteOneID(o.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrganizationsClient) DeleteOneID(id uuid.UUID) *OrganizationsDeleteOne {
+	builder := c.Delete().Where(organizations.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrganizationsDeleteOne{builder}
+}
+
+// Query returns a query builder for Organizations.
+func (c *OrganizationsClient) Query() *OrganizationsQuery {
+	return &OrganizationsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrganizations},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Organizations entity by its id.
+func (c *OrganizationsClient) Get(ctx context.Context, id uuid.UUID) (*Organizations, error) {
+	return c.Query().Where(organizations.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrganizationsClient) GetX(ctx context.Context, id uuid.UUID) *Organizations {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMembers queries the members edge of a Organizations.
+func (c *OrganizationsClient) QueryMembers(o *Organizations) *OrgMembersQuery {
+	query := (&OrgMembersClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizations.Table, organizations.FieldID, id),
+			sqlgraph.To(orgmembers.Table, orgmembers.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, organizations.MembersTable, organizations.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrgRoles queries the org_roles edge of a Organizations.
+func (c *OrganizationsClient) QueryOrgRoles(o *Organizations) *OrgRolesQuery {
+	query := (&OrgRolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organizations.Table, organizations.FieldID, id),
+			sqlgraph.To(orgroles.Table, orgroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, organizations.OrgRolesTable, organizations.OrgRolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationsClient) Hooks() []Hook {
+	return c.hooks.Organizations
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationsClient) Interceptors() []Interceptor {
+	return c.inters.Organizations
+}
+
+func (c *OrganizationsClient) mutate(ctx context.Context, m *OrganizationsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Organizations mutation op: %q", m.Op())
+	}
+}
+
+// PasswordHistoriesClient is a client for the PasswordHistories schema.
+type PasswordHistoriesClient struct {
+	config
+}
+
+// NewPasswordHistoriesClient returns a client for the PasswordHistories from the given config.
+func NewPasswordHistoriesClient(c config) *PasswordHistoriesClient {
+	return &PasswordHistoriesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passwordhistories.Hooks(f(g(h())))`.
+func (c *PasswordHistoriesClient) Use(hooks ...Hook) {
+	c.hooks.PasswordHistories = append(c.hooks.PasswordHistories, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passwordhistories.Intercept(f(g(h())))`.
+func (c *PasswordHistoriesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordHistories = append(c.inters.PasswordHistories, interceptors...)
+}
+
+// Create returns a builder for creating a PasswordHistories entity.
+func (c *PasswordHistoriesClient) Create() *PasswordHistoriesCreate {
+	mutation := newPasswordHistoriesMutation(c.config, OpCreate)
+	return &PasswordHistoriesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordHistories entities.
+func (c *PasswordHistoriesClient) CreateBulk(builders ...*PasswordHistoriesCreate) *PasswordHistoriesCreateBulk {
+	return &PasswordHistoriesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordHistoriesClient) MapCreateBulk(slice any, setFunc func(*PasswordHistoriesCreate, int)) *PasswordHistoriesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordHistoriesCreateBulk{err: fmt.Errorf("calling to PasswordHistoriesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordHistoriesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordHistoriesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordHistories.
+func (c *PasswordHistoriesClient) Update() *PasswordHistoriesUpdate {
+	mutation := newPasswordHistoriesMutation(c.config, OpUpdate)
+	return &PasswordHistoriesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordHistoriesClient) UpdateOne(ph *PasswordHistories) *PasswordHistoriesUpdateOne {
+	mutation := newPasswordHistoriesMutation(c.config, OpUpdateOne, withPasswordHistories(ph))
+	return &PasswordHistoriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordHistoriesClient) UpdateOneID(id uuid.UUID) *PasswordHistoriesUpdateOne {
+	mutation := newPasswordHistoriesMutation(c.config, OpUpdateOne, withPasswordHistoriesID(id))
+	return &PasswordHistoriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordHistories.
+func (c *PasswordHistoriesClient) Delete() *PasswordHistoriesDelete {
+	mutation := newPasswordHistoriesMutation(c.config, OpDelete)
+	return &PasswordHistoriesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordHistoriesClient) DeleteOne(ph *PasswordHistories) *PasswordHistoriesDeleteOne {
+	return c.DeleteOneID(ph.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordHistoriesClient) DeleteOneID(id uuid.UUID) *PasswordHistoriesDeleteOne {
+	builder := c.Delete().Where(passwordhistories.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordHistoriesDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordHistories.
+func (c *PasswordHistoriesClient) Query() *PasswordHistoriesQuery {
+	return &PasswordHistoriesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasswordHistories},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordHistories entity by its id.
+func (c *PasswordHistoriesClient) Get(ctx context.Context, id uuid.UUID) (*PasswordHistories, error) {
+	return c.Query().Where(passwordhistories.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordHistoriesClient) GetX(ctx context.Context, id uuid.UUID) *PasswordHistories {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordHistoriesClient) Hooks() []Hook {
+	return c.hooks.PasswordHistories
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordHistoriesClient) Interceptors() []Interceptor {
+	return c.inters.PasswordHistories
+}
+
+func (c *PasswordHistoriesClient) mutate(ctx context.Context, m *PasswordHistoriesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordHistoriesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordHistoriesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordHistoriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordHistoriesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordHistories mutation op: %q", m.Op())
+	}
+}
+
+// PasswordResetsClient is a client for the PasswordResets schema.
+type PasswordResetsClient struct {
+	config
+}
+
+// NewPasswordResetsClient returns a client for the PasswordResets from the given config.
+func NewPasswordResetsClient(c config) *PasswordResetsClient {
+	return &PasswordResetsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passwordresets.Hooks(f(g(h())))`.
+func (c *PasswordResetsClient) Use(hooks ...Hook) {
+	c.hooks.PasswordResets = append(c.hooks.PasswordResets, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passwordresets.Intercept(f(g(h())))`.
+func (c *PasswordResetsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordResets = append(c.inters.PasswordResets, interceptors...)
+}
+
+// Create returns a builder for creating a PasswordResets entity.
+func (c *PasswordResetsClient) Create() *PasswordResetsCreate {
+	mutation := newPasswordResetsMutation(c.config, OpCreate)
+	return &PasswordResetsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordResets entities.
+func (c *PasswordResetsClient) CreateBulk(builders ...*PasswordResetsCreate) *PasswordResetsCreateBulk {
+	return &PasswordResetsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordResetsClient) MapCreateBulk(slice any, setFunc func(*PasswordResetsCreate, int)) *PasswordResetsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordResetsCreateBulk{err: fmt.Errorf("calling to PasswordResetsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordResetsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordResetsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordResets.
+func (c *PasswordResetsClient) Update() *PasswordResetsUpdate {
+	mutation := newPasswordResetsMutation(c.config, OpUpdate)
+	return &PasswordResetsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordResetsClient) UpdateOne(pr *PasswordResets) *PasswordResetsUpdateOne {
+	mutation := newPasswordResetsMutation(c.config, OpUpdateOne, withPasswordResets(pr))
+	return &PasswordResetsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordResetsClient) UpdateOneID(id uuid.UUID) *PasswordResetsUpdateOne {
+	mutation := newPasswordResetsMutation(c.config, OpUpdateOne, withPasswordResetsID(id))
+	return &PasswordResetsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordResets.
+func (c *PasswordResetsClient) Delete() *PasswordResetsDelete {
+	mutation := newPasswordResetsMutation(c.config, OpDelete)
+	return &PasswordResetsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordResetsClient) DeleteOne(pr *PasswordResets) *PasswordResetsDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordResetsClient) DeleteOneID(id uuid.UUID) *PasswordResetsDeleteOne {
+	builder := c.Delete().Where(passwordresets.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordResetsDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordResets.
+func (c *PasswordResetsClient) Query() *PasswordResetsQuery {
+	return &PasswordResetsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasswordResets},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordResets entity by its id.
+func (c *PasswordResetsClient) Get(ctx context.Context, id uuid.UUID) (*PasswordResets, error) {
+	return c.Query().Where(passwordresets.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordResetsClient) GetX(ctx context.Context, id uuid.UUID) *PasswordResets {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordResetsClient) Hooks() []Hook {
+	return c.hooks.PasswordResets
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordResetsClient) Interceptors() []Interceptor {
+	return c.inters.PasswordResets
+}
+
+func (c *PasswordResetsClient) mutate(ctx context.Context, m *PasswordResetsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordResetsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordResetsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordResetsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordResetsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordResets mutation op: %q", m.Op())
+	}
+}
+
+// PermissionsClient is a client for the Permissions schema.
+type PermissionsClient struct {
+	config
+}
+
+// NewPermissionsClient returns a client for the Permissions from the given config.
 func NewPermissionsClient(c config) *PermissionsClient {
 	return &PermissionsClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `permissions.Hooks(f(g(h())))`.
-func (c *PermissionsClient) Use(hooks ...Hook) {
-	c.hooks.Permissions = append(c.hooks.Permissions, hooks...)
+// A call to `Use(f, g, h)` equals to `permissions.Hooks(f(g(h())))`.
+func (c *PermissionsClient) Use(hooks ...Hook) {
+	c.hooks.Permissions = append(c.hooks.Permissions, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `permissions.Intercept(f(g(h())))`.
+func (c *PermissionsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Permissions = append(c.inters.Permissions, interceptors...)
+}
+
+// Create returns a builder for creating a Permissions entity.
+func (c *PermissionsClient) Create() *PermissionsCreate {
+	mutation := newPermissionsMutation(c.config, OpCreate)
+	return &PermissionsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Permissions entities.
+func (c *PermissionsClient) CreateBulk(builders ...*PermissionsCreate) *PermissionsCreateBulk {
+	return &PermissionsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PermissionsClient) MapCreateBulk(slice any, setFunc func(*PermissionsCreate, int)) *PermissionsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PermissionsCreateBulk{err: fmt.Errorf("calling to PermissionsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PermissionsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PermissionsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Permissions.
+func (c *PermissionsClient) Update() *PermissionsUpdate {
+	mutation := newPermissionsMutation(c.config, OpUpdate)
+	return &PermissionsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PermissionsClient) UpdateOne(pe *Permissions) *PermissionsUpdateOne {
+	mutation := newPermissionsMutation(c.config, OpUpdateOne, withPermissions(pe))
+	return &PermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PermissionsClient) UpdateOneID(id int) *PermissionsUpdateOne {
+	mutation := newPermissionsMutation(c.config, OpUpdateOne, withPermissionsID(id))
+	return &PermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Permissions.
+func (c *PermissionsClient) Delete() *PermissionsDelete {
+	mutation := newPermissionsMutation(c.config, OpDelete)
+	return &PermissionsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PermissionsClient) DeleteOne(pe *Permissions) *PermissionsDeleteOne {
+	return c.DeleteOneID(pe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PermissionsClient) DeleteOneID(id int) *PermissionsDeleteOne {
+	builder := c.Delete().Where(permissions.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PermissionsDeleteOne{builder}
+}
+
+// Query returns a query builder for Permissions.
+func (c *PermissionsClient) Query() *PermissionsQuery {
+	return &PermissionsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePermissions},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Permissions entity by its id.
+func (c *PermissionsClient) Get(ctx context.Context, id int) (*Permissions, error) {
+	return c.Query().Where(permissions.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PermissionsClient) GetX(ctx context.Context, id int) *Permissions {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRolePermissions queries the role_permissions edge of a Permissions.
+func (c *PermissionsClient) QueryRolePermissions(pe *Permissions) *RolePermissionsQuery {
+	query := (&RolePermissionsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(permissions.Table, permissions.FieldID, id),
+			sqlgraph.To(rolepermissions.Table, rolepermissions.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, permissions.RolePermissionsTable, permissions.RolePermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PermissionsClient) Hooks() []Hook {
+	return c.hooks.Permissions
+}
+
+// Interceptors returns the client interceptors.
+func (c *PermissionsClient) Interceptors() []Interceptor {
+	return c.inters.Permissions
+}
+
+func (c *PermissionsClient) mutate(ctx context.Context, m *PermissionsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PermissionsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PermissionsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PermissionsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Permissions mutation op: %q", m.Op())
+	}
+}
+
+// RelationTuplesClient is a client for the RelationTuples schema.
+type RelationTuplesClient struct {
+	config
+}
+
+// NewRelationTuplesClient returns a client for the RelationTuples from the given config.
+func NewRelationTuplesClient(c config) *RelationTuplesClient {
+	return &RelationTuplesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `relationtuples.Hooks(f(g(h())))`.
+func (c *RelationTuplesClient) Use(hooks ...Hook) {
+	c.hooks.RelationTuples = append(c.hooks.RelationTuples, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `relationtuples.Intercept(f(g(h())))`.
+func (c *RelationTuplesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RelationTuples = append(c.inters.RelationTuples, interceptors...)
+}
+
+// Create returns a builder for creating a RelationTuples entity.
+func (c *RelationTuplesClient) Create() *RelationTuplesCreate {
+	mutation := newRelationTuplesMutation(c.config, OpCreate)
+	return &RelationTuplesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RelationTuples entities.
+func (c *RelationTuplesClient) CreateBulk(builders ...*RelationTuplesCreate) *RelationTuplesCreateBulk {
+	return &RelationTuplesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RelationTuplesClient) MapCreateBulk(slice any, setFunc func(*RelationTuplesCreate, int)) *RelationTuplesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RelationTuplesCreateBulk{err: fmt.Errorf("calling to RelationTuplesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RelationTuplesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RelationTuplesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RelationTuples.
+func (c *RelationTuplesClient) Update() *RelationTuplesUpdate {
+	mutation := newRelationTuplesMutation(c.config, OpUpdate)
+	return &RelationTuplesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RelationTuplesClient) UpdateOne(rt *RelationTuples) *RelationTuplesUpdateOne {
+	mutation := newRelationTuplesMutation(c.config, OpUpdateOne, withRelationTuples(rt))
+	return &RelationTuplesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RelationTuplesClient) UpdateOneID(id int) *RelationTuplesUpdateOne {
+	mutation := newRelationTuplesMutation(c.config, OpUpdateOne, withRelationTuplesID(id))
+	return &RelationTuplesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RelationTuples.
+func (c *RelationTuplesClient) Delete() *RelationTuplesDelete {
+	mutation := newRelationTuplesMutation(c.config, OpDelete)
+	return &RelationTuplesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RelationTuplesClient) DeleteOne(rt *RelationTuples) *RelationTuplesDeleteOne {
+	return c.DeleteOneID(rt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RelationTuplesClient) DeleteOneID(id int) *RelationTuplesDeleteOne {
+	builder := c.Delete().Where(relationtuples.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RelationTuplesDeleteOne{builder}
+}
+
+// Query returns a query builder for RelationTuples.
+func (c *RelationTuplesClient) Query() *RelationTuplesQuery {
+	return &RelationTuplesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRelationTuples},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RelationTuples entity by its id.
+func (c *RelationTuplesClient) Get(ctx context.Context, id int) (*RelationTuples, error) {
+	return c.Query().Where(relationtuples.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RelationTuplesClient) GetX(ctx context.Context, id int) *RelationTuples {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RelationTuplesClient) Hooks() []Hook {
+	return c.hooks.RelationTuples
+}
+
+// Interceptors returns the client interceptors.
+func (c *RelationTuplesClient) Interceptors() []Interceptor {
+	return c.inters.RelationTuples
+}
+
+func (c *RelationTuplesClient) mutate(ctx context.Context, m *RelationTuplesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RelationTuplesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RelationTuplesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RelationTuplesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RelationTuplesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RelationTuples mutation op: %q", m.Op())
+	}
+}
+
+// RoleApproversClient is a client for the RoleApprovers schema.
+type RoleApproversClient struct {
+	config
+}
+
+// NewRoleApproversClient returns a client for the RoleApprovers from the given config.
+func NewRoleApproversClient(c config) *RoleApproversClient {
+	return &RoleApproversClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roleapprovers.Hooks(f(g(h())))`.
+func (c *RoleApproversClient) Use(hooks ...Hook) {
+	c.hooks.RoleApprovers = append(c.hooks.RoleApprovers, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roleapprovers.Intercept(f(g(h())))`.
+func (c *RoleApproversClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleApprovers = append(c.inters.RoleApprovers, interceptors...)
+}
+
+// Create returns a builder for creating a RoleApprovers entity.
+func (c *RoleApproversClient) Create() *RoleApproversCreate {
+	mutation := newRoleApproversMutation(c.config, OpCreate)
+	return &RoleApproversCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleApprovers entities.
+func (c *RoleApproversClient) CreateBulk(builders ...*RoleApproversCreate) *RoleApproversCreateBulk {
+	return &RoleApproversCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleApproversClient) MapCreateBulk(slice any, setFunc func(*RoleApproversCreate, int)) *RoleApproversCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleApproversCreateBulk{err: fmt.Errorf("calling to RoleApproversClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleApproversCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleApproversCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleApprovers.
+func (c *RoleApproversClient) Update() *RoleApproversUpdate {
+	mutation := newRoleApproversMutation(c.config, OpUpdate)
+	return &RoleApproversUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleApproversClient) UpdateOne(ra *RoleApprovers) *RoleApproversUpdateOne {
+	mutation := newRoleApproversMutation(c.config, OpUpdateOne, withRoleApprovers(ra))
+	return &RoleApproversUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleApproversClient) UpdateOneID(id int) *RoleApproversUpdateOne {
+	mutation := newRoleApproversMutation(c.config, OpUpdateOne, withRoleApproversID(id))
+	return &RoleApproversUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleApprovers.
+func (c *RoleApproversClient) Delete() *RoleApproversDelete {
+	mutation := newRoleApproversMutation(c.config, OpDelete)
+	return &RoleApproversDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleApproversClient) DeleteOne(ra *RoleApprovers) *RoleApproversDeleteOne {
+	return c.DeleteOneID(ra.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleApproversClient) DeleteOneID(id int) *RoleApproversDeleteOne {
+	builder := c.Delete().Where(roleapprovers.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleApproversDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleApprovers.
+func (c *RoleApproversClient) Query() *RoleApproversQuery {
+	return &RoleApproversQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleApprovers},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleApprovers entity by its id.
+func (c *RoleApproversClient) Get(ctx context.Context, id int) (*RoleApprovers, error) {
+	return c.Query().Where(roleapprovers.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleApproversClient) GetX(ctx context.Context, id int) *RoleApprovers {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRole queries the role edge of a RoleApprovers.
+func (c *RoleApproversClient) QueryRole(ra *RoleApprovers) *RolesQuery {
+	query := (&RolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ra.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roleapprovers.Table, roleapprovers.FieldID, id),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roleapprovers.RoleTable, roleapprovers.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryApproverRole queries the approver_role edge of a RoleApprovers.
+func (c *RoleApproversClient) QueryApproverRole(ra *RoleApprovers) *RolesQuery {
+	query := (&RolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ra.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roleapprovers.Table, roleapprovers.FieldID, id),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roleapprovers.ApproverRoleTable, roleapprovers.ApproverRoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleApproversClient) Hooks() []Hook {
+	return c.hooks.RoleApprovers
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleApproversClient) Interceptors() []Interceptor {
+	return c.inters.RoleApprovers
+}
+
+func (c *RoleApproversClient) mutate(ctx context.Context, m *RoleApproversMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleApproversCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleApproversUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleApproversUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleApproversDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleApprovers mutation op: %q", m.Op())
+	}
+}
+
+// RoleParentsClient is a client for the RoleParents schema.
+type RoleParentsClient struct {
+	config
+}
+
+// NewRoleParentsClient returns a client for the RoleParents from the given config.
+func NewRoleParentsClient(c config) *RoleParentsClient {
+	return &RoleParentsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roleparents.Hooks(f(g(h())))`.
+func (c *RoleParentsClient) Use(hooks ...Hook) {
+	c.hooks.RoleParents = append(c.hooks.RoleParents, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roleparents.Intercept(f(g(h())))`.
+func (c *RoleParentsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleParents = append(c.inters.RoleParents, interceptors...)
+}
+
+// Create returns a builder for creating a RoleParents entity.
+func (c *RoleParentsClient) Create() *RoleParentsCreate {
+	mutation := newRoleParentsMutation(c.config, OpCreate)
+	return &RoleParentsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoleParents entities.
+func (c *RoleParentsClient) CreateBulk(builders ...*RoleParentsCreate) *RoleParentsCreateBulk {
+	return &RoleParentsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleParentsClient) MapCreateBulk(slice any, setFunc func(*RoleParentsCreate, int)) *RoleParentsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleParentsCreateBulk{err: fmt.Errorf("calling to RoleParentsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleParentsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleParentsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoleParents.
+func (c *RoleParentsClient) Update() *RoleParentsUpdate {
+	mutation := newRoleParentsMutation(c.config, OpUpdate)
+	return &RoleParentsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleParentsClient) UpdateOne(rp *RoleParents) *RoleParentsUpdateOne {
+	mutation := newRoleParentsMutation(c.config, OpUpdateOne, withRoleParents(rp))
+	return &RoleParentsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleParentsClient) UpdateOneID(id int) *RoleParentsUpdateOne {
+	mutation := newRoleParentsMutation(c.config, OpUpdateOne, withRoleParentsID(id))
+	return &RoleParentsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoleParents.
+func (c *RoleParentsClient) Delete() *RoleParentsDelete {
+	mutation := newRoleParentsMutation(c.config, OpDelete)
+	return &RoleParentsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleParentsClient) DeleteOne(rp *RoleParents) *RoleParentsDeleteOne {
+	return c.DeleteOneID(rp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleParentsClient) DeleteOneID(id int) *RoleParentsDeleteOne {
+	builder := c.Delete().Where(roleparents.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleParentsDeleteOne{builder}
+}
+
+// Query returns a query builder for RoleParents.
+func (c *RoleParentsClient) Query() *RoleParentsQuery {
+	return &RoleParentsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoleParents},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleParents entity by its id.
+func (c *RoleParentsClient) Get(ctx context.Context, id int) (*RoleParents, error) {
+	return c.Query().Where(roleparents.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleParentsClient) GetX(ctx context.Context, id int) *RoleParents {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRole queries the role edge of a RoleParents.
+func (c *RoleParentsClient) QueryRole(rp *RoleParents) *RolesQuery {
+	query := (&RolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roleparents.Table, roleparents.FieldID, id),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roleparents.RoleTable, roleparents.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(rp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a RoleParents.
+func (c *RoleParentsClient) QueryParent(rp *RoleParents) *RolesQuery {
+	query := (&RolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roleparents.Table, roleparents.FieldID, id),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roleparents.ParentTable, roleparents.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(rp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoleParentsClient) Hooks() []Hook {
+	return c.hooks.RoleParents
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleParentsClient) Interceptors() []Interceptor {
+	return c.inters.RoleParents
+}
+
+func (c *RoleParentsClient) mutate(ctx context.Context, m *RoleParentsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleParentsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleParentsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleParentsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleParentsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleParents mutation op: %q", m.Op())
+	}
+}
+
+// RolePermissionsClient is a client for the RolePermissions schema.
+type RolePermissionsClient struct {
+	config
+}
+
+// NewRolePermissionsClient returns a client for the RolePermissions from the given config.
+func NewRolePermissionsClient(c config) *RolePermissionsClient {
+	return &RolePermissionsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolepermissions.Hooks(f(g(h())))`.
+func (c *RolePermissionsClient) Use(hooks ...Hook) {
+	c.hooks.RolePermissions = append(c.hooks.RolePermissions, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rolepermissions.Intercept(f(g(h())))`.
+func (c *RolePermissionsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RolePermissions = append(c.inters.RolePermissions, interceptors...)
+}
+
+// Create returns a builder for creating a RolePermissions entity.
+func (c *RolePermissionsClient) Create() *RolePermissionsCreate {
+	mutation := newRolePermissionsMutation(c.config, OpCreate)
+	return &RolePermissionsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RolePermissions entities.
+func (c *RolePermissionsClient) CreateBulk(builders ...*RolePermissionsCreate) *RolePermissionsCreateBulk {
+	return &RolePermissionsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RolePermissionsClient) MapCreateBulk(slice any, setFunc func(*RolePermissionsCreate, int)) *RolePermissionsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RolePermissionsCreateBulk{err: fmt.Errorf("calling to RolePermissionsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RolePermissionsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RolePermissionsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RolePermissions.
+func (c *RolePermissionsClient) Update() *RolePermissionsUpdate {
+	mutation := newRolePermissionsMutation(c.config, OpUpdate)
+	return &RolePermissionsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RolePermissionsClient) UpdateOne(rp *RolePermissions) *RolePermissionsUpdateOne {
+	mutation := newRolePermissionsMutation(c.config, OpUpdateOne, withRolePermissions(rp))
+	return &RolePermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RolePermissionsClient) UpdateOneID(id int) *RolePermissionsUpdateOne {
+	mutation := newRolePermissionsMutation(c.config, OpUpdateOne, withRolePermissionsID(id))
+	return &RolePermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RolePermissions.
+func (c *RolePermissionsClient) Delete() *RolePermissionsDelete {
+	mutation := newRolePermissionsMutation(c.config, OpDelete)
+	return &RolePermissionsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RolePermissionsClient) DeleteOne(rp *RolePermissions) *RolePermissionsDeleteOne {
+	return c.DeleteOneID(rp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RolePermissionsClient) DeleteOneID(id int) *RolePermissionsDeleteOne {
+	builder := c.Delete().Where(rolepermissions.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RolePermissionsDeleteOne{builder}
+}
+
+// Query returns a query builder for RolePermissions.
+func (c *RolePermissionsClient) Query() *RolePermissionsQuery {
+	return &RolePermissionsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRolePermissions},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RolePermissions entity by its id.
+func (c *RolePermissionsClient) Get(ctx context.Context, id int) (*RolePermissions, error) {
+	return c.Query().Where(rolepermissions.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RolePermissionsClient) GetX(ctx context.Context, id int) *RolePermissions {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRole queries the role edge of a RolePermissions.
+func (c *RolePermissionsClient) QueryRole(rp *RolePermissions) *RolesQuery {
+	query := (&RolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolepermissions.Table, rolepermissions.FieldID, id),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolepermissions.RoleTable, rolepermissions.RoleColumn),
+		)
+		fromV = sqlgraph.Neighbors(rp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermission queries the permission edge of a RolePermissions.
+func (c *RolePermissionsClient) QueryPermission(rp *RolePermissions) *PermissionsQuery {
+	query := (&PermissionsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolepermissions.Table, rolepermissions.FieldID, id),
+			sqlgraph.To(permissions.Table, permissions.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, rolepermissions.PermissionTable, rolepermissions.PermissionColumn),
+		)
+		fromV = sqlgraph.Neighbors(rp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RolePermissionsClient) Hooks() []Hook {
+	return c.hooks.RolePermissions
+}
+
+// Interceptors returns the client interceptors.
+func (c *RolePermissionsClient) Interceptors() []Interceptor {
+	return c.inters.RolePermissions
+}
+
+func (c *RolePermissionsClient) mutate(ctx context.Context, m *RolePermissionsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RolePermissionsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RolePermissionsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RolePermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RolePermissionsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RolePermissions mutation op: %q", m.Op())
+	}
+}
+
+// RoleRequestsClient is a client for the RoleRequests schema.
+type RoleRequestsClient struct {
+	config
+}
+
+// NewRoleRequestsClient returns a client for the RoleRequests from the given config.
+func NewRoleRequestsClient(c config) *RoleRequestsClient {
+	return &RoleRequestsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolerequests.Hooks(f(g(h())))`.
+func (c *RoleRequestsClient) Use(hooks ...Hook) {
+	c.hooks.RoleRequests = append(c.hooks.RoleRequests, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `permissions.Intercept(f(g(h())))`.
-func (c *PermissionsClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Permissions = append(c.inters.Permissions, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `rolerequests.Intercept(f(g(h())))`.
+func (c *RoleRequestsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoleRequests = append(c.inters.RoleRequests, interceptors...)
 }
 
-// Create returns a builder for creating a Permissions entity.
-func (c *PermissionsClient) Create() *PermissionsCreate {
-	mutation := newPermissionsMutation(c.config, OpCreate)
-	return &PermissionsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RoleRequests entity.
+func (c *RoleRequestsClient) Create() *RoleRequestsCreate {
+	mutation := newRoleRequestsMutation(c.config, OpCreate)
+	return &RoleRequestsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Permissions entities.
-func (c *PermissionsClient) CreateBulk(builders ...*PermissionsCreate) *PermissionsCreateBulk {
-	return &PermissionsCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RoleRequests entities.
+func (c *RoleRequestsClient) CreateBulk(builders ...*RoleRequestsCreate) *RoleRequestsCreateBulk {
+	return &RoleRequestsCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PermissionsClient) MapCreateBulk(slice any, setFunc func(*PermissionsCreate, int)) *PermissionsCreateBulk {
+func (c *RoleRequestsClient) MapCreateBulk(slice any, setFunc func(*RoleRequestsCreate, int)) *RoleRequestsCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PermissionsCreateBulk{err: fmt.Errorf("calling to PermissionsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RoleRequestsCreateBulk{err: fmt.Errorf("calling to RoleRequestsClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PermissionsCreate, rv.Len())
+	builders := make([]*RoleRequestsCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PermissionsCreateBulk{config: c.config, builders: builders}
+	return &RoleRequestsCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Permissions.
-func (c *PermissionsClient) Update() *PermissionsUpdate {
-	mutation := newPermissionsMutation(c.config, OpUpdate)
-	return &PermissionsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RoleRequests.
+func (c *RoleRequestsClient) Update() *RoleRequestsUpdate {
+	mutation := newRoleRequestsMutation(c.config, OpUpdate)
+	return &RoleRequestsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PermissionsClient) UpdateOne(pe *Permissions) *PermissionsUpdateOne {
-	mutation := newPermissionsMutation(c.config, OpUpdateOne, withPermissions(pe))
-	return &PermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleRequestsClient) UpdateOne(rr *RoleRequests) *RoleRequestsUpdateOne {
+	mutation := newRoleRequestsMutation(c.config, OpUpdateOne, withRoleRequests(rr))
+	return &RoleRequestsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PermissionsClient) UpdateOneID(id int) *PermissionsUpdateOne {
-	mutation := newPermissionsMutation(c.config, OpUpdateOne, withPermissionsID(id))
-	return &PermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RoleRequestsClient) UpdateOneID(id uuid.UUID) *RoleRequestsUpdateOne {
+	mutation := newRoleRequestsMutation(c.config, OpUpdateOne, withRoleRequestsID(id))
+	return &RoleRequestsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Permissions.
-func (c *PermissionsClient) Delete() *PermissionsDelete {
-	mutation := newPermissionsMutation(c.config, OpDelete)
-	return &PermissionsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RoleRequests.
+func (c *RoleRequestsClient) Delete() *RoleRequestsDelete {
+	mutation := newRoleRequestsMutation(c.config, OpDelete)
+	return &RoleRequestsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PermissionsClient) DeleteOne(pe *Permissions) *PermissionsDeleteOne {
-	return c.DeleteOneID(pe.ID)
+func (c *RoleRequestsClient) DeleteOne(rr *RoleRequests) *RoleRequestsDeleteOne {
+	return c.DeleteOneID(rr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PermissionsClient) DeleteOneID(id int) *PermissionsDeleteOne {
-	builder := c.Delete().Where(permissions.ID(id))
+func (c *RoleRequestsClient) DeleteOneID(id uuid.UUID) *RoleRequestsDeleteOne {
+	builder := c.Delete().Where(rolerequests.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PermissionsDeleteOne{builder}
+	return &RoleRequestsDeleteOne{builder}
 }
 
-// Query returns a query builder for Permissions.
-func (c *PermissionsClient) Query() *PermissionsQuery {
-	return &PermissionsQuery{
+// Query returns a query builder for RoleRequests.
+func (c *RoleRequestsClient) Query() *RoleRequestsQuery {
+	return &RoleRequestsQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePermissions},
+		ctx:    &QueryContext{Type: TypeRoleRequests},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoleRequests entity by its id.
+func (c *RoleRequestsClient) Get(ctx context.Context, id uuid.UUID) (*RoleRequests, error) {
+	return c.Query().Where(rolerequests.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleRequestsClient) GetX(ctx context.Context, id uuid.UUID) *RoleRequests {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RoleRequestsClient) Hooks() []Hook {
+	return c.hooks.RoleRequests
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleRequestsClient) Interceptors() []Interceptor {
+	return c.inters.RoleRequests
+}
+
+func (c *RoleRequestsClient) mutate(ctx context.Context, m *RoleRequestsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleRequestsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleRequestsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleRequestsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleRequestsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoleRequests mutation op: %q", m.Op())
+	}
+}
+
+// RolesClient is a client for the Roles schema.
+type RolesClient struct {
+	config
+}
+
+// NewRolesClient returns a client for the Roles from the given config.
+func NewRolesClient(c config) *RolesClient {
+	return &RolesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `roles.Hooks(f(g(h())))`.
+func (c *RolesClient) Use(hooks ...Hook) {
+	c.hooks.Roles = append(c.hooks.Roles, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `roles.Intercept(f(g(h())))`.
+func (c *RolesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Roles = append(c.inters.Roles, interceptors...)
+}
+
+// Create returns a builder for creating a Roles entity.
+func (c *RolesClient) Create() *RolesCreate {
+	mutation := newRolesMutation(c.config, OpCreate)
+	return &RolesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Roles entities.
+func (c *RolesClient) CreateBulk(builders ...*RolesCreate) *RolesCreateBulk {
+	return &RolesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RolesClient) MapCreateBulk(slice any, setFunc func(*RolesCreate, int)) *RolesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RolesCreateBulk{err: fmt.Errorf("calling to RolesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RolesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RolesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Roles.
+func (c *RolesClient) Update() *RolesUpdate {
+	mutation := newRolesMutation(c.config, OpUpdate)
+	return &RolesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RolesClient) UpdateOne(r *Roles) *RolesUpdateOne {
+	mutation := newRolesMutation(c.config, OpUpdateOne, withRoles(r))
+	return &RolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RolesClient) UpdateOneID(id int) *RolesUpdateOne {
+	mutation := newRolesMutation(c.config, OpUpdateOne, withRolesID(id))
+	return &RolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Roles.
+func (c *RolesClient) Delete() *RolesDelete {
+	mutation := newRolesMutation(c.config, OpDelete)
+	return &RolesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RolesClient) DeleteOne(r *Roles) *RolesDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RolesClient) DeleteOneID(id int) *RolesDeleteOne {
+	builder := c.Delete().Where(roles.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RolesDeleteOne{builder}
+}
+
+// Query returns a query builder for Roles.
+func (c *RolesClient) Query() *RolesQuery {
+	return &RolesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoles},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Permissions entity by its id.
-func (c *PermissionsClient) Get(ctx context.Context, id int) (*Permissions, error) {
-	return c.Query().Where(permissions.ID(id)).Only(ctx)
+// Get returns a Roles entity by its id.
+func (c *RolesClient) Get(ctx context.Context, id int) (*Roles, error) {
+	return c.Query().Where(roles.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RolesClient) GetX(ctx context.Context, id int) *Roles {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUserRoles queries the user_roles edge of a Roles.
+func (c *RolesClient) QueryUserRoles(r *Roles) *UserRolesQuery {
+	query := (&UserRolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, id),
+			sqlgraph.To(userroles.Table, userroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.UserRolesTable, roles.UserRolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRolePermissions queries the role_permissions edge of a Roles.
+func (c *RolesClient) QueryRolePermissions(r *Roles) *RolePermissionsQuery {
+	query := (&RolePermissionsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, id),
+			sqlgraph.To(rolepermissions.Table, rolepermissions.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.RolePermissionsTable, roles.RolePermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParentLinks queries the parent_links edge of a Roles.
+func (c *RolesClient) QueryParentLinks(r *Roles) *RoleParentsQuery {
+	query := (&RoleParentsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, id),
+			sqlgraph.To(roleparents.Table, roleparents.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.ParentLinksTable, roles.ParentLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildLinks queries the child_links edge of a Roles.
+func (c *RolesClient) QueryChildLinks(r *Roles) *RoleParentsQuery {
+	query := (&RoleParentsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, id),
+			sqlgraph.To(roleparents.Table, roleparents.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.ChildLinksTable, roles.ChildLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryApproverLinks queries the approver_links edge of a Roles.
+func (c *RolesClient) QueryApproverLinks(r *Roles) *RoleApproversQuery {
+	query := (&RoleApproversClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, id),
+			sqlgraph.To(roleapprovers.Table, roleapprovers.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.ApproverLinksTable, roles.ApproverLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryApprovesLinks queries the approves_links edge of a Roles.
+func (c *RolesClient) QueryApprovesLinks(r *Roles) *RoleApproversQuery {
+	query := (&RoleApproversClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, id),
+			sqlgraph.To(roleapprovers.Table, roleapprovers.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.ApprovesLinksTable, roles.ApprovesLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySodConstraintRoles queries the sod_constraint_roles edge of a Roles.
+func (c *RolesClient) QuerySodConstraintRoles(r *Roles) *SodConstraintRolesQuery {
+	query := (&SodConstraintRolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, id),
+			sqlgraph.To(sodconstraintroles.Table, sodconstraintroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.SodConstraintRolesTable, roles.SodConstraintRolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// GetX is like Get, but panics if an error occurs.
-func (c *PermissionsClient) GetX(ctx context.Context, id int) *Permissions {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
+// QueryGroupRoles queries the group_roles edge of a Roles.
+func (c *RolesClient) QueryGroupRoles(r *Roles) *GroupRolesQuery {
+	query := (&GroupRolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, id),
+			sqlgraph.To(grouproles.Table, grouproles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.GroupRolesTable, roles.GroupRolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
 	}
-	return obj
+	return query
 }
 
-// QueryRolePermissions queries the role_permissions edge of a Permissions.
-func (c *PermissionsClient) QueryRolePermissions(pe *Permissions) *RolePermissionsQuery {
-	query := (&RolePermissionsClient{config: c.config}).Query()
+// QueryOrgRoles queries the org_roles edge of a Roles.
+func (c *RolesClient) QueryOrgRoles(r *Roles) *OrgRolesQuery {
+	query := (&OrgRolesClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pe.ID
+		id := r.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(permissions.Table, permissions.FieldID, id),
-			sqlgraph.To(rolepermissions.Table, rolepermissions.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, permissions.RolePermissionsTable, permissions.RolePermissionsColumn),
+			sqlgraph.From(roles.Table, roles.FieldID, id),
+			sqlgraph.To(orgroles.Table, orgroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.OrgRolesTable, roles.OrgRolesColumn),
 		)
-		fromV = sqlgraph.Neighbors(pe.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PermissionsClient) Hooks() []Hook {
-	return c.hooks.Permissions
+func (c *RolesClient) Hooks() []Hook {
+	return c.hooks.Roles
 }
 
 // Interceptors returns the client interceptors.
-func (c *PermissionsClient) Interceptors() []Interceptor {
-	return c.inters.Permissions
+func (c *RolesClient) Interceptors() []Interceptor {
+	return c.inters.Roles
 }
 
-func (c *PermissionsClient) mutate(ctx context.Context, m *PermissionsMutation) (Value, error) {
+func (c *RolesClient) mutate(ctx context.Context, m *RolesMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PermissionsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RolesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PermissionsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RolesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PermissionsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RolesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Permissions mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Roles mutation op: %q", m.Op())
 	}
 }
 
-// RolePermissionsClient is a client for the RolePermissions schema.
-type RolePermissionsClient struct {
+// SodConstraintRolesClient is a client for the SodConstraintRoles schema.
+type SodConstraintRolesClient struct {
 	config
 }
 
-// NewRolePermissionsClient returns a client for the RolePermissions from the given config.
-func NewRolePermissionsClient(c config) *RolePermissionsClient {
-	return &RolePermissionsClient{config: c}
+// NewSodConstraintRolesClient returns a client for the SodConstraintRoles from the given config.
+func NewSodConstraintRolesClient(c config) *SodConstraintRolesClient {
+	return &SodConstraintRolesClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `rolepermissions.Hooks(f(g(h())))`.
-func (c *RolePermissionsClient) Use(hooks ...Hook) {
-	c.hooks.RolePermissions = append(c.hooks.RolePermissions, hooks...)
+// A call to `Use(f, g, h)` equals to `sodconstraintroles.Hooks(f(g(h())))`.
+func (c *SodConstraintRolesClient) Use(hooks ...Hook) {
+	c.hooks.SodConstraintRoles = append(c.hooks.SodConstraintRoles, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `rolepermissions.Intercept(f(g(h())))`.
-func (c *RolePermissionsClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RolePermissions = append(c.inters.RolePermissions, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `sodconstraintroles.Intercept(f(g(h())))`.
+func (c *SodConstraintRolesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SodConstraintRoles = append(c.inters.SodConstraintRoles, interceptors...)
 }
 
-// Create returns a builder for creating a RolePermissions entity.
-func (c *RolePermissionsClient) Create() *RolePermissionsCreate {
-	mutation := newRolePermissionsMutation(c.config, OpCreate)
-	return &RolePermissionsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SodConstraintRoles entity.
+func (c *SodConstraintRolesClient) Create() *SodConstraintRolesCreate {
+	mutation := newSodConstraintRolesMutation(c.config, OpCreate)
+	return &SodConstraintRolesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RolePermissions entities.
-func (c *RolePermissionsClient) CreateBulk(builders ...*RolePermissionsCreate) *RolePermissionsCreateBulk {
-	return &RolePermissionsCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SodConstraintRoles entities.
+func (c *SodConstraintRolesClient) CreateBulk(builders ...*SodConstraintRolesCreate) *SodConstraintRolesCreateBulk {
+	return &SodConstraintRolesCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RolePermissionsClient) MapCreateBulk(slice any, setFunc func(*RolePermissionsCreate, int)) *RolePermissionsCreateBulk {
+func (c *SodConstraintRolesClient) MapCreateBulk(slice any, setFunc func(*SodConstraintRolesCreate, int)) *SodConstraintRolesCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RolePermissionsCreateBulk{err: fmt.Errorf("calling to RolePermissionsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SodConstraintRolesCreateBulk{err: fmt.Errorf("calling to SodConstraintRolesClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RolePermissionsCreate, rv.Len())
+	builders := make([]*SodConstraintRolesCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RolePermissionsCreateBulk{config: c.config, builders: builders}
+	return &SodConstraintRolesCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RolePermissions.
-func (c *RolePermissionsClient) Update() *RolePermissionsUpdate {
-	mutation := newRolePermissionsMutation(c.config, OpUpdate)
-	return &RolePermissionsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SodConstraintRoles.
+func (c *SodConstraintRolesClient) Update() *SodConstraintRolesUpdate {
+	mutation := newSodConstraintRolesMutation(c.config, OpUpdate)
+	return &SodConstraintRolesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RolePermissionsClient) UpdateOne(rp *RolePermissions) *RolePermissionsUpdateOne {
-	mutation := newRolePermissionsMutation(c.config, OpUpdateOne, withRolePermissions(rp))
-	return &RolePermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SodConstraintRolesClient) UpdateOne(scr *SodConstraintRoles) *SodConstraintRolesUpdateOne {
+	mutation := newSodConstraintRolesMutation(c.config, OpUpdateOne, withSodConstraintRoles(scr))
+	return &SodConstraintRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RolePermissionsClient) UpdateOneID(id int) *RolePermissionsUpdateOne {
-	mutation := newRolePermissionsMutation(c.config, OpUpdateOne, withRolePermissionsID(id))
-	return &RolePermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SodConstraintRolesClient) UpdateOneID(id int) *SodConstraintRolesUpdateOne {
+	mutation := newSodConstraintRolesMutation(c.config, OpUpdateOne, withSodConstraintRolesID(id))
+	return &SodConstraintRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RolePermissions.
-func (c *RolePermissionsClient) Delete() *RolePermissionsDelete {
-	mutation := newRolePermissionsMutation(c.config, OpDelete)
-	return &RolePermissionsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SodConstraintRoles.
+func (c *SodConstraintRolesClient) Delete() *SodConstraintRolesDelete {
+	mutation := newSodConstraintRolesMutation(c.config, OpDelete)
+	return &SodConstraintRolesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RolePermissionsClient) DeleteOne(rp *RolePermissions) *RolePermissionsDeleteOne {
-	return c.DeleteOneID(rp.ID)
+func (c *SodConstraintRolesClient) DeleteOne(scr *SodConstraintRoles) *SodConstraintRolesDeleteOne {
+	return c.DeleteOneID(scr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RolePermissionsClient) DeleteOneID(id int) *RolePermissionsDeleteOne {
-	builder := c.Delete().Where(rolepermissions.ID(id))
+func (c *SodConstraintRolesClient) DeleteOneID(id int) *SodConstraintRolesDeleteOne {
+	builder := c.Delete().Where(sodconstraintroles.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RolePermissionsDeleteOne{builder}
+	return &SodConstraintRolesDeleteOne{builder}
 }
 
-// Query returns a query builder for RolePermissions.
-func (c *RolePermissionsClient) Query() *RolePermissionsQuery {
-	return &RolePermissionsQuery{
+// Query returns a query builder for SodConstraintRoles.
+func (c *SodConstraintRolesClient) Query() *SodConstraintRolesQuery {
+	return &SodConstraintRolesQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRolePermissions},
+		ctx:    &QueryContext{Type: TypeSodConstraintRoles},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RolePermissions entity by its id.
-func (c *RolePermissionsClient) Get(ctx context.Context, id int) (*RolePermissions, error) {
-	return c.Query().Where(rolepermissions.ID(id)).Only(ctx)
+// Get returns a SodConstraintRoles entity by its id.
+func (c *SodConstraintRolesClient) Get(ctx context.Context, id int) (*SodConstraintRoles, error) {
+	return c.Query().Where(sodconstraintroles.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RolePermissionsClient) GetX(ctx context.Context, id int) *RolePermissions {
+func (c *SodConstraintRolesClient) GetX(ctx context.Context, id int) *SodConstraintRoles {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1061,164 +4262,164 @@ func (c *RolePermissionsClient) GetX(ctx context.Context, id int) *RolePermissio
 	return obj
 }
 
-// QueryRole queries the role edge of a RolePermissions.
-func (c *RolePermissionsClient) QueryRole(rp *RolePermissions) *RolesQuery {
-	query := (&RolesClient{config: c.config}).Query()
+// QuerySodConstraint queries the sod_constraint edge of a SodConstraintRoles.
+func (c *SodConstraintRolesClient) QuerySodConstraint(scr *SodConstraintRoles) *SodConstraintsQuery {
+	query := (&SodConstraintsClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rp.ID
+		id := scr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(rolepermissions.Table, rolepermissions.FieldID, id),
-			sqlgraph.To(roles.Table, roles.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, rolepermissions.RoleTable, rolepermissions.RoleColumn),
+			sqlgraph.From(sodconstraintroles.Table, sodconstraintroles.FieldID, id),
+			sqlgraph.To(sodconstraints.Table, sodconstraints.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, sodconstraintroles.SodConstraintTable, sodconstraintroles.SodConstraintColumn),
 		)
-		fromV = sqlgraph.Neighbors(rp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(scr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryPermission queries the permission edge of a RolePermissions.
-func (c *RolePermissionsClient) QueryPermission(rp *RolePermissions) *PermissionsQuery {
-	query := (&PermissionsClient{config: c.config}).Query()
+// QueryRole queries the role edge of a SodConstraintRoles.
+func (c *SodConstraintRolesClient) QueryRole(scr *SodConstraintRoles) *RolesQuery {
+	query := (&RolesClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rp.ID
+		id := scr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(rolepermissions.Table, rolepermissions.FieldID, id),
-			sqlgraph.To(permissions.Table, permissions.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, rolepermissions.PermissionTable, rolepermissions.PermissionColumn),
+			sqlgraph.From(sodconstraintroles.Table, sodconstraintroles.FieldID, id),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, sodconstraintroles.RoleTable, sodconstraintroles.RoleColumn),
 		)
-		fromV = sqlgraph.Neighbors(rp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(scr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *RolePermissionsClient) Hooks() []Hook {
-	return c.hooks.RolePermissions
+func (c *SodConstraintRolesClient) Hooks() []Hook {
+	return c.hooks.SodConstraintRoles
 }
 
 // Interceptors returns the client interceptors.
-func (c *RolePermissionsClient) Interceptors() []Interceptor {
-	return c.inters.RolePermissions
+func (c *SodConstraintRolesClient) Interceptors() []Interceptor {
+	return c.inters.SodConstraintRoles
 }
 
-func (c *RolePermissionsClient) mutate(ctx context.Context, m *RolePermissionsMutation) (Value, error) {
+func (c *SodConstraintRolesClient) mutate(ctx context.Context, m *SodConstraintRolesMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RolePermissionsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SodConstraintRolesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RolePermissionsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SodConstraintRolesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RolePermissionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SodConstraintRolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RolePermissionsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SodConstraintRolesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RolePermissions mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SodConstraintRoles mutation op: %q", m.Op())
 	}
 }
 
-// RolesClient is a client for the Roles schema.
-type RolesClient struct {
+// SodConstraintsClient is a client for the SodConstraints schema.
+type SodConstraintsClient struct {
 	config
 }
 
-// NewRolesClient returns a client for the Roles from the given config.
-func NewRolesClient(c config) *RolesClient {
-	return &RolesClient{config: c}
+// NewSodConstraintsClient returns a client for the SodConstraints from the given config.
+func NewSodConstraintsClient(c config) *SodConstraintsClient {
+	return &SodConstraintsClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `roles.Hooks(f(g(h())))`.
-func (c *RolesClient) Use(hooks ...Hook) {
-	c.hooks.Roles = append(c.hooks.Roles, hooks...)
+// A call to `Use(f, g, h)` equals to `sodconstraints.Hooks(f(g(h())))`.
+func (c *SodConstraintsClient) Use(hooks ...Hook) {
+	c.hooks.SodConstraints = append(c.hooks.SodConstraints, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `roles.Intercept(f(g(h())))`.
-func (c *RolesClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Roles = append(c.inters.Roles, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `sodconstraints.Intercept(f(g(h())))`.
+func (c *SodConstraintsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SodConstraints = append(c.inters.SodConstraints, interceptors...)
 }
 
-// Create returns a builder for creating a Roles entity.
-func (c *RolesClient) Create() *RolesCreate {
-	mutation := newRolesMutation(c.config, OpCreate)
-	return &RolesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SodConstraints entity.
+func (c *SodConstraintsClient) Create() *SodConstraintsCreate {
+	mutation := newSodConstraintsMutation(c.config, OpCreate)
+	return &SodConstraintsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Roles entities.
-func (c *RolesClient) CreateBulk(builders ...*RolesCreate) *RolesCreateBulk {
-	return &RolesCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SodConstraints entities.
+func (c *SodConstraintsClient) CreateBulk(builders ...*SodConstraintsCreate) *SodConstraintsCreateBulk {
+	return &SodConstraintsCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RolesClient) MapCreateBulk(slice any, setFunc func(*RolesCreate, int)) *RolesCreateBulk {
+func (c *SodConstraintsClient) MapCreateBulk(slice any, setFunc func(*SodConstraintsCreate, int)) *SodConstraintsCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RolesCreateBulk{err: fmt.Errorf("calling to RolesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SodConstraintsCreateBulk{err: fmt.Errorf("calling to SodConstraintsClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RolesCreate, rv.Len())
+	builders := make([]*SodConstraintsCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RolesCreateBulk{config: c.config, builders: builders}
+	return &SodConstraintsCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Roles.
-func (c *RolesClient) Update() *RolesUpdate {
-	mutation := newRolesMutation(c.config, OpUpdate)
-	return &RolesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SodConstraints.
+func (c *SodConstraintsClient) Update() *SodConstraintsUpdate {
+	mutation := newSodConstraintsMutation(c.config, OpUpdate)
+	return &SodConstraintsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RolesClient) UpdateOne(r *Roles) *RolesUpdateOne {
-	mutation := newRolesMutation(c.config, OpUpdateOne, withRoles(r))
-	return &RolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SodConstraintsClient) UpdateOne(sc *SodConstraints) *SodConstraintsUpdateOne {
+	mutation := newSodConstraintsMutation(c.config, OpUpdateOne, withSodConstraints(sc))
+	return &SodConstraintsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RolesClient) UpdateOneID(id int) *RolesUpdateOne {
-	mutation := newRolesMutation(c.config, OpUpdateOne, withRolesID(id))
-	return &RolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SodConstraintsClient) UpdateOneID(id int) *SodConstraintsUpdateOne {
+	mutation := newSodConstraintsMutation(c.config, OpUpdateOne, withSodConstraintsID(id))
+	return &SodConstraintsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Roles.
-func (c *RolesClient) Delete() *RolesDelete {
-	mutation := newRolesMutation(c.config, OpDelete)
-	return &RolesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SodConstraints.
+func (c *SodConstraintsClient) Delete() *SodConstraintsDelete {
+	mutation := newSodConstraintsMutation(c.config, OpDelete)
+	return &SodConstraintsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RolesClient) DeleteOne(r *Roles) *RolesDeleteOne {
-	return c.DeleteOneID(r.ID)
+func (c *SodConstraintsClient) DeleteOne(sc *SodConstraints) *SodConstraintsDeleteOne {
+	return c.DeleteOneID(sc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RolesClient) DeleteOneID(id int) *RolesDeleteOne {
-	builder := c.Delete().Where(roles.ID(id))
+func (c *SodConstraintsClient) DeleteOneID(id int) *SodConstraintsDeleteOne {
+	builder := c.Delete().Where(sodconstraints.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RolesDeleteOne{builder}
+	return &SodConstraintsDeleteOne{builder}
 }
 
-// Query returns a query builder for Roles.
-func (c *RolesClient) Query() *RolesQuery {
-	return &RolesQuery{
+// Query returns a query builder for SodConstraints.
+func (c *SodConstraintsClient) Query() *SodConstraintsQuery {
+	return &SodConstraintsQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRoles},
+		ctx:    &QueryContext{Type: TypeSodConstraints},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Roles entity by its id.
-func (c *RolesClient) Get(ctx context.Context, id int) (*Roles, error) {
-	return c.Query().Where(roles.ID(id)).Only(ctx)
+// Get returns a SodConstraints entity by its id.
+func (c *SodConstraintsClient) Get(ctx context.Context, id int) (*SodConstraints, error) {
+	return c.Query().Where(sodconstraints.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RolesClient) GetX(ctx context.Context, id int) *Roles {
+func (c *SodConstraintsClient) GetX(ctx context.Context, id int) *SodConstraints {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1226,60 +4427,44 @@ func (c *RolesClient) GetX(ctx context.Context, id int) *Roles {
 	return obj
 }
 
-// QueryUserRoles queries the user_roles edge of a Roles.
-func (c *RolesClient) QueryUserRoles(r *Roles) *UserRolesQuery {
-	query := (&UserRolesClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(roles.Table, roles.FieldID, id),
-			sqlgraph.To(userroles.Table, userroles.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, roles.UserRolesTable, roles.UserRolesColumn),
-		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRolePermissions queries the role_permissions edge of a Roles.
-func (c *RolesClient) QueryRolePermissions(r *Roles) *RolePermissionsQuery {
-	query := (&RolePermissionsClient{config: c.config}).Query()
+// QueryConstraintRoles queries the constraint_roles edge of a SodConstraints.
+func (c *SodConstraintsClient) QueryConstraintRoles(sc *SodConstraints) *SodConstraintRolesQuery {
+	query := (&SodConstraintRolesClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
+		id := sc.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(roles.Table, roles.FieldID, id),
-			sqlgraph.To(rolepermissions.Table, rolepermissions.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, roles.RolePermissionsTable, roles.RolePermissionsColumn),
+			sqlgraph.From(sodconstraints.Table, sodconstraints.FieldID, id),
+			sqlgraph.To(sodconstraintroles.Table, sodconstraintroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, sodconstraints.ConstraintRolesTable, sodconstraints.ConstraintRolesColumn),
 		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *RolesClient) Hooks() []Hook {
-	return c.hooks.Roles
+func (c *SodConstraintsClient) Hooks() []Hook {
+	return c.hooks.SodConstraints
 }
 
 // Interceptors returns the client interceptors.
-func (c *RolesClient) Interceptors() []Interceptor {
-	return c.inters.Roles
+func (c *SodConstraintsClient) Interceptors() []Interceptor {
+	return c.inters.SodConstraints
 }
 
-func (c *RolesClient) mutate(ctx context.Context, m *RolesMutation) (Value, error) {
+func (c *SodConstraintsClient) mutate(ctx context.Context, m *SodConstraintsMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RolesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SodConstraintsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RolesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SodConstraintsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RolesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SodConstraintsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RolesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SodConstraintsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Roles mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SodConstraints mutation op: %q", m.Op())
 	}
 }
 
@@ -1572,6 +4757,54 @@ func (c *UsersClient) QueryUserRoles(u *Users) *UserRolesQuery {
 	return query
 }
 
+// QueryGroupMemberships queries the group_memberships edge of a Users.
+func (c *UsersClient) QueryGroupMemberships(u *Users) *GroupMembersQuery {
+	query := (&GroupMembersClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(users.Table, users.FieldID, id),
+			sqlgraph.To(groupmembers.Table, groupmembers.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, users.GroupMembershipsTable, users.GroupMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrgMemberships queries the org_memberships edge of a Users.
+func (c *UsersClient) QueryOrgMemberships(u *Users) *OrgMembersQuery {
+	query := (&OrgMembersClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(users.Table, users.FieldID, id),
+			sqlgraph.To(orgmembers.Table, orgmembers.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, users.OrgMembershipsTable, users.OrgMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrgRoles queries the org_roles edge of a Users.
+func (c *UsersClient) QueryOrgRoles(u *Users) *OrgRolesQuery {
+	query := (&OrgRolesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(users.Table, users.FieldID, id),
+			sqlgraph.To(orgroles.Table, orgroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, users.OrgRolesTable, users.OrgRolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UsersClient) Hooks() []Hook {
 	return c.hooks.Users
@@ -1600,11 +4833,19 @@ func (c *UsersClient) mutate(ctx context.Context, m *UsersMutation) (Value, erro
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuditLogs, EmailLogs, EmailVerifications, PasswordResets, Permissions,
-		RolePermissions, Roles, UserRoles, Users []ent.Hook
+		APIKeys, AuditLogs, ConsentAcceptances, ConsentDocuments, EmailChanges,
+		EmailLogs, EmailVerifications, GroupMembers, GroupParents, GroupRoles, Groups,
+		Invitations, OrgMembers, OrgRoles, Organizations, PasswordHistories,
+		PasswordResets, Permissions, RelationTuples, RoleApprovers, RoleParents,
+		RolePermissions, RoleRequests, Roles, SodConstraintRoles, SodConstraints,
+		UserRoles, Users []ent.Hook
 	}
 	inters struct {
-		AuditLogs, EmailLogs, EmailVerifications, PasswordResets, Permissions,
-		RolePermissions, Roles, UserRoles, Users []ent.Interceptor
+		APIKeys, AuditLogs, ConsentAcceptances, ConsentDocuments, EmailChanges,
+		EmailLogs, EmailVerifications, GroupMembers, GroupParents, GroupRoles, Groups,
+		Invitations, OrgMembers, OrgRoles, Organizations, PasswordHistories,
+		PasswordResets, Permissions, RelationTuples, RoleApprovers, RoleParents,
+		RolePermissions, RoleRequests, Roles, SodConstraintRoles, SodConstraints,
+		UserRoles, Users []ent.Interceptor
 	}
 )
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/consentacceptances"
+)
+
+// ConsentAcceptances is the model entity for the ConsentAcceptances schema.
+type ConsentAcceptances struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// DocumentID holds the value of the "document_id" field.
+	DocumentID uuid.UUID `json:"document_id,omitempty"`
+	// DocumentType holds the value of the "document_type" field.
+	DocumentType string `json:"document_type,omitempty"`
+	// Version holds the value of the "version" field.
+	Version string `json:"version,omitempty"`
+	// IPAddress holds the value of the "ip_address" field.
+	IPAddress string `json:"ip_address,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// AcceptedAt holds the value of the "accepted_at" field.
+	AcceptedAt   time.Time `json:"accepted_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConsentAcceptances) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case consentacceptances.FieldDocumentType, consentacceptances.FieldVersion, consentacceptances.FieldIPAddress, consentacceptances.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case consentacceptances.FieldAcceptedAt:
+			values[i] = new(sql.NullTime)
+		case consentacceptances.FieldID, consentacceptances.FieldUserID, consentacceptances.FieldDocumentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConsentAcceptances fields.
+func (ca *ConsentAcceptances) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case consentacceptances.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ca.ID = *value
+			}
+		case consentacceptances.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ca.UserID = *value
+			}
+		case consentacceptances.FieldDocumentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value != nil {
+				ca.DocumentID = *value
+			}
+		case consentacceptances.FieldDocumentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_type", values[i])
+			} else if value.Valid {
+				ca.DocumentType = value.String
+			}
+		case consentacceptances.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				ca.Version = value.String
+			}
+		case consentacceptances.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				ca.IPAddress = value.String
+			}
+		case consentacceptances.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				ca.UserAgent = value.String
+			}
+		case consentacceptances.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				ca.AcceptedAt = value.Time
+			}
+		default:
+			ca.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConsentAcceptances.
+// This includes values selected through modifiers, order, etc.
+func (ca *ConsentAcceptances) Value(name string) (ent.Value, error) {
+	return ca.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConsentAcceptances.
+// Note that you need to call ConsentAcceptances.Unwrap() before calling this method if this ConsentAcceptances
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ca *ConsentAcceptances) Update() *ConsentAcceptancesUpdateOne {
+	return NewConsentAcceptancesClient(ca.config).UpdateOne(ca)
+}
+
+// Unwrap unwraps the ConsentAcceptances entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ca *ConsentAcceptances) Unwrap() *ConsentAcceptances {
+	_tx, ok := ca.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConsentAcceptances is not a transactional entity")
+	}
+	ca.config.driver = _tx.drv
+	return ca
+}
+
+// String implements the fmt.Stringer.
+func (ca *ConsentAcceptances) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConsentAcceptances(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ca.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ca.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("document_id=")
+	builder.WriteString(fmt.Sprintf("%v", ca.DocumentID))
+	builder.WriteString(", ")
+	builder.WriteString("document_type=")
+	builder.WriteString(ca.DocumentType)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(ca.Version)
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(ca.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(ca.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("accepted_at=")
+	builder.WriteString(ca.AcceptedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConsentAcceptancesSlice is a parsable slice of ConsentAcceptances.
+type ConsentAcceptancesSlice []*ConsentAcceptances
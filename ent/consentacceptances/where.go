@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package consentacceptances
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldUserID, v))
+}
+
+// DocumentID applies equality check predicate on the "document_id" field. It's identical to DocumentIDEQ.
+func DocumentID(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentType applies equality check predicate on the "document_type" field. It's identical to DocumentTypeEQ.
+func DocumentType(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldDocumentType, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldVersion, v))
+}
+
+// IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
+func IPAddress(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// AcceptedAt applies equality check predicate on the "accepted_at" field. It's identical to AcceptedAtEQ.
+func AcceptedAt(v time.Time) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLTE(FieldUserID, v))
+}
+
+// DocumentIDEQ applies the EQ predicate on the "document_id" field.
+func DocumentIDEQ(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentIDNEQ applies the NEQ predicate on the "document_id" field.
+func DocumentIDNEQ(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNEQ(FieldDocumentID, v))
+}
+
+// DocumentIDIn applies the In predicate on the "document_id" field.
+func DocumentIDIn(vs ...uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDNotIn applies the NotIn predicate on the "document_id" field.
+func DocumentIDNotIn(vs ...uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNotIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDGT applies the GT predicate on the "document_id" field.
+func DocumentIDGT(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGT(FieldDocumentID, v))
+}
+
+// DocumentIDGTE applies the GTE predicate on the "document_id" field.
+func DocumentIDGTE(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGTE(FieldDocumentID, v))
+}
+
+// DocumentIDLT applies the LT predicate on the "document_id" field.
+func DocumentIDLT(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLT(FieldDocumentID, v))
+}
+
+// DocumentIDLTE applies the LTE predicate on the "document_id" field.
+func DocumentIDLTE(v uuid.UUID) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLTE(FieldDocumentID, v))
+}
+
+// DocumentTypeEQ applies the EQ predicate on the "document_type" field.
+func DocumentTypeEQ(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldDocumentType, v))
+}
+
+// DocumentTypeNEQ applies the NEQ predicate on the "document_type" field.
+func DocumentTypeNEQ(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNEQ(FieldDocumentType, v))
+}
+
+// DocumentTypeIn applies the In predicate on the "document_type" field.
+func DocumentTypeIn(vs ...string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldIn(FieldDocumentType, vs...))
+}
+
+// DocumentTypeNotIn applies the NotIn predicate on the "document_type" field.
+func DocumentTypeNotIn(vs ...string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNotIn(FieldDocumentType, vs...))
+}
+
+// DocumentTypeGT applies the GT predicate on the "document_type" field.
+func DocumentTypeGT(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGT(FieldDocumentType, v))
+}
+
+// DocumentTypeGTE applies the GTE predicate on the "document_type" field.
+func DocumentTypeGTE(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGTE(FieldDocumentType, v))
+}
+
+// DocumentTypeLT applies the LT predicate on the "document_type" field.
+func DocumentTypeLT(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLT(FieldDocumentType, v))
+}
+
+// DocumentTypeLTE applies the LTE predicate on the "document_type" field.
+func DocumentTypeLTE(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLTE(FieldDocumentType, v))
+}
+
+// DocumentTypeContains applies the Contains predicate on the "document_type" field.
+func DocumentTypeContains(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldContains(FieldDocumentType, v))
+}
+
+// DocumentTypeHasPrefix applies the HasPrefix predicate on the "document_type" field.
+func DocumentTypeHasPrefix(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldHasPrefix(FieldDocumentType, v))
+}
+
+// DocumentTypeHasSuffix applies the HasSuffix predicate on the "document_type" field.
+func DocumentTypeHasSuffix(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldHasSuffix(FieldDocumentType, v))
+}
+
+// DocumentTypeEqualFold applies the EqualFold predicate on the "document_type" field.
+func DocumentTypeEqualFold(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEqualFold(FieldDocumentType, v))
+}
+
+// DocumentTypeContainsFold applies the ContainsFold predicate on the "document_type" field.
+func DocumentTypeContainsFold(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldContainsFold(FieldDocumentType, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// IPAddressEQ applies the EQ predicate on the "ip_address" field.
+func IPAddressEQ(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// IPAddressNEQ applies the NEQ predicate on the "ip_address" field.
+func IPAddressNEQ(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNEQ(FieldIPAddress, v))
+}
+
+// IPAddressIn applies the In predicate on the "ip_address" field.
+func IPAddressIn(vs ...string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldIn(FieldIPAddress, vs...))
+}
+
+// IPAddressNotIn applies the NotIn predicate on the "ip_address" field.
+func IPAddressNotIn(vs ...string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNotIn(FieldIPAddress, vs...))
+}
+
+// IPAddressGT applies the GT predicate on the "ip_address" field.
+func IPAddressGT(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGT(FieldIPAddress, v))
+}
+
+// IPAddressGTE applies the GTE predicate on the "ip_address" field.
+func IPAddressGTE(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGTE(FieldIPAddress, v))
+}
+
+// IPAddressLT applies the LT predicate on the "ip_address" field.
+func IPAddressLT(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLT(FieldIPAddress, v))
+}
+
+// IPAddressLTE applies the LTE predicate on the "ip_address" field.
+func IPAddressLTE(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLTE(FieldIPAddress, v))
+}
+
+// IPAddressContains applies the Contains predicate on the "ip_address" field.
+func IPAddressContains(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldContains(FieldIPAddress, v))
+}
+
+// IPAddressHasPrefix applies the HasPrefix predicate on the "ip_address" field.
+func IPAddressHasPrefix(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldHasPrefix(FieldIPAddress, v))
+}
+
+// IPAddressHasSuffix applies the HasSuffix predicate on the "ip_address" field.
+func IPAddressHasSuffix(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldHasSuffix(FieldIPAddress, v))
+}
+
+// IPAddressIsNil applies the IsNil predicate on the "ip_address" field.
+func IPAddressIsNil() predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldIsNull(FieldIPAddress))
+}
+
+// IPAddressNotNil applies the NotNil predicate on the "ip_address" field.
+func IPAddressNotNil() predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNotNull(FieldIPAddress))
+}
+
+// IPAddressEqualFold applies the EqualFold predicate on the "ip_address" field.
+func IPAddressEqualFold(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEqualFold(FieldIPAddress, v))
+}
+
+// IPAddressContainsFold applies the ContainsFold predicate on the "ip_address" field.
+func IPAddressContainsFold(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldContainsFold(FieldIPAddress, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentIsNil applies the IsNil predicate on the "user_agent" field.
+func UserAgentIsNil() predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldIsNull(FieldUserAgent))
+}
+
+// UserAgentNotNil applies the NotNil predicate on the "user_agent" field.
+func UserAgentNotNil() predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNotNull(FieldUserAgent))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// AcceptedAtEQ applies the EQ predicate on the "accepted_at" field.
+func AcceptedAtEQ(v time.Time) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtNEQ applies the NEQ predicate on the "accepted_at" field.
+func AcceptedAtNEQ(v time.Time) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIn applies the In predicate on the "accepted_at" field.
+func AcceptedAtIn(vs ...time.Time) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtNotIn applies the NotIn predicate on the "accepted_at" field.
+func AcceptedAtNotIn(vs ...time.Time) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldNotIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtGT applies the GT predicate on the "accepted_at" field.
+func AcceptedAtGT(v time.Time) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtGTE applies the GTE predicate on the "accepted_at" field.
+func AcceptedAtGTE(v time.Time) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldGTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLT applies the LT predicate on the "accepted_at" field.
+func AcceptedAtLT(v time.Time) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLTE applies the LTE predicate on the "accepted_at" field.
+func AcceptedAtLTE(v time.Time) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.FieldLTE(FieldAcceptedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConsentAcceptances) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConsentAcceptances) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConsentAcceptances) predicate.ConsentAcceptances {
+	return predicate.ConsentAcceptances(sql.NotPredicates(p))
+}
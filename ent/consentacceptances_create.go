@@ -0,0 +1,621 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/consentacceptances"
+)
+
+// ConsentAcceptancesCreate is the builder for creating a ConsentAcceptances entity.
+type ConsentAcceptancesCreate struct {
+	config
+	mutation *ConsentAcceptancesMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (cac *ConsentAcceptancesCreate) SetUserID(u uuid.UUID) *ConsentAcceptancesCreate {
+	cac.mutation.SetUserID(u)
+	return cac
+}
+
+// SetDocumentID sets the "document_id" field.
+func (cac *ConsentAcceptancesCreate) SetDocumentID(u uuid.UUID) *ConsentAcceptancesCreate {
+	cac.mutation.SetDocumentID(u)
+	return cac
+}
+
+// SetDocumentType sets the "document_type" field.
+func (cac *ConsentAcceptancesCreate) SetDocumentType(s string) *ConsentAcceptancesCreate {
+	cac.mutation.SetDocumentType(s)
+	return cac
+}
+
+// SetVersion sets the "version" field.
+func (cac *ConsentAcceptancesCreate) SetVersion(s string) *ConsentAcceptancesCreate {
+	cac.mutation.SetVersion(s)
+	return cac
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (cac *ConsentAcceptancesCreate) SetIPAddress(s string) *ConsentAcceptancesCreate {
+	cac.mutation.SetIPAddress(s)
+	return cac
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (cac *ConsentAcceptancesCreate) SetNillableIPAddress(s *string) *ConsentAcceptancesCreate {
+	if s != nil {
+		cac.SetIPAddress(*s)
+	}
+	return cac
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (cac *ConsentAcceptancesCreate) SetUserAgent(s string) *ConsentAcceptancesCreate {
+	cac.mutation.SetUserAgent(s)
+	return cac
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (cac *ConsentAcceptancesCreate) SetNillableUserAgent(s *string) *ConsentAcceptancesCreate {
+	if s != nil {
+		cac.SetUserAgent(*s)
+	}
+	return cac
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (cac *ConsentAcceptancesCreate) SetAcceptedAt(t time.Time) *ConsentAcceptancesCreate {
+	cac.mutation.SetAcceptedAt(t)
+	return cac
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (cac *ConsentAcceptancesCreate) SetNillableAcceptedAt(t *time.Time) *ConsentAcceptancesCreate {
+	if t != nil {
+		cac.SetAcceptedAt(*t)
+	}
+	return cac
+}
+
+// SetID sets the "id" field.
+func (cac *ConsentAcceptancesCreate) SetID(u uuid.UUID) *ConsentAcceptancesCreate {
+	cac.mutation.SetID(u)
+	return cac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cac *ConsentAcceptancesCreate) SetNillableID(u *uuid.UUID) *ConsentAcceptancesCreate {
+	if u != nil {
+		cac.SetID(*u)
+	}
+	return cac
+}
+
+// Mutation returns the ConsentAcceptancesMutation object of the builder.
+func (cac *ConsentAcceptancesCreate) Mutation() *ConsentAcceptancesMutation {
+	return cac.mutation
+}
+
+// Save creates the ConsentAcceptances in the database.
+func (cac *ConsentAcceptancesCreate) Save(ctx context.Context) (*ConsentAcceptances, error) {
+	cac.defaults()
+	return withHooks(ctx, cac.sqlSave, cac.mutation, cac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cac *ConsentAcceptancesCreate) SaveX(ctx context.Context) *ConsentAcceptances {
+	v, err := cac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cac *ConsentAcceptancesCreate) Exec(ctx context.Context) error {
+	_, err := cac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cac *ConsentAcceptancesCreate) ExecX(ctx context.Context) {
+	if err := cac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cac *ConsentAcceptancesCreate) defaults() {
+	if _, ok := cac.mutation.AcceptedAt(); !ok {
+		v := consentacceptances.DefaultAcceptedAt()
+		cac.mutation.SetAcceptedAt(v)
+	}
+	if _, ok := cac.mutation.ID(); !ok {
+		v := consentacceptances.DefaultID()
+		cac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cac *ConsentAcceptancesCreate) check() error {
+	if _, ok := cac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ConsentAcceptances.user_id"`)}
+	}
+	if _, ok := cac.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "ConsentAcceptances.document_id"`)}
+	}
+	if _, ok := cac.mutation.DocumentType(); !ok {
+		return &ValidationError{Name: "document_type", err: errors.New(`ent: missing required field "ConsentAcceptances.document_type"`)}
+	}
+	if v, ok := cac.mutation.DocumentType(); ok {
+		if err := consentacceptances.DocumentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "document_type", err: fmt.Errorf(`ent: validator failed for field "ConsentAcceptances.document_type": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ConsentAcceptances.version"`)}
+	}
+	if v, ok := cac.mutation.Version(); ok {
+		if err := consentacceptances.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ConsentAcceptances.version": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.AcceptedAt(); !ok {
+		return &ValidationError{Name: "accepted_at", err: errors.New(`ent: missing required field "ConsentAcceptances.accepted_at"`)}
+	}
+	return nil
+}
+
+func (cac *ConsentAcceptancesCreate) sqlSave(ctx context.Context) (*ConsentAcceptances, error) {
+	if err := cac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cac.mutation.id = &_node.ID
+	cac.mutation.done = true
+	return _node, nil
+}
+
+func (cac *ConsentAcceptancesCreate) createSpec() (*ConsentAcceptances, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConsentAcceptances{config: cac.config}
+		_spec = sqlgraph.NewCreateSpec(consentacceptances.Table, sqlgraph.NewFieldSpec(consentacceptances.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = cac.conflict
+	if id, ok := cac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cac.mutation.UserID(); ok {
+		_spec.SetField(consentacceptances.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := cac.mutation.DocumentID(); ok {
+		_spec.SetField(consentacceptances.FieldDocumentID, field.TypeUUID, value)
+		_node.DocumentID = value
+	}
+	if value, ok := cac.mutation.DocumentType(); ok {
+		_spec.SetField(consentacceptances.FieldDocumentType, field.TypeString, value)
+		_node.DocumentType = value
+	}
+	if value, ok := cac.mutation.Version(); ok {
+		_spec.SetField(consentacceptances.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := cac.mutation.IPAddress(); ok {
+		_spec.SetField(consentacceptances.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := cac.mutation.UserAgent(); ok {
+		_spec.SetField(consentacceptances.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := cac.mutation.AcceptedAt(); ok {
+		_spec.SetField(consentacceptances.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConsentAcceptances.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConsentAcceptancesUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (cac *ConsentAcceptancesCreate) OnConflict(opts ...sql.ConflictOption) *ConsentAcceptancesUpsertOne {
+	cac.conflict = opts
+	return &ConsentAcceptancesUpsertOne{
+		create: cac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConsentAcceptances.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cac *ConsentAcceptancesCreate) OnConflictColumns(columns ...string) *ConsentAcceptancesUpsertOne {
+	cac.conflict = append(cac.conflict, sql.ConflictColumns(columns...))
+	return &ConsentAcceptancesUpsertOne{
+		create: cac,
+	}
+}
+
+type (
+	// ConsentAcceptancesUpsertOne is the builder for "upsert"-ing
+	//  one ConsentAcceptances node.
+	ConsentAcceptancesUpsertOne struct {
+		create *ConsentAcceptancesCreate
+	}
+
+	// ConsentAcceptancesUpsert is the "OnConflict" setter.
+	ConsentAcceptancesUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ConsentAcceptances.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(consentacceptances.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConsentAcceptancesUpsertOne) UpdateNewValues() *ConsentAcceptancesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(consentacceptances.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(consentacceptances.FieldUserID)
+		}
+		if _, exists := u.create.mutation.DocumentID(); exists {
+			s.SetIgnore(consentacceptances.FieldDocumentID)
+		}
+		if _, exists := u.create.mutation.DocumentType(); exists {
+			s.SetIgnore(consentacceptances.FieldDocumentType)
+		}
+		if _, exists := u.create.mutation.Version(); exists {
+			s.SetIgnore(consentacceptances.FieldVersion)
+		}
+		if _, exists := u.create.mutation.IPAddress(); exists {
+			s.SetIgnore(consentacceptances.FieldIPAddress)
+		}
+		if _, exists := u.create.mutation.UserAgent(); exists {
+			s.SetIgnore(consentacceptances.FieldUserAgent)
+		}
+		if _, exists := u.create.mutation.AcceptedAt(); exists {
+			s.SetIgnore(consentacceptances.FieldAcceptedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConsentAcceptances.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ConsentAcceptancesUpsertOne) Ignore() *ConsentAcceptancesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConsentAcceptancesUpsertOne) DoNothing() *ConsentAcceptancesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConsentAcceptancesCreate.OnConflict
+// documentation for more info.
+func (u *ConsentAcceptancesUpsertOne) Update(set func(*ConsentAcceptancesUpsert)) *ConsentAcceptancesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConsentAcceptancesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ConsentAcceptancesUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConsentAcceptancesCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConsentAcceptancesUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ConsentAcceptancesUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ConsentAcceptancesUpsertOne.ID is not supported by MySQL driver. Use ConsentAcceptancesUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ConsentAcceptancesUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ConsentAcceptancesCreateBulk is the builder for creating many ConsentAcceptances entities in bulk.
+type ConsentAcceptancesCreateBulk struct {
+	config
+	err      error
+	builders []*ConsentAcceptancesCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ConsentAcceptances entities in the database.
+func (cacb *ConsentAcceptancesCreateBulk) Save(ctx context.Context) ([]*ConsentAcceptances, error) {
+	if cacb.err != nil {
+		return nil, cacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cacb.builders))
+	nodes := make([]*ConsentAcceptances, len(cacb.builders))
+	mutators := make([]Mutator, len(cacb.builders))
+	for i := range cacb.builders {
+		func(i int, root context.Context) {
+			builder := cacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConsentAcceptancesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cacb *ConsentAcceptancesCreateBulk) SaveX(ctx context.Context) []*ConsentAcceptances {
+	v, err := cacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cacb *ConsentAcceptancesCreateBulk) Exec(ctx context.Context) error {
+	_, err := cacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cacb *ConsentAcceptancesCreateBulk) ExecX(ctx context.Context) {
+	if err := cacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConsentAcceptances.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConsentAcceptancesUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (cacb *ConsentAcceptancesCreateBulk) OnConflict(opts ...sql.ConflictOption) *ConsentAcceptancesUpsertBulk {
+	cacb.conflict = opts
+	return &ConsentAcceptancesUpsertBulk{
+		create: cacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConsentAcceptances.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cacb *ConsentAcceptancesCreateBulk) OnConflictColumns(columns ...string) *ConsentAcceptancesUpsertBulk {
+	cacb.conflict = append(cacb.conflict, sql.ConflictColumns(columns...))
+	return &ConsentAcceptancesUpsertBulk{
+		create: cacb,
+	}
+}
+
+// ConsentAcceptancesUpsertBulk is the builder for "upsert"-ing
+// a bulk of ConsentAcceptances nodes.
+type ConsentAcceptancesUpsertBulk struct {
+	create *ConsentAcceptancesCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ConsentAcceptances.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(consentacceptances.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConsentAcceptancesUpsertBulk) UpdateNewValues() *ConsentAcceptancesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(consentacceptances.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(consentacceptances.FieldUserID)
+			}
+			if _, exists := b.mutation.DocumentID(); exists {
+				s.SetIgnore(consentacceptances.FieldDocumentID)
+			}
+			if _, exists := b.mutation.DocumentType(); exists {
+				s.SetIgnore(consentacceptances.FieldDocumentType)
+			}
+			if _, exists := b.mutation.Version(); exists {
+				s.SetIgnore(consentacceptances.FieldVersion)
+			}
+			if _, exists := b.mutation.IPAddress(); exists {
+				s.SetIgnore(consentacceptances.FieldIPAddress)
+			}
+			if _, exists := b.mutation.UserAgent(); exists {
+				s.SetIgnore(consentacceptances.FieldUserAgent)
+			}
+			if _, exists := b.mutation.AcceptedAt(); exists {
+				s.SetIgnore(consentacceptances.FieldAcceptedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConsentAcceptances.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ConsentAcceptancesUpsertBulk) Ignore() *ConsentAcceptancesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConsentAcceptancesUpsertBulk) DoNothing() *ConsentAcceptancesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConsentAcceptancesCreateBulk.OnConflict
+// documentation for more info.
+func (u *ConsentAcceptancesUpsertBulk) Update(set func(*ConsentAcceptancesUpsert)) *ConsentAcceptancesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConsentAcceptancesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ConsentAcceptancesUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ConsentAcceptancesCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConsentAcceptancesCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConsentAcceptancesUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/consentacceptances"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ConsentAcceptancesDelete is the builder for deleting a ConsentAcceptances entity.
+type ConsentAcceptancesDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConsentAcceptancesMutation
+}
+
+// Where appends a list predicates to the ConsentAcceptancesDelete builder.
+func (cad *ConsentAcceptancesDelete) Where(ps ...predicate.ConsentAcceptances) *ConsentAcceptancesDelete {
+	cad.mutation.Where(ps...)
+	return cad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cad *ConsentAcceptancesDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cad.sqlExec, cad.mutation, cad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cad *ConsentAcceptancesDelete) ExecX(ctx context.Context) int {
+	n, err := cad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cad *ConsentAcceptancesDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(consentacceptances.Table, sqlgraph.NewFieldSpec(consentacceptances.FieldID, field.TypeUUID))
+	if ps := cad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cad.mutation.done = true
+	return affected, err
+}
+
+// ConsentAcceptancesDeleteOne is the builder for deleting a single ConsentAcceptances entity.
+type ConsentAcceptancesDeleteOne struct {
+	cad *ConsentAcceptancesDelete
+}
+
+// Where appends a list predicates to the ConsentAcceptancesDelete builder.
+func (cado *ConsentAcceptancesDeleteOne) Where(ps ...predicate.ConsentAcceptances) *ConsentAcceptancesDeleteOne {
+	cado.cad.mutation.Where(ps...)
+	return cado
+}
+
+// Exec executes the deletion query.
+func (cado *ConsentAcceptancesDeleteOne) Exec(ctx context.Context) error {
+	n, err := cado.cad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{consentacceptances.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cado *ConsentAcceptancesDeleteOne) ExecX(ctx context.Context) {
+	if err := cado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/consentacceptances"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ConsentAcceptancesQuery is the builder for querying ConsentAcceptances entities.
+type ConsentAcceptancesQuery struct {
+	config
+	ctx        *QueryContext
+	order      []consentacceptances.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ConsentAcceptances
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConsentAcceptancesQuery builder.
+func (caq *ConsentAcceptancesQuery) Where(ps ...predicate.ConsentAcceptances) *ConsentAcceptancesQuery {
+	caq.predicates = append(caq.predicates, ps...)
+	return caq
+}
+
+// Limit the number of records to be returned by this query.
+func (caq *ConsentAcceptancesQuery) Limit(limit int) *ConsentAcceptancesQuery {
+	caq.ctx.Limit = &limit
+	return caq
+}
+
+// Offset to start from.
+func (caq *ConsentAcceptancesQuery) Offset(offset int) *ConsentAcceptancesQuery {
+	caq.ctx.Offset = &offset
+	return caq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (caq *ConsentAcceptancesQuery) Unique(unique bool) *ConsentAcceptancesQuery {
+	caq.ctx.Unique = &unique
+	return caq
+}
+
+// Order specifies how the records should be ordered.
+func (caq *ConsentAcceptancesQuery) Order(o ...consentacceptances.OrderOption) *ConsentAcceptancesQuery {
+	caq.order = append(caq.order, o...)
+	return caq
+}
+
+// First returns the first ConsentAcceptances entity from the query.
+// Returns a *NotFoundError when no ConsentAcceptances was found.
+func (caq *ConsentAcceptancesQuery) First(ctx context.Context) (*ConsentAcceptances, error) {
+	nodes, err := caq.Limit(1).All(setContextOp(ctx, caq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{consentacceptances.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (caq *ConsentAcceptancesQuery) FirstX(ctx context.Context) *ConsentAcceptances {
+	node, err := caq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConsentAcceptances ID from the query.
+// Returns a *NotFoundError when no ConsentAcceptances ID was found.
+func (caq *ConsentAcceptancesQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = caq.Limit(1).IDs(setContextOp(ctx, caq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{consentacceptances.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (caq *ConsentAcceptancesQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := caq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConsentAcceptances entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConsentAcceptances entity is found.
+// Returns a *NotFoundError when no ConsentAcceptances entities are found.
+func (caq *ConsentAcceptancesQuery) Only(ctx context.Context) (*ConsentAcceptances, error) {
+	nodes, err := caq.Limit(2).All(setContextOp(ctx, caq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{consentacceptances.Label}
+	default:
+		return nil, &NotSingularError{consentacceptances.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (caq *ConsentAcceptancesQuery) OnlyX(ctx context.Context) *ConsentAcceptances {
+	node, err := caq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConsentAcceptances ID in the query.
+// Returns a *NotSingularError when more than one ConsentAcceptances ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (caq *ConsentAcceptancesQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = caq.Limit(2).IDs(setContextOp(ctx, caq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{consentacceptances.Label}
+	default:
+		err = &NotSingularError{consentacceptances.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (caq *ConsentAcceptancesQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := caq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConsentAcceptancesSlice.
+func (caq *ConsentAcceptancesQuery) All(ctx context.Context) ([]*ConsentAcceptances, error) {
+	ctx = setContextOp(ctx, caq.ctx, "All")
+	if err := caq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ConsentAcceptances, *ConsentAcceptancesQuery]()
+	return withInterceptors[[]*ConsentAcceptances](ctx, caq, qr, caq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (caq *ConsentAcceptancesQuery) AllX(ctx context.Context) []*ConsentAcceptances {
+	nodes, err := caq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConsentAcceptances IDs.
+func (caq *ConsentAcceptancesQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if caq.ctx.Unique == nil && caq.path != nil {
+		caq.Unique(true)
+	}
+	ctx = setContextOp(ctx, caq.ctx, "IDs")
+	if err = caq.Select(consentacceptances.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (caq *ConsentAcceptancesQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := caq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (caq *ConsentAcceptancesQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, caq.ctx, "Count")
+	if err := caq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, caq, querierCount[*ConsentAcceptancesQuery](), caq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (caq *ConsentAcceptancesQuery) CountX(ctx context.Context) int {
+	count, err := caq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (caq *ConsentAcceptancesQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, caq.ctx, "Exist")
+	switch _, err := caq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (caq *ConsentAcceptancesQuery) ExistX(ctx context.Context) bool {
+	exist, err := caq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConsentAcceptancesQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (caq *ConsentAcceptancesQuery) Clone() *ConsentAcceptancesQuery {
+	if caq == nil {
+		return nil
+	}
+	return &ConsentAcceptancesQuery{
+		config:     caq.config,
+		ctx:        caq.ctx.Clone(),
+		order:      append([]consentacceptances.OrderOption{}, caq.order...),
+		inters:     append([]Interceptor{}, caq.inters...),
+		predicates: append([]predicate.ConsentAcceptances{}, caq.predicates...),
+		// clone intermediate query.
+		sql:  caq.sql.Clone(),
+		path: caq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConsentAcceptances.Query().
+//		GroupBy(consentacceptances.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (caq *ConsentAcceptancesQuery) GroupBy(field string, fields ...string) *ConsentAcceptancesGroupBy {
+	caq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ConsentAcceptancesGroupBy{build: caq}
+	grbuild.flds = &caq.ctx.Fields
+	grbuild.label = consentacceptances.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.ConsentAcceptances.Query().
+//		Select(consentacceptances.FieldUserID).
+//		Scan(ctx, &v)
+func (caq *ConsentAcceptancesQuery) Select(fields ...string) *ConsentAcceptancesSelect {
+	caq.ctx.Fields = append(caq.ctx.Fields, fields...)
+	sbuild := &ConsentAcceptancesSelect{ConsentAcceptancesQuery: caq}
+	sbuild.label = consentacceptances.Label
+	sbuild.flds, sbuild.scan = &caq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ConsentAcceptancesSelect configured with the given aggregations.
+func (caq *ConsentAcceptancesQuery) Aggregate(fns ...AggregateFunc) *ConsentAcceptancesSelect {
+	return caq.Select().Aggregate(fns...)
+}
+
+func (caq *ConsentAcceptancesQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range caq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, caq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range caq.ctx.Fields {
+		if !consentacceptances.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if caq.path != nil {
+		prev, err := caq.path(ctx)
+		if err != nil {
+			return err
+		}
+		caq.sql = prev
+	}
+	return nil
+}
+
+func (caq *ConsentAcceptancesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ConsentAcceptances, error) {
+	var (
+		nodes = []*ConsentAcceptances{}
+		_spec = caq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ConsentAcceptances).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ConsentAcceptances{config: caq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(caq.modifiers) > 0 {
+		_spec.Modifiers = caq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, caq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (caq *ConsentAcceptancesQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := caq.querySpec()
+	if len(caq.modifiers) > 0 {
+		_spec.Modifiers = caq.modifiers
+	}
+	_spec.Node.Columns = caq.ctx.Fields
+	if len(caq.ctx.Fields) > 0 {
+		_spec.Unique = caq.ctx.Unique != nil && *caq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, caq.driver, _spec)
+}
+
+func (caq *ConsentAcceptancesQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(consentacceptances.Table, consentacceptances.Columns, sqlgraph.NewFieldSpec(consentacceptances.FieldID, field.TypeUUID))
+	_spec.From = caq.sql
+	if unique := caq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if caq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := caq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consentacceptances.FieldID)
+		for i := range fields {
+			if fields[i] != consentacceptances.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := caq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := caq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := caq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := caq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (caq *ConsentAcceptancesQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(caq.driver.Dialect())
+	t1 := builder.Table(consentacceptances.Table)
+	columns := caq.ctx.Fields
+	if len(columns) == 0 {
+		columns = consentacceptances.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if caq.sql != nil {
+		selector = caq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if caq.ctx.Unique != nil && *caq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range caq.modifiers {
+		m(selector)
+	}
+	for _, p := range caq.predicates {
+		p(selector)
+	}
+	for _, p := range caq.order {
+		p(selector)
+	}
+	if offset := caq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := caq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (caq *ConsentAcceptancesQuery) ForUpdate(opts ...sql.LockOption) *ConsentAcceptancesQuery {
+	if caq.driver.Dialect() == dialect.Postgres {
+		caq.Unique(false)
+	}
+	caq.modifiers = append(caq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return caq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (caq *ConsentAcceptancesQuery) ForShare(opts ...sql.LockOption) *ConsentAcceptancesQuery {
+	if caq.driver.Dialect() == dialect.Postgres {
+		caq.Unique(false)
+	}
+	caq.modifiers = append(caq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return caq
+}
+
+// ConsentAcceptancesGroupBy is the group-by builder for ConsentAcceptances entities.
+type ConsentAcceptancesGroupBy struct {
+	selector
+	build *ConsentAcceptancesQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cagb *ConsentAcceptancesGroupBy) Aggregate(fns ...AggregateFunc) *ConsentAcceptancesGroupBy {
+	cagb.fns = append(cagb.fns, fns...)
+	return cagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cagb *ConsentAcceptancesGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cagb.build.ctx, "GroupBy")
+	if err := cagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConsentAcceptancesQuery, *ConsentAcceptancesGroupBy](ctx, cagb.build, cagb, cagb.build.inters, v)
+}
+
+func (cagb *ConsentAcceptancesGroupBy) sqlScan(ctx context.Context, root *ConsentAcceptancesQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cagb.fns))
+	for _, fn := range cagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cagb.flds)+len(cagb.fns))
+		for _, f := range *cagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ConsentAcceptancesSelect is the builder for selecting fields of ConsentAcceptances entities.
+type ConsentAcceptancesSelect struct {
+	*ConsentAcceptancesQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cas *ConsentAcceptancesSelect) Aggregate(fns ...AggregateFunc) *ConsentAcceptancesSelect {
+	cas.fns = append(cas.fns, fns...)
+	return cas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cas *ConsentAcceptancesSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cas.ctx, "Select")
+	if err := cas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConsentAcceptancesQuery, *ConsentAcceptancesSelect](ctx, cas.ConsentAcceptancesQuery, cas, cas.inters, v)
+}
+
+func (cas *ConsentAcceptancesSelect) sqlScan(ctx context.Context, root *ConsentAcceptancesQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cas.fns))
+	for _, fn := range cas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
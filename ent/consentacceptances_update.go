@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/consentacceptances"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ConsentAcceptancesUpdate is the builder for updating ConsentAcceptances entities.
+type ConsentAcceptancesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConsentAcceptancesMutation
+}
+
+// Where appends a list predicates to the ConsentAcceptancesUpdate builder.
+func (cau *ConsentAcceptancesUpdate) Where(ps ...predicate.ConsentAcceptances) *ConsentAcceptancesUpdate {
+	cau.mutation.Where(ps...)
+	return cau
+}
+
+// Mutation returns the ConsentAcceptancesMutation object of the builder.
+func (cau *ConsentAcceptancesUpdate) Mutation() *ConsentAcceptancesMutation {
+	return cau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cau *ConsentAcceptancesUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cau.sqlSave, cau.mutation, cau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cau *ConsentAcceptancesUpdate) SaveX(ctx context.Context) int {
+	affected, err := cau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cau *ConsentAcceptancesUpdate) Exec(ctx context.Context) error {
+	_, err := cau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cau *ConsentAcceptancesUpdate) ExecX(ctx context.Context) {
+	if err := cau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cau *ConsentAcceptancesUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(consentacceptances.Table, consentacceptances.Columns, sqlgraph.NewFieldSpec(consentacceptances.FieldID, field.TypeUUID))
+	if ps := cau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cau.mutation.IPAddressCleared() {
+		_spec.ClearField(consentacceptances.FieldIPAddress, field.TypeString)
+	}
+	if cau.mutation.UserAgentCleared() {
+		_spec.ClearField(consentacceptances.FieldUserAgent, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consentacceptances.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cau.mutation.done = true
+	return n, nil
+}
+
+// ConsentAcceptancesUpdateOne is the builder for updating a single ConsentAcceptances entity.
+type ConsentAcceptancesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConsentAcceptancesMutation
+}
+
+// Mutation returns the ConsentAcceptancesMutation object of the builder.
+func (cauo *ConsentAcceptancesUpdateOne) Mutation() *ConsentAcceptancesMutation {
+	return cauo.mutation
+}
+
+// Where appends a list predicates to the ConsentAcceptancesUpdate builder.
+func (cauo *ConsentAcceptancesUpdateOne) Where(ps ...predicate.ConsentAcceptances) *ConsentAcceptancesUpdateOne {
+	cauo.mutation.Where(ps...)
+	return cauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cauo *ConsentAcceptancesUpdateOne) Select(field string, fields ...string) *ConsentAcceptancesUpdateOne {
+	cauo.fields = append([]string{field}, fields...)
+	return cauo
+}
+
+// Save executes the query and returns the updated ConsentAcceptances entity.
+func (cauo *ConsentAcceptancesUpdateOne) Save(ctx context.Context) (*ConsentAcceptances, error) {
+	return withHooks(ctx, cauo.sqlSave, cauo.mutation, cauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cauo *ConsentAcceptancesUpdateOne) SaveX(ctx context.Context) *ConsentAcceptances {
+	node, err := cauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cauo *ConsentAcceptancesUpdateOne) Exec(ctx context.Context) error {
+	_, err := cauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cauo *ConsentAcceptancesUpdateOne) ExecX(ctx context.Context) {
+	if err := cauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cauo *ConsentAcceptancesUpdateOne) sqlSave(ctx context.Context) (_node *ConsentAcceptances, err error) {
+	_spec := sqlgraph.NewUpdateSpec(consentacceptances.Table, consentacceptances.Columns, sqlgraph.NewFieldSpec(consentacceptances.FieldID, field.TypeUUID))
+	id, ok := cauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConsentAcceptances.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consentacceptances.FieldID)
+		for _, f := range fields {
+			if !consentacceptances.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != consentacceptances.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cauo.mutation.IPAddressCleared() {
+		_spec.ClearField(consentacceptances.FieldIPAddress, field.TypeString)
+	}
+	if cauo.mutation.UserAgentCleared() {
+		_spec.ClearField(consentacceptances.FieldUserAgent, field.TypeString)
+	}
+	_node = &ConsentAcceptances{config: cauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consentacceptances.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cauo.mutation.done = true
+	return _node, nil
+}
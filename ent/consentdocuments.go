@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/consentdocuments"
+)
+
+// ConsentDocuments is the model entity for the ConsentDocuments schema.
+type ConsentDocuments struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Kind of document, e.g. terms or privacy
+	Type string `json:"type,omitempty"`
+	// Version holds the value of the "version" field.
+	Version string `json:"version,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Where the full text is published
+	URL string `json:"url,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Users must accept this version before tokens are issued
+	Mandatory bool `json:"mandatory,omitempty"`
+	// When the version takes effect
+	PublishedAt time.Time `json:"published_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConsentDocuments) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case consentdocuments.FieldCreatedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case consentdocuments.FieldMandatory:
+			values[i] = new(sql.NullBool)
+		case consentdocuments.FieldType, consentdocuments.FieldVersion, consentdocuments.FieldTitle, consentdocuments.FieldURL, consentdocuments.FieldContent:
+			values[i] = new(sql.NullString)
+		case consentdocuments.FieldPublishedAt, consentdocuments.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case consentdocuments.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConsentDocuments fields.
+func (cd *ConsentDocuments) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case consentdocuments.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cd.ID = *value
+			}
+		case consentdocuments.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				cd.Type = value.String
+			}
+		case consentdocuments.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				cd.Version = value.String
+			}
+		case consentdocuments.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				cd.Title = value.String
+			}
+		case consentdocuments.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				cd.URL = value.String
+			}
+		case consentdocuments.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				cd.Content = value.String
+			}
+		case consentdocuments.FieldMandatory:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mandatory", values[i])
+			} else if value.Valid {
+				cd.Mandatory = value.Bool
+			}
+		case consentdocuments.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				cd.PublishedAt = value.Time
+			}
+		case consentdocuments.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				cd.CreatedBy = new(uuid.UUID)
+				*cd.CreatedBy = *value.S.(*uuid.UUID)
+			}
+		case consentdocuments.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cd.CreatedAt = value.Time
+			}
+		default:
+			cd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConsentDocuments.
+// This includes values selected through modifiers, order, etc.
+func (cd *ConsentDocuments) Value(name string) (ent.Value, error) {
+	return cd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConsentDocuments.
+// Note that you need to call ConsentDocuments.Unwrap() before calling this method if this ConsentDocuments
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cd *ConsentDocuments) Update() *ConsentDocumentsUpdateOne {
+	return NewConsentDocumentsClient(cd.config).UpdateOne(cd)
+}
+
+// Unwrap unwraps the ConsentDocuments entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cd *ConsentDocuments) Unwrap() *ConsentDocuments {
+	_tx, ok := cd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConsentDocuments is not a transactional entity")
+	}
+	cd.config.driver = _tx.drv
+	return cd
+}
+
+// String implements the fmt.Stringer.
+func (cd *ConsentDocuments) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConsentDocuments(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cd.ID))
+	builder.WriteString("type=")
+	builder.WriteString(cd.Type)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(cd.Version)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(cd.Title)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(cd.URL)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(cd.Content)
+	builder.WriteString(", ")
+	builder.WriteString("mandatory=")
+	builder.WriteString(fmt.Sprintf("%v", cd.Mandatory))
+	builder.WriteString(", ")
+	builder.WriteString("published_at=")
+	builder.WriteString(cd.PublishedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := cd.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cd.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConsentDocumentsSlice is a parsable slice of ConsentDocuments.
+type ConsentDocumentsSlice []*ConsentDocuments
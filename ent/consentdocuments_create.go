@@ -0,0 +1,693 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/consentdocuments"
+)
+
+// ConsentDocumentsCreate is the builder for creating a ConsentDocuments entity.
+type ConsentDocumentsCreate struct {
+	config
+	mutation *ConsentDocumentsMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetType sets the "type" field.
+func (cdc *ConsentDocumentsCreate) SetType(s string) *ConsentDocumentsCreate {
+	cdc.mutation.SetType(s)
+	return cdc
+}
+
+// SetVersion sets the "version" field.
+func (cdc *ConsentDocumentsCreate) SetVersion(s string) *ConsentDocumentsCreate {
+	cdc.mutation.SetVersion(s)
+	return cdc
+}
+
+// SetTitle sets the "title" field.
+func (cdc *ConsentDocumentsCreate) SetTitle(s string) *ConsentDocumentsCreate {
+	cdc.mutation.SetTitle(s)
+	return cdc
+}
+
+// SetURL sets the "url" field.
+func (cdc *ConsentDocumentsCreate) SetURL(s string) *ConsentDocumentsCreate {
+	cdc.mutation.SetURL(s)
+	return cdc
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (cdc *ConsentDocumentsCreate) SetNillableURL(s *string) *ConsentDocumentsCreate {
+	if s != nil {
+		cdc.SetURL(*s)
+	}
+	return cdc
+}
+
+// SetContent sets the "content" field.
+func (cdc *ConsentDocumentsCreate) SetContent(s string) *ConsentDocumentsCreate {
+	cdc.mutation.SetContent(s)
+	return cdc
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (cdc *ConsentDocumentsCreate) SetNillableContent(s *string) *ConsentDocumentsCreate {
+	if s != nil {
+		cdc.SetContent(*s)
+	}
+	return cdc
+}
+
+// SetMandatory sets the "mandatory" field.
+func (cdc *ConsentDocumentsCreate) SetMandatory(b bool) *ConsentDocumentsCreate {
+	cdc.mutation.SetMandatory(b)
+	return cdc
+}
+
+// SetNillableMandatory sets the "mandatory" field if the given value is not nil.
+func (cdc *ConsentDocumentsCreate) SetNillableMandatory(b *bool) *ConsentDocumentsCreate {
+	if b != nil {
+		cdc.SetMandatory(*b)
+	}
+	return cdc
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (cdc *ConsentDocumentsCreate) SetPublishedAt(t time.Time) *ConsentDocumentsCreate {
+	cdc.mutation.SetPublishedAt(t)
+	return cdc
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (cdc *ConsentDocumentsCreate) SetNillablePublishedAt(t *time.Time) *ConsentDocumentsCreate {
+	if t != nil {
+		cdc.SetPublishedAt(*t)
+	}
+	return cdc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cdc *ConsentDocumentsCreate) SetCreatedBy(u uuid.UUID) *ConsentDocumentsCreate {
+	cdc.mutation.SetCreatedBy(u)
+	return cdc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cdc *ConsentDocumentsCreate) SetNillableCreatedBy(u *uuid.UUID) *ConsentDocumentsCreate {
+	if u != nil {
+		cdc.SetCreatedBy(*u)
+	}
+	return cdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cdc *ConsentDocumentsCreate) SetCreatedAt(t time.Time) *ConsentDocumentsCreate {
+	cdc.mutation.SetCreatedAt(t)
+	return cdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cdc *ConsentDocumentsCreate) SetNillableCreatedAt(t *time.Time) *ConsentDocumentsCreate {
+	if t != nil {
+		cdc.SetCreatedAt(*t)
+	}
+	return cdc
+}
+
+// SetID sets the "id" field.
+func (cdc *ConsentDocumentsCreate) SetID(u uuid.UUID) *ConsentDocumentsCreate {
+	cdc.mutation.SetID(u)
+	return cdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cdc *ConsentDocumentsCreate) SetNillableID(u *uuid.UUID) *ConsentDocumentsCreate {
+	if u != nil {
+		cdc.SetID(*u)
+	}
+	return cdc
+}
+
+// Mutation returns the ConsentDocumentsMutation object of the builder.
+func (cdc *ConsentDocumentsCreate) Mutation() *ConsentDocumentsMutation {
+	return cdc.mutation
+}
+
+// Save creates the ConsentDocuments in the database.
+func (cdc *ConsentDocumentsCreate) Save(ctx context.Context) (*ConsentDocuments, error) {
+	cdc.defaults()
+	return withHooks(ctx, cdc.sqlSave, cdc.mutation, cdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cdc *ConsentDocumentsCreate) SaveX(ctx context.Context) *ConsentDocuments {
+	v, err := cdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdc *ConsentDocumentsCreate) Exec(ctx context.Context) error {
+	_, err := cdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdc *ConsentDocumentsCreate) ExecX(ctx context.Context) {
+	if err := cdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cdc *ConsentDocumentsCreate) defaults() {
+	if _, ok := cdc.mutation.Mandatory(); !ok {
+		v := consentdocuments.DefaultMandatory
+		cdc.mutation.SetMandatory(v)
+	}
+	if _, ok := cdc.mutation.PublishedAt(); !ok {
+		v := consentdocuments.DefaultPublishedAt()
+		cdc.mutation.SetPublishedAt(v)
+	}
+	if _, ok := cdc.mutation.CreatedAt(); !ok {
+		v := consentdocuments.DefaultCreatedAt()
+		cdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cdc.mutation.ID(); !ok {
+		v := consentdocuments.DefaultID()
+		cdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdc *ConsentDocumentsCreate) check() error {
+	if _, ok := cdc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ConsentDocuments.type"`)}
+	}
+	if v, ok := cdc.mutation.GetType(); ok {
+		if err := consentdocuments.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ConsentDocuments.type": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ConsentDocuments.version"`)}
+	}
+	if v, ok := cdc.mutation.Version(); ok {
+		if err := consentdocuments.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ConsentDocuments.version": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "ConsentDocuments.title"`)}
+	}
+	if v, ok := cdc.mutation.Title(); ok {
+		if err := consentdocuments.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ConsentDocuments.title": %w`, err)}
+		}
+	}
+	if _, ok := cdc.mutation.Mandatory(); !ok {
+		return &ValidationError{Name: "mandatory", err: errors.New(`ent: missing required field "ConsentDocuments.mandatory"`)}
+	}
+	if _, ok := cdc.mutation.PublishedAt(); !ok {
+		return &ValidationError{Name: "published_at", err: errors.New(`ent: missing required field "ConsentDocuments.published_at"`)}
+	}
+	if _, ok := cdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConsentDocuments.created_at"`)}
+	}
+	return nil
+}
+
+func (cdc *ConsentDocumentsCreate) sqlSave(ctx context.Context) (*ConsentDocuments, error) {
+	if err := cdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cdc.mutation.id = &_node.ID
+	cdc.mutation.done = true
+	return _node, nil
+}
+
+func (cdc *ConsentDocumentsCreate) createSpec() (*ConsentDocuments, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConsentDocuments{config: cdc.config}
+		_spec = sqlgraph.NewCreateSpec(consentdocuments.Table, sqlgraph.NewFieldSpec(consentdocuments.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = cdc.conflict
+	if id, ok := cdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cdc.mutation.GetType(); ok {
+		_spec.SetField(consentdocuments.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := cdc.mutation.Version(); ok {
+		_spec.SetField(consentdocuments.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := cdc.mutation.Title(); ok {
+		_spec.SetField(consentdocuments.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := cdc.mutation.URL(); ok {
+		_spec.SetField(consentdocuments.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := cdc.mutation.Content(); ok {
+		_spec.SetField(consentdocuments.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := cdc.mutation.Mandatory(); ok {
+		_spec.SetField(consentdocuments.FieldMandatory, field.TypeBool, value)
+		_node.Mandatory = value
+	}
+	if value, ok := cdc.mutation.PublishedAt(); ok {
+		_spec.SetField(consentdocuments.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = value
+	}
+	if value, ok := cdc.mutation.CreatedBy(); ok {
+		_spec.SetField(consentdocuments.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := cdc.mutation.CreatedAt(); ok {
+		_spec.SetField(consentdocuments.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConsentDocuments.Create().
+//		SetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConsentDocumentsUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (cdc *ConsentDocumentsCreate) OnConflict(opts ...sql.ConflictOption) *ConsentDocumentsUpsertOne {
+	cdc.conflict = opts
+	return &ConsentDocumentsUpsertOne{
+		create: cdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConsentDocuments.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cdc *ConsentDocumentsCreate) OnConflictColumns(columns ...string) *ConsentDocumentsUpsertOne {
+	cdc.conflict = append(cdc.conflict, sql.ConflictColumns(columns...))
+	return &ConsentDocumentsUpsertOne{
+		create: cdc,
+	}
+}
+
+type (
+	// ConsentDocumentsUpsertOne is the builder for "upsert"-ing
+	//  one ConsentDocuments node.
+	ConsentDocumentsUpsertOne struct {
+		create *ConsentDocumentsCreate
+	}
+
+	// ConsentDocumentsUpsert is the "OnConflict" setter.
+	ConsentDocumentsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ConsentDocuments.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(consentdocuments.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConsentDocumentsUpsertOne) UpdateNewValues() *ConsentDocumentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(consentdocuments.FieldID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(consentdocuments.FieldType)
+		}
+		if _, exists := u.create.mutation.Version(); exists {
+			s.SetIgnore(consentdocuments.FieldVersion)
+		}
+		if _, exists := u.create.mutation.Title(); exists {
+			s.SetIgnore(consentdocuments.FieldTitle)
+		}
+		if _, exists := u.create.mutation.URL(); exists {
+			s.SetIgnore(consentdocuments.FieldURL)
+		}
+		if _, exists := u.create.mutation.Content(); exists {
+			s.SetIgnore(consentdocuments.FieldContent)
+		}
+		if _, exists := u.create.mutation.Mandatory(); exists {
+			s.SetIgnore(consentdocuments.FieldMandatory)
+		}
+		if _, exists := u.create.mutation.PublishedAt(); exists {
+			s.SetIgnore(consentdocuments.FieldPublishedAt)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(consentdocuments.FieldCreatedBy)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(consentdocuments.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConsentDocuments.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ConsentDocumentsUpsertOne) Ignore() *ConsentDocumentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConsentDocumentsUpsertOne) DoNothing() *ConsentDocumentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConsentDocumentsCreate.OnConflict
+// documentation for more info.
+func (u *ConsentDocumentsUpsertOne) Update(set func(*ConsentDocumentsUpsert)) *ConsentDocumentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConsentDocumentsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ConsentDocumentsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConsentDocumentsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConsentDocumentsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ConsentDocumentsUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ConsentDocumentsUpsertOne.ID is not supported by MySQL driver. Use ConsentDocumentsUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ConsentDocumentsUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ConsentDocumentsCreateBulk is the builder for creating many ConsentDocuments entities in bulk.
+type ConsentDocumentsCreateBulk struct {
+	config
+	err      error
+	builders []*ConsentDocumentsCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ConsentDocuments entities in the database.
+func (cdcb *ConsentDocumentsCreateBulk) Save(ctx context.Context) ([]*ConsentDocuments, error) {
+	if cdcb.err != nil {
+		return nil, cdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cdcb.builders))
+	nodes := make([]*ConsentDocuments, len(cdcb.builders))
+	mutators := make([]Mutator, len(cdcb.builders))
+	for i := range cdcb.builders {
+		func(i int, root context.Context) {
+			builder := cdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConsentDocumentsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdcb *ConsentDocumentsCreateBulk) SaveX(ctx context.Context) []*ConsentDocuments {
+	v, err := cdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdcb *ConsentDocumentsCreateBulk) Exec(ctx context.Context) error {
+	_, err := cdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdcb *ConsentDocumentsCreateBulk) ExecX(ctx context.Context) {
+	if err := cdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConsentDocuments.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConsentDocumentsUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (cdcb *ConsentDocumentsCreateBulk) OnConflict(opts ...sql.ConflictOption) *ConsentDocumentsUpsertBulk {
+	cdcb.conflict = opts
+	return &ConsentDocumentsUpsertBulk{
+		create: cdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConsentDocuments.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cdcb *ConsentDocumentsCreateBulk) OnConflictColumns(columns ...string) *ConsentDocumentsUpsertBulk {
+	cdcb.conflict = append(cdcb.conflict, sql.ConflictColumns(columns...))
+	return &ConsentDocumentsUpsertBulk{
+		create: cdcb,
+	}
+}
+
+// ConsentDocumentsUpsertBulk is the builder for "upsert"-ing
+// a bulk of ConsentDocuments nodes.
+type ConsentDocumentsUpsertBulk struct {
+	create *ConsentDocumentsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ConsentDocuments.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(consentdocuments.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConsentDocumentsUpsertBulk) UpdateNewValues() *ConsentDocumentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(consentdocuments.FieldID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(consentdocuments.FieldType)
+			}
+			if _, exists := b.mutation.Version(); exists {
+				s.SetIgnore(consentdocuments.FieldVersion)
+			}
+			if _, exists := b.mutation.Title(); exists {
+				s.SetIgnore(consentdocuments.FieldTitle)
+			}
+			if _, exists := b.mutation.URL(); exists {
+				s.SetIgnore(consentdocuments.FieldURL)
+			}
+			if _, exists := b.mutation.Content(); exists {
+				s.SetIgnore(consentdocuments.FieldContent)
+			}
+			if _, exists := b.mutation.Mandatory(); exists {
+				s.SetIgnore(consentdocuments.FieldMandatory)
+			}
+			if _, exists := b.mutation.PublishedAt(); exists {
+				s.SetIgnore(consentdocuments.FieldPublishedAt)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(consentdocuments.FieldCreatedBy)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(consentdocuments.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConsentDocuments.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ConsentDocumentsUpsertBulk) Ignore() *ConsentDocumentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConsentDocumentsUpsertBulk) DoNothing() *ConsentDocumentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConsentDocumentsCreateBulk.OnConflict
+// documentation for more info.
+func (u *ConsentDocumentsUpsertBulk) Update(set func(*ConsentDocumentsUpsert)) *ConsentDocumentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConsentDocumentsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ConsentDocumentsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ConsentDocumentsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConsentDocumentsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConsentDocumentsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/consentdocuments"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ConsentDocumentsDelete is the builder for deleting a ConsentDocuments entity.
+type ConsentDocumentsDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConsentDocumentsMutation
+}
+
+// Where appends a list predicates to the ConsentDocumentsDelete builder.
+func (cdd *ConsentDocumentsDelete) Where(ps ...predicate.ConsentDocuments) *ConsentDocumentsDelete {
+	cdd.mutation.Where(ps...)
+	return cdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cdd *ConsentDocumentsDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdd.sqlExec, cdd.mutation, cdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdd *ConsentDocumentsDelete) ExecX(ctx context.Context) int {
+	n, err := cdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cdd *ConsentDocumentsDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(consentdocuments.Table, sqlgraph.NewFieldSpec(consentdocuments.FieldID, field.TypeUUID))
+	if ps := cdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cdd.mutation.done = true
+	return affected, err
+}
+
+// ConsentDocumentsDeleteOne is the builder for deleting a single ConsentDocuments entity.
+type ConsentDocumentsDeleteOne struct {
+	cdd *ConsentDocumentsDelete
+}
+
+// Where appends a list predicates to the ConsentDocumentsDelete builder.
+func (cddo *ConsentDocumentsDeleteOne) Where(ps ...predicate.ConsentDocuments) *ConsentDocumentsDeleteOne {
+	cddo.cdd.mutation.Where(ps...)
+	return cddo
+}
+
+// Exec executes the deletion query.
+func (cddo *ConsentDocumentsDeleteOne) Exec(ctx context.Context) error {
+	n, err := cddo.cdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{consentdocuments.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cddo *ConsentDocumentsDeleteOne) ExecX(ctx context.Context) {
+	if err := cddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
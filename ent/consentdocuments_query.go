@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/consentdocuments"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ConsentDocumentsQuery is the builder for querying ConsentDocuments entities.
+type ConsentDocumentsQuery struct {
+	config
+	ctx        *QueryContext
+	order      []consentdocuments.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ConsentDocuments
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ConsentDocumentsQuery builder.
+func (cdq *ConsentDocumentsQuery) Where(ps ...predicate.ConsentDocuments) *ConsentDocumentsQuery {
+	cdq.predicates = append(cdq.predicates, ps...)
+	return cdq
+}
+
+// Limit the number of records to be returned by this query.
+func (cdq *ConsentDocumentsQuery) Limit(limit int) *ConsentDocumentsQuery {
+	cdq.ctx.Limit = &limit
+	return cdq
+}
+
+// Offset to start from.
+func (cdq *ConsentDocumentsQuery) Offset(offset int) *ConsentDocumentsQuery {
+	cdq.ctx.Offset = &offset
+	return cdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cdq *ConsentDocumentsQuery) Unique(unique bool) *ConsentDocumentsQuery {
+	cdq.ctx.Unique = &unique
+	return cdq
+}
+
+// Order specifies how the records should be ordered.
+func (cdq *ConsentDocumentsQuery) Order(o ...consentdocuments.OrderOption) *ConsentDocumentsQuery {
+	cdq.order = append(cdq.order, o...)
+	return cdq
+}
+
+// First returns the first ConsentDocuments entity from the query.
+// Returns a *NotFoundError when no ConsentDocuments was found.
+func (cdq *ConsentDocumentsQuery) First(ctx context.Context) (*ConsentDocuments, error) {
+	nodes, err := cdq.Limit(1).All(setContextOp(ctx, cdq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{consentdocuments.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cdq *ConsentDocumentsQuery) FirstX(ctx context.Context) *ConsentDocuments {
+	node, err := cdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ConsentDocuments ID from the query.
+// Returns a *NotFoundError when no ConsentDocuments ID was found.
+func (cdq *ConsentDocumentsQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cdq.Limit(1).IDs(setContextOp(ctx, cdq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{consentdocuments.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cdq *ConsentDocumentsQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ConsentDocuments entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ConsentDocuments entity is found.
+// Returns a *NotFoundError when no ConsentDocuments entities are found.
+func (cdq *ConsentDocumentsQuery) Only(ctx context.Context) (*ConsentDocuments, error) {
+	nodes, err := cdq.Limit(2).All(setContextOp(ctx, cdq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{consentdocuments.Label}
+	default:
+		return nil, &NotSingularError{consentdocuments.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cdq *ConsentDocumentsQuery) OnlyX(ctx context.Context) *ConsentDocuments {
+	node, err := cdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ConsentDocuments ID in the query.
+// Returns a *NotSingularError when more than one ConsentDocuments ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cdq *ConsentDocumentsQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cdq.Limit(2).IDs(setContextOp(ctx, cdq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{consentdocuments.Label}
+	default:
+		err = &NotSingularError{consentdocuments.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cdq *ConsentDocumentsQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ConsentDocumentsSlice.
+func (cdq *ConsentDocumentsQuery) All(ctx context.Context) ([]*ConsentDocuments, error) {
+	ctx = setContextOp(ctx, cdq.ctx, "All")
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ConsentDocuments, *ConsentDocumentsQuery]()
+	return withInterceptors[[]*ConsentDocuments](ctx, cdq, qr, cdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cdq *ConsentDocumentsQuery) AllX(ctx context.Context) []*ConsentDocuments {
+	nodes, err := cdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ConsentDocuments IDs.
+func (cdq *ConsentDocumentsQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if cdq.ctx.Unique == nil && cdq.path != nil {
+		cdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cdq.ctx, "IDs")
+	if err = cdq.Select(consentdocuments.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cdq *ConsentDocumentsQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cdq *ConsentDocumentsQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cdq.ctx, "Count")
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cdq, querierCount[*ConsentDocumentsQuery](), cdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cdq *ConsentDocumentsQuery) CountX(ctx context.Context) int {
+	count, err := cdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cdq *ConsentDocumentsQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cdq.ctx, "Exist")
+	switch _, err := cdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cdq *ConsentDocumentsQuery) ExistX(ctx context.Context) bool {
+	exist, err := cdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ConsentDocumentsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cdq *ConsentDocumentsQuery) Clone() *ConsentDocumentsQuery {
+	if cdq == nil {
+		return nil
+	}
+	return &ConsentDocumentsQuery{
+		config:     cdq.config,
+		ctx:        cdq.ctx.Clone(),
+		order:      append([]consentdocuments.OrderOption{}, cdq.order...),
+		inters:     append([]Interceptor{}, cdq.inters...),
+		predicates: append([]predicate.ConsentDocuments{}, cdq.predicates...),
+		// clone intermediate query.
+		sql:  cdq.sql.Clone(),
+		path: cdq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Type string `json:"type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ConsentDocuments.Query().
+//		GroupBy(consentdocuments.FieldType).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cdq *ConsentDocumentsQuery) GroupBy(field string, fields ...string) *ConsentDocumentsGroupBy {
+	cdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ConsentDocumentsGroupBy{build: cdq}
+	grbuild.flds = &cdq.ctx.Fields
+	grbuild.label = consentdocuments.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Type string `json:"type,omitempty"`
+//	}
+//
+//	client.ConsentDocuments.Query().
+//		Select(consentdocuments.FieldType).
+//		Scan(ctx, &v)
+func (cdq *ConsentDocumentsQuery) Select(fields ...string) *ConsentDocumentsSelect {
+	cdq.ctx.Fields = append(cdq.ctx.Fields, fields...)
+	sbuild := &ConsentDocumentsSelect{ConsentDocumentsQuery: cdq}
+	sbuild.label = consentdocuments.Label
+	sbuild.flds, sbuild.scan = &cdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ConsentDocumentsSelect configured with the given aggregations.
+func (cdq *ConsentDocumentsQuery) Aggregate(fns ...AggregateFunc) *ConsentDocumentsSelect {
+	return cdq.Select().Aggregate(fns...)
+}
+
+func (cdq *ConsentDocumentsQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cdq.ctx.Fields {
+		if !consentdocuments.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cdq.path != nil {
+		prev, err := cdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cdq.sql = prev
+	}
+	return nil
+}
+
+func (cdq *ConsentDocumentsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ConsentDocuments, error) {
+	var (
+		nodes = []*ConsentDocuments{}
+		_spec = cdq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ConsentDocuments).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ConsentDocuments{config: cdq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(cdq.modifiers) > 0 {
+		_spec.Modifiers = cdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (cdq *ConsentDocumentsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cdq.querySpec()
+	if len(cdq.modifiers) > 0 {
+		_spec.Modifiers = cdq.modifiers
+	}
+	_spec.Node.Columns = cdq.ctx.Fields
+	if len(cdq.ctx.Fields) > 0 {
+		_spec.Unique = cdq.ctx.Unique != nil && *cdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cdq.driver, _spec)
+}
+
+func (cdq *ConsentDocumentsQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(consentdocuments.Table, consentdocuments.Columns, sqlgraph.NewFieldSpec(consentdocuments.FieldID, field.TypeUUID))
+	_spec.From = cdq.sql
+	if unique := cdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consentdocuments.FieldID)
+		for i := range fields {
+			if fields[i] != consentdocuments.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cdq *ConsentDocumentsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cdq.driver.Dialect())
+	t1 := builder.Table(consentdocuments.Table)
+	columns := cdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = consentdocuments.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cdq.sql != nil {
+		selector = cdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cdq.ctx.Unique != nil && *cdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range cdq.modifiers {
+		m(selector)
+	}
+	for _, p := range cdq.predicates {
+		p(selector)
+	}
+	for _, p := range cdq.order {
+		p(selector)
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (cdq *ConsentDocumentsQuery) ForUpdate(opts ...sql.LockOption) *ConsentDocumentsQuery {
+	if cdq.driver.Dialect() == dialect.Postgres {
+		cdq.Unique(false)
+	}
+	cdq.modifiers = append(cdq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return cdq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (cdq *ConsentDocumentsQuery) ForShare(opts ...sql.LockOption) *ConsentDocumentsQuery {
+	if cdq.driver.Dialect() == dialect.Postgres {
+		cdq.Unique(false)
+	}
+	cdq.modifiers = append(cdq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return cdq
+}
+
+// ConsentDocumentsGroupBy is the group-by builder for ConsentDocuments entities.
+type ConsentDocumentsGroupBy struct {
+	selector
+	build *ConsentDocumentsQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cdgb *ConsentDocumentsGroupBy) Aggregate(fns ...AggregateFunc) *ConsentDocumentsGroupBy {
+	cdgb.fns = append(cdgb.fns, fns...)
+	return cdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cdgb *ConsentDocumentsGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cdgb.build.ctx, "GroupBy")
+	if err := cdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConsentDocumentsQuery, *ConsentDocumentsGroupBy](ctx, cdgb.build, cdgb, cdgb.build.inters, v)
+}
+
+func (cdgb *ConsentDocumentsGroupBy) sqlScan(ctx context.Context, root *ConsentDocumentsQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cdgb.fns))
+	for _, fn := range cdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cdgb.flds)+len(cdgb.fns))
+		for _, f := range *cdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ConsentDocumentsSelect is the builder for selecting fields of ConsentDocuments entities.
+type ConsentDocumentsSelect struct {
+	*ConsentDocumentsQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cds *ConsentDocumentsSelect) Aggregate(fns ...AggregateFunc) *ConsentDocumentsSelect {
+	cds.fns = append(cds.fns, fns...)
+	return cds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cds *ConsentDocumentsSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cds.ctx, "Select")
+	if err := cds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ConsentDocumentsQuery, *ConsentDocumentsSelect](ctx, cds.ConsentDocumentsQuery, cds, cds.inters, v)
+}
+
+func (cds *ConsentDocumentsSelect) sqlScan(ctx context.Context, root *ConsentDocumentsQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cds.fns))
+	for _, fn := range cds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/consentdocuments"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ConsentDocumentsUpdate is the builder for updating ConsentDocuments entities.
+type ConsentDocumentsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConsentDocumentsMutation
+}
+
+// Where appends a list predicates to the ConsentDocumentsUpdate builder.
+func (cdu *ConsentDocumentsUpdate) Where(ps ...predicate.ConsentDocuments) *ConsentDocumentsUpdate {
+	cdu.mutation.Where(ps...)
+	return cdu
+}
+
+// Mutation returns the ConsentDocumentsMutation object of the builder.
+func (cdu *ConsentDocumentsUpdate) Mutation() *ConsentDocumentsMutation {
+	return cdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cdu *ConsentDocumentsUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdu.sqlSave, cdu.mutation, cdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdu *ConsentDocumentsUpdate) SaveX(ctx context.Context) int {
+	affected, err := cdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cdu *ConsentDocumentsUpdate) Exec(ctx context.Context) error {
+	_, err := cdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdu *ConsentDocumentsUpdate) ExecX(ctx context.Context) {
+	if err := cdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cdu *ConsentDocumentsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(consentdocuments.Table, consentdocuments.Columns, sqlgraph.NewFieldSpec(consentdocuments.FieldID, field.TypeUUID))
+	if ps := cdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cdu.mutation.URLCleared() {
+		_spec.ClearField(consentdocuments.FieldURL, field.TypeString)
+	}
+	if cdu.mutation.ContentCleared() {
+		_spec.ClearField(consentdocuments.FieldContent, field.TypeString)
+	}
+	if cdu.mutation.CreatedByCleared() {
+		_spec.ClearField(consentdocuments.FieldCreatedBy, field.TypeUUID)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consentdocuments.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cdu.mutation.done = true
+	return n, nil
+}
+
+// ConsentDocumentsUpdateOne is the builder for updating a single ConsentDocuments entity.
+type ConsentDocumentsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConsentDocumentsMutation
+}
+
+// Mutation returns the ConsentDocumentsMutation object of the builder.
+func (cduo *ConsentDocumentsUpdateOne) Mutation() *ConsentDocumentsMutation {
+	return cduo.mutation
+}
+
+// Where appends a list predicates to the ConsentDocumentsUpdate builder.
+func (cduo *ConsentDocumentsUpdateOne) Where(ps ...predicate.ConsentDocuments) *ConsentDocumentsUpdateOne {
+	cduo.mutation.Where(ps...)
+	return cduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cduo *ConsentDocumentsUpdateOne) Select(field string, fields ...string) *ConsentDocumentsUpdateOne {
+	cduo.fields = append([]string{field}, fields...)
+	return cduo
+}
+
+// Save executes the query and returns the updated ConsentDocuments entity.
+func (cduo *ConsentDocumentsUpdateOne) Save(ctx context.Context) (*ConsentDocuments, error) {
+	return withHooks(ctx, cduo.sqlSave, cduo.mutation, cduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cduo *ConsentDocumentsUpdateOne) SaveX(ctx context.Context) *ConsentDocuments {
+	node, err := cduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cduo *ConsentDocumentsUpdateOne) Exec(ctx context.Context) error {
+	_, err := cduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cduo *ConsentDocumentsUpdateOne) ExecX(ctx context.Context) {
+	if err := cduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (cduo *ConsentDocumentsUpdateOne) sqlSave(ctx context.Context) (_node *ConsentDocuments, err error) {
+	_spec := sqlgraph.NewUpdateSpec(consentdocuments.Table, consentdocuments.Columns, sqlgraph.NewFieldSpec(consentdocuments.FieldID, field.TypeUUID))
+	id, ok := cduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConsentDocuments.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consentdocuments.FieldID)
+		for _, f := range fields {
+			if !consentdocuments.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != consentdocuments.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if cduo.mutation.URLCleared() {
+		_spec.ClearField(consentdocuments.FieldURL, field.TypeString)
+	}
+	if cduo.mutation.ContentCleared() {
+		_spec.ClearField(consentdocuments.FieldContent, field.TypeString)
+	}
+	if cduo.mutation.CreatedByCleared() {
+		_spec.ClearField(consentdocuments.FieldCreatedBy, field.TypeUUID)
+	}
+	_node = &ConsentDocuments{config: cduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consentdocuments.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/emailchanges"
+)
+
+// EmailChanges is the model entity for the EmailChanges schema.
+type EmailChanges struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// User changing their email
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// OldEmail holds the value of the "old_email" field.
+	OldEmail string `json:"old_email,omitempty"`
+	// Restored when the change is undone
+	OldEmailVerified bool `json:"old_email_verified,omitempty"`
+	// Requested address, lowercased
+	NewEmail string `json:"new_email,omitempty"`
+	// Sent to the new address to confirm the change
+	Token string `json:"token,omitempty"`
+	// Sent to the old address to cancel or revert the change
+	UndoToken string `json:"undo_token,omitempty"`
+	// Status holds the value of the "status" field.
+	Status emailchanges.Status `json:"status,omitempty"`
+	// When the confirmation link expires
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// When the undo link expires
+	UndoExpiresAt time.Time `json:"undo_expires_at,omitempty"`
+	// ConfirmedAt holds the value of the "confirmed_at" field.
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+	// RevertedAt holds the value of the "reverted_at" field.
+	RevertedAt *time.Time `json:"reverted_at,omitempty"`
+	// IPAddress holds the value of the "ip_address" field.
+	IPAddress string `json:"ip_address,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailChanges) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailchanges.FieldOldEmailVerified:
+			values[i] = new(sql.NullBool)
+		case emailchanges.FieldOldEmail, emailchanges.FieldNewEmail, emailchanges.FieldToken, emailchanges.FieldUndoToken, emailchanges.FieldStatus, emailchanges.FieldIPAddress:
+			values[i] = new(sql.NullString)
+		case emailchanges.FieldExpiresAt, emailchanges.FieldUndoExpiresAt, emailchanges.FieldConfirmedAt, emailchanges.FieldRevertedAt, emailchanges.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case emailchanges.FieldID, emailchanges.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailChanges fields.
+func (ec *EmailChanges) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailchanges.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ec.ID = *value
+			}
+		case emailchanges.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ec.UserID = *value
+			}
+		case emailchanges.FieldOldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_email", values[i])
+			} else if value.Valid {
+				ec.OldEmail = value.String
+			}
+		case emailchanges.FieldOldEmailVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field old_email_verified", values[i])
+			} else if value.Valid {
+				ec.OldEmailVerified = value.Bool
+			}
+		case emailchanges.FieldNewEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_email", values[i])
+			} else if value.Valid {
+				ec.NewEmail = value.String
+			}
+		case emailchanges.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				ec.Token = value.String
+			}
+		case emailchanges.FieldUndoToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field undo_token", values[i])
+			} else if value.Valid {
+				ec.UndoToken = value.String
+			}
+		case emailchanges.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ec.Status = emailchanges.Status(value.String)
+			}
+		case emailchanges.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ec.ExpiresAt = value.Time
+			}
+		case emailchanges.FieldUndoExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field undo_expires_at", values[i])
+			} else if value.Valid {
+				ec.UndoExpiresAt = value.Time
+			}
+		case emailchanges.FieldConfirmedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field confirmed_at", values[i])
+			} else if value.Valid {
+				ec.ConfirmedAt = new(time.Time)
+				*ec.ConfirmedAt = value.Time
+			}
+		case emailchanges.FieldRevertedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reverted_at", values[i])
+			} else if value.Valid {
+				ec.RevertedAt = new(time.Time)
+				*ec.RevertedAt = value.Time
+			}
+		case emailchanges.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				ec.IPAddress = value.String
+			}
+		case emailchanges.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ec.CreatedAt = value.Time
+			}
+		default:
+			ec.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailChanges.
+// This includes values selected through modifiers, order, etc.
+func (ec *EmailChanges) Value(name string) (ent.Value, error) {
+	return ec.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EmailChanges.
+// Note that you need to call EmailChanges.Unwrap() before calling this method if this EmailChanges
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ec *EmailChanges) Update() *EmailChangesUpdateOne {
+	return NewEmailChangesClient(ec.config).UpdateOne(ec)
+}
+
+// Unwrap unwraps the EmailChanges entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ec *EmailChanges) Unwrap() *EmailChanges {
+	_tx, ok := ec.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailChanges is not a transactional entity")
+	}
+	ec.config.driver = _tx.drv
+	return ec
+}
+
+// String implements the fmt.Stringer.
+func (ec *EmailChanges) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailChanges(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ec.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ec.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("old_email=")
+	builder.WriteString(ec.OldEmail)
+	builder.WriteString(", ")
+	builder.WriteString("old_email_verified=")
+	builder.WriteString(fmt.Sprintf("%v", ec.OldEmailVerified))
+	builder.WriteString(", ")
+	builder.WriteString("new_email=")
+	builder.WriteString(ec.NewEmail)
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(ec.Token)
+	builder.WriteString(", ")
+	builder.WriteString("undo_token=")
+	builder.WriteString(ec.UndoToken)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ec.Status))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ec.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("undo_expires_at=")
+	builder.WriteString(ec.UndoExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ec.ConfirmedAt; v != nil {
+		builder.WriteString("confirmed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ec.RevertedAt; v != nil {
+		builder.WriteString("reverted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(ec.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ec.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailChangesSlice is a parsable slice of EmailChanges.
+type EmailChangesSlice []*EmailChanges
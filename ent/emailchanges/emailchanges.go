@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailchanges
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the emailchanges type in the database.
+	Label = "email_changes"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldOldEmail holds the string denoting the old_email field in the database.
+	FieldOldEmail = "old_email"
+	// FieldOldEmailVerified holds the string denoting the old_email_verified field in the database.
+	FieldOldEmailVerified = "old_email_verified"
+	// FieldNewEmail holds the string denoting the new_email field in the database.
+	FieldNewEmail = "new_email"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldUndoToken holds the string denoting the undo_token field in the database.
+	FieldUndoToken = "undo_token"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldUndoExpiresAt holds the string denoting the undo_expires_at field in the database.
+	FieldUndoExpiresAt = "undo_expires_at"
+	// FieldConfirmedAt holds the string denoting the confirmed_at field in the database.
+	FieldConfirmedAt = "confirmed_at"
+	// FieldRevertedAt holds the string denoting the reverted_at field in the database.
+	FieldRevertedAt = "reverted_at"
+	// FieldIPAddress holds the string denoting the ip_address field in the database.
+	FieldIPAddress = "ip_address"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the emailchanges in the database.
+	Table = "email_changes"
+)
+
+// Columns holds all SQL columns for emailchanges fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldOldEmail,
+	FieldOldEmailVerified,
+	FieldNewEmail,
+	FieldToken,
+	FieldUndoToken,
+	FieldStatus,
+	FieldExpiresAt,
+	FieldUndoExpiresAt,
+	FieldConfirmedAt,
+	FieldRevertedAt,
+	FieldIPAddress,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// OldEmailValidator is a validator for the "old_email" field. It is called by the builders before save.
+	OldEmailValidator func(string) error
+	// DefaultOldEmailVerified holds the default value on creation for the "old_email_verified" field.
+	DefaultOldEmailVerified bool
+	// NewEmailValidator is a validator for the "new_email" field. It is called by the builders before save.
+	NewEmailValidator func(string) error
+	// TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	TokenValidator func(string) error
+	// UndoTokenValidator is a validator for the "undo_token" field. It is called by the builders before save.
+	UndoTokenValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusConfirmed Status = "confirmed"
+	StatusCancelled Status = "cancelled"
+	StatusReverted  Status = "reverted"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusConfirmed, StatusCancelled, StatusReverted:
+		return nil
+	default:
+		return fmt.Errorf("emailchanges: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the EmailChanges queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByOldEmail orders the results by the old_email field.
+func ByOldEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldEmail, opts...).ToFunc()
+}
+
+// ByOldEmailVerified orders the results by the old_email_verified field.
+func ByOldEmailVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOldEmailVerified, opts...).ToFunc()
+}
+
+// ByNewEmail orders the results by the new_email field.
+func ByNewEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewEmail, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// ByUndoToken orders the results by the undo_token field.
+func ByUndoToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUndoToken, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByUndoExpiresAt orders the results by the undo_expires_at field.
+func ByUndoExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUndoExpiresAt, opts...).ToFunc()
+}
+
+// ByConfirmedAt orders the results by the confirmed_at field.
+func ByConfirmedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfirmedAt, opts...).ToFunc()
+}
+
+// ByRevertedAt orders the results by the reverted_at field.
+func ByRevertedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevertedAt, opts...).ToFunc()
+}
+
+// ByIPAddress orders the results by the ip_address field.
+func ByIPAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIPAddress, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
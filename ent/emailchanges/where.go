@@ -0,0 +1,756 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailchanges
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldUserID, v))
+}
+
+// OldEmail applies equality check predicate on the "old_email" field. It's identical to OldEmailEQ.
+func OldEmail(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldOldEmail, v))
+}
+
+// OldEmailVerified applies equality check predicate on the "old_email_verified" field. It's identical to OldEmailVerifiedEQ.
+func OldEmailVerified(v bool) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldOldEmailVerified, v))
+}
+
+// NewEmail applies equality check predicate on the "new_email" field. It's identical to NewEmailEQ.
+func NewEmail(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldNewEmail, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldToken, v))
+}
+
+// UndoToken applies equality check predicate on the "undo_token" field. It's identical to UndoTokenEQ.
+func UndoToken(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldUndoToken, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// UndoExpiresAt applies equality check predicate on the "undo_expires_at" field. It's identical to UndoExpiresAtEQ.
+func UndoExpiresAt(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldUndoExpiresAt, v))
+}
+
+// ConfirmedAt applies equality check predicate on the "confirmed_at" field. It's identical to ConfirmedAtEQ.
+func ConfirmedAt(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldConfirmedAt, v))
+}
+
+// RevertedAt applies equality check predicate on the "reverted_at" field. It's identical to RevertedAtEQ.
+func RevertedAt(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldRevertedAt, v))
+}
+
+// IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
+func IPAddress(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldUserID, v))
+}
+
+// OldEmailEQ applies the EQ predicate on the "old_email" field.
+func OldEmailEQ(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldOldEmail, v))
+}
+
+// OldEmailNEQ applies the NEQ predicate on the "old_email" field.
+func OldEmailNEQ(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldOldEmail, v))
+}
+
+// OldEmailIn applies the In predicate on the "old_email" field.
+func OldEmailIn(vs ...string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldOldEmail, vs...))
+}
+
+// OldEmailNotIn applies the NotIn predicate on the "old_email" field.
+func OldEmailNotIn(vs ...string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldOldEmail, vs...))
+}
+
+// OldEmailGT applies the GT predicate on the "old_email" field.
+func OldEmailGT(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldOldEmail, v))
+}
+
+// OldEmailGTE applies the GTE predicate on the "old_email" field.
+func OldEmailGTE(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldOldEmail, v))
+}
+
+// OldEmailLT applies the LT predicate on the "old_email" field.
+func OldEmailLT(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldOldEmail, v))
+}
+
+// OldEmailLTE applies the LTE predicate on the "old_email" field.
+func OldEmailLTE(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldOldEmail, v))
+}
+
+// OldEmailContains applies the Contains predicate on the "old_email" field.
+func OldEmailContains(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldContains(FieldOldEmail, v))
+}
+
+// OldEmailHasPrefix applies the HasPrefix predicate on the "old_email" field.
+func OldEmailHasPrefix(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldHasPrefix(FieldOldEmail, v))
+}
+
+// OldEmailHasSuffix applies the HasSuffix predicate on the "old_email" field.
+func OldEmailHasSuffix(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldHasSuffix(FieldOldEmail, v))
+}
+
+// OldEmailEqualFold applies the EqualFold predicate on the "old_email" field.
+func OldEmailEqualFold(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEqualFold(FieldOldEmail, v))
+}
+
+// OldEmailContainsFold applies the ContainsFold predicate on the "old_email" field.
+func OldEmailContainsFold(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldContainsFold(FieldOldEmail, v))
+}
+
+// OldEmailVerifiedEQ applies the EQ predicate on the "old_email_verified" field.
+func OldEmailVerifiedEQ(v bool) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldOldEmailVerified, v))
+}
+
+// OldEmailVerifiedNEQ applies the NEQ predicate on the "old_email_verified" field.
+func OldEmailVerifiedNEQ(v bool) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldOldEmailVerified, v))
+}
+
+// NewEmailEQ applies the EQ predicate on the "new_email" field.
+func NewEmailEQ(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldNewEmail, v))
+}
+
+// NewEmailNEQ applies the NEQ predicate on the "new_email" field.
+func NewEmailNEQ(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldNewEmail, v))
+}
+
+// NewEmailIn applies the In predicate on the "new_email" field.
+func NewEmailIn(vs ...string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldNewEmail, vs...))
+}
+
+// NewEmailNotIn applies the NotIn predicate on the "new_email" field.
+func NewEmailNotIn(vs ...string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldNewEmail, vs...))
+}
+
+// NewEmailGT applies the GT predicate on the "new_email" field.
+func NewEmailGT(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldNewEmail, v))
+}
+
+// NewEmailGTE applies the GTE predicate on the "new_email" field.
+func NewEmailGTE(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldNewEmail, v))
+}
+
+// NewEmailLT applies the LT predicate on the "new_email" field.
+func NewEmailLT(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldNewEmail, v))
+}
+
+// NewEmailLTE applies the LTE predicate on the "new_email" field.
+func NewEmailLTE(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldNewEmail, v))
+}
+
+// NewEmailContains applies the Contains predicate on the "new_email" field.
+func NewEmailContains(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldContains(FieldNewEmail, v))
+}
+
+// NewEmailHasPrefix applies the HasPrefix predicate on the "new_email" field.
+func NewEmailHasPrefix(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldHasPrefix(FieldNewEmail, v))
+}
+
+// NewEmailHasSuffix applies the HasSuffix predicate on the "new_email" field.
+func NewEmailHasSuffix(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldHasSuffix(FieldNewEmail, v))
+}
+
+// NewEmailEqualFold applies the EqualFold predicate on the "new_email" field.
+func NewEmailEqualFold(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEqualFold(FieldNewEmail, v))
+}
+
+// NewEmailContainsFold applies the ContainsFold predicate on the "new_email" field.
+func NewEmailContainsFold(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldContainsFold(FieldNewEmail, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldContainsFold(FieldToken, v))
+}
+
+// UndoTokenEQ applies the EQ predicate on the "undo_token" field.
+func UndoTokenEQ(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldUndoToken, v))
+}
+
+// UndoTokenNEQ applies the NEQ predicate on the "undo_token" field.
+func UndoTokenNEQ(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldUndoToken, v))
+}
+
+// UndoTokenIn applies the In predicate on the "undo_token" field.
+func UndoTokenIn(vs ...string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldUndoToken, vs...))
+}
+
+// UndoTokenNotIn applies the NotIn predicate on the "undo_token" field.
+func UndoTokenNotIn(vs ...string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldUndoToken, vs...))
+}
+
+// UndoTokenGT applies the GT predicate on the "undo_token" field.
+func UndoTokenGT(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldUndoToken, v))
+}
+
+// UndoTokenGTE applies the GTE predicate on the "undo_token" field.
+func UndoTokenGTE(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldUndoToken, v))
+}
+
+// UndoTokenLT applies the LT predicate on the "undo_token" field.
+func UndoTokenLT(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldUndoToken, v))
+}
+
+// UndoTokenLTE applies the LTE predicate on the "undo_token" field.
+func UndoTokenLTE(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldUndoToken, v))
+}
+
+// UndoTokenContains applies the Contains predicate on the "undo_token" field.
+func UndoTokenContains(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldContains(FieldUndoToken, v))
+}
+
+// UndoTokenHasPrefix applies the HasPrefix predicate on the "undo_token" field.
+func UndoTokenHasPrefix(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldHasPrefix(FieldUndoToken, v))
+}
+
+// UndoTokenHasSuffix applies the HasSuffix predicate on the "undo_token" field.
+func UndoTokenHasSuffix(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldHasSuffix(FieldUndoToken, v))
+}
+
+// UndoTokenEqualFold applies the EqualFold predicate on the "undo_token" field.
+func UndoTokenEqualFold(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEqualFold(FieldUndoToken, v))
+}
+
+// UndoTokenContainsFold applies the ContainsFold predicate on the "undo_token" field.
+func UndoTokenContainsFold(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldContainsFold(FieldUndoToken, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// UndoExpiresAtEQ applies the EQ predicate on the "undo_expires_at" field.
+func UndoExpiresAtEQ(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldUndoExpiresAt, v))
+}
+
+// UndoExpiresAtNEQ applies the NEQ predicate on the "undo_expires_at" field.
+func UndoExpiresAtNEQ(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldUndoExpiresAt, v))
+}
+
+// UndoExpiresAtIn applies the In predicate on the "undo_expires_at" field.
+func UndoExpiresAtIn(vs ...time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldUndoExpiresAt, vs...))
+}
+
+// UndoExpiresAtNotIn applies the NotIn predicate on the "undo_expires_at" field.
+func UndoExpiresAtNotIn(vs ...time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldUndoExpiresAt, vs...))
+}
+
+// UndoExpiresAtGT applies the GT predicate on the "undo_expires_at" field.
+func UndoExpiresAtGT(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldUndoExpiresAt, v))
+}
+
+// UndoExpiresAtGTE applies the GTE predicate on the "undo_expires_at" field.
+func UndoExpiresAtGTE(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldUndoExpiresAt, v))
+}
+
+// UndoExpiresAtLT applies the LT predicate on the "undo_expires_at" field.
+func UndoExpiresAtLT(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldUndoExpiresAt, v))
+}
+
+// UndoExpiresAtLTE applies the LTE predicate on the "undo_expires_at" field.
+func UndoExpiresAtLTE(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldUndoExpiresAt, v))
+}
+
+// ConfirmedAtEQ applies the EQ predicate on the "confirmed_at" field.
+func ConfirmedAtEQ(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtNEQ applies the NEQ predicate on the "confirmed_at" field.
+func ConfirmedAtNEQ(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtIn applies the In predicate on the "confirmed_at" field.
+func ConfirmedAtIn(vs ...time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldConfirmedAt, vs...))
+}
+
+// ConfirmedAtNotIn applies the NotIn predicate on the "confirmed_at" field.
+func ConfirmedAtNotIn(vs ...time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldConfirmedAt, vs...))
+}
+
+// ConfirmedAtGT applies the GT predicate on the "confirmed_at" field.
+func ConfirmedAtGT(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtGTE applies the GTE predicate on the "confirmed_at" field.
+func ConfirmedAtGTE(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtLT applies the LT predicate on the "confirmed_at" field.
+func ConfirmedAtLT(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtLTE applies the LTE predicate on the "confirmed_at" field.
+func ConfirmedAtLTE(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtIsNil applies the IsNil predicate on the "confirmed_at" field.
+func ConfirmedAtIsNil() predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIsNull(FieldConfirmedAt))
+}
+
+// ConfirmedAtNotNil applies the NotNil predicate on the "confirmed_at" field.
+func ConfirmedAtNotNil() predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotNull(FieldConfirmedAt))
+}
+
+// RevertedAtEQ applies the EQ predicate on the "reverted_at" field.
+func RevertedAtEQ(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldRevertedAt, v))
+}
+
+// RevertedAtNEQ applies the NEQ predicate on the "reverted_at" field.
+func RevertedAtNEQ(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldRevertedAt, v))
+}
+
+// RevertedAtIn applies the In predicate on the "reverted_at" field.
+func RevertedAtIn(vs ...time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldRevertedAt, vs...))
+}
+
+// RevertedAtNotIn applies the NotIn predicate on the "reverted_at" field.
+func RevertedAtNotIn(vs ...time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldRevertedAt, vs...))
+}
+
+// RevertedAtGT applies the GT predicate on the "reverted_at" field.
+func RevertedAtGT(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldRevertedAt, v))
+}
+
+// RevertedAtGTE applies the GTE predicate on the "reverted_at" field.
+func RevertedAtGTE(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldRevertedAt, v))
+}
+
+// RevertedAtLT applies the LT predicate on the "reverted_at" field.
+func RevertedAtLT(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldRevertedAt, v))
+}
+
+// RevertedAtLTE applies the LTE predicate on the "reverted_at" field.
+func RevertedAtLTE(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldRevertedAt, v))
+}
+
+// RevertedAtIsNil applies the IsNil predicate on the "reverted_at" field.
+func RevertedAtIsNil() predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIsNull(FieldRevertedAt))
+}
+
+// RevertedAtNotNil applies the NotNil predicate on the "reverted_at" field.
+func RevertedAtNotNil() predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotNull(FieldRevertedAt))
+}
+
+// IPAddressEQ applies the EQ predicate on the "ip_address" field.
+func IPAddressEQ(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// IPAddressNEQ applies the NEQ predicate on the "ip_address" field.
+func IPAddressNEQ(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldIPAddress, v))
+}
+
+// IPAddressIn applies the In predicate on the "ip_address" field.
+func IPAddressIn(vs ...string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldIPAddress, vs...))
+}
+
+// IPAddressNotIn applies the NotIn predicate on the "ip_address" field.
+func IPAddressNotIn(vs ...string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldIPAddress, vs...))
+}
+
+// IPAddressGT applies the GT predicate on the "ip_address" field.
+func IPAddressGT(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldIPAddress, v))
+}
+
+// IPAddressGTE applies the GTE predicate on the "ip_address" field.
+func IPAddressGTE(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldIPAddress, v))
+}
+
+// IPAddressLT applies the LT predicate on the "ip_address" field.
+func IPAddressLT(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldIPAddress, v))
+}
+
+// IPAddressLTE applies the LTE predicate on the "ip_address" field.
+func IPAddressLTE(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldIPAddress, v))
+}
+
+// IPAddressContains applies the Contains predicate on the "ip_address" field.
+func IPAddressContains(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldContains(FieldIPAddress, v))
+}
+
+// IPAddressHasPrefix applies the HasPrefix predicate on the "ip_address" field.
+func IPAddressHasPrefix(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldHasPrefix(FieldIPAddress, v))
+}
+
+// IPAddressHasSuffix applies the HasSuffix predicate on the "ip_address" field.
+func IPAddressHasSuffix(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldHasSuffix(FieldIPAddress, v))
+}
+
+// IPAddressIsNil applies the IsNil predicate on the "ip_address" field.
+func IPAddressIsNil() predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIsNull(FieldIPAddress))
+}
+
+// IPAddressNotNil applies the NotNil predicate on the "ip_address" field.
+func IPAddressNotNil() predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotNull(FieldIPAddress))
+}
+
+// IPAddressEqualFold applies the EqualFold predicate on the "ip_address" field.
+func IPAddressEqualFold(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEqualFold(FieldIPAddress, v))
+}
+
+// IPAddressContainsFold applies the ContainsFold predicate on the "ip_address" field.
+func IPAddressContainsFold(v string) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldContainsFold(FieldIPAddress, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailChanges) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailChanges) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailChanges) predicate.EmailChanges {
+	return predicate.EmailChanges(sql.NotPredicates(p))
+}
@@ -0,0 +1,1247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/emailchanges"
+)
+
+// EmailChangesCreate is the builder for creating a EmailChanges entity.
+type EmailChangesCreate struct {
+	config
+	mutation *EmailChangesMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (ecc *EmailChangesCreate) SetUserID(u uuid.UUID) *EmailChangesCreate {
+	ecc.mutation.SetUserID(u)
+	return ecc
+}
+
+// SetOldEmail sets the "old_email" field.
+func (ecc *EmailChangesCreate) SetOldEmail(s string) *EmailChangesCreate {
+	ecc.mutation.SetOldEmail(s)
+	return ecc
+}
+
+// SetOldEmailVerified sets the "old_email_verified" field.
+func (ecc *EmailChangesCreate) SetOldEmailVerified(b bool) *EmailChangesCreate {
+	ecc.mutation.SetOldEmailVerified(b)
+	return ecc
+}
+
+// SetNillableOldEmailVerified sets the "old_email_verified" field if the given value is not nil.
+func (ecc *EmailChangesCreate) SetNillableOldEmailVerified(b *bool) *EmailChangesCreate {
+	if b != nil {
+		ecc.SetOldEmailVerified(*b)
+	}
+	return ecc
+}
+
+// SetNewEmail sets the "new_email" field.
+func (ecc *EmailChangesCreate) SetNewEmail(s string) *EmailChangesCreate {
+	ecc.mutation.SetNewEmail(s)
+	return ecc
+}
+
+// SetToken sets the "token" field.
+func (ecc *EmailChangesCreate) SetToken(s string) *EmailChangesCreate {
+	ecc.mutation.SetToken(s)
+	return ecc
+}
+
+// SetUndoToken sets the "undo_token" field.
+func (ecc *EmailChangesCreate) SetUndoToken(s string) *EmailChangesCreate {
+	ecc.mutation.SetUndoToken(s)
+	return ecc
+}
+
+// SetStatus sets the "status" field.
+func (ecc *EmailChangesCreate) SetStatus(e emailchanges.Status) *EmailChangesCreate {
+	ecc.mutation.SetStatus(e)
+	return ecc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ecc *EmailChangesCreate) SetNillableStatus(e *emailchanges.Status) *EmailChangesCreate {
+	if e != nil {
+		ecc.SetStatus(*e)
+	}
+	return ecc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ecc *EmailChangesCreate) SetExpiresAt(t time.Time) *EmailChangesCreate {
+	ecc.mutation.SetExpiresAt(t)
+	return ecc
+}
+
+// SetUndoExpiresAt sets the "undo_expires_at" field.
+func (ecc *EmailChangesCreate) SetUndoExpiresAt(t time.Time) *EmailChangesCreate {
+	ecc.mutation.SetUndoExpiresAt(t)
+	return ecc
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (ecc *EmailChangesCreate) SetConfirmedAt(t time.Time) *EmailChangesCreate {
+	ecc.mutation.SetConfirmedAt(t)
+	return ecc
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (ecc *EmailChangesCreate) SetNillableConfirmedAt(t *time.Time) *EmailChangesCreate {
+	if t != nil {
+		ecc.SetConfirmedAt(*t)
+	}
+	return ecc
+}
+
+// SetRevertedAt sets the "reverted_at" field.
+func (ecc *EmailChangesCreate) SetRevertedAt(t time.Time) *EmailChangesCreate {
+	ecc.mutation.SetRevertedAt(t)
+	return ecc
+}
+
+// SetNillableRevertedAt sets the "reverted_at" field if the given value is not nil.
+func (ecc *EmailChangesCreate) SetNillableRevertedAt(t *time.Time) *EmailChangesCreate {
+	if t != nil {
+		ecc.SetRevertedAt(*t)
+	}
+	return ecc
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (ecc *EmailChangesCreate) SetIPAddress(s string) *EmailChangesCreate {
+	ecc.mutation.SetIPAddress(s)
+	return ecc
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (ecc *EmailChangesCreate) SetNillableIPAddress(s *string) *EmailChangesCreate {
+	if s != nil {
+		ecc.SetIPAddress(*s)
+	}
+	return ecc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ecc *EmailChangesCreate) SetCreatedAt(t time.Time) *EmailChangesCreate {
+	ecc.mutation.SetCreatedAt(t)
+	return ecc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ecc *EmailChangesCreate) SetNillableCreatedAt(t *time.Time) *EmailChangesCreate {
+	if t != nil {
+		ecc.SetCreatedAt(*t)
+	}
+	return ecc
+}
+
+// SetID sets the "id" field.
+func (ecc *EmailChangesCreate) SetID(u uuid.UUID) *EmailChangesCreate {
+	ecc.mutation.SetID(u)
+	return ecc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ecc *EmailChangesCreate) SetNillableID(u *uuid.UUID) *EmailChangesCreate {
+	if u != nil {
+		ecc.SetID(*u)
+	}
+	return ecc
+}
+
+// Mutation returns the EmailChangesMutation object of the builder.
+func (ecc *EmailChangesCreate) Mutation() *EmailChangesMutation {
+	return ecc.mutation
+}
+
+// Save creates the EmailChanges in the database.
+func (ecc *EmailChangesCreate) Save(ctx context.Context) (*EmailChanges, error) {
+	ecc.defaults()
+	return withHooks(ctx, ecc.sqlSave, ecc.mutation, ecc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ecc *EmailChangesCreate) SaveX(ctx context.Context) *EmailChanges {
+	v, err := ecc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecc *EmailChangesCreate) Exec(ctx context.Context) error {
+	_, err := ecc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecc *EmailChangesCreate) ExecX(ctx context.Context) {
+	if err := ecc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ecc *EmailChangesCreate) defaults() {
+	if _, ok := ecc.mutation.OldEmailVerified(); !ok {
+		v := emailchanges.DefaultOldEmailVerified
+		ecc.mutation.SetOldEmailVerified(v)
+	}
+	if _, ok := ecc.mutation.Status(); !ok {
+		v := emailchanges.DefaultStatus
+		ecc.mutation.SetStatus(v)
+	}
+	if _, ok := ecc.mutation.CreatedAt(); !ok {
+		v := emailchanges.DefaultCreatedAt()
+		ecc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ecc.mutation.ID(); !ok {
+		v := emailchanges.DefaultID()
+		ecc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecc *EmailChangesCreate) check() error {
+	if _, ok := ecc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EmailChanges.user_id"`)}
+	}
+	if _, ok := ecc.mutation.OldEmail(); !ok {
+		return &ValidationError{Name: "old_email", err: errors.New(`ent: missing required field "EmailChanges.old_email"`)}
+	}
+	if v, ok := ecc.mutation.OldEmail(); ok {
+		if err := emailchanges.OldEmailValidator(v); err != nil {
+			return &ValidationError{Name: "old_email", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.old_email": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.OldEmailVerified(); !ok {
+		return &ValidationError{Name: "old_email_verified", err: errors.New(`ent: missing required field "EmailChanges.old_email_verified"`)}
+	}
+	if _, ok := ecc.mutation.NewEmail(); !ok {
+		return &ValidationError{Name: "new_email", err: errors.New(`ent: missing required field "EmailChanges.new_email"`)}
+	}
+	if v, ok := ecc.mutation.NewEmail(); ok {
+		if err := emailchanges.NewEmailValidator(v); err != nil {
+			return &ValidationError{Name: "new_email", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.new_email": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "EmailChanges.token"`)}
+	}
+	if v, ok := ecc.mutation.Token(); ok {
+		if err := emailchanges.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.token": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.UndoToken(); !ok {
+		return &ValidationError{Name: "undo_token", err: errors.New(`ent: missing required field "EmailChanges.undo_token"`)}
+	}
+	if v, ok := ecc.mutation.UndoToken(); ok {
+		if err := emailchanges.UndoTokenValidator(v); err != nil {
+			return &ValidationError{Name: "undo_token", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.undo_token": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EmailChanges.status"`)}
+	}
+	if v, ok := ecc.mutation.Status(); ok {
+		if err := emailchanges.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.status": %w`, err)}
+		}
+	}
+	if _, ok := ecc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "EmailChanges.expires_at"`)}
+	}
+	if _, ok := ecc.mutation.UndoExpiresAt(); !ok {
+		return &ValidationError{Name: "undo_expires_at", err: errors.New(`ent: missing required field "EmailChanges.undo_expires_at"`)}
+	}
+	if _, ok := ecc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailChanges.created_at"`)}
+	}
+	return nil
+}
+
+func (ecc *EmailChangesCreate) sqlSave(ctx context.Context) (*EmailChanges, error) {
+	if err := ecc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ecc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ecc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ecc.mutation.id = &_node.ID
+	ecc.mutation.done = true
+	return _node, nil
+}
+
+func (ecc *EmailChangesCreate) createSpec() (*EmailChanges, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailChanges{config: ecc.config}
+		_spec = sqlgraph.NewCreateSpec(emailchanges.Table, sqlgraph.NewFieldSpec(emailchanges.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ecc.conflict
+	if id, ok := ecc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ecc.mutation.UserID(); ok {
+		_spec.SetField(emailchanges.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := ecc.mutation.OldEmail(); ok {
+		_spec.SetField(emailchanges.FieldOldEmail, field.TypeString, value)
+		_node.OldEmail = value
+	}
+	if value, ok := ecc.mutation.OldEmailVerified(); ok {
+		_spec.SetField(emailchanges.FieldOldEmailVerified, field.TypeBool, value)
+		_node.OldEmailVerified = value
+	}
+	if value, ok := ecc.mutation.NewEmail(); ok {
+		_spec.SetField(emailchanges.FieldNewEmail, field.TypeString, value)
+		_node.NewEmail = value
+	}
+	if value, ok := ecc.mutation.Token(); ok {
+		_spec.SetField(emailchanges.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := ecc.mutation.UndoToken(); ok {
+		_spec.SetField(emailchanges.FieldUndoToken, field.TypeString, value)
+		_node.UndoToken = value
+	}
+	if value, ok := ecc.mutation.Status(); ok {
+		_spec.SetField(emailchanges.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ecc.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailchanges.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := ecc.mutation.UndoExpiresAt(); ok {
+		_spec.SetField(emailchanges.FieldUndoExpiresAt, field.TypeTime, value)
+		_node.UndoExpiresAt = value
+	}
+	if value, ok := ecc.mutation.ConfirmedAt(); ok {
+		_spec.SetField(emailchanges.FieldConfirmedAt, field.TypeTime, value)
+		_node.ConfirmedAt = &value
+	}
+	if value, ok := ecc.mutation.RevertedAt(); ok {
+		_spec.SetField(emailchanges.FieldRevertedAt, field.TypeTime, value)
+		_node.RevertedAt = &value
+	}
+	if value, ok := ecc.mutation.IPAddress(); ok {
+		_spec.SetField(emailchanges.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := ecc.mutation.CreatedAt(); ok {
+		_spec.SetField(emailchanges.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailChanges.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailChangesUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (ecc *EmailChangesCreate) OnConflict(opts ...sql.ConflictOption) *EmailChangesUpsertOne {
+	ecc.conflict = opts
+	return &EmailChangesUpsertOne{
+		create: ecc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailChanges.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ecc *EmailChangesCreate) OnConflictColumns(columns ...string) *EmailChangesUpsertOne {
+	ecc.conflict = append(ecc.conflict, sql.ConflictColumns(columns...))
+	return &EmailChangesUpsertOne{
+		create: ecc,
+	}
+}
+
+type (
+	// EmailChangesUpsertOne is the builder for "upsert"-ing
+	//  one EmailChanges node.
+	EmailChangesUpsertOne struct {
+		create *EmailChangesCreate
+	}
+
+	// EmailChangesUpsert is the "OnConflict" setter.
+	EmailChangesUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *EmailChangesUpsert) SetUserID(v uuid.UUID) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateUserID() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldUserID)
+	return u
+}
+
+// SetOldEmail sets the "old_email" field.
+func (u *EmailChangesUpsert) SetOldEmail(v string) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldOldEmail, v)
+	return u
+}
+
+// UpdateOldEmail sets the "old_email" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateOldEmail() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldOldEmail)
+	return u
+}
+
+// SetOldEmailVerified sets the "old_email_verified" field.
+func (u *EmailChangesUpsert) SetOldEmailVerified(v bool) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldOldEmailVerified, v)
+	return u
+}
+
+// UpdateOldEmailVerified sets the "old_email_verified" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateOldEmailVerified() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldOldEmailVerified)
+	return u
+}
+
+// SetNewEmail sets the "new_email" field.
+func (u *EmailChangesUpsert) SetNewEmail(v string) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldNewEmail, v)
+	return u
+}
+
+// UpdateNewEmail sets the "new_email" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateNewEmail() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldNewEmail)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *EmailChangesUpsert) SetToken(v string) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateToken() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldToken)
+	return u
+}
+
+// SetUndoToken sets the "undo_token" field.
+func (u *EmailChangesUpsert) SetUndoToken(v string) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldUndoToken, v)
+	return u
+}
+
+// UpdateUndoToken sets the "undo_token" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateUndoToken() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldUndoToken)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailChangesUpsert) SetStatus(v emailchanges.Status) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateStatus() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldStatus)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *EmailChangesUpsert) SetExpiresAt(v time.Time) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateExpiresAt() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldExpiresAt)
+	return u
+}
+
+// SetUndoExpiresAt sets the "undo_expires_at" field.
+func (u *EmailChangesUpsert) SetUndoExpiresAt(v time.Time) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldUndoExpiresAt, v)
+	return u
+}
+
+// UpdateUndoExpiresAt sets the "undo_expires_at" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateUndoExpiresAt() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldUndoExpiresAt)
+	return u
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (u *EmailChangesUpsert) SetConfirmedAt(v time.Time) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldConfirmedAt, v)
+	return u
+}
+
+// UpdateConfirmedAt sets the "confirmed_at" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateConfirmedAt() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldConfirmedAt)
+	return u
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (u *EmailChangesUpsert) ClearConfirmedAt() *EmailChangesUpsert {
+	u.SetNull(emailchanges.FieldConfirmedAt)
+	return u
+}
+
+// SetRevertedAt sets the "reverted_at" field.
+func (u *EmailChangesUpsert) SetRevertedAt(v time.Time) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldRevertedAt, v)
+	return u
+}
+
+// UpdateRevertedAt sets the "reverted_at" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateRevertedAt() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldRevertedAt)
+	return u
+}
+
+// ClearRevertedAt clears the value of the "reverted_at" field.
+func (u *EmailChangesUpsert) ClearRevertedAt() *EmailChangesUpsert {
+	u.SetNull(emailchanges.FieldRevertedAt)
+	return u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *EmailChangesUpsert) SetIPAddress(v string) *EmailChangesUpsert {
+	u.Set(emailchanges.FieldIPAddress, v)
+	return u
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *EmailChangesUpsert) UpdateIPAddress() *EmailChangesUpsert {
+	u.SetExcluded(emailchanges.FieldIPAddress)
+	return u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *EmailChangesUpsert) ClearIPAddress() *EmailChangesUpsert {
+	u.SetNull(emailchanges.FieldIPAddress)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmailChanges.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailchanges.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailChangesUpsertOne) UpdateNewValues() *EmailChangesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(emailchanges.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(emailchanges.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailChanges.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmailChangesUpsertOne) Ignore() *EmailChangesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailChangesUpsertOne) DoNothing() *EmailChangesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailChangesCreate.OnConflict
+// documentation for more info.
+func (u *EmailChangesUpsertOne) Update(set func(*EmailChangesUpsert)) *EmailChangesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailChangesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmailChangesUpsertOne) SetUserID(v uuid.UUID) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateUserID() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetOldEmail sets the "old_email" field.
+func (u *EmailChangesUpsertOne) SetOldEmail(v string) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetOldEmail(v)
+	})
+}
+
+// UpdateOldEmail sets the "old_email" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateOldEmail() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateOldEmail()
+	})
+}
+
+// SetOldEmailVerified sets the "old_email_verified" field.
+func (u *EmailChangesUpsertOne) SetOldEmailVerified(v bool) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetOldEmailVerified(v)
+	})
+}
+
+// UpdateOldEmailVerified sets the "old_email_verified" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateOldEmailVerified() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateOldEmailVerified()
+	})
+}
+
+// SetNewEmail sets the "new_email" field.
+func (u *EmailChangesUpsertOne) SetNewEmail(v string) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetNewEmail(v)
+	})
+}
+
+// UpdateNewEmail sets the "new_email" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateNewEmail() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateNewEmail()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *EmailChangesUpsertOne) SetToken(v string) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateToken() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetUndoToken sets the "undo_token" field.
+func (u *EmailChangesUpsertOne) SetUndoToken(v string) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetUndoToken(v)
+	})
+}
+
+// UpdateUndoToken sets the "undo_token" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateUndoToken() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateUndoToken()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailChangesUpsertOne) SetStatus(v emailchanges.Status) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateStatus() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *EmailChangesUpsertOne) SetExpiresAt(v time.Time) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateExpiresAt() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUndoExpiresAt sets the "undo_expires_at" field.
+func (u *EmailChangesUpsertOne) SetUndoExpiresAt(v time.Time) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetUndoExpiresAt(v)
+	})
+}
+
+// UpdateUndoExpiresAt sets the "undo_expires_at" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateUndoExpiresAt() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateUndoExpiresAt()
+	})
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (u *EmailChangesUpsertOne) SetConfirmedAt(v time.Time) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetConfirmedAt(v)
+	})
+}
+
+// UpdateConfirmedAt sets the "confirmed_at" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateConfirmedAt() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateConfirmedAt()
+	})
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (u *EmailChangesUpsertOne) ClearConfirmedAt() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.ClearConfirmedAt()
+	})
+}
+
+// SetRevertedAt sets the "reverted_at" field.
+func (u *EmailChangesUpsertOne) SetRevertedAt(v time.Time) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetRevertedAt(v)
+	})
+}
+
+// UpdateRevertedAt sets the "reverted_at" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateRevertedAt() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateRevertedAt()
+	})
+}
+
+// ClearRevertedAt clears the value of the "reverted_at" field.
+func (u *EmailChangesUpsertOne) ClearRevertedAt() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.ClearRevertedAt()
+	})
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *EmailChangesUpsertOne) SetIPAddress(v string) *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *EmailChangesUpsertOne) UpdateIPAddress() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *EmailChangesUpsertOne) ClearIPAddress() *EmailChangesUpsertOne {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailChangesUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailChangesCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailChangesUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmailChangesUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmailChangesUpsertOne.ID is not supported by MySQL driver. Use EmailChangesUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmailChangesUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmailChangesCreateBulk is the builder for creating many EmailChanges entities in bulk.
+type EmailChangesCreateBulk struct {
+	config
+	err      error
+	builders []*EmailChangesCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmailChanges entities in the database.
+func (eccb *EmailChangesCreateBulk) Save(ctx context.Context) ([]*EmailChanges, error) {
+	if eccb.err != nil {
+		return nil, eccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eccb.builders))
+	nodes := make([]*EmailChanges, len(eccb.builders))
+	mutators := make([]Mutator, len(eccb.builders))
+	for i := range eccb.builders {
+		func(i int, root context.Context) {
+			builder := eccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailChangesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = eccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eccb *EmailChangesCreateBulk) SaveX(ctx context.Context) []*EmailChanges {
+	v, err := eccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eccb *EmailChangesCreateBulk) Exec(ctx context.Context) error {
+	_, err := eccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eccb *EmailChangesCreateBulk) ExecX(ctx context.Context) {
+	if err := eccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailChanges.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailChangesUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (eccb *EmailChangesCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmailChangesUpsertBulk {
+	eccb.conflict = opts
+	return &EmailChangesUpsertBulk{
+		create: eccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailChanges.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (eccb *EmailChangesCreateBulk) OnConflictColumns(columns ...string) *EmailChangesUpsertBulk {
+	eccb.conflict = append(eccb.conflict, sql.ConflictColumns(columns...))
+	return &EmailChangesUpsertBulk{
+		create: eccb,
+	}
+}
+
+// EmailChangesUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmailChanges nodes.
+type EmailChangesUpsertBulk struct {
+	create *EmailChangesCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmailChanges.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailchanges.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailChangesUpsertBulk) UpdateNewValues() *EmailChangesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(emailchanges.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(emailchanges.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailChanges.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmailChangesUpsertBulk) Ignore() *EmailChangesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailChangesUpsertBulk) DoNothing() *EmailChangesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailChangesCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmailChangesUpsertBulk) Update(set func(*EmailChangesUpsert)) *EmailChangesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailChangesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmailChangesUpsertBulk) SetUserID(v uuid.UUID) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateUserID() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetOldEmail sets the "old_email" field.
+func (u *EmailChangesUpsertBulk) SetOldEmail(v string) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetOldEmail(v)
+	})
+}
+
+// UpdateOldEmail sets the "old_email" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateOldEmail() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateOldEmail()
+	})
+}
+
+// SetOldEmailVerified sets the "old_email_verified" field.
+func (u *EmailChangesUpsertBulk) SetOldEmailVerified(v bool) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetOldEmailVerified(v)
+	})
+}
+
+// UpdateOldEmailVerified sets the "old_email_verified" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateOldEmailVerified() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateOldEmailVerified()
+	})
+}
+
+// SetNewEmail sets the "new_email" field.
+func (u *EmailChangesUpsertBulk) SetNewEmail(v string) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetNewEmail(v)
+	})
+}
+
+// UpdateNewEmail sets the "new_email" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateNewEmail() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateNewEmail()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *EmailChangesUpsertBulk) SetToken(v string) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateToken() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetUndoToken sets the "undo_token" field.
+func (u *EmailChangesUpsertBulk) SetUndoToken(v string) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetUndoToken(v)
+	})
+}
+
+// UpdateUndoToken sets the "undo_token" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateUndoToken() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateUndoToken()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailChangesUpsertBulk) SetStatus(v emailchanges.Status) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateStatus() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *EmailChangesUpsertBulk) SetExpiresAt(v time.Time) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateExpiresAt() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetUndoExpiresAt sets the "undo_expires_at" field.
+func (u *EmailChangesUpsertBulk) SetUndoExpiresAt(v time.Time) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetUndoExpiresAt(v)
+	})
+}
+
+// UpdateUndoExpiresAt sets the "undo_expires_at" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateUndoExpiresAt() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateUndoExpiresAt()
+	})
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (u *EmailChangesUpsertBulk) SetConfirmedAt(v time.Time) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetConfirmedAt(v)
+	})
+}
+
+// UpdateConfirmedAt sets the "confirmed_at" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateConfirmedAt() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateConfirmedAt()
+	})
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (u *EmailChangesUpsertBulk) ClearConfirmedAt() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.ClearConfirmedAt()
+	})
+}
+
+// SetRevertedAt sets the "reverted_at" field.
+func (u *EmailChangesUpsertBulk) SetRevertedAt(v time.Time) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetRevertedAt(v)
+	})
+}
+
+// UpdateRevertedAt sets the "reverted_at" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateRevertedAt() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateRevertedAt()
+	})
+}
+
+// ClearRevertedAt clears the value of the "reverted_at" field.
+func (u *EmailChangesUpsertBulk) ClearRevertedAt() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.ClearRevertedAt()
+	})
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *EmailChangesUpsertBulk) SetIPAddress(v string) *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *EmailChangesUpsertBulk) UpdateIPAddress() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *EmailChangesUpsertBulk) ClearIPAddress() *EmailChangesUpsertBulk {
+	return u.Update(func(s *EmailChangesUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailChangesUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmailChangesCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailChangesCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailChangesUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
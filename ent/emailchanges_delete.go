@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/emailchanges"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// EmailChangesDelete is the builder for deleting a EmailChanges entity.
+type EmailChangesDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmailChangesMutation
+}
+
+// Where appends a list predicates to the EmailChangesDelete builder.
+func (ecd *EmailChangesDelete) Where(ps ...predicate.EmailChanges) *EmailChangesDelete {
+	ecd.mutation.Where(ps...)
+	return ecd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ecd *EmailChangesDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ecd.sqlExec, ecd.mutation, ecd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecd *EmailChangesDelete) ExecX(ctx context.Context) int {
+	n, err := ecd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ecd *EmailChangesDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(emailchanges.Table, sqlgraph.NewFieldSpec(emailchanges.FieldID, field.TypeUUID))
+	if ps := ecd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ecd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ecd.mutation.done = true
+	return affected, err
+}
+
+// EmailChangesDeleteOne is the builder for deleting a single EmailChanges entity.
+type EmailChangesDeleteOne struct {
+	ecd *EmailChangesDelete
+}
+
+// Where appends a list predicates to the EmailChangesDelete builder.
+func (ecdo *EmailChangesDeleteOne) Where(ps ...predicate.EmailChanges) *EmailChangesDeleteOne {
+	ecdo.ecd.mutation.Where(ps...)
+	return ecdo
+}
+
+// Exec executes the deletion query.
+func (ecdo *EmailChangesDeleteOne) Exec(ctx context.Context) error {
+	n, err := ecdo.ecd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{emailchanges.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecdo *EmailChangesDeleteOne) ExecX(ctx context.Context) {
+	if err := ecdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/emailchanges"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// EmailChangesQuery is the builder for querying EmailChanges entities.
+type EmailChangesQuery struct {
+	config
+	ctx        *QueryContext
+	order      []emailchanges.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EmailChanges
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EmailChangesQuery builder.
+func (ecq *EmailChangesQuery) Where(ps ...predicate.EmailChanges) *EmailChangesQuery {
+	ecq.predicates = append(ecq.predicates, ps...)
+	return ecq
+}
+
+// Limit the number of records to be returned by this query.
+func (ecq *EmailChangesQuery) Limit(limit int) *EmailChangesQuery {
+	ecq.ctx.Limit = &limit
+	return ecq
+}
+
+// Offset to start from.
+func (ecq *EmailChangesQuery) Offset(offset int) *EmailChangesQuery {
+	ecq.ctx.Offset = &offset
+	return ecq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ecq *EmailChangesQuery) Unique(unique bool) *EmailChangesQuery {
+	ecq.ctx.Unique = &unique
+	return ecq
+}
+
+// Order specifies how the records should be ordered.
+func (ecq *EmailChangesQuery) Order(o ...emailchanges.OrderOption) *EmailChangesQuery {
+	ecq.order = append(ecq.order, o...)
+	return ecq
+}
+
+// First returns the first EmailChanges entity from the query.
+// Returns a *NotFoundError when no EmailChanges was found.
+func (ecq *EmailChangesQuery) First(ctx context.Context) (*EmailChanges, error) {
+	nodes, err := ecq.Limit(1).All(setContextOp(ctx, ecq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{emailchanges.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ecq *EmailChangesQuery) FirstX(ctx context.Context) *EmailChanges {
+	node, err := ecq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EmailChanges ID from the query.
+// Returns a *NotFoundError when no EmailChanges ID was found.
+func (ecq *EmailChangesQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ecq.Limit(1).IDs(setContextOp(ctx, ecq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{emailchanges.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ecq *EmailChangesQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ecq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EmailChanges entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EmailChanges entity is found.
+// Returns a *NotFoundError when no EmailChanges entities are found.
+func (ecq *EmailChangesQuery) Only(ctx context.Context) (*EmailChanges, error) {
+	nodes, err := ecq.Limit(2).All(setContextOp(ctx, ecq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{emailchanges.Label}
+	default:
+		return nil, &NotSingularError{emailchanges.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ecq *EmailChangesQuery) OnlyX(ctx context.Context) *EmailChanges {
+	node, err := ecq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EmailChanges ID in the query.
+// Returns a *NotSingularError when more than one EmailChanges ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ecq *EmailChangesQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ecq.Limit(2).IDs(setContextOp(ctx, ecq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{emailchanges.Label}
+	default:
+		err = &NotSingularError{emailchanges.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ecq *EmailChangesQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ecq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EmailChangesSlice.
+func (ecq *EmailChangesQuery) All(ctx context.Context) ([]*EmailChanges, error) {
+	ctx = setContextOp(ctx, ecq.ctx, "All")
+	if err := ecq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EmailChanges, *EmailChangesQuery]()
+	return withInterceptors[[]*EmailChanges](ctx, ecq, qr, ecq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ecq *EmailChangesQuery) AllX(ctx context.Context) []*EmailChanges {
+	nodes, err := ecq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EmailChanges IDs.
+func (ecq *EmailChangesQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ecq.ctx.Unique == nil && ecq.path != nil {
+		ecq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ecq.ctx, "IDs")
+	if err = ecq.Select(emailchanges.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ecq *EmailChangesQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ecq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ecq *EmailChangesQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ecq.ctx, "Count")
+	if err := ecq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ecq, querierCount[*EmailChangesQuery](), ecq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ecq *EmailChangesQuery) CountX(ctx context.Context) int {
+	count, err := ecq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ecq *EmailChangesQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ecq.ctx, "Exist")
+	switch _, err := ecq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ecq *EmailChangesQuery) ExistX(ctx context.Context) bool {
+	exist, err := ecq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EmailChangesQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ecq *EmailChangesQuery) Clone() *EmailChangesQuery {
+	if ecq == nil {
+		return nil
+	}
+	return &EmailChangesQuery{
+		config:     ecq.config,
+		ctx:        ecq.ctx.Clone(),
+		order:      append([]emailchanges.OrderOption{}, ecq.order...),
+		inters:     append([]Interceptor{}, ecq.inters...),
+		predicates: append([]predicate.EmailChanges{}, ecq.predicates...),
+		// clone intermediate query.
+		sql:  ecq.sql.Clone(),
+		path: ecq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EmailChanges.Query().
+//		GroupBy(emailchanges.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ecq *EmailChangesQuery) GroupBy(field string, fields ...string) *EmailChangesGroupBy {
+	ecq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EmailChangesGroupBy{build: ecq}
+	grbuild.flds = &ecq.ctx.Fields
+	grbuild.label = emailchanges.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.EmailChanges.Query().
+//		Select(emailchanges.FieldUserID).
+//		Scan(ctx, &v)
+func (ecq *EmailChangesQuery) Select(fields ...string) *EmailChangesSelect {
+	ecq.ctx.Fields = append(ecq.ctx.Fields, fields...)
+	sbuild := &EmailChangesSelect{EmailChangesQuery: ecq}
+	sbuild.label = emailchanges.Label
+	sbuild.flds, sbuild.scan = &ecq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EmailChangesSelect configured with the given aggregations.
+func (ecq *EmailChangesQuery) Aggregate(fns ...AggregateFunc) *EmailChangesSelect {
+	return ecq.Select().Aggregate(fns...)
+}
+
+func (ecq *EmailChangesQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ecq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ecq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ecq.ctx.Fields {
+		if !emailchanges.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ecq.path != nil {
+		prev, err := ecq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ecq.sql = prev
+	}
+	return nil
+}
+
+func (ecq *EmailChangesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EmailChanges, error) {
+	var (
+		nodes = []*EmailChanges{}
+		_spec = ecq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EmailChanges).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EmailChanges{config: ecq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ecq.modifiers) > 0 {
+		_spec.Modifiers = ecq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ecq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ecq *EmailChangesQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ecq.querySpec()
+	if len(ecq.modifiers) > 0 {
+		_spec.Modifiers = ecq.modifiers
+	}
+	_spec.Node.Columns = ecq.ctx.Fields
+	if len(ecq.ctx.Fields) > 0 {
+		_spec.Unique = ecq.ctx.Unique != nil && *ecq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ecq.driver, _spec)
+}
+
+func (ecq *EmailChangesQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(emailchanges.Table, emailchanges.Columns, sqlgraph.NewFieldSpec(emailchanges.FieldID, field.TypeUUID))
+	_spec.From = ecq.sql
+	if unique := ecq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ecq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ecq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailchanges.FieldID)
+		for i := range fields {
+			if fields[i] != emailchanges.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ecq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ecq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ecq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ecq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ecq *EmailChangesQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ecq.driver.Dialect())
+	t1 := builder.Table(emailchanges.Table)
+	columns := ecq.ctx.Fields
+	if len(columns) == 0 {
+		columns = emailchanges.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ecq.sql != nil {
+		selector = ecq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ecq.ctx.Unique != nil && *ecq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ecq.modifiers {
+		m(selector)
+	}
+	for _, p := range ecq.predicates {
+		p(selector)
+	}
+	for _, p := range ecq.order {
+		p(selector)
+	}
+	if offset := ecq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ecq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ecq *EmailChangesQuery) ForUpdate(opts ...sql.LockOption) *EmailChangesQuery {
+	if ecq.driver.Dialect() == dialect.Postgres {
+		ecq.Unique(false)
+	}
+	ecq.modifiers = append(ecq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ecq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ecq *EmailChangesQuery) ForShare(opts ...sql.LockOption) *EmailChangesQuery {
+	if ecq.driver.Dialect() == dialect.Postgres {
+		ecq.Unique(false)
+	}
+	ecq.modifiers = append(ecq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ecq
+}
+
+// EmailChangesGroupBy is the group-by builder for EmailChanges entities.
+type EmailChangesGroupBy struct {
+	selector
+	build *EmailChangesQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ecgb *EmailChangesGroupBy) Aggregate(fns ...AggregateFunc) *EmailChangesGroupBy {
+	ecgb.fns = append(ecgb.fns, fns...)
+	return ecgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ecgb *EmailChangesGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ecgb.build.ctx, "GroupBy")
+	if err := ecgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmailChangesQuery, *EmailChangesGroupBy](ctx, ecgb.build, ecgb, ecgb.build.inters, v)
+}
+
+func (ecgb *EmailChangesGroupBy) sqlScan(ctx context.Context, root *EmailChangesQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ecgb.fns))
+	for _, fn := range ecgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ecgb.flds)+len(ecgb.fns))
+		for _, f := range *ecgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ecgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ecgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EmailChangesSelect is the builder for selecting fields of EmailChanges entities.
+type EmailChangesSelect struct {
+	*EmailChangesQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ecs *EmailChangesSelect) Aggregate(fns ...AggregateFunc) *EmailChangesSelect {
+	ecs.fns = append(ecs.fns, fns...)
+	return ecs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ecs *EmailChangesSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ecs.ctx, "Select")
+	if err := ecs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmailChangesQuery, *EmailChangesSelect](ctx, ecs.EmailChangesQuery, ecs, ecs.inters, v)
+}
+
+func (ecs *EmailChangesSelect) sqlScan(ctx context.Context, root *EmailChangesQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ecs.fns))
+	for _, fn := range ecs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ecs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ecs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/emailchanges"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// EmailChangesUpdate is the builder for updating EmailChanges entities.
+type EmailChangesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailChangesMutation
+}
+
+// Where appends a list predicates to the EmailChangesUpdate builder.
+func (ecu *EmailChangesUpdate) Where(ps ...predicate.EmailChanges) *EmailChangesUpdate {
+	ecu.mutation.Where(ps...)
+	return ecu
+}
+
+// SetUserID sets the "user_id" field.
+func (ecu *EmailChangesUpdate) SetUserID(u uuid.UUID) *EmailChangesUpdate {
+	ecu.mutation.SetUserID(u)
+	return ecu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableUserID(u *uuid.UUID) *EmailChangesUpdate {
+	if u != nil {
+		ecu.SetUserID(*u)
+	}
+	return ecu
+}
+
+// SetOldEmail sets the "old_email" field.
+func (ecu *EmailChangesUpdate) SetOldEmail(s string) *EmailChangesUpdate {
+	ecu.mutation.SetOldEmail(s)
+	return ecu
+}
+
+// SetNillableOldEmail sets the "old_email" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableOldEmail(s *string) *EmailChangesUpdate {
+	if s != nil {
+		ecu.SetOldEmail(*s)
+	}
+	return ecu
+}
+
+// SetOldEmailVerified sets the "old_email_verified" field.
+func (ecu *EmailChangesUpdate) SetOldEmailVerified(b bool) *EmailChangesUpdate {
+	ecu.mutation.SetOldEmailVerified(b)
+	return ecu
+}
+
+// SetNillableOldEmailVerified sets the "old_email_verified" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableOldEmailVerified(b *bool) *EmailChangesUpdate {
+	if b != nil {
+		ecu.SetOldEmailVerified(*b)
+	}
+	return ecu
+}
+
+// SetNewEmail sets the "new_email" field.
+func (ecu *EmailChangesUpdate) SetNewEmail(s string) *EmailChangesUpdate {
+	ecu.mutation.SetNewEmail(s)
+	return ecu
+}
+
+// SetNillableNewEmail sets the "new_email" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableNewEmail(s *string) *EmailChangesUpdate {
+	if s != nil {
+		ecu.SetNewEmail(*s)
+	}
+	return ecu
+}
+
+// SetToken sets the "token" field.
+func (ecu *EmailChangesUpdate) SetToken(s string) *EmailChangesUpdate {
+	ecu.mutation.SetToken(s)
+	return ecu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableToken(s *string) *EmailChangesUpdate {
+	if s != nil {
+		ecu.SetToken(*s)
+	}
+	return ecu
+}
+
+// SetUndoToken sets the "undo_token" field.
+func (ecu *EmailChangesUpdate) SetUndoToken(s string) *EmailChangesUpdate {
+	ecu.mutation.SetUndoToken(s)
+	return ecu
+}
+
+// SetNillableUndoToken sets the "undo_token" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableUndoToken(s *string) *EmailChangesUpdate {
+	if s != nil {
+		ecu.SetUndoToken(*s)
+	}
+	return ecu
+}
+
+// SetStatus sets the "status" field.
+func (ecu *EmailChangesUpdate) SetStatus(e emailchanges.Status) *EmailChangesUpdate {
+	ecu.mutation.SetStatus(e)
+	return ecu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableStatus(e *emailchanges.Status) *EmailChangesUpdate {
+	if e != nil {
+		ecu.SetStatus(*e)
+	}
+	return ecu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ecu *EmailChangesUpdate) SetExpiresAt(t time.Time) *EmailChangesUpdate {
+	ecu.mutation.SetExpiresAt(t)
+	return ecu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableExpiresAt(t *time.Time) *EmailChangesUpdate {
+	if t != nil {
+		ecu.SetExpiresAt(*t)
+	}
+	return ecu
+}
+
+// SetUndoExpiresAt sets the "undo_expires_at" field.
+func (ecu *EmailChangesUpdate) SetUndoExpiresAt(t time.Time) *EmailChangesUpdate {
+	ecu.mutation.SetUndoExpiresAt(t)
+	return ecu
+}
+
+// SetNillableUndoExpiresAt sets the "undo_expires_at" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableUndoExpiresAt(t *time.Time) *EmailChangesUpdate {
+	if t != nil {
+		ecu.SetUndoExpiresAt(*t)
+	}
+	return ecu
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (ecu *EmailChangesUpdate) SetConfirmedAt(t time.Time) *EmailChangesUpdate {
+	ecu.mutation.SetConfirmedAt(t)
+	return ecu
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableConfirmedAt(t *time.Time) *EmailChangesUpdate {
+	if t != nil {
+		ecu.SetConfirmedAt(*t)
+	}
+	return ecu
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (ecu *EmailChangesUpdate) ClearConfirmedAt() *EmailChangesUpdate {
+	ecu.mutation.ClearConfirmedAt()
+	return ecu
+}
+
+// SetRevertedAt sets the "reverted_at" field.
+func (ecu *EmailChangesUpdate) SetRevertedAt(t time.Time) *EmailChangesUpdate {
+	ecu.mutation.SetRevertedAt(t)
+	return ecu
+}
+
+// SetNillableRevertedAt sets the "reverted_at" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableRevertedAt(t *time.Time) *EmailChangesUpdate {
+	if t != nil {
+		ecu.SetRevertedAt(*t)
+	}
+	return ecu
+}
+
+// ClearRevertedAt clears the value of the "reverted_at" field.
+func (ecu *EmailChangesUpdate) ClearRevertedAt() *EmailChangesUpdate {
+	ecu.mutation.ClearRevertedAt()
+	return ecu
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (ecu *EmailChangesUpdate) SetIPAddress(s string) *EmailChangesUpdate {
+	ecu.mutation.SetIPAddress(s)
+	return ecu
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (ecu *EmailChangesUpdate) SetNillableIPAddress(s *string) *EmailChangesUpdate {
+	if s != nil {
+		ecu.SetIPAddress(*s)
+	}
+	return ecu
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (ecu *EmailChangesUpdate) ClearIPAddress() *EmailChangesUpdate {
+	ecu.mutation.ClearIPAddress()
+	return ecu
+}
+
+// Mutation returns the EmailChangesMutation object of the builder.
+func (ecu *EmailChangesUpdate) Mutation() *EmailChangesMutation {
+	return ecu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ecu *EmailChangesUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ecu.sqlSave, ecu.mutation, ecu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecu *EmailChangesUpdate) SaveX(ctx context.Context) int {
+	affected, err := ecu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ecu *EmailChangesUpdate) Exec(ctx context.Context) error {
+	_, err := ecu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecu *EmailChangesUpdate) ExecX(ctx context.Context) {
+	if err := ecu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecu *EmailChangesUpdate) check() error {
+	if v, ok := ecu.mutation.OldEmail(); ok {
+		if err := emailchanges.OldEmailValidator(v); err != nil {
+			return &ValidationError{Name: "old_email", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.old_email": %w`, err)}
+		}
+	}
+	if v, ok := ecu.mutation.NewEmail(); ok {
+		if err := emailchanges.NewEmailValidator(v); err != nil {
+			return &ValidationError{Name: "new_email", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.new_email": %w`, err)}
+		}
+	}
+	if v, ok := ecu.mutation.Token(); ok {
+		if err := emailchanges.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.token": %w`, err)}
+		}
+	}
+	if v, ok := ecu.mutation.UndoToken(); ok {
+		if err := emailchanges.UndoTokenValidator(v); err != nil {
+			return &ValidationError{Name: "undo_token", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.undo_token": %w`, err)}
+		}
+	}
+	if v, ok := ecu.mutation.Status(); ok {
+		if err := emailchanges.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ecu *EmailChangesUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ecu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailchanges.Table, emailchanges.Columns, sqlgraph.NewFieldSpec(emailchanges.FieldID, field.TypeUUID))
+	if ps := ecu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecu.mutation.UserID(); ok {
+		_spec.SetField(emailchanges.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := ecu.mutation.OldEmail(); ok {
+		_spec.SetField(emailchanges.FieldOldEmail, field.TypeString, value)
+	}
+	if value, ok := ecu.mutation.OldEmailVerified(); ok {
+		_spec.SetField(emailchanges.FieldOldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := ecu.mutation.NewEmail(); ok {
+		_spec.SetField(emailchanges.FieldNewEmail, field.TypeString, value)
+	}
+	if value, ok := ecu.mutation.Token(); ok {
+		_spec.SetField(emailchanges.FieldToken, field.TypeString, value)
+	}
+	if value, ok := ecu.mutation.UndoToken(); ok {
+		_spec.SetField(emailchanges.FieldUndoToken, field.TypeString, value)
+	}
+	if value, ok := ecu.mutation.Status(); ok {
+		_spec.SetField(emailchanges.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ecu.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailchanges.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := ecu.mutation.UndoExpiresAt(); ok {
+		_spec.SetField(emailchanges.FieldUndoExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := ecu.mutation.ConfirmedAt(); ok {
+		_spec.SetField(emailchanges.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if ecu.mutation.ConfirmedAtCleared() {
+		_spec.ClearField(emailchanges.FieldConfirmedAt, field.TypeTime)
+	}
+	if value, ok := ecu.mutation.RevertedAt(); ok {
+		_spec.SetField(emailchanges.FieldRevertedAt, field.TypeTime, value)
+	}
+	if ecu.mutation.RevertedAtCleared() {
+		_spec.ClearField(emailchanges.FieldRevertedAt, field.TypeTime)
+	}
+	if value, ok := ecu.mutation.IPAddress(); ok {
+		_spec.SetField(emailchanges.FieldIPAddress, field.TypeString, value)
+	}
+	if ecu.mutation.IPAddressCleared() {
+		_spec.ClearField(emailchanges.FieldIPAddress, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ecu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailchanges.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ecu.mutation.done = true
+	return n, nil
+}
+
+// EmailChangesUpdateOne is the builder for updating a single EmailChanges entity.
+type EmailChangesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailChangesMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (ecuo *EmailChangesUpdateOne) SetUserID(u uuid.UUID) *EmailChangesUpdateOne {
+	ecuo.mutation.SetUserID(u)
+	return ecuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableUserID(u *uuid.UUID) *EmailChangesUpdateOne {
+	if u != nil {
+		ecuo.SetUserID(*u)
+	}
+	return ecuo
+}
+
+// SetOldEmail sets the "old_email" field.
+func (ecuo *EmailChangesUpdateOne) SetOldEmail(s string) *EmailChangesUpdateOne {
+	ecuo.mutation.SetOldEmail(s)
+	return ecuo
+}
+
+// SetNillableOldEmail sets the "old_email" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableOldEmail(s *string) *EmailChangesUpdateOne {
+	if s != nil {
+		ecuo.SetOldEmail(*s)
+	}
+	return ecuo
+}
+
+// SetOldEmailVerified sets the "old_email_verified" field.
+func (ecuo *EmailChangesUpdateOne) SetOldEmailVerified(b bool) *EmailChangesUpdateOne {
+	ecuo.mutation.SetOldEmailVerified(b)
+	return ecuo
+}
+
+// SetNillableOldEmailVerified sets the "old_email_verified" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableOldEmailVerified(b *bool) *EmailChangesUpdateOne {
+	if b != nil {
+		ecuo.SetOldEmailVerified(*b)
+	}
+	return ecuo
+}
+
+// SetNewEmail sets the "new_email" field.
+func (ecuo *EmailChangesUpdateOne) SetNewEmail(s string) *EmailChangesUpdateOne {
+	ecuo.mutation.SetNewEmail(s)
+	return ecuo
+}
+
+// SetNillableNewEmail sets the "new_email" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableNewEmail(s *string) *EmailChangesUpdateOne {
+	if s != nil {
+		ecuo.SetNewEmail(*s)
+	}
+	return ecuo
+}
+
+// SetToken sets the "token" field.
+func (ecuo *EmailChangesUpdateOne) SetToken(s string) *EmailChangesUpdateOne {
+	ecuo.mutation.SetToken(s)
+	return ecuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableToken(s *string) *EmailChangesUpdateOne {
+	if s != nil {
+		ecuo.SetToken(*s)
+	}
+	return ecuo
+}
+
+// SetUndoToken sets the "undo_token" field.
+func (ecuo *EmailChangesUpdateOne) SetUndoToken(s string) *EmailChangesUpdateOne {
+	ecuo.mutation.SetUndoToken(s)
+	return ecuo
+}
+
+// SetNillableUndoToken sets the "undo_token" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableUndoToken(s *string) *EmailChangesUpdateOne {
+	if s != nil {
+		ecuo.SetUndoToken(*s)
+	}
+	return ecuo
+}
+
+// SetStatus sets the "status" field.
+func (ecuo *EmailChangesUpdateOne) SetStatus(e emailchanges.Status) *EmailChangesUpdateOne {
+	ecuo.mutation.SetStatus(e)
+	return ecuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableStatus(e *emailchanges.Status) *EmailChangesUpdateOne {
+	if e != nil {
+		ecuo.SetStatus(*e)
+	}
+	return ecuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ecuo *EmailChangesUpdateOne) SetExpiresAt(t time.Time) *EmailChangesUpdateOne {
+	ecuo.mutation.SetExpiresAt(t)
+	return ecuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableExpiresAt(t *time.Time) *EmailChangesUpdateOne {
+	if t != nil {
+		ecuo.SetExpiresAt(*t)
+	}
+	return ecuo
+}
+
+// SetUndoExpiresAt sets the "undo_expires_at" field.
+func (ecuo *EmailChangesUpdateOne) SetUndoExpiresAt(t time.Time) *EmailChangesUpdateOne {
+	ecuo.mutation.SetUndoExpiresAt(t)
+	return ecuo
+}
+
+// SetNillableUndoExpiresAt sets the "undo_expires_at" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableUndoExpiresAt(t *time.Time) *EmailChangesUpdateOne {
+	if t != nil {
+		ecuo.SetUndoExpiresAt(*t)
+	}
+	return ecuo
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (ecuo *EmailChangesUpdateOne) SetConfirmedAt(t time.Time) *EmailChangesUpdateOne {
+	ecuo.mutation.SetConfirmedAt(t)
+	return ecuo
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableConfirmedAt(t *time.Time) *EmailChangesUpdateOne {
+	if t != nil {
+		ecuo.SetConfirmedAt(*t)
+	}
+	return ecuo
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (ecuo *EmailChangesUpdateOne) ClearConfirmedAt() *EmailChangesUpdateOne {
+	ecuo.mutation.ClearConfirmedAt()
+	return ecuo
+}
+
+// SetRevertedAt sets the "reverted_at" field.
+func (ecuo *EmailChangesUpdateOne) SetRevertedAt(t time.Time) *EmailChangesUpdateOne {
+	ecuo.mutation.SetRevertedAt(t)
+	return ecuo
+}
+
+// SetNillableRevertedAt sets the "reverted_at" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableRevertedAt(t *time.Time) *EmailChangesUpdateOne {
+	if t != nil {
+		ecuo.SetRevertedAt(*t)
+	}
+	return ecuo
+}
+
+// ClearRevertedAt clears the value of the "reverted_at" field.
+func (ecuo *EmailChangesUpdateOne) ClearRevertedAt() *EmailChangesUpdateOne {
+	ecuo.mutation.ClearRevertedAt()
+	return ecuo
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (ecuo *EmailChangesUpdateOne) SetIPAddress(s string) *EmailChangesUpdateOne {
+	ecuo.mutation.SetIPAddress(s)
+	return ecuo
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (ecuo *EmailChangesUpdateOne) SetNillableIPAddress(s *string) *EmailChangesUpdateOne {
+	if s != nil {
+		ecuo.SetIPAddress(*s)
+	}
+	return ecuo
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (ecuo *EmailChangesUpdateOne) ClearIPAddress() *EmailChangesUpdateOne {
+	ecuo.mutation.ClearIPAddress()
+	return ecuo
+}
+
+// Mutation returns the EmailChangesMutation object of the builder.
+func (ecuo *EmailChangesUpdateOne) Mutation() *EmailChangesMutation {
+	return ecuo.mutation
+}
+
+// Where appends a list predicates to the EmailChangesUpdate builder.
+func (ecuo *EmailChangesUpdateOne) Where(ps ...predicate.EmailChanges) *EmailChangesUpdateOne {
+	ecuo.mutation.Where(ps...)
+	return ecuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ecuo *EmailChangesUpdateOne) Select(field string, fields ...string) *EmailChangesUpdateOne {
+	ecuo.fields = append([]string{field}, fields...)
+	return ecuo
+}
+
+// Save executes the query and returns the updated EmailChanges entity.
+func (ecuo *EmailChangesUpdateOne) Save(ctx context.Context) (*EmailChanges, error) {
+	return withHooks(ctx, ecuo.sqlSave, ecuo.mutation, ecuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecuo *EmailChangesUpdateOne) SaveX(ctx context.Context) *EmailChanges {
+	node, err := ecuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ecuo *EmailChangesUpdateOne) Exec(ctx context.Context) error {
+	_, err := ecuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecuo *EmailChangesUpdateOne) ExecX(ctx context.Context) {
+	if err := ecuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ecuo *EmailChangesUpdateOne) check() error {
+	if v, ok := ecuo.mutation.OldEmail(); ok {
+		if err := emailchanges.OldEmailValidator(v); err != nil {
+			return &ValidationError{Name: "old_email", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.old_email": %w`, err)}
+		}
+	}
+	if v, ok := ecuo.mutation.NewEmail(); ok {
+		if err := emailchanges.NewEmailValidator(v); err != nil {
+			return &ValidationError{Name: "new_email", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.new_email": %w`, err)}
+		}
+	}
+	if v, ok := ecuo.mutation.Token(); ok {
+		if err := emailchanges.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.token": %w`, err)}
+		}
+	}
+	if v, ok := ecuo.mutation.UndoToken(); ok {
+		if err := emailchanges.UndoTokenValidator(v); err != nil {
+			return &ValidationError{Name: "undo_token", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.undo_token": %w`, err)}
+		}
+	}
+	if v, ok := ecuo.mutation.Status(); ok {
+		if err := emailchanges.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailChanges.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ecuo *EmailChangesUpdateOne) sqlSave(ctx context.Context) (_node *EmailChanges, err error) {
+	if err := ecuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailchanges.Table, emailchanges.Columns, sqlgraph.NewFieldSpec(emailchanges.FieldID, field.TypeUUID))
+	id, ok := ecuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailChanges.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ecuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailchanges.FieldID)
+		for _, f := range fields {
+			if !emailchanges.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailchanges.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ecuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ecuo.mutation.UserID(); ok {
+		_spec.SetField(emailchanges.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := ecuo.mutation.OldEmail(); ok {
+		_spec.SetField(emailchanges.FieldOldEmail, field.TypeString, value)
+	}
+	if value, ok := ecuo.mutation.OldEmailVerified(); ok {
+		_spec.SetField(emailchanges.FieldOldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := ecuo.mutation.NewEmail(); ok {
+		_spec.SetField(emailchanges.FieldNewEmail, field.TypeString, value)
+	}
+	if value, ok := ecuo.mutation.Token(); ok {
+		_spec.SetField(emailchanges.FieldToken, field.TypeString, value)
+	}
+	if value, ok := ecuo.mutation.UndoToken(); ok {
+		_spec.SetField(emailchanges.FieldUndoToken, field.TypeString, value)
+	}
+	if value, ok := ecuo.mutation.Status(); ok {
+		_spec.SetField(emailchanges.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ecuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(emailchanges.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := ecuo.mutation.UndoExpiresAt(); ok {
+		_spec.SetField(emailchanges.FieldUndoExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := ecuo.mutation.ConfirmedAt(); ok {
+		_spec.SetField(emailchanges.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if ecuo.mutation.ConfirmedAtCleared() {
+		_spec.ClearField(emailchanges.FieldConfirmedAt, field.TypeTime)
+	}
+	if value, ok := ecuo.mutation.RevertedAt(); ok {
+		_spec.SetField(emailchanges.FieldRevertedAt, field.TypeTime, value)
+	}
+	if ecuo.mutation.RevertedAtCleared() {
+		_spec.ClearField(emailchanges.FieldRevertedAt, field.TypeTime)
+	}
+	if value, ok := ecuo.mutation.IPAddress(); ok {
+		_spec.SetField(emailchanges.FieldIPAddress, field.TypeString, value)
+	}
+	if ecuo.mutation.IPAddressCleared() {
+		_spec.ClearField(emailchanges.FieldIPAddress, field.TypeString)
+	}
+	_node = &EmailChanges{config: ecuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ecuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailchanges.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ecuo.mutation.done = true
+	return _node, nil
+}
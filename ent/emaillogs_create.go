@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -19,6 +21,7 @@ type EmailLogsCreate struct {
 	config
 	mutation *EmailLogsMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -276,6 +279,7 @@ func (elc *EmailLogsCreate) createSpec() (*EmailLogs, *sqlgraph.CreateSpec) {
 		_node = &EmailLogs{config: elc.config}
 		_spec = sqlgraph.NewCreateSpec(emaillogs.Table, sqlgraph.NewFieldSpec(emaillogs.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = elc.conflict
 	if id, ok := elc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -327,11 +331,488 @@ func (elc *EmailLogsCreate) createSpec() (*EmailLogs, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailLogs.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailLogsUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (elc *EmailLogsCreate) OnConflict(opts ...sql.ConflictOption) *EmailLogsUpsertOne {
+	elc.conflict = opts
+	return &EmailLogsUpsertOne{
+		create: elc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailLogs.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (elc *EmailLogsCreate) OnConflictColumns(columns ...string) *EmailLogsUpsertOne {
+	elc.conflict = append(elc.conflict, sql.ConflictColumns(columns...))
+	return &EmailLogsUpsertOne{
+		create: elc,
+	}
+}
+
+type (
+	// EmailLogsUpsertOne is the builder for "upsert"-ing
+	//  one EmailLogs node.
+	EmailLogsUpsertOne struct {
+		create *EmailLogsCreate
+	}
+
+	// EmailLogsUpsert is the "OnConflict" setter.
+	EmailLogsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *EmailLogsUpsert) SetUserID(v uuid.UUID) *EmailLogsUpsert {
+	u.Set(emaillogs.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailLogsUpsert) UpdateUserID() *EmailLogsUpsert {
+	u.SetExcluded(emaillogs.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *EmailLogsUpsert) ClearUserID() *EmailLogsUpsert {
+	u.SetNull(emaillogs.FieldUserID)
+	return u
+}
+
+// SetRecipient sets the "recipient" field.
+func (u *EmailLogsUpsert) SetRecipient(v string) *EmailLogsUpsert {
+	u.Set(emaillogs.FieldRecipient, v)
+	return u
+}
+
+// UpdateRecipient sets the "recipient" field to the value that was provided on create.
+func (u *EmailLogsUpsert) UpdateRecipient() *EmailLogsUpsert {
+	u.SetExcluded(emaillogs.FieldRecipient)
+	return u
+}
+
+// SetEmailType sets the "email_type" field.
+func (u *EmailLogsUpsert) SetEmailType(v string) *EmailLogsUpsert {
+	u.Set(emaillogs.FieldEmailType, v)
+	return u
+}
+
+// UpdateEmailType sets the "email_type" field to the value that was provided on create.
+func (u *EmailLogsUpsert) UpdateEmailType() *EmailLogsUpsert {
+	u.SetExcluded(emaillogs.FieldEmailType)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *EmailLogsUpsert) SetSubject(v string) *EmailLogsUpsert {
+	u.Set(emaillogs.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *EmailLogsUpsert) UpdateSubject() *EmailLogsUpsert {
+	u.SetExcluded(emaillogs.FieldSubject)
+	return u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (u *EmailLogsUpsert) ClearSubject() *EmailLogsUpsert {
+	u.SetNull(emaillogs.FieldSubject)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailLogsUpsert) SetStatus(v string) *EmailLogsUpsert {
+	u.Set(emaillogs.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailLogsUpsert) UpdateStatus() *EmailLogsUpsert {
+	u.SetExcluded(emaillogs.FieldStatus)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *EmailLogsUpsert) SetProvider(v string) *EmailLogsUpsert {
+	u.Set(emaillogs.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *EmailLogsUpsert) UpdateProvider() *EmailLogsUpsert {
+	u.SetExcluded(emaillogs.FieldProvider)
+	return u
+}
+
+// SetProviderMessageID sets the "provider_message_id" field.
+func (u *EmailLogsUpsert) SetProviderMessageID(v string) *EmailLogsUpsert {
+	u.Set(emaillogs.FieldProviderMessageID, v)
+	return u
+}
+
+// UpdateProviderMessageID sets the "provider_message_id" field to the value that was provided on create.
+func (u *EmailLogsUpsert) UpdateProviderMessageID() *EmailLogsUpsert {
+	u.SetExcluded(emaillogs.FieldProviderMessageID)
+	return u
+}
+
+// ClearProviderMessageID clears the value of the "provider_message_id" field.
+func (u *EmailLogsUpsert) ClearProviderMessageID() *EmailLogsUpsert {
+	u.SetNull(emaillogs.FieldProviderMessageID)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *EmailLogsUpsert) SetMetadata(v map[string]interface{}) *EmailLogsUpsert {
+	u.Set(emaillogs.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *EmailLogsUpsert) UpdateMetadata() *EmailLogsUpsert {
+	u.SetExcluded(emaillogs.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *EmailLogsUpsert) ClearMetadata() *EmailLogsUpsert {
+	u.SetNull(emaillogs.FieldMetadata)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *EmailLogsUpsert) SetErrorMessage(v string) *EmailLogsUpsert {
+	u.Set(emaillogs.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *EmailLogsUpsert) UpdateErrorMessage() *EmailLogsUpsert {
+	u.SetExcluded(emaillogs.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *EmailLogsUpsert) ClearErrorMessage() *EmailLogsUpsert {
+	u.SetNull(emaillogs.FieldErrorMessage)
+	return u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *EmailLogsUpsert) SetDeliveredAt(v time.Time) *EmailLogsUpsert {
+	u.Set(emaillogs.FieldDeliveredAt, v)
+	return u
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *EmailLogsUpsert) UpdateDeliveredAt() *EmailLogsUpsert {
+	u.SetExcluded(emaillogs.FieldDeliveredAt)
+	return u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *EmailLogsUpsert) ClearDeliveredAt() *EmailLogsUpsert {
+	u.SetNull(emaillogs.FieldDeliveredAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmailLogs.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emaillogs.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailLogsUpsertOne) UpdateNewValues() *EmailLogsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(emaillogs.FieldID)
+		}
+		if _, exists := u.create.mutation.SentAt(); exists {
+			s.SetIgnore(emaillogs.FieldSentAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailLogs.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmailLogsUpsertOne) Ignore() *EmailLogsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailLogsUpsertOne) DoNothing() *EmailLogsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailLogsCreate.OnConflict
+// documentation for more info.
+func (u *EmailLogsUpsertOne) Update(set func(*EmailLogsUpsert)) *EmailLogsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailLogsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmailLogsUpsertOne) SetUserID(v uuid.UUID) *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailLogsUpsertOne) UpdateUserID() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *EmailLogsUpsertOne) ClearUserID() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRecipient sets the "recipient" field.
+func (u *EmailLogsUpsertOne) SetRecipient(v string) *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetRecipient(v)
+	})
+}
+
+// UpdateRecipient sets the "recipient" field to the value that was provided on create.
+func (u *EmailLogsUpsertOne) UpdateRecipient() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateRecipient()
+	})
+}
+
+// SetEmailType sets the "email_type" field.
+func (u *EmailLogsUpsertOne) SetEmailType(v string) *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetEmailType(v)
+	})
+}
+
+// UpdateEmailType sets the "email_type" field to the value that was provided on create.
+func (u *EmailLogsUpsertOne) UpdateEmailType() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateEmailType()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *EmailLogsUpsertOne) SetSubject(v string) *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *EmailLogsUpsertOne) UpdateSubject() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (u *EmailLogsUpsertOne) ClearSubject() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearSubject()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailLogsUpsertOne) SetStatus(v string) *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailLogsUpsertOne) UpdateStatus() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *EmailLogsUpsertOne) SetProvider(v string) *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *EmailLogsUpsertOne) UpdateProvider() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetProviderMessageID sets the "provider_message_id" field.
+func (u *EmailLogsUpsertOne) SetProviderMessageID(v string) *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetProviderMessageID(v)
+	})
+}
+
+// UpdateProviderMessageID sets the "provider_message_id" field to the value that was provided on create.
+func (u *EmailLogsUpsertOne) UpdateProviderMessageID() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateProviderMessageID()
+	})
+}
+
+// ClearProviderMessageID clears the value of the "provider_message_id" field.
+func (u *EmailLogsUpsertOne) ClearProviderMessageID() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearProviderMessageID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *EmailLogsUpsertOne) SetMetadata(v map[string]interface{}) *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *EmailLogsUpsertOne) UpdateMetadata() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *EmailLogsUpsertOne) ClearMetadata() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *EmailLogsUpsertOne) SetErrorMessage(v string) *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *EmailLogsUpsertOne) UpdateErrorMessage() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *EmailLogsUpsertOne) ClearErrorMessage() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *EmailLogsUpsertOne) SetDeliveredAt(v time.Time) *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *EmailLogsUpsertOne) UpdateDeliveredAt() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *EmailLogsUpsertOne) ClearDeliveredAt() *EmailLogsUpsertOne {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailLogsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailLogsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailLogsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmailLogsUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmailLogsUpsertOne.ID is not supported by MySQL driver. Use EmailLogsUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmailLogsUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EmailLogsCreateBulk is the builder for creating many EmailLogs entities in bulk.
 type EmailLogsCreateBulk struct {
 	config
 	err      error
 	builders []*EmailLogsCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the EmailLogs entities in the database.
@@ -361,6 +842,7 @@ func (elcb *EmailLogsCreateBulk) Save(ctx context.Context) ([]*EmailLogs, error)
 					_, err = mutators[i+1].Mutate(root, elcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = elcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, elcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -410,3 +892,302 @@ func (elcb *EmailLogsCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailLogs.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailLogsUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (elcb *EmailLogsCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmailLogsUpsertBulk {
+	elcb.conflict = opts
+	return &EmailLogsUpsertBulk{
+		create: elcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailLogs.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (elcb *EmailLogsCreateBulk) OnConflictColumns(columns ...string) *EmailLogsUpsertBulk {
+	elcb.conflict = append(elcb.conflict, sql.ConflictColumns(columns...))
+	return &EmailLogsUpsertBulk{
+		create: elcb,
+	}
+}
+
+// EmailLogsUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmailLogs nodes.
+type EmailLogsUpsertBulk struct {
+	create *EmailLogsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmailLogs.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emaillogs.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailLogsUpsertBulk) UpdateNewValues() *EmailLogsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(emaillogs.FieldID)
+			}
+			if _, exists := b.mutation.SentAt(); exists {
+				s.SetIgnore(emaillogs.FieldSentAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailLogs.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmailLogsUpsertBulk) Ignore() *EmailLogsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailLogsUpsertBulk) DoNothing() *EmailLogsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailLogsCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmailLogsUpsertBulk) Update(set func(*EmailLogsUpsert)) *EmailLogsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailLogsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmailLogsUpsertBulk) SetUserID(v uuid.UUID) *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailLogsUpsertBulk) UpdateUserID() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *EmailLogsUpsertBulk) ClearUserID() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRecipient sets the "recipient" field.
+func (u *EmailLogsUpsertBulk) SetRecipient(v string) *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetRecipient(v)
+	})
+}
+
+// UpdateRecipient sets the "recipient" field to the value that was provided on create.
+func (u *EmailLogsUpsertBulk) UpdateRecipient() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateRecipient()
+	})
+}
+
+// SetEmailType sets the "email_type" field.
+func (u *EmailLogsUpsertBulk) SetEmailType(v string) *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetEmailType(v)
+	})
+}
+
+// UpdateEmailType sets the "email_type" field to the value that was provided on create.
+func (u *EmailLogsUpsertBulk) UpdateEmailType() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateEmailType()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *EmailLogsUpsertBulk) SetSubject(v string) *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *EmailLogsUpsertBulk) UpdateSubject() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (u *EmailLogsUpsertBulk) ClearSubject() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearSubject()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EmailLogsUpsertBulk) SetStatus(v string) *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EmailLogsUpsertBulk) UpdateStatus() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *EmailLogsUpsertBulk) SetProvider(v string) *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *EmailLogsUpsertBulk) UpdateProvider() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetProviderMessageID sets the "provider_message_id" field.
+func (u *EmailLogsUpsertBulk) SetProviderMessageID(v string) *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetProviderMessageID(v)
+	})
+}
+
+// UpdateProviderMessageID sets the "provider_message_id" field to the value that was provided on create.
+func (u *EmailLogsUpsertBulk) UpdateProviderMessageID() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateProviderMessageID()
+	})
+}
+
+// ClearProviderMessageID clears the value of the "provider_message_id" field.
+func (u *EmailLogsUpsertBulk) ClearProviderMessageID() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearProviderMessageID()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *EmailLogsUpsertBulk) SetMetadata(v map[string]interface{}) *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *EmailLogsUpsertBulk) UpdateMetadata() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *EmailLogsUpsertBulk) ClearMetadata() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *EmailLogsUpsertBulk) SetErrorMessage(v string) *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *EmailLogsUpsertBulk) UpdateErrorMessage() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *EmailLogsUpsertBulk) ClearErrorMessage() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *EmailLogsUpsertBulk) SetDeliveredAt(v time.Time) *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *EmailLogsUpsertBulk) UpdateDeliveredAt() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *EmailLogsUpsertBulk) ClearDeliveredAt() *EmailLogsUpsertBulk {
+	return u.Update(func(s *EmailLogsUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailLogsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmailLogsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailLogsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailLogsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
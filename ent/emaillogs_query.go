@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -22,6 +23,7 @@ type EmailLogsQuery struct {
 	order      []emaillogs.OrderOption
 	inters     []Interceptor
 	predicates []predicate.EmailLogs
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -343,6 +345,9 @@ func (elq *EmailLogsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*E
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	if len(elq.modifiers) > 0 {
+		_spec.Modifiers = elq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -357,6 +362,9 @@ func (elq *EmailLogsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*E
 
 func (elq *EmailLogsQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := elq.querySpec()
+	if len(elq.modifiers) > 0 {
+		_spec.Modifiers = elq.modifiers
+	}
 	_spec.Node.Columns = elq.ctx.Fields
 	if len(elq.ctx.Fields) > 0 {
 		_spec.Unique = elq.ctx.Unique != nil && *elq.ctx.Unique
@@ -419,6 +427,9 @@ func (elq *EmailLogsQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if elq.ctx.Unique != nil && *elq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range elq.modifiers {
+		m(selector)
+	}
 	for _, p := range elq.predicates {
 		p(selector)
 	}
@@ -436,6 +447,32 @@ func (elq *EmailLogsQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (elq *EmailLogsQuery) ForUpdate(opts ...sql.LockOption) *EmailLogsQuery {
+	if elq.driver.Dialect() == dialect.Postgres {
+		elq.Unique(false)
+	}
+	elq.modifiers = append(elq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return elq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (elq *EmailLogsQuery) ForShare(opts ...sql.LockOption) *EmailLogsQuery {
+	if elq.driver.Dialect() == dialect.Postgres {
+		elq.Unique(false)
+	}
+	elq.modifiers = append(elq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return elq
+}
+
 // EmailLogsGroupBy is the group-by builder for EmailLogs entities.
 type EmailLogsGroupBy struct {
 	selector
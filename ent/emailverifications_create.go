@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -19,6 +21,7 @@ type EmailVerificationsCreate struct {
 	config
 	mutation *EmailVerificationsMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -225,6 +228,7 @@ func (evc *EmailVerificationsCreate) createSpec() (*EmailVerifications, *sqlgrap
 		_node = &EmailVerifications{config: evc.config}
 		_spec = sqlgraph.NewCreateSpec(emailverifications.Table, sqlgraph.NewFieldSpec(emailverifications.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = evc.conflict
 	if id, ok := evc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -264,11 +268,358 @@ func (evc *EmailVerificationsCreate) createSpec() (*EmailVerifications, *sqlgrap
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailVerifications.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailVerificationsUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (evc *EmailVerificationsCreate) OnConflict(opts ...sql.ConflictOption) *EmailVerificationsUpsertOne {
+	evc.conflict = opts
+	return &EmailVerificationsUpsertOne{
+		create: evc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailVerifications.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (evc *EmailVerificationsCreate) OnConflictColumns(columns ...string) *EmailVerificationsUpsertOne {
+	evc.conflict = append(evc.conflict, sql.ConflictColumns(columns...))
+	return &EmailVerificationsUpsertOne{
+		create: evc,
+	}
+}
+
+type (
+	// EmailVerificationsUpsertOne is the builder for "upsert"-ing
+	//  one EmailVerifications node.
+	EmailVerificationsUpsertOne struct {
+		create *EmailVerificationsCreate
+	}
+
+	// EmailVerificationsUpsert is the "OnConflict" setter.
+	EmailVerificationsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *EmailVerificationsUpsert) SetUserID(v uuid.UUID) *EmailVerificationsUpsert {
+	u.Set(emailverifications.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailVerificationsUpsert) UpdateUserID() *EmailVerificationsUpsert {
+	u.SetExcluded(emailverifications.FieldUserID)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *EmailVerificationsUpsert) SetEmail(v string) *EmailVerificationsUpsert {
+	u.Set(emailverifications.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *EmailVerificationsUpsert) UpdateEmail() *EmailVerificationsUpsert {
+	u.SetExcluded(emailverifications.FieldEmail)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *EmailVerificationsUpsert) SetToken(v string) *EmailVerificationsUpsert {
+	u.Set(emailverifications.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *EmailVerificationsUpsert) UpdateToken() *EmailVerificationsUpsert {
+	u.SetExcluded(emailverifications.FieldToken)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *EmailVerificationsUpsert) SetExpiresAt(v time.Time) *EmailVerificationsUpsert {
+	u.Set(emailverifications.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *EmailVerificationsUpsert) UpdateExpiresAt() *EmailVerificationsUpsert {
+	u.SetExcluded(emailverifications.FieldExpiresAt)
+	return u
+}
+
+// SetIsUsed sets the "is_used" field.
+func (u *EmailVerificationsUpsert) SetIsUsed(v bool) *EmailVerificationsUpsert {
+	u.Set(emailverifications.FieldIsUsed, v)
+	return u
+}
+
+// UpdateIsUsed sets the "is_used" field to the value that was provided on create.
+func (u *EmailVerificationsUpsert) UpdateIsUsed() *EmailVerificationsUpsert {
+	u.SetExcluded(emailverifications.FieldIsUsed)
+	return u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *EmailVerificationsUpsert) SetUsedAt(v time.Time) *EmailVerificationsUpsert {
+	u.Set(emailverifications.FieldUsedAt, v)
+	return u
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *EmailVerificationsUpsert) UpdateUsedAt() *EmailVerificationsUpsert {
+	u.SetExcluded(emailverifications.FieldUsedAt)
+	return u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *EmailVerificationsUpsert) ClearUsedAt() *EmailVerificationsUpsert {
+	u.SetNull(emailverifications.FieldUsedAt)
+	return u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *EmailVerificationsUpsert) SetIPAddress(v string) *EmailVerificationsUpsert {
+	u.Set(emailverifications.FieldIPAddress, v)
+	return u
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *EmailVerificationsUpsert) UpdateIPAddress() *EmailVerificationsUpsert {
+	u.SetExcluded(emailverifications.FieldIPAddress)
+	return u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *EmailVerificationsUpsert) ClearIPAddress() *EmailVerificationsUpsert {
+	u.SetNull(emailverifications.FieldIPAddress)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmailVerifications.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailverifications.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailVerificationsUpsertOne) UpdateNewValues() *EmailVerificationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(emailverifications.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(emailverifications.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailVerifications.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmailVerificationsUpsertOne) Ignore() *EmailVerificationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailVerificationsUpsertOne) DoNothing() *EmailVerificationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailVerificationsCreate.OnConflict
+// documentation for more info.
+func (u *EmailVerificationsUpsertOne) Update(set func(*EmailVerificationsUpsert)) *EmailVerificationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailVerificationsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmailVerificationsUpsertOne) SetUserID(v uuid.UUID) *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertOne) UpdateUserID() *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *EmailVerificationsUpsertOne) SetEmail(v string) *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertOne) UpdateEmail() *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *EmailVerificationsUpsertOne) SetToken(v string) *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertOne) UpdateToken() *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *EmailVerificationsUpsertOne) SetExpiresAt(v time.Time) *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertOne) UpdateExpiresAt() *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetIsUsed sets the "is_used" field.
+func (u *EmailVerificationsUpsertOne) SetIsUsed(v bool) *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetIsUsed(v)
+	})
+}
+
+// UpdateIsUsed sets the "is_used" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertOne) UpdateIsUsed() *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateIsUsed()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *EmailVerificationsUpsertOne) SetUsedAt(v time.Time) *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertOne) UpdateUsedAt() *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *EmailVerificationsUpsertOne) ClearUsedAt() *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *EmailVerificationsUpsertOne) SetIPAddress(v string) *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertOne) UpdateIPAddress() *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *EmailVerificationsUpsertOne) ClearIPAddress() *EmailVerificationsUpsertOne {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailVerificationsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailVerificationsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailVerificationsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmailVerificationsUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmailVerificationsUpsertOne.ID is not supported by MySQL driver. Use EmailVerificationsUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmailVerificationsUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EmailVerificationsCreateBulk is the builder for creating many EmailVerifications entities in bulk.
 type EmailVerificationsCreateBulk struct {
 	config
 	err      error
 	builders []*EmailVerificationsCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the EmailVerifications entities in the database.
@@ -298,6 +649,7 @@ func (evcb *EmailVerificationsCreateBulk) Save(ctx context.Context) ([]*EmailVer
 					_, err = mutators[i+1].Mutate(root, evcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = evcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, evcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -347,3 +699,232 @@ func (evcb *EmailVerificationsCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmailVerifications.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmailVerificationsUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (evcb *EmailVerificationsCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmailVerificationsUpsertBulk {
+	evcb.conflict = opts
+	return &EmailVerificationsUpsertBulk{
+		create: evcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmailVerifications.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (evcb *EmailVerificationsCreateBulk) OnConflictColumns(columns ...string) *EmailVerificationsUpsertBulk {
+	evcb.conflict = append(evcb.conflict, sql.ConflictColumns(columns...))
+	return &EmailVerificationsUpsertBulk{
+		create: evcb,
+	}
+}
+
+// EmailVerificationsUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmailVerifications nodes.
+type EmailVerificationsUpsertBulk struct {
+	create *EmailVerificationsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmailVerifications.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(emailverifications.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmailVerificationsUpsertBulk) UpdateNewValues() *EmailVerificationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(emailverifications.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(emailverifications.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmailVerifications.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmailVerificationsUpsertBulk) Ignore() *EmailVerificationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmailVerificationsUpsertBulk) DoNothing() *EmailVerificationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmailVerificationsCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmailVerificationsUpsertBulk) Update(set func(*EmailVerificationsUpsert)) *EmailVerificationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmailVerificationsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmailVerificationsUpsertBulk) SetUserID(v uuid.UUID) *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertBulk) UpdateUserID() *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *EmailVerificationsUpsertBulk) SetEmail(v string) *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertBulk) UpdateEmail() *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *EmailVerificationsUpsertBulk) SetToken(v string) *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertBulk) UpdateToken() *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *EmailVerificationsUpsertBulk) SetExpiresAt(v time.Time) *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertBulk) UpdateExpiresAt() *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetIsUsed sets the "is_used" field.
+func (u *EmailVerificationsUpsertBulk) SetIsUsed(v bool) *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetIsUsed(v)
+	})
+}
+
+// UpdateIsUsed sets the "is_used" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertBulk) UpdateIsUsed() *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateIsUsed()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *EmailVerificationsUpsertBulk) SetUsedAt(v time.Time) *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertBulk) UpdateUsedAt() *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *EmailVerificationsUpsertBulk) ClearUsedAt() *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *EmailVerificationsUpsertBulk) SetIPAddress(v string) *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *EmailVerificationsUpsertBulk) UpdateIPAddress() *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *EmailVerificationsUpsertBulk) ClearIPAddress() *EmailVerificationsUpsertBulk {
+	return u.Update(func(s *EmailVerificationsUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// Exec executes the query.
+func (u *EmailVerificationsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmailVerificationsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmailVerificationsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmailVerificationsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -22,6 +23,7 @@ type EmailVerificationsQuery struct {
 	order      []emailverifications.OrderOption
 	inters     []Interceptor
 	predicates []predicate.EmailVerifications
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -343,6 +345,9 @@ func (evq *EmailVerificationsQuery) sqlAll(ctx context.Context, hooks ...queryHo
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	if len(evq.modifiers) > 0 {
+		_spec.Modifiers = evq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -357,6 +362,9 @@ func (evq *EmailVerificationsQuery) sqlAll(ctx context.Context, hooks ...queryHo
 
 func (evq *EmailVerificationsQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := evq.querySpec()
+	if len(evq.modifiers) > 0 {
+		_spec.Modifiers = evq.modifiers
+	}
 	_spec.Node.Columns = evq.ctx.Fields
 	if len(evq.ctx.Fields) > 0 {
 		_spec.Unique = evq.ctx.Unique != nil && *evq.ctx.Unique
@@ -419,6 +427,9 @@ func (evq *EmailVerificationsQuery) sqlQuery(ctx context.Context) *sql.Selector
 	if evq.ctx.Unique != nil && *evq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range evq.modifiers {
+		m(selector)
+	}
 	for _, p := range evq.predicates {
 		p(selector)
 	}
@@ -436,6 +447,32 @@ func (evq *EmailVerificationsQuery) sqlQuery(ctx context.Context) *sql.Selector
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (evq *EmailVerificationsQuery) ForUpdate(opts ...sql.LockOption) *EmailVerificationsQuery {
+	if evq.driver.Dialect() == dialect.Postgres {
+		evq.Unique(false)
+	}
+	evq.modifiers = append(evq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return evq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (evq *EmailVerificationsQuery) ForShare(opts ...sql.LockOption) *EmailVerificationsQuery {
+	if evq.driver.Dialect() == dialect.Postgres {
+		evq.Unique(false)
+	}
+	evq.modifiers = append(evq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return evq
+}
+
 // EmailVerificationsGroupBy is the group-by builder for EmailVerifications entities.
 type EmailVerificationsGroupBy struct {
 	selector
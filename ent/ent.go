@@ -12,13 +12,32 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shammianand/go-auth/ent/apikeys"
 	"github.com/shammianand/go-auth/ent/auditlogs"
+	"github.com/shammianand/go-auth/ent/consentacceptances"
+	"github.com/shammianand/go-auth/ent/consentdocuments"
+	"github.com/shammianand/go-auth/ent/emailchanges"
 	"github.com/shammianand/go-auth/ent/emaillogs"
 	"github.com/shammianand/go-auth/ent/emailverifications"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/invitations"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/passwordhistories"
 	"github.com/shammianand/go-auth/ent/passwordresets"
 	"github.com/shammianand/go-auth/ent/permissions"
+	"github.com/shammianand/go-auth/ent/relationtuples"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roleparents"
 	"github.com/shammianand/go-auth/ent/rolepermissions"
+	"github.com/shammianand/go-auth/ent/rolerequests"
 	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
 	"github.com/shammianand/go-auth/ent/userroles"
 	"github.com/shammianand/go-auth/ent/users"
 )
@@ -81,13 +100,32 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			apikeys.Table:            apikeys.ValidColumn,
 			auditlogs.Table:          auditlogs.ValidColumn,
+			consentacceptances.Table: consentacceptances.ValidColumn,
+			consentdocuments.Table:   consentdocuments.ValidColumn,
+			emailchanges.Table:       emailchanges.ValidColumn,
 			emaillogs.Table:          emaillogs.ValidColumn,
 			emailverifications.Table: emailverifications.ValidColumn,
+			groupmembers.Table:       groupmembers.ValidColumn,
+			groupparents.Table:       groupparents.ValidColumn,
+			grouproles.Table:         grouproles.ValidColumn,
+			groups.Table:             groups.ValidColumn,
+			invitations.Table:        invitations.ValidColumn,
+			orgmembers.Table:         orgmembers.ValidColumn,
+			orgroles.Table:           orgroles.ValidColumn,
+			organizations.Table:      organizations.ValidColumn,
+			passwordhistories.Table:  passwordhistories.ValidColumn,
 			passwordresets.Table:     passwordresets.ValidColumn,
 			permissions.Table:        permissions.ValidColumn,
+			relationtuples.Table:     relationtuples.ValidColumn,
+			roleapprovers.Table:      roleapprovers.ValidColumn,
+			roleparents.Table:        roleparents.ValidColumn,
 			rolepermissions.Table:    rolepermissions.ValidColumn,
+			rolerequests.Table:       rolerequests.ValidColumn,
 			roles.Table:              roles.ValidColumn,
+			sodconstraintroles.Table: sodconstraintroles.ValidColumn,
+			sodconstraints.Table:     sodconstraints.ValidColumn,
 			userroles.Table:          userroles.ValidColumn,
 			users.Table:              users.ValidColumn,
 		})
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// GroupMembers is the model entity for the GroupMembers schema.
+type GroupMembers struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID int `json:"group_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// User who added the member
+	AddedBy *uuid.UUID `json:"added_by,omitempty"`
+	// AddedAt holds the value of the "added_at" field.
+	AddedAt time.Time `json:"added_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GroupMembersQuery when eager-loading is set.
+	Edges        GroupMembersEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GroupMembersEdges holds the relations/edges for other nodes in the graph.
+type GroupMembersEdges struct {
+	// Group holds the value of the group edge.
+	Group *Groups `json:"group,omitempty"`
+	// User holds the value of the user edge.
+	User *Users `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupMembersEdges) GroupOrErr() (*Groups, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: groups.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupMembersEdges) UserOrErr() (*Users, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: users.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GroupMembers) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groupmembers.FieldAddedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case groupmembers.FieldGroupID:
+			values[i] = new(sql.NullInt64)
+		case groupmembers.FieldAddedAt:
+			values[i] = new(sql.NullTime)
+		case groupmembers.FieldID, groupmembers.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GroupMembers fields.
+func (gm *GroupMembers) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case groupmembers.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				gm.ID = *value
+			}
+		case groupmembers.FieldGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				gm.GroupID = int(value.Int64)
+			}
+		case groupmembers.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				gm.UserID = *value
+			}
+		case groupmembers.FieldAddedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field added_by", values[i])
+			} else if value.Valid {
+				gm.AddedBy = new(uuid.UUID)
+				*gm.AddedBy = *value.S.(*uuid.UUID)
+			}
+		case groupmembers.FieldAddedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+			} else if value.Valid {
+				gm.AddedAt = value.Time
+			}
+		default:
+			gm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GroupMembers.
+// This includes values selected through modifiers, order, etc.
+func (gm *GroupMembers) Value(name string) (ent.Value, error) {
+	return gm.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the GroupMembers entity.
+func (gm *GroupMembers) QueryGroup() *GroupsQuery {
+	return NewGroupMembersClient(gm.config).QueryGroup(gm)
+}
+
+// QueryUser queries the "user" edge of the GroupMembers entity.
+func (gm *GroupMembers) QueryUser() *UsersQuery {
+	return NewGroupMembersClient(gm.config).QueryUser(gm)
+}
+
+// Update returns a builder for updating this GroupMembers.
+// Note that you need to call GroupMembers.Unwrap() before calling this method if this GroupMembers
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gm *GroupMembers) Update() *GroupMembersUpdateOne {
+	return NewGroupMembersClient(gm.config).UpdateOne(gm)
+}
+
+// Unwrap unwraps the GroupMembers entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gm *GroupMembers) Unwrap() *GroupMembers {
+	_tx, ok := gm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupMembers is not a transactional entity")
+	}
+	gm.config.driver = _tx.drv
+	return gm
+}
+
+// String implements the fmt.Stringer.
+func (gm *GroupMembers) String() string {
+	var builder strings.Builder
+	builder.WriteString("GroupMembers(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gm.ID))
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", gm.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", gm.UserID))
+	builder.WriteString(", ")
+	if v := gm.AddedBy; v != nil {
+		builder.WriteString("added_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("added_at=")
+	builder.WriteString(gm.AddedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupMembersSlice is a parsable slice of GroupMembers.
+type GroupMembersSlice []*GroupMembers
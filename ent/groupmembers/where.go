@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package groupmembers
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldLTE(FieldID, id))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v int) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldEQ(FieldGroupID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldEQ(FieldUserID, v))
+}
+
+// AddedBy applies equality check predicate on the "added_by" field. It's identical to AddedByEQ.
+func AddedBy(v uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldEQ(FieldAddedBy, v))
+}
+
+// AddedAt applies equality check predicate on the "added_at" field. It's identical to AddedAtEQ.
+func AddedAt(v time.Time) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v int) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v int) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...int) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...int) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// AddedByEQ applies the EQ predicate on the "added_by" field.
+func AddedByEQ(v uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldEQ(FieldAddedBy, v))
+}
+
+// AddedByNEQ applies the NEQ predicate on the "added_by" field.
+func AddedByNEQ(v uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldNEQ(FieldAddedBy, v))
+}
+
+// AddedByIn applies the In predicate on the "added_by" field.
+func AddedByIn(vs ...uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldIn(FieldAddedBy, vs...))
+}
+
+// AddedByNotIn applies the NotIn predicate on the "added_by" field.
+func AddedByNotIn(vs ...uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldNotIn(FieldAddedBy, vs...))
+}
+
+// AddedByGT applies the GT predicate on the "added_by" field.
+func AddedByGT(v uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldGT(FieldAddedBy, v))
+}
+
+// AddedByGTE applies the GTE predicate on the "added_by" field.
+func AddedByGTE(v uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldGTE(FieldAddedBy, v))
+}
+
+// AddedByLT applies the LT predicate on the "added_by" field.
+func AddedByLT(v uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldLT(FieldAddedBy, v))
+}
+
+// AddedByLTE applies the LTE predicate on the "added_by" field.
+func AddedByLTE(v uuid.UUID) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldLTE(FieldAddedBy, v))
+}
+
+// AddedByIsNil applies the IsNil predicate on the "added_by" field.
+func AddedByIsNil() predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldIsNull(FieldAddedBy))
+}
+
+// AddedByNotNil applies the NotNil predicate on the "added_by" field.
+func AddedByNotNil() predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldNotNull(FieldAddedBy))
+}
+
+// AddedAtEQ applies the EQ predicate on the "added_at" field.
+func AddedAtEQ(v time.Time) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// AddedAtNEQ applies the NEQ predicate on the "added_at" field.
+func AddedAtNEQ(v time.Time) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldNEQ(FieldAddedAt, v))
+}
+
+// AddedAtIn applies the In predicate on the "added_at" field.
+func AddedAtIn(vs ...time.Time) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldIn(FieldAddedAt, vs...))
+}
+
+// AddedAtNotIn applies the NotIn predicate on the "added_at" field.
+func AddedAtNotIn(vs ...time.Time) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldNotIn(FieldAddedAt, vs...))
+}
+
+// AddedAtGT applies the GT predicate on the "added_at" field.
+func AddedAtGT(v time.Time) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldGT(FieldAddedAt, v))
+}
+
+// AddedAtGTE applies the GTE predicate on the "added_at" field.
+func AddedAtGTE(v time.Time) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldGTE(FieldAddedAt, v))
+}
+
+// AddedAtLT applies the LT predicate on the "added_at" field.
+func AddedAtLT(v time.Time) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldLT(FieldAddedAt, v))
+}
+
+// AddedAtLTE applies the LTE predicate on the "added_at" field.
+func AddedAtLTE(v time.Time) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.FieldLTE(FieldAddedAt, v))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.GroupMembers {
+	return predicate.GroupMembers(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Groups) predicate.GroupMembers {
+	return predicate.GroupMembers(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.GroupMembers {
+	return predicate.GroupMembers(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.Users) predicate.GroupMembers {
+	return predicate.GroupMembers(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GroupMembers) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GroupMembers) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GroupMembers) predicate.GroupMembers {
+	return predicate.GroupMembers(sql.NotPredicates(p))
+}
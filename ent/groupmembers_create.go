@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// GroupMembersCreate is the builder for creating a GroupMembers entity.
+type GroupMembersCreate struct {
+	config
+	mutation *GroupMembersMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGroupID sets the "group_id" field.
+func (gmc *GroupMembersCreate) SetGroupID(i int) *GroupMembersCreate {
+	gmc.mutation.SetGroupID(i)
+	return gmc
+}
+
+// SetUserID sets the "user_id" field.
+func (gmc *GroupMembersCreate) SetUserID(u uuid.UUID) *GroupMembersCreate {
+	gmc.mutation.SetUserID(u)
+	return gmc
+}
+
+// SetAddedBy sets the "added_by" field.
+func (gmc *GroupMembersCreate) SetAddedBy(u uuid.UUID) *GroupMembersCreate {
+	gmc.mutation.SetAddedBy(u)
+	return gmc
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (gmc *GroupMembersCreate) SetNillableAddedBy(u *uuid.UUID) *GroupMembersCreate {
+	if u != nil {
+		gmc.SetAddedBy(*u)
+	}
+	return gmc
+}
+
+// SetAddedAt sets the "added_at" field.
+func (gmc *GroupMembersCreate) SetAddedAt(t time.Time) *GroupMembersCreate {
+	gmc.mutation.SetAddedAt(t)
+	return gmc
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (gmc *GroupMembersCreate) SetNillableAddedAt(t *time.Time) *GroupMembersCreate {
+	if t != nil {
+		gmc.SetAddedAt(*t)
+	}
+	return gmc
+}
+
+// SetID sets the "id" field.
+func (gmc *GroupMembersCreate) SetID(u uuid.UUID) *GroupMembersCreate {
+	gmc.mutation.SetID(u)
+	return gmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (gmc *GroupMembersCreate) SetNillableID(u *uuid.UUID) *GroupMembersCreate {
+	if u != nil {
+		gmc.SetID(*u)
+	}
+	return gmc
+}
+
+// SetGroup sets the "group" edge to the Groups entity.
+func (gmc *GroupMembersCreate) SetGroup(g *Groups) *GroupMembersCreate {
+	return gmc.SetGroupID(g.ID)
+}
+
+// SetUser sets the "user" edge to the Users entity.
+func (gmc *GroupMembersCreate) SetUser(u *Users) *GroupMembersCreate {
+	return gmc.SetUserID(u.ID)
+}
+
+// Mutation returns the GroupMembersMutation object of the builder.
+func (gmc *GroupMembersCreate) Mutation() *GroupMembersMutation {
+	return gmc.mutation
+}
+
+// Save creates the GroupMembers in the database.
+func (gmc *GroupMembersCreate) Save(ctx context.Context) (*GroupMembers, error) {
+	gmc.defaults()
+	return withHooks(ctx, gmc.sqlSave, gmc.mutation, gmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gmc *GroupMembersCreate) SaveX(ctx context.Context) *GroupMembers {
+	v, err := gmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gmc *GroupMembersCreate) Exec(ctx context.Context) error {
+	_, err := gmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmc *GroupMembersCreate) ExecX(ctx context.Context) {
+	if err := gmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gmc *GroupMembersCreate) defaults() {
+	if _, ok := gmc.mutation.AddedAt(); !ok {
+		v := groupmembers.DefaultAddedAt()
+		gmc.mutation.SetAddedAt(v)
+	}
+	if _, ok := gmc.mutation.ID(); !ok {
+		v := groupmembers.DefaultID()
+		gmc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gmc *GroupMembersCreate) check() error {
+	if _, ok := gmc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "GroupMembers.group_id"`)}
+	}
+	if _, ok := gmc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "GroupMembers.user_id"`)}
+	}
+	if _, ok := gmc.mutation.AddedAt(); !ok {
+		return &ValidationError{Name: "added_at", err: errors.New(`ent: missing required field "GroupMembers.added_at"`)}
+	}
+	if _, ok := gmc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "GroupMembers.group"`)}
+	}
+	if _, ok := gmc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "GroupMembers.user"`)}
+	}
+	return nil
+}
+
+func (gmc *GroupMembersCreate) sqlSave(ctx context.Context) (*GroupMembers, error) {
+	if err := gmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	gmc.mutation.id = &_node.ID
+	gmc.mutation.done = true
+	return _node, nil
+}
+
+func (gmc *GroupMembersCreate) createSpec() (*GroupMembers, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupMembers{config: gmc.config}
+		_spec = sqlgraph.NewCreateSpec(groupmembers.Table, sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = gmc.conflict
+	if id, ok := gmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := gmc.mutation.AddedBy(); ok {
+		_spec.SetField(groupmembers.FieldAddedBy, field.TypeUUID, value)
+		_node.AddedBy = &value
+	}
+	if value, ok := gmc.mutation.AddedAt(); ok {
+		_spec.SetField(groupmembers.FieldAddedAt, field.TypeTime, value)
+		_node.AddedAt = value
+	}
+	if nodes := gmc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembers.GroupTable,
+			Columns: []string{groupmembers.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gmc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembers.UserTable,
+			Columns: []string{groupmembers.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupMembers.Create().
+//		SetGroupID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupMembersUpsert) {
+//			SetGroupID(v+v).
+//		}).
+//		Exec(ctx)
+func (gmc *GroupMembersCreate) OnConflict(opts ...sql.ConflictOption) *GroupMembersUpsertOne {
+	gmc.conflict = opts
+	return &GroupMembersUpsertOne{
+		create: gmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupMembers.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gmc *GroupMembersCreate) OnConflictColumns(columns ...string) *GroupMembersUpsertOne {
+	gmc.conflict = append(gmc.conflict, sql.ConflictColumns(columns...))
+	return &GroupMembersUpsertOne{
+		create: gmc,
+	}
+}
+
+type (
+	// GroupMembersUpsertOne is the builder for "upsert"-ing
+	//  one GroupMembers node.
+	GroupMembersUpsertOne struct {
+		create *GroupMembersCreate
+	}
+
+	// GroupMembersUpsert is the "OnConflict" setter.
+	GroupMembersUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupMembersUpsert) SetGroupID(v int) *GroupMembersUpsert {
+	u.Set(groupmembers.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupMembersUpsert) UpdateGroupID() *GroupMembersUpsert {
+	u.SetExcluded(groupmembers.FieldGroupID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GroupMembersUpsert) SetUserID(v uuid.UUID) *GroupMembersUpsert {
+	u.Set(groupmembers.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GroupMembersUpsert) UpdateUserID() *GroupMembersUpsert {
+	u.SetExcluded(groupmembers.FieldUserID)
+	return u
+}
+
+// SetAddedBy sets the "added_by" field.
+func (u *GroupMembersUpsert) SetAddedBy(v uuid.UUID) *GroupMembersUpsert {
+	u.Set(groupmembers.FieldAddedBy, v)
+	return u
+}
+
+// UpdateAddedBy sets the "added_by" field to the value that was provided on create.
+func (u *GroupMembersUpsert) UpdateAddedBy() *GroupMembersUpsert {
+	u.SetExcluded(groupmembers.FieldAddedBy)
+	return u
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (u *GroupMembersUpsert) ClearAddedBy() *GroupMembersUpsert {
+	u.SetNull(groupmembers.FieldAddedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GroupMembers.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(groupmembers.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupMembersUpsertOne) UpdateNewValues() *GroupMembersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(groupmembers.FieldID)
+		}
+		if _, exists := u.create.mutation.AddedAt(); exists {
+			s.SetIgnore(groupmembers.FieldAddedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupMembers.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GroupMembersUpsertOne) Ignore() *GroupMembersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupMembersUpsertOne) DoNothing() *GroupMembersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupMembersCreate.OnConflict
+// documentation for more info.
+func (u *GroupMembersUpsertOne) Update(set func(*GroupMembersUpsert)) *GroupMembersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupMembersUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupMembersUpsertOne) SetGroupID(v int) *GroupMembersUpsertOne {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupMembersUpsertOne) UpdateGroupID() *GroupMembersUpsertOne {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GroupMembersUpsertOne) SetUserID(v uuid.UUID) *GroupMembersUpsertOne {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GroupMembersUpsertOne) UpdateUserID() *GroupMembersUpsertOne {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAddedBy sets the "added_by" field.
+func (u *GroupMembersUpsertOne) SetAddedBy(v uuid.UUID) *GroupMembersUpsertOne {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.SetAddedBy(v)
+	})
+}
+
+// UpdateAddedBy sets the "added_by" field to the value that was provided on create.
+func (u *GroupMembersUpsertOne) UpdateAddedBy() *GroupMembersUpsertOne {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.UpdateAddedBy()
+	})
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (u *GroupMembersUpsertOne) ClearAddedBy() *GroupMembersUpsertOne {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.ClearAddedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupMembersUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupMembersCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupMembersUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GroupMembersUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GroupMembersUpsertOne.ID is not supported by MySQL driver. Use GroupMembersUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GroupMembersUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GroupMembersCreateBulk is the builder for creating many GroupMembers entities in bulk.
+type GroupMembersCreateBulk struct {
+	config
+	err      error
+	builders []*GroupMembersCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GroupMembers entities in the database.
+func (gmcb *GroupMembersCreateBulk) Save(ctx context.Context) ([]*GroupMembers, error) {
+	if gmcb.err != nil {
+		return nil, gmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gmcb.builders))
+	nodes := make([]*GroupMembers, len(gmcb.builders))
+	mutators := make([]Mutator, len(gmcb.builders))
+	for i := range gmcb.builders {
+		func(i int, root context.Context) {
+			builder := gmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupMembersMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = gmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmcb *GroupMembersCreateBulk) SaveX(ctx context.Context) []*GroupMembers {
+	v, err := gmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gmcb *GroupMembersCreateBulk) Exec(ctx context.Context) error {
+	_, err := gmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmcb *GroupMembersCreateBulk) ExecX(ctx context.Context) {
+	if err := gmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupMembers.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupMembersUpsert) {
+//			SetGroupID(v+v).
+//		}).
+//		Exec(ctx)
+func (gmcb *GroupMembersCreateBulk) OnConflict(opts ...sql.ConflictOption) *GroupMembersUpsertBulk {
+	gmcb.conflict = opts
+	return &GroupMembersUpsertBulk{
+		create: gmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupMembers.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gmcb *GroupMembersCreateBulk) OnConflictColumns(columns ...string) *GroupMembersUpsertBulk {
+	gmcb.conflict = append(gmcb.conflict, sql.ConflictColumns(columns...))
+	return &GroupMembersUpsertBulk{
+		create: gmcb,
+	}
+}
+
+// GroupMembersUpsertBulk is the builder for "upsert"-ing
+// a bulk of GroupMembers nodes.
+type GroupMembersUpsertBulk struct {
+	create *GroupMembersCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GroupMembers.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(groupmembers.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupMembersUpsertBulk) UpdateNewValues() *GroupMembersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(groupmembers.FieldID)
+			}
+			if _, exists := b.mutation.AddedAt(); exists {
+				s.SetIgnore(groupmembers.FieldAddedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupMembers.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GroupMembersUpsertBulk) Ignore() *GroupMembersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupMembersUpsertBulk) DoNothing() *GroupMembersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupMembersCreateBulk.OnConflict
+// documentation for more info.
+func (u *GroupMembersUpsertBulk) Update(set func(*GroupMembersUpsert)) *GroupMembersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupMembersUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupMembersUpsertBulk) SetGroupID(v int) *GroupMembersUpsertBulk {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupMembersUpsertBulk) UpdateGroupID() *GroupMembersUpsertBulk {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GroupMembersUpsertBulk) SetUserID(v uuid.UUID) *GroupMembersUpsertBulk {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GroupMembersUpsertBulk) UpdateUserID() *GroupMembersUpsertBulk {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAddedBy sets the "added_by" field.
+func (u *GroupMembersUpsertBulk) SetAddedBy(v uuid.UUID) *GroupMembersUpsertBulk {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.SetAddedBy(v)
+	})
+}
+
+// UpdateAddedBy sets the "added_by" field to the value that was provided on create.
+func (u *GroupMembersUpsertBulk) UpdateAddedBy() *GroupMembersUpsertBulk {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.UpdateAddedBy()
+	})
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (u *GroupMembersUpsertBulk) ClearAddedBy() *GroupMembersUpsertBulk {
+	return u.Update(func(s *GroupMembersUpsert) {
+		s.ClearAddedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupMembersUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GroupMembersCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupMembersCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupMembersUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
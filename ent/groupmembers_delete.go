@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// GroupMembersDelete is the builder for deleting a GroupMembers entity.
+type GroupMembersDelete struct {
+	config
+	hooks    []Hook
+	mutation *GroupMembersMutation
+}
+
+// Where appends a list predicates to the GroupMembersDelete builder.
+func (gmd *GroupMembersDelete) Where(ps ...predicate.GroupMembers) *GroupMembersDelete {
+	gmd.mutation.Where(ps...)
+	return gmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gmd *GroupMembersDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gmd.sqlExec, gmd.mutation, gmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmd *GroupMembersDelete) ExecX(ctx context.Context) int {
+	n, err := gmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gmd *GroupMembersDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(groupmembers.Table, sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID))
+	if ps := gmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gmd.mutation.done = true
+	return affected, err
+}
+
+// GroupMembersDeleteOne is the builder for deleting a single GroupMembers entity.
+type GroupMembersDeleteOne struct {
+	gmd *GroupMembersDelete
+}
+
+// Where appends a list predicates to the GroupMembersDelete builder.
+func (gmdo *GroupMembersDeleteOne) Where(ps ...predicate.GroupMembers) *GroupMembersDeleteOne {
+	gmdo.gmd.mutation.Where(ps...)
+	return gmdo
+}
+
+// Exec executes the deletion query.
+func (gmdo *GroupMembersDeleteOne) Exec(ctx context.Context) error {
+	n, err := gmdo.gmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{groupmembers.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmdo *GroupMembersDeleteOne) ExecX(ctx context.Context) {
+	if err := gmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// GroupMembersQuery is the builder for querying GroupMembers entities.
+type GroupMembersQuery struct {
+	config
+	ctx        *QueryContext
+	order      []groupmembers.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GroupMembers
+	withGroup  *GroupsQuery
+	withUser   *UsersQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GroupMembersQuery builder.
+func (gmq *GroupMembersQuery) Where(ps ...predicate.GroupMembers) *GroupMembersQuery {
+	gmq.predicates = append(gmq.predicates, ps...)
+	return gmq
+}
+
+// Limit the number of records to be returned by this query.
+func (gmq *GroupMembersQuery) Limit(limit int) *GroupMembersQuery {
+	gmq.ctx.Limit = &limit
+	return gmq
+}
+
+// Offset to start from.
+func (gmq *GroupMembersQuery) Offset(offset int) *GroupMembersQuery {
+	gmq.ctx.Offset = &offset
+	return gmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gmq *GroupMembersQuery) Unique(unique bool) *GroupMembersQuery {
+	gmq.ctx.Unique = &unique
+	return gmq
+}
+
+// Order specifies how the records should be ordered.
+func (gmq *GroupMembersQuery) Order(o ...groupmembers.OrderOption) *GroupMembersQuery {
+	gmq.order = append(gmq.order, o...)
+	return gmq
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (gmq *GroupMembersQuery) QueryGroup() *GroupsQuery {
+	query := (&GroupsClient{config: gmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmembers.Table, groupmembers.FieldID, selector),
+			sqlgraph.To(groups.Table, groups.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupmembers.GroupTable, groupmembers.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (gmq *GroupMembersQuery) QueryUser() *UsersQuery {
+	query := (&UsersClient{config: gmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmembers.Table, groupmembers.FieldID, selector),
+			sqlgraph.To(users.Table, users.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupmembers.UserTable, groupmembers.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first GroupMembers entity from the query.
+// Returns a *NotFoundError when no GroupMembers was found.
+func (gmq *GroupMembersQuery) First(ctx context.Context) (*GroupMembers, error) {
+	nodes, err := gmq.Limit(1).All(setContextOp(ctx, gmq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{groupmembers.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gmq *GroupMembersQuery) FirstX(ctx context.Context) *GroupMembers {
+	node, err := gmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GroupMembers ID from the query.
+// Returns a *NotFoundError when no GroupMembers ID was found.
+func (gmq *GroupMembersQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = gmq.Limit(1).IDs(setContextOp(ctx, gmq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{groupmembers.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gmq *GroupMembersQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := gmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GroupMembers entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GroupMembers entity is found.
+// Returns a *NotFoundError when no GroupMembers entities are found.
+func (gmq *GroupMembersQuery) Only(ctx context.Context) (*GroupMembers, error) {
+	nodes, err := gmq.Limit(2).All(setContextOp(ctx, gmq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{groupmembers.Label}
+	default:
+		return nil, &NotSingularError{groupmembers.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gmq *GroupMembersQuery) OnlyX(ctx context.Context) *GroupMembers {
+	node, err := gmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GroupMembers ID in the query.
+// Returns a *NotSingularError when more than one GroupMembers ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gmq *GroupMembersQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = gmq.Limit(2).IDs(setContextOp(ctx, gmq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{groupmembers.Label}
+	default:
+		err = &NotSingularError{groupmembers.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gmq *GroupMembersQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := gmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GroupMembersSlice.
+func (gmq *GroupMembersQuery) All(ctx context.Context) ([]*GroupMembers, error) {
+	ctx = setContextOp(ctx, gmq.ctx, "All")
+	if err := gmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GroupMembers, *GroupMembersQuery]()
+	return withInterceptors[[]*GroupMembers](ctx, gmq, qr, gmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gmq *GroupMembersQuery) AllX(ctx context.Context) []*GroupMembers {
+	nodes, err := gmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GroupMembers IDs.
+func (gmq *GroupMembersQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if gmq.ctx.Unique == nil && gmq.path != nil {
+		gmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gmq.ctx, "IDs")
+	if err = gmq.Select(groupmembers.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gmq *GroupMembersQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := gmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gmq *GroupMembersQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gmq.ctx, "Count")
+	if err := gmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gmq, querierCount[*GroupMembersQuery](), gmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gmq *GroupMembersQuery) CountX(ctx context.Context) int {
+	count, err := gmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gmq *GroupMembersQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gmq.ctx, "Exist")
+	switch _, err := gmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gmq *GroupMembersQuery) ExistX(ctx context.Context) bool {
+	exist, err := gmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GroupMembersQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gmq *GroupMembersQuery) Clone() *GroupMembersQuery {
+	if gmq == nil {
+		return nil
+	}
+	return &GroupMembersQuery{
+		config:     gmq.config,
+		ctx:        gmq.ctx.Clone(),
+		order:      append([]groupmembers.OrderOption{}, gmq.order...),
+		inters:     append([]Interceptor{}, gmq.inters...),
+		predicates: append([]predicate.GroupMembers{}, gmq.predicates...),
+		withGroup:  gmq.withGroup.Clone(),
+		withUser:   gmq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  gmq.sql.Clone(),
+		path: gmq.path,
+	}
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (gmq *GroupMembersQuery) WithGroup(opts ...func(*GroupsQuery)) *GroupMembersQuery {
+	query := (&GroupsClient{config: gmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gmq.withGroup = query
+	return gmq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (gmq *GroupMembersQuery) WithUser(opts ...func(*UsersQuery)) *GroupMembersQuery {
+	query := (&UsersClient{config: gmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gmq.withUser = query
+	return gmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GroupID int `json:"group_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GroupMembers.Query().
+//		GroupBy(groupmembers.FieldGroupID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gmq *GroupMembersQuery) GroupBy(field string, fields ...string) *GroupMembersGroupBy {
+	gmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GroupMembersGroupBy{build: gmq}
+	grbuild.flds = &gmq.ctx.Fields
+	grbuild.label = groupmembers.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GroupID int `json:"group_id,omitempty"`
+//	}
+//
+//	client.GroupMembers.Query().
+//		Select(groupmembers.FieldGroupID).
+//		Scan(ctx, &v)
+func (gmq *GroupMembersQuery) Select(fields ...string) *GroupMembersSelect {
+	gmq.ctx.Fields = append(gmq.ctx.Fields, fields...)
+	sbuild := &GroupMembersSelect{GroupMembersQuery: gmq}
+	sbuild.label = groupmembers.Label
+	sbuild.flds, sbuild.scan = &gmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GroupMembersSelect configured with the given aggregations.
+func (gmq *GroupMembersQuery) Aggregate(fns ...AggregateFunc) *GroupMembersSelect {
+	return gmq.Select().Aggregate(fns...)
+}
+
+func (gmq *GroupMembersQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gmq.ctx.Fields {
+		if !groupmembers.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gmq.path != nil {
+		prev, err := gmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gmq.sql = prev
+	}
+	return nil
+}
+
+func (gmq *GroupMembersQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GroupMembers, error) {
+	var (
+		nodes       = []*GroupMembers{}
+		_spec       = gmq.querySpec()
+		loadedTypes = [2]bool{
+			gmq.withGroup != nil,
+			gmq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GroupMembers).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GroupMembers{config: gmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(gmq.modifiers) > 0 {
+		_spec.Modifiers = gmq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := gmq.withGroup; query != nil {
+		if err := gmq.loadGroup(ctx, query, nodes, nil,
+			func(n *GroupMembers, e *Groups) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gmq.withUser; query != nil {
+		if err := gmq.loadUser(ctx, query, nodes, nil,
+			func(n *GroupMembers, e *Users) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (gmq *GroupMembersQuery) loadGroup(ctx context.Context, query *GroupsQuery, nodes []*GroupMembers, init func(*GroupMembers), assign func(*GroupMembers, *Groups)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*GroupMembers)
+	for i := range nodes {
+		fk := nodes[i].GroupID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(groups.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (gmq *GroupMembersQuery) loadUser(ctx context.Context, query *UsersQuery, nodes []*GroupMembers, init func(*GroupMembers), assign func(*GroupMembers, *Users)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*GroupMembers)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(users.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (gmq *GroupMembersQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gmq.querySpec()
+	if len(gmq.modifiers) > 0 {
+		_spec.Modifiers = gmq.modifiers
+	}
+	_spec.Node.Columns = gmq.ctx.Fields
+	if len(gmq.ctx.Fields) > 0 {
+		_spec.Unique = gmq.ctx.Unique != nil && *gmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gmq.driver, _spec)
+}
+
+func (gmq *GroupMembersQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(groupmembers.Table, groupmembers.Columns, sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID))
+	_spec.From = gmq.sql
+	if unique := gmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupmembers.FieldID)
+		for i := range fields {
+			if fields[i] != groupmembers.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if gmq.withGroup != nil {
+			_spec.Node.AddColumnOnce(groupmembers.FieldGroupID)
+		}
+		if gmq.withUser != nil {
+			_spec.Node.AddColumnOnce(groupmembers.FieldUserID)
+		}
+	}
+	if ps := gmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gmq *GroupMembersQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gmq.driver.Dialect())
+	t1 := builder.Table(groupmembers.Table)
+	columns := gmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = groupmembers.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gmq.sql != nil {
+		selector = gmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gmq.ctx.Unique != nil && *gmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range gmq.modifiers {
+		m(selector)
+	}
+	for _, p := range gmq.predicates {
+		p(selector)
+	}
+	for _, p := range gmq.order {
+		p(selector)
+	}
+	if offset := gmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (gmq *GroupMembersQuery) ForUpdate(opts ...sql.LockOption) *GroupMembersQuery {
+	if gmq.driver.Dialect() == dialect.Postgres {
+		gmq.Unique(false)
+	}
+	gmq.modifiers = append(gmq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return gmq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (gmq *GroupMembersQuery) ForShare(opts ...sql.LockOption) *GroupMembersQuery {
+	if gmq.driver.Dialect() == dialect.Postgres {
+		gmq.Unique(false)
+	}
+	gmq.modifiers = append(gmq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return gmq
+}
+
+// GroupMembersGroupBy is the group-by builder for GroupMembers entities.
+type GroupMembersGroupBy struct {
+	selector
+	build *GroupMembersQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gmgb *GroupMembersGroupBy) Aggregate(fns ...AggregateFunc) *GroupMembersGroupBy {
+	gmgb.fns = append(gmgb.fns, fns...)
+	return gmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gmgb *GroupMembersGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gmgb.build.ctx, "GroupBy")
+	if err := gmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupMembersQuery, *GroupMembersGroupBy](ctx, gmgb.build, gmgb, gmgb.build.inters, v)
+}
+
+func (gmgb *GroupMembersGroupBy) sqlScan(ctx context.Context, root *GroupMembersQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gmgb.fns))
+	for _, fn := range gmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gmgb.flds)+len(gmgb.fns))
+		for _, f := range *gmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GroupMembersSelect is the builder for selecting fields of GroupMembers entities.
+type GroupMembersSelect struct {
+	*GroupMembersQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gms *GroupMembersSelect) Aggregate(fns ...AggregateFunc) *GroupMembersSelect {
+	gms.fns = append(gms.fns, fns...)
+	return gms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gms *GroupMembersSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gms.ctx, "Select")
+	if err := gms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupMembersQuery, *GroupMembersSelect](ctx, gms.GroupMembersQuery, gms, gms.inters, v)
+}
+
+func (gms *GroupMembersSelect) sqlScan(ctx context.Context, root *GroupMembersQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gms.fns))
+	for _, fn := range gms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// GroupMembersUpdate is the builder for updating GroupMembers entities.
+type GroupMembersUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupMembersMutation
+}
+
+// Where appends a list predicates to the GroupMembersUpdate builder.
+func (gmu *GroupMembersUpdate) Where(ps ...predicate.GroupMembers) *GroupMembersUpdate {
+	gmu.mutation.Where(ps...)
+	return gmu
+}
+
+// SetGroupID sets the "group_id" field.
+func (gmu *GroupMembersUpdate) SetGroupID(i int) *GroupMembersUpdate {
+	gmu.mutation.SetGroupID(i)
+	return gmu
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (gmu *GroupMembersUpdate) SetNillableGroupID(i *int) *GroupMembersUpdate {
+	if i != nil {
+		gmu.SetGroupID(*i)
+	}
+	return gmu
+}
+
+// SetUserID sets the "user_id" field.
+func (gmu *GroupMembersUpdate) SetUserID(u uuid.UUID) *GroupMembersUpdate {
+	gmu.mutation.SetUserID(u)
+	return gmu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (gmu *GroupMembersUpdate) SetNillableUserID(u *uuid.UUID) *GroupMembersUpdate {
+	if u != nil {
+		gmu.SetUserID(*u)
+	}
+	return gmu
+}
+
+// SetAddedBy sets the "added_by" field.
+func (gmu *GroupMembersUpdate) SetAddedBy(u uuid.UUID) *GroupMembersUpdate {
+	gmu.mutation.SetAddedBy(u)
+	return gmu
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (gmu *GroupMembersUpdate) SetNillableAddedBy(u *uuid.UUID) *GroupMembersUpdate {
+	if u != nil {
+		gmu.SetAddedBy(*u)
+	}
+	return gmu
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (gmu *GroupMembersUpdate) ClearAddedBy() *GroupMembersUpdate {
+	gmu.mutation.ClearAddedBy()
+	return gmu
+}
+
+// SetGroup sets the "group" edge to the Groups entity.
+func (gmu *GroupMembersUpdate) SetGroup(g *Groups) *GroupMembersUpdate {
+	return gmu.SetGroupID(g.ID)
+}
+
+// SetUser sets the "user" edge to the Users entity.
+func (gmu *GroupMembersUpdate) SetUser(u *Users) *GroupMembersUpdate {
+	return gmu.SetUserID(u.ID)
+}
+
+// Mutation returns the GroupMembersMutation object of the builder.
+func (gmu *GroupMembersUpdate) Mutation() *GroupMembersMutation {
+	return gmu.mutation
+}
+
+// ClearGroup clears the "group" edge to the Groups entity.
+func (gmu *GroupMembersUpdate) ClearGroup() *GroupMembersUpdate {
+	gmu.mutation.ClearGroup()
+	return gmu
+}
+
+// ClearUser clears the "user" edge to the Users entity.
+func (gmu *GroupMembersUpdate) ClearUser() *GroupMembersUpdate {
+	gmu.mutation.ClearUser()
+	return gmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gmu *GroupMembersUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gmu.sqlSave, gmu.mutation, gmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmu *GroupMembersUpdate) SaveX(ctx context.Context) int {
+	affected, err := gmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gmu *GroupMembersUpdate) Exec(ctx context.Context) error {
+	_, err := gmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmu *GroupMembersUpdate) ExecX(ctx context.Context) {
+	if err := gmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gmu *GroupMembersUpdate) check() error {
+	if _, ok := gmu.mutation.GroupID(); gmu.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupMembers.group"`)
+	}
+	if _, ok := gmu.mutation.UserID(); gmu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupMembers.user"`)
+	}
+	return nil
+}
+
+func (gmu *GroupMembersUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupmembers.Table, groupmembers.Columns, sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID))
+	if ps := gmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gmu.mutation.AddedBy(); ok {
+		_spec.SetField(groupmembers.FieldAddedBy, field.TypeUUID, value)
+	}
+	if gmu.mutation.AddedByCleared() {
+		_spec.ClearField(groupmembers.FieldAddedBy, field.TypeUUID)
+	}
+	if gmu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembers.GroupTable,
+			Columns: []string{groupmembers.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembers.GroupTable,
+			Columns: []string{groupmembers.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gmu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembers.UserTable,
+			Columns: []string{groupmembers.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembers.UserTable,
+			Columns: []string{groupmembers.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupmembers.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gmu.mutation.done = true
+	return n, nil
+}
+
+// GroupMembersUpdateOne is the builder for updating a single GroupMembers entity.
+type GroupMembersUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupMembersMutation
+}
+
+// SetGroupID sets the "group_id" field.
+func (gmuo *GroupMembersUpdateOne) SetGroupID(i int) *GroupMembersUpdateOne {
+	gmuo.mutation.SetGroupID(i)
+	return gmuo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (gmuo *GroupMembersUpdateOne) SetNillableGroupID(i *int) *GroupMembersUpdateOne {
+	if i != nil {
+		gmuo.SetGroupID(*i)
+	}
+	return gmuo
+}
+
+// SetUserID sets the "user_id" field.
+func (gmuo *GroupMembersUpdateOne) SetUserID(u uuid.UUID) *GroupMembersUpdateOne {
+	gmuo.mutation.SetUserID(u)
+	return gmuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (gmuo *GroupMembersUpdateOne) SetNillableUserID(u *uuid.UUID) *GroupMembersUpdateOne {
+	if u != nil {
+		gmuo.SetUserID(*u)
+	}
+	return gmuo
+}
+
+// SetAddedBy sets the "added_by" field.
+func (gmuo *GroupMembersUpdateOne) SetAddedBy(u uuid.UUID) *GroupMembersUpdateOne {
+	gmuo.mutation.SetAddedBy(u)
+	return gmuo
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (gmuo *GroupMembersUpdateOne) SetNillableAddedBy(u *uuid.UUID) *GroupMembersUpdateOne {
+	if u != nil {
+		gmuo.SetAddedBy(*u)
+	}
+	return gmuo
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (gmuo *GroupMembersUpdateOne) ClearAddedBy() *GroupMembersUpdateOne {
+	gmuo.mutation.ClearAddedBy()
+	return gmuo
+}
+
+// SetGroup sets the "group" edge to the Groups entity.
+func (gmuo *GroupMembersUpdateOne) SetGroup(g *Groups) *GroupMembersUpdateOne {
+	return gmuo.SetGroupID(g.ID)
+}
+
+// SetUser sets the "user" edge to the Users entity.
+func (gmuo *GroupMembersUpdateOne) SetUser(u *Users) *GroupMembersUpdateOne {
+	return gmuo.SetUserID(u.ID)
+}
+
+// Mutation returns the GroupMembersMutation object of the builder.
+func (gmuo *GroupMembersUpdateOne) Mutation() *GroupMembersMutation {
+	return gmuo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Groups entity.
+func (gmuo *GroupMembersUpdateOne) ClearGroup() *GroupMembersUpdateOne {
+	gmuo.mutation.ClearGroup()
+	return gmuo
+}
+
+// ClearUser clears the "user" edge to the Users entity.
+func (gmuo *GroupMembersUpdateOne) ClearUser() *GroupMembersUpdateOne {
+	gmuo.mutation.ClearUser()
+	return gmuo
+}
+
+// Where appends a list predicates to the GroupMembersUpdate builder.
+func (gmuo *GroupMembersUpdateOne) Where(ps ...predicate.GroupMembers) *GroupMembersUpdateOne {
+	gmuo.mutation.Where(ps...)
+	return gmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gmuo *GroupMembersUpdateOne) Select(field string, fields ...string) *GroupMembersUpdateOne {
+	gmuo.fields = append([]string{field}, fields...)
+	return gmuo
+}
+
+// Save executes the query and returns the updated GroupMembers entity.
+func (gmuo *GroupMembersUpdateOne) Save(ctx context.Context) (*GroupMembers, error) {
+	return withHooks(ctx, gmuo.sqlSave, gmuo.mutation, gmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmuo *GroupMembersUpdateOne) SaveX(ctx context.Context) *GroupMembers {
+	node, err := gmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gmuo *GroupMembersUpdateOne) Exec(ctx context.Context) error {
+	_, err := gmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmuo *GroupMembersUpdateOne) ExecX(ctx context.Context) {
+	if err := gmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gmuo *GroupMembersUpdateOne) check() error {
+	if _, ok := gmuo.mutation.GroupID(); gmuo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupMembers.group"`)
+	}
+	if _, ok := gmuo.mutation.UserID(); gmuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupMembers.user"`)
+	}
+	return nil
+}
+
+func (gmuo *GroupMembersUpdateOne) sqlSave(ctx context.Context) (_node *GroupMembers, err error) {
+	if err := gmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupmembers.Table, groupmembers.Columns, sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID))
+	id, ok := gmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupMembers.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupmembers.FieldID)
+		for _, f := range fields {
+			if !groupmembers.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != groupmembers.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gmuo.mutation.AddedBy(); ok {
+		_spec.SetField(groupmembers.FieldAddedBy, field.TypeUUID, value)
+	}
+	if gmuo.mutation.AddedByCleared() {
+		_spec.ClearField(groupmembers.FieldAddedBy, field.TypeUUID)
+	}
+	if gmuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembers.GroupTable,
+			Columns: []string{groupmembers.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembers.GroupTable,
+			Columns: []string{groupmembers.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gmuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembers.UserTable,
+			Columns: []string{groupmembers.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gmuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmembers.UserTable,
+			Columns: []string{groupmembers.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GroupMembers{config: gmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupmembers.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gmuo.mutation.done = true
+	return _node, nil
+}
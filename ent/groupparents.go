@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/groups"
+)
+
+// GroupParents is the model entity for the GroupParents schema.
+type GroupParents struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Nested group
+	GroupID int `json:"group_id,omitempty"`
+	// Group the nested group's members also belong to
+	ParentGroupID int `json:"parent_group_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GroupParentsQuery when eager-loading is set.
+	Edges        GroupParentsEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GroupParentsEdges holds the relations/edges for other nodes in the graph.
+type GroupParentsEdges struct {
+	// Group holds the value of the group edge.
+	Group *Groups `json:"group,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Groups `json:"parent,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupParentsEdges) GroupOrErr() (*Groups, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: groups.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupParentsEdges) ParentOrErr() (*Groups, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: groups.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GroupParents) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groupparents.FieldID, groupparents.FieldGroupID, groupparents.FieldParentGroupID:
+			values[i] = new(sql.NullInt64)
+		case groupparents.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GroupParents fields.
+func (gp *GroupParents) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case groupparents.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gp.ID = int(value.Int64)
+		case groupparents.FieldGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				gp.GroupID = int(value.Int64)
+			}
+		case groupparents.FieldParentGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_group_id", values[i])
+			} else if value.Valid {
+				gp.ParentGroupID = int(value.Int64)
+			}
+		case groupparents.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gp.CreatedAt = value.Time
+			}
+		default:
+			gp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GroupParents.
+// This includes values selected through modifiers, order, etc.
+func (gp *GroupParents) Value(name string) (ent.Value, error) {
+	return gp.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the GroupParents entity.
+func (gp *GroupParents) QueryGroup() *GroupsQuery {
+	return NewGroupParentsClient(gp.config).QueryGroup(gp)
+}
+
+// QueryParent queries the "parent" edge of the GroupParents entity.
+func (gp *GroupParents) QueryParent() *GroupsQuery {
+	return NewGroupParentsClient(gp.config).QueryParent(gp)
+}
+
+// Update returns a builder for updating this GroupParents.
+// Note that you need to call GroupParents.Unwrap() before calling this method if this GroupParents
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gp *GroupParents) Update() *GroupParentsUpdateOne {
+	return NewGroupParentsClient(gp.config).UpdateOne(gp)
+}
+
+// Unwrap unwraps the GroupParents entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gp *GroupParents) Unwrap() *GroupParents {
+	_tx, ok := gp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupParents is not a transactional entity")
+	}
+	gp.config.driver = _tx.drv
+	return gp
+}
+
+// String implements the fmt.Stringer.
+func (gp *GroupParents) String() string {
+	var builder strings.Builder
+	builder.WriteString("GroupParents(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gp.ID))
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", gp.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("parent_group_id=")
+	builder.WriteString(fmt.Sprintf("%v", gp.ParentGroupID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(gp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupParentsSlice is a parsable slice of GroupParents.
+type GroupParentsSlice []*GroupParents
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package groupparents
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldLTE(FieldID, id))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldEQ(FieldGroupID, v))
+}
+
+// ParentGroupID applies equality check predicate on the "parent_group_id" field. It's identical to ParentGroupIDEQ.
+func ParentGroupID(v int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldEQ(FieldParentGroupID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// ParentGroupIDEQ applies the EQ predicate on the "parent_group_id" field.
+func ParentGroupIDEQ(v int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldEQ(FieldParentGroupID, v))
+}
+
+// ParentGroupIDNEQ applies the NEQ predicate on the "parent_group_id" field.
+func ParentGroupIDNEQ(v int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldNEQ(FieldParentGroupID, v))
+}
+
+// ParentGroupIDIn applies the In predicate on the "parent_group_id" field.
+func ParentGroupIDIn(vs ...int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldIn(FieldParentGroupID, vs...))
+}
+
+// ParentGroupIDNotIn applies the NotIn predicate on the "parent_group_id" field.
+func ParentGroupIDNotIn(vs ...int) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldNotIn(FieldParentGroupID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GroupParents {
+	return predicate.GroupParents(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.GroupParents {
+	return predicate.GroupParents(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.Groups) predicate.GroupParents {
+	return predicate.GroupParents(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.GroupParents {
+	return predicate.GroupParents(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Groups) predicate.GroupParents {
+	return predicate.GroupParents(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GroupParents) predicate.GroupParents {
+	return predicate.GroupParents(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GroupParents) predicate.GroupParents {
+	return predicate.GroupParents(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GroupParents) predicate.GroupParents {
+	return predicate.GroupParents(sql.NotPredicates(p))
+}
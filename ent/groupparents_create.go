@@ -0,0 +1,624 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/groups"
+)
+
+// GroupParentsCreate is the builder for creating a GroupParents entity.
+type GroupParentsCreate struct {
+	config
+	mutation *GroupParentsMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGroupID sets the "group_id" field.
+func (gpc *GroupParentsCreate) SetGroupID(i int) *GroupParentsCreate {
+	gpc.mutation.SetGroupID(i)
+	return gpc
+}
+
+// SetParentGroupID sets the "parent_group_id" field.
+func (gpc *GroupParentsCreate) SetParentGroupID(i int) *GroupParentsCreate {
+	gpc.mutation.SetParentGroupID(i)
+	return gpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gpc *GroupParentsCreate) SetCreatedAt(t time.Time) *GroupParentsCreate {
+	gpc.mutation.SetCreatedAt(t)
+	return gpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gpc *GroupParentsCreate) SetNillableCreatedAt(t *time.Time) *GroupParentsCreate {
+	if t != nil {
+		gpc.SetCreatedAt(*t)
+	}
+	return gpc
+}
+
+// SetID sets the "id" field.
+func (gpc *GroupParentsCreate) SetID(i int) *GroupParentsCreate {
+	gpc.mutation.SetID(i)
+	return gpc
+}
+
+// SetGroup sets the "group" edge to the Groups entity.
+func (gpc *GroupParentsCreate) SetGroup(g *Groups) *GroupParentsCreate {
+	return gpc.SetGroupID(g.ID)
+}
+
+// SetParentID sets the "parent" edge to the Groups entity by ID.
+func (gpc *GroupParentsCreate) SetParentID(id int) *GroupParentsCreate {
+	gpc.mutation.SetParentID(id)
+	return gpc
+}
+
+// SetParent sets the "parent" edge to the Groups entity.
+func (gpc *GroupParentsCreate) SetParent(g *Groups) *GroupParentsCreate {
+	return gpc.SetParentID(g.ID)
+}
+
+// Mutation returns the GroupParentsMutation object of the builder.
+func (gpc *GroupParentsCreate) Mutation() *GroupParentsMutation {
+	return gpc.mutation
+}
+
+// Save creates the GroupParents in the database.
+func (gpc *GroupParentsCreate) Save(ctx context.Context) (*GroupParents, error) {
+	gpc.defaults()
+	return withHooks(ctx, gpc.sqlSave, gpc.mutation, gpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gpc *GroupParentsCreate) SaveX(ctx context.Context) *GroupParents {
+	v, err := gpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gpc *GroupParentsCreate) Exec(ctx context.Context) error {
+	_, err := gpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpc *GroupParentsCreate) ExecX(ctx context.Context) {
+	if err := gpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gpc *GroupParentsCreate) defaults() {
+	if _, ok := gpc.mutation.CreatedAt(); !ok {
+		v := groupparents.DefaultCreatedAt()
+		gpc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gpc *GroupParentsCreate) check() error {
+	if _, ok := gpc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "GroupParents.group_id"`)}
+	}
+	if _, ok := gpc.mutation.ParentGroupID(); !ok {
+		return &ValidationError{Name: "parent_group_id", err: errors.New(`ent: missing required field "GroupParents.parent_group_id"`)}
+	}
+	if _, ok := gpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GroupParents.created_at"`)}
+	}
+	if _, ok := gpc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "GroupParents.group"`)}
+	}
+	if _, ok := gpc.mutation.ParentID(); !ok {
+		return &ValidationError{Name: "parent", err: errors.New(`ent: missing required edge "GroupParents.parent"`)}
+	}
+	return nil
+}
+
+func (gpc *GroupParentsCreate) sqlSave(ctx context.Context) (*GroupParents, error) {
+	if err := gpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	gpc.mutation.id = &_node.ID
+	gpc.mutation.done = true
+	return _node, nil
+}
+
+func (gpc *GroupParentsCreate) createSpec() (*GroupParents, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupParents{config: gpc.config}
+		_spec = sqlgraph.NewCreateSpec(groupparents.Table, sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = gpc.conflict
+	if id, ok := gpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := gpc.mutation.CreatedAt(); ok {
+		_spec.SetField(groupparents.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := gpc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupparents.GroupTable,
+			Columns: []string{groupparents.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gpc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupparents.ParentTable,
+			Columns: []string{groupparents.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentGroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupParents.Create().
+//		SetGroupID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupParentsUpsert) {
+//			SetGroupID(v+v).
+//		}).
+//		Exec(ctx)
+func (gpc *GroupParentsCreate) OnConflict(opts ...sql.ConflictOption) *GroupParentsUpsertOne {
+	gpc.conflict = opts
+	return &GroupParentsUpsertOne{
+		create: gpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupParents.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gpc *GroupParentsCreate) OnConflictColumns(columns ...string) *GroupParentsUpsertOne {
+	gpc.conflict = append(gpc.conflict, sql.ConflictColumns(columns...))
+	return &GroupParentsUpsertOne{
+		create: gpc,
+	}
+}
+
+type (
+	// GroupParentsUpsertOne is the builder for "upsert"-ing
+	//  one GroupParents node.
+	GroupParentsUpsertOne struct {
+		create *GroupParentsCreate
+	}
+
+	// GroupParentsUpsert is the "OnConflict" setter.
+	GroupParentsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupParentsUpsert) SetGroupID(v int) *GroupParentsUpsert {
+	u.Set(groupparents.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupParentsUpsert) UpdateGroupID() *GroupParentsUpsert {
+	u.SetExcluded(groupparents.FieldGroupID)
+	return u
+}
+
+// SetParentGroupID sets the "parent_group_id" field.
+func (u *GroupParentsUpsert) SetParentGroupID(v int) *GroupParentsUpsert {
+	u.Set(groupparents.FieldParentGroupID, v)
+	return u
+}
+
+// UpdateParentGroupID sets the "parent_group_id" field to the value that was provided on create.
+func (u *GroupParentsUpsert) UpdateParentGroupID() *GroupParentsUpsert {
+	u.SetExcluded(groupparents.FieldParentGroupID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GroupParents.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(groupparents.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupParentsUpsertOne) UpdateNewValues() *GroupParentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(groupparents.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(groupparents.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupParents.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GroupParentsUpsertOne) Ignore() *GroupParentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupParentsUpsertOne) DoNothing() *GroupParentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupParentsCreate.OnConflict
+// documentation for more info.
+func (u *GroupParentsUpsertOne) Update(set func(*GroupParentsUpsert)) *GroupParentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupParentsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupParentsUpsertOne) SetGroupID(v int) *GroupParentsUpsertOne {
+	return u.Update(func(s *GroupParentsUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupParentsUpsertOne) UpdateGroupID() *GroupParentsUpsertOne {
+	return u.Update(func(s *GroupParentsUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetParentGroupID sets the "parent_group_id" field.
+func (u *GroupParentsUpsertOne) SetParentGroupID(v int) *GroupParentsUpsertOne {
+	return u.Update(func(s *GroupParentsUpsert) {
+		s.SetParentGroupID(v)
+	})
+}
+
+// UpdateParentGroupID sets the "parent_group_id" field to the value that was provided on create.
+func (u *GroupParentsUpsertOne) UpdateParentGroupID() *GroupParentsUpsertOne {
+	return u.Update(func(s *GroupParentsUpsert) {
+		s.UpdateParentGroupID()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupParentsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupParentsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupParentsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GroupParentsUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GroupParentsUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GroupParentsCreateBulk is the builder for creating many GroupParents entities in bulk.
+type GroupParentsCreateBulk struct {
+	config
+	err      error
+	builders []*GroupParentsCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GroupParents entities in the database.
+func (gpcb *GroupParentsCreateBulk) Save(ctx context.Context) ([]*GroupParents, error) {
+	if gpcb.err != nil {
+		return nil, gpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gpcb.builders))
+	nodes := make([]*GroupParents, len(gpcb.builders))
+	mutators := make([]Mutator, len(gpcb.builders))
+	for i := range gpcb.builders {
+		func(i int, root context.Context) {
+			builder := gpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupParentsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = gpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gpcb *GroupParentsCreateBulk) SaveX(ctx context.Context) []*GroupParents {
+	v, err := gpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gpcb *GroupParentsCreateBulk) Exec(ctx context.Context) error {
+	_, err := gpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpcb *GroupParentsCreateBulk) ExecX(ctx context.Context) {
+	if err := gpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupParents.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupParentsUpsert) {
+//			SetGroupID(v+v).
+//		}).
+//		Exec(ctx)
+func (gpcb *GroupParentsCreateBulk) OnConflict(opts ...sql.ConflictOption) *GroupParentsUpsertBulk {
+	gpcb.conflict = opts
+	return &GroupParentsUpsertBulk{
+		create: gpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupParents.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gpcb *GroupParentsCreateBulk) OnConflictColumns(columns ...string) *GroupParentsUpsertBulk {
+	gpcb.conflict = append(gpcb.conflict, sql.ConflictColumns(columns...))
+	return &GroupParentsUpsertBulk{
+		create: gpcb,
+	}
+}
+
+// GroupParentsUpsertBulk is the builder for "upsert"-ing
+// a bulk of GroupParents nodes.
+type GroupParentsUpsertBulk struct {
+	create *GroupParentsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GroupParents.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(groupparents.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupParentsUpsertBulk) UpdateNewValues() *GroupParentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(groupparents.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(groupparents.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupParents.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GroupParentsUpsertBulk) Ignore() *GroupParentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupParentsUpsertBulk) DoNothing() *GroupParentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupParentsCreateBulk.OnConflict
+// documentation for more info.
+func (u *GroupParentsUpsertBulk) Update(set func(*GroupParentsUpsert)) *GroupParentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupParentsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupParentsUpsertBulk) SetGroupID(v int) *GroupParentsUpsertBulk {
+	return u.Update(func(s *GroupParentsUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupParentsUpsertBulk) UpdateGroupID() *GroupParentsUpsertBulk {
+	return u.Update(func(s *GroupParentsUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetParentGroupID sets the "parent_group_id" field.
+func (u *GroupParentsUpsertBulk) SetParentGroupID(v int) *GroupParentsUpsertBulk {
+	return u.Update(func(s *GroupParentsUpsert) {
+		s.SetParentGroupID(v)
+	})
+}
+
+// UpdateParentGroupID sets the "parent_group_id" field to the value that was provided on create.
+func (u *GroupParentsUpsertBulk) UpdateParentGroupID() *GroupParentsUpsertBulk {
+	return u.Update(func(s *GroupParentsUpsert) {
+		s.UpdateParentGroupID()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupParentsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GroupParentsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupParentsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupParentsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
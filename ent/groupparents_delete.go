@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// GroupParentsDelete is the builder for deleting a GroupParents entity.
+type GroupParentsDelete struct {
+	config
+	hooks    []Hook
+	mutation *GroupParentsMutation
+}
+
+// Where appends a list predicates to the GroupParentsDelete builder.
+func (gpd *GroupParentsDelete) Where(ps ...predicate.GroupParents) *GroupParentsDelete {
+	gpd.mutation.Where(ps...)
+	return gpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gpd *GroupParentsDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gpd.sqlExec, gpd.mutation, gpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpd *GroupParentsDelete) ExecX(ctx context.Context) int {
+	n, err := gpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gpd *GroupParentsDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(groupparents.Table, sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt))
+	if ps := gpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gpd.mutation.done = true
+	return affected, err
+}
+
+// GroupParentsDeleteOne is the builder for deleting a single GroupParents entity.
+type GroupParentsDeleteOne struct {
+	gpd *GroupParentsDelete
+}
+
+// Where appends a list predicates to the GroupParentsDelete builder.
+func (gpdo *GroupParentsDeleteOne) Where(ps ...predicate.GroupParents) *GroupParentsDeleteOne {
+	gpdo.gpd.mutation.Where(ps...)
+	return gpdo
+}
+
+// Exec executes the deletion query.
+func (gpdo *GroupParentsDeleteOne) Exec(ctx context.Context) error {
+	n, err := gpdo.gpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{groupparents.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpdo *GroupParentsDeleteOne) ExecX(ctx context.Context) {
+	if err := gpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,716 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// GroupParentsQuery is the builder for querying GroupParents entities.
+type GroupParentsQuery struct {
+	config
+	ctx        *QueryContext
+	order      []groupparents.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GroupParents
+	withGroup  *GroupsQuery
+	withParent *GroupsQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GroupParentsQuery builder.
+func (gpq *GroupParentsQuery) Where(ps ...predicate.GroupParents) *GroupParentsQuery {
+	gpq.predicates = append(gpq.predicates, ps...)
+	return gpq
+}
+
+// Limit the number of records to be returned by this query.
+func (gpq *GroupParentsQuery) Limit(limit int) *GroupParentsQuery {
+	gpq.ctx.Limit = &limit
+	return gpq
+}
+
+// Offset to start from.
+func (gpq *GroupParentsQuery) Offset(offset int) *GroupParentsQuery {
+	gpq.ctx.Offset = &offset
+	return gpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gpq *GroupParentsQuery) Unique(unique bool) *GroupParentsQuery {
+	gpq.ctx.Unique = &unique
+	return gpq
+}
+
+// Order specifies how the records should be ordered.
+func (gpq *GroupParentsQuery) Order(o ...groupparents.OrderOption) *GroupParentsQuery {
+	gpq.order = append(gpq.order, o...)
+	return gpq
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (gpq *GroupParentsQuery) QueryGroup() *GroupsQuery {
+	query := (&GroupsClient{config: gpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupparents.Table, groupparents.FieldID, selector),
+			sqlgraph.To(groups.Table, groups.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupparents.GroupTable, groupparents.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (gpq *GroupParentsQuery) QueryParent() *GroupsQuery {
+	query := (&GroupsClient{config: gpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupparents.Table, groupparents.FieldID, selector),
+			sqlgraph.To(groups.Table, groups.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupparents.ParentTable, groupparents.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first GroupParents entity from the query.
+// Returns a *NotFoundError when no GroupParents was found.
+func (gpq *GroupParentsQuery) First(ctx context.Context) (*GroupParents, error) {
+	nodes, err := gpq.Limit(1).All(setContextOp(ctx, gpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{groupparents.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gpq *GroupParentsQuery) FirstX(ctx context.Context) *GroupParents {
+	node, err := gpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GroupParents ID from the query.
+// Returns a *NotFoundError when no GroupParents ID was found.
+func (gpq *GroupParentsQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gpq.Limit(1).IDs(setContextOp(ctx, gpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{groupparents.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gpq *GroupParentsQuery) FirstIDX(ctx context.Context) int {
+	id, err := gpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GroupParents entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GroupParents entity is found.
+// Returns a *NotFoundError when no GroupParents entities are found.
+func (gpq *GroupParentsQuery) Only(ctx context.Context) (*GroupParents, error) {
+	nodes, err := gpq.Limit(2).All(setContextOp(ctx, gpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{groupparents.Label}
+	default:
+		return nil, &NotSingularError{groupparents.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gpq *GroupParentsQuery) OnlyX(ctx context.Context) *GroupParents {
+	node, err := gpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GroupParents ID in the query.
+// Returns a *NotSingularError when more than one GroupParents ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gpq *GroupParentsQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gpq.Limit(2).IDs(setContextOp(ctx, gpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{groupparents.Label}
+	default:
+		err = &NotSingularError{groupparents.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gpq *GroupParentsQuery) OnlyIDX(ctx context.Context) int {
+	id, err := gpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GroupParentsSlice.
+func (gpq *GroupParentsQuery) All(ctx context.Context) ([]*GroupParents, error) {
+	ctx = setContextOp(ctx, gpq.ctx, "All")
+	if err := gpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GroupParents, *GroupParentsQuery]()
+	return withInterceptors[[]*GroupParents](ctx, gpq, qr, gpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gpq *GroupParentsQuery) AllX(ctx context.Context) []*GroupParents {
+	nodes, err := gpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GroupParents IDs.
+func (gpq *GroupParentsQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if gpq.ctx.Unique == nil && gpq.path != nil {
+		gpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gpq.ctx, "IDs")
+	if err = gpq.Select(groupparents.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gpq *GroupParentsQuery) IDsX(ctx context.Context) []int {
+	ids, err := gpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gpq *GroupParentsQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gpq.ctx, "Count")
+	if err := gpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gpq, querierCount[*GroupParentsQuery](), gpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gpq *GroupParentsQuery) CountX(ctx context.Context) int {
+	count, err := gpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gpq *GroupParentsQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gpq.ctx, "Exist")
+	switch _, err := gpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gpq *GroupParentsQuery) ExistX(ctx context.Context) bool {
+	exist, err := gpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GroupParentsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gpq *GroupParentsQuery) Clone() *GroupParentsQuery {
+	if gpq == nil {
+		return nil
+	}
+	return &GroupParentsQuery{
+		config:     gpq.config,
+		ctx:        gpq.ctx.Clone(),
+		order:      append([]groupparents.OrderOption{}, gpq.order...),
+		inters:     append([]Interceptor{}, gpq.inters...),
+		predicates: append([]predicate.GroupParents{}, gpq.predicates...),
+		withGroup:  gpq.withGroup.Clone(),
+		withParent: gpq.withParent.Clone(),
+		// clone intermediate query.
+		sql:  gpq.sql.Clone(),
+		path: gpq.path,
+	}
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (gpq *GroupParentsQuery) WithGroup(opts ...func(*GroupsQuery)) *GroupParentsQuery {
+	query := (&GroupsClient{config: gpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gpq.withGroup = query
+	return gpq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (gpq *GroupParentsQuery) WithParent(opts ...func(*GroupsQuery)) *GroupParentsQuery {
+	query := (&GroupsClient{config: gpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gpq.withParent = query
+	return gpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GroupID int `json:"group_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GroupParents.Query().
+//		GroupBy(groupparents.FieldGroupID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gpq *GroupParentsQuery) GroupBy(field string, fields ...string) *GroupParentsGroupBy {
+	gpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GroupParentsGroupBy{build: gpq}
+	grbuild.flds = &gpq.ctx.Fields
+	grbuild.label = groupparents.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GroupID int `json:"group_id,omitempty"`
+//	}
+//
+//	client.GroupParents.Query().
+//		Select(groupparents.FieldGroupID).
+//		Scan(ctx, &v)
+func (gpq *GroupParentsQuery) Select(fields ...string) *GroupParentsSelect {
+	gpq.ctx.Fields = append(gpq.ctx.Fields, fields...)
+	sbuild := &GroupParentsSelect{GroupParentsQuery: gpq}
+	sbuild.label = groupparents.Label
+	sbuild.flds, sbuild.scan = &gpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GroupParentsSelect configured with the given aggregations.
+func (gpq *GroupParentsQuery) Aggregate(fns ...AggregateFunc) *GroupParentsSelect {
+	return gpq.Select().Aggregate(fns...)
+}
+
+func (gpq *GroupParentsQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gpq.ctx.Fields {
+		if !groupparents.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gpq.path != nil {
+		prev, err := gpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gpq.sql = prev
+	}
+	return nil
+}
+
+func (gpq *GroupParentsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GroupParents, error) {
+	var (
+		nodes       = []*GroupParents{}
+		_spec       = gpq.querySpec()
+		loadedTypes = [2]bool{
+			gpq.withGroup != nil,
+			gpq.withParent != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GroupParents).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GroupParents{config: gpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(gpq.modifiers) > 0 {
+		_spec.Modifiers = gpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := gpq.withGroup; query != nil {
+		if err := gpq.loadGroup(ctx, query, nodes, nil,
+			func(n *GroupParents, e *Groups) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gpq.withParent; query != nil {
+		if err := gpq.loadParent(ctx, query, nodes, nil,
+			func(n *GroupParents, e *Groups) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (gpq *GroupParentsQuery) loadGroup(ctx context.Context, query *GroupsQuery, nodes []*GroupParents, init func(*GroupParents), assign func(*GroupParents, *Groups)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*GroupParents)
+	for i := range nodes {
+		fk := nodes[i].GroupID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(groups.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (gpq *GroupParentsQuery) loadParent(ctx context.Context, query *GroupsQuery, nodes []*GroupParents, init func(*GroupParents), assign func(*GroupParents, *Groups)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*GroupParents)
+	for i := range nodes {
+		fk := nodes[i].ParentGroupID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(groups.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_group_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (gpq *GroupParentsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gpq.querySpec()
+	if len(gpq.modifiers) > 0 {
+		_spec.Modifiers = gpq.modifiers
+	}
+	_spec.Node.Columns = gpq.ctx.Fields
+	if len(gpq.ctx.Fields) > 0 {
+		_spec.Unique = gpq.ctx.Unique != nil && *gpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gpq.driver, _spec)
+}
+
+func (gpq *GroupParentsQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(groupparents.Table, groupparents.Columns, sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt))
+	_spec.From = gpq.sql
+	if unique := gpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupparents.FieldID)
+		for i := range fields {
+			if fields[i] != groupparents.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if gpq.withGroup != nil {
+			_spec.Node.AddColumnOnce(groupparents.FieldGroupID)
+		}
+		if gpq.withParent != nil {
+			_spec.Node.AddColumnOnce(groupparents.FieldParentGroupID)
+		}
+	}
+	if ps := gpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gpq *GroupParentsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gpq.driver.Dialect())
+	t1 := builder.Table(groupparents.Table)
+	columns := gpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = groupparents.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gpq.sql != nil {
+		selector = gpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gpq.ctx.Unique != nil && *gpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range gpq.modifiers {
+		m(selector)
+	}
+	for _, p := range gpq.predicates {
+		p(selector)
+	}
+	for _, p := range gpq.order {
+		p(selector)
+	}
+	if offset := gpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (gpq *GroupParentsQuery) ForUpdate(opts ...sql.LockOption) *GroupParentsQuery {
+	if gpq.driver.Dialect() == dialect.Postgres {
+		gpq.Unique(false)
+	}
+	gpq.modifiers = append(gpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return gpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (gpq *GroupParentsQuery) ForShare(opts ...sql.LockOption) *GroupParentsQuery {
+	if gpq.driver.Dialect() == dialect.Postgres {
+		gpq.Unique(false)
+	}
+	gpq.modifiers = append(gpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return gpq
+}
+
+// GroupParentsGroupBy is the group-by builder for GroupParents entities.
+type GroupParentsGroupBy struct {
+	selector
+	build *GroupParentsQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gpgb *GroupParentsGroupBy) Aggregate(fns ...AggregateFunc) *GroupParentsGroupBy {
+	gpgb.fns = append(gpgb.fns, fns...)
+	return gpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gpgb *GroupParentsGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gpgb.build.ctx, "GroupBy")
+	if err := gpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupParentsQuery, *GroupParentsGroupBy](ctx, gpgb.build, gpgb, gpgb.build.inters, v)
+}
+
+func (gpgb *GroupParentsGroupBy) sqlScan(ctx context.Context, root *GroupParentsQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gpgb.fns))
+	for _, fn := range gpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gpgb.flds)+len(gpgb.fns))
+		for _, f := range *gpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GroupParentsSelect is the builder for selecting fields of GroupParents entities.
+type GroupParentsSelect struct {
+	*GroupParentsQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gps *GroupParentsSelect) Aggregate(fns ...AggregateFunc) *GroupParentsSelect {
+	gps.fns = append(gps.fns, fns...)
+	return gps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gps *GroupParentsSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gps.ctx, "Select")
+	if err := gps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupParentsQuery, *GroupParentsSelect](ctx, gps.GroupParentsQuery, gps, gps.inters, v)
+}
+
+func (gps *GroupParentsSelect) sqlScan(ctx context.Context, root *GroupParentsQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gps.fns))
+	for _, fn := range gps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
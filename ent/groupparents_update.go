@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// GroupParentsUpdate is the builder for updating GroupParents entities.
+type GroupParentsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupParentsMutation
+}
+
+// Where appends a list predicates to the GroupParentsUpdate builder.
+func (gpu *GroupParentsUpdate) Where(ps ...predicate.GroupParents) *GroupParentsUpdate {
+	gpu.mutation.Where(ps...)
+	return gpu
+}
+
+// SetGroupID sets the "group_id" field.
+func (gpu *GroupParentsUpdate) SetGroupID(i int) *GroupParentsUpdate {
+	gpu.mutation.SetGroupID(i)
+	return gpu
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (gpu *GroupParentsUpdate) SetNillableGroupID(i *int) *GroupParentsUpdate {
+	if i != nil {
+		gpu.SetGroupID(*i)
+	}
+	return gpu
+}
+
+// SetParentGroupID sets the "parent_group_id" field.
+func (gpu *GroupParentsUpdate) SetParentGroupID(i int) *GroupParentsUpdate {
+	gpu.mutation.SetParentGroupID(i)
+	return gpu
+}
+
+// SetNillableParentGroupID sets the "parent_group_id" field if the given value is not nil.
+func (gpu *GroupParentsUpdate) SetNillableParentGroupID(i *int) *GroupParentsUpdate {
+	if i != nil {
+		gpu.SetParentGroupID(*i)
+	}
+	return gpu
+}
+
+// SetGroup sets the "group" edge to the Groups entity.
+func (gpu *GroupParentsUpdate) SetGroup(g *Groups) *GroupParentsUpdate {
+	return gpu.SetGroupID(g.ID)
+}
+
+// SetParentID sets the "parent" edge to the Groups entity by ID.
+func (gpu *GroupParentsUpdate) SetParentID(id int) *GroupParentsUpdate {
+	gpu.mutation.SetParentID(id)
+	return gpu
+}
+
+// SetParent sets the "parent" edge to the Groups entity.
+func (gpu *GroupParentsUpdate) SetParent(g *Groups) *GroupParentsUpdate {
+	return gpu.SetParentID(g.ID)
+}
+
+// Mutation returns the GroupParentsMutation object of the builder.
+func (gpu *GroupParentsUpdate) Mutation() *GroupParentsMutation {
+	return gpu.mutation
+}
+
+// ClearGroup clears the "group" edge to the Groups entity.
+func (gpu *GroupParentsUpdate) ClearGroup() *GroupParentsUpdate {
+	gpu.mutation.ClearGroup()
+	return gpu
+}
+
+// ClearParent clears the "parent" edge to the Groups entity.
+func (gpu *GroupParentsUpdate) ClearParent() *GroupParentsUpdate {
+	gpu.mutation.ClearParent()
+	return gpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gpu *GroupParentsUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gpu.sqlSave, gpu.mutation, gpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gpu *GroupParentsUpdate) SaveX(ctx context.Context) int {
+	affected, err := gpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gpu *GroupParentsUpdate) Exec(ctx context.Context) error {
+	_, err := gpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpu *GroupParentsUpdate) ExecX(ctx context.Context) {
+	if err := gpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gpu *GroupParentsUpdate) check() error {
+	if _, ok := gpu.mutation.GroupID(); gpu.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupParents.group"`)
+	}
+	if _, ok := gpu.mutation.ParentID(); gpu.mutation.ParentCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupParents.parent"`)
+	}
+	return nil
+}
+
+func (gpu *GroupParentsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupparents.Table, groupparents.Columns, sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt))
+	if ps := gpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if gpu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupparents.GroupTable,
+			Columns: []string{groupparents.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gpu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupparents.GroupTable,
+			Columns: []string{groupparents.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gpu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupparents.ParentTable,
+			Columns: []string{groupparents.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gpu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupparents.ParentTable,
+			Columns: []string{groupparents.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupparents.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gpu.mutation.done = true
+	return n, nil
+}
+
+// GroupParentsUpdateOne is the builder for updating a single GroupParents entity.
+type GroupParentsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupParentsMutation
+}
+
+// SetGroupID sets the "group_id" field.
+func (gpuo *GroupParentsUpdateOne) SetGroupID(i int) *GroupParentsUpdateOne {
+	gpuo.mutation.SetGroupID(i)
+	return gpuo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (gpuo *GroupParentsUpdateOne) SetNillableGroupID(i *int) *GroupParentsUpdateOne {
+	if i != nil {
+		gpuo.SetGroupID(*i)
+	}
+	return gpuo
+}
+
+// SetParentGroupID sets the "parent_group_id" field.
+func (gpuo *GroupParentsUpdateOne) SetParentGroupID(i int) *GroupParentsUpdateOne {
+	gpuo.mutation.SetParentGroupID(i)
+	return gpuo
+}
+
+// SetNillableParentGroupID sets the "parent_group_id" field if the given value is not nil.
+func (gpuo *GroupParentsUpdateOne) SetNillableParentGroupID(i *int) *GroupParentsUpdateOne {
+	if i != nil {
+		gpuo.SetParentGroupID(*i)
+	}
+	return gpuo
+}
+
+// SetGroup sets the "group" edge to the Groups entity.
+func (gpuo *GroupParentsUpdateOne) SetGroup(g *Groups) *GroupParentsUpdateOne {
+	return gpuo.SetGroupID(g.ID)
+}
+
+// SetParentID sets the "parent" edge to the Groups entity by ID.
+func (gpuo *GroupParentsUpdateOne) SetParentID(id int) *GroupParentsUpdateOne {
+	gpuo.mutation.SetParentID(id)
+	return gpuo
+}
+
+// SetParent sets the "parent" edge to the Groups entity.
+func (gpuo *GroupParentsUpdateOne) SetParent(g *Groups) *GroupParentsUpdateOne {
+	return gpuo.SetParentID(g.ID)
+}
+
+// Mutation returns the GroupParentsMutation object of the builder.
+func (gpuo *GroupParentsUpdateOne) Mutation() *GroupParentsMutation {
+	return gpuo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Groups entity.
+func (gpuo *GroupParentsUpdateOne) ClearGroup() *GroupParentsUpdateOne {
+	gpuo.mutation.ClearGroup()
+	return gpuo
+}
+
+// ClearParent clears the "parent" edge to the Groups entity.
+func (gpuo *GroupParentsUpdateOne) ClearParent() *GroupParentsUpdateOne {
+	gpuo.mutation.ClearParent()
+	return gpuo
+}
+
+// Where appends a list predicates to the GroupParentsUpdate builder.
+func (gpuo *GroupParentsUpdateOne) Where(ps ...predicate.GroupParents) *GroupParentsUpdateOne {
+	gpuo.mutation.Where(ps...)
+	return gpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gpuo *GroupParentsUpdateOne) Select(field string, fields ...string) *GroupParentsUpdateOne {
+	gpuo.fields = append([]string{field}, fields...)
+	return gpuo
+}
+
+// Save executes the query and returns the updated GroupParents entity.
+func (gpuo *GroupParentsUpdateOne) Save(ctx context.Context) (*GroupParents, error) {
+	return withHooks(ctx, gpuo.sqlSave, gpuo.mutation, gpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gpuo *GroupParentsUpdateOne) SaveX(ctx context.Context) *GroupParents {
+	node, err := gpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gpuo *GroupParentsUpdateOne) Exec(ctx context.Context) error {
+	_, err := gpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpuo *GroupParentsUpdateOne) ExecX(ctx context.Context) {
+	if err := gpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gpuo *GroupParentsUpdateOne) check() error {
+	if _, ok := gpuo.mutation.GroupID(); gpuo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupParents.group"`)
+	}
+	if _, ok := gpuo.mutation.ParentID(); gpuo.mutation.ParentCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupParents.parent"`)
+	}
+	return nil
+}
+
+func (gpuo *GroupParentsUpdateOne) sqlSave(ctx context.Context) (_node *GroupParents, err error) {
+	if err := gpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupparents.Table, groupparents.Columns, sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt))
+	id, ok := gpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupParents.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupparents.FieldID)
+		for _, f := range fields {
+			if !groupparents.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != groupparents.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if gpuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupparents.GroupTable,
+			Columns: []string{groupparents.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gpuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupparents.GroupTable,
+			Columns: []string{groupparents.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gpuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupparents.ParentTable,
+			Columns: []string{groupparents.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gpuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupparents.ParentTable,
+			Columns: []string{groupparents.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GroupParents{config: gpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupparents.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gpuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// GroupRoles is the model entity for the GroupRoles schema.
+type GroupRoles struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID int `json:"group_id,omitempty"`
+	// RoleID holds the value of the "role_id" field.
+	RoleID int `json:"role_id,omitempty"`
+	// User who assigned this role to the group
+	AssignedBy *uuid.UUID `json:"assigned_by,omitempty"`
+	// AssignedAt holds the value of the "assigned_at" field.
+	AssignedAt time.Time `json:"assigned_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GroupRolesQuery when eager-loading is set.
+	Edges        GroupRolesEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GroupRolesEdges holds the relations/edges for other nodes in the graph.
+type GroupRolesEdges struct {
+	// Group holds the value of the group edge.
+	Group *Groups `json:"group,omitempty"`
+	// Role holds the value of the role edge.
+	Role *Roles `json:"role,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupRolesEdges) GroupOrErr() (*Groups, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: groups.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupRolesEdges) RoleOrErr() (*Roles, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: roles.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GroupRoles) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case grouproles.FieldAssignedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case grouproles.FieldID, grouproles.FieldGroupID, grouproles.FieldRoleID:
+			values[i] = new(sql.NullInt64)
+		case grouproles.FieldAssignedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GroupRoles fields.
+func (gr *GroupRoles) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case grouproles.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gr.ID = int(value.Int64)
+		case grouproles.FieldGroupID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				gr.GroupID = int(value.Int64)
+			}
+		case grouproles.FieldRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				gr.RoleID = int(value.Int64)
+			}
+		case grouproles.FieldAssignedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_by", values[i])
+			} else if value.Valid {
+				gr.AssignedBy = new(uuid.UUID)
+				*gr.AssignedBy = *value.S.(*uuid.UUID)
+			}
+		case grouproles.FieldAssignedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_at", values[i])
+			} else if value.Valid {
+				gr.AssignedAt = value.Time
+			}
+		default:
+			gr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GroupRoles.
+// This includes values selected through modifiers, order, etc.
+func (gr *GroupRoles) Value(name string) (ent.Value, error) {
+	return gr.selectValues.Get(name)
+}
+
+// QueryGroup queries the "group" edge of the GroupRoles entity.
+func (gr *GroupRoles) QueryGroup() *GroupsQuery {
+	return NewGroupRolesClient(gr.config).QueryGroup(gr)
+}
+
+// QueryRole queries the "role" edge of the GroupRoles entity.
+func (gr *GroupRoles) QueryRole() *RolesQuery {
+	return NewGroupRolesClient(gr.config).QueryRole(gr)
+}
+
+// Update returns a builder for updating this GroupRoles.
+// Note that you need to call GroupRoles.Unwrap() before calling this method if this GroupRoles
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gr *GroupRoles) Update() *GroupRolesUpdateOne {
+	return NewGroupRolesClient(gr.config).UpdateOne(gr)
+}
+
+// Unwrap unwraps the GroupRoles entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gr *GroupRoles) Unwrap() *GroupRoles {
+	_tx, ok := gr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupRoles is not a transactional entity")
+	}
+	gr.config.driver = _tx.drv
+	return gr
+}
+
+// String implements the fmt.Stringer.
+func (gr *GroupRoles) String() string {
+	var builder strings.Builder
+	builder.WriteString("GroupRoles(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gr.ID))
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", gr.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", gr.RoleID))
+	builder.WriteString(", ")
+	if v := gr.AssignedBy; v != nil {
+		builder.WriteString("assigned_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("assigned_at=")
+	builder.WriteString(gr.AssignedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupRolesSlice is a parsable slice of GroupRoles.
+type GroupRolesSlice []*GroupRoles
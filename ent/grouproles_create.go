@@ -0,0 +1,698 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// GroupRolesCreate is the builder for creating a GroupRoles entity.
+type GroupRolesCreate struct {
+	config
+	mutation *GroupRolesMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGroupID sets the "group_id" field.
+func (grc *GroupRolesCreate) SetGroupID(i int) *GroupRolesCreate {
+	grc.mutation.SetGroupID(i)
+	return grc
+}
+
+// SetRoleID sets the "role_id" field.
+func (grc *GroupRolesCreate) SetRoleID(i int) *GroupRolesCreate {
+	grc.mutation.SetRoleID(i)
+	return grc
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (grc *GroupRolesCreate) SetAssignedBy(u uuid.UUID) *GroupRolesCreate {
+	grc.mutation.SetAssignedBy(u)
+	return grc
+}
+
+// SetNillableAssignedBy sets the "assigned_by" field if the given value is not nil.
+func (grc *GroupRolesCreate) SetNillableAssignedBy(u *uuid.UUID) *GroupRolesCreate {
+	if u != nil {
+		grc.SetAssignedBy(*u)
+	}
+	return grc
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (grc *GroupRolesCreate) SetAssignedAt(t time.Time) *GroupRolesCreate {
+	grc.mutation.SetAssignedAt(t)
+	return grc
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (grc *GroupRolesCreate) SetNillableAssignedAt(t *time.Time) *GroupRolesCreate {
+	if t != nil {
+		grc.SetAssignedAt(*t)
+	}
+	return grc
+}
+
+// SetID sets the "id" field.
+func (grc *GroupRolesCreate) SetID(i int) *GroupRolesCreate {
+	grc.mutation.SetID(i)
+	return grc
+}
+
+// SetGroup sets the "group" edge to the Groups entity.
+func (grc *GroupRolesCreate) SetGroup(g *Groups) *GroupRolesCreate {
+	return grc.SetGroupID(g.ID)
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (grc *GroupRolesCreate) SetRole(r *Roles) *GroupRolesCreate {
+	return grc.SetRoleID(r.ID)
+}
+
+// Mutation returns the GroupRolesMutation object of the builder.
+func (grc *GroupRolesCreate) Mutation() *GroupRolesMutation {
+	return grc.mutation
+}
+
+// Save creates the GroupRoles in the database.
+func (grc *GroupRolesCreate) Save(ctx context.Context) (*GroupRoles, error) {
+	grc.defaults()
+	return withHooks(ctx, grc.sqlSave, grc.mutation, grc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (grc *GroupRolesCreate) SaveX(ctx context.Context) *GroupRoles {
+	v, err := grc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (grc *GroupRolesCreate) Exec(ctx context.Context) error {
+	_, err := grc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (grc *GroupRolesCreate) ExecX(ctx context.Context) {
+	if err := grc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (grc *GroupRolesCreate) defaults() {
+	if _, ok := grc.mutation.AssignedAt(); !ok {
+		v := grouproles.DefaultAssignedAt()
+		grc.mutation.SetAssignedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (grc *GroupRolesCreate) check() error {
+	if _, ok := grc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "GroupRoles.group_id"`)}
+	}
+	if _, ok := grc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "GroupRoles.role_id"`)}
+	}
+	if _, ok := grc.mutation.AssignedAt(); !ok {
+		return &ValidationError{Name: "assigned_at", err: errors.New(`ent: missing required field "GroupRoles.assigned_at"`)}
+	}
+	if _, ok := grc.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group", err: errors.New(`ent: missing required edge "GroupRoles.group"`)}
+	}
+	if _, ok := grc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "GroupRoles.role"`)}
+	}
+	return nil
+}
+
+func (grc *GroupRolesCreate) sqlSave(ctx context.Context) (*GroupRoles, error) {
+	if err := grc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := grc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, grc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	grc.mutation.id = &_node.ID
+	grc.mutation.done = true
+	return _node, nil
+}
+
+func (grc *GroupRolesCreate) createSpec() (*GroupRoles, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupRoles{config: grc.config}
+		_spec = sqlgraph.NewCreateSpec(grouproles.Table, sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = grc.conflict
+	if id, ok := grc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := grc.mutation.AssignedBy(); ok {
+		_spec.SetField(grouproles.FieldAssignedBy, field.TypeUUID, value)
+		_node.AssignedBy = &value
+	}
+	if value, ok := grc.mutation.AssignedAt(); ok {
+		_spec.SetField(grouproles.FieldAssignedAt, field.TypeTime, value)
+		_node.AssignedAt = value
+	}
+	if nodes := grc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   grouproles.GroupTable,
+			Columns: []string{grouproles.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := grc.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   grouproles.RoleTable,
+			Columns: []string{grouproles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupRoles.Create().
+//		SetGroupID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupRolesUpsert) {
+//			SetGroupID(v+v).
+//		}).
+//		Exec(ctx)
+func (grc *GroupRolesCreate) OnConflict(opts ...sql.ConflictOption) *GroupRolesUpsertOne {
+	grc.conflict = opts
+	return &GroupRolesUpsertOne{
+		create: grc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupRoles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (grc *GroupRolesCreate) OnConflictColumns(columns ...string) *GroupRolesUpsertOne {
+	grc.conflict = append(grc.conflict, sql.ConflictColumns(columns...))
+	return &GroupRolesUpsertOne{
+		create: grc,
+	}
+}
+
+type (
+	// GroupRolesUpsertOne is the builder for "upsert"-ing
+	//  one GroupRoles node.
+	GroupRolesUpsertOne struct {
+		create *GroupRolesCreate
+	}
+
+	// GroupRolesUpsert is the "OnConflict" setter.
+	GroupRolesUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupRolesUpsert) SetGroupID(v int) *GroupRolesUpsert {
+	u.Set(grouproles.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupRolesUpsert) UpdateGroupID() *GroupRolesUpsert {
+	u.SetExcluded(grouproles.FieldGroupID)
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *GroupRolesUpsert) SetRoleID(v int) *GroupRolesUpsert {
+	u.Set(grouproles.FieldRoleID, v)
+	return u
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *GroupRolesUpsert) UpdateRoleID() *GroupRolesUpsert {
+	u.SetExcluded(grouproles.FieldRoleID)
+	return u
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *GroupRolesUpsert) SetAssignedBy(v uuid.UUID) *GroupRolesUpsert {
+	u.Set(grouproles.FieldAssignedBy, v)
+	return u
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *GroupRolesUpsert) UpdateAssignedBy() *GroupRolesUpsert {
+	u.SetExcluded(grouproles.FieldAssignedBy)
+	return u
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *GroupRolesUpsert) ClearAssignedBy() *GroupRolesUpsert {
+	u.SetNull(grouproles.FieldAssignedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GroupRoles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(grouproles.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupRolesUpsertOne) UpdateNewValues() *GroupRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(grouproles.FieldID)
+		}
+		if _, exists := u.create.mutation.AssignedAt(); exists {
+			s.SetIgnore(grouproles.FieldAssignedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupRoles.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GroupRolesUpsertOne) Ignore() *GroupRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupRolesUpsertOne) DoNothing() *GroupRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupRolesCreate.OnConflict
+// documentation for more info.
+func (u *GroupRolesUpsertOne) Update(set func(*GroupRolesUpsert)) *GroupRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupRolesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupRolesUpsertOne) SetGroupID(v int) *GroupRolesUpsertOne {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupRolesUpsertOne) UpdateGroupID() *GroupRolesUpsertOne {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *GroupRolesUpsertOne) SetRoleID(v int) *GroupRolesUpsertOne {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *GroupRolesUpsertOne) UpdateRoleID() *GroupRolesUpsertOne {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *GroupRolesUpsertOne) SetAssignedBy(v uuid.UUID) *GroupRolesUpsertOne {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.SetAssignedBy(v)
+	})
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *GroupRolesUpsertOne) UpdateAssignedBy() *GroupRolesUpsertOne {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.UpdateAssignedBy()
+	})
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *GroupRolesUpsertOne) ClearAssignedBy() *GroupRolesUpsertOne {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.ClearAssignedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupRolesUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupRolesCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupRolesUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GroupRolesUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GroupRolesUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GroupRolesCreateBulk is the builder for creating many GroupRoles entities in bulk.
+type GroupRolesCreateBulk struct {
+	config
+	err      error
+	builders []*GroupRolesCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GroupRoles entities in the database.
+func (grcb *GroupRolesCreateBulk) Save(ctx context.Context) ([]*GroupRoles, error) {
+	if grcb.err != nil {
+		return nil, grcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(grcb.builders))
+	nodes := make([]*GroupRoles, len(grcb.builders))
+	mutators := make([]Mutator, len(grcb.builders))
+	for i := range grcb.builders {
+		func(i int, root context.Context) {
+			builder := grcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupRolesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, grcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = grcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, grcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, grcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (grcb *GroupRolesCreateBulk) SaveX(ctx context.Context) []*GroupRoles {
+	v, err := grcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (grcb *GroupRolesCreateBulk) Exec(ctx context.Context) error {
+	_, err := grcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (grcb *GroupRolesCreateBulk) ExecX(ctx context.Context) {
+	if err := grcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupRoles.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupRolesUpsert) {
+//			SetGroupID(v+v).
+//		}).
+//		Exec(ctx)
+func (grcb *GroupRolesCreateBulk) OnConflict(opts ...sql.ConflictOption) *GroupRolesUpsertBulk {
+	grcb.conflict = opts
+	return &GroupRolesUpsertBulk{
+		create: grcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupRoles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (grcb *GroupRolesCreateBulk) OnConflictColumns(columns ...string) *GroupRolesUpsertBulk {
+	grcb.conflict = append(grcb.conflict, sql.ConflictColumns(columns...))
+	return &GroupRolesUpsertBulk{
+		create: grcb,
+	}
+}
+
+// GroupRolesUpsertBulk is the builder for "upsert"-ing
+// a bulk of GroupRoles nodes.
+type GroupRolesUpsertBulk struct {
+	create *GroupRolesCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GroupRoles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(grouproles.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupRolesUpsertBulk) UpdateNewValues() *GroupRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(grouproles.FieldID)
+			}
+			if _, exists := b.mutation.AssignedAt(); exists {
+				s.SetIgnore(grouproles.FieldAssignedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupRoles.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GroupRolesUpsertBulk) Ignore() *GroupRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupRolesUpsertBulk) DoNothing() *GroupRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupRolesCreateBulk.OnConflict
+// documentation for more info.
+func (u *GroupRolesUpsertBulk) Update(set func(*GroupRolesUpsert)) *GroupRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupRolesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *GroupRolesUpsertBulk) SetGroupID(v int) *GroupRolesUpsertBulk {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *GroupRolesUpsertBulk) UpdateGroupID() *GroupRolesUpsertBulk {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *GroupRolesUpsertBulk) SetRoleID(v int) *GroupRolesUpsertBulk {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *GroupRolesUpsertBulk) UpdateRoleID() *GroupRolesUpsertBulk {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *GroupRolesUpsertBulk) SetAssignedBy(v uuid.UUID) *GroupRolesUpsertBulk {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.SetAssignedBy(v)
+	})
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *GroupRolesUpsertBulk) UpdateAssignedBy() *GroupRolesUpsertBulk {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.UpdateAssignedBy()
+	})
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *GroupRolesUpsertBulk) ClearAssignedBy() *GroupRolesUpsertBulk {
+	return u.Update(func(s *GroupRolesUpsert) {
+		s.ClearAssignedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupRolesUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GroupRolesCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupRolesCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupRolesUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
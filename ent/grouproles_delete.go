@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// GroupRolesDelete is the builder for deleting a GroupRoles entity.
+type GroupRolesDelete struct {
+	config
+	hooks    []Hook
+	mutation *GroupRolesMutation
+}
+
+// Where appends a list predicates to the GroupRolesDelete builder.
+func (grd *GroupRolesDelete) Where(ps ...predicate.GroupRoles) *GroupRolesDelete {
+	grd.mutation.Where(ps...)
+	return grd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (grd *GroupRolesDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, grd.sqlExec, grd.mutation, grd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (grd *GroupRolesDelete) ExecX(ctx context.Context) int {
+	n, err := grd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (grd *GroupRolesDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(grouproles.Table, sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt))
+	if ps := grd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, grd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	grd.mutation.done = true
+	return affected, err
+}
+
+// GroupRolesDeleteOne is the builder for deleting a single GroupRoles entity.
+type GroupRolesDeleteOne struct {
+	grd *GroupRolesDelete
+}
+
+// Where appends a list predicates to the GroupRolesDelete builder.
+func (grdo *GroupRolesDeleteOne) Where(ps ...predicate.GroupRoles) *GroupRolesDeleteOne {
+	grdo.grd.mutation.Where(ps...)
+	return grdo
+}
+
+// Exec executes the deletion query.
+func (grdo *GroupRolesDeleteOne) Exec(ctx context.Context) error {
+	n, err := grdo.grd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{grouproles.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (grdo *GroupRolesDeleteOne) ExecX(ctx context.Context) {
+	if err := grdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// GroupRolesQuery is the builder for querying GroupRoles entities.
+type GroupRolesQuery struct {
+	config
+	ctx        *QueryContext
+	order      []grouproles.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GroupRoles
+	withGroup  *GroupsQuery
+	withRole   *RolesQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GroupRolesQuery builder.
+func (grq *GroupRolesQuery) Where(ps ...predicate.GroupRoles) *GroupRolesQuery {
+	grq.predicates = append(grq.predicates, ps...)
+	return grq
+}
+
+// Limit the number of records to be returned by this query.
+func (grq *GroupRolesQuery) Limit(limit int) *GroupRolesQuery {
+	grq.ctx.Limit = &limit
+	return grq
+}
+
+// Offset to start from.
+func (grq *GroupRolesQuery) Offset(offset int) *GroupRolesQuery {
+	grq.ctx.Offset = &offset
+	return grq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (grq *GroupRolesQuery) Unique(unique bool) *GroupRolesQuery {
+	grq.ctx.Unique = &unique
+	return grq
+}
+
+// Order specifies how the records should be ordered.
+func (grq *GroupRolesQuery) Order(o ...grouproles.OrderOption) *GroupRolesQuery {
+	grq.order = append(grq.order, o...)
+	return grq
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (grq *GroupRolesQuery) QueryGroup() *GroupsQuery {
+	query := (&GroupsClient{config: grq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := grq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := grq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouproles.Table, grouproles.FieldID, selector),
+			sqlgraph.To(groups.Table, groups.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, grouproles.GroupTable, grouproles.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(grq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRole chains the current query on the "role" edge.
+func (grq *GroupRolesQuery) QueryRole() *RolesQuery {
+	query := (&RolesClient{config: grq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := grq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := grq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(grouproles.Table, grouproles.FieldID, selector),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, grouproles.RoleTable, grouproles.RoleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(grq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first GroupRoles entity from the query.
+// Returns a *NotFoundError when no GroupRoles was found.
+func (grq *GroupRolesQuery) First(ctx context.Context) (*GroupRoles, error) {
+	nodes, err := grq.Limit(1).All(setContextOp(ctx, grq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{grouproles.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (grq *GroupRolesQuery) FirstX(ctx context.Context) *GroupRoles {
+	node, err := grq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GroupRoles ID from the query.
+// Returns a *NotFoundError when no GroupRoles ID was found.
+func (grq *GroupRolesQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = grq.Limit(1).IDs(setContextOp(ctx, grq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{grouproles.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (grq *GroupRolesQuery) FirstIDX(ctx context.Context) int {
+	id, err := grq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GroupRoles entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GroupRoles entity is found.
+// Returns a *NotFoundError when no GroupRoles entities are found.
+func (grq *GroupRolesQuery) Only(ctx context.Context) (*GroupRoles, error) {
+	nodes, err := grq.Limit(2).All(setContextOp(ctx, grq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{grouproles.Label}
+	default:
+		return nil, &NotSingularError{grouproles.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (grq *GroupRolesQuery) OnlyX(ctx context.Context) *GroupRoles {
+	node, err := grq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GroupRoles ID in the query.
+// Returns a *NotSingularError when more than one GroupRoles ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (grq *GroupRolesQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = grq.Limit(2).IDs(setContextOp(ctx, grq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{grouproles.Label}
+	default:
+		err = &NotSingularError{grouproles.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (grq *GroupRolesQuery) OnlyIDX(ctx context.Context) int {
+	id, err := grq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GroupRolesSlice.
+func (grq *GroupRolesQuery) All(ctx context.Context) ([]*GroupRoles, error) {
+	ctx = setContextOp(ctx, grq.ctx, "All")
+	if err := grq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GroupRoles, *GroupRolesQuery]()
+	return withInterceptors[[]*GroupRoles](ctx, grq, qr, grq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (grq *GroupRolesQuery) AllX(ctx context.Context) []*GroupRoles {
+	nodes, err := grq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GroupRoles IDs.
+func (grq *GroupRolesQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if grq.ctx.Unique == nil && grq.path != nil {
+		grq.Unique(true)
+	}
+	ctx = setContextOp(ctx, grq.ctx, "IDs")
+	if err = grq.Select(grouproles.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (grq *GroupRolesQuery) IDsX(ctx context.Context) []int {
+	ids, err := grq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (grq *GroupRolesQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, grq.ctx, "Count")
+	if err := grq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, grq, querierCount[*GroupRolesQuery](), grq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (grq *GroupRolesQuery) CountX(ctx context.Context) int {
+	count, err := grq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (grq *GroupRolesQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, grq.ctx, "Exist")
+	switch _, err := grq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (grq *GroupRolesQuery) ExistX(ctx context.Context) bool {
+	exist, err := grq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GroupRolesQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (grq *GroupRolesQuery) Clone() *GroupRolesQuery {
+	if grq == nil {
+		return nil
+	}
+	return &GroupRolesQuery{
+		config:     grq.config,
+		ctx:        grq.ctx.Clone(),
+		order:      append([]grouproles.OrderOption{}, grq.order...),
+		inters:     append([]Interceptor{}, grq.inters...),
+		predicates: append([]predicate.GroupRoles{}, grq.predicates...),
+		withGroup:  grq.withGroup.Clone(),
+		withRole:   grq.withRole.Clone(),
+		// clone intermediate query.
+		sql:  grq.sql.Clone(),
+		path: grq.path,
+	}
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (grq *GroupRolesQuery) WithGroup(opts ...func(*GroupsQuery)) *GroupRolesQuery {
+	query := (&GroupsClient{config: grq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	grq.withGroup = query
+	return grq
+}
+
+// WithRole tells the query-builder to eager-load the nodes that are connected to
+// the "role" edge. The optional arguments are used to configure the query builder of the edge.
+func (grq *GroupRolesQuery) WithRole(opts ...func(*RolesQuery)) *GroupRolesQuery {
+	query := (&RolesClient{config: grq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	grq.withRole = query
+	return grq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GroupID int `json:"group_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GroupRoles.Query().
+//		GroupBy(grouproles.FieldGroupID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (grq *GroupRolesQuery) GroupBy(field string, fields ...string) *GroupRolesGroupBy {
+	grq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GroupRolesGroupBy{build: grq}
+	grbuild.flds = &grq.ctx.Fields
+	grbuild.label = grouproles.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GroupID int `json:"group_id,omitempty"`
+//	}
+//
+//	client.GroupRoles.Query().
+//		Select(grouproles.FieldGroupID).
+//		Scan(ctx, &v)
+func (grq *GroupRolesQuery) Select(fields ...string) *GroupRolesSelect {
+	grq.ctx.Fields = append(grq.ctx.Fields, fields...)
+	sbuild := &GroupRolesSelect{GroupRolesQuery: grq}
+	sbuild.label = grouproles.Label
+	sbuild.flds, sbuild.scan = &grq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GroupRolesSelect configured with the given aggregations.
+func (grq *GroupRolesQuery) Aggregate(fns ...AggregateFunc) *GroupRolesSelect {
+	return grq.Select().Aggregate(fns...)
+}
+
+func (grq *GroupRolesQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range grq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, grq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range grq.ctx.Fields {
+		if !grouproles.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if grq.path != nil {
+		prev, err := grq.path(ctx)
+		if err != nil {
+			return err
+		}
+		grq.sql = prev
+	}
+	return nil
+}
+
+func (grq *GroupRolesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GroupRoles, error) {
+	var (
+		nodes       = []*GroupRoles{}
+		_spec       = grq.querySpec()
+		loadedTypes = [2]bool{
+			grq.withGroup != nil,
+			grq.withRole != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GroupRoles).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GroupRoles{config: grq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(grq.modifiers) > 0 {
+		_spec.Modifiers = grq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, grq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := grq.withGroup; query != nil {
+		if err := grq.loadGroup(ctx, query, nodes, nil,
+			func(n *GroupRoles, e *Groups) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := grq.withRole; query != nil {
+		if err := grq.loadRole(ctx, query, nodes, nil,
+			func(n *GroupRoles, e *Roles) { n.Edges.Role = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (grq *GroupRolesQuery) loadGroup(ctx context.Context, query *GroupsQuery, nodes []*GroupRoles, init func(*GroupRoles), assign func(*GroupRoles, *Groups)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*GroupRoles)
+	for i := range nodes {
+		fk := nodes[i].GroupID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(groups.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (grq *GroupRolesQuery) loadRole(ctx context.Context, query *RolesQuery, nodes []*GroupRoles, init func(*GroupRoles), assign func(*GroupRoles, *Roles)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*GroupRoles)
+	for i := range nodes {
+		fk := nodes[i].RoleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(roles.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "role_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (grq *GroupRolesQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := grq.querySpec()
+	if len(grq.modifiers) > 0 {
+		_spec.Modifiers = grq.modifiers
+	}
+	_spec.Node.Columns = grq.ctx.Fields
+	if len(grq.ctx.Fields) > 0 {
+		_spec.Unique = grq.ctx.Unique != nil && *grq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, grq.driver, _spec)
+}
+
+func (grq *GroupRolesQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(grouproles.Table, grouproles.Columns, sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt))
+	_spec.From = grq.sql
+	if unique := grq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if grq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := grq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, grouproles.FieldID)
+		for i := range fields {
+			if fields[i] != grouproles.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if grq.withGroup != nil {
+			_spec.Node.AddColumnOnce(grouproles.FieldGroupID)
+		}
+		if grq.withRole != nil {
+			_spec.Node.AddColumnOnce(grouproles.FieldRoleID)
+		}
+	}
+	if ps := grq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := grq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := grq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := grq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (grq *GroupRolesQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(grq.driver.Dialect())
+	t1 := builder.Table(grouproles.Table)
+	columns := grq.ctx.Fields
+	if len(columns) == 0 {
+		columns = grouproles.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if grq.sql != nil {
+		selector = grq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if grq.ctx.Unique != nil && *grq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range grq.modifiers {
+		m(selector)
+	}
+	for _, p := range grq.predicates {
+		p(selector)
+	}
+	for _, p := range grq.order {
+		p(selector)
+	}
+	if offset := grq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := grq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (grq *GroupRolesQuery) ForUpdate(opts ...sql.LockOption) *GroupRolesQuery {
+	if grq.driver.Dialect() == dialect.Postgres {
+		grq.Unique(false)
+	}
+	grq.modifiers = append(grq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return grq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (grq *GroupRolesQuery) ForShare(opts ...sql.LockOption) *GroupRolesQuery {
+	if grq.driver.Dialect() == dialect.Postgres {
+		grq.Unique(false)
+	}
+	grq.modifiers = append(grq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return grq
+}
+
+// GroupRolesGroupBy is the group-by builder for GroupRoles entities.
+type GroupRolesGroupBy struct {
+	selector
+	build *GroupRolesQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (grgb *GroupRolesGroupBy) Aggregate(fns ...AggregateFunc) *GroupRolesGroupBy {
+	grgb.fns = append(grgb.fns, fns...)
+	return grgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (grgb *GroupRolesGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, grgb.build.ctx, "GroupBy")
+	if err := grgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupRolesQuery, *GroupRolesGroupBy](ctx, grgb.build, grgb, grgb.build.inters, v)
+}
+
+func (grgb *GroupRolesGroupBy) sqlScan(ctx context.Context, root *GroupRolesQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(grgb.fns))
+	for _, fn := range grgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*grgb.flds)+len(grgb.fns))
+		for _, f := range *grgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*grgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := grgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GroupRolesSelect is the builder for selecting fields of GroupRoles entities.
+type GroupRolesSelect struct {
+	*GroupRolesQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (grs *GroupRolesSelect) Aggregate(fns ...AggregateFunc) *GroupRolesSelect {
+	grs.fns = append(grs.fns, fns...)
+	return grs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (grs *GroupRolesSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, grs.ctx, "Select")
+	if err := grs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupRolesQuery, *GroupRolesSelect](ctx, grs.GroupRolesQuery, grs, grs.inters, v)
+}
+
+func (grs *GroupRolesSelect) sqlScan(ctx context.Context, root *GroupRolesQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(grs.fns))
+	for _, fn := range grs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*grs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := grs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
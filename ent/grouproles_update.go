@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// GroupRolesUpdate is the builder for updating GroupRoles entities.
+type GroupRolesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupRolesMutation
+}
+
+// Where appends a list predicates to the GroupRolesUpdate builder.
+func (gru *GroupRolesUpdate) Where(ps ...predicate.GroupRoles) *GroupRolesUpdate {
+	gru.mutation.Where(ps...)
+	return gru
+}
+
+// SetGroupID sets the "group_id" field.
+func (gru *GroupRolesUpdate) SetGroupID(i int) *GroupRolesUpdate {
+	gru.mutation.SetGroupID(i)
+	return gru
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (gru *GroupRolesUpdate) SetNillableGroupID(i *int) *GroupRolesUpdate {
+	if i != nil {
+		gru.SetGroupID(*i)
+	}
+	return gru
+}
+
+// SetRoleID sets the "role_id" field.
+func (gru *GroupRolesUpdate) SetRoleID(i int) *GroupRolesUpdate {
+	gru.mutation.SetRoleID(i)
+	return gru
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (gru *GroupRolesUpdate) SetNillableRoleID(i *int) *GroupRolesUpdate {
+	if i != nil {
+		gru.SetRoleID(*i)
+	}
+	return gru
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (gru *GroupRolesUpdate) SetAssignedBy(u uuid.UUID) *GroupRolesUpdate {
+	gru.mutation.SetAssignedBy(u)
+	return gru
+}
+
+// SetNillableAssignedBy sets the "assigned_by" field if the given value is not nil.
+func (gru *GroupRolesUpdate) SetNillableAssignedBy(u *uuid.UUID) *GroupRolesUpdate {
+	if u != nil {
+		gru.SetAssignedBy(*u)
+	}
+	return gru
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (gru *GroupRolesUpdate) ClearAssignedBy() *GroupRolesUpdate {
+	gru.mutation.ClearAssignedBy()
+	return gru
+}
+
+// SetGroup sets the "group" edge to the Groups entity.
+func (gru *GroupRolesUpdate) SetGroup(g *Groups) *GroupRolesUpdate {
+	return gru.SetGroupID(g.ID)
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (gru *GroupRolesUpdate) SetRole(r *Roles) *GroupRolesUpdate {
+	return gru.SetRoleID(r.ID)
+}
+
+// Mutation returns the GroupRolesMutation object of the builder.
+func (gru *GroupRolesUpdate) Mutation() *GroupRolesMutation {
+	return gru.mutation
+}
+
+// ClearGroup clears the "group" edge to the Groups entity.
+func (gru *GroupRolesUpdate) ClearGroup() *GroupRolesUpdate {
+	gru.mutation.ClearGroup()
+	return gru
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (gru *GroupRolesUpdate) ClearRole() *GroupRolesUpdate {
+	gru.mutation.ClearRole()
+	return gru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gru *GroupRolesUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gru.sqlSave, gru.mutation, gru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gru *GroupRolesUpdate) SaveX(ctx context.Context) int {
+	affected, err := gru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gru *GroupRolesUpdate) Exec(ctx context.Context) error {
+	_, err := gru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gru *GroupRolesUpdate) ExecX(ctx context.Context) {
+	if err := gru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gru *GroupRolesUpdate) check() error {
+	if _, ok := gru.mutation.GroupID(); gru.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupRoles.group"`)
+	}
+	if _, ok := gru.mutation.RoleID(); gru.mutation.RoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupRoles.role"`)
+	}
+	return nil
+}
+
+func (gru *GroupRolesUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(grouproles.Table, grouproles.Columns, sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt))
+	if ps := gru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gru.mutation.AssignedBy(); ok {
+		_spec.SetField(grouproles.FieldAssignedBy, field.TypeUUID, value)
+	}
+	if gru.mutation.AssignedByCleared() {
+		_spec.ClearField(grouproles.FieldAssignedBy, field.TypeUUID)
+	}
+	if gru.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   grouproles.GroupTable,
+			Columns: []string{grouproles.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gru.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   grouproles.GroupTable,
+			Columns: []string{grouproles.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gru.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   grouproles.RoleTable,
+			Columns: []string{grouproles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gru.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   grouproles.RoleTable,
+			Columns: []string{grouproles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{grouproles.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gru.mutation.done = true
+	return n, nil
+}
+
+// GroupRolesUpdateOne is the builder for updating a single GroupRoles entity.
+type GroupRolesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupRolesMutation
+}
+
+// SetGroupID sets the "group_id" field.
+func (gruo *GroupRolesUpdateOne) SetGroupID(i int) *GroupRolesUpdateOne {
+	gruo.mutation.SetGroupID(i)
+	return gruo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (gruo *GroupRolesUpdateOne) SetNillableGroupID(i *int) *GroupRolesUpdateOne {
+	if i != nil {
+		gruo.SetGroupID(*i)
+	}
+	return gruo
+}
+
+// SetRoleID sets the "role_id" field.
+func (gruo *GroupRolesUpdateOne) SetRoleID(i int) *GroupRolesUpdateOne {
+	gruo.mutation.SetRoleID(i)
+	return gruo
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (gruo *GroupRolesUpdateOne) SetNillableRoleID(i *int) *GroupRolesUpdateOne {
+	if i != nil {
+		gruo.SetRoleID(*i)
+	}
+	return gruo
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (gruo *GroupRolesUpdateOne) SetAssignedBy(u uuid.UUID) *GroupRolesUpdateOne {
+	gruo.mutation.SetAssignedBy(u)
+	return gruo
+}
+
+// SetNillableAssignedBy sets the "assigned_by" field if the given value is not nil.
+func (gruo *GroupRolesUpdateOne) SetNillableAssignedBy(u *uuid.UUID) *GroupRolesUpdateOne {
+	if u != nil {
+		gruo.SetAssignedBy(*u)
+	}
+	return gruo
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (gruo *GroupRolesUpdateOne) ClearAssignedBy() *GroupRolesUpdateOne {
+	gruo.mutation.ClearAssignedBy()
+	return gruo
+}
+
+// SetGroup sets the "group" edge to the Groups entity.
+func (gruo *GroupRolesUpdateOne) SetGroup(g *Groups) *GroupRolesUpdateOne {
+	return gruo.SetGroupID(g.ID)
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (gruo *GroupRolesUpdateOne) SetRole(r *Roles) *GroupRolesUpdateOne {
+	return gruo.SetRoleID(r.ID)
+}
+
+// Mutation returns the GroupRolesMutation object of the builder.
+func (gruo *GroupRolesUpdateOne) Mutation() *GroupRolesMutation {
+	return gruo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Groups entity.
+func (gruo *GroupRolesUpdateOne) ClearGroup() *GroupRolesUpdateOne {
+	gruo.mutation.ClearGroup()
+	return gruo
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (gruo *GroupRolesUpdateOne) ClearRole() *GroupRolesUpdateOne {
+	gruo.mutation.ClearRole()
+	return gruo
+}
+
+// Where appends a list predicates to the GroupRolesUpdate builder.
+func (gruo *GroupRolesUpdateOne) Where(ps ...predicate.GroupRoles) *GroupRolesUpdateOne {
+	gruo.mutation.Where(ps...)
+	return gruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gruo *GroupRolesUpdateOne) Select(field string, fields ...string) *GroupRolesUpdateOne {
+	gruo.fields = append([]string{field}, fields...)
+	return gruo
+}
+
+// Save executes the query and returns the updated GroupRoles entity.
+func (gruo *GroupRolesUpdateOne) Save(ctx context.Context) (*GroupRoles, error) {
+	return withHooks(ctx, gruo.sqlSave, gruo.mutation, gruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gruo *GroupRolesUpdateOne) SaveX(ctx context.Context) *GroupRoles {
+	node, err := gruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gruo *GroupRolesUpdateOne) Exec(ctx context.Context) error {
+	_, err := gruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gruo *GroupRolesUpdateOne) ExecX(ctx context.Context) {
+	if err := gruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gruo *GroupRolesUpdateOne) check() error {
+	if _, ok := gruo.mutation.GroupID(); gruo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupRoles.group"`)
+	}
+	if _, ok := gruo.mutation.RoleID(); gruo.mutation.RoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "GroupRoles.role"`)
+	}
+	return nil
+}
+
+func (gruo *GroupRolesUpdateOne) sqlSave(ctx context.Context) (_node *GroupRoles, err error) {
+	if err := gruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(grouproles.Table, grouproles.Columns, sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt))
+	id, ok := gruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupRoles.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, grouproles.FieldID)
+		for _, f := range fields {
+			if !grouproles.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != grouproles.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gruo.mutation.AssignedBy(); ok {
+		_spec.SetField(grouproles.FieldAssignedBy, field.TypeUUID, value)
+	}
+	if gruo.mutation.AssignedByCleared() {
+		_spec.ClearField(grouproles.FieldAssignedBy, field.TypeUUID)
+	}
+	if gruo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   grouproles.GroupTable,
+			Columns: []string{grouproles.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gruo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   grouproles.GroupTable,
+			Columns: []string{grouproles.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gruo.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   grouproles.RoleTable,
+			Columns: []string{grouproles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gruo.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   grouproles.RoleTable,
+			Columns: []string{grouproles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GroupRoles{config: gruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{grouproles.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/groups"
+)
+
+// Groups is the model entity for the Groups schema.
+type Groups struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Unique code identifier (e.g., 'platform-team')
+	Code string `json:"code,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// User who created the group
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GroupsQuery when eager-loading is set.
+	Edges        GroupsEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GroupsEdges holds the relations/edges for other nodes in the graph.
+type GroupsEdges struct {
+	// Members holds the value of the members edge.
+	Members []*GroupMembers `json:"members,omitempty"`
+	// GroupRoles holds the value of the group_roles edge.
+	GroupRoles []*GroupRoles `json:"group_roles,omitempty"`
+	// ParentLinks holds the value of the parent_links edge.
+	ParentLinks []*GroupParents `json:"parent_links,omitempty"`
+	// ChildLinks holds the value of the child_links edge.
+	ChildLinks []*GroupParents `json:"child_links,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupsEdges) MembersOrErr() ([]*GroupMembers, error) {
+	if e.loadedTypes[0] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// GroupRolesOrErr returns the GroupRoles value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupsEdges) GroupRolesOrErr() ([]*GroupRoles, error) {
+	if e.loadedTypes[1] {
+		return e.GroupRoles, nil
+	}
+	return nil, &NotLoadedError{edge: "group_roles"}
+}
+
+// ParentLinksOrErr returns the ParentLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupsEdges) ParentLinksOrErr() ([]*GroupParents, error) {
+	if e.loadedTypes[2] {
+		return e.ParentLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "parent_links"}
+}
+
+// ChildLinksOrErr returns the ChildLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupsEdges) ChildLinksOrErr() ([]*GroupParents, error) {
+	if e.loadedTypes[3] {
+		return e.ChildLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "child_links"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Groups) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groups.FieldCreatedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case groups.FieldID:
+			values[i] = new(sql.NullInt64)
+		case groups.FieldCode, groups.FieldName, groups.FieldDescription:
+			values[i] = new(sql.NullString)
+		case groups.FieldCreatedAt, groups.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Groups fields.
+func (gr *Groups) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case groups.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gr.ID = int(value.Int64)
+		case groups.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				gr.Code = value.String
+			}
+		case groups.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				gr.Name = value.String
+			}
+		case groups.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				gr.Description = value.String
+			}
+		case groups.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				gr.CreatedBy = new(uuid.UUID)
+				*gr.CreatedBy = *value.S.(*uuid.UUID)
+			}
+		case groups.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gr.CreatedAt = value.Time
+			}
+		case groups.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				gr.UpdatedAt = value.Time
+			}
+		default:
+			gr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Groups.
+// This includes values selected through modifiers, order, etc.
+func (gr *Groups) Value(name string) (ent.Value, error) {
+	return gr.selectValues.Get(name)
+}
+
+// QueryMembers queries the "members" edge of the Groups entity.
+func (gr *Groups) QueryMembers() *GroupMembersQuery {
+	return NewGroupsClient(gr.config).QueryMembers(gr)
+}
+
+// QueryGroupRoles queries the "group_roles" edge of the Groups entity.
+func (gr *Groups) QueryGroupRoles() *GroupRolesQuery {
+	return NewGroupsClient(gr.config).QueryGroupRoles(gr)
+}
+
+// QueryParentLinks queries the "parent_links" edge of the Groups entity.
+func (gr *Groups) QueryParentLinks() *GroupParentsQuery {
+	return NewGroupsClient(gr.config).QueryParentLinks(gr)
+}
+
+// QueryChildLinks queries the "child_links" edge of the Groups entity.
+func (gr *Groups) QueryChildLinks() *GroupParentsQuery {
+	return NewGroupsClient(gr.config).QueryChildLinks(gr)
+}
+
+// Update returns a builder for updating this Groups.
+// Note that you need to call Groups.Unwrap() before calling this method if this Groups
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gr *Groups) Update() *GroupsUpdateOne {
+	return NewGroupsClient(gr.config).UpdateOne(gr)
+}
+
+// Unwrap unwraps the Groups entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gr *Groups) Unwrap() *Groups {
+	_tx, ok := gr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Groups is not a transactional entity")
+	}
+	gr.config.driver = _tx.drv
+	return gr
+}
+
+// String implements the fmt.Stringer.
+func (gr *Groups) String() string {
+	var builder strings.Builder
+	builder.WriteString("Groups(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gr.ID))
+	builder.WriteString("code=")
+	builder.WriteString(gr.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(gr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(gr.Description)
+	builder.WriteString(", ")
+	if v := gr.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(gr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(gr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupsSlice is a parsable slice of Groups.
+type GroupsSlice []*Groups
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package groups
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the groups type in the database.
+	Label = "groups"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// EdgeGroupRoles holds the string denoting the group_roles edge name in mutations.
+	EdgeGroupRoles = "group_roles"
+	// EdgeParentLinks holds the string denoting the parent_links edge name in mutations.
+	EdgeParentLinks = "parent_links"
+	// EdgeChildLinks holds the string denoting the child_links edge name in mutations.
+	EdgeChildLinks = "child_links"
+	// Table holds the table name of the groups in the database.
+	Table = "groups"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "group_members"
+	// MembersInverseTable is the table name for the GroupMembers entity.
+	// It exists in this package in order to avoid circular dependency with the "groupmembers" package.
+	MembersInverseTable = "group_members"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "group_id"
+	// GroupRolesTable is the table that holds the group_roles relation/edge.
+	GroupRolesTable = "group_roles"
+	// GroupRolesInverseTable is the table name for the GroupRoles entity.
+	// It exists in this package in order to avoid circular dependency with the "grouproles" package.
+	GroupRolesInverseTable = "group_roles"
+	// GroupRolesColumn is the table column denoting the group_roles relation/edge.
+	GroupRolesColumn = "group_id"
+	// ParentLinksTable is the table that holds the parent_links relation/edge.
+	ParentLinksTable = "group_parents"
+	// ParentLinksInverseTable is the table name for the GroupParents entity.
+	// It exists in this package in order to avoid circular dependency with the "groupparents" package.
+	ParentLinksInverseTable = "group_parents"
+	// ParentLinksColumn is the table column denoting the parent_links relation/edge.
+	ParentLinksColumn = "group_id"
+	// ChildLinksTable is the table that holds the child_links relation/edge.
+	ChildLinksTable = "group_parents"
+	// ChildLinksInverseTable is the table name for the GroupParents entity.
+	// It exists in this package in order to avoid circular dependency with the "groupparents" package.
+	ChildLinksInverseTable = "group_parents"
+	// ChildLinksColumn is the table column denoting the child_links relation/edge.
+	ChildLinksColumn = "parent_group_id"
+)
+
+// Columns holds all SQL columns for groups fields.
+var Columns = []string{
+	FieldID,
+	FieldCode,
+	FieldName,
+	FieldDescription,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Groups queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMembersCount orders the results by members count.
+func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembersStep(), opts...)
+	}
+}
+
+// ByMembers orders the results by members terms.
+func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupRolesCount orders the results by group_roles count.
+func ByGroupRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupRolesStep(), opts...)
+	}
+}
+
+// ByGroupRoles orders the results by group_roles terms.
+func ByGroupRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByParentLinksCount orders the results by parent_links count.
+func ByParentLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newParentLinksStep(), opts...)
+	}
+}
+
+// ByParentLinks orders the results by parent_links terms.
+func ByParentLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChildLinksCount orders the results by child_links count.
+func ByChildLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildLinksStep(), opts...)
+	}
+}
+
+// ByChildLinks orders the results by child_links terms.
+func ByChildLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MembersTable, MembersColumn),
+	)
+}
+func newGroupRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupRolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, GroupRolesTable, GroupRolesColumn),
+	)
+}
+func newParentLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ParentLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ParentLinksTable, ParentLinksColumn),
+	)
+}
+func newChildLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChildLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ChildLinksTable, ChildLinksColumn),
+	)
+}
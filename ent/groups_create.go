@@ -0,0 +1,934 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+)
+
+// GroupsCreate is the builder for creating a Groups entity.
+type GroupsCreate struct {
+	config
+	mutation *GroupsMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCode sets the "code" field.
+func (gc *GroupsCreate) SetCode(s string) *GroupsCreate {
+	gc.mutation.SetCode(s)
+	return gc
+}
+
+// SetName sets the "name" field.
+func (gc *GroupsCreate) SetName(s string) *GroupsCreate {
+	gc.mutation.SetName(s)
+	return gc
+}
+
+// SetDescription sets the "description" field.
+func (gc *GroupsCreate) SetDescription(s string) *GroupsCreate {
+	gc.mutation.SetDescription(s)
+	return gc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (gc *GroupsCreate) SetNillableDescription(s *string) *GroupsCreate {
+	if s != nil {
+		gc.SetDescription(*s)
+	}
+	return gc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (gc *GroupsCreate) SetCreatedBy(u uuid.UUID) *GroupsCreate {
+	gc.mutation.SetCreatedBy(u)
+	return gc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (gc *GroupsCreate) SetNillableCreatedBy(u *uuid.UUID) *GroupsCreate {
+	if u != nil {
+		gc.SetCreatedBy(*u)
+	}
+	return gc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gc *GroupsCreate) SetCreatedAt(t time.Time) *GroupsCreate {
+	gc.mutation.SetCreatedAt(t)
+	return gc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gc *GroupsCreate) SetNillableCreatedAt(t *time.Time) *GroupsCreate {
+	if t != nil {
+		gc.SetCreatedAt(*t)
+	}
+	return gc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gc *GroupsCreate) SetUpdatedAt(t time.Time) *GroupsCreate {
+	gc.mutation.SetUpdatedAt(t)
+	return gc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (gc *GroupsCreate) SetNillableUpdatedAt(t *time.Time) *GroupsCreate {
+	if t != nil {
+		gc.SetUpdatedAt(*t)
+	}
+	return gc
+}
+
+// SetID sets the "id" field.
+func (gc *GroupsCreate) SetID(i int) *GroupsCreate {
+	gc.mutation.SetID(i)
+	return gc
+}
+
+// AddMemberIDs adds the "members" edge to the GroupMembers entity by IDs.
+func (gc *GroupsCreate) AddMemberIDs(ids ...uuid.UUID) *GroupsCreate {
+	gc.mutation.AddMemberIDs(ids...)
+	return gc
+}
+
+// AddMembers adds the "members" edges to the GroupMembers entity.
+func (gc *GroupsCreate) AddMembers(g ...*GroupMembers) *GroupsCreate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gc.AddMemberIDs(ids...)
+}
+
+// AddGroupRoleIDs adds the "group_roles" edge to the GroupRoles entity by IDs.
+func (gc *GroupsCreate) AddGroupRoleIDs(ids ...int) *GroupsCreate {
+	gc.mutation.AddGroupRoleIDs(ids...)
+	return gc
+}
+
+// AddGroupRoles adds the "group_roles" edges to the GroupRoles entity.
+func (gc *GroupsCreate) AddGroupRoles(g ...*GroupRoles) *GroupsCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gc.AddGroupRoleIDs(ids...)
+}
+
+// AddParentLinkIDs adds the "parent_links" edge to the GroupParents entity by IDs.
+func (gc *GroupsCreate) AddParentLinkIDs(ids ...int) *GroupsCreate {
+	gc.mutation.AddParentLinkIDs(ids...)
+	return gc
+}
+
+// AddParentLinks adds the "parent_links" edges to the GroupParents entity.
+func (gc *GroupsCreate) AddParentLinks(g ...*GroupParents) *GroupsCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gc.AddParentLinkIDs(ids...)
+}
+
+// AddChildLinkIDs adds the "child_links" edge to the GroupParents entity by IDs.
+func (gc *GroupsCreate) AddChildLinkIDs(ids ...int) *GroupsCreate {
+	gc.mutation.AddChildLinkIDs(ids...)
+	return gc
+}
+
+// AddChildLinks adds the "child_links" edges to the GroupParents entity.
+func (gc *GroupsCreate) AddChildLinks(g ...*GroupParents) *GroupsCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gc.AddChildLinkIDs(ids...)
+}
+
+// Mutation returns the GroupsMutation object of the builder.
+func (gc *GroupsCreate) Mutation() *GroupsMutation {
+	return gc.mutation
+}
+
+// Save creates the Groups in the database.
+func (gc *GroupsCreate) Save(ctx context.Context) (*Groups, error) {
+	gc.defaults()
+	return withHooks(ctx, gc.sqlSave, gc.mutation, gc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gc *GroupsCreate) SaveX(ctx context.Context) *Groups {
+	v, err := gc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gc *GroupsCreate) Exec(ctx context.Context) error {
+	_, err := gc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gc *GroupsCreate) ExecX(ctx context.Context) {
+	if err := gc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gc *GroupsCreate) defaults() {
+	if _, ok := gc.mutation.CreatedAt(); !ok {
+		v := groups.DefaultCreatedAt()
+		gc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := gc.mutation.UpdatedAt(); !ok {
+		v := groups.DefaultUpdatedAt()
+		gc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gc *GroupsCreate) check() error {
+	if _, ok := gc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Groups.code"`)}
+	}
+	if v, ok := gc.mutation.Code(); ok {
+		if err := groups.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Groups.code": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Groups.name"`)}
+	}
+	if v, ok := gc.mutation.Name(); ok {
+		if err := groups.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Groups.name": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Groups.created_at"`)}
+	}
+	if _, ok := gc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Groups.updated_at"`)}
+	}
+	return nil
+}
+
+func (gc *GroupsCreate) sqlSave(ctx context.Context) (*Groups, error) {
+	if err := gc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	gc.mutation.id = &_node.ID
+	gc.mutation.done = true
+	return _node, nil
+}
+
+func (gc *GroupsCreate) createSpec() (*Groups, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Groups{config: gc.config}
+		_spec = sqlgraph.NewCreateSpec(groups.Table, sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = gc.conflict
+	if id, ok := gc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := gc.mutation.Code(); ok {
+		_spec.SetField(groups.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := gc.mutation.Name(); ok {
+		_spec.SetField(groups.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := gc.mutation.Description(); ok {
+		_spec.SetField(groups.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := gc.mutation.CreatedBy(); ok {
+		_spec.SetField(groups.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := gc.mutation.CreatedAt(); ok {
+		_spec.SetField(groups.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := gc.mutation.UpdatedAt(); ok {
+		_spec.SetField(groups.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := gc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.MembersTable,
+			Columns: []string{groups.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.GroupRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.GroupRolesTable,
+			Columns: []string{groups.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.ParentLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ParentLinksTable,
+			Columns: []string{groups.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.ChildLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ChildLinksTable,
+			Columns: []string{groups.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Groups.Create().
+//		SetCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupsUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (gc *GroupsCreate) OnConflict(opts ...sql.ConflictOption) *GroupsUpsertOne {
+	gc.conflict = opts
+	return &GroupsUpsertOne{
+		create: gc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Groups.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gc *GroupsCreate) OnConflictColumns(columns ...string) *GroupsUpsertOne {
+	gc.conflict = append(gc.conflict, sql.ConflictColumns(columns...))
+	return &GroupsUpsertOne{
+		create: gc,
+	}
+}
+
+type (
+	// GroupsUpsertOne is the builder for "upsert"-ing
+	//  one Groups node.
+	GroupsUpsertOne struct {
+		create *GroupsCreate
+	}
+
+	// GroupsUpsert is the "OnConflict" setter.
+	GroupsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCode sets the "code" field.
+func (u *GroupsUpsert) SetCode(v string) *GroupsUpsert {
+	u.Set(groups.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *GroupsUpsert) UpdateCode() *GroupsUpsert {
+	u.SetExcluded(groups.FieldCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GroupsUpsert) SetName(v string) *GroupsUpsert {
+	u.Set(groups.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GroupsUpsert) UpdateName() *GroupsUpsert {
+	u.SetExcluded(groups.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *GroupsUpsert) SetDescription(v string) *GroupsUpsert {
+	u.Set(groups.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GroupsUpsert) UpdateDescription() *GroupsUpsert {
+	u.SetExcluded(groups.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GroupsUpsert) ClearDescription() *GroupsUpsert {
+	u.SetNull(groups.FieldDescription)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *GroupsUpsert) SetCreatedBy(v uuid.UUID) *GroupsUpsert {
+	u.Set(groups.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *GroupsUpsert) UpdateCreatedBy() *GroupsUpsert {
+	u.SetExcluded(groups.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *GroupsUpsert) ClearCreatedBy() *GroupsUpsert {
+	u.SetNull(groups.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GroupsUpsert) SetUpdatedAt(v time.Time) *GroupsUpsert {
+	u.Set(groups.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GroupsUpsert) UpdateUpdatedAt() *GroupsUpsert {
+	u.SetExcluded(groups.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Groups.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(groups.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupsUpsertOne) UpdateNewValues() *GroupsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(groups.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(groups.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Groups.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GroupsUpsertOne) Ignore() *GroupsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupsUpsertOne) DoNothing() *GroupsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupsCreate.OnConflict
+// documentation for more info.
+func (u *GroupsUpsertOne) Update(set func(*GroupsUpsert)) *GroupsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *GroupsUpsertOne) SetCode(v string) *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *GroupsUpsertOne) UpdateCode() *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GroupsUpsertOne) SetName(v string) *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GroupsUpsertOne) UpdateName() *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GroupsUpsertOne) SetDescription(v string) *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GroupsUpsertOne) UpdateDescription() *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GroupsUpsertOne) ClearDescription() *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *GroupsUpsertOne) SetCreatedBy(v uuid.UUID) *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *GroupsUpsertOne) UpdateCreatedBy() *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *GroupsUpsertOne) ClearCreatedBy() *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GroupsUpsertOne) SetUpdatedAt(v time.Time) *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GroupsUpsertOne) UpdateUpdatedAt() *GroupsUpsertOne {
+	return u.Update(func(s *GroupsUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GroupsUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GroupsUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GroupsCreateBulk is the builder for creating many Groups entities in bulk.
+type GroupsCreateBulk struct {
+	config
+	err      error
+	builders []*GroupsCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Groups entities in the database.
+func (gcb *GroupsCreateBulk) Save(ctx context.Context) ([]*Groups, error) {
+	if gcb.err != nil {
+		return nil, gcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
+	nodes := make([]*Groups, len(gcb.builders))
+	mutators := make([]Mutator, len(gcb.builders))
+	for i := range gcb.builders {
+		func(i int, root context.Context) {
+			builder := gcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = gcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gcb *GroupsCreateBulk) SaveX(ctx context.Context) []*Groups {
+	v, err := gcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gcb *GroupsCreateBulk) Exec(ctx context.Context) error {
+	_, err := gcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gcb *GroupsCreateBulk) ExecX(ctx context.Context) {
+	if err := gcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Groups.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupsUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (gcb *GroupsCreateBulk) OnConflict(opts ...sql.ConflictOption) *GroupsUpsertBulk {
+	gcb.conflict = opts
+	return &GroupsUpsertBulk{
+		create: gcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Groups.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gcb *GroupsCreateBulk) OnConflictColumns(columns ...string) *GroupsUpsertBulk {
+	gcb.conflict = append(gcb.conflict, sql.ConflictColumns(columns...))
+	return &GroupsUpsertBulk{
+		create: gcb,
+	}
+}
+
+// GroupsUpsertBulk is the builder for "upsert"-ing
+// a bulk of Groups nodes.
+type GroupsUpsertBulk struct {
+	create *GroupsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Groups.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(groups.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupsUpsertBulk) UpdateNewValues() *GroupsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(groups.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(groups.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Groups.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GroupsUpsertBulk) Ignore() *GroupsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupsUpsertBulk) DoNothing() *GroupsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupsCreateBulk.OnConflict
+// documentation for more info.
+func (u *GroupsUpsertBulk) Update(set func(*GroupsUpsert)) *GroupsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *GroupsUpsertBulk) SetCode(v string) *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *GroupsUpsertBulk) UpdateCode() *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GroupsUpsertBulk) SetName(v string) *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GroupsUpsertBulk) UpdateName() *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GroupsUpsertBulk) SetDescription(v string) *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GroupsUpsertBulk) UpdateDescription() *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GroupsUpsertBulk) ClearDescription() *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *GroupsUpsertBulk) SetCreatedBy(v uuid.UUID) *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *GroupsUpsertBulk) UpdateCreatedBy() *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *GroupsUpsertBulk) ClearCreatedBy() *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GroupsUpsertBulk) SetUpdatedAt(v time.Time) *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GroupsUpsertBulk) UpdateUpdatedAt() *GroupsUpsertBulk {
+	return u.Update(func(s *GroupsUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GroupsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
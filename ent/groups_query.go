@@ -0,0 +1,863 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// GroupsQuery is the builder for querying Groups entities.
+type GroupsQuery struct {
+	config
+	ctx             *QueryContext
+	order           []groups.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Groups
+	withMembers     *GroupMembersQuery
+	withGroupRoles  *GroupRolesQuery
+	withParentLinks *GroupParentsQuery
+	withChildLinks  *GroupParentsQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GroupsQuery builder.
+func (gq *GroupsQuery) Where(ps ...predicate.Groups) *GroupsQuery {
+	gq.predicates = append(gq.predicates, ps...)
+	return gq
+}
+
+// Limit the number of records to be returned by this query.
+func (gq *GroupsQuery) Limit(limit int) *GroupsQuery {
+	gq.ctx.Limit = &limit
+	return gq
+}
+
+// Offset to start from.
+func (gq *GroupsQuery) Offset(offset int) *GroupsQuery {
+	gq.ctx.Offset = &offset
+	return gq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gq *GroupsQuery) Unique(unique bool) *GroupsQuery {
+	gq.ctx.Unique = &unique
+	return gq
+}
+
+// Order specifies how the records should be ordered.
+func (gq *GroupsQuery) Order(o ...groups.OrderOption) *GroupsQuery {
+	gq.order = append(gq.order, o...)
+	return gq
+}
+
+// QueryMembers chains the current query on the "members" edge.
+func (gq *GroupsQuery) QueryMembers() *GroupMembersQuery {
+	query := (&GroupMembersClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groups.Table, groups.FieldID, selector),
+			sqlgraph.To(groupmembers.Table, groupmembers.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, groups.MembersTable, groups.MembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroupRoles chains the current query on the "group_roles" edge.
+func (gq *GroupsQuery) QueryGroupRoles() *GroupRolesQuery {
+	query := (&GroupRolesClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groups.Table, groups.FieldID, selector),
+			sqlgraph.To(grouproles.Table, grouproles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, groups.GroupRolesTable, groups.GroupRolesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParentLinks chains the current query on the "parent_links" edge.
+func (gq *GroupsQuery) QueryParentLinks() *GroupParentsQuery {
+	query := (&GroupParentsClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groups.Table, groups.FieldID, selector),
+			sqlgraph.To(groupparents.Table, groupparents.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, groups.ParentLinksTable, groups.ParentLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildLinks chains the current query on the "child_links" edge.
+func (gq *GroupsQuery) QueryChildLinks() *GroupParentsQuery {
+	query := (&GroupParentsClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groups.Table, groups.FieldID, selector),
+			sqlgraph.To(groupparents.Table, groupparents.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, groups.ChildLinksTable, groups.ChildLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Groups entity from the query.
+// Returns a *NotFoundError when no Groups was found.
+func (gq *GroupsQuery) First(ctx context.Context) (*Groups, error) {
+	nodes, err := gq.Limit(1).All(setContextOp(ctx, gq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{groups.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gq *GroupsQuery) FirstX(ctx context.Context) *Groups {
+	node, err := gq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Groups ID from the query.
+// Returns a *NotFoundError when no Groups ID was found.
+func (gq *GroupsQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gq.Limit(1).IDs(setContextOp(ctx, gq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{groups.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gq *GroupsQuery) FirstIDX(ctx context.Context) int {
+	id, err := gq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Groups entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Groups entity is found.
+// Returns a *NotFoundError when no Groups entities are found.
+func (gq *GroupsQuery) Only(ctx context.Context) (*Groups, error) {
+	nodes, err := gq.Limit(2).All(setContextOp(ctx, gq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{groups.Label}
+	default:
+		return nil, &NotSingularError{groups.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gq *GroupsQuery) OnlyX(ctx context.Context) *Groups {
+	node, err := gq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Groups ID in the query.
+// Returns a *NotSingularError when more than one Groups ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gq *GroupsQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gq.Limit(2).IDs(setContextOp(ctx, gq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{groups.Label}
+	default:
+		err = &NotSingularError{groups.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gq *GroupsQuery) OnlyIDX(ctx context.Context) int {
+	id, err := gq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GroupsSlice.
+func (gq *GroupsQuery) All(ctx context.Context) ([]*Groups, error) {
+	ctx = setContextOp(ctx, gq.ctx, "All")
+	if err := gq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Groups, *GroupsQuery]()
+	return withInterceptors[[]*Groups](ctx, gq, qr, gq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gq *GroupsQuery) AllX(ctx context.Context) []*Groups {
+	nodes, err := gq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Groups IDs.
+func (gq *GroupsQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if gq.ctx.Unique == nil && gq.path != nil {
+		gq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gq.ctx, "IDs")
+	if err = gq.Select(groups.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gq *GroupsQuery) IDsX(ctx context.Context) []int {
+	ids, err := gq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gq *GroupsQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gq.ctx, "Count")
+	if err := gq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gq, querierCount[*GroupsQuery](), gq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gq *GroupsQuery) CountX(ctx context.Context) int {
+	count, err := gq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gq *GroupsQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gq.ctx, "Exist")
+	switch _, err := gq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gq *GroupsQuery) ExistX(ctx context.Context) bool {
+	exist, err := gq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GroupsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gq *GroupsQuery) Clone() *GroupsQuery {
+	if gq == nil {
+		return nil
+	}
+	return &GroupsQuery{
+		config:          gq.config,
+		ctx:             gq.ctx.Clone(),
+		order:           append([]groups.OrderOption{}, gq.order...),
+		inters:          append([]Interceptor{}, gq.inters...),
+		predicates:      append([]predicate.Groups{}, gq.predicates...),
+		withMembers:     gq.withMembers.Clone(),
+		withGroupRoles:  gq.withGroupRoles.Clone(),
+		withParentLinks: gq.withParentLinks.Clone(),
+		withChildLinks:  gq.withChildLinks.Clone(),
+		// clone intermediate query.
+		sql:  gq.sql.Clone(),
+		path: gq.path,
+	}
+}
+
+// WithMembers tells the query-builder to eager-load the nodes that are connected to
+// the "members" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupsQuery) WithMembers(opts ...func(*GroupMembersQuery)) *GroupsQuery {
+	query := (&GroupMembersClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withMembers = query
+	return gq
+}
+
+// WithGroupRoles tells the query-builder to eager-load the nodes that are connected to
+// the "group_roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupsQuery) WithGroupRoles(opts ...func(*GroupRolesQuery)) *GroupsQuery {
+	query := (&GroupRolesClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withGroupRoles = query
+	return gq
+}
+
+// WithParentLinks tells the query-builder to eager-load the nodes that are connected to
+// the "parent_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupsQuery) WithParentLinks(opts ...func(*GroupParentsQuery)) *GroupsQuery {
+	query := (&GroupParentsClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withParentLinks = query
+	return gq
+}
+
+// WithChildLinks tells the query-builder to eager-load the nodes that are connected to
+// the "child_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GroupsQuery) WithChildLinks(opts ...func(*GroupParentsQuery)) *GroupsQuery {
+	query := (&GroupParentsClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withChildLinks = query
+	return gq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Code string `json:"code,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Groups.Query().
+//		GroupBy(groups.FieldCode).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gq *GroupsQuery) GroupBy(field string, fields ...string) *GroupsGroupBy {
+	gq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GroupsGroupBy{build: gq}
+	grbuild.flds = &gq.ctx.Fields
+	grbuild.label = groups.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Code string `json:"code,omitempty"`
+//	}
+//
+//	client.Groups.Query().
+//		Select(groups.FieldCode).
+//		Scan(ctx, &v)
+func (gq *GroupsQuery) Select(fields ...string) *GroupsSelect {
+	gq.ctx.Fields = append(gq.ctx.Fields, fields...)
+	sbuild := &GroupsSelect{GroupsQuery: gq}
+	sbuild.label = groups.Label
+	sbuild.flds, sbuild.scan = &gq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GroupsSelect configured with the given aggregations.
+func (gq *GroupsQuery) Aggregate(fns ...AggregateFunc) *GroupsSelect {
+	return gq.Select().Aggregate(fns...)
+}
+
+func (gq *GroupsQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gq.ctx.Fields {
+		if !groups.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gq.path != nil {
+		prev, err := gq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gq.sql = prev
+	}
+	return nil
+}
+
+func (gq *GroupsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Groups, error) {
+	var (
+		nodes       = []*Groups{}
+		_spec       = gq.querySpec()
+		loadedTypes = [4]bool{
+			gq.withMembers != nil,
+			gq.withGroupRoles != nil,
+			gq.withParentLinks != nil,
+			gq.withChildLinks != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Groups).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Groups{config: gq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(gq.modifiers) > 0 {
+		_spec.Modifiers = gq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := gq.withMembers; query != nil {
+		if err := gq.loadMembers(ctx, query, nodes,
+			func(n *Groups) { n.Edges.Members = []*GroupMembers{} },
+			func(n *Groups, e *GroupMembers) { n.Edges.Members = append(n.Edges.Members, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withGroupRoles; query != nil {
+		if err := gq.loadGroupRoles(ctx, query, nodes,
+			func(n *Groups) { n.Edges.GroupRoles = []*GroupRoles{} },
+			func(n *Groups, e *GroupRoles) { n.Edges.GroupRoles = append(n.Edges.GroupRoles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withParentLinks; query != nil {
+		if err := gq.loadParentLinks(ctx, query, nodes,
+			func(n *Groups) { n.Edges.ParentLinks = []*GroupParents{} },
+			func(n *Groups, e *GroupParents) { n.Edges.ParentLinks = append(n.Edges.ParentLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withChildLinks; query != nil {
+		if err := gq.loadChildLinks(ctx, query, nodes,
+			func(n *Groups) { n.Edges.ChildLinks = []*GroupParents{} },
+			func(n *Groups, e *GroupParents) { n.Edges.ChildLinks = append(n.Edges.ChildLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (gq *GroupsQuery) loadMembers(ctx context.Context, query *GroupMembersQuery, nodes []*Groups, init func(*Groups), assign func(*Groups, *GroupMembers)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Groups)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(groupmembers.FieldGroupID)
+	}
+	query.Where(predicate.GroupMembers(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(groups.MembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupsQuery) loadGroupRoles(ctx context.Context, query *GroupRolesQuery, nodes []*Groups, init func(*Groups), assign func(*Groups, *GroupRoles)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Groups)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(grouproles.FieldGroupID)
+	}
+	query.Where(predicate.GroupRoles(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(groups.GroupRolesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupsQuery) loadParentLinks(ctx context.Context, query *GroupParentsQuery, nodes []*Groups, init func(*Groups), assign func(*Groups, *GroupParents)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Groups)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(groupparents.FieldGroupID)
+	}
+	query.Where(predicate.GroupParents(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(groups.ParentLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GroupsQuery) loadChildLinks(ctx context.Context, query *GroupParentsQuery, nodes []*Groups, init func(*Groups), assign func(*Groups, *GroupParents)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Groups)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(groupparents.FieldParentGroupID)
+	}
+	query.Where(predicate.GroupParents(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(groups.ChildLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentGroupID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_group_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (gq *GroupsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gq.querySpec()
+	if len(gq.modifiers) > 0 {
+		_spec.Modifiers = gq.modifiers
+	}
+	_spec.Node.Columns = gq.ctx.Fields
+	if len(gq.ctx.Fields) > 0 {
+		_spec.Unique = gq.ctx.Unique != nil && *gq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
+}
+
+func (gq *GroupsQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(groups.Table, groups.Columns, sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt))
+	_spec.From = gq.sql
+	if unique := gq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groups.FieldID)
+		for i := range fields {
+			if fields[i] != groups.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gq *GroupsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gq.driver.Dialect())
+	t1 := builder.Table(groups.Table)
+	columns := gq.ctx.Fields
+	if len(columns) == 0 {
+		columns = groups.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gq.sql != nil {
+		selector = gq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gq.ctx.Unique != nil && *gq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range gq.modifiers {
+		m(selector)
+	}
+	for _, p := range gq.predicates {
+		p(selector)
+	}
+	for _, p := range gq.order {
+		p(selector)
+	}
+	if offset := gq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (gq *GroupsQuery) ForUpdate(opts ...sql.LockOption) *GroupsQuery {
+	if gq.driver.Dialect() == dialect.Postgres {
+		gq.Unique(false)
+	}
+	gq.modifiers = append(gq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return gq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (gq *GroupsQuery) ForShare(opts ...sql.LockOption) *GroupsQuery {
+	if gq.driver.Dialect() == dialect.Postgres {
+		gq.Unique(false)
+	}
+	gq.modifiers = append(gq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return gq
+}
+
+// GroupsGroupBy is the group-by builder for Groups entities.
+type GroupsGroupBy struct {
+	selector
+	build *GroupsQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ggb *GroupsGroupBy) Aggregate(fns ...AggregateFunc) *GroupsGroupBy {
+	ggb.fns = append(ggb.fns, fns...)
+	return ggb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ggb *GroupsGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ggb.build.ctx, "GroupBy")
+	if err := ggb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupsQuery, *GroupsGroupBy](ctx, ggb.build, ggb, ggb.build.inters, v)
+}
+
+func (ggb *GroupsGroupBy) sqlScan(ctx context.Context, root *GroupsQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ggb.fns))
+	for _, fn := range ggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ggb.flds)+len(ggb.fns))
+		for _, f := range *ggb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ggb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ggb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GroupsSelect is the builder for selecting fields of Groups entities.
+type GroupsSelect struct {
+	*GroupsQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gs *GroupsSelect) Aggregate(fns ...AggregateFunc) *GroupsSelect {
+	gs.fns = append(gs.fns, fns...)
+	return gs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gs *GroupsSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gs.ctx, "Select")
+	if err := gs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GroupsQuery, *GroupsSelect](ctx, gs.GroupsQuery, gs, gs.inters, v)
+}
+
+func (gs *GroupsSelect) sqlScan(ctx context.Context, root *GroupsQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gs.fns))
+	for _, fn := range gs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
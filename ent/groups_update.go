@@ -0,0 +1,1072 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// GroupsUpdate is the builder for updating Groups entities.
+type GroupsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GroupsMutation
+}
+
+// Where appends a list predicates to the GroupsUpdate builder.
+func (gu *GroupsUpdate) Where(ps ...predicate.Groups) *GroupsUpdate {
+	gu.mutation.Where(ps...)
+	return gu
+}
+
+// SetCode sets the "code" field.
+func (gu *GroupsUpdate) SetCode(s string) *GroupsUpdate {
+	gu.mutation.SetCode(s)
+	return gu
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (gu *GroupsUpdate) SetNillableCode(s *string) *GroupsUpdate {
+	if s != nil {
+		gu.SetCode(*s)
+	}
+	return gu
+}
+
+// SetName sets the "name" field.
+func (gu *GroupsUpdate) SetName(s string) *GroupsUpdate {
+	gu.mutation.SetName(s)
+	return gu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (gu *GroupsUpdate) SetNillableName(s *string) *GroupsUpdate {
+	if s != nil {
+		gu.SetName(*s)
+	}
+	return gu
+}
+
+// SetDescription sets the "description" field.
+func (gu *GroupsUpdate) SetDescription(s string) *GroupsUpdate {
+	gu.mutation.SetDescription(s)
+	return gu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (gu *GroupsUpdate) SetNillableDescription(s *string) *GroupsUpdate {
+	if s != nil {
+		gu.SetDescription(*s)
+	}
+	return gu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (gu *GroupsUpdate) ClearDescription() *GroupsUpdate {
+	gu.mutation.ClearDescription()
+	return gu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (gu *GroupsUpdate) SetCreatedBy(u uuid.UUID) *GroupsUpdate {
+	gu.mutation.SetCreatedBy(u)
+	return gu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (gu *GroupsUpdate) SetNillableCreatedBy(u *uuid.UUID) *GroupsUpdate {
+	if u != nil {
+		gu.SetCreatedBy(*u)
+	}
+	return gu
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (gu *GroupsUpdate) ClearCreatedBy() *GroupsUpdate {
+	gu.mutation.ClearCreatedBy()
+	return gu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gu *GroupsUpdate) SetUpdatedAt(t time.Time) *GroupsUpdate {
+	gu.mutation.SetUpdatedAt(t)
+	return gu
+}
+
+// AddMemberIDs adds the "members" edge to the GroupMembers entity by IDs.
+func (gu *GroupsUpdate) AddMemberIDs(ids ...uuid.UUID) *GroupsUpdate {
+	gu.mutation.AddMemberIDs(ids...)
+	return gu
+}
+
+// AddMembers adds the "members" edges to the GroupMembers entity.
+func (gu *GroupsUpdate) AddMembers(g ...*GroupMembers) *GroupsUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.AddMemberIDs(ids...)
+}
+
+// AddGroupRoleIDs adds the "group_roles" edge to the GroupRoles entity by IDs.
+func (gu *GroupsUpdate) AddGroupRoleIDs(ids ...int) *GroupsUpdate {
+	gu.mutation.AddGroupRoleIDs(ids...)
+	return gu
+}
+
+// AddGroupRoles adds the "group_roles" edges to the GroupRoles entity.
+func (gu *GroupsUpdate) AddGroupRoles(g ...*GroupRoles) *GroupsUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.AddGroupRoleIDs(ids...)
+}
+
+// AddParentLinkIDs adds the "parent_links" edge to the GroupParents entity by IDs.
+func (gu *GroupsUpdate) AddParentLinkIDs(ids ...int) *GroupsUpdate {
+	gu.mutation.AddParentLinkIDs(ids...)
+	return gu
+}
+
+// AddParentLinks adds the "parent_links" edges to the GroupParents entity.
+func (gu *GroupsUpdate) AddParentLinks(g ...*GroupParents) *GroupsUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.AddParentLinkIDs(ids...)
+}
+
+// AddChildLinkIDs adds the "child_links" edge to the GroupParents entity by IDs.
+func (gu *GroupsUpdate) AddChildLinkIDs(ids ...int) *GroupsUpdate {
+	gu.mutation.AddChildLinkIDs(ids...)
+	return gu
+}
+
+// AddChildLinks adds the "child_links" edges to the GroupParents entity.
+func (gu *GroupsUpdate) AddChildLinks(g ...*GroupParents) *GroupsUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.AddChildLinkIDs(ids...)
+}
+
+// Mutation returns the GroupsMutation object of the builder.
+func (gu *GroupsUpdate) Mutation() *GroupsMutation {
+	return gu.mutation
+}
+
+// ClearMembers clears all "members" edges to the GroupMembers entity.
+func (gu *GroupsUpdate) ClearMembers() *GroupsUpdate {
+	gu.mutation.ClearMembers()
+	return gu
+}
+
+// RemoveMemberIDs removes the "members" edge to GroupMembers entities by IDs.
+func (gu *GroupsUpdate) RemoveMemberIDs(ids ...uuid.UUID) *GroupsUpdate {
+	gu.mutation.RemoveMemberIDs(ids...)
+	return gu
+}
+
+// RemoveMembers removes "members" edges to GroupMembers entities.
+func (gu *GroupsUpdate) RemoveMembers(g ...*GroupMembers) *GroupsUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.RemoveMemberIDs(ids...)
+}
+
+// ClearGroupRoles clears all "group_roles" edges to the GroupRoles entity.
+func (gu *GroupsUpdate) ClearGroupRoles() *GroupsUpdate {
+	gu.mutation.ClearGroupRoles()
+	return gu
+}
+
+// RemoveGroupRoleIDs removes the "group_roles" edge to GroupRoles entities by IDs.
+func (gu *GroupsUpdate) RemoveGroupRoleIDs(ids ...int) *GroupsUpdate {
+	gu.mutation.RemoveGroupRoleIDs(ids...)
+	return gu
+}
+
+// RemoveGroupRoles removes "group_roles" edges to GroupRoles entities.
+func (gu *GroupsUpdate) RemoveGroupRoles(g ...*GroupRoles) *GroupsUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.RemoveGroupRoleIDs(ids...)
+}
+
+// ClearParentLinks clears all "parent_links" edges to the GroupParents entity.
+func (gu *GroupsUpdate) ClearParentLinks() *GroupsUpdate {
+	gu.mutation.ClearParentLinks()
+	return gu
+}
+
+// RemoveParentLinkIDs removes the "parent_links" edge to GroupParents entities by IDs.
+func (gu *GroupsUpdate) RemoveParentLinkIDs(ids ...int) *GroupsUpdate {
+	gu.mutation.RemoveParentLinkIDs(ids...)
+	return gu
+}
+
+// RemoveParentLinks removes "parent_links" edges to GroupParents entities.
+func (gu *GroupsUpdate) RemoveParentLinks(g ...*GroupParents) *GroupsUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.RemoveParentLinkIDs(ids...)
+}
+
+// ClearChildLinks clears all "child_links" edges to the GroupParents entity.
+func (gu *GroupsUpdate) ClearChildLinks() *GroupsUpdate {
+	gu.mutation.ClearChildLinks()
+	return gu
+}
+
+// RemoveChildLinkIDs removes the "child_links" edge to GroupParents entities by IDs.
+func (gu *GroupsUpdate) RemoveChildLinkIDs(ids ...int) *GroupsUpdate {
+	gu.mutation.RemoveChildLinkIDs(ids...)
+	return gu
+}
+
+// RemoveChildLinks removes "child_links" edges to GroupParents entities.
+func (gu *GroupsUpdate) RemoveChildLinks(g ...*GroupParents) *GroupsUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return gu.RemoveChildLinkIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gu *GroupsUpdate) Save(ctx context.Context) (int, error) {
+	gu.defaults()
+	return withHooks(ctx, gu.sqlSave, gu.mutation, gu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gu *GroupsUpdate) SaveX(ctx context.Context) int {
+	affected, err := gu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gu *GroupsUpdate) Exec(ctx context.Context) error {
+	_, err := gu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gu *GroupsUpdate) ExecX(ctx context.Context) {
+	if err := gu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gu *GroupsUpdate) defaults() {
+	if _, ok := gu.mutation.UpdatedAt(); !ok {
+		v := groups.UpdateDefaultUpdatedAt()
+		gu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gu *GroupsUpdate) check() error {
+	if v, ok := gu.mutation.Code(); ok {
+		if err := groups.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Groups.code": %w`, err)}
+		}
+	}
+	if v, ok := gu.mutation.Name(); ok {
+		if err := groups.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Groups.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gu *GroupsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groups.Table, groups.Columns, sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt))
+	if ps := gu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gu.mutation.Code(); ok {
+		_spec.SetField(groups.FieldCode, field.TypeString, value)
+	}
+	if value, ok := gu.mutation.Name(); ok {
+		_spec.SetField(groups.FieldName, field.TypeString, value)
+	}
+	if value, ok := gu.mutation.Description(); ok {
+		_spec.SetField(groups.FieldDescription, field.TypeString, value)
+	}
+	if gu.mutation.DescriptionCleared() {
+		_spec.ClearField(groups.FieldDescription, field.TypeString)
+	}
+	if value, ok := gu.mutation.CreatedBy(); ok {
+		_spec.SetField(groups.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if gu.mutation.CreatedByCleared() {
+		_spec.ClearField(groups.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := gu.mutation.UpdatedAt(); ok {
+		_spec.SetField(groups.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.MembersTable,
+			Columns: []string{groups.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedMembersIDs(); len(nodes) > 0 && !gu.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.MembersTable,
+			Columns: []string{groups.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.MembersTable,
+			Columns: []string{groups.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.GroupRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.GroupRolesTable,
+			Columns: []string{groups.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedGroupRolesIDs(); len(nodes) > 0 && !gu.mutation.GroupRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.GroupRolesTable,
+			Columns: []string{groups.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.GroupRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.GroupRolesTable,
+			Columns: []string{groups.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.ParentLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ParentLinksTable,
+			Columns: []string{groups.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedParentLinksIDs(); len(nodes) > 0 && !gu.mutation.ParentLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ParentLinksTable,
+			Columns: []string{groups.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.ParentLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ParentLinksTable,
+			Columns: []string{groups.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.ChildLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ChildLinksTable,
+			Columns: []string{groups.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedChildLinksIDs(); len(nodes) > 0 && !gu.mutation.ChildLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ChildLinksTable,
+			Columns: []string{groups.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.ChildLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ChildLinksTable,
+			Columns: []string{groups.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groups.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gu.mutation.done = true
+	return n, nil
+}
+
+// GroupsUpdateOne is the builder for updating a single Groups entity.
+type GroupsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GroupsMutation
+}
+
+// SetCode sets the "code" field.
+func (guo *GroupsUpdateOne) SetCode(s string) *GroupsUpdateOne {
+	guo.mutation.SetCode(s)
+	return guo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (guo *GroupsUpdateOne) SetNillableCode(s *string) *GroupsUpdateOne {
+	if s != nil {
+		guo.SetCode(*s)
+	}
+	return guo
+}
+
+// SetName sets the "name" field.
+func (guo *GroupsUpdateOne) SetName(s string) *GroupsUpdateOne {
+	guo.mutation.SetName(s)
+	return guo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (guo *GroupsUpdateOne) SetNillableName(s *string) *GroupsUpdateOne {
+	if s != nil {
+		guo.SetName(*s)
+	}
+	return guo
+}
+
+// SetDescription sets the "description" field.
+func (guo *GroupsUpdateOne) SetDescription(s string) *GroupsUpdateOne {
+	guo.mutation.SetDescription(s)
+	return guo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (guo *GroupsUpdateOne) SetNillableDescription(s *string) *GroupsUpdateOne {
+	if s != nil {
+		guo.SetDescription(*s)
+	}
+	return guo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (guo *GroupsUpdateOne) ClearDescription() *GroupsUpdateOne {
+	guo.mutation.ClearDescription()
+	return guo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (guo *GroupsUpdateOne) SetCreatedBy(u uuid.UUID) *GroupsUpdateOne {
+	guo.mutation.SetCreatedBy(u)
+	return guo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (guo *GroupsUpdateOne) SetNillableCreatedBy(u *uuid.UUID) *GroupsUpdateOne {
+	if u != nil {
+		guo.SetCreatedBy(*u)
+	}
+	return guo
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (guo *GroupsUpdateOne) ClearCreatedBy() *GroupsUpdateOne {
+	guo.mutation.ClearCreatedBy()
+	return guo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (guo *GroupsUpdateOne) SetUpdatedAt(t time.Time) *GroupsUpdateOne {
+	guo.mutation.SetUpdatedAt(t)
+	return guo
+}
+
+// AddMemberIDs adds the "members" edge to the GroupMembers entity by IDs.
+func (guo *GroupsUpdateOne) AddMemberIDs(ids ...uuid.UUID) *GroupsUpdateOne {
+	guo.mutation.AddMemberIDs(ids...)
+	return guo
+}
+
+// AddMembers adds the "members" edges to the GroupMembers entity.
+func (guo *GroupsUpdateOne) AddMembers(g ...*GroupMembers) *GroupsUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.AddMemberIDs(ids...)
+}
+
+// AddGroupRoleIDs adds the "group_roles" edge to the GroupRoles entity by IDs.
+func (guo *GroupsUpdateOne) AddGroupRoleIDs(ids ...int) *GroupsUpdateOne {
+	guo.mutation.AddGroupRoleIDs(ids...)
+	return guo
+}
+
+// AddGroupRoles adds the "group_roles" edges to the GroupRoles entity.
+func (guo *GroupsUpdateOne) AddGroupRoles(g ...*GroupRoles) *GroupsUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.AddGroupRoleIDs(ids...)
+}
+
+// AddParentLinkIDs adds the "parent_links" edge to the GroupParents entity by IDs.
+func (guo *GroupsUpdateOne) AddParentLinkIDs(ids ...int) *GroupsUpdateOne {
+	guo.mutation.AddParentLinkIDs(ids...)
+	return guo
+}
+
+// AddParentLinks adds the "parent_links" edges to the GroupParents entity.
+func (guo *GroupsUpdateOne) AddParentLinks(g ...*GroupParents) *GroupsUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.AddParentLinkIDs(ids...)
+}
+
+// AddChildLinkIDs adds the "child_links" edge to the GroupParents entity by IDs.
+func (guo *GroupsUpdateOne) AddChildLinkIDs(ids ...int) *GroupsUpdateOne {
+	guo.mutation.AddChildLinkIDs(ids...)
+	return guo
+}
+
+// AddChildLinks adds the "child_links" edges to the GroupParents entity.
+func (guo *GroupsUpdateOne) AddChildLinks(g ...*GroupParents) *GroupsUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.AddChildLinkIDs(ids...)
+}
+
+// Mutation returns the GroupsMutation object of the builder.
+func (guo *GroupsUpdateOne) Mutation() *GroupsMutation {
+	return guo.mutation
+}
+
+// ClearMembers clears all "members" edges to the GroupMembers entity.
+func (guo *GroupsUpdateOne) ClearMembers() *GroupsUpdateOne {
+	guo.mutation.ClearMembers()
+	return guo
+}
+
+// RemoveMemberIDs removes the "members" edge to GroupMembers entities by IDs.
+func (guo *GroupsUpdateOne) RemoveMemberIDs(ids ...uuid.UUID) *GroupsUpdateOne {
+	guo.mutation.RemoveMemberIDs(ids...)
+	return guo
+}
+
+// RemoveMembers removes "members" edges to GroupMembers entities.
+func (guo *GroupsUpdateOne) RemoveMembers(g ...*GroupMembers) *GroupsUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.RemoveMemberIDs(ids...)
+}
+
+// ClearGroupRoles clears all "group_roles" edges to the GroupRoles entity.
+func (guo *GroupsUpdateOne) ClearGroupRoles() *GroupsUpdateOne {
+	guo.mutation.ClearGroupRoles()
+	return guo
+}
+
+// RemoveGroupRoleIDs removes the "group_roles" edge to GroupRoles entities by IDs.
+func (guo *GroupsUpdateOne) RemoveGroupRoleIDs(ids ...int) *GroupsUpdateOne {
+	guo.mutation.RemoveGroupRoleIDs(ids...)
+	return guo
+}
+
+// RemoveGroupRoles removes "group_roles" edges to GroupRoles entities.
+func (guo *GroupsUpdateOne) RemoveGroupRoles(g ...*GroupRoles) *GroupsUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.RemoveGroupRoleIDs(ids...)
+}
+
+// ClearParentLinks clears all "parent_links" edges to the GroupParents entity.
+func (guo *GroupsUpdateOne) ClearParentLinks() *GroupsUpdateOne {
+	guo.mutation.ClearParentLinks()
+	return guo
+}
+
+// RemoveParentLinkIDs removes the "parent_links" edge to GroupParents entities by IDs.
+func (guo *GroupsUpdateOne) RemoveParentLinkIDs(ids ...int) *GroupsUpdateOne {
+	guo.mutation.RemoveParentLinkIDs(ids...)
+	return guo
+}
+
+// RemoveParentLinks removes "parent_links" edges to GroupParents entities.
+func (guo *GroupsUpdateOne) RemoveParentLinks(g ...*GroupParents) *GroupsUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.RemoveParentLinkIDs(ids...)
+}
+
+// ClearChildLinks clears all "child_links" edges to the GroupParents entity.
+func (guo *GroupsUpdateOne) ClearChildLinks() *GroupsUpdateOne {
+	guo.mutation.ClearChildLinks()
+	return guo
+}
+
+// RemoveChildLinkIDs removes the "child_links" edge to GroupParents entities by IDs.
+func (guo *GroupsUpdateOne) RemoveChildLinkIDs(ids ...int) *GroupsUpdateOne {
+	guo.mutation.RemoveChildLinkIDs(ids...)
+	return guo
+}
+
+// RemoveChildLinks removes "child_links" edges to GroupParents entities.
+func (guo *GroupsUpdateOne) RemoveChildLinks(g ...*GroupParents) *GroupsUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return guo.RemoveChildLinkIDs(ids...)
+}
+
+// Where appends a list predicates to the GroupsUpdate builder.
+func (guo *GroupsUpdateOne) Where(ps ...predicate.Groups) *GroupsUpdateOne {
+	guo.mutation.Where(ps...)
+	return guo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (guo *GroupsUpdateOne) Select(field string, fields ...string) *GroupsUpdateOne {
+	guo.fields = append([]string{field}, fields...)
+	return guo
+}
+
+// Save executes the query and returns the updated Groups entity.
+func (guo *GroupsUpdateOne) Save(ctx context.Context) (*Groups, error) {
+	guo.defaults()
+	return withHooks(ctx, guo.sqlSave, guo.mutation, guo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (guo *GroupsUpdateOne) SaveX(ctx context.Context) *Groups {
+	node, err := guo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (guo *GroupsUpdateOne) Exec(ctx context.Context) error {
+	_, err := guo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (guo *GroupsUpdateOne) ExecX(ctx context.Context) {
+	if err := guo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (guo *GroupsUpdateOne) defaults() {
+	if _, ok := guo.mutation.UpdatedAt(); !ok {
+		v := groups.UpdateDefaultUpdatedAt()
+		guo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (guo *GroupsUpdateOne) check() error {
+	if v, ok := guo.mutation.Code(); ok {
+		if err := groups.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Groups.code": %w`, err)}
+		}
+	}
+	if v, ok := guo.mutation.Name(); ok {
+		if err := groups.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Groups.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (guo *GroupsUpdateOne) sqlSave(ctx context.Context) (_node *Groups, err error) {
+	if err := guo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groups.Table, groups.Columns, sqlgraph.NewFieldSpec(groups.FieldID, field.TypeInt))
+	id, ok := guo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Groups.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := guo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groups.FieldID)
+		for _, f := range fields {
+			if !groups.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != groups.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := guo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := guo.mutation.Code(); ok {
+		_spec.SetField(groups.FieldCode, field.TypeString, value)
+	}
+	if value, ok := guo.mutation.Name(); ok {
+		_spec.SetField(groups.FieldName, field.TypeString, value)
+	}
+	if value, ok := guo.mutation.Description(); ok {
+		_spec.SetField(groups.FieldDescription, field.TypeString, value)
+	}
+	if guo.mutation.DescriptionCleared() {
+		_spec.ClearField(groups.FieldDescription, field.TypeString)
+	}
+	if value, ok := guo.mutation.CreatedBy(); ok {
+		_spec.SetField(groups.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if guo.mutation.CreatedByCleared() {
+		_spec.ClearField(groups.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := guo.mutation.UpdatedAt(); ok {
+		_spec.SetField(groups.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.MembersTable,
+			Columns: []string{groups.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !guo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.MembersTable,
+			Columns: []string{groups.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.MembersTable,
+			Columns: []string{groups.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.GroupRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.GroupRolesTable,
+			Columns: []string{groups.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedGroupRolesIDs(); len(nodes) > 0 && !guo.mutation.GroupRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.GroupRolesTable,
+			Columns: []string{groups.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.GroupRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.GroupRolesTable,
+			Columns: []string{groups.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.ParentLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ParentLinksTable,
+			Columns: []string{groups.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedParentLinksIDs(); len(nodes) > 0 && !guo.mutation.ParentLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ParentLinksTable,
+			Columns: []string{groups.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.ParentLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ParentLinksTable,
+			Columns: []string{groups.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.ChildLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ChildLinksTable,
+			Columns: []string{groups.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedChildLinksIDs(); len(nodes) > 0 && !guo.mutation.ChildLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ChildLinksTable,
+			Columns: []string{groups.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.ChildLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groups.ChildLinksTable,
+			Columns: []string{groups.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Groups{config: guo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groups.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	guo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/shammianand/go-auth/ent"
 )
 
+// The APIKeysFunc type is an adapter to allow the use of ordinary
+// function as APIKeys mutator.
+type APIKeysFunc func(context.Context, *ent.APIKeysMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIKeysFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.APIKeysMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIKeysMutation", m)
+}
+
 // The AuditLogsFunc type is an adapter to allow the use of ordinary
 // function as AuditLogs mutator.
 type AuditLogsFunc func(context.Context, *ent.AuditLogsMutation) (ent.Value, error)
@@ -21,6 +33,42 @@ func (f AuditLogsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogsMutation", m)
 }
 
+// The ConsentAcceptancesFunc type is an adapter to allow the use of ordinary
+// function as ConsentAcceptances mutator.
+type ConsentAcceptancesFunc func(context.Context, *ent.ConsentAcceptancesMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConsentAcceptancesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConsentAcceptancesMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConsentAcceptancesMutation", m)
+}
+
+// The ConsentDocumentsFunc type is an adapter to allow the use of ordinary
+// function as ConsentDocuments mutator.
+type ConsentDocumentsFunc func(context.Context, *ent.ConsentDocumentsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConsentDocumentsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConsentDocumentsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConsentDocumentsMutation", m)
+}
+
+// The EmailChangesFunc type is an adapter to allow the use of ordinary
+// function as EmailChanges mutator.
+type EmailChangesFunc func(context.Context, *ent.EmailChangesMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmailChangesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmailChangesMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailChangesMutation", m)
+}
+
 // The EmailLogsFunc type is an adapter to allow the use of ordinary
 // function as EmailLogs mutator.
 type EmailLogsFunc func(context.Context, *ent.EmailLogsMutation) (ent.Value, error)
@@ -45,6 +93,114 @@ func (f EmailVerificationsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailVerificationsMutation", m)
 }
 
+// The GroupMembersFunc type is an adapter to allow the use of ordinary
+// function as GroupMembers mutator.
+type GroupMembersFunc func(context.Context, *ent.GroupMembersMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupMembersFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupMembersMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupMembersMutation", m)
+}
+
+// The GroupParentsFunc type is an adapter to allow the use of ordinary
+// function as GroupParents mutator.
+type GroupParentsFunc func(context.Context, *ent.GroupParentsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupParentsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupParentsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupParentsMutation", m)
+}
+
+// The GroupRolesFunc type is an adapter to allow the use of ordinary
+// function as GroupRoles mutator.
+type GroupRolesFunc func(context.Context, *ent.GroupRolesMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupRolesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupRolesMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupRolesMutation", m)
+}
+
+// The GroupsFunc type is an adapter to allow the use of ordinary
+// function as Groups mutator.
+type GroupsFunc func(context.Context, *ent.GroupsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupsMutation", m)
+}
+
+// The InvitationsFunc type is an adapter to allow the use of ordinary
+// function as Invitations mutator.
+type InvitationsFunc func(context.Context, *ent.InvitationsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvitationsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvitationsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvitationsMutation", m)
+}
+
+// The OrgMembersFunc type is an adapter to allow the use of ordinary
+// function as OrgMembers mutator.
+type OrgMembersFunc func(context.Context, *ent.OrgMembersMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrgMembersFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrgMembersMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrgMembersMutation", m)
+}
+
+// The OrgRolesFunc type is an adapter to allow the use of ordinary
+// function as OrgRoles mutator.
+type OrgRolesFunc func(context.Context, *ent.OrgRolesMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrgRolesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrgRolesMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrgRolesMutation", m)
+}
+
+// The OrganizationsFunc type is an adapter to allow the use of ordinary
+// function as Organizations mutator.
+type OrganizationsFunc func(context.Context, *ent.OrganizationsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrganizationsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrganizationsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrganizationsMutation", m)
+}
+
+// The PasswordHistoriesFunc type is an adapter to allow the use of ordinary
+// function as PasswordHistories mutator.
+type PasswordHistoriesFunc func(context.Context, *ent.PasswordHistoriesMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PasswordHistoriesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PasswordHistoriesMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PasswordHistoriesMutation", m)
+}
+
 // The PasswordResetsFunc type is an adapter to allow the use of ordinary
 // function as PasswordResets mutator.
 type PasswordResetsFunc func(context.Context, *ent.PasswordResetsMutation) (ent.Value, error)
@@ -69,6 +225,42 @@ func (f PermissionsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PermissionsMutation", m)
 }
 
+// The RelationTuplesFunc type is an adapter to allow the use of ordinary
+// function as RelationTuples mutator.
+type RelationTuplesFunc func(context.Context, *ent.RelationTuplesMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RelationTuplesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RelationTuplesMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RelationTuplesMutation", m)
+}
+
+// The RoleApproversFunc type is an adapter to allow the use of ordinary
+// function as RoleApprovers mutator.
+type RoleApproversFunc func(context.Context, *ent.RoleApproversMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleApproversFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleApproversMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleApproversMutation", m)
+}
+
+// The RoleParentsFunc type is an adapter to allow the use of ordinary
+// function as RoleParents mutator.
+type RoleParentsFunc func(context.Context, *ent.RoleParentsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleParentsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleParentsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleParentsMutation", m)
+}
+
 // The RolePermissionsFunc type is an adapter to allow the use of ordinary
 // function as RolePermissions mutator.
 type RolePermissionsFunc func(context.Context, *ent.RolePermissionsMutation) (ent.Value, error)
@@ -81,6 +273,18 @@ func (f RolePermissionsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RolePermissionsMutation", m)
 }
 
+// The RoleRequestsFunc type is an adapter to allow the use of ordinary
+// function as RoleRequests mutator.
+type RoleRequestsFunc func(context.Context, *ent.RoleRequestsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RoleRequestsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RoleRequestsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RoleRequestsMutation", m)
+}
+
 // The RolesFunc type is an adapter to allow the use of ordinary
 // function as Roles mutator.
 type RolesFunc func(context.Context, *ent.RolesMutation) (ent.Value, error)
@@ -93,6 +297,30 @@ func (f RolesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RolesMutation", m)
 }
 
+// The SodConstraintRolesFunc type is an adapter to allow the use of ordinary
+// function as SodConstraintRoles mutator.
+type SodConstraintRolesFunc func(context.Context, *ent.SodConstraintRolesMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SodConstraintRolesFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SodConstraintRolesMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SodConstraintRolesMutation", m)
+}
+
+// The SodConstraintsFunc type is an adapter to allow the use of ordinary
+// function as SodConstraints mutator.
+type SodConstraintsFunc func(context.Context, *ent.SodConstraintsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SodConstraintsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SodConstraintsMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SodConstraintsMutation", m)
+}
+
 // The UserRolesFunc type is an adapter to allow the use of ordinary
 // function as UserRoles mutator.
 type UserRolesFunc func(context.Context, *ent.UserRolesMutation) (ent.Value, error)
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/invitations"
+)
+
+// Invitations is the model entity for the Invitations schema.
+type Invitations struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Address the invitation was sent to, lowercased
+	Email string `json:"email,omitempty"`
+	// Roles assigned when the invitation is accepted
+	RoleIds []int `json:"role_ids,omitempty"`
+	// User who sent the invitation
+	InvitedBy *uuid.UUID `json:"invited_by,omitempty"`
+	// Status holds the value of the "status" field.
+	Status invitations.Status `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Account the invitation was accepted with
+	AcceptedBy *uuid.UUID `json:"accepted_by,omitempty"`
+	// AcceptedAt holds the value of the "accepted_at" field.
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	// RevokedBy holds the value of the "revoked_by" field.
+	RevokedBy *uuid.UUID `json:"revoked_by,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invitations) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invitations.FieldInvitedBy, invitations.FieldAcceptedBy, invitations.FieldRevokedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case invitations.FieldRoleIds:
+			values[i] = new([]byte)
+		case invitations.FieldEmail, invitations.FieldStatus:
+			values[i] = new(sql.NullString)
+		case invitations.FieldExpiresAt, invitations.FieldAcceptedAt, invitations.FieldRevokedAt, invitations.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case invitations.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invitations fields.
+func (i *Invitations) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invitations.FieldID:
+			if value, ok := values[j].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[j])
+			} else if value != nil {
+				i.ID = *value
+			}
+		case invitations.FieldEmail:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[j])
+			} else if value.Valid {
+				i.Email = value.String
+			}
+		case invitations.FieldRoleIds:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field role_ids", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.RoleIds); err != nil {
+					return fmt.Errorf("unmarshal field role_ids: %w", err)
+				}
+			}
+		case invitations.FieldInvitedBy:
+			if value, ok := values[j].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field invited_by", values[j])
+			} else if value.Valid {
+				i.InvitedBy = new(uuid.UUID)
+				*i.InvitedBy = *value.S.(*uuid.UUID)
+			}
+		case invitations.FieldStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[j])
+			} else if value.Valid {
+				i.Status = invitations.Status(value.String)
+			}
+		case invitations.FieldExpiresAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[j])
+			} else if value.Valid {
+				i.ExpiresAt = value.Time
+			}
+		case invitations.FieldAcceptedBy:
+			if value, ok := values[j].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_by", values[j])
+			} else if value.Valid {
+				i.AcceptedBy = new(uuid.UUID)
+				*i.AcceptedBy = *value.S.(*uuid.UUID)
+			}
+		case invitations.FieldAcceptedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[j])
+			} else if value.Valid {
+				i.AcceptedAt = new(time.Time)
+				*i.AcceptedAt = value.Time
+			}
+		case invitations.FieldRevokedBy:
+			if value, ok := values[j].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_by", values[j])
+			} else if value.Valid {
+				i.RevokedBy = new(uuid.UUID)
+				*i.RevokedBy = *value.S.(*uuid.UUID)
+			}
+		case invitations.FieldRevokedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[j])
+			} else if value.Valid {
+				i.RevokedAt = new(time.Time)
+				*i.RevokedAt = value.Time
+			}
+		case invitations.FieldCreatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[j])
+			} else if value.Valid {
+				i.CreatedAt = value.Time
+			}
+		default:
+			i.selectValues.Set(columns[j], values[j])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Invitations.
+// This includes values selected through modifiers, order, etc.
+func (i *Invitations) Value(name string) (ent.Value, error) {
+	return i.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Invitations.
+// Note that you need to call Invitations.Unwrap() before calling this method if this Invitations
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invitations) Update() *InvitationsUpdateOne {
+	return NewInvitationsClient(i.config).UpdateOne(i)
+}
+
+// Unwrap unwraps the Invitations entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Invitations) Unwrap() *Invitations {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invitations is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invitations) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invitations(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("email=")
+	builder.WriteString(i.Email)
+	builder.WriteString(", ")
+	builder.WriteString("role_ids=")
+	builder.WriteString(fmt.Sprintf("%v", i.RoleIds))
+	builder.WriteString(", ")
+	if v := i.InvitedBy; v != nil {
+		builder.WriteString("invited_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", i.Status))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(i.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := i.AcceptedBy; v != nil {
+		builder.WriteString("accepted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := i.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := i.RevokedBy; v != nil {
+		builder.WriteString("revoked_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := i.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvitationsSlice is a parsable slice of Invitations.
+type InvitationsSlice []*Invitations
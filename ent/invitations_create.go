@@ -0,0 +1,1128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/invitations"
+)
+
+// InvitationsCreate is the builder for creating a Invitations entity.
+type InvitationsCreate struct {
+	config
+	mutation *InvitationsMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEmail sets the "email" field.
+func (ic *InvitationsCreate) SetEmail(s string) *InvitationsCreate {
+	ic.mutation.SetEmail(s)
+	return ic
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (ic *InvitationsCreate) SetRoleIds(i []int) *InvitationsCreate {
+	ic.mutation.SetRoleIds(i)
+	return ic
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (ic *InvitationsCreate) SetInvitedBy(u uuid.UUID) *InvitationsCreate {
+	ic.mutation.SetInvitedBy(u)
+	return ic
+}
+
+// SetNillableInvitedBy sets the "invited_by" field if the given value is not nil.
+func (ic *InvitationsCreate) SetNillableInvitedBy(u *uuid.UUID) *InvitationsCreate {
+	if u != nil {
+		ic.SetInvitedBy(*u)
+	}
+	return ic
+}
+
+// SetStatus sets the "status" field.
+func (ic *InvitationsCreate) SetStatus(i invitations.Status) *InvitationsCreate {
+	ic.mutation.SetStatus(i)
+	return ic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ic *InvitationsCreate) SetNillableStatus(i *invitations.Status) *InvitationsCreate {
+	if i != nil {
+		ic.SetStatus(*i)
+	}
+	return ic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ic *InvitationsCreate) SetExpiresAt(t time.Time) *InvitationsCreate {
+	ic.mutation.SetExpiresAt(t)
+	return ic
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (ic *InvitationsCreate) SetAcceptedBy(u uuid.UUID) *InvitationsCreate {
+	ic.mutation.SetAcceptedBy(u)
+	return ic
+}
+
+// SetNillableAcceptedBy sets the "accepted_by" field if the given value is not nil.
+func (ic *InvitationsCreate) SetNillableAcceptedBy(u *uuid.UUID) *InvitationsCreate {
+	if u != nil {
+		ic.SetAcceptedBy(*u)
+	}
+	return ic
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (ic *InvitationsCreate) SetAcceptedAt(t time.Time) *InvitationsCreate {
+	ic.mutation.SetAcceptedAt(t)
+	return ic
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (ic *InvitationsCreate) SetNillableAcceptedAt(t *time.Time) *InvitationsCreate {
+	if t != nil {
+		ic.SetAcceptedAt(*t)
+	}
+	return ic
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (ic *InvitationsCreate) SetRevokedBy(u uuid.UUID) *InvitationsCreate {
+	ic.mutation.SetRevokedBy(u)
+	return ic
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (ic *InvitationsCreate) SetNillableRevokedBy(u *uuid.UUID) *InvitationsCreate {
+	if u != nil {
+		ic.SetRevokedBy(*u)
+	}
+	return ic
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (ic *InvitationsCreate) SetRevokedAt(t time.Time) *InvitationsCreate {
+	ic.mutation.SetRevokedAt(t)
+	return ic
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (ic *InvitationsCreate) SetNillableRevokedAt(t *time.Time) *InvitationsCreate {
+	if t != nil {
+		ic.SetRevokedAt(*t)
+	}
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InvitationsCreate) SetCreatedAt(t time.Time) *InvitationsCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InvitationsCreate) SetNillableCreatedAt(t *time.Time) *InvitationsCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetID sets the "id" field.
+func (ic *InvitationsCreate) SetID(u uuid.UUID) *InvitationsCreate {
+	ic.mutation.SetID(u)
+	return ic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ic *InvitationsCreate) SetNillableID(u *uuid.UUID) *InvitationsCreate {
+	if u != nil {
+		ic.SetID(*u)
+	}
+	return ic
+}
+
+// Mutation returns the InvitationsMutation object of the builder.
+func (ic *InvitationsCreate) Mutation() *InvitationsMutation {
+	return ic.mutation
+}
+
+// Save creates the Invitations in the database.
+func (ic *InvitationsCreate) Save(ctx context.Context) (*Invitations, error) {
+	ic.defaults()
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvitationsCreate) SaveX(ctx context.Context) *Invitations {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InvitationsCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InvitationsCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InvitationsCreate) defaults() {
+	if _, ok := ic.mutation.Status(); !ok {
+		v := invitations.DefaultStatus
+		ic.mutation.SetStatus(v)
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		v := invitations.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.ID(); !ok {
+		v := invitations.DefaultID()
+		ic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InvitationsCreate) check() error {
+	if _, ok := ic.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "Invitations.email"`)}
+	}
+	if v, ok := ic.mutation.Email(); ok {
+		if err := invitations.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Invitations.email": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Invitations.status"`)}
+	}
+	if v, ok := ic.mutation.Status(); ok {
+		if err := invitations.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invitations.status": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Invitations.expires_at"`)}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invitations.created_at"`)}
+	}
+	return nil
+}
+
+func (ic *InvitationsCreate) sqlSave(ctx context.Context) (*Invitations, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InvitationsCreate) createSpec() (*Invitations, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invitations{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(invitations.Table, sqlgraph.NewFieldSpec(invitations.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ic.conflict
+	if id, ok := ic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ic.mutation.Email(); ok {
+		_spec.SetField(invitations.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := ic.mutation.RoleIds(); ok {
+		_spec.SetField(invitations.FieldRoleIds, field.TypeJSON, value)
+		_node.RoleIds = value
+	}
+	if value, ok := ic.mutation.InvitedBy(); ok {
+		_spec.SetField(invitations.FieldInvitedBy, field.TypeUUID, value)
+		_node.InvitedBy = &value
+	}
+	if value, ok := ic.mutation.Status(); ok {
+		_spec.SetField(invitations.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ic.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitations.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := ic.mutation.AcceptedBy(); ok {
+		_spec.SetField(invitations.FieldAcceptedBy, field.TypeUUID, value)
+		_node.AcceptedBy = &value
+	}
+	if value, ok := ic.mutation.AcceptedAt(); ok {
+		_spec.SetField(invitations.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = &value
+	}
+	if value, ok := ic.mutation.RevokedBy(); ok {
+		_spec.SetField(invitations.FieldRevokedBy, field.TypeUUID, value)
+		_node.RevokedBy = &value
+	}
+	if value, ok := ic.mutation.RevokedAt(); ok {
+		_spec.SetField(invitations.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(invitations.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invitations.Create().
+//		SetEmail(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvitationsUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (ic *InvitationsCreate) OnConflict(opts ...sql.ConflictOption) *InvitationsUpsertOne {
+	ic.conflict = opts
+	return &InvitationsUpsertOne{
+		create: ic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invitations.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ic *InvitationsCreate) OnConflictColumns(columns ...string) *InvitationsUpsertOne {
+	ic.conflict = append(ic.conflict, sql.ConflictColumns(columns...))
+	return &InvitationsUpsertOne{
+		create: ic,
+	}
+}
+
+type (
+	// InvitationsUpsertOne is the builder for "upsert"-ing
+	//  one Invitations node.
+	InvitationsUpsertOne struct {
+		create *InvitationsCreate
+	}
+
+	// InvitationsUpsert is the "OnConflict" setter.
+	InvitationsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEmail sets the "email" field.
+func (u *InvitationsUpsert) SetEmail(v string) *InvitationsUpsert {
+	u.Set(invitations.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *InvitationsUpsert) UpdateEmail() *InvitationsUpsert {
+	u.SetExcluded(invitations.FieldEmail)
+	return u
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (u *InvitationsUpsert) SetRoleIds(v []int) *InvitationsUpsert {
+	u.Set(invitations.FieldRoleIds, v)
+	return u
+}
+
+// UpdateRoleIds sets the "role_ids" field to the value that was provided on create.
+func (u *InvitationsUpsert) UpdateRoleIds() *InvitationsUpsert {
+	u.SetExcluded(invitations.FieldRoleIds)
+	return u
+}
+
+// ClearRoleIds clears the value of the "role_ids" field.
+func (u *InvitationsUpsert) ClearRoleIds() *InvitationsUpsert {
+	u.SetNull(invitations.FieldRoleIds)
+	return u
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (u *InvitationsUpsert) SetInvitedBy(v uuid.UUID) *InvitationsUpsert {
+	u.Set(invitations.FieldInvitedBy, v)
+	return u
+}
+
+// UpdateInvitedBy sets the "invited_by" field to the value that was provided on create.
+func (u *InvitationsUpsert) UpdateInvitedBy() *InvitationsUpsert {
+	u.SetExcluded(invitations.FieldInvitedBy)
+	return u
+}
+
+// ClearInvitedBy clears the value of the "invited_by" field.
+func (u *InvitationsUpsert) ClearInvitedBy() *InvitationsUpsert {
+	u.SetNull(invitations.FieldInvitedBy)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *InvitationsUpsert) SetStatus(v invitations.Status) *InvitationsUpsert {
+	u.Set(invitations.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvitationsUpsert) UpdateStatus() *InvitationsUpsert {
+	u.SetExcluded(invitations.FieldStatus)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationsUpsert) SetExpiresAt(v time.Time) *InvitationsUpsert {
+	u.Set(invitations.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationsUpsert) UpdateExpiresAt() *InvitationsUpsert {
+	u.SetExcluded(invitations.FieldExpiresAt)
+	return u
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (u *InvitationsUpsert) SetAcceptedBy(v uuid.UUID) *InvitationsUpsert {
+	u.Set(invitations.FieldAcceptedBy, v)
+	return u
+}
+
+// UpdateAcceptedBy sets the "accepted_by" field to the value that was provided on create.
+func (u *InvitationsUpsert) UpdateAcceptedBy() *InvitationsUpsert {
+	u.SetExcluded(invitations.FieldAcceptedBy)
+	return u
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (u *InvitationsUpsert) ClearAcceptedBy() *InvitationsUpsert {
+	u.SetNull(invitations.FieldAcceptedBy)
+	return u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *InvitationsUpsert) SetAcceptedAt(v time.Time) *InvitationsUpsert {
+	u.Set(invitations.FieldAcceptedAt, v)
+	return u
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *InvitationsUpsert) UpdateAcceptedAt() *InvitationsUpsert {
+	u.SetExcluded(invitations.FieldAcceptedAt)
+	return u
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *InvitationsUpsert) ClearAcceptedAt() *InvitationsUpsert {
+	u.SetNull(invitations.FieldAcceptedAt)
+	return u
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (u *InvitationsUpsert) SetRevokedBy(v uuid.UUID) *InvitationsUpsert {
+	u.Set(invitations.FieldRevokedBy, v)
+	return u
+}
+
+// UpdateRevokedBy sets the "revoked_by" field to the value that was provided on create.
+func (u *InvitationsUpsert) UpdateRevokedBy() *InvitationsUpsert {
+	u.SetExcluded(invitations.FieldRevokedBy)
+	return u
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (u *InvitationsUpsert) ClearRevokedBy() *InvitationsUpsert {
+	u.SetNull(invitations.FieldRevokedBy)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationsUpsert) SetRevokedAt(v time.Time) *InvitationsUpsert {
+	u.Set(invitations.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationsUpsert) UpdateRevokedAt() *InvitationsUpsert {
+	u.SetExcluded(invitations.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationsUpsert) ClearRevokedAt() *InvitationsUpsert {
+	u.SetNull(invitations.FieldRevokedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Invitations.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invitations.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvitationsUpsertOne) UpdateNewValues() *InvitationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(invitations.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invitations.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invitations.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvitationsUpsertOne) Ignore() *InvitationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvitationsUpsertOne) DoNothing() *InvitationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvitationsCreate.OnConflict
+// documentation for more info.
+func (u *InvitationsUpsertOne) Update(set func(*InvitationsUpsert)) *InvitationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvitationsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *InvitationsUpsertOne) SetEmail(v string) *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *InvitationsUpsertOne) UpdateEmail() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (u *InvitationsUpsertOne) SetRoleIds(v []int) *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetRoleIds(v)
+	})
+}
+
+// UpdateRoleIds sets the "role_ids" field to the value that was provided on create.
+func (u *InvitationsUpsertOne) UpdateRoleIds() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateRoleIds()
+	})
+}
+
+// ClearRoleIds clears the value of the "role_ids" field.
+func (u *InvitationsUpsertOne) ClearRoleIds() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearRoleIds()
+	})
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (u *InvitationsUpsertOne) SetInvitedBy(v uuid.UUID) *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetInvitedBy(v)
+	})
+}
+
+// UpdateInvitedBy sets the "invited_by" field to the value that was provided on create.
+func (u *InvitationsUpsertOne) UpdateInvitedBy() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateInvitedBy()
+	})
+}
+
+// ClearInvitedBy clears the value of the "invited_by" field.
+func (u *InvitationsUpsertOne) ClearInvitedBy() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearInvitedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InvitationsUpsertOne) SetStatus(v invitations.Status) *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvitationsUpsertOne) UpdateStatus() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationsUpsertOne) SetExpiresAt(v time.Time) *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationsUpsertOne) UpdateExpiresAt() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (u *InvitationsUpsertOne) SetAcceptedBy(v uuid.UUID) *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetAcceptedBy(v)
+	})
+}
+
+// UpdateAcceptedBy sets the "accepted_by" field to the value that was provided on create.
+func (u *InvitationsUpsertOne) UpdateAcceptedBy() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateAcceptedBy()
+	})
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (u *InvitationsUpsertOne) ClearAcceptedBy() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearAcceptedBy()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *InvitationsUpsertOne) SetAcceptedAt(v time.Time) *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *InvitationsUpsertOne) UpdateAcceptedAt() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *InvitationsUpsertOne) ClearAcceptedAt() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (u *InvitationsUpsertOne) SetRevokedBy(v uuid.UUID) *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetRevokedBy(v)
+	})
+}
+
+// UpdateRevokedBy sets the "revoked_by" field to the value that was provided on create.
+func (u *InvitationsUpsertOne) UpdateRevokedBy() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateRevokedBy()
+	})
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (u *InvitationsUpsertOne) ClearRevokedBy() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearRevokedBy()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationsUpsertOne) SetRevokedAt(v time.Time) *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationsUpsertOne) UpdateRevokedAt() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationsUpsertOne) ClearRevokedAt() *InvitationsUpsertOne {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvitationsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvitationsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvitationsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvitationsUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: InvitationsUpsertOne.ID is not supported by MySQL driver. Use InvitationsUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvitationsUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvitationsCreateBulk is the builder for creating many Invitations entities in bulk.
+type InvitationsCreateBulk struct {
+	config
+	err      error
+	builders []*InvitationsCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Invitations entities in the database.
+func (icb *InvitationsCreateBulk) Save(ctx context.Context) ([]*Invitations, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invitations, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvitationsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InvitationsCreateBulk) SaveX(ctx context.Context) []*Invitations {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InvitationsCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InvitationsCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invitations.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvitationsUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (icb *InvitationsCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvitationsUpsertBulk {
+	icb.conflict = opts
+	return &InvitationsUpsertBulk{
+		create: icb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invitations.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icb *InvitationsCreateBulk) OnConflictColumns(columns ...string) *InvitationsUpsertBulk {
+	icb.conflict = append(icb.conflict, sql.ConflictColumns(columns...))
+	return &InvitationsUpsertBulk{
+		create: icb,
+	}
+}
+
+// InvitationsUpsertBulk is the builder for "upsert"-ing
+// a bulk of Invitations nodes.
+type InvitationsUpsertBulk struct {
+	create *InvitationsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Invitations.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invitations.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvitationsUpsertBulk) UpdateNewValues() *InvitationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(invitations.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invitations.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invitations.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvitationsUpsertBulk) Ignore() *InvitationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvitationsUpsertBulk) DoNothing() *InvitationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvitationsCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvitationsUpsertBulk) Update(set func(*InvitationsUpsert)) *InvitationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvitationsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *InvitationsUpsertBulk) SetEmail(v string) *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *InvitationsUpsertBulk) UpdateEmail() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (u *InvitationsUpsertBulk) SetRoleIds(v []int) *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetRoleIds(v)
+	})
+}
+
+// UpdateRoleIds sets the "role_ids" field to the value that was provided on create.
+func (u *InvitationsUpsertBulk) UpdateRoleIds() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateRoleIds()
+	})
+}
+
+// ClearRoleIds clears the value of the "role_ids" field.
+func (u *InvitationsUpsertBulk) ClearRoleIds() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearRoleIds()
+	})
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (u *InvitationsUpsertBulk) SetInvitedBy(v uuid.UUID) *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetInvitedBy(v)
+	})
+}
+
+// UpdateInvitedBy sets the "invited_by" field to the value that was provided on create.
+func (u *InvitationsUpsertBulk) UpdateInvitedBy() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateInvitedBy()
+	})
+}
+
+// ClearInvitedBy clears the value of the "invited_by" field.
+func (u *InvitationsUpsertBulk) ClearInvitedBy() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearInvitedBy()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InvitationsUpsertBulk) SetStatus(v invitations.Status) *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvitationsUpsertBulk) UpdateStatus() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationsUpsertBulk) SetExpiresAt(v time.Time) *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationsUpsertBulk) UpdateExpiresAt() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (u *InvitationsUpsertBulk) SetAcceptedBy(v uuid.UUID) *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetAcceptedBy(v)
+	})
+}
+
+// UpdateAcceptedBy sets the "accepted_by" field to the value that was provided on create.
+func (u *InvitationsUpsertBulk) UpdateAcceptedBy() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateAcceptedBy()
+	})
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (u *InvitationsUpsertBulk) ClearAcceptedBy() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearAcceptedBy()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *InvitationsUpsertBulk) SetAcceptedAt(v time.Time) *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *InvitationsUpsertBulk) UpdateAcceptedAt() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *InvitationsUpsertBulk) ClearAcceptedAt() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (u *InvitationsUpsertBulk) SetRevokedBy(v uuid.UUID) *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetRevokedBy(v)
+	})
+}
+
+// UpdateRevokedBy sets the "revoked_by" field to the value that was provided on create.
+func (u *InvitationsUpsertBulk) UpdateRevokedBy() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateRevokedBy()
+	})
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (u *InvitationsUpsertBulk) ClearRevokedBy() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearRevokedBy()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *InvitationsUpsertBulk) SetRevokedAt(v time.Time) *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *InvitationsUpsertBulk) UpdateRevokedAt() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *InvitationsUpsertBulk) ClearRevokedAt() *InvitationsUpsertBulk {
+	return u.Update(func(s *InvitationsUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvitationsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvitationsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvitationsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvitationsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,634 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/invitations"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// InvitationsUpdate is the builder for updating Invitations entities.
+type InvitationsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvitationsMutation
+}
+
+// Where appends a list predicates to the InvitationsUpdate builder.
+func (iu *InvitationsUpdate) Where(ps ...predicate.Invitations) *InvitationsUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetEmail sets the "email" field.
+func (iu *InvitationsUpdate) SetEmail(s string) *InvitationsUpdate {
+	iu.mutation.SetEmail(s)
+	return iu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (iu *InvitationsUpdate) SetNillableEmail(s *string) *InvitationsUpdate {
+	if s != nil {
+		iu.SetEmail(*s)
+	}
+	return iu
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (iu *InvitationsUpdate) SetRoleIds(i []int) *InvitationsUpdate {
+	iu.mutation.SetRoleIds(i)
+	return iu
+}
+
+// AppendRoleIds appends i to the "role_ids" field.
+func (iu *InvitationsUpdate) AppendRoleIds(i []int) *InvitationsUpdate {
+	iu.mutation.AppendRoleIds(i)
+	return iu
+}
+
+// ClearRoleIds clears the value of the "role_ids" field.
+func (iu *InvitationsUpdate) ClearRoleIds() *InvitationsUpdate {
+	iu.mutation.ClearRoleIds()
+	return iu
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (iu *InvitationsUpdate) SetInvitedBy(u uuid.UUID) *InvitationsUpdate {
+	iu.mutation.SetInvitedBy(u)
+	return iu
+}
+
+// SetNillableInvitedBy sets the "invited_by" field if the given value is not nil.
+func (iu *InvitationsUpdate) SetNillableInvitedBy(u *uuid.UUID) *InvitationsUpdate {
+	if u != nil {
+		iu.SetInvitedBy(*u)
+	}
+	return iu
+}
+
+// ClearInvitedBy clears the value of the "invited_by" field.
+func (iu *InvitationsUpdate) ClearInvitedBy() *InvitationsUpdate {
+	iu.mutation.ClearInvitedBy()
+	return iu
+}
+
+// SetStatus sets the "status" field.
+func (iu *InvitationsUpdate) SetStatus(i invitations.Status) *InvitationsUpdate {
+	iu.mutation.SetStatus(i)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *InvitationsUpdate) SetNillableStatus(i *invitations.Status) *InvitationsUpdate {
+	if i != nil {
+		iu.SetStatus(*i)
+	}
+	return iu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iu *InvitationsUpdate) SetExpiresAt(t time.Time) *InvitationsUpdate {
+	iu.mutation.SetExpiresAt(t)
+	return iu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iu *InvitationsUpdate) SetNillableExpiresAt(t *time.Time) *InvitationsUpdate {
+	if t != nil {
+		iu.SetExpiresAt(*t)
+	}
+	return iu
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (iu *InvitationsUpdate) SetAcceptedBy(u uuid.UUID) *InvitationsUpdate {
+	iu.mutation.SetAcceptedBy(u)
+	return iu
+}
+
+// SetNillableAcceptedBy sets the "accepted_by" field if the given value is not nil.
+func (iu *InvitationsUpdate) SetNillableAcceptedBy(u *uuid.UUID) *InvitationsUpdate {
+	if u != nil {
+		iu.SetAcceptedBy(*u)
+	}
+	return iu
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (iu *InvitationsUpdate) ClearAcceptedBy() *InvitationsUpdate {
+	iu.mutation.ClearAcceptedBy()
+	return iu
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (iu *InvitationsUpdate) SetAcceptedAt(t time.Time) *InvitationsUpdate {
+	iu.mutation.SetAcceptedAt(t)
+	return iu
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (iu *InvitationsUpdate) SetNillableAcceptedAt(t *time.Time) *InvitationsUpdate {
+	if t != nil {
+		iu.SetAcceptedAt(*t)
+	}
+	return iu
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (iu *InvitationsUpdate) ClearAcceptedAt() *InvitationsUpdate {
+	iu.mutation.ClearAcceptedAt()
+	return iu
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (iu *InvitationsUpdate) SetRevokedBy(u uuid.UUID) *InvitationsUpdate {
+	iu.mutation.SetRevokedBy(u)
+	return iu
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (iu *InvitationsUpdate) SetNillableRevokedBy(u *uuid.UUID) *InvitationsUpdate {
+	if u != nil {
+		iu.SetRevokedBy(*u)
+	}
+	return iu
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (iu *InvitationsUpdate) ClearRevokedBy() *InvitationsUpdate {
+	iu.mutation.ClearRevokedBy()
+	return iu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (iu *InvitationsUpdate) SetRevokedAt(t time.Time) *InvitationsUpdate {
+	iu.mutation.SetRevokedAt(t)
+	return iu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (iu *InvitationsUpdate) SetNillableRevokedAt(t *time.Time) *InvitationsUpdate {
+	if t != nil {
+		iu.SetRevokedAt(*t)
+	}
+	return iu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (iu *InvitationsUpdate) ClearRevokedAt() *InvitationsUpdate {
+	iu.mutation.ClearRevokedAt()
+	return iu
+}
+
+// Mutation returns the InvitationsMutation object of the builder.
+func (iu *InvitationsUpdate) Mutation() *InvitationsMutation {
+	return iu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvitationsUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvitationsUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvitationsUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvitationsUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvitationsUpdate) check() error {
+	if v, ok := iu.mutation.Email(); ok {
+		if err := invitations.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Invitations.email": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Status(); ok {
+		if err := invitations.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invitations.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iu *InvitationsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitations.Table, invitations.Columns, sqlgraph.NewFieldSpec(invitations.FieldID, field.TypeUUID))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Email(); ok {
+		_spec.SetField(invitations.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.RoleIds(); ok {
+		_spec.SetField(invitations.FieldRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := iu.mutation.AppendedRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invitations.FieldRoleIds, value)
+		})
+	}
+	if iu.mutation.RoleIdsCleared() {
+		_spec.ClearField(invitations.FieldRoleIds, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.InvitedBy(); ok {
+		_spec.SetField(invitations.FieldInvitedBy, field.TypeUUID, value)
+	}
+	if iu.mutation.InvitedByCleared() {
+		_spec.ClearField(invitations.FieldInvitedBy, field.TypeUUID)
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.SetField(invitations.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iu.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitations.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.AcceptedBy(); ok {
+		_spec.SetField(invitations.FieldAcceptedBy, field.TypeUUID, value)
+	}
+	if iu.mutation.AcceptedByCleared() {
+		_spec.ClearField(invitations.FieldAcceptedBy, field.TypeUUID)
+	}
+	if value, ok := iu.mutation.AcceptedAt(); ok {
+		_spec.SetField(invitations.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if iu.mutation.AcceptedAtCleared() {
+		_spec.ClearField(invitations.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.RevokedBy(); ok {
+		_spec.SetField(invitations.FieldRevokedBy, field.TypeUUID, value)
+	}
+	if iu.mutation.RevokedByCleared() {
+		_spec.ClearField(invitations.FieldRevokedBy, field.TypeUUID)
+	}
+	if value, ok := iu.mutation.RevokedAt(); ok {
+		_spec.SetField(invitations.FieldRevokedAt, field.TypeTime, value)
+	}
+	if iu.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitations.FieldRevokedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitations.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InvitationsUpdateOne is the builder for updating a single Invitations entity.
+type InvitationsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvitationsMutation
+}
+
+// SetEmail sets the "email" field.
+func (iuo *InvitationsUpdateOne) SetEmail(s string) *InvitationsUpdateOne {
+	iuo.mutation.SetEmail(s)
+	return iuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (iuo *InvitationsUpdateOne) SetNillableEmail(s *string) *InvitationsUpdateOne {
+	if s != nil {
+		iuo.SetEmail(*s)
+	}
+	return iuo
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (iuo *InvitationsUpdateOne) SetRoleIds(i []int) *InvitationsUpdateOne {
+	iuo.mutation.SetRoleIds(i)
+	return iuo
+}
+
+// AppendRoleIds appends i to the "role_ids" field.
+func (iuo *InvitationsUpdateOne) AppendRoleIds(i []int) *InvitationsUpdateOne {
+	iuo.mutation.AppendRoleIds(i)
+	return iuo
+}
+
+// ClearRoleIds clears the value of the "role_ids" field.
+func (iuo *InvitationsUpdateOne) ClearRoleIds() *InvitationsUpdateOne {
+	iuo.mutation.ClearRoleIds()
+	return iuo
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (iuo *InvitationsUpdateOne) SetInvitedBy(u uuid.UUID) *InvitationsUpdateOne {
+	iuo.mutation.SetInvitedBy(u)
+	return iuo
+}
+
+// SetNillableInvitedBy sets the "invited_by" field if the given value is not nil.
+func (iuo *InvitationsUpdateOne) SetNillableInvitedBy(u *uuid.UUID) *InvitationsUpdateOne {
+	if u != nil {
+		iuo.SetInvitedBy(*u)
+	}
+	return iuo
+}
+
+// ClearInvitedBy clears the value of the "invited_by" field.
+func (iuo *InvitationsUpdateOne) ClearInvitedBy() *InvitationsUpdateOne {
+	iuo.mutation.ClearInvitedBy()
+	return iuo
+}
+
+// SetStatus sets the "status" field.
+func (iuo *InvitationsUpdateOne) SetStatus(i invitations.Status) *InvitationsUpdateOne {
+	iuo.mutation.SetStatus(i)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *InvitationsUpdateOne) SetNillableStatus(i *invitations.Status) *InvitationsUpdateOne {
+	if i != nil {
+		iuo.SetStatus(*i)
+	}
+	return iuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iuo *InvitationsUpdateOne) SetExpiresAt(t time.Time) *InvitationsUpdateOne {
+	iuo.mutation.SetExpiresAt(t)
+	return iuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iuo *InvitationsUpdateOne) SetNillableExpiresAt(t *time.Time) *InvitationsUpdateOne {
+	if t != nil {
+		iuo.SetExpiresAt(*t)
+	}
+	return iuo
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (iuo *InvitationsUpdateOne) SetAcceptedBy(u uuid.UUID) *InvitationsUpdateOne {
+	iuo.mutation.SetAcceptedBy(u)
+	return iuo
+}
+
+// SetNillableAcceptedBy sets the "accepted_by" field if the given value is not nil.
+func (iuo *InvitationsUpdateOne) SetNillableAcceptedBy(u *uuid.UUID) *InvitationsUpdateOne {
+	if u != nil {
+		iuo.SetAcceptedBy(*u)
+	}
+	return iuo
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (iuo *InvitationsUpdateOne) ClearAcceptedBy() *InvitationsUpdateOne {
+	iuo.mutation.ClearAcceptedBy()
+	return iuo
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (iuo *InvitationsUpdateOne) SetAcceptedAt(t time.Time) *InvitationsUpdateOne {
+	iuo.mutation.SetAcceptedAt(t)
+	return iuo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (iuo *InvitationsUpdateOne) SetNillableAcceptedAt(t *time.Time) *InvitationsUpdateOne {
+	if t != nil {
+		iuo.SetAcceptedAt(*t)
+	}
+	return iuo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (iuo *InvitationsUpdateOne) ClearAcceptedAt() *InvitationsUpdateOne {
+	iuo.mutation.ClearAcceptedAt()
+	return iuo
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (iuo *InvitationsUpdateOne) SetRevokedBy(u uuid.UUID) *InvitationsUpdateOne {
+	iuo.mutation.SetRevokedBy(u)
+	return iuo
+}
+
+// SetNillableRevokedBy sets the "revoked_by" field if the given value is not nil.
+func (iuo *InvitationsUpdateOne) SetNillableRevokedBy(u *uuid.UUID) *InvitationsUpdateOne {
+	if u != nil {
+		iuo.SetRevokedBy(*u)
+	}
+	return iuo
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (iuo *InvitationsUpdateOne) ClearRevokedBy() *InvitationsUpdateOne {
+	iuo.mutation.ClearRevokedBy()
+	return iuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (iuo *InvitationsUpdateOne) SetRevokedAt(t time.Time) *InvitationsUpdateOne {
+	iuo.mutation.SetRevokedAt(t)
+	return iuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (iuo *InvitationsUpdateOne) SetNillableRevokedAt(t *time.Time) *InvitationsUpdateOne {
+	if t != nil {
+		iuo.SetRevokedAt(*t)
+	}
+	return iuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (iuo *InvitationsUpdateOne) ClearRevokedAt() *InvitationsUpdateOne {
+	iuo.mutation.ClearRevokedAt()
+	return iuo
+}
+
+// Mutation returns the InvitationsMutation object of the builder.
+func (iuo *InvitationsUpdateOne) Mutation() *InvitationsMutation {
+	return iuo.mutation
+}
+
+// Where appends a list predicates to the InvitationsUpdate builder.
+func (iuo *InvitationsUpdateOne) Where(ps ...predicate.Invitations) *InvitationsUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvitationsUpdateOne) Select(field string, fields ...string) *InvitationsUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invitations entity.
+func (iuo *InvitationsUpdateOne) Save(ctx context.Context) (*Invitations, error) {
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvitationsUpdateOne) SaveX(ctx context.Context) *Invitations {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvitationsUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvitationsUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvitationsUpdateOne) check() error {
+	if v, ok := iuo.mutation.Email(); ok {
+		if err := invitations.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Invitations.email": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Status(); ok {
+		if err := invitations.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invitations.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iuo *InvitationsUpdateOne) sqlSave(ctx context.Context) (_node *Invitations, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitations.Table, invitations.Columns, sqlgraph.NewFieldSpec(invitations.FieldID, field.TypeUUID))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invitations.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitations.FieldID)
+		for _, f := range fields {
+			if !invitations.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invitations.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Email(); ok {
+		_spec.SetField(invitations.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.RoleIds(); ok {
+		_spec.SetField(invitations.FieldRoleIds, field.TypeJSON, value)
+	}
+	if value, ok := iuo.mutation.AppendedRoleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invitations.FieldRoleIds, value)
+		})
+	}
+	if iuo.mutation.RoleIdsCleared() {
+		_spec.ClearField(invitations.FieldRoleIds, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.InvitedBy(); ok {
+		_spec.SetField(invitations.FieldInvitedBy, field.TypeUUID, value)
+	}
+	if iuo.mutation.InvitedByCleared() {
+		_spec.ClearField(invitations.FieldInvitedBy, field.TypeUUID)
+	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.SetField(invitations.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitations.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.AcceptedBy(); ok {
+		_spec.SetField(invitations.FieldAcceptedBy, field.TypeUUID, value)
+	}
+	if iuo.mutation.AcceptedByCleared() {
+		_spec.ClearField(invitations.FieldAcceptedBy, field.TypeUUID)
+	}
+	if value, ok := iuo.mutation.AcceptedAt(); ok {
+		_spec.SetField(invitations.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.AcceptedAtCleared() {
+		_spec.ClearField(invitations.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.RevokedBy(); ok {
+		_spec.SetField(invitations.FieldRevokedBy, field.TypeUUID, value)
+	}
+	if iuo.mutation.RevokedByCleared() {
+		_spec.ClearField(invitations.FieldRevokedBy, field.TypeUUID)
+	}
+	if value, ok := iuo.mutation.RevokedAt(); ok {
+		_spec.SetField(invitations.FieldRevokedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(invitations.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &Invitations{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitations.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
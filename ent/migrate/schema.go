@@ -8,6 +8,34 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString, Unique: true},
+		{Name: "key_hash", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true},
+		{Name: "revoked_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikeys_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[1], APIKeysColumns[11]},
+			},
+		},
+	}
 	// AuditLogsColumns holds the columns for the "audit_logs" table.
 	AuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -17,6 +45,7 @@ var (
 		{Name: "resource_id", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "org_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "ip_address", Type: field.TypeString, Nullable: true},
 		{Name: "user_agent", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -26,6 +55,103 @@ var (
 		Name:       "audit_logs",
 		Columns:    AuditLogsColumns,
 		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlogs_org_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[7], AuditLogsColumns[10]},
+			},
+		},
+	}
+	// ConsentAcceptancesColumns holds the columns for the "consent_acceptances" table.
+	ConsentAcceptancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "document_id", Type: field.TypeUUID},
+		{Name: "document_type", Type: field.TypeString},
+		{Name: "version", Type: field.TypeString},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "accepted_at", Type: field.TypeTime},
+	}
+	// ConsentAcceptancesTable holds the schema information for the "consent_acceptances" table.
+	ConsentAcceptancesTable = &schema.Table{
+		Name:       "consent_acceptances",
+		Columns:    ConsentAcceptancesColumns,
+		PrimaryKey: []*schema.Column{ConsentAcceptancesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "consentacceptances_user_id_document_id",
+				Unique:  true,
+				Columns: []*schema.Column{ConsentAcceptancesColumns[1], ConsentAcceptancesColumns[2]},
+			},
+			{
+				Name:    "consentacceptances_document_id",
+				Unique:  false,
+				Columns: []*schema.Column{ConsentAcceptancesColumns[2]},
+			},
+		},
+	}
+	// ConsentDocumentsColumns holds the columns for the "consent_documents" table.
+	ConsentDocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeString},
+		{Name: "version", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "mandatory", Type: field.TypeBool, Default: true},
+		{Name: "published_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ConsentDocumentsTable holds the schema information for the "consent_documents" table.
+	ConsentDocumentsTable = &schema.Table{
+		Name:       "consent_documents",
+		Columns:    ConsentDocumentsColumns,
+		PrimaryKey: []*schema.Column{ConsentDocumentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "consentdocuments_type_version",
+				Unique:  true,
+				Columns: []*schema.Column{ConsentDocumentsColumns[1], ConsentDocumentsColumns[2]},
+			},
+			{
+				Name:    "consentdocuments_type_published_at",
+				Unique:  false,
+				Columns: []*schema.Column{ConsentDocumentsColumns[1], ConsentDocumentsColumns[7]},
+			},
+		},
+	}
+	// EmailChangesColumns holds the columns for the "email_changes" table.
+	EmailChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "old_email", Type: field.TypeString},
+		{Name: "old_email_verified", Type: field.TypeBool, Default: false},
+		{Name: "new_email", Type: field.TypeString},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "undo_token", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "confirmed", "cancelled", "reverted"}, Default: "pending"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "undo_expires_at", Type: field.TypeTime},
+		{Name: "confirmed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reverted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EmailChangesTable holds the schema information for the "email_changes" table.
+	EmailChangesTable = &schema.Table{
+		Name:       "email_changes",
+		Columns:    EmailChangesColumns,
+		PrimaryKey: []*schema.Column{EmailChangesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailchanges_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{EmailChangesColumns[1], EmailChangesColumns[7]},
+			},
+		},
 	}
 	// EmailLogsColumns holds the columns for the "email_logs" table.
 	EmailLogsColumns = []*schema.Column{
@@ -66,6 +192,282 @@ var (
 		Columns:    EmailVerificationsColumns,
 		PrimaryKey: []*schema.Column{EmailVerificationsColumns[0]},
 	}
+	// GroupMembersColumns holds the columns for the "group_members" table.
+	GroupMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "added_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "added_at", Type: field.TypeTime},
+		{Name: "group_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// GroupMembersTable holds the schema information for the "group_members" table.
+	GroupMembersTable = &schema.Table{
+		Name:       "group_members",
+		Columns:    GroupMembersColumns,
+		PrimaryKey: []*schema.Column{GroupMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_members_groups_group",
+				Columns:    []*schema.Column{GroupMembersColumns[3]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "group_members_users_user",
+				Columns:    []*schema.Column{GroupMembersColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupmembers_group_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{GroupMembersColumns[3], GroupMembersColumns[4]},
+			},
+			{
+				Name:    "groupmembers_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{GroupMembersColumns[4]},
+			},
+		},
+	}
+	// GroupParentsColumns holds the columns for the "group_parents" table.
+	GroupParentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "group_id", Type: field.TypeInt},
+		{Name: "parent_group_id", Type: field.TypeInt},
+	}
+	// GroupParentsTable holds the schema information for the "group_parents" table.
+	GroupParentsTable = &schema.Table{
+		Name:       "group_parents",
+		Columns:    GroupParentsColumns,
+		PrimaryKey: []*schema.Column{GroupParentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_parents_groups_group",
+				Columns:    []*schema.Column{GroupParentsColumns[2]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "group_parents_groups_parent",
+				Columns:    []*schema.Column{GroupParentsColumns[3]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupparents_group_id_parent_group_id",
+				Unique:  true,
+				Columns: []*schema.Column{GroupParentsColumns[2], GroupParentsColumns[3]},
+			},
+		},
+	}
+	// GroupRolesColumns holds the columns for the "group_roles" table.
+	GroupRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "assigned_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "assigned_at", Type: field.TypeTime},
+		{Name: "group_id", Type: field.TypeInt},
+		{Name: "role_id", Type: field.TypeInt},
+	}
+	// GroupRolesTable holds the schema information for the "group_roles" table.
+	GroupRolesTable = &schema.Table{
+		Name:       "group_roles",
+		Columns:    GroupRolesColumns,
+		PrimaryKey: []*schema.Column{GroupRolesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_roles_groups_group",
+				Columns:    []*schema.Column{GroupRolesColumns[3]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "group_roles_roles_role",
+				Columns:    []*schema.Column{GroupRolesColumns[4]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "grouproles_group_id_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{GroupRolesColumns[3], GroupRolesColumns[4]},
+			},
+		},
+	}
+	// GroupsColumns holds the columns for the "groups" table.
+	GroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// GroupsTable holds the schema information for the "groups" table.
+	GroupsTable = &schema.Table{
+		Name:       "groups",
+		Columns:    GroupsColumns,
+		PrimaryKey: []*schema.Column{GroupsColumns[0]},
+	}
+	// InvitationsColumns holds the columns for the "invitations" table.
+	InvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "email", Type: field.TypeString},
+		{Name: "role_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "invited_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "revoked"}, Default: "pending"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "accepted_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// InvitationsTable holds the schema information for the "invitations" table.
+	InvitationsTable = &schema.Table{
+		Name:       "invitations",
+		Columns:    InvitationsColumns,
+		PrimaryKey: []*schema.Column{InvitationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invitations_email_status",
+				Unique:  false,
+				Columns: []*schema.Column{InvitationsColumns[1], InvitationsColumns[4]},
+			},
+			{
+				Name:    "invitations_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvitationsColumns[4], InvitationsColumns[10]},
+			},
+		},
+	}
+	// OrgMembersColumns holds the columns for the "org_members" table.
+	OrgMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "added_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "joined_at", Type: field.TypeTime},
+		{Name: "org_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// OrgMembersTable holds the schema information for the "org_members" table.
+	OrgMembersTable = &schema.Table{
+		Name:       "org_members",
+		Columns:    OrgMembersColumns,
+		PrimaryKey: []*schema.Column{OrgMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "org_members_organizations_org",
+				Columns:    []*schema.Column{OrgMembersColumns[3]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "org_members_users_user",
+				Columns:    []*schema.Column{OrgMembersColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orgmembers_org_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{OrgMembersColumns[3], OrgMembersColumns[4]},
+			},
+			{
+				Name:    "orgmembers_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrgMembersColumns[4]},
+			},
+		},
+	}
+	// OrgRolesColumns holds the columns for the "org_roles" table.
+	OrgRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "assigned_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "assigned_at", Type: field.TypeTime},
+		{Name: "org_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "role_id", Type: field.TypeInt},
+	}
+	// OrgRolesTable holds the schema information for the "org_roles" table.
+	OrgRolesTable = &schema.Table{
+		Name:       "org_roles",
+		Columns:    OrgRolesColumns,
+		PrimaryKey: []*schema.Column{OrgRolesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "org_roles_organizations_org",
+				Columns:    []*schema.Column{OrgRolesColumns[3]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "org_roles_users_user",
+				Columns:    []*schema.Column{OrgRolesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "org_roles_roles_role",
+				Columns:    []*schema.Column{OrgRolesColumns[5]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orgroles_org_id_user_id_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{OrgRolesColumns[3], OrgRolesColumns[4], OrgRolesColumns[5]},
+			},
+		},
+	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "allowed_domains", Type: field.TypeJSON, Nullable: true},
+		{Name: "mfa_required", Type: field.TypeBool, Default: false},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
+	}
+	// PasswordHistoriesColumns holds the columns for the "password_histories" table.
+	PasswordHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PasswordHistoriesTable holds the schema information for the "password_histories" table.
+	PasswordHistoriesTable = &schema.Table{
+		Name:       "password_histories",
+		Columns:    PasswordHistoriesColumns,
+		PrimaryKey: []*schema.Column{PasswordHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passwordhistories_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordHistoriesColumns[1], PasswordHistoriesColumns[3]},
+			},
+		},
+	}
 	// PasswordResetsColumns holds the columns for the "password_resets" table.
 	PasswordResetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -92,6 +494,7 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "resource", Type: field.TypeString, Nullable: true},
 		{Name: "action", Type: field.TypeString, Nullable: true},
+		{Name: "is_system", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -101,9 +504,107 @@ var (
 		Columns:    PermissionsColumns,
 		PrimaryKey: []*schema.Column{PermissionsColumns[0]},
 	}
+	// RelationTuplesColumns holds the columns for the "relation_tuples" table.
+	RelationTuplesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "object_type", Type: field.TypeString},
+		{Name: "object_id", Type: field.TypeString},
+		{Name: "relation", Type: field.TypeString},
+		{Name: "subject_type", Type: field.TypeString},
+		{Name: "subject_id", Type: field.TypeString},
+		{Name: "subject_relation", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// RelationTuplesTable holds the schema information for the "relation_tuples" table.
+	RelationTuplesTable = &schema.Table{
+		Name:       "relation_tuples",
+		Columns:    RelationTuplesColumns,
+		PrimaryKey: []*schema.Column{RelationTuplesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "relationtuples_object_type_object_id_relation_subject_type_subject_id_subject_relation",
+				Unique:  true,
+				Columns: []*schema.Column{RelationTuplesColumns[1], RelationTuplesColumns[2], RelationTuplesColumns[3], RelationTuplesColumns[4], RelationTuplesColumns[5], RelationTuplesColumns[6]},
+			},
+			{
+				Name:    "relationtuples_subject_type_subject_id_subject_relation",
+				Unique:  false,
+				Columns: []*schema.Column{RelationTuplesColumns[4], RelationTuplesColumns[5], RelationTuplesColumns[6]},
+			},
+		},
+	}
+	// RoleApproversColumns holds the columns for the "role_approvers" table.
+	RoleApproversColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "role_id", Type: field.TypeInt},
+		{Name: "approver_role_id", Type: field.TypeInt},
+	}
+	// RoleApproversTable holds the schema information for the "role_approvers" table.
+	RoleApproversTable = &schema.Table{
+		Name:       "role_approvers",
+		Columns:    RoleApproversColumns,
+		PrimaryKey: []*schema.Column{RoleApproversColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_approvers_roles_role",
+				Columns:    []*schema.Column{RoleApproversColumns[2]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "role_approvers_roles_approver_role",
+				Columns:    []*schema.Column{RoleApproversColumns[3]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roleapprovers_role_id_approver_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{RoleApproversColumns[2], RoleApproversColumns[3]},
+			},
+		},
+	}
+	// RoleParentsColumns holds the columns for the "role_parents" table.
+	RoleParentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "role_id", Type: field.TypeInt},
+		{Name: "parent_role_id", Type: field.TypeInt},
+	}
+	// RoleParentsTable holds the schema information for the "role_parents" table.
+	RoleParentsTable = &schema.Table{
+		Name:       "role_parents",
+		Columns:    RoleParentsColumns,
+		PrimaryKey: []*schema.Column{RoleParentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "role_parents_roles_role",
+				Columns:    []*schema.Column{RoleParentsColumns[2]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "role_parents_roles_parent",
+				Columns:    []*schema.Column{RoleParentsColumns[3]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "roleparents_role_id_parent_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{RoleParentsColumns[2], RoleParentsColumns[3]},
+			},
+		},
+	}
 	// RolePermissionsColumns holds the columns for the "role_permissions" table.
 	RolePermissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "condition", Type: field.TypeString, Nullable: true},
 		{Name: "assigned_at", Type: field.TypeTime},
 		{Name: "role_id", Type: field.TypeInt},
 		{Name: "permission_id", Type: field.TypeInt},
@@ -116,13 +617,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "role_permissions_roles_role",
-				Columns:    []*schema.Column{RolePermissionsColumns[2]},
+				Columns:    []*schema.Column{RolePermissionsColumns[3]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "role_permissions_permissions_permission",
-				Columns:    []*schema.Column{RolePermissionsColumns[3]},
+				Columns:    []*schema.Column{RolePermissionsColumns[4]},
 				RefColumns: []*schema.Column{PermissionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -131,7 +632,40 @@ var (
 			{
 				Name:    "rolepermissions_role_id_permission_id",
 				Unique:  true,
-				Columns: []*schema.Column{RolePermissionsColumns[2], RolePermissionsColumns[3]},
+				Columns: []*schema.Column{RolePermissionsColumns[3], RolePermissionsColumns[4]},
+			},
+		},
+	}
+	// RoleRequestsColumns holds the columns for the "role_requests" table.
+	RoleRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "role_id", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "duration_hours", Type: field.TypeInt, Nullable: true},
+		{Name: "break_glass", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "denied", "expired"}, Default: "pending"},
+		{Name: "decided_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "decided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "decision_note", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RoleRequestsTable holds the schema information for the "role_requests" table.
+	RoleRequestsTable = &schema.Table{
+		Name:       "role_requests",
+		Columns:    RoleRequestsColumns,
+		PrimaryKey: []*schema.Column{RoleRequestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rolerequests_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RoleRequestsColumns[6], RoleRequestsColumns[10]},
+			},
+			{
+				Name:    "rolerequests_user_id_role_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{RoleRequestsColumns[1], RoleRequestsColumns[2], RoleRequestsColumns[6]},
 			},
 		},
 	}
@@ -144,6 +678,9 @@ var (
 		{Name: "is_system", Type: field.TypeBool, Default: false},
 		{Name: "is_default", Type: field.TypeBool, Default: false},
 		{Name: "max_users", Type: field.TypeInt, Nullable: true},
+		{Name: "org_assignable", Type: field.TypeBool, Default: false},
+		{Name: "break_glass", Type: field.TypeEnum, Enums: []string{"disabled", "auto", "approval"}, Default: "disabled"},
+		{Name: "break_glass_max_hours", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -153,11 +690,62 @@ var (
 		Columns:    RolesColumns,
 		PrimaryKey: []*schema.Column{RolesColumns[0]},
 	}
+	// SodConstraintRolesColumns holds the columns for the "sod_constraint_roles" table.
+	SodConstraintRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "constraint_id", Type: field.TypeInt},
+		{Name: "role_id", Type: field.TypeInt},
+	}
+	// SodConstraintRolesTable holds the schema information for the "sod_constraint_roles" table.
+	SodConstraintRolesTable = &schema.Table{
+		Name:       "sod_constraint_roles",
+		Columns:    SodConstraintRolesColumns,
+		PrimaryKey: []*schema.Column{SodConstraintRolesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sod_constraint_roles_sod_constraints_sod_constraint",
+				Columns:    []*schema.Column{SodConstraintRolesColumns[1]},
+				RefColumns: []*schema.Column{SodConstraintsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "sod_constraint_roles_roles_role",
+				Columns:    []*schema.Column{SodConstraintRolesColumns[2]},
+				RefColumns: []*schema.Column{RolesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sodconstraintroles_constraint_id_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{SodConstraintRolesColumns[1], SodConstraintRolesColumns[2]},
+			},
+		},
+	}
+	// SodConstraintsColumns holds the columns for the "sod_constraints" table.
+	SodConstraintsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "is_system", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SodConstraintsTable holds the schema information for the "sod_constraints" table.
+	SodConstraintsTable = &schema.Table{
+		Name:       "sod_constraints",
+		Columns:    SodConstraintsColumns,
+		PrimaryKey: []*schema.Column{SodConstraintsColumns[0]},
+	}
 	// UserRolesColumns holds the columns for the "user_roles" table.
 	UserRolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "assigned_by", Type: field.TypeUUID, Nullable: true},
 		{Name: "assigned_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
 		{Name: "user_id", Type: field.TypeUUID},
 		{Name: "role_id", Type: field.TypeInt},
 	}
@@ -169,13 +757,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_roles_users_user",
-				Columns:    []*schema.Column{UserRolesColumns[3]},
+				Columns:    []*schema.Column{UserRolesColumns[5]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "user_roles_roles_role",
-				Columns:    []*schema.Column{UserRolesColumns[4]},
+				Columns:    []*schema.Column{UserRolesColumns[6]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -184,7 +772,12 @@ var (
 			{
 				Name:    "userroles_user_id_role_id",
 				Unique:  true,
-				Columns: []*schema.Column{UserRolesColumns[3], UserRolesColumns[4]},
+				Columns: []*schema.Column{UserRolesColumns[5], UserRolesColumns[6]},
+			},
+			{
+				Name:    "userroles_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserRolesColumns[3]},
 			},
 		},
 	}
@@ -204,6 +797,12 @@ var (
 		{Name: "verification_token_expiry", Type: field.TypeTime, Nullable: true},
 		{Name: "password_reset_token", Type: field.TypeString, Nullable: true},
 		{Name: "password_reset_token_expiry", Type: field.TypeTime, Nullable: true},
+		{Name: "password_reset_required", Type: field.TypeBool, Default: false},
+		{Name: "approval_status", Type: field.TypeEnum, Enums: []string{"approved", "pending", "rejected"}, Default: "approved"},
+		{Name: "reviewed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_requested_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_scheduled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -211,24 +810,72 @@ var (
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "users_approval_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[15], UsersColumns[5]},
+			},
+			{
+				Name:    "users_deletion_scheduled_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[19]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
 		AuditLogsTable,
+		ConsentAcceptancesTable,
+		ConsentDocumentsTable,
+		EmailChangesTable,
 		EmailLogsTable,
 		EmailVerificationsTable,
+		GroupMembersTable,
+		GroupParentsTable,
+		GroupRolesTable,
+		GroupsTable,
+		InvitationsTable,
+		OrgMembersTable,
+		OrgRolesTable,
+		OrganizationsTable,
+		PasswordHistoriesTable,
 		PasswordResetsTable,
 		PermissionsTable,
+		RelationTuplesTable,
+		RoleApproversTable,
+		RoleParentsTable,
 		RolePermissionsTable,
+		RoleRequestsTable,
 		RolesTable,
+		SodConstraintRolesTable,
+		SodConstraintsTable,
 		UserRolesTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	GroupMembersTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupMembersTable.ForeignKeys[1].RefTable = UsersTable
+	GroupParentsTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupParentsTable.ForeignKeys[1].RefTable = GroupsTable
+	GroupRolesTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupRolesTable.ForeignKeys[1].RefTable = RolesTable
+	OrgMembersTable.ForeignKeys[0].RefTable = OrganizationsTable
+	OrgMembersTable.ForeignKeys[1].RefTable = UsersTable
+	OrgRolesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	OrgRolesTable.ForeignKeys[1].RefTable = UsersTable
+	OrgRolesTable.ForeignKeys[2].RefTable = RolesTable
+	RoleApproversTable.ForeignKeys[0].RefTable = RolesTable
+	RoleApproversTable.ForeignKeys[1].RefTable = RolesTable
+	RoleParentsTable.ForeignKeys[0].RefTable = RolesTable
+	RoleParentsTable.ForeignKeys[1].RefTable = RolesTable
 	RolePermissionsTable.ForeignKeys[0].RefTable = RolesTable
 	RolePermissionsTable.ForeignKeys[1].RefTable = PermissionsTable
+	SodConstraintRolesTable.ForeignKeys[0].RefTable = SodConstraintsTable
+	SodConstraintRolesTable.ForeignKeys[1].RefTable = RolesTable
 	UserRolesTable.ForeignKeys[0].RefTable = UsersTable
 	UserRolesTable.ForeignKeys[1].RefTable = RolesTable
 }
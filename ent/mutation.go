@@ -12,14 +12,33 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/apikeys"
 	"github.com/shammianand/go-auth/ent/auditlogs"
+	"github.com/shammianand/go-auth/ent/consentacceptances"
+	"github.com/shammianand/go-auth/ent/consentdocuments"
+	"github.com/shammianand/go-auth/ent/emailchanges"
 	"github.com/shammianand/go-auth/ent/emaillogs"
 	"github.com/shammianand/go-auth/ent/emailverifications"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/invitations"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/passwordhistories"
 	"github.com/shammianand/go-auth/ent/passwordresets"
 	"github.com/shammianand/go-auth/ent/permissions"
 	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/relationtuples"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roleparents"
 	"github.com/shammianand/go-auth/ent/rolepermissions"
+	"github.com/shammianand/go-auth/ent/rolerequests"
 	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
 	"github.com/shammianand/go-auth/ent/userroles"
 	"github.com/shammianand/go-auth/ent/users"
 )
@@ -33,49 +52,71 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeAPIKeys            = "APIKeys"
 	TypeAuditLogs          = "AuditLogs"
+	TypeConsentAcceptances = "ConsentAcceptances"
+	TypeConsentDocuments   = "ConsentDocuments"
+	TypeEmailChanges       = "EmailChanges"
 	TypeEmailLogs          = "EmailLogs"
 	TypeEmailVerifications = "EmailVerifications"
+	TypeGroupMembers       = "GroupMembers"
+	TypeGroupParents       = "GroupParents"
+	TypeGroupRoles         = "GroupRoles"
+	TypeGroups             = "Groups"
+	TypeInvitations        = "Invitations"
+	TypeOrgMembers         = "OrgMembers"
+	TypeOrgRoles           = "OrgRoles"
+	TypeOrganizations      = "Organizations"
+	TypePasswordHistories  = "PasswordHistories"
 	TypePasswordResets     = "PasswordResets"
 	TypePermissions        = "Permissions"
+	TypeRelationTuples     = "RelationTuples"
+	TypeRoleApprovers      = "RoleApprovers"
+	TypeRoleParents        = "RoleParents"
 	TypeRolePermissions    = "RolePermissions"
+	TypeRoleRequests       = "RoleRequests"
 	TypeRoles              = "Roles"
+	TypeSodConstraintRoles = "SodConstraintRoles"
+	TypeSodConstraints     = "SodConstraints"
 	TypeUserRoles          = "UserRoles"
 	TypeUsers              = "Users"
 )
 
-// AuditLogsMutation represents an operation that mutates the AuditLogs nodes in the graph.
-type AuditLogsMutation struct {
+// APIKeysMutation represents an operation that mutates the APIKeys nodes in the graph.
+type APIKeysMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
-	actor_id      *uuid.UUID
-	action_type   *string
-	resource_type *string
-	resource_id   *string
-	metadata      *map[string]interface{}
-	changes       *map[string]interface{}
-	ip_address    *string
-	user_agent    *string
+	user_id       *uuid.UUID
+	name          *string
+	prefix        *string
+	key_hash      *string
+	scopes        *[]string
+	appendscopes  []string
+	expires_at    *time.Time
+	last_used_at  *time.Time
+	last_used_ip  *string
+	revoked_by    *uuid.UUID
+	revoked_at    *time.Time
 	created_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*AuditLogs, error)
-	predicates    []predicate.AuditLogs
+	oldValue      func(context.Context) (*APIKeys, error)
+	predicates    []predicate.APIKeys
 }
 
-var _ ent.Mutation = (*AuditLogsMutation)(nil)
+var _ ent.Mutation = (*APIKeysMutation)(nil)
 
-// auditlogsOption allows management of the mutation configuration using functional options.
-type auditlogsOption func(*AuditLogsMutation)
+// apikeysOption allows management of the mutation configuration using functional options.
+type apikeysOption func(*APIKeysMutation)
 
-// newAuditLogsMutation creates new mutation for the AuditLogs entity.
-func newAuditLogsMutation(c config, op Op, opts ...auditlogsOption) *AuditLogsMutation {
-	m := &AuditLogsMutation{
+// newAPIKeysMutation creates new mutation for the APIKeys entity.
+func newAPIKeysMutation(c config, op Op, opts ...apikeysOption) *APIKeysMutation {
+	m := &APIKeysMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuditLogs,
+		typ:           TypeAPIKeys,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -84,20 +125,20 @@ func newAuditLogsMutation(c config, op Op, opts ...auditlogsOption) *AuditLogsMu
 	return m
 }
 
-// withAuditLogsID sets the ID field of the mutation.
-func withAuditLogsID(id uuid.UUID) auditlogsOption {
-	return func(m *AuditLogsMutation) {
+// withAPIKeysID sets the ID field of the mutation.
+func withAPIKeysID(id uuid.UUID) apikeysOption {
+	return func(m *APIKeysMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuditLogs
+			value *APIKeys
 		)
-		m.oldValue = func(ctx context.Context) (*AuditLogs, error) {
+		m.oldValue = func(ctx context.Context) (*APIKeys, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuditLogs.Get(ctx, id)
+					value, err = m.Client().APIKeys.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -106,10 +147,10 @@ func withAuditLogsID(id uuid.UUID) auditlogsOption {
 	}
 }
 
-// withAuditLogs sets the old AuditLogs of the mutation.
-func withAuditLogs(node *AuditLogs) auditlogsOption {
-	return func(m *AuditLogsMutation) {
-		m.oldValue = func(context.Context) (*AuditLogs, error) {
+// withAPIKeys sets the old APIKeys of the mutation.
+func withAPIKeys(node *APIKeys) apikeysOption {
+	return func(m *APIKeysMutation) {
+		m.oldValue = func(context.Context) (*APIKeys, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -118,7 +159,7 @@ func withAuditLogs(node *AuditLogs) auditlogsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuditLogsMutation) Client() *Client {
+func (m APIKeysMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -126,7 +167,7 @@ func (m AuditLogsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuditLogsMutation) Tx() (*Tx, error) {
+func (m APIKeysMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -136,14 +177,14 @@ func (m AuditLogsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuditLogs entities.
-func (m *AuditLogsMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of APIKeys entities.
+func (m *APIKeysMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuditLogsMutation) ID() (id uuid.UUID, exists bool) {
+func (m *APIKeysMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -154,7 +195,7 @@ func (m *AuditLogsMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuditLogsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *APIKeysMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -163,385 +204,459 @@ func (m *AuditLogsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuditLogs.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKeys.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetActorID sets the "actor_id" field.
-func (m *AuditLogsMutation) SetActorID(u uuid.UUID) {
-	m.actor_id = &u
+// SetUserID sets the "user_id" field.
+func (m *APIKeysMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// ActorID returns the value of the "actor_id" field in the mutation.
-func (m *AuditLogsMutation) ActorID() (r uuid.UUID, exists bool) {
-	v := m.actor_id
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *APIKeysMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActorID returns the old "actor_id" field's value of the AuditLogs entity.
-// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the APIKeys entity.
+// If the APIKeys object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogsMutation) OldActorID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *APIKeysMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActorID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.ActorID, nil
+	return oldValue.UserID, nil
 }
 
-// ClearActorID clears the value of the "actor_id" field.
-func (m *AuditLogsMutation) ClearActorID() {
-	m.actor_id = nil
-	m.clearedFields[auditlogs.FieldActorID] = struct{}{}
+// ResetUserID resets all changes to the "user_id" field.
+func (m *APIKeysMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
-func (m *AuditLogsMutation) ActorIDCleared() bool {
-	_, ok := m.clearedFields[auditlogs.FieldActorID]
-	return ok
+// SetName sets the "name" field.
+func (m *APIKeysMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ResetActorID resets all changes to the "actor_id" field.
-func (m *AuditLogsMutation) ResetActorID() {
-	m.actor_id = nil
-	delete(m.clearedFields, auditlogs.FieldActorID)
+// Name returns the value of the "name" field in the mutation.
+func (m *APIKeysMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetActionType sets the "action_type" field.
-func (m *AuditLogsMutation) SetActionType(s string) {
-	m.action_type = &s
+// OldName returns the old "name" field's value of the APIKeys entity.
+// If the APIKeys object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeysMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// ActionType returns the value of the "action_type" field in the mutation.
-func (m *AuditLogsMutation) ActionType() (r string, exists bool) {
-	v := m.action_type
+// ResetName resets all changes to the "name" field.
+func (m *APIKeysMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPrefix sets the "prefix" field.
+func (m *APIKeysMutation) SetPrefix(s string) {
+	m.prefix = &s
+}
+
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APIKeysMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActionType returns the old "action_type" field's value of the AuditLogs entity.
-// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the APIKeys entity.
+// If the APIKeys object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogsMutation) OldActionType(ctx context.Context) (v string, err error) {
+func (m *APIKeysMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActionType is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActionType requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActionType: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.ActionType, nil
+	return oldValue.Prefix, nil
 }
 
-// ResetActionType resets all changes to the "action_type" field.
-func (m *AuditLogsMutation) ResetActionType() {
-	m.action_type = nil
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APIKeysMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetResourceType sets the "resource_type" field.
-func (m *AuditLogsMutation) SetResourceType(s string) {
-	m.resource_type = &s
+// SetKeyHash sets the "key_hash" field.
+func (m *APIKeysMutation) SetKeyHash(s string) {
+	m.key_hash = &s
 }
 
-// ResourceType returns the value of the "resource_type" field in the mutation.
-func (m *AuditLogsMutation) ResourceType() (r string, exists bool) {
-	v := m.resource_type
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *APIKeysMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceType returns the old "resource_type" field's value of the AuditLogs entity.
-// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyHash returns the old "key_hash" field's value of the APIKeys entity.
+// If the APIKeys object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogsMutation) OldResourceType(ctx context.Context) (v string, err error) {
+func (m *APIKeysMutation) OldKeyHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceType requires an ID field in the mutation")
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
 	}
-	return oldValue.ResourceType, nil
+	return oldValue.KeyHash, nil
 }
 
-// ResetResourceType resets all changes to the "resource_type" field.
-func (m *AuditLogsMutation) ResetResourceType() {
-	m.resource_type = nil
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *APIKeysMutation) ResetKeyHash() {
+	m.key_hash = nil
 }
 
-// SetResourceID sets the "resource_id" field.
-func (m *AuditLogsMutation) SetResourceID(s string) {
-	m.resource_id = &s
+// SetScopes sets the "scopes" field.
+func (m *APIKeysMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// ResourceID returns the value of the "resource_id" field in the mutation.
-func (m *AuditLogsMutation) ResourceID() (r string, exists bool) {
-	v := m.resource_id
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *APIKeysMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResourceID returns the old "resource_id" field's value of the AuditLogs entity.
-// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the APIKeys entity.
+// If the APIKeys object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogsMutation) OldResourceID(ctx context.Context) (v string, err error) {
+func (m *APIKeysMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResourceID requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.ResourceID, nil
+	return oldValue.Scopes, nil
 }
 
-// ClearResourceID clears the value of the "resource_id" field.
-func (m *AuditLogsMutation) ClearResourceID() {
-	m.resource_id = nil
-	m.clearedFields[auditlogs.FieldResourceID] = struct{}{}
+// AppendScopes adds s to the "scopes" field.
+func (m *APIKeysMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// ResourceIDCleared returns if the "resource_id" field was cleared in this mutation.
-func (m *AuditLogsMutation) ResourceIDCleared() bool {
-	_, ok := m.clearedFields[auditlogs.FieldResourceID]
-	return ok
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *APIKeysMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
 }
 
-// ResetResourceID resets all changes to the "resource_id" field.
-func (m *AuditLogsMutation) ResetResourceID() {
-	m.resource_id = nil
-	delete(m.clearedFields, auditlogs.FieldResourceID)
+// ResetScopes resets all changes to the "scopes" field.
+func (m *APIKeysMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *AuditLogsMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetExpiresAt sets the "expires_at" field.
+func (m *APIKeysMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *AuditLogsMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *APIKeysMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the AuditLogs entity.
-// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the APIKeys entity.
+// If the APIKeys object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogsMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *APIKeysMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *AuditLogsMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[auditlogs.FieldMetadata] = struct{}{}
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *APIKeysMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apikeys.FieldExpiresAt] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *AuditLogsMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[auditlogs.FieldMetadata]
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *APIKeysMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apikeys.FieldExpiresAt]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *AuditLogsMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, auditlogs.FieldMetadata)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *APIKeysMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apikeys.FieldExpiresAt)
 }
 
-// SetChanges sets the "changes" field.
-func (m *AuditLogsMutation) SetChanges(value map[string]interface{}) {
-	m.changes = &value
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeysMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// Changes returns the value of the "changes" field in the mutation.
-func (m *AuditLogsMutation) Changes() (r map[string]interface{}, exists bool) {
-	v := m.changes
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeysMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChanges returns the old "changes" field's value of the AuditLogs entity.
-// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKeys entity.
+// If the APIKeys object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogsMutation) OldChanges(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *APIKeysMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChanges requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.Changes, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearChanges clears the value of the "changes" field.
-func (m *AuditLogsMutation) ClearChanges() {
-	m.changes = nil
-	m.clearedFields[auditlogs.FieldChanges] = struct{}{}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APIKeysMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikeys.FieldLastUsedAt] = struct{}{}
 }
 
-// ChangesCleared returns if the "changes" field was cleared in this mutation.
-func (m *AuditLogsMutation) ChangesCleared() bool {
-	_, ok := m.clearedFields[auditlogs.FieldChanges]
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APIKeysMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikeys.FieldLastUsedAt]
 	return ok
 }
 
-// ResetChanges resets all changes to the "changes" field.
-func (m *AuditLogsMutation) ResetChanges() {
-	m.changes = nil
-	delete(m.clearedFields, auditlogs.FieldChanges)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeysMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikeys.FieldLastUsedAt)
 }
 
-// SetIPAddress sets the "ip_address" field.
-func (m *AuditLogsMutation) SetIPAddress(s string) {
-	m.ip_address = &s
+// SetLastUsedIP sets the "last_used_ip" field.
+func (m *APIKeysMutation) SetLastUsedIP(s string) {
+	m.last_used_ip = &s
 }
 
-// IPAddress returns the value of the "ip_address" field in the mutation.
-func (m *AuditLogsMutation) IPAddress() (r string, exists bool) {
-	v := m.ip_address
+// LastUsedIP returns the value of the "last_used_ip" field in the mutation.
+func (m *APIKeysMutation) LastUsedIP() (r string, exists bool) {
+	v := m.last_used_ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIPAddress returns the old "ip_address" field's value of the AuditLogs entity.
-// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedIP returns the old "last_used_ip" field's value of the APIKeys entity.
+// If the APIKeys object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogsMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+func (m *APIKeysMutation) OldLastUsedIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedIP: %w", err)
 	}
-	return oldValue.IPAddress, nil
+	return oldValue.LastUsedIP, nil
 }
 
-// ClearIPAddress clears the value of the "ip_address" field.
-func (m *AuditLogsMutation) ClearIPAddress() {
-	m.ip_address = nil
-	m.clearedFields[auditlogs.FieldIPAddress] = struct{}{}
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (m *APIKeysMutation) ClearLastUsedIP() {
+	m.last_used_ip = nil
+	m.clearedFields[apikeys.FieldLastUsedIP] = struct{}{}
 }
 
-// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
-func (m *AuditLogsMutation) IPAddressCleared() bool {
-	_, ok := m.clearedFields[auditlogs.FieldIPAddress]
+// LastUsedIPCleared returns if the "last_used_ip" field was cleared in this mutation.
+func (m *APIKeysMutation) LastUsedIPCleared() bool {
+	_, ok := m.clearedFields[apikeys.FieldLastUsedIP]
 	return ok
 }
 
-// ResetIPAddress resets all changes to the "ip_address" field.
-func (m *AuditLogsMutation) ResetIPAddress() {
-	m.ip_address = nil
-	delete(m.clearedFields, auditlogs.FieldIPAddress)
+// ResetLastUsedIP resets all changes to the "last_used_ip" field.
+func (m *APIKeysMutation) ResetLastUsedIP() {
+	m.last_used_ip = nil
+	delete(m.clearedFields, apikeys.FieldLastUsedIP)
 }
 
-// SetUserAgent sets the "user_agent" field.
-func (m *AuditLogsMutation) SetUserAgent(s string) {
-	m.user_agent = &s
+// SetRevokedBy sets the "revoked_by" field.
+func (m *APIKeysMutation) SetRevokedBy(u uuid.UUID) {
+	m.revoked_by = &u
 }
 
-// UserAgent returns the value of the "user_agent" field in the mutation.
-func (m *AuditLogsMutation) UserAgent() (r string, exists bool) {
-	v := m.user_agent
+// RevokedBy returns the value of the "revoked_by" field in the mutation.
+func (m *APIKeysMutation) RevokedBy() (r uuid.UUID, exists bool) {
+	v := m.revoked_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserAgent returns the old "user_agent" field's value of the AuditLogs entity.
-// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedBy returns the old "revoked_by" field's value of the APIKeys entity.
+// If the APIKeys object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogsMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+func (m *APIKeysMutation) OldRevokedBy(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+		return v, errors.New("OldRevokedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedBy: %w", err)
 	}
-	return oldValue.UserAgent, nil
+	return oldValue.RevokedBy, nil
 }
 
-// ClearUserAgent clears the value of the "user_agent" field.
-func (m *AuditLogsMutation) ClearUserAgent() {
-	m.user_agent = nil
-	m.clearedFields[auditlogs.FieldUserAgent] = struct{}{}
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (m *APIKeysMutation) ClearRevokedBy() {
+	m.revoked_by = nil
+	m.clearedFields[apikeys.FieldRevokedBy] = struct{}{}
 }
 
-// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
-func (m *AuditLogsMutation) UserAgentCleared() bool {
-	_, ok := m.clearedFields[auditlogs.FieldUserAgent]
+// RevokedByCleared returns if the "revoked_by" field was cleared in this mutation.
+func (m *APIKeysMutation) RevokedByCleared() bool {
+	_, ok := m.clearedFields[apikeys.FieldRevokedBy]
 	return ok
 }
 
-// ResetUserAgent resets all changes to the "user_agent" field.
-func (m *AuditLogsMutation) ResetUserAgent() {
-	m.user_agent = nil
-	delete(m.clearedFields, auditlogs.FieldUserAgent)
+// ResetRevokedBy resets all changes to the "revoked_by" field.
+func (m *APIKeysMutation) ResetRevokedBy() {
+	m.revoked_by = nil
+	delete(m.clearedFields, apikeys.FieldRevokedBy)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APIKeysMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APIKeysMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the APIKeys entity.
+// If the APIKeys object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeysMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *APIKeysMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikeys.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *APIKeysMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikeys.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APIKeysMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikeys.FieldRevokedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *AuditLogsMutation) SetCreatedAt(t time.Time) {
+func (m *APIKeysMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AuditLogsMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *APIKeysMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -549,10 +664,10 @@ func (m *AuditLogsMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the AuditLogs entity.
-// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIKeys entity.
+// If the APIKeys object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeysMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -567,19 +682,19 @@ func (m *AuditLogsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AuditLogsMutation) ResetCreatedAt() {
+func (m *APIKeysMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the AuditLogsMutation builder.
-func (m *AuditLogsMutation) Where(ps ...predicate.AuditLogs) {
+// Where appends a list predicates to the APIKeysMutation builder.
+func (m *APIKeysMutation) Where(ps ...predicate.APIKeys) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuditLogsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the APIKeysMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuditLogsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuditLogs, len(ps))
+func (m *APIKeysMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKeys, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -587,51 +702,57 @@ func (m *AuditLogsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuditLogsMutation) Op() Op {
+func (m *APIKeysMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuditLogsMutation) SetOp(op Op) {
+func (m *APIKeysMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AuditLogs).
-func (m *AuditLogsMutation) Type() string {
+// Type returns the node type of this mutation (APIKeys).
+func (m *APIKeysMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuditLogsMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.actor_id != nil {
-		fields = append(fields, auditlogs.FieldActorID)
+func (m *APIKeysMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.user_id != nil {
+		fields = append(fields, apikeys.FieldUserID)
 	}
-	if m.action_type != nil {
-		fields = append(fields, auditlogs.FieldActionType)
+	if m.name != nil {
+		fields = append(fields, apikeys.FieldName)
 	}
-	if m.resource_type != nil {
-		fields = append(fields, auditlogs.FieldResourceType)
+	if m.prefix != nil {
+		fields = append(fields, apikeys.FieldPrefix)
 	}
-	if m.resource_id != nil {
-		fields = append(fields, auditlogs.FieldResourceID)
+	if m.key_hash != nil {
+		fields = append(fields, apikeys.FieldKeyHash)
 	}
-	if m.metadata != nil {
-		fields = append(fields, auditlogs.FieldMetadata)
+	if m.scopes != nil {
+		fields = append(fields, apikeys.FieldScopes)
 	}
-	if m.changes != nil {
-		fields = append(fields, auditlogs.FieldChanges)
+	if m.expires_at != nil {
+		fields = append(fields, apikeys.FieldExpiresAt)
 	}
-	if m.ip_address != nil {
-		fields = append(fields, auditlogs.FieldIPAddress)
+	if m.last_used_at != nil {
+		fields = append(fields, apikeys.FieldLastUsedAt)
 	}
-	if m.user_agent != nil {
-		fields = append(fields, auditlogs.FieldUserAgent)
+	if m.last_used_ip != nil {
+		fields = append(fields, apikeys.FieldLastUsedIP)
+	}
+	if m.revoked_by != nil {
+		fields = append(fields, apikeys.FieldRevokedBy)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, apikeys.FieldRevokedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, auditlogs.FieldCreatedAt)
+		fields = append(fields, apikeys.FieldCreatedAt)
 	}
 	return fields
 }
@@ -639,25 +760,29 @@ func (m *AuditLogsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuditLogsMutation) Field(name string) (ent.Value, bool) {
+func (m *APIKeysMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case auditlogs.FieldActorID:
-		return m.ActorID()
-	case auditlogs.FieldActionType:
-		return m.ActionType()
-	case auditlogs.FieldResourceType:
-		return m.ResourceType()
-	case auditlogs.FieldResourceID:
-		return m.ResourceID()
-	case auditlogs.FieldMetadata:
-		return m.Metadata()
-	case auditlogs.FieldChanges:
-		return m.Changes()
-	case auditlogs.FieldIPAddress:
-		return m.IPAddress()
-	case auditlogs.FieldUserAgent:
-		return m.UserAgent()
-	case auditlogs.FieldCreatedAt:
+	case apikeys.FieldUserID:
+		return m.UserID()
+	case apikeys.FieldName:
+		return m.Name()
+	case apikeys.FieldPrefix:
+		return m.Prefix()
+	case apikeys.FieldKeyHash:
+		return m.KeyHash()
+	case apikeys.FieldScopes:
+		return m.Scopes()
+	case apikeys.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apikeys.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikeys.FieldLastUsedIP:
+		return m.LastUsedIP()
+	case apikeys.FieldRevokedBy:
+		return m.RevokedBy()
+	case apikeys.FieldRevokedAt:
+		return m.RevokedAt()
+	case apikeys.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -666,92 +791,110 @@ func (m *AuditLogsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuditLogsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APIKeysMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case auditlogs.FieldActorID:
-		return m.OldActorID(ctx)
-	case auditlogs.FieldActionType:
-		return m.OldActionType(ctx)
-	case auditlogs.FieldResourceType:
-		return m.OldResourceType(ctx)
-	case auditlogs.FieldResourceID:
-		return m.OldResourceID(ctx)
-	case auditlogs.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case auditlogs.FieldChanges:
-		return m.OldChanges(ctx)
-	case auditlogs.FieldIPAddress:
-		return m.OldIPAddress(ctx)
-	case auditlogs.FieldUserAgent:
-		return m.OldUserAgent(ctx)
-	case auditlogs.FieldCreatedAt:
+	case apikeys.FieldUserID:
+		return m.OldUserID(ctx)
+	case apikeys.FieldName:
+		return m.OldName(ctx)
+	case apikeys.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikeys.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikeys.FieldScopes:
+		return m.OldScopes(ctx)
+	case apikeys.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apikeys.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikeys.FieldLastUsedIP:
+		return m.OldLastUsedIP(ctx)
+	case apikeys.FieldRevokedBy:
+		return m.OldRevokedBy(ctx)
+	case apikeys.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case apikeys.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuditLogs field %s", name)
+	return nil, fmt.Errorf("unknown APIKeys field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuditLogsMutation) SetField(name string, value ent.Value) error {
+func (m *APIKeysMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case auditlogs.FieldActorID:
+	case apikeys.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActorID(v)
+		m.SetUserID(v)
 		return nil
-	case auditlogs.FieldActionType:
+	case apikeys.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActionType(v)
+		m.SetName(v)
 		return nil
-	case auditlogs.FieldResourceType:
+	case apikeys.FieldPrefix:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResourceType(v)
+		m.SetPrefix(v)
 		return nil
-	case auditlogs.FieldResourceID:
+	case apikeys.FieldKeyHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResourceID(v)
+		m.SetKeyHash(v)
 		return nil
-	case auditlogs.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case apikeys.FieldScopes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetScopes(v)
 		return nil
-	case auditlogs.FieldChanges:
-		v, ok := value.(map[string]interface{})
+	case apikeys.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChanges(v)
+		m.SetExpiresAt(v)
 		return nil
-	case auditlogs.FieldIPAddress:
-		v, ok := value.(string)
+	case apikeys.FieldLastUsedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIPAddress(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case auditlogs.FieldUserAgent:
+	case apikeys.FieldLastUsedIP:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserAgent(v)
+		m.SetLastUsedIP(v)
 		return nil
-	case auditlogs.FieldCreatedAt:
+	case apikeys.FieldRevokedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedBy(v)
+		return nil
+	case apikeys.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case apikeys.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -759,206 +902,205 @@ func (m *AuditLogsMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLogs field %s", name)
+	return fmt.Errorf("unknown APIKeys field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuditLogsMutation) AddedFields() []string {
+func (m *APIKeysMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuditLogsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *APIKeysMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuditLogsMutation) AddField(name string, value ent.Value) error {
+func (m *APIKeysMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown AuditLogs numeric field %s", name)
+	return fmt.Errorf("unknown APIKeys numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuditLogsMutation) ClearedFields() []string {
+func (m *APIKeysMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(auditlogs.FieldActorID) {
-		fields = append(fields, auditlogs.FieldActorID)
+	if m.FieldCleared(apikeys.FieldExpiresAt) {
+		fields = append(fields, apikeys.FieldExpiresAt)
 	}
-	if m.FieldCleared(auditlogs.FieldResourceID) {
-		fields = append(fields, auditlogs.FieldResourceID)
-	}
-	if m.FieldCleared(auditlogs.FieldMetadata) {
-		fields = append(fields, auditlogs.FieldMetadata)
+	if m.FieldCleared(apikeys.FieldLastUsedAt) {
+		fields = append(fields, apikeys.FieldLastUsedAt)
 	}
-	if m.FieldCleared(auditlogs.FieldChanges) {
-		fields = append(fields, auditlogs.FieldChanges)
+	if m.FieldCleared(apikeys.FieldLastUsedIP) {
+		fields = append(fields, apikeys.FieldLastUsedIP)
 	}
-	if m.FieldCleared(auditlogs.FieldIPAddress) {
-		fields = append(fields, auditlogs.FieldIPAddress)
+	if m.FieldCleared(apikeys.FieldRevokedBy) {
+		fields = append(fields, apikeys.FieldRevokedBy)
 	}
-	if m.FieldCleared(auditlogs.FieldUserAgent) {
-		fields = append(fields, auditlogs.FieldUserAgent)
+	if m.FieldCleared(apikeys.FieldRevokedAt) {
+		fields = append(fields, apikeys.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuditLogsMutation) FieldCleared(name string) bool {
+func (m *APIKeysMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuditLogsMutation) ClearField(name string) error {
+func (m *APIKeysMutation) ClearField(name string) error {
 	switch name {
-	case auditlogs.FieldActorID:
-		m.ClearActorID()
-		return nil
-	case auditlogs.FieldResourceID:
-		m.ClearResourceID()
+	case apikeys.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
-	case auditlogs.FieldMetadata:
-		m.ClearMetadata()
+	case apikeys.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
-	case auditlogs.FieldChanges:
-		m.ClearChanges()
+	case apikeys.FieldLastUsedIP:
+		m.ClearLastUsedIP()
 		return nil
-	case auditlogs.FieldIPAddress:
-		m.ClearIPAddress()
+	case apikeys.FieldRevokedBy:
+		m.ClearRevokedBy()
 		return nil
-	case auditlogs.FieldUserAgent:
-		m.ClearUserAgent()
+	case apikeys.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLogs nullable field %s", name)
+	return fmt.Errorf("unknown APIKeys nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuditLogsMutation) ResetField(name string) error {
+func (m *APIKeysMutation) ResetField(name string) error {
 	switch name {
-	case auditlogs.FieldActorID:
-		m.ResetActorID()
+	case apikeys.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case auditlogs.FieldActionType:
-		m.ResetActionType()
+	case apikeys.FieldName:
+		m.ResetName()
 		return nil
-	case auditlogs.FieldResourceType:
-		m.ResetResourceType()
+	case apikeys.FieldPrefix:
+		m.ResetPrefix()
 		return nil
-	case auditlogs.FieldResourceID:
-		m.ResetResourceID()
+	case apikeys.FieldKeyHash:
+		m.ResetKeyHash()
 		return nil
-	case auditlogs.FieldMetadata:
-		m.ResetMetadata()
+	case apikeys.FieldScopes:
+		m.ResetScopes()
 		return nil
-	case auditlogs.FieldChanges:
-		m.ResetChanges()
+	case apikeys.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case auditlogs.FieldIPAddress:
-		m.ResetIPAddress()
+	case apikeys.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
-	case auditlogs.FieldUserAgent:
-		m.ResetUserAgent()
+	case apikeys.FieldLastUsedIP:
+		m.ResetLastUsedIP()
 		return nil
-	case auditlogs.FieldCreatedAt:
+	case apikeys.FieldRevokedBy:
+		m.ResetRevokedBy()
+		return nil
+	case apikeys.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case apikeys.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLogs field %s", name)
+	return fmt.Errorf("unknown APIKeys field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuditLogsMutation) AddedEdges() []string {
+func (m *APIKeysMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuditLogsMutation) AddedIDs(name string) []ent.Value {
+func (m *APIKeysMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuditLogsMutation) RemovedEdges() []string {
+func (m *APIKeysMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuditLogsMutation) RemovedIDs(name string) []ent.Value {
+func (m *APIKeysMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuditLogsMutation) ClearedEdges() []string {
+func (m *APIKeysMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuditLogsMutation) EdgeCleared(name string) bool {
+func (m *APIKeysMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuditLogsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuditLogs unique edge %s", name)
+func (m *APIKeysMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown APIKeys unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuditLogsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuditLogs edge %s", name)
+func (m *APIKeysMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown APIKeys edge %s", name)
 }
 
-// EmailLogsMutation represents an operation that mutates the EmailLogs nodes in the graph.
-type EmailLogsMutation struct {
+// AuditLogsMutation represents an operation that mutates the AuditLogs nodes in the graph.
+type AuditLogsMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	user_id             *uuid.UUID
-	recipient           *string
-	email_type          *string
-	subject             *string
-	status              *string
-	provider            *string
-	provider_message_id *string
-	metadata            *map[string]interface{}
-	error_message       *string
-	sent_at             *time.Time
-	delivered_at        *time.Time
-	clearedFields       map[string]struct{}
-	done                bool
-	oldValue            func(context.Context) (*EmailLogs, error)
-	predicates          []predicate.EmailLogs
-}
-
-var _ ent.Mutation = (*EmailLogsMutation)(nil)
-
-// emaillogsOption allows management of the mutation configuration using functional options.
-type emaillogsOption func(*EmailLogsMutation)
-
-// newEmailLogsMutation creates new mutation for the EmailLogs entity.
-func newEmailLogsMutation(c config, op Op, opts ...emaillogsOption) *EmailLogsMutation {
-	m := &EmailLogsMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeEmailLogs,
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	actor_id      *uuid.UUID
+	action_type   *string
+	resource_type *string
+	resource_id   *string
+	metadata      *map[string]interface{}
+	changes       *map[string]interface{}
+	org_id        *uuid.UUID
+	ip_address    *string
+	user_agent    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditLogs, error)
+	predicates    []predicate.AuditLogs
+}
+
+var _ ent.Mutation = (*AuditLogsMutation)(nil)
+
+// auditlogsOption allows management of the mutation configuration using functional options.
+type auditlogsOption func(*AuditLogsMutation)
+
+// newAuditLogsMutation creates new mutation for the AuditLogs entity.
+func newAuditLogsMutation(c config, op Op, opts ...auditlogsOption) *AuditLogsMutation {
+	m := &AuditLogsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditLogs,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -967,20 +1109,20 @@ func newEmailLogsMutation(c config, op Op, opts ...emaillogsOption) *EmailLogsMu
 	return m
 }
 
-// withEmailLogsID sets the ID field of the mutation.
-func withEmailLogsID(id uuid.UUID) emaillogsOption {
-	return func(m *EmailLogsMutation) {
+// withAuditLogsID sets the ID field of the mutation.
+func withAuditLogsID(id uuid.UUID) auditlogsOption {
+	return func(m *AuditLogsMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EmailLogs
+			value *AuditLogs
 		)
-		m.oldValue = func(ctx context.Context) (*EmailLogs, error) {
+		m.oldValue = func(ctx context.Context) (*AuditLogs, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EmailLogs.Get(ctx, id)
+					value, err = m.Client().AuditLogs.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -989,10 +1131,10 @@ func withEmailLogsID(id uuid.UUID) emaillogsOption {
 	}
 }
 
-// withEmailLogs sets the old EmailLogs of the mutation.
-func withEmailLogs(node *EmailLogs) emaillogsOption {
-	return func(m *EmailLogsMutation) {
-		m.oldValue = func(context.Context) (*EmailLogs, error) {
+// withAuditLogs sets the old AuditLogs of the mutation.
+func withAuditLogs(node *AuditLogs) auditlogsOption {
+	return func(m *AuditLogsMutation) {
+		m.oldValue = func(context.Context) (*AuditLogs, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1001,7 +1143,7 @@ func withEmailLogs(node *EmailLogs) emaillogsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EmailLogsMutation) Client() *Client {
+func (m AuditLogsMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1009,7 +1151,7 @@ func (m EmailLogsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EmailLogsMutation) Tx() (*Tx, error) {
+func (m AuditLogsMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1019,14 +1161,14 @@ func (m EmailLogsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EmailLogs entities.
-func (m *EmailLogsMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AuditLogs entities.
+func (m *AuditLogsMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EmailLogsMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuditLogsMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1037,7 +1179,7 @@ func (m *EmailLogsMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EmailLogsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AuditLogsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1046,553 +1188,527 @@ func (m *EmailLogsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EmailLogs.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditLogs.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *EmailLogsMutation) SetUserID(u uuid.UUID) {
-	m.user_id = &u
+// SetActorID sets the "actor_id" field.
+func (m *AuditLogsMutation) SetActorID(u uuid.UUID) {
+	m.actor_id = &u
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *EmailLogsMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user_id
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *AuditLogsMutation) ActorID() (r uuid.UUID, exists bool) {
+	v := m.actor_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the EmailLogs entity.
-// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldActorID returns the old "actor_id" field's value of the AuditLogs entity.
+// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailLogsMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *AuditLogsMutation) OldActorID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldActorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.ActorID, nil
 }
 
-// ClearUserID clears the value of the "user_id" field.
-func (m *EmailLogsMutation) ClearUserID() {
-	m.user_id = nil
-	m.clearedFields[emaillogs.FieldUserID] = struct{}{}
+// ClearActorID clears the value of the "actor_id" field.
+func (m *AuditLogsMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[auditlogs.FieldActorID] = struct{}{}
 }
 
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *EmailLogsMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[emaillogs.FieldUserID]
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *AuditLogsMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[auditlogs.FieldActorID]
 	return ok
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *EmailLogsMutation) ResetUserID() {
-	m.user_id = nil
-	delete(m.clearedFields, emaillogs.FieldUserID)
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *AuditLogsMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, auditlogs.FieldActorID)
 }
 
-// SetRecipient sets the "recipient" field.
-func (m *EmailLogsMutation) SetRecipient(s string) {
-	m.recipient = &s
+// SetActionType sets the "action_type" field.
+func (m *AuditLogsMutation) SetActionType(s string) {
+	m.action_type = &s
 }
 
-// Recipient returns the value of the "recipient" field in the mutation.
-func (m *EmailLogsMutation) Recipient() (r string, exists bool) {
-	v := m.recipient
+// ActionType returns the value of the "action_type" field in the mutation.
+func (m *AuditLogsMutation) ActionType() (r string, exists bool) {
+	v := m.action_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRecipient returns the old "recipient" field's value of the EmailLogs entity.
-// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldActionType returns the old "action_type" field's value of the AuditLogs entity.
+// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailLogsMutation) OldRecipient(ctx context.Context) (v string, err error) {
+func (m *AuditLogsMutation) OldActionType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRecipient is only allowed on UpdateOne operations")
+		return v, errors.New("OldActionType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRecipient requires an ID field in the mutation")
+		return v, errors.New("OldActionType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRecipient: %w", err)
+		return v, fmt.Errorf("querying old value for OldActionType: %w", err)
 	}
-	return oldValue.Recipient, nil
+	return oldValue.ActionType, nil
 }
 
-// ResetRecipient resets all changes to the "recipient" field.
-func (m *EmailLogsMutation) ResetRecipient() {
-	m.recipient = nil
+// ResetActionType resets all changes to the "action_type" field.
+func (m *AuditLogsMutation) ResetActionType() {
+	m.action_type = nil
 }
 
-// SetEmailType sets the "email_type" field.
-func (m *EmailLogsMutation) SetEmailType(s string) {
-	m.email_type = &s
+// SetResourceType sets the "resource_type" field.
+func (m *AuditLogsMutation) SetResourceType(s string) {
+	m.resource_type = &s
 }
 
-// EmailType returns the value of the "email_type" field in the mutation.
-func (m *EmailLogsMutation) EmailType() (r string, exists bool) {
-	v := m.email_type
+// ResourceType returns the value of the "resource_type" field in the mutation.
+func (m *AuditLogsMutation) ResourceType() (r string, exists bool) {
+	v := m.resource_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmailType returns the old "email_type" field's value of the EmailLogs entity.
-// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceType returns the old "resource_type" field's value of the AuditLogs entity.
+// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailLogsMutation) OldEmailType(ctx context.Context) (v string, err error) {
+func (m *AuditLogsMutation) OldResourceType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmailType is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmailType requires an ID field in the mutation")
+		return v, errors.New("OldResourceType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmailType: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceType: %w", err)
 	}
-	return oldValue.EmailType, nil
+	return oldValue.ResourceType, nil
 }
 
-// ResetEmailType resets all changes to the "email_type" field.
-func (m *EmailLogsMutation) ResetEmailType() {
-	m.email_type = nil
+// ResetResourceType resets all changes to the "resource_type" field.
+func (m *AuditLogsMutation) ResetResourceType() {
+	m.resource_type = nil
 }
 
-// SetSubject sets the "subject" field.
-func (m *EmailLogsMutation) SetSubject(s string) {
-	m.subject = &s
+// SetResourceID sets the "resource_id" field.
+func (m *AuditLogsMutation) SetResourceID(s string) {
+	m.resource_id = &s
 }
 
-// Subject returns the value of the "subject" field in the mutation.
-func (m *EmailLogsMutation) Subject() (r string, exists bool) {
-	v := m.subject
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *AuditLogsMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubject returns the old "subject" field's value of the EmailLogs entity.
-// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceID returns the old "resource_id" field's value of the AuditLogs entity.
+// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailLogsMutation) OldSubject(ctx context.Context) (v string, err error) {
+func (m *AuditLogsMutation) OldResourceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubject requires an ID field in the mutation")
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
 	}
-	return oldValue.Subject, nil
+	return oldValue.ResourceID, nil
 }
 
-// ClearSubject clears the value of the "subject" field.
-func (m *EmailLogsMutation) ClearSubject() {
-	m.subject = nil
-	m.clearedFields[emaillogs.FieldSubject] = struct{}{}
+// ClearResourceID clears the value of the "resource_id" field.
+func (m *AuditLogsMutation) ClearResourceID() {
+	m.resource_id = nil
+	m.clearedFields[auditlogs.FieldResourceID] = struct{}{}
 }
 
-// SubjectCleared returns if the "subject" field was cleared in this mutation.
-func (m *EmailLogsMutation) SubjectCleared() bool {
-	_, ok := m.clearedFields[emaillogs.FieldSubject]
+// ResourceIDCleared returns if the "resource_id" field was cleared in this mutation.
+func (m *AuditLogsMutation) ResourceIDCleared() bool {
+	_, ok := m.clearedFields[auditlogs.FieldResourceID]
 	return ok
 }
 
-// ResetSubject resets all changes to the "subject" field.
-func (m *EmailLogsMutation) ResetSubject() {
-	m.subject = nil
-	delete(m.clearedFields, emaillogs.FieldSubject)
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *AuditLogsMutation) ResetResourceID() {
+	m.resource_id = nil
+	delete(m.clearedFields, auditlogs.FieldResourceID)
 }
 
-// SetStatus sets the "status" field.
-func (m *EmailLogsMutation) SetStatus(s string) {
-	m.status = &s
+// SetMetadata sets the "metadata" field.
+func (m *AuditLogsMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *EmailLogsMutation) Status() (r string, exists bool) {
-	v := m.status
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *AuditLogsMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the EmailLogs entity.
-// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the AuditLogs entity.
+// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailLogsMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *AuditLogsMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Metadata, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *EmailLogsMutation) ResetStatus() {
-	m.status = nil
+// ClearMetadata clears the value of the "metadata" field.
+func (m *AuditLogsMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[auditlogs.FieldMetadata] = struct{}{}
 }
 
-// SetProvider sets the "provider" field.
-func (m *EmailLogsMutation) SetProvider(s string) {
-	m.provider = &s
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *AuditLogsMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[auditlogs.FieldMetadata]
+	return ok
 }
 
-// Provider returns the value of the "provider" field in the mutation.
-func (m *EmailLogsMutation) Provider() (r string, exists bool) {
-	v := m.provider
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *AuditLogsMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, auditlogs.FieldMetadata)
+}
+
+// SetChanges sets the "changes" field.
+func (m *AuditLogsMutation) SetChanges(value map[string]interface{}) {
+	m.changes = &value
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *AuditLogsMutation) Changes() (r map[string]interface{}, exists bool) {
+	v := m.changes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the EmailLogs entity.
-// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldChanges returns the old "changes" field's value of the AuditLogs entity.
+// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailLogsMutation) OldProvider(ctx context.Context) (v string, err error) {
+func (m *AuditLogsMutation) OldChanges(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
+		return v, errors.New("OldChanges requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
 	}
-	return oldValue.Provider, nil
+	return oldValue.Changes, nil
 }
 
-// ResetProvider resets all changes to the "provider" field.
-func (m *EmailLogsMutation) ResetProvider() {
-	m.provider = nil
+// ClearChanges clears the value of the "changes" field.
+func (m *AuditLogsMutation) ClearChanges() {
+	m.changes = nil
+	m.clearedFields[auditlogs.FieldChanges] = struct{}{}
 }
 
-// SetProviderMessageID sets the "provider_message_id" field.
-func (m *EmailLogsMutation) SetProviderMessageID(s string) {
-	m.provider_message_id = &s
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *AuditLogsMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[auditlogs.FieldChanges]
+	return ok
 }
 
-// ProviderMessageID returns the value of the "provider_message_id" field in the mutation.
-func (m *EmailLogsMutation) ProviderMessageID() (r string, exists bool) {
-	v := m.provider_message_id
+// ResetChanges resets all changes to the "changes" field.
+func (m *AuditLogsMutation) ResetChanges() {
+	m.changes = nil
+	delete(m.clearedFields, auditlogs.FieldChanges)
+}
+
+// SetOrgID sets the "org_id" field.
+func (m *AuditLogsMutation) SetOrgID(u uuid.UUID) {
+	m.org_id = &u
+}
+
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *AuditLogsMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.org_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProviderMessageID returns the old "provider_message_id" field's value of the EmailLogs entity.
-// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldOrgID returns the old "org_id" field's value of the AuditLogs entity.
+// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailLogsMutation) OldProviderMessageID(ctx context.Context) (v string, err error) {
+func (m *AuditLogsMutation) OldOrgID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderMessageID is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderMessageID requires an ID field in the mutation")
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderMessageID: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
 	}
-	return oldValue.ProviderMessageID, nil
+	return oldValue.OrgID, nil
 }
 
-// ClearProviderMessageID clears the value of the "provider_message_id" field.
-func (m *EmailLogsMutation) ClearProviderMessageID() {
-	m.provider_message_id = nil
-	m.clearedFields[emaillogs.FieldProviderMessageID] = struct{}{}
+// ClearOrgID clears the value of the "org_id" field.
+func (m *AuditLogsMutation) ClearOrgID() {
+	m.org_id = nil
+	m.clearedFields[auditlogs.FieldOrgID] = struct{}{}
 }
 
-// ProviderMessageIDCleared returns if the "provider_message_id" field was cleared in this mutation.
-func (m *EmailLogsMutation) ProviderMessageIDCleared() bool {
-	_, ok := m.clearedFields[emaillogs.FieldProviderMessageID]
+// OrgIDCleared returns if the "org_id" field was cleared in this mutation.
+func (m *AuditLogsMutation) OrgIDCleared() bool {
+	_, ok := m.clearedFields[auditlogs.FieldOrgID]
 	return ok
 }
 
-// ResetProviderMessageID resets all changes to the "provider_message_id" field.
-func (m *EmailLogsMutation) ResetProviderMessageID() {
-	m.provider_message_id = nil
-	delete(m.clearedFields, emaillogs.FieldProviderMessageID)
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *AuditLogsMutation) ResetOrgID() {
+	m.org_id = nil
+	delete(m.clearedFields, auditlogs.FieldOrgID)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *EmailLogsMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetIPAddress sets the "ip_address" field.
+func (m *AuditLogsMutation) SetIPAddress(s string) {
+	m.ip_address = &s
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *EmailLogsMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *AuditLogsMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the EmailLogs entity.
-// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldIPAddress returns the old "ip_address" field's value of the AuditLogs entity.
+// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailLogsMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *AuditLogsMutation) OldIPAddress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.IPAddress, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *EmailLogsMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[emaillogs.FieldMetadata] = struct{}{}
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *AuditLogsMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[auditlogs.FieldIPAddress] = struct{}{}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *EmailLogsMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[emaillogs.FieldMetadata]
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *AuditLogsMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[auditlogs.FieldIPAddress]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *EmailLogsMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, emaillogs.FieldMetadata)
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *AuditLogsMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, auditlogs.FieldIPAddress)
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *EmailLogsMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetUserAgent sets the "user_agent" field.
+func (m *AuditLogsMutation) SetUserAgent(s string) {
+	m.user_agent = &s
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *EmailLogsMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *AuditLogsMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the EmailLogs entity.
-// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldUserAgent returns the old "user_agent" field's value of the AuditLogs entity.
+// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailLogsMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+func (m *AuditLogsMutation) OldUserAgent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
+	return oldValue.UserAgent, nil
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *EmailLogsMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[emaillogs.FieldErrorMessage] = struct{}{}
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *AuditLogsMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[auditlogs.FieldUserAgent] = struct{}{}
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *EmailLogsMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[emaillogs.FieldErrorMessage]
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *AuditLogsMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[auditlogs.FieldUserAgent]
 	return ok
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *EmailLogsMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, emaillogs.FieldErrorMessage)
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *AuditLogsMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, auditlogs.FieldUserAgent)
 }
 
-// SetSentAt sets the "sent_at" field.
-func (m *EmailLogsMutation) SetSentAt(t time.Time) {
-	m.sent_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditLogsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// SentAt returns the value of the "sent_at" field in the mutation.
-func (m *EmailLogsMutation) SentAt() (r time.Time, exists bool) {
-	v := m.sent_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditLogsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSentAt returns the old "sent_at" field's value of the EmailLogs entity.
-// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditLogs entity.
+// If the AuditLogs object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailLogsMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSentAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.SentAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSentAt resets all changes to the "sent_at" field.
-func (m *EmailLogsMutation) ResetSentAt() {
-	m.sent_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditLogsMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetDeliveredAt sets the "delivered_at" field.
-func (m *EmailLogsMutation) SetDeliveredAt(t time.Time) {
-	m.delivered_at = &t
+// Where appends a list predicates to the AuditLogsMutation builder.
+func (m *AuditLogsMutation) Where(ps ...predicate.AuditLogs) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// DeliveredAt returns the value of the "delivered_at" field in the mutation.
-func (m *EmailLogsMutation) DeliveredAt() (r time.Time, exists bool) {
-	v := m.delivered_at
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AuditLogsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditLogsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLogs, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldDeliveredAt returns the old "delivered_at" field's value of the EmailLogs entity.
-// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailLogsMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
-	}
-	return oldValue.DeliveredAt, nil
+// Op returns the operation name.
+func (m *AuditLogsMutation) Op() Op {
+	return m.op
 }
 
-// ClearDeliveredAt clears the value of the "delivered_at" field.
-func (m *EmailLogsMutation) ClearDeliveredAt() {
-	m.delivered_at = nil
-	m.clearedFields[emaillogs.FieldDeliveredAt] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *AuditLogsMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
-func (m *EmailLogsMutation) DeliveredAtCleared() bool {
-	_, ok := m.clearedFields[emaillogs.FieldDeliveredAt]
-	return ok
-}
-
-// ResetDeliveredAt resets all changes to the "delivered_at" field.
-func (m *EmailLogsMutation) ResetDeliveredAt() {
-	m.delivered_at = nil
-	delete(m.clearedFields, emaillogs.FieldDeliveredAt)
-}
-
-// Where appends a list predicates to the EmailLogsMutation builder.
-func (m *EmailLogsMutation) Where(ps ...predicate.EmailLogs) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the EmailLogsMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EmailLogsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EmailLogs, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *EmailLogsMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *EmailLogsMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (EmailLogs).
-func (m *EmailLogsMutation) Type() string {
+// Type returns the node type of this mutation (AuditLogs).
+func (m *AuditLogsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EmailLogsMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.user_id != nil {
-		fields = append(fields, emaillogs.FieldUserID)
-	}
-	if m.recipient != nil {
-		fields = append(fields, emaillogs.FieldRecipient)
+func (m *AuditLogsMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.actor_id != nil {
+		fields = append(fields, auditlogs.FieldActorID)
 	}
-	if m.email_type != nil {
-		fields = append(fields, emaillogs.FieldEmailType)
+	if m.action_type != nil {
+		fields = append(fields, auditlogs.FieldActionType)
 	}
-	if m.subject != nil {
-		fields = append(fields, emaillogs.FieldSubject)
+	if m.resource_type != nil {
+		fields = append(fields, auditlogs.FieldResourceType)
 	}
-	if m.status != nil {
-		fields = append(fields, emaillogs.FieldStatus)
+	if m.resource_id != nil {
+		fields = append(fields, auditlogs.FieldResourceID)
 	}
-	if m.provider != nil {
-		fields = append(fields, emaillogs.FieldProvider)
+	if m.metadata != nil {
+		fields = append(fields, auditlogs.FieldMetadata)
 	}
-	if m.provider_message_id != nil {
-		fields = append(fields, emaillogs.FieldProviderMessageID)
+	if m.changes != nil {
+		fields = append(fields, auditlogs.FieldChanges)
 	}
-	if m.metadata != nil {
-		fields = append(fields, emaillogs.FieldMetadata)
+	if m.org_id != nil {
+		fields = append(fields, auditlogs.FieldOrgID)
 	}
-	if m.error_message != nil {
-		fields = append(fields, emaillogs.FieldErrorMessage)
+	if m.ip_address != nil {
+		fields = append(fields, auditlogs.FieldIPAddress)
 	}
-	if m.sent_at != nil {
-		fields = append(fields, emaillogs.FieldSentAt)
+	if m.user_agent != nil {
+		fields = append(fields, auditlogs.FieldUserAgent)
 	}
-	if m.delivered_at != nil {
-		fields = append(fields, emaillogs.FieldDeliveredAt)
+	if m.created_at != nil {
+		fields = append(fields, auditlogs.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1600,30 +1716,28 @@ func (m *EmailLogsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EmailLogsMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditLogsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case emaillogs.FieldUserID:
-		return m.UserID()
-	case emaillogs.FieldRecipient:
-		return m.Recipient()
-	case emaillogs.FieldEmailType:
-		return m.EmailType()
-	case emaillogs.FieldSubject:
-		return m.Subject()
-	case emaillogs.FieldStatus:
-		return m.Status()
-	case emaillogs.FieldProvider:
-		return m.Provider()
-	case emaillogs.FieldProviderMessageID:
-		return m.ProviderMessageID()
-	case emaillogs.FieldMetadata:
+	case auditlogs.FieldActorID:
+		return m.ActorID()
+	case auditlogs.FieldActionType:
+		return m.ActionType()
+	case auditlogs.FieldResourceType:
+		return m.ResourceType()
+	case auditlogs.FieldResourceID:
+		return m.ResourceID()
+	case auditlogs.FieldMetadata:
 		return m.Metadata()
-	case emaillogs.FieldErrorMessage:
-		return m.ErrorMessage()
-	case emaillogs.FieldSentAt:
-		return m.SentAt()
-	case emaillogs.FieldDeliveredAt:
-		return m.DeliveredAt()
+	case auditlogs.FieldChanges:
+		return m.Changes()
+	case auditlogs.FieldOrgID:
+		return m.OrgID()
+	case auditlogs.FieldIPAddress:
+		return m.IPAddress()
+	case auditlogs.FieldUserAgent:
+		return m.UserAgent()
+	case auditlogs.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1631,320 +1745,313 @@ func (m *EmailLogsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EmailLogsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditLogsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case emaillogs.FieldUserID:
-		return m.OldUserID(ctx)
-	case emaillogs.FieldRecipient:
-		return m.OldRecipient(ctx)
-	case emaillogs.FieldEmailType:
-		return m.OldEmailType(ctx)
-	case emaillogs.FieldSubject:
-		return m.OldSubject(ctx)
-	case emaillogs.FieldStatus:
-		return m.OldStatus(ctx)
-	case emaillogs.FieldProvider:
-		return m.OldProvider(ctx)
-	case emaillogs.FieldProviderMessageID:
-		return m.OldProviderMessageID(ctx)
-	case emaillogs.FieldMetadata:
+	case auditlogs.FieldActorID:
+		return m.OldActorID(ctx)
+	case auditlogs.FieldActionType:
+		return m.OldActionType(ctx)
+	case auditlogs.FieldResourceType:
+		return m.OldResourceType(ctx)
+	case auditlogs.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case auditlogs.FieldMetadata:
 		return m.OldMetadata(ctx)
-	case emaillogs.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case emaillogs.FieldSentAt:
-		return m.OldSentAt(ctx)
-	case emaillogs.FieldDeliveredAt:
-		return m.OldDeliveredAt(ctx)
+	case auditlogs.FieldChanges:
+		return m.OldChanges(ctx)
+	case auditlogs.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case auditlogs.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case auditlogs.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case auditlogs.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown EmailLogs field %s", name)
+	return nil, fmt.Errorf("unknown AuditLogs field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EmailLogsMutation) SetField(name string, value ent.Value) error {
+func (m *AuditLogsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case emaillogs.FieldUserID:
+	case auditlogs.FieldActorID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
-		return nil
-	case emaillogs.FieldRecipient:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRecipient(v)
+		m.SetActorID(v)
 		return nil
-	case emaillogs.FieldEmailType:
+	case auditlogs.FieldActionType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmailType(v)
+		m.SetActionType(v)
 		return nil
-	case emaillogs.FieldSubject:
+	case auditlogs.FieldResourceType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubject(v)
+		m.SetResourceType(v)
 		return nil
-	case emaillogs.FieldStatus:
+	case auditlogs.FieldResourceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetResourceID(v)
 		return nil
-	case emaillogs.FieldProvider:
-		v, ok := value.(string)
+	case auditlogs.FieldMetadata:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProvider(v)
+		m.SetMetadata(v)
 		return nil
-	case emaillogs.FieldProviderMessageID:
-		v, ok := value.(string)
+	case auditlogs.FieldChanges:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProviderMessageID(v)
+		m.SetChanges(v)
 		return nil
-	case emaillogs.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case auditlogs.FieldOrgID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetOrgID(v)
 		return nil
-	case emaillogs.FieldErrorMessage:
+	case auditlogs.FieldIPAddress:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetIPAddress(v)
 		return nil
-	case emaillogs.FieldSentAt:
-		v, ok := value.(time.Time)
+	case auditlogs.FieldUserAgent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSentAt(v)
+		m.SetUserAgent(v)
 		return nil
-	case emaillogs.FieldDeliveredAt:
+	case auditlogs.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeliveredAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EmailLogs field %s", name)
+	return fmt.Errorf("unknown AuditLogs field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EmailLogsMutation) AddedFields() []string {
+func (m *AuditLogsMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EmailLogsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditLogsMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EmailLogsMutation) AddField(name string, value ent.Value) error {
+func (m *AuditLogsMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown EmailLogs numeric field %s", name)
+	return fmt.Errorf("unknown AuditLogs numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EmailLogsMutation) ClearedFields() []string {
+func (m *AuditLogsMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(emaillogs.FieldUserID) {
-		fields = append(fields, emaillogs.FieldUserID)
+	if m.FieldCleared(auditlogs.FieldActorID) {
+		fields = append(fields, auditlogs.FieldActorID)
 	}
-	if m.FieldCleared(emaillogs.FieldSubject) {
-		fields = append(fields, emaillogs.FieldSubject)
+	if m.FieldCleared(auditlogs.FieldResourceID) {
+		fields = append(fields, auditlogs.FieldResourceID)
 	}
-	if m.FieldCleared(emaillogs.FieldProviderMessageID) {
-		fields = append(fields, emaillogs.FieldProviderMessageID)
+	if m.FieldCleared(auditlogs.FieldMetadata) {
+		fields = append(fields, auditlogs.FieldMetadata)
 	}
-	if m.FieldCleared(emaillogs.FieldMetadata) {
-		fields = append(fields, emaillogs.FieldMetadata)
+	if m.FieldCleared(auditlogs.FieldChanges) {
+		fields = append(fields, auditlogs.FieldChanges)
 	}
-	if m.FieldCleared(emaillogs.FieldErrorMessage) {
-		fields = append(fields, emaillogs.FieldErrorMessage)
+	if m.FieldCleared(auditlogs.FieldOrgID) {
+		fields = append(fields, auditlogs.FieldOrgID)
 	}
-	if m.FieldCleared(emaillogs.FieldDeliveredAt) {
-		fields = append(fields, emaillogs.FieldDeliveredAt)
+	if m.FieldCleared(auditlogs.FieldIPAddress) {
+		fields = append(fields, auditlogs.FieldIPAddress)
 	}
-	return fields
-}
+	if m.FieldCleared(auditlogs.FieldUserAgent) {
+		fields = append(fields, auditlogs.FieldUserAgent)
+	}
+	return fields
+}
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EmailLogsMutation) FieldCleared(name string) bool {
+func (m *AuditLogsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EmailLogsMutation) ClearField(name string) error {
+func (m *AuditLogsMutation) ClearField(name string) error {
 	switch name {
-	case emaillogs.FieldUserID:
-		m.ClearUserID()
-		return nil
-	case emaillogs.FieldSubject:
-		m.ClearSubject()
+	case auditlogs.FieldActorID:
+		m.ClearActorID()
 		return nil
-	case emaillogs.FieldProviderMessageID:
-		m.ClearProviderMessageID()
+	case auditlogs.FieldResourceID:
+		m.ClearResourceID()
 		return nil
-	case emaillogs.FieldMetadata:
+	case auditlogs.FieldMetadata:
 		m.ClearMetadata()
 		return nil
-	case emaillogs.FieldErrorMessage:
-		m.ClearErrorMessage()
+	case auditlogs.FieldChanges:
+		m.ClearChanges()
 		return nil
-	case emaillogs.FieldDeliveredAt:
-		m.ClearDeliveredAt()
+	case auditlogs.FieldOrgID:
+		m.ClearOrgID()
+		return nil
+	case auditlogs.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	case auditlogs.FieldUserAgent:
+		m.ClearUserAgent()
 		return nil
 	}
-	return fmt.Errorf("unknown EmailLogs nullable field %s", name)
+	return fmt.Errorf("unknown AuditLogs nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EmailLogsMutation) ResetField(name string) error {
+func (m *AuditLogsMutation) ResetField(name string) error {
 	switch name {
-	case emaillogs.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case emaillogs.FieldRecipient:
-		m.ResetRecipient()
+	case auditlogs.FieldActorID:
+		m.ResetActorID()
 		return nil
-	case emaillogs.FieldEmailType:
-		m.ResetEmailType()
+	case auditlogs.FieldActionType:
+		m.ResetActionType()
 		return nil
-	case emaillogs.FieldSubject:
-		m.ResetSubject()
+	case auditlogs.FieldResourceType:
+		m.ResetResourceType()
 		return nil
-	case emaillogs.FieldStatus:
-		m.ResetStatus()
+	case auditlogs.FieldResourceID:
+		m.ResetResourceID()
 		return nil
-	case emaillogs.FieldProvider:
-		m.ResetProvider()
+	case auditlogs.FieldMetadata:
+		m.ResetMetadata()
 		return nil
-	case emaillogs.FieldProviderMessageID:
-		m.ResetProviderMessageID()
+	case auditlogs.FieldChanges:
+		m.ResetChanges()
 		return nil
-	case emaillogs.FieldMetadata:
-		m.ResetMetadata()
+	case auditlogs.FieldOrgID:
+		m.ResetOrgID()
 		return nil
-	case emaillogs.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case auditlogs.FieldIPAddress:
+		m.ResetIPAddress()
 		return nil
-	case emaillogs.FieldSentAt:
-		m.ResetSentAt()
+	case auditlogs.FieldUserAgent:
+		m.ResetUserAgent()
 		return nil
-	case emaillogs.FieldDeliveredAt:
-		m.ResetDeliveredAt()
+	case auditlogs.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown EmailLogs field %s", name)
+	return fmt.Errorf("unknown AuditLogs field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EmailLogsMutation) AddedEdges() []string {
+func (m *AuditLogsMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EmailLogsMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditLogsMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EmailLogsMutation) RemovedEdges() []string {
+func (m *AuditLogsMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EmailLogsMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditLogsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EmailLogsMutation) ClearedEdges() []string {
+func (m *AuditLogsMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EmailLogsMutation) EdgeCleared(name string) bool {
+func (m *AuditLogsMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EmailLogsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown EmailLogs unique edge %s", name)
+func (m *AuditLogsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLogs unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EmailLogsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown EmailLogs edge %s", name)
+func (m *AuditLogsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLogs edge %s", name)
 }
 
-// EmailVerificationsMutation represents an operation that mutates the EmailVerifications nodes in the graph.
-type EmailVerificationsMutation struct {
+// ConsentAcceptancesMutation represents an operation that mutates the ConsentAcceptances nodes in the graph.
+type ConsentAcceptancesMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
 	user_id       *uuid.UUID
-	email         *string
-	token         *string
-	expires_at    *time.Time
-	is_used       *bool
-	used_at       *time.Time
+	document_id   *uuid.UUID
+	document_type *string
+	version       *string
 	ip_address    *string
-	created_at    *time.Time
+	user_agent    *string
+	accepted_at   *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*EmailVerifications, error)
-	predicates    []predicate.EmailVerifications
+	oldValue      func(context.Context) (*ConsentAcceptances, error)
+	predicates    []predicate.ConsentAcceptances
 }
 
-var _ ent.Mutation = (*EmailVerificationsMutation)(nil)
+var _ ent.Mutation = (*ConsentAcceptancesMutation)(nil)
 
-// emailverificationsOption allows management of the mutation configuration using functional options.
-type emailverificationsOption func(*EmailVerificationsMutation)
+// consentacceptancesOption allows management of the mutation configuration using functional options.
+type consentacceptancesOption func(*ConsentAcceptancesMutation)
 
-// newEmailVerificationsMutation creates new mutation for the EmailVerifications entity.
-func newEmailVerificationsMutation(c config, op Op, opts ...emailverificationsOption) *EmailVerificationsMutation {
-	m := &EmailVerificationsMutation{
+// newConsentAcceptancesMutation creates new mutation for the ConsentAcceptances entity.
+func newConsentAcceptancesMutation(c config, op Op, opts ...consentacceptancesOption) *ConsentAcceptancesMutation {
+	m := &ConsentAcceptancesMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEmailVerifications,
+		typ:           TypeConsentAcceptances,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1953,20 +2060,20 @@ func newEmailVerificationsMutation(c config, op Op, opts ...emailverificationsOp
 	return m
 }
 
-// withEmailVerificationsID sets the ID field of the mutation.
-func withEmailVerificationsID(id uuid.UUID) emailverificationsOption {
-	return func(m *EmailVerificationsMutation) {
+// withConsentAcceptancesID sets the ID field of the mutation.
+func withConsentAcceptancesID(id uuid.UUID) consentacceptancesOption {
+	return func(m *ConsentAcceptancesMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EmailVerifications
+			value *ConsentAcceptances
 		)
-		m.oldValue = func(ctx context.Context) (*EmailVerifications, error) {
+		m.oldValue = func(ctx context.Context) (*ConsentAcceptances, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EmailVerifications.Get(ctx, id)
+					value, err = m.Client().ConsentAcceptances.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1975,10 +2082,10 @@ func withEmailVerificationsID(id uuid.UUID) emailverificationsOption {
 	}
 }
 
-// withEmailVerifications sets the old EmailVerifications of the mutation.
-func withEmailVerifications(node *EmailVerifications) emailverificationsOption {
-	return func(m *EmailVerificationsMutation) {
-		m.oldValue = func(context.Context) (*EmailVerifications, error) {
+// withConsentAcceptances sets the old ConsentAcceptances of the mutation.
+func withConsentAcceptances(node *ConsentAcceptances) consentacceptancesOption {
+	return func(m *ConsentAcceptancesMutation) {
+		m.oldValue = func(context.Context) (*ConsentAcceptances, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1987,7 +2094,7 @@ func withEmailVerifications(node *EmailVerifications) emailverificationsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EmailVerificationsMutation) Client() *Client {
+func (m ConsentAcceptancesMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1995,7 +2102,7 @@ func (m EmailVerificationsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EmailVerificationsMutation) Tx() (*Tx, error) {
+func (m ConsentAcceptancesMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2005,14 +2112,14 @@ func (m EmailVerificationsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EmailVerifications entities.
-func (m *EmailVerificationsMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ConsentAcceptances entities.
+func (m *ConsentAcceptancesMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EmailVerificationsMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ConsentAcceptancesMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2023,7 +2130,7 @@ func (m *EmailVerificationsMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EmailVerificationsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ConsentAcceptancesMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2032,19 +2139,19 @@ func (m *EmailVerificationsMutation) IDs(ctx context.Context) ([]uuid.UUID, erro
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EmailVerifications.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConsentAcceptances.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUserID sets the "user_id" field.
-func (m *EmailVerificationsMutation) SetUserID(u uuid.UUID) {
+func (m *ConsentAcceptancesMutation) SetUserID(u uuid.UUID) {
 	m.user_id = &u
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *EmailVerificationsMutation) UserID() (r uuid.UUID, exists bool) {
+func (m *ConsentAcceptancesMutation) UserID() (r uuid.UUID, exists bool) {
 	v := m.user_id
 	if v == nil {
 		return
@@ -2052,10 +2159,10 @@ func (m *EmailVerificationsMutation) UserID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the EmailVerifications entity.
-// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ConsentAcceptances entity.
+// If the ConsentAcceptances object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ConsentAcceptancesMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -2070,297 +2177,261 @@ func (m *EmailVerificationsMutation) OldUserID(ctx context.Context) (v uuid.UUID
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *EmailVerificationsMutation) ResetUserID() {
+func (m *ConsentAcceptancesMutation) ResetUserID() {
 	m.user_id = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *EmailVerificationsMutation) SetEmail(s string) {
-	m.email = &s
+// SetDocumentID sets the "document_id" field.
+func (m *ConsentAcceptancesMutation) SetDocumentID(u uuid.UUID) {
+	m.document_id = &u
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *EmailVerificationsMutation) Email() (r string, exists bool) {
-	v := m.email
+// DocumentID returns the value of the "document_id" field in the mutation.
+func (m *ConsentAcceptancesMutation) DocumentID() (r uuid.UUID, exists bool) {
+	v := m.document_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the EmailVerifications entity.
-// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// OldDocumentID returns the old "document_id" field's value of the ConsentAcceptances entity.
+// If the ConsentAcceptances object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationsMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *ConsentAcceptancesMutation) OldDocumentID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldDocumentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldDocumentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldDocumentID: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.DocumentID, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *EmailVerificationsMutation) ResetEmail() {
-	m.email = nil
+// ResetDocumentID resets all changes to the "document_id" field.
+func (m *ConsentAcceptancesMutation) ResetDocumentID() {
+	m.document_id = nil
 }
 
-// SetToken sets the "token" field.
-func (m *EmailVerificationsMutation) SetToken(s string) {
-	m.token = &s
+// SetDocumentType sets the "document_type" field.
+func (m *ConsentAcceptancesMutation) SetDocumentType(s string) {
+	m.document_type = &s
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *EmailVerificationsMutation) Token() (r string, exists bool) {
-	v := m.token
+// DocumentType returns the value of the "document_type" field in the mutation.
+func (m *ConsentAcceptancesMutation) DocumentType() (r string, exists bool) {
+	v := m.document_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the EmailVerifications entity.
-// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// OldDocumentType returns the old "document_type" field's value of the ConsentAcceptances entity.
+// If the ConsentAcceptances object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationsMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *ConsentAcceptancesMutation) OldDocumentType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldDocumentType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldDocumentType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldDocumentType: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.DocumentType, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *EmailVerificationsMutation) ResetToken() {
-	m.token = nil
+// ResetDocumentType resets all changes to the "document_type" field.
+func (m *ConsentAcceptancesMutation) ResetDocumentType() {
+	m.document_type = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *EmailVerificationsMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetVersion sets the "version" field.
+func (m *ConsentAcceptancesMutation) SetVersion(s string) {
+	m.version = &s
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *EmailVerificationsMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// Version returns the value of the "version" field in the mutation.
+func (m *ConsentAcceptancesMutation) Version() (r string, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the EmailVerifications entity.
-// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the ConsentAcceptances entity.
+// If the ConsentAcceptances object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationsMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConsentAcceptancesMutation) OldVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.Version, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *EmailVerificationsMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ResetVersion resets all changes to the "version" field.
+func (m *ConsentAcceptancesMutation) ResetVersion() {
+	m.version = nil
 }
 
-// SetIsUsed sets the "is_used" field.
-func (m *EmailVerificationsMutation) SetIsUsed(b bool) {
-	m.is_used = &b
+// SetIPAddress sets the "ip_address" field.
+func (m *ConsentAcceptancesMutation) SetIPAddress(s string) {
+	m.ip_address = &s
 }
 
-// IsUsed returns the value of the "is_used" field in the mutation.
-func (m *EmailVerificationsMutation) IsUsed() (r bool, exists bool) {
-	v := m.is_used
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *ConsentAcceptancesMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsUsed returns the old "is_used" field's value of the EmailVerifications entity.
-// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// OldIPAddress returns the old "ip_address" field's value of the ConsentAcceptances entity.
+// If the ConsentAcceptances object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationsMutation) OldIsUsed(ctx context.Context) (v bool, err error) {
+func (m *ConsentAcceptancesMutation) OldIPAddress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsUsed requires an ID field in the mutation")
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsUsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
 	}
-	return oldValue.IsUsed, nil
+	return oldValue.IPAddress, nil
 }
 
-// ResetIsUsed resets all changes to the "is_used" field.
-func (m *EmailVerificationsMutation) ResetIsUsed() {
-	m.is_used = nil
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *ConsentAcceptancesMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[consentacceptances.FieldIPAddress] = struct{}{}
 }
 
-// SetUsedAt sets the "used_at" field.
-func (m *EmailVerificationsMutation) SetUsedAt(t time.Time) {
-	m.used_at = &t
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *ConsentAcceptancesMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[consentacceptances.FieldIPAddress]
+	return ok
 }
 
-// UsedAt returns the value of the "used_at" field in the mutation.
-func (m *EmailVerificationsMutation) UsedAt() (r time.Time, exists bool) {
-	v := m.used_at
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *ConsentAcceptancesMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, consentacceptances.FieldIPAddress)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *ConsentAcceptancesMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *ConsentAcceptancesMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsedAt returns the old "used_at" field's value of the EmailVerifications entity.
-// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// OldUserAgent returns the old "user_agent" field's value of the ConsentAcceptances entity.
+// If the ConsentAcceptances object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationsMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ConsentAcceptancesMutation) OldUserAgent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
 	}
-	return oldValue.UsedAt, nil
+	return oldValue.UserAgent, nil
 }
 
-// ClearUsedAt clears the value of the "used_at" field.
-func (m *EmailVerificationsMutation) ClearUsedAt() {
-	m.used_at = nil
-	m.clearedFields[emailverifications.FieldUsedAt] = struct{}{}
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *ConsentAcceptancesMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[consentacceptances.FieldUserAgent] = struct{}{}
 }
 
-// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
-func (m *EmailVerificationsMutation) UsedAtCleared() bool {
-	_, ok := m.clearedFields[emailverifications.FieldUsedAt]
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *ConsentAcceptancesMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[consentacceptances.FieldUserAgent]
 	return ok
 }
 
-// ResetUsedAt resets all changes to the "used_at" field.
-func (m *EmailVerificationsMutation) ResetUsedAt() {
-	m.used_at = nil
-	delete(m.clearedFields, emailverifications.FieldUsedAt)
-}
-
-// SetIPAddress sets the "ip_address" field.
-func (m *EmailVerificationsMutation) SetIPAddress(s string) {
-	m.ip_address = &s
-}
-
-// IPAddress returns the value of the "ip_address" field in the mutation.
-func (m *EmailVerificationsMutation) IPAddress() (r string, exists bool) {
-	v := m.ip_address
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIPAddress returns the old "ip_address" field's value of the EmailVerifications entity.
-// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationsMutation) OldIPAddress(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIPAddress requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
-	}
-	return oldValue.IPAddress, nil
-}
-
-// ClearIPAddress clears the value of the "ip_address" field.
-func (m *EmailVerificationsMutation) ClearIPAddress() {
-	m.ip_address = nil
-	m.clearedFields[emailverifications.FieldIPAddress] = struct{}{}
-}
-
-// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
-func (m *EmailVerificationsMutation) IPAddressCleared() bool {
-	_, ok := m.clearedFields[emailverifications.FieldIPAddress]
-	return ok
-}
-
-// ResetIPAddress resets all changes to the "ip_address" field.
-func (m *EmailVerificationsMutation) ResetIPAddress() {
-	m.ip_address = nil
-	delete(m.clearedFields, emailverifications.FieldIPAddress)
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *ConsentAcceptancesMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, consentacceptances.FieldUserAgent)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EmailVerificationsMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAcceptedAt sets the "accepted_at" field.
+func (m *ConsentAcceptancesMutation) SetAcceptedAt(t time.Time) {
+	m.accepted_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EmailVerificationsMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// AcceptedAt returns the value of the "accepted_at" field in the mutation.
+func (m *ConsentAcceptancesMutation) AcceptedAt() (r time.Time, exists bool) {
+	v := m.accepted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the EmailVerifications entity.
-// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// OldAcceptedAt returns the old "accepted_at" field's value of the ConsentAcceptances entity.
+// If the ConsentAcceptances object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConsentAcceptancesMutation) OldAcceptedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAcceptedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAcceptedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAcceptedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.AcceptedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EmailVerificationsMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetAcceptedAt resets all changes to the "accepted_at" field.
+func (m *ConsentAcceptancesMutation) ResetAcceptedAt() {
+	m.accepted_at = nil
 }
 
-// Where appends a list predicates to the EmailVerificationsMutation builder.
-func (m *EmailVerificationsMutation) Where(ps ...predicate.EmailVerifications) {
+// Where appends a list predicates to the ConsentAcceptancesMutation builder.
+func (m *ConsentAcceptancesMutation) Where(ps ...predicate.ConsentAcceptances) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EmailVerificationsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ConsentAcceptancesMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EmailVerificationsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EmailVerifications, len(ps))
+func (m *ConsentAcceptancesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConsentAcceptances, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2368,48 +2439,45 @@ func (m *EmailVerificationsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EmailVerificationsMutation) Op() Op {
+func (m *ConsentAcceptancesMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EmailVerificationsMutation) SetOp(op Op) {
+func (m *ConsentAcceptancesMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (EmailVerifications).
-func (m *EmailVerificationsMutation) Type() string {
+// Type returns the node type of this mutation (ConsentAcceptances).
+func (m *ConsentAcceptancesMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EmailVerificationsMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *ConsentAcceptancesMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.user_id != nil {
-		fields = append(fields, emailverifications.FieldUserID)
-	}
-	if m.email != nil {
-		fields = append(fields, emailverifications.FieldEmail)
-	}
-	if m.token != nil {
-		fields = append(fields, emailverifications.FieldToken)
+		fields = append(fields, consentacceptances.FieldUserID)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, emailverifications.FieldExpiresAt)
+	if m.document_id != nil {
+		fields = append(fields, consentacceptances.FieldDocumentID)
 	}
-	if m.is_used != nil {
-		fields = append(fields, emailverifications.FieldIsUsed)
+	if m.document_type != nil {
+		fields = append(fields, consentacceptances.FieldDocumentType)
 	}
-	if m.used_at != nil {
-		fields = append(fields, emailverifications.FieldUsedAt)
+	if m.version != nil {
+		fields = append(fields, consentacceptances.FieldVersion)
 	}
 	if m.ip_address != nil {
-		fields = append(fields, emailverifications.FieldIPAddress)
+		fields = append(fields, consentacceptances.FieldIPAddress)
 	}
-	if m.created_at != nil {
-		fields = append(fields, emailverifications.FieldCreatedAt)
+	if m.user_agent != nil {
+		fields = append(fields, consentacceptances.FieldUserAgent)
+	}
+	if m.accepted_at != nil {
+		fields = append(fields, consentacceptances.FieldAcceptedAt)
 	}
 	return fields
 }
@@ -2417,24 +2485,22 @@ func (m *EmailVerificationsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EmailVerificationsMutation) Field(name string) (ent.Value, bool) {
+func (m *ConsentAcceptancesMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case emailverifications.FieldUserID:
+	case consentacceptances.FieldUserID:
 		return m.UserID()
-	case emailverifications.FieldEmail:
-		return m.Email()
-	case emailverifications.FieldToken:
-		return m.Token()
-	case emailverifications.FieldExpiresAt:
-		return m.ExpiresAt()
-	case emailverifications.FieldIsUsed:
-		return m.IsUsed()
-	case emailverifications.FieldUsedAt:
-		return m.UsedAt()
-	case emailverifications.FieldIPAddress:
+	case consentacceptances.FieldDocumentID:
+		return m.DocumentID()
+	case consentacceptances.FieldDocumentType:
+		return m.DocumentType()
+	case consentacceptances.FieldVersion:
+		return m.Version()
+	case consentacceptances.FieldIPAddress:
 		return m.IPAddress()
-	case emailverifications.FieldCreatedAt:
-		return m.CreatedAt()
+	case consentacceptances.FieldUserAgent:
+		return m.UserAgent()
+	case consentacceptances.FieldAcceptedAt:
+		return m.AcceptedAt()
 	}
 	return nil, false
 }
@@ -2442,260 +2508,249 @@ func (m *EmailVerificationsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EmailVerificationsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConsentAcceptancesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case emailverifications.FieldUserID:
+	case consentacceptances.FieldUserID:
 		return m.OldUserID(ctx)
-	case emailverifications.FieldEmail:
-		return m.OldEmail(ctx)
-	case emailverifications.FieldToken:
-		return m.OldToken(ctx)
-	case emailverifications.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case emailverifications.FieldIsUsed:
-		return m.OldIsUsed(ctx)
-	case emailverifications.FieldUsedAt:
-		return m.OldUsedAt(ctx)
-	case emailverifications.FieldIPAddress:
+	case consentacceptances.FieldDocumentID:
+		return m.OldDocumentID(ctx)
+	case consentacceptances.FieldDocumentType:
+		return m.OldDocumentType(ctx)
+	case consentacceptances.FieldVersion:
+		return m.OldVersion(ctx)
+	case consentacceptances.FieldIPAddress:
 		return m.OldIPAddress(ctx)
-	case emailverifications.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case consentacceptances.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case consentacceptances.FieldAcceptedAt:
+		return m.OldAcceptedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown EmailVerifications field %s", name)
+	return nil, fmt.Errorf("unknown ConsentAcceptances field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EmailVerificationsMutation) SetField(name string, value ent.Value) error {
+func (m *ConsentAcceptancesMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case emailverifications.FieldUserID:
+	case consentacceptances.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case emailverifications.FieldEmail:
-		v, ok := value.(string)
+	case consentacceptances.FieldDocumentID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetDocumentID(v)
 		return nil
-	case emailverifications.FieldToken:
+	case consentacceptances.FieldDocumentType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
-		return nil
-	case emailverifications.FieldExpiresAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiresAt(v)
+		m.SetDocumentType(v)
 		return nil
-	case emailverifications.FieldIsUsed:
-		v, ok := value.(bool)
+	case consentacceptances.FieldVersion:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsUsed(v)
+		m.SetVersion(v)
 		return nil
-	case emailverifications.FieldUsedAt:
-		v, ok := value.(time.Time)
+	case consentacceptances.FieldIPAddress:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsedAt(v)
+		m.SetIPAddress(v)
 		return nil
-	case emailverifications.FieldIPAddress:
+	case consentacceptances.FieldUserAgent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIPAddress(v)
+		m.SetUserAgent(v)
 		return nil
-	case emailverifications.FieldCreatedAt:
+	case consentacceptances.FieldAcceptedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetAcceptedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EmailVerifications field %s", name)
+	return fmt.Errorf("unknown ConsentAcceptances field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EmailVerificationsMutation) AddedFields() []string {
+func (m *ConsentAcceptancesMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EmailVerificationsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConsentAcceptancesMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EmailVerificationsMutation) AddField(name string, value ent.Value) error {
+func (m *ConsentAcceptancesMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown EmailVerifications numeric field %s", name)
+	return fmt.Errorf("unknown ConsentAcceptances numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EmailVerificationsMutation) ClearedFields() []string {
+func (m *ConsentAcceptancesMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(emailverifications.FieldUsedAt) {
-		fields = append(fields, emailverifications.FieldUsedAt)
+	if m.FieldCleared(consentacceptances.FieldIPAddress) {
+		fields = append(fields, consentacceptances.FieldIPAddress)
 	}
-	if m.FieldCleared(emailverifications.FieldIPAddress) {
-		fields = append(fields, emailverifications.FieldIPAddress)
+	if m.FieldCleared(consentacceptances.FieldUserAgent) {
+		fields = append(fields, consentacceptances.FieldUserAgent)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EmailVerificationsMutation) FieldCleared(name string) bool {
+func (m *ConsentAcceptancesMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EmailVerificationsMutation) ClearField(name string) error {
+func (m *ConsentAcceptancesMutation) ClearField(name string) error {
 	switch name {
-	case emailverifications.FieldUsedAt:
-		m.ClearUsedAt()
-		return nil
-	case emailverifications.FieldIPAddress:
+	case consentacceptances.FieldIPAddress:
 		m.ClearIPAddress()
 		return nil
+	case consentacceptances.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
 	}
-	return fmt.Errorf("unknown EmailVerifications nullable field %s", name)
+	return fmt.Errorf("unknown ConsentAcceptances nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EmailVerificationsMutation) ResetField(name string) error {
+func (m *ConsentAcceptancesMutation) ResetField(name string) error {
 	switch name {
-	case emailverifications.FieldUserID:
+	case consentacceptances.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case emailverifications.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case emailverifications.FieldToken:
-		m.ResetToken()
-		return nil
-	case emailverifications.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case consentacceptances.FieldDocumentID:
+		m.ResetDocumentID()
 		return nil
-	case emailverifications.FieldIsUsed:
-		m.ResetIsUsed()
+	case consentacceptances.FieldDocumentType:
+		m.ResetDocumentType()
 		return nil
-	case emailverifications.FieldUsedAt:
-		m.ResetUsedAt()
+	case consentacceptances.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case emailverifications.FieldIPAddress:
+	case consentacceptances.FieldIPAddress:
 		m.ResetIPAddress()
 		return nil
-	case emailverifications.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case consentacceptances.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case consentacceptances.FieldAcceptedAt:
+		m.ResetAcceptedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown EmailVerifications field %s", name)
+	return fmt.Errorf("unknown ConsentAcceptances field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EmailVerificationsMutation) AddedEdges() []string {
+func (m *ConsentAcceptancesMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EmailVerificationsMutation) AddedIDs(name string) []ent.Value {
+func (m *ConsentAcceptancesMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EmailVerificationsMutation) RemovedEdges() []string {
+func (m *ConsentAcceptancesMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EmailVerificationsMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConsentAcceptancesMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EmailVerificationsMutation) ClearedEdges() []string {
+func (m *ConsentAcceptancesMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EmailVerificationsMutation) EdgeCleared(name string) bool {
+func (m *ConsentAcceptancesMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EmailVerificationsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown EmailVerifications unique edge %s", name)
+func (m *ConsentAcceptancesMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConsentAcceptances unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EmailVerificationsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown EmailVerifications edge %s", name)
+func (m *ConsentAcceptancesMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConsentAcceptances edge %s", name)
 }
 
-// PasswordResetsMutation represents an operation that mutates the PasswordResets nodes in the graph.
-type PasswordResetsMutation struct {
+// ConsentDocumentsMutation represents an operation that mutates the ConsentDocuments nodes in the graph.
+type ConsentDocumentsMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
-	user_id       *uuid.UUID
-	email         *string
-	token         *string
-	expires_at    *time.Time
-	is_used       *bool
-	used_at       *time.Time
-	ip_address    *string
+	_type         *string
+	version       *string
+	title         *string
+	url           *string
+	content       *string
+	mandatory     *bool
+	published_at  *time.Time
+	created_by    *uuid.UUID
 	created_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*PasswordResets, error)
-	predicates    []predicate.PasswordResets
+	oldValue      func(context.Context) (*ConsentDocuments, error)
+	predicates    []predicate.ConsentDocuments
 }
 
-var _ ent.Mutation = (*PasswordResetsMutation)(nil)
+var _ ent.Mutation = (*ConsentDocumentsMutation)(nil)
 
-// passwordresetsOption allows management of the mutation configuration using functional options.
-type passwordresetsOption func(*PasswordResetsMutation)
+// consentdocumentsOption allows management of the mutation configuration using functional options.
+type consentdocumentsOption func(*ConsentDocumentsMutation)
 
-// newPasswordResetsMutation creates new mutation for the PasswordResets entity.
-func newPasswordResetsMutation(c config, op Op, opts ...passwordresetsOption) *PasswordResetsMutation {
-	m := &PasswordResetsMutation{
+// newConsentDocumentsMutation creates new mutation for the ConsentDocuments entity.
+func newConsentDocumentsMutation(c config, op Op, opts ...consentdocumentsOption) *ConsentDocumentsMutation {
+	m := &ConsentDocumentsMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePasswordResets,
+		typ:           TypeConsentDocuments,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2704,20 +2759,20 @@ func newPasswordResetsMutation(c config, op Op, opts ...passwordresetsOption) *P
 	return m
 }
 
-// withPasswordResetsID sets the ID field of the mutation.
-func withPasswordResetsID(id uuid.UUID) passwordresetsOption {
-	return func(m *PasswordResetsMutation) {
+// withConsentDocumentsID sets the ID field of the mutation.
+func withConsentDocumentsID(id uuid.UUID) consentdocumentsOption {
+	return func(m *ConsentDocumentsMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PasswordResets
+			value *ConsentDocuments
 		)
-		m.oldValue = func(ctx context.Context) (*PasswordResets, error) {
+		m.oldValue = func(ctx context.Context) (*ConsentDocuments, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PasswordResets.Get(ctx, id)
+					value, err = m.Client().ConsentDocuments.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2726,10 +2781,10 @@ func withPasswordResetsID(id uuid.UUID) passwordresetsOption {
 	}
 }
 
-// withPasswordResets sets the old PasswordResets of the mutation.
-func withPasswordResets(node *PasswordResets) passwordresetsOption {
-	return func(m *PasswordResetsMutation) {
-		m.oldValue = func(context.Context) (*PasswordResets, error) {
+// withConsentDocuments sets the old ConsentDocuments of the mutation.
+func withConsentDocuments(node *ConsentDocuments) consentdocumentsOption {
+	return func(m *ConsentDocumentsMutation) {
+		m.oldValue = func(context.Context) (*ConsentDocuments, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2738,7 +2793,7 @@ func withPasswordResets(node *PasswordResets) passwordresetsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PasswordResetsMutation) Client() *Client {
+func (m ConsentDocumentsMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2746,7 +2801,7 @@ func (m PasswordResetsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PasswordResetsMutation) Tx() (*Tx, error) {
+func (m ConsentDocumentsMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2756,14 +2811,14 @@ func (m PasswordResetsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PasswordResets entities.
-func (m *PasswordResetsMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ConsentDocuments entities.
+func (m *ConsentDocumentsMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PasswordResetsMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ConsentDocumentsMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2774,7 +2829,7 @@ func (m *PasswordResetsMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PasswordResetsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ConsentDocumentsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2783,297 +2838,346 @@ func (m *PasswordResetsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PasswordResets.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConsentDocuments.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PasswordResetsMutation) SetUserID(u uuid.UUID) {
-	m.user_id = &u
+// SetType sets the "type" field.
+func (m *ConsentDocumentsMutation) SetType(s string) {
+	m._type = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PasswordResetsMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user_id
+// GetType returns the value of the "type" field in the mutation.
+func (m *ConsentDocumentsMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the PasswordResets entity.
-// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the ConsentDocuments entity.
+// If the ConsentDocuments object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ConsentDocumentsMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Type, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PasswordResetsMutation) ResetUserID() {
-	m.user_id = nil
+// ResetType resets all changes to the "type" field.
+func (m *ConsentDocumentsMutation) ResetType() {
+	m._type = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *PasswordResetsMutation) SetEmail(s string) {
-	m.email = &s
+// SetVersion sets the "version" field.
+func (m *ConsentDocumentsMutation) SetVersion(s string) {
+	m.version = &s
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *PasswordResetsMutation) Email() (r string, exists bool) {
-	v := m.email
+// Version returns the value of the "version" field in the mutation.
+func (m *ConsentDocumentsMutation) Version() (r string, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the PasswordResets entity.
-// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the ConsentDocuments entity.
+// If the ConsentDocuments object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetsMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *ConsentDocumentsMutation) OldVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.Version, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *PasswordResetsMutation) ResetEmail() {
-	m.email = nil
+// ResetVersion resets all changes to the "version" field.
+func (m *ConsentDocumentsMutation) ResetVersion() {
+	m.version = nil
 }
 
-// SetToken sets the "token" field.
-func (m *PasswordResetsMutation) SetToken(s string) {
-	m.token = &s
+// SetTitle sets the "title" field.
+func (m *ConsentDocumentsMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *PasswordResetsMutation) Token() (r string, exists bool) {
-	v := m.token
+// Title returns the value of the "title" field in the mutation.
+func (m *ConsentDocumentsMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the PasswordResets entity.
-// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the ConsentDocuments entity.
+// If the ConsentDocuments object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetsMutation) OldToken(ctx context.Context) (v string, err error) {
+func (m *ConsentDocumentsMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.Title, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *PasswordResetsMutation) ResetToken() {
-	m.token = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *ConsentDocumentsMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *PasswordResetsMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetURL sets the "url" field.
+func (m *ConsentDocumentsMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *PasswordResetsMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// URL returns the value of the "url" field in the mutation.
+func (m *ConsentDocumentsMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the PasswordResets entity.
-// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the ConsentDocuments entity.
+// If the ConsentDocuments object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetsMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConsentDocumentsMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.URL, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *PasswordResetsMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ClearURL clears the value of the "url" field.
+func (m *ConsentDocumentsMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[consentdocuments.FieldURL] = struct{}{}
 }
 
-// SetIsUsed sets the "is_used" field.
-func (m *PasswordResetsMutation) SetIsUsed(b bool) {
-	m.is_used = &b
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *ConsentDocumentsMutation) URLCleared() bool {
+	_, ok := m.clearedFields[consentdocuments.FieldURL]
+	return ok
 }
 
-// IsUsed returns the value of the "is_used" field in the mutation.
-func (m *PasswordResetsMutation) IsUsed() (r bool, exists bool) {
-	v := m.is_used
+// ResetURL resets all changes to the "url" field.
+func (m *ConsentDocumentsMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, consentdocuments.FieldURL)
+}
+
+// SetContent sets the "content" field.
+func (m *ConsentDocumentsMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ConsentDocumentsMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsUsed returns the old "is_used" field's value of the PasswordResets entity.
-// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the ConsentDocuments entity.
+// If the ConsentDocuments object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetsMutation) OldIsUsed(ctx context.Context) (v bool, err error) {
+func (m *ConsentDocumentsMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsUsed requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsUsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.IsUsed, nil
+	return oldValue.Content, nil
 }
 
-// ResetIsUsed resets all changes to the "is_used" field.
-func (m *PasswordResetsMutation) ResetIsUsed() {
-	m.is_used = nil
+// ClearContent clears the value of the "content" field.
+func (m *ConsentDocumentsMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[consentdocuments.FieldContent] = struct{}{}
 }
 
-// SetUsedAt sets the "used_at" field.
-func (m *PasswordResetsMutation) SetUsedAt(t time.Time) {
-	m.used_at = &t
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *ConsentDocumentsMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[consentdocuments.FieldContent]
+	return ok
 }
 
-// UsedAt returns the value of the "used_at" field in the mutation.
-func (m *PasswordResetsMutation) UsedAt() (r time.Time, exists bool) {
-	v := m.used_at
+// ResetContent resets all changes to the "content" field.
+func (m *ConsentDocumentsMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, consentdocuments.FieldContent)
+}
+
+// SetMandatory sets the "mandatory" field.
+func (m *ConsentDocumentsMutation) SetMandatory(b bool) {
+	m.mandatory = &b
+}
+
+// Mandatory returns the value of the "mandatory" field in the mutation.
+func (m *ConsentDocumentsMutation) Mandatory() (r bool, exists bool) {
+	v := m.mandatory
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsedAt returns the old "used_at" field's value of the PasswordResets entity.
-// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// OldMandatory returns the old "mandatory" field's value of the ConsentDocuments entity.
+// If the ConsentDocuments object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetsMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ConsentDocumentsMutation) OldMandatory(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMandatory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+		return v, errors.New("OldMandatory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMandatory: %w", err)
 	}
-	return oldValue.UsedAt, nil
+	return oldValue.Mandatory, nil
 }
 
-// ClearUsedAt clears the value of the "used_at" field.
-func (m *PasswordResetsMutation) ClearUsedAt() {
-	m.used_at = nil
-	m.clearedFields[passwordresets.FieldUsedAt] = struct{}{}
+// ResetMandatory resets all changes to the "mandatory" field.
+func (m *ConsentDocumentsMutation) ResetMandatory() {
+	m.mandatory = nil
 }
 
-// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
-func (m *PasswordResetsMutation) UsedAtCleared() bool {
-	_, ok := m.clearedFields[passwordresets.FieldUsedAt]
-	return ok
+// SetPublishedAt sets the "published_at" field.
+func (m *ConsentDocumentsMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
 }
 
-// ResetUsedAt resets all changes to the "used_at" field.
-func (m *PasswordResetsMutation) ResetUsedAt() {
-	m.used_at = nil
-	delete(m.clearedFields, passwordresets.FieldUsedAt)
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *ConsentDocumentsMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetIPAddress sets the "ip_address" field.
-func (m *PasswordResetsMutation) SetIPAddress(s string) {
-	m.ip_address = &s
+// OldPublishedAt returns the old "published_at" field's value of the ConsentDocuments entity.
+// If the ConsentDocuments object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentDocumentsMutation) OldPublishedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
 }
 
-// IPAddress returns the value of the "ip_address" field in the mutation.
-func (m *PasswordResetsMutation) IPAddress() (r string, exists bool) {
-	v := m.ip_address
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *ConsentDocumentsMutation) ResetPublishedAt() {
+	m.published_at = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *ConsentDocumentsMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *ConsentDocumentsMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIPAddress returns the old "ip_address" field's value of the PasswordResets entity.
-// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the ConsentDocuments entity.
+// If the ConsentDocuments object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetsMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+func (m *ConsentDocumentsMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.IPAddress, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ClearIPAddress clears the value of the "ip_address" field.
-func (m *PasswordResetsMutation) ClearIPAddress() {
-	m.ip_address = nil
-	m.clearedFields[passwordresets.FieldIPAddress] = struct{}{}
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *ConsentDocumentsMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[consentdocuments.FieldCreatedBy] = struct{}{}
 }
 
-// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
-func (m *PasswordResetsMutation) IPAddressCleared() bool {
-	_, ok := m.clearedFields[passwordresets.FieldIPAddress]
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *ConsentDocumentsMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[consentdocuments.FieldCreatedBy]
 	return ok
 }
 
-// ResetIPAddress resets all changes to the "ip_address" field.
-func (m *PasswordResetsMutation) ResetIPAddress() {
-	m.ip_address = nil
-	delete(m.clearedFields, passwordresets.FieldIPAddress)
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *ConsentDocumentsMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, consentdocuments.FieldCreatedBy)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PasswordResetsMutation) SetCreatedAt(t time.Time) {
+func (m *ConsentDocumentsMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PasswordResetsMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ConsentDocumentsMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3081,10 +3185,10 @@ func (m *PasswordResetsMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PasswordResets entity.
-// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ConsentDocuments entity.
+// If the ConsentDocuments object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PasswordResetsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConsentDocumentsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3099,19 +3203,19 @@ func (m *PasswordResetsMutation) OldCreatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PasswordResetsMutation) ResetCreatedAt() {
+func (m *ConsentDocumentsMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the PasswordResetsMutation builder.
-func (m *PasswordResetsMutation) Where(ps ...predicate.PasswordResets) {
+// Where appends a list predicates to the ConsentDocumentsMutation builder.
+func (m *ConsentDocumentsMutation) Where(ps ...predicate.ConsentDocuments) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PasswordResetsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ConsentDocumentsMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PasswordResetsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PasswordResets, len(ps))
+func (m *ConsentDocumentsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConsentDocuments, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3119,48 +3223,51 @@ func (m *PasswordResetsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PasswordResetsMutation) Op() Op {
+func (m *ConsentDocumentsMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PasswordResetsMutation) SetOp(op Op) {
+func (m *ConsentDocumentsMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PasswordResets).
-func (m *PasswordResetsMutation) Type() string {
+// Type returns the node type of this mutation (ConsentDocuments).
+func (m *ConsentDocumentsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PasswordResetsMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.user_id != nil {
-		fields = append(fields, passwordresets.FieldUserID)
+func (m *ConsentDocumentsMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m._type != nil {
+		fields = append(fields, consentdocuments.FieldType)
 	}
-	if m.email != nil {
-		fields = append(fields, passwordresets.FieldEmail)
+	if m.version != nil {
+		fields = append(fields, consentdocuments.FieldVersion)
 	}
-	if m.token != nil {
-		fields = append(fields, passwordresets.FieldToken)
+	if m.title != nil {
+		fields = append(fields, consentdocuments.FieldTitle)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, passwordresets.FieldExpiresAt)
+	if m.url != nil {
+		fields = append(fields, consentdocuments.FieldURL)
 	}
-	if m.is_used != nil {
-		fields = append(fields, passwordresets.FieldIsUsed)
+	if m.content != nil {
+		fields = append(fields, consentdocuments.FieldContent)
 	}
-	if m.used_at != nil {
-		fields = append(fields, passwordresets.FieldUsedAt)
+	if m.mandatory != nil {
+		fields = append(fields, consentdocuments.FieldMandatory)
 	}
-	if m.ip_address != nil {
-		fields = append(fields, passwordresets.FieldIPAddress)
+	if m.published_at != nil {
+		fields = append(fields, consentdocuments.FieldPublishedAt)
+	}
+	if m.created_by != nil {
+		fields = append(fields, consentdocuments.FieldCreatedBy)
 	}
 	if m.created_at != nil {
-		fields = append(fields, passwordresets.FieldCreatedAt)
+		fields = append(fields, consentdocuments.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3168,23 +3275,25 @@ func (m *PasswordResetsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PasswordResetsMutation) Field(name string) (ent.Value, bool) {
+func (m *ConsentDocumentsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case passwordresets.FieldUserID:
-		return m.UserID()
-	case passwordresets.FieldEmail:
-		return m.Email()
-	case passwordresets.FieldToken:
-		return m.Token()
-	case passwordresets.FieldExpiresAt:
-		return m.ExpiresAt()
-	case passwordresets.FieldIsUsed:
-		return m.IsUsed()
-	case passwordresets.FieldUsedAt:
-		return m.UsedAt()
-	case passwordresets.FieldIPAddress:
-		return m.IPAddress()
-	case passwordresets.FieldCreatedAt:
+	case consentdocuments.FieldType:
+		return m.GetType()
+	case consentdocuments.FieldVersion:
+		return m.Version()
+	case consentdocuments.FieldTitle:
+		return m.Title()
+	case consentdocuments.FieldURL:
+		return m.URL()
+	case consentdocuments.FieldContent:
+		return m.Content()
+	case consentdocuments.FieldMandatory:
+		return m.Mandatory()
+	case consentdocuments.FieldPublishedAt:
+		return m.PublishedAt()
+	case consentdocuments.FieldCreatedBy:
+		return m.CreatedBy()
+	case consentdocuments.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -3193,83 +3302,92 @@ func (m *PasswordResetsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PasswordResetsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConsentDocumentsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case passwordresets.FieldUserID:
-		return m.OldUserID(ctx)
-	case passwordresets.FieldEmail:
-		return m.OldEmail(ctx)
-	case passwordresets.FieldToken:
-		return m.OldToken(ctx)
-	case passwordresets.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case passwordresets.FieldIsUsed:
-		return m.OldIsUsed(ctx)
-	case passwordresets.FieldUsedAt:
-		return m.OldUsedAt(ctx)
-	case passwordresets.FieldIPAddress:
-		return m.OldIPAddress(ctx)
-	case passwordresets.FieldCreatedAt:
+	case consentdocuments.FieldType:
+		return m.OldType(ctx)
+	case consentdocuments.FieldVersion:
+		return m.OldVersion(ctx)
+	case consentdocuments.FieldTitle:
+		return m.OldTitle(ctx)
+	case consentdocuments.FieldURL:
+		return m.OldURL(ctx)
+	case consentdocuments.FieldContent:
+		return m.OldContent(ctx)
+	case consentdocuments.FieldMandatory:
+		return m.OldMandatory(ctx)
+	case consentdocuments.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case consentdocuments.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case consentdocuments.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PasswordResets field %s", name)
+	return nil, fmt.Errorf("unknown ConsentDocuments field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PasswordResetsMutation) SetField(name string, value ent.Value) error {
+func (m *ConsentDocumentsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case passwordresets.FieldUserID:
-		v, ok := value.(uuid.UUID)
+	case consentdocuments.FieldType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetType(v)
 		return nil
-	case passwordresets.FieldEmail:
+	case consentdocuments.FieldVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetVersion(v)
 		return nil
-	case passwordresets.FieldToken:
+	case consentdocuments.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetTitle(v)
 		return nil
-	case passwordresets.FieldExpiresAt:
-		v, ok := value.(time.Time)
+	case consentdocuments.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetURL(v)
 		return nil
-	case passwordresets.FieldIsUsed:
+	case consentdocuments.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case consentdocuments.FieldMandatory:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsUsed(v)
+		m.SetMandatory(v)
 		return nil
-	case passwordresets.FieldUsedAt:
+	case consentdocuments.FieldPublishedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsedAt(v)
+		m.SetPublishedAt(v)
 		return nil
-	case passwordresets.FieldIPAddress:
-		v, ok := value.(string)
+	case consentdocuments.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIPAddress(v)
+		m.SetCreatedBy(v)
 		return nil
-	case passwordresets.FieldCreatedAt:
+	case consentdocuments.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3277,178 +3395,190 @@ func (m *PasswordResetsMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PasswordResets field %s", name)
+	return fmt.Errorf("unknown ConsentDocuments field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PasswordResetsMutation) AddedFields() []string {
+func (m *ConsentDocumentsMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PasswordResetsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConsentDocumentsMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PasswordResetsMutation) AddField(name string, value ent.Value) error {
+func (m *ConsentDocumentsMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PasswordResets numeric field %s", name)
+	return fmt.Errorf("unknown ConsentDocuments numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PasswordResetsMutation) ClearedFields() []string {
+func (m *ConsentDocumentsMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(passwordresets.FieldUsedAt) {
-		fields = append(fields, passwordresets.FieldUsedAt)
+	if m.FieldCleared(consentdocuments.FieldURL) {
+		fields = append(fields, consentdocuments.FieldURL)
 	}
-	if m.FieldCleared(passwordresets.FieldIPAddress) {
-		fields = append(fields, passwordresets.FieldIPAddress)
+	if m.FieldCleared(consentdocuments.FieldContent) {
+		fields = append(fields, consentdocuments.FieldContent)
+	}
+	if m.FieldCleared(consentdocuments.FieldCreatedBy) {
+		fields = append(fields, consentdocuments.FieldCreatedBy)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PasswordResetsMutation) FieldCleared(name string) bool {
+func (m *ConsentDocumentsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PasswordResetsMutation) ClearField(name string) error {
+func (m *ConsentDocumentsMutation) ClearField(name string) error {
 	switch name {
-	case passwordresets.FieldUsedAt:
-		m.ClearUsedAt()
+	case consentdocuments.FieldURL:
+		m.ClearURL()
 		return nil
-	case passwordresets.FieldIPAddress:
-		m.ClearIPAddress()
+	case consentdocuments.FieldContent:
+		m.ClearContent()
+		return nil
+	case consentdocuments.FieldCreatedBy:
+		m.ClearCreatedBy()
 		return nil
 	}
-	return fmt.Errorf("unknown PasswordResets nullable field %s", name)
+	return fmt.Errorf("unknown ConsentDocuments nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PasswordResetsMutation) ResetField(name string) error {
+func (m *ConsentDocumentsMutation) ResetField(name string) error {
 	switch name {
-	case passwordresets.FieldUserID:
-		m.ResetUserID()
+	case consentdocuments.FieldType:
+		m.ResetType()
 		return nil
-	case passwordresets.FieldEmail:
-		m.ResetEmail()
+	case consentdocuments.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case passwordresets.FieldToken:
-		m.ResetToken()
+	case consentdocuments.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case passwordresets.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case consentdocuments.FieldURL:
+		m.ResetURL()
 		return nil
-	case passwordresets.FieldIsUsed:
-		m.ResetIsUsed()
+	case consentdocuments.FieldContent:
+		m.ResetContent()
 		return nil
-	case passwordresets.FieldUsedAt:
-		m.ResetUsedAt()
+	case consentdocuments.FieldMandatory:
+		m.ResetMandatory()
 		return nil
-	case passwordresets.FieldIPAddress:
-		m.ResetIPAddress()
+	case consentdocuments.FieldPublishedAt:
+		m.ResetPublishedAt()
 		return nil
-	case passwordresets.FieldCreatedAt:
+	case consentdocuments.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case consentdocuments.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PasswordResets field %s", name)
+	return fmt.Errorf("unknown ConsentDocuments field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PasswordResetsMutation) AddedEdges() []string {
+func (m *ConsentDocumentsMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PasswordResetsMutation) AddedIDs(name string) []ent.Value {
+func (m *ConsentDocumentsMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PasswordResetsMutation) RemovedEdges() []string {
+func (m *ConsentDocumentsMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PasswordResetsMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConsentDocumentsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PasswordResetsMutation) ClearedEdges() []string {
+func (m *ConsentDocumentsMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PasswordResetsMutation) EdgeCleared(name string) bool {
+func (m *ConsentDocumentsMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PasswordResetsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown PasswordResets unique edge %s", name)
+func (m *ConsentDocumentsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConsentDocuments unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PasswordResetsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown PasswordResets edge %s", name)
+func (m *ConsentDocumentsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConsentDocuments edge %s", name)
 }
 
-// PermissionsMutation represents an operation that mutates the Permissions nodes in the graph.
-type PermissionsMutation struct {
+// EmailChangesMutation represents an operation that mutates the EmailChanges nodes in the graph.
+type EmailChangesMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	code                    *string
-	name                    *string
-	description             *string
-	resource                *string
-	action                  *string
-	created_at              *time.Time
-	updated_at              *time.Time
-	clearedFields           map[string]struct{}
-	role_permissions        map[int]struct{}
-	removedrole_permissions map[int]struct{}
-	clearedrole_permissions bool
-	done                    bool
-	oldValue                func(context.Context) (*Permissions, error)
-	predicates              []predicate.Permissions
-}
-
-var _ ent.Mutation = (*PermissionsMutation)(nil)
-
-// permissionsOption allows management of the mutation configuration using functional options.
-type permissionsOption func(*PermissionsMutation)
-
-// newPermissionsMutation creates new mutation for the Permissions entity.
-func newPermissionsMutation(c config, op Op, opts ...permissionsOption) *PermissionsMutation {
-	m := &PermissionsMutation{
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	user_id            *uuid.UUID
+	old_email          *string
+	old_email_verified *bool
+	new_email          *string
+	token              *string
+	undo_token         *string
+	status             *emailchanges.Status
+	expires_at         *time.Time
+	undo_expires_at    *time.Time
+	confirmed_at       *time.Time
+	reverted_at        *time.Time
+	ip_address         *string
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*EmailChanges, error)
+	predicates         []predicate.EmailChanges
+}
+
+var _ ent.Mutation = (*EmailChangesMutation)(nil)
+
+// emailchangesOption allows management of the mutation configuration using functional options.
+type emailchangesOption func(*EmailChangesMutation)
+
+// newEmailChangesMutation creates new mutation for the EmailChanges entity.
+func newEmailChangesMutation(c config, op Op, opts ...emailchangesOption) *EmailChangesMutation {
+	m := &EmailChangesMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePermissions,
+		typ:           TypeEmailChanges,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3457,20 +3587,20 @@ func newPermissionsMutation(c config, op Op, opts ...permissionsOption) *Permiss
 	return m
 }
 
-// withPermissionsID sets the ID field of the mutation.
-func withPermissionsID(id int) permissionsOption {
-	return func(m *PermissionsMutation) {
+// withEmailChangesID sets the ID field of the mutation.
+func withEmailChangesID(id uuid.UUID) emailchangesOption {
+	return func(m *EmailChangesMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Permissions
+			value *EmailChanges
 		)
-		m.oldValue = func(ctx context.Context) (*Permissions, error) {
+		m.oldValue = func(ctx context.Context) (*EmailChanges, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Permissions.Get(ctx, id)
+					value, err = m.Client().EmailChanges.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3479,10 +3609,10 @@ func withPermissionsID(id int) permissionsOption {
 	}
 }
 
-// withPermissions sets the old Permissions of the mutation.
-func withPermissions(node *Permissions) permissionsOption {
-	return func(m *PermissionsMutation) {
-		m.oldValue = func(context.Context) (*Permissions, error) {
+// withEmailChanges sets the old EmailChanges of the mutation.
+func withEmailChanges(node *EmailChanges) emailchangesOption {
+	return func(m *EmailChangesMutation) {
+		m.oldValue = func(context.Context) (*EmailChanges, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3491,7 +3621,7 @@ func withPermissions(node *Permissions) permissionsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PermissionsMutation) Client() *Client {
+func (m EmailChangesMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3499,7 +3629,7 @@ func (m PermissionsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PermissionsMutation) Tx() (*Tx, error) {
+func (m EmailChangesMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3509,14 +3639,14 @@ func (m PermissionsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Permissions entities.
-func (m *PermissionsMutation) SetID(id int) {
+// operation is only accepted on creation of EmailChanges entities.
+func (m *EmailChangesMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PermissionsMutation) ID() (id int, exists bool) {
+func (m *EmailChangesMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3527,375 +3657,15205 @@ func (m *PermissionsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PermissionsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *EmailChangesMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Permissions.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EmailChanges.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCode sets the "code" field.
-func (m *PermissionsMutation) SetCode(s string) {
-	m.code = &s
+// SetUserID sets the "user_id" field.
+func (m *EmailChangesMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *PermissionsMutation) Code() (r string, exists bool) {
-	v := m.code
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *EmailChangesMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the Permissions entity.
-// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionsMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *EmailChangesMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Code, nil
+	return oldValue.UserID, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *PermissionsMutation) ResetCode() {
-	m.code = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *EmailChangesMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetName sets the "name" field.
-func (m *PermissionsMutation) SetName(s string) {
-	m.name = &s
+// SetOldEmail sets the "old_email" field.
+func (m *EmailChangesMutation) SetOldEmail(s string) {
+	m.old_email = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PermissionsMutation) Name() (r string, exists bool) {
-	v := m.name
+// OldEmail returns the value of the "old_email" field in the mutation.
+func (m *EmailChangesMutation) OldEmail() (r string, exists bool) {
+	v := m.old_email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Permissions entity.
-// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// OldOldEmail returns the old "old_email" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionsMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *EmailChangesMutation) OldOldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldOldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOldEmail: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.OldEmail, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PermissionsMutation) ResetName() {
-	m.name = nil
+// ResetOldEmail resets all changes to the "old_email" field.
+func (m *EmailChangesMutation) ResetOldEmail() {
+	m.old_email = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *PermissionsMutation) SetDescription(s string) {
-	m.description = &s
+// SetOldEmailVerified sets the "old_email_verified" field.
+func (m *EmailChangesMutation) SetOldEmailVerified(b bool) {
+	m.old_email_verified = &b
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *PermissionsMutation) Description() (r string, exists bool) {
-	v := m.description
+// OldEmailVerified returns the value of the "old_email_verified" field in the mutation.
+func (m *EmailChangesMutation) OldEmailVerified() (r bool, exists bool) {
+	v := m.old_email_verified
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Permissions entity.
-// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// OldOldEmailVerified returns the old "old_email_verified" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionsMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *EmailChangesMutation) OldOldEmailVerified(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldOldEmailVerified is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldOldEmailVerified requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldOldEmailVerified: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.OldEmailVerified, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *PermissionsMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[permissions.FieldDescription] = struct{}{}
+// ResetOldEmailVerified resets all changes to the "old_email_verified" field.
+func (m *EmailChangesMutation) ResetOldEmailVerified() {
+	m.old_email_verified = nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *PermissionsMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[permissions.FieldDescription]
-	return ok
+// SetNewEmail sets the "new_email" field.
+func (m *EmailChangesMutation) SetNewEmail(s string) {
+	m.new_email = &s
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *PermissionsMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, permissions.FieldDescription)
+// NewEmail returns the value of the "new_email" field in the mutation.
+func (m *EmailChangesMutation) NewEmail() (r string, exists bool) {
+	v := m.new_email
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetResource sets the "resource" field.
-func (m *PermissionsMutation) SetResource(s string) {
-	m.resource = &s
+// OldNewEmail returns the old "new_email" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailChangesMutation) OldNewEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewEmail: %w", err)
+	}
+	return oldValue.NewEmail, nil
 }
 
-// Resource returns the value of the "resource" field in the mutation.
-func (m *PermissionsMutation) Resource() (r string, exists bool) {
-	v := m.resource
+// ResetNewEmail resets all changes to the "new_email" field.
+func (m *EmailChangesMutation) ResetNewEmail() {
+	m.new_email = nil
+}
+
+// SetToken sets the "token" field.
+func (m *EmailChangesMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *EmailChangesMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResource returns the old "resource" field's value of the Permissions entity.
-// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionsMutation) OldResource(ctx context.Context) (v string, err error) {
+func (m *EmailChangesMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResource is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResource requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResource: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Resource, nil
+	return oldValue.Token, nil
 }
 
-// ClearResource clears the value of the "resource" field.
-func (m *PermissionsMutation) ClearResource() {
-	m.resource = nil
-	m.clearedFields[permissions.FieldResource] = struct{}{}
+// ResetToken resets all changes to the "token" field.
+func (m *EmailChangesMutation) ResetToken() {
+	m.token = nil
 }
 
-// ResourceCleared returns if the "resource" field was cleared in this mutation.
-func (m *PermissionsMutation) ResourceCleared() bool {
-	_, ok := m.clearedFields[permissions.FieldResource]
-	return ok
+// SetUndoToken sets the "undo_token" field.
+func (m *EmailChangesMutation) SetUndoToken(s string) {
+	m.undo_token = &s
+}
+
+// UndoToken returns the value of the "undo_token" field in the mutation.
+func (m *EmailChangesMutation) UndoToken() (r string, exists bool) {
+	v := m.undo_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUndoToken returns the old "undo_token" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailChangesMutation) OldUndoToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUndoToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUndoToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUndoToken: %w", err)
+	}
+	return oldValue.UndoToken, nil
+}
+
+// ResetUndoToken resets all changes to the "undo_token" field.
+func (m *EmailChangesMutation) ResetUndoToken() {
+	m.undo_token = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *EmailChangesMutation) SetStatus(e emailchanges.Status) {
+	m.status = &e
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EmailChangesMutation) Status() (r emailchanges.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailChangesMutation) OldStatus(ctx context.Context) (v emailchanges.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *EmailChangesMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *EmailChangesMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *EmailChangesMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailChangesMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *EmailChangesMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetUndoExpiresAt sets the "undo_expires_at" field.
+func (m *EmailChangesMutation) SetUndoExpiresAt(t time.Time) {
+	m.undo_expires_at = &t
+}
+
+// UndoExpiresAt returns the value of the "undo_expires_at" field in the mutation.
+func (m *EmailChangesMutation) UndoExpiresAt() (r time.Time, exists bool) {
+	v := m.undo_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUndoExpiresAt returns the old "undo_expires_at" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailChangesMutation) OldUndoExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUndoExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUndoExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUndoExpiresAt: %w", err)
+	}
+	return oldValue.UndoExpiresAt, nil
+}
+
+// ResetUndoExpiresAt resets all changes to the "undo_expires_at" field.
+func (m *EmailChangesMutation) ResetUndoExpiresAt() {
+	m.undo_expires_at = nil
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (m *EmailChangesMutation) SetConfirmedAt(t time.Time) {
+	m.confirmed_at = &t
+}
+
+// ConfirmedAt returns the value of the "confirmed_at" field in the mutation.
+func (m *EmailChangesMutation) ConfirmedAt() (r time.Time, exists bool) {
+	v := m.confirmed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfirmedAt returns the old "confirmed_at" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailChangesMutation) OldConfirmedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfirmedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfirmedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfirmedAt: %w", err)
+	}
+	return oldValue.ConfirmedAt, nil
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (m *EmailChangesMutation) ClearConfirmedAt() {
+	m.confirmed_at = nil
+	m.clearedFields[emailchanges.FieldConfirmedAt] = struct{}{}
+}
+
+// ConfirmedAtCleared returns if the "confirmed_at" field was cleared in this mutation.
+func (m *EmailChangesMutation) ConfirmedAtCleared() bool {
+	_, ok := m.clearedFields[emailchanges.FieldConfirmedAt]
+	return ok
+}
+
+// ResetConfirmedAt resets all changes to the "confirmed_at" field.
+func (m *EmailChangesMutation) ResetConfirmedAt() {
+	m.confirmed_at = nil
+	delete(m.clearedFields, emailchanges.FieldConfirmedAt)
+}
+
+// SetRevertedAt sets the "reverted_at" field.
+func (m *EmailChangesMutation) SetRevertedAt(t time.Time) {
+	m.reverted_at = &t
+}
+
+// RevertedAt returns the value of the "reverted_at" field in the mutation.
+func (m *EmailChangesMutation) RevertedAt() (r time.Time, exists bool) {
+	v := m.reverted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevertedAt returns the old "reverted_at" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailChangesMutation) OldRevertedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevertedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevertedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevertedAt: %w", err)
+	}
+	return oldValue.RevertedAt, nil
+}
+
+// ClearRevertedAt clears the value of the "reverted_at" field.
+func (m *EmailChangesMutation) ClearRevertedAt() {
+	m.reverted_at = nil
+	m.clearedFields[emailchanges.FieldRevertedAt] = struct{}{}
+}
+
+// RevertedAtCleared returns if the "reverted_at" field was cleared in this mutation.
+func (m *EmailChangesMutation) RevertedAtCleared() bool {
+	_, ok := m.clearedFields[emailchanges.FieldRevertedAt]
+	return ok
+}
+
+// ResetRevertedAt resets all changes to the "reverted_at" field.
+func (m *EmailChangesMutation) ResetRevertedAt() {
+	m.reverted_at = nil
+	delete(m.clearedFields, emailchanges.FieldRevertedAt)
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *EmailChangesMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *EmailChangesMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailChangesMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *EmailChangesMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[emailchanges.FieldIPAddress] = struct{}{}
+}
+
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *EmailChangesMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[emailchanges.FieldIPAddress]
+	return ok
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *EmailChangesMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, emailchanges.FieldIPAddress)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EmailChangesMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmailChangesMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EmailChanges entity.
+// If the EmailChanges object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailChangesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmailChangesMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the EmailChangesMutation builder.
+func (m *EmailChangesMutation) Where(ps ...predicate.EmailChanges) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmailChangesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmailChangesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmailChanges, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmailChangesMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmailChangesMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmailChanges).
+func (m *EmailChangesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmailChangesMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.user_id != nil {
+		fields = append(fields, emailchanges.FieldUserID)
+	}
+	if m.old_email != nil {
+		fields = append(fields, emailchanges.FieldOldEmail)
+	}
+	if m.old_email_verified != nil {
+		fields = append(fields, emailchanges.FieldOldEmailVerified)
+	}
+	if m.new_email != nil {
+		fields = append(fields, emailchanges.FieldNewEmail)
+	}
+	if m.token != nil {
+		fields = append(fields, emailchanges.FieldToken)
+	}
+	if m.undo_token != nil {
+		fields = append(fields, emailchanges.FieldUndoToken)
+	}
+	if m.status != nil {
+		fields = append(fields, emailchanges.FieldStatus)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, emailchanges.FieldExpiresAt)
+	}
+	if m.undo_expires_at != nil {
+		fields = append(fields, emailchanges.FieldUndoExpiresAt)
+	}
+	if m.confirmed_at != nil {
+		fields = append(fields, emailchanges.FieldConfirmedAt)
+	}
+	if m.reverted_at != nil {
+		fields = append(fields, emailchanges.FieldRevertedAt)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, emailchanges.FieldIPAddress)
+	}
+	if m.created_at != nil {
+		fields = append(fields, emailchanges.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmailChangesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case emailchanges.FieldUserID:
+		return m.UserID()
+	case emailchanges.FieldOldEmail:
+		return m.OldEmail()
+	case emailchanges.FieldOldEmailVerified:
+		return m.OldEmailVerified()
+	case emailchanges.FieldNewEmail:
+		return m.NewEmail()
+	case emailchanges.FieldToken:
+		return m.Token()
+	case emailchanges.FieldUndoToken:
+		return m.UndoToken()
+	case emailchanges.FieldStatus:
+		return m.Status()
+	case emailchanges.FieldExpiresAt:
+		return m.ExpiresAt()
+	case emailchanges.FieldUndoExpiresAt:
+		return m.UndoExpiresAt()
+	case emailchanges.FieldConfirmedAt:
+		return m.ConfirmedAt()
+	case emailchanges.FieldRevertedAt:
+		return m.RevertedAt()
+	case emailchanges.FieldIPAddress:
+		return m.IPAddress()
+	case emailchanges.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmailChangesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case emailchanges.FieldUserID:
+		return m.OldUserID(ctx)
+	case emailchanges.FieldOldEmail:
+		return m.OldOldEmail(ctx)
+	case emailchanges.FieldOldEmailVerified:
+		return m.OldOldEmailVerified(ctx)
+	case emailchanges.FieldNewEmail:
+		return m.OldNewEmail(ctx)
+	case emailchanges.FieldToken:
+		return m.OldToken(ctx)
+	case emailchanges.FieldUndoToken:
+		return m.OldUndoToken(ctx)
+	case emailchanges.FieldStatus:
+		return m.OldStatus(ctx)
+	case emailchanges.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case emailchanges.FieldUndoExpiresAt:
+		return m.OldUndoExpiresAt(ctx)
+	case emailchanges.FieldConfirmedAt:
+		return m.OldConfirmedAt(ctx)
+	case emailchanges.FieldRevertedAt:
+		return m.OldRevertedAt(ctx)
+	case emailchanges.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case emailchanges.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmailChanges field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailChangesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case emailchanges.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case emailchanges.FieldOldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldEmail(v)
+		return nil
+	case emailchanges.FieldOldEmailVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldEmailVerified(v)
+		return nil
+	case emailchanges.FieldNewEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewEmail(v)
+		return nil
+	case emailchanges.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case emailchanges.FieldUndoToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUndoToken(v)
+		return nil
+	case emailchanges.FieldStatus:
+		v, ok := value.(emailchanges.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case emailchanges.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case emailchanges.FieldUndoExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUndoExpiresAt(v)
+		return nil
+	case emailchanges.FieldConfirmedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfirmedAt(v)
+		return nil
+	case emailchanges.FieldRevertedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevertedAt(v)
+		return nil
+	case emailchanges.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case emailchanges.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmailChanges field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmailChangesMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmailChangesMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailChangesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EmailChanges numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmailChangesMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(emailchanges.FieldConfirmedAt) {
+		fields = append(fields, emailchanges.FieldConfirmedAt)
+	}
+	if m.FieldCleared(emailchanges.FieldRevertedAt) {
+		fields = append(fields, emailchanges.FieldRevertedAt)
+	}
+	if m.FieldCleared(emailchanges.FieldIPAddress) {
+		fields = append(fields, emailchanges.FieldIPAddress)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmailChangesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmailChangesMutation) ClearField(name string) error {
+	switch name {
+	case emailchanges.FieldConfirmedAt:
+		m.ClearConfirmedAt()
+		return nil
+	case emailchanges.FieldRevertedAt:
+		m.ClearRevertedAt()
+		return nil
+	case emailchanges.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailChanges nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmailChangesMutation) ResetField(name string) error {
+	switch name {
+	case emailchanges.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case emailchanges.FieldOldEmail:
+		m.ResetOldEmail()
+		return nil
+	case emailchanges.FieldOldEmailVerified:
+		m.ResetOldEmailVerified()
+		return nil
+	case emailchanges.FieldNewEmail:
+		m.ResetNewEmail()
+		return nil
+	case emailchanges.FieldToken:
+		m.ResetToken()
+		return nil
+	case emailchanges.FieldUndoToken:
+		m.ResetUndoToken()
+		return nil
+	case emailchanges.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case emailchanges.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case emailchanges.FieldUndoExpiresAt:
+		m.ResetUndoExpiresAt()
+		return nil
+	case emailchanges.FieldConfirmedAt:
+		m.ResetConfirmedAt()
+		return nil
+	case emailchanges.FieldRevertedAt:
+		m.ResetRevertedAt()
+		return nil
+	case emailchanges.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case emailchanges.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailChanges field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmailChangesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmailChangesMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmailChangesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmailChangesMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmailChangesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmailChangesMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmailChangesMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmailChanges unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmailChangesMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmailChanges edge %s", name)
+}
+
+// EmailLogsMutation represents an operation that mutates the EmailLogs nodes in the graph.
+type EmailLogsMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	user_id             *uuid.UUID
+	recipient           *string
+	email_type          *string
+	subject             *string
+	status              *string
+	provider            *string
+	provider_message_id *string
+	metadata            *map[string]interface{}
+	error_message       *string
+	sent_at             *time.Time
+	delivered_at        *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*EmailLogs, error)
+	predicates          []predicate.EmailLogs
+}
+
+var _ ent.Mutation = (*EmailLogsMutation)(nil)
+
+// emaillogsOption allows management of the mutation configuration using functional options.
+type emaillogsOption func(*EmailLogsMutation)
+
+// newEmailLogsMutation creates new mutation for the EmailLogs entity.
+func newEmailLogsMutation(c config, op Op, opts ...emaillogsOption) *EmailLogsMutation {
+	m := &EmailLogsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmailLogs,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmailLogsID sets the ID field of the mutation.
+func withEmailLogsID(id uuid.UUID) emaillogsOption {
+	return func(m *EmailLogsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmailLogs
+		)
+		m.oldValue = func(ctx context.Context) (*EmailLogs, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmailLogs.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmailLogs sets the old EmailLogs of the mutation.
+func withEmailLogs(node *EmailLogs) emaillogsOption {
+	return func(m *EmailLogsMutation) {
+		m.oldValue = func(context.Context) (*EmailLogs, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmailLogsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmailLogsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EmailLogs entities.
+func (m *EmailLogsMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmailLogsMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmailLogsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmailLogs.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *EmailLogsMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *EmailLogsMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the EmailLogs entity.
+// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailLogsMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *EmailLogsMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[emaillogs.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *EmailLogsMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[emaillogs.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *EmailLogsMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, emaillogs.FieldUserID)
+}
+
+// SetRecipient sets the "recipient" field.
+func (m *EmailLogsMutation) SetRecipient(s string) {
+	m.recipient = &s
+}
+
+// Recipient returns the value of the "recipient" field in the mutation.
+func (m *EmailLogsMutation) Recipient() (r string, exists bool) {
+	v := m.recipient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecipient returns the old "recipient" field's value of the EmailLogs entity.
+// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailLogsMutation) OldRecipient(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecipient is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecipient requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecipient: %w", err)
+	}
+	return oldValue.Recipient, nil
+}
+
+// ResetRecipient resets all changes to the "recipient" field.
+func (m *EmailLogsMutation) ResetRecipient() {
+	m.recipient = nil
+}
+
+// SetEmailType sets the "email_type" field.
+func (m *EmailLogsMutation) SetEmailType(s string) {
+	m.email_type = &s
+}
+
+// EmailType returns the value of the "email_type" field in the mutation.
+func (m *EmailLogsMutation) EmailType() (r string, exists bool) {
+	v := m.email_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailType returns the old "email_type" field's value of the EmailLogs entity.
+// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailLogsMutation) OldEmailType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailType: %w", err)
+	}
+	return oldValue.EmailType, nil
+}
+
+// ResetEmailType resets all changes to the "email_type" field.
+func (m *EmailLogsMutation) ResetEmailType() {
+	m.email_type = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *EmailLogsMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *EmailLogsMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the EmailLogs entity.
+// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailLogsMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (m *EmailLogsMutation) ClearSubject() {
+	m.subject = nil
+	m.clearedFields[emaillogs.FieldSubject] = struct{}{}
+}
+
+// SubjectCleared returns if the "subject" field was cleared in this mutation.
+func (m *EmailLogsMutation) SubjectCleared() bool {
+	_, ok := m.clearedFields[emaillogs.FieldSubject]
+	return ok
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *EmailLogsMutation) ResetSubject() {
+	m.subject = nil
+	delete(m.clearedFields, emaillogs.FieldSubject)
+}
+
+// SetStatus sets the "status" field.
+func (m *EmailLogsMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EmailLogsMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the EmailLogs entity.
+// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailLogsMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *EmailLogsMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *EmailLogsMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *EmailLogsMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the EmailLogs entity.
+// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailLogsMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *EmailLogsMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetProviderMessageID sets the "provider_message_id" field.
+func (m *EmailLogsMutation) SetProviderMessageID(s string) {
+	m.provider_message_id = &s
+}
+
+// ProviderMessageID returns the value of the "provider_message_id" field in the mutation.
+func (m *EmailLogsMutation) ProviderMessageID() (r string, exists bool) {
+	v := m.provider_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderMessageID returns the old "provider_message_id" field's value of the EmailLogs entity.
+// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailLogsMutation) OldProviderMessageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderMessageID: %w", err)
+	}
+	return oldValue.ProviderMessageID, nil
+}
+
+// ClearProviderMessageID clears the value of the "provider_message_id" field.
+func (m *EmailLogsMutation) ClearProviderMessageID() {
+	m.provider_message_id = nil
+	m.clearedFields[emaillogs.FieldProviderMessageID] = struct{}{}
+}
+
+// ProviderMessageIDCleared returns if the "provider_message_id" field was cleared in this mutation.
+func (m *EmailLogsMutation) ProviderMessageIDCleared() bool {
+	_, ok := m.clearedFields[emaillogs.FieldProviderMessageID]
+	return ok
+}
+
+// ResetProviderMessageID resets all changes to the "provider_message_id" field.
+func (m *EmailLogsMutation) ResetProviderMessageID() {
+	m.provider_message_id = nil
+	delete(m.clearedFields, emaillogs.FieldProviderMessageID)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *EmailLogsMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *EmailLogsMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the EmailLogs entity.
+// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailLogsMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *EmailLogsMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[emaillogs.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *EmailLogsMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[emaillogs.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *EmailLogsMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, emaillogs.FieldMetadata)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *EmailLogsMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *EmailLogsMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the EmailLogs entity.
+// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailLogsMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *EmailLogsMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[emaillogs.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *EmailLogsMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[emaillogs.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *EmailLogsMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, emaillogs.FieldErrorMessage)
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *EmailLogsMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *EmailLogsMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the EmailLogs entity.
+// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailLogsMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *EmailLogsMutation) ResetSentAt() {
+	m.sent_at = nil
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *EmailLogsMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *EmailLogsMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredAt returns the old "delivered_at" field's value of the EmailLogs entity.
+// If the EmailLogs object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailLogsMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *EmailLogsMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[emaillogs.FieldDeliveredAt] = struct{}{}
+}
+
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *EmailLogsMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[emaillogs.FieldDeliveredAt]
+	return ok
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *EmailLogsMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, emaillogs.FieldDeliveredAt)
+}
+
+// Where appends a list predicates to the EmailLogsMutation builder.
+func (m *EmailLogsMutation) Where(ps ...predicate.EmailLogs) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmailLogsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmailLogsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmailLogs, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmailLogsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmailLogsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmailLogs).
+func (m *EmailLogsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmailLogsMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.user_id != nil {
+		fields = append(fields, emaillogs.FieldUserID)
+	}
+	if m.recipient != nil {
+		fields = append(fields, emaillogs.FieldRecipient)
+	}
+	if m.email_type != nil {
+		fields = append(fields, emaillogs.FieldEmailType)
+	}
+	if m.subject != nil {
+		fields = append(fields, emaillogs.FieldSubject)
+	}
+	if m.status != nil {
+		fields = append(fields, emaillogs.FieldStatus)
+	}
+	if m.provider != nil {
+		fields = append(fields, emaillogs.FieldProvider)
+	}
+	if m.provider_message_id != nil {
+		fields = append(fields, emaillogs.FieldProviderMessageID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, emaillogs.FieldMetadata)
+	}
+	if m.error_message != nil {
+		fields = append(fields, emaillogs.FieldErrorMessage)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, emaillogs.FieldSentAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, emaillogs.FieldDeliveredAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmailLogsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case emaillogs.FieldUserID:
+		return m.UserID()
+	case emaillogs.FieldRecipient:
+		return m.Recipient()
+	case emaillogs.FieldEmailType:
+		return m.EmailType()
+	case emaillogs.FieldSubject:
+		return m.Subject()
+	case emaillogs.FieldStatus:
+		return m.Status()
+	case emaillogs.FieldProvider:
+		return m.Provider()
+	case emaillogs.FieldProviderMessageID:
+		return m.ProviderMessageID()
+	case emaillogs.FieldMetadata:
+		return m.Metadata()
+	case emaillogs.FieldErrorMessage:
+		return m.ErrorMessage()
+	case emaillogs.FieldSentAt:
+		return m.SentAt()
+	case emaillogs.FieldDeliveredAt:
+		return m.DeliveredAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmailLogsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case emaillogs.FieldUserID:
+		return m.OldUserID(ctx)
+	case emaillogs.FieldRecipient:
+		return m.OldRecipient(ctx)
+	case emaillogs.FieldEmailType:
+		return m.OldEmailType(ctx)
+	case emaillogs.FieldSubject:
+		return m.OldSubject(ctx)
+	case emaillogs.FieldStatus:
+		return m.OldStatus(ctx)
+	case emaillogs.FieldProvider:
+		return m.OldProvider(ctx)
+	case emaillogs.FieldProviderMessageID:
+		return m.OldProviderMessageID(ctx)
+	case emaillogs.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case emaillogs.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case emaillogs.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case emaillogs.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmailLogs field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailLogsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case emaillogs.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case emaillogs.FieldRecipient:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecipient(v)
+		return nil
+	case emaillogs.FieldEmailType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailType(v)
+		return nil
+	case emaillogs.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case emaillogs.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case emaillogs.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case emaillogs.FieldProviderMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderMessageID(v)
+		return nil
+	case emaillogs.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case emaillogs.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case emaillogs.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	case emaillogs.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmailLogs field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmailLogsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmailLogsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailLogsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EmailLogs numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmailLogsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(emaillogs.FieldUserID) {
+		fields = append(fields, emaillogs.FieldUserID)
+	}
+	if m.FieldCleared(emaillogs.FieldSubject) {
+		fields = append(fields, emaillogs.FieldSubject)
+	}
+	if m.FieldCleared(emaillogs.FieldProviderMessageID) {
+		fields = append(fields, emaillogs.FieldProviderMessageID)
+	}
+	if m.FieldCleared(emaillogs.FieldMetadata) {
+		fields = append(fields, emaillogs.FieldMetadata)
+	}
+	if m.FieldCleared(emaillogs.FieldErrorMessage) {
+		fields = append(fields, emaillogs.FieldErrorMessage)
+	}
+	if m.FieldCleared(emaillogs.FieldDeliveredAt) {
+		fields = append(fields, emaillogs.FieldDeliveredAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmailLogsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmailLogsMutation) ClearField(name string) error {
+	switch name {
+	case emaillogs.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case emaillogs.FieldSubject:
+		m.ClearSubject()
+		return nil
+	case emaillogs.FieldProviderMessageID:
+		m.ClearProviderMessageID()
+		return nil
+	case emaillogs.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case emaillogs.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case emaillogs.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailLogs nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmailLogsMutation) ResetField(name string) error {
+	switch name {
+	case emaillogs.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case emaillogs.FieldRecipient:
+		m.ResetRecipient()
+		return nil
+	case emaillogs.FieldEmailType:
+		m.ResetEmailType()
+		return nil
+	case emaillogs.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case emaillogs.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case emaillogs.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case emaillogs.FieldProviderMessageID:
+		m.ResetProviderMessageID()
+		return nil
+	case emaillogs.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case emaillogs.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case emaillogs.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case emaillogs.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailLogs field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmailLogsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmailLogsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmailLogsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmailLogsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmailLogsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmailLogsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmailLogsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmailLogs unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmailLogsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmailLogs edge %s", name)
+}
+
+// EmailVerificationsMutation represents an operation that mutates the EmailVerifications nodes in the graph.
+type EmailVerificationsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	email         *string
+	token         *string
+	expires_at    *time.Time
+	is_used       *bool
+	used_at       *time.Time
+	ip_address    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EmailVerifications, error)
+	predicates    []predicate.EmailVerifications
+}
+
+var _ ent.Mutation = (*EmailVerificationsMutation)(nil)
+
+// emailverificationsOption allows management of the mutation configuration using functional options.
+type emailverificationsOption func(*EmailVerificationsMutation)
+
+// newEmailVerificationsMutation creates new mutation for the EmailVerifications entity.
+func newEmailVerificationsMutation(c config, op Op, opts ...emailverificationsOption) *EmailVerificationsMutation {
+	m := &EmailVerificationsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmailVerifications,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmailVerificationsID sets the ID field of the mutation.
+func withEmailVerificationsID(id uuid.UUID) emailverificationsOption {
+	return func(m *EmailVerificationsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmailVerifications
+		)
+		m.oldValue = func(ctx context.Context) (*EmailVerifications, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmailVerifications.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmailVerifications sets the old EmailVerifications of the mutation.
+func withEmailVerifications(node *EmailVerifications) emailverificationsOption {
+	return func(m *EmailVerificationsMutation) {
+		m.oldValue = func(context.Context) (*EmailVerifications, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmailVerificationsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmailVerificationsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EmailVerifications entities.
+func (m *EmailVerificationsMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmailVerificationsMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmailVerificationsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmailVerifications.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *EmailVerificationsMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *EmailVerificationsMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the EmailVerifications entity.
+// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *EmailVerificationsMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *EmailVerificationsMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *EmailVerificationsMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the EmailVerifications entity.
+// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationsMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *EmailVerificationsMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetToken sets the "token" field.
+func (m *EmailVerificationsMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *EmailVerificationsMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the EmailVerifications entity.
+// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationsMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *EmailVerificationsMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *EmailVerificationsMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *EmailVerificationsMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the EmailVerifications entity.
+// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationsMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *EmailVerificationsMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetIsUsed sets the "is_used" field.
+func (m *EmailVerificationsMutation) SetIsUsed(b bool) {
+	m.is_used = &b
+}
+
+// IsUsed returns the value of the "is_used" field in the mutation.
+func (m *EmailVerificationsMutation) IsUsed() (r bool, exists bool) {
+	v := m.is_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsUsed returns the old "is_used" field's value of the EmailVerifications entity.
+// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationsMutation) OldIsUsed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsUsed: %w", err)
+	}
+	return oldValue.IsUsed, nil
+}
+
+// ResetIsUsed resets all changes to the "is_used" field.
+func (m *EmailVerificationsMutation) ResetIsUsed() {
+	m.is_used = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *EmailVerificationsMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *EmailVerificationsMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the EmailVerifications entity.
+// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationsMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *EmailVerificationsMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[emailverifications.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *EmailVerificationsMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[emailverifications.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *EmailVerificationsMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, emailverifications.FieldUsedAt)
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *EmailVerificationsMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *EmailVerificationsMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the EmailVerifications entity.
+// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationsMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *EmailVerificationsMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[emailverifications.FieldIPAddress] = struct{}{}
+}
+
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *EmailVerificationsMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[emailverifications.FieldIPAddress]
+	return ok
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *EmailVerificationsMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, emailverifications.FieldIPAddress)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EmailVerificationsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmailVerificationsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EmailVerifications entity.
+// If the EmailVerifications object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmailVerificationsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the EmailVerificationsMutation builder.
+func (m *EmailVerificationsMutation) Where(ps ...predicate.EmailVerifications) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmailVerificationsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmailVerificationsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmailVerifications, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmailVerificationsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmailVerificationsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmailVerifications).
+func (m *EmailVerificationsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmailVerificationsMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user_id != nil {
+		fields = append(fields, emailverifications.FieldUserID)
+	}
+	if m.email != nil {
+		fields = append(fields, emailverifications.FieldEmail)
+	}
+	if m.token != nil {
+		fields = append(fields, emailverifications.FieldToken)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, emailverifications.FieldExpiresAt)
+	}
+	if m.is_used != nil {
+		fields = append(fields, emailverifications.FieldIsUsed)
+	}
+	if m.used_at != nil {
+		fields = append(fields, emailverifications.FieldUsedAt)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, emailverifications.FieldIPAddress)
+	}
+	if m.created_at != nil {
+		fields = append(fields, emailverifications.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmailVerificationsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case emailverifications.FieldUserID:
+		return m.UserID()
+	case emailverifications.FieldEmail:
+		return m.Email()
+	case emailverifications.FieldToken:
+		return m.Token()
+	case emailverifications.FieldExpiresAt:
+		return m.ExpiresAt()
+	case emailverifications.FieldIsUsed:
+		return m.IsUsed()
+	case emailverifications.FieldUsedAt:
+		return m.UsedAt()
+	case emailverifications.FieldIPAddress:
+		return m.IPAddress()
+	case emailverifications.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmailVerificationsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case emailverifications.FieldUserID:
+		return m.OldUserID(ctx)
+	case emailverifications.FieldEmail:
+		return m.OldEmail(ctx)
+	case emailverifications.FieldToken:
+		return m.OldToken(ctx)
+	case emailverifications.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case emailverifications.FieldIsUsed:
+		return m.OldIsUsed(ctx)
+	case emailverifications.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case emailverifications.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case emailverifications.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmailVerifications field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case emailverifications.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case emailverifications.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case emailverifications.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case emailverifications.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case emailverifications.FieldIsUsed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsUsed(v)
+		return nil
+	case emailverifications.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case emailverifications.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case emailverifications.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerifications field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmailVerificationsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmailVerificationsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EmailVerifications numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmailVerificationsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(emailverifications.FieldUsedAt) {
+		fields = append(fields, emailverifications.FieldUsedAt)
+	}
+	if m.FieldCleared(emailverifications.FieldIPAddress) {
+		fields = append(fields, emailverifications.FieldIPAddress)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmailVerificationsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmailVerificationsMutation) ClearField(name string) error {
+	switch name {
+	case emailverifications.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	case emailverifications.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerifications nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmailVerificationsMutation) ResetField(name string) error {
+	switch name {
+	case emailverifications.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case emailverifications.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case emailverifications.FieldToken:
+		m.ResetToken()
+		return nil
+	case emailverifications.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case emailverifications.FieldIsUsed:
+		m.ResetIsUsed()
+		return nil
+	case emailverifications.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case emailverifications.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case emailverifications.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerifications field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmailVerificationsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmailVerificationsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmailVerificationsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmailVerificationsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmailVerificationsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmailVerificationsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmailVerificationsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmailVerifications unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmailVerificationsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmailVerifications edge %s", name)
+}
+
+// GroupMembersMutation represents an operation that mutates the GroupMembers nodes in the graph.
+type GroupMembersMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	added_by      *uuid.UUID
+	added_at      *time.Time
+	clearedFields map[string]struct{}
+	group         *int
+	clearedgroup  bool
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*GroupMembers, error)
+	predicates    []predicate.GroupMembers
+}
+
+var _ ent.Mutation = (*GroupMembersMutation)(nil)
+
+// groupmembersOption allows management of the mutation configuration using functional options.
+type groupmembersOption func(*GroupMembersMutation)
+
+// newGroupMembersMutation creates new mutation for the GroupMembers entity.
+func newGroupMembersMutation(c config, op Op, opts ...groupmembersOption) *GroupMembersMutation {
+	m := &GroupMembersMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupMembers,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupMembersID sets the ID field of the mutation.
+func withGroupMembersID(id uuid.UUID) groupmembersOption {
+	return func(m *GroupMembersMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupMembers
+		)
+		m.oldValue = func(ctx context.Context) (*GroupMembers, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupMembers.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroupMembers sets the old GroupMembers of the mutation.
+func withGroupMembers(node *GroupMembers) groupmembersOption {
+	return func(m *GroupMembersMutation) {
+		m.oldValue = func(context.Context) (*GroupMembers, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupMembersMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupMembersMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GroupMembers entities.
+func (m *GroupMembersMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupMembersMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupMembersMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupMembers.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *GroupMembersMutation) SetGroupID(i int) {
+	m.group = &i
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *GroupMembersMutation) GroupID() (r int, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupID returns the old "group_id" field's value of the GroupMembers entity.
+// If the GroupMembers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMembersMutation) OldGroupID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *GroupMembersMutation) ResetGroupID() {
+	m.group = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *GroupMembersMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *GroupMembersMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the GroupMembers entity.
+// If the GroupMembers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMembersMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *GroupMembersMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetAddedBy sets the "added_by" field.
+func (m *GroupMembersMutation) SetAddedBy(u uuid.UUID) {
+	m.added_by = &u
+}
+
+// AddedBy returns the value of the "added_by" field in the mutation.
+func (m *GroupMembersMutation) AddedBy() (r uuid.UUID, exists bool) {
+	v := m.added_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedBy returns the old "added_by" field's value of the GroupMembers entity.
+// If the GroupMembers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMembersMutation) OldAddedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedBy: %w", err)
+	}
+	return oldValue.AddedBy, nil
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (m *GroupMembersMutation) ClearAddedBy() {
+	m.added_by = nil
+	m.clearedFields[groupmembers.FieldAddedBy] = struct{}{}
+}
+
+// AddedByCleared returns if the "added_by" field was cleared in this mutation.
+func (m *GroupMembersMutation) AddedByCleared() bool {
+	_, ok := m.clearedFields[groupmembers.FieldAddedBy]
+	return ok
+}
+
+// ResetAddedBy resets all changes to the "added_by" field.
+func (m *GroupMembersMutation) ResetAddedBy() {
+	m.added_by = nil
+	delete(m.clearedFields, groupmembers.FieldAddedBy)
+}
+
+// SetAddedAt sets the "added_at" field.
+func (m *GroupMembersMutation) SetAddedAt(t time.Time) {
+	m.added_at = &t
+}
+
+// AddedAt returns the value of the "added_at" field in the mutation.
+func (m *GroupMembersMutation) AddedAt() (r time.Time, exists bool) {
+	v := m.added_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedAt returns the old "added_at" field's value of the GroupMembers entity.
+// If the GroupMembers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMembersMutation) OldAddedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedAt: %w", err)
+	}
+	return oldValue.AddedAt, nil
+}
+
+// ResetAddedAt resets all changes to the "added_at" field.
+func (m *GroupMembersMutation) ResetAddedAt() {
+	m.added_at = nil
+}
+
+// ClearGroup clears the "group" edge to the Groups entity.
+func (m *GroupMembersMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[groupmembers.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the Groups entity was cleared.
+func (m *GroupMembersMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *GroupMembersMutation) GroupIDs() (ids []int) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *GroupMembersMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// ClearUser clears the "user" edge to the Users entity.
+func (m *GroupMembersMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[groupmembers.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the Users entity was cleared.
+func (m *GroupMembersMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *GroupMembersMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *GroupMembersMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the GroupMembersMutation builder.
+func (m *GroupMembersMutation) Where(ps ...predicate.GroupMembers) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupMembersMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMembersMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupMembers, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupMembersMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupMembersMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GroupMembers).
+func (m *GroupMembersMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupMembersMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.group != nil {
+		fields = append(fields, groupmembers.FieldGroupID)
+	}
+	if m.user != nil {
+		fields = append(fields, groupmembers.FieldUserID)
+	}
+	if m.added_by != nil {
+		fields = append(fields, groupmembers.FieldAddedBy)
+	}
+	if m.added_at != nil {
+		fields = append(fields, groupmembers.FieldAddedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupMembersMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groupmembers.FieldGroupID:
+		return m.GroupID()
+	case groupmembers.FieldUserID:
+		return m.UserID()
+	case groupmembers.FieldAddedBy:
+		return m.AddedBy()
+	case groupmembers.FieldAddedAt:
+		return m.AddedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupMembersMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groupmembers.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case groupmembers.FieldUserID:
+		return m.OldUserID(ctx)
+	case groupmembers.FieldAddedBy:
+		return m.OldAddedBy(ctx)
+	case groupmembers.FieldAddedAt:
+		return m.OldAddedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupMembers field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMembersMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groupmembers.FieldGroupID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case groupmembers.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case groupmembers.FieldAddedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedBy(v)
+		return nil
+	case groupmembers.FieldAddedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembers field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupMembersMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupMembersMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMembersMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GroupMembers numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupMembersMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(groupmembers.FieldAddedBy) {
+		fields = append(fields, groupmembers.FieldAddedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupMembersMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupMembersMutation) ClearField(name string) error {
+	switch name {
+	case groupmembers.FieldAddedBy:
+		m.ClearAddedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembers nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupMembersMutation) ResetField(name string) error {
+	switch name {
+	case groupmembers.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case groupmembers.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case groupmembers.FieldAddedBy:
+		m.ResetAddedBy()
+		return nil
+	case groupmembers.FieldAddedAt:
+		m.ResetAddedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembers field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupMembersMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, groupmembers.EdgeGroup)
+	}
+	if m.user != nil {
+		edges = append(edges, groupmembers.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupMembersMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case groupmembers.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case groupmembers.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupMembersMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupMembersMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupMembersMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, groupmembers.EdgeGroup)
+	}
+	if m.cleareduser {
+		edges = append(edges, groupmembers.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupMembersMutation) EdgeCleared(name string) bool {
+	switch name {
+	case groupmembers.EdgeGroup:
+		return m.clearedgroup
+	case groupmembers.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupMembersMutation) ClearEdge(name string) error {
+	switch name {
+	case groupmembers.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case groupmembers.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembers unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupMembersMutation) ResetEdge(name string) error {
+	switch name {
+	case groupmembers.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case groupmembers.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupMembers edge %s", name)
+}
+
+// GroupParentsMutation represents an operation that mutates the GroupParents nodes in the graph.
+type GroupParentsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	group         *int
+	clearedgroup  bool
+	parent        *int
+	clearedparent bool
+	done          bool
+	oldValue      func(context.Context) (*GroupParents, error)
+	predicates    []predicate.GroupParents
+}
+
+var _ ent.Mutation = (*GroupParentsMutation)(nil)
+
+// groupparentsOption allows management of the mutation configuration using functional options.
+type groupparentsOption func(*GroupParentsMutation)
+
+// newGroupParentsMutation creates new mutation for the GroupParents entity.
+func newGroupParentsMutation(c config, op Op, opts ...groupparentsOption) *GroupParentsMutation {
+	m := &GroupParentsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupParents,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupParentsID sets the ID field of the mutation.
+func withGroupParentsID(id int) groupparentsOption {
+	return func(m *GroupParentsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupParents
+		)
+		m.oldValue = func(ctx context.Context) (*GroupParents, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupParents.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroupParents sets the old GroupParents of the mutation.
+func withGroupParents(node *GroupParents) groupparentsOption {
+	return func(m *GroupParentsMutation) {
+		m.oldValue = func(context.Context) (*GroupParents, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupParentsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupParentsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GroupParents entities.
+func (m *GroupParentsMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupParentsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupParentsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupParents.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *GroupParentsMutation) SetGroupID(i int) {
+	m.group = &i
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *GroupParentsMutation) GroupID() (r int, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupID returns the old "group_id" field's value of the GroupParents entity.
+// If the GroupParents object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupParentsMutation) OldGroupID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *GroupParentsMutation) ResetGroupID() {
+	m.group = nil
+}
+
+// SetParentGroupID sets the "parent_group_id" field.
+func (m *GroupParentsMutation) SetParentGroupID(i int) {
+	m.parent = &i
+}
+
+// ParentGroupID returns the value of the "parent_group_id" field in the mutation.
+func (m *GroupParentsMutation) ParentGroupID() (r int, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentGroupID returns the old "parent_group_id" field's value of the GroupParents entity.
+// If the GroupParents object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupParentsMutation) OldParentGroupID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentGroupID: %w", err)
+	}
+	return oldValue.ParentGroupID, nil
+}
+
+// ResetParentGroupID resets all changes to the "parent_group_id" field.
+func (m *GroupParentsMutation) ResetParentGroupID() {
+	m.parent = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupParentsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupParentsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GroupParents entity.
+// If the GroupParents object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupParentsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupParentsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearGroup clears the "group" edge to the Groups entity.
+func (m *GroupParentsMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[groupparents.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the Groups entity was cleared.
+func (m *GroupParentsMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *GroupParentsMutation) GroupIDs() (ids []int) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *GroupParentsMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// SetParentID sets the "parent" edge to the Groups entity by id.
+func (m *GroupParentsMutation) SetParentID(id int) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Groups entity.
+func (m *GroupParentsMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[groupparents.FieldParentGroupID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Groups entity was cleared.
+func (m *GroupParentsMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *GroupParentsMutation) ParentID() (id int, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *GroupParentsMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *GroupParentsMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// Where appends a list predicates to the GroupParentsMutation builder.
+func (m *GroupParentsMutation) Where(ps ...predicate.GroupParents) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupParentsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupParentsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupParents, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupParentsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupParentsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GroupParents).
+func (m *GroupParentsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupParentsMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.group != nil {
+		fields = append(fields, groupparents.FieldGroupID)
+	}
+	if m.parent != nil {
+		fields = append(fields, groupparents.FieldParentGroupID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, groupparents.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupParentsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groupparents.FieldGroupID:
+		return m.GroupID()
+	case groupparents.FieldParentGroupID:
+		return m.ParentGroupID()
+	case groupparents.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupParentsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groupparents.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case groupparents.FieldParentGroupID:
+		return m.OldParentGroupID(ctx)
+	case groupparents.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupParents field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupParentsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groupparents.FieldGroupID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case groupparents.FieldParentGroupID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentGroupID(v)
+		return nil
+	case groupparents.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupParents field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupParentsMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupParentsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupParentsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GroupParents numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupParentsMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupParentsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupParentsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GroupParents nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupParentsMutation) ResetField(name string) error {
+	switch name {
+	case groupparents.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case groupparents.FieldParentGroupID:
+		m.ResetParentGroupID()
+		return nil
+	case groupparents.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupParents field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupParentsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, groupparents.EdgeGroup)
+	}
+	if m.parent != nil {
+		edges = append(edges, groupparents.EdgeParent)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupParentsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case groupparents.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case groupparents.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupParentsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupParentsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupParentsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, groupparents.EdgeGroup)
+	}
+	if m.clearedparent {
+		edges = append(edges, groupparents.EdgeParent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupParentsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case groupparents.EdgeGroup:
+		return m.clearedgroup
+	case groupparents.EdgeParent:
+		return m.clearedparent
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupParentsMutation) ClearEdge(name string) error {
+	switch name {
+	case groupparents.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case groupparents.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupParents unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupParentsMutation) ResetEdge(name string) error {
+	switch name {
+	case groupparents.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case groupparents.EdgeParent:
+		m.ResetParent()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupParents edge %s", name)
+}
+
+// GroupRolesMutation represents an operation that mutates the GroupRoles nodes in the graph.
+type GroupRolesMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	assigned_by   *uuid.UUID
+	assigned_at   *time.Time
+	clearedFields map[string]struct{}
+	group         *int
+	clearedgroup  bool
+	role          *int
+	clearedrole   bool
+	done          bool
+	oldValue      func(context.Context) (*GroupRoles, error)
+	predicates    []predicate.GroupRoles
+}
+
+var _ ent.Mutation = (*GroupRolesMutation)(nil)
+
+// grouprolesOption allows management of the mutation configuration using functional options.
+type grouprolesOption func(*GroupRolesMutation)
+
+// newGroupRolesMutation creates new mutation for the GroupRoles entity.
+func newGroupRolesMutation(c config, op Op, opts ...grouprolesOption) *GroupRolesMutation {
+	m := &GroupRolesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupRoles,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupRolesID sets the ID field of the mutation.
+func withGroupRolesID(id int) grouprolesOption {
+	return func(m *GroupRolesMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupRoles
+		)
+		m.oldValue = func(ctx context.Context) (*GroupRoles, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupRoles.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroupRoles sets the old GroupRoles of the mutation.
+func withGroupRoles(node *GroupRoles) grouprolesOption {
+	return func(m *GroupRolesMutation) {
+		m.oldValue = func(context.Context) (*GroupRoles, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupRolesMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupRolesMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GroupRoles entities.
+func (m *GroupRolesMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupRolesMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupRolesMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupRoles.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *GroupRolesMutation) SetGroupID(i int) {
+	m.group = &i
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *GroupRolesMutation) GroupID() (r int, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupID returns the old "group_id" field's value of the GroupRoles entity.
+// If the GroupRoles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupRolesMutation) OldGroupID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *GroupRolesMutation) ResetGroupID() {
+	m.group = nil
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *GroupRolesMutation) SetRoleID(i int) {
+	m.role = &i
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *GroupRolesMutation) RoleID() (r int, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the GroupRoles entity.
+// If the GroupRoles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupRolesMutation) OldRoleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *GroupRolesMutation) ResetRoleID() {
+	m.role = nil
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (m *GroupRolesMutation) SetAssignedBy(u uuid.UUID) {
+	m.assigned_by = &u
+}
+
+// AssignedBy returns the value of the "assigned_by" field in the mutation.
+func (m *GroupRolesMutation) AssignedBy() (r uuid.UUID, exists bool) {
+	v := m.assigned_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedBy returns the old "assigned_by" field's value of the GroupRoles entity.
+// If the GroupRoles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupRolesMutation) OldAssignedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedBy: %w", err)
+	}
+	return oldValue.AssignedBy, nil
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (m *GroupRolesMutation) ClearAssignedBy() {
+	m.assigned_by = nil
+	m.clearedFields[grouproles.FieldAssignedBy] = struct{}{}
+}
+
+// AssignedByCleared returns if the "assigned_by" field was cleared in this mutation.
+func (m *GroupRolesMutation) AssignedByCleared() bool {
+	_, ok := m.clearedFields[grouproles.FieldAssignedBy]
+	return ok
+}
+
+// ResetAssignedBy resets all changes to the "assigned_by" field.
+func (m *GroupRolesMutation) ResetAssignedBy() {
+	m.assigned_by = nil
+	delete(m.clearedFields, grouproles.FieldAssignedBy)
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (m *GroupRolesMutation) SetAssignedAt(t time.Time) {
+	m.assigned_at = &t
+}
+
+// AssignedAt returns the value of the "assigned_at" field in the mutation.
+func (m *GroupRolesMutation) AssignedAt() (r time.Time, exists bool) {
+	v := m.assigned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedAt returns the old "assigned_at" field's value of the GroupRoles entity.
+// If the GroupRoles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupRolesMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+	}
+	return oldValue.AssignedAt, nil
+}
+
+// ResetAssignedAt resets all changes to the "assigned_at" field.
+func (m *GroupRolesMutation) ResetAssignedAt() {
+	m.assigned_at = nil
+}
+
+// ClearGroup clears the "group" edge to the Groups entity.
+func (m *GroupRolesMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[grouproles.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the Groups entity was cleared.
+func (m *GroupRolesMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *GroupRolesMutation) GroupIDs() (ids []int) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *GroupRolesMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (m *GroupRolesMutation) ClearRole() {
+	m.clearedrole = true
+	m.clearedFields[grouproles.FieldRoleID] = struct{}{}
+}
+
+// RoleCleared reports if the "role" edge to the Roles entity was cleared.
+func (m *GroupRolesMutation) RoleCleared() bool {
+	return m.clearedrole
+}
+
+// RoleIDs returns the "role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleID instead. It exists only for internal usage by the builders.
+func (m *GroupRolesMutation) RoleIDs() (ids []int) {
+	if id := m.role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRole resets all changes to the "role" edge.
+func (m *GroupRolesMutation) ResetRole() {
+	m.role = nil
+	m.clearedrole = false
+}
+
+// Where appends a list predicates to the GroupRolesMutation builder.
+func (m *GroupRolesMutation) Where(ps ...predicate.GroupRoles) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupRolesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupRolesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupRoles, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupRolesMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupRolesMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GroupRoles).
+func (m *GroupRolesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupRolesMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.group != nil {
+		fields = append(fields, grouproles.FieldGroupID)
+	}
+	if m.role != nil {
+		fields = append(fields, grouproles.FieldRoleID)
+	}
+	if m.assigned_by != nil {
+		fields = append(fields, grouproles.FieldAssignedBy)
+	}
+	if m.assigned_at != nil {
+		fields = append(fields, grouproles.FieldAssignedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupRolesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case grouproles.FieldGroupID:
+		return m.GroupID()
+	case grouproles.FieldRoleID:
+		return m.RoleID()
+	case grouproles.FieldAssignedBy:
+		return m.AssignedBy()
+	case grouproles.FieldAssignedAt:
+		return m.AssignedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupRolesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case grouproles.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case grouproles.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case grouproles.FieldAssignedBy:
+		return m.OldAssignedBy(ctx)
+	case grouproles.FieldAssignedAt:
+		return m.OldAssignedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupRoles field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupRolesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case grouproles.FieldGroupID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case grouproles.FieldRoleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case grouproles.FieldAssignedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedBy(v)
+		return nil
+	case grouproles.FieldAssignedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupRoles field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupRolesMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupRolesMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupRolesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GroupRoles numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupRolesMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(grouproles.FieldAssignedBy) {
+		fields = append(fields, grouproles.FieldAssignedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupRolesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupRolesMutation) ClearField(name string) error {
+	switch name {
+	case grouproles.FieldAssignedBy:
+		m.ClearAssignedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupRoles nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupRolesMutation) ResetField(name string) error {
+	switch name {
+	case grouproles.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case grouproles.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	case grouproles.FieldAssignedBy:
+		m.ResetAssignedBy()
+		return nil
+	case grouproles.FieldAssignedAt:
+		m.ResetAssignedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupRoles field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupRolesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group != nil {
+		edges = append(edges, grouproles.EdgeGroup)
+	}
+	if m.role != nil {
+		edges = append(edges, grouproles.EdgeRole)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupRolesMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case grouproles.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case grouproles.EdgeRole:
+		if id := m.role; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupRolesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupRolesMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupRolesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup {
+		edges = append(edges, grouproles.EdgeGroup)
+	}
+	if m.clearedrole {
+		edges = append(edges, grouproles.EdgeRole)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupRolesMutation) EdgeCleared(name string) bool {
+	switch name {
+	case grouproles.EdgeGroup:
+		return m.clearedgroup
+	case grouproles.EdgeRole:
+		return m.clearedrole
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupRolesMutation) ClearEdge(name string) error {
+	switch name {
+	case grouproles.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case grouproles.EdgeRole:
+		m.ClearRole()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupRoles unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupRolesMutation) ResetEdge(name string) error {
+	switch name {
+	case grouproles.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case grouproles.EdgeRole:
+		m.ResetRole()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupRoles edge %s", name)
+}
+
+// GroupsMutation represents an operation that mutates the Groups nodes in the graph.
+type GroupsMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	code                *string
+	name                *string
+	description         *string
+	created_by          *uuid.UUID
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	members             map[uuid.UUID]struct{}
+	removedmembers      map[uuid.UUID]struct{}
+	clearedmembers      bool
+	group_roles         map[int]struct{}
+	removedgroup_roles  map[int]struct{}
+	clearedgroup_roles  bool
+	parent_links        map[int]struct{}
+	removedparent_links map[int]struct{}
+	clearedparent_links bool
+	child_links         map[int]struct{}
+	removedchild_links  map[int]struct{}
+	clearedchild_links  bool
+	done                bool
+	oldValue            func(context.Context) (*Groups, error)
+	predicates          []predicate.Groups
+}
+
+var _ ent.Mutation = (*GroupsMutation)(nil)
+
+// groupsOption allows management of the mutation configuration using functional options.
+type groupsOption func(*GroupsMutation)
+
+// newGroupsMutation creates new mutation for the Groups entity.
+func newGroupsMutation(c config, op Op, opts ...groupsOption) *GroupsMutation {
+	m := &GroupsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroups,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupsID sets the ID field of the mutation.
+func withGroupsID(id int) groupsOption {
+	return func(m *GroupsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Groups
+		)
+		m.oldValue = func(ctx context.Context) (*Groups, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Groups.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroups sets the old Groups of the mutation.
+func withGroups(node *Groups) groupsOption {
+	return func(m *GroupsMutation) {
+		m.oldValue = func(context.Context) (*Groups, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Groups entities.
+func (m *GroupsMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Groups.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *GroupsMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *GroupsMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Groups entity.
+// If the Groups object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupsMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *GroupsMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *GroupsMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GroupsMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Groups entity.
+// If the Groups object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupsMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GroupsMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *GroupsMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *GroupsMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Groups entity.
+// If the Groups object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupsMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *GroupsMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[groups.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *GroupsMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[groups.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *GroupsMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, groups.FieldDescription)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *GroupsMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *GroupsMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Groups entity.
+// If the Groups object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupsMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *GroupsMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[groups.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *GroupsMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[groups.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *GroupsMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, groups.FieldCreatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Groups entity.
+// If the Groups object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GroupsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GroupsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Groups entity.
+// If the Groups object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GroupsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddMemberIDs adds the "members" edge to the GroupMembers entity by ids.
+func (m *GroupsMutation) AddMemberIDs(ids ...uuid.UUID) {
+	if m.members == nil {
+		m.members = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the GroupMembers entity.
+func (m *GroupsMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the GroupMembers entity was cleared.
+func (m *GroupsMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the GroupMembers entity by IDs.
+func (m *GroupsMutation) RemoveMemberIDs(ids ...uuid.UUID) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the GroupMembers entity.
+func (m *GroupsMutation) RemovedMembersIDs() (ids []uuid.UUID) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *GroupsMutation) MembersIDs() (ids []uuid.UUID) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *GroupsMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// AddGroupRoleIDs adds the "group_roles" edge to the GroupRoles entity by ids.
+func (m *GroupsMutation) AddGroupRoleIDs(ids ...int) {
+	if m.group_roles == nil {
+		m.group_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.group_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGroupRoles clears the "group_roles" edge to the GroupRoles entity.
+func (m *GroupsMutation) ClearGroupRoles() {
+	m.clearedgroup_roles = true
+}
+
+// GroupRolesCleared reports if the "group_roles" edge to the GroupRoles entity was cleared.
+func (m *GroupsMutation) GroupRolesCleared() bool {
+	return m.clearedgroup_roles
+}
+
+// RemoveGroupRoleIDs removes the "group_roles" edge to the GroupRoles entity by IDs.
+func (m *GroupsMutation) RemoveGroupRoleIDs(ids ...int) {
+	if m.removedgroup_roles == nil {
+		m.removedgroup_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.group_roles, ids[i])
+		m.removedgroup_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroupRoles returns the removed IDs of the "group_roles" edge to the GroupRoles entity.
+func (m *GroupsMutation) RemovedGroupRolesIDs() (ids []int) {
+	for id := range m.removedgroup_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupRolesIDs returns the "group_roles" edge IDs in the mutation.
+func (m *GroupsMutation) GroupRolesIDs() (ids []int) {
+	for id := range m.group_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroupRoles resets all changes to the "group_roles" edge.
+func (m *GroupsMutation) ResetGroupRoles() {
+	m.group_roles = nil
+	m.clearedgroup_roles = false
+	m.removedgroup_roles = nil
+}
+
+// AddParentLinkIDs adds the "parent_links" edge to the GroupParents entity by ids.
+func (m *GroupsMutation) AddParentLinkIDs(ids ...int) {
+	if m.parent_links == nil {
+		m.parent_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.parent_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParentLinks clears the "parent_links" edge to the GroupParents entity.
+func (m *GroupsMutation) ClearParentLinks() {
+	m.clearedparent_links = true
+}
+
+// ParentLinksCleared reports if the "parent_links" edge to the GroupParents entity was cleared.
+func (m *GroupsMutation) ParentLinksCleared() bool {
+	return m.clearedparent_links
+}
+
+// RemoveParentLinkIDs removes the "parent_links" edge to the GroupParents entity by IDs.
+func (m *GroupsMutation) RemoveParentLinkIDs(ids ...int) {
+	if m.removedparent_links == nil {
+		m.removedparent_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.parent_links, ids[i])
+		m.removedparent_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParentLinks returns the removed IDs of the "parent_links" edge to the GroupParents entity.
+func (m *GroupsMutation) RemovedParentLinksIDs() (ids []int) {
+	for id := range m.removedparent_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParentLinksIDs returns the "parent_links" edge IDs in the mutation.
+func (m *GroupsMutation) ParentLinksIDs() (ids []int) {
+	for id := range m.parent_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParentLinks resets all changes to the "parent_links" edge.
+func (m *GroupsMutation) ResetParentLinks() {
+	m.parent_links = nil
+	m.clearedparent_links = false
+	m.removedparent_links = nil
+}
+
+// AddChildLinkIDs adds the "child_links" edge to the GroupParents entity by ids.
+func (m *GroupsMutation) AddChildLinkIDs(ids ...int) {
+	if m.child_links == nil {
+		m.child_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.child_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildLinks clears the "child_links" edge to the GroupParents entity.
+func (m *GroupsMutation) ClearChildLinks() {
+	m.clearedchild_links = true
+}
+
+// ChildLinksCleared reports if the "child_links" edge to the GroupParents entity was cleared.
+func (m *GroupsMutation) ChildLinksCleared() bool {
+	return m.clearedchild_links
+}
+
+// RemoveChildLinkIDs removes the "child_links" edge to the GroupParents entity by IDs.
+func (m *GroupsMutation) RemoveChildLinkIDs(ids ...int) {
+	if m.removedchild_links == nil {
+		m.removedchild_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.child_links, ids[i])
+		m.removedchild_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildLinks returns the removed IDs of the "child_links" edge to the GroupParents entity.
+func (m *GroupsMutation) RemovedChildLinksIDs() (ids []int) {
+	for id := range m.removedchild_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildLinksIDs returns the "child_links" edge IDs in the mutation.
+func (m *GroupsMutation) ChildLinksIDs() (ids []int) {
+	for id := range m.child_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildLinks resets all changes to the "child_links" edge.
+func (m *GroupsMutation) ResetChildLinks() {
+	m.child_links = nil
+	m.clearedchild_links = false
+	m.removedchild_links = nil
+}
+
+// Where appends a list predicates to the GroupsMutation builder.
+func (m *GroupsMutation) Where(ps ...predicate.Groups) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Groups, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Groups).
+func (m *GroupsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupsMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.code != nil {
+		fields = append(fields, groups.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, groups.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, groups.FieldDescription)
+	}
+	if m.created_by != nil {
+		fields = append(fields, groups.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, groups.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, groups.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groups.FieldCode:
+		return m.Code()
+	case groups.FieldName:
+		return m.Name()
+	case groups.FieldDescription:
+		return m.Description()
+	case groups.FieldCreatedBy:
+		return m.CreatedBy()
+	case groups.FieldCreatedAt:
+		return m.CreatedAt()
+	case groups.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groups.FieldCode:
+		return m.OldCode(ctx)
+	case groups.FieldName:
+		return m.OldName(ctx)
+	case groups.FieldDescription:
+		return m.OldDescription(ctx)
+	case groups.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case groups.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case groups.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Groups field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groups.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case groups.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case groups.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case groups.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case groups.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case groups.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Groups field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Groups numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(groups.FieldDescription) {
+		fields = append(fields, groups.FieldDescription)
+	}
+	if m.FieldCleared(groups.FieldCreatedBy) {
+		fields = append(fields, groups.FieldCreatedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupsMutation) ClearField(name string) error {
+	switch name {
+	case groups.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case groups.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Groups nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupsMutation) ResetField(name string) error {
+	switch name {
+	case groups.FieldCode:
+		m.ResetCode()
+		return nil
+	case groups.FieldName:
+		m.ResetName()
+		return nil
+	case groups.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case groups.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case groups.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case groups.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Groups field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.members != nil {
+		edges = append(edges, groups.EdgeMembers)
+	}
+	if m.group_roles != nil {
+		edges = append(edges, groups.EdgeGroupRoles)
+	}
+	if m.parent_links != nil {
+		edges = append(edges, groups.EdgeParentLinks)
+	}
+	if m.child_links != nil {
+		edges = append(edges, groups.EdgeChildLinks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case groups.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	case groups.EdgeGroupRoles:
+		ids := make([]ent.Value, 0, len(m.group_roles))
+		for id := range m.group_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case groups.EdgeParentLinks:
+		ids := make([]ent.Value, 0, len(m.parent_links))
+		for id := range m.parent_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case groups.EdgeChildLinks:
+		ids := make([]ent.Value, 0, len(m.child_links))
+		for id := range m.child_links {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedmembers != nil {
+		edges = append(edges, groups.EdgeMembers)
+	}
+	if m.removedgroup_roles != nil {
+		edges = append(edges, groups.EdgeGroupRoles)
+	}
+	if m.removedparent_links != nil {
+		edges = append(edges, groups.EdgeParentLinks)
+	}
+	if m.removedchild_links != nil {
+		edges = append(edges, groups.EdgeChildLinks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupsMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case groups.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case groups.EdgeGroupRoles:
+		ids := make([]ent.Value, 0, len(m.removedgroup_roles))
+		for id := range m.removedgroup_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case groups.EdgeParentLinks:
+		ids := make([]ent.Value, 0, len(m.removedparent_links))
+		for id := range m.removedparent_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case groups.EdgeChildLinks:
+		ids := make([]ent.Value, 0, len(m.removedchild_links))
+		for id := range m.removedchild_links {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedmembers {
+		edges = append(edges, groups.EdgeMembers)
+	}
+	if m.clearedgroup_roles {
+		edges = append(edges, groups.EdgeGroupRoles)
+	}
+	if m.clearedparent_links {
+		edges = append(edges, groups.EdgeParentLinks)
+	}
+	if m.clearedchild_links {
+		edges = append(edges, groups.EdgeChildLinks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case groups.EdgeMembers:
+		return m.clearedmembers
+	case groups.EdgeGroupRoles:
+		return m.clearedgroup_roles
+	case groups.EdgeParentLinks:
+		return m.clearedparent_links
+	case groups.EdgeChildLinks:
+		return m.clearedchild_links
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupsMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Groups unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupsMutation) ResetEdge(name string) error {
+	switch name {
+	case groups.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	case groups.EdgeGroupRoles:
+		m.ResetGroupRoles()
+		return nil
+	case groups.EdgeParentLinks:
+		m.ResetParentLinks()
+		return nil
+	case groups.EdgeChildLinks:
+		m.ResetChildLinks()
+		return nil
+	}
+	return fmt.Errorf("unknown Groups edge %s", name)
+}
+
+// InvitationsMutation represents an operation that mutates the Invitations nodes in the graph.
+type InvitationsMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	email          *string
+	role_ids       *[]int
+	appendrole_ids []int
+	invited_by     *uuid.UUID
+	status         *invitations.Status
+	expires_at     *time.Time
+	accepted_by    *uuid.UUID
+	accepted_at    *time.Time
+	revoked_by     *uuid.UUID
+	revoked_at     *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Invitations, error)
+	predicates     []predicate.Invitations
+}
+
+var _ ent.Mutation = (*InvitationsMutation)(nil)
+
+// invitationsOption allows management of the mutation configuration using functional options.
+type invitationsOption func(*InvitationsMutation)
+
+// newInvitationsMutation creates new mutation for the Invitations entity.
+func newInvitationsMutation(c config, op Op, opts ...invitationsOption) *InvitationsMutation {
+	m := &InvitationsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInvitations,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInvitationsID sets the ID field of the mutation.
+func withInvitationsID(id uuid.UUID) invitationsOption {
+	return func(m *InvitationsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Invitations
+		)
+		m.oldValue = func(ctx context.Context) (*Invitations, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Invitations.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInvitations sets the old Invitations of the mutation.
+func withInvitations(node *Invitations) invitationsOption {
+	return func(m *InvitationsMutation) {
+		m.oldValue = func(context.Context) (*Invitations, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InvitationsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InvitationsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Invitations entities.
+func (m *InvitationsMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InvitationsMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InvitationsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Invitations.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *InvitationsMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *InvitationsMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Invitations entity.
+// If the Invitations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationsMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *InvitationsMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetRoleIds sets the "role_ids" field.
+func (m *InvitationsMutation) SetRoleIds(i []int) {
+	m.role_ids = &i
+	m.appendrole_ids = nil
+}
+
+// RoleIds returns the value of the "role_ids" field in the mutation.
+func (m *InvitationsMutation) RoleIds() (r []int, exists bool) {
+	v := m.role_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleIds returns the old "role_ids" field's value of the Invitations entity.
+// If the Invitations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationsMutation) OldRoleIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleIds: %w", err)
+	}
+	return oldValue.RoleIds, nil
+}
+
+// AppendRoleIds adds i to the "role_ids" field.
+func (m *InvitationsMutation) AppendRoleIds(i []int) {
+	m.appendrole_ids = append(m.appendrole_ids, i...)
+}
+
+// AppendedRoleIds returns the list of values that were appended to the "role_ids" field in this mutation.
+func (m *InvitationsMutation) AppendedRoleIds() ([]int, bool) {
+	if len(m.appendrole_ids) == 0 {
+		return nil, false
+	}
+	return m.appendrole_ids, true
+}
+
+// ClearRoleIds clears the value of the "role_ids" field.
+func (m *InvitationsMutation) ClearRoleIds() {
+	m.role_ids = nil
+	m.appendrole_ids = nil
+	m.clearedFields[invitations.FieldRoleIds] = struct{}{}
+}
+
+// RoleIdsCleared returns if the "role_ids" field was cleared in this mutation.
+func (m *InvitationsMutation) RoleIdsCleared() bool {
+	_, ok := m.clearedFields[invitations.FieldRoleIds]
+	return ok
+}
+
+// ResetRoleIds resets all changes to the "role_ids" field.
+func (m *InvitationsMutation) ResetRoleIds() {
+	m.role_ids = nil
+	m.appendrole_ids = nil
+	delete(m.clearedFields, invitations.FieldRoleIds)
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (m *InvitationsMutation) SetInvitedBy(u uuid.UUID) {
+	m.invited_by = &u
+}
+
+// InvitedBy returns the value of the "invited_by" field in the mutation.
+func (m *InvitationsMutation) InvitedBy() (r uuid.UUID, exists bool) {
+	v := m.invited_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvitedBy returns the old "invited_by" field's value of the Invitations entity.
+// If the Invitations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationsMutation) OldInvitedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvitedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvitedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvitedBy: %w", err)
+	}
+	return oldValue.InvitedBy, nil
+}
+
+// ClearInvitedBy clears the value of the "invited_by" field.
+func (m *InvitationsMutation) ClearInvitedBy() {
+	m.invited_by = nil
+	m.clearedFields[invitations.FieldInvitedBy] = struct{}{}
+}
+
+// InvitedByCleared returns if the "invited_by" field was cleared in this mutation.
+func (m *InvitationsMutation) InvitedByCleared() bool {
+	_, ok := m.clearedFields[invitations.FieldInvitedBy]
+	return ok
+}
+
+// ResetInvitedBy resets all changes to the "invited_by" field.
+func (m *InvitationsMutation) ResetInvitedBy() {
+	m.invited_by = nil
+	delete(m.clearedFields, invitations.FieldInvitedBy)
+}
+
+// SetStatus sets the "status" field.
+func (m *InvitationsMutation) SetStatus(i invitations.Status) {
+	m.status = &i
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *InvitationsMutation) Status() (r invitations.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Invitations entity.
+// If the Invitations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationsMutation) OldStatus(ctx context.Context) (v invitations.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *InvitationsMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *InvitationsMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *InvitationsMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Invitations entity.
+// If the Invitations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationsMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *InvitationsMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (m *InvitationsMutation) SetAcceptedBy(u uuid.UUID) {
+	m.accepted_by = &u
+}
+
+// AcceptedBy returns the value of the "accepted_by" field in the mutation.
+func (m *InvitationsMutation) AcceptedBy() (r uuid.UUID, exists bool) {
+	v := m.accepted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcceptedBy returns the old "accepted_by" field's value of the Invitations entity.
+// If the Invitations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationsMutation) OldAcceptedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcceptedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcceptedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcceptedBy: %w", err)
+	}
+	return oldValue.AcceptedBy, nil
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (m *InvitationsMutation) ClearAcceptedBy() {
+	m.accepted_by = nil
+	m.clearedFields[invitations.FieldAcceptedBy] = struct{}{}
+}
+
+// AcceptedByCleared returns if the "accepted_by" field was cleared in this mutation.
+func (m *InvitationsMutation) AcceptedByCleared() bool {
+	_, ok := m.clearedFields[invitations.FieldAcceptedBy]
+	return ok
+}
+
+// ResetAcceptedBy resets all changes to the "accepted_by" field.
+func (m *InvitationsMutation) ResetAcceptedBy() {
+	m.accepted_by = nil
+	delete(m.clearedFields, invitations.FieldAcceptedBy)
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (m *InvitationsMutation) SetAcceptedAt(t time.Time) {
+	m.accepted_at = &t
+}
+
+// AcceptedAt returns the value of the "accepted_at" field in the mutation.
+func (m *InvitationsMutation) AcceptedAt() (r time.Time, exists bool) {
+	v := m.accepted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcceptedAt returns the old "accepted_at" field's value of the Invitations entity.
+// If the Invitations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationsMutation) OldAcceptedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcceptedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcceptedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcceptedAt: %w", err)
+	}
+	return oldValue.AcceptedAt, nil
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (m *InvitationsMutation) ClearAcceptedAt() {
+	m.accepted_at = nil
+	m.clearedFields[invitations.FieldAcceptedAt] = struct{}{}
+}
+
+// AcceptedAtCleared returns if the "accepted_at" field was cleared in this mutation.
+func (m *InvitationsMutation) AcceptedAtCleared() bool {
+	_, ok := m.clearedFields[invitations.FieldAcceptedAt]
+	return ok
+}
+
+// ResetAcceptedAt resets all changes to the "accepted_at" field.
+func (m *InvitationsMutation) ResetAcceptedAt() {
+	m.accepted_at = nil
+	delete(m.clearedFields, invitations.FieldAcceptedAt)
+}
+
+// SetRevokedBy sets the "revoked_by" field.
+func (m *InvitationsMutation) SetRevokedBy(u uuid.UUID) {
+	m.revoked_by = &u
+}
+
+// RevokedBy returns the value of the "revoked_by" field in the mutation.
+func (m *InvitationsMutation) RevokedBy() (r uuid.UUID, exists bool) {
+	v := m.revoked_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedBy returns the old "revoked_by" field's value of the Invitations entity.
+// If the Invitations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationsMutation) OldRevokedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedBy: %w", err)
+	}
+	return oldValue.RevokedBy, nil
+}
+
+// ClearRevokedBy clears the value of the "revoked_by" field.
+func (m *InvitationsMutation) ClearRevokedBy() {
+	m.revoked_by = nil
+	m.clearedFields[invitations.FieldRevokedBy] = struct{}{}
+}
+
+// RevokedByCleared returns if the "revoked_by" field was cleared in this mutation.
+func (m *InvitationsMutation) RevokedByCleared() bool {
+	_, ok := m.clearedFields[invitations.FieldRevokedBy]
+	return ok
+}
+
+// ResetRevokedBy resets all changes to the "revoked_by" field.
+func (m *InvitationsMutation) ResetRevokedBy() {
+	m.revoked_by = nil
+	delete(m.clearedFields, invitations.FieldRevokedBy)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *InvitationsMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *InvitationsMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the Invitations entity.
+// If the Invitations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationsMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *InvitationsMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[invitations.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *InvitationsMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[invitations.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *InvitationsMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, invitations.FieldRevokedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *InvitationsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InvitationsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Invitations entity.
+// If the Invitations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InvitationsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the InvitationsMutation builder.
+func (m *InvitationsMutation) Where(ps ...predicate.Invitations) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InvitationsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InvitationsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Invitations, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InvitationsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InvitationsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Invitations).
+func (m *InvitationsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InvitationsMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.email != nil {
+		fields = append(fields, invitations.FieldEmail)
+	}
+	if m.role_ids != nil {
+		fields = append(fields, invitations.FieldRoleIds)
+	}
+	if m.invited_by != nil {
+		fields = append(fields, invitations.FieldInvitedBy)
+	}
+	if m.status != nil {
+		fields = append(fields, invitations.FieldStatus)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, invitations.FieldExpiresAt)
+	}
+	if m.accepted_by != nil {
+		fields = append(fields, invitations.FieldAcceptedBy)
+	}
+	if m.accepted_at != nil {
+		fields = append(fields, invitations.FieldAcceptedAt)
+	}
+	if m.revoked_by != nil {
+		fields = append(fields, invitations.FieldRevokedBy)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, invitations.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, invitations.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InvitationsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invitations.FieldEmail:
+		return m.Email()
+	case invitations.FieldRoleIds:
+		return m.RoleIds()
+	case invitations.FieldInvitedBy:
+		return m.InvitedBy()
+	case invitations.FieldStatus:
+		return m.Status()
+	case invitations.FieldExpiresAt:
+		return m.ExpiresAt()
+	case invitations.FieldAcceptedBy:
+		return m.AcceptedBy()
+	case invitations.FieldAcceptedAt:
+		return m.AcceptedAt()
+	case invitations.FieldRevokedBy:
+		return m.RevokedBy()
+	case invitations.FieldRevokedAt:
+		return m.RevokedAt()
+	case invitations.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InvitationsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case invitations.FieldEmail:
+		return m.OldEmail(ctx)
+	case invitations.FieldRoleIds:
+		return m.OldRoleIds(ctx)
+	case invitations.FieldInvitedBy:
+		return m.OldInvitedBy(ctx)
+	case invitations.FieldStatus:
+		return m.OldStatus(ctx)
+	case invitations.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case invitations.FieldAcceptedBy:
+		return m.OldAcceptedBy(ctx)
+	case invitations.FieldAcceptedAt:
+		return m.OldAcceptedAt(ctx)
+	case invitations.FieldRevokedBy:
+		return m.OldRevokedBy(ctx)
+	case invitations.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case invitations.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Invitations field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvitationsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invitations.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case invitations.FieldRoleIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleIds(v)
+		return nil
+	case invitations.FieldInvitedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvitedBy(v)
+		return nil
+	case invitations.FieldStatus:
+		v, ok := value.(invitations.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case invitations.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case invitations.FieldAcceptedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcceptedBy(v)
+		return nil
+	case invitations.FieldAcceptedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcceptedAt(v)
+		return nil
+	case invitations.FieldRevokedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedBy(v)
+		return nil
+	case invitations.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case invitations.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invitations field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InvitationsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InvitationsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvitationsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Invitations numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InvitationsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(invitations.FieldRoleIds) {
+		fields = append(fields, invitations.FieldRoleIds)
+	}
+	if m.FieldCleared(invitations.FieldInvitedBy) {
+		fields = append(fields, invitations.FieldInvitedBy)
+	}
+	if m.FieldCleared(invitations.FieldAcceptedBy) {
+		fields = append(fields, invitations.FieldAcceptedBy)
+	}
+	if m.FieldCleared(invitations.FieldAcceptedAt) {
+		fields = append(fields, invitations.FieldAcceptedAt)
+	}
+	if m.FieldCleared(invitations.FieldRevokedBy) {
+		fields = append(fields, invitations.FieldRevokedBy)
+	}
+	if m.FieldCleared(invitations.FieldRevokedAt) {
+		fields = append(fields, invitations.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InvitationsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InvitationsMutation) ClearField(name string) error {
+	switch name {
+	case invitations.FieldRoleIds:
+		m.ClearRoleIds()
+		return nil
+	case invitations.FieldInvitedBy:
+		m.ClearInvitedBy()
+		return nil
+	case invitations.FieldAcceptedBy:
+		m.ClearAcceptedBy()
+		return nil
+	case invitations.FieldAcceptedAt:
+		m.ClearAcceptedAt()
+		return nil
+	case invitations.FieldRevokedBy:
+		m.ClearRevokedBy()
+		return nil
+	case invitations.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Invitations nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InvitationsMutation) ResetField(name string) error {
+	switch name {
+	case invitations.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case invitations.FieldRoleIds:
+		m.ResetRoleIds()
+		return nil
+	case invitations.FieldInvitedBy:
+		m.ResetInvitedBy()
+		return nil
+	case invitations.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case invitations.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case invitations.FieldAcceptedBy:
+		m.ResetAcceptedBy()
+		return nil
+	case invitations.FieldAcceptedAt:
+		m.ResetAcceptedAt()
+		return nil
+	case invitations.FieldRevokedBy:
+		m.ResetRevokedBy()
+		return nil
+	case invitations.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case invitations.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Invitations field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InvitationsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InvitationsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InvitationsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InvitationsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InvitationsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InvitationsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InvitationsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Invitations unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InvitationsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Invitations edge %s", name)
+}
+
+// OrgMembersMutation represents an operation that mutates the OrgMembers nodes in the graph.
+type OrgMembersMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	added_by      *uuid.UUID
+	joined_at     *time.Time
+	clearedFields map[string]struct{}
+	org           *uuid.UUID
+	clearedorg    bool
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*OrgMembers, error)
+	predicates    []predicate.OrgMembers
+}
+
+var _ ent.Mutation = (*OrgMembersMutation)(nil)
+
+// orgmembersOption allows management of the mutation configuration using functional options.
+type orgmembersOption func(*OrgMembersMutation)
+
+// newOrgMembersMutation creates new mutation for the OrgMembers entity.
+func newOrgMembersMutation(c config, op Op, opts ...orgmembersOption) *OrgMembersMutation {
+	m := &OrgMembersMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrgMembers,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrgMembersID sets the ID field of the mutation.
+func withOrgMembersID(id uuid.UUID) orgmembersOption {
+	return func(m *OrgMembersMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OrgMembers
+		)
+		m.oldValue = func(ctx context.Context) (*OrgMembers, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OrgMembers.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrgMembers sets the old OrgMembers of the mutation.
+func withOrgMembers(node *OrgMembers) orgmembersOption {
+	return func(m *OrgMembersMutation) {
+		m.oldValue = func(context.Context) (*OrgMembers, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrgMembersMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrgMembersMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OrgMembers entities.
+func (m *OrgMembersMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrgMembersMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrgMembersMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OrgMembers.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrgID sets the "org_id" field.
+func (m *OrgMembersMutation) SetOrgID(u uuid.UUID) {
+	m.org = &u
+}
+
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *OrgMembersMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.org
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrgID returns the old "org_id" field's value of the OrgMembers entity.
+// If the OrgMembers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrgMembersMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
+	}
+	return oldValue.OrgID, nil
+}
+
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *OrgMembersMutation) ResetOrgID() {
+	m.org = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *OrgMembersMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *OrgMembersMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the OrgMembers entity.
+// If the OrgMembers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrgMembersMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *OrgMembersMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetAddedBy sets the "added_by" field.
+func (m *OrgMembersMutation) SetAddedBy(u uuid.UUID) {
+	m.added_by = &u
+}
+
+// AddedBy returns the value of the "added_by" field in the mutation.
+func (m *OrgMembersMutation) AddedBy() (r uuid.UUID, exists bool) {
+	v := m.added_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedBy returns the old "added_by" field's value of the OrgMembers entity.
+// If the OrgMembers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrgMembersMutation) OldAddedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedBy: %w", err)
+	}
+	return oldValue.AddedBy, nil
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (m *OrgMembersMutation) ClearAddedBy() {
+	m.added_by = nil
+	m.clearedFields[orgmembers.FieldAddedBy] = struct{}{}
+}
+
+// AddedByCleared returns if the "added_by" field was cleared in this mutation.
+func (m *OrgMembersMutation) AddedByCleared() bool {
+	_, ok := m.clearedFields[orgmembers.FieldAddedBy]
+	return ok
+}
+
+// ResetAddedBy resets all changes to the "added_by" field.
+func (m *OrgMembersMutation) ResetAddedBy() {
+	m.added_by = nil
+	delete(m.clearedFields, orgmembers.FieldAddedBy)
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *OrgMembersMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *OrgMembersMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the OrgMembers entity.
+// If the OrgMembers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrgMembersMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *OrgMembersMutation) ResetJoinedAt() {
+	m.joined_at = nil
+}
+
+// ClearOrg clears the "org" edge to the Organizations entity.
+func (m *OrgMembersMutation) ClearOrg() {
+	m.clearedorg = true
+	m.clearedFields[orgmembers.FieldOrgID] = struct{}{}
+}
+
+// OrgCleared reports if the "org" edge to the Organizations entity was cleared.
+func (m *OrgMembersMutation) OrgCleared() bool {
+	return m.clearedorg
+}
+
+// OrgIDs returns the "org" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrgID instead. It exists only for internal usage by the builders.
+func (m *OrgMembersMutation) OrgIDs() (ids []uuid.UUID) {
+	if id := m.org; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrg resets all changes to the "org" edge.
+func (m *OrgMembersMutation) ResetOrg() {
+	m.org = nil
+	m.clearedorg = false
+}
+
+// ClearUser clears the "user" edge to the Users entity.
+func (m *OrgMembersMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[orgmembers.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the Users entity was cleared.
+func (m *OrgMembersMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *OrgMembersMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *OrgMembersMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the OrgMembersMutation builder.
+func (m *OrgMembersMutation) Where(ps ...predicate.OrgMembers) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrgMembersMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrgMembersMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OrgMembers, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrgMembersMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrgMembersMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OrgMembers).
+func (m *OrgMembersMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrgMembersMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.org != nil {
+		fields = append(fields, orgmembers.FieldOrgID)
+	}
+	if m.user != nil {
+		fields = append(fields, orgmembers.FieldUserID)
+	}
+	if m.added_by != nil {
+		fields = append(fields, orgmembers.FieldAddedBy)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, orgmembers.FieldJoinedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrgMembersMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case orgmembers.FieldOrgID:
+		return m.OrgID()
+	case orgmembers.FieldUserID:
+		return m.UserID()
+	case orgmembers.FieldAddedBy:
+		return m.AddedBy()
+	case orgmembers.FieldJoinedAt:
+		return m.JoinedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrgMembersMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case orgmembers.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case orgmembers.FieldUserID:
+		return m.OldUserID(ctx)
+	case orgmembers.FieldAddedBy:
+		return m.OldAddedBy(ctx)
+	case orgmembers.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OrgMembers field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrgMembersMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case orgmembers.FieldOrgID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrgID(v)
+		return nil
+	case orgmembers.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case orgmembers.FieldAddedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedBy(v)
+		return nil
+	case orgmembers.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrgMembers field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrgMembersMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrgMembersMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrgMembersMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OrgMembers numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrgMembersMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(orgmembers.FieldAddedBy) {
+		fields = append(fields, orgmembers.FieldAddedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrgMembersMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrgMembersMutation) ClearField(name string) error {
+	switch name {
+	case orgmembers.FieldAddedBy:
+		m.ClearAddedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown OrgMembers nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrgMembersMutation) ResetField(name string) error {
+	switch name {
+	case orgmembers.FieldOrgID:
+		m.ResetOrgID()
+		return nil
+	case orgmembers.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case orgmembers.FieldAddedBy:
+		m.ResetAddedBy()
+		return nil
+	case orgmembers.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OrgMembers field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrgMembersMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.org != nil {
+		edges = append(edges, orgmembers.EdgeOrg)
+	}
+	if m.user != nil {
+		edges = append(edges, orgmembers.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrgMembersMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case orgmembers.EdgeOrg:
+		if id := m.org; id != nil {
+			return []ent.Value{*id}
+		}
+	case orgmembers.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrgMembersMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrgMembersMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrgMembersMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedorg {
+		edges = append(edges, orgmembers.EdgeOrg)
+	}
+	if m.cleareduser {
+		edges = append(edges, orgmembers.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrgMembersMutation) EdgeCleared(name string) bool {
+	switch name {
+	case orgmembers.EdgeOrg:
+		return m.clearedorg
+	case orgmembers.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrgMembersMutation) ClearEdge(name string) error {
+	switch name {
+	case orgmembers.EdgeOrg:
+		m.ClearOrg()
+		return nil
+	case orgmembers.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown OrgMembers unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrgMembersMutation) ResetEdge(name string) error {
+	switch name {
+	case orgmembers.EdgeOrg:
+		m.ResetOrg()
+		return nil
+	case orgmembers.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown OrgMembers edge %s", name)
+}
+
+// OrgRolesMutation represents an operation that mutates the OrgRoles nodes in the graph.
+type OrgRolesMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	assigned_by   *uuid.UUID
+	assigned_at   *time.Time
+	clearedFields map[string]struct{}
+	org           *uuid.UUID
+	clearedorg    bool
+	user          *uuid.UUID
+	cleareduser   bool
+	role          *int
+	clearedrole   bool
+	done          bool
+	oldValue      func(context.Context) (*OrgRoles, error)
+	predicates    []predicate.OrgRoles
+}
+
+var _ ent.Mutation = (*OrgRolesMutation)(nil)
+
+// orgrolesOption allows management of the mutation configuration using functional options.
+type orgrolesOption func(*OrgRolesMutation)
+
+// newOrgRolesMutation creates new mutation for the OrgRoles entity.
+func newOrgRolesMutation(c config, op Op, opts ...orgrolesOption) *OrgRolesMutation {
+	m := &OrgRolesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrgRoles,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrgRolesID sets the ID field of the mutation.
+func withOrgRolesID(id int) orgrolesOption {
+	return func(m *OrgRolesMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OrgRoles
+		)
+		m.oldValue = func(ctx context.Context) (*OrgRoles, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OrgRoles.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrgRoles sets the old OrgRoles of the mutation.
+func withOrgRoles(node *OrgRoles) orgrolesOption {
+	return func(m *OrgRolesMutation) {
+		m.oldValue = func(context.Context) (*OrgRoles, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrgRolesMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrgRolesMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OrgRoles entities.
+func (m *OrgRolesMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrgRolesMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrgRolesMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OrgRoles.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrgID sets the "org_id" field.
+func (m *OrgRolesMutation) SetOrgID(u uuid.UUID) {
+	m.org = &u
+}
+
+// OrgID returns the value of the "org_id" field in the mutation.
+func (m *OrgRolesMutation) OrgID() (r uuid.UUID, exists bool) {
+	v := m.org
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrgID returns the old "org_id" field's value of the OrgRoles entity.
+// If the OrgRoles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrgRolesMutation) OldOrgID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrgID: %w", err)
+	}
+	return oldValue.OrgID, nil
+}
+
+// ResetOrgID resets all changes to the "org_id" field.
+func (m *OrgRolesMutation) ResetOrgID() {
+	m.org = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *OrgRolesMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *OrgRolesMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the OrgRoles entity.
+// If the OrgRoles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrgRolesMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *OrgRolesMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *OrgRolesMutation) SetRoleID(i int) {
+	m.role = &i
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *OrgRolesMutation) RoleID() (r int, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the OrgRoles entity.
+// If the OrgRoles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrgRolesMutation) OldRoleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *OrgRolesMutation) ResetRoleID() {
+	m.role = nil
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (m *OrgRolesMutation) SetAssignedBy(u uuid.UUID) {
+	m.assigned_by = &u
+}
+
+// AssignedBy returns the value of the "assigned_by" field in the mutation.
+func (m *OrgRolesMutation) AssignedBy() (r uuid.UUID, exists bool) {
+	v := m.assigned_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedBy returns the old "assigned_by" field's value of the OrgRoles entity.
+// If the OrgRoles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrgRolesMutation) OldAssignedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedBy: %w", err)
+	}
+	return oldValue.AssignedBy, nil
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (m *OrgRolesMutation) ClearAssignedBy() {
+	m.assigned_by = nil
+	m.clearedFields[orgroles.FieldAssignedBy] = struct{}{}
+}
+
+// AssignedByCleared returns if the "assigned_by" field was cleared in this mutation.
+func (m *OrgRolesMutation) AssignedByCleared() bool {
+	_, ok := m.clearedFields[orgroles.FieldAssignedBy]
+	return ok
+}
+
+// ResetAssignedBy resets all changes to the "assigned_by" field.
+func (m *OrgRolesMutation) ResetAssignedBy() {
+	m.assigned_by = nil
+	delete(m.clearedFields, orgroles.FieldAssignedBy)
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (m *OrgRolesMutation) SetAssignedAt(t time.Time) {
+	m.assigned_at = &t
+}
+
+// AssignedAt returns the value of the "assigned_at" field in the mutation.
+func (m *OrgRolesMutation) AssignedAt() (r time.Time, exists bool) {
+	v := m.assigned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedAt returns the old "assigned_at" field's value of the OrgRoles entity.
+// If the OrgRoles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrgRolesMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+	}
+	return oldValue.AssignedAt, nil
+}
+
+// ResetAssignedAt resets all changes to the "assigned_at" field.
+func (m *OrgRolesMutation) ResetAssignedAt() {
+	m.assigned_at = nil
+}
+
+// ClearOrg clears the "org" edge to the Organizations entity.
+func (m *OrgRolesMutation) ClearOrg() {
+	m.clearedorg = true
+	m.clearedFields[orgroles.FieldOrgID] = struct{}{}
+}
+
+// OrgCleared reports if the "org" edge to the Organizations entity was cleared.
+func (m *OrgRolesMutation) OrgCleared() bool {
+	return m.clearedorg
+}
+
+// OrgIDs returns the "org" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrgID instead. It exists only for internal usage by the builders.
+func (m *OrgRolesMutation) OrgIDs() (ids []uuid.UUID) {
+	if id := m.org; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrg resets all changes to the "org" edge.
+func (m *OrgRolesMutation) ResetOrg() {
+	m.org = nil
+	m.clearedorg = false
+}
+
+// ClearUser clears the "user" edge to the Users entity.
+func (m *OrgRolesMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[orgroles.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the Users entity was cleared.
+func (m *OrgRolesMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *OrgRolesMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *OrgRolesMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (m *OrgRolesMutation) ClearRole() {
+	m.clearedrole = true
+	m.clearedFields[orgroles.FieldRoleID] = struct{}{}
+}
+
+// RoleCleared reports if the "role" edge to the Roles entity was cleared.
+func (m *OrgRolesMutation) RoleCleared() bool {
+	return m.clearedrole
+}
+
+// RoleIDs returns the "role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleID instead. It exists only for internal usage by the builders.
+func (m *OrgRolesMutation) RoleIDs() (ids []int) {
+	if id := m.role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRole resets all changes to the "role" edge.
+func (m *OrgRolesMutation) ResetRole() {
+	m.role = nil
+	m.clearedrole = false
+}
+
+// Where appends a list predicates to the OrgRolesMutation builder.
+func (m *OrgRolesMutation) Where(ps ...predicate.OrgRoles) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrgRolesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrgRolesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OrgRoles, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrgRolesMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrgRolesMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OrgRoles).
+func (m *OrgRolesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrgRolesMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.org != nil {
+		fields = append(fields, orgroles.FieldOrgID)
+	}
+	if m.user != nil {
+		fields = append(fields, orgroles.FieldUserID)
+	}
+	if m.role != nil {
+		fields = append(fields, orgroles.FieldRoleID)
+	}
+	if m.assigned_by != nil {
+		fields = append(fields, orgroles.FieldAssignedBy)
+	}
+	if m.assigned_at != nil {
+		fields = append(fields, orgroles.FieldAssignedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrgRolesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case orgroles.FieldOrgID:
+		return m.OrgID()
+	case orgroles.FieldUserID:
+		return m.UserID()
+	case orgroles.FieldRoleID:
+		return m.RoleID()
+	case orgroles.FieldAssignedBy:
+		return m.AssignedBy()
+	case orgroles.FieldAssignedAt:
+		return m.AssignedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrgRolesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case orgroles.FieldOrgID:
+		return m.OldOrgID(ctx)
+	case orgroles.FieldUserID:
+		return m.OldUserID(ctx)
+	case orgroles.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case orgroles.FieldAssignedBy:
+		return m.OldAssignedBy(ctx)
+	case orgroles.FieldAssignedAt:
+		return m.OldAssignedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OrgRoles field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrgRolesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case orgroles.FieldOrgID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrgID(v)
+		return nil
+	case orgroles.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case orgroles.FieldRoleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case orgroles.FieldAssignedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedBy(v)
+		return nil
+	case orgroles.FieldAssignedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrgRoles field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrgRolesMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrgRolesMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrgRolesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OrgRoles numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrgRolesMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(orgroles.FieldAssignedBy) {
+		fields = append(fields, orgroles.FieldAssignedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrgRolesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrgRolesMutation) ClearField(name string) error {
+	switch name {
+	case orgroles.FieldAssignedBy:
+		m.ClearAssignedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown OrgRoles nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrgRolesMutation) ResetField(name string) error {
+	switch name {
+	case orgroles.FieldOrgID:
+		m.ResetOrgID()
+		return nil
+	case orgroles.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case orgroles.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	case orgroles.FieldAssignedBy:
+		m.ResetAssignedBy()
+		return nil
+	case orgroles.FieldAssignedAt:
+		m.ResetAssignedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OrgRoles field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrgRolesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.org != nil {
+		edges = append(edges, orgroles.EdgeOrg)
+	}
+	if m.user != nil {
+		edges = append(edges, orgroles.EdgeUser)
+	}
+	if m.role != nil {
+		edges = append(edges, orgroles.EdgeRole)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrgRolesMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case orgroles.EdgeOrg:
+		if id := m.org; id != nil {
+			return []ent.Value{*id}
+		}
+	case orgroles.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case orgroles.EdgeRole:
+		if id := m.role; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrgRolesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrgRolesMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrgRolesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedorg {
+		edges = append(edges, orgroles.EdgeOrg)
+	}
+	if m.cleareduser {
+		edges = append(edges, orgroles.EdgeUser)
+	}
+	if m.clearedrole {
+		edges = append(edges, orgroles.EdgeRole)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrgRolesMutation) EdgeCleared(name string) bool {
+	switch name {
+	case orgroles.EdgeOrg:
+		return m.clearedorg
+	case orgroles.EdgeUser:
+		return m.cleareduser
+	case orgroles.EdgeRole:
+		return m.clearedrole
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrgRolesMutation) ClearEdge(name string) error {
+	switch name {
+	case orgroles.EdgeOrg:
+		m.ClearOrg()
+		return nil
+	case orgroles.EdgeUser:
+		m.ClearUser()
+		return nil
+	case orgroles.EdgeRole:
+		m.ClearRole()
+		return nil
+	}
+	return fmt.Errorf("unknown OrgRoles unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrgRolesMutation) ResetEdge(name string) error {
+	switch name {
+	case orgroles.EdgeOrg:
+		m.ResetOrg()
+		return nil
+	case orgroles.EdgeUser:
+		m.ResetUser()
+		return nil
+	case orgroles.EdgeRole:
+		m.ResetRole()
+		return nil
+	}
+	return fmt.Errorf("unknown OrgRoles edge %s", name)
+}
+
+// OrganizationsMutation represents an operation that mutates the Organizations nodes in the graph.
+type OrganizationsMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	slug                  *string
+	name                  *string
+	allowed_domains       *[]string
+	appendallowed_domains []string
+	mfa_required          *bool
+	created_by            *uuid.UUID
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	members               map[uuid.UUID]struct{}
+	removedmembers        map[uuid.UUID]struct{}
+	clearedmembers        bool
+	org_roles             map[int]struct{}
+	removedorg_roles      map[int]struct{}
+	clearedorg_roles      bool
+	done                  bool
+	oldValue              func(context.Context) (*Organizations, error)
+	predicates            []predicate.Organizations
+}
+
+var _ ent.Mutation = (*OrganizationsMutation)(nil)
+
+// organizationsOption allows management of the mutation configuration using functional options.
+type organizationsOption func(*OrganizationsMutation)
+
+// newOrganizationsMutation creates new mutation for the Organizations entity.
+func newOrganizationsMutation(c config, op Op, opts ...organizationsOption) *OrganizationsMutation {
+	m := &OrganizationsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrganizations,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrganizationsID sets the ID field of the mutation.
+func withOrganizationsID(id uuid.UUID) organizationsOption {
+	return func(m *OrganizationsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Organizations
+		)
+		m.oldValue = func(ctx context.Context) (*Organizations, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Organizations.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrganizations sets the old Organizations of the mutation.
+func withOrganizations(node *Organizations) organizationsOption {
+	return func(m *OrganizationsMutation) {
+		m.oldValue = func(context.Context) (*Organizations, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrganizationsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrganizationsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Organizations entities.
+func (m *OrganizationsMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrganizationsMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrganizationsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Organizations.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSlug sets the "slug" field.
+func (m *OrganizationsMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *OrganizationsMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Organizations entity.
+// If the Organizations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationsMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *OrganizationsMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetName sets the "name" field.
+func (m *OrganizationsMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OrganizationsMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Organizations entity.
+// If the Organizations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationsMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OrganizationsMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (m *OrganizationsMutation) SetAllowedDomains(s []string) {
+	m.allowed_domains = &s
+	m.appendallowed_domains = nil
+}
+
+// AllowedDomains returns the value of the "allowed_domains" field in the mutation.
+func (m *OrganizationsMutation) AllowedDomains() (r []string, exists bool) {
+	v := m.allowed_domains
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedDomains returns the old "allowed_domains" field's value of the Organizations entity.
+// If the Organizations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationsMutation) OldAllowedDomains(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedDomains is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedDomains requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedDomains: %w", err)
+	}
+	return oldValue.AllowedDomains, nil
+}
+
+// AppendAllowedDomains adds s to the "allowed_domains" field.
+func (m *OrganizationsMutation) AppendAllowedDomains(s []string) {
+	m.appendallowed_domains = append(m.appendallowed_domains, s...)
+}
+
+// AppendedAllowedDomains returns the list of values that were appended to the "allowed_domains" field in this mutation.
+func (m *OrganizationsMutation) AppendedAllowedDomains() ([]string, bool) {
+	if len(m.appendallowed_domains) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_domains, true
+}
+
+// ClearAllowedDomains clears the value of the "allowed_domains" field.
+func (m *OrganizationsMutation) ClearAllowedDomains() {
+	m.allowed_domains = nil
+	m.appendallowed_domains = nil
+	m.clearedFields[organizations.FieldAllowedDomains] = struct{}{}
+}
+
+// AllowedDomainsCleared returns if the "allowed_domains" field was cleared in this mutation.
+func (m *OrganizationsMutation) AllowedDomainsCleared() bool {
+	_, ok := m.clearedFields[organizations.FieldAllowedDomains]
+	return ok
+}
+
+// ResetAllowedDomains resets all changes to the "allowed_domains" field.
+func (m *OrganizationsMutation) ResetAllowedDomains() {
+	m.allowed_domains = nil
+	m.appendallowed_domains = nil
+	delete(m.clearedFields, organizations.FieldAllowedDomains)
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (m *OrganizationsMutation) SetMfaRequired(b bool) {
+	m.mfa_required = &b
+}
+
+// MfaRequired returns the value of the "mfa_required" field in the mutation.
+func (m *OrganizationsMutation) MfaRequired() (r bool, exists bool) {
+	v := m.mfa_required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMfaRequired returns the old "mfa_required" field's value of the Organizations entity.
+// If the Organizations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationsMutation) OldMfaRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaRequired: %w", err)
+	}
+	return oldValue.MfaRequired, nil
+}
+
+// ResetMfaRequired resets all changes to the "mfa_required" field.
+func (m *OrganizationsMutation) ResetMfaRequired() {
+	m.mfa_required = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *OrganizationsMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *OrganizationsMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Organizations entity.
+// If the Organizations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationsMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *OrganizationsMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[organizations.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *OrganizationsMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[organizations.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *OrganizationsMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, organizations.FieldCreatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OrganizationsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrganizationsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Organizations entity.
+// If the Organizations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrganizationsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OrganizationsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OrganizationsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Organizations entity.
+// If the Organizations object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OrganizationsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddMemberIDs adds the "members" edge to the OrgMembers entity by ids.
+func (m *OrganizationsMutation) AddMemberIDs(ids ...uuid.UUID) {
+	if m.members == nil {
+		m.members = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the OrgMembers entity.
+func (m *OrganizationsMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the OrgMembers entity was cleared.
+func (m *OrganizationsMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the OrgMembers entity by IDs.
+func (m *OrganizationsMutation) RemoveMemberIDs(ids ...uuid.UUID) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the OrgMembers entity.
+func (m *OrganizationsMutation) RemovedMembersIDs() (ids []uuid.UUID) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *OrganizationsMutation) MembersIDs() (ids []uuid.UUID) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *OrganizationsMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by ids.
+func (m *OrganizationsMutation) AddOrgRoleIDs(ids ...int) {
+	if m.org_roles == nil {
+		m.org_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.org_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrgRoles clears the "org_roles" edge to the OrgRoles entity.
+func (m *OrganizationsMutation) ClearOrgRoles() {
+	m.clearedorg_roles = true
+}
+
+// OrgRolesCleared reports if the "org_roles" edge to the OrgRoles entity was cleared.
+func (m *OrganizationsMutation) OrgRolesCleared() bool {
+	return m.clearedorg_roles
+}
+
+// RemoveOrgRoleIDs removes the "org_roles" edge to the OrgRoles entity by IDs.
+func (m *OrganizationsMutation) RemoveOrgRoleIDs(ids ...int) {
+	if m.removedorg_roles == nil {
+		m.removedorg_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.org_roles, ids[i])
+		m.removedorg_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrgRoles returns the removed IDs of the "org_roles" edge to the OrgRoles entity.
+func (m *OrganizationsMutation) RemovedOrgRolesIDs() (ids []int) {
+	for id := range m.removedorg_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrgRolesIDs returns the "org_roles" edge IDs in the mutation.
+func (m *OrganizationsMutation) OrgRolesIDs() (ids []int) {
+	for id := range m.org_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrgRoles resets all changes to the "org_roles" edge.
+func (m *OrganizationsMutation) ResetOrgRoles() {
+	m.org_roles = nil
+	m.clearedorg_roles = false
+	m.removedorg_roles = nil
+}
+
+// Where appends a list predicates to the OrganizationsMutation builder.
+func (m *OrganizationsMutation) Where(ps ...predicate.Organizations) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrganizationsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrganizationsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Organizations, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrganizationsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrganizationsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Organizations).
+func (m *OrganizationsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrganizationsMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.slug != nil {
+		fields = append(fields, organizations.FieldSlug)
+	}
+	if m.name != nil {
+		fields = append(fields, organizations.FieldName)
+	}
+	if m.allowed_domains != nil {
+		fields = append(fields, organizations.FieldAllowedDomains)
+	}
+	if m.mfa_required != nil {
+		fields = append(fields, organizations.FieldMfaRequired)
+	}
+	if m.created_by != nil {
+		fields = append(fields, organizations.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, organizations.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, organizations.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrganizationsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case organizations.FieldSlug:
+		return m.Slug()
+	case organizations.FieldName:
+		return m.Name()
+	case organizations.FieldAllowedDomains:
+		return m.AllowedDomains()
+	case organizations.FieldMfaRequired:
+		return m.MfaRequired()
+	case organizations.FieldCreatedBy:
+		return m.CreatedBy()
+	case organizations.FieldCreatedAt:
+		return m.CreatedAt()
+	case organizations.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrganizationsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case organizations.FieldSlug:
+		return m.OldSlug(ctx)
+	case organizations.FieldName:
+		return m.OldName(ctx)
+	case organizations.FieldAllowedDomains:
+		return m.OldAllowedDomains(ctx)
+	case organizations.FieldMfaRequired:
+		return m.OldMfaRequired(ctx)
+	case organizations.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case organizations.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case organizations.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Organizations field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case organizations.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case organizations.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case organizations.FieldAllowedDomains:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedDomains(v)
+		return nil
+	case organizations.FieldMfaRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaRequired(v)
+		return nil
+	case organizations.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case organizations.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case organizations.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Organizations field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrganizationsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrganizationsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Organizations numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrganizationsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(organizations.FieldAllowedDomains) {
+		fields = append(fields, organizations.FieldAllowedDomains)
+	}
+	if m.FieldCleared(organizations.FieldCreatedBy) {
+		fields = append(fields, organizations.FieldCreatedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrganizationsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrganizationsMutation) ClearField(name string) error {
+	switch name {
+	case organizations.FieldAllowedDomains:
+		m.ClearAllowedDomains()
+		return nil
+	case organizations.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Organizations nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrganizationsMutation) ResetField(name string) error {
+	switch name {
+	case organizations.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case organizations.FieldName:
+		m.ResetName()
+		return nil
+	case organizations.FieldAllowedDomains:
+		m.ResetAllowedDomains()
+		return nil
+	case organizations.FieldMfaRequired:
+		m.ResetMfaRequired()
+		return nil
+	case organizations.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case organizations.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case organizations.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Organizations field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrganizationsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.members != nil {
+		edges = append(edges, organizations.EdgeMembers)
+	}
+	if m.org_roles != nil {
+		edges = append(edges, organizations.EdgeOrgRoles)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrganizationsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case organizations.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	case organizations.EdgeOrgRoles:
+		ids := make([]ent.Value, 0, len(m.org_roles))
+		for id := range m.org_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrganizationsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmembers != nil {
+		edges = append(edges, organizations.EdgeMembers)
+	}
+	if m.removedorg_roles != nil {
+		edges = append(edges, organizations.EdgeOrgRoles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrganizationsMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case organizations.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case organizations.EdgeOrgRoles:
+		ids := make([]ent.Value, 0, len(m.removedorg_roles))
+		for id := range m.removedorg_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrganizationsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmembers {
+		edges = append(edges, organizations.EdgeMembers)
+	}
+	if m.clearedorg_roles {
+		edges = append(edges, organizations.EdgeOrgRoles)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrganizationsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case organizations.EdgeMembers:
+		return m.clearedmembers
+	case organizations.EdgeOrgRoles:
+		return m.clearedorg_roles
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrganizationsMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Organizations unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrganizationsMutation) ResetEdge(name string) error {
+	switch name {
+	case organizations.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	case organizations.EdgeOrgRoles:
+		m.ResetOrgRoles()
+		return nil
+	}
+	return fmt.Errorf("unknown Organizations edge %s", name)
+}
+
+// PasswordHistoriesMutation represents an operation that mutates the PasswordHistories nodes in the graph.
+type PasswordHistoriesMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	password_hash *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PasswordHistories, error)
+	predicates    []predicate.PasswordHistories
+}
+
+var _ ent.Mutation = (*PasswordHistoriesMutation)(nil)
+
+// passwordhistoriesOption allows management of the mutation configuration using functional options.
+type passwordhistoriesOption func(*PasswordHistoriesMutation)
+
+// newPasswordHistoriesMutation creates new mutation for the PasswordHistories entity.
+func newPasswordHistoriesMutation(c config, op Op, opts ...passwordhistoriesOption) *PasswordHistoriesMutation {
+	m := &PasswordHistoriesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePasswordHistories,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPasswordHistoriesID sets the ID field of the mutation.
+func withPasswordHistoriesID(id uuid.UUID) passwordhistoriesOption {
+	return func(m *PasswordHistoriesMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PasswordHistories
+		)
+		m.oldValue = func(ctx context.Context) (*PasswordHistories, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PasswordHistories.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPasswordHistories sets the old PasswordHistories of the mutation.
+func withPasswordHistories(node *PasswordHistories) passwordhistoriesOption {
+	return func(m *PasswordHistoriesMutation) {
+		m.oldValue = func(context.Context) (*PasswordHistories, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PasswordHistoriesMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PasswordHistoriesMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PasswordHistories entities.
+func (m *PasswordHistoriesMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PasswordHistoriesMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PasswordHistoriesMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PasswordHistories.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PasswordHistoriesMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasswordHistoriesMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PasswordHistories entity.
+// If the PasswordHistories object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordHistoriesMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasswordHistoriesMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *PasswordHistoriesMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *PasswordHistoriesMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the PasswordHistories entity.
+// If the PasswordHistories object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordHistoriesMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *PasswordHistoriesMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PasswordHistoriesMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PasswordHistoriesMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PasswordHistories entity.
+// If the PasswordHistories object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordHistoriesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PasswordHistoriesMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the PasswordHistoriesMutation builder.
+func (m *PasswordHistoriesMutation) Where(ps ...predicate.PasswordHistories) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PasswordHistoriesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PasswordHistoriesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PasswordHistories, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PasswordHistoriesMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PasswordHistoriesMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PasswordHistories).
+func (m *PasswordHistoriesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PasswordHistoriesMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, passwordhistories.FieldUserID)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, passwordhistories.FieldPasswordHash)
+	}
+	if m.created_at != nil {
+		fields = append(fields, passwordhistories.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PasswordHistoriesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case passwordhistories.FieldUserID:
+		return m.UserID()
+	case passwordhistories.FieldPasswordHash:
+		return m.PasswordHash()
+	case passwordhistories.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PasswordHistoriesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case passwordhistories.FieldUserID:
+		return m.OldUserID(ctx)
+	case passwordhistories.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case passwordhistories.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PasswordHistories field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordHistoriesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case passwordhistories.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case passwordhistories.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case passwordhistories.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordHistories field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasswordHistoriesMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PasswordHistoriesMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordHistoriesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PasswordHistories numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PasswordHistoriesMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PasswordHistoriesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PasswordHistoriesMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PasswordHistories nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PasswordHistoriesMutation) ResetField(name string) error {
+	switch name {
+	case passwordhistories.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case passwordhistories.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case passwordhistories.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordHistories field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PasswordHistoriesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PasswordHistoriesMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PasswordHistoriesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PasswordHistoriesMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PasswordHistoriesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PasswordHistoriesMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PasswordHistoriesMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PasswordHistories unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PasswordHistoriesMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PasswordHistories edge %s", name)
+}
+
+// PasswordResetsMutation represents an operation that mutates the PasswordResets nodes in the graph.
+type PasswordResetsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	email         *string
+	token         *string
+	expires_at    *time.Time
+	is_used       *bool
+	used_at       *time.Time
+	ip_address    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PasswordResets, error)
+	predicates    []predicate.PasswordResets
+}
+
+var _ ent.Mutation = (*PasswordResetsMutation)(nil)
+
+// passwordresetsOption allows management of the mutation configuration using functional options.
+type passwordresetsOption func(*PasswordResetsMutation)
+
+// newPasswordResetsMutation creates new mutation for the PasswordResets entity.
+func newPasswordResetsMutation(c config, op Op, opts ...passwordresetsOption) *PasswordResetsMutation {
+	m := &PasswordResetsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePasswordResets,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPasswordResetsID sets the ID field of the mutation.
+func withPasswordResetsID(id uuid.UUID) passwordresetsOption {
+	return func(m *PasswordResetsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PasswordResets
+		)
+		m.oldValue = func(ctx context.Context) (*PasswordResets, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PasswordResets.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPasswordResets sets the old PasswordResets of the mutation.
+func withPasswordResets(node *PasswordResets) passwordresetsOption {
+	return func(m *PasswordResetsMutation) {
+		m.oldValue = func(context.Context) (*PasswordResets, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PasswordResetsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PasswordResetsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PasswordResets entities.
+func (m *PasswordResetsMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PasswordResetsMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PasswordResetsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PasswordResets.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PasswordResetsMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasswordResetsMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PasswordResets entity.
+// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasswordResetsMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *PasswordResetsMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *PasswordResetsMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the PasswordResets entity.
+// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetsMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *PasswordResetsMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetToken sets the "token" field.
+func (m *PasswordResetsMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *PasswordResetsMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the PasswordResets entity.
+// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetsMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *PasswordResetsMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PasswordResetsMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PasswordResetsMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the PasswordResets entity.
+// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetsMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PasswordResetsMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetIsUsed sets the "is_used" field.
+func (m *PasswordResetsMutation) SetIsUsed(b bool) {
+	m.is_used = &b
+}
+
+// IsUsed returns the value of the "is_used" field in the mutation.
+func (m *PasswordResetsMutation) IsUsed() (r bool, exists bool) {
+	v := m.is_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsUsed returns the old "is_used" field's value of the PasswordResets entity.
+// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetsMutation) OldIsUsed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsUsed: %w", err)
+	}
+	return oldValue.IsUsed, nil
+}
+
+// ResetIsUsed resets all changes to the "is_used" field.
+func (m *PasswordResetsMutation) ResetIsUsed() {
+	m.is_used = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *PasswordResetsMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *PasswordResetsMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the PasswordResets entity.
+// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetsMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *PasswordResetsMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[passwordresets.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *PasswordResetsMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[passwordresets.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *PasswordResetsMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, passwordresets.FieldUsedAt)
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *PasswordResetsMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *PasswordResetsMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the PasswordResets entity.
+// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetsMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *PasswordResetsMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[passwordresets.FieldIPAddress] = struct{}{}
+}
+
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *PasswordResetsMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[passwordresets.FieldIPAddress]
+	return ok
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *PasswordResetsMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, passwordresets.FieldIPAddress)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PasswordResetsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PasswordResetsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PasswordResets entity.
+// If the PasswordResets object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PasswordResetsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the PasswordResetsMutation builder.
+func (m *PasswordResetsMutation) Where(ps ...predicate.PasswordResets) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PasswordResetsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PasswordResetsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PasswordResets, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PasswordResetsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PasswordResetsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PasswordResets).
+func (m *PasswordResetsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PasswordResetsMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user_id != nil {
+		fields = append(fields, passwordresets.FieldUserID)
+	}
+	if m.email != nil {
+		fields = append(fields, passwordresets.FieldEmail)
+	}
+	if m.token != nil {
+		fields = append(fields, passwordresets.FieldToken)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, passwordresets.FieldExpiresAt)
+	}
+	if m.is_used != nil {
+		fields = append(fields, passwordresets.FieldIsUsed)
+	}
+	if m.used_at != nil {
+		fields = append(fields, passwordresets.FieldUsedAt)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, passwordresets.FieldIPAddress)
+	}
+	if m.created_at != nil {
+		fields = append(fields, passwordresets.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PasswordResetsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case passwordresets.FieldUserID:
+		return m.UserID()
+	case passwordresets.FieldEmail:
+		return m.Email()
+	case passwordresets.FieldToken:
+		return m.Token()
+	case passwordresets.FieldExpiresAt:
+		return m.ExpiresAt()
+	case passwordresets.FieldIsUsed:
+		return m.IsUsed()
+	case passwordresets.FieldUsedAt:
+		return m.UsedAt()
+	case passwordresets.FieldIPAddress:
+		return m.IPAddress()
+	case passwordresets.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PasswordResetsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case passwordresets.FieldUserID:
+		return m.OldUserID(ctx)
+	case passwordresets.FieldEmail:
+		return m.OldEmail(ctx)
+	case passwordresets.FieldToken:
+		return m.OldToken(ctx)
+	case passwordresets.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case passwordresets.FieldIsUsed:
+		return m.OldIsUsed(ctx)
+	case passwordresets.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case passwordresets.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case passwordresets.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PasswordResets field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordResetsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case passwordresets.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case passwordresets.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case passwordresets.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case passwordresets.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case passwordresets.FieldIsUsed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsUsed(v)
+		return nil
+	case passwordresets.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case passwordresets.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case passwordresets.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResets field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasswordResetsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PasswordResetsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordResetsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PasswordResets numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PasswordResetsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(passwordresets.FieldUsedAt) {
+		fields = append(fields, passwordresets.FieldUsedAt)
+	}
+	if m.FieldCleared(passwordresets.FieldIPAddress) {
+		fields = append(fields, passwordresets.FieldIPAddress)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PasswordResetsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PasswordResetsMutation) ClearField(name string) error {
+	switch name {
+	case passwordresets.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	case passwordresets.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResets nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PasswordResetsMutation) ResetField(name string) error {
+	switch name {
+	case passwordresets.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case passwordresets.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case passwordresets.FieldToken:
+		m.ResetToken()
+		return nil
+	case passwordresets.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case passwordresets.FieldIsUsed:
+		m.ResetIsUsed()
+		return nil
+	case passwordresets.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case passwordresets.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case passwordresets.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordResets field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PasswordResetsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PasswordResetsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PasswordResetsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PasswordResetsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PasswordResetsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PasswordResetsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PasswordResetsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PasswordResets unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PasswordResetsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PasswordResets edge %s", name)
+}
+
+// PermissionsMutation represents an operation that mutates the Permissions nodes in the graph.
+type PermissionsMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	code                    *string
+	name                    *string
+	description             *string
+	resource                *string
+	action                  *string
+	is_system               *bool
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	role_permissions        map[int]struct{}
+	removedrole_permissions map[int]struct{}
+	clearedrole_permissions bool
+	done                    bool
+	oldValue                func(context.Context) (*Permissions, error)
+	predicates              []predicate.Permissions
+}
+
+var _ ent.Mutation = (*PermissionsMutation)(nil)
+
+// permissionsOption allows management of the mutation configuration using functional options.
+type permissionsOption func(*PermissionsMutation)
+
+// newPermissionsMutation creates new mutation for the Permissions entity.
+func newPermissionsMutation(c config, op Op, opts ...permissionsOption) *PermissionsMutation {
+	m := &PermissionsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePermissions,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPermissionsID sets the ID field of the mutation.
+func withPermissionsID(id int) permissionsOption {
+	return func(m *PermissionsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Permissions
+		)
+		m.oldValue = func(ctx context.Context) (*Permissions, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Permissions.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPermissions sets the old Permissions of the mutation.
+func withPermissions(node *Permissions) permissionsOption {
+	return func(m *PermissionsMutation) {
+		m.oldValue = func(context.Context) (*Permissions, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PermissionsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PermissionsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Permissions entities.
+func (m *PermissionsMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PermissionsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PermissionsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Permissions.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *PermissionsMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *PermissionsMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Permissions entity.
+// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionsMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *PermissionsMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *PermissionsMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PermissionsMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Permissions entity.
+// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionsMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PermissionsMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *PermissionsMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PermissionsMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Permissions entity.
+// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionsMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *PermissionsMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[permissions.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PermissionsMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[permissions.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PermissionsMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, permissions.FieldDescription)
+}
+
+// SetResource sets the "resource" field.
+func (m *PermissionsMutation) SetResource(s string) {
+	m.resource = &s
+}
+
+// Resource returns the value of the "resource" field in the mutation.
+func (m *PermissionsMutation) Resource() (r string, exists bool) {
+	v := m.resource
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResource returns the old "resource" field's value of the Permissions entity.
+// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionsMutation) OldResource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResource: %w", err)
+	}
+	return oldValue.Resource, nil
+}
+
+// ClearResource clears the value of the "resource" field.
+func (m *PermissionsMutation) ClearResource() {
+	m.resource = nil
+	m.clearedFields[permissions.FieldResource] = struct{}{}
+}
+
+// ResourceCleared returns if the "resource" field was cleared in this mutation.
+func (m *PermissionsMutation) ResourceCleared() bool {
+	_, ok := m.clearedFields[permissions.FieldResource]
+	return ok
+}
+
+// ResetResource resets all changes to the "resource" field.
+func (m *PermissionsMutation) ResetResource() {
+	m.resource = nil
+	delete(m.clearedFields, permissions.FieldResource)
+}
+
+// SetAction sets the "action" field.
+func (m *PermissionsMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *PermissionsMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the Permissions entity.
+// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionsMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ClearAction clears the value of the "action" field.
+func (m *PermissionsMutation) ClearAction() {
+	m.action = nil
+	m.clearedFields[permissions.FieldAction] = struct{}{}
+}
+
+// ActionCleared returns if the "action" field was cleared in this mutation.
+func (m *PermissionsMutation) ActionCleared() bool {
+	_, ok := m.clearedFields[permissions.FieldAction]
+	return ok
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *PermissionsMutation) ResetAction() {
+	m.action = nil
+	delete(m.clearedFields, permissions.FieldAction)
+}
+
+// SetIsSystem sets the "is_system" field.
+func (m *PermissionsMutation) SetIsSystem(b bool) {
+	m.is_system = &b
+}
+
+// IsSystem returns the value of the "is_system" field in the mutation.
+func (m *PermissionsMutation) IsSystem() (r bool, exists bool) {
+	v := m.is_system
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSystem returns the old "is_system" field's value of the Permissions entity.
+// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionsMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSystem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
+	}
+	return oldValue.IsSystem, nil
+}
+
+// ResetIsSystem resets all changes to the "is_system" field.
+func (m *PermissionsMutation) ResetIsSystem() {
+	m.is_system = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PermissionsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PermissionsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Permissions entity.
+// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PermissionsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PermissionsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PermissionsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Permissions entity.
+// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PermissionsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PermissionsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddRolePermissionIDs adds the "role_permissions" edge to the RolePermissions entity by ids.
+func (m *PermissionsMutation) AddRolePermissionIDs(ids ...int) {
+	if m.role_permissions == nil {
+		m.role_permissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.role_permissions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRolePermissions clears the "role_permissions" edge to the RolePermissions entity.
+func (m *PermissionsMutation) ClearRolePermissions() {
+	m.clearedrole_permissions = true
+}
+
+// RolePermissionsCleared reports if the "role_permissions" edge to the RolePermissions entity was cleared.
+func (m *PermissionsMutation) RolePermissionsCleared() bool {
+	return m.clearedrole_permissions
+}
+
+// RemoveRolePermissionIDs removes the "role_permissions" edge to the RolePermissions entity by IDs.
+func (m *PermissionsMutation) RemoveRolePermissionIDs(ids ...int) {
+	if m.removedrole_permissions == nil {
+		m.removedrole_permissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.role_permissions, ids[i])
+		m.removedrole_permissions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRolePermissions returns the removed IDs of the "role_permissions" edge to the RolePermissions entity.
+func (m *PermissionsMutation) RemovedRolePermissionsIDs() (ids []int) {
+	for id := range m.removedrole_permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RolePermissionsIDs returns the "role_permissions" edge IDs in the mutation.
+func (m *PermissionsMutation) RolePermissionsIDs() (ids []int) {
+	for id := range m.role_permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRolePermissions resets all changes to the "role_permissions" edge.
+func (m *PermissionsMutation) ResetRolePermissions() {
+	m.role_permissions = nil
+	m.clearedrole_permissions = false
+	m.removedrole_permissions = nil
+}
+
+// Where appends a list predicates to the PermissionsMutation builder.
+func (m *PermissionsMutation) Where(ps ...predicate.Permissions) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PermissionsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PermissionsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Permissions, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PermissionsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PermissionsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Permissions).
+func (m *PermissionsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PermissionsMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.code != nil {
+		fields = append(fields, permissions.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, permissions.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, permissions.FieldDescription)
+	}
+	if m.resource != nil {
+		fields = append(fields, permissions.FieldResource)
+	}
+	if m.action != nil {
+		fields = append(fields, permissions.FieldAction)
+	}
+	if m.is_system != nil {
+		fields = append(fields, permissions.FieldIsSystem)
+	}
+	if m.created_at != nil {
+		fields = append(fields, permissions.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, permissions.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PermissionsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case permissions.FieldCode:
+		return m.Code()
+	case permissions.FieldName:
+		return m.Name()
+	case permissions.FieldDescription:
+		return m.Description()
+	case permissions.FieldResource:
+		return m.Resource()
+	case permissions.FieldAction:
+		return m.Action()
+	case permissions.FieldIsSystem:
+		return m.IsSystem()
+	case permissions.FieldCreatedAt:
+		return m.CreatedAt()
+	case permissions.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PermissionsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case permissions.FieldCode:
+		return m.OldCode(ctx)
+	case permissions.FieldName:
+		return m.OldName(ctx)
+	case permissions.FieldDescription:
+		return m.OldDescription(ctx)
+	case permissions.FieldResource:
+		return m.OldResource(ctx)
+	case permissions.FieldAction:
+		return m.OldAction(ctx)
+	case permissions.FieldIsSystem:
+		return m.OldIsSystem(ctx)
+	case permissions.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case permissions.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Permissions field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PermissionsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case permissions.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case permissions.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case permissions.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case permissions.FieldResource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResource(v)
+		return nil
+	case permissions.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case permissions.FieldIsSystem:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSystem(v)
+		return nil
+	case permissions.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case permissions.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Permissions field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PermissionsMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PermissionsMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PermissionsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Permissions numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PermissionsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(permissions.FieldDescription) {
+		fields = append(fields, permissions.FieldDescription)
+	}
+	if m.FieldCleared(permissions.FieldResource) {
+		fields = append(fields, permissions.FieldResource)
+	}
+	if m.FieldCleared(permissions.FieldAction) {
+		fields = append(fields, permissions.FieldAction)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PermissionsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PermissionsMutation) ClearField(name string) error {
+	switch name {
+	case permissions.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case permissions.FieldResource:
+		m.ClearResource()
+		return nil
+	case permissions.FieldAction:
+		m.ClearAction()
+		return nil
+	}
+	return fmt.Errorf("unknown Permissions nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PermissionsMutation) ResetField(name string) error {
+	switch name {
+	case permissions.FieldCode:
+		m.ResetCode()
+		return nil
+	case permissions.FieldName:
+		m.ResetName()
+		return nil
+	case permissions.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case permissions.FieldResource:
+		m.ResetResource()
+		return nil
+	case permissions.FieldAction:
+		m.ResetAction()
+		return nil
+	case permissions.FieldIsSystem:
+		m.ResetIsSystem()
+		return nil
+	case permissions.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case permissions.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Permissions field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PermissionsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.role_permissions != nil {
+		edges = append(edges, permissions.EdgeRolePermissions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PermissionsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case permissions.EdgeRolePermissions:
+		ids := make([]ent.Value, 0, len(m.role_permissions))
+		for id := range m.role_permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PermissionsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedrole_permissions != nil {
+		edges = append(edges, permissions.EdgeRolePermissions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PermissionsMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case permissions.EdgeRolePermissions:
+		ids := make([]ent.Value, 0, len(m.removedrole_permissions))
+		for id := range m.removedrole_permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PermissionsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrole_permissions {
+		edges = append(edges, permissions.EdgeRolePermissions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PermissionsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case permissions.EdgeRolePermissions:
+		return m.clearedrole_permissions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PermissionsMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Permissions unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PermissionsMutation) ResetEdge(name string) error {
+	switch name {
+	case permissions.EdgeRolePermissions:
+		m.ResetRolePermissions()
+		return nil
+	}
+	return fmt.Errorf("unknown Permissions edge %s", name)
+}
+
+// RelationTuplesMutation represents an operation that mutates the RelationTuples nodes in the graph.
+type RelationTuplesMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	object_type      *string
+	object_id        *string
+	relation         *string
+	subject_type     *string
+	subject_id       *string
+	subject_relation *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*RelationTuples, error)
+	predicates       []predicate.RelationTuples
+}
+
+var _ ent.Mutation = (*RelationTuplesMutation)(nil)
+
+// relationtuplesOption allows management of the mutation configuration using functional options.
+type relationtuplesOption func(*RelationTuplesMutation)
+
+// newRelationTuplesMutation creates new mutation for the RelationTuples entity.
+func newRelationTuplesMutation(c config, op Op, opts ...relationtuplesOption) *RelationTuplesMutation {
+	m := &RelationTuplesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRelationTuples,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRelationTuplesID sets the ID field of the mutation.
+func withRelationTuplesID(id int) relationtuplesOption {
+	return func(m *RelationTuplesMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RelationTuples
+		)
+		m.oldValue = func(ctx context.Context) (*RelationTuples, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RelationTuples.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRelationTuples sets the old RelationTuples of the mutation.
+func withRelationTuples(node *RelationTuples) relationtuplesOption {
+	return func(m *RelationTuplesMutation) {
+		m.oldValue = func(context.Context) (*RelationTuples, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RelationTuplesMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RelationTuplesMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RelationTuples entities.
+func (m *RelationTuplesMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RelationTuplesMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RelationTuplesMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RelationTuples.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetObjectType sets the "object_type" field.
+func (m *RelationTuplesMutation) SetObjectType(s string) {
+	m.object_type = &s
+}
+
+// ObjectType returns the value of the "object_type" field in the mutation.
+func (m *RelationTuplesMutation) ObjectType() (r string, exists bool) {
+	v := m.object_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectType returns the old "object_type" field's value of the RelationTuples entity.
+// If the RelationTuples object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationTuplesMutation) OldObjectType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectType: %w", err)
+	}
+	return oldValue.ObjectType, nil
+}
+
+// ResetObjectType resets all changes to the "object_type" field.
+func (m *RelationTuplesMutation) ResetObjectType() {
+	m.object_type = nil
+}
+
+// SetObjectID sets the "object_id" field.
+func (m *RelationTuplesMutation) SetObjectID(s string) {
+	m.object_id = &s
+}
+
+// ObjectID returns the value of the "object_id" field in the mutation.
+func (m *RelationTuplesMutation) ObjectID() (r string, exists bool) {
+	v := m.object_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectID returns the old "object_id" field's value of the RelationTuples entity.
+// If the RelationTuples object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationTuplesMutation) OldObjectID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectID: %w", err)
+	}
+	return oldValue.ObjectID, nil
+}
+
+// ResetObjectID resets all changes to the "object_id" field.
+func (m *RelationTuplesMutation) ResetObjectID() {
+	m.object_id = nil
+}
+
+// SetRelation sets the "relation" field.
+func (m *RelationTuplesMutation) SetRelation(s string) {
+	m.relation = &s
+}
+
+// Relation returns the value of the "relation" field in the mutation.
+func (m *RelationTuplesMutation) Relation() (r string, exists bool) {
+	v := m.relation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRelation returns the old "relation" field's value of the RelationTuples entity.
+// If the RelationTuples object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationTuplesMutation) OldRelation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRelation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRelation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRelation: %w", err)
+	}
+	return oldValue.Relation, nil
+}
+
+// ResetRelation resets all changes to the "relation" field.
+func (m *RelationTuplesMutation) ResetRelation() {
+	m.relation = nil
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (m *RelationTuplesMutation) SetSubjectType(s string) {
+	m.subject_type = &s
+}
+
+// SubjectType returns the value of the "subject_type" field in the mutation.
+func (m *RelationTuplesMutation) SubjectType() (r string, exists bool) {
+	v := m.subject_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectType returns the old "subject_type" field's value of the RelationTuples entity.
+// If the RelationTuples object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationTuplesMutation) OldSubjectType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectType: %w", err)
+	}
+	return oldValue.SubjectType, nil
+}
+
+// ResetSubjectType resets all changes to the "subject_type" field.
+func (m *RelationTuplesMutation) ResetSubjectType() {
+	m.subject_type = nil
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (m *RelationTuplesMutation) SetSubjectID(s string) {
+	m.subject_id = &s
+}
+
+// SubjectID returns the value of the "subject_id" field in the mutation.
+func (m *RelationTuplesMutation) SubjectID() (r string, exists bool) {
+	v := m.subject_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectID returns the old "subject_id" field's value of the RelationTuples entity.
+// If the RelationTuples object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationTuplesMutation) OldSubjectID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectID: %w", err)
+	}
+	return oldValue.SubjectID, nil
+}
+
+// ResetSubjectID resets all changes to the "subject_id" field.
+func (m *RelationTuplesMutation) ResetSubjectID() {
+	m.subject_id = nil
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (m *RelationTuplesMutation) SetSubjectRelation(s string) {
+	m.subject_relation = &s
+}
+
+// SubjectRelation returns the value of the "subject_relation" field in the mutation.
+func (m *RelationTuplesMutation) SubjectRelation() (r string, exists bool) {
+	v := m.subject_relation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectRelation returns the old "subject_relation" field's value of the RelationTuples entity.
+// If the RelationTuples object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationTuplesMutation) OldSubjectRelation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectRelation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectRelation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectRelation: %w", err)
+	}
+	return oldValue.SubjectRelation, nil
+}
+
+// ResetSubjectRelation resets all changes to the "subject_relation" field.
+func (m *RelationTuplesMutation) ResetSubjectRelation() {
+	m.subject_relation = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RelationTuplesMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RelationTuplesMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RelationTuples entity.
+// If the RelationTuples object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelationTuplesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RelationTuplesMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the RelationTuplesMutation builder.
+func (m *RelationTuplesMutation) Where(ps ...predicate.RelationTuples) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RelationTuplesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RelationTuplesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RelationTuples, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RelationTuplesMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RelationTuplesMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RelationTuples).
+func (m *RelationTuplesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RelationTuplesMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.object_type != nil {
+		fields = append(fields, relationtuples.FieldObjectType)
+	}
+	if m.object_id != nil {
+		fields = append(fields, relationtuples.FieldObjectID)
+	}
+	if m.relation != nil {
+		fields = append(fields, relationtuples.FieldRelation)
+	}
+	if m.subject_type != nil {
+		fields = append(fields, relationtuples.FieldSubjectType)
+	}
+	if m.subject_id != nil {
+		fields = append(fields, relationtuples.FieldSubjectID)
+	}
+	if m.subject_relation != nil {
+		fields = append(fields, relationtuples.FieldSubjectRelation)
+	}
+	if m.created_at != nil {
+		fields = append(fields, relationtuples.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RelationTuplesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case relationtuples.FieldObjectType:
+		return m.ObjectType()
+	case relationtuples.FieldObjectID:
+		return m.ObjectID()
+	case relationtuples.FieldRelation:
+		return m.Relation()
+	case relationtuples.FieldSubjectType:
+		return m.SubjectType()
+	case relationtuples.FieldSubjectID:
+		return m.SubjectID()
+	case relationtuples.FieldSubjectRelation:
+		return m.SubjectRelation()
+	case relationtuples.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RelationTuplesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case relationtuples.FieldObjectType:
+		return m.OldObjectType(ctx)
+	case relationtuples.FieldObjectID:
+		return m.OldObjectID(ctx)
+	case relationtuples.FieldRelation:
+		return m.OldRelation(ctx)
+	case relationtuples.FieldSubjectType:
+		return m.OldSubjectType(ctx)
+	case relationtuples.FieldSubjectID:
+		return m.OldSubjectID(ctx)
+	case relationtuples.FieldSubjectRelation:
+		return m.OldSubjectRelation(ctx)
+	case relationtuples.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RelationTuples field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RelationTuplesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case relationtuples.FieldObjectType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectType(v)
+		return nil
+	case relationtuples.FieldObjectID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectID(v)
+		return nil
+	case relationtuples.FieldRelation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRelation(v)
+		return nil
+	case relationtuples.FieldSubjectType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectType(v)
+		return nil
+	case relationtuples.FieldSubjectID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectID(v)
+		return nil
+	case relationtuples.FieldSubjectRelation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectRelation(v)
+		return nil
+	case relationtuples.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RelationTuples field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RelationTuplesMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RelationTuplesMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RelationTuplesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RelationTuples numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RelationTuplesMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RelationTuplesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RelationTuplesMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RelationTuples nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RelationTuplesMutation) ResetField(name string) error {
+	switch name {
+	case relationtuples.FieldObjectType:
+		m.ResetObjectType()
+		return nil
+	case relationtuples.FieldObjectID:
+		m.ResetObjectID()
+		return nil
+	case relationtuples.FieldRelation:
+		m.ResetRelation()
+		return nil
+	case relationtuples.FieldSubjectType:
+		m.ResetSubjectType()
+		return nil
+	case relationtuples.FieldSubjectID:
+		m.ResetSubjectID()
+		return nil
+	case relationtuples.FieldSubjectRelation:
+		m.ResetSubjectRelation()
+		return nil
+	case relationtuples.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RelationTuples field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RelationTuplesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RelationTuplesMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RelationTuplesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RelationTuplesMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RelationTuplesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RelationTuplesMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RelationTuplesMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RelationTuples unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RelationTuplesMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RelationTuples edge %s", name)
+}
+
+// RoleApproversMutation represents an operation that mutates the RoleApprovers nodes in the graph.
+type RoleApproversMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	role                 *int
+	clearedrole          bool
+	approver_role        *int
+	clearedapprover_role bool
+	done                 bool
+	oldValue             func(context.Context) (*RoleApprovers, error)
+	predicates           []predicate.RoleApprovers
+}
+
+var _ ent.Mutation = (*RoleApproversMutation)(nil)
+
+// roleapproversOption allows management of the mutation configuration using functional options.
+type roleapproversOption func(*RoleApproversMutation)
+
+// newRoleApproversMutation creates new mutation for the RoleApprovers entity.
+func newRoleApproversMutation(c config, op Op, opts ...roleapproversOption) *RoleApproversMutation {
+	m := &RoleApproversMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoleApprovers,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleApproversID sets the ID field of the mutation.
+func withRoleApproversID(id int) roleapproversOption {
+	return func(m *RoleApproversMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoleApprovers
+		)
+		m.oldValue = func(ctx context.Context) (*RoleApprovers, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoleApprovers.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoleApprovers sets the old RoleApprovers of the mutation.
+func withRoleApprovers(node *RoleApprovers) roleapproversOption {
+	return func(m *RoleApproversMutation) {
+		m.oldValue = func(context.Context) (*RoleApprovers, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleApproversMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleApproversMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoleApprovers entities.
+func (m *RoleApproversMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleApproversMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleApproversMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoleApprovers.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *RoleApproversMutation) SetRoleID(i int) {
+	m.role = &i
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *RoleApproversMutation) RoleID() (r int, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the RoleApprovers entity.
+// If the RoleApprovers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleApproversMutation) OldRoleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *RoleApproversMutation) ResetRoleID() {
+	m.role = nil
+}
+
+// SetApproverRoleID sets the "approver_role_id" field.
+func (m *RoleApproversMutation) SetApproverRoleID(i int) {
+	m.approver_role = &i
+}
+
+// ApproverRoleID returns the value of the "approver_role_id" field in the mutation.
+func (m *RoleApproversMutation) ApproverRoleID() (r int, exists bool) {
+	v := m.approver_role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApproverRoleID returns the old "approver_role_id" field's value of the RoleApprovers entity.
+// If the RoleApprovers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleApproversMutation) OldApproverRoleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApproverRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApproverRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApproverRoleID: %w", err)
+	}
+	return oldValue.ApproverRoleID, nil
+}
+
+// ResetApproverRoleID resets all changes to the "approver_role_id" field.
+func (m *RoleApproversMutation) ResetApproverRoleID() {
+	m.approver_role = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleApproversMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleApproversMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RoleApprovers entity.
+// If the RoleApprovers object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleApproversMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleApproversMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (m *RoleApproversMutation) ClearRole() {
+	m.clearedrole = true
+	m.clearedFields[roleapprovers.FieldRoleID] = struct{}{}
+}
+
+// RoleCleared reports if the "role" edge to the Roles entity was cleared.
+func (m *RoleApproversMutation) RoleCleared() bool {
+	return m.clearedrole
+}
+
+// RoleIDs returns the "role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleID instead. It exists only for internal usage by the builders.
+func (m *RoleApproversMutation) RoleIDs() (ids []int) {
+	if id := m.role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRole resets all changes to the "role" edge.
+func (m *RoleApproversMutation) ResetRole() {
+	m.role = nil
+	m.clearedrole = false
+}
+
+// ClearApproverRole clears the "approver_role" edge to the Roles entity.
+func (m *RoleApproversMutation) ClearApproverRole() {
+	m.clearedapprover_role = true
+	m.clearedFields[roleapprovers.FieldApproverRoleID] = struct{}{}
+}
+
+// ApproverRoleCleared reports if the "approver_role" edge to the Roles entity was cleared.
+func (m *RoleApproversMutation) ApproverRoleCleared() bool {
+	return m.clearedapprover_role
+}
+
+// ApproverRoleIDs returns the "approver_role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ApproverRoleID instead. It exists only for internal usage by the builders.
+func (m *RoleApproversMutation) ApproverRoleIDs() (ids []int) {
+	if id := m.approver_role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetApproverRole resets all changes to the "approver_role" edge.
+func (m *RoleApproversMutation) ResetApproverRole() {
+	m.approver_role = nil
+	m.clearedapprover_role = false
+}
+
+// Where appends a list predicates to the RoleApproversMutation builder.
+func (m *RoleApproversMutation) Where(ps ...predicate.RoleApprovers) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleApproversMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleApproversMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleApprovers, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleApproversMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleApproversMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoleApprovers).
+func (m *RoleApproversMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleApproversMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.role != nil {
+		fields = append(fields, roleapprovers.FieldRoleID)
+	}
+	if m.approver_role != nil {
+		fields = append(fields, roleapprovers.FieldApproverRoleID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, roleapprovers.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleApproversMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roleapprovers.FieldRoleID:
+		return m.RoleID()
+	case roleapprovers.FieldApproverRoleID:
+		return m.ApproverRoleID()
+	case roleapprovers.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleApproversMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roleapprovers.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case roleapprovers.FieldApproverRoleID:
+		return m.OldApproverRoleID(ctx)
+	case roleapprovers.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoleApprovers field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleApproversMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roleapprovers.FieldRoleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case roleapprovers.FieldApproverRoleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApproverRoleID(v)
+		return nil
+	case roleapprovers.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleApprovers field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleApproversMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleApproversMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleApproversMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RoleApprovers numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleApproversMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleApproversMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleApproversMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RoleApprovers nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleApproversMutation) ResetField(name string) error {
+	switch name {
+	case roleapprovers.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	case roleapprovers.FieldApproverRoleID:
+		m.ResetApproverRoleID()
+		return nil
+	case roleapprovers.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleApprovers field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleApproversMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.role != nil {
+		edges = append(edges, roleapprovers.EdgeRole)
+	}
+	if m.approver_role != nil {
+		edges = append(edges, roleapprovers.EdgeApproverRole)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleApproversMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case roleapprovers.EdgeRole:
+		if id := m.role; id != nil {
+			return []ent.Value{*id}
+		}
+	case roleapprovers.EdgeApproverRole:
+		if id := m.approver_role; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleApproversMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleApproversMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleApproversMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedrole {
+		edges = append(edges, roleapprovers.EdgeRole)
+	}
+	if m.clearedapprover_role {
+		edges = append(edges, roleapprovers.EdgeApproverRole)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleApproversMutation) EdgeCleared(name string) bool {
+	switch name {
+	case roleapprovers.EdgeRole:
+		return m.clearedrole
+	case roleapprovers.EdgeApproverRole:
+		return m.clearedapprover_role
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleApproversMutation) ClearEdge(name string) error {
+	switch name {
+	case roleapprovers.EdgeRole:
+		m.ClearRole()
+		return nil
+	case roleapprovers.EdgeApproverRole:
+		m.ClearApproverRole()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleApprovers unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleApproversMutation) ResetEdge(name string) error {
+	switch name {
+	case roleapprovers.EdgeRole:
+		m.ResetRole()
+		return nil
+	case roleapprovers.EdgeApproverRole:
+		m.ResetApproverRole()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleApprovers edge %s", name)
+}
+
+// RoleParentsMutation represents an operation that mutates the RoleParents nodes in the graph.
+type RoleParentsMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	role          *int
+	clearedrole   bool
+	parent        *int
+	clearedparent bool
+	done          bool
+	oldValue      func(context.Context) (*RoleParents, error)
+	predicates    []predicate.RoleParents
+}
+
+var _ ent.Mutation = (*RoleParentsMutation)(nil)
+
+// roleparentsOption allows management of the mutation configuration using functional options.
+type roleparentsOption func(*RoleParentsMutation)
+
+// newRoleParentsMutation creates new mutation for the RoleParents entity.
+func newRoleParentsMutation(c config, op Op, opts ...roleparentsOption) *RoleParentsMutation {
+	m := &RoleParentsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoleParents,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleParentsID sets the ID field of the mutation.
+func withRoleParentsID(id int) roleparentsOption {
+	return func(m *RoleParentsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoleParents
+		)
+		m.oldValue = func(ctx context.Context) (*RoleParents, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoleParents.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoleParents sets the old RoleParents of the mutation.
+func withRoleParents(node *RoleParents) roleparentsOption {
+	return func(m *RoleParentsMutation) {
+		m.oldValue = func(context.Context) (*RoleParents, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleParentsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleParentsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoleParents entities.
+func (m *RoleParentsMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleParentsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleParentsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoleParents.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *RoleParentsMutation) SetRoleID(i int) {
+	m.role = &i
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *RoleParentsMutation) RoleID() (r int, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the RoleParents entity.
+// If the RoleParents object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleParentsMutation) OldRoleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *RoleParentsMutation) ResetRoleID() {
+	m.role = nil
+}
+
+// SetParentRoleID sets the "parent_role_id" field.
+func (m *RoleParentsMutation) SetParentRoleID(i int) {
+	m.parent = &i
+}
+
+// ParentRoleID returns the value of the "parent_role_id" field in the mutation.
+func (m *RoleParentsMutation) ParentRoleID() (r int, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentRoleID returns the old "parent_role_id" field's value of the RoleParents entity.
+// If the RoleParents object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleParentsMutation) OldParentRoleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentRoleID: %w", err)
+	}
+	return oldValue.ParentRoleID, nil
+}
+
+// ResetParentRoleID resets all changes to the "parent_role_id" field.
+func (m *RoleParentsMutation) ResetParentRoleID() {
+	m.parent = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleParentsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleParentsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RoleParents entity.
+// If the RoleParents object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleParentsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleParentsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (m *RoleParentsMutation) ClearRole() {
+	m.clearedrole = true
+	m.clearedFields[roleparents.FieldRoleID] = struct{}{}
+}
+
+// RoleCleared reports if the "role" edge to the Roles entity was cleared.
+func (m *RoleParentsMutation) RoleCleared() bool {
+	return m.clearedrole
+}
+
+// RoleIDs returns the "role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleID instead. It exists only for internal usage by the builders.
+func (m *RoleParentsMutation) RoleIDs() (ids []int) {
+	if id := m.role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRole resets all changes to the "role" edge.
+func (m *RoleParentsMutation) ResetRole() {
+	m.role = nil
+	m.clearedrole = false
+}
+
+// SetParentID sets the "parent" edge to the Roles entity by id.
+func (m *RoleParentsMutation) SetParentID(id int) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Roles entity.
+func (m *RoleParentsMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[roleparents.FieldParentRoleID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Roles entity was cleared.
+func (m *RoleParentsMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *RoleParentsMutation) ParentID() (id int, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *RoleParentsMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *RoleParentsMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// Where appends a list predicates to the RoleParentsMutation builder.
+func (m *RoleParentsMutation) Where(ps ...predicate.RoleParents) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleParentsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleParentsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleParents, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleParentsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleParentsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoleParents).
+func (m *RoleParentsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleParentsMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.role != nil {
+		fields = append(fields, roleparents.FieldRoleID)
+	}
+	if m.parent != nil {
+		fields = append(fields, roleparents.FieldParentRoleID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, roleparents.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleParentsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case roleparents.FieldRoleID:
+		return m.RoleID()
+	case roleparents.FieldParentRoleID:
+		return m.ParentRoleID()
+	case roleparents.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleParentsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case roleparents.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case roleparents.FieldParentRoleID:
+		return m.OldParentRoleID(ctx)
+	case roleparents.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoleParents field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleParentsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case roleparents.FieldRoleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case roleparents.FieldParentRoleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentRoleID(v)
+		return nil
+	case roleparents.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleParents field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleParentsMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleParentsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleParentsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RoleParents numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleParentsMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleParentsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleParentsMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RoleParents nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleParentsMutation) ResetField(name string) error {
+	switch name {
+	case roleparents.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	case roleparents.FieldParentRoleID:
+		m.ResetParentRoleID()
+		return nil
+	case roleparents.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleParents field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleParentsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.role != nil {
+		edges = append(edges, roleparents.EdgeRole)
+	}
+	if m.parent != nil {
+		edges = append(edges, roleparents.EdgeParent)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleParentsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case roleparents.EdgeRole:
+		if id := m.role; id != nil {
+			return []ent.Value{*id}
+		}
+	case roleparents.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleParentsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleParentsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleParentsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedrole {
+		edges = append(edges, roleparents.EdgeRole)
+	}
+	if m.clearedparent {
+		edges = append(edges, roleparents.EdgeParent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleParentsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case roleparents.EdgeRole:
+		return m.clearedrole
+	case roleparents.EdgeParent:
+		return m.clearedparent
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleParentsMutation) ClearEdge(name string) error {
+	switch name {
+	case roleparents.EdgeRole:
+		m.ClearRole()
+		return nil
+	case roleparents.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleParents unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleParentsMutation) ResetEdge(name string) error {
+	switch name {
+	case roleparents.EdgeRole:
+		m.ResetRole()
+		return nil
+	case roleparents.EdgeParent:
+		m.ResetParent()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleParents edge %s", name)
+}
+
+// RolePermissionsMutation represents an operation that mutates the RolePermissions nodes in the graph.
+type RolePermissionsMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	condition         *string
+	assigned_at       *time.Time
+	clearedFields     map[string]struct{}
+	role              *int
+	clearedrole       bool
+	permission        *int
+	clearedpermission bool
+	done              bool
+	oldValue          func(context.Context) (*RolePermissions, error)
+	predicates        []predicate.RolePermissions
+}
+
+var _ ent.Mutation = (*RolePermissionsMutation)(nil)
+
+// rolepermissionsOption allows management of the mutation configuration using functional options.
+type rolepermissionsOption func(*RolePermissionsMutation)
+
+// newRolePermissionsMutation creates new mutation for the RolePermissions entity.
+func newRolePermissionsMutation(c config, op Op, opts ...rolepermissionsOption) *RolePermissionsMutation {
+	m := &RolePermissionsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRolePermissions,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRolePermissionsID sets the ID field of the mutation.
+func withRolePermissionsID(id int) rolepermissionsOption {
+	return func(m *RolePermissionsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RolePermissions
+		)
+		m.oldValue = func(ctx context.Context) (*RolePermissions, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RolePermissions.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRolePermissions sets the old RolePermissions of the mutation.
+func withRolePermissions(node *RolePermissions) rolepermissionsOption {
+	return func(m *RolePermissionsMutation) {
+		m.oldValue = func(context.Context) (*RolePermissions, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RolePermissionsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RolePermissionsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RolePermissions entities.
+func (m *RolePermissionsMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RolePermissionsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RolePermissionsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RolePermissions.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *RolePermissionsMutation) SetRoleID(i int) {
+	m.role = &i
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *RolePermissionsMutation) RoleID() (r int, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the RolePermissions entity.
+// If the RolePermissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionsMutation) OldRoleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *RolePermissionsMutation) ResetRoleID() {
+	m.role = nil
+}
+
+// SetPermissionID sets the "permission_id" field.
+func (m *RolePermissionsMutation) SetPermissionID(i int) {
+	m.permission = &i
+}
+
+// PermissionID returns the value of the "permission_id" field in the mutation.
+func (m *RolePermissionsMutation) PermissionID() (r int, exists bool) {
+	v := m.permission
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissionID returns the old "permission_id" field's value of the RolePermissions entity.
+// If the RolePermissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionsMutation) OldPermissionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissionID: %w", err)
+	}
+	return oldValue.PermissionID, nil
+}
+
+// ResetPermissionID resets all changes to the "permission_id" field.
+func (m *RolePermissionsMutation) ResetPermissionID() {
+	m.permission = nil
+}
+
+// SetCondition sets the "condition" field.
+func (m *RolePermissionsMutation) SetCondition(s string) {
+	m.condition = &s
+}
+
+// Condition returns the value of the "condition" field in the mutation.
+func (m *RolePermissionsMutation) Condition() (r string, exists bool) {
+	v := m.condition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCondition returns the old "condition" field's value of the RolePermissions entity.
+// If the RolePermissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionsMutation) OldCondition(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCondition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCondition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCondition: %w", err)
+	}
+	return oldValue.Condition, nil
+}
+
+// ClearCondition clears the value of the "condition" field.
+func (m *RolePermissionsMutation) ClearCondition() {
+	m.condition = nil
+	m.clearedFields[rolepermissions.FieldCondition] = struct{}{}
+}
+
+// ConditionCleared returns if the "condition" field was cleared in this mutation.
+func (m *RolePermissionsMutation) ConditionCleared() bool {
+	_, ok := m.clearedFields[rolepermissions.FieldCondition]
+	return ok
+}
+
+// ResetCondition resets all changes to the "condition" field.
+func (m *RolePermissionsMutation) ResetCondition() {
+	m.condition = nil
+	delete(m.clearedFields, rolepermissions.FieldCondition)
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (m *RolePermissionsMutation) SetAssignedAt(t time.Time) {
+	m.assigned_at = &t
+}
+
+// AssignedAt returns the value of the "assigned_at" field in the mutation.
+func (m *RolePermissionsMutation) AssignedAt() (r time.Time, exists bool) {
+	v := m.assigned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedAt returns the old "assigned_at" field's value of the RolePermissions entity.
+// If the RolePermissions object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolePermissionsMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+	}
+	return oldValue.AssignedAt, nil
+}
+
+// ResetAssignedAt resets all changes to the "assigned_at" field.
+func (m *RolePermissionsMutation) ResetAssignedAt() {
+	m.assigned_at = nil
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (m *RolePermissionsMutation) ClearRole() {
+	m.clearedrole = true
+	m.clearedFields[rolepermissions.FieldRoleID] = struct{}{}
+}
+
+// RoleCleared reports if the "role" edge to the Roles entity was cleared.
+func (m *RolePermissionsMutation) RoleCleared() bool {
+	return m.clearedrole
+}
+
+// RoleIDs returns the "role" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RoleID instead. It exists only for internal usage by the builders.
+func (m *RolePermissionsMutation) RoleIDs() (ids []int) {
+	if id := m.role; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRole resets all changes to the "role" edge.
+func (m *RolePermissionsMutation) ResetRole() {
+	m.role = nil
+	m.clearedrole = false
+}
+
+// ClearPermission clears the "permission" edge to the Permissions entity.
+func (m *RolePermissionsMutation) ClearPermission() {
+	m.clearedpermission = true
+	m.clearedFields[rolepermissions.FieldPermissionID] = struct{}{}
+}
+
+// PermissionCleared reports if the "permission" edge to the Permissions entity was cleared.
+func (m *RolePermissionsMutation) PermissionCleared() bool {
+	return m.clearedpermission
+}
+
+// PermissionIDs returns the "permission" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PermissionID instead. It exists only for internal usage by the builders.
+func (m *RolePermissionsMutation) PermissionIDs() (ids []int) {
+	if id := m.permission; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPermission resets all changes to the "permission" edge.
+func (m *RolePermissionsMutation) ResetPermission() {
+	m.permission = nil
+	m.clearedpermission = false
+}
+
+// Where appends a list predicates to the RolePermissionsMutation builder.
+func (m *RolePermissionsMutation) Where(ps ...predicate.RolePermissions) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RolePermissionsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RolePermissionsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RolePermissions, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RolePermissionsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RolePermissionsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RolePermissions).
+func (m *RolePermissionsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RolePermissionsMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.role != nil {
+		fields = append(fields, rolepermissions.FieldRoleID)
+	}
+	if m.permission != nil {
+		fields = append(fields, rolepermissions.FieldPermissionID)
+	}
+	if m.condition != nil {
+		fields = append(fields, rolepermissions.FieldCondition)
+	}
+	if m.assigned_at != nil {
+		fields = append(fields, rolepermissions.FieldAssignedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RolePermissionsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rolepermissions.FieldRoleID:
+		return m.RoleID()
+	case rolepermissions.FieldPermissionID:
+		return m.PermissionID()
+	case rolepermissions.FieldCondition:
+		return m.Condition()
+	case rolepermissions.FieldAssignedAt:
+		return m.AssignedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RolePermissionsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rolepermissions.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case rolepermissions.FieldPermissionID:
+		return m.OldPermissionID(ctx)
+	case rolepermissions.FieldCondition:
+		return m.OldCondition(ctx)
+	case rolepermissions.FieldAssignedAt:
+		return m.OldAssignedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RolePermissions field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RolePermissionsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rolepermissions.FieldRoleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case rolepermissions.FieldPermissionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissionID(v)
+		return nil
+	case rolepermissions.FieldCondition:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCondition(v)
+		return nil
+	case rolepermissions.FieldAssignedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermissions field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RolePermissionsMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RolePermissionsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RolePermissionsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RolePermissions numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RolePermissionsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rolepermissions.FieldCondition) {
+		fields = append(fields, rolepermissions.FieldCondition)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RolePermissionsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RolePermissionsMutation) ClearField(name string) error {
+	switch name {
+	case rolepermissions.FieldCondition:
+		m.ClearCondition()
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermissions nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RolePermissionsMutation) ResetField(name string) error {
+	switch name {
+	case rolepermissions.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	case rolepermissions.FieldPermissionID:
+		m.ResetPermissionID()
+		return nil
+	case rolepermissions.FieldCondition:
+		m.ResetCondition()
+		return nil
+	case rolepermissions.FieldAssignedAt:
+		m.ResetAssignedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermissions field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RolePermissionsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.role != nil {
+		edges = append(edges, rolepermissions.EdgeRole)
+	}
+	if m.permission != nil {
+		edges = append(edges, rolepermissions.EdgePermission)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RolePermissionsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rolepermissions.EdgeRole:
+		if id := m.role; id != nil {
+			return []ent.Value{*id}
+		}
+	case rolepermissions.EdgePermission:
+		if id := m.permission; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RolePermissionsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RolePermissionsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RolePermissionsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedrole {
+		edges = append(edges, rolepermissions.EdgeRole)
+	}
+	if m.clearedpermission {
+		edges = append(edges, rolepermissions.EdgePermission)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RolePermissionsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rolepermissions.EdgeRole:
+		return m.clearedrole
+	case rolepermissions.EdgePermission:
+		return m.clearedpermission
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RolePermissionsMutation) ClearEdge(name string) error {
+	switch name {
+	case rolepermissions.EdgeRole:
+		m.ClearRole()
+		return nil
+	case rolepermissions.EdgePermission:
+		m.ClearPermission()
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermissions unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RolePermissionsMutation) ResetEdge(name string) error {
+	switch name {
+	case rolepermissions.EdgeRole:
+		m.ResetRole()
+		return nil
+	case rolepermissions.EdgePermission:
+		m.ResetPermission()
+		return nil
+	}
+	return fmt.Errorf("unknown RolePermissions edge %s", name)
+}
+
+// RoleRequestsMutation represents an operation that mutates the RoleRequests nodes in the graph.
+type RoleRequestsMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	user_id           *uuid.UUID
+	role_id           *int
+	addrole_id        *int
+	reason            *string
+	duration_hours    *int
+	addduration_hours *int
+	break_glass       *bool
+	status            *rolerequests.Status
+	decided_by        *uuid.UUID
+	decided_at        *time.Time
+	decision_note     *string
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*RoleRequests, error)
+	predicates        []predicate.RoleRequests
+}
+
+var _ ent.Mutation = (*RoleRequestsMutation)(nil)
+
+// rolerequestsOption allows management of the mutation configuration using functional options.
+type rolerequestsOption func(*RoleRequestsMutation)
+
+// newRoleRequestsMutation creates new mutation for the RoleRequests entity.
+func newRoleRequestsMutation(c config, op Op, opts ...rolerequestsOption) *RoleRequestsMutation {
+	m := &RoleRequestsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoleRequests,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleRequestsID sets the ID field of the mutation.
+func withRoleRequestsID(id uuid.UUID) rolerequestsOption {
+	return func(m *RoleRequestsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoleRequests
+		)
+		m.oldValue = func(ctx context.Context) (*RoleRequests, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoleRequests.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoleRequests sets the old RoleRequests of the mutation.
+func withRoleRequests(node *RoleRequests) rolerequestsOption {
+	return func(m *RoleRequestsMutation) {
+		m.oldValue = func(context.Context) (*RoleRequests, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleRequestsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleRequestsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoleRequests entities.
+func (m *RoleRequestsMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleRequestsMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleRequestsMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoleRequests.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RoleRequestsMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RoleRequestsMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RoleRequests entity.
+// If the RoleRequests object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRequestsMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RoleRequestsMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *RoleRequestsMutation) SetRoleID(i int) {
+	m.role_id = &i
+	m.addrole_id = nil
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *RoleRequestsMutation) RoleID() (r int, exists bool) {
+	v := m.role_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the RoleRequests entity.
+// If the RoleRequests object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRequestsMutation) OldRoleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// AddRoleID adds i to the "role_id" field.
+func (m *RoleRequestsMutation) AddRoleID(i int) {
+	if m.addrole_id != nil {
+		*m.addrole_id += i
+	} else {
+		m.addrole_id = &i
+	}
+}
+
+// AddedRoleID returns the value that was added to the "role_id" field in this mutation.
+func (m *RoleRequestsMutation) AddedRoleID() (r int, exists bool) {
+	v := m.addrole_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *RoleRequestsMutation) ResetRoleID() {
+	m.role_id = nil
+	m.addrole_id = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *RoleRequestsMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *RoleRequestsMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the RoleRequests entity.
+// If the RoleRequests object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRequestsMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *RoleRequestsMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetDurationHours sets the "duration_hours" field.
+func (m *RoleRequestsMutation) SetDurationHours(i int) {
+	m.duration_hours = &i
+	m.addduration_hours = nil
+}
+
+// DurationHours returns the value of the "duration_hours" field in the mutation.
+func (m *RoleRequestsMutation) DurationHours() (r int, exists bool) {
+	v := m.duration_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationHours returns the old "duration_hours" field's value of the RoleRequests entity.
+// If the RoleRequests object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRequestsMutation) OldDurationHours(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationHours is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationHours: %w", err)
+	}
+	return oldValue.DurationHours, nil
+}
+
+// AddDurationHours adds i to the "duration_hours" field.
+func (m *RoleRequestsMutation) AddDurationHours(i int) {
+	if m.addduration_hours != nil {
+		*m.addduration_hours += i
+	} else {
+		m.addduration_hours = &i
+	}
+}
+
+// AddedDurationHours returns the value that was added to the "duration_hours" field in this mutation.
+func (m *RoleRequestsMutation) AddedDurationHours() (r int, exists bool) {
+	v := m.addduration_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDurationHours clears the value of the "duration_hours" field.
+func (m *RoleRequestsMutation) ClearDurationHours() {
+	m.duration_hours = nil
+	m.addduration_hours = nil
+	m.clearedFields[rolerequests.FieldDurationHours] = struct{}{}
+}
+
+// DurationHoursCleared returns if the "duration_hours" field was cleared in this mutation.
+func (m *RoleRequestsMutation) DurationHoursCleared() bool {
+	_, ok := m.clearedFields[rolerequests.FieldDurationHours]
+	return ok
+}
+
+// ResetDurationHours resets all changes to the "duration_hours" field.
+func (m *RoleRequestsMutation) ResetDurationHours() {
+	m.duration_hours = nil
+	m.addduration_hours = nil
+	delete(m.clearedFields, rolerequests.FieldDurationHours)
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (m *RoleRequestsMutation) SetBreakGlass(b bool) {
+	m.break_glass = &b
+}
+
+// BreakGlass returns the value of the "break_glass" field in the mutation.
+func (m *RoleRequestsMutation) BreakGlass() (r bool, exists bool) {
+	v := m.break_glass
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBreakGlass returns the old "break_glass" field's value of the RoleRequests entity.
+// If the RoleRequests object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRequestsMutation) OldBreakGlass(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBreakGlass is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBreakGlass requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBreakGlass: %w", err)
+	}
+	return oldValue.BreakGlass, nil
+}
+
+// ResetBreakGlass resets all changes to the "break_glass" field.
+func (m *RoleRequestsMutation) ResetBreakGlass() {
+	m.break_glass = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *RoleRequestsMutation) SetStatus(r rolerequests.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RoleRequestsMutation) Status() (r rolerequests.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the RoleRequests entity.
+// If the RoleRequests object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRequestsMutation) OldStatus(ctx context.Context) (v rolerequests.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RoleRequestsMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (m *RoleRequestsMutation) SetDecidedBy(u uuid.UUID) {
+	m.decided_by = &u
+}
+
+// DecidedBy returns the value of the "decided_by" field in the mutation.
+func (m *RoleRequestsMutation) DecidedBy() (r uuid.UUID, exists bool) {
+	v := m.decided_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecidedBy returns the old "decided_by" field's value of the RoleRequests entity.
+// If the RoleRequests object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRequestsMutation) OldDecidedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedBy: %w", err)
+	}
+	return oldValue.DecidedBy, nil
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (m *RoleRequestsMutation) ClearDecidedBy() {
+	m.decided_by = nil
+	m.clearedFields[rolerequests.FieldDecidedBy] = struct{}{}
+}
+
+// DecidedByCleared returns if the "decided_by" field was cleared in this mutation.
+func (m *RoleRequestsMutation) DecidedByCleared() bool {
+	_, ok := m.clearedFields[rolerequests.FieldDecidedBy]
+	return ok
+}
+
+// ResetDecidedBy resets all changes to the "decided_by" field.
+func (m *RoleRequestsMutation) ResetDecidedBy() {
+	m.decided_by = nil
+	delete(m.clearedFields, rolerequests.FieldDecidedBy)
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (m *RoleRequestsMutation) SetDecidedAt(t time.Time) {
+	m.decided_at = &t
+}
+
+// DecidedAt returns the value of the "decided_at" field in the mutation.
+func (m *RoleRequestsMutation) DecidedAt() (r time.Time, exists bool) {
+	v := m.decided_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecidedAt returns the old "decided_at" field's value of the RoleRequests entity.
+// If the RoleRequests object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRequestsMutation) OldDecidedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedAt: %w", err)
+	}
+	return oldValue.DecidedAt, nil
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (m *RoleRequestsMutation) ClearDecidedAt() {
+	m.decided_at = nil
+	m.clearedFields[rolerequests.FieldDecidedAt] = struct{}{}
+}
+
+// DecidedAtCleared returns if the "decided_at" field was cleared in this mutation.
+func (m *RoleRequestsMutation) DecidedAtCleared() bool {
+	_, ok := m.clearedFields[rolerequests.FieldDecidedAt]
+	return ok
+}
+
+// ResetDecidedAt resets all changes to the "decided_at" field.
+func (m *RoleRequestsMutation) ResetDecidedAt() {
+	m.decided_at = nil
+	delete(m.clearedFields, rolerequests.FieldDecidedAt)
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (m *RoleRequestsMutation) SetDecisionNote(s string) {
+	m.decision_note = &s
+}
+
+// DecisionNote returns the value of the "decision_note" field in the mutation.
+func (m *RoleRequestsMutation) DecisionNote() (r string, exists bool) {
+	v := m.decision_note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecisionNote returns the old "decision_note" field's value of the RoleRequests entity.
+// If the RoleRequests object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRequestsMutation) OldDecisionNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecisionNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecisionNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecisionNote: %w", err)
+	}
+	return oldValue.DecisionNote, nil
+}
+
+// ClearDecisionNote clears the value of the "decision_note" field.
+func (m *RoleRequestsMutation) ClearDecisionNote() {
+	m.decision_note = nil
+	m.clearedFields[rolerequests.FieldDecisionNote] = struct{}{}
+}
+
+// DecisionNoteCleared returns if the "decision_note" field was cleared in this mutation.
+func (m *RoleRequestsMutation) DecisionNoteCleared() bool {
+	_, ok := m.clearedFields[rolerequests.FieldDecisionNote]
+	return ok
+}
+
+// ResetDecisionNote resets all changes to the "decision_note" field.
+func (m *RoleRequestsMutation) ResetDecisionNote() {
+	m.decision_note = nil
+	delete(m.clearedFields, rolerequests.FieldDecisionNote)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleRequestsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleRequestsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RoleRequests entity.
+// If the RoleRequests object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRequestsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleRequestsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoleRequestsMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoleRequestsMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RoleRequests entity.
+// If the RoleRequests object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleRequestsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoleRequestsMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RoleRequestsMutation builder.
+func (m *RoleRequestsMutation) Where(ps ...predicate.RoleRequests) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleRequestsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleRequestsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoleRequests, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleRequestsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleRequestsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoleRequests).
+func (m *RoleRequestsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleRequestsMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.user_id != nil {
+		fields = append(fields, rolerequests.FieldUserID)
+	}
+	if m.role_id != nil {
+		fields = append(fields, rolerequests.FieldRoleID)
+	}
+	if m.reason != nil {
+		fields = append(fields, rolerequests.FieldReason)
+	}
+	if m.duration_hours != nil {
+		fields = append(fields, rolerequests.FieldDurationHours)
+	}
+	if m.break_glass != nil {
+		fields = append(fields, rolerequests.FieldBreakGlass)
+	}
+	if m.status != nil {
+		fields = append(fields, rolerequests.FieldStatus)
+	}
+	if m.decided_by != nil {
+		fields = append(fields, rolerequests.FieldDecidedBy)
+	}
+	if m.decided_at != nil {
+		fields = append(fields, rolerequests.FieldDecidedAt)
+	}
+	if m.decision_note != nil {
+		fields = append(fields, rolerequests.FieldDecisionNote)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rolerequests.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rolerequests.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleRequestsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rolerequests.FieldUserID:
+		return m.UserID()
+	case rolerequests.FieldRoleID:
+		return m.RoleID()
+	case rolerequests.FieldReason:
+		return m.Reason()
+	case rolerequests.FieldDurationHours:
+		return m.DurationHours()
+	case rolerequests.FieldBreakGlass:
+		return m.BreakGlass()
+	case rolerequests.FieldStatus:
+		return m.Status()
+	case rolerequests.FieldDecidedBy:
+		return m.DecidedBy()
+	case rolerequests.FieldDecidedAt:
+		return m.DecidedAt()
+	case rolerequests.FieldDecisionNote:
+		return m.DecisionNote()
+	case rolerequests.FieldCreatedAt:
+		return m.CreatedAt()
+	case rolerequests.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleRequestsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rolerequests.FieldUserID:
+		return m.OldUserID(ctx)
+	case rolerequests.FieldRoleID:
+		return m.OldRoleID(ctx)
+	case rolerequests.FieldReason:
+		return m.OldReason(ctx)
+	case rolerequests.FieldDurationHours:
+		return m.OldDurationHours(ctx)
+	case rolerequests.FieldBreakGlass:
+		return m.OldBreakGlass(ctx)
+	case rolerequests.FieldStatus:
+		return m.OldStatus(ctx)
+	case rolerequests.FieldDecidedBy:
+		return m.OldDecidedBy(ctx)
+	case rolerequests.FieldDecidedAt:
+		return m.OldDecidedAt(ctx)
+	case rolerequests.FieldDecisionNote:
+		return m.OldDecisionNote(ctx)
+	case rolerequests.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case rolerequests.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoleRequests field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleRequestsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rolerequests.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case rolerequests.FieldRoleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	case rolerequests.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case rolerequests.FieldDurationHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationHours(v)
+		return nil
+	case rolerequests.FieldBreakGlass:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBreakGlass(v)
+		return nil
+	case rolerequests.FieldStatus:
+		v, ok := value.(rolerequests.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case rolerequests.FieldDecidedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecidedBy(v)
+		return nil
+	case rolerequests.FieldDecidedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecidedAt(v)
+		return nil
+	case rolerequests.FieldDecisionNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecisionNote(v)
+		return nil
+	case rolerequests.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case rolerequests.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleRequests field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleRequestsMutation) AddedFields() []string {
+	var fields []string
+	if m.addrole_id != nil {
+		fields = append(fields, rolerequests.FieldRoleID)
+	}
+	if m.addduration_hours != nil {
+		fields = append(fields, rolerequests.FieldDurationHours)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleRequestsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rolerequests.FieldRoleID:
+		return m.AddedRoleID()
+	case rolerequests.FieldDurationHours:
+		return m.AddedDurationHours()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleRequestsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rolerequests.FieldRoleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoleID(v)
+		return nil
+	case rolerequests.FieldDurationHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationHours(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoleRequests numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleRequestsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rolerequests.FieldDurationHours) {
+		fields = append(fields, rolerequests.FieldDurationHours)
+	}
+	if m.FieldCleared(rolerequests.FieldDecidedBy) {
+		fields = append(fields, rolerequests.FieldDecidedBy)
+	}
+	if m.FieldCleared(rolerequests.FieldDecidedAt) {
+		fields = append(fields, rolerequests.FieldDecidedAt)
+	}
+	if m.FieldCleared(rolerequests.FieldDecisionNote) {
+		fields = append(fields, rolerequests.FieldDecisionNote)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleRequestsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleRequestsMutation) ClearField(name string) error {
+	switch name {
+	case rolerequests.FieldDurationHours:
+		m.ClearDurationHours()
+		return nil
+	case rolerequests.FieldDecidedBy:
+		m.ClearDecidedBy()
+		return nil
+	case rolerequests.FieldDecidedAt:
+		m.ClearDecidedAt()
+		return nil
+	case rolerequests.FieldDecisionNote:
+		m.ClearDecisionNote()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleRequests nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleRequestsMutation) ResetField(name string) error {
+	switch name {
+	case rolerequests.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case rolerequests.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	case rolerequests.FieldReason:
+		m.ResetReason()
+		return nil
+	case rolerequests.FieldDurationHours:
+		m.ResetDurationHours()
+		return nil
+	case rolerequests.FieldBreakGlass:
+		m.ResetBreakGlass()
+		return nil
+	case rolerequests.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case rolerequests.FieldDecidedBy:
+		m.ResetDecidedBy()
+		return nil
+	case rolerequests.FieldDecidedAt:
+		m.ResetDecidedAt()
+		return nil
+	case rolerequests.FieldDecisionNote:
+		m.ResetDecisionNote()
+		return nil
+	case rolerequests.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case rolerequests.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RoleRequests field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleRequestsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleRequestsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleRequestsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleRequestsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleRequestsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleRequestsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleRequestsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RoleRequests unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleRequestsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RoleRequests edge %s", name)
+}
+
+// RolesMutation represents an operation that mutates the Roles nodes in the graph.
+type RolesMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int
+	code                        *string
+	name                        *string
+	description                 *string
+	is_system                   *bool
+	is_default                  *bool
+	max_users                   *int
+	addmax_users                *int
+	org_assignable              *bool
+	break_glass                 *roles.BreakGlass
+	break_glass_max_hours       *int
+	addbreak_glass_max_hours    *int
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	clearedFields               map[string]struct{}
+	user_roles                  map[uuid.UUID]struct{}
+	removeduser_roles           map[uuid.UUID]struct{}
+	cleareduser_roles           bool
+	role_permissions            map[int]struct{}
+	removedrole_permissions     map[int]struct{}
+	clearedrole_permissions     bool
+	parent_links                map[int]struct{}
+	removedparent_links         map[int]struct{}
+	clearedparent_links         bool
+	child_links                 map[int]struct{}
+	removedchild_links          map[int]struct{}
+	clearedchild_links          bool
+	approver_links              map[int]struct{}
+	removedapprover_links       map[int]struct{}
+	clearedapprover_links       bool
+	approves_links              map[int]struct{}
+	removedapproves_links       map[int]struct{}
+	clearedapproves_links       bool
+	sod_constraint_roles        map[int]struct{}
+	removedsod_constraint_roles map[int]struct{}
+	clearedsod_constraint_roles bool
+	group_roles                 map[int]struct{}
+	removedgroup_roles          map[int]struct{}
+	clearedgroup_roles          bool
+	org_roles                   map[int]struct{}
+	removedorg_roles            map[int]struct{}
+	clearedorg_roles            bool
+	done                        bool
+	oldValue                    func(context.Context) (*Roles, error)
+	predicates                  []predicate.Roles
+}
+
+var _ ent.Mutation = (*RolesMutation)(nil)
+
+// rolesOption allows management of the mutation configuration using functional options.
+type rolesOption func(*RolesMutation)
+
+// newRolesMutation creates new mutation for the Roles entity.
+func newRolesMutation(c config, op Op, opts ...rolesOption) *RolesMutation {
+	m := &RolesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoles,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRolesID sets the ID field of the mutation.
+func withRolesID(id int) rolesOption {
+	return func(m *RolesMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Roles
+		)
+		m.oldValue = func(ctx context.Context) (*Roles, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Roles.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoles sets the old Roles of the mutation.
+func withRoles(node *Roles) rolesOption {
+	return func(m *RolesMutation) {
+		m.oldValue = func(context.Context) (*Roles, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RolesMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RolesMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Roles entities.
+func (m *RolesMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RolesMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RolesMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Roles.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *RolesMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *RolesMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Roles entity.
+// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolesMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *RolesMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *RolesMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RolesMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Roles entity.
+// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolesMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RolesMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RolesMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RolesMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Roles entity.
+// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolesMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RolesMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[roles.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RolesMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[roles.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RolesMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, roles.FieldDescription)
+}
+
+// SetIsSystem sets the "is_system" field.
+func (m *RolesMutation) SetIsSystem(b bool) {
+	m.is_system = &b
+}
+
+// IsSystem returns the value of the "is_system" field in the mutation.
+func (m *RolesMutation) IsSystem() (r bool, exists bool) {
+	v := m.is_system
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSystem returns the old "is_system" field's value of the Roles entity.
+// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolesMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSystem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
+	}
+	return oldValue.IsSystem, nil
+}
+
+// ResetIsSystem resets all changes to the "is_system" field.
+func (m *RolesMutation) ResetIsSystem() {
+	m.is_system = nil
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *RolesMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *RolesMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the Roles entity.
+// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolesMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *RolesMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (m *RolesMutation) SetMaxUsers(i int) {
+	m.max_users = &i
+	m.addmax_users = nil
+}
+
+// MaxUsers returns the value of the "max_users" field in the mutation.
+func (m *RolesMutation) MaxUsers() (r int, exists bool) {
+	v := m.max_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUsers returns the old "max_users" field's value of the Roles entity.
+// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolesMutation) OldMaxUsers(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUsers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUsers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUsers: %w", err)
+	}
+	return oldValue.MaxUsers, nil
+}
+
+// AddMaxUsers adds i to the "max_users" field.
+func (m *RolesMutation) AddMaxUsers(i int) {
+	if m.addmax_users != nil {
+		*m.addmax_users += i
+	} else {
+		m.addmax_users = &i
+	}
+}
+
+// AddedMaxUsers returns the value that was added to the "max_users" field in this mutation.
+func (m *RolesMutation) AddedMaxUsers() (r int, exists bool) {
+	v := m.addmax_users
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (m *RolesMutation) ClearMaxUsers() {
+	m.max_users = nil
+	m.addmax_users = nil
+	m.clearedFields[roles.FieldMaxUsers] = struct{}{}
+}
+
+// MaxUsersCleared returns if the "max_users" field was cleared in this mutation.
+func (m *RolesMutation) MaxUsersCleared() bool {
+	_, ok := m.clearedFields[roles.FieldMaxUsers]
+	return ok
+}
+
+// ResetMaxUsers resets all changes to the "max_users" field.
+func (m *RolesMutation) ResetMaxUsers() {
+	m.max_users = nil
+	m.addmax_users = nil
+	delete(m.clearedFields, roles.FieldMaxUsers)
+}
+
+// SetOrgAssignable sets the "org_assignable" field.
+func (m *RolesMutation) SetOrgAssignable(b bool) {
+	m.org_assignable = &b
+}
+
+// OrgAssignable returns the value of the "org_assignable" field in the mutation.
+func (m *RolesMutation) OrgAssignable() (r bool, exists bool) {
+	v := m.org_assignable
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrgAssignable returns the old "org_assignable" field's value of the Roles entity.
+// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolesMutation) OldOrgAssignable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrgAssignable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrgAssignable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrgAssignable: %w", err)
+	}
+	return oldValue.OrgAssignable, nil
+}
+
+// ResetOrgAssignable resets all changes to the "org_assignable" field.
+func (m *RolesMutation) ResetOrgAssignable() {
+	m.org_assignable = nil
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (m *RolesMutation) SetBreakGlass(rg roles.BreakGlass) {
+	m.break_glass = &rg
+}
+
+// BreakGlass returns the value of the "break_glass" field in the mutation.
+func (m *RolesMutation) BreakGlass() (r roles.BreakGlass, exists bool) {
+	v := m.break_glass
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBreakGlass returns the old "break_glass" field's value of the Roles entity.
+// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolesMutation) OldBreakGlass(ctx context.Context) (v roles.BreakGlass, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBreakGlass is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBreakGlass requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBreakGlass: %w", err)
+	}
+	return oldValue.BreakGlass, nil
+}
+
+// ResetBreakGlass resets all changes to the "break_glass" field.
+func (m *RolesMutation) ResetBreakGlass() {
+	m.break_glass = nil
+}
+
+// SetBreakGlassMaxHours sets the "break_glass_max_hours" field.
+func (m *RolesMutation) SetBreakGlassMaxHours(i int) {
+	m.break_glass_max_hours = &i
+	m.addbreak_glass_max_hours = nil
+}
+
+// BreakGlassMaxHours returns the value of the "break_glass_max_hours" field in the mutation.
+func (m *RolesMutation) BreakGlassMaxHours() (r int, exists bool) {
+	v := m.break_glass_max_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBreakGlassMaxHours returns the old "break_glass_max_hours" field's value of the Roles entity.
+// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolesMutation) OldBreakGlassMaxHours(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBreakGlassMaxHours is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBreakGlassMaxHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBreakGlassMaxHours: %w", err)
+	}
+	return oldValue.BreakGlassMaxHours, nil
+}
+
+// AddBreakGlassMaxHours adds i to the "break_glass_max_hours" field.
+func (m *RolesMutation) AddBreakGlassMaxHours(i int) {
+	if m.addbreak_glass_max_hours != nil {
+		*m.addbreak_glass_max_hours += i
+	} else {
+		m.addbreak_glass_max_hours = &i
+	}
+}
+
+// AddedBreakGlassMaxHours returns the value that was added to the "break_glass_max_hours" field in this mutation.
+func (m *RolesMutation) AddedBreakGlassMaxHours() (r int, exists bool) {
+	v := m.addbreak_glass_max_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBreakGlassMaxHours clears the value of the "break_glass_max_hours" field.
+func (m *RolesMutation) ClearBreakGlassMaxHours() {
+	m.break_glass_max_hours = nil
+	m.addbreak_glass_max_hours = nil
+	m.clearedFields[roles.FieldBreakGlassMaxHours] = struct{}{}
+}
+
+// BreakGlassMaxHoursCleared returns if the "break_glass_max_hours" field was cleared in this mutation.
+func (m *RolesMutation) BreakGlassMaxHoursCleared() bool {
+	_, ok := m.clearedFields[roles.FieldBreakGlassMaxHours]
+	return ok
+}
+
+// ResetBreakGlassMaxHours resets all changes to the "break_glass_max_hours" field.
+func (m *RolesMutation) ResetBreakGlassMaxHours() {
+	m.break_glass_max_hours = nil
+	m.addbreak_glass_max_hours = nil
+	delete(m.clearedFields, roles.FieldBreakGlassMaxHours)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RolesMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RolesMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Roles entity.
+// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RolesMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RolesMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RolesMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Roles entity.
+// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolesMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RolesMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddUserRoleIDs adds the "user_roles" edge to the UserRoles entity by ids.
+func (m *RolesMutation) AddUserRoleIDs(ids ...uuid.UUID) {
+	if m.user_roles == nil {
+		m.user_roles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.user_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserRoles clears the "user_roles" edge to the UserRoles entity.
+func (m *RolesMutation) ClearUserRoles() {
+	m.cleareduser_roles = true
+}
+
+// UserRolesCleared reports if the "user_roles" edge to the UserRoles entity was cleared.
+func (m *RolesMutation) UserRolesCleared() bool {
+	return m.cleareduser_roles
+}
+
+// RemoveUserRoleIDs removes the "user_roles" edge to the UserRoles entity by IDs.
+func (m *RolesMutation) RemoveUserRoleIDs(ids ...uuid.UUID) {
+	if m.removeduser_roles == nil {
+		m.removeduser_roles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.user_roles, ids[i])
+		m.removeduser_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserRoles returns the removed IDs of the "user_roles" edge to the UserRoles entity.
+func (m *RolesMutation) RemovedUserRolesIDs() (ids []uuid.UUID) {
+	for id := range m.removeduser_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserRolesIDs returns the "user_roles" edge IDs in the mutation.
+func (m *RolesMutation) UserRolesIDs() (ids []uuid.UUID) {
+	for id := range m.user_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserRoles resets all changes to the "user_roles" edge.
+func (m *RolesMutation) ResetUserRoles() {
+	m.user_roles = nil
+	m.cleareduser_roles = false
+	m.removeduser_roles = nil
+}
+
+// AddRolePermissionIDs adds the "role_permissions" edge to the RolePermissions entity by ids.
+func (m *RolesMutation) AddRolePermissionIDs(ids ...int) {
+	if m.role_permissions == nil {
+		m.role_permissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.role_permissions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRolePermissions clears the "role_permissions" edge to the RolePermissions entity.
+func (m *RolesMutation) ClearRolePermissions() {
+	m.clearedrole_permissions = true
+}
+
+// RolePermissionsCleared reports if the "role_permissions" edge to the RolePermissions entity was cleared.
+func (m *RolesMutation) RolePermissionsCleared() bool {
+	return m.clearedrole_permissions
+}
+
+// RemoveRolePermissionIDs removes the "role_permissions" edge to the RolePermissions entity by IDs.
+func (m *RolesMutation) RemoveRolePermissionIDs(ids ...int) {
+	if m.removedrole_permissions == nil {
+		m.removedrole_permissions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.role_permissions, ids[i])
+		m.removedrole_permissions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRolePermissions returns the removed IDs of the "role_permissions" edge to the RolePermissions entity.
+func (m *RolesMutation) RemovedRolePermissionsIDs() (ids []int) {
+	for id := range m.removedrole_permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RolePermissionsIDs returns the "role_permissions" edge IDs in the mutation.
+func (m *RolesMutation) RolePermissionsIDs() (ids []int) {
+	for id := range m.role_permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRolePermissions resets all changes to the "role_permissions" edge.
+func (m *RolesMutation) ResetRolePermissions() {
+	m.role_permissions = nil
+	m.clearedrole_permissions = false
+	m.removedrole_permissions = nil
+}
+
+// AddParentLinkIDs adds the "parent_links" edge to the RoleParents entity by ids.
+func (m *RolesMutation) AddParentLinkIDs(ids ...int) {
+	if m.parent_links == nil {
+		m.parent_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.parent_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParentLinks clears the "parent_links" edge to the RoleParents entity.
+func (m *RolesMutation) ClearParentLinks() {
+	m.clearedparent_links = true
+}
+
+// ParentLinksCleared reports if the "parent_links" edge to the RoleParents entity was cleared.
+func (m *RolesMutation) ParentLinksCleared() bool {
+	return m.clearedparent_links
+}
+
+// RemoveParentLinkIDs removes the "parent_links" edge to the RoleParents entity by IDs.
+func (m *RolesMutation) RemoveParentLinkIDs(ids ...int) {
+	if m.removedparent_links == nil {
+		m.removedparent_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.parent_links, ids[i])
+		m.removedparent_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParentLinks returns the removed IDs of the "parent_links" edge to the RoleParents entity.
+func (m *RolesMutation) RemovedParentLinksIDs() (ids []int) {
+	for id := range m.removedparent_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParentLinksIDs returns the "parent_links" edge IDs in the mutation.
+func (m *RolesMutation) ParentLinksIDs() (ids []int) {
+	for id := range m.parent_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParentLinks resets all changes to the "parent_links" edge.
+func (m *RolesMutation) ResetParentLinks() {
+	m.parent_links = nil
+	m.clearedparent_links = false
+	m.removedparent_links = nil
+}
+
+// AddChildLinkIDs adds the "child_links" edge to the RoleParents entity by ids.
+func (m *RolesMutation) AddChildLinkIDs(ids ...int) {
+	if m.child_links == nil {
+		m.child_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.child_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildLinks clears the "child_links" edge to the RoleParents entity.
+func (m *RolesMutation) ClearChildLinks() {
+	m.clearedchild_links = true
+}
+
+// ChildLinksCleared reports if the "child_links" edge to the RoleParents entity was cleared.
+func (m *RolesMutation) ChildLinksCleared() bool {
+	return m.clearedchild_links
+}
+
+// RemoveChildLinkIDs removes the "child_links" edge to the RoleParents entity by IDs.
+func (m *RolesMutation) RemoveChildLinkIDs(ids ...int) {
+	if m.removedchild_links == nil {
+		m.removedchild_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.child_links, ids[i])
+		m.removedchild_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildLinks returns the removed IDs of the "child_links" edge to the RoleParents entity.
+func (m *RolesMutation) RemovedChildLinksIDs() (ids []int) {
+	for id := range m.removedchild_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildLinksIDs returns the "child_links" edge IDs in the mutation.
+func (m *RolesMutation) ChildLinksIDs() (ids []int) {
+	for id := range m.child_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildLinks resets all changes to the "child_links" edge.
+func (m *RolesMutation) ResetChildLinks() {
+	m.child_links = nil
+	m.clearedchild_links = false
+	m.removedchild_links = nil
+}
+
+// AddApproverLinkIDs adds the "approver_links" edge to the RoleApprovers entity by ids.
+func (m *RolesMutation) AddApproverLinkIDs(ids ...int) {
+	if m.approver_links == nil {
+		m.approver_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.approver_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApproverLinks clears the "approver_links" edge to the RoleApprovers entity.
+func (m *RolesMutation) ClearApproverLinks() {
+	m.clearedapprover_links = true
+}
+
+// ApproverLinksCleared reports if the "approver_links" edge to the RoleApprovers entity was cleared.
+func (m *RolesMutation) ApproverLinksCleared() bool {
+	return m.clearedapprover_links
+}
+
+// RemoveApproverLinkIDs removes the "approver_links" edge to the RoleApprovers entity by IDs.
+func (m *RolesMutation) RemoveApproverLinkIDs(ids ...int) {
+	if m.removedapprover_links == nil {
+		m.removedapprover_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.approver_links, ids[i])
+		m.removedapprover_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApproverLinks returns the removed IDs of the "approver_links" edge to the RoleApprovers entity.
+func (m *RolesMutation) RemovedApproverLinksIDs() (ids []int) {
+	for id := range m.removedapprover_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ApproverLinksIDs returns the "approver_links" edge IDs in the mutation.
+func (m *RolesMutation) ApproverLinksIDs() (ids []int) {
+	for id := range m.approver_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetApproverLinks resets all changes to the "approver_links" edge.
+func (m *RolesMutation) ResetApproverLinks() {
+	m.approver_links = nil
+	m.clearedapprover_links = false
+	m.removedapprover_links = nil
+}
+
+// AddApprovesLinkIDs adds the "approves_links" edge to the RoleApprovers entity by ids.
+func (m *RolesMutation) AddApprovesLinkIDs(ids ...int) {
+	if m.approves_links == nil {
+		m.approves_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.approves_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApprovesLinks clears the "approves_links" edge to the RoleApprovers entity.
+func (m *RolesMutation) ClearApprovesLinks() {
+	m.clearedapproves_links = true
 }
 
-// ResetResource resets all changes to the "resource" field.
-func (m *PermissionsMutation) ResetResource() {
-	m.resource = nil
-	delete(m.clearedFields, permissions.FieldResource)
+// ApprovesLinksCleared reports if the "approves_links" edge to the RoleApprovers entity was cleared.
+func (m *RolesMutation) ApprovesLinksCleared() bool {
+	return m.clearedapproves_links
 }
 
-// SetAction sets the "action" field.
-func (m *PermissionsMutation) SetAction(s string) {
-	m.action = &s
+// RemoveApprovesLinkIDs removes the "approves_links" edge to the RoleApprovers entity by IDs.
+func (m *RolesMutation) RemoveApprovesLinkIDs(ids ...int) {
+	if m.removedapproves_links == nil {
+		m.removedapproves_links = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.approves_links, ids[i])
+		m.removedapproves_links[ids[i]] = struct{}{}
+	}
 }
 
-// Action returns the value of the "action" field in the mutation.
-func (m *PermissionsMutation) Action() (r string, exists bool) {
-	v := m.action
-	if v == nil {
-		return
+// RemovedApprovesLinks returns the removed IDs of the "approves_links" edge to the RoleApprovers entity.
+func (m *RolesMutation) RemovedApprovesLinksIDs() (ids []int) {
+	for id := range m.removedapproves_links {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldAction returns the old "action" field's value of the Permissions entity.
-// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionsMutation) OldAction(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+// ApprovesLinksIDs returns the "approves_links" edge IDs in the mutation.
+func (m *RolesMutation) ApprovesLinksIDs() (ids []int) {
+	for id := range m.approves_links {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAction requires an ID field in the mutation")
+	return
+}
+
+// ResetApprovesLinks resets all changes to the "approves_links" edge.
+func (m *RolesMutation) ResetApprovesLinks() {
+	m.approves_links = nil
+	m.clearedapproves_links = false
+	m.removedapproves_links = nil
+}
+
+// AddSodConstraintRoleIDs adds the "sod_constraint_roles" edge to the SodConstraintRoles entity by ids.
+func (m *RolesMutation) AddSodConstraintRoleIDs(ids ...int) {
+	if m.sod_constraint_roles == nil {
+		m.sod_constraint_roles = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	for i := range ids {
+		m.sod_constraint_roles[ids[i]] = struct{}{}
 	}
-	return oldValue.Action, nil
 }
 
-// ClearAction clears the value of the "action" field.
-func (m *PermissionsMutation) ClearAction() {
-	m.action = nil
-	m.clearedFields[permissions.FieldAction] = struct{}{}
+// ClearSodConstraintRoles clears the "sod_constraint_roles" edge to the SodConstraintRoles entity.
+func (m *RolesMutation) ClearSodConstraintRoles() {
+	m.clearedsod_constraint_roles = true
 }
 
-// ActionCleared returns if the "action" field was cleared in this mutation.
-func (m *PermissionsMutation) ActionCleared() bool {
-	_, ok := m.clearedFields[permissions.FieldAction]
-	return ok
+// SodConstraintRolesCleared reports if the "sod_constraint_roles" edge to the SodConstraintRoles entity was cleared.
+func (m *RolesMutation) SodConstraintRolesCleared() bool {
+	return m.clearedsod_constraint_roles
 }
 
-// ResetAction resets all changes to the "action" field.
-func (m *PermissionsMutation) ResetAction() {
-	m.action = nil
-	delete(m.clearedFields, permissions.FieldAction)
+// RemoveSodConstraintRoleIDs removes the "sod_constraint_roles" edge to the SodConstraintRoles entity by IDs.
+func (m *RolesMutation) RemoveSodConstraintRoleIDs(ids ...int) {
+	if m.removedsod_constraint_roles == nil {
+		m.removedsod_constraint_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sod_constraint_roles, ids[i])
+		m.removedsod_constraint_roles[ids[i]] = struct{}{}
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PermissionsMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// RemovedSodConstraintRoles returns the removed IDs of the "sod_constraint_roles" edge to the SodConstraintRoles entity.
+func (m *RolesMutation) RemovedSodConstraintRolesIDs() (ids []int) {
+	for id := range m.removedsod_constraint_roles {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PermissionsMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// SodConstraintRolesIDs returns the "sod_constraint_roles" edge IDs in the mutation.
+func (m *RolesMutation) SodConstraintRolesIDs() (ids []int) {
+	for id := range m.sod_constraint_roles {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Permissions entity.
-// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// ResetSodConstraintRoles resets all changes to the "sod_constraint_roles" edge.
+func (m *RolesMutation) ResetSodConstraintRoles() {
+	m.sod_constraint_roles = nil
+	m.clearedsod_constraint_roles = false
+	m.removedsod_constraint_roles = nil
+}
+
+// AddGroupRoleIDs adds the "group_roles" edge to the GroupRoles entity by ids.
+func (m *RolesMutation) AddGroupRoleIDs(ids ...int) {
+	if m.group_roles == nil {
+		m.group_roles = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		m.group_roles[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PermissionsMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearGroupRoles clears the "group_roles" edge to the GroupRoles entity.
+func (m *RolesMutation) ClearGroupRoles() {
+	m.clearedgroup_roles = true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PermissionsMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// GroupRolesCleared reports if the "group_roles" edge to the GroupRoles entity was cleared.
+func (m *RolesMutation) GroupRolesCleared() bool {
+	return m.clearedgroup_roles
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PermissionsMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// RemoveGroupRoleIDs removes the "group_roles" edge to the GroupRoles entity by IDs.
+func (m *RolesMutation) RemoveGroupRoleIDs(ids ...int) {
+	if m.removedgroup_roles == nil {
+		m.removedgroup_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.group_roles, ids[i])
+		m.removedgroup_roles[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Permissions entity.
-// If the Permissions object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PermissionsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// RemovedGroupRoles returns the removed IDs of the "group_roles" edge to the GroupRoles entity.
+func (m *RolesMutation) RemovedGroupRolesIDs() (ids []int) {
+	for id := range m.removedgroup_roles {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return
+}
+
+// GroupRolesIDs returns the "group_roles" edge IDs in the mutation.
+func (m *RolesMutation) GroupRolesIDs() (ids []int) {
+	for id := range m.group_roles {
+		ids = append(ids, id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PermissionsMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetGroupRoles resets all changes to the "group_roles" edge.
+func (m *RolesMutation) ResetGroupRoles() {
+	m.group_roles = nil
+	m.clearedgroup_roles = false
+	m.removedgroup_roles = nil
 }
 
-// AddRolePermissionIDs adds the "role_permissions" edge to the RolePermissions entity by ids.
-func (m *PermissionsMutation) AddRolePermissionIDs(ids ...int) {
-	if m.role_permissions == nil {
-		m.role_permissions = make(map[int]struct{})
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by ids.
+func (m *RolesMutation) AddOrgRoleIDs(ids ...int) {
+	if m.org_roles == nil {
+		m.org_roles = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.role_permissions[ids[i]] = struct{}{}
+		m.org_roles[ids[i]] = struct{}{}
 	}
 }
 
-// ClearRolePermissions clears the "role_permissions" edge to the RolePermissions entity.
-func (m *PermissionsMutation) ClearRolePermissions() {
-	m.clearedrole_permissions = true
+// ClearOrgRoles clears the "org_roles" edge to the OrgRoles entity.
+func (m *RolesMutation) ClearOrgRoles() {
+	m.clearedorg_roles = true
 }
 
-// RolePermissionsCleared reports if the "role_permissions" edge to the RolePermissions entity was cleared.
-func (m *PermissionsMutation) RolePermissionsCleared() bool {
-	return m.clearedrole_permissions
+// OrgRolesCleared reports if the "org_roles" edge to the OrgRoles entity was cleared.
+func (m *RolesMutation) OrgRolesCleared() bool {
+	return m.clearedorg_roles
 }
 
-// RemoveRolePermissionIDs removes the "role_permissions" edge to the RolePermissions entity by IDs.
-func (m *PermissionsMutation) RemoveRolePermissionIDs(ids ...int) {
-	if m.removedrole_permissions == nil {
-		m.removedrole_permissions = make(map[int]struct{})
+// RemoveOrgRoleIDs removes the "org_roles" edge to the OrgRoles entity by IDs.
+func (m *RolesMutation) RemoveOrgRoleIDs(ids ...int) {
+	if m.removedorg_roles == nil {
+		m.removedorg_roles = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.role_permissions, ids[i])
-		m.removedrole_permissions[ids[i]] = struct{}{}
+		delete(m.org_roles, ids[i])
+		m.removedorg_roles[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedRolePermissions returns the removed IDs of the "role_permissions" edge to the RolePermissions entity.
-func (m *PermissionsMutation) RemovedRolePermissionsIDs() (ids []int) {
-	for id := range m.removedrole_permissions {
+// RemovedOrgRoles returns the removed IDs of the "org_roles" edge to the OrgRoles entity.
+func (m *RolesMutation) RemovedOrgRolesIDs() (ids []int) {
+	for id := range m.removedorg_roles {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// RolePermissionsIDs returns the "role_permissions" edge IDs in the mutation.
-func (m *PermissionsMutation) RolePermissionsIDs() (ids []int) {
-	for id := range m.role_permissions {
+// OrgRolesIDs returns the "org_roles" edge IDs in the mutation.
+func (m *RolesMutation) OrgRolesIDs() (ids []int) {
+	for id := range m.org_roles {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetRolePermissions resets all changes to the "role_permissions" edge.
-func (m *PermissionsMutation) ResetRolePermissions() {
-	m.role_permissions = nil
-	m.clearedrole_permissions = false
-	m.removedrole_permissions = nil
+// ResetOrgRoles resets all changes to the "org_roles" edge.
+func (m *RolesMutation) ResetOrgRoles() {
+	m.org_roles = nil
+	m.clearedorg_roles = false
+	m.removedorg_roles = nil
 }
 
-// Where appends a list predicates to the PermissionsMutation builder.
-func (m *PermissionsMutation) Where(ps ...predicate.Permissions) {
+// Where appends a list predicates to the RolesMutation builder.
+func (m *RolesMutation) Where(ps ...predicate.Roles) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PermissionsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RolesMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PermissionsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Permissions, len(ps))
+func (m *RolesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Roles, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3903,45 +18863,57 @@ func (m *PermissionsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PermissionsMutation) Op() Op {
+func (m *RolesMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PermissionsMutation) SetOp(op Op) {
+func (m *RolesMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Permissions).
-func (m *PermissionsMutation) Type() string {
+// Type returns the node type of this mutation (Roles).
+func (m *RolesMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PermissionsMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *RolesMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.code != nil {
-		fields = append(fields, permissions.FieldCode)
+		fields = append(fields, roles.FieldCode)
 	}
 	if m.name != nil {
-		fields = append(fields, permissions.FieldName)
+		fields = append(fields, roles.FieldName)
 	}
 	if m.description != nil {
-		fields = append(fields, permissions.FieldDescription)
+		fields = append(fields, roles.FieldDescription)
 	}
-	if m.resource != nil {
-		fields = append(fields, permissions.FieldResource)
+	if m.is_system != nil {
+		fields = append(fields, roles.FieldIsSystem)
 	}
-	if m.action != nil {
-		fields = append(fields, permissions.FieldAction)
+	if m.is_default != nil {
+		fields = append(fields, roles.FieldIsDefault)
+	}
+	if m.max_users != nil {
+		fields = append(fields, roles.FieldMaxUsers)
+	}
+	if m.org_assignable != nil {
+		fields = append(fields, roles.FieldOrgAssignable)
+	}
+	if m.break_glass != nil {
+		fields = append(fields, roles.FieldBreakGlass)
+	}
+	if m.break_glass_max_hours != nil {
+		fields = append(fields, roles.FieldBreakGlassMaxHours)
 	}
 	if m.created_at != nil {
-		fields = append(fields, permissions.FieldCreatedAt)
+		fields = append(fields, roles.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, permissions.FieldUpdatedAt)
+		fields = append(fields, roles.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3949,21 +18921,29 @@ func (m *PermissionsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PermissionsMutation) Field(name string) (ent.Value, bool) {
+func (m *RolesMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case permissions.FieldCode:
+	case roles.FieldCode:
 		return m.Code()
-	case permissions.FieldName:
+	case roles.FieldName:
 		return m.Name()
-	case permissions.FieldDescription:
+	case roles.FieldDescription:
 		return m.Description()
-	case permissions.FieldResource:
-		return m.Resource()
-	case permissions.FieldAction:
-		return m.Action()
-	case permissions.FieldCreatedAt:
+	case roles.FieldIsSystem:
+		return m.IsSystem()
+	case roles.FieldIsDefault:
+		return m.IsDefault()
+	case roles.FieldMaxUsers:
+		return m.MaxUsers()
+	case roles.FieldOrgAssignable:
+		return m.OrgAssignable()
+	case roles.FieldBreakGlass:
+		return m.BreakGlass()
+	case roles.FieldBreakGlassMaxHours:
+		return m.BreakGlassMaxHours()
+	case roles.FieldCreatedAt:
 		return m.CreatedAt()
-	case permissions.FieldUpdatedAt:
+	case roles.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -3972,74 +18952,110 @@ func (m *PermissionsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PermissionsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RolesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case permissions.FieldCode:
+	case roles.FieldCode:
 		return m.OldCode(ctx)
-	case permissions.FieldName:
+	case roles.FieldName:
 		return m.OldName(ctx)
-	case permissions.FieldDescription:
+	case roles.FieldDescription:
 		return m.OldDescription(ctx)
-	case permissions.FieldResource:
-		return m.OldResource(ctx)
-	case permissions.FieldAction:
-		return m.OldAction(ctx)
-	case permissions.FieldCreatedAt:
+	case roles.FieldIsSystem:
+		return m.OldIsSystem(ctx)
+	case roles.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	case roles.FieldMaxUsers:
+		return m.OldMaxUsers(ctx)
+	case roles.FieldOrgAssignable:
+		return m.OldOrgAssignable(ctx)
+	case roles.FieldBreakGlass:
+		return m.OldBreakGlass(ctx)
+	case roles.FieldBreakGlassMaxHours:
+		return m.OldBreakGlassMaxHours(ctx)
+	case roles.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case permissions.FieldUpdatedAt:
+	case roles.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Permissions field %s", name)
+	return nil, fmt.Errorf("unknown Roles field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PermissionsMutation) SetField(name string, value ent.Value) error {
+func (m *RolesMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case permissions.FieldCode:
+	case roles.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case roles.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case roles.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCode(v)
+		m.SetDescription(v)
 		return nil
-	case permissions.FieldName:
-		v, ok := value.(string)
+	case roles.FieldIsSystem:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetIsSystem(v)
 		return nil
-	case permissions.FieldDescription:
-		v, ok := value.(string)
+	case roles.FieldIsDefault:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetIsDefault(v)
 		return nil
-	case permissions.FieldResource:
-		v, ok := value.(string)
+	case roles.FieldMaxUsers:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResource(v)
+		m.SetMaxUsers(v)
 		return nil
-	case permissions.FieldAction:
-		v, ok := value.(string)
+	case roles.FieldOrgAssignable:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAction(v)
+		m.SetOrgAssignable(v)
 		return nil
-	case permissions.FieldCreatedAt:
+	case roles.FieldBreakGlass:
+		v, ok := value.(roles.BreakGlass)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBreakGlass(v)
+		return nil
+	case roles.FieldBreakGlassMaxHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBreakGlassMaxHours(v)
+		return nil
+	case roles.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case permissions.FieldUpdatedAt:
+	case roles.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4047,212 +19063,458 @@ func (m *PermissionsMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Permissions field %s", name)
+	return fmt.Errorf("unknown Roles field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PermissionsMutation) AddedFields() []string {
-	return nil
+func (m *RolesMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_users != nil {
+		fields = append(fields, roles.FieldMaxUsers)
+	}
+	if m.addbreak_glass_max_hours != nil {
+		fields = append(fields, roles.FieldBreakGlassMaxHours)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PermissionsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RolesMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case roles.FieldMaxUsers:
+		return m.AddedMaxUsers()
+	case roles.FieldBreakGlassMaxHours:
+		return m.AddedBreakGlassMaxHours()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PermissionsMutation) AddField(name string, value ent.Value) error {
+func (m *RolesMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case roles.FieldMaxUsers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUsers(v)
+		return nil
+	case roles.FieldBreakGlassMaxHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBreakGlassMaxHours(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Permissions numeric field %s", name)
+	return fmt.Errorf("unknown Roles numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PermissionsMutation) ClearedFields() []string {
+func (m *RolesMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(permissions.FieldDescription) {
-		fields = append(fields, permissions.FieldDescription)
+	if m.FieldCleared(roles.FieldDescription) {
+		fields = append(fields, roles.FieldDescription)
 	}
-	if m.FieldCleared(permissions.FieldResource) {
-		fields = append(fields, permissions.FieldResource)
+	if m.FieldCleared(roles.FieldMaxUsers) {
+		fields = append(fields, roles.FieldMaxUsers)
 	}
-	if m.FieldCleared(permissions.FieldAction) {
-		fields = append(fields, permissions.FieldAction)
+	if m.FieldCleared(roles.FieldBreakGlassMaxHours) {
+		fields = append(fields, roles.FieldBreakGlassMaxHours)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PermissionsMutation) FieldCleared(name string) bool {
+func (m *RolesMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PermissionsMutation) ClearField(name string) error {
+func (m *RolesMutation) ClearField(name string) error {
 	switch name {
-	case permissions.FieldDescription:
+	case roles.FieldDescription:
 		m.ClearDescription()
 		return nil
-	case permissions.FieldResource:
-		m.ClearResource()
+	case roles.FieldMaxUsers:
+		m.ClearMaxUsers()
 		return nil
-	case permissions.FieldAction:
-		m.ClearAction()
+	case roles.FieldBreakGlassMaxHours:
+		m.ClearBreakGlassMaxHours()
 		return nil
 	}
-	return fmt.Errorf("unknown Permissions nullable field %s", name)
+	return fmt.Errorf("unknown Roles nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PermissionsMutation) ResetField(name string) error {
+func (m *RolesMutation) ResetField(name string) error {
 	switch name {
-	case permissions.FieldCode:
+	case roles.FieldCode:
 		m.ResetCode()
 		return nil
-	case permissions.FieldName:
+	case roles.FieldName:
 		m.ResetName()
 		return nil
-	case permissions.FieldDescription:
+	case roles.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case permissions.FieldResource:
-		m.ResetResource()
+	case roles.FieldIsSystem:
+		m.ResetIsSystem()
 		return nil
-	case permissions.FieldAction:
-		m.ResetAction()
+	case roles.FieldIsDefault:
+		m.ResetIsDefault()
 		return nil
-	case permissions.FieldCreatedAt:
+	case roles.FieldMaxUsers:
+		m.ResetMaxUsers()
+		return nil
+	case roles.FieldOrgAssignable:
+		m.ResetOrgAssignable()
+		return nil
+	case roles.FieldBreakGlass:
+		m.ResetBreakGlass()
+		return nil
+	case roles.FieldBreakGlassMaxHours:
+		m.ResetBreakGlassMaxHours()
+		return nil
+	case roles.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case permissions.FieldUpdatedAt:
+	case roles.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Permissions field %s", name)
+	return fmt.Errorf("unknown Roles field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PermissionsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *RolesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.user_roles != nil {
+		edges = append(edges, roles.EdgeUserRoles)
+	}
 	if m.role_permissions != nil {
-		edges = append(edges, permissions.EdgeRolePermissions)
+		edges = append(edges, roles.EdgeRolePermissions)
+	}
+	if m.parent_links != nil {
+		edges = append(edges, roles.EdgeParentLinks)
+	}
+	if m.child_links != nil {
+		edges = append(edges, roles.EdgeChildLinks)
+	}
+	if m.approver_links != nil {
+		edges = append(edges, roles.EdgeApproverLinks)
+	}
+	if m.approves_links != nil {
+		edges = append(edges, roles.EdgeApprovesLinks)
+	}
+	if m.sod_constraint_roles != nil {
+		edges = append(edges, roles.EdgeSodConstraintRoles)
+	}
+	if m.group_roles != nil {
+		edges = append(edges, roles.EdgeGroupRoles)
+	}
+	if m.org_roles != nil {
+		edges = append(edges, roles.EdgeOrgRoles)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PermissionsMutation) AddedIDs(name string) []ent.Value {
+func (m *RolesMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case permissions.EdgeRolePermissions:
+	case roles.EdgeUserRoles:
+		ids := make([]ent.Value, 0, len(m.user_roles))
+		for id := range m.user_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeRolePermissions:
 		ids := make([]ent.Value, 0, len(m.role_permissions))
 		for id := range m.role_permissions {
 			ids = append(ids, id)
 		}
 		return ids
+	case roles.EdgeParentLinks:
+		ids := make([]ent.Value, 0, len(m.parent_links))
+		for id := range m.parent_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeChildLinks:
+		ids := make([]ent.Value, 0, len(m.child_links))
+		for id := range m.child_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeApproverLinks:
+		ids := make([]ent.Value, 0, len(m.approver_links))
+		for id := range m.approver_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeApprovesLinks:
+		ids := make([]ent.Value, 0, len(m.approves_links))
+		for id := range m.approves_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeSodConstraintRoles:
+		ids := make([]ent.Value, 0, len(m.sod_constraint_roles))
+		for id := range m.sod_constraint_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeGroupRoles:
+		ids := make([]ent.Value, 0, len(m.group_roles))
+		for id := range m.group_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeOrgRoles:
+		ids := make([]ent.Value, 0, len(m.org_roles))
+		for id := range m.org_roles {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PermissionsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *RolesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removeduser_roles != nil {
+		edges = append(edges, roles.EdgeUserRoles)
+	}
 	if m.removedrole_permissions != nil {
-		edges = append(edges, permissions.EdgeRolePermissions)
+		edges = append(edges, roles.EdgeRolePermissions)
+	}
+	if m.removedparent_links != nil {
+		edges = append(edges, roles.EdgeParentLinks)
+	}
+	if m.removedchild_links != nil {
+		edges = append(edges, roles.EdgeChildLinks)
+	}
+	if m.removedapprover_links != nil {
+		edges = append(edges, roles.EdgeApproverLinks)
+	}
+	if m.removedapproves_links != nil {
+		edges = append(edges, roles.EdgeApprovesLinks)
+	}
+	if m.removedsod_constraint_roles != nil {
+		edges = append(edges, roles.EdgeSodConstraintRoles)
+	}
+	if m.removedgroup_roles != nil {
+		edges = append(edges, roles.EdgeGroupRoles)
+	}
+	if m.removedorg_roles != nil {
+		edges = append(edges, roles.EdgeOrgRoles)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PermissionsMutation) RemovedIDs(name string) []ent.Value {
+func (m *RolesMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case permissions.EdgeRolePermissions:
+	case roles.EdgeUserRoles:
+		ids := make([]ent.Value, 0, len(m.removeduser_roles))
+		for id := range m.removeduser_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeRolePermissions:
 		ids := make([]ent.Value, 0, len(m.removedrole_permissions))
 		for id := range m.removedrole_permissions {
 			ids = append(ids, id)
 		}
 		return ids
+	case roles.EdgeParentLinks:
+		ids := make([]ent.Value, 0, len(m.removedparent_links))
+		for id := range m.removedparent_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeChildLinks:
+		ids := make([]ent.Value, 0, len(m.removedchild_links))
+		for id := range m.removedchild_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeApproverLinks:
+		ids := make([]ent.Value, 0, len(m.removedapprover_links))
+		for id := range m.removedapprover_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeApprovesLinks:
+		ids := make([]ent.Value, 0, len(m.removedapproves_links))
+		for id := range m.removedapproves_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeSodConstraintRoles:
+		ids := make([]ent.Value, 0, len(m.removedsod_constraint_roles))
+		for id := range m.removedsod_constraint_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeGroupRoles:
+		ids := make([]ent.Value, 0, len(m.removedgroup_roles))
+		for id := range m.removedgroup_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case roles.EdgeOrgRoles:
+		ids := make([]ent.Value, 0, len(m.removedorg_roles))
+		for id := range m.removedorg_roles {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PermissionsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *RolesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.cleareduser_roles {
+		edges = append(edges, roles.EdgeUserRoles)
+	}
 	if m.clearedrole_permissions {
-		edges = append(edges, permissions.EdgeRolePermissions)
+		edges = append(edges, roles.EdgeRolePermissions)
+	}
+	if m.clearedparent_links {
+		edges = append(edges, roles.EdgeParentLinks)
+	}
+	if m.clearedchild_links {
+		edges = append(edges, roles.EdgeChildLinks)
+	}
+	if m.clearedapprover_links {
+		edges = append(edges, roles.EdgeApproverLinks)
+	}
+	if m.clearedapproves_links {
+		edges = append(edges, roles.EdgeApprovesLinks)
+	}
+	if m.clearedsod_constraint_roles {
+		edges = append(edges, roles.EdgeSodConstraintRoles)
+	}
+	if m.clearedgroup_roles {
+		edges = append(edges, roles.EdgeGroupRoles)
+	}
+	if m.clearedorg_roles {
+		edges = append(edges, roles.EdgeOrgRoles)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PermissionsMutation) EdgeCleared(name string) bool {
+func (m *RolesMutation) EdgeCleared(name string) bool {
 	switch name {
-	case permissions.EdgeRolePermissions:
+	case roles.EdgeUserRoles:
+		return m.cleareduser_roles
+	case roles.EdgeRolePermissions:
 		return m.clearedrole_permissions
+	case roles.EdgeParentLinks:
+		return m.clearedparent_links
+	case roles.EdgeChildLinks:
+		return m.clearedchild_links
+	case roles.EdgeApproverLinks:
+		return m.clearedapprover_links
+	case roles.EdgeApprovesLinks:
+		return m.clearedapproves_links
+	case roles.EdgeSodConstraintRoles:
+		return m.clearedsod_constraint_roles
+	case roles.EdgeGroupRoles:
+		return m.clearedgroup_roles
+	case roles.EdgeOrgRoles:
+		return m.clearedorg_roles
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PermissionsMutation) ClearEdge(name string) error {
+func (m *RolesMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Permissions unique edge %s", name)
+	return fmt.Errorf("unknown Roles unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PermissionsMutation) ResetEdge(name string) error {
+func (m *RolesMutation) ResetEdge(name string) error {
 	switch name {
-	case permissions.EdgeRolePermissions:
+	case roles.EdgeUserRoles:
+		m.ResetUserRoles()
+		return nil
+	case roles.EdgeRolePermissions:
 		m.ResetRolePermissions()
 		return nil
+	case roles.EdgeParentLinks:
+		m.ResetParentLinks()
+		return nil
+	case roles.EdgeChildLinks:
+		m.ResetChildLinks()
+		return nil
+	case roles.EdgeApproverLinks:
+		m.ResetApproverLinks()
+		return nil
+	case roles.EdgeApprovesLinks:
+		m.ResetApprovesLinks()
+		return nil
+	case roles.EdgeSodConstraintRoles:
+		m.ResetSodConstraintRoles()
+		return nil
+	case roles.EdgeGroupRoles:
+		m.ResetGroupRoles()
+		return nil
+	case roles.EdgeOrgRoles:
+		m.ResetOrgRoles()
+		return nil
 	}
-	return fmt.Errorf("unknown Permissions edge %s", name)
+	return fmt.Errorf("unknown Roles edge %s", name)
 }
 
-// RolePermissionsMutation represents an operation that mutates the RolePermissions nodes in the graph.
-type RolePermissionsMutation struct {
+// SodConstraintRolesMutation represents an operation that mutates the SodConstraintRoles nodes in the graph.
+type SodConstraintRolesMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	assigned_at       *time.Time
-	clearedFields     map[string]struct{}
-	role              *int
-	clearedrole       bool
-	permission        *int
-	clearedpermission bool
-	done              bool
-	oldValue          func(context.Context) (*RolePermissions, error)
-	predicates        []predicate.RolePermissions
-}
-
-var _ ent.Mutation = (*RolePermissionsMutation)(nil)
-
-// rolepermissionsOption allows management of the mutation configuration using functional options.
-type rolepermissionsOption func(*RolePermissionsMutation)
-
-// newRolePermissionsMutation creates new mutation for the RolePermissions entity.
-func newRolePermissionsMutation(c config, op Op, opts ...rolepermissionsOption) *RolePermissionsMutation {
-	m := &RolePermissionsMutation{
+	op                    Op
+	typ                   string
+	id                    *int
+	clearedFields         map[string]struct{}
+	sod_constraint        *int
+	clearedsod_constraint bool
+	role                  *int
+	clearedrole           bool
+	done                  bool
+	oldValue              func(context.Context) (*SodConstraintRoles, error)
+	predicates            []predicate.SodConstraintRoles
+}
+
+var _ ent.Mutation = (*SodConstraintRolesMutation)(nil)
+
+// sodconstraintrolesOption allows management of the mutation configuration using functional options.
+type sodconstraintrolesOption func(*SodConstraintRolesMutation)
+
+// newSodConstraintRolesMutation creates new mutation for the SodConstraintRoles entity.
+func newSodConstraintRolesMutation(c config, op Op, opts ...sodconstraintrolesOption) *SodConstraintRolesMutation {
+	m := &SodConstraintRolesMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRolePermissions,
+		typ:           TypeSodConstraintRoles,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4261,20 +19523,20 @@ func newRolePermissionsMutation(c config, op Op, opts ...rolepermissionsOption)
 	return m
 }
 
-// withRolePermissionsID sets the ID field of the mutation.
-func withRolePermissionsID(id int) rolepermissionsOption {
-	return func(m *RolePermissionsMutation) {
+// withSodConstraintRolesID sets the ID field of the mutation.
+func withSodConstraintRolesID(id int) sodconstraintrolesOption {
+	return func(m *SodConstraintRolesMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RolePermissions
+			value *SodConstraintRoles
 		)
-		m.oldValue = func(ctx context.Context) (*RolePermissions, error) {
+		m.oldValue = func(ctx context.Context) (*SodConstraintRoles, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RolePermissions.Get(ctx, id)
+					value, err = m.Client().SodConstraintRoles.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4283,10 +19545,10 @@ func withRolePermissionsID(id int) rolepermissionsOption {
 	}
 }
 
-// withRolePermissions sets the old RolePermissions of the mutation.
-func withRolePermissions(node *RolePermissions) rolepermissionsOption {
-	return func(m *RolePermissionsMutation) {
-		m.oldValue = func(context.Context) (*RolePermissions, error) {
+// withSodConstraintRoles sets the old SodConstraintRoles of the mutation.
+func withSodConstraintRoles(node *SodConstraintRoles) sodconstraintrolesOption {
+	return func(m *SodConstraintRolesMutation) {
+		m.oldValue = func(context.Context) (*SodConstraintRoles, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4295,7 +19557,7 @@ func withRolePermissions(node *RolePermissions) rolepermissionsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RolePermissionsMutation) Client() *Client {
+func (m SodConstraintRolesMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4303,7 +19565,7 @@ func (m RolePermissionsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RolePermissionsMutation) Tx() (*Tx, error) {
+func (m SodConstraintRolesMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4313,14 +19575,14 @@ func (m RolePermissionsMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RolePermissions entities.
-func (m *RolePermissionsMutation) SetID(id int) {
+// operation is only accepted on creation of SodConstraintRoles entities.
+func (m *SodConstraintRolesMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RolePermissionsMutation) ID() (id int, exists bool) {
+func (m *SodConstraintRolesMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4331,7 +19593,7 @@ func (m *RolePermissionsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RolePermissionsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SodConstraintRolesMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4340,135 +19602,139 @@ func (m *RolePermissionsMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RolePermissions.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SodConstraintRoles.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRoleID sets the "role_id" field.
-func (m *RolePermissionsMutation) SetRoleID(i int) {
-	m.role = &i
+// SetConstraintID sets the "constraint_id" field.
+func (m *SodConstraintRolesMutation) SetConstraintID(i int) {
+	m.sod_constraint = &i
 }
 
-// RoleID returns the value of the "role_id" field in the mutation.
-func (m *RolePermissionsMutation) RoleID() (r int, exists bool) {
-	v := m.role
+// ConstraintID returns the value of the "constraint_id" field in the mutation.
+func (m *SodConstraintRolesMutation) ConstraintID() (r int, exists bool) {
+	v := m.sod_constraint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRoleID returns the old "role_id" field's value of the RolePermissions entity.
-// If the RolePermissions object wasn't provided to the builder, the object is fetched from the database.
+// OldConstraintID returns the old "constraint_id" field's value of the SodConstraintRoles entity.
+// If the SodConstraintRoles object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolePermissionsMutation) OldRoleID(ctx context.Context) (v int, err error) {
+func (m *SodConstraintRolesMutation) OldConstraintID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+		return v, errors.New("OldConstraintID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRoleID requires an ID field in the mutation")
+		return v, errors.New("OldConstraintID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+		return v, fmt.Errorf("querying old value for OldConstraintID: %w", err)
 	}
-	return oldValue.RoleID, nil
+	return oldValue.ConstraintID, nil
 }
 
-// ResetRoleID resets all changes to the "role_id" field.
-func (m *RolePermissionsMutation) ResetRoleID() {
-	m.role = nil
+// ResetConstraintID resets all changes to the "constraint_id" field.
+func (m *SodConstraintRolesMutation) ResetConstraintID() {
+	m.sod_constraint = nil
 }
 
-// SetPermissionID sets the "permission_id" field.
-func (m *RolePermissionsMutation) SetPermissionID(i int) {
-	m.permission = &i
+// SetRoleID sets the "role_id" field.
+func (m *SodConstraintRolesMutation) SetRoleID(i int) {
+	m.role = &i
 }
 
-// PermissionID returns the value of the "permission_id" field in the mutation.
-func (m *RolePermissionsMutation) PermissionID() (r int, exists bool) {
-	v := m.permission
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *SodConstraintRolesMutation) RoleID() (r int, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPermissionID returns the old "permission_id" field's value of the RolePermissions entity.
-// If the RolePermissions object wasn't provided to the builder, the object is fetched from the database.
+// OldRoleID returns the old "role_id" field's value of the SodConstraintRoles entity.
+// If the SodConstraintRoles object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolePermissionsMutation) OldPermissionID(ctx context.Context) (v int, err error) {
+func (m *SodConstraintRolesMutation) OldRoleID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPermissionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPermissionID requires an ID field in the mutation")
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPermissionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
 	}
-	return oldValue.PermissionID, nil
+	return oldValue.RoleID, nil
 }
 
-// ResetPermissionID resets all changes to the "permission_id" field.
-func (m *RolePermissionsMutation) ResetPermissionID() {
-	m.permission = nil
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *SodConstraintRolesMutation) ResetRoleID() {
+	m.role = nil
 }
 
-// SetAssignedAt sets the "assigned_at" field.
-func (m *RolePermissionsMutation) SetAssignedAt(t time.Time) {
-	m.assigned_at = &t
+// SetSodConstraintID sets the "sod_constraint" edge to the SodConstraints entity by id.
+func (m *SodConstraintRolesMutation) SetSodConstraintID(id int) {
+	m.sod_constraint = &id
 }
 
-// AssignedAt returns the value of the "assigned_at" field in the mutation.
-func (m *RolePermissionsMutation) AssignedAt() (r time.Time, exists bool) {
-	v := m.assigned_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearSodConstraint clears the "sod_constraint" edge to the SodConstraints entity.
+func (m *SodConstraintRolesMutation) ClearSodConstraint() {
+	m.clearedsod_constraint = true
+	m.clearedFields[sodconstraintroles.FieldConstraintID] = struct{}{}
 }
 
-// OldAssignedAt returns the old "assigned_at" field's value of the RolePermissions entity.
-// If the RolePermissions object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolePermissionsMutation) OldAssignedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+// SodConstraintCleared reports if the "sod_constraint" edge to the SodConstraints entity was cleared.
+func (m *SodConstraintRolesMutation) SodConstraintCleared() bool {
+	return m.clearedsod_constraint
+}
+
+// SodConstraintID returns the "sod_constraint" edge ID in the mutation.
+func (m *SodConstraintRolesMutation) SodConstraintID() (id int, exists bool) {
+	if m.sod_constraint != nil {
+		return *m.sod_constraint, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+	return
+}
+
+// SodConstraintIDs returns the "sod_constraint" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SodConstraintID instead. It exists only for internal usage by the builders.
+func (m *SodConstraintRolesMutation) SodConstraintIDs() (ids []int) {
+	if id := m.sod_constraint; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.AssignedAt, nil
+	return
 }
 
-// ResetAssignedAt resets all changes to the "assigned_at" field.
-func (m *RolePermissionsMutation) ResetAssignedAt() {
-	m.assigned_at = nil
+// ResetSodConstraint resets all changes to the "sod_constraint" edge.
+func (m *SodConstraintRolesMutation) ResetSodConstraint() {
+	m.sod_constraint = nil
+	m.clearedsod_constraint = false
 }
 
 // ClearRole clears the "role" edge to the Roles entity.
-func (m *RolePermissionsMutation) ClearRole() {
+func (m *SodConstraintRolesMutation) ClearRole() {
 	m.clearedrole = true
-	m.clearedFields[rolepermissions.FieldRoleID] = struct{}{}
+	m.clearedFields[sodconstraintroles.FieldRoleID] = struct{}{}
 }
 
 // RoleCleared reports if the "role" edge to the Roles entity was cleared.
-func (m *RolePermissionsMutation) RoleCleared() bool {
+func (m *SodConstraintRolesMutation) RoleCleared() bool {
 	return m.clearedrole
 }
 
 // RoleIDs returns the "role" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // RoleID instead. It exists only for internal usage by the builders.
-func (m *RolePermissionsMutation) RoleIDs() (ids []int) {
+func (m *SodConstraintRolesMutation) RoleIDs() (ids []int) {
 	if id := m.role; id != nil {
 		ids = append(ids, *id)
 	}
@@ -4476,47 +19742,20 @@ func (m *RolePermissionsMutation) RoleIDs() (ids []int) {
 }
 
 // ResetRole resets all changes to the "role" edge.
-func (m *RolePermissionsMutation) ResetRole() {
+func (m *SodConstraintRolesMutation) ResetRole() {
 	m.role = nil
 	m.clearedrole = false
 }
 
-// ClearPermission clears the "permission" edge to the Permissions entity.
-func (m *RolePermissionsMutation) ClearPermission() {
-	m.clearedpermission = true
-	m.clearedFields[rolepermissions.FieldPermissionID] = struct{}{}
-}
-
-// PermissionCleared reports if the "permission" edge to the Permissions entity was cleared.
-func (m *RolePermissionsMutation) PermissionCleared() bool {
-	return m.clearedpermission
-}
-
-// PermissionIDs returns the "permission" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PermissionID instead. It exists only for internal usage by the builders.
-func (m *RolePermissionsMutation) PermissionIDs() (ids []int) {
-	if id := m.permission; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetPermission resets all changes to the "permission" edge.
-func (m *RolePermissionsMutation) ResetPermission() {
-	m.permission = nil
-	m.clearedpermission = false
-}
-
-// Where appends a list predicates to the RolePermissionsMutation builder.
-func (m *RolePermissionsMutation) Where(ps ...predicate.RolePermissions) {
+// Where appends a list predicates to the SodConstraintRolesMutation builder.
+func (m *SodConstraintRolesMutation) Where(ps ...predicate.SodConstraintRoles) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RolePermissionsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SodConstraintRolesMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RolePermissionsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RolePermissions, len(ps))
+func (m *SodConstraintRolesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SodConstraintRoles, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4524,33 +19763,30 @@ func (m *RolePermissionsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RolePermissionsMutation) Op() Op {
+func (m *SodConstraintRolesMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RolePermissionsMutation) SetOp(op Op) {
+func (m *SodConstraintRolesMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RolePermissions).
-func (m *RolePermissionsMutation) Type() string {
+// Type returns the node type of this mutation (SodConstraintRoles).
+func (m *SodConstraintRolesMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RolePermissionsMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.role != nil {
-		fields = append(fields, rolepermissions.FieldRoleID)
-	}
-	if m.permission != nil {
-		fields = append(fields, rolepermissions.FieldPermissionID)
+func (m *SodConstraintRolesMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.sod_constraint != nil {
+		fields = append(fields, sodconstraintroles.FieldConstraintID)
 	}
-	if m.assigned_at != nil {
-		fields = append(fields, rolepermissions.FieldAssignedAt)
+	if m.role != nil {
+		fields = append(fields, sodconstraintroles.FieldRoleID)
 	}
 	return fields
 }
@@ -4558,14 +19794,12 @@ func (m *RolePermissionsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RolePermissionsMutation) Field(name string) (ent.Value, bool) {
+func (m *SodConstraintRolesMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case rolepermissions.FieldRoleID:
+	case sodconstraintroles.FieldConstraintID:
+		return m.ConstraintID()
+	case sodconstraintroles.FieldRoleID:
 		return m.RoleID()
-	case rolepermissions.FieldPermissionID:
-		return m.PermissionID()
-	case rolepermissions.FieldAssignedAt:
-		return m.AssignedAt()
 	}
 	return nil, false
 }
@@ -4573,51 +19807,42 @@ func (m *RolePermissionsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RolePermissionsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SodConstraintRolesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case rolepermissions.FieldRoleID:
+	case sodconstraintroles.FieldConstraintID:
+		return m.OldConstraintID(ctx)
+	case sodconstraintroles.FieldRoleID:
 		return m.OldRoleID(ctx)
-	case rolepermissions.FieldPermissionID:
-		return m.OldPermissionID(ctx)
-	case rolepermissions.FieldAssignedAt:
-		return m.OldAssignedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RolePermissions field %s", name)
+	return nil, fmt.Errorf("unknown SodConstraintRoles field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RolePermissionsMutation) SetField(name string, value ent.Value) error {
+func (m *SodConstraintRolesMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case rolepermissions.FieldRoleID:
+	case sodconstraintroles.FieldConstraintID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRoleID(v)
+		m.SetConstraintID(v)
 		return nil
-	case rolepermissions.FieldPermissionID:
+	case sodconstraintroles.FieldRoleID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPermissionID(v)
-		return nil
-	case rolepermissions.FieldAssignedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAssignedAt(v)
+		m.SetRoleID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RolePermissions field %s", name)
+	return fmt.Errorf("unknown SodConstraintRoles field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RolePermissionsMutation) AddedFields() []string {
+func (m *SodConstraintRolesMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -4625,7 +19850,7 @@ func (m *RolePermissionsMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RolePermissionsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SodConstraintRolesMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -4634,70 +19859,67 @@ func (m *RolePermissionsMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RolePermissionsMutation) AddField(name string, value ent.Value) error {
+func (m *SodConstraintRolesMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown RolePermissions numeric field %s", name)
+	return fmt.Errorf("unknown SodConstraintRoles numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RolePermissionsMutation) ClearedFields() []string {
+func (m *SodConstraintRolesMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RolePermissionsMutation) FieldCleared(name string) bool {
+func (m *SodConstraintRolesMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RolePermissionsMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown RolePermissions nullable field %s", name)
+func (m *SodConstraintRolesMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SodConstraintRoles nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RolePermissionsMutation) ResetField(name string) error {
+func (m *SodConstraintRolesMutation) ResetField(name string) error {
 	switch name {
-	case rolepermissions.FieldRoleID:
-		m.ResetRoleID()
-		return nil
-	case rolepermissions.FieldPermissionID:
-		m.ResetPermissionID()
+	case sodconstraintroles.FieldConstraintID:
+		m.ResetConstraintID()
 		return nil
-	case rolepermissions.FieldAssignedAt:
-		m.ResetAssignedAt()
+	case sodconstraintroles.FieldRoleID:
+		m.ResetRoleID()
 		return nil
 	}
-	return fmt.Errorf("unknown RolePermissions field %s", name)
+	return fmt.Errorf("unknown SodConstraintRoles field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RolePermissionsMutation) AddedEdges() []string {
+func (m *SodConstraintRolesMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.role != nil {
-		edges = append(edges, rolepermissions.EdgeRole)
+	if m.sod_constraint != nil {
+		edges = append(edges, sodconstraintroles.EdgeSodConstraint)
 	}
-	if m.permission != nil {
-		edges = append(edges, rolepermissions.EdgePermission)
+	if m.role != nil {
+		edges = append(edges, sodconstraintroles.EdgeRole)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RolePermissionsMutation) AddedIDs(name string) []ent.Value {
+func (m *SodConstraintRolesMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case rolepermissions.EdgeRole:
-		if id := m.role; id != nil {
+	case sodconstraintroles.EdgeSodConstraint:
+		if id := m.sod_constraint; id != nil {
 			return []ent.Value{*id}
 		}
-	case rolepermissions.EdgePermission:
-		if id := m.permission; id != nil {
+	case sodconstraintroles.EdgeRole:
+		if id := m.role; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4705,71 +19927,71 @@ func (m *RolePermissionsMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RolePermissionsMutation) RemovedEdges() []string {
+func (m *SodConstraintRolesMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RolePermissionsMutation) RemovedIDs(name string) []ent.Value {
+func (m *SodConstraintRolesMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RolePermissionsMutation) ClearedEdges() []string {
+func (m *SodConstraintRolesMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedrole {
-		edges = append(edges, rolepermissions.EdgeRole)
+	if m.clearedsod_constraint {
+		edges = append(edges, sodconstraintroles.EdgeSodConstraint)
 	}
-	if m.clearedpermission {
-		edges = append(edges, rolepermissions.EdgePermission)
+	if m.clearedrole {
+		edges = append(edges, sodconstraintroles.EdgeRole)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RolePermissionsMutation) EdgeCleared(name string) bool {
+func (m *SodConstraintRolesMutation) EdgeCleared(name string) bool {
 	switch name {
-	case rolepermissions.EdgeRole:
+	case sodconstraintroles.EdgeSodConstraint:
+		return m.clearedsod_constraint
+	case sodconstraintroles.EdgeRole:
 		return m.clearedrole
-	case rolepermissions.EdgePermission:
-		return m.clearedpermission
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RolePermissionsMutation) ClearEdge(name string) error {
+func (m *SodConstraintRolesMutation) ClearEdge(name string) error {
 	switch name {
-	case rolepermissions.EdgeRole:
-		m.ClearRole()
+	case sodconstraintroles.EdgeSodConstraint:
+		m.ClearSodConstraint()
 		return nil
-	case rolepermissions.EdgePermission:
-		m.ClearPermission()
+	case sodconstraintroles.EdgeRole:
+		m.ClearRole()
 		return nil
 	}
-	return fmt.Errorf("unknown RolePermissions unique edge %s", name)
+	return fmt.Errorf("unknown SodConstraintRoles unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RolePermissionsMutation) ResetEdge(name string) error {
+func (m *SodConstraintRolesMutation) ResetEdge(name string) error {
 	switch name {
-	case rolepermissions.EdgeRole:
-		m.ResetRole()
+	case sodconstraintroles.EdgeSodConstraint:
+		m.ResetSodConstraint()
 		return nil
-	case rolepermissions.EdgePermission:
-		m.ResetPermission()
+	case sodconstraintroles.EdgeRole:
+		m.ResetRole()
 		return nil
 	}
-	return fmt.Errorf("unknown RolePermissions edge %s", name)
+	return fmt.Errorf("unknown SodConstraintRoles edge %s", name)
 }
 
-// RolesMutation represents an operation that mutates the Roles nodes in the graph.
-type RolesMutation struct {
+// SodConstraintsMutation represents an operation that mutates the SodConstraints nodes in the graph.
+type SodConstraintsMutation struct {
 	config
 	op                      Op
 	typ                     string
@@ -4778,34 +20000,28 @@ type RolesMutation struct {
 	name                    *string
 	description             *string
 	is_system               *bool
-	is_default              *bool
-	max_users               *int
-	addmax_users            *int
 	created_at              *time.Time
 	updated_at              *time.Time
 	clearedFields           map[string]struct{}
-	user_roles              map[uuid.UUID]struct{}
-	removeduser_roles       map[uuid.UUID]struct{}
-	cleareduser_roles       bool
-	role_permissions        map[int]struct{}
-	removedrole_permissions map[int]struct{}
-	clearedrole_permissions bool
+	constraint_roles        map[int]struct{}
+	removedconstraint_roles map[int]struct{}
+	clearedconstraint_roles bool
 	done                    bool
-	oldValue                func(context.Context) (*Roles, error)
-	predicates              []predicate.Roles
+	oldValue                func(context.Context) (*SodConstraints, error)
+	predicates              []predicate.SodConstraints
 }
 
-var _ ent.Mutation = (*RolesMutation)(nil)
+var _ ent.Mutation = (*SodConstraintsMutation)(nil)
 
-// rolesOption allows management of the mutation configuration using functional options.
-type rolesOption func(*RolesMutation)
+// sodconstraintsOption allows management of the mutation configuration using functional options.
+type sodconstraintsOption func(*SodConstraintsMutation)
 
-// newRolesMutation creates new mutation for the Roles entity.
-func newRolesMutation(c config, op Op, opts ...rolesOption) *RolesMutation {
-	m := &RolesMutation{
+// newSodConstraintsMutation creates new mutation for the SodConstraints entity.
+func newSodConstraintsMutation(c config, op Op, opts ...sodconstraintsOption) *SodConstraintsMutation {
+	m := &SodConstraintsMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRoles,
+		typ:           TypeSodConstraints,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4814,20 +20030,20 @@ func newRolesMutation(c config, op Op, opts ...rolesOption) *RolesMutation {
 	return m
 }
 
-// withRolesID sets the ID field of the mutation.
-func withRolesID(id int) rolesOption {
-	return func(m *RolesMutation) {
+// withSodConstraintsID sets the ID field of the mutation.
+func withSodConstraintsID(id int) sodconstraintsOption {
+	return func(m *SodConstraintsMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Roles
+			value *SodConstraints
 		)
-		m.oldValue = func(ctx context.Context) (*Roles, error) {
+		m.oldValue = func(ctx context.Context) (*SodConstraints, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Roles.Get(ctx, id)
+					value, err = m.Client().SodConstraints.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4836,10 +20052,10 @@ func withRolesID(id int) rolesOption {
 	}
 }
 
-// withRoles sets the old Roles of the mutation.
-func withRoles(node *Roles) rolesOption {
-	return func(m *RolesMutation) {
-		m.oldValue = func(context.Context) (*Roles, error) {
+// withSodConstraints sets the old SodConstraints of the mutation.
+func withSodConstraints(node *SodConstraints) sodconstraintsOption {
+	return func(m *SodConstraintsMutation) {
+		m.oldValue = func(context.Context) (*SodConstraints, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4848,7 +20064,7 @@ func withRoles(node *Roles) rolesOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RolesMutation) Client() *Client {
+func (m SodConstraintsMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4856,7 +20072,7 @@ func (m RolesMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RolesMutation) Tx() (*Tx, error) {
+func (m SodConstraintsMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4866,14 +20082,14 @@ func (m RolesMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Roles entities.
-func (m *RolesMutation) SetID(id int) {
+// operation is only accepted on creation of SodConstraints entities.
+func (m *SodConstraintsMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RolesMutation) ID() (id int, exists bool) {
+func (m *SodConstraintsMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4884,7 +20100,7 @@ func (m *RolesMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RolesMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SodConstraintsMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4893,19 +20109,19 @@ func (m *RolesMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Roles.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SodConstraints.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCode sets the "code" field.
-func (m *RolesMutation) SetCode(s string) {
+func (m *SodConstraintsMutation) SetCode(s string) {
 	m.code = &s
 }
 
 // Code returns the value of the "code" field in the mutation.
-func (m *RolesMutation) Code() (r string, exists bool) {
+func (m *SodConstraintsMutation) Code() (r string, exists bool) {
 	v := m.code
 	if v == nil {
 		return
@@ -4913,10 +20129,10 @@ func (m *RolesMutation) Code() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the Roles entity.
-// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// OldCode returns the old "code" field's value of the SodConstraints entity.
+// If the SodConstraints object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolesMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *SodConstraintsMutation) OldCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCode is only allowed on UpdateOne operations")
 	}
@@ -4931,17 +20147,17 @@ func (m *RolesMutation) OldCode(ctx context.Context) (v string, err error) {
 }
 
 // ResetCode resets all changes to the "code" field.
-func (m *RolesMutation) ResetCode() {
+func (m *SodConstraintsMutation) ResetCode() {
 	m.code = nil
 }
 
 // SetName sets the "name" field.
-func (m *RolesMutation) SetName(s string) {
+func (m *SodConstraintsMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *RolesMutation) Name() (r string, exists bool) {
+func (m *SodConstraintsMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -4949,10 +20165,10 @@ func (m *RolesMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Roles entity.
-// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the SodConstraints entity.
+// If the SodConstraints object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolesMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *SodConstraintsMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -4967,17 +20183,17 @@ func (m *RolesMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *RolesMutation) ResetName() {
+func (m *SodConstraintsMutation) ResetName() {
 	m.name = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *RolesMutation) SetDescription(s string) {
+func (m *SodConstraintsMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *RolesMutation) Description() (r string, exists bool) {
+func (m *SodConstraintsMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -4985,10 +20201,10 @@ func (m *RolesMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Roles entity.
-// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the SodConstraints entity.
+// If the SodConstraints object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolesMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *SodConstraintsMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -5003,30 +20219,30 @@ func (m *RolesMutation) OldDescription(ctx context.Context) (v string, err error
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *RolesMutation) ClearDescription() {
+func (m *SodConstraintsMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[roles.FieldDescription] = struct{}{}
+	m.clearedFields[sodconstraints.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *RolesMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[roles.FieldDescription]
+func (m *SodConstraintsMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[sodconstraints.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *RolesMutation) ResetDescription() {
+func (m *SodConstraintsMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, roles.FieldDescription)
+	delete(m.clearedFields, sodconstraints.FieldDescription)
 }
 
 // SetIsSystem sets the "is_system" field.
-func (m *RolesMutation) SetIsSystem(b bool) {
+func (m *SodConstraintsMutation) SetIsSystem(b bool) {
 	m.is_system = &b
 }
 
 // IsSystem returns the value of the "is_system" field in the mutation.
-func (m *RolesMutation) IsSystem() (r bool, exists bool) {
+func (m *SodConstraintsMutation) IsSystem() (r bool, exists bool) {
 	v := m.is_system
 	if v == nil {
 		return
@@ -5034,10 +20250,10 @@ func (m *RolesMutation) IsSystem() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldIsSystem returns the old "is_system" field's value of the Roles entity.
-// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// OldIsSystem returns the old "is_system" field's value of the SodConstraints entity.
+// If the SodConstraints object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolesMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
+func (m *SodConstraintsMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
 	}
@@ -5052,123 +20268,17 @@ func (m *RolesMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
 }
 
 // ResetIsSystem resets all changes to the "is_system" field.
-func (m *RolesMutation) ResetIsSystem() {
+func (m *SodConstraintsMutation) ResetIsSystem() {
 	m.is_system = nil
 }
 
-// SetIsDefault sets the "is_default" field.
-func (m *RolesMutation) SetIsDefault(b bool) {
-	m.is_default = &b
-}
-
-// IsDefault returns the value of the "is_default" field in the mutation.
-func (m *RolesMutation) IsDefault() (r bool, exists bool) {
-	v := m.is_default
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIsDefault returns the old "is_default" field's value of the Roles entity.
-// If the Roles object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolesMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDefault requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
-	}
-	return oldValue.IsDefault, nil
-}
-
-// ResetIsDefault resets all changes to the "is_default" field.
-func (m *RolesMutation) ResetIsDefault() {
-	m.is_default = nil
-}
-
-// SetMaxUsers sets the "max_users" field.
-func (m *RolesMutation) SetMaxUsers(i int) {
-	m.max_users = &i
-	m.addmax_users = nil
-}
-
-// MaxUsers returns the value of the "max_users" field in the mutation.
-func (m *RolesMutation) MaxUsers() (r int, exists bool) {
-	v := m.max_users
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldMaxUsers returns the old "max_users" field's value of the Roles entity.
-// If the Roles object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolesMutation) OldMaxUsers(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxUsers is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxUsers requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxUsers: %w", err)
-	}
-	return oldValue.MaxUsers, nil
-}
-
-// AddMaxUsers adds i to the "max_users" field.
-func (m *RolesMutation) AddMaxUsers(i int) {
-	if m.addmax_users != nil {
-		*m.addmax_users += i
-	} else {
-		m.addmax_users = &i
-	}
-}
-
-// AddedMaxUsers returns the value that was added to the "max_users" field in this mutation.
-func (m *RolesMutation) AddedMaxUsers() (r int, exists bool) {
-	v := m.addmax_users
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearMaxUsers clears the value of the "max_users" field.
-func (m *RolesMutation) ClearMaxUsers() {
-	m.max_users = nil
-	m.addmax_users = nil
-	m.clearedFields[roles.FieldMaxUsers] = struct{}{}
-}
-
-// MaxUsersCleared returns if the "max_users" field was cleared in this mutation.
-func (m *RolesMutation) MaxUsersCleared() bool {
-	_, ok := m.clearedFields[roles.FieldMaxUsers]
-	return ok
-}
-
-// ResetMaxUsers resets all changes to the "max_users" field.
-func (m *RolesMutation) ResetMaxUsers() {
-	m.max_users = nil
-	m.addmax_users = nil
-	delete(m.clearedFields, roles.FieldMaxUsers)
-}
-
 // SetCreatedAt sets the "created_at" field.
-func (m *RolesMutation) SetCreatedAt(t time.Time) {
+func (m *SodConstraintsMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RolesMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SodConstraintsMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5176,10 +20286,10 @@ func (m *RolesMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Roles entity.
-// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SodConstraints entity.
+// If the SodConstraints object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SodConstraintsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5194,17 +20304,17 @@ func (m *RolesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RolesMutation) ResetCreatedAt() {
+func (m *SodConstraintsMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *RolesMutation) SetUpdatedAt(t time.Time) {
+func (m *SodConstraintsMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RolesMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SodConstraintsMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5212,10 +20322,10 @@ func (m *RolesMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Roles entity.
-// If the Roles object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SodConstraints entity.
+// If the SodConstraints object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RolesMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SodConstraintsMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5230,127 +20340,73 @@ func (m *RolesMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RolesMutation) ResetUpdatedAt() {
+func (m *SodConstraintsMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// AddUserRoleIDs adds the "user_roles" edge to the UserRoles entity by ids.
-func (m *RolesMutation) AddUserRoleIDs(ids ...uuid.UUID) {
-	if m.user_roles == nil {
-		m.user_roles = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.user_roles[ids[i]] = struct{}{}
-	}
-}
-
-// ClearUserRoles clears the "user_roles" edge to the UserRoles entity.
-func (m *RolesMutation) ClearUserRoles() {
-	m.cleareduser_roles = true
-}
-
-// UserRolesCleared reports if the "user_roles" edge to the UserRoles entity was cleared.
-func (m *RolesMutation) UserRolesCleared() bool {
-	return m.cleareduser_roles
-}
-
-// RemoveUserRoleIDs removes the "user_roles" edge to the UserRoles entity by IDs.
-func (m *RolesMutation) RemoveUserRoleIDs(ids ...uuid.UUID) {
-	if m.removeduser_roles == nil {
-		m.removeduser_roles = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.user_roles, ids[i])
-		m.removeduser_roles[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedUserRoles returns the removed IDs of the "user_roles" edge to the UserRoles entity.
-func (m *RolesMutation) RemovedUserRolesIDs() (ids []uuid.UUID) {
-	for id := range m.removeduser_roles {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// UserRolesIDs returns the "user_roles" edge IDs in the mutation.
-func (m *RolesMutation) UserRolesIDs() (ids []uuid.UUID) {
-	for id := range m.user_roles {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetUserRoles resets all changes to the "user_roles" edge.
-func (m *RolesMutation) ResetUserRoles() {
-	m.user_roles = nil
-	m.cleareduser_roles = false
-	m.removeduser_roles = nil
-}
-
-// AddRolePermissionIDs adds the "role_permissions" edge to the RolePermissions entity by ids.
-func (m *RolesMutation) AddRolePermissionIDs(ids ...int) {
-	if m.role_permissions == nil {
-		m.role_permissions = make(map[int]struct{})
+// AddConstraintRoleIDs adds the "constraint_roles" edge to the SodConstraintRoles entity by ids.
+func (m *SodConstraintsMutation) AddConstraintRoleIDs(ids ...int) {
+	if m.constraint_roles == nil {
+		m.constraint_roles = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.role_permissions[ids[i]] = struct{}{}
+		m.constraint_roles[ids[i]] = struct{}{}
 	}
 }
 
-// ClearRolePermissions clears the "role_permissions" edge to the RolePermissions entity.
-func (m *RolesMutation) ClearRolePermissions() {
-	m.clearedrole_permissions = true
+// ClearConstraintRoles clears the "constraint_roles" edge to the SodConstraintRoles entity.
+func (m *SodConstraintsMutation) ClearConstraintRoles() {
+	m.clearedconstraint_roles = true
 }
 
-// RolePermissionsCleared reports if the "role_permissions" edge to the RolePermissions entity was cleared.
-func (m *RolesMutation) RolePermissionsCleared() bool {
-	return m.clearedrole_permissions
+// ConstraintRolesCleared reports if the "constraint_roles" edge to the SodConstraintRoles entity was cleared.
+func (m *SodConstraintsMutation) ConstraintRolesCleared() bool {
+	return m.clearedconstraint_roles
 }
 
-// RemoveRolePermissionIDs removes the "role_permissions" edge to the RolePermissions entity by IDs.
-func (m *RolesMutation) RemoveRolePermissionIDs(ids ...int) {
-	if m.removedrole_permissions == nil {
-		m.removedrole_permissions = make(map[int]struct{})
+// RemoveConstraintRoleIDs removes the "constraint_roles" edge to the SodConstraintRoles entity by IDs.
+func (m *SodConstraintsMutation) RemoveConstraintRoleIDs(ids ...int) {
+	if m.removedconstraint_roles == nil {
+		m.removedconstraint_roles = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.role_permissions, ids[i])
-		m.removedrole_permissions[ids[i]] = struct{}{}
+		delete(m.constraint_roles, ids[i])
+		m.removedconstraint_roles[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedRolePermissions returns the removed IDs of the "role_permissions" edge to the RolePermissions entity.
-func (m *RolesMutation) RemovedRolePermissionsIDs() (ids []int) {
-	for id := range m.removedrole_permissions {
+// RemovedConstraintRoles returns the removed IDs of the "constraint_roles" edge to the SodConstraintRoles entity.
+func (m *SodConstraintsMutation) RemovedConstraintRolesIDs() (ids []int) {
+	for id := range m.removedconstraint_roles {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// RolePermissionsIDs returns the "role_permissions" edge IDs in the mutation.
-func (m *RolesMutation) RolePermissionsIDs() (ids []int) {
-	for id := range m.role_permissions {
+// ConstraintRolesIDs returns the "constraint_roles" edge IDs in the mutation.
+func (m *SodConstraintsMutation) ConstraintRolesIDs() (ids []int) {
+	for id := range m.constraint_roles {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetRolePermissions resets all changes to the "role_permissions" edge.
-func (m *RolesMutation) ResetRolePermissions() {
-	m.role_permissions = nil
-	m.clearedrole_permissions = false
-	m.removedrole_permissions = nil
+// ResetConstraintRoles resets all changes to the "constraint_roles" edge.
+func (m *SodConstraintsMutation) ResetConstraintRoles() {
+	m.constraint_roles = nil
+	m.clearedconstraint_roles = false
+	m.removedconstraint_roles = nil
 }
 
-// Where appends a list predicates to the RolesMutation builder.
-func (m *RolesMutation) Where(ps ...predicate.Roles) {
+// Where appends a list predicates to the SodConstraintsMutation builder.
+func (m *SodConstraintsMutation) Where(ps ...predicate.SodConstraints) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RolesMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SodConstraintsMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RolesMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Roles, len(ps))
+func (m *SodConstraintsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SodConstraints, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5358,48 +20414,42 @@ func (m *RolesMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RolesMutation) Op() Op {
+func (m *SodConstraintsMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RolesMutation) SetOp(op Op) {
+func (m *SodConstraintsMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Roles).
-func (m *RolesMutation) Type() string {
+// Type returns the node type of this mutation (SodConstraints).
+func (m *SodConstraintsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RolesMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *SodConstraintsMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.code != nil {
-		fields = append(fields, roles.FieldCode)
+		fields = append(fields, sodconstraints.FieldCode)
 	}
 	if m.name != nil {
-		fields = append(fields, roles.FieldName)
+		fields = append(fields, sodconstraints.FieldName)
 	}
 	if m.description != nil {
-		fields = append(fields, roles.FieldDescription)
+		fields = append(fields, sodconstraints.FieldDescription)
 	}
 	if m.is_system != nil {
-		fields = append(fields, roles.FieldIsSystem)
-	}
-	if m.is_default != nil {
-		fields = append(fields, roles.FieldIsDefault)
-	}
-	if m.max_users != nil {
-		fields = append(fields, roles.FieldMaxUsers)
+		fields = append(fields, sodconstraints.FieldIsSystem)
 	}
 	if m.created_at != nil {
-		fields = append(fields, roles.FieldCreatedAt)
+		fields = append(fields, sodconstraints.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, roles.FieldUpdatedAt)
+		fields = append(fields, sodconstraints.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5407,23 +20457,19 @@ func (m *RolesMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RolesMutation) Field(name string) (ent.Value, bool) {
+func (m *SodConstraintsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case roles.FieldCode:
+	case sodconstraints.FieldCode:
 		return m.Code()
-	case roles.FieldName:
+	case sodconstraints.FieldName:
 		return m.Name()
-	case roles.FieldDescription:
+	case sodconstraints.FieldDescription:
 		return m.Description()
-	case roles.FieldIsSystem:
+	case sodconstraints.FieldIsSystem:
 		return m.IsSystem()
-	case roles.FieldIsDefault:
-		return m.IsDefault()
-	case roles.FieldMaxUsers:
-		return m.MaxUsers()
-	case roles.FieldCreatedAt:
+	case sodconstraints.FieldCreatedAt:
 		return m.CreatedAt()
-	case roles.FieldUpdatedAt:
+	case sodconstraints.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -5432,83 +20478,65 @@ func (m *RolesMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RolesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SodConstraintsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case roles.FieldCode:
+	case sodconstraints.FieldCode:
 		return m.OldCode(ctx)
-	case roles.FieldName:
+	case sodconstraints.FieldName:
 		return m.OldName(ctx)
-	case roles.FieldDescription:
+	case sodconstraints.FieldDescription:
 		return m.OldDescription(ctx)
-	case roles.FieldIsSystem:
+	case sodconstraints.FieldIsSystem:
 		return m.OldIsSystem(ctx)
-	case roles.FieldIsDefault:
-		return m.OldIsDefault(ctx)
-	case roles.FieldMaxUsers:
-		return m.OldMaxUsers(ctx)
-	case roles.FieldCreatedAt:
+	case sodconstraints.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case roles.FieldUpdatedAt:
+	case sodconstraints.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Roles field %s", name)
+	return nil, fmt.Errorf("unknown SodConstraints field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RolesMutation) SetField(name string, value ent.Value) error {
+func (m *SodConstraintsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case roles.FieldCode:
+	case sodconstraints.FieldCode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCode(v)
 		return nil
-	case roles.FieldName:
+	case sodconstraints.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case roles.FieldDescription:
+	case sodconstraints.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case roles.FieldIsSystem:
+	case sodconstraints.FieldIsSystem:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetIsSystem(v)
 		return nil
-	case roles.FieldIsDefault:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsDefault(v)
-		return nil
-	case roles.FieldMaxUsers:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxUsers(v)
-		return nil
-	case roles.FieldCreatedAt:
+	case sodconstraints.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case roles.FieldUpdatedAt:
+	case sodconstraints.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5516,137 +20544,101 @@ func (m *RolesMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Roles field %s", name)
+	return fmt.Errorf("unknown SodConstraints field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RolesMutation) AddedFields() []string {
-	var fields []string
-	if m.addmax_users != nil {
-		fields = append(fields, roles.FieldMaxUsers)
-	}
-	return fields
+func (m *SodConstraintsMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RolesMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case roles.FieldMaxUsers:
-		return m.AddedMaxUsers()
-	}
+func (m *SodConstraintsMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RolesMutation) AddField(name string, value ent.Value) error {
+func (m *SodConstraintsMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case roles.FieldMaxUsers:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxUsers(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Roles numeric field %s", name)
+	return fmt.Errorf("unknown SodConstraints numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RolesMutation) ClearedFields() []string {
+func (m *SodConstraintsMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(roles.FieldDescription) {
-		fields = append(fields, roles.FieldDescription)
-	}
-	if m.FieldCleared(roles.FieldMaxUsers) {
-		fields = append(fields, roles.FieldMaxUsers)
+	if m.FieldCleared(sodconstraints.FieldDescription) {
+		fields = append(fields, sodconstraints.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RolesMutation) FieldCleared(name string) bool {
+func (m *SodConstraintsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RolesMutation) ClearField(name string) error {
+func (m *SodConstraintsMutation) ClearField(name string) error {
 	switch name {
-	case roles.FieldDescription:
+	case sodconstraints.FieldDescription:
 		m.ClearDescription()
 		return nil
-	case roles.FieldMaxUsers:
-		m.ClearMaxUsers()
-		return nil
 	}
-	return fmt.Errorf("unknown Roles nullable field %s", name)
+	return fmt.Errorf("unknown SodConstraints nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RolesMutation) ResetField(name string) error {
+func (m *SodConstraintsMutation) ResetField(name string) error {
 	switch name {
-	case roles.FieldCode:
+	case sodconstraints.FieldCode:
 		m.ResetCode()
 		return nil
-	case roles.FieldName:
+	case sodconstraints.FieldName:
 		m.ResetName()
 		return nil
-	case roles.FieldDescription:
+	case sodconstraints.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case roles.FieldIsSystem:
+	case sodconstraints.FieldIsSystem:
 		m.ResetIsSystem()
 		return nil
-	case roles.FieldIsDefault:
-		m.ResetIsDefault()
-		return nil
-	case roles.FieldMaxUsers:
-		m.ResetMaxUsers()
-		return nil
-	case roles.FieldCreatedAt:
+	case sodconstraints.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case roles.FieldUpdatedAt:
+	case sodconstraints.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Roles field %s", name)
+	return fmt.Errorf("unknown SodConstraints field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RolesMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user_roles != nil {
-		edges = append(edges, roles.EdgeUserRoles)
-	}
-	if m.role_permissions != nil {
-		edges = append(edges, roles.EdgeRolePermissions)
+func (m *SodConstraintsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.constraint_roles != nil {
+		edges = append(edges, sodconstraints.EdgeConstraintRoles)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RolesMutation) AddedIDs(name string) []ent.Value {
+func (m *SodConstraintsMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case roles.EdgeUserRoles:
-		ids := make([]ent.Value, 0, len(m.user_roles))
-		for id := range m.user_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case roles.EdgeRolePermissions:
-		ids := make([]ent.Value, 0, len(m.role_permissions))
-		for id := range m.role_permissions {
+	case sodconstraints.EdgeConstraintRoles:
+		ids := make([]ent.Value, 0, len(m.constraint_roles))
+		for id := range m.constraint_roles {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5655,30 +20647,21 @@ func (m *RolesMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RolesMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removeduser_roles != nil {
-		edges = append(edges, roles.EdgeUserRoles)
-	}
-	if m.removedrole_permissions != nil {
-		edges = append(edges, roles.EdgeRolePermissions)
+func (m *SodConstraintsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedconstraint_roles != nil {
+		edges = append(edges, sodconstraints.EdgeConstraintRoles)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RolesMutation) RemovedIDs(name string) []ent.Value {
+func (m *SodConstraintsMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case roles.EdgeUserRoles:
-		ids := make([]ent.Value, 0, len(m.removeduser_roles))
-		for id := range m.removeduser_roles {
-			ids = append(ids, id)
-		}
-		return ids
-	case roles.EdgeRolePermissions:
-		ids := make([]ent.Value, 0, len(m.removedrole_permissions))
-		for id := range m.removedrole_permissions {
+	case sodconstraints.EdgeConstraintRoles:
+		ids := make([]ent.Value, 0, len(m.removedconstraint_roles))
+		for id := range m.removedconstraint_roles {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5687,49 +20670,41 @@ func (m *RolesMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RolesMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser_roles {
-		edges = append(edges, roles.EdgeUserRoles)
-	}
-	if m.clearedrole_permissions {
-		edges = append(edges, roles.EdgeRolePermissions)
+func (m *SodConstraintsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedconstraint_roles {
+		edges = append(edges, sodconstraints.EdgeConstraintRoles)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RolesMutation) EdgeCleared(name string) bool {
+func (m *SodConstraintsMutation) EdgeCleared(name string) bool {
 	switch name {
-	case roles.EdgeUserRoles:
-		return m.cleareduser_roles
-	case roles.EdgeRolePermissions:
-		return m.clearedrole_permissions
+	case sodconstraints.EdgeConstraintRoles:
+		return m.clearedconstraint_roles
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RolesMutation) ClearEdge(name string) error {
+func (m *SodConstraintsMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Roles unique edge %s", name)
+	return fmt.Errorf("unknown SodConstraints unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RolesMutation) ResetEdge(name string) error {
+func (m *SodConstraintsMutation) ResetEdge(name string) error {
 	switch name {
-	case roles.EdgeUserRoles:
-		m.ResetUserRoles()
-		return nil
-	case roles.EdgeRolePermissions:
-		m.ResetRolePermissions()
+	case sodconstraints.EdgeConstraintRoles:
+		m.ResetConstraintRoles()
 		return nil
 	}
-	return fmt.Errorf("unknown Roles edge %s", name)
+	return fmt.Errorf("unknown SodConstraints edge %s", name)
 }
 
 // UserRolesMutation represents an operation that mutates the UserRoles nodes in the graph.
@@ -5740,6 +20715,8 @@ type UserRolesMutation struct {
 	id            *uuid.UUID
 	assigned_by   *uuid.UUID
 	assigned_at   *time.Time
+	expires_at    *time.Time
+	reason        *string
 	clearedFields map[string]struct{}
 	user          *uuid.UUID
 	cleareduser   bool
@@ -6011,6 +20988,104 @@ func (m *UserRolesMutation) ResetAssignedAt() {
 	m.assigned_at = nil
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (m *UserRolesMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *UserRolesMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the UserRoles entity.
+// If the UserRoles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserRolesMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *UserRolesMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[userroles.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *UserRolesMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[userroles.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *UserRolesMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, userroles.FieldExpiresAt)
+}
+
+// SetReason sets the "reason" field.
+func (m *UserRolesMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *UserRolesMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the UserRoles entity.
+// If the UserRoles object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserRolesMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *UserRolesMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[userroles.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *UserRolesMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[userroles.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *UserRolesMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, userroles.FieldReason)
+}
+
 // ClearUser clears the "user" edge to the Users entity.
 func (m *UserRolesMutation) ClearUser() {
 	m.cleareduser = true
@@ -6099,7 +21174,7 @@ func (m *UserRolesMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserRolesMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 6)
 	if m.user != nil {
 		fields = append(fields, userroles.FieldUserID)
 	}
@@ -6112,6 +21187,12 @@ func (m *UserRolesMutation) Fields() []string {
 	if m.assigned_at != nil {
 		fields = append(fields, userroles.FieldAssignedAt)
 	}
+	if m.expires_at != nil {
+		fields = append(fields, userroles.FieldExpiresAt)
+	}
+	if m.reason != nil {
+		fields = append(fields, userroles.FieldReason)
+	}
 	return fields
 }
 
@@ -6128,6 +21209,10 @@ func (m *UserRolesMutation) Field(name string) (ent.Value, bool) {
 		return m.AssignedBy()
 	case userroles.FieldAssignedAt:
 		return m.AssignedAt()
+	case userroles.FieldExpiresAt:
+		return m.ExpiresAt()
+	case userroles.FieldReason:
+		return m.Reason()
 	}
 	return nil, false
 }
@@ -6145,6 +21230,10 @@ func (m *UserRolesMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldAssignedBy(ctx)
 	case userroles.FieldAssignedAt:
 		return m.OldAssignedAt(ctx)
+	case userroles.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case userroles.FieldReason:
+		return m.OldReason(ctx)
 	}
 	return nil, fmt.Errorf("unknown UserRoles field %s", name)
 }
@@ -6173,14 +21262,28 @@ func (m *UserRolesMutation) SetField(name string, value ent.Value) error {
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAssignedBy(v)
+		m.SetAssignedBy(v)
+		return nil
+	case userroles.FieldAssignedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedAt(v)
+		return nil
+	case userroles.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
 		return nil
-	case userroles.FieldAssignedAt:
-		v, ok := value.(time.Time)
+	case userroles.FieldReason:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAssignedAt(v)
+		m.SetReason(v)
 		return nil
 	}
 	return fmt.Errorf("unknown UserRoles field %s", name)
@@ -6218,6 +21321,12 @@ func (m *UserRolesMutation) ClearedFields() []string {
 	if m.FieldCleared(userroles.FieldAssignedBy) {
 		fields = append(fields, userroles.FieldAssignedBy)
 	}
+	if m.FieldCleared(userroles.FieldExpiresAt) {
+		fields = append(fields, userroles.FieldExpiresAt)
+	}
+	if m.FieldCleared(userroles.FieldReason) {
+		fields = append(fields, userroles.FieldReason)
+	}
 	return fields
 }
 
@@ -6235,6 +21344,12 @@ func (m *UserRolesMutation) ClearField(name string) error {
 	case userroles.FieldAssignedBy:
 		m.ClearAssignedBy()
 		return nil
+	case userroles.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case userroles.FieldReason:
+		m.ClearReason()
+		return nil
 	}
 	return fmt.Errorf("unknown UserRoles nullable field %s", name)
 }
@@ -6255,6 +21370,12 @@ func (m *UserRolesMutation) ResetField(name string) error {
 	case userroles.FieldAssignedAt:
 		m.ResetAssignedAt()
 		return nil
+	case userroles.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case userroles.FieldReason:
+		m.ResetReason()
+		return nil
 	}
 	return fmt.Errorf("unknown UserRoles field %s", name)
 }
@@ -6370,11 +21491,26 @@ type UsersMutation struct {
 	verification_token_expiry   *time.Time
 	password_reset_token        *string
 	password_reset_token_expiry *time.Time
+	password_reset_required     *bool
+	approval_status             *users.ApprovalStatus
+	reviewed_by                 *uuid.UUID
+	reviewed_at                 *time.Time
+	deletion_requested_at       *time.Time
+	deletion_scheduled_at       *time.Time
 	metadata                    *map[string]interface{}
 	clearedFields               map[string]struct{}
 	user_roles                  map[uuid.UUID]struct{}
 	removeduser_roles           map[uuid.UUID]struct{}
 	cleareduser_roles           bool
+	group_memberships           map[uuid.UUID]struct{}
+	removedgroup_memberships    map[uuid.UUID]struct{}
+	clearedgroup_memberships    bool
+	org_memberships             map[uuid.UUID]struct{}
+	removedorg_memberships      map[uuid.UUID]struct{}
+	clearedorg_memberships      bool
+	org_roles                   map[int]struct{}
+	removedorg_roles            map[int]struct{}
+	clearedorg_roles            bool
 	done                        bool
 	oldValue                    func(context.Context) (*Users, error)
 	predicates                  []predicate.Users
@@ -6813,208 +21949,476 @@ func (m *UsersMutation) OldEmailVerified(ctx context.Context) (v bool, err error
 	if err != nil {
 		return v, fmt.Errorf("querying old value for OldEmailVerified: %w", err)
 	}
-	return oldValue.EmailVerified, nil
+	return oldValue.EmailVerified, nil
+}
+
+// ResetEmailVerified resets all changes to the "email_verified" field.
+func (m *UsersMutation) ResetEmailVerified() {
+	m.email_verified = nil
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (m *UsersMutation) SetVerificationToken(s string) {
+	m.verification_token = &s
+}
+
+// VerificationToken returns the value of the "verification_token" field in the mutation.
+func (m *UsersMutation) VerificationToken() (r string, exists bool) {
+	v := m.verification_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationToken returns the old "verification_token" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersMutation) OldVerificationToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationToken: %w", err)
+	}
+	return oldValue.VerificationToken, nil
+}
+
+// ClearVerificationToken clears the value of the "verification_token" field.
+func (m *UsersMutation) ClearVerificationToken() {
+	m.verification_token = nil
+	m.clearedFields[users.FieldVerificationToken] = struct{}{}
+}
+
+// VerificationTokenCleared returns if the "verification_token" field was cleared in this mutation.
+func (m *UsersMutation) VerificationTokenCleared() bool {
+	_, ok := m.clearedFields[users.FieldVerificationToken]
+	return ok
+}
+
+// ResetVerificationToken resets all changes to the "verification_token" field.
+func (m *UsersMutation) ResetVerificationToken() {
+	m.verification_token = nil
+	delete(m.clearedFields, users.FieldVerificationToken)
+}
+
+// SetVerificationTokenExpiry sets the "verification_token_expiry" field.
+func (m *UsersMutation) SetVerificationTokenExpiry(t time.Time) {
+	m.verification_token_expiry = &t
+}
+
+// VerificationTokenExpiry returns the value of the "verification_token_expiry" field in the mutation.
+func (m *UsersMutation) VerificationTokenExpiry() (r time.Time, exists bool) {
+	v := m.verification_token_expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerificationTokenExpiry returns the old "verification_token_expiry" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersMutation) OldVerificationTokenExpiry(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerificationTokenExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerificationTokenExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerificationTokenExpiry: %w", err)
+	}
+	return oldValue.VerificationTokenExpiry, nil
+}
+
+// ClearVerificationTokenExpiry clears the value of the "verification_token_expiry" field.
+func (m *UsersMutation) ClearVerificationTokenExpiry() {
+	m.verification_token_expiry = nil
+	m.clearedFields[users.FieldVerificationTokenExpiry] = struct{}{}
+}
+
+// VerificationTokenExpiryCleared returns if the "verification_token_expiry" field was cleared in this mutation.
+func (m *UsersMutation) VerificationTokenExpiryCleared() bool {
+	_, ok := m.clearedFields[users.FieldVerificationTokenExpiry]
+	return ok
+}
+
+// ResetVerificationTokenExpiry resets all changes to the "verification_token_expiry" field.
+func (m *UsersMutation) ResetVerificationTokenExpiry() {
+	m.verification_token_expiry = nil
+	delete(m.clearedFields, users.FieldVerificationTokenExpiry)
+}
+
+// SetPasswordResetToken sets the "password_reset_token" field.
+func (m *UsersMutation) SetPasswordResetToken(s string) {
+	m.password_reset_token = &s
+}
+
+// PasswordResetToken returns the value of the "password_reset_token" field in the mutation.
+func (m *UsersMutation) PasswordResetToken() (r string, exists bool) {
+	v := m.password_reset_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordResetToken returns the old "password_reset_token" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersMutation) OldPasswordResetToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordResetToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordResetToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordResetToken: %w", err)
+	}
+	return oldValue.PasswordResetToken, nil
+}
+
+// ClearPasswordResetToken clears the value of the "password_reset_token" field.
+func (m *UsersMutation) ClearPasswordResetToken() {
+	m.password_reset_token = nil
+	m.clearedFields[users.FieldPasswordResetToken] = struct{}{}
+}
+
+// PasswordResetTokenCleared returns if the "password_reset_token" field was cleared in this mutation.
+func (m *UsersMutation) PasswordResetTokenCleared() bool {
+	_, ok := m.clearedFields[users.FieldPasswordResetToken]
+	return ok
+}
+
+// ResetPasswordResetToken resets all changes to the "password_reset_token" field.
+func (m *UsersMutation) ResetPasswordResetToken() {
+	m.password_reset_token = nil
+	delete(m.clearedFields, users.FieldPasswordResetToken)
+}
+
+// SetPasswordResetTokenExpiry sets the "password_reset_token_expiry" field.
+func (m *UsersMutation) SetPasswordResetTokenExpiry(t time.Time) {
+	m.password_reset_token_expiry = &t
+}
+
+// PasswordResetTokenExpiry returns the value of the "password_reset_token_expiry" field in the mutation.
+func (m *UsersMutation) PasswordResetTokenExpiry() (r time.Time, exists bool) {
+	v := m.password_reset_token_expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordResetTokenExpiry returns the old "password_reset_token_expiry" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersMutation) OldPasswordResetTokenExpiry(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordResetTokenExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordResetTokenExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordResetTokenExpiry: %w", err)
+	}
+	return oldValue.PasswordResetTokenExpiry, nil
+}
+
+// ClearPasswordResetTokenExpiry clears the value of the "password_reset_token_expiry" field.
+func (m *UsersMutation) ClearPasswordResetTokenExpiry() {
+	m.password_reset_token_expiry = nil
+	m.clearedFields[users.FieldPasswordResetTokenExpiry] = struct{}{}
+}
+
+// PasswordResetTokenExpiryCleared returns if the "password_reset_token_expiry" field was cleared in this mutation.
+func (m *UsersMutation) PasswordResetTokenExpiryCleared() bool {
+	_, ok := m.clearedFields[users.FieldPasswordResetTokenExpiry]
+	return ok
+}
+
+// ResetPasswordResetTokenExpiry resets all changes to the "password_reset_token_expiry" field.
+func (m *UsersMutation) ResetPasswordResetTokenExpiry() {
+	m.password_reset_token_expiry = nil
+	delete(m.clearedFields, users.FieldPasswordResetTokenExpiry)
+}
+
+// SetPasswordResetRequired sets the "password_reset_required" field.
+func (m *UsersMutation) SetPasswordResetRequired(b bool) {
+	m.password_reset_required = &b
+}
+
+// PasswordResetRequired returns the value of the "password_reset_required" field in the mutation.
+func (m *UsersMutation) PasswordResetRequired() (r bool, exists bool) {
+	v := m.password_reset_required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordResetRequired returns the old "password_reset_required" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersMutation) OldPasswordResetRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordResetRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordResetRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordResetRequired: %w", err)
+	}
+	return oldValue.PasswordResetRequired, nil
+}
+
+// ResetPasswordResetRequired resets all changes to the "password_reset_required" field.
+func (m *UsersMutation) ResetPasswordResetRequired() {
+	m.password_reset_required = nil
+}
+
+// SetApprovalStatus sets the "approval_status" field.
+func (m *UsersMutation) SetApprovalStatus(us users.ApprovalStatus) {
+	m.approval_status = &us
+}
+
+// ApprovalStatus returns the value of the "approval_status" field in the mutation.
+func (m *UsersMutation) ApprovalStatus() (r users.ApprovalStatus, exists bool) {
+	v := m.approval_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovalStatus returns the old "approval_status" field's value of the Users entity.
+// If the Users object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsersMutation) OldApprovalStatus(ctx context.Context) (v users.ApprovalStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovalStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovalStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovalStatus: %w", err)
+	}
+	return oldValue.ApprovalStatus, nil
 }
 
-// ResetEmailVerified resets all changes to the "email_verified" field.
-func (m *UsersMutation) ResetEmailVerified() {
-	m.email_verified = nil
+// ResetApprovalStatus resets all changes to the "approval_status" field.
+func (m *UsersMutation) ResetApprovalStatus() {
+	m.approval_status = nil
 }
 
-// SetVerificationToken sets the "verification_token" field.
-func (m *UsersMutation) SetVerificationToken(s string) {
-	m.verification_token = &s
+// SetReviewedBy sets the "reviewed_by" field.
+func (m *UsersMutation) SetReviewedBy(u uuid.UUID) {
+	m.reviewed_by = &u
 }
 
-// VerificationToken returns the value of the "verification_token" field in the mutation.
-func (m *UsersMutation) VerificationToken() (r string, exists bool) {
-	v := m.verification_token
+// ReviewedBy returns the value of the "reviewed_by" field in the mutation.
+func (m *UsersMutation) ReviewedBy() (r uuid.UUID, exists bool) {
+	v := m.reviewed_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVerificationToken returns the old "verification_token" field's value of the Users entity.
+// OldReviewedBy returns the old "reviewed_by" field's value of the Users entity.
 // If the Users object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersMutation) OldVerificationToken(ctx context.Context) (v *string, err error) {
+func (m *UsersMutation) OldReviewedBy(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVerificationToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldReviewedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVerificationToken requires an ID field in the mutation")
+		return v, errors.New("OldReviewedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVerificationToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewedBy: %w", err)
 	}
-	return oldValue.VerificationToken, nil
+	return oldValue.ReviewedBy, nil
 }
 
-// ClearVerificationToken clears the value of the "verification_token" field.
-func (m *UsersMutation) ClearVerificationToken() {
-	m.verification_token = nil
-	m.clearedFields[users.FieldVerificationToken] = struct{}{}
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (m *UsersMutation) ClearReviewedBy() {
+	m.reviewed_by = nil
+	m.clearedFields[users.FieldReviewedBy] = struct{}{}
 }
 
-// VerificationTokenCleared returns if the "verification_token" field was cleared in this mutation.
-func (m *UsersMutation) VerificationTokenCleared() bool {
-	_, ok := m.clearedFields[users.FieldVerificationToken]
+// ReviewedByCleared returns if the "reviewed_by" field was cleared in this mutation.
+func (m *UsersMutation) ReviewedByCleared() bool {
+	_, ok := m.clearedFields[users.FieldReviewedBy]
 	return ok
 }
 
-// ResetVerificationToken resets all changes to the "verification_token" field.
-func (m *UsersMutation) ResetVerificationToken() {
-	m.verification_token = nil
-	delete(m.clearedFields, users.FieldVerificationToken)
+// ResetReviewedBy resets all changes to the "reviewed_by" field.
+func (m *UsersMutation) ResetReviewedBy() {
+	m.reviewed_by = nil
+	delete(m.clearedFields, users.FieldReviewedBy)
 }
 
-// SetVerificationTokenExpiry sets the "verification_token_expiry" field.
-func (m *UsersMutation) SetVerificationTokenExpiry(t time.Time) {
-	m.verification_token_expiry = &t
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *UsersMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
 }
 
-// VerificationTokenExpiry returns the value of the "verification_token_expiry" field in the mutation.
-func (m *UsersMutation) VerificationTokenExpiry() (r time.Time, exists bool) {
-	v := m.verification_token_expiry
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *UsersMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVerificationTokenExpiry returns the old "verification_token_expiry" field's value of the Users entity.
+// OldReviewedAt returns the old "reviewed_at" field's value of the Users entity.
 // If the Users object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersMutation) OldVerificationTokenExpiry(ctx context.Context) (v *time.Time, err error) {
+func (m *UsersMutation) OldReviewedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVerificationTokenExpiry is only allowed on UpdateOne operations")
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVerificationTokenExpiry requires an ID field in the mutation")
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVerificationTokenExpiry: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
 	}
-	return oldValue.VerificationTokenExpiry, nil
+	return oldValue.ReviewedAt, nil
 }
 
-// ClearVerificationTokenExpiry clears the value of the "verification_token_expiry" field.
-func (m *UsersMutation) ClearVerificationTokenExpiry() {
-	m.verification_token_expiry = nil
-	m.clearedFields[users.FieldVerificationTokenExpiry] = struct{}{}
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (m *UsersMutation) ClearReviewedAt() {
+	m.reviewed_at = nil
+	m.clearedFields[users.FieldReviewedAt] = struct{}{}
 }
 
-// VerificationTokenExpiryCleared returns if the "verification_token_expiry" field was cleared in this mutation.
-func (m *UsersMutation) VerificationTokenExpiryCleared() bool {
-	_, ok := m.clearedFields[users.FieldVerificationTokenExpiry]
+// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
+func (m *UsersMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[users.FieldReviewedAt]
 	return ok
 }
 
-// ResetVerificationTokenExpiry resets all changes to the "verification_token_expiry" field.
-func (m *UsersMutation) ResetVerificationTokenExpiry() {
-	m.verification_token_expiry = nil
-	delete(m.clearedFields, users.FieldVerificationTokenExpiry)
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *UsersMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+	delete(m.clearedFields, users.FieldReviewedAt)
 }
 
-// SetPasswordResetToken sets the "password_reset_token" field.
-func (m *UsersMutation) SetPasswordResetToken(s string) {
-	m.password_reset_token = &s
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (m *UsersMutation) SetDeletionRequestedAt(t time.Time) {
+	m.deletion_requested_at = &t
 }
 
-// PasswordResetToken returns the value of the "password_reset_token" field in the mutation.
-func (m *UsersMutation) PasswordResetToken() (r string, exists bool) {
-	v := m.password_reset_token
+// DeletionRequestedAt returns the value of the "deletion_requested_at" field in the mutation.
+func (m *UsersMutation) DeletionRequestedAt() (r time.Time, exists bool) {
+	v := m.deletion_requested_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPasswordResetToken returns the old "password_reset_token" field's value of the Users entity.
+// OldDeletionRequestedAt returns the old "deletion_requested_at" field's value of the Users entity.
 // If the Users object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersMutation) OldPasswordResetToken(ctx context.Context) (v *string, err error) {
+func (m *UsersMutation) OldDeletionRequestedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordResetToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletionRequestedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordResetToken requires an ID field in the mutation")
+		return v, errors.New("OldDeletionRequestedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordResetToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletionRequestedAt: %w", err)
 	}
-	return oldValue.PasswordResetToken, nil
+	return oldValue.DeletionRequestedAt, nil
 }
 
-// ClearPasswordResetToken clears the value of the "password_reset_token" field.
-func (m *UsersMutation) ClearPasswordResetToken() {
-	m.password_reset_token = nil
-	m.clearedFields[users.FieldPasswordResetToken] = struct{}{}
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (m *UsersMutation) ClearDeletionRequestedAt() {
+	m.deletion_requested_at = nil
+	m.clearedFields[users.FieldDeletionRequestedAt] = struct{}{}
 }
 
-// PasswordResetTokenCleared returns if the "password_reset_token" field was cleared in this mutation.
-func (m *UsersMutation) PasswordResetTokenCleared() bool {
-	_, ok := m.clearedFields[users.FieldPasswordResetToken]
+// DeletionRequestedAtCleared returns if the "deletion_requested_at" field was cleared in this mutation.
+func (m *UsersMutation) DeletionRequestedAtCleared() bool {
+	_, ok := m.clearedFields[users.FieldDeletionRequestedAt]
 	return ok
 }
 
-// ResetPasswordResetToken resets all changes to the "password_reset_token" field.
-func (m *UsersMutation) ResetPasswordResetToken() {
-	m.password_reset_token = nil
-	delete(m.clearedFields, users.FieldPasswordResetToken)
+// ResetDeletionRequestedAt resets all changes to the "deletion_requested_at" field.
+func (m *UsersMutation) ResetDeletionRequestedAt() {
+	m.deletion_requested_at = nil
+	delete(m.clearedFields, users.FieldDeletionRequestedAt)
 }
 
-// SetPasswordResetTokenExpiry sets the "password_reset_token_expiry" field.
-func (m *UsersMutation) SetPasswordResetTokenExpiry(t time.Time) {
-	m.password_reset_token_expiry = &t
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (m *UsersMutation) SetDeletionScheduledAt(t time.Time) {
+	m.deletion_scheduled_at = &t
 }
 
-// PasswordResetTokenExpiry returns the value of the "password_reset_token_expiry" field in the mutation.
-func (m *UsersMutation) PasswordResetTokenExpiry() (r time.Time, exists bool) {
-	v := m.password_reset_token_expiry
+// DeletionScheduledAt returns the value of the "deletion_scheduled_at" field in the mutation.
+func (m *UsersMutation) DeletionScheduledAt() (r time.Time, exists bool) {
+	v := m.deletion_scheduled_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPasswordResetTokenExpiry returns the old "password_reset_token_expiry" field's value of the Users entity.
+// OldDeletionScheduledAt returns the old "deletion_scheduled_at" field's value of the Users entity.
 // If the Users object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UsersMutation) OldPasswordResetTokenExpiry(ctx context.Context) (v *time.Time, err error) {
+func (m *UsersMutation) OldDeletionScheduledAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordResetTokenExpiry is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletionScheduledAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordResetTokenExpiry requires an ID field in the mutation")
+		return v, errors.New("OldDeletionScheduledAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordResetTokenExpiry: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletionScheduledAt: %w", err)
 	}
-	return oldValue.PasswordResetTokenExpiry, nil
+	return oldValue.DeletionScheduledAt, nil
 }
 
-// ClearPasswordResetTokenExpiry clears the value of the "password_reset_token_expiry" field.
-func (m *UsersMutation) ClearPasswordResetTokenExpiry() {
-	m.password_reset_token_expiry = nil
-	m.clearedFields[users.FieldPasswordResetTokenExpiry] = struct{}{}
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (m *UsersMutation) ClearDeletionScheduledAt() {
+	m.deletion_scheduled_at = nil
+	m.clearedFields[users.FieldDeletionScheduledAt] = struct{}{}
 }
 
-// PasswordResetTokenExpiryCleared returns if the "password_reset_token_expiry" field was cleared in this mutation.
-func (m *UsersMutation) PasswordResetTokenExpiryCleared() bool {
-	_, ok := m.clearedFields[users.FieldPasswordResetTokenExpiry]
+// DeletionScheduledAtCleared returns if the "deletion_scheduled_at" field was cleared in this mutation.
+func (m *UsersMutation) DeletionScheduledAtCleared() bool {
+	_, ok := m.clearedFields[users.FieldDeletionScheduledAt]
 	return ok
 }
 
-// ResetPasswordResetTokenExpiry resets all changes to the "password_reset_token_expiry" field.
-func (m *UsersMutation) ResetPasswordResetTokenExpiry() {
-	m.password_reset_token_expiry = nil
-	delete(m.clearedFields, users.FieldPasswordResetTokenExpiry)
+// ResetDeletionScheduledAt resets all changes to the "deletion_scheduled_at" field.
+func (m *UsersMutation) ResetDeletionScheduledAt() {
+	m.deletion_scheduled_at = nil
+	delete(m.clearedFields, users.FieldDeletionScheduledAt)
 }
 
 // SetMetadata sets the "metadata" field.
@@ -7120,6 +22524,168 @@ func (m *UsersMutation) ResetUserRoles() {
 	m.removeduser_roles = nil
 }
 
+// AddGroupMembershipIDs adds the "group_memberships" edge to the GroupMembers entity by ids.
+func (m *UsersMutation) AddGroupMembershipIDs(ids ...uuid.UUID) {
+	if m.group_memberships == nil {
+		m.group_memberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.group_memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGroupMemberships clears the "group_memberships" edge to the GroupMembers entity.
+func (m *UsersMutation) ClearGroupMemberships() {
+	m.clearedgroup_memberships = true
+}
+
+// GroupMembershipsCleared reports if the "group_memberships" edge to the GroupMembers entity was cleared.
+func (m *UsersMutation) GroupMembershipsCleared() bool {
+	return m.clearedgroup_memberships
+}
+
+// RemoveGroupMembershipIDs removes the "group_memberships" edge to the GroupMembers entity by IDs.
+func (m *UsersMutation) RemoveGroupMembershipIDs(ids ...uuid.UUID) {
+	if m.removedgroup_memberships == nil {
+		m.removedgroup_memberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.group_memberships, ids[i])
+		m.removedgroup_memberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroupMemberships returns the removed IDs of the "group_memberships" edge to the GroupMembers entity.
+func (m *UsersMutation) RemovedGroupMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgroup_memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupMembershipsIDs returns the "group_memberships" edge IDs in the mutation.
+func (m *UsersMutation) GroupMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.group_memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroupMemberships resets all changes to the "group_memberships" edge.
+func (m *UsersMutation) ResetGroupMemberships() {
+	m.group_memberships = nil
+	m.clearedgroup_memberships = false
+	m.removedgroup_memberships = nil
+}
+
+// AddOrgMembershipIDs adds the "org_memberships" edge to the OrgMembers entity by ids.
+func (m *UsersMutation) AddOrgMembershipIDs(ids ...uuid.UUID) {
+	if m.org_memberships == nil {
+		m.org_memberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.org_memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrgMemberships clears the "org_memberships" edge to the OrgMembers entity.
+func (m *UsersMutation) ClearOrgMemberships() {
+	m.clearedorg_memberships = true
+}
+
+// OrgMembershipsCleared reports if the "org_memberships" edge to the OrgMembers entity was cleared.
+func (m *UsersMutation) OrgMembershipsCleared() bool {
+	return m.clearedorg_memberships
+}
+
+// RemoveOrgMembershipIDs removes the "org_memberships" edge to the OrgMembers entity by IDs.
+func (m *UsersMutation) RemoveOrgMembershipIDs(ids ...uuid.UUID) {
+	if m.removedorg_memberships == nil {
+		m.removedorg_memberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.org_memberships, ids[i])
+		m.removedorg_memberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrgMemberships returns the removed IDs of the "org_memberships" edge to the OrgMembers entity.
+func (m *UsersMutation) RemovedOrgMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.removedorg_memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrgMembershipsIDs returns the "org_memberships" edge IDs in the mutation.
+func (m *UsersMutation) OrgMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.org_memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrgMemberships resets all changes to the "org_memberships" edge.
+func (m *UsersMutation) ResetOrgMemberships() {
+	m.org_memberships = nil
+	m.clearedorg_memberships = false
+	m.removedorg_memberships = nil
+}
+
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by ids.
+func (m *UsersMutation) AddOrgRoleIDs(ids ...int) {
+	if m.org_roles == nil {
+		m.org_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.org_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrgRoles clears the "org_roles" edge to the OrgRoles entity.
+func (m *UsersMutation) ClearOrgRoles() {
+	m.clearedorg_roles = true
+}
+
+// OrgRolesCleared reports if the "org_roles" edge to the OrgRoles entity was cleared.
+func (m *UsersMutation) OrgRolesCleared() bool {
+	return m.clearedorg_roles
+}
+
+// RemoveOrgRoleIDs removes the "org_roles" edge to the OrgRoles entity by IDs.
+func (m *UsersMutation) RemoveOrgRoleIDs(ids ...int) {
+	if m.removedorg_roles == nil {
+		m.removedorg_roles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.org_roles, ids[i])
+		m.removedorg_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrgRoles returns the removed IDs of the "org_roles" edge to the OrgRoles entity.
+func (m *UsersMutation) RemovedOrgRolesIDs() (ids []int) {
+	for id := range m.removedorg_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrgRolesIDs returns the "org_roles" edge IDs in the mutation.
+func (m *UsersMutation) OrgRolesIDs() (ids []int) {
+	for id := range m.org_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrgRoles resets all changes to the "org_roles" edge.
+func (m *UsersMutation) ResetOrgRoles() {
+	m.org_roles = nil
+	m.clearedorg_roles = false
+	m.removedorg_roles = nil
+}
+
 // Where appends a list predicates to the UsersMutation builder.
 func (m *UsersMutation) Where(ps ...predicate.Users) {
 	m.predicates = append(m.predicates, ps...)
@@ -7154,7 +22720,7 @@ func (m *UsersMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UsersMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+	fields := make([]string, 0, 20)
 	if m.email != nil {
 		fields = append(fields, users.FieldEmail)
 	}
@@ -7194,6 +22760,24 @@ func (m *UsersMutation) Fields() []string {
 	if m.password_reset_token_expiry != nil {
 		fields = append(fields, users.FieldPasswordResetTokenExpiry)
 	}
+	if m.password_reset_required != nil {
+		fields = append(fields, users.FieldPasswordResetRequired)
+	}
+	if m.approval_status != nil {
+		fields = append(fields, users.FieldApprovalStatus)
+	}
+	if m.reviewed_by != nil {
+		fields = append(fields, users.FieldReviewedBy)
+	}
+	if m.reviewed_at != nil {
+		fields = append(fields, users.FieldReviewedAt)
+	}
+	if m.deletion_requested_at != nil {
+		fields = append(fields, users.FieldDeletionRequestedAt)
+	}
+	if m.deletion_scheduled_at != nil {
+		fields = append(fields, users.FieldDeletionScheduledAt)
+	}
 	if m.metadata != nil {
 		fields = append(fields, users.FieldMetadata)
 	}
@@ -7231,6 +22815,18 @@ func (m *UsersMutation) Field(name string) (ent.Value, bool) {
 		return m.PasswordResetToken()
 	case users.FieldPasswordResetTokenExpiry:
 		return m.PasswordResetTokenExpiry()
+	case users.FieldPasswordResetRequired:
+		return m.PasswordResetRequired()
+	case users.FieldApprovalStatus:
+		return m.ApprovalStatus()
+	case users.FieldReviewedBy:
+		return m.ReviewedBy()
+	case users.FieldReviewedAt:
+		return m.ReviewedAt()
+	case users.FieldDeletionRequestedAt:
+		return m.DeletionRequestedAt()
+	case users.FieldDeletionScheduledAt:
+		return m.DeletionScheduledAt()
 	case users.FieldMetadata:
 		return m.Metadata()
 	}
@@ -7268,6 +22864,18 @@ func (m *UsersMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldPasswordResetToken(ctx)
 	case users.FieldPasswordResetTokenExpiry:
 		return m.OldPasswordResetTokenExpiry(ctx)
+	case users.FieldPasswordResetRequired:
+		return m.OldPasswordResetRequired(ctx)
+	case users.FieldApprovalStatus:
+		return m.OldApprovalStatus(ctx)
+	case users.FieldReviewedBy:
+		return m.OldReviewedBy(ctx)
+	case users.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
+	case users.FieldDeletionRequestedAt:
+		return m.OldDeletionRequestedAt(ctx)
+	case users.FieldDeletionScheduledAt:
+		return m.OldDeletionScheduledAt(ctx)
 	case users.FieldMetadata:
 		return m.OldMetadata(ctx)
 	}
@@ -7370,6 +22978,48 @@ func (m *UsersMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPasswordResetTokenExpiry(v)
 		return nil
+	case users.FieldPasswordResetRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordResetRequired(v)
+		return nil
+	case users.FieldApprovalStatus:
+		v, ok := value.(users.ApprovalStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovalStatus(v)
+		return nil
+	case users.FieldReviewedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedBy(v)
+		return nil
+	case users.FieldReviewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedAt(v)
+		return nil
+	case users.FieldDeletionRequestedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletionRequestedAt(v)
+		return nil
+	case users.FieldDeletionScheduledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletionScheduledAt(v)
+		return nil
 	case users.FieldMetadata:
 		v, ok := value.(map[string]interface{})
 		if !ok {
@@ -7422,6 +23072,18 @@ func (m *UsersMutation) ClearedFields() []string {
 	if m.FieldCleared(users.FieldPasswordResetTokenExpiry) {
 		fields = append(fields, users.FieldPasswordResetTokenExpiry)
 	}
+	if m.FieldCleared(users.FieldReviewedBy) {
+		fields = append(fields, users.FieldReviewedBy)
+	}
+	if m.FieldCleared(users.FieldReviewedAt) {
+		fields = append(fields, users.FieldReviewedAt)
+	}
+	if m.FieldCleared(users.FieldDeletionRequestedAt) {
+		fields = append(fields, users.FieldDeletionRequestedAt)
+	}
+	if m.FieldCleared(users.FieldDeletionScheduledAt) {
+		fields = append(fields, users.FieldDeletionScheduledAt)
+	}
 	if m.FieldCleared(users.FieldMetadata) {
 		fields = append(fields, users.FieldMetadata)
 	}
@@ -7454,6 +23116,18 @@ func (m *UsersMutation) ClearField(name string) error {
 	case users.FieldPasswordResetTokenExpiry:
 		m.ClearPasswordResetTokenExpiry()
 		return nil
+	case users.FieldReviewedBy:
+		m.ClearReviewedBy()
+		return nil
+	case users.FieldReviewedAt:
+		m.ClearReviewedAt()
+		return nil
+	case users.FieldDeletionRequestedAt:
+		m.ClearDeletionRequestedAt()
+		return nil
+	case users.FieldDeletionScheduledAt:
+		m.ClearDeletionScheduledAt()
+		return nil
 	case users.FieldMetadata:
 		m.ClearMetadata()
 		return nil
@@ -7504,6 +23178,24 @@ func (m *UsersMutation) ResetField(name string) error {
 	case users.FieldPasswordResetTokenExpiry:
 		m.ResetPasswordResetTokenExpiry()
 		return nil
+	case users.FieldPasswordResetRequired:
+		m.ResetPasswordResetRequired()
+		return nil
+	case users.FieldApprovalStatus:
+		m.ResetApprovalStatus()
+		return nil
+	case users.FieldReviewedBy:
+		m.ResetReviewedBy()
+		return nil
+	case users.FieldReviewedAt:
+		m.ResetReviewedAt()
+		return nil
+	case users.FieldDeletionRequestedAt:
+		m.ResetDeletionRequestedAt()
+		return nil
+	case users.FieldDeletionScheduledAt:
+		m.ResetDeletionScheduledAt()
+		return nil
 	case users.FieldMetadata:
 		m.ResetMetadata()
 		return nil
@@ -7513,10 +23205,19 @@ func (m *UsersMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UsersMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 4)
 	if m.user_roles != nil {
 		edges = append(edges, users.EdgeUserRoles)
 	}
+	if m.group_memberships != nil {
+		edges = append(edges, users.EdgeGroupMemberships)
+	}
+	if m.org_memberships != nil {
+		edges = append(edges, users.EdgeOrgMemberships)
+	}
+	if m.org_roles != nil {
+		edges = append(edges, users.EdgeOrgRoles)
+	}
 	return edges
 }
 
@@ -7530,16 +23231,43 @@ func (m *UsersMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case users.EdgeGroupMemberships:
+		ids := make([]ent.Value, 0, len(m.group_memberships))
+		for id := range m.group_memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case users.EdgeOrgMemberships:
+		ids := make([]ent.Value, 0, len(m.org_memberships))
+		for id := range m.org_memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case users.EdgeOrgRoles:
+		ids := make([]ent.Value, 0, len(m.org_roles))
+		for id := range m.org_roles {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UsersMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 4)
 	if m.removeduser_roles != nil {
 		edges = append(edges, users.EdgeUserRoles)
 	}
+	if m.removedgroup_memberships != nil {
+		edges = append(edges, users.EdgeGroupMemberships)
+	}
+	if m.removedorg_memberships != nil {
+		edges = append(edges, users.EdgeOrgMemberships)
+	}
+	if m.removedorg_roles != nil {
+		edges = append(edges, users.EdgeOrgRoles)
+	}
 	return edges
 }
 
@@ -7553,16 +23281,43 @@ func (m *UsersMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case users.EdgeGroupMemberships:
+		ids := make([]ent.Value, 0, len(m.removedgroup_memberships))
+		for id := range m.removedgroup_memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case users.EdgeOrgMemberships:
+		ids := make([]ent.Value, 0, len(m.removedorg_memberships))
+		for id := range m.removedorg_memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case users.EdgeOrgRoles:
+		ids := make([]ent.Value, 0, len(m.removedorg_roles))
+		for id := range m.removedorg_roles {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UsersMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 4)
 	if m.cleareduser_roles {
 		edges = append(edges, users.EdgeUserRoles)
 	}
+	if m.clearedgroup_memberships {
+		edges = append(edges, users.EdgeGroupMemberships)
+	}
+	if m.clearedorg_memberships {
+		edges = append(edges, users.EdgeOrgMemberships)
+	}
+	if m.clearedorg_roles {
+		edges = append(edges, users.EdgeOrgRoles)
+	}
 	return edges
 }
 
@@ -7572,6 +23327,12 @@ func (m *UsersMutation) EdgeCleared(name string) bool {
 	switch name {
 	case users.EdgeUserRoles:
 		return m.cleareduser_roles
+	case users.EdgeGroupMemberships:
+		return m.clearedgroup_memberships
+	case users.EdgeOrgMemberships:
+		return m.clearedorg_memberships
+	case users.EdgeOrgRoles:
+		return m.clearedorg_roles
 	}
 	return false
 }
@@ -7591,6 +23352,15 @@ func (m *UsersMutation) ResetEdge(name string) error {
 	case users.EdgeUserRoles:
 		m.ResetUserRoles()
 		return nil
+	case users.EdgeGroupMemberships:
+		m.ResetGroupMemberships()
+		return nil
+	case users.EdgeOrgMemberships:
+		m.ResetOrgMemberships()
+		return nil
+	case users.EdgeOrgRoles:
+		m.ResetOrgRoles()
+		return nil
 	}
 	return fmt.Errorf("unknown Users edge %s", name)
 }
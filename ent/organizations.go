@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/organizations"
+)
+
+// Organizations is the model entity for the Organizations schema.
+type Organizations struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Unique slug identifier (e.g., 'acme-corp')
+	Slug string `json:"slug,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Email domains members must belong to; empty allows any
+	AllowedDomains []string `json:"allowed_domains,omitempty"`
+	// Whether members must have completed MFA to act in the organization
+	MfaRequired bool `json:"mfa_required,omitempty"`
+	// User who created the organization
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrganizationsQuery when eager-loading is set.
+	Edges        OrganizationsEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrganizationsEdges holds the relations/edges for other nodes in the graph.
+type OrganizationsEdges struct {
+	// Members holds the value of the members edge.
+	Members []*OrgMembers `json:"members,omitempty"`
+	// OrgRoles holds the value of the org_roles edge.
+	OrgRoles []*OrgRoles `json:"org_roles,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationsEdges) MembersOrErr() ([]*OrgMembers, error) {
+	if e.loadedTypes[0] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// OrgRolesOrErr returns the OrgRoles value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationsEdges) OrgRolesOrErr() ([]*OrgRoles, error) {
+	if e.loadedTypes[1] {
+		return e.OrgRoles, nil
+	}
+	return nil, &NotLoadedError{edge: "org_roles"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Organizations) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case organizations.FieldCreatedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case organizations.FieldAllowedDomains:
+			values[i] = new([]byte)
+		case organizations.FieldMfaRequired:
+			values[i] = new(sql.NullBool)
+		case organizations.FieldSlug, organizations.FieldName:
+			values[i] = new(sql.NullString)
+		case organizations.FieldCreatedAt, organizations.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case organizations.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Organizations fields.
+func (o *Organizations) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case organizations.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				o.ID = *value
+			}
+		case organizations.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				o.Slug = value.String
+			}
+		case organizations.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				o.Name = value.String
+			}
+		case organizations.FieldAllowedDomains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_domains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.AllowedDomains); err != nil {
+					return fmt.Errorf("unmarshal field allowed_domains: %w", err)
+				}
+			}
+		case organizations.FieldMfaRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_required", values[i])
+			} else if value.Valid {
+				o.MfaRequired = value.Bool
+			}
+		case organizations.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				o.CreatedBy = new(uuid.UUID)
+				*o.CreatedBy = *value.S.(*uuid.UUID)
+			}
+		case organizations.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				o.CreatedAt = value.Time
+			}
+		case organizations.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				o.UpdatedAt = value.Time
+			}
+		default:
+			o.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Organizations.
+// This includes values selected through modifiers, order, etc.
+func (o *Organizations) Value(name string) (ent.Value, error) {
+	return o.selectValues.Get(name)
+}
+
+// QueryMembers queries the "members" edge of the Organizations entity.
+func (o *Organizations) QueryMembers() *OrgMembersQuery {
+	return NewOrganizationsClient(o.config).QueryMembers(o)
+}
+
+// QueryOrgRoles queries the "org_roles" edge of the Organizations entity.
+func (o *Organizations) QueryOrgRoles() *OrgRolesQuery {
+	return NewOrganizationsClient(o.config).QueryOrgRoles(o)
+}
+
+// Update returns a builder for updating this Organizations.
+// Note that you need to call Organizations.Unwrap() before calling this method if this Organizations
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (o *Organizations) Update() *OrganizationsUpdateOne {
+	return NewOrganizationsClient(o.config).UpdateOne(o)
+}
+
+// Unwrap unwraps the Organizations entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (o *Organizations) Unwrap() *Organizations {
+	_tx, ok := o.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Organizations is not a transactional entity")
+	}
+	o.config.driver = _tx.drv
+	return o
+}
+
+// String implements the fmt.Stringer.
+func (o *Organizations) String() string {
+	var builder strings.Builder
+	builder.WriteString("Organizations(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", o.ID))
+	builder.WriteString("slug=")
+	builder.WriteString(o.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(o.Name)
+	builder.WriteString(", ")
+	builder.WriteString("allowed_domains=")
+	builder.WriteString(fmt.Sprintf("%v", o.AllowedDomains))
+	builder.WriteString(", ")
+	builder.WriteString("mfa_required=")
+	builder.WriteString(fmt.Sprintf("%v", o.MfaRequired))
+	builder.WriteString(", ")
+	if v := o.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(o.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(o.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrganizationsSlice is a parsable slice of Organizations.
+type OrganizationsSlice []*Organizations
@@ -0,0 +1,945 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/orgroles"
+)
+
+// OrganizationsCreate is the builder for creating a Organizations entity.
+type OrganizationsCreate struct {
+	config
+	mutation *OrganizationsMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSlug sets the "slug" field.
+func (oc *OrganizationsCreate) SetSlug(s string) *OrganizationsCreate {
+	oc.mutation.SetSlug(s)
+	return oc
+}
+
+// SetName sets the "name" field.
+func (oc *OrganizationsCreate) SetName(s string) *OrganizationsCreate {
+	oc.mutation.SetName(s)
+	return oc
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (oc *OrganizationsCreate) SetAllowedDomains(s []string) *OrganizationsCreate {
+	oc.mutation.SetAllowedDomains(s)
+	return oc
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (oc *OrganizationsCreate) SetMfaRequired(b bool) *OrganizationsCreate {
+	oc.mutation.SetMfaRequired(b)
+	return oc
+}
+
+// SetNillableMfaRequired sets the "mfa_required" field if the given value is not nil.
+func (oc *OrganizationsCreate) SetNillableMfaRequired(b *bool) *OrganizationsCreate {
+	if b != nil {
+		oc.SetMfaRequired(*b)
+	}
+	return oc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (oc *OrganizationsCreate) SetCreatedBy(u uuid.UUID) *OrganizationsCreate {
+	oc.mutation.SetCreatedBy(u)
+	return oc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (oc *OrganizationsCreate) SetNillableCreatedBy(u *uuid.UUID) *OrganizationsCreate {
+	if u != nil {
+		oc.SetCreatedBy(*u)
+	}
+	return oc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oc *OrganizationsCreate) SetCreatedAt(t time.Time) *OrganizationsCreate {
+	oc.mutation.SetCreatedAt(t)
+	return oc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oc *OrganizationsCreate) SetNillableCreatedAt(t *time.Time) *OrganizationsCreate {
+	if t != nil {
+		oc.SetCreatedAt(*t)
+	}
+	return oc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oc *OrganizationsCreate) SetUpdatedAt(t time.Time) *OrganizationsCreate {
+	oc.mutation.SetUpdatedAt(t)
+	return oc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (oc *OrganizationsCreate) SetNillableUpdatedAt(t *time.Time) *OrganizationsCreate {
+	if t != nil {
+		oc.SetUpdatedAt(*t)
+	}
+	return oc
+}
+
+// SetID sets the "id" field.
+func (oc *OrganizationsCreate) SetID(u uuid.UUID) *OrganizationsCreate {
+	oc.mutation.SetID(u)
+	return oc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (oc *OrganizationsCreate) SetNillableID(u *uuid.UUID) *OrganizationsCreate {
+	if u != nil {
+		oc.SetID(*u)
+	}
+	return oc
+}
+
+// AddMemberIDs adds the "members" edge to the OrgMembers entity by IDs.
+func (oc *OrganizationsCreate) AddMemberIDs(ids ...uuid.UUID) *OrganizationsCreate {
+	oc.mutation.AddMemberIDs(ids...)
+	return oc
+}
+
+// AddMembers adds the "members" edges to the OrgMembers entity.
+func (oc *OrganizationsCreate) AddMembers(o ...*OrgMembers) *OrganizationsCreate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return oc.AddMemberIDs(ids...)
+}
+
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by IDs.
+func (oc *OrganizationsCreate) AddOrgRoleIDs(ids ...int) *OrganizationsCreate {
+	oc.mutation.AddOrgRoleIDs(ids...)
+	return oc
+}
+
+// AddOrgRoles adds the "org_roles" edges to the OrgRoles entity.
+func (oc *OrganizationsCreate) AddOrgRoles(o ...*OrgRoles) *OrganizationsCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return oc.AddOrgRoleIDs(ids...)
+}
+
+// Mutation returns the OrganizationsMutation object of the builder.
+func (oc *OrganizationsCreate) Mutation() *OrganizationsMutation {
+	return oc.mutation
+}
+
+// Save creates the Organizations in the database.
+func (oc *OrganizationsCreate) Save(ctx context.Context) (*Organizations, error) {
+	oc.defaults()
+	return withHooks(ctx, oc.sqlSave, oc.mutation, oc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oc *OrganizationsCreate) SaveX(ctx context.Context) *Organizations {
+	v, err := oc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oc *OrganizationsCreate) Exec(ctx context.Context) error {
+	_, err := oc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oc *OrganizationsCreate) ExecX(ctx context.Context) {
+	if err := oc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oc *OrganizationsCreate) defaults() {
+	if _, ok := oc.mutation.MfaRequired(); !ok {
+		v := organizations.DefaultMfaRequired
+		oc.mutation.SetMfaRequired(v)
+	}
+	if _, ok := oc.mutation.CreatedAt(); !ok {
+		v := organizations.DefaultCreatedAt()
+		oc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oc.mutation.UpdatedAt(); !ok {
+		v := organizations.DefaultUpdatedAt()
+		oc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := oc.mutation.ID(); !ok {
+		v := organizations.DefaultID()
+		oc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oc *OrganizationsCreate) check() error {
+	if _, ok := oc.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "Organizations.slug"`)}
+	}
+	if v, ok := oc.mutation.Slug(); ok {
+		if err := organizations.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Organizations.slug": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Organizations.name"`)}
+	}
+	if v, ok := oc.mutation.Name(); ok {
+		if err := organizations.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Organizations.name": %w`, err)}
+		}
+	}
+	if _, ok := oc.mutation.MfaRequired(); !ok {
+		return &ValidationError{Name: "mfa_required", err: errors.New(`ent: missing required field "Organizations.mfa_required"`)}
+	}
+	if _, ok := oc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Organizations.created_at"`)}
+	}
+	if _, ok := oc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Organizations.updated_at"`)}
+	}
+	return nil
+}
+
+func (oc *OrganizationsCreate) sqlSave(ctx context.Context) (*Organizations, error) {
+	if err := oc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	oc.mutation.id = &_node.ID
+	oc.mutation.done = true
+	return _node, nil
+}
+
+func (oc *OrganizationsCreate) createSpec() (*Organizations, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Organizations{config: oc.config}
+		_spec = sqlgraph.NewCreateSpec(organizations.Table, sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = oc.conflict
+	if id, ok := oc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := oc.mutation.Slug(); ok {
+		_spec.SetField(organizations.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := oc.mutation.Name(); ok {
+		_spec.SetField(organizations.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := oc.mutation.AllowedDomains(); ok {
+		_spec.SetField(organizations.FieldAllowedDomains, field.TypeJSON, value)
+		_node.AllowedDomains = value
+	}
+	if value, ok := oc.mutation.MfaRequired(); ok {
+		_spec.SetField(organizations.FieldMfaRequired, field.TypeBool, value)
+		_node.MfaRequired = value
+	}
+	if value, ok := oc.mutation.CreatedBy(); ok {
+		_spec.SetField(organizations.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := oc.mutation.CreatedAt(); ok {
+		_spec.SetField(organizations.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := oc.mutation.UpdatedAt(); ok {
+		_spec.SetField(organizations.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := oc.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.MembersTable,
+			Columns: []string{organizations.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.OrgRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.OrgRolesTable,
+			Columns: []string{organizations.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Organizations.Create().
+//		SetSlug(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrganizationsUpsert) {
+//			SetSlug(v+v).
+//		}).
+//		Exec(ctx)
+func (oc *OrganizationsCreate) OnConflict(opts ...sql.ConflictOption) *OrganizationsUpsertOne {
+	oc.conflict = opts
+	return &OrganizationsUpsertOne{
+		create: oc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Organizations.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (oc *OrganizationsCreate) OnConflictColumns(columns ...string) *OrganizationsUpsertOne {
+	oc.conflict = append(oc.conflict, sql.ConflictColumns(columns...))
+	return &OrganizationsUpsertOne{
+		create: oc,
+	}
+}
+
+type (
+	// OrganizationsUpsertOne is the builder for "upsert"-ing
+	//  one Organizations node.
+	OrganizationsUpsertOne struct {
+		create *OrganizationsCreate
+	}
+
+	// OrganizationsUpsert is the "OnConflict" setter.
+	OrganizationsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSlug sets the "slug" field.
+func (u *OrganizationsUpsert) SetSlug(v string) *OrganizationsUpsert {
+	u.Set(organizations.FieldSlug, v)
+	return u
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *OrganizationsUpsert) UpdateSlug() *OrganizationsUpsert {
+	u.SetExcluded(organizations.FieldSlug)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *OrganizationsUpsert) SetName(v string) *OrganizationsUpsert {
+	u.Set(organizations.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *OrganizationsUpsert) UpdateName() *OrganizationsUpsert {
+	u.SetExcluded(organizations.FieldName)
+	return u
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (u *OrganizationsUpsert) SetAllowedDomains(v []string) *OrganizationsUpsert {
+	u.Set(organizations.FieldAllowedDomains, v)
+	return u
+}
+
+// UpdateAllowedDomains sets the "allowed_domains" field to the value that was provided on create.
+func (u *OrganizationsUpsert) UpdateAllowedDomains() *OrganizationsUpsert {
+	u.SetExcluded(organizations.FieldAllowedDomains)
+	return u
+}
+
+// ClearAllowedDomains clears the value of the "allowed_domains" field.
+func (u *OrganizationsUpsert) ClearAllowedDomains() *OrganizationsUpsert {
+	u.SetNull(organizations.FieldAllowedDomains)
+	return u
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (u *OrganizationsUpsert) SetMfaRequired(v bool) *OrganizationsUpsert {
+	u.Set(organizations.FieldMfaRequired, v)
+	return u
+}
+
+// UpdateMfaRequired sets the "mfa_required" field to the value that was provided on create.
+func (u *OrganizationsUpsert) UpdateMfaRequired() *OrganizationsUpsert {
+	u.SetExcluded(organizations.FieldMfaRequired)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *OrganizationsUpsert) SetCreatedBy(v uuid.UUID) *OrganizationsUpsert {
+	u.Set(organizations.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *OrganizationsUpsert) UpdateCreatedBy() *OrganizationsUpsert {
+	u.SetExcluded(organizations.FieldCreatedBy)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *OrganizationsUpsert) ClearCreatedBy() *OrganizationsUpsert {
+	u.SetNull(organizations.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OrganizationsUpsert) SetUpdatedAt(v time.Time) *OrganizationsUpsert {
+	u.Set(organizations.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OrganizationsUpsert) UpdateUpdatedAt() *OrganizationsUpsert {
+	u.SetExcluded(organizations.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Organizations.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(organizations.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrganizationsUpsertOne) UpdateNewValues() *OrganizationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(organizations.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(organizations.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Organizations.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OrganizationsUpsertOne) Ignore() *OrganizationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrganizationsUpsertOne) DoNothing() *OrganizationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrganizationsCreate.OnConflict
+// documentation for more info.
+func (u *OrganizationsUpsertOne) Update(set func(*OrganizationsUpsert)) *OrganizationsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrganizationsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *OrganizationsUpsertOne) SetSlug(v string) *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *OrganizationsUpsertOne) UpdateSlug() *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *OrganizationsUpsertOne) SetName(v string) *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *OrganizationsUpsertOne) UpdateName() *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (u *OrganizationsUpsertOne) SetAllowedDomains(v []string) *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetAllowedDomains(v)
+	})
+}
+
+// UpdateAllowedDomains sets the "allowed_domains" field to the value that was provided on create.
+func (u *OrganizationsUpsertOne) UpdateAllowedDomains() *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateAllowedDomains()
+	})
+}
+
+// ClearAllowedDomains clears the value of the "allowed_domains" field.
+func (u *OrganizationsUpsertOne) ClearAllowedDomains() *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.ClearAllowedDomains()
+	})
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (u *OrganizationsUpsertOne) SetMfaRequired(v bool) *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetMfaRequired(v)
+	})
+}
+
+// UpdateMfaRequired sets the "mfa_required" field to the value that was provided on create.
+func (u *OrganizationsUpsertOne) UpdateMfaRequired() *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateMfaRequired()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *OrganizationsUpsertOne) SetCreatedBy(v uuid.UUID) *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *OrganizationsUpsertOne) UpdateCreatedBy() *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *OrganizationsUpsertOne) ClearCreatedBy() *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OrganizationsUpsertOne) SetUpdatedAt(v time.Time) *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OrganizationsUpsertOne) UpdateUpdatedAt() *OrganizationsUpsertOne {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OrganizationsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrganizationsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrganizationsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OrganizationsUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OrganizationsUpsertOne.ID is not supported by MySQL driver. Use OrganizationsUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OrganizationsUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OrganizationsCreateBulk is the builder for creating many Organizations entities in bulk.
+type OrganizationsCreateBulk struct {
+	config
+	err      error
+	builders []*OrganizationsCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Organizations entities in the database.
+func (ocb *OrganizationsCreateBulk) Save(ctx context.Context) ([]*Organizations, error) {
+	if ocb.err != nil {
+		return nil, ocb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ocb.builders))
+	nodes := make([]*Organizations, len(ocb.builders))
+	mutators := make([]Mutator, len(ocb.builders))
+	for i := range ocb.builders {
+		func(i int, root context.Context) {
+			builder := ocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrganizationsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ocb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocb *OrganizationsCreateBulk) SaveX(ctx context.Context) []*Organizations {
+	v, err := ocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ocb *OrganizationsCreateBulk) Exec(ctx context.Context) error {
+	_, err := ocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocb *OrganizationsCreateBulk) ExecX(ctx context.Context) {
+	if err := ocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Organizations.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrganizationsUpsert) {
+//			SetSlug(v+v).
+//		}).
+//		Exec(ctx)
+func (ocb *OrganizationsCreateBulk) OnConflict(opts ...sql.ConflictOption) *OrganizationsUpsertBulk {
+	ocb.conflict = opts
+	return &OrganizationsUpsertBulk{
+		create: ocb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Organizations.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ocb *OrganizationsCreateBulk) OnConflictColumns(columns ...string) *OrganizationsUpsertBulk {
+	ocb.conflict = append(ocb.conflict, sql.ConflictColumns(columns...))
+	return &OrganizationsUpsertBulk{
+		create: ocb,
+	}
+}
+
+// OrganizationsUpsertBulk is the builder for "upsert"-ing
+// a bulk of Organizations nodes.
+type OrganizationsUpsertBulk struct {
+	create *OrganizationsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Organizations.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(organizations.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrganizationsUpsertBulk) UpdateNewValues() *OrganizationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(organizations.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(organizations.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Organizations.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OrganizationsUpsertBulk) Ignore() *OrganizationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrganizationsUpsertBulk) DoNothing() *OrganizationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrganizationsCreateBulk.OnConflict
+// documentation for more info.
+func (u *OrganizationsUpsertBulk) Update(set func(*OrganizationsUpsert)) *OrganizationsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrganizationsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *OrganizationsUpsertBulk) SetSlug(v string) *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *OrganizationsUpsertBulk) UpdateSlug() *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *OrganizationsUpsertBulk) SetName(v string) *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *OrganizationsUpsertBulk) UpdateName() *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (u *OrganizationsUpsertBulk) SetAllowedDomains(v []string) *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetAllowedDomains(v)
+	})
+}
+
+// UpdateAllowedDomains sets the "allowed_domains" field to the value that was provided on create.
+func (u *OrganizationsUpsertBulk) UpdateAllowedDomains() *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateAllowedDomains()
+	})
+}
+
+// ClearAllowedDomains clears the value of the "allowed_domains" field.
+func (u *OrganizationsUpsertBulk) ClearAllowedDomains() *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.ClearAllowedDomains()
+	})
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (u *OrganizationsUpsertBulk) SetMfaRequired(v bool) *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetMfaRequired(v)
+	})
+}
+
+// UpdateMfaRequired sets the "mfa_required" field to the value that was provided on create.
+func (u *OrganizationsUpsertBulk) UpdateMfaRequired() *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateMfaRequired()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *OrganizationsUpsertBulk) SetCreatedBy(v uuid.UUID) *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *OrganizationsUpsertBulk) UpdateCreatedBy() *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *OrganizationsUpsertBulk) ClearCreatedBy() *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OrganizationsUpsertBulk) SetUpdatedAt(v time.Time) *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OrganizationsUpsertBulk) UpdateUpdatedAt() *OrganizationsUpsertBulk {
+	return u.Update(func(s *OrganizationsUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OrganizationsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OrganizationsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrganizationsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrganizationsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
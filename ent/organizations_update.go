@@ -0,0 +1,788 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// OrganizationsUpdate is the builder for updating Organizations entities.
+type OrganizationsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrganizationsMutation
+}
+
+// Where appends a list predicates to the OrganizationsUpdate builder.
+func (ou *OrganizationsUpdate) Where(ps ...predicate.Organizations) *OrganizationsUpdate {
+	ou.mutation.Where(ps...)
+	return ou
+}
+
+// SetSlug sets the "slug" field.
+func (ou *OrganizationsUpdate) SetSlug(s string) *OrganizationsUpdate {
+	ou.mutation.SetSlug(s)
+	return ou
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (ou *OrganizationsUpdate) SetNillableSlug(s *string) *OrganizationsUpdate {
+	if s != nil {
+		ou.SetSlug(*s)
+	}
+	return ou
+}
+
+// SetName sets the "name" field.
+func (ou *OrganizationsUpdate) SetName(s string) *OrganizationsUpdate {
+	ou.mutation.SetName(s)
+	return ou
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ou *OrganizationsUpdate) SetNillableName(s *string) *OrganizationsUpdate {
+	if s != nil {
+		ou.SetName(*s)
+	}
+	return ou
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (ou *OrganizationsUpdate) SetAllowedDomains(s []string) *OrganizationsUpdate {
+	ou.mutation.SetAllowedDomains(s)
+	return ou
+}
+
+// AppendAllowedDomains appends s to the "allowed_domains" field.
+func (ou *OrganizationsUpdate) AppendAllowedDomains(s []string) *OrganizationsUpdate {
+	ou.mutation.AppendAllowedDomains(s)
+	return ou
+}
+
+// ClearAllowedDomains clears the value of the "allowed_domains" field.
+func (ou *OrganizationsUpdate) ClearAllowedDomains() *OrganizationsUpdate {
+	ou.mutation.ClearAllowedDomains()
+	return ou
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (ou *OrganizationsUpdate) SetMfaRequired(b bool) *OrganizationsUpdate {
+	ou.mutation.SetMfaRequired(b)
+	return ou
+}
+
+// SetNillableMfaRequired sets the "mfa_required" field if the given value is not nil.
+func (ou *OrganizationsUpdate) SetNillableMfaRequired(b *bool) *OrganizationsUpdate {
+	if b != nil {
+		ou.SetMfaRequired(*b)
+	}
+	return ou
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ou *OrganizationsUpdate) SetCreatedBy(u uuid.UUID) *OrganizationsUpdate {
+	ou.mutation.SetCreatedBy(u)
+	return ou
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ou *OrganizationsUpdate) SetNillableCreatedBy(u *uuid.UUID) *OrganizationsUpdate {
+	if u != nil {
+		ou.SetCreatedBy(*u)
+	}
+	return ou
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (ou *OrganizationsUpdate) ClearCreatedBy() *OrganizationsUpdate {
+	ou.mutation.ClearCreatedBy()
+	return ou
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ou *OrganizationsUpdate) SetUpdatedAt(t time.Time) *OrganizationsUpdate {
+	ou.mutation.SetUpdatedAt(t)
+	return ou
+}
+
+// AddMemberIDs adds the "members" edge to the OrgMembers entity by IDs.
+func (ou *OrganizationsUpdate) AddMemberIDs(ids ...uuid.UUID) *OrganizationsUpdate {
+	ou.mutation.AddMemberIDs(ids...)
+	return ou
+}
+
+// AddMembers adds the "members" edges to the OrgMembers entity.
+func (ou *OrganizationsUpdate) AddMembers(o ...*OrgMembers) *OrganizationsUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.AddMemberIDs(ids...)
+}
+
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by IDs.
+func (ou *OrganizationsUpdate) AddOrgRoleIDs(ids ...int) *OrganizationsUpdate {
+	ou.mutation.AddOrgRoleIDs(ids...)
+	return ou
+}
+
+// AddOrgRoles adds the "org_roles" edges to the OrgRoles entity.
+func (ou *OrganizationsUpdate) AddOrgRoles(o ...*OrgRoles) *OrganizationsUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.AddOrgRoleIDs(ids...)
+}
+
+// Mutation returns the OrganizationsMutation object of the builder.
+func (ou *OrganizationsUpdate) Mutation() *OrganizationsMutation {
+	return ou.mutation
+}
+
+// ClearMembers clears all "members" edges to the OrgMembers entity.
+func (ou *OrganizationsUpdate) ClearMembers() *OrganizationsUpdate {
+	ou.mutation.ClearMembers()
+	return ou
+}
+
+// RemoveMemberIDs removes the "members" edge to OrgMembers entities by IDs.
+func (ou *OrganizationsUpdate) RemoveMemberIDs(ids ...uuid.UUID) *OrganizationsUpdate {
+	ou.mutation.RemoveMemberIDs(ids...)
+	return ou
+}
+
+// RemoveMembers removes "members" edges to OrgMembers entities.
+func (ou *OrganizationsUpdate) RemoveMembers(o ...*OrgMembers) *OrganizationsUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.RemoveMemberIDs(ids...)
+}
+
+// ClearOrgRoles clears all "org_roles" edges to the OrgRoles entity.
+func (ou *OrganizationsUpdate) ClearOrgRoles() *OrganizationsUpdate {
+	ou.mutation.ClearOrgRoles()
+	return ou
+}
+
+// RemoveOrgRoleIDs removes the "org_roles" edge to OrgRoles entities by IDs.
+func (ou *OrganizationsUpdate) RemoveOrgRoleIDs(ids ...int) *OrganizationsUpdate {
+	ou.mutation.RemoveOrgRoleIDs(ids...)
+	return ou
+}
+
+// RemoveOrgRoles removes "org_roles" edges to OrgRoles entities.
+func (ou *OrganizationsUpdate) RemoveOrgRoles(o ...*OrgRoles) *OrganizationsUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.RemoveOrgRoleIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ou *OrganizationsUpdate) Save(ctx context.Context) (int, error) {
+	ou.defaults()
+	return withHooks(ctx, ou.sqlSave, ou.mutation, ou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ou *OrganizationsUpdate) SaveX(ctx context.Context) int {
+	affected, err := ou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ou *OrganizationsUpdate) Exec(ctx context.Context) error {
+	_, err := ou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ou *OrganizationsUpdate) ExecX(ctx context.Context) {
+	if err := ou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ou *OrganizationsUpdate) defaults() {
+	if _, ok := ou.mutation.UpdatedAt(); !ok {
+		v := organizations.UpdateDefaultUpdatedAt()
+		ou.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ou *OrganizationsUpdate) check() error {
+	if v, ok := ou.mutation.Slug(); ok {
+		if err := organizations.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Organizations.slug": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.Name(); ok {
+		if err := organizations.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Organizations.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ou *OrganizationsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organizations.Table, organizations.Columns, sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID))
+	if ps := ou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ou.mutation.Slug(); ok {
+		_spec.SetField(organizations.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := ou.mutation.Name(); ok {
+		_spec.SetField(organizations.FieldName, field.TypeString, value)
+	}
+	if value, ok := ou.mutation.AllowedDomains(); ok {
+		_spec.SetField(organizations.FieldAllowedDomains, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedAllowedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, organizations.FieldAllowedDomains, value)
+		})
+	}
+	if ou.mutation.AllowedDomainsCleared() {
+		_spec.ClearField(organizations.FieldAllowedDomains, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.MfaRequired(); ok {
+		_spec.SetField(organizations.FieldMfaRequired, field.TypeBool, value)
+	}
+	if value, ok := ou.mutation.CreatedBy(); ok {
+		_spec.SetField(organizations.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if ou.mutation.CreatedByCleared() {
+		_spec.ClearField(organizations.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := ou.mutation.UpdatedAt(); ok {
+		_spec.SetField(organizations.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ou.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.MembersTable,
+			Columns: []string{organizations.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedMembersIDs(); len(nodes) > 0 && !ou.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.MembersTable,
+			Columns: []string{organizations.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.MembersTable,
+			Columns: []string{organizations.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.OrgRolesTable,
+			Columns: []string{organizations.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedOrgRolesIDs(); len(nodes) > 0 && !ou.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.OrgRolesTable,
+			Columns: []string{organizations.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.OrgRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.OrgRolesTable,
+			Columns: []string{organizations.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizations.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ou.mutation.done = true
+	return n, nil
+}
+
+// OrganizationsUpdateOne is the builder for updating a single Organizations entity.
+type OrganizationsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrganizationsMutation
+}
+
+// SetSlug sets the "slug" field.
+func (ouo *OrganizationsUpdateOne) SetSlug(s string) *OrganizationsUpdateOne {
+	ouo.mutation.SetSlug(s)
+	return ouo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (ouo *OrganizationsUpdateOne) SetNillableSlug(s *string) *OrganizationsUpdateOne {
+	if s != nil {
+		ouo.SetSlug(*s)
+	}
+	return ouo
+}
+
+// SetName sets the "name" field.
+func (ouo *OrganizationsUpdateOne) SetName(s string) *OrganizationsUpdateOne {
+	ouo.mutation.SetName(s)
+	return ouo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ouo *OrganizationsUpdateOne) SetNillableName(s *string) *OrganizationsUpdateOne {
+	if s != nil {
+		ouo.SetName(*s)
+	}
+	return ouo
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (ouo *OrganizationsUpdateOne) SetAllowedDomains(s []string) *OrganizationsUpdateOne {
+	ouo.mutation.SetAllowedDomains(s)
+	return ouo
+}
+
+// AppendAllowedDomains appends s to the "allowed_domains" field.
+func (ouo *OrganizationsUpdateOne) AppendAllowedDomains(s []string) *OrganizationsUpdateOne {
+	ouo.mutation.AppendAllowedDomains(s)
+	return ouo
+}
+
+// ClearAllowedDomains clears the value of the "allowed_domains" field.
+func (ouo *OrganizationsUpdateOne) ClearAllowedDomains() *OrganizationsUpdateOne {
+	ouo.mutation.ClearAllowedDomains()
+	return ouo
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (ouo *OrganizationsUpdateOne) SetMfaRequired(b bool) *OrganizationsUpdateOne {
+	ouo.mutation.SetMfaRequired(b)
+	return ouo
+}
+
+// SetNillableMfaRequired sets the "mfa_required" field if the given value is not nil.
+func (ouo *OrganizationsUpdateOne) SetNillableMfaRequired(b *bool) *OrganizationsUpdateOne {
+	if b != nil {
+		ouo.SetMfaRequired(*b)
+	}
+	return ouo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ouo *OrganizationsUpdateOne) SetCreatedBy(u uuid.UUID) *OrganizationsUpdateOne {
+	ouo.mutation.SetCreatedBy(u)
+	return ouo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ouo *OrganizationsUpdateOne) SetNillableCreatedBy(u *uuid.UUID) *OrganizationsUpdateOne {
+	if u != nil {
+		ouo.SetCreatedBy(*u)
+	}
+	return ouo
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (ouo *OrganizationsUpdateOne) ClearCreatedBy() *OrganizationsUpdateOne {
+	ouo.mutation.ClearCreatedBy()
+	return ouo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ouo *OrganizationsUpdateOne) SetUpdatedAt(t time.Time) *OrganizationsUpdateOne {
+	ouo.mutation.SetUpdatedAt(t)
+	return ouo
+}
+
+// AddMemberIDs adds the "members" edge to the OrgMembers entity by IDs.
+func (ouo *OrganizationsUpdateOne) AddMemberIDs(ids ...uuid.UUID) *OrganizationsUpdateOne {
+	ouo.mutation.AddMemberIDs(ids...)
+	return ouo
+}
+
+// AddMembers adds the "members" edges to the OrgMembers entity.
+func (ouo *OrganizationsUpdateOne) AddMembers(o ...*OrgMembers) *OrganizationsUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.AddMemberIDs(ids...)
+}
+
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by IDs.
+func (ouo *OrganizationsUpdateOne) AddOrgRoleIDs(ids ...int) *OrganizationsUpdateOne {
+	ouo.mutation.AddOrgRoleIDs(ids...)
+	return ouo
+}
+
+// AddOrgRoles adds the "org_roles" edges to the OrgRoles entity.
+func (ouo *OrganizationsUpdateOne) AddOrgRoles(o ...*OrgRoles) *OrganizationsUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.AddOrgRoleIDs(ids...)
+}
+
+// Mutation returns the OrganizationsMutation object of the builder.
+func (ouo *OrganizationsUpdateOne) Mutation() *OrganizationsMutation {
+	return ouo.mutation
+}
+
+// ClearMembers clears all "members" edges to the OrgMembers entity.
+func (ouo *OrganizationsUpdateOne) ClearMembers() *OrganizationsUpdateOne {
+	ouo.mutation.ClearMembers()
+	return ouo
+}
+
+// RemoveMemberIDs removes the "members" edge to OrgMembers entities by IDs.
+func (ouo *OrganizationsUpdateOne) RemoveMemberIDs(ids ...uuid.UUID) *OrganizationsUpdateOne {
+	ouo.mutation.RemoveMemberIDs(ids...)
+	return ouo
+}
+
+// RemoveMembers removes "members" edges to OrgMembers entities.
+func (ouo *OrganizationsUpdateOne) RemoveMembers(o ...*OrgMembers) *OrganizationsUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.RemoveMemberIDs(ids...)
+}
+
+// ClearOrgRoles clears all "org_roles" edges to the OrgRoles entity.
+func (ouo *OrganizationsUpdateOne) ClearOrgRoles() *OrganizationsUpdateOne {
+	ouo.mutation.ClearOrgRoles()
+	return ouo
+}
+
+// RemoveOrgRoleIDs removes the "org_roles" edge to OrgRoles entities by IDs.
+func (ouo *OrganizationsUpdateOne) RemoveOrgRoleIDs(ids ...int) *OrganizationsUpdateOne {
+	ouo.mutation.RemoveOrgRoleIDs(ids...)
+	return ouo
+}
+
+// RemoveOrgRoles removes "org_roles" edges to OrgRoles entities.
+func (ouo *OrganizationsUpdateOne) RemoveOrgRoles(o ...*OrgRoles) *OrganizationsUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.RemoveOrgRoleIDs(ids...)
+}
+
+// Where appends a list predicates to the OrganizationsUpdate builder.
+func (ouo *OrganizationsUpdateOne) Where(ps ...predicate.Organizations) *OrganizationsUpdateOne {
+	ouo.mutation.Where(ps...)
+	return ouo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ouo *OrganizationsUpdateOne) Select(field string, fields ...string) *OrganizationsUpdateOne {
+	ouo.fields = append([]string{field}, fields...)
+	return ouo
+}
+
+// Save executes the query and returns the updated Organizations entity.
+func (ouo *OrganizationsUpdateOne) Save(ctx context.Context) (*Organizations, error) {
+	ouo.defaults()
+	return withHooks(ctx, ouo.sqlSave, ouo.mutation, ouo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ouo *OrganizationsUpdateOne) SaveX(ctx context.Context) *Organizations {
+	node, err := ouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ouo *OrganizationsUpdateOne) Exec(ctx context.Context) error {
+	_, err := ouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ouo *OrganizationsUpdateOne) ExecX(ctx context.Context) {
+	if err := ouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ouo *OrganizationsUpdateOne) defaults() {
+	if _, ok := ouo.mutation.UpdatedAt(); !ok {
+		v := organizations.UpdateDefaultUpdatedAt()
+		ouo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ouo *OrganizationsUpdateOne) check() error {
+	if v, ok := ouo.mutation.Slug(); ok {
+		if err := organizations.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Organizations.slug": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.Name(); ok {
+		if err := organizations.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Organizations.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ouo *OrganizationsUpdateOne) sqlSave(ctx context.Context) (_node *Organizations, err error) {
+	if err := ouo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organizations.Table, organizations.Columns, sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID))
+	id, ok := ouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Organizations.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, organizations.FieldID)
+		for _, f := range fields {
+			if !organizations.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != organizations.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ouo.mutation.Slug(); ok {
+		_spec.SetField(organizations.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := ouo.mutation.Name(); ok {
+		_spec.SetField(organizations.FieldName, field.TypeString, value)
+	}
+	if value, ok := ouo.mutation.AllowedDomains(); ok {
+		_spec.SetField(organizations.FieldAllowedDomains, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedAllowedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, organizations.FieldAllowedDomains, value)
+		})
+	}
+	if ouo.mutation.AllowedDomainsCleared() {
+		_spec.ClearField(organizations.FieldAllowedDomains, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.MfaRequired(); ok {
+		_spec.SetField(organizations.FieldMfaRequired, field.TypeBool, value)
+	}
+	if value, ok := ouo.mutation.CreatedBy(); ok {
+		_spec.SetField(organizations.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if ouo.mutation.CreatedByCleared() {
+		_spec.ClearField(organizations.FieldCreatedBy, field.TypeUUID)
+	}
+	if value, ok := ouo.mutation.UpdatedAt(); ok {
+		_spec.SetField(organizations.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if ouo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.MembersTable,
+			Columns: []string{organizations.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedMembersIDs(); len(nodes) > 0 && !ouo.mutation.MembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.MembersTable,
+			Columns: []string{organizations.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.MembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.MembersTable,
+			Columns: []string{organizations.MembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.OrgRolesTable,
+			Columns: []string{organizations.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedOrgRolesIDs(); len(nodes) > 0 && !ouo.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.OrgRolesTable,
+			Columns: []string{organizations.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.OrgRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   organizations.OrgRolesTable,
+			Columns: []string{organizations.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Organizations{config: ouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizations.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ouo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// OrgMembers is the model entity for the OrgMembers schema.
+type OrgMembers struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID uuid.UUID `json:"org_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// User who added the member
+	AddedBy *uuid.UUID `json:"added_by,omitempty"`
+	// JoinedAt holds the value of the "joined_at" field.
+	JoinedAt time.Time `json:"joined_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrgMembersQuery when eager-loading is set.
+	Edges        OrgMembersEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrgMembersEdges holds the relations/edges for other nodes in the graph.
+type OrgMembersEdges struct {
+	// Org holds the value of the org edge.
+	Org *Organizations `json:"org,omitempty"`
+	// User holds the value of the user edge.
+	User *Users `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrgOrErr returns the Org value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrgMembersEdges) OrgOrErr() (*Organizations, error) {
+	if e.Org != nil {
+		return e.Org, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organizations.Label}
+	}
+	return nil, &NotLoadedError{edge: "org"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrgMembersEdges) UserOrErr() (*Users, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: users.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrgMembers) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orgmembers.FieldAddedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case orgmembers.FieldJoinedAt:
+			values[i] = new(sql.NullTime)
+		case orgmembers.FieldID, orgmembers.FieldOrgID, orgmembers.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrgMembers fields.
+func (om *OrgMembers) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orgmembers.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				om.ID = *value
+			}
+		case orgmembers.FieldOrgID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value != nil {
+				om.OrgID = *value
+			}
+		case orgmembers.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				om.UserID = *value
+			}
+		case orgmembers.FieldAddedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field added_by", values[i])
+			} else if value.Valid {
+				om.AddedBy = new(uuid.UUID)
+				*om.AddedBy = *value.S.(*uuid.UUID)
+			}
+		case orgmembers.FieldJoinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
+			} else if value.Valid {
+				om.JoinedAt = value.Time
+			}
+		default:
+			om.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrgMembers.
+// This includes values selected through modifiers, order, etc.
+func (om *OrgMembers) Value(name string) (ent.Value, error) {
+	return om.selectValues.Get(name)
+}
+
+// QueryOrg queries the "org" edge of the OrgMembers entity.
+func (om *OrgMembers) QueryOrg() *OrganizationsQuery {
+	return NewOrgMembersClient(om.config).QueryOrg(om)
+}
+
+// QueryUser queries the "user" edge of the OrgMembers entity.
+func (om *OrgMembers) QueryUser() *UsersQuery {
+	return NewOrgMembersClient(om.config).QueryUser(om)
+}
+
+// Update returns a builder for updating this OrgMembers.
+// Note that you need to call OrgMembers.Unwrap() before calling this method if this OrgMembers
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (om *OrgMembers) Update() *OrgMembersUpdateOne {
+	return NewOrgMembersClient(om.config).UpdateOne(om)
+}
+
+// Unwrap unwraps the OrgMembers entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (om *OrgMembers) Unwrap() *OrgMembers {
+	_tx, ok := om.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrgMembers is not a transactional entity")
+	}
+	om.config.driver = _tx.drv
+	return om
+}
+
+// String implements the fmt.Stringer.
+func (om *OrgMembers) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrgMembers(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", om.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", om.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", om.UserID))
+	builder.WriteString(", ")
+	if v := om.AddedBy; v != nil {
+		builder.WriteString("added_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("joined_at=")
+	builder.WriteString(om.JoinedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrgMembersSlice is a parsable slice of OrgMembers.
+type OrgMembersSlice []*OrgMembers
@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// OrgMembersCreate is the builder for creating a OrgMembers entity.
+type OrgMembersCreate struct {
+	config
+	mutation *OrgMembersMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (omc *OrgMembersCreate) SetOrgID(u uuid.UUID) *OrgMembersCreate {
+	omc.mutation.SetOrgID(u)
+	return omc
+}
+
+// SetUserID sets the "user_id" field.
+func (omc *OrgMembersCreate) SetUserID(u uuid.UUID) *OrgMembersCreate {
+	omc.mutation.SetUserID(u)
+	return omc
+}
+
+// SetAddedBy sets the "added_by" field.
+func (omc *OrgMembersCreate) SetAddedBy(u uuid.UUID) *OrgMembersCreate {
+	omc.mutation.SetAddedBy(u)
+	return omc
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (omc *OrgMembersCreate) SetNillableAddedBy(u *uuid.UUID) *OrgMembersCreate {
+	if u != nil {
+		omc.SetAddedBy(*u)
+	}
+	return omc
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (omc *OrgMembersCreate) SetJoinedAt(t time.Time) *OrgMembersCreate {
+	omc.mutation.SetJoinedAt(t)
+	return omc
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (omc *OrgMembersCreate) SetNillableJoinedAt(t *time.Time) *OrgMembersCreate {
+	if t != nil {
+		omc.SetJoinedAt(*t)
+	}
+	return omc
+}
+
+// SetID sets the "id" field.
+func (omc *OrgMembersCreate) SetID(u uuid.UUID) *OrgMembersCreate {
+	omc.mutation.SetID(u)
+	return omc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (omc *OrgMembersCreate) SetNillableID(u *uuid.UUID) *OrgMembersCreate {
+	if u != nil {
+		omc.SetID(*u)
+	}
+	return omc
+}
+
+// SetOrg sets the "org" edge to the Organizations entity.
+func (omc *OrgMembersCreate) SetOrg(o *Organizations) *OrgMembersCreate {
+	return omc.SetOrgID(o.ID)
+}
+
+// SetUser sets the "user" edge to the Users entity.
+func (omc *OrgMembersCreate) SetUser(u *Users) *OrgMembersCreate {
+	return omc.SetUserID(u.ID)
+}
+
+// Mutation returns the OrgMembersMutation object of the builder.
+func (omc *OrgMembersCreate) Mutation() *OrgMembersMutation {
+	return omc.mutation
+}
+
+// Save creates the OrgMembers in the database.
+func (omc *OrgMembersCreate) Save(ctx context.Context) (*OrgMembers, error) {
+	omc.defaults()
+	return withHooks(ctx, omc.sqlSave, omc.mutation, omc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (omc *OrgMembersCreate) SaveX(ctx context.Context) *OrgMembers {
+	v, err := omc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (omc *OrgMembersCreate) Exec(ctx context.Context) error {
+	_, err := omc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omc *OrgMembersCreate) ExecX(ctx context.Context) {
+	if err := omc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (omc *OrgMembersCreate) defaults() {
+	if _, ok := omc.mutation.JoinedAt(); !ok {
+		v := orgmembers.DefaultJoinedAt()
+		omc.mutation.SetJoinedAt(v)
+	}
+	if _, ok := omc.mutation.ID(); !ok {
+		v := orgmembers.DefaultID()
+		omc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (omc *OrgMembersCreate) check() error {
+	if _, ok := omc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "OrgMembers.org_id"`)}
+	}
+	if _, ok := omc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OrgMembers.user_id"`)}
+	}
+	if _, ok := omc.mutation.JoinedAt(); !ok {
+		return &ValidationError{Name: "joined_at", err: errors.New(`ent: missing required field "OrgMembers.joined_at"`)}
+	}
+	if _, ok := omc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org", err: errors.New(`ent: missing required edge "OrgMembers.org"`)}
+	}
+	if _, ok := omc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "OrgMembers.user"`)}
+	}
+	return nil
+}
+
+func (omc *OrgMembersCreate) sqlSave(ctx context.Context) (*OrgMembers, error) {
+	if err := omc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := omc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, omc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	omc.mutation.id = &_node.ID
+	omc.mutation.done = true
+	return _node, nil
+}
+
+func (omc *OrgMembersCreate) createSpec() (*OrgMembers, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrgMembers{config: omc.config}
+		_spec = sqlgraph.NewCreateSpec(orgmembers.Table, sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = omc.conflict
+	if id, ok := omc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := omc.mutation.AddedBy(); ok {
+		_spec.SetField(orgmembers.FieldAddedBy, field.TypeUUID, value)
+		_node.AddedBy = &value
+	}
+	if value, ok := omc.mutation.JoinedAt(); ok {
+		_spec.SetField(orgmembers.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = value
+	}
+	if nodes := omc.mutation.OrgIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembers.OrgTable,
+			Columns: []string{orgmembers.OrgColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrgID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := omc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembers.UserTable,
+			Columns: []string{orgmembers.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrgMembers.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrgMembersUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (omc *OrgMembersCreate) OnConflict(opts ...sql.ConflictOption) *OrgMembersUpsertOne {
+	omc.conflict = opts
+	return &OrgMembersUpsertOne{
+		create: omc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrgMembers.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (omc *OrgMembersCreate) OnConflictColumns(columns ...string) *OrgMembersUpsertOne {
+	omc.conflict = append(omc.conflict, sql.ConflictColumns(columns...))
+	return &OrgMembersUpsertOne{
+		create: omc,
+	}
+}
+
+type (
+	// OrgMembersUpsertOne is the builder for "upsert"-ing
+	//  one OrgMembers node.
+	OrgMembersUpsertOne struct {
+		create *OrgMembersCreate
+	}
+
+	// OrgMembersUpsert is the "OnConflict" setter.
+	OrgMembersUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *OrgMembersUpsert) SetOrgID(v uuid.UUID) *OrgMembersUpsert {
+	u.Set(orgmembers.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *OrgMembersUpsert) UpdateOrgID() *OrgMembersUpsert {
+	u.SetExcluded(orgmembers.FieldOrgID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *OrgMembersUpsert) SetUserID(v uuid.UUID) *OrgMembersUpsert {
+	u.Set(orgmembers.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *OrgMembersUpsert) UpdateUserID() *OrgMembersUpsert {
+	u.SetExcluded(orgmembers.FieldUserID)
+	return u
+}
+
+// SetAddedBy sets the "added_by" field.
+func (u *OrgMembersUpsert) SetAddedBy(v uuid.UUID) *OrgMembersUpsert {
+	u.Set(orgmembers.FieldAddedBy, v)
+	return u
+}
+
+// UpdateAddedBy sets the "added_by" field to the value that was provided on create.
+func (u *OrgMembersUpsert) UpdateAddedBy() *OrgMembersUpsert {
+	u.SetExcluded(orgmembers.FieldAddedBy)
+	return u
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (u *OrgMembersUpsert) ClearAddedBy() *OrgMembersUpsert {
+	u.SetNull(orgmembers.FieldAddedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OrgMembers.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(orgmembers.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrgMembersUpsertOne) UpdateNewValues() *OrgMembersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(orgmembers.FieldID)
+		}
+		if _, exists := u.create.mutation.JoinedAt(); exists {
+			s.SetIgnore(orgmembers.FieldJoinedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrgMembers.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OrgMembersUpsertOne) Ignore() *OrgMembersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrgMembersUpsertOne) DoNothing() *OrgMembersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrgMembersCreate.OnConflict
+// documentation for more info.
+func (u *OrgMembersUpsertOne) Update(set func(*OrgMembersUpsert)) *OrgMembersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrgMembersUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *OrgMembersUpsertOne) SetOrgID(v uuid.UUID) *OrgMembersUpsertOne {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *OrgMembersUpsertOne) UpdateOrgID() *OrgMembersUpsertOne {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *OrgMembersUpsertOne) SetUserID(v uuid.UUID) *OrgMembersUpsertOne {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *OrgMembersUpsertOne) UpdateUserID() *OrgMembersUpsertOne {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAddedBy sets the "added_by" field.
+func (u *OrgMembersUpsertOne) SetAddedBy(v uuid.UUID) *OrgMembersUpsertOne {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.SetAddedBy(v)
+	})
+}
+
+// UpdateAddedBy sets the "added_by" field to the value that was provided on create.
+func (u *OrgMembersUpsertOne) UpdateAddedBy() *OrgMembersUpsertOne {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.UpdateAddedBy()
+	})
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (u *OrgMembersUpsertOne) ClearAddedBy() *OrgMembersUpsertOne {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.ClearAddedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *OrgMembersUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrgMembersCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrgMembersUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OrgMembersUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OrgMembersUpsertOne.ID is not supported by MySQL driver. Use OrgMembersUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OrgMembersUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OrgMembersCreateBulk is the builder for creating many OrgMembers entities in bulk.
+type OrgMembersCreateBulk struct {
+	config
+	err      error
+	builders []*OrgMembersCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OrgMembers entities in the database.
+func (omcb *OrgMembersCreateBulk) Save(ctx context.Context) ([]*OrgMembers, error) {
+	if omcb.err != nil {
+		return nil, omcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(omcb.builders))
+	nodes := make([]*OrgMembers, len(omcb.builders))
+	mutators := make([]Mutator, len(omcb.builders))
+	for i := range omcb.builders {
+		func(i int, root context.Context) {
+			builder := omcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrgMembersMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, omcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = omcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, omcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, omcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (omcb *OrgMembersCreateBulk) SaveX(ctx context.Context) []*OrgMembers {
+	v, err := omcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (omcb *OrgMembersCreateBulk) Exec(ctx context.Context) error {
+	_, err := omcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omcb *OrgMembersCreateBulk) ExecX(ctx context.Context) {
+	if err := omcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrgMembers.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrgMembersUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (omcb *OrgMembersCreateBulk) OnConflict(opts ...sql.ConflictOption) *OrgMembersUpsertBulk {
+	omcb.conflict = opts
+	return &OrgMembersUpsertBulk{
+		create: omcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrgMembers.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (omcb *OrgMembersCreateBulk) OnConflictColumns(columns ...string) *OrgMembersUpsertBulk {
+	omcb.conflict = append(omcb.conflict, sql.ConflictColumns(columns...))
+	return &OrgMembersUpsertBulk{
+		create: omcb,
+	}
+}
+
+// OrgMembersUpsertBulk is the builder for "upsert"-ing
+// a bulk of OrgMembers nodes.
+type OrgMembersUpsertBulk struct {
+	create *OrgMembersCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OrgMembers.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(orgmembers.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrgMembersUpsertBulk) UpdateNewValues() *OrgMembersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(orgmembers.FieldID)
+			}
+			if _, exists := b.mutation.JoinedAt(); exists {
+				s.SetIgnore(orgmembers.FieldJoinedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrgMembers.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OrgMembersUpsertBulk) Ignore() *OrgMembersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrgMembersUpsertBulk) DoNothing() *OrgMembersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrgMembersCreateBulk.OnConflict
+// documentation for more info.
+func (u *OrgMembersUpsertBulk) Update(set func(*OrgMembersUpsert)) *OrgMembersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrgMembersUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *OrgMembersUpsertBulk) SetOrgID(v uuid.UUID) *OrgMembersUpsertBulk {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *OrgMembersUpsertBulk) UpdateOrgID() *OrgMembersUpsertBulk {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *OrgMembersUpsertBulk) SetUserID(v uuid.UUID) *OrgMembersUpsertBulk {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *OrgMembersUpsertBulk) UpdateUserID() *OrgMembersUpsertBulk {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAddedBy sets the "added_by" field.
+func (u *OrgMembersUpsertBulk) SetAddedBy(v uuid.UUID) *OrgMembersUpsertBulk {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.SetAddedBy(v)
+	})
+}
+
+// UpdateAddedBy sets the "added_by" field to the value that was provided on create.
+func (u *OrgMembersUpsertBulk) UpdateAddedBy() *OrgMembersUpsertBulk {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.UpdateAddedBy()
+	})
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (u *OrgMembersUpsertBulk) ClearAddedBy() *OrgMembersUpsertBulk {
+	return u.Update(func(s *OrgMembersUpsert) {
+		s.ClearAddedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *OrgMembersUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OrgMembersCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrgMembersCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrgMembersUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
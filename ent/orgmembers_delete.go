@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// OrgMembersDelete is the builder for deleting a OrgMembers entity.
+type OrgMembersDelete struct {
+	config
+	hooks    []Hook
+	mutation *OrgMembersMutation
+}
+
+// Where appends a list predicates to the OrgMembersDelete builder.
+func (omd *OrgMembersDelete) Where(ps ...predicate.OrgMembers) *OrgMembersDelete {
+	omd.mutation.Where(ps...)
+	return omd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (omd *OrgMembersDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, omd.sqlExec, omd.mutation, omd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omd *OrgMembersDelete) ExecX(ctx context.Context) int {
+	n, err := omd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (omd *OrgMembersDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(orgmembers.Table, sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID))
+	if ps := omd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, omd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	omd.mutation.done = true
+	return affected, err
+}
+
+// OrgMembersDeleteOne is the builder for deleting a single OrgMembers entity.
+type OrgMembersDeleteOne struct {
+	omd *OrgMembersDelete
+}
+
+// Where appends a list predicates to the OrgMembersDelete builder.
+func (omdo *OrgMembersDeleteOne) Where(ps ...predicate.OrgMembers) *OrgMembersDeleteOne {
+	omdo.omd.mutation.Where(ps...)
+	return omdo
+}
+
+// Exec executes the deletion query.
+func (omdo *OrgMembersDeleteOne) Exec(ctx context.Context) error {
+	n, err := omdo.omd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{orgmembers.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omdo *OrgMembersDeleteOne) ExecX(ctx context.Context) {
+	if err := omdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// OrgMembersQuery is the builder for querying OrgMembers entities.
+type OrgMembersQuery struct {
+	config
+	ctx        *QueryContext
+	order      []orgmembers.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OrgMembers
+	withOrg    *OrganizationsQuery
+	withUser   *UsersQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OrgMembersQuery builder.
+func (omq *OrgMembersQuery) Where(ps ...predicate.OrgMembers) *OrgMembersQuery {
+	omq.predicates = append(omq.predicates, ps...)
+	return omq
+}
+
+// Limit the number of records to be returned by this query.
+func (omq *OrgMembersQuery) Limit(limit int) *OrgMembersQuery {
+	omq.ctx.Limit = &limit
+	return omq
+}
+
+// Offset to start from.
+func (omq *OrgMembersQuery) Offset(offset int) *OrgMembersQuery {
+	omq.ctx.Offset = &offset
+	return omq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (omq *OrgMembersQuery) Unique(unique bool) *OrgMembersQuery {
+	omq.ctx.Unique = &unique
+	return omq
+}
+
+// Order specifies how the records should be ordered.
+func (omq *OrgMembersQuery) Order(o ...orgmembers.OrderOption) *OrgMembersQuery {
+	omq.order = append(omq.order, o...)
+	return omq
+}
+
+// QueryOrg chains the current query on the "org" edge.
+func (omq *OrgMembersQuery) QueryOrg() *OrganizationsQuery {
+	query := (&OrganizationsClient{config: omq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := omq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := omq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgmembers.Table, orgmembers.FieldID, selector),
+			sqlgraph.To(organizations.Table, organizations.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgmembers.OrgTable, orgmembers.OrgColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(omq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (omq *OrgMembersQuery) QueryUser() *UsersQuery {
+	query := (&UsersClient{config: omq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := omq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := omq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgmembers.Table, orgmembers.FieldID, selector),
+			sqlgraph.To(users.Table, users.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgmembers.UserTable, orgmembers.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(omq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OrgMembers entity from the query.
+// Returns a *NotFoundError when no OrgMembers was found.
+func (omq *OrgMembersQuery) First(ctx context.Context) (*OrgMembers, error) {
+	nodes, err := omq.Limit(1).All(setContextOp(ctx, omq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{orgmembers.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (omq *OrgMembersQuery) FirstX(ctx context.Context) *OrgMembers {
+	node, err := omq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OrgMembers ID from the query.
+// Returns a *NotFoundError when no OrgMembers ID was found.
+func (omq *OrgMembersQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = omq.Limit(1).IDs(setContextOp(ctx, omq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{orgmembers.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (omq *OrgMembersQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := omq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OrgMembers entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OrgMembers entity is found.
+// Returns a *NotFoundError when no OrgMembers entities are found.
+func (omq *OrgMembersQuery) Only(ctx context.Context) (*OrgMembers, error) {
+	nodes, err := omq.Limit(2).All(setContextOp(ctx, omq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{orgmembers.Label}
+	default:
+		return nil, &NotSingularError{orgmembers.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (omq *OrgMembersQuery) OnlyX(ctx context.Context) *OrgMembers {
+	node, err := omq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OrgMembers ID in the query.
+// Returns a *NotSingularError when more than one OrgMembers ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (omq *OrgMembersQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = omq.Limit(2).IDs(setContextOp(ctx, omq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{orgmembers.Label}
+	default:
+		err = &NotSingularError{orgmembers.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (omq *OrgMembersQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := omq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OrgMembersSlice.
+func (omq *OrgMembersQuery) All(ctx context.Context) ([]*OrgMembers, error) {
+	ctx = setContextOp(ctx, omq.ctx, "All")
+	if err := omq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OrgMembers, *OrgMembersQuery]()
+	return withInterceptors[[]*OrgMembers](ctx, omq, qr, omq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (omq *OrgMembersQuery) AllX(ctx context.Context) []*OrgMembers {
+	nodes, err := omq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OrgMembers IDs.
+func (omq *OrgMembersQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if omq.ctx.Unique == nil && omq.path != nil {
+		omq.Unique(true)
+	}
+	ctx = setContextOp(ctx, omq.ctx, "IDs")
+	if err = omq.Select(orgmembers.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (omq *OrgMembersQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := omq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (omq *OrgMembersQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, omq.ctx, "Count")
+	if err := omq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, omq, querierCount[*OrgMembersQuery](), omq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (omq *OrgMembersQuery) CountX(ctx context.Context) int {
+	count, err := omq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (omq *OrgMembersQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, omq.ctx, "Exist")
+	switch _, err := omq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (omq *OrgMembersQuery) ExistX(ctx context.Context) bool {
+	exist, err := omq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OrgMembersQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (omq *OrgMembersQuery) Clone() *OrgMembersQuery {
+	if omq == nil {
+		return nil
+	}
+	return &OrgMembersQuery{
+		config:     omq.config,
+		ctx:        omq.ctx.Clone(),
+		order:      append([]orgmembers.OrderOption{}, omq.order...),
+		inters:     append([]Interceptor{}, omq.inters...),
+		predicates: append([]predicate.OrgMembers{}, omq.predicates...),
+		withOrg:    omq.withOrg.Clone(),
+		withUser:   omq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  omq.sql.Clone(),
+		path: omq.path,
+	}
+}
+
+// WithOrg tells the query-builder to eager-load the nodes that are connected to
+// the "org" edge. The optional arguments are used to configure the query builder of the edge.
+func (omq *OrgMembersQuery) WithOrg(opts ...func(*OrganizationsQuery)) *OrgMembersQuery {
+	query := (&OrganizationsClient{config: omq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	omq.withOrg = query
+	return omq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (omq *OrgMembersQuery) WithUser(opts ...func(*UsersQuery)) *OrgMembersQuery {
+	query := (&UsersClient{config: omq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	omq.withUser = query
+	return omq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OrgMembers.Query().
+//		GroupBy(orgmembers.FieldOrgID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (omq *OrgMembersQuery) GroupBy(field string, fields ...string) *OrgMembersGroupBy {
+	omq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OrgMembersGroupBy{build: omq}
+	grbuild.flds = &omq.ctx.Fields
+	grbuild.label = orgmembers.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
+//	}
+//
+//	client.OrgMembers.Query().
+//		Select(orgmembers.FieldOrgID).
+//		Scan(ctx, &v)
+func (omq *OrgMembersQuery) Select(fields ...string) *OrgMembersSelect {
+	omq.ctx.Fields = append(omq.ctx.Fields, fields...)
+	sbuild := &OrgMembersSelect{OrgMembersQuery: omq}
+	sbuild.label = orgmembers.Label
+	sbuild.flds, sbuild.scan = &omq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OrgMembersSelect configured with the given aggregations.
+func (omq *OrgMembersQuery) Aggregate(fns ...AggregateFunc) *OrgMembersSelect {
+	return omq.Select().Aggregate(fns...)
+}
+
+func (omq *OrgMembersQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range omq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, omq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range omq.ctx.Fields {
+		if !orgmembers.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if omq.path != nil {
+		prev, err := omq.path(ctx)
+		if err != nil {
+			return err
+		}
+		omq.sql = prev
+	}
+	return nil
+}
+
+func (omq *OrgMembersQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OrgMembers, error) {
+	var (
+		nodes       = []*OrgMembers{}
+		_spec       = omq.querySpec()
+		loadedTypes = [2]bool{
+			omq.withOrg != nil,
+			omq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OrgMembers).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OrgMembers{config: omq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(omq.modifiers) > 0 {
+		_spec.Modifiers = omq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, omq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := omq.withOrg; query != nil {
+		if err := omq.loadOrg(ctx, query, nodes, nil,
+			func(n *OrgMembers, e *Organizations) { n.Edges.Org = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := omq.withUser; query != nil {
+		if err := omq.loadUser(ctx, query, nodes, nil,
+			func(n *OrgMembers, e *Users) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (omq *OrgMembersQuery) loadOrg(ctx context.Context, query *OrganizationsQuery, nodes []*OrgMembers, init func(*OrgMembers), assign func(*OrgMembers, *Organizations)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*OrgMembers)
+	for i := range nodes {
+		fk := nodes[i].OrgID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organizations.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "org_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (omq *OrgMembersQuery) loadUser(ctx context.Context, query *UsersQuery, nodes []*OrgMembers, init func(*OrgMembers), assign func(*OrgMembers, *Users)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*OrgMembers)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(users.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (omq *OrgMembersQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := omq.querySpec()
+	if len(omq.modifiers) > 0 {
+		_spec.Modifiers = omq.modifiers
+	}
+	_spec.Node.Columns = omq.ctx.Fields
+	if len(omq.ctx.Fields) > 0 {
+		_spec.Unique = omq.ctx.Unique != nil && *omq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, omq.driver, _spec)
+}
+
+func (omq *OrgMembersQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(orgmembers.Table, orgmembers.Columns, sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID))
+	_spec.From = omq.sql
+	if unique := omq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if omq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := omq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orgmembers.FieldID)
+		for i := range fields {
+			if fields[i] != orgmembers.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if omq.withOrg != nil {
+			_spec.Node.AddColumnOnce(orgmembers.FieldOrgID)
+		}
+		if omq.withUser != nil {
+			_spec.Node.AddColumnOnce(orgmembers.FieldUserID)
+		}
+	}
+	if ps := omq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := omq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := omq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := omq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (omq *OrgMembersQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(omq.driver.Dialect())
+	t1 := builder.Table(orgmembers.Table)
+	columns := omq.ctx.Fields
+	if len(columns) == 0 {
+		columns = orgmembers.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if omq.sql != nil {
+		selector = omq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if omq.ctx.Unique != nil && *omq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range omq.modifiers {
+		m(selector)
+	}
+	for _, p := range omq.predicates {
+		p(selector)
+	}
+	for _, p := range omq.order {
+		p(selector)
+	}
+	if offset := omq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := omq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (omq *OrgMembersQuery) ForUpdate(opts ...sql.LockOption) *OrgMembersQuery {
+	if omq.driver.Dialect() == dialect.Postgres {
+		omq.Unique(false)
+	}
+	omq.modifiers = append(omq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return omq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (omq *OrgMembersQuery) ForShare(opts ...sql.LockOption) *OrgMembersQuery {
+	if omq.driver.Dialect() == dialect.Postgres {
+		omq.Unique(false)
+	}
+	omq.modifiers = append(omq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return omq
+}
+
+// OrgMembersGroupBy is the group-by builder for OrgMembers entities.
+type OrgMembersGroupBy struct {
+	selector
+	build *OrgMembersQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (omgb *OrgMembersGroupBy) Aggregate(fns ...AggregateFunc) *OrgMembersGroupBy {
+	omgb.fns = append(omgb.fns, fns...)
+	return omgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (omgb *OrgMembersGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, omgb.build.ctx, "GroupBy")
+	if err := omgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrgMembersQuery, *OrgMembersGroupBy](ctx, omgb.build, omgb, omgb.build.inters, v)
+}
+
+func (omgb *OrgMembersGroupBy) sqlScan(ctx context.Context, root *OrgMembersQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(omgb.fns))
+	for _, fn := range omgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*omgb.flds)+len(omgb.fns))
+		for _, f := range *omgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*omgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := omgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OrgMembersSelect is the builder for selecting fields of OrgMembers entities.
+type OrgMembersSelect struct {
+	*OrgMembersQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (oms *OrgMembersSelect) Aggregate(fns ...AggregateFunc) *OrgMembersSelect {
+	oms.fns = append(oms.fns, fns...)
+	return oms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oms *OrgMembersSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oms.ctx, "Select")
+	if err := oms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrgMembersQuery, *OrgMembersSelect](ctx, oms.OrgMembersQuery, oms, oms.inters, v)
+}
+
+func (oms *OrgMembersSelect) sqlScan(ctx context.Context, root *OrgMembersQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(oms.fns))
+	for _, fn := range oms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*oms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// OrgMembersUpdate is the builder for updating OrgMembers entities.
+type OrgMembersUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrgMembersMutation
+}
+
+// Where appends a list predicates to the OrgMembersUpdate builder.
+func (omu *OrgMembersUpdate) Where(ps ...predicate.OrgMembers) *OrgMembersUpdate {
+	omu.mutation.Where(ps...)
+	return omu
+}
+
+// SetOrgID sets the "org_id" field.
+func (omu *OrgMembersUpdate) SetOrgID(u uuid.UUID) *OrgMembersUpdate {
+	omu.mutation.SetOrgID(u)
+	return omu
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (omu *OrgMembersUpdate) SetNillableOrgID(u *uuid.UUID) *OrgMembersUpdate {
+	if u != nil {
+		omu.SetOrgID(*u)
+	}
+	return omu
+}
+
+// SetUserID sets the "user_id" field.
+func (omu *OrgMembersUpdate) SetUserID(u uuid.UUID) *OrgMembersUpdate {
+	omu.mutation.SetUserID(u)
+	return omu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (omu *OrgMembersUpdate) SetNillableUserID(u *uuid.UUID) *OrgMembersUpdate {
+	if u != nil {
+		omu.SetUserID(*u)
+	}
+	return omu
+}
+
+// SetAddedBy sets the "added_by" field.
+func (omu *OrgMembersUpdate) SetAddedBy(u uuid.UUID) *OrgMembersUpdate {
+	omu.mutation.SetAddedBy(u)
+	return omu
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (omu *OrgMembersUpdate) SetNillableAddedBy(u *uuid.UUID) *OrgMembersUpdate {
+	if u != nil {
+		omu.SetAddedBy(*u)
+	}
+	return omu
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (omu *OrgMembersUpdate) ClearAddedBy() *OrgMembersUpdate {
+	omu.mutation.ClearAddedBy()
+	return omu
+}
+
+// SetOrg sets the "org" edge to the Organizations entity.
+func (omu *OrgMembersUpdate) SetOrg(o *Organizations) *OrgMembersUpdate {
+	return omu.SetOrgID(o.ID)
+}
+
+// SetUser sets the "user" edge to the Users entity.
+func (omu *OrgMembersUpdate) SetUser(u *Users) *OrgMembersUpdate {
+	return omu.SetUserID(u.ID)
+}
+
+// Mutation returns the OrgMembersMutation object of the builder.
+func (omu *OrgMembersUpdate) Mutation() *OrgMembersMutation {
+	return omu.mutation
+}
+
+// ClearOrg clears the "org" edge to the Organizations entity.
+func (omu *OrgMembersUpdate) ClearOrg() *OrgMembersUpdate {
+	omu.mutation.ClearOrg()
+	return omu
+}
+
+// ClearUser clears the "user" edge to the Users entity.
+func (omu *OrgMembersUpdate) ClearUser() *OrgMembersUpdate {
+	omu.mutation.ClearUser()
+	return omu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (omu *OrgMembersUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, omu.sqlSave, omu.mutation, omu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (omu *OrgMembersUpdate) SaveX(ctx context.Context) int {
+	affected, err := omu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (omu *OrgMembersUpdate) Exec(ctx context.Context) error {
+	_, err := omu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omu *OrgMembersUpdate) ExecX(ctx context.Context) {
+	if err := omu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (omu *OrgMembersUpdate) check() error {
+	if _, ok := omu.mutation.OrgID(); omu.mutation.OrgCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrgMembers.org"`)
+	}
+	if _, ok := omu.mutation.UserID(); omu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrgMembers.user"`)
+	}
+	return nil
+}
+
+func (omu *OrgMembersUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := omu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orgmembers.Table, orgmembers.Columns, sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID))
+	if ps := omu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := omu.mutation.AddedBy(); ok {
+		_spec.SetField(orgmembers.FieldAddedBy, field.TypeUUID, value)
+	}
+	if omu.mutation.AddedByCleared() {
+		_spec.ClearField(orgmembers.FieldAddedBy, field.TypeUUID)
+	}
+	if omu.mutation.OrgCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembers.OrgTable,
+			Columns: []string{orgmembers.OrgColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := omu.mutation.OrgIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembers.OrgTable,
+			Columns: []string{orgmembers.OrgColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if omu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembers.UserTable,
+			Columns: []string{orgmembers.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := omu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembers.UserTable,
+			Columns: []string{orgmembers.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, omu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orgmembers.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	omu.mutation.done = true
+	return n, nil
+}
+
+// OrgMembersUpdateOne is the builder for updating a single OrgMembers entity.
+type OrgMembersUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrgMembersMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (omuo *OrgMembersUpdateOne) SetOrgID(u uuid.UUID) *OrgMembersUpdateOne {
+	omuo.mutation.SetOrgID(u)
+	return omuo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (omuo *OrgMembersUpdateOne) SetNillableOrgID(u *uuid.UUID) *OrgMembersUpdateOne {
+	if u != nil {
+		omuo.SetOrgID(*u)
+	}
+	return omuo
+}
+
+// SetUserID sets the "user_id" field.
+func (omuo *OrgMembersUpdateOne) SetUserID(u uuid.UUID) *OrgMembersUpdateOne {
+	omuo.mutation.SetUserID(u)
+	return omuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (omuo *OrgMembersUpdateOne) SetNillableUserID(u *uuid.UUID) *OrgMembersUpdateOne {
+	if u != nil {
+		omuo.SetUserID(*u)
+	}
+	return omuo
+}
+
+// SetAddedBy sets the "added_by" field.
+func (omuo *OrgMembersUpdateOne) SetAddedBy(u uuid.UUID) *OrgMembersUpdateOne {
+	omuo.mutation.SetAddedBy(u)
+	return omuo
+}
+
+// SetNillableAddedBy sets the "added_by" field if the given value is not nil.
+func (omuo *OrgMembersUpdateOne) SetNillableAddedBy(u *uuid.UUID) *OrgMembersUpdateOne {
+	if u != nil {
+		omuo.SetAddedBy(*u)
+	}
+	return omuo
+}
+
+// ClearAddedBy clears the value of the "added_by" field.
+func (omuo *OrgMembersUpdateOne) ClearAddedBy() *OrgMembersUpdateOne {
+	omuo.mutation.ClearAddedBy()
+	return omuo
+}
+
+// SetOrg sets the "org" edge to the Organizations entity.
+func (omuo *OrgMembersUpdateOne) SetOrg(o *Organizations) *OrgMembersUpdateOne {
+	return omuo.SetOrgID(o.ID)
+}
+
+// SetUser sets the "user" edge to the Users entity.
+func (omuo *OrgMembersUpdateOne) SetUser(u *Users) *OrgMembersUpdateOne {
+	return omuo.SetUserID(u.ID)
+}
+
+// Mutation returns the OrgMembersMutation object of the builder.
+func (omuo *OrgMembersUpdateOne) Mutation() *OrgMembersMutation {
+	return omuo.mutation
+}
+
+// ClearOrg clears the "org" edge to the Organizations entity.
+func (omuo *OrgMembersUpdateOne) ClearOrg() *OrgMembersUpdateOne {
+	omuo.mutation.ClearOrg()
+	return omuo
+}
+
+// ClearUser clears the "user" edge to the Users entity.
+func (omuo *OrgMembersUpdateOne) ClearUser() *OrgMembersUpdateOne {
+	omuo.mutation.ClearUser()
+	return omuo
+}
+
+// Where appends a list predicates to the OrgMembersUpdate builder.
+func (omuo *OrgMembersUpdateOne) Where(ps ...predicate.OrgMembers) *OrgMembersUpdateOne {
+	omuo.mutation.Where(ps...)
+	return omuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (omuo *OrgMembersUpdateOne) Select(field string, fields ...string) *OrgMembersUpdateOne {
+	omuo.fields = append([]string{field}, fields...)
+	return omuo
+}
+
+// Save executes the query and returns the updated OrgMembers entity.
+func (omuo *OrgMembersUpdateOne) Save(ctx context.Context) (*OrgMembers, error) {
+	return withHooks(ctx, omuo.sqlSave, omuo.mutation, omuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (omuo *OrgMembersUpdateOne) SaveX(ctx context.Context) *OrgMembers {
+	node, err := omuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (omuo *OrgMembersUpdateOne) Exec(ctx context.Context) error {
+	_, err := omuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (omuo *OrgMembersUpdateOne) ExecX(ctx context.Context) {
+	if err := omuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (omuo *OrgMembersUpdateOne) check() error {
+	if _, ok := omuo.mutation.OrgID(); omuo.mutation.OrgCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrgMembers.org"`)
+	}
+	if _, ok := omuo.mutation.UserID(); omuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrgMembers.user"`)
+	}
+	return nil
+}
+
+func (omuo *OrgMembersUpdateOne) sqlSave(ctx context.Context) (_node *OrgMembers, err error) {
+	if err := omuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orgmembers.Table, orgmembers.Columns, sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID))
+	id, ok := omuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrgMembers.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := omuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orgmembers.FieldID)
+		for _, f := range fields {
+			if !orgmembers.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != orgmembers.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := omuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := omuo.mutation.AddedBy(); ok {
+		_spec.SetField(orgmembers.FieldAddedBy, field.TypeUUID, value)
+	}
+	if omuo.mutation.AddedByCleared() {
+		_spec.ClearField(orgmembers.FieldAddedBy, field.TypeUUID)
+	}
+	if omuo.mutation.OrgCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembers.OrgTable,
+			Columns: []string{orgmembers.OrgColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := omuo.mutation.OrgIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembers.OrgTable,
+			Columns: []string{orgmembers.OrgColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if omuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembers.UserTable,
+			Columns: []string{orgmembers.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := omuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgmembers.UserTable,
+			Columns: []string{orgmembers.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OrgMembers{config: omuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, omuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orgmembers.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	omuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// OrgRoles is the model entity for the OrgRoles schema.
+type OrgRoles struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// OrgID holds the value of the "org_id" field.
+	OrgID uuid.UUID `json:"org_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// RoleID holds the value of the "role_id" field.
+	RoleID int `json:"role_id,omitempty"`
+	// User who assigned this role
+	AssignedBy *uuid.UUID `json:"assigned_by,omitempty"`
+	// AssignedAt holds the value of the "assigned_at" field.
+	AssignedAt time.Time `json:"assigned_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrgRolesQuery when eager-loading is set.
+	Edges        OrgRolesEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrgRolesEdges holds the relations/edges for other nodes in the graph.
+type OrgRolesEdges struct {
+	// Org holds the value of the org edge.
+	Org *Organizations `json:"org,omitempty"`
+	// User holds the value of the user edge.
+	User *Users `json:"user,omitempty"`
+	// Role holds the value of the role edge.
+	Role *Roles `json:"role,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrgOrErr returns the Org value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrgRolesEdges) OrgOrErr() (*Organizations, error) {
+	if e.Org != nil {
+		return e.Org, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organizations.Label}
+	}
+	return nil, &NotLoadedError{edge: "org"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrgRolesEdges) UserOrErr() (*Users, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: users.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrgRolesEdges) RoleOrErr() (*Roles, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: roles.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrgRoles) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orgroles.FieldAssignedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case orgroles.FieldID, orgroles.FieldRoleID:
+			values[i] = new(sql.NullInt64)
+		case orgroles.FieldAssignedAt:
+			values[i] = new(sql.NullTime)
+		case orgroles.FieldOrgID, orgroles.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrgRoles fields.
+func (or *OrgRoles) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orgroles.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			or.ID = int(value.Int64)
+		case orgroles.FieldOrgID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field org_id", values[i])
+			} else if value != nil {
+				or.OrgID = *value
+			}
+		case orgroles.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				or.UserID = *value
+			}
+		case orgroles.FieldRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				or.RoleID = int(value.Int64)
+			}
+		case orgroles.FieldAssignedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_by", values[i])
+			} else if value.Valid {
+				or.AssignedBy = new(uuid.UUID)
+				*or.AssignedBy = *value.S.(*uuid.UUID)
+			}
+		case orgroles.FieldAssignedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_at", values[i])
+			} else if value.Valid {
+				or.AssignedAt = value.Time
+			}
+		default:
+			or.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrgRoles.
+// This includes values selected through modifiers, order, etc.
+func (or *OrgRoles) Value(name string) (ent.Value, error) {
+	return or.selectValues.Get(name)
+}
+
+// QueryOrg queries the "org" edge of the OrgRoles entity.
+func (or *OrgRoles) QueryOrg() *OrganizationsQuery {
+	return NewOrgRolesClient(or.config).QueryOrg(or)
+}
+
+// QueryUser queries the "user" edge of the OrgRoles entity.
+func (or *OrgRoles) QueryUser() *UsersQuery {
+	return NewOrgRolesClient(or.config).QueryUser(or)
+}
+
+// QueryRole queries the "role" edge of the OrgRoles entity.
+func (or *OrgRoles) QueryRole() *RolesQuery {
+	return NewOrgRolesClient(or.config).QueryRole(or)
+}
+
+// Update returns a builder for updating this OrgRoles.
+// Note that you need to call OrgRoles.Unwrap() before calling this method if this OrgRoles
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (or *OrgRoles) Update() *OrgRolesUpdateOne {
+	return NewOrgRolesClient(or.config).UpdateOne(or)
+}
+
+// Unwrap unwraps the OrgRoles entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (or *OrgRoles) Unwrap() *OrgRoles {
+	_tx, ok := or.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrgRoles is not a transactional entity")
+	}
+	or.config.driver = _tx.drv
+	return or
+}
+
+// String implements the fmt.Stringer.
+func (or *OrgRoles) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrgRoles(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", or.ID))
+	builder.WriteString("org_id=")
+	builder.WriteString(fmt.Sprintf("%v", or.OrgID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", or.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", or.RoleID))
+	builder.WriteString(", ")
+	if v := or.AssignedBy; v != nil {
+		builder.WriteString("assigned_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("assigned_at=")
+	builder.WriteString(or.AssignedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrgRolesSlice is a parsable slice of OrgRoles.
+type OrgRolesSlice []*OrgRoles
@@ -0,0 +1,773 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// OrgRolesCreate is the builder for creating a OrgRoles entity.
+type OrgRolesCreate struct {
+	config
+	mutation *OrgRolesMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetOrgID sets the "org_id" field.
+func (orc *OrgRolesCreate) SetOrgID(u uuid.UUID) *OrgRolesCreate {
+	orc.mutation.SetOrgID(u)
+	return orc
+}
+
+// SetUserID sets the "user_id" field.
+func (orc *OrgRolesCreate) SetUserID(u uuid.UUID) *OrgRolesCreate {
+	orc.mutation.SetUserID(u)
+	return orc
+}
+
+// SetRoleID sets the "role_id" field.
+func (orc *OrgRolesCreate) SetRoleID(i int) *OrgRolesCreate {
+	orc.mutation.SetRoleID(i)
+	return orc
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (orc *OrgRolesCreate) SetAssignedBy(u uuid.UUID) *OrgRolesCreate {
+	orc.mutation.SetAssignedBy(u)
+	return orc
+}
+
+// SetNillableAssignedBy sets the "assigned_by" field if the given value is not nil.
+func (orc *OrgRolesCreate) SetNillableAssignedBy(u *uuid.UUID) *OrgRolesCreate {
+	if u != nil {
+		orc.SetAssignedBy(*u)
+	}
+	return orc
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (orc *OrgRolesCreate) SetAssignedAt(t time.Time) *OrgRolesCreate {
+	orc.mutation.SetAssignedAt(t)
+	return orc
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (orc *OrgRolesCreate) SetNillableAssignedAt(t *time.Time) *OrgRolesCreate {
+	if t != nil {
+		orc.SetAssignedAt(*t)
+	}
+	return orc
+}
+
+// SetID sets the "id" field.
+func (orc *OrgRolesCreate) SetID(i int) *OrgRolesCreate {
+	orc.mutation.SetID(i)
+	return orc
+}
+
+// SetOrg sets the "org" edge to the Organizations entity.
+func (orc *OrgRolesCreate) SetOrg(o *Organizations) *OrgRolesCreate {
+	return orc.SetOrgID(o.ID)
+}
+
+// SetUser sets the "user" edge to the Users entity.
+func (orc *OrgRolesCreate) SetUser(u *Users) *OrgRolesCreate {
+	return orc.SetUserID(u.ID)
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (orc *OrgRolesCreate) SetRole(r *Roles) *OrgRolesCreate {
+	return orc.SetRoleID(r.ID)
+}
+
+// Mutation returns the OrgRolesMutation object of the builder.
+func (orc *OrgRolesCreate) Mutation() *OrgRolesMutation {
+	return orc.mutation
+}
+
+// Save creates the OrgRoles in the database.
+func (orc *OrgRolesCreate) Save(ctx context.Context) (*OrgRoles, error) {
+	orc.defaults()
+	return withHooks(ctx, orc.sqlSave, orc.mutation, orc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (orc *OrgRolesCreate) SaveX(ctx context.Context) *OrgRoles {
+	v, err := orc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (orc *OrgRolesCreate) Exec(ctx context.Context) error {
+	_, err := orc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (orc *OrgRolesCreate) ExecX(ctx context.Context) {
+	if err := orc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (orc *OrgRolesCreate) defaults() {
+	if _, ok := orc.mutation.AssignedAt(); !ok {
+		v := orgroles.DefaultAssignedAt()
+		orc.mutation.SetAssignedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (orc *OrgRolesCreate) check() error {
+	if _, ok := orc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org_id", err: errors.New(`ent: missing required field "OrgRoles.org_id"`)}
+	}
+	if _, ok := orc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OrgRoles.user_id"`)}
+	}
+	if _, ok := orc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "OrgRoles.role_id"`)}
+	}
+	if _, ok := orc.mutation.AssignedAt(); !ok {
+		return &ValidationError{Name: "assigned_at", err: errors.New(`ent: missing required field "OrgRoles.assigned_at"`)}
+	}
+	if _, ok := orc.mutation.OrgID(); !ok {
+		return &ValidationError{Name: "org", err: errors.New(`ent: missing required edge "OrgRoles.org"`)}
+	}
+	if _, ok := orc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "OrgRoles.user"`)}
+	}
+	if _, ok := orc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "OrgRoles.role"`)}
+	}
+	return nil
+}
+
+func (orc *OrgRolesCreate) sqlSave(ctx context.Context) (*OrgRoles, error) {
+	if err := orc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := orc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, orc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	orc.mutation.id = &_node.ID
+	orc.mutation.done = true
+	return _node, nil
+}
+
+func (orc *OrgRolesCreate) createSpec() (*OrgRoles, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrgRoles{config: orc.config}
+		_spec = sqlgraph.NewCreateSpec(orgroles.Table, sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = orc.conflict
+	if id, ok := orc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := orc.mutation.AssignedBy(); ok {
+		_spec.SetField(orgroles.FieldAssignedBy, field.TypeUUID, value)
+		_node.AssignedBy = &value
+	}
+	if value, ok := orc.mutation.AssignedAt(); ok {
+		_spec.SetField(orgroles.FieldAssignedAt, field.TypeTime, value)
+		_node.AssignedAt = value
+	}
+	if nodes := orc.mutation.OrgIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.OrgTable,
+			Columns: []string{orgroles.OrgColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrgID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := orc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.UserTable,
+			Columns: []string{orgroles.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := orc.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.RoleTable,
+			Columns: []string{orgroles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrgRoles.Create().
+//		SetOrgID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrgRolesUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (orc *OrgRolesCreate) OnConflict(opts ...sql.ConflictOption) *OrgRolesUpsertOne {
+	orc.conflict = opts
+	return &OrgRolesUpsertOne{
+		create: orc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrgRoles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (orc *OrgRolesCreate) OnConflictColumns(columns ...string) *OrgRolesUpsertOne {
+	orc.conflict = append(orc.conflict, sql.ConflictColumns(columns...))
+	return &OrgRolesUpsertOne{
+		create: orc,
+	}
+}
+
+type (
+	// OrgRolesUpsertOne is the builder for "upsert"-ing
+	//  one OrgRoles node.
+	OrgRolesUpsertOne struct {
+		create *OrgRolesCreate
+	}
+
+	// OrgRolesUpsert is the "OnConflict" setter.
+	OrgRolesUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetOrgID sets the "org_id" field.
+func (u *OrgRolesUpsert) SetOrgID(v uuid.UUID) *OrgRolesUpsert {
+	u.Set(orgroles.FieldOrgID, v)
+	return u
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *OrgRolesUpsert) UpdateOrgID() *OrgRolesUpsert {
+	u.SetExcluded(orgroles.FieldOrgID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *OrgRolesUpsert) SetUserID(v uuid.UUID) *OrgRolesUpsert {
+	u.Set(orgroles.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *OrgRolesUpsert) UpdateUserID() *OrgRolesUpsert {
+	u.SetExcluded(orgroles.FieldUserID)
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *OrgRolesUpsert) SetRoleID(v int) *OrgRolesUpsert {
+	u.Set(orgroles.FieldRoleID, v)
+	return u
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *OrgRolesUpsert) UpdateRoleID() *OrgRolesUpsert {
+	u.SetExcluded(orgroles.FieldRoleID)
+	return u
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *OrgRolesUpsert) SetAssignedBy(v uuid.UUID) *OrgRolesUpsert {
+	u.Set(orgroles.FieldAssignedBy, v)
+	return u
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *OrgRolesUpsert) UpdateAssignedBy() *OrgRolesUpsert {
+	u.SetExcluded(orgroles.FieldAssignedBy)
+	return u
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *OrgRolesUpsert) ClearAssignedBy() *OrgRolesUpsert {
+	u.SetNull(orgroles.FieldAssignedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OrgRoles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(orgroles.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrgRolesUpsertOne) UpdateNewValues() *OrgRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(orgroles.FieldID)
+		}
+		if _, exists := u.create.mutation.AssignedAt(); exists {
+			s.SetIgnore(orgroles.FieldAssignedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrgRoles.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OrgRolesUpsertOne) Ignore() *OrgRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrgRolesUpsertOne) DoNothing() *OrgRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrgRolesCreate.OnConflict
+// documentation for more info.
+func (u *OrgRolesUpsertOne) Update(set func(*OrgRolesUpsert)) *OrgRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrgRolesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *OrgRolesUpsertOne) SetOrgID(v uuid.UUID) *OrgRolesUpsertOne {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *OrgRolesUpsertOne) UpdateOrgID() *OrgRolesUpsertOne {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *OrgRolesUpsertOne) SetUserID(v uuid.UUID) *OrgRolesUpsertOne {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *OrgRolesUpsertOne) UpdateUserID() *OrgRolesUpsertOne {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *OrgRolesUpsertOne) SetRoleID(v int) *OrgRolesUpsertOne {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *OrgRolesUpsertOne) UpdateRoleID() *OrgRolesUpsertOne {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *OrgRolesUpsertOne) SetAssignedBy(v uuid.UUID) *OrgRolesUpsertOne {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.SetAssignedBy(v)
+	})
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *OrgRolesUpsertOne) UpdateAssignedBy() *OrgRolesUpsertOne {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.UpdateAssignedBy()
+	})
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *OrgRolesUpsertOne) ClearAssignedBy() *OrgRolesUpsertOne {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.ClearAssignedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *OrgRolesUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrgRolesCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrgRolesUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OrgRolesUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OrgRolesUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OrgRolesCreateBulk is the builder for creating many OrgRoles entities in bulk.
+type OrgRolesCreateBulk struct {
+	config
+	err      error
+	builders []*OrgRolesCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OrgRoles entities in the database.
+func (orcb *OrgRolesCreateBulk) Save(ctx context.Context) ([]*OrgRoles, error) {
+	if orcb.err != nil {
+		return nil, orcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(orcb.builders))
+	nodes := make([]*OrgRoles, len(orcb.builders))
+	mutators := make([]Mutator, len(orcb.builders))
+	for i := range orcb.builders {
+		func(i int, root context.Context) {
+			builder := orcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrgRolesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, orcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = orcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, orcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, orcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (orcb *OrgRolesCreateBulk) SaveX(ctx context.Context) []*OrgRoles {
+	v, err := orcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (orcb *OrgRolesCreateBulk) Exec(ctx context.Context) error {
+	_, err := orcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (orcb *OrgRolesCreateBulk) ExecX(ctx context.Context) {
+	if err := orcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrgRoles.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrgRolesUpsert) {
+//			SetOrgID(v+v).
+//		}).
+//		Exec(ctx)
+func (orcb *OrgRolesCreateBulk) OnConflict(opts ...sql.ConflictOption) *OrgRolesUpsertBulk {
+	orcb.conflict = opts
+	return &OrgRolesUpsertBulk{
+		create: orcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrgRoles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (orcb *OrgRolesCreateBulk) OnConflictColumns(columns ...string) *OrgRolesUpsertBulk {
+	orcb.conflict = append(orcb.conflict, sql.ConflictColumns(columns...))
+	return &OrgRolesUpsertBulk{
+		create: orcb,
+	}
+}
+
+// OrgRolesUpsertBulk is the builder for "upsert"-ing
+// a bulk of OrgRoles nodes.
+type OrgRolesUpsertBulk struct {
+	create *OrgRolesCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OrgRoles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(orgroles.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrgRolesUpsertBulk) UpdateNewValues() *OrgRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(orgroles.FieldID)
+			}
+			if _, exists := b.mutation.AssignedAt(); exists {
+				s.SetIgnore(orgroles.FieldAssignedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrgRoles.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OrgRolesUpsertBulk) Ignore() *OrgRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrgRolesUpsertBulk) DoNothing() *OrgRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrgRolesCreateBulk.OnConflict
+// documentation for more info.
+func (u *OrgRolesUpsertBulk) Update(set func(*OrgRolesUpsert)) *OrgRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrgRolesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetOrgID sets the "org_id" field.
+func (u *OrgRolesUpsertBulk) SetOrgID(v uuid.UUID) *OrgRolesUpsertBulk {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.SetOrgID(v)
+	})
+}
+
+// UpdateOrgID sets the "org_id" field to the value that was provided on create.
+func (u *OrgRolesUpsertBulk) UpdateOrgID() *OrgRolesUpsertBulk {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.UpdateOrgID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *OrgRolesUpsertBulk) SetUserID(v uuid.UUID) *OrgRolesUpsertBulk {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *OrgRolesUpsertBulk) UpdateUserID() *OrgRolesUpsertBulk {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *OrgRolesUpsertBulk) SetRoleID(v int) *OrgRolesUpsertBulk {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *OrgRolesUpsertBulk) UpdateRoleID() *OrgRolesUpsertBulk {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *OrgRolesUpsertBulk) SetAssignedBy(v uuid.UUID) *OrgRolesUpsertBulk {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.SetAssignedBy(v)
+	})
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *OrgRolesUpsertBulk) UpdateAssignedBy() *OrgRolesUpsertBulk {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.UpdateAssignedBy()
+	})
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *OrgRolesUpsertBulk) ClearAssignedBy() *OrgRolesUpsertBulk {
+	return u.Update(func(s *OrgRolesUpsert) {
+		s.ClearAssignedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *OrgRolesUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OrgRolesCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrgRolesCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrgRolesUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
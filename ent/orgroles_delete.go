@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// OrgRolesDelete is the builder for deleting a OrgRoles entity.
+type OrgRolesDelete struct {
+	config
+	hooks    []Hook
+	mutation *OrgRolesMutation
+}
+
+// Where appends a list predicates to the OrgRolesDelete builder.
+func (ord *OrgRolesDelete) Where(ps ...predicate.OrgRoles) *OrgRolesDelete {
+	ord.mutation.Where(ps...)
+	return ord
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ord *OrgRolesDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ord.sqlExec, ord.mutation, ord.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ord *OrgRolesDelete) ExecX(ctx context.Context) int {
+	n, err := ord.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ord *OrgRolesDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(orgroles.Table, sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt))
+	if ps := ord.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ord.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ord.mutation.done = true
+	return affected, err
+}
+
+// OrgRolesDeleteOne is the builder for deleting a single OrgRoles entity.
+type OrgRolesDeleteOne struct {
+	ord *OrgRolesDelete
+}
+
+// Where appends a list predicates to the OrgRolesDelete builder.
+func (ordo *OrgRolesDeleteOne) Where(ps ...predicate.OrgRoles) *OrgRolesDeleteOne {
+	ordo.ord.mutation.Where(ps...)
+	return ordo
+}
+
+// Exec executes the deletion query.
+func (ordo *OrgRolesDeleteOne) Exec(ctx context.Context) error {
+	n, err := ordo.ord.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{orgroles.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ordo *OrgRolesDeleteOne) ExecX(ctx context.Context) {
+	if err := ordo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
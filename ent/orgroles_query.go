@@ -0,0 +1,793 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// OrgRolesQuery is the builder for querying OrgRoles entities.
+type OrgRolesQuery struct {
+	config
+	ctx        *QueryContext
+	order      []orgroles.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OrgRoles
+	withOrg    *OrganizationsQuery
+	withUser   *UsersQuery
+	withRole   *RolesQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OrgRolesQuery builder.
+func (orq *OrgRolesQuery) Where(ps ...predicate.OrgRoles) *OrgRolesQuery {
+	orq.predicates = append(orq.predicates, ps...)
+	return orq
+}
+
+// Limit the number of records to be returned by this query.
+func (orq *OrgRolesQuery) Limit(limit int) *OrgRolesQuery {
+	orq.ctx.Limit = &limit
+	return orq
+}
+
+// Offset to start from.
+func (orq *OrgRolesQuery) Offset(offset int) *OrgRolesQuery {
+	orq.ctx.Offset = &offset
+	return orq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (orq *OrgRolesQuery) Unique(unique bool) *OrgRolesQuery {
+	orq.ctx.Unique = &unique
+	return orq
+}
+
+// Order specifies how the records should be ordered.
+func (orq *OrgRolesQuery) Order(o ...orgroles.OrderOption) *OrgRolesQuery {
+	orq.order = append(orq.order, o...)
+	return orq
+}
+
+// QueryOrg chains the current query on the "org" edge.
+func (orq *OrgRolesQuery) QueryOrg() *OrganizationsQuery {
+	query := (&OrganizationsClient{config: orq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := orq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := orq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgroles.Table, orgroles.FieldID, selector),
+			sqlgraph.To(organizations.Table, organizations.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgroles.OrgTable, orgroles.OrgColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(orq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (orq *OrgRolesQuery) QueryUser() *UsersQuery {
+	query := (&UsersClient{config: orq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := orq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := orq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgroles.Table, orgroles.FieldID, selector),
+			sqlgraph.To(users.Table, users.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgroles.UserTable, orgroles.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(orq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRole chains the current query on the "role" edge.
+func (orq *OrgRolesQuery) QueryRole() *RolesQuery {
+	query := (&RolesClient{config: orq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := orq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := orq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orgroles.Table, orgroles.FieldID, selector),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, orgroles.RoleTable, orgroles.RoleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(orq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OrgRoles entity from the query.
+// Returns a *NotFoundError when no OrgRoles was found.
+func (orq *OrgRolesQuery) First(ctx context.Context) (*OrgRoles, error) {
+	nodes, err := orq.Limit(1).All(setContextOp(ctx, orq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{orgroles.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (orq *OrgRolesQuery) FirstX(ctx context.Context) *OrgRoles {
+	node, err := orq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OrgRoles ID from the query.
+// Returns a *NotFoundError when no OrgRoles ID was found.
+func (orq *OrgRolesQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = orq.Limit(1).IDs(setContextOp(ctx, orq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{orgroles.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (orq *OrgRolesQuery) FirstIDX(ctx context.Context) int {
+	id, err := orq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OrgRoles entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OrgRoles entity is found.
+// Returns a *NotFoundError when no OrgRoles entities are found.
+func (orq *OrgRolesQuery) Only(ctx context.Context) (*OrgRoles, error) {
+	nodes, err := orq.Limit(2).All(setContextOp(ctx, orq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{orgroles.Label}
+	default:
+		return nil, &NotSingularError{orgroles.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (orq *OrgRolesQuery) OnlyX(ctx context.Context) *OrgRoles {
+	node, err := orq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OrgRoles ID in the query.
+// Returns a *NotSingularError when more than one OrgRoles ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (orq *OrgRolesQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = orq.Limit(2).IDs(setContextOp(ctx, orq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{orgroles.Label}
+	default:
+		err = &NotSingularError{orgroles.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (orq *OrgRolesQuery) OnlyIDX(ctx context.Context) int {
+	id, err := orq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OrgRolesSlice.
+func (orq *OrgRolesQuery) All(ctx context.Context) ([]*OrgRoles, error) {
+	ctx = setContextOp(ctx, orq.ctx, "All")
+	if err := orq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OrgRoles, *OrgRolesQuery]()
+	return withInterceptors[[]*OrgRoles](ctx, orq, qr, orq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (orq *OrgRolesQuery) AllX(ctx context.Context) []*OrgRoles {
+	nodes, err := orq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OrgRoles IDs.
+func (orq *OrgRolesQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if orq.ctx.Unique == nil && orq.path != nil {
+		orq.Unique(true)
+	}
+	ctx = setContextOp(ctx, orq.ctx, "IDs")
+	if err = orq.Select(orgroles.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (orq *OrgRolesQuery) IDsX(ctx context.Context) []int {
+	ids, err := orq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (orq *OrgRolesQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, orq.ctx, "Count")
+	if err := orq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, orq, querierCount[*OrgRolesQuery](), orq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (orq *OrgRolesQuery) CountX(ctx context.Context) int {
+	count, err := orq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (orq *OrgRolesQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, orq.ctx, "Exist")
+	switch _, err := orq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (orq *OrgRolesQuery) ExistX(ctx context.Context) bool {
+	exist, err := orq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OrgRolesQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (orq *OrgRolesQuery) Clone() *OrgRolesQuery {
+	if orq == nil {
+		return nil
+	}
+	return &OrgRolesQuery{
+		config:     orq.config,
+		ctx:        orq.ctx.Clone(),
+		order:      append([]orgroles.OrderOption{}, orq.order...),
+		inters:     append([]Interceptor{}, orq.inters...),
+		predicates: append([]predicate.OrgRoles{}, orq.predicates...),
+		withOrg:    orq.withOrg.Clone(),
+		withUser:   orq.withUser.Clone(),
+		withRole:   orq.withRole.Clone(),
+		// clone intermediate query.
+		sql:  orq.sql.Clone(),
+		path: orq.path,
+	}
+}
+
+// WithOrg tells the query-builder to eager-load the nodes that are connected to
+// the "org" edge. The optional arguments are used to configure the query builder of the edge.
+func (orq *OrgRolesQuery) WithOrg(opts ...func(*OrganizationsQuery)) *OrgRolesQuery {
+	query := (&OrganizationsClient{config: orq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	orq.withOrg = query
+	return orq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (orq *OrgRolesQuery) WithUser(opts ...func(*UsersQuery)) *OrgRolesQuery {
+	query := (&UsersClient{config: orq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	orq.withUser = query
+	return orq
+}
+
+// WithRole tells the query-builder to eager-load the nodes that are connected to
+// the "role" edge. The optional arguments are used to configure the query builder of the edge.
+func (orq *OrgRolesQuery) WithRole(opts ...func(*RolesQuery)) *OrgRolesQuery {
+	query := (&RolesClient{config: orq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	orq.withRole = query
+	return orq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OrgRoles.Query().
+//		GroupBy(orgroles.FieldOrgID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (orq *OrgRolesQuery) GroupBy(field string, fields ...string) *OrgRolesGroupBy {
+	orq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OrgRolesGroupBy{build: orq}
+	grbuild.flds = &orq.ctx.Fields
+	grbuild.label = orgroles.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrgID uuid.UUID `json:"org_id,omitempty"`
+//	}
+//
+//	client.OrgRoles.Query().
+//		Select(orgroles.FieldOrgID).
+//		Scan(ctx, &v)
+func (orq *OrgRolesQuery) Select(fields ...string) *OrgRolesSelect {
+	orq.ctx.Fields = append(orq.ctx.Fields, fields...)
+	sbuild := &OrgRolesSelect{OrgRolesQuery: orq}
+	sbuild.label = orgroles.Label
+	sbuild.flds, sbuild.scan = &orq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OrgRolesSelect configured with the given aggregations.
+func (orq *OrgRolesQuery) Aggregate(fns ...AggregateFunc) *OrgRolesSelect {
+	return orq.Select().Aggregate(fns...)
+}
+
+func (orq *OrgRolesQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range orq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, orq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range orq.ctx.Fields {
+		if !orgroles.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if orq.path != nil {
+		prev, err := orq.path(ctx)
+		if err != nil {
+			return err
+		}
+		orq.sql = prev
+	}
+	return nil
+}
+
+func (orq *OrgRolesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OrgRoles, error) {
+	var (
+		nodes       = []*OrgRoles{}
+		_spec       = orq.querySpec()
+		loadedTypes = [3]bool{
+			orq.withOrg != nil,
+			orq.withUser != nil,
+			orq.withRole != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OrgRoles).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OrgRoles{config: orq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(orq.modifiers) > 0 {
+		_spec.Modifiers = orq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, orq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := orq.withOrg; query != nil {
+		if err := orq.loadOrg(ctx, query, nodes, nil,
+			func(n *OrgRoles, e *Organizations) { n.Edges.Org = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := orq.withUser; query != nil {
+		if err := orq.loadUser(ctx, query, nodes, nil,
+			func(n *OrgRoles, e *Users) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := orq.withRole; query != nil {
+		if err := orq.loadRole(ctx, query, nodes, nil,
+			func(n *OrgRoles, e *Roles) { n.Edges.Role = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (orq *OrgRolesQuery) loadOrg(ctx context.Context, query *OrganizationsQuery, nodes []*OrgRoles, init func(*OrgRoles), assign func(*OrgRoles, *Organizations)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*OrgRoles)
+	for i := range nodes {
+		fk := nodes[i].OrgID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organizations.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "org_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (orq *OrgRolesQuery) loadUser(ctx context.Context, query *UsersQuery, nodes []*OrgRoles, init func(*OrgRoles), assign func(*OrgRoles, *Users)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*OrgRoles)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(users.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (orq *OrgRolesQuery) loadRole(ctx context.Context, query *RolesQuery, nodes []*OrgRoles, init func(*OrgRoles), assign func(*OrgRoles, *Roles)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OrgRoles)
+	for i := range nodes {
+		fk := nodes[i].RoleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(roles.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "role_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (orq *OrgRolesQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := orq.querySpec()
+	if len(orq.modifiers) > 0 {
+		_spec.Modifiers = orq.modifiers
+	}
+	_spec.Node.Columns = orq.ctx.Fields
+	if len(orq.ctx.Fields) > 0 {
+		_spec.Unique = orq.ctx.Unique != nil && *orq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, orq.driver, _spec)
+}
+
+func (orq *OrgRolesQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(orgroles.Table, orgroles.Columns, sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt))
+	_spec.From = orq.sql
+	if unique := orq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if orq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := orq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orgroles.FieldID)
+		for i := range fields {
+			if fields[i] != orgroles.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if orq.withOrg != nil {
+			_spec.Node.AddColumnOnce(orgroles.FieldOrgID)
+		}
+		if orq.withUser != nil {
+			_spec.Node.AddColumnOnce(orgroles.FieldUserID)
+		}
+		if orq.withRole != nil {
+			_spec.Node.AddColumnOnce(orgroles.FieldRoleID)
+		}
+	}
+	if ps := orq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := orq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := orq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := orq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (orq *OrgRolesQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(orq.driver.Dialect())
+	t1 := builder.Table(orgroles.Table)
+	columns := orq.ctx.Fields
+	if len(columns) == 0 {
+		columns = orgroles.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if orq.sql != nil {
+		selector = orq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if orq.ctx.Unique != nil && *orq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range orq.modifiers {
+		m(selector)
+	}
+	for _, p := range orq.predicates {
+		p(selector)
+	}
+	for _, p := range orq.order {
+		p(selector)
+	}
+	if offset := orq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := orq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (orq *OrgRolesQuery) ForUpdate(opts ...sql.LockOption) *OrgRolesQuery {
+	if orq.driver.Dialect() == dialect.Postgres {
+		orq.Unique(false)
+	}
+	orq.modifiers = append(orq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return orq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (orq *OrgRolesQuery) ForShare(opts ...sql.LockOption) *OrgRolesQuery {
+	if orq.driver.Dialect() == dialect.Postgres {
+		orq.Unique(false)
+	}
+	orq.modifiers = append(orq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return orq
+}
+
+// OrgRolesGroupBy is the group-by builder for OrgRoles entities.
+type OrgRolesGroupBy struct {
+	selector
+	build *OrgRolesQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (orgb *OrgRolesGroupBy) Aggregate(fns ...AggregateFunc) *OrgRolesGroupBy {
+	orgb.fns = append(orgb.fns, fns...)
+	return orgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (orgb *OrgRolesGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, orgb.build.ctx, "GroupBy")
+	if err := orgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrgRolesQuery, *OrgRolesGroupBy](ctx, orgb.build, orgb, orgb.build.inters, v)
+}
+
+func (orgb *OrgRolesGroupBy) sqlScan(ctx context.Context, root *OrgRolesQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(orgb.fns))
+	for _, fn := range orgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*orgb.flds)+len(orgb.fns))
+		for _, f := range *orgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*orgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := orgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OrgRolesSelect is the builder for selecting fields of OrgRoles entities.
+type OrgRolesSelect struct {
+	*OrgRolesQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ors *OrgRolesSelect) Aggregate(fns ...AggregateFunc) *OrgRolesSelect {
+	ors.fns = append(ors.fns, fns...)
+	return ors
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ors *OrgRolesSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ors.ctx, "Select")
+	if err := ors.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrgRolesQuery, *OrgRolesSelect](ctx, ors.OrgRolesQuery, ors, ors.inters, v)
+}
+
+func (ors *OrgRolesSelect) sqlScan(ctx context.Context, root *OrgRolesQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ors.fns))
+	for _, fn := range ors.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ors.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ors.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
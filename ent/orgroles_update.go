@@ -0,0 +1,589 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// OrgRolesUpdate is the builder for updating OrgRoles entities.
+type OrgRolesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrgRolesMutation
+}
+
+// Where appends a list predicates to the OrgRolesUpdate builder.
+func (oru *OrgRolesUpdate) Where(ps ...predicate.OrgRoles) *OrgRolesUpdate {
+	oru.mutation.Where(ps...)
+	return oru
+}
+
+// SetOrgID sets the "org_id" field.
+func (oru *OrgRolesUpdate) SetOrgID(u uuid.UUID) *OrgRolesUpdate {
+	oru.mutation.SetOrgID(u)
+	return oru
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (oru *OrgRolesUpdate) SetNillableOrgID(u *uuid.UUID) *OrgRolesUpdate {
+	if u != nil {
+		oru.SetOrgID(*u)
+	}
+	return oru
+}
+
+// SetUserID sets the "user_id" field.
+func (oru *OrgRolesUpdate) SetUserID(u uuid.UUID) *OrgRolesUpdate {
+	oru.mutation.SetUserID(u)
+	return oru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (oru *OrgRolesUpdate) SetNillableUserID(u *uuid.UUID) *OrgRolesUpdate {
+	if u != nil {
+		oru.SetUserID(*u)
+	}
+	return oru
+}
+
+// SetRoleID sets the "role_id" field.
+func (oru *OrgRolesUpdate) SetRoleID(i int) *OrgRolesUpdate {
+	oru.mutation.SetRoleID(i)
+	return oru
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (oru *OrgRolesUpdate) SetNillableRoleID(i *int) *OrgRolesUpdate {
+	if i != nil {
+		oru.SetRoleID(*i)
+	}
+	return oru
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (oru *OrgRolesUpdate) SetAssignedBy(u uuid.UUID) *OrgRolesUpdate {
+	oru.mutation.SetAssignedBy(u)
+	return oru
+}
+
+// SetNillableAssignedBy sets the "assigned_by" field if the given value is not nil.
+func (oru *OrgRolesUpdate) SetNillableAssignedBy(u *uuid.UUID) *OrgRolesUpdate {
+	if u != nil {
+		oru.SetAssignedBy(*u)
+	}
+	return oru
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (oru *OrgRolesUpdate) ClearAssignedBy() *OrgRolesUpdate {
+	oru.mutation.ClearAssignedBy()
+	return oru
+}
+
+// SetOrg sets the "org" edge to the Organizations entity.
+func (oru *OrgRolesUpdate) SetOrg(o *Organizations) *OrgRolesUpdate {
+	return oru.SetOrgID(o.ID)
+}
+
+// SetUser sets the "user" edge to the Users entity.
+func (oru *OrgRolesUpdate) SetUser(u *Users) *OrgRolesUpdate {
+	return oru.SetUserID(u.ID)
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (oru *OrgRolesUpdate) SetRole(r *Roles) *OrgRolesUpdate {
+	return oru.SetRoleID(r.ID)
+}
+
+// Mutation returns the OrgRolesMutation object of the builder.
+func (oru *OrgRolesUpdate) Mutation() *OrgRolesMutation {
+	return oru.mutation
+}
+
+// ClearOrg clears the "org" edge to the Organizations entity.
+func (oru *OrgRolesUpdate) ClearOrg() *OrgRolesUpdate {
+	oru.mutation.ClearOrg()
+	return oru
+}
+
+// ClearUser clears the "user" edge to the Users entity.
+func (oru *OrgRolesUpdate) ClearUser() *OrgRolesUpdate {
+	oru.mutation.ClearUser()
+	return oru
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (oru *OrgRolesUpdate) ClearRole() *OrgRolesUpdate {
+	oru.mutation.ClearRole()
+	return oru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oru *OrgRolesUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oru.sqlSave, oru.mutation, oru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oru *OrgRolesUpdate) SaveX(ctx context.Context) int {
+	affected, err := oru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oru *OrgRolesUpdate) Exec(ctx context.Context) error {
+	_, err := oru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oru *OrgRolesUpdate) ExecX(ctx context.Context) {
+	if err := oru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oru *OrgRolesUpdate) check() error {
+	if _, ok := oru.mutation.OrgID(); oru.mutation.OrgCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrgRoles.org"`)
+	}
+	if _, ok := oru.mutation.UserID(); oru.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrgRoles.user"`)
+	}
+	if _, ok := oru.mutation.RoleID(); oru.mutation.RoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrgRoles.role"`)
+	}
+	return nil
+}
+
+func (oru *OrgRolesUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orgroles.Table, orgroles.Columns, sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt))
+	if ps := oru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oru.mutation.AssignedBy(); ok {
+		_spec.SetField(orgroles.FieldAssignedBy, field.TypeUUID, value)
+	}
+	if oru.mutation.AssignedByCleared() {
+		_spec.ClearField(orgroles.FieldAssignedBy, field.TypeUUID)
+	}
+	if oru.mutation.OrgCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.OrgTable,
+			Columns: []string{orgroles.OrgColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oru.mutation.OrgIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.OrgTable,
+			Columns: []string{orgroles.OrgColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.UserTable,
+			Columns: []string{orgroles.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.UserTable,
+			Columns: []string{orgroles.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oru.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.RoleTable,
+			Columns: []string{orgroles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oru.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.RoleTable,
+			Columns: []string{orgroles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orgroles.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oru.mutation.done = true
+	return n, nil
+}
+
+// OrgRolesUpdateOne is the builder for updating a single OrgRoles entity.
+type OrgRolesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrgRolesMutation
+}
+
+// SetOrgID sets the "org_id" field.
+func (oruo *OrgRolesUpdateOne) SetOrgID(u uuid.UUID) *OrgRolesUpdateOne {
+	oruo.mutation.SetOrgID(u)
+	return oruo
+}
+
+// SetNillableOrgID sets the "org_id" field if the given value is not nil.
+func (oruo *OrgRolesUpdateOne) SetNillableOrgID(u *uuid.UUID) *OrgRolesUpdateOne {
+	if u != nil {
+		oruo.SetOrgID(*u)
+	}
+	return oruo
+}
+
+// SetUserID sets the "user_id" field.
+func (oruo *OrgRolesUpdateOne) SetUserID(u uuid.UUID) *OrgRolesUpdateOne {
+	oruo.mutation.SetUserID(u)
+	return oruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (oruo *OrgRolesUpdateOne) SetNillableUserID(u *uuid.UUID) *OrgRolesUpdateOne {
+	if u != nil {
+		oruo.SetUserID(*u)
+	}
+	return oruo
+}
+
+// SetRoleID sets the "role_id" field.
+func (oruo *OrgRolesUpdateOne) SetRoleID(i int) *OrgRolesUpdateOne {
+	oruo.mutation.SetRoleID(i)
+	return oruo
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (oruo *OrgRolesUpdateOne) SetNillableRoleID(i *int) *OrgRolesUpdateOne {
+	if i != nil {
+		oruo.SetRoleID(*i)
+	}
+	return oruo
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (oruo *OrgRolesUpdateOne) SetAssignedBy(u uuid.UUID) *OrgRolesUpdateOne {
+	oruo.mutation.SetAssignedBy(u)
+	return oruo
+}
+
+// SetNillableAssignedBy sets the "assigned_by" field if the given value is not nil.
+func (oruo *OrgRolesUpdateOne) SetNillableAssignedBy(u *uuid.UUID) *OrgRolesUpdateOne {
+	if u != nil {
+		oruo.SetAssignedBy(*u)
+	}
+	return oruo
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (oruo *OrgRolesUpdateOne) ClearAssignedBy() *OrgRolesUpdateOne {
+	oruo.mutation.ClearAssignedBy()
+	return oruo
+}
+
+// SetOrg sets the "org" edge to the Organizations entity.
+func (oruo *OrgRolesUpdateOne) SetOrg(o *Organizations) *OrgRolesUpdateOne {
+	return oruo.SetOrgID(o.ID)
+}
+
+// SetUser sets the "user" edge to the Users entity.
+func (oruo *OrgRolesUpdateOne) SetUser(u *Users) *OrgRolesUpdateOne {
+	return oruo.SetUserID(u.ID)
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (oruo *OrgRolesUpdateOne) SetRole(r *Roles) *OrgRolesUpdateOne {
+	return oruo.SetRoleID(r.ID)
+}
+
+// Mutation returns the OrgRolesMutation object of the builder.
+func (oruo *OrgRolesUpdateOne) Mutation() *OrgRolesMutation {
+	return oruo.mutation
+}
+
+// ClearOrg clears the "org" edge to the Organizations entity.
+func (oruo *OrgRolesUpdateOne) ClearOrg() *OrgRolesUpdateOne {
+	oruo.mutation.ClearOrg()
+	return oruo
+}
+
+// ClearUser clears the "user" edge to the Users entity.
+func (oruo *OrgRolesUpdateOne) ClearUser() *OrgRolesUpdateOne {
+	oruo.mutation.ClearUser()
+	return oruo
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (oruo *OrgRolesUpdateOne) ClearRole() *OrgRolesUpdateOne {
+	oruo.mutation.ClearRole()
+	return oruo
+}
+
+// Where appends a list predicates to the OrgRolesUpdate builder.
+func (oruo *OrgRolesUpdateOne) Where(ps ...predicate.OrgRoles) *OrgRolesUpdateOne {
+	oruo.mutation.Where(ps...)
+	return oruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oruo *OrgRolesUpdateOne) Select(field string, fields ...string) *OrgRolesUpdateOne {
+	oruo.fields = append([]string{field}, fields...)
+	return oruo
+}
+
+// Save executes the query and returns the updated OrgRoles entity.
+func (oruo *OrgRolesUpdateOne) Save(ctx context.Context) (*OrgRoles, error) {
+	return withHooks(ctx, oruo.sqlSave, oruo.mutation, oruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oruo *OrgRolesUpdateOne) SaveX(ctx context.Context) *OrgRoles {
+	node, err := oruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oruo *OrgRolesUpdateOne) Exec(ctx context.Context) error {
+	_, err := oruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oruo *OrgRolesUpdateOne) ExecX(ctx context.Context) {
+	if err := oruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oruo *OrgRolesUpdateOne) check() error {
+	if _, ok := oruo.mutation.OrgID(); oruo.mutation.OrgCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrgRoles.org"`)
+	}
+	if _, ok := oruo.mutation.UserID(); oruo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrgRoles.user"`)
+	}
+	if _, ok := oruo.mutation.RoleID(); oruo.mutation.RoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "OrgRoles.role"`)
+	}
+	return nil
+}
+
+func (oruo *OrgRolesUpdateOne) sqlSave(ctx context.Context) (_node *OrgRoles, err error) {
+	if err := oruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orgroles.Table, orgroles.Columns, sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt))
+	id, ok := oruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrgRoles.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orgroles.FieldID)
+		for _, f := range fields {
+			if !orgroles.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != orgroles.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oruo.mutation.AssignedBy(); ok {
+		_spec.SetField(orgroles.FieldAssignedBy, field.TypeUUID, value)
+	}
+	if oruo.mutation.AssignedByCleared() {
+		_spec.ClearField(orgroles.FieldAssignedBy, field.TypeUUID)
+	}
+	if oruo.mutation.OrgCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.OrgTable,
+			Columns: []string{orgroles.OrgColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oruo.mutation.OrgIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.OrgTable,
+			Columns: []string{orgroles.OrgColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizations.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.UserTable,
+			Columns: []string{orgroles.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.UserTable,
+			Columns: []string{orgroles.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if oruo.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.RoleTable,
+			Columns: []string{orgroles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oruo.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   orgroles.RoleTable,
+			Columns: []string{orgroles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OrgRoles{config: oruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orgroles.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/passwordhistories"
+)
+
+// PasswordHistories is the model entity for the PasswordHistories schema.
+type PasswordHistories struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Hash of a password previously set by the user
+	PasswordHash string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PasswordHistories) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case passwordhistories.FieldPasswordHash:
+			values[i] = new(sql.NullString)
+		case passwordhistories.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case passwordhistories.FieldID, passwordhistories.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PasswordHistories fields.
+func (ph *PasswordHistories) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case passwordhistories.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ph.ID = *value
+			}
+		case passwordhistories.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				ph.UserID = *value
+			}
+		case passwordhistories.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				ph.PasswordHash = value.String
+			}
+		case passwordhistories.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ph.CreatedAt = value.Time
+			}
+		default:
+			ph.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PasswordHistories.
+// This includes values selected through modifiers, order, etc.
+func (ph *PasswordHistories) Value(name string) (ent.Value, error) {
+	return ph.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PasswordHistories.
+// Note that you need to call PasswordHistories.Unwrap() before calling this method if this PasswordHistories
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ph *PasswordHistories) Update() *PasswordHistoriesUpdateOne {
+	return NewPasswordHistoriesClient(ph.config).UpdateOne(ph)
+}
+
+// Unwrap unwraps the PasswordHistories entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ph *PasswordHistories) Unwrap() *PasswordHistories {
+	_tx, ok := ph.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PasswordHistories is not a transactional entity")
+	}
+	ph.config.driver = _tx.drv
+	return ph
+}
+
+// String implements the fmt.Stringer.
+func (ph *PasswordHistories) String() string {
+	var builder strings.Builder
+	builder.WriteString("PasswordHistories(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ph.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ph.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ph.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PasswordHistoriesSlice is a parsable slice of PasswordHistories.
+type PasswordHistoriesSlice []*PasswordHistories
@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/passwordhistories"
+)
+
+// PasswordHistoriesCreate is the builder for creating a PasswordHistories entity.
+type PasswordHistoriesCreate struct {
+	config
+	mutation *PasswordHistoriesMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (phc *PasswordHistoriesCreate) SetUserID(u uuid.UUID) *PasswordHistoriesCreate {
+	phc.mutation.SetUserID(u)
+	return phc
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (phc *PasswordHistoriesCreate) SetPasswordHash(s string) *PasswordHistoriesCreate {
+	phc.mutation.SetPasswordHash(s)
+	return phc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (phc *PasswordHistoriesCreate) SetCreatedAt(t time.Time) *PasswordHistoriesCreate {
+	phc.mutation.SetCreatedAt(t)
+	return phc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (phc *PasswordHistoriesCreate) SetNillableCreatedAt(t *time.Time) *PasswordHistoriesCreate {
+	if t != nil {
+		phc.SetCreatedAt(*t)
+	}
+	return phc
+}
+
+// SetID sets the "id" field.
+func (phc *PasswordHistoriesCreate) SetID(u uuid.UUID) *PasswordHistoriesCreate {
+	phc.mutation.SetID(u)
+	return phc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (phc *PasswordHistoriesCreate) SetNillableID(u *uuid.UUID) *PasswordHistoriesCreate {
+	if u != nil {
+		phc.SetID(*u)
+	}
+	return phc
+}
+
+// Mutation returns the PasswordHistoriesMutation object of the builder.
+func (phc *PasswordHistoriesCreate) Mutation() *PasswordHistoriesMutation {
+	return phc.mutation
+}
+
+// Save creates the PasswordHistories in the database.
+func (phc *PasswordHistoriesCreate) Save(ctx context.Context) (*PasswordHistories, error) {
+	phc.defaults()
+	return withHooks(ctx, phc.sqlSave, phc.mutation, phc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (phc *PasswordHistoriesCreate) SaveX(ctx context.Context) *PasswordHistories {
+	v, err := phc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (phc *PasswordHistoriesCreate) Exec(ctx context.Context) error {
+	_, err := phc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phc *PasswordHistoriesCreate) ExecX(ctx context.Context) {
+	if err := phc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (phc *PasswordHistoriesCreate) defaults() {
+	if _, ok := phc.mutation.CreatedAt(); !ok {
+		v := passwordhistories.DefaultCreatedAt()
+		phc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := phc.mutation.ID(); !ok {
+		v := passwordhistories.DefaultID()
+		phc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phc *PasswordHistoriesCreate) check() error {
+	if _, ok := phc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PasswordHistories.user_id"`)}
+	}
+	if _, ok := phc.mutation.PasswordHash(); !ok {
+		return &ValidationError{Name: "password_hash", err: errors.New(`ent: missing required field "PasswordHistories.password_hash"`)}
+	}
+	if v, ok := phc.mutation.PasswordHash(); ok {
+		if err := passwordhistories.PasswordHashValidator(v); err != nil {
+			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "PasswordHistories.password_hash": %w`, err)}
+		}
+	}
+	if _, ok := phc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PasswordHistories.created_at"`)}
+	}
+	return nil
+}
+
+func (phc *PasswordHistoriesCreate) sqlSave(ctx context.Context) (*PasswordHistories, error) {
+	if err := phc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := phc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, phc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	phc.mutation.id = &_node.ID
+	phc.mutation.done = true
+	return _node, nil
+}
+
+func (phc *PasswordHistoriesCreate) createSpec() (*PasswordHistories, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PasswordHistories{config: phc.config}
+		_spec = sqlgraph.NewCreateSpec(passwordhistories.Table, sqlgraph.NewFieldSpec(passwordhistories.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = phc.conflict
+	if id, ok := phc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := phc.mutation.UserID(); ok {
+		_spec.SetField(passwordhistories.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := phc.mutation.PasswordHash(); ok {
+		_spec.SetField(passwordhistories.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = value
+	}
+	if value, ok := phc.mutation.CreatedAt(); ok {
+		_spec.SetField(passwordhistories.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PasswordHistories.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasswordHistoriesUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (phc *PasswordHistoriesCreate) OnConflict(opts ...sql.ConflictOption) *PasswordHistoriesUpsertOne {
+	phc.conflict = opts
+	return &PasswordHistoriesUpsertOne{
+		create: phc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PasswordHistories.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (phc *PasswordHistoriesCreate) OnConflictColumns(columns ...string) *PasswordHistoriesUpsertOne {
+	phc.conflict = append(phc.conflict, sql.ConflictColumns(columns...))
+	return &PasswordHistoriesUpsertOne{
+		create: phc,
+	}
+}
+
+type (
+	// PasswordHistoriesUpsertOne is the builder for "upsert"-ing
+	//  one PasswordHistories node.
+	PasswordHistoriesUpsertOne struct {
+		create *PasswordHistoriesCreate
+	}
+
+	// PasswordHistoriesUpsert is the "OnConflict" setter.
+	PasswordHistoriesUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *PasswordHistoriesUpsert) SetUserID(v uuid.UUID) *PasswordHistoriesUpsert {
+	u.Set(passwordhistories.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasswordHistoriesUpsert) UpdateUserID() *PasswordHistoriesUpsert {
+	u.SetExcluded(passwordhistories.FieldUserID)
+	return u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *PasswordHistoriesUpsert) SetPasswordHash(v string) *PasswordHistoriesUpsert {
+	u.Set(passwordhistories.FieldPasswordHash, v)
+	return u
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *PasswordHistoriesUpsert) UpdatePasswordHash() *PasswordHistoriesUpsert {
+	u.SetExcluded(passwordhistories.FieldPasswordHash)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PasswordHistories.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passwordhistories.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasswordHistoriesUpsertOne) UpdateNewValues() *PasswordHistoriesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(passwordhistories.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(passwordhistories.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PasswordHistories.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PasswordHistoriesUpsertOne) Ignore() *PasswordHistoriesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasswordHistoriesUpsertOne) DoNothing() *PasswordHistoriesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasswordHistoriesCreate.OnConflict
+// documentation for more info.
+func (u *PasswordHistoriesUpsertOne) Update(set func(*PasswordHistoriesUpsert)) *PasswordHistoriesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasswordHistoriesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PasswordHistoriesUpsertOne) SetUserID(v uuid.UUID) *PasswordHistoriesUpsertOne {
+	return u.Update(func(s *PasswordHistoriesUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasswordHistoriesUpsertOne) UpdateUserID() *PasswordHistoriesUpsertOne {
+	return u.Update(func(s *PasswordHistoriesUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *PasswordHistoriesUpsertOne) SetPasswordHash(v string) *PasswordHistoriesUpsertOne {
+	return u.Update(func(s *PasswordHistoriesUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *PasswordHistoriesUpsertOne) UpdatePasswordHash() *PasswordHistoriesUpsertOne {
+	return u.Update(func(s *PasswordHistoriesUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// Exec executes the query.
+func (u *PasswordHistoriesUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasswordHistoriesCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasswordHistoriesUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PasswordHistoriesUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PasswordHistoriesUpsertOne.ID is not supported by MySQL driver. Use PasswordHistoriesUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PasswordHistoriesUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PasswordHistoriesCreateBulk is the builder for creating many PasswordHistories entities in bulk.
+type PasswordHistoriesCreateBulk struct {
+	config
+	err      error
+	builders []*PasswordHistoriesCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PasswordHistories entities in the database.
+func (phcb *PasswordHistoriesCreateBulk) Save(ctx context.Context) ([]*PasswordHistories, error) {
+	if phcb.err != nil {
+		return nil, phcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(phcb.builders))
+	nodes := make([]*PasswordHistories, len(phcb.builders))
+	mutators := make([]Mutator, len(phcb.builders))
+	for i := range phcb.builders {
+		func(i int, root context.Context) {
+			builder := phcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PasswordHistoriesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, phcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = phcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, phcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, phcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phcb *PasswordHistoriesCreateBulk) SaveX(ctx context.Context) []*PasswordHistories {
+	v, err := phcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (phcb *PasswordHistoriesCreateBulk) Exec(ctx context.Context) error {
+	_, err := phcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phcb *PasswordHistoriesCreateBulk) ExecX(ctx context.Context) {
+	if err := phcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PasswordHistories.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasswordHistoriesUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (phcb *PasswordHistoriesCreateBulk) OnConflict(opts ...sql.ConflictOption) *PasswordHistoriesUpsertBulk {
+	phcb.conflict = opts
+	return &PasswordHistoriesUpsertBulk{
+		create: phcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PasswordHistories.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (phcb *PasswordHistoriesCreateBulk) OnConflictColumns(columns ...string) *PasswordHistoriesUpsertBulk {
+	phcb.conflict = append(phcb.conflict, sql.ConflictColumns(columns...))
+	return &PasswordHistoriesUpsertBulk{
+		create: phcb,
+	}
+}
+
+// PasswordHistoriesUpsertBulk is the builder for "upsert"-ing
+// a bulk of PasswordHistories nodes.
+type PasswordHistoriesUpsertBulk struct {
+	create *PasswordHistoriesCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PasswordHistories.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passwordhistories.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasswordHistoriesUpsertBulk) UpdateNewValues() *PasswordHistoriesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(passwordhistories.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(passwordhistories.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PasswordHistories.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PasswordHistoriesUpsertBulk) Ignore() *PasswordHistoriesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasswordHistoriesUpsertBulk) DoNothing() *PasswordHistoriesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasswordHistoriesCreateBulk.OnConflict
+// documentation for more info.
+func (u *PasswordHistoriesUpsertBulk) Update(set func(*PasswordHistoriesUpsert)) *PasswordHistoriesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasswordHistoriesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PasswordHistoriesUpsertBulk) SetUserID(v uuid.UUID) *PasswordHistoriesUpsertBulk {
+	return u.Update(func(s *PasswordHistoriesUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasswordHistoriesUpsertBulk) UpdateUserID() *PasswordHistoriesUpsertBulk {
+	return u.Update(func(s *PasswordHistoriesUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *PasswordHistoriesUpsertBulk) SetPasswordHash(v string) *PasswordHistoriesUpsertBulk {
+	return u.Update(func(s *PasswordHistoriesUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *PasswordHistoriesUpsertBulk) UpdatePasswordHash() *PasswordHistoriesUpsertBulk {
+	return u.Update(func(s *PasswordHistoriesUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// Exec executes the query.
+func (u *PasswordHistoriesUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PasswordHistoriesCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasswordHistoriesCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasswordHistoriesUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
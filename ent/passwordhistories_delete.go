@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/passwordhistories"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// PasswordHistoriesDelete is the builder for deleting a PasswordHistories entity.
+type PasswordHistoriesDelete struct {
+	config
+	hooks    []Hook
+	mutation *PasswordHistoriesMutation
+}
+
+// Where appends a list predicates to the PasswordHistoriesDelete builder.
+func (phd *PasswordHistoriesDelete) Where(ps ...predicate.PasswordHistories) *PasswordHistoriesDelete {
+	phd.mutation.Where(ps...)
+	return phd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (phd *PasswordHistoriesDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, phd.sqlExec, phd.mutation, phd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phd *PasswordHistoriesDelete) ExecX(ctx context.Context) int {
+	n, err := phd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (phd *PasswordHistoriesDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(passwordhistories.Table, sqlgraph.NewFieldSpec(passwordhistories.FieldID, field.TypeUUID))
+	if ps := phd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, phd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	phd.mutation.done = true
+	return affected, err
+}
+
+// PasswordHistoriesDeleteOne is the builder for deleting a single PasswordHistories entity.
+type PasswordHistoriesDeleteOne struct {
+	phd *PasswordHistoriesDelete
+}
+
+// Where appends a list predicates to the PasswordHistoriesDelete builder.
+func (phdo *PasswordHistoriesDeleteOne) Where(ps ...predicate.PasswordHistories) *PasswordHistoriesDeleteOne {
+	phdo.phd.mutation.Where(ps...)
+	return phdo
+}
+
+// Exec executes the deletion query.
+func (phdo *PasswordHistoriesDeleteOne) Exec(ctx context.Context) error {
+	n, err := phdo.phd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{passwordhistories.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phdo *PasswordHistoriesDeleteOne) ExecX(ctx context.Context) {
+	if err := phdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
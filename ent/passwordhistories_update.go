@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/passwordhistories"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// PasswordHistoriesUpdate is the builder for updating PasswordHistories entities.
+type PasswordHistoriesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasswordHistoriesMutation
+}
+
+// Where appends a list predicates to the PasswordHistoriesUpdate builder.
+func (phu *PasswordHistoriesUpdate) Where(ps ...predicate.PasswordHistories) *PasswordHistoriesUpdate {
+	phu.mutation.Where(ps...)
+	return phu
+}
+
+// SetUserID sets the "user_id" field.
+func (phu *PasswordHistoriesUpdate) SetUserID(u uuid.UUID) *PasswordHistoriesUpdate {
+	phu.mutation.SetUserID(u)
+	return phu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (phu *PasswordHistoriesUpdate) SetNillableUserID(u *uuid.UUID) *PasswordHistoriesUpdate {
+	if u != nil {
+		phu.SetUserID(*u)
+	}
+	return phu
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (phu *PasswordHistoriesUpdate) SetPasswordHash(s string) *PasswordHistoriesUpdate {
+	phu.mutation.SetPasswordHash(s)
+	return phu
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (phu *PasswordHistoriesUpdate) SetNillablePasswordHash(s *string) *PasswordHistoriesUpdate {
+	if s != nil {
+		phu.SetPasswordHash(*s)
+	}
+	return phu
+}
+
+// Mutation returns the PasswordHistoriesMutation object of the builder.
+func (phu *PasswordHistoriesUpdate) Mutation() *PasswordHistoriesMutation {
+	return phu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (phu *PasswordHistoriesUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, phu.sqlSave, phu.mutation, phu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phu *PasswordHistoriesUpdate) SaveX(ctx context.Context) int {
+	affected, err := phu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (phu *PasswordHistoriesUpdate) Exec(ctx context.Context) error {
+	_, err := phu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phu *PasswordHistoriesUpdate) ExecX(ctx context.Context) {
+	if err := phu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phu *PasswordHistoriesUpdate) check() error {
+	if v, ok := phu.mutation.PasswordHash(); ok {
+		if err := passwordhistories.PasswordHashValidator(v); err != nil {
+			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "PasswordHistories.password_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (phu *PasswordHistoriesUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := phu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordhistories.Table, passwordhistories.Columns, sqlgraph.NewFieldSpec(passwordhistories.FieldID, field.TypeUUID))
+	if ps := phu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := phu.mutation.UserID(); ok {
+		_spec.SetField(passwordhistories.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := phu.mutation.PasswordHash(); ok {
+		_spec.SetField(passwordhistories.FieldPasswordHash, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, phu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordhistories.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	phu.mutation.done = true
+	return n, nil
+}
+
+// PasswordHistoriesUpdateOne is the builder for updating a single PasswordHistories entity.
+type PasswordHistoriesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasswordHistoriesMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (phuo *PasswordHistoriesUpdateOne) SetUserID(u uuid.UUID) *PasswordHistoriesUpdateOne {
+	phuo.mutation.SetUserID(u)
+	return phuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (phuo *PasswordHistoriesUpdateOne) SetNillableUserID(u *uuid.UUID) *PasswordHistoriesUpdateOne {
+	if u != nil {
+		phuo.SetUserID(*u)
+	}
+	return phuo
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (phuo *PasswordHistoriesUpdateOne) SetPasswordHash(s string) *PasswordHistoriesUpdateOne {
+	phuo.mutation.SetPasswordHash(s)
+	return phuo
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (phuo *PasswordHistoriesUpdateOne) SetNillablePasswordHash(s *string) *PasswordHistoriesUpdateOne {
+	if s != nil {
+		phuo.SetPasswordHash(*s)
+	}
+	return phuo
+}
+
+// Mutation returns the PasswordHistoriesMutation object of the builder.
+func (phuo *PasswordHistoriesUpdateOne) Mutation() *PasswordHistoriesMutation {
+	return phuo.mutation
+}
+
+// Where appends a list predicates to the PasswordHistoriesUpdate builder.
+func (phuo *PasswordHistoriesUpdateOne) Where(ps ...predicate.PasswordHistories) *PasswordHistoriesUpdateOne {
+	phuo.mutation.Where(ps...)
+	return phuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (phuo *PasswordHistoriesUpdateOne) Select(field string, fields ...string) *PasswordHistoriesUpdateOne {
+	phuo.fields = append([]string{field}, fields...)
+	return phuo
+}
+
+// Save executes the query and returns the updated PasswordHistories entity.
+func (phuo *PasswordHistoriesUpdateOne) Save(ctx context.Context) (*PasswordHistories, error) {
+	return withHooks(ctx, phuo.sqlSave, phuo.mutation, phuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phuo *PasswordHistoriesUpdateOne) SaveX(ctx context.Context) *PasswordHistories {
+	node, err := phuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (phuo *PasswordHistoriesUpdateOne) Exec(ctx context.Context) error {
+	_, err := phuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phuo *PasswordHistoriesUpdateOne) ExecX(ctx context.Context) {
+	if err := phuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phuo *PasswordHistoriesUpdateOne) check() error {
+	if v, ok := phuo.mutation.PasswordHash(); ok {
+		if err := passwordhistories.PasswordHashValidator(v); err != nil {
+			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "PasswordHistories.password_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (phuo *PasswordHistoriesUpdateOne) sqlSave(ctx context.Context) (_node *PasswordHistories, err error) {
+	if err := phuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordhistories.Table, passwordhistories.Columns, sqlgraph.NewFieldSpec(passwordhistories.FieldID, field.TypeUUID))
+	id, ok := phuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PasswordHistories.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := phuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordhistories.FieldID)
+		for _, f := range fields {
+			if !passwordhistories.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passwordhistories.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := phuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := phuo.mutation.UserID(); ok {
+		_spec.SetField(passwordhistories.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := phuo.mutation.PasswordHash(); ok {
+		_spec.SetField(passwordhistories.FieldPasswordHash, field.TypeString, value)
+	}
+	_node = &PasswordHistories{config: phuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, phuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordhistories.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	phuo.mutation.done = true
+	return _node, nil
+}
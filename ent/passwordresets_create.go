@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -19,6 +21,7 @@ type PasswordResetsCreate struct {
 	config
 	mutation *PasswordResetsMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -225,6 +228,7 @@ func (prc *PasswordResetsCreate) createSpec() (*PasswordResets, *sqlgraph.Create
 		_node = &PasswordResets{config: prc.config}
 		_spec = sqlgraph.NewCreateSpec(passwordresets.Table, sqlgraph.NewFieldSpec(passwordresets.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = prc.conflict
 	if id, ok := prc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -264,11 +268,358 @@ func (prc *PasswordResetsCreate) createSpec() (*PasswordResets, *sqlgraph.Create
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PasswordResets.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasswordResetsUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (prc *PasswordResetsCreate) OnConflict(opts ...sql.ConflictOption) *PasswordResetsUpsertOne {
+	prc.conflict = opts
+	return &PasswordResetsUpsertOne{
+		create: prc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PasswordResets.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prc *PasswordResetsCreate) OnConflictColumns(columns ...string) *PasswordResetsUpsertOne {
+	prc.conflict = append(prc.conflict, sql.ConflictColumns(columns...))
+	return &PasswordResetsUpsertOne{
+		create: prc,
+	}
+}
+
+type (
+	// PasswordResetsUpsertOne is the builder for "upsert"-ing
+	//  one PasswordResets node.
+	PasswordResetsUpsertOne struct {
+		create *PasswordResetsCreate
+	}
+
+	// PasswordResetsUpsert is the "OnConflict" setter.
+	PasswordResetsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *PasswordResetsUpsert) SetUserID(v uuid.UUID) *PasswordResetsUpsert {
+	u.Set(passwordresets.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasswordResetsUpsert) UpdateUserID() *PasswordResetsUpsert {
+	u.SetExcluded(passwordresets.FieldUserID)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *PasswordResetsUpsert) SetEmail(v string) *PasswordResetsUpsert {
+	u.Set(passwordresets.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *PasswordResetsUpsert) UpdateEmail() *PasswordResetsUpsert {
+	u.SetExcluded(passwordresets.FieldEmail)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *PasswordResetsUpsert) SetToken(v string) *PasswordResetsUpsert {
+	u.Set(passwordresets.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *PasswordResetsUpsert) UpdateToken() *PasswordResetsUpsert {
+	u.SetExcluded(passwordresets.FieldToken)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PasswordResetsUpsert) SetExpiresAt(v time.Time) *PasswordResetsUpsert {
+	u.Set(passwordresets.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PasswordResetsUpsert) UpdateExpiresAt() *PasswordResetsUpsert {
+	u.SetExcluded(passwordresets.FieldExpiresAt)
+	return u
+}
+
+// SetIsUsed sets the "is_used" field.
+func (u *PasswordResetsUpsert) SetIsUsed(v bool) *PasswordResetsUpsert {
+	u.Set(passwordresets.FieldIsUsed, v)
+	return u
+}
+
+// UpdateIsUsed sets the "is_used" field to the value that was provided on create.
+func (u *PasswordResetsUpsert) UpdateIsUsed() *PasswordResetsUpsert {
+	u.SetExcluded(passwordresets.FieldIsUsed)
+	return u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *PasswordResetsUpsert) SetUsedAt(v time.Time) *PasswordResetsUpsert {
+	u.Set(passwordresets.FieldUsedAt, v)
+	return u
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *PasswordResetsUpsert) UpdateUsedAt() *PasswordResetsUpsert {
+	u.SetExcluded(passwordresets.FieldUsedAt)
+	return u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *PasswordResetsUpsert) ClearUsedAt() *PasswordResetsUpsert {
+	u.SetNull(passwordresets.FieldUsedAt)
+	return u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *PasswordResetsUpsert) SetIPAddress(v string) *PasswordResetsUpsert {
+	u.Set(passwordresets.FieldIPAddress, v)
+	return u
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *PasswordResetsUpsert) UpdateIPAddress() *PasswordResetsUpsert {
+	u.SetExcluded(passwordresets.FieldIPAddress)
+	return u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *PasswordResetsUpsert) ClearIPAddress() *PasswordResetsUpsert {
+	u.SetNull(passwordresets.FieldIPAddress)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PasswordResets.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passwordresets.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasswordResetsUpsertOne) UpdateNewValues() *PasswordResetsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(passwordresets.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(passwordresets.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PasswordResets.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PasswordResetsUpsertOne) Ignore() *PasswordResetsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasswordResetsUpsertOne) DoNothing() *PasswordResetsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasswordResetsCreate.OnConflict
+// documentation for more info.
+func (u *PasswordResetsUpsertOne) Update(set func(*PasswordResetsUpsert)) *PasswordResetsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasswordResetsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PasswordResetsUpsertOne) SetUserID(v uuid.UUID) *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasswordResetsUpsertOne) UpdateUserID() *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *PasswordResetsUpsertOne) SetEmail(v string) *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *PasswordResetsUpsertOne) UpdateEmail() *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *PasswordResetsUpsertOne) SetToken(v string) *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *PasswordResetsUpsertOne) UpdateToken() *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PasswordResetsUpsertOne) SetExpiresAt(v time.Time) *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PasswordResetsUpsertOne) UpdateExpiresAt() *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetIsUsed sets the "is_used" field.
+func (u *PasswordResetsUpsertOne) SetIsUsed(v bool) *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetIsUsed(v)
+	})
+}
+
+// UpdateIsUsed sets the "is_used" field to the value that was provided on create.
+func (u *PasswordResetsUpsertOne) UpdateIsUsed() *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateIsUsed()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *PasswordResetsUpsertOne) SetUsedAt(v time.Time) *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *PasswordResetsUpsertOne) UpdateUsedAt() *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *PasswordResetsUpsertOne) ClearUsedAt() *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *PasswordResetsUpsertOne) SetIPAddress(v string) *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *PasswordResetsUpsertOne) UpdateIPAddress() *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *PasswordResetsUpsertOne) ClearIPAddress() *PasswordResetsUpsertOne {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// Exec executes the query.
+func (u *PasswordResetsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasswordResetsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasswordResetsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PasswordResetsUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PasswordResetsUpsertOne.ID is not supported by MySQL driver. Use PasswordResetsUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PasswordResetsUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PasswordResetsCreateBulk is the builder for creating many PasswordResets entities in bulk.
 type PasswordResetsCreateBulk struct {
 	config
 	err      error
 	builders []*PasswordResetsCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PasswordResets entities in the database.
@@ -298,6 +649,7 @@ func (prcb *PasswordResetsCreateBulk) Save(ctx context.Context) ([]*PasswordRese
 					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = prcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -347,3 +699,232 @@ func (prcb *PasswordResetsCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PasswordResets.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PasswordResetsUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (prcb *PasswordResetsCreateBulk) OnConflict(opts ...sql.ConflictOption) *PasswordResetsUpsertBulk {
+	prcb.conflict = opts
+	return &PasswordResetsUpsertBulk{
+		create: prcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PasswordResets.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (prcb *PasswordResetsCreateBulk) OnConflictColumns(columns ...string) *PasswordResetsUpsertBulk {
+	prcb.conflict = append(prcb.conflict, sql.ConflictColumns(columns...))
+	return &PasswordResetsUpsertBulk{
+		create: prcb,
+	}
+}
+
+// PasswordResetsUpsertBulk is the builder for "upsert"-ing
+// a bulk of PasswordResets nodes.
+type PasswordResetsUpsertBulk struct {
+	create *PasswordResetsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PasswordResets.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(passwordresets.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PasswordResetsUpsertBulk) UpdateNewValues() *PasswordResetsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(passwordresets.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(passwordresets.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PasswordResets.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PasswordResetsUpsertBulk) Ignore() *PasswordResetsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PasswordResetsUpsertBulk) DoNothing() *PasswordResetsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PasswordResetsCreateBulk.OnConflict
+// documentation for more info.
+func (u *PasswordResetsUpsertBulk) Update(set func(*PasswordResetsUpsert)) *PasswordResetsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PasswordResetsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PasswordResetsUpsertBulk) SetUserID(v uuid.UUID) *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PasswordResetsUpsertBulk) UpdateUserID() *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *PasswordResetsUpsertBulk) SetEmail(v string) *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *PasswordResetsUpsertBulk) UpdateEmail() *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *PasswordResetsUpsertBulk) SetToken(v string) *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *PasswordResetsUpsertBulk) UpdateToken() *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PasswordResetsUpsertBulk) SetExpiresAt(v time.Time) *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PasswordResetsUpsertBulk) UpdateExpiresAt() *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetIsUsed sets the "is_used" field.
+func (u *PasswordResetsUpsertBulk) SetIsUsed(v bool) *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetIsUsed(v)
+	})
+}
+
+// UpdateIsUsed sets the "is_used" field to the value that was provided on create.
+func (u *PasswordResetsUpsertBulk) UpdateIsUsed() *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateIsUsed()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *PasswordResetsUpsertBulk) SetUsedAt(v time.Time) *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *PasswordResetsUpsertBulk) UpdateUsedAt() *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *PasswordResetsUpsertBulk) ClearUsedAt() *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *PasswordResetsUpsertBulk) SetIPAddress(v string) *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *PasswordResetsUpsertBulk) UpdateIPAddress() *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *PasswordResetsUpsertBulk) ClearIPAddress() *PasswordResetsUpsertBulk {
+	return u.Update(func(s *PasswordResetsUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// Exec executes the query.
+func (u *PasswordResetsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PasswordResetsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PasswordResetsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PasswordResetsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
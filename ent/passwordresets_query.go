@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -22,6 +23,7 @@ type PasswordResetsQuery struct {
 	order      []passwordresets.OrderOption
 	inters     []Interceptor
 	predicates []predicate.PasswordResets
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -343,6 +345,9 @@ func (prq *PasswordResetsQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	if len(prq.modifiers) > 0 {
+		_spec.Modifiers = prq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -357,6 +362,9 @@ func (prq *PasswordResetsQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 
 func (prq *PasswordResetsQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := prq.querySpec()
+	if len(prq.modifiers) > 0 {
+		_spec.Modifiers = prq.modifiers
+	}
 	_spec.Node.Columns = prq.ctx.Fields
 	if len(prq.ctx.Fields) > 0 {
 		_spec.Unique = prq.ctx.Unique != nil && *prq.ctx.Unique
@@ -419,6 +427,9 @@ func (prq *PasswordResetsQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if prq.ctx.Unique != nil && *prq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range prq.modifiers {
+		m(selector)
+	}
 	for _, p := range prq.predicates {
 		p(selector)
 	}
@@ -436,6 +447,32 @@ func (prq *PasswordResetsQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (prq *PasswordResetsQuery) ForUpdate(opts ...sql.LockOption) *PasswordResetsQuery {
+	if prq.driver.Dialect() == dialect.Postgres {
+		prq.Unique(false)
+	}
+	prq.modifiers = append(prq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return prq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (prq *PasswordResetsQuery) ForShare(opts ...sql.LockOption) *PasswordResetsQuery {
+	if prq.driver.Dialect() == dialect.Postgres {
+		prq.Unique(false)
+	}
+	prq.modifiers = append(prq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return prq
+}
+
 // PasswordResetsGroupBy is the group-by builder for PasswordResets entities.
 type PasswordResetsGroupBy struct {
 	selector
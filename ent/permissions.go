@@ -27,6 +27,8 @@ type Permissions struct {
 	Resource string `json:"resource,omitempty"`
 	// Action this permission allows (e.g., read, write, delete)
 	Action string `json:"action,omitempty"`
+	// System permissions come from the bootstrap config and cannot be deleted or modified via API
+	IsSystem bool `json:"is_system,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -60,6 +62,8 @@ func (*Permissions) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case permissions.FieldIsSystem:
+			values[i] = new(sql.NullBool)
 		case permissions.FieldID:
 			values[i] = new(sql.NullInt64)
 		case permissions.FieldCode, permissions.FieldName, permissions.FieldDescription, permissions.FieldResource, permissions.FieldAction:
@@ -117,6 +121,12 @@ func (pe *Permissions) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pe.Action = value.String
 			}
+		case permissions.FieldIsSystem:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_system", values[i])
+			} else if value.Valid {
+				pe.IsSystem = value.Bool
+			}
 		case permissions.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -185,6 +195,9 @@ func (pe *Permissions) String() string {
 	builder.WriteString("action=")
 	builder.WriteString(pe.Action)
 	builder.WriteString(", ")
+	builder.WriteString("is_system=")
+	builder.WriteString(fmt.Sprintf("%v", pe.IsSystem))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(pe.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -80,6 +80,11 @@ func Action(v string) predicate.Permissions {
 	return predicate.Permissions(sql.FieldEQ(FieldAction, v))
 }
 
+// IsSystem applies equality check predicate on the "is_system" field. It's identical to IsSystemEQ.
+func IsSystem(v bool) predicate.Permissions {
+	return predicate.Permissions(sql.FieldEQ(FieldIsSystem, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Permissions {
 	return predicate.Permissions(sql.FieldEQ(FieldCreatedAt, v))
@@ -445,6 +450,16 @@ func ActionContainsFold(v string) predicate.Permissions {
 	return predicate.Permissions(sql.FieldContainsFold(FieldAction, v))
 }
 
+// IsSystemEQ applies the EQ predicate on the "is_system" field.
+func IsSystemEQ(v bool) predicate.Permissions {
+	return predicate.Permissions(sql.FieldEQ(FieldIsSystem, v))
+}
+
+// IsSystemNEQ applies the NEQ predicate on the "is_system" field.
+func IsSystemNEQ(v bool) predicate.Permissions {
+	return predicate.Permissions(sql.FieldNEQ(FieldIsSystem, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Permissions {
 	return predicate.Permissions(sql.FieldEQ(FieldCreatedAt, v))
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/shammianand/go-auth/ent/permissions"
@@ -19,6 +20,7 @@ type PermissionsCreate struct {
 	config
 	mutation *PermissionsMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCode sets the "code" field.
@@ -75,6 +77,20 @@ func (pc *PermissionsCreate) SetNillableAction(s *string) *PermissionsCreate {
 	return pc
 }
 
+// SetIsSystem sets the "is_system" field.
+func (pc *PermissionsCreate) SetIsSystem(b bool) *PermissionsCreate {
+	pc.mutation.SetIsSystem(b)
+	return pc
+}
+
+// SetNillableIsSystem sets the "is_system" field if the given value is not nil.
+func (pc *PermissionsCreate) SetNillableIsSystem(b *bool) *PermissionsCreate {
+	if b != nil {
+		pc.SetIsSystem(*b)
+	}
+	return pc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pc *PermissionsCreate) SetCreatedAt(t time.Time) *PermissionsCreate {
 	pc.mutation.SetCreatedAt(t)
@@ -159,6 +175,10 @@ func (pc *PermissionsCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (pc *PermissionsCreate) defaults() {
+	if _, ok := pc.mutation.IsSystem(); !ok {
+		v := permissions.DefaultIsSystem
+		pc.mutation.SetIsSystem(v)
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		v := permissions.DefaultCreatedAt()
 		pc.mutation.SetCreatedAt(v)
@@ -187,6 +207,9 @@ func (pc *PermissionsCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Permissions.name": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.IsSystem(); !ok {
+		return &ValidationError{Name: "is_system", err: errors.New(`ent: missing required field "Permissions.is_system"`)}
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Permissions.created_at"`)}
 	}
@@ -221,6 +244,7 @@ func (pc *PermissionsCreate) createSpec() (*Permissions, *sqlgraph.CreateSpec) {
 		_node = &Permissions{config: pc.config}
 		_spec = sqlgraph.NewCreateSpec(permissions.Table, sqlgraph.NewFieldSpec(permissions.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = pc.conflict
 	if id, ok := pc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -245,6 +269,10 @@ func (pc *PermissionsCreate) createSpec() (*Permissions, *sqlgraph.CreateSpec) {
 		_spec.SetField(permissions.FieldAction, field.TypeString, value)
 		_node.Action = value
 	}
+	if value, ok := pc.mutation.IsSystem(); ok {
+		_spec.SetField(permissions.FieldIsSystem, field.TypeBool, value)
+		_node.IsSystem = value
+	}
 	if value, ok := pc.mutation.CreatedAt(); ok {
 		_spec.SetField(permissions.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -272,11 +300,366 @@ func (pc *PermissionsCreate) createSpec() (*Permissions, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Permissions.Create().
+//		SetCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PermissionsUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PermissionsCreate) OnConflict(opts ...sql.ConflictOption) *PermissionsUpsertOne {
+	pc.conflict = opts
+	return &PermissionsUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Permissions.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PermissionsCreate) OnConflictColumns(columns ...string) *PermissionsUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PermissionsUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PermissionsUpsertOne is the builder for "upsert"-ing
+	//  one Permissions node.
+	PermissionsUpsertOne struct {
+		create *PermissionsCreate
+	}
+
+	// PermissionsUpsert is the "OnConflict" setter.
+	PermissionsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCode sets the "code" field.
+func (u *PermissionsUpsert) SetCode(v string) *PermissionsUpsert {
+	u.Set(permissions.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PermissionsUpsert) UpdateCode() *PermissionsUpsert {
+	u.SetExcluded(permissions.FieldCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PermissionsUpsert) SetName(v string) *PermissionsUpsert {
+	u.Set(permissions.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PermissionsUpsert) UpdateName() *PermissionsUpsert {
+	u.SetExcluded(permissions.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *PermissionsUpsert) SetDescription(v string) *PermissionsUpsert {
+	u.Set(permissions.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PermissionsUpsert) UpdateDescription() *PermissionsUpsert {
+	u.SetExcluded(permissions.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PermissionsUpsert) ClearDescription() *PermissionsUpsert {
+	u.SetNull(permissions.FieldDescription)
+	return u
+}
+
+// SetResource sets the "resource" field.
+func (u *PermissionsUpsert) SetResource(v string) *PermissionsUpsert {
+	u.Set(permissions.FieldResource, v)
+	return u
+}
+
+// UpdateResource sets the "resource" field to the value that was provided on create.
+func (u *PermissionsUpsert) UpdateResource() *PermissionsUpsert {
+	u.SetExcluded(permissions.FieldResource)
+	return u
+}
+
+// ClearResource clears the value of the "resource" field.
+func (u *PermissionsUpsert) ClearResource() *PermissionsUpsert {
+	u.SetNull(permissions.FieldResource)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *PermissionsUpsert) SetAction(v string) *PermissionsUpsert {
+	u.Set(permissions.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *PermissionsUpsert) UpdateAction() *PermissionsUpsert {
+	u.SetExcluded(permissions.FieldAction)
+	return u
+}
+
+// ClearAction clears the value of the "action" field.
+func (u *PermissionsUpsert) ClearAction() *PermissionsUpsert {
+	u.SetNull(permissions.FieldAction)
+	return u
+}
+
+// SetIsSystem sets the "is_system" field.
+func (u *PermissionsUpsert) SetIsSystem(v bool) *PermissionsUpsert {
+	u.Set(permissions.FieldIsSystem, v)
+	return u
+}
+
+// UpdateIsSystem sets the "is_system" field to the value that was provided on create.
+func (u *PermissionsUpsert) UpdateIsSystem() *PermissionsUpsert {
+	u.SetExcluded(permissions.FieldIsSystem)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PermissionsUpsert) SetUpdatedAt(v time.Time) *PermissionsUpsert {
+	u.Set(permissions.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PermissionsUpsert) UpdateUpdatedAt() *PermissionsUpsert {
+	u.SetExcluded(permissions.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Permissions.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(permissions.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PermissionsUpsertOne) UpdateNewValues() *PermissionsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(permissions.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(permissions.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Permissions.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PermissionsUpsertOne) Ignore() *PermissionsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PermissionsUpsertOne) DoNothing() *PermissionsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PermissionsCreate.OnConflict
+// documentation for more info.
+func (u *PermissionsUpsertOne) Update(set func(*PermissionsUpsert)) *PermissionsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PermissionsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *PermissionsUpsertOne) SetCode(v string) *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PermissionsUpsertOne) UpdateCode() *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PermissionsUpsertOne) SetName(v string) *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PermissionsUpsertOne) UpdateName() *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PermissionsUpsertOne) SetDescription(v string) *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PermissionsUpsertOne) UpdateDescription() *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PermissionsUpsertOne) ClearDescription() *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetResource sets the "resource" field.
+func (u *PermissionsUpsertOne) SetResource(v string) *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetResource(v)
+	})
+}
+
+// UpdateResource sets the "resource" field to the value that was provided on create.
+func (u *PermissionsUpsertOne) UpdateResource() *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateResource()
+	})
+}
+
+// ClearResource clears the value of the "resource" field.
+func (u *PermissionsUpsertOne) ClearResource() *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.ClearResource()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *PermissionsUpsertOne) SetAction(v string) *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *PermissionsUpsertOne) UpdateAction() *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// ClearAction clears the value of the "action" field.
+func (u *PermissionsUpsertOne) ClearAction() *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.ClearAction()
+	})
+}
+
+// SetIsSystem sets the "is_system" field.
+func (u *PermissionsUpsertOne) SetIsSystem(v bool) *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetIsSystem(v)
+	})
+}
+
+// UpdateIsSystem sets the "is_system" field to the value that was provided on create.
+func (u *PermissionsUpsertOne) UpdateIsSystem() *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateIsSystem()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PermissionsUpsertOne) SetUpdatedAt(v time.Time) *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PermissionsUpsertOne) UpdateUpdatedAt() *PermissionsUpsertOne {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PermissionsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PermissionsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PermissionsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PermissionsUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PermissionsUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PermissionsCreateBulk is the builder for creating many Permissions entities in bulk.
 type PermissionsCreateBulk struct {
 	config
 	err      error
 	builders []*PermissionsCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Permissions entities in the database.
@@ -306,6 +689,7 @@ func (pcb *PermissionsCreateBulk) Save(ctx context.Context) ([]*Permissions, err
 					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -359,3 +743,239 @@ func (pcb *PermissionsCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Permissions.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PermissionsUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PermissionsCreateBulk) OnConflict(opts ...sql.ConflictOption) *PermissionsUpsertBulk {
+	pcb.conflict = opts
+	return &PermissionsUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Permissions.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PermissionsCreateBulk) OnConflictColumns(columns ...string) *PermissionsUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PermissionsUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PermissionsUpsertBulk is the builder for "upsert"-ing
+// a bulk of Permissions nodes.
+type PermissionsUpsertBulk struct {
+	create *PermissionsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Permissions.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(permissions.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PermissionsUpsertBulk) UpdateNewValues() *PermissionsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(permissions.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(permissions.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Permissions.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PermissionsUpsertBulk) Ignore() *PermissionsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PermissionsUpsertBulk) DoNothing() *PermissionsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PermissionsCreateBulk.OnConflict
+// documentation for more info.
+func (u *PermissionsUpsertBulk) Update(set func(*PermissionsUpsert)) *PermissionsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PermissionsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *PermissionsUpsertBulk) SetCode(v string) *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PermissionsUpsertBulk) UpdateCode() *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PermissionsUpsertBulk) SetName(v string) *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PermissionsUpsertBulk) UpdateName() *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *PermissionsUpsertBulk) SetDescription(v string) *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *PermissionsUpsertBulk) UpdateDescription() *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *PermissionsUpsertBulk) ClearDescription() *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetResource sets the "resource" field.
+func (u *PermissionsUpsertBulk) SetResource(v string) *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetResource(v)
+	})
+}
+
+// UpdateResource sets the "resource" field to the value that was provided on create.
+func (u *PermissionsUpsertBulk) UpdateResource() *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateResource()
+	})
+}
+
+// ClearResource clears the value of the "resource" field.
+func (u *PermissionsUpsertBulk) ClearResource() *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.ClearResource()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *PermissionsUpsertBulk) SetAction(v string) *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *PermissionsUpsertBulk) UpdateAction() *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// ClearAction clears the value of the "action" field.
+func (u *PermissionsUpsertBulk) ClearAction() *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.ClearAction()
+	})
+}
+
+// SetIsSystem sets the "is_system" field.
+func (u *PermissionsUpsertBulk) SetIsSystem(v bool) *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetIsSystem(v)
+	})
+}
+
+// UpdateIsSystem sets the "is_system" field to the value that was provided on create.
+func (u *PermissionsUpsertBulk) UpdateIsSystem() *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateIsSystem()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PermissionsUpsertBulk) SetUpdatedAt(v time.Time) *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PermissionsUpsertBulk) UpdateUpdatedAt() *PermissionsUpsertBulk {
+	return u.Update(func(s *PermissionsUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PermissionsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PermissionsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PermissionsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PermissionsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -117,6 +117,20 @@ func (pu *PermissionsUpdate) ClearAction() *PermissionsUpdate {
 	return pu
 }
 
+// SetIsSystem sets the "is_system" field.
+func (pu *PermissionsUpdate) SetIsSystem(b bool) *PermissionsUpdate {
+	pu.mutation.SetIsSystem(b)
+	return pu
+}
+
+// SetNillableIsSystem sets the "is_system" field if the given value is not nil.
+func (pu *PermissionsUpdate) SetNillableIsSystem(b *bool) *PermissionsUpdate {
+	if b != nil {
+		pu.SetIsSystem(*b)
+	}
+	return pu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (pu *PermissionsUpdate) SetUpdatedAt(t time.Time) *PermissionsUpdate {
 	pu.mutation.SetUpdatedAt(t)
@@ -251,6 +265,9 @@ func (pu *PermissionsUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.ActionCleared() {
 		_spec.ClearField(permissions.FieldAction, field.TypeString)
 	}
+	if value, ok := pu.mutation.IsSystem(); ok {
+		_spec.SetField(permissions.FieldIsSystem, field.TypeBool, value)
+	}
 	if value, ok := pu.mutation.UpdatedAt(); ok {
 		_spec.SetField(permissions.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -407,6 +424,20 @@ func (puo *PermissionsUpdateOne) ClearAction() *PermissionsUpdateOne {
 	return puo
 }
 
+// SetIsSystem sets the "is_system" field.
+func (puo *PermissionsUpdateOne) SetIsSystem(b bool) *PermissionsUpdateOne {
+	puo.mutation.SetIsSystem(b)
+	return puo
+}
+
+// SetNillableIsSystem sets the "is_system" field if the given value is not nil.
+func (puo *PermissionsUpdateOne) SetNillableIsSystem(b *bool) *PermissionsUpdateOne {
+	if b != nil {
+		puo.SetIsSystem(*b)
+	}
+	return puo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (puo *PermissionsUpdateOne) SetUpdatedAt(t time.Time) *PermissionsUpdateOne {
 	puo.mutation.SetUpdatedAt(t)
@@ -571,6 +602,9 @@ func (puo *PermissionsUpdateOne) sqlSave(ctx context.Context) (_node *Permission
 	if puo.mutation.ActionCleared() {
 		_spec.ClearField(permissions.FieldAction, field.TypeString)
 	}
+	if value, ok := puo.mutation.IsSystem(); ok {
+		_spec.SetField(permissions.FieldIsSystem, field.TypeBool, value)
+	}
 	if value, ok := puo.mutation.UpdatedAt(); ok {
 		_spec.SetField(permissions.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -6,27 +6,84 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKeys is the predicate function for apikeys builders.
+type APIKeys func(*sql.Selector)
+
 // AuditLogs is the predicate function for auditlogs builders.
 type AuditLogs func(*sql.Selector)
 
+// ConsentAcceptances is the predicate function for consentacceptances builders.
+type ConsentAcceptances func(*sql.Selector)
+
+// ConsentDocuments is the predicate function for consentdocuments builders.
+type ConsentDocuments func(*sql.Selector)
+
+// EmailChanges is the predicate function for emailchanges builders.
+type EmailChanges func(*sql.Selector)
+
 // EmailLogs is the predicate function for emaillogs builders.
 type EmailLogs func(*sql.Selector)
 
 // EmailVerifications is the predicate function for emailverifications builders.
 type EmailVerifications func(*sql.Selector)
 
+// GroupMembers is the predicate function for groupmembers builders.
+type GroupMembers func(*sql.Selector)
+
+// GroupParents is the predicate function for groupparents builders.
+type GroupParents func(*sql.Selector)
+
+// GroupRoles is the predicate function for grouproles builders.
+type GroupRoles func(*sql.Selector)
+
+// Groups is the predicate function for groups builders.
+type Groups func(*sql.Selector)
+
+// Invitations is the predicate function for invitations builders.
+type Invitations func(*sql.Selector)
+
+// OrgMembers is the predicate function for orgmembers builders.
+type OrgMembers func(*sql.Selector)
+
+// OrgRoles is the predicate function for orgroles builders.
+type OrgRoles func(*sql.Selector)
+
+// Organizations is the predicate function for organizations builders.
+type Organizations func(*sql.Selector)
+
+// PasswordHistories is the predicate function for passwordhistories builders.
+type PasswordHistories func(*sql.Selector)
+
 // PasswordResets is the predicate function for passwordresets builders.
 type PasswordResets func(*sql.Selector)
 
 // Permissions is the predicate function for permissions builders.
 type Permissions func(*sql.Selector)
 
+// RelationTuples is the predicate function for relationtuples builders.
+type RelationTuples func(*sql.Selector)
+
+// RoleApprovers is the predicate function for roleapprovers builders.
+type RoleApprovers func(*sql.Selector)
+
+// RoleParents is the predicate function for roleparents builders.
+type RoleParents func(*sql.Selector)
+
 // RolePermissions is the predicate function for rolepermissions builders.
 type RolePermissions func(*sql.Selector)
 
+// RoleRequests is the predicate function for rolerequests builders.
+type RoleRequests func(*sql.Selector)
+
 // Roles is the predicate function for roles builders.
 type Roles func(*sql.Selector)
 
+// SodConstraintRoles is the predicate function for sodconstraintroles builders.
+type SodConstraintRoles func(*sql.Selector)
+
+// SodConstraints is the predicate function for sodconstraints builders.
+type SodConstraints func(*sql.Selector)
+
 // UserRoles is the predicate function for userroles builders.
 type UserRoles func(*sql.Selector)
 
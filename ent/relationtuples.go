@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shammianand/go-auth/ent/relationtuples"
+)
+
+// RelationTuples is the model entity for the RelationTuples schema.
+type RelationTuples struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ObjectType holds the value of the "object_type" field.
+	ObjectType string `json:"object_type,omitempty"`
+	// ObjectID holds the value of the "object_id" field.
+	ObjectID string `json:"object_id,omitempty"`
+	// Relation holds the value of the "relation" field.
+	Relation string `json:"relation,omitempty"`
+	// SubjectType holds the value of the "subject_type" field.
+	SubjectType string `json:"subject_type,omitempty"`
+	// SubjectID holds the value of the "subject_id" field.
+	SubjectID string `json:"subject_id,omitempty"`
+	// Relation of the subject object for usersets; empty for direct subjects
+	SubjectRelation string `json:"subject_relation,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RelationTuples) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case relationtuples.FieldID:
+			values[i] = new(sql.NullInt64)
+		case relationtuples.FieldObjectType, relationtuples.FieldObjectID, relationtuples.FieldRelation, relationtuples.FieldSubjectType, relationtuples.FieldSubjectID, relationtuples.FieldSubjectRelation:
+			values[i] = new(sql.NullString)
+		case relationtuples.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RelationTuples fields.
+func (rt *RelationTuples) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case relationtuples.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rt.ID = int(value.Int64)
+		case relationtuples.FieldObjectType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_type", values[i])
+			} else if value.Valid {
+				rt.ObjectType = value.String
+			}
+		case relationtuples.FieldObjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_id", values[i])
+			} else if value.Valid {
+				rt.ObjectID = value.String
+			}
+		case relationtuples.FieldRelation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field relation", values[i])
+			} else if value.Valid {
+				rt.Relation = value.String
+			}
+		case relationtuples.FieldSubjectType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_type", values[i])
+			} else if value.Valid {
+				rt.SubjectType = value.String
+			}
+		case relationtuples.FieldSubjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_id", values[i])
+			} else if value.Valid {
+				rt.SubjectID = value.String
+			}
+		case relationtuples.FieldSubjectRelation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_relation", values[i])
+			} else if value.Valid {
+				rt.SubjectRelation = value.String
+			}
+		case relationtuples.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rt.CreatedAt = value.Time
+			}
+		default:
+			rt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RelationTuples.
+// This includes values selected through modifiers, order, etc.
+func (rt *RelationTuples) Value(name string) (ent.Value, error) {
+	return rt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RelationTuples.
+// Note that you need to call RelationTuples.Unwrap() before calling this method if this RelationTuples
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rt *RelationTuples) Update() *RelationTuplesUpdateOne {
+	return NewRelationTuplesClient(rt.config).UpdateOne(rt)
+}
+
+// Unwrap unwraps the RelationTuples entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rt *RelationTuples) Unwrap() *RelationTuples {
+	_tx, ok := rt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RelationTuples is not a transactional entity")
+	}
+	rt.config.driver = _tx.drv
+	return rt
+}
+
+// String implements the fmt.Stringer.
+func (rt *RelationTuples) String() string {
+	var builder strings.Builder
+	builder.WriteString("RelationTuples(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rt.ID))
+	builder.WriteString("object_type=")
+	builder.WriteString(rt.ObjectType)
+	builder.WriteString(", ")
+	builder.WriteString("object_id=")
+	builder.WriteString(rt.ObjectID)
+	builder.WriteString(", ")
+	builder.WriteString("relation=")
+	builder.WriteString(rt.Relation)
+	builder.WriteString(", ")
+	builder.WriteString("subject_type=")
+	builder.WriteString(rt.SubjectType)
+	builder.WriteString(", ")
+	builder.WriteString("subject_id=")
+	builder.WriteString(rt.SubjectID)
+	builder.WriteString(", ")
+	builder.WriteString("subject_relation=")
+	builder.WriteString(rt.SubjectRelation)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RelationTuplesSlice is a parsable slice of RelationTuples.
+type RelationTuplesSlice []*RelationTuples
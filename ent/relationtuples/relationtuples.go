@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package relationtuples
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the relationtuples type in the database.
+	Label = "relation_tuples"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldObjectType holds the string denoting the object_type field in the database.
+	FieldObjectType = "object_type"
+	// FieldObjectID holds the string denoting the object_id field in the database.
+	FieldObjectID = "object_id"
+	// FieldRelation holds the string denoting the relation field in the database.
+	FieldRelation = "relation"
+	// FieldSubjectType holds the string denoting the subject_type field in the database.
+	FieldSubjectType = "subject_type"
+	// FieldSubjectID holds the string denoting the subject_id field in the database.
+	FieldSubjectID = "subject_id"
+	// FieldSubjectRelation holds the string denoting the subject_relation field in the database.
+	FieldSubjectRelation = "subject_relation"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the relationtuples in the database.
+	Table = "relation_tuples"
+)
+
+// Columns holds all SQL columns for relationtuples fields.
+var Columns = []string{
+	FieldID,
+	FieldObjectType,
+	FieldObjectID,
+	FieldRelation,
+	FieldSubjectType,
+	FieldSubjectID,
+	FieldSubjectRelation,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ObjectTypeValidator is a validator for the "object_type" field. It is called by the builders before save.
+	ObjectTypeValidator func(string) error
+	// ObjectIDValidator is a validator for the "object_id" field. It is called by the builders before save.
+	ObjectIDValidator func(string) error
+	// RelationValidator is a validator for the "relation" field. It is called by the builders before save.
+	RelationValidator func(string) error
+	// SubjectTypeValidator is a validator for the "subject_type" field. It is called by the builders before save.
+	SubjectTypeValidator func(string) error
+	// SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	SubjectIDValidator func(string) error
+	// DefaultSubjectRelation holds the default value on creation for the "subject_relation" field.
+	DefaultSubjectRelation string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the RelationTuples queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByObjectType orders the results by the object_type field.
+func ByObjectType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectType, opts...).ToFunc()
+}
+
+// ByObjectID orders the results by the object_id field.
+func ByObjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectID, opts...).ToFunc()
+}
+
+// ByRelation orders the results by the relation field.
+func ByRelation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRelation, opts...).ToFunc()
+}
+
+// BySubjectType orders the results by the subject_type field.
+func BySubjectType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectType, opts...).ToFunc()
+}
+
+// BySubjectID orders the results by the subject_id field.
+func BySubjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectID, opts...).ToFunc()
+}
+
+// BySubjectRelation orders the results by the subject_relation field.
+func BySubjectRelation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectRelation, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
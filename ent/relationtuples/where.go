@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package relationtuples
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLTE(FieldID, id))
+}
+
+// ObjectType applies equality check predicate on the "object_type" field. It's identical to ObjectTypeEQ.
+func ObjectType(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldObjectType, v))
+}
+
+// ObjectID applies equality check predicate on the "object_id" field. It's identical to ObjectIDEQ.
+func ObjectID(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldObjectID, v))
+}
+
+// Relation applies equality check predicate on the "relation" field. It's identical to RelationEQ.
+func Relation(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldRelation, v))
+}
+
+// SubjectType applies equality check predicate on the "subject_type" field. It's identical to SubjectTypeEQ.
+func SubjectType(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldSubjectType, v))
+}
+
+// SubjectID applies equality check predicate on the "subject_id" field. It's identical to SubjectIDEQ.
+func SubjectID(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SubjectRelation applies equality check predicate on the "subject_relation" field. It's identical to SubjectRelationEQ.
+func SubjectRelation(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldSubjectRelation, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ObjectTypeEQ applies the EQ predicate on the "object_type" field.
+func ObjectTypeEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldObjectType, v))
+}
+
+// ObjectTypeNEQ applies the NEQ predicate on the "object_type" field.
+func ObjectTypeNEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNEQ(FieldObjectType, v))
+}
+
+// ObjectTypeIn applies the In predicate on the "object_type" field.
+func ObjectTypeIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldIn(FieldObjectType, vs...))
+}
+
+// ObjectTypeNotIn applies the NotIn predicate on the "object_type" field.
+func ObjectTypeNotIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNotIn(FieldObjectType, vs...))
+}
+
+// ObjectTypeGT applies the GT predicate on the "object_type" field.
+func ObjectTypeGT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGT(FieldObjectType, v))
+}
+
+// ObjectTypeGTE applies the GTE predicate on the "object_type" field.
+func ObjectTypeGTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGTE(FieldObjectType, v))
+}
+
+// ObjectTypeLT applies the LT predicate on the "object_type" field.
+func ObjectTypeLT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLT(FieldObjectType, v))
+}
+
+// ObjectTypeLTE applies the LTE predicate on the "object_type" field.
+func ObjectTypeLTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLTE(FieldObjectType, v))
+}
+
+// ObjectTypeContains applies the Contains predicate on the "object_type" field.
+func ObjectTypeContains(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContains(FieldObjectType, v))
+}
+
+// ObjectTypeHasPrefix applies the HasPrefix predicate on the "object_type" field.
+func ObjectTypeHasPrefix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasPrefix(FieldObjectType, v))
+}
+
+// ObjectTypeHasSuffix applies the HasSuffix predicate on the "object_type" field.
+func ObjectTypeHasSuffix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasSuffix(FieldObjectType, v))
+}
+
+// ObjectTypeEqualFold applies the EqualFold predicate on the "object_type" field.
+func ObjectTypeEqualFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEqualFold(FieldObjectType, v))
+}
+
+// ObjectTypeContainsFold applies the ContainsFold predicate on the "object_type" field.
+func ObjectTypeContainsFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContainsFold(FieldObjectType, v))
+}
+
+// ObjectIDEQ applies the EQ predicate on the "object_id" field.
+func ObjectIDEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldObjectID, v))
+}
+
+// ObjectIDNEQ applies the NEQ predicate on the "object_id" field.
+func ObjectIDNEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNEQ(FieldObjectID, v))
+}
+
+// ObjectIDIn applies the In predicate on the "object_id" field.
+func ObjectIDIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldIn(FieldObjectID, vs...))
+}
+
+// ObjectIDNotIn applies the NotIn predicate on the "object_id" field.
+func ObjectIDNotIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNotIn(FieldObjectID, vs...))
+}
+
+// ObjectIDGT applies the GT predicate on the "object_id" field.
+func ObjectIDGT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGT(FieldObjectID, v))
+}
+
+// ObjectIDGTE applies the GTE predicate on the "object_id" field.
+func ObjectIDGTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGTE(FieldObjectID, v))
+}
+
+// ObjectIDLT applies the LT predicate on the "object_id" field.
+func ObjectIDLT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLT(FieldObjectID, v))
+}
+
+// ObjectIDLTE applies the LTE predicate on the "object_id" field.
+func ObjectIDLTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLTE(FieldObjectID, v))
+}
+
+// ObjectIDContains applies the Contains predicate on the "object_id" field.
+func ObjectIDContains(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContains(FieldObjectID, v))
+}
+
+// ObjectIDHasPrefix applies the HasPrefix predicate on the "object_id" field.
+func ObjectIDHasPrefix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasPrefix(FieldObjectID, v))
+}
+
+// ObjectIDHasSuffix applies the HasSuffix predicate on the "object_id" field.
+func ObjectIDHasSuffix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasSuffix(FieldObjectID, v))
+}
+
+// ObjectIDEqualFold applies the EqualFold predicate on the "object_id" field.
+func ObjectIDEqualFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEqualFold(FieldObjectID, v))
+}
+
+// ObjectIDContainsFold applies the ContainsFold predicate on the "object_id" field.
+func ObjectIDContainsFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContainsFold(FieldObjectID, v))
+}
+
+// RelationEQ applies the EQ predicate on the "relation" field.
+func RelationEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldRelation, v))
+}
+
+// RelationNEQ applies the NEQ predicate on the "relation" field.
+func RelationNEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNEQ(FieldRelation, v))
+}
+
+// RelationIn applies the In predicate on the "relation" field.
+func RelationIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldIn(FieldRelation, vs...))
+}
+
+// RelationNotIn applies the NotIn predicate on the "relation" field.
+func RelationNotIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNotIn(FieldRelation, vs...))
+}
+
+// RelationGT applies the GT predicate on the "relation" field.
+func RelationGT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGT(FieldRelation, v))
+}
+
+// RelationGTE applies the GTE predicate on the "relation" field.
+func RelationGTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGTE(FieldRelation, v))
+}
+
+// RelationLT applies the LT predicate on the "relation" field.
+func RelationLT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLT(FieldRelation, v))
+}
+
+// RelationLTE applies the LTE predicate on the "relation" field.
+func RelationLTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLTE(FieldRelation, v))
+}
+
+// RelationContains applies the Contains predicate on the "relation" field.
+func RelationContains(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContains(FieldRelation, v))
+}
+
+// RelationHasPrefix applies the HasPrefix predicate on the "relation" field.
+func RelationHasPrefix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasPrefix(FieldRelation, v))
+}
+
+// RelationHasSuffix applies the HasSuffix predicate on the "relation" field.
+func RelationHasSuffix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasSuffix(FieldRelation, v))
+}
+
+// RelationEqualFold applies the EqualFold predicate on the "relation" field.
+func RelationEqualFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEqualFold(FieldRelation, v))
+}
+
+// RelationContainsFold applies the ContainsFold predicate on the "relation" field.
+func RelationContainsFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContainsFold(FieldRelation, v))
+}
+
+// SubjectTypeEQ applies the EQ predicate on the "subject_type" field.
+func SubjectTypeEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldSubjectType, v))
+}
+
+// SubjectTypeNEQ applies the NEQ predicate on the "subject_type" field.
+func SubjectTypeNEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNEQ(FieldSubjectType, v))
+}
+
+// SubjectTypeIn applies the In predicate on the "subject_type" field.
+func SubjectTypeIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldIn(FieldSubjectType, vs...))
+}
+
+// SubjectTypeNotIn applies the NotIn predicate on the "subject_type" field.
+func SubjectTypeNotIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNotIn(FieldSubjectType, vs...))
+}
+
+// SubjectTypeGT applies the GT predicate on the "subject_type" field.
+func SubjectTypeGT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGT(FieldSubjectType, v))
+}
+
+// SubjectTypeGTE applies the GTE predicate on the "subject_type" field.
+func SubjectTypeGTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGTE(FieldSubjectType, v))
+}
+
+// SubjectTypeLT applies the LT predicate on the "subject_type" field.
+func SubjectTypeLT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLT(FieldSubjectType, v))
+}
+
+// SubjectTypeLTE applies the LTE predicate on the "subject_type" field.
+func SubjectTypeLTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLTE(FieldSubjectType, v))
+}
+
+// SubjectTypeContains applies the Contains predicate on the "subject_type" field.
+func SubjectTypeContains(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContains(FieldSubjectType, v))
+}
+
+// SubjectTypeHasPrefix applies the HasPrefix predicate on the "subject_type" field.
+func SubjectTypeHasPrefix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasPrefix(FieldSubjectType, v))
+}
+
+// SubjectTypeHasSuffix applies the HasSuffix predicate on the "subject_type" field.
+func SubjectTypeHasSuffix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasSuffix(FieldSubjectType, v))
+}
+
+// SubjectTypeEqualFold applies the EqualFold predicate on the "subject_type" field.
+func SubjectTypeEqualFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEqualFold(FieldSubjectType, v))
+}
+
+// SubjectTypeContainsFold applies the ContainsFold predicate on the "subject_type" field.
+func SubjectTypeContainsFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContainsFold(FieldSubjectType, v))
+}
+
+// SubjectIDEQ applies the EQ predicate on the "subject_id" field.
+func SubjectIDEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SubjectIDNEQ applies the NEQ predicate on the "subject_id" field.
+func SubjectIDNEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNEQ(FieldSubjectID, v))
+}
+
+// SubjectIDIn applies the In predicate on the "subject_id" field.
+func SubjectIDIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDNotIn applies the NotIn predicate on the "subject_id" field.
+func SubjectIDNotIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNotIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDGT applies the GT predicate on the "subject_id" field.
+func SubjectIDGT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGT(FieldSubjectID, v))
+}
+
+// SubjectIDGTE applies the GTE predicate on the "subject_id" field.
+func SubjectIDGTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGTE(FieldSubjectID, v))
+}
+
+// SubjectIDLT applies the LT predicate on the "subject_id" field.
+func SubjectIDLT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLT(FieldSubjectID, v))
+}
+
+// SubjectIDLTE applies the LTE predicate on the "subject_id" field.
+func SubjectIDLTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLTE(FieldSubjectID, v))
+}
+
+// SubjectIDContains applies the Contains predicate on the "subject_id" field.
+func SubjectIDContains(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContains(FieldSubjectID, v))
+}
+
+// SubjectIDHasPrefix applies the HasPrefix predicate on the "subject_id" field.
+func SubjectIDHasPrefix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasPrefix(FieldSubjectID, v))
+}
+
+// SubjectIDHasSuffix applies the HasSuffix predicate on the "subject_id" field.
+func SubjectIDHasSuffix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasSuffix(FieldSubjectID, v))
+}
+
+// SubjectIDEqualFold applies the EqualFold predicate on the "subject_id" field.
+func SubjectIDEqualFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEqualFold(FieldSubjectID, v))
+}
+
+// SubjectIDContainsFold applies the ContainsFold predicate on the "subject_id" field.
+func SubjectIDContainsFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContainsFold(FieldSubjectID, v))
+}
+
+// SubjectRelationEQ applies the EQ predicate on the "subject_relation" field.
+func SubjectRelationEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldSubjectRelation, v))
+}
+
+// SubjectRelationNEQ applies the NEQ predicate on the "subject_relation" field.
+func SubjectRelationNEQ(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNEQ(FieldSubjectRelation, v))
+}
+
+// SubjectRelationIn applies the In predicate on the "subject_relation" field.
+func SubjectRelationIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldIn(FieldSubjectRelation, vs...))
+}
+
+// SubjectRelationNotIn applies the NotIn predicate on the "subject_relation" field.
+func SubjectRelationNotIn(vs ...string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNotIn(FieldSubjectRelation, vs...))
+}
+
+// SubjectRelationGT applies the GT predicate on the "subject_relation" field.
+func SubjectRelationGT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGT(FieldSubjectRelation, v))
+}
+
+// SubjectRelationGTE applies the GTE predicate on the "subject_relation" field.
+func SubjectRelationGTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGTE(FieldSubjectRelation, v))
+}
+
+// SubjectRelationLT applies the LT predicate on the "subject_relation" field.
+func SubjectRelationLT(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLT(FieldSubjectRelation, v))
+}
+
+// SubjectRelationLTE applies the LTE predicate on the "subject_relation" field.
+func SubjectRelationLTE(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLTE(FieldSubjectRelation, v))
+}
+
+// SubjectRelationContains applies the Contains predicate on the "subject_relation" field.
+func SubjectRelationContains(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContains(FieldSubjectRelation, v))
+}
+
+// SubjectRelationHasPrefix applies the HasPrefix predicate on the "subject_relation" field.
+func SubjectRelationHasPrefix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasPrefix(FieldSubjectRelation, v))
+}
+
+// SubjectRelationHasSuffix applies the HasSuffix predicate on the "subject_relation" field.
+func SubjectRelationHasSuffix(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldHasSuffix(FieldSubjectRelation, v))
+}
+
+// SubjectRelationEqualFold applies the EqualFold predicate on the "subject_relation" field.
+func SubjectRelationEqualFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEqualFold(FieldSubjectRelation, v))
+}
+
+// SubjectRelationContainsFold applies the ContainsFold predicate on the "subject_relation" field.
+func SubjectRelationContainsFold(v string) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldContainsFold(FieldSubjectRelation, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RelationTuples) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RelationTuples) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RelationTuples) predicate.RelationTuples {
+	return predicate.RelationTuples(sql.NotPredicates(p))
+}
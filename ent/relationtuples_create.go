@@ -0,0 +1,824 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/relationtuples"
+)
+
+// RelationTuplesCreate is the builder for creating a RelationTuples entity.
+type RelationTuplesCreate struct {
+	config
+	mutation *RelationTuplesMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetObjectType sets the "object_type" field.
+func (rtc *RelationTuplesCreate) SetObjectType(s string) *RelationTuplesCreate {
+	rtc.mutation.SetObjectType(s)
+	return rtc
+}
+
+// SetObjectID sets the "object_id" field.
+func (rtc *RelationTuplesCreate) SetObjectID(s string) *RelationTuplesCreate {
+	rtc.mutation.SetObjectID(s)
+	return rtc
+}
+
+// SetRelation sets the "relation" field.
+func (rtc *RelationTuplesCreate) SetRelation(s string) *RelationTuplesCreate {
+	rtc.mutation.SetRelation(s)
+	return rtc
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (rtc *RelationTuplesCreate) SetSubjectType(s string) *RelationTuplesCreate {
+	rtc.mutation.SetSubjectType(s)
+	return rtc
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (rtc *RelationTuplesCreate) SetSubjectID(s string) *RelationTuplesCreate {
+	rtc.mutation.SetSubjectID(s)
+	return rtc
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (rtc *RelationTuplesCreate) SetSubjectRelation(s string) *RelationTuplesCreate {
+	rtc.mutation.SetSubjectRelation(s)
+	return rtc
+}
+
+// SetNillableSubjectRelation sets the "subject_relation" field if the given value is not nil.
+func (rtc *RelationTuplesCreate) SetNillableSubjectRelation(s *string) *RelationTuplesCreate {
+	if s != nil {
+		rtc.SetSubjectRelation(*s)
+	}
+	return rtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rtc *RelationTuplesCreate) SetCreatedAt(t time.Time) *RelationTuplesCreate {
+	rtc.mutation.SetCreatedAt(t)
+	return rtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rtc *RelationTuplesCreate) SetNillableCreatedAt(t *time.Time) *RelationTuplesCreate {
+	if t != nil {
+		rtc.SetCreatedAt(*t)
+	}
+	return rtc
+}
+
+// SetID sets the "id" field.
+func (rtc *RelationTuplesCreate) SetID(i int) *RelationTuplesCreate {
+	rtc.mutation.SetID(i)
+	return rtc
+}
+
+// Mutation returns the RelationTuplesMutation object of the builder.
+func (rtc *RelationTuplesCreate) Mutation() *RelationTuplesMutation {
+	return rtc.mutation
+}
+
+// Save creates the RelationTuples in the database.
+func (rtc *RelationTuplesCreate) Save(ctx context.Context) (*RelationTuples, error) {
+	rtc.defaults()
+	return withHooks(ctx, rtc.sqlSave, rtc.mutation, rtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rtc *RelationTuplesCreate) SaveX(ctx context.Context) *RelationTuples {
+	v, err := rtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtc *RelationTuplesCreate) Exec(ctx context.Context) error {
+	_, err := rtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtc *RelationTuplesCreate) ExecX(ctx context.Context) {
+	if err := rtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtc *RelationTuplesCreate) defaults() {
+	if _, ok := rtc.mutation.SubjectRelation(); !ok {
+		v := relationtuples.DefaultSubjectRelation
+		rtc.mutation.SetSubjectRelation(v)
+	}
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		v := relationtuples.DefaultCreatedAt()
+		rtc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtc *RelationTuplesCreate) check() error {
+	if _, ok := rtc.mutation.ObjectType(); !ok {
+		return &ValidationError{Name: "object_type", err: errors.New(`ent: missing required field "RelationTuples.object_type"`)}
+	}
+	if v, ok := rtc.mutation.ObjectType(); ok {
+		if err := relationtuples.ObjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "object_type", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.object_type": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.ObjectID(); !ok {
+		return &ValidationError{Name: "object_id", err: errors.New(`ent: missing required field "RelationTuples.object_id"`)}
+	}
+	if v, ok := rtc.mutation.ObjectID(); ok {
+		if err := relationtuples.ObjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "object_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.object_id": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.Relation(); !ok {
+		return &ValidationError{Name: "relation", err: errors.New(`ent: missing required field "RelationTuples.relation"`)}
+	}
+	if v, ok := rtc.mutation.Relation(); ok {
+		if err := relationtuples.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.relation": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.SubjectType(); !ok {
+		return &ValidationError{Name: "subject_type", err: errors.New(`ent: missing required field "RelationTuples.subject_type"`)}
+	}
+	if v, ok := rtc.mutation.SubjectType(); ok {
+		if err := relationtuples.SubjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "subject_type", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.subject_type": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.SubjectID(); !ok {
+		return &ValidationError{Name: "subject_id", err: errors.New(`ent: missing required field "RelationTuples.subject_id"`)}
+	}
+	if v, ok := rtc.mutation.SubjectID(); ok {
+		if err := relationtuples.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.subject_id": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.SubjectRelation(); !ok {
+		return &ValidationError{Name: "subject_relation", err: errors.New(`ent: missing required field "RelationTuples.subject_relation"`)}
+	}
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RelationTuples.created_at"`)}
+	}
+	return nil
+}
+
+func (rtc *RelationTuplesCreate) sqlSave(ctx context.Context) (*RelationTuples, error) {
+	if err := rtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rtc.mutation.id = &_node.ID
+	rtc.mutation.done = true
+	return _node, nil
+}
+
+func (rtc *RelationTuplesCreate) createSpec() (*RelationTuples, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RelationTuples{config: rtc.config}
+		_spec = sqlgraph.NewCreateSpec(relationtuples.Table, sqlgraph.NewFieldSpec(relationtuples.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rtc.conflict
+	if id, ok := rtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rtc.mutation.ObjectType(); ok {
+		_spec.SetField(relationtuples.FieldObjectType, field.TypeString, value)
+		_node.ObjectType = value
+	}
+	if value, ok := rtc.mutation.ObjectID(); ok {
+		_spec.SetField(relationtuples.FieldObjectID, field.TypeString, value)
+		_node.ObjectID = value
+	}
+	if value, ok := rtc.mutation.Relation(); ok {
+		_spec.SetField(relationtuples.FieldRelation, field.TypeString, value)
+		_node.Relation = value
+	}
+	if value, ok := rtc.mutation.SubjectType(); ok {
+		_spec.SetField(relationtuples.FieldSubjectType, field.TypeString, value)
+		_node.SubjectType = value
+	}
+	if value, ok := rtc.mutation.SubjectID(); ok {
+		_spec.SetField(relationtuples.FieldSubjectID, field.TypeString, value)
+		_node.SubjectID = value
+	}
+	if value, ok := rtc.mutation.SubjectRelation(); ok {
+		_spec.SetField(relationtuples.FieldSubjectRelation, field.TypeString, value)
+		_node.SubjectRelation = value
+	}
+	if value, ok := rtc.mutation.CreatedAt(); ok {
+		_spec.SetField(relationtuples.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RelationTuples.Create().
+//		SetObjectType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RelationTuplesUpsert) {
+//			SetObjectType(v+v).
+//		}).
+//		Exec(ctx)
+func (rtc *RelationTuplesCreate) OnConflict(opts ...sql.ConflictOption) *RelationTuplesUpsertOne {
+	rtc.conflict = opts
+	return &RelationTuplesUpsertOne{
+		create: rtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RelationTuples.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rtc *RelationTuplesCreate) OnConflictColumns(columns ...string) *RelationTuplesUpsertOne {
+	rtc.conflict = append(rtc.conflict, sql.ConflictColumns(columns...))
+	return &RelationTuplesUpsertOne{
+		create: rtc,
+	}
+}
+
+type (
+	// RelationTuplesUpsertOne is the builder for "upsert"-ing
+	//  one RelationTuples node.
+	RelationTuplesUpsertOne struct {
+		create *RelationTuplesCreate
+	}
+
+	// RelationTuplesUpsert is the "OnConflict" setter.
+	RelationTuplesUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetObjectType sets the "object_type" field.
+func (u *RelationTuplesUpsert) SetObjectType(v string) *RelationTuplesUpsert {
+	u.Set(relationtuples.FieldObjectType, v)
+	return u
+}
+
+// UpdateObjectType sets the "object_type" field to the value that was provided on create.
+func (u *RelationTuplesUpsert) UpdateObjectType() *RelationTuplesUpsert {
+	u.SetExcluded(relationtuples.FieldObjectType)
+	return u
+}
+
+// SetObjectID sets the "object_id" field.
+func (u *RelationTuplesUpsert) SetObjectID(v string) *RelationTuplesUpsert {
+	u.Set(relationtuples.FieldObjectID, v)
+	return u
+}
+
+// UpdateObjectID sets the "object_id" field to the value that was provided on create.
+func (u *RelationTuplesUpsert) UpdateObjectID() *RelationTuplesUpsert {
+	u.SetExcluded(relationtuples.FieldObjectID)
+	return u
+}
+
+// SetRelation sets the "relation" field.
+func (u *RelationTuplesUpsert) SetRelation(v string) *RelationTuplesUpsert {
+	u.Set(relationtuples.FieldRelation, v)
+	return u
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *RelationTuplesUpsert) UpdateRelation() *RelationTuplesUpsert {
+	u.SetExcluded(relationtuples.FieldRelation)
+	return u
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (u *RelationTuplesUpsert) SetSubjectType(v string) *RelationTuplesUpsert {
+	u.Set(relationtuples.FieldSubjectType, v)
+	return u
+}
+
+// UpdateSubjectType sets the "subject_type" field to the value that was provided on create.
+func (u *RelationTuplesUpsert) UpdateSubjectType() *RelationTuplesUpsert {
+	u.SetExcluded(relationtuples.FieldSubjectType)
+	return u
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *RelationTuplesUpsert) SetSubjectID(v string) *RelationTuplesUpsert {
+	u.Set(relationtuples.FieldSubjectID, v)
+	return u
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *RelationTuplesUpsert) UpdateSubjectID() *RelationTuplesUpsert {
+	u.SetExcluded(relationtuples.FieldSubjectID)
+	return u
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (u *RelationTuplesUpsert) SetSubjectRelation(v string) *RelationTuplesUpsert {
+	u.Set(relationtuples.FieldSubjectRelation, v)
+	return u
+}
+
+// UpdateSubjectRelation sets the "subject_relation" field to the value that was provided on create.
+func (u *RelationTuplesUpsert) UpdateSubjectRelation() *RelationTuplesUpsert {
+	u.SetExcluded(relationtuples.FieldSubjectRelation)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RelationTuples.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(relationtuples.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RelationTuplesUpsertOne) UpdateNewValues() *RelationTuplesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(relationtuples.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(relationtuples.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RelationTuples.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RelationTuplesUpsertOne) Ignore() *RelationTuplesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RelationTuplesUpsertOne) DoNothing() *RelationTuplesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RelationTuplesCreate.OnConflict
+// documentation for more info.
+func (u *RelationTuplesUpsertOne) Update(set func(*RelationTuplesUpsert)) *RelationTuplesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RelationTuplesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetObjectType sets the "object_type" field.
+func (u *RelationTuplesUpsertOne) SetObjectType(v string) *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetObjectType(v)
+	})
+}
+
+// UpdateObjectType sets the "object_type" field to the value that was provided on create.
+func (u *RelationTuplesUpsertOne) UpdateObjectType() *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateObjectType()
+	})
+}
+
+// SetObjectID sets the "object_id" field.
+func (u *RelationTuplesUpsertOne) SetObjectID(v string) *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetObjectID(v)
+	})
+}
+
+// UpdateObjectID sets the "object_id" field to the value that was provided on create.
+func (u *RelationTuplesUpsertOne) UpdateObjectID() *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateObjectID()
+	})
+}
+
+// SetRelation sets the "relation" field.
+func (u *RelationTuplesUpsertOne) SetRelation(v string) *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetRelation(v)
+	})
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *RelationTuplesUpsertOne) UpdateRelation() *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateRelation()
+	})
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (u *RelationTuplesUpsertOne) SetSubjectType(v string) *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetSubjectType(v)
+	})
+}
+
+// UpdateSubjectType sets the "subject_type" field to the value that was provided on create.
+func (u *RelationTuplesUpsertOne) UpdateSubjectType() *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateSubjectType()
+	})
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *RelationTuplesUpsertOne) SetSubjectID(v string) *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *RelationTuplesUpsertOne) UpdateSubjectID() *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (u *RelationTuplesUpsertOne) SetSubjectRelation(v string) *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetSubjectRelation(v)
+	})
+}
+
+// UpdateSubjectRelation sets the "subject_relation" field to the value that was provided on create.
+func (u *RelationTuplesUpsertOne) UpdateSubjectRelation() *RelationTuplesUpsertOne {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateSubjectRelation()
+	})
+}
+
+// Exec executes the query.
+func (u *RelationTuplesUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RelationTuplesCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RelationTuplesUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RelationTuplesUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RelationTuplesUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RelationTuplesCreateBulk is the builder for creating many RelationTuples entities in bulk.
+type RelationTuplesCreateBulk struct {
+	config
+	err      error
+	builders []*RelationTuplesCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RelationTuples entities in the database.
+func (rtcb *RelationTuplesCreateBulk) Save(ctx context.Context) ([]*RelationTuples, error) {
+	if rtcb.err != nil {
+		return nil, rtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rtcb.builders))
+	nodes := make([]*RelationTuples, len(rtcb.builders))
+	mutators := make([]Mutator, len(rtcb.builders))
+	for i := range rtcb.builders {
+		func(i int, root context.Context) {
+			builder := rtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RelationTuplesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtcb *RelationTuplesCreateBulk) SaveX(ctx context.Context) []*RelationTuples {
+	v, err := rtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtcb *RelationTuplesCreateBulk) Exec(ctx context.Context) error {
+	_, err := rtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtcb *RelationTuplesCreateBulk) ExecX(ctx context.Context) {
+	if err := rtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RelationTuples.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RelationTuplesUpsert) {
+//			SetObjectType(v+v).
+//		}).
+//		Exec(ctx)
+func (rtcb *RelationTuplesCreateBulk) OnConflict(opts ...sql.ConflictOption) *RelationTuplesUpsertBulk {
+	rtcb.conflict = opts
+	return &RelationTuplesUpsertBulk{
+		create: rtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RelationTuples.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rtcb *RelationTuplesCreateBulk) OnConflictColumns(columns ...string) *RelationTuplesUpsertBulk {
+	rtcb.conflict = append(rtcb.conflict, sql.ConflictColumns(columns...))
+	return &RelationTuplesUpsertBulk{
+		create: rtcb,
+	}
+}
+
+// RelationTuplesUpsertBulk is the builder for "upsert"-ing
+// a bulk of RelationTuples nodes.
+type RelationTuplesUpsertBulk struct {
+	create *RelationTuplesCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RelationTuples.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(relationtuples.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RelationTuplesUpsertBulk) UpdateNewValues() *RelationTuplesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(relationtuples.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(relationtuples.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RelationTuples.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RelationTuplesUpsertBulk) Ignore() *RelationTuplesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RelationTuplesUpsertBulk) DoNothing() *RelationTuplesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RelationTuplesCreateBulk.OnConflict
+// documentation for more info.
+func (u *RelationTuplesUpsertBulk) Update(set func(*RelationTuplesUpsert)) *RelationTuplesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RelationTuplesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetObjectType sets the "object_type" field.
+func (u *RelationTuplesUpsertBulk) SetObjectType(v string) *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetObjectType(v)
+	})
+}
+
+// UpdateObjectType sets the "object_type" field to the value that was provided on create.
+func (u *RelationTuplesUpsertBulk) UpdateObjectType() *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateObjectType()
+	})
+}
+
+// SetObjectID sets the "object_id" field.
+func (u *RelationTuplesUpsertBulk) SetObjectID(v string) *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetObjectID(v)
+	})
+}
+
+// UpdateObjectID sets the "object_id" field to the value that was provided on create.
+func (u *RelationTuplesUpsertBulk) UpdateObjectID() *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateObjectID()
+	})
+}
+
+// SetRelation sets the "relation" field.
+func (u *RelationTuplesUpsertBulk) SetRelation(v string) *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetRelation(v)
+	})
+}
+
+// UpdateRelation sets the "relation" field to the value that was provided on create.
+func (u *RelationTuplesUpsertBulk) UpdateRelation() *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateRelation()
+	})
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (u *RelationTuplesUpsertBulk) SetSubjectType(v string) *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetSubjectType(v)
+	})
+}
+
+// UpdateSubjectType sets the "subject_type" field to the value that was provided on create.
+func (u *RelationTuplesUpsertBulk) UpdateSubjectType() *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateSubjectType()
+	})
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (u *RelationTuplesUpsertBulk) SetSubjectID(v string) *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetSubjectID(v)
+	})
+}
+
+// UpdateSubjectID sets the "subject_id" field to the value that was provided on create.
+func (u *RelationTuplesUpsertBulk) UpdateSubjectID() *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateSubjectID()
+	})
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (u *RelationTuplesUpsertBulk) SetSubjectRelation(v string) *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.SetSubjectRelation(v)
+	})
+}
+
+// UpdateSubjectRelation sets the "subject_relation" field to the value that was provided on create.
+func (u *RelationTuplesUpsertBulk) UpdateSubjectRelation() *RelationTuplesUpsertBulk {
+	return u.Update(func(s *RelationTuplesUpsert) {
+		s.UpdateSubjectRelation()
+	})
+}
+
+// Exec executes the query.
+func (u *RelationTuplesUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RelationTuplesCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RelationTuplesCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RelationTuplesUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
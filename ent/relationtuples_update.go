@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/relationtuples"
+)
+
+// RelationTuplesUpdate is the builder for updating RelationTuples entities.
+type RelationTuplesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RelationTuplesMutation
+}
+
+// Where appends a list predicates to the RelationTuplesUpdate builder.
+func (rtu *RelationTuplesUpdate) Where(ps ...predicate.RelationTuples) *RelationTuplesUpdate {
+	rtu.mutation.Where(ps...)
+	return rtu
+}
+
+// SetObjectType sets the "object_type" field.
+func (rtu *RelationTuplesUpdate) SetObjectType(s string) *RelationTuplesUpdate {
+	rtu.mutation.SetObjectType(s)
+	return rtu
+}
+
+// SetNillableObjectType sets the "object_type" field if the given value is not nil.
+func (rtu *RelationTuplesUpdate) SetNillableObjectType(s *string) *RelationTuplesUpdate {
+	if s != nil {
+		rtu.SetObjectType(*s)
+	}
+	return rtu
+}
+
+// SetObjectID sets the "object_id" field.
+func (rtu *RelationTuplesUpdate) SetObjectID(s string) *RelationTuplesUpdate {
+	rtu.mutation.SetObjectID(s)
+	return rtu
+}
+
+// SetNillableObjectID sets the "object_id" field if the given value is not nil.
+func (rtu *RelationTuplesUpdate) SetNillableObjectID(s *string) *RelationTuplesUpdate {
+	if s != nil {
+		rtu.SetObjectID(*s)
+	}
+	return rtu
+}
+
+// SetRelation sets the "relation" field.
+func (rtu *RelationTuplesUpdate) SetRelation(s string) *RelationTuplesUpdate {
+	rtu.mutation.SetRelation(s)
+	return rtu
+}
+
+// SetNillableRelation sets the "relation" field if the given value is not nil.
+func (rtu *RelationTuplesUpdate) SetNillableRelation(s *string) *RelationTuplesUpdate {
+	if s != nil {
+		rtu.SetRelation(*s)
+	}
+	return rtu
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (rtu *RelationTuplesUpdate) SetSubjectType(s string) *RelationTuplesUpdate {
+	rtu.mutation.SetSubjectType(s)
+	return rtu
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (rtu *RelationTuplesUpdate) SetNillableSubjectType(s *string) *RelationTuplesUpdate {
+	if s != nil {
+		rtu.SetSubjectType(*s)
+	}
+	return rtu
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (rtu *RelationTuplesUpdate) SetSubjectID(s string) *RelationTuplesUpdate {
+	rtu.mutation.SetSubjectID(s)
+	return rtu
+}
+
+// SetNillableSubjectID sets the "subject_id" field if the given value is not nil.
+func (rtu *RelationTuplesUpdate) SetNillableSubjectID(s *string) *RelationTuplesUpdate {
+	if s != nil {
+		rtu.SetSubjectID(*s)
+	}
+	return rtu
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (rtu *RelationTuplesUpdate) SetSubjectRelation(s string) *RelationTuplesUpdate {
+	rtu.mutation.SetSubjectRelation(s)
+	return rtu
+}
+
+// SetNillableSubjectRelation sets the "subject_relation" field if the given value is not nil.
+func (rtu *RelationTuplesUpdate) SetNillableSubjectRelation(s *string) *RelationTuplesUpdate {
+	if s != nil {
+		rtu.SetSubjectRelation(*s)
+	}
+	return rtu
+}
+
+// Mutation returns the RelationTuplesMutation object of the builder.
+func (rtu *RelationTuplesUpdate) Mutation() *RelationTuplesMutation {
+	return rtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *RelationTuplesUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rtu.sqlSave, rtu.mutation, rtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *RelationTuplesUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *RelationTuplesUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *RelationTuplesUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtu *RelationTuplesUpdate) check() error {
+	if v, ok := rtu.mutation.ObjectType(); ok {
+		if err := relationtuples.ObjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "object_type", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.object_type": %w`, err)}
+		}
+	}
+	if v, ok := rtu.mutation.ObjectID(); ok {
+		if err := relationtuples.ObjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "object_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.object_id": %w`, err)}
+		}
+	}
+	if v, ok := rtu.mutation.Relation(); ok {
+		if err := relationtuples.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.relation": %w`, err)}
+		}
+	}
+	if v, ok := rtu.mutation.SubjectType(); ok {
+		if err := relationtuples.SubjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "subject_type", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.subject_type": %w`, err)}
+		}
+	}
+	if v, ok := rtu.mutation.SubjectID(); ok {
+		if err := relationtuples.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.subject_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rtu *RelationTuplesUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(relationtuples.Table, relationtuples.Columns, sqlgraph.NewFieldSpec(relationtuples.FieldID, field.TypeInt))
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtu.mutation.ObjectType(); ok {
+		_spec.SetField(relationtuples.FieldObjectType, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.ObjectID(); ok {
+		_spec.SetField(relationtuples.FieldObjectID, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.Relation(); ok {
+		_spec.SetField(relationtuples.FieldRelation, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.SubjectType(); ok {
+		_spec.SetField(relationtuples.FieldSubjectType, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.SubjectID(); ok {
+		_spec.SetField(relationtuples.FieldSubjectID, field.TypeString, value)
+	}
+	if value, ok := rtu.mutation.SubjectRelation(); ok {
+		_spec.SetField(relationtuples.FieldSubjectRelation, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{relationtuples.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rtu.mutation.done = true
+	return n, nil
+}
+
+// RelationTuplesUpdateOne is the builder for updating a single RelationTuples entity.
+type RelationTuplesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RelationTuplesMutation
+}
+
+// SetObjectType sets the "object_type" field.
+func (rtuo *RelationTuplesUpdateOne) SetObjectType(s string) *RelationTuplesUpdateOne {
+	rtuo.mutation.SetObjectType(s)
+	return rtuo
+}
+
+// SetNillableObjectType sets the "object_type" field if the given value is not nil.
+func (rtuo *RelationTuplesUpdateOne) SetNillableObjectType(s *string) *RelationTuplesUpdateOne {
+	if s != nil {
+		rtuo.SetObjectType(*s)
+	}
+	return rtuo
+}
+
+// SetObjectID sets the "object_id" field.
+func (rtuo *RelationTuplesUpdateOne) SetObjectID(s string) *RelationTuplesUpdateOne {
+	rtuo.mutation.SetObjectID(s)
+	return rtuo
+}
+
+// SetNillableObjectID sets the "object_id" field if the given value is not nil.
+func (rtuo *RelationTuplesUpdateOne) SetNillableObjectID(s *string) *RelationTuplesUpdateOne {
+	if s != nil {
+		rtuo.SetObjectID(*s)
+	}
+	return rtuo
+}
+
+// SetRelation sets the "relation" field.
+func (rtuo *RelationTuplesUpdateOne) SetRelation(s string) *RelationTuplesUpdateOne {
+	rtuo.mutation.SetRelation(s)
+	return rtuo
+}
+
+// SetNillableRelation sets the "relation" field if the given value is not nil.
+func (rtuo *RelationTuplesUpdateOne) SetNillableRelation(s *string) *RelationTuplesUpdateOne {
+	if s != nil {
+		rtuo.SetRelation(*s)
+	}
+	return rtuo
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (rtuo *RelationTuplesUpdateOne) SetSubjectType(s string) *RelationTuplesUpdateOne {
+	rtuo.mutation.SetSubjectType(s)
+	return rtuo
+}
+
+// SetNillableSubjectType sets the "subject_type" field if the given value is not nil.
+func (rtuo *RelationTuplesUpdateOne) SetNillableSubjectType(s *string) *RelationTuplesUpdateOne {
+	if s != nil {
+		rtuo.SetSubjectType(*s)
+	}
+	return rtuo
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (rtuo *RelationTuplesUpdateOne) SetSubjectID(s string) *RelationTuplesUpdateOne {
+	rtuo.mutation.SetSubjectID(s)
+	return rtuo
+}
+
+// SetNillableSubjectID sets the "subject_id" field if the given value is not nil.
+func (rtuo *RelationTuplesUpdateOne) SetNillableSubjectID(s *string) *RelationTuplesUpdateOne {
+	if s != nil {
+		rtuo.SetSubjectID(*s)
+	}
+	return rtuo
+}
+
+// SetSubjectRelation sets the "subject_relation" field.
+func (rtuo *RelationTuplesUpdateOne) SetSubjectRelation(s string) *RelationTuplesUpdateOne {
+	rtuo.mutation.SetSubjectRelation(s)
+	return rtuo
+}
+
+// SetNillableSubjectRelation sets the "subject_relation" field if the given value is not nil.
+func (rtuo *RelationTuplesUpdateOne) SetNillableSubjectRelation(s *string) *RelationTuplesUpdateOne {
+	if s != nil {
+		rtuo.SetSubjectRelation(*s)
+	}
+	return rtuo
+}
+
+// Mutation returns the RelationTuplesMutation object of the builder.
+func (rtuo *RelationTuplesUpdateOne) Mutation() *RelationTuplesMutation {
+	return rtuo.mutation
+}
+
+// Where appends a list predicates to the RelationTuplesUpdate builder.
+func (rtuo *RelationTuplesUpdateOne) Where(ps ...predicate.RelationTuples) *RelationTuplesUpdateOne {
+	rtuo.mutation.Where(ps...)
+	return rtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rtuo *RelationTuplesUpdateOne) Select(field string, fields ...string) *RelationTuplesUpdateOne {
+	rtuo.fields = append([]string{field}, fields...)
+	return rtuo
+}
+
+// Save executes the query and returns the updated RelationTuples entity.
+func (rtuo *RelationTuplesUpdateOne) Save(ctx context.Context) (*RelationTuples, error) {
+	return withHooks(ctx, rtuo.sqlSave, rtuo.mutation, rtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *RelationTuplesUpdateOne) SaveX(ctx context.Context) *RelationTuples {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *RelationTuplesUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *RelationTuplesUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtuo *RelationTuplesUpdateOne) check() error {
+	if v, ok := rtuo.mutation.ObjectType(); ok {
+		if err := relationtuples.ObjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "object_type", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.object_type": %w`, err)}
+		}
+	}
+	if v, ok := rtuo.mutation.ObjectID(); ok {
+		if err := relationtuples.ObjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "object_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.object_id": %w`, err)}
+		}
+	}
+	if v, ok := rtuo.mutation.Relation(); ok {
+		if err := relationtuples.RelationValidator(v); err != nil {
+			return &ValidationError{Name: "relation", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.relation": %w`, err)}
+		}
+	}
+	if v, ok := rtuo.mutation.SubjectType(); ok {
+		if err := relationtuples.SubjectTypeValidator(v); err != nil {
+			return &ValidationError{Name: "subject_type", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.subject_type": %w`, err)}
+		}
+	}
+	if v, ok := rtuo.mutation.SubjectID(); ok {
+		if err := relationtuples.SubjectIDValidator(v); err != nil {
+			return &ValidationError{Name: "subject_id", err: fmt.Errorf(`ent: validator failed for field "RelationTuples.subject_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rtuo *RelationTuplesUpdateOne) sqlSave(ctx context.Context) (_node *RelationTuples, err error) {
+	if err := rtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(relationtuples.Table, relationtuples.Columns, sqlgraph.NewFieldSpec(relationtuples.FieldID, field.TypeInt))
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RelationTuples.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, relationtuples.FieldID)
+		for _, f := range fields {
+			if !relationtuples.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != relationtuples.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rtuo.mutation.ObjectType(); ok {
+		_spec.SetField(relationtuples.FieldObjectType, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.ObjectID(); ok {
+		_spec.SetField(relationtuples.FieldObjectID, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.Relation(); ok {
+		_spec.SetField(relationtuples.FieldRelation, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.SubjectType(); ok {
+		_spec.SetField(relationtuples.FieldSubjectType, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.SubjectID(); ok {
+		_spec.SetField(relationtuples.FieldSubjectID, field.TypeString, value)
+	}
+	if value, ok := rtuo.mutation.SubjectRelation(); ok {
+		_spec.SetField(relationtuples.FieldSubjectRelation, field.TypeString, value)
+	}
+	_node = &RelationTuples{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{relationtuples.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rtuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// RoleApprovers is the model entity for the RoleApprovers schema.
+type RoleApprovers struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Role being requested
+	RoleID int `json:"role_id,omitempty"`
+	// Role whose holders decide requests
+	ApproverRoleID int `json:"approver_role_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoleApproversQuery when eager-loading is set.
+	Edges        RoleApproversEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RoleApproversEdges holds the relations/edges for other nodes in the graph.
+type RoleApproversEdges struct {
+	// Role holds the value of the role edge.
+	Role *Roles `json:"role,omitempty"`
+	// ApproverRole holds the value of the approver_role edge.
+	ApproverRole *Roles `json:"approver_role,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleApproversEdges) RoleOrErr() (*Roles, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: roles.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// ApproverRoleOrErr returns the ApproverRole value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleApproversEdges) ApproverRoleOrErr() (*Roles, error) {
+	if e.ApproverRole != nil {
+		return e.ApproverRole, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: roles.Label}
+	}
+	return nil, &NotLoadedError{edge: "approver_role"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleApprovers) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roleapprovers.FieldID, roleapprovers.FieldRoleID, roleapprovers.FieldApproverRoleID:
+			values[i] = new(sql.NullInt64)
+		case roleapprovers.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleApprovers fields.
+func (ra *RoleApprovers) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roleapprovers.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ra.ID = int(value.Int64)
+		case roleapprovers.FieldRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				ra.RoleID = int(value.Int64)
+			}
+		case roleapprovers.FieldApproverRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approver_role_id", values[i])
+			} else if value.Valid {
+				ra.ApproverRoleID = int(value.Int64)
+			}
+		case roleapprovers.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ra.CreatedAt = value.Time
+			}
+		default:
+			ra.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleApprovers.
+// This includes values selected through modifiers, order, etc.
+func (ra *RoleApprovers) Value(name string) (ent.Value, error) {
+	return ra.selectValues.Get(name)
+}
+
+// QueryRole queries the "role" edge of the RoleApprovers entity.
+func (ra *RoleApprovers) QueryRole() *RolesQuery {
+	return NewRoleApproversClient(ra.config).QueryRole(ra)
+}
+
+// QueryApproverRole queries the "approver_role" edge of the RoleApprovers entity.
+func (ra *RoleApprovers) QueryApproverRole() *RolesQuery {
+	return NewRoleApproversClient(ra.config).QueryApproverRole(ra)
+}
+
+// Update returns a builder for updating this RoleApprovers.
+// Note that you need to call RoleApprovers.Unwrap() before calling this method if this RoleApprovers
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ra *RoleApprovers) Update() *RoleApproversUpdateOne {
+	return NewRoleApproversClient(ra.config).UpdateOne(ra)
+}
+
+// Unwrap unwraps the RoleApprovers entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ra *RoleApprovers) Unwrap() *RoleApprovers {
+	_tx, ok := ra.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleApprovers is not a transactional entity")
+	}
+	ra.config.driver = _tx.drv
+	return ra
+}
+
+// String implements the fmt.Stringer.
+func (ra *RoleApprovers) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleApprovers(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ra.ID))
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", ra.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("approver_role_id=")
+	builder.WriteString(fmt.Sprintf("%v", ra.ApproverRoleID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ra.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleApproversSlice is a parsable slice of RoleApprovers.
+type RoleApproversSlice []*RoleApprovers
@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roleapprovers
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the roleapprovers type in the database.
+	Label = "role_approvers"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRoleID holds the string denoting the role_id field in the database.
+	FieldRoleID = "role_id"
+	// FieldApproverRoleID holds the string denoting the approver_role_id field in the database.
+	FieldApproverRoleID = "approver_role_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRole holds the string denoting the role edge name in mutations.
+	EdgeRole = "role"
+	// EdgeApproverRole holds the string denoting the approver_role edge name in mutations.
+	EdgeApproverRole = "approver_role"
+	// Table holds the table name of the roleapprovers in the database.
+	Table = "role_approvers"
+	// RoleTable is the table that holds the role relation/edge.
+	RoleTable = "role_approvers"
+	// RoleInverseTable is the table name for the Roles entity.
+	// It exists in this package in order to avoid circular dependency with the "roles" package.
+	RoleInverseTable = "roles"
+	// RoleColumn is the table column denoting the role relation/edge.
+	RoleColumn = "role_id"
+	// ApproverRoleTable is the table that holds the approver_role relation/edge.
+	ApproverRoleTable = "role_approvers"
+	// ApproverRoleInverseTable is the table name for the Roles entity.
+	// It exists in this package in order to avoid circular dependency with the "roles" package.
+	ApproverRoleInverseTable = "roles"
+	// ApproverRoleColumn is the table column denoting the approver_role relation/edge.
+	ApproverRoleColumn = "approver_role_id"
+)
+
+// Columns holds all SQL columns for roleapprovers fields.
+var Columns = []string{
+	FieldID,
+	FieldRoleID,
+	FieldApproverRoleID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the RoleApprovers queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRoleID orders the results by the role_id field.
+func ByRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleID, opts...).ToFunc()
+}
+
+// ByApproverRoleID orders the results by the approver_role_id field.
+func ByApproverRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApproverRoleID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRoleField orders the results by role field.
+func ByRoleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByApproverRoleField orders the results by approver_role field.
+func ByApproverRoleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newApproverRoleStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRoleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RoleTable, RoleColumn),
+	)
+}
+func newApproverRoleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ApproverRoleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ApproverRoleTable, ApproverRoleColumn),
+	)
+}
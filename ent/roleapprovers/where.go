@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roleapprovers
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldLTE(FieldID, id))
+}
+
+// RoleID applies equality check predicate on the "role_id" field. It's identical to RoleIDEQ.
+func RoleID(v int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldEQ(FieldRoleID, v))
+}
+
+// ApproverRoleID applies equality check predicate on the "approver_role_id" field. It's identical to ApproverRoleIDEQ.
+func ApproverRoleID(v int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldEQ(FieldApproverRoleID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RoleIDEQ applies the EQ predicate on the "role_id" field.
+func RoleIDEQ(v int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldEQ(FieldRoleID, v))
+}
+
+// RoleIDNEQ applies the NEQ predicate on the "role_id" field.
+func RoleIDNEQ(v int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldNEQ(FieldRoleID, v))
+}
+
+// RoleIDIn applies the In predicate on the "role_id" field.
+func RoleIDIn(vs ...int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldIn(FieldRoleID, vs...))
+}
+
+// RoleIDNotIn applies the NotIn predicate on the "role_id" field.
+func RoleIDNotIn(vs ...int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldNotIn(FieldRoleID, vs...))
+}
+
+// ApproverRoleIDEQ applies the EQ predicate on the "approver_role_id" field.
+func ApproverRoleIDEQ(v int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldEQ(FieldApproverRoleID, v))
+}
+
+// ApproverRoleIDNEQ applies the NEQ predicate on the "approver_role_id" field.
+func ApproverRoleIDNEQ(v int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldNEQ(FieldApproverRoleID, v))
+}
+
+// ApproverRoleIDIn applies the In predicate on the "approver_role_id" field.
+func ApproverRoleIDIn(vs ...int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldIn(FieldApproverRoleID, vs...))
+}
+
+// ApproverRoleIDNotIn applies the NotIn predicate on the "approver_role_id" field.
+func ApproverRoleIDNotIn(vs ...int) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldNotIn(FieldApproverRoleID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRole applies the HasEdge predicate on the "role" edge.
+func HasRole() predicate.RoleApprovers {
+	return predicate.RoleApprovers(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RoleTable, RoleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleWith applies the HasEdge predicate on the "role" edge with a given conditions (other predicates).
+func HasRoleWith(preds ...predicate.Roles) predicate.RoleApprovers {
+	return predicate.RoleApprovers(func(s *sql.Selector) {
+		step := newRoleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasApproverRole applies the HasEdge predicate on the "approver_role" edge.
+func HasApproverRole() predicate.RoleApprovers {
+	return predicate.RoleApprovers(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ApproverRoleTable, ApproverRoleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasApproverRoleWith applies the HasEdge predicate on the "approver_role" edge with a given conditions (other predicates).
+func HasApproverRoleWith(preds ...predicate.Roles) predicate.RoleApprovers {
+	return predicate.RoleApprovers(func(s *sql.Selector) {
+		step := newApproverRoleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoleApprovers) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoleApprovers) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoleApprovers) predicate.RoleApprovers {
+	return predicate.RoleApprovers(sql.NotPredicates(p))
+}
@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// RoleApproversCreate is the builder for creating a RoleApprovers entity.
+type RoleApproversCreate struct {
+	config
+	mutation *RoleApproversMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRoleID sets the "role_id" field.
+func (rac *RoleApproversCreate) SetRoleID(i int) *RoleApproversCreate {
+	rac.mutation.SetRoleID(i)
+	return rac
+}
+
+// SetApproverRoleID sets the "approver_role_id" field.
+func (rac *RoleApproversCreate) SetApproverRoleID(i int) *RoleApproversCreate {
+	rac.mutation.SetApproverRoleID(i)
+	return rac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rac *RoleApproversCreate) SetCreatedAt(t time.Time) *RoleApproversCreate {
+	rac.mutation.SetCreatedAt(t)
+	return rac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rac *RoleApproversCreate) SetNillableCreatedAt(t *time.Time) *RoleApproversCreate {
+	if t != nil {
+		rac.SetCreatedAt(*t)
+	}
+	return rac
+}
+
+// SetID sets the "id" field.
+func (rac *RoleApproversCreate) SetID(i int) *RoleApproversCreate {
+	rac.mutation.SetID(i)
+	return rac
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (rac *RoleApproversCreate) SetRole(r *Roles) *RoleApproversCreate {
+	return rac.SetRoleID(r.ID)
+}
+
+// SetApproverRole sets the "approver_role" edge to the Roles entity.
+func (rac *RoleApproversCreate) SetApproverRole(r *Roles) *RoleApproversCreate {
+	return rac.SetApproverRoleID(r.ID)
+}
+
+// Mutation returns the RoleApproversMutation object of the builder.
+func (rac *RoleApproversCreate) Mutation() *RoleApproversMutation {
+	return rac.mutation
+}
+
+// Save creates the RoleApprovers in the database.
+func (rac *RoleApproversCreate) Save(ctx context.Context) (*RoleApprovers, error) {
+	rac.defaults()
+	return withHooks(ctx, rac.sqlSave, rac.mutation, rac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rac *RoleApproversCreate) SaveX(ctx context.Context) *RoleApprovers {
+	v, err := rac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rac *RoleApproversCreate) Exec(ctx context.Context) error {
+	_, err := rac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rac *RoleApproversCreate) ExecX(ctx context.Context) {
+	if err := rac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rac *RoleApproversCreate) defaults() {
+	if _, ok := rac.mutation.CreatedAt(); !ok {
+		v := roleapprovers.DefaultCreatedAt()
+		rac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rac *RoleApproversCreate) check() error {
+	if _, ok := rac.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "RoleApprovers.role_id"`)}
+	}
+	if _, ok := rac.mutation.ApproverRoleID(); !ok {
+		return &ValidationError{Name: "approver_role_id", err: errors.New(`ent: missing required field "RoleApprovers.approver_role_id"`)}
+	}
+	if _, ok := rac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoleApprovers.created_at"`)}
+	}
+	if _, ok := rac.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "RoleApprovers.role"`)}
+	}
+	if _, ok := rac.mutation.ApproverRoleID(); !ok {
+		return &ValidationError{Name: "approver_role", err: errors.New(`ent: missing required edge "RoleApprovers.approver_role"`)}
+	}
+	return nil
+}
+
+func (rac *RoleApproversCreate) sqlSave(ctx context.Context) (*RoleApprovers, error) {
+	if err := rac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rac.mutation.id = &_node.ID
+	rac.mutation.done = true
+	return _node, nil
+}
+
+func (rac *RoleApproversCreate) createSpec() (*RoleApprovers, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleApprovers{config: rac.config}
+		_spec = sqlgraph.NewCreateSpec(roleapprovers.Table, sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rac.conflict
+	if id, ok := rac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rac.mutation.CreatedAt(); ok {
+		_spec.SetField(roleapprovers.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rac.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleapprovers.RoleTable,
+			Columns: []string{roleapprovers.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rac.mutation.ApproverRoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleapprovers.ApproverRoleTable,
+			Columns: []string{roleapprovers.ApproverRoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ApproverRoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoleApprovers.Create().
+//		SetRoleID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoleApproversUpsert) {
+//			SetRoleID(v+v).
+//		}).
+//		Exec(ctx)
+func (rac *RoleApproversCreate) OnConflict(opts ...sql.ConflictOption) *RoleApproversUpsertOne {
+	rac.conflict = opts
+	return &RoleApproversUpsertOne{
+		create: rac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoleApprovers.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rac *RoleApproversCreate) OnConflictColumns(columns ...string) *RoleApproversUpsertOne {
+	rac.conflict = append(rac.conflict, sql.ConflictColumns(columns...))
+	return &RoleApproversUpsertOne{
+		create: rac,
+	}
+}
+
+type (
+	// RoleApproversUpsertOne is the builder for "upsert"-ing
+	//  one RoleApprovers node.
+	RoleApproversUpsertOne struct {
+		create *RoleApproversCreate
+	}
+
+	// RoleApproversUpsert is the "OnConflict" setter.
+	RoleApproversUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRoleID sets the "role_id" field.
+func (u *RoleApproversUpsert) SetRoleID(v int) *RoleApproversUpsert {
+	u.Set(roleapprovers.FieldRoleID, v)
+	return u
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RoleApproversUpsert) UpdateRoleID() *RoleApproversUpsert {
+	u.SetExcluded(roleapprovers.FieldRoleID)
+	return u
+}
+
+// SetApproverRoleID sets the "approver_role_id" field.
+func (u *RoleApproversUpsert) SetApproverRoleID(v int) *RoleApproversUpsert {
+	u.Set(roleapprovers.FieldApproverRoleID, v)
+	return u
+}
+
+// UpdateApproverRoleID sets the "approver_role_id" field to the value that was provided on create.
+func (u *RoleApproversUpsert) UpdateApproverRoleID() *RoleApproversUpsert {
+	u.SetExcluded(roleapprovers.FieldApproverRoleID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RoleApprovers.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(roleapprovers.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoleApproversUpsertOne) UpdateNewValues() *RoleApproversUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(roleapprovers.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(roleapprovers.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoleApprovers.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RoleApproversUpsertOne) Ignore() *RoleApproversUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoleApproversUpsertOne) DoNothing() *RoleApproversUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoleApproversCreate.OnConflict
+// documentation for more info.
+func (u *RoleApproversUpsertOne) Update(set func(*RoleApproversUpsert)) *RoleApproversUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoleApproversUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *RoleApproversUpsertOne) SetRoleID(v int) *RoleApproversUpsertOne {
+	return u.Update(func(s *RoleApproversUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RoleApproversUpsertOne) UpdateRoleID() *RoleApproversUpsertOne {
+	return u.Update(func(s *RoleApproversUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetApproverRoleID sets the "approver_role_id" field.
+func (u *RoleApproversUpsertOne) SetApproverRoleID(v int) *RoleApproversUpsertOne {
+	return u.Update(func(s *RoleApproversUpsert) {
+		s.SetApproverRoleID(v)
+	})
+}
+
+// UpdateApproverRoleID sets the "approver_role_id" field to the value that was provided on create.
+func (u *RoleApproversUpsertOne) UpdateApproverRoleID() *RoleApproversUpsertOne {
+	return u.Update(func(s *RoleApproversUpsert) {
+		s.UpdateApproverRoleID()
+	})
+}
+
+// Exec executes the query.
+func (u *RoleApproversUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoleApproversCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoleApproversUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RoleApproversUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RoleApproversUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RoleApproversCreateBulk is the builder for creating many RoleApprovers entities in bulk.
+type RoleApproversCreateBulk struct {
+	config
+	err      error
+	builders []*RoleApproversCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RoleApprovers entities in the database.
+func (racb *RoleApproversCreateBulk) Save(ctx context.Context) ([]*RoleApprovers, error) {
+	if racb.err != nil {
+		return nil, racb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(racb.builders))
+	nodes := make([]*RoleApprovers, len(racb.builders))
+	mutators := make([]Mutator, len(racb.builders))
+	for i := range racb.builders {
+		func(i int, root context.Context) {
+			builder := racb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleApproversMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, racb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = racb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, racb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, racb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (racb *RoleApproversCreateBulk) SaveX(ctx context.Context) []*RoleApprovers {
+	v, err := racb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (racb *RoleApproversCreateBulk) Exec(ctx context.Context) error {
+	_, err := racb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (racb *RoleApproversCreateBulk) ExecX(ctx context.Context) {
+	if err := racb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoleApprovers.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoleApproversUpsert) {
+//			SetRoleID(v+v).
+//		}).
+//		Exec(ctx)
+func (racb *RoleApproversCreateBulk) OnConflict(opts ...sql.ConflictOption) *RoleApproversUpsertBulk {
+	racb.conflict = opts
+	return &RoleApproversUpsertBulk{
+		create: racb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoleApprovers.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (racb *RoleApproversCreateBulk) OnConflictColumns(columns ...string) *RoleApproversUpsertBulk {
+	racb.conflict = append(racb.conflict, sql.ConflictColumns(columns...))
+	return &RoleApproversUpsertBulk{
+		create: racb,
+	}
+}
+
+// RoleApproversUpsertBulk is the builder for "upsert"-ing
+// a bulk of RoleApprovers nodes.
+type RoleApproversUpsertBulk struct {
+	create *RoleApproversCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RoleApprovers.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(roleapprovers.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoleApproversUpsertBulk) UpdateNewValues() *RoleApproversUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(roleapprovers.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(roleapprovers.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoleApprovers.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RoleApproversUpsertBulk) Ignore() *RoleApproversUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoleApproversUpsertBulk) DoNothing() *RoleApproversUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoleApproversCreateBulk.OnConflict
+// documentation for more info.
+func (u *RoleApproversUpsertBulk) Update(set func(*RoleApproversUpsert)) *RoleApproversUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoleApproversUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *RoleApproversUpsertBulk) SetRoleID(v int) *RoleApproversUpsertBulk {
+	return u.Update(func(s *RoleApproversUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RoleApproversUpsertBulk) UpdateRoleID() *RoleApproversUpsertBulk {
+	return u.Update(func(s *RoleApproversUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetApproverRoleID sets the "approver_role_id" field.
+func (u *RoleApproversUpsertBulk) SetApproverRoleID(v int) *RoleApproversUpsertBulk {
+	return u.Update(func(s *RoleApproversUpsert) {
+		s.SetApproverRoleID(v)
+	})
+}
+
+// UpdateApproverRoleID sets the "approver_role_id" field to the value that was provided on create.
+func (u *RoleApproversUpsertBulk) UpdateApproverRoleID() *RoleApproversUpsertBulk {
+	return u.Update(func(s *RoleApproversUpsert) {
+		s.UpdateApproverRoleID()
+	})
+}
+
+// Exec executes the query.
+func (u *RoleApproversUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RoleApproversCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoleApproversCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoleApproversUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+)
+
+// RoleApproversDelete is the builder for deleting a RoleApprovers entity.
+type RoleApproversDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoleApproversMutation
+}
+
+// Where appends a list predicates to the RoleApproversDelete builder.
+func (rad *RoleApproversDelete) Where(ps ...predicate.RoleApprovers) *RoleApproversDelete {
+	rad.mutation.Where(ps...)
+	return rad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rad *RoleApproversDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rad.sqlExec, rad.mutation, rad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rad *RoleApproversDelete) ExecX(ctx context.Context) int {
+	n, err := rad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rad *RoleApproversDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(roleapprovers.Table, sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt))
+	if ps := rad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rad.mutation.done = true
+	return affected, err
+}
+
+// RoleApproversDeleteOne is the builder for deleting a single RoleApprovers entity.
+type RoleApproversDeleteOne struct {
+	rad *RoleApproversDelete
+}
+
+// Where appends a list predicates to the RoleApproversDelete builder.
+func (rado *RoleApproversDeleteOne) Where(ps ...predicate.RoleApprovers) *RoleApproversDeleteOne {
+	rado.rad.mutation.Where(ps...)
+	return rado
+}
+
+// Exec executes the deletion query.
+func (rado *RoleApproversDeleteOne) Exec(ctx context.Context) error {
+	n, err := rado.rad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{roleapprovers.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rado *RoleApproversDeleteOne) ExecX(ctx context.Context) {
+	if err := rado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
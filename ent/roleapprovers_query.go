@@ -0,0 +1,716 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// RoleApproversQuery is the builder for querying RoleApprovers entities.
+type RoleApproversQuery struct {
+	config
+	ctx              *QueryContext
+	order            []roleapprovers.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.RoleApprovers
+	withRole         *RolesQuery
+	withApproverRole *RolesQuery
+	modifiers        []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RoleApproversQuery builder.
+func (raq *RoleApproversQuery) Where(ps ...predicate.RoleApprovers) *RoleApproversQuery {
+	raq.predicates = append(raq.predicates, ps...)
+	return raq
+}
+
+// Limit the number of records to be returned by this query.
+func (raq *RoleApproversQuery) Limit(limit int) *RoleApproversQuery {
+	raq.ctx.Limit = &limit
+	return raq
+}
+
+// Offset to start from.
+func (raq *RoleApproversQuery) Offset(offset int) *RoleApproversQuery {
+	raq.ctx.Offset = &offset
+	return raq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (raq *RoleApproversQuery) Unique(unique bool) *RoleApproversQuery {
+	raq.ctx.Unique = &unique
+	return raq
+}
+
+// Order specifies how the records should be ordered.
+func (raq *RoleApproversQuery) Order(o ...roleapprovers.OrderOption) *RoleApproversQuery {
+	raq.order = append(raq.order, o...)
+	return raq
+}
+
+// QueryRole chains the current query on the "role" edge.
+func (raq *RoleApproversQuery) QueryRole() *RolesQuery {
+	query := (&RolesClient{config: raq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := raq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := raq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roleapprovers.Table, roleapprovers.FieldID, selector),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roleapprovers.RoleTable, roleapprovers.RoleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(raq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryApproverRole chains the current query on the "approver_role" edge.
+func (raq *RoleApproversQuery) QueryApproverRole() *RolesQuery {
+	query := (&RolesClient{config: raq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := raq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := raq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roleapprovers.Table, roleapprovers.FieldID, selector),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roleapprovers.ApproverRoleTable, roleapprovers.ApproverRoleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(raq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RoleApprovers entity from the query.
+// Returns a *NotFoundError when no RoleApprovers was found.
+func (raq *RoleApproversQuery) First(ctx context.Context) (*RoleApprovers, error) {
+	nodes, err := raq.Limit(1).All(setContextOp(ctx, raq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{roleapprovers.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (raq *RoleApproversQuery) FirstX(ctx context.Context) *RoleApprovers {
+	node, err := raq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RoleApprovers ID from the query.
+// Returns a *NotFoundError when no RoleApprovers ID was found.
+func (raq *RoleApproversQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = raq.Limit(1).IDs(setContextOp(ctx, raq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{roleapprovers.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (raq *RoleApproversQuery) FirstIDX(ctx context.Context) int {
+	id, err := raq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RoleApprovers entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RoleApprovers entity is found.
+// Returns a *NotFoundError when no RoleApprovers entities are found.
+func (raq *RoleApproversQuery) Only(ctx context.Context) (*RoleApprovers, error) {
+	nodes, err := raq.Limit(2).All(setContextOp(ctx, raq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{roleapprovers.Label}
+	default:
+		return nil, &NotSingularError{roleapprovers.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (raq *RoleApproversQuery) OnlyX(ctx context.Context) *RoleApprovers {
+	node, err := raq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RoleApprovers ID in the query.
+// Returns a *NotSingularError when more than one RoleApprovers ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (raq *RoleApproversQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = raq.Limit(2).IDs(setContextOp(ctx, raq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{roleapprovers.Label}
+	default:
+		err = &NotSingularError{roleapprovers.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (raq *RoleApproversQuery) OnlyIDX(ctx context.Context) int {
+	id, err := raq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RoleApproversSlice.
+func (raq *RoleApproversQuery) All(ctx context.Context) ([]*RoleApprovers, error) {
+	ctx = setContextOp(ctx, raq.ctx, "All")
+	if err := raq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RoleApprovers, *RoleApproversQuery]()
+	return withInterceptors[[]*RoleApprovers](ctx, raq, qr, raq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (raq *RoleApproversQuery) AllX(ctx context.Context) []*RoleApprovers {
+	nodes, err := raq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RoleApprovers IDs.
+func (raq *RoleApproversQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if raq.ctx.Unique == nil && raq.path != nil {
+		raq.Unique(true)
+	}
+	ctx = setContextOp(ctx, raq.ctx, "IDs")
+	if err = raq.Select(roleapprovers.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (raq *RoleApproversQuery) IDsX(ctx context.Context) []int {
+	ids, err := raq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (raq *RoleApproversQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, raq.ctx, "Count")
+	if err := raq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, raq, querierCount[*RoleApproversQuery](), raq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (raq *RoleApproversQuery) CountX(ctx context.Context) int {
+	count, err := raq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (raq *RoleApproversQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, raq.ctx, "Exist")
+	switch _, err := raq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (raq *RoleApproversQuery) ExistX(ctx context.Context) bool {
+	exist, err := raq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RoleApproversQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (raq *RoleApproversQuery) Clone() *RoleApproversQuery {
+	if raq == nil {
+		return nil
+	}
+	return &RoleApproversQuery{
+		config:           raq.config,
+		ctx:              raq.ctx.Clone(),
+		order:            append([]roleapprovers.OrderOption{}, raq.order...),
+		inters:           append([]Interceptor{}, raq.inters...),
+		predicates:       append([]predicate.RoleApprovers{}, raq.predicates...),
+		withRole:         raq.withRole.Clone(),
+		withApproverRole: raq.withApproverRole.Clone(),
+		// clone intermediate query.
+		sql:  raq.sql.Clone(),
+		path: raq.path,
+	}
+}
+
+// WithRole tells the query-builder to eager-load the nodes that are connected to
+// the "role" edge. The optional arguments are used to configure the query builder of the edge.
+func (raq *RoleApproversQuery) WithRole(opts ...func(*RolesQuery)) *RoleApproversQuery {
+	query := (&RolesClient{config: raq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	raq.withRole = query
+	return raq
+}
+
+// WithApproverRole tells the query-builder to eager-load the nodes that are connected to
+// the "approver_role" edge. The optional arguments are used to configure the query builder of the edge.
+func (raq *RoleApproversQuery) WithApproverRole(opts ...func(*RolesQuery)) *RoleApproversQuery {
+	query := (&RolesClient{config: raq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	raq.withApproverRole = query
+	return raq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RoleID int `json:"role_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RoleApprovers.Query().
+//		GroupBy(roleapprovers.FieldRoleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (raq *RoleApproversQuery) GroupBy(field string, fields ...string) *RoleApproversGroupBy {
+	raq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RoleApproversGroupBy{build: raq}
+	grbuild.flds = &raq.ctx.Fields
+	grbuild.label = roleapprovers.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RoleID int `json:"role_id,omitempty"`
+//	}
+//
+//	client.RoleApprovers.Query().
+//		Select(roleapprovers.FieldRoleID).
+//		Scan(ctx, &v)
+func (raq *RoleApproversQuery) Select(fields ...string) *RoleApproversSelect {
+	raq.ctx.Fields = append(raq.ctx.Fields, fields...)
+	sbuild := &RoleApproversSelect{RoleApproversQuery: raq}
+	sbuild.label = roleapprovers.Label
+	sbuild.flds, sbuild.scan = &raq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RoleApproversSelect configured with the given aggregations.
+func (raq *RoleApproversQuery) Aggregate(fns ...AggregateFunc) *RoleApproversSelect {
+	return raq.Select().Aggregate(fns...)
+}
+
+func (raq *RoleApproversQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range raq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, raq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range raq.ctx.Fields {
+		if !roleapprovers.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if raq.path != nil {
+		prev, err := raq.path(ctx)
+		if err != nil {
+			return err
+		}
+		raq.sql = prev
+	}
+	return nil
+}
+
+func (raq *RoleApproversQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RoleApprovers, error) {
+	var (
+		nodes       = []*RoleApprovers{}
+		_spec       = raq.querySpec()
+		loadedTypes = [2]bool{
+			raq.withRole != nil,
+			raq.withApproverRole != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RoleApprovers).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RoleApprovers{config: raq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(raq.modifiers) > 0 {
+		_spec.Modifiers = raq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, raq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := raq.withRole; query != nil {
+		if err := raq.loadRole(ctx, query, nodes, nil,
+			func(n *RoleApprovers, e *Roles) { n.Edges.Role = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := raq.withApproverRole; query != nil {
+		if err := raq.loadApproverRole(ctx, query, nodes, nil,
+			func(n *RoleApprovers, e *Roles) { n.Edges.ApproverRole = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (raq *RoleApproversQuery) loadRole(ctx context.Context, query *RolesQuery, nodes []*RoleApprovers, init func(*RoleApprovers), assign func(*RoleApprovers, *Roles)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RoleApprovers)
+	for i := range nodes {
+		fk := nodes[i].RoleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(roles.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "role_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (raq *RoleApproversQuery) loadApproverRole(ctx context.Context, query *RolesQuery, nodes []*RoleApprovers, init func(*RoleApprovers), assign func(*RoleApprovers, *Roles)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RoleApprovers)
+	for i := range nodes {
+		fk := nodes[i].ApproverRoleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(roles.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "approver_role_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (raq *RoleApproversQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := raq.querySpec()
+	if len(raq.modifiers) > 0 {
+		_spec.Modifiers = raq.modifiers
+	}
+	_spec.Node.Columns = raq.ctx.Fields
+	if len(raq.ctx.Fields) > 0 {
+		_spec.Unique = raq.ctx.Unique != nil && *raq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, raq.driver, _spec)
+}
+
+func (raq *RoleApproversQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(roleapprovers.Table, roleapprovers.Columns, sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt))
+	_spec.From = raq.sql
+	if unique := raq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if raq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := raq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roleapprovers.FieldID)
+		for i := range fields {
+			if fields[i] != roleapprovers.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if raq.withRole != nil {
+			_spec.Node.AddColumnOnce(roleapprovers.FieldRoleID)
+		}
+		if raq.withApproverRole != nil {
+			_spec.Node.AddColumnOnce(roleapprovers.FieldApproverRoleID)
+		}
+	}
+	if ps := raq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := raq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := raq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := raq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (raq *RoleApproversQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(raq.driver.Dialect())
+	t1 := builder.Table(roleapprovers.Table)
+	columns := raq.ctx.Fields
+	if len(columns) == 0 {
+		columns = roleapprovers.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if raq.sql != nil {
+		selector = raq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if raq.ctx.Unique != nil && *raq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range raq.modifiers {
+		m(selector)
+	}
+	for _, p := range raq.predicates {
+		p(selector)
+	}
+	for _, p := range raq.order {
+		p(selector)
+	}
+	if offset := raq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := raq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (raq *RoleApproversQuery) ForUpdate(opts ...sql.LockOption) *RoleApproversQuery {
+	if raq.driver.Dialect() == dialect.Postgres {
+		raq.Unique(false)
+	}
+	raq.modifiers = append(raq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return raq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (raq *RoleApproversQuery) ForShare(opts ...sql.LockOption) *RoleApproversQuery {
+	if raq.driver.Dialect() == dialect.Postgres {
+		raq.Unique(false)
+	}
+	raq.modifiers = append(raq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return raq
+}
+
+// RoleApproversGroupBy is the group-by builder for RoleApprovers entities.
+type RoleApproversGroupBy struct {
+	selector
+	build *RoleApproversQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ragb *RoleApproversGroupBy) Aggregate(fns ...AggregateFunc) *RoleApproversGroupBy {
+	ragb.fns = append(ragb.fns, fns...)
+	return ragb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ragb *RoleApproversGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ragb.build.ctx, "GroupBy")
+	if err := ragb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoleApproversQuery, *RoleApproversGroupBy](ctx, ragb.build, ragb, ragb.build.inters, v)
+}
+
+func (ragb *RoleApproversGroupBy) sqlScan(ctx context.Context, root *RoleApproversQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ragb.fns))
+	for _, fn := range ragb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ragb.flds)+len(ragb.fns))
+		for _, f := range *ragb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ragb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ragb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RoleApproversSelect is the builder for selecting fields of RoleApprovers entities.
+type RoleApproversSelect struct {
+	*RoleApproversQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ras *RoleApproversSelect) Aggregate(fns ...AggregateFunc) *RoleApproversSelect {
+	ras.fns = append(ras.fns, fns...)
+	return ras
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ras *RoleApproversSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ras.ctx, "Select")
+	if err := ras.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoleApproversQuery, *RoleApproversSelect](ctx, ras.RoleApproversQuery, ras, ras.inters, v)
+}
+
+func (ras *RoleApproversSelect) sqlScan(ctx context.Context, root *RoleApproversQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ras.fns))
+	for _, fn := range ras.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ras.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ras.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// RoleApproversUpdate is the builder for updating RoleApprovers entities.
+type RoleApproversUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoleApproversMutation
+}
+
+// Where appends a list predicates to the RoleApproversUpdate builder.
+func (rau *RoleApproversUpdate) Where(ps ...predicate.RoleApprovers) *RoleApproversUpdate {
+	rau.mutation.Where(ps...)
+	return rau
+}
+
+// SetRoleID sets the "role_id" field.
+func (rau *RoleApproversUpdate) SetRoleID(i int) *RoleApproversUpdate {
+	rau.mutation.SetRoleID(i)
+	return rau
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (rau *RoleApproversUpdate) SetNillableRoleID(i *int) *RoleApproversUpdate {
+	if i != nil {
+		rau.SetRoleID(*i)
+	}
+	return rau
+}
+
+// SetApproverRoleID sets the "approver_role_id" field.
+func (rau *RoleApproversUpdate) SetApproverRoleID(i int) *RoleApproversUpdate {
+	rau.mutation.SetApproverRoleID(i)
+	return rau
+}
+
+// SetNillableApproverRoleID sets the "approver_role_id" field if the given value is not nil.
+func (rau *RoleApproversUpdate) SetNillableApproverRoleID(i *int) *RoleApproversUpdate {
+	if i != nil {
+		rau.SetApproverRoleID(*i)
+	}
+	return rau
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (rau *RoleApproversUpdate) SetRole(r *Roles) *RoleApproversUpdate {
+	return rau.SetRoleID(r.ID)
+}
+
+// SetApproverRole sets the "approver_role" edge to the Roles entity.
+func (rau *RoleApproversUpdate) SetApproverRole(r *Roles) *RoleApproversUpdate {
+	return rau.SetApproverRoleID(r.ID)
+}
+
+// Mutation returns the RoleApproversMutation object of the builder.
+func (rau *RoleApproversUpdate) Mutation() *RoleApproversMutation {
+	return rau.mutation
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (rau *RoleApproversUpdate) ClearRole() *RoleApproversUpdate {
+	rau.mutation.ClearRole()
+	return rau
+}
+
+// ClearApproverRole clears the "approver_role" edge to the Roles entity.
+func (rau *RoleApproversUpdate) ClearApproverRole() *RoleApproversUpdate {
+	rau.mutation.ClearApproverRole()
+	return rau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rau *RoleApproversUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rau.sqlSave, rau.mutation, rau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rau *RoleApproversUpdate) SaveX(ctx context.Context) int {
+	affected, err := rau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rau *RoleApproversUpdate) Exec(ctx context.Context) error {
+	_, err := rau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rau *RoleApproversUpdate) ExecX(ctx context.Context) {
+	if err := rau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rau *RoleApproversUpdate) check() error {
+	if _, ok := rau.mutation.RoleID(); rau.mutation.RoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RoleApprovers.role"`)
+	}
+	if _, ok := rau.mutation.ApproverRoleID(); rau.mutation.ApproverRoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RoleApprovers.approver_role"`)
+	}
+	return nil
+}
+
+func (rau *RoleApproversUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roleapprovers.Table, roleapprovers.Columns, sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt))
+	if ps := rau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rau.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleapprovers.RoleTable,
+			Columns: []string{roleapprovers.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rau.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleapprovers.RoleTable,
+			Columns: []string{roleapprovers.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rau.mutation.ApproverRoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleapprovers.ApproverRoleTable,
+			Columns: []string{roleapprovers.ApproverRoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rau.mutation.ApproverRoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleapprovers.ApproverRoleTable,
+			Columns: []string{roleapprovers.ApproverRoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roleapprovers.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rau.mutation.done = true
+	return n, nil
+}
+
+// RoleApproversUpdateOne is the builder for updating a single RoleApprovers entity.
+type RoleApproversUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoleApproversMutation
+}
+
+// SetRoleID sets the "role_id" field.
+func (rauo *RoleApproversUpdateOne) SetRoleID(i int) *RoleApproversUpdateOne {
+	rauo.mutation.SetRoleID(i)
+	return rauo
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (rauo *RoleApproversUpdateOne) SetNillableRoleID(i *int) *RoleApproversUpdateOne {
+	if i != nil {
+		rauo.SetRoleID(*i)
+	}
+	return rauo
+}
+
+// SetApproverRoleID sets the "approver_role_id" field.
+func (rauo *RoleApproversUpdateOne) SetApproverRoleID(i int) *RoleApproversUpdateOne {
+	rauo.mutation.SetApproverRoleID(i)
+	return rauo
+}
+
+// SetNillableApproverRoleID sets the "approver_role_id" field if the given value is not nil.
+func (rauo *RoleApproversUpdateOne) SetNillableApproverRoleID(i *int) *RoleApproversUpdateOne {
+	if i != nil {
+		rauo.SetApproverRoleID(*i)
+	}
+	return rauo
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (rauo *RoleApproversUpdateOne) SetRole(r *Roles) *RoleApproversUpdateOne {
+	return rauo.SetRoleID(r.ID)
+}
+
+// SetApproverRole sets the "approver_role" edge to the Roles entity.
+func (rauo *RoleApproversUpdateOne) SetApproverRole(r *Roles) *RoleApproversUpdateOne {
+	return rauo.SetApproverRoleID(r.ID)
+}
+
+// Mutation returns the RoleApproversMutation object of the builder.
+func (rauo *RoleApproversUpdateOne) Mutation() *RoleApproversMutation {
+	return rauo.mutation
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (rauo *RoleApproversUpdateOne) ClearRole() *RoleApproversUpdateOne {
+	rauo.mutation.ClearRole()
+	return rauo
+}
+
+// ClearApproverRole clears the "approver_role" edge to the Roles entity.
+func (rauo *RoleApproversUpdateOne) ClearApproverRole() *RoleApproversUpdateOne {
+	rauo.mutation.ClearApproverRole()
+	return rauo
+}
+
+// Where appends a list predicates to the RoleApproversUpdate builder.
+func (rauo *RoleApproversUpdateOne) Where(ps ...predicate.RoleApprovers) *RoleApproversUpdateOne {
+	rauo.mutation.Where(ps...)
+	return rauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rauo *RoleApproversUpdateOne) Select(field string, fields ...string) *RoleApproversUpdateOne {
+	rauo.fields = append([]string{field}, fields...)
+	return rauo
+}
+
+// Save executes the query and returns the updated RoleApprovers entity.
+func (rauo *RoleApproversUpdateOne) Save(ctx context.Context) (*RoleApprovers, error) {
+	return withHooks(ctx, rauo.sqlSave, rauo.mutation, rauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rauo *RoleApproversUpdateOne) SaveX(ctx context.Context) *RoleApprovers {
+	node, err := rauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rauo *RoleApproversUpdateOne) Exec(ctx context.Context) error {
+	_, err := rauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rauo *RoleApproversUpdateOne) ExecX(ctx context.Context) {
+	if err := rauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rauo *RoleApproversUpdateOne) check() error {
+	if _, ok := rauo.mutation.RoleID(); rauo.mutation.RoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RoleApprovers.role"`)
+	}
+	if _, ok := rauo.mutation.ApproverRoleID(); rauo.mutation.ApproverRoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RoleApprovers.approver_role"`)
+	}
+	return nil
+}
+
+func (rauo *RoleApproversUpdateOne) sqlSave(ctx context.Context) (_node *RoleApprovers, err error) {
+	if err := rauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roleapprovers.Table, roleapprovers.Columns, sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt))
+	id, ok := rauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleApprovers.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roleapprovers.FieldID)
+		for _, f := range fields {
+			if !roleapprovers.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roleapprovers.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rauo.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleapprovers.RoleTable,
+			Columns: []string{roleapprovers.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rauo.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleapprovers.RoleTable,
+			Columns: []string{roleapprovers.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rauo.mutation.ApproverRoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleapprovers.ApproverRoleTable,
+			Columns: []string{roleapprovers.ApproverRoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rauo.mutation.ApproverRoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleapprovers.ApproverRoleTable,
+			Columns: []string{roleapprovers.ApproverRoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RoleApprovers{config: rauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roleapprovers.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rauo.mutation.done = true
+	return _node, nil
+}
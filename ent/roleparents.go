@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shammianand/go-auth/ent/roleparents"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// RoleParents is the model entity for the RoleParents schema.
+type RoleParents struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Role that inherits
+	RoleID int `json:"role_id,omitempty"`
+	// Role whose permissions are inherited
+	ParentRoleID int `json:"parent_role_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoleParentsQuery when eager-loading is set.
+	Edges        RoleParentsEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RoleParentsEdges holds the relations/edges for other nodes in the graph.
+type RoleParentsEdges struct {
+	// Role holds the value of the role edge.
+	Role *Roles `json:"role,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Roles `json:"parent,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleParentsEdges) RoleOrErr() (*Roles, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: roles.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RoleParentsEdges) ParentOrErr() (*Roles, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: roles.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleParents) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case roleparents.FieldID, roleparents.FieldRoleID, roleparents.FieldParentRoleID:
+			values[i] = new(sql.NullInt64)
+		case roleparents.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleParents fields.
+func (rp *RoleParents) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case roleparents.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rp.ID = int(value.Int64)
+		case roleparents.FieldRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				rp.RoleID = int(value.Int64)
+			}
+		case roleparents.FieldParentRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_role_id", values[i])
+			} else if value.Valid {
+				rp.ParentRoleID = int(value.Int64)
+			}
+		case roleparents.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rp.CreatedAt = value.Time
+			}
+		default:
+			rp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleParents.
+// This includes values selected through modifiers, order, etc.
+func (rp *RoleParents) Value(name string) (ent.Value, error) {
+	return rp.selectValues.Get(name)
+}
+
+// QueryRole queries the "role" edge of the RoleParents entity.
+func (rp *RoleParents) QueryRole() *RolesQuery {
+	return NewRoleParentsClient(rp.config).QueryRole(rp)
+}
+
+// QueryParent queries the "parent" edge of the RoleParents entity.
+func (rp *RoleParents) QueryParent() *RolesQuery {
+	return NewRoleParentsClient(rp.config).QueryParent(rp)
+}
+
+// Update returns a builder for updating this RoleParents.
+// Note that you need to call RoleParents.Unwrap() before calling this method if this RoleParents
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rp *RoleParents) Update() *RoleParentsUpdateOne {
+	return NewRoleParentsClient(rp.config).UpdateOne(rp)
+}
+
+// Unwrap unwraps the RoleParents entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rp *RoleParents) Unwrap() *RoleParents {
+	_tx, ok := rp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleParents is not a transactional entity")
+	}
+	rp.config.driver = _tx.drv
+	return rp
+}
+
+// String implements the fmt.Stringer.
+func (rp *RoleParents) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleParents(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rp.ID))
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", rp.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("parent_role_id=")
+	builder.WriteString(fmt.Sprintf("%v", rp.ParentRoleID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleParentsSlice is a parsable slice of RoleParents.
+type RoleParentsSlice []*RoleParents
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package roleparents
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldLTE(FieldID, id))
+}
+
+// RoleID applies equality check predicate on the "role_id" field. It's identical to RoleIDEQ.
+func RoleID(v int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldEQ(FieldRoleID, v))
+}
+
+// ParentRoleID applies equality check predicate on the "parent_role_id" field. It's identical to ParentRoleIDEQ.
+func ParentRoleID(v int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldEQ(FieldParentRoleID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RoleIDEQ applies the EQ predicate on the "role_id" field.
+func RoleIDEQ(v int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldEQ(FieldRoleID, v))
+}
+
+// RoleIDNEQ applies the NEQ predicate on the "role_id" field.
+func RoleIDNEQ(v int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldNEQ(FieldRoleID, v))
+}
+
+// RoleIDIn applies the In predicate on the "role_id" field.
+func RoleIDIn(vs ...int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldIn(FieldRoleID, vs...))
+}
+
+// RoleIDNotIn applies the NotIn predicate on the "role_id" field.
+func RoleIDNotIn(vs ...int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldNotIn(FieldRoleID, vs...))
+}
+
+// ParentRoleIDEQ applies the EQ predicate on the "parent_role_id" field.
+func ParentRoleIDEQ(v int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldEQ(FieldParentRoleID, v))
+}
+
+// ParentRoleIDNEQ applies the NEQ predicate on the "parent_role_id" field.
+func ParentRoleIDNEQ(v int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldNEQ(FieldParentRoleID, v))
+}
+
+// ParentRoleIDIn applies the In predicate on the "parent_role_id" field.
+func ParentRoleIDIn(vs ...int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldIn(FieldParentRoleID, vs...))
+}
+
+// ParentRoleIDNotIn applies the NotIn predicate on the "parent_role_id" field.
+func ParentRoleIDNotIn(vs ...int) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldNotIn(FieldParentRoleID, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RoleParents {
+	return predicate.RoleParents(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasRole applies the HasEdge predicate on the "role" edge.
+func HasRole() predicate.RoleParents {
+	return predicate.RoleParents(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RoleTable, RoleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleWith applies the HasEdge predicate on the "role" edge with a given conditions (other predicates).
+func HasRoleWith(preds ...predicate.Roles) predicate.RoleParents {
+	return predicate.RoleParents(func(s *sql.Selector) {
+		step := newRoleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.RoleParents {
+	return predicate.RoleParents(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Roles) predicate.RoleParents {
+	return predicate.RoleParents(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoleParents) predicate.RoleParents {
+	return predicate.RoleParents(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoleParents) predicate.RoleParents {
+	return predicate.RoleParents(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoleParents) predicate.RoleParents {
+	return predicate.RoleParents(sql.NotPredicates(p))
+}
@@ -0,0 +1,624 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/roleparents"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// RoleParentsCreate is the builder for creating a RoleParents entity.
+type RoleParentsCreate struct {
+	config
+	mutation *RoleParentsMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRoleID sets the "role_id" field.
+func (rpc *RoleParentsCreate) SetRoleID(i int) *RoleParentsCreate {
+	rpc.mutation.SetRoleID(i)
+	return rpc
+}
+
+// SetParentRoleID sets the "parent_role_id" field.
+func (rpc *RoleParentsCreate) SetParentRoleID(i int) *RoleParentsCreate {
+	rpc.mutation.SetParentRoleID(i)
+	return rpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpc *RoleParentsCreate) SetCreatedAt(t time.Time) *RoleParentsCreate {
+	rpc.mutation.SetCreatedAt(t)
+	return rpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpc *RoleParentsCreate) SetNillableCreatedAt(t *time.Time) *RoleParentsCreate {
+	if t != nil {
+		rpc.SetCreatedAt(*t)
+	}
+	return rpc
+}
+
+// SetID sets the "id" field.
+func (rpc *RoleParentsCreate) SetID(i int) *RoleParentsCreate {
+	rpc.mutation.SetID(i)
+	return rpc
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (rpc *RoleParentsCreate) SetRole(r *Roles) *RoleParentsCreate {
+	return rpc.SetRoleID(r.ID)
+}
+
+// SetParentID sets the "parent" edge to the Roles entity by ID.
+func (rpc *RoleParentsCreate) SetParentID(id int) *RoleParentsCreate {
+	rpc.mutation.SetParentID(id)
+	return rpc
+}
+
+// SetParent sets the "parent" edge to the Roles entity.
+func (rpc *RoleParentsCreate) SetParent(r *Roles) *RoleParentsCreate {
+	return rpc.SetParentID(r.ID)
+}
+
+// Mutation returns the RoleParentsMutation object of the builder.
+func (rpc *RoleParentsCreate) Mutation() *RoleParentsMutation {
+	return rpc.mutation
+}
+
+// Save creates the RoleParents in the database.
+func (rpc *RoleParentsCreate) Save(ctx context.Context) (*RoleParents, error) {
+	rpc.defaults()
+	return withHooks(ctx, rpc.sqlSave, rpc.mutation, rpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rpc *RoleParentsCreate) SaveX(ctx context.Context) *RoleParents {
+	v, err := rpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpc *RoleParentsCreate) Exec(ctx context.Context) error {
+	_, err := rpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpc *RoleParentsCreate) ExecX(ctx context.Context) {
+	if err := rpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpc *RoleParentsCreate) defaults() {
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		v := roleparents.DefaultCreatedAt()
+		rpc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpc *RoleParentsCreate) check() error {
+	if _, ok := rpc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "RoleParents.role_id"`)}
+	}
+	if _, ok := rpc.mutation.ParentRoleID(); !ok {
+		return &ValidationError{Name: "parent_role_id", err: errors.New(`ent: missing required field "RoleParents.parent_role_id"`)}
+	}
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoleParents.created_at"`)}
+	}
+	if _, ok := rpc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "RoleParents.role"`)}
+	}
+	if _, ok := rpc.mutation.ParentID(); !ok {
+		return &ValidationError{Name: "parent", err: errors.New(`ent: missing required edge "RoleParents.parent"`)}
+	}
+	return nil
+}
+
+func (rpc *RoleParentsCreate) sqlSave(ctx context.Context) (*RoleParents, error) {
+	if err := rpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rpc.mutation.id = &_node.ID
+	rpc.mutation.done = true
+	return _node, nil
+}
+
+func (rpc *RoleParentsCreate) createSpec() (*RoleParents, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleParents{config: rpc.config}
+		_spec = sqlgraph.NewCreateSpec(roleparents.Table, sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rpc.conflict
+	if id, ok := rpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rpc.mutation.CreatedAt(); ok {
+		_spec.SetField(roleparents.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rpc.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleparents.RoleTable,
+			Columns: []string{roleparents.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rpc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleparents.ParentTable,
+			Columns: []string{roleparents.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentRoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoleParents.Create().
+//		SetRoleID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoleParentsUpsert) {
+//			SetRoleID(v+v).
+//		}).
+//		Exec(ctx)
+func (rpc *RoleParentsCreate) OnConflict(opts ...sql.ConflictOption) *RoleParentsUpsertOne {
+	rpc.conflict = opts
+	return &RoleParentsUpsertOne{
+		create: rpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoleParents.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rpc *RoleParentsCreate) OnConflictColumns(columns ...string) *RoleParentsUpsertOne {
+	rpc.conflict = append(rpc.conflict, sql.ConflictColumns(columns...))
+	return &RoleParentsUpsertOne{
+		create: rpc,
+	}
+}
+
+type (
+	// RoleParentsUpsertOne is the builder for "upsert"-ing
+	//  one RoleParents node.
+	RoleParentsUpsertOne struct {
+		create *RoleParentsCreate
+	}
+
+	// RoleParentsUpsert is the "OnConflict" setter.
+	RoleParentsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRoleID sets the "role_id" field.
+func (u *RoleParentsUpsert) SetRoleID(v int) *RoleParentsUpsert {
+	u.Set(roleparents.FieldRoleID, v)
+	return u
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RoleParentsUpsert) UpdateRoleID() *RoleParentsUpsert {
+	u.SetExcluded(roleparents.FieldRoleID)
+	return u
+}
+
+// SetParentRoleID sets the "parent_role_id" field.
+func (u *RoleParentsUpsert) SetParentRoleID(v int) *RoleParentsUpsert {
+	u.Set(roleparents.FieldParentRoleID, v)
+	return u
+}
+
+// UpdateParentRoleID sets the "parent_role_id" field to the value that was provided on create.
+func (u *RoleParentsUpsert) UpdateParentRoleID() *RoleParentsUpsert {
+	u.SetExcluded(roleparents.FieldParentRoleID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RoleParents.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(roleparents.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoleParentsUpsertOne) UpdateNewValues() *RoleParentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(roleparents.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(roleparents.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoleParents.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RoleParentsUpsertOne) Ignore() *RoleParentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoleParentsUpsertOne) DoNothing() *RoleParentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoleParentsCreate.OnConflict
+// documentation for more info.
+func (u *RoleParentsUpsertOne) Update(set func(*RoleParentsUpsert)) *RoleParentsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoleParentsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *RoleParentsUpsertOne) SetRoleID(v int) *RoleParentsUpsertOne {
+	return u.Update(func(s *RoleParentsUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RoleParentsUpsertOne) UpdateRoleID() *RoleParentsUpsertOne {
+	return u.Update(func(s *RoleParentsUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetParentRoleID sets the "parent_role_id" field.
+func (u *RoleParentsUpsertOne) SetParentRoleID(v int) *RoleParentsUpsertOne {
+	return u.Update(func(s *RoleParentsUpsert) {
+		s.SetParentRoleID(v)
+	})
+}
+
+// UpdateParentRoleID sets the "parent_role_id" field to the value that was provided on create.
+func (u *RoleParentsUpsertOne) UpdateParentRoleID() *RoleParentsUpsertOne {
+	return u.Update(func(s *RoleParentsUpsert) {
+		s.UpdateParentRoleID()
+	})
+}
+
+// Exec executes the query.
+func (u *RoleParentsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoleParentsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoleParentsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RoleParentsUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RoleParentsUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RoleParentsCreateBulk is the builder for creating many RoleParents entities in bulk.
+type RoleParentsCreateBulk struct {
+	config
+	err      error
+	builders []*RoleParentsCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RoleParents entities in the database.
+func (rpcb *RoleParentsCreateBulk) Save(ctx context.Context) ([]*RoleParents, error) {
+	if rpcb.err != nil {
+		return nil, rpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rpcb.builders))
+	nodes := make([]*RoleParents, len(rpcb.builders))
+	mutators := make([]Mutator, len(rpcb.builders))
+	for i := range rpcb.builders {
+		func(i int, root context.Context) {
+			builder := rpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleParentsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpcb *RoleParentsCreateBulk) SaveX(ctx context.Context) []*RoleParents {
+	v, err := rpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpcb *RoleParentsCreateBulk) Exec(ctx context.Context) error {
+	_, err := rpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpcb *RoleParentsCreateBulk) ExecX(ctx context.Context) {
+	if err := rpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoleParents.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoleParentsUpsert) {
+//			SetRoleID(v+v).
+//		}).
+//		Exec(ctx)
+func (rpcb *RoleParentsCreateBulk) OnConflict(opts ...sql.ConflictOption) *RoleParentsUpsertBulk {
+	rpcb.conflict = opts
+	return &RoleParentsUpsertBulk{
+		create: rpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoleParents.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rpcb *RoleParentsCreateBulk) OnConflictColumns(columns ...string) *RoleParentsUpsertBulk {
+	rpcb.conflict = append(rpcb.conflict, sql.ConflictColumns(columns...))
+	return &RoleParentsUpsertBulk{
+		create: rpcb,
+	}
+}
+
+// RoleParentsUpsertBulk is the builder for "upsert"-ing
+// a bulk of RoleParents nodes.
+type RoleParentsUpsertBulk struct {
+	create *RoleParentsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RoleParents.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(roleparents.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoleParentsUpsertBulk) UpdateNewValues() *RoleParentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(roleparents.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(roleparents.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoleParents.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RoleParentsUpsertBulk) Ignore() *RoleParentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoleParentsUpsertBulk) DoNothing() *RoleParentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoleParentsCreateBulk.OnConflict
+// documentation for more info.
+func (u *RoleParentsUpsertBulk) Update(set func(*RoleParentsUpsert)) *RoleParentsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoleParentsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *RoleParentsUpsertBulk) SetRoleID(v int) *RoleParentsUpsertBulk {
+	return u.Update(func(s *RoleParentsUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RoleParentsUpsertBulk) UpdateRoleID() *RoleParentsUpsertBulk {
+	return u.Update(func(s *RoleParentsUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetParentRoleID sets the "parent_role_id" field.
+func (u *RoleParentsUpsertBulk) SetParentRoleID(v int) *RoleParentsUpsertBulk {
+	return u.Update(func(s *RoleParentsUpsert) {
+		s.SetParentRoleID(v)
+	})
+}
+
+// UpdateParentRoleID sets the "parent_role_id" field to the value that was provided on create.
+func (u *RoleParentsUpsertBulk) UpdateParentRoleID() *RoleParentsUpsertBulk {
+	return u.Update(func(s *RoleParentsUpsert) {
+		s.UpdateParentRoleID()
+	})
+}
+
+// Exec executes the query.
+func (u *RoleParentsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RoleParentsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoleParentsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoleParentsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roleparents"
+)
+
+// RoleParentsDelete is the builder for deleting a RoleParents entity.
+type RoleParentsDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoleParentsMutation
+}
+
+// Where appends a list predicates to the RoleParentsDelete builder.
+func (rpd *RoleParentsDelete) Where(ps ...predicate.RoleParents) *RoleParentsDelete {
+	rpd.mutation.Where(ps...)
+	return rpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rpd *RoleParentsDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rpd.sqlExec, rpd.mutation, rpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpd *RoleParentsDelete) ExecX(ctx context.Context) int {
+	n, err := rpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rpd *RoleParentsDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(roleparents.Table, sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt))
+	if ps := rpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rpd.mutation.done = true
+	return affected, err
+}
+
+// RoleParentsDeleteOne is the builder for deleting a single RoleParents entity.
+type RoleParentsDeleteOne struct {
+	rpd *RoleParentsDelete
+}
+
+// Where appends a list predicates to the RoleParentsDelete builder.
+func (rpdo *RoleParentsDeleteOne) Where(ps ...predicate.RoleParents) *RoleParentsDeleteOne {
+	rpdo.rpd.mutation.Where(ps...)
+	return rpdo
+}
+
+// Exec executes the deletion query.
+func (rpdo *RoleParentsDeleteOne) Exec(ctx context.Context) error {
+	n, err := rpdo.rpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{roleparents.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpdo *RoleParentsDeleteOne) ExecX(ctx context.Context) {
+	if err := rpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,716 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roleparents"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// RoleParentsQuery is the builder for querying RoleParents entities.
+type RoleParentsQuery struct {
+	config
+	ctx        *QueryContext
+	order      []roleparents.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RoleParents
+	withRole   *RolesQuery
+	withParent *RolesQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RoleParentsQuery builder.
+func (rpq *RoleParentsQuery) Where(ps ...predicate.RoleParents) *RoleParentsQuery {
+	rpq.predicates = append(rpq.predicates, ps...)
+	return rpq
+}
+
+// Limit the number of records to be returned by this query.
+func (rpq *RoleParentsQuery) Limit(limit int) *RoleParentsQuery {
+	rpq.ctx.Limit = &limit
+	return rpq
+}
+
+// Offset to start from.
+func (rpq *RoleParentsQuery) Offset(offset int) *RoleParentsQuery {
+	rpq.ctx.Offset = &offset
+	return rpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rpq *RoleParentsQuery) Unique(unique bool) *RoleParentsQuery {
+	rpq.ctx.Unique = &unique
+	return rpq
+}
+
+// Order specifies how the records should be ordered.
+func (rpq *RoleParentsQuery) Order(o ...roleparents.OrderOption) *RoleParentsQuery {
+	rpq.order = append(rpq.order, o...)
+	return rpq
+}
+
+// QueryRole chains the current query on the "role" edge.
+func (rpq *RoleParentsQuery) QueryRole() *RolesQuery {
+	query := (&RolesClient{config: rpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roleparents.Table, roleparents.FieldID, selector),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roleparents.RoleTable, roleparents.RoleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (rpq *RoleParentsQuery) QueryParent() *RolesQuery {
+	query := (&RolesClient{config: rpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roleparents.Table, roleparents.FieldID, selector),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, roleparents.ParentTable, roleparents.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RoleParents entity from the query.
+// Returns a *NotFoundError when no RoleParents was found.
+func (rpq *RoleParentsQuery) First(ctx context.Context) (*RoleParents, error) {
+	nodes, err := rpq.Limit(1).All(setContextOp(ctx, rpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{roleparents.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rpq *RoleParentsQuery) FirstX(ctx context.Context) *RoleParents {
+	node, err := rpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RoleParents ID from the query.
+// Returns a *NotFoundError when no RoleParents ID was found.
+func (rpq *RoleParentsQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rpq.Limit(1).IDs(setContextOp(ctx, rpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{roleparents.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rpq *RoleParentsQuery) FirstIDX(ctx context.Context) int {
+	id, err := rpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RoleParents entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RoleParents entity is found.
+// Returns a *NotFoundError when no RoleParents entities are found.
+func (rpq *RoleParentsQuery) Only(ctx context.Context) (*RoleParents, error) {
+	nodes, err := rpq.Limit(2).All(setContextOp(ctx, rpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{roleparents.Label}
+	default:
+		return nil, &NotSingularError{roleparents.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rpq *RoleParentsQuery) OnlyX(ctx context.Context) *RoleParents {
+	node, err := rpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RoleParents ID in the query.
+// Returns a *NotSingularError when more than one RoleParents ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rpq *RoleParentsQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rpq.Limit(2).IDs(setContextOp(ctx, rpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{roleparents.Label}
+	default:
+		err = &NotSingularError{roleparents.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rpq *RoleParentsQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RoleParentsSlice.
+func (rpq *RoleParentsQuery) All(ctx context.Context) ([]*RoleParents, error) {
+	ctx = setContextOp(ctx, rpq.ctx, "All")
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RoleParents, *RoleParentsQuery]()
+	return withInterceptors[[]*RoleParents](ctx, rpq, qr, rpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rpq *RoleParentsQuery) AllX(ctx context.Context) []*RoleParents {
+	nodes, err := rpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RoleParents IDs.
+func (rpq *RoleParentsQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rpq.ctx.Unique == nil && rpq.path != nil {
+		rpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rpq.ctx, "IDs")
+	if err = rpq.Select(roleparents.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rpq *RoleParentsQuery) IDsX(ctx context.Context) []int {
+	ids, err := rpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rpq *RoleParentsQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rpq.ctx, "Count")
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rpq, querierCount[*RoleParentsQuery](), rpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rpq *RoleParentsQuery) CountX(ctx context.Context) int {
+	count, err := rpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rpq *RoleParentsQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rpq.ctx, "Exist")
+	switch _, err := rpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rpq *RoleParentsQuery) ExistX(ctx context.Context) bool {
+	exist, err := rpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RoleParentsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rpq *RoleParentsQuery) Clone() *RoleParentsQuery {
+	if rpq == nil {
+		return nil
+	}
+	return &RoleParentsQuery{
+		config:     rpq.config,
+		ctx:        rpq.ctx.Clone(),
+		order:      append([]roleparents.OrderOption{}, rpq.order...),
+		inters:     append([]Interceptor{}, rpq.inters...),
+		predicates: append([]predicate.RoleParents{}, rpq.predicates...),
+		withRole:   rpq.withRole.Clone(),
+		withParent: rpq.withParent.Clone(),
+		// clone intermediate query.
+		sql:  rpq.sql.Clone(),
+		path: rpq.path,
+	}
+}
+
+// WithRole tells the query-builder to eager-load the nodes that are connected to
+// the "role" edge. The optional arguments are used to configure the query builder of the edge.
+func (rpq *RoleParentsQuery) WithRole(opts ...func(*RolesQuery)) *RoleParentsQuery {
+	query := (&RolesClient{config: rpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rpq.withRole = query
+	return rpq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (rpq *RoleParentsQuery) WithParent(opts ...func(*RolesQuery)) *RoleParentsQuery {
+	query := (&RolesClient{config: rpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rpq.withParent = query
+	return rpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RoleID int `json:"role_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RoleParents.Query().
+//		GroupBy(roleparents.FieldRoleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rpq *RoleParentsQuery) GroupBy(field string, fields ...string) *RoleParentsGroupBy {
+	rpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RoleParentsGroupBy{build: rpq}
+	grbuild.flds = &rpq.ctx.Fields
+	grbuild.label = roleparents.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RoleID int `json:"role_id,omitempty"`
+//	}
+//
+//	client.RoleParents.Query().
+//		Select(roleparents.FieldRoleID).
+//		Scan(ctx, &v)
+func (rpq *RoleParentsQuery) Select(fields ...string) *RoleParentsSelect {
+	rpq.ctx.Fields = append(rpq.ctx.Fields, fields...)
+	sbuild := &RoleParentsSelect{RoleParentsQuery: rpq}
+	sbuild.label = roleparents.Label
+	sbuild.flds, sbuild.scan = &rpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RoleParentsSelect configured with the given aggregations.
+func (rpq *RoleParentsQuery) Aggregate(fns ...AggregateFunc) *RoleParentsSelect {
+	return rpq.Select().Aggregate(fns...)
+}
+
+func (rpq *RoleParentsQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rpq.ctx.Fields {
+		if !roleparents.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rpq.path != nil {
+		prev, err := rpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rpq.sql = prev
+	}
+	return nil
+}
+
+func (rpq *RoleParentsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RoleParents, error) {
+	var (
+		nodes       = []*RoleParents{}
+		_spec       = rpq.querySpec()
+		loadedTypes = [2]bool{
+			rpq.withRole != nil,
+			rpq.withParent != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RoleParents).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RoleParents{config: rpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rpq.modifiers) > 0 {
+		_spec.Modifiers = rpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rpq.withRole; query != nil {
+		if err := rpq.loadRole(ctx, query, nodes, nil,
+			func(n *RoleParents, e *Roles) { n.Edges.Role = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rpq.withParent; query != nil {
+		if err := rpq.loadParent(ctx, query, nodes, nil,
+			func(n *RoleParents, e *Roles) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rpq *RoleParentsQuery) loadRole(ctx context.Context, query *RolesQuery, nodes []*RoleParents, init func(*RoleParents), assign func(*RoleParents, *Roles)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RoleParents)
+	for i := range nodes {
+		fk := nodes[i].RoleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(roles.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "role_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rpq *RoleParentsQuery) loadParent(ctx context.Context, query *RolesQuery, nodes []*RoleParents, init func(*RoleParents), assign func(*RoleParents, *Roles)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RoleParents)
+	for i := range nodes {
+		fk := nodes[i].ParentRoleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(roles.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_role_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rpq *RoleParentsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rpq.querySpec()
+	if len(rpq.modifiers) > 0 {
+		_spec.Modifiers = rpq.modifiers
+	}
+	_spec.Node.Columns = rpq.ctx.Fields
+	if len(rpq.ctx.Fields) > 0 {
+		_spec.Unique = rpq.ctx.Unique != nil && *rpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rpq.driver, _spec)
+}
+
+func (rpq *RoleParentsQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(roleparents.Table, roleparents.Columns, sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt))
+	_spec.From = rpq.sql
+	if unique := rpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roleparents.FieldID)
+		for i := range fields {
+			if fields[i] != roleparents.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rpq.withRole != nil {
+			_spec.Node.AddColumnOnce(roleparents.FieldRoleID)
+		}
+		if rpq.withParent != nil {
+			_spec.Node.AddColumnOnce(roleparents.FieldParentRoleID)
+		}
+	}
+	if ps := rpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rpq *RoleParentsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rpq.driver.Dialect())
+	t1 := builder.Table(roleparents.Table)
+	columns := rpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = roleparents.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rpq.sql != nil {
+		selector = rpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rpq.ctx.Unique != nil && *rpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rpq.modifiers {
+		m(selector)
+	}
+	for _, p := range rpq.predicates {
+		p(selector)
+	}
+	for _, p := range rpq.order {
+		p(selector)
+	}
+	if offset := rpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rpq *RoleParentsQuery) ForUpdate(opts ...sql.LockOption) *RoleParentsQuery {
+	if rpq.driver.Dialect() == dialect.Postgres {
+		rpq.Unique(false)
+	}
+	rpq.modifiers = append(rpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rpq *RoleParentsQuery) ForShare(opts ...sql.LockOption) *RoleParentsQuery {
+	if rpq.driver.Dialect() == dialect.Postgres {
+		rpq.Unique(false)
+	}
+	rpq.modifiers = append(rpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rpq
+}
+
+// RoleParentsGroupBy is the group-by builder for RoleParents entities.
+type RoleParentsGroupBy struct {
+	selector
+	build *RoleParentsQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rpgb *RoleParentsGroupBy) Aggregate(fns ...AggregateFunc) *RoleParentsGroupBy {
+	rpgb.fns = append(rpgb.fns, fns...)
+	return rpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rpgb *RoleParentsGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rpgb.build.ctx, "GroupBy")
+	if err := rpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoleParentsQuery, *RoleParentsGroupBy](ctx, rpgb.build, rpgb, rpgb.build.inters, v)
+}
+
+func (rpgb *RoleParentsGroupBy) sqlScan(ctx context.Context, root *RoleParentsQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rpgb.fns))
+	for _, fn := range rpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rpgb.flds)+len(rpgb.fns))
+		for _, f := range *rpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RoleParentsSelect is the builder for selecting fields of RoleParents entities.
+type RoleParentsSelect struct {
+	*RoleParentsQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rps *RoleParentsSelect) Aggregate(fns ...AggregateFunc) *RoleParentsSelect {
+	rps.fns = append(rps.fns, fns...)
+	return rps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rps *RoleParentsSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rps.ctx, "Select")
+	if err := rps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoleParentsQuery, *RoleParentsSelect](ctx, rps.RoleParentsQuery, rps, rps.inters, v)
+}
+
+func (rps *RoleParentsSelect) sqlScan(ctx context.Context, root *RoleParentsQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rps.fns))
+	for _, fn := range rps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roleparents"
+	"github.com/shammianand/go-auth/ent/roles"
+)
+
+// RoleParentsUpdate is the builder for updating RoleParents entities.
+type RoleParentsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoleParentsMutation
+}
+
+// Where appends a list predicates to the RoleParentsUpdate builder.
+func (rpu *RoleParentsUpdate) Where(ps ...predicate.RoleParents) *RoleParentsUpdate {
+	rpu.mutation.Where(ps...)
+	return rpu
+}
+
+// SetRoleID sets the "role_id" field.
+func (rpu *RoleParentsUpdate) SetRoleID(i int) *RoleParentsUpdate {
+	rpu.mutation.SetRoleID(i)
+	return rpu
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (rpu *RoleParentsUpdate) SetNillableRoleID(i *int) *RoleParentsUpdate {
+	if i != nil {
+		rpu.SetRoleID(*i)
+	}
+	return rpu
+}
+
+// SetParentRoleID sets the "parent_role_id" field.
+func (rpu *RoleParentsUpdate) SetParentRoleID(i int) *RoleParentsUpdate {
+	rpu.mutation.SetParentRoleID(i)
+	return rpu
+}
+
+// SetNillableParentRoleID sets the "parent_role_id" field if the given value is not nil.
+func (rpu *RoleParentsUpdate) SetNillableParentRoleID(i *int) *RoleParentsUpdate {
+	if i != nil {
+		rpu.SetParentRoleID(*i)
+	}
+	return rpu
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (rpu *RoleParentsUpdate) SetRole(r *Roles) *RoleParentsUpdate {
+	return rpu.SetRoleID(r.ID)
+}
+
+// SetParentID sets the "parent" edge to the Roles entity by ID.
+func (rpu *RoleParentsUpdate) SetParentID(id int) *RoleParentsUpdate {
+	rpu.mutation.SetParentID(id)
+	return rpu
+}
+
+// SetParent sets the "parent" edge to the Roles entity.
+func (rpu *RoleParentsUpdate) SetParent(r *Roles) *RoleParentsUpdate {
+	return rpu.SetParentID(r.ID)
+}
+
+// Mutation returns the RoleParentsMutation object of the builder.
+func (rpu *RoleParentsUpdate) Mutation() *RoleParentsMutation {
+	return rpu.mutation
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (rpu *RoleParentsUpdate) ClearRole() *RoleParentsUpdate {
+	rpu.mutation.ClearRole()
+	return rpu
+}
+
+// ClearParent clears the "parent" edge to the Roles entity.
+func (rpu *RoleParentsUpdate) ClearParent() *RoleParentsUpdate {
+	rpu.mutation.ClearParent()
+	return rpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rpu *RoleParentsUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rpu.sqlSave, rpu.mutation, rpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpu *RoleParentsUpdate) SaveX(ctx context.Context) int {
+	affected, err := rpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rpu *RoleParentsUpdate) Exec(ctx context.Context) error {
+	_, err := rpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpu *RoleParentsUpdate) ExecX(ctx context.Context) {
+	if err := rpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpu *RoleParentsUpdate) check() error {
+	if _, ok := rpu.mutation.RoleID(); rpu.mutation.RoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RoleParents.role"`)
+	}
+	if _, ok := rpu.mutation.ParentID(); rpu.mutation.ParentCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RoleParents.parent"`)
+	}
+	return nil
+}
+
+func (rpu *RoleParentsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roleparents.Table, roleparents.Columns, sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt))
+	if ps := rpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rpu.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleparents.RoleTable,
+			Columns: []string{roleparents.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpu.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleparents.RoleTable,
+			Columns: []string{roleparents.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rpu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleparents.ParentTable,
+			Columns: []string{roleparents.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleparents.ParentTable,
+			Columns: []string{roleparents.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roleparents.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rpu.mutation.done = true
+	return n, nil
+}
+
+// RoleParentsUpdateOne is the builder for updating a single RoleParents entity.
+type RoleParentsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoleParentsMutation
+}
+
+// SetRoleID sets the "role_id" field.
+func (rpuo *RoleParentsUpdateOne) SetRoleID(i int) *RoleParentsUpdateOne {
+	rpuo.mutation.SetRoleID(i)
+	return rpuo
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (rpuo *RoleParentsUpdateOne) SetNillableRoleID(i *int) *RoleParentsUpdateOne {
+	if i != nil {
+		rpuo.SetRoleID(*i)
+	}
+	return rpuo
+}
+
+// SetParentRoleID sets the "parent_role_id" field.
+func (rpuo *RoleParentsUpdateOne) SetParentRoleID(i int) *RoleParentsUpdateOne {
+	rpuo.mutation.SetParentRoleID(i)
+	return rpuo
+}
+
+// SetNillableParentRoleID sets the "parent_role_id" field if the given value is not nil.
+func (rpuo *RoleParentsUpdateOne) SetNillableParentRoleID(i *int) *RoleParentsUpdateOne {
+	if i != nil {
+		rpuo.SetParentRoleID(*i)
+	}
+	return rpuo
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (rpuo *RoleParentsUpdateOne) SetRole(r *Roles) *RoleParentsUpdateOne {
+	return rpuo.SetRoleID(r.ID)
+}
+
+// SetParentID sets the "parent" edge to the Roles entity by ID.
+func (rpuo *RoleParentsUpdateOne) SetParentID(id int) *RoleParentsUpdateOne {
+	rpuo.mutation.SetParentID(id)
+	return rpuo
+}
+
+// SetParent sets the "parent" edge to the Roles entity.
+func (rpuo *RoleParentsUpdateOne) SetParent(r *Roles) *RoleParentsUpdateOne {
+	return rpuo.SetParentID(r.ID)
+}
+
+// Mutation returns the RoleParentsMutation object of the builder.
+func (rpuo *RoleParentsUpdateOne) Mutation() *RoleParentsMutation {
+	return rpuo.mutation
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (rpuo *RoleParentsUpdateOne) ClearRole() *RoleParentsUpdateOne {
+	rpuo.mutation.ClearRole()
+	return rpuo
+}
+
+// ClearParent clears the "parent" edge to the Roles entity.
+func (rpuo *RoleParentsUpdateOne) ClearParent() *RoleParentsUpdateOne {
+	rpuo.mutation.ClearParent()
+	return rpuo
+}
+
+// Where appends a list predicates to the RoleParentsUpdate builder.
+func (rpuo *RoleParentsUpdateOne) Where(ps ...predicate.RoleParents) *RoleParentsUpdateOne {
+	rpuo.mutation.Where(ps...)
+	return rpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rpuo *RoleParentsUpdateOne) Select(field string, fields ...string) *RoleParentsUpdateOne {
+	rpuo.fields = append([]string{field}, fields...)
+	return rpuo
+}
+
+// Save executes the query and returns the updated RoleParents entity.
+func (rpuo *RoleParentsUpdateOne) Save(ctx context.Context) (*RoleParents, error) {
+	return withHooks(ctx, rpuo.sqlSave, rpuo.mutation, rpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpuo *RoleParentsUpdateOne) SaveX(ctx context.Context) *RoleParents {
+	node, err := rpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rpuo *RoleParentsUpdateOne) Exec(ctx context.Context) error {
+	_, err := rpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpuo *RoleParentsUpdateOne) ExecX(ctx context.Context) {
+	if err := rpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpuo *RoleParentsUpdateOne) check() error {
+	if _, ok := rpuo.mutation.RoleID(); rpuo.mutation.RoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RoleParents.role"`)
+	}
+	if _, ok := rpuo.mutation.ParentID(); rpuo.mutation.ParentCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RoleParents.parent"`)
+	}
+	return nil
+}
+
+func (rpuo *RoleParentsUpdateOne) sqlSave(ctx context.Context) (_node *RoleParents, err error) {
+	if err := rpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(roleparents.Table, roleparents.Columns, sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt))
+	id, ok := rpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleParents.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, roleparents.FieldID)
+		for _, f := range fields {
+			if !roleparents.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != roleparents.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rpuo.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleparents.RoleTable,
+			Columns: []string{roleparents.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpuo.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleparents.RoleTable,
+			Columns: []string{roleparents.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rpuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleparents.ParentTable,
+			Columns: []string{roleparents.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   roleparents.ParentTable,
+			Columns: []string{roleparents.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RoleParents{config: rpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roleparents.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rpuo.mutation.done = true
+	return _node, nil
+}
@@ -23,6 +23,8 @@ type RolePermissions struct {
 	RoleID int `json:"role_id,omitempty"`
 	// PermissionID holds the value of the "permission_id" field.
 	PermissionID int `json:"permission_id,omitempty"`
+	// Expression that must hold for the grant to apply; empty grants unconditionally
+	Condition string `json:"condition,omitempty"`
 	// AssignedAt holds the value of the "assigned_at" field.
 	AssignedAt time.Time `json:"assigned_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -71,6 +73,8 @@ func (*RolePermissions) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case rolepermissions.FieldID, rolepermissions.FieldRoleID, rolepermissions.FieldPermissionID:
 			values[i] = new(sql.NullInt64)
+		case rolepermissions.FieldCondition:
+			values[i] = new(sql.NullString)
 		case rolepermissions.FieldAssignedAt:
 			values[i] = new(sql.NullTime)
 		default:
@@ -106,6 +110,12 @@ func (rp *RolePermissions) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				rp.PermissionID = int(value.Int64)
 			}
+		case rolepermissions.FieldCondition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field condition", values[i])
+			} else if value.Valid {
+				rp.Condition = value.String
+			}
 		case rolepermissions.FieldAssignedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field assigned_at", values[i])
@@ -164,6 +174,9 @@ func (rp *RolePermissions) String() string {
 	builder.WriteString("permission_id=")
 	builder.WriteString(fmt.Sprintf("%v", rp.PermissionID))
 	builder.WriteString(", ")
+	builder.WriteString("condition=")
+	builder.WriteString(rp.Condition)
+	builder.WriteString(", ")
 	builder.WriteString("assigned_at=")
 	builder.WriteString(rp.AssignedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
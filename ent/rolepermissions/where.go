@@ -65,6 +65,11 @@ func PermissionID(v int) predicate.RolePermissions {
 	return predicate.RolePermissions(sql.FieldEQ(FieldPermissionID, v))
 }
 
+// Condition applies equality check predicate on the "condition" field. It's identical to ConditionEQ.
+func Condition(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldEQ(FieldCondition, v))
+}
+
 // AssignedAt applies equality check predicate on the "assigned_at" field. It's identical to AssignedAtEQ.
 func AssignedAt(v time.Time) predicate.RolePermissions {
 	return predicate.RolePermissions(sql.FieldEQ(FieldAssignedAt, v))
@@ -110,6 +115,81 @@ func PermissionIDNotIn(vs ...int) predicate.RolePermissions {
 	return predicate.RolePermissions(sql.FieldNotIn(FieldPermissionID, vs...))
 }
 
+// ConditionEQ applies the EQ predicate on the "condition" field.
+func ConditionEQ(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldEQ(FieldCondition, v))
+}
+
+// ConditionNEQ applies the NEQ predicate on the "condition" field.
+func ConditionNEQ(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldNEQ(FieldCondition, v))
+}
+
+// ConditionIn applies the In predicate on the "condition" field.
+func ConditionIn(vs ...string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldIn(FieldCondition, vs...))
+}
+
+// ConditionNotIn applies the NotIn predicate on the "condition" field.
+func ConditionNotIn(vs ...string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldNotIn(FieldCondition, vs...))
+}
+
+// ConditionGT applies the GT predicate on the "condition" field.
+func ConditionGT(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldGT(FieldCondition, v))
+}
+
+// ConditionGTE applies the GTE predicate on the "condition" field.
+func ConditionGTE(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldGTE(FieldCondition, v))
+}
+
+// ConditionLT applies the LT predicate on the "condition" field.
+func ConditionLT(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldLT(FieldCondition, v))
+}
+
+// ConditionLTE applies the LTE predicate on the "condition" field.
+func ConditionLTE(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldLTE(FieldCondition, v))
+}
+
+// ConditionContains applies the Contains predicate on the "condition" field.
+func ConditionContains(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldContains(FieldCondition, v))
+}
+
+// ConditionHasPrefix applies the HasPrefix predicate on the "condition" field.
+func ConditionHasPrefix(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldHasPrefix(FieldCondition, v))
+}
+
+// ConditionHasSuffix applies the HasSuffix predicate on the "condition" field.
+func ConditionHasSuffix(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldHasSuffix(FieldCondition, v))
+}
+
+// ConditionIsNil applies the IsNil predicate on the "condition" field.
+func ConditionIsNil() predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldIsNull(FieldCondition))
+}
+
+// ConditionNotNil applies the NotNil predicate on the "condition" field.
+func ConditionNotNil() predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldNotNull(FieldCondition))
+}
+
+// ConditionEqualFold applies the EqualFold predicate on the "condition" field.
+func ConditionEqualFold(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldEqualFold(FieldCondition, v))
+}
+
+// ConditionContainsFold applies the ContainsFold predicate on the "condition" field.
+func ConditionContainsFold(v string) predicate.RolePermissions {
+	return predicate.RolePermissions(sql.FieldContainsFold(FieldCondition, v))
+}
+
 // AssignedAtEQ applies the EQ predicate on the "assigned_at" field.
 func AssignedAtEQ(v time.Time) predicate.RolePermissions {
 	return predicate.RolePermissions(sql.FieldEQ(FieldAssignedAt, v))
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/shammianand/go-auth/ent/permissions"
@@ -20,6 +21,7 @@ type RolePermissionsCreate struct {
 	config
 	mutation *RolePermissionsMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetRoleID sets the "role_id" field.
@@ -34,6 +36,20 @@ func (rpc *RolePermissionsCreate) SetPermissionID(i int) *RolePermissionsCreate
 	return rpc
 }
 
+// SetCondition sets the "condition" field.
+func (rpc *RolePermissionsCreate) SetCondition(s string) *RolePermissionsCreate {
+	rpc.mutation.SetCondition(s)
+	return rpc
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (rpc *RolePermissionsCreate) SetNillableCondition(s *string) *RolePermissionsCreate {
+	if s != nil {
+		rpc.SetCondition(*s)
+	}
+	return rpc
+}
+
 // SetAssignedAt sets the "assigned_at" field.
 func (rpc *RolePermissionsCreate) SetAssignedAt(t time.Time) *RolePermissionsCreate {
 	rpc.mutation.SetAssignedAt(t)
@@ -150,10 +166,15 @@ func (rpc *RolePermissionsCreate) createSpec() (*RolePermissions, *sqlgraph.Crea
 		_node = &RolePermissions{config: rpc.config}
 		_spec = sqlgraph.NewCreateSpec(rolepermissions.Table, sqlgraph.NewFieldSpec(rolepermissions.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = rpc.conflict
 	if id, ok := rpc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := rpc.mutation.Condition(); ok {
+		_spec.SetField(rolepermissions.FieldCondition, field.TypeString, value)
+		_node.Condition = value
+	}
 	if value, ok := rpc.mutation.AssignedAt(); ok {
 		_spec.SetField(rolepermissions.FieldAssignedAt, field.TypeTime, value)
 		_node.AssignedAt = value
@@ -195,11 +216,236 @@ func (rpc *RolePermissionsCreate) createSpec() (*RolePermissions, *sqlgraph.Crea
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RolePermissions.Create().
+//		SetRoleID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RolePermissionsUpsert) {
+//			SetRoleID(v+v).
+//		}).
+//		Exec(ctx)
+func (rpc *RolePermissionsCreate) OnConflict(opts ...sql.ConflictOption) *RolePermissionsUpsertOne {
+	rpc.conflict = opts
+	return &RolePermissionsUpsertOne{
+		create: rpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RolePermissions.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rpc *RolePermissionsCreate) OnConflictColumns(columns ...string) *RolePermissionsUpsertOne {
+	rpc.conflict = append(rpc.conflict, sql.ConflictColumns(columns...))
+	return &RolePermissionsUpsertOne{
+		create: rpc,
+	}
+}
+
+type (
+	// RolePermissionsUpsertOne is the builder for "upsert"-ing
+	//  one RolePermissions node.
+	RolePermissionsUpsertOne struct {
+		create *RolePermissionsCreate
+	}
+
+	// RolePermissionsUpsert is the "OnConflict" setter.
+	RolePermissionsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRoleID sets the "role_id" field.
+func (u *RolePermissionsUpsert) SetRoleID(v int) *RolePermissionsUpsert {
+	u.Set(rolepermissions.FieldRoleID, v)
+	return u
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RolePermissionsUpsert) UpdateRoleID() *RolePermissionsUpsert {
+	u.SetExcluded(rolepermissions.FieldRoleID)
+	return u
+}
+
+// SetPermissionID sets the "permission_id" field.
+func (u *RolePermissionsUpsert) SetPermissionID(v int) *RolePermissionsUpsert {
+	u.Set(rolepermissions.FieldPermissionID, v)
+	return u
+}
+
+// UpdatePermissionID sets the "permission_id" field to the value that was provided on create.
+func (u *RolePermissionsUpsert) UpdatePermissionID() *RolePermissionsUpsert {
+	u.SetExcluded(rolepermissions.FieldPermissionID)
+	return u
+}
+
+// SetCondition sets the "condition" field.
+func (u *RolePermissionsUpsert) SetCondition(v string) *RolePermissionsUpsert {
+	u.Set(rolepermissions.FieldCondition, v)
+	return u
+}
+
+// UpdateCondition sets the "condition" field to the value that was provided on create.
+func (u *RolePermissionsUpsert) UpdateCondition() *RolePermissionsUpsert {
+	u.SetExcluded(rolepermissions.FieldCondition)
+	return u
+}
+
+// ClearCondition clears the value of the "condition" field.
+func (u *RolePermissionsUpsert) ClearCondition() *RolePermissionsUpsert {
+	u.SetNull(rolepermissions.FieldCondition)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RolePermissions.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rolepermissions.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RolePermissionsUpsertOne) UpdateNewValues() *RolePermissionsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(rolepermissions.FieldID)
+		}
+		if _, exists := u.create.mutation.AssignedAt(); exists {
+			s.SetIgnore(rolepermissions.FieldAssignedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RolePermissions.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RolePermissionsUpsertOne) Ignore() *RolePermissionsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RolePermissionsUpsertOne) DoNothing() *RolePermissionsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RolePermissionsCreate.OnConflict
+// documentation for more info.
+func (u *RolePermissionsUpsertOne) Update(set func(*RolePermissionsUpsert)) *RolePermissionsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RolePermissionsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *RolePermissionsUpsertOne) SetRoleID(v int) *RolePermissionsUpsertOne {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RolePermissionsUpsertOne) UpdateRoleID() *RolePermissionsUpsertOne {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetPermissionID sets the "permission_id" field.
+func (u *RolePermissionsUpsertOne) SetPermissionID(v int) *RolePermissionsUpsertOne {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.SetPermissionID(v)
+	})
+}
+
+// UpdatePermissionID sets the "permission_id" field to the value that was provided on create.
+func (u *RolePermissionsUpsertOne) UpdatePermissionID() *RolePermissionsUpsertOne {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.UpdatePermissionID()
+	})
+}
+
+// SetCondition sets the "condition" field.
+func (u *RolePermissionsUpsertOne) SetCondition(v string) *RolePermissionsUpsertOne {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.SetCondition(v)
+	})
+}
+
+// UpdateCondition sets the "condition" field to the value that was provided on create.
+func (u *RolePermissionsUpsertOne) UpdateCondition() *RolePermissionsUpsertOne {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.UpdateCondition()
+	})
+}
+
+// ClearCondition clears the value of the "condition" field.
+func (u *RolePermissionsUpsertOne) ClearCondition() *RolePermissionsUpsertOne {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.ClearCondition()
+	})
+}
+
+// Exec executes the query.
+func (u *RolePermissionsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RolePermissionsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RolePermissionsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RolePermissionsUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RolePermissionsUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // RolePermissionsCreateBulk is the builder for creating many RolePermissions entities in bulk.
 type RolePermissionsCreateBulk struct {
 	config
 	err      error
 	builders []*RolePermissionsCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the RolePermissions entities in the database.
@@ -229,6 +475,7 @@ func (rpcb *RolePermissionsCreateBulk) Save(ctx context.Context) ([]*RolePermiss
 					_, err = mutators[i+1].Mutate(root, rpcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rpcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, rpcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -282,3 +529,169 @@ func (rpcb *RolePermissionsCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RolePermissions.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RolePermissionsUpsert) {
+//			SetRoleID(v+v).
+//		}).
+//		Exec(ctx)
+func (rpcb *RolePermissionsCreateBulk) OnConflict(opts ...sql.ConflictOption) *RolePermissionsUpsertBulk {
+	rpcb.conflict = opts
+	return &RolePermissionsUpsertBulk{
+		create: rpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RolePermissions.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rpcb *RolePermissionsCreateBulk) OnConflictColumns(columns ...string) *RolePermissionsUpsertBulk {
+	rpcb.conflict = append(rpcb.conflict, sql.ConflictColumns(columns...))
+	return &RolePermissionsUpsertBulk{
+		create: rpcb,
+	}
+}
+
+// RolePermissionsUpsertBulk is the builder for "upsert"-ing
+// a bulk of RolePermissions nodes.
+type RolePermissionsUpsertBulk struct {
+	create *RolePermissionsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RolePermissions.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rolepermissions.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RolePermissionsUpsertBulk) UpdateNewValues() *RolePermissionsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(rolepermissions.FieldID)
+			}
+			if _, exists := b.mutation.AssignedAt(); exists {
+				s.SetIgnore(rolepermissions.FieldAssignedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RolePermissions.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RolePermissionsUpsertBulk) Ignore() *RolePermissionsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RolePermissionsUpsertBulk) DoNothing() *RolePermissionsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RolePermissionsCreateBulk.OnConflict
+// documentation for more info.
+func (u *RolePermissionsUpsertBulk) Update(set func(*RolePermissionsUpsert)) *RolePermissionsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RolePermissionsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *RolePermissionsUpsertBulk) SetRoleID(v int) *RolePermissionsUpsertBulk {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RolePermissionsUpsertBulk) UpdateRoleID() *RolePermissionsUpsertBulk {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetPermissionID sets the "permission_id" field.
+func (u *RolePermissionsUpsertBulk) SetPermissionID(v int) *RolePermissionsUpsertBulk {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.SetPermissionID(v)
+	})
+}
+
+// UpdatePermissionID sets the "permission_id" field to the value that was provided on create.
+func (u *RolePermissionsUpsertBulk) UpdatePermissionID() *RolePermissionsUpsertBulk {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.UpdatePermissionID()
+	})
+}
+
+// SetCondition sets the "condition" field.
+func (u *RolePermissionsUpsertBulk) SetCondition(v string) *RolePermissionsUpsertBulk {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.SetCondition(v)
+	})
+}
+
+// UpdateCondition sets the "condition" field to the value that was provided on create.
+func (u *RolePermissionsUpsertBulk) UpdateCondition() *RolePermissionsUpsertBulk {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.UpdateCondition()
+	})
+}
+
+// ClearCondition clears the value of the "condition" field.
+func (u *RolePermissionsUpsertBulk) ClearCondition() *RolePermissionsUpsertBulk {
+	return u.Update(func(s *RolePermissionsUpsert) {
+		s.ClearCondition()
+	})
+}
+
+// Exec executes the query.
+func (u *RolePermissionsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RolePermissionsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RolePermissionsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RolePermissionsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,7 @@ type RolePermissionsQuery struct {
 	predicates     []predicate.RolePermissions
 	withRole       *RolesQuery
 	withPermission *PermissionsQuery
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -419,6 +421,9 @@ func (rpq *RolePermissionsQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(rpq.modifiers) > 0 {
+		_spec.Modifiers = rpq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -504,6 +509,9 @@ func (rpq *RolePermissionsQuery) loadPermission(ctx context.Context, query *Perm
 
 func (rpq *RolePermissionsQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rpq.querySpec()
+	if len(rpq.modifiers) > 0 {
+		_spec.Modifiers = rpq.modifiers
+	}
 	_spec.Node.Columns = rpq.ctx.Fields
 	if len(rpq.ctx.Fields) > 0 {
 		_spec.Unique = rpq.ctx.Unique != nil && *rpq.ctx.Unique
@@ -572,6 +580,9 @@ func (rpq *RolePermissionsQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if rpq.ctx.Unique != nil && *rpq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range rpq.modifiers {
+		m(selector)
+	}
 	for _, p := range rpq.predicates {
 		p(selector)
 	}
@@ -589,6 +600,32 @@ func (rpq *RolePermissionsQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rpq *RolePermissionsQuery) ForUpdate(opts ...sql.LockOption) *RolePermissionsQuery {
+	if rpq.driver.Dialect() == dialect.Postgres {
+		rpq.Unique(false)
+	}
+	rpq.modifiers = append(rpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rpq *RolePermissionsQuery) ForShare(opts ...sql.LockOption) *RolePermissionsQuery {
+	if rpq.driver.Dialect() == dialect.Postgres {
+		rpq.Unique(false)
+	}
+	rpq.modifiers = append(rpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rpq
+}
+
 // RolePermissionsGroupBy is the group-by builder for RolePermissions entities.
 type RolePermissionsGroupBy struct {
 	selector
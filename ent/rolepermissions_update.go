@@ -57,6 +57,26 @@ func (rpu *RolePermissionsUpdate) SetNillablePermissionID(i *int) *RolePermissio
 	return rpu
 }
 
+// SetCondition sets the "condition" field.
+func (rpu *RolePermissionsUpdate) SetCondition(s string) *RolePermissionsUpdate {
+	rpu.mutation.SetCondition(s)
+	return rpu
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (rpu *RolePermissionsUpdate) SetNillableCondition(s *string) *RolePermissionsUpdate {
+	if s != nil {
+		rpu.SetCondition(*s)
+	}
+	return rpu
+}
+
+// ClearCondition clears the value of the "condition" field.
+func (rpu *RolePermissionsUpdate) ClearCondition() *RolePermissionsUpdate {
+	rpu.mutation.ClearCondition()
+	return rpu
+}
+
 // SetRole sets the "role" edge to the Roles entity.
 func (rpu *RolePermissionsUpdate) SetRole(r *Roles) *RolePermissionsUpdate {
 	return rpu.SetRoleID(r.ID)
@@ -134,6 +154,12 @@ func (rpu *RolePermissionsUpdate) sqlSave(ctx context.Context) (n int, err error
 			}
 		}
 	}
+	if value, ok := rpu.mutation.Condition(); ok {
+		_spec.SetField(rolepermissions.FieldCondition, field.TypeString, value)
+	}
+	if rpu.mutation.ConditionCleared() {
+		_spec.ClearField(rolepermissions.FieldCondition, field.TypeString)
+	}
 	if rpu.mutation.RoleCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -240,6 +266,26 @@ func (rpuo *RolePermissionsUpdateOne) SetNillablePermissionID(i *int) *RolePermi
 	return rpuo
 }
 
+// SetCondition sets the "condition" field.
+func (rpuo *RolePermissionsUpdateOne) SetCondition(s string) *RolePermissionsUpdateOne {
+	rpuo.mutation.SetCondition(s)
+	return rpuo
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (rpuo *RolePermissionsUpdateOne) SetNillableCondition(s *string) *RolePermissionsUpdateOne {
+	if s != nil {
+		rpuo.SetCondition(*s)
+	}
+	return rpuo
+}
+
+// ClearCondition clears the value of the "condition" field.
+func (rpuo *RolePermissionsUpdateOne) ClearCondition() *RolePermissionsUpdateOne {
+	rpuo.mutation.ClearCondition()
+	return rpuo
+}
+
 // SetRole sets the "role" edge to the Roles entity.
 func (rpuo *RolePermissionsUpdateOne) SetRole(r *Roles) *RolePermissionsUpdateOne {
 	return rpuo.SetRoleID(r.ID)
@@ -347,6 +393,12 @@ func (rpuo *RolePermissionsUpdateOne) sqlSave(ctx context.Context) (_node *RoleP
 			}
 		}
 	}
+	if value, ok := rpuo.mutation.Condition(); ok {
+		_spec.SetField(rolepermissions.FieldCondition, field.TypeString, value)
+	}
+	if rpuo.mutation.ConditionCleared() {
+		_spec.ClearField(rolepermissions.FieldCondition, field.TypeString)
+	}
 	if rpuo.mutation.RoleCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
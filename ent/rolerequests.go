@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/rolerequests"
+)
+
+// RoleRequests is the model entity for the RoleRequests schema.
+type RoleRequests struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// User who requested the role
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// RoleID holds the value of the "role_id" field.
+	RoleID int `json:"role_id,omitempty"`
+	// Justification given by the requester
+	Reason string `json:"reason,omitempty"`
+	// How long the role is granted for once approved (null = permanent)
+	DurationHours *int `json:"duration_hours,omitempty"`
+	// Emergency request for a privileged role
+	BreakGlass bool `json:"break_glass,omitempty"`
+	// Status holds the value of the "status" field.
+	Status rolerequests.Status `json:"status,omitempty"`
+	// User who approved or denied the request (null = automatic)
+	DecidedBy *uuid.UUID `json:"decided_by,omitempty"`
+	// DecidedAt holds the value of the "decided_at" field.
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+	// DecisionNote holds the value of the "decision_note" field.
+	DecisionNote string `json:"decision_note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleRequests) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rolerequests.FieldDecidedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case rolerequests.FieldBreakGlass:
+			values[i] = new(sql.NullBool)
+		case rolerequests.FieldRoleID, rolerequests.FieldDurationHours:
+			values[i] = new(sql.NullInt64)
+		case rolerequests.FieldReason, rolerequests.FieldStatus, rolerequests.FieldDecisionNote:
+			values[i] = new(sql.NullString)
+		case rolerequests.FieldDecidedAt, rolerequests.FieldCreatedAt, rolerequests.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case rolerequests.FieldID, rolerequests.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleRequests fields.
+func (rr *RoleRequests) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rolerequests.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rr.ID = *value
+			}
+		case rolerequests.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				rr.UserID = *value
+			}
+		case rolerequests.FieldRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				rr.RoleID = int(value.Int64)
+			}
+		case rolerequests.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				rr.Reason = value.String
+			}
+		case rolerequests.FieldDurationHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_hours", values[i])
+			} else if value.Valid {
+				rr.DurationHours = new(int)
+				*rr.DurationHours = int(value.Int64)
+			}
+		case rolerequests.FieldBreakGlass:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field break_glass", values[i])
+			} else if value.Valid {
+				rr.BreakGlass = value.Bool
+			}
+		case rolerequests.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				rr.Status = rolerequests.Status(value.String)
+			}
+		case rolerequests.FieldDecidedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_by", values[i])
+			} else if value.Valid {
+				rr.DecidedBy = new(uuid.UUID)
+				*rr.DecidedBy = *value.S.(*uuid.UUID)
+			}
+		case rolerequests.FieldDecidedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_at", values[i])
+			} else if value.Valid {
+				rr.DecidedAt = new(time.Time)
+				*rr.DecidedAt = value.Time
+			}
+		case rolerequests.FieldDecisionNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decision_note", values[i])
+			} else if value.Valid {
+				rr.DecisionNote = value.String
+			}
+		case rolerequests.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rr.CreatedAt = value.Time
+			}
+		case rolerequests.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rr.UpdatedAt = value.Time
+			}
+		default:
+			rr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleRequests.
+// This includes values selected through modifiers, order, etc.
+func (rr *RoleRequests) Value(name string) (ent.Value, error) {
+	return rr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RoleRequests.
+// Note that you need to call RoleRequests.Unwrap() before calling this method if this RoleRequests
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rr *RoleRequests) Update() *RoleRequestsUpdateOne {
+	return NewRoleRequestsClient(rr.config).UpdateOne(rr)
+}
+
+// Unwrap unwraps the RoleRequests entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rr *RoleRequests) Unwrap() *RoleRequests {
+	_tx, ok := rr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleRequests is not a transactional entity")
+	}
+	rr.config.driver = _tx.drv
+	return rr
+}
+
+// String implements the fmt.Stringer.
+func (rr *RoleRequests) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleRequests(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rr.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rr.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", rr.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(rr.Reason)
+	builder.WriteString(", ")
+	if v := rr.DurationHours; v != nil {
+		builder.WriteString("duration_hours=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("break_glass=")
+	builder.WriteString(fmt.Sprintf("%v", rr.BreakGlass))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Status))
+	builder.WriteString(", ")
+	if v := rr.DecidedBy; v != nil {
+		builder.WriteString("decided_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := rr.DecidedAt; v != nil {
+		builder.WriteString("decided_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("decision_note=")
+	builder.WriteString(rr.DecisionNote)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleRequestsSlice is a parsable slice of RoleRequests.
+type RoleRequestsSlice []*RoleRequests
@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rolerequests
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldUserID, v))
+}
+
+// RoleID applies equality check predicate on the "role_id" field. It's identical to RoleIDEQ.
+func RoleID(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldRoleID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldReason, v))
+}
+
+// DurationHours applies equality check predicate on the "duration_hours" field. It's identical to DurationHoursEQ.
+func DurationHours(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldDurationHours, v))
+}
+
+// BreakGlass applies equality check predicate on the "break_glass" field. It's identical to BreakGlassEQ.
+func BreakGlass(v bool) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldBreakGlass, v))
+}
+
+// DecidedBy applies equality check predicate on the "decided_by" field. It's identical to DecidedByEQ.
+func DecidedBy(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldDecidedBy, v))
+}
+
+// DecidedAt applies equality check predicate on the "decided_at" field. It's identical to DecidedAtEQ.
+func DecidedAt(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldDecidedAt, v))
+}
+
+// DecisionNote applies equality check predicate on the "decision_note" field. It's identical to DecisionNoteEQ.
+func DecisionNote(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldDecisionNote, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLTE(FieldUserID, v))
+}
+
+// RoleIDEQ applies the EQ predicate on the "role_id" field.
+func RoleIDEQ(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldRoleID, v))
+}
+
+// RoleIDNEQ applies the NEQ predicate on the "role_id" field.
+func RoleIDNEQ(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldRoleID, v))
+}
+
+// RoleIDIn applies the In predicate on the "role_id" field.
+func RoleIDIn(vs ...int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIn(FieldRoleID, vs...))
+}
+
+// RoleIDNotIn applies the NotIn predicate on the "role_id" field.
+func RoleIDNotIn(vs ...int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotIn(FieldRoleID, vs...))
+}
+
+// RoleIDGT applies the GT predicate on the "role_id" field.
+func RoleIDGT(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGT(FieldRoleID, v))
+}
+
+// RoleIDGTE applies the GTE predicate on the "role_id" field.
+func RoleIDGTE(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGTE(FieldRoleID, v))
+}
+
+// RoleIDLT applies the LT predicate on the "role_id" field.
+func RoleIDLT(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLT(FieldRoleID, v))
+}
+
+// RoleIDLTE applies the LTE predicate on the "role_id" field.
+func RoleIDLTE(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLTE(FieldRoleID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldContainsFold(FieldReason, v))
+}
+
+// DurationHoursEQ applies the EQ predicate on the "duration_hours" field.
+func DurationHoursEQ(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldDurationHours, v))
+}
+
+// DurationHoursNEQ applies the NEQ predicate on the "duration_hours" field.
+func DurationHoursNEQ(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldDurationHours, v))
+}
+
+// DurationHoursIn applies the In predicate on the "duration_hours" field.
+func DurationHoursIn(vs ...int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIn(FieldDurationHours, vs...))
+}
+
+// DurationHoursNotIn applies the NotIn predicate on the "duration_hours" field.
+func DurationHoursNotIn(vs ...int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotIn(FieldDurationHours, vs...))
+}
+
+// DurationHoursGT applies the GT predicate on the "duration_hours" field.
+func DurationHoursGT(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGT(FieldDurationHours, v))
+}
+
+// DurationHoursGTE applies the GTE predicate on the "duration_hours" field.
+func DurationHoursGTE(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGTE(FieldDurationHours, v))
+}
+
+// DurationHoursLT applies the LT predicate on the "duration_hours" field.
+func DurationHoursLT(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLT(FieldDurationHours, v))
+}
+
+// DurationHoursLTE applies the LTE predicate on the "duration_hours" field.
+func DurationHoursLTE(v int) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLTE(FieldDurationHours, v))
+}
+
+// DurationHoursIsNil applies the IsNil predicate on the "duration_hours" field.
+func DurationHoursIsNil() predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIsNull(FieldDurationHours))
+}
+
+// DurationHoursNotNil applies the NotNil predicate on the "duration_hours" field.
+func DurationHoursNotNil() predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotNull(FieldDurationHours))
+}
+
+// BreakGlassEQ applies the EQ predicate on the "break_glass" field.
+func BreakGlassEQ(v bool) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldBreakGlass, v))
+}
+
+// BreakGlassNEQ applies the NEQ predicate on the "break_glass" field.
+func BreakGlassNEQ(v bool) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldBreakGlass, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// DecidedByEQ applies the EQ predicate on the "decided_by" field.
+func DecidedByEQ(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldDecidedBy, v))
+}
+
+// DecidedByNEQ applies the NEQ predicate on the "decided_by" field.
+func DecidedByNEQ(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldDecidedBy, v))
+}
+
+// DecidedByIn applies the In predicate on the "decided_by" field.
+func DecidedByIn(vs ...uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIn(FieldDecidedBy, vs...))
+}
+
+// DecidedByNotIn applies the NotIn predicate on the "decided_by" field.
+func DecidedByNotIn(vs ...uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotIn(FieldDecidedBy, vs...))
+}
+
+// DecidedByGT applies the GT predicate on the "decided_by" field.
+func DecidedByGT(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGT(FieldDecidedBy, v))
+}
+
+// DecidedByGTE applies the GTE predicate on the "decided_by" field.
+func DecidedByGTE(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGTE(FieldDecidedBy, v))
+}
+
+// DecidedByLT applies the LT predicate on the "decided_by" field.
+func DecidedByLT(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLT(FieldDecidedBy, v))
+}
+
+// DecidedByLTE applies the LTE predicate on the "decided_by" field.
+func DecidedByLTE(v uuid.UUID) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLTE(FieldDecidedBy, v))
+}
+
+// DecidedByIsNil applies the IsNil predicate on the "decided_by" field.
+func DecidedByIsNil() predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIsNull(FieldDecidedBy))
+}
+
+// DecidedByNotNil applies the NotNil predicate on the "decided_by" field.
+func DecidedByNotNil() predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotNull(FieldDecidedBy))
+}
+
+// DecidedAtEQ applies the EQ predicate on the "decided_at" field.
+func DecidedAtEQ(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldDecidedAt, v))
+}
+
+// DecidedAtNEQ applies the NEQ predicate on the "decided_at" field.
+func DecidedAtNEQ(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldDecidedAt, v))
+}
+
+// DecidedAtIn applies the In predicate on the "decided_at" field.
+func DecidedAtIn(vs ...time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIn(FieldDecidedAt, vs...))
+}
+
+// DecidedAtNotIn applies the NotIn predicate on the "decided_at" field.
+func DecidedAtNotIn(vs ...time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotIn(FieldDecidedAt, vs...))
+}
+
+// DecidedAtGT applies the GT predicate on the "decided_at" field.
+func DecidedAtGT(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGT(FieldDecidedAt, v))
+}
+
+// DecidedAtGTE applies the GTE predicate on the "decided_at" field.
+func DecidedAtGTE(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGTE(FieldDecidedAt, v))
+}
+
+// DecidedAtLT applies the LT predicate on the "decided_at" field.
+func DecidedAtLT(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLT(FieldDecidedAt, v))
+}
+
+// DecidedAtLTE applies the LTE predicate on the "decided_at" field.
+func DecidedAtLTE(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLTE(FieldDecidedAt, v))
+}
+
+// DecidedAtIsNil applies the IsNil predicate on the "decided_at" field.
+func DecidedAtIsNil() predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIsNull(FieldDecidedAt))
+}
+
+// DecidedAtNotNil applies the NotNil predicate on the "decided_at" field.
+func DecidedAtNotNil() predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotNull(FieldDecidedAt))
+}
+
+// DecisionNoteEQ applies the EQ predicate on the "decision_note" field.
+func DecisionNoteEQ(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldDecisionNote, v))
+}
+
+// DecisionNoteNEQ applies the NEQ predicate on the "decision_note" field.
+func DecisionNoteNEQ(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldDecisionNote, v))
+}
+
+// DecisionNoteIn applies the In predicate on the "decision_note" field.
+func DecisionNoteIn(vs ...string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIn(FieldDecisionNote, vs...))
+}
+
+// DecisionNoteNotIn applies the NotIn predicate on the "decision_note" field.
+func DecisionNoteNotIn(vs ...string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotIn(FieldDecisionNote, vs...))
+}
+
+// DecisionNoteGT applies the GT predicate on the "decision_note" field.
+func DecisionNoteGT(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGT(FieldDecisionNote, v))
+}
+
+// DecisionNoteGTE applies the GTE predicate on the "decision_note" field.
+func DecisionNoteGTE(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGTE(FieldDecisionNote, v))
+}
+
+// DecisionNoteLT applies the LT predicate on the "decision_note" field.
+func DecisionNoteLT(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLT(FieldDecisionNote, v))
+}
+
+// DecisionNoteLTE applies the LTE predicate on the "decision_note" field.
+func DecisionNoteLTE(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLTE(FieldDecisionNote, v))
+}
+
+// DecisionNoteContains applies the Contains predicate on the "decision_note" field.
+func DecisionNoteContains(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldContains(FieldDecisionNote, v))
+}
+
+// DecisionNoteHasPrefix applies the HasPrefix predicate on the "decision_note" field.
+func DecisionNoteHasPrefix(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldHasPrefix(FieldDecisionNote, v))
+}
+
+// DecisionNoteHasSuffix applies the HasSuffix predicate on the "decision_note" field.
+func DecisionNoteHasSuffix(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldHasSuffix(FieldDecisionNote, v))
+}
+
+// DecisionNoteIsNil applies the IsNil predicate on the "decision_note" field.
+func DecisionNoteIsNil() predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIsNull(FieldDecisionNote))
+}
+
+// DecisionNoteNotNil applies the NotNil predicate on the "decision_note" field.
+func DecisionNoteNotNil() predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotNull(FieldDecisionNote))
+}
+
+// DecisionNoteEqualFold applies the EqualFold predicate on the "decision_note" field.
+func DecisionNoteEqualFold(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEqualFold(FieldDecisionNote, v))
+}
+
+// DecisionNoteContainsFold applies the ContainsFold predicate on the "decision_note" field.
+func DecisionNoteContainsFold(v string) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldContainsFold(FieldDecisionNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoleRequests) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoleRequests) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoleRequests) predicate.RoleRequests {
+	return predicate.RoleRequests(sql.NotPredicates(p))
+}
@@ -0,0 +1,1203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/rolerequests"
+)
+
+// RoleRequestsCreate is the builder for creating a RoleRequests entity.
+type RoleRequestsCreate struct {
+	config
+	mutation *RoleRequestsMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (rrc *RoleRequestsCreate) SetUserID(u uuid.UUID) *RoleRequestsCreate {
+	rrc.mutation.SetUserID(u)
+	return rrc
+}
+
+// SetRoleID sets the "role_id" field.
+func (rrc *RoleRequestsCreate) SetRoleID(i int) *RoleRequestsCreate {
+	rrc.mutation.SetRoleID(i)
+	return rrc
+}
+
+// SetReason sets the "reason" field.
+func (rrc *RoleRequestsCreate) SetReason(s string) *RoleRequestsCreate {
+	rrc.mutation.SetReason(s)
+	return rrc
+}
+
+// SetDurationHours sets the "duration_hours" field.
+func (rrc *RoleRequestsCreate) SetDurationHours(i int) *RoleRequestsCreate {
+	rrc.mutation.SetDurationHours(i)
+	return rrc
+}
+
+// SetNillableDurationHours sets the "duration_hours" field if the given value is not nil.
+func (rrc *RoleRequestsCreate) SetNillableDurationHours(i *int) *RoleRequestsCreate {
+	if i != nil {
+		rrc.SetDurationHours(*i)
+	}
+	return rrc
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (rrc *RoleRequestsCreate) SetBreakGlass(b bool) *RoleRequestsCreate {
+	rrc.mutation.SetBreakGlass(b)
+	return rrc
+}
+
+// SetNillableBreakGlass sets the "break_glass" field if the given value is not nil.
+func (rrc *RoleRequestsCreate) SetNillableBreakGlass(b *bool) *RoleRequestsCreate {
+	if b != nil {
+		rrc.SetBreakGlass(*b)
+	}
+	return rrc
+}
+
+// SetStatus sets the "status" field.
+func (rrc *RoleRequestsCreate) SetStatus(r rolerequests.Status) *RoleRequestsCreate {
+	rrc.mutation.SetStatus(r)
+	return rrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rrc *RoleRequestsCreate) SetNillableStatus(r *rolerequests.Status) *RoleRequestsCreate {
+	if r != nil {
+		rrc.SetStatus(*r)
+	}
+	return rrc
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (rrc *RoleRequestsCreate) SetDecidedBy(u uuid.UUID) *RoleRequestsCreate {
+	rrc.mutation.SetDecidedBy(u)
+	return rrc
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (rrc *RoleRequestsCreate) SetNillableDecidedBy(u *uuid.UUID) *RoleRequestsCreate {
+	if u != nil {
+		rrc.SetDecidedBy(*u)
+	}
+	return rrc
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (rrc *RoleRequestsCreate) SetDecidedAt(t time.Time) *RoleRequestsCreate {
+	rrc.mutation.SetDecidedAt(t)
+	return rrc
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (rrc *RoleRequestsCreate) SetNillableDecidedAt(t *time.Time) *RoleRequestsCreate {
+	if t != nil {
+		rrc.SetDecidedAt(*t)
+	}
+	return rrc
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (rrc *RoleRequestsCreate) SetDecisionNote(s string) *RoleRequestsCreate {
+	rrc.mutation.SetDecisionNote(s)
+	return rrc
+}
+
+// SetNillableDecisionNote sets the "decision_note" field if the given value is not nil.
+func (rrc *RoleRequestsCreate) SetNillableDecisionNote(s *string) *RoleRequestsCreate {
+	if s != nil {
+		rrc.SetDecisionNote(*s)
+	}
+	return rrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrc *RoleRequestsCreate) SetCreatedAt(t time.Time) *RoleRequestsCreate {
+	rrc.mutation.SetCreatedAt(t)
+	return rrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrc *RoleRequestsCreate) SetNillableCreatedAt(t *time.Time) *RoleRequestsCreate {
+	if t != nil {
+		rrc.SetCreatedAt(*t)
+	}
+	return rrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rrc *RoleRequestsCreate) SetUpdatedAt(t time.Time) *RoleRequestsCreate {
+	rrc.mutation.SetUpdatedAt(t)
+	return rrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rrc *RoleRequestsCreate) SetNillableUpdatedAt(t *time.Time) *RoleRequestsCreate {
+	if t != nil {
+		rrc.SetUpdatedAt(*t)
+	}
+	return rrc
+}
+
+// SetID sets the "id" field.
+func (rrc *RoleRequestsCreate) SetID(u uuid.UUID) *RoleRequestsCreate {
+	rrc.mutation.SetID(u)
+	return rrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rrc *RoleRequestsCreate) SetNillableID(u *uuid.UUID) *RoleRequestsCreate {
+	if u != nil {
+		rrc.SetID(*u)
+	}
+	return rrc
+}
+
+// Mutation returns the RoleRequestsMutation object of the builder.
+func (rrc *RoleRequestsCreate) Mutation() *RoleRequestsMutation {
+	return rrc.mutation
+}
+
+// Save creates the RoleRequests in the database.
+func (rrc *RoleRequestsCreate) Save(ctx context.Context) (*RoleRequests, error) {
+	rrc.defaults()
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *RoleRequestsCreate) SaveX(ctx context.Context) *RoleRequests {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *RoleRequestsCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *RoleRequestsCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *RoleRequestsCreate) defaults() {
+	if _, ok := rrc.mutation.BreakGlass(); !ok {
+		v := rolerequests.DefaultBreakGlass
+		rrc.mutation.SetBreakGlass(v)
+	}
+	if _, ok := rrc.mutation.Status(); !ok {
+		v := rolerequests.DefaultStatus
+		rrc.mutation.SetStatus(v)
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		v := rolerequests.DefaultCreatedAt()
+		rrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		v := rolerequests.DefaultUpdatedAt()
+		rrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rrc.mutation.ID(); !ok {
+		v := rolerequests.DefaultID()
+		rrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *RoleRequestsCreate) check() error {
+	if _, ok := rrc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RoleRequests.user_id"`)}
+	}
+	if _, ok := rrc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "RoleRequests.role_id"`)}
+	}
+	if _, ok := rrc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "RoleRequests.reason"`)}
+	}
+	if v, ok := rrc.mutation.Reason(); ok {
+		if err := rolerequests.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "RoleRequests.reason": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.BreakGlass(); !ok {
+		return &ValidationError{Name: "break_glass", err: errors.New(`ent: missing required field "RoleRequests.break_glass"`)}
+	}
+	if _, ok := rrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RoleRequests.status"`)}
+	}
+	if v, ok := rrc.mutation.Status(); ok {
+		if err := rolerequests.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoleRequests.status": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoleRequests.created_at"`)}
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RoleRequests.updated_at"`)}
+	}
+	return nil
+}
+
+func (rrc *RoleRequestsCreate) sqlSave(ctx context.Context) (*RoleRequests, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *RoleRequestsCreate) createSpec() (*RoleRequests, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleRequests{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(rolerequests.Table, sqlgraph.NewFieldSpec(rolerequests.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rrc.conflict
+	if id, ok := rrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rrc.mutation.UserID(); ok {
+		_spec.SetField(rolerequests.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := rrc.mutation.RoleID(); ok {
+		_spec.SetField(rolerequests.FieldRoleID, field.TypeInt, value)
+		_node.RoleID = value
+	}
+	if value, ok := rrc.mutation.Reason(); ok {
+		_spec.SetField(rolerequests.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := rrc.mutation.DurationHours(); ok {
+		_spec.SetField(rolerequests.FieldDurationHours, field.TypeInt, value)
+		_node.DurationHours = &value
+	}
+	if value, ok := rrc.mutation.BreakGlass(); ok {
+		_spec.SetField(rolerequests.FieldBreakGlass, field.TypeBool, value)
+		_node.BreakGlass = value
+	}
+	if value, ok := rrc.mutation.Status(); ok {
+		_spec.SetField(rolerequests.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rrc.mutation.DecidedBy(); ok {
+		_spec.SetField(rolerequests.FieldDecidedBy, field.TypeUUID, value)
+		_node.DecidedBy = &value
+	}
+	if value, ok := rrc.mutation.DecidedAt(); ok {
+		_spec.SetField(rolerequests.FieldDecidedAt, field.TypeTime, value)
+		_node.DecidedAt = &value
+	}
+	if value, ok := rrc.mutation.DecisionNote(); ok {
+		_spec.SetField(rolerequests.FieldDecisionNote, field.TypeString, value)
+		_node.DecisionNote = value
+	}
+	if value, ok := rrc.mutation.CreatedAt(); ok {
+		_spec.SetField(rolerequests.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolerequests.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoleRequests.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoleRequestsUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (rrc *RoleRequestsCreate) OnConflict(opts ...sql.ConflictOption) *RoleRequestsUpsertOne {
+	rrc.conflict = opts
+	return &RoleRequestsUpsertOne{
+		create: rrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoleRequests.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrc *RoleRequestsCreate) OnConflictColumns(columns ...string) *RoleRequestsUpsertOne {
+	rrc.conflict = append(rrc.conflict, sql.ConflictColumns(columns...))
+	return &RoleRequestsUpsertOne{
+		create: rrc,
+	}
+}
+
+type (
+	// RoleRequestsUpsertOne is the builder for "upsert"-ing
+	//  one RoleRequests node.
+	RoleRequestsUpsertOne struct {
+		create *RoleRequestsCreate
+	}
+
+	// RoleRequestsUpsert is the "OnConflict" setter.
+	RoleRequestsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *RoleRequestsUpsert) SetUserID(v uuid.UUID) *RoleRequestsUpsert {
+	u.Set(rolerequests.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RoleRequestsUpsert) UpdateUserID() *RoleRequestsUpsert {
+	u.SetExcluded(rolerequests.FieldUserID)
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *RoleRequestsUpsert) SetRoleID(v int) *RoleRequestsUpsert {
+	u.Set(rolerequests.FieldRoleID, v)
+	return u
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RoleRequestsUpsert) UpdateRoleID() *RoleRequestsUpsert {
+	u.SetExcluded(rolerequests.FieldRoleID)
+	return u
+}
+
+// AddRoleID adds v to the "role_id" field.
+func (u *RoleRequestsUpsert) AddRoleID(v int) *RoleRequestsUpsert {
+	u.Add(rolerequests.FieldRoleID, v)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *RoleRequestsUpsert) SetReason(v string) *RoleRequestsUpsert {
+	u.Set(rolerequests.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *RoleRequestsUpsert) UpdateReason() *RoleRequestsUpsert {
+	u.SetExcluded(rolerequests.FieldReason)
+	return u
+}
+
+// SetDurationHours sets the "duration_hours" field.
+func (u *RoleRequestsUpsert) SetDurationHours(v int) *RoleRequestsUpsert {
+	u.Set(rolerequests.FieldDurationHours, v)
+	return u
+}
+
+// UpdateDurationHours sets the "duration_hours" field to the value that was provided on create.
+func (u *RoleRequestsUpsert) UpdateDurationHours() *RoleRequestsUpsert {
+	u.SetExcluded(rolerequests.FieldDurationHours)
+	return u
+}
+
+// AddDurationHours adds v to the "duration_hours" field.
+func (u *RoleRequestsUpsert) AddDurationHours(v int) *RoleRequestsUpsert {
+	u.Add(rolerequests.FieldDurationHours, v)
+	return u
+}
+
+// ClearDurationHours clears the value of the "duration_hours" field.
+func (u *RoleRequestsUpsert) ClearDurationHours() *RoleRequestsUpsert {
+	u.SetNull(rolerequests.FieldDurationHours)
+	return u
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (u *RoleRequestsUpsert) SetBreakGlass(v bool) *RoleRequestsUpsert {
+	u.Set(rolerequests.FieldBreakGlass, v)
+	return u
+}
+
+// UpdateBreakGlass sets the "break_glass" field to the value that was provided on create.
+func (u *RoleRequestsUpsert) UpdateBreakGlass() *RoleRequestsUpsert {
+	u.SetExcluded(rolerequests.FieldBreakGlass)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *RoleRequestsUpsert) SetStatus(v rolerequests.Status) *RoleRequestsUpsert {
+	u.Set(rolerequests.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *RoleRequestsUpsert) UpdateStatus() *RoleRequestsUpsert {
+	u.SetExcluded(rolerequests.FieldStatus)
+	return u
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (u *RoleRequestsUpsert) SetDecidedBy(v uuid.UUID) *RoleRequestsUpsert {
+	u.Set(rolerequests.FieldDecidedBy, v)
+	return u
+}
+
+// UpdateDecidedBy sets the "decided_by" field to the value that was provided on create.
+func (u *RoleRequestsUpsert) UpdateDecidedBy() *RoleRequestsUpsert {
+	u.SetExcluded(rolerequests.FieldDecidedBy)
+	return u
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (u *RoleRequestsUpsert) ClearDecidedBy() *RoleRequestsUpsert {
+	u.SetNull(rolerequests.FieldDecidedBy)
+	return u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *RoleRequestsUpsert) SetDecidedAt(v time.Time) *RoleRequestsUpsert {
+	u.Set(rolerequests.FieldDecidedAt, v)
+	return u
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *RoleRequestsUpsert) UpdateDecidedAt() *RoleRequestsUpsert {
+	u.SetExcluded(rolerequests.FieldDecidedAt)
+	return u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *RoleRequestsUpsert) ClearDecidedAt() *RoleRequestsUpsert {
+	u.SetNull(rolerequests.FieldDecidedAt)
+	return u
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (u *RoleRequestsUpsert) SetDecisionNote(v string) *RoleRequestsUpsert {
+	u.Set(rolerequests.FieldDecisionNote, v)
+	return u
+}
+
+// UpdateDecisionNote sets the "decision_note" field to the value that was provided on create.
+func (u *RoleRequestsUpsert) UpdateDecisionNote() *RoleRequestsUpsert {
+	u.SetExcluded(rolerequests.FieldDecisionNote)
+	return u
+}
+
+// ClearDecisionNote clears the value of the "decision_note" field.
+func (u *RoleRequestsUpsert) ClearDecisionNote() *RoleRequestsUpsert {
+	u.SetNull(rolerequests.FieldDecisionNote)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoleRequestsUpsert) SetUpdatedAt(v time.Time) *RoleRequestsUpsert {
+	u.Set(rolerequests.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoleRequestsUpsert) UpdateUpdatedAt() *RoleRequestsUpsert {
+	u.SetExcluded(rolerequests.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RoleRequests.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rolerequests.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoleRequestsUpsertOne) UpdateNewValues() *RoleRequestsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(rolerequests.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(rolerequests.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoleRequests.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RoleRequestsUpsertOne) Ignore() *RoleRequestsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoleRequestsUpsertOne) DoNothing() *RoleRequestsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoleRequestsCreate.OnConflict
+// documentation for more info.
+func (u *RoleRequestsUpsertOne) Update(set func(*RoleRequestsUpsert)) *RoleRequestsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoleRequestsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RoleRequestsUpsertOne) SetUserID(v uuid.UUID) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RoleRequestsUpsertOne) UpdateUserID() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *RoleRequestsUpsertOne) SetRoleID(v int) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// AddRoleID adds v to the "role_id" field.
+func (u *RoleRequestsUpsertOne) AddRoleID(v int) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.AddRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RoleRequestsUpsertOne) UpdateRoleID() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *RoleRequestsUpsertOne) SetReason(v string) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *RoleRequestsUpsertOne) UpdateReason() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetDurationHours sets the "duration_hours" field.
+func (u *RoleRequestsUpsertOne) SetDurationHours(v int) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetDurationHours(v)
+	})
+}
+
+// AddDurationHours adds v to the "duration_hours" field.
+func (u *RoleRequestsUpsertOne) AddDurationHours(v int) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.AddDurationHours(v)
+	})
+}
+
+// UpdateDurationHours sets the "duration_hours" field to the value that was provided on create.
+func (u *RoleRequestsUpsertOne) UpdateDurationHours() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateDurationHours()
+	})
+}
+
+// ClearDurationHours clears the value of the "duration_hours" field.
+func (u *RoleRequestsUpsertOne) ClearDurationHours() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.ClearDurationHours()
+	})
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (u *RoleRequestsUpsertOne) SetBreakGlass(v bool) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetBreakGlass(v)
+	})
+}
+
+// UpdateBreakGlass sets the "break_glass" field to the value that was provided on create.
+func (u *RoleRequestsUpsertOne) UpdateBreakGlass() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateBreakGlass()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *RoleRequestsUpsertOne) SetStatus(v rolerequests.Status) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *RoleRequestsUpsertOne) UpdateStatus() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (u *RoleRequestsUpsertOne) SetDecidedBy(v uuid.UUID) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetDecidedBy(v)
+	})
+}
+
+// UpdateDecidedBy sets the "decided_by" field to the value that was provided on create.
+func (u *RoleRequestsUpsertOne) UpdateDecidedBy() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateDecidedBy()
+	})
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (u *RoleRequestsUpsertOne) ClearDecidedBy() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.ClearDecidedBy()
+	})
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *RoleRequestsUpsertOne) SetDecidedAt(v time.Time) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetDecidedAt(v)
+	})
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *RoleRequestsUpsertOne) UpdateDecidedAt() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateDecidedAt()
+	})
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *RoleRequestsUpsertOne) ClearDecidedAt() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.ClearDecidedAt()
+	})
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (u *RoleRequestsUpsertOne) SetDecisionNote(v string) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetDecisionNote(v)
+	})
+}
+
+// UpdateDecisionNote sets the "decision_note" field to the value that was provided on create.
+func (u *RoleRequestsUpsertOne) UpdateDecisionNote() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateDecisionNote()
+	})
+}
+
+// ClearDecisionNote clears the value of the "decision_note" field.
+func (u *RoleRequestsUpsertOne) ClearDecisionNote() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.ClearDecisionNote()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoleRequestsUpsertOne) SetUpdatedAt(v time.Time) *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoleRequestsUpsertOne) UpdateUpdatedAt() *RoleRequestsUpsertOne {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RoleRequestsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoleRequestsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoleRequestsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RoleRequestsUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RoleRequestsUpsertOne.ID is not supported by MySQL driver. Use RoleRequestsUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RoleRequestsUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RoleRequestsCreateBulk is the builder for creating many RoleRequests entities in bulk.
+type RoleRequestsCreateBulk struct {
+	config
+	err      error
+	builders []*RoleRequestsCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RoleRequests entities in the database.
+func (rrcb *RoleRequestsCreateBulk) Save(ctx context.Context) ([]*RoleRequests, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*RoleRequests, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleRequestsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *RoleRequestsCreateBulk) SaveX(ctx context.Context) []*RoleRequests {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *RoleRequestsCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *RoleRequestsCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RoleRequests.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RoleRequestsUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (rrcb *RoleRequestsCreateBulk) OnConflict(opts ...sql.ConflictOption) *RoleRequestsUpsertBulk {
+	rrcb.conflict = opts
+	return &RoleRequestsUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RoleRequests.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrcb *RoleRequestsCreateBulk) OnConflictColumns(columns ...string) *RoleRequestsUpsertBulk {
+	rrcb.conflict = append(rrcb.conflict, sql.ConflictColumns(columns...))
+	return &RoleRequestsUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// RoleRequestsUpsertBulk is the builder for "upsert"-ing
+// a bulk of RoleRequests nodes.
+type RoleRequestsUpsertBulk struct {
+	create *RoleRequestsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RoleRequests.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(rolerequests.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RoleRequestsUpsertBulk) UpdateNewValues() *RoleRequestsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(rolerequests.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(rolerequests.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RoleRequests.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RoleRequestsUpsertBulk) Ignore() *RoleRequestsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RoleRequestsUpsertBulk) DoNothing() *RoleRequestsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RoleRequestsCreateBulk.OnConflict
+// documentation for more info.
+func (u *RoleRequestsUpsertBulk) Update(set func(*RoleRequestsUpsert)) *RoleRequestsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RoleRequestsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RoleRequestsUpsertBulk) SetUserID(v uuid.UUID) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RoleRequestsUpsertBulk) UpdateUserID() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *RoleRequestsUpsertBulk) SetRoleID(v int) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// AddRoleID adds v to the "role_id" field.
+func (u *RoleRequestsUpsertBulk) AddRoleID(v int) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.AddRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *RoleRequestsUpsertBulk) UpdateRoleID() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *RoleRequestsUpsertBulk) SetReason(v string) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *RoleRequestsUpsertBulk) UpdateReason() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetDurationHours sets the "duration_hours" field.
+func (u *RoleRequestsUpsertBulk) SetDurationHours(v int) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetDurationHours(v)
+	})
+}
+
+// AddDurationHours adds v to the "duration_hours" field.
+func (u *RoleRequestsUpsertBulk) AddDurationHours(v int) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.AddDurationHours(v)
+	})
+}
+
+// UpdateDurationHours sets the "duration_hours" field to the value that was provided on create.
+func (u *RoleRequestsUpsertBulk) UpdateDurationHours() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateDurationHours()
+	})
+}
+
+// ClearDurationHours clears the value of the "duration_hours" field.
+func (u *RoleRequestsUpsertBulk) ClearDurationHours() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.ClearDurationHours()
+	})
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (u *RoleRequestsUpsertBulk) SetBreakGlass(v bool) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetBreakGlass(v)
+	})
+}
+
+// UpdateBreakGlass sets the "break_glass" field to the value that was provided on create.
+func (u *RoleRequestsUpsertBulk) UpdateBreakGlass() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateBreakGlass()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *RoleRequestsUpsertBulk) SetStatus(v rolerequests.Status) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *RoleRequestsUpsertBulk) UpdateStatus() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (u *RoleRequestsUpsertBulk) SetDecidedBy(v uuid.UUID) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetDecidedBy(v)
+	})
+}
+
+// UpdateDecidedBy sets the "decided_by" field to the value that was provided on create.
+func (u *RoleRequestsUpsertBulk) UpdateDecidedBy() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateDecidedBy()
+	})
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (u *RoleRequestsUpsertBulk) ClearDecidedBy() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.ClearDecidedBy()
+	})
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *RoleRequestsUpsertBulk) SetDecidedAt(v time.Time) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetDecidedAt(v)
+	})
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *RoleRequestsUpsertBulk) UpdateDecidedAt() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateDecidedAt()
+	})
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *RoleRequestsUpsertBulk) ClearDecidedAt() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.ClearDecidedAt()
+	})
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (u *RoleRequestsUpsertBulk) SetDecisionNote(v string) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetDecisionNote(v)
+	})
+}
+
+// UpdateDecisionNote sets the "decision_note" field to the value that was provided on create.
+func (u *RoleRequestsUpsertBulk) UpdateDecisionNote() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateDecisionNote()
+	})
+}
+
+// ClearDecisionNote clears the value of the "decision_note" field.
+func (u *RoleRequestsUpsertBulk) ClearDecisionNote() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.ClearDecisionNote()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RoleRequestsUpsertBulk) SetUpdatedAt(v time.Time) *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RoleRequestsUpsertBulk) UpdateUpdatedAt() *RoleRequestsUpsertBulk {
+	return u.Update(func(s *RoleRequestsUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RoleRequestsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RoleRequestsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RoleRequestsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RoleRequestsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/rolerequests"
+)
+
+// RoleRequestsDelete is the builder for deleting a RoleRequests entity.
+type RoleRequestsDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoleRequestsMutation
+}
+
+// Where appends a list predicates to the RoleRequestsDelete builder.
+func (rrd *RoleRequestsDelete) Where(ps ...predicate.RoleRequests) *RoleRequestsDelete {
+	rrd.mutation.Where(ps...)
+	return rrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rrd *RoleRequestsDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rrd.sqlExec, rrd.mutation, rrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrd *RoleRequestsDelete) ExecX(ctx context.Context) int {
+	n, err := rrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rrd *RoleRequestsDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(rolerequests.Table, sqlgraph.NewFieldSpec(rolerequests.FieldID, field.TypeUUID))
+	if ps := rrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rrd.mutation.done = true
+	return affected, err
+}
+
+// RoleRequestsDeleteOne is the builder for deleting a single RoleRequests entity.
+type RoleRequestsDeleteOne struct {
+	rrd *RoleRequestsDelete
+}
+
+// Where appends a list predicates to the RoleRequestsDelete builder.
+func (rrdo *RoleRequestsDeleteOne) Where(ps ...predicate.RoleRequests) *RoleRequestsDeleteOne {
+	rrdo.rrd.mutation.Where(ps...)
+	return rrdo
+}
+
+// Exec executes the deletion query.
+func (rrdo *RoleRequestsDeleteOne) Exec(ctx context.Context) error {
+	n, err := rrdo.rrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{rolerequests.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrdo *RoleRequestsDeleteOne) ExecX(ctx context.Context) {
+	if err := rrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/rolerequests"
+)
+
+// RoleRequestsQuery is the builder for querying RoleRequests entities.
+type RoleRequestsQuery struct {
+	config
+	ctx        *QueryContext
+	order      []rolerequests.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RoleRequests
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RoleRequestsQuery builder.
+func (rrq *RoleRequestsQuery) Where(ps ...predicate.RoleRequests) *RoleRequestsQuery {
+	rrq.predicates = append(rrq.predicates, ps...)
+	return rrq
+}
+
+// Limit the number of records to be returned by this query.
+func (rrq *RoleRequestsQuery) Limit(limit int) *RoleRequestsQuery {
+	rrq.ctx.Limit = &limit
+	return rrq
+}
+
+// Offset to start from.
+func (rrq *RoleRequestsQuery) Offset(offset int) *RoleRequestsQuery {
+	rrq.ctx.Offset = &offset
+	return rrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rrq *RoleRequestsQuery) Unique(unique bool) *RoleRequestsQuery {
+	rrq.ctx.Unique = &unique
+	return rrq
+}
+
+// Order specifies how the records should be ordered.
+func (rrq *RoleRequestsQuery) Order(o ...rolerequests.OrderOption) *RoleRequestsQuery {
+	rrq.order = append(rrq.order, o...)
+	return rrq
+}
+
+// First returns the first RoleRequests entity from the query.
+// Returns a *NotFoundError when no RoleRequests was found.
+func (rrq *RoleRequestsQuery) First(ctx context.Context) (*RoleRequests, error) {
+	nodes, err := rrq.Limit(1).All(setContextOp(ctx, rrq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{rolerequests.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rrq *RoleRequestsQuery) FirstX(ctx context.Context) *RoleRequests {
+	node, err := rrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RoleRequests ID from the query.
+// Returns a *NotFoundError when no RoleRequests ID was found.
+func (rrq *RoleRequestsQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rrq.Limit(1).IDs(setContextOp(ctx, rrq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{rolerequests.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rrq *RoleRequestsQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RoleRequests entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RoleRequests entity is found.
+// Returns a *NotFoundError when no RoleRequests entities are found.
+func (rrq *RoleRequestsQuery) Only(ctx context.Context) (*RoleRequests, error) {
+	nodes, err := rrq.Limit(2).All(setContextOp(ctx, rrq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{rolerequests.Label}
+	default:
+		return nil, &NotSingularError{rolerequests.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rrq *RoleRequestsQuery) OnlyX(ctx context.Context) *RoleRequests {
+	node, err := rrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RoleRequests ID in the query.
+// Returns a *NotSingularError when more than one RoleRequests ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rrq *RoleRequestsQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rrq.Limit(2).IDs(setContextOp(ctx, rrq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{rolerequests.Label}
+	default:
+		err = &NotSingularError{rolerequests.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rrq *RoleRequestsQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RoleRequestsSlice.
+func (rrq *RoleRequestsQuery) All(ctx context.Context) ([]*RoleRequests, error) {
+	ctx = setContextOp(ctx, rrq.ctx, "All")
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RoleRequests, *RoleRequestsQuery]()
+	return withInterceptors[[]*RoleRequests](ctx, rrq, qr, rrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rrq *RoleRequestsQuery) AllX(ctx context.Context) []*RoleRequests {
+	nodes, err := rrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RoleRequests IDs.
+func (rrq *RoleRequestsQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if rrq.ctx.Unique == nil && rrq.path != nil {
+		rrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rrq.ctx, "IDs")
+	if err = rrq.Select(rolerequests.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rrq *RoleRequestsQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rrq *RoleRequestsQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rrq.ctx, "Count")
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rrq, querierCount[*RoleRequestsQuery](), rrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rrq *RoleRequestsQuery) CountX(ctx context.Context) int {
+	count, err := rrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rrq *RoleRequestsQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rrq.ctx, "Exist")
+	switch _, err := rrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rrq *RoleRequestsQuery) ExistX(ctx context.Context) bool {
+	exist, err := rrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RoleRequestsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rrq *RoleRequestsQuery) Clone() *RoleRequestsQuery {
+	if rrq == nil {
+		return nil
+	}
+	return &RoleRequestsQuery{
+		config:     rrq.config,
+		ctx:        rrq.ctx.Clone(),
+		order:      append([]rolerequests.OrderOption{}, rrq.order...),
+		inters:     append([]Interceptor{}, rrq.inters...),
+		predicates: append([]predicate.RoleRequests{}, rrq.predicates...),
+		// clone intermediate query.
+		sql:  rrq.sql.Clone(),
+		path: rrq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RoleRequests.Query().
+//		GroupBy(rolerequests.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rrq *RoleRequestsQuery) GroupBy(field string, fields ...string) *RoleRequestsGroupBy {
+	rrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RoleRequestsGroupBy{build: rrq}
+	grbuild.flds = &rrq.ctx.Fields
+	grbuild.label = rolerequests.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.RoleRequests.Query().
+//		Select(rolerequests.FieldUserID).
+//		Scan(ctx, &v)
+func (rrq *RoleRequestsQuery) Select(fields ...string) *RoleRequestsSelect {
+	rrq.ctx.Fields = append(rrq.ctx.Fields, fields...)
+	sbuild := &RoleRequestsSelect{RoleRequestsQuery: rrq}
+	sbuild.label = rolerequests.Label
+	sbuild.flds, sbuild.scan = &rrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RoleRequestsSelect configured with the given aggregations.
+func (rrq *RoleRequestsQuery) Aggregate(fns ...AggregateFunc) *RoleRequestsSelect {
+	return rrq.Select().Aggregate(fns...)
+}
+
+func (rrq *RoleRequestsQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rrq.ctx.Fields {
+		if !rolerequests.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rrq.path != nil {
+		prev, err := rrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rrq.sql = prev
+	}
+	return nil
+}
+
+func (rrq *RoleRequestsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RoleRequests, error) {
+	var (
+		nodes = []*RoleRequests{}
+		_spec = rrq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RoleRequests).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RoleRequests{config: rrq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(rrq.modifiers) > 0 {
+		_spec.Modifiers = rrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rrq *RoleRequestsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rrq.querySpec()
+	if len(rrq.modifiers) > 0 {
+		_spec.Modifiers = rrq.modifiers
+	}
+	_spec.Node.Columns = rrq.ctx.Fields
+	if len(rrq.ctx.Fields) > 0 {
+		_spec.Unique = rrq.ctx.Unique != nil && *rrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rrq.driver, _spec)
+}
+
+func (rrq *RoleRequestsQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(rolerequests.Table, rolerequests.Columns, sqlgraph.NewFieldSpec(rolerequests.FieldID, field.TypeUUID))
+	_spec.From = rrq.sql
+	if unique := rrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rolerequests.FieldID)
+		for i := range fields {
+			if fields[i] != rolerequests.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rrq *RoleRequestsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rrq.driver.Dialect())
+	t1 := builder.Table(rolerequests.Table)
+	columns := rrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = rolerequests.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rrq.sql != nil {
+		selector = rrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rrq.ctx.Unique != nil && *rrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rrq.modifiers {
+		m(selector)
+	}
+	for _, p := range rrq.predicates {
+		p(selector)
+	}
+	for _, p := range rrq.order {
+		p(selector)
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rrq *RoleRequestsQuery) ForUpdate(opts ...sql.LockOption) *RoleRequestsQuery {
+	if rrq.driver.Dialect() == dialect.Postgres {
+		rrq.Unique(false)
+	}
+	rrq.modifiers = append(rrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rrq *RoleRequestsQuery) ForShare(opts ...sql.LockOption) *RoleRequestsQuery {
+	if rrq.driver.Dialect() == dialect.Postgres {
+		rrq.Unique(false)
+	}
+	rrq.modifiers = append(rrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rrq
+}
+
+// RoleRequestsGroupBy is the group-by builder for RoleRequests entities.
+type RoleRequestsGroupBy struct {
+	selector
+	build *RoleRequestsQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rrgb *RoleRequestsGroupBy) Aggregate(fns ...AggregateFunc) *RoleRequestsGroupBy {
+	rrgb.fns = append(rrgb.fns, fns...)
+	return rrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrgb *RoleRequestsGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrgb.build.ctx, "GroupBy")
+	if err := rrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoleRequestsQuery, *RoleRequestsGroupBy](ctx, rrgb.build, rrgb, rrgb.build.inters, v)
+}
+
+func (rrgb *RoleRequestsGroupBy) sqlScan(ctx context.Context, root *RoleRequestsQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rrgb.fns))
+	for _, fn := range rrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rrgb.flds)+len(rrgb.fns))
+		for _, f := range *rrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RoleRequestsSelect is the builder for selecting fields of RoleRequests entities.
+type RoleRequestsSelect struct {
+	*RoleRequestsQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rrs *RoleRequestsSelect) Aggregate(fns ...AggregateFunc) *RoleRequestsSelect {
+	rrs.fns = append(rrs.fns, fns...)
+	return rrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrs *RoleRequestsSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrs.ctx, "Select")
+	if err := rrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoleRequestsQuery, *RoleRequestsSelect](ctx, rrs.RoleRequestsQuery, rrs, rrs.inters, v)
+}
+
+func (rrs *RoleRequestsSelect) sqlScan(ctx context.Context, root *RoleRequestsQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rrs.fns))
+	for _, fn := range rrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
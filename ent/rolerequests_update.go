@@ -0,0 +1,667 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/rolerequests"
+)
+
+// RoleRequestsUpdate is the builder for updating RoleRequests entities.
+type RoleRequestsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoleRequestsMutation
+}
+
+// Where appends a list predicates to the RoleRequestsUpdate builder.
+func (rru *RoleRequestsUpdate) Where(ps ...predicate.RoleRequests) *RoleRequestsUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetUserID sets the "user_id" field.
+func (rru *RoleRequestsUpdate) SetUserID(u uuid.UUID) *RoleRequestsUpdate {
+	rru.mutation.SetUserID(u)
+	return rru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rru *RoleRequestsUpdate) SetNillableUserID(u *uuid.UUID) *RoleRequestsUpdate {
+	if u != nil {
+		rru.SetUserID(*u)
+	}
+	return rru
+}
+
+// SetRoleID sets the "role_id" field.
+func (rru *RoleRequestsUpdate) SetRoleID(i int) *RoleRequestsUpdate {
+	rru.mutation.ResetRoleID()
+	rru.mutation.SetRoleID(i)
+	return rru
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (rru *RoleRequestsUpdate) SetNillableRoleID(i *int) *RoleRequestsUpdate {
+	if i != nil {
+		rru.SetRoleID(*i)
+	}
+	return rru
+}
+
+// AddRoleID adds i to the "role_id" field.
+func (rru *RoleRequestsUpdate) AddRoleID(i int) *RoleRequestsUpdate {
+	rru.mutation.AddRoleID(i)
+	return rru
+}
+
+// SetReason sets the "reason" field.
+func (rru *RoleRequestsUpdate) SetReason(s string) *RoleRequestsUpdate {
+	rru.mutation.SetReason(s)
+	return rru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (rru *RoleRequestsUpdate) SetNillableReason(s *string) *RoleRequestsUpdate {
+	if s != nil {
+		rru.SetReason(*s)
+	}
+	return rru
+}
+
+// SetDurationHours sets the "duration_hours" field.
+func (rru *RoleRequestsUpdate) SetDurationHours(i int) *RoleRequestsUpdate {
+	rru.mutation.ResetDurationHours()
+	rru.mutation.SetDurationHours(i)
+	return rru
+}
+
+// SetNillableDurationHours sets the "duration_hours" field if the given value is not nil.
+func (rru *RoleRequestsUpdate) SetNillableDurationHours(i *int) *RoleRequestsUpdate {
+	if i != nil {
+		rru.SetDurationHours(*i)
+	}
+	return rru
+}
+
+// AddDurationHours adds i to the "duration_hours" field.
+func (rru *RoleRequestsUpdate) AddDurationHours(i int) *RoleRequestsUpdate {
+	rru.mutation.AddDurationHours(i)
+	return rru
+}
+
+// ClearDurationHours clears the value of the "duration_hours" field.
+func (rru *RoleRequestsUpdate) ClearDurationHours() *RoleRequestsUpdate {
+	rru.mutation.ClearDurationHours()
+	return rru
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (rru *RoleRequestsUpdate) SetBreakGlass(b bool) *RoleRequestsUpdate {
+	rru.mutation.SetBreakGlass(b)
+	return rru
+}
+
+// SetNillableBreakGlass sets the "break_glass" field if the given value is not nil.
+func (rru *RoleRequestsUpdate) SetNillableBreakGlass(b *bool) *RoleRequestsUpdate {
+	if b != nil {
+		rru.SetBreakGlass(*b)
+	}
+	return rru
+}
+
+// SetStatus sets the "status" field.
+func (rru *RoleRequestsUpdate) SetStatus(r rolerequests.Status) *RoleRequestsUpdate {
+	rru.mutation.SetStatus(r)
+	return rru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rru *RoleRequestsUpdate) SetNillableStatus(r *rolerequests.Status) *RoleRequestsUpdate {
+	if r != nil {
+		rru.SetStatus(*r)
+	}
+	return rru
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (rru *RoleRequestsUpdate) SetDecidedBy(u uuid.UUID) *RoleRequestsUpdate {
+	rru.mutation.SetDecidedBy(u)
+	return rru
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (rru *RoleRequestsUpdate) SetNillableDecidedBy(u *uuid.UUID) *RoleRequestsUpdate {
+	if u != nil {
+		rru.SetDecidedBy(*u)
+	}
+	return rru
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (rru *RoleRequestsUpdate) ClearDecidedBy() *RoleRequestsUpdate {
+	rru.mutation.ClearDecidedBy()
+	return rru
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (rru *RoleRequestsUpdate) SetDecidedAt(t time.Time) *RoleRequestsUpdate {
+	rru.mutation.SetDecidedAt(t)
+	return rru
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (rru *RoleRequestsUpdate) SetNillableDecidedAt(t *time.Time) *RoleRequestsUpdate {
+	if t != nil {
+		rru.SetDecidedAt(*t)
+	}
+	return rru
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (rru *RoleRequestsUpdate) ClearDecidedAt() *RoleRequestsUpdate {
+	rru.mutation.ClearDecidedAt()
+	return rru
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (rru *RoleRequestsUpdate) SetDecisionNote(s string) *RoleRequestsUpdate {
+	rru.mutation.SetDecisionNote(s)
+	return rru
+}
+
+// SetNillableDecisionNote sets the "decision_note" field if the given value is not nil.
+func (rru *RoleRequestsUpdate) SetNillableDecisionNote(s *string) *RoleRequestsUpdate {
+	if s != nil {
+		rru.SetDecisionNote(*s)
+	}
+	return rru
+}
+
+// ClearDecisionNote clears the value of the "decision_note" field.
+func (rru *RoleRequestsUpdate) ClearDecisionNote() *RoleRequestsUpdate {
+	rru.mutation.ClearDecisionNote()
+	return rru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rru *RoleRequestsUpdate) SetUpdatedAt(t time.Time) *RoleRequestsUpdate {
+	rru.mutation.SetUpdatedAt(t)
+	return rru
+}
+
+// Mutation returns the RoleRequestsMutation object of the builder.
+func (rru *RoleRequestsUpdate) Mutation() *RoleRequestsMutation {
+	return rru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *RoleRequestsUpdate) Save(ctx context.Context) (int, error) {
+	rru.defaults()
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *RoleRequestsUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *RoleRequestsUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *RoleRequestsUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rru *RoleRequestsUpdate) defaults() {
+	if _, ok := rru.mutation.UpdatedAt(); !ok {
+		v := rolerequests.UpdateDefaultUpdatedAt()
+		rru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rru *RoleRequestsUpdate) check() error {
+	if v, ok := rru.mutation.Reason(); ok {
+		if err := rolerequests.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "RoleRequests.reason": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.Status(); ok {
+		if err := rolerequests.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoleRequests.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rru *RoleRequestsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolerequests.Table, rolerequests.Columns, sqlgraph.NewFieldSpec(rolerequests.FieldID, field.TypeUUID))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.UserID(); ok {
+		_spec.SetField(rolerequests.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := rru.mutation.RoleID(); ok {
+		_spec.SetField(rolerequests.FieldRoleID, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedRoleID(); ok {
+		_spec.AddField(rolerequests.FieldRoleID, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.Reason(); ok {
+		_spec.SetField(rolerequests.FieldReason, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.DurationHours(); ok {
+		_spec.SetField(rolerequests.FieldDurationHours, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedDurationHours(); ok {
+		_spec.AddField(rolerequests.FieldDurationHours, field.TypeInt, value)
+	}
+	if rru.mutation.DurationHoursCleared() {
+		_spec.ClearField(rolerequests.FieldDurationHours, field.TypeInt)
+	}
+	if value, ok := rru.mutation.BreakGlass(); ok {
+		_spec.SetField(rolerequests.FieldBreakGlass, field.TypeBool, value)
+	}
+	if value, ok := rru.mutation.Status(); ok {
+		_spec.SetField(rolerequests.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rru.mutation.DecidedBy(); ok {
+		_spec.SetField(rolerequests.FieldDecidedBy, field.TypeUUID, value)
+	}
+	if rru.mutation.DecidedByCleared() {
+		_spec.ClearField(rolerequests.FieldDecidedBy, field.TypeUUID)
+	}
+	if value, ok := rru.mutation.DecidedAt(); ok {
+		_spec.SetField(rolerequests.FieldDecidedAt, field.TypeTime, value)
+	}
+	if rru.mutation.DecidedAtCleared() {
+		_spec.ClearField(rolerequests.FieldDecidedAt, field.TypeTime)
+	}
+	if value, ok := rru.mutation.DecisionNote(); ok {
+		_spec.SetField(rolerequests.FieldDecisionNote, field.TypeString, value)
+	}
+	if rru.mutation.DecisionNoteCleared() {
+		_spec.ClearField(rolerequests.FieldDecisionNote, field.TypeString)
+	}
+	if value, ok := rru.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolerequests.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolerequests.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// RoleRequestsUpdateOne is the builder for updating a single RoleRequests entity.
+type RoleRequestsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoleRequestsMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (rruo *RoleRequestsUpdateOne) SetUserID(u uuid.UUID) *RoleRequestsUpdateOne {
+	rruo.mutation.SetUserID(u)
+	return rruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rruo *RoleRequestsUpdateOne) SetNillableUserID(u *uuid.UUID) *RoleRequestsUpdateOne {
+	if u != nil {
+		rruo.SetUserID(*u)
+	}
+	return rruo
+}
+
+// SetRoleID sets the "role_id" field.
+func (rruo *RoleRequestsUpdateOne) SetRoleID(i int) *RoleRequestsUpdateOne {
+	rruo.mutation.ResetRoleID()
+	rruo.mutation.SetRoleID(i)
+	return rruo
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (rruo *RoleRequestsUpdateOne) SetNillableRoleID(i *int) *RoleRequestsUpdateOne {
+	if i != nil {
+		rruo.SetRoleID(*i)
+	}
+	return rruo
+}
+
+// AddRoleID adds i to the "role_id" field.
+func (rruo *RoleRequestsUpdateOne) AddRoleID(i int) *RoleRequestsUpdateOne {
+	rruo.mutation.AddRoleID(i)
+	return rruo
+}
+
+// SetReason sets the "reason" field.
+func (rruo *RoleRequestsUpdateOne) SetReason(s string) *RoleRequestsUpdateOne {
+	rruo.mutation.SetReason(s)
+	return rruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (rruo *RoleRequestsUpdateOne) SetNillableReason(s *string) *RoleRequestsUpdateOne {
+	if s != nil {
+		rruo.SetReason(*s)
+	}
+	return rruo
+}
+
+// SetDurationHours sets the "duration_hours" field.
+func (rruo *RoleRequestsUpdateOne) SetDurationHours(i int) *RoleRequestsUpdateOne {
+	rruo.mutation.ResetDurationHours()
+	rruo.mutation.SetDurationHours(i)
+	return rruo
+}
+
+// SetNillableDurationHours sets the "duration_hours" field if the given value is not nil.
+func (rruo *RoleRequestsUpdateOne) SetNillableDurationHours(i *int) *RoleRequestsUpdateOne {
+	if i != nil {
+		rruo.SetDurationHours(*i)
+	}
+	return rruo
+}
+
+// AddDurationHours adds i to the "duration_hours" field.
+func (rruo *RoleRequestsUpdateOne) AddDurationHours(i int) *RoleRequestsUpdateOne {
+	rruo.mutation.AddDurationHours(i)
+	return rruo
+}
+
+// ClearDurationHours clears the value of the "duration_hours" field.
+func (rruo *RoleRequestsUpdateOne) ClearDurationHours() *RoleRequestsUpdateOne {
+	rruo.mutation.ClearDurationHours()
+	return rruo
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (rruo *RoleRequestsUpdateOne) SetBreakGlass(b bool) *RoleRequestsUpdateOne {
+	rruo.mutation.SetBreakGlass(b)
+	return rruo
+}
+
+// SetNillableBreakGlass sets the "break_glass" field if the given value is not nil.
+func (rruo *RoleRequestsUpdateOne) SetNillableBreakGlass(b *bool) *RoleRequestsUpdateOne {
+	if b != nil {
+		rruo.SetBreakGlass(*b)
+	}
+	return rruo
+}
+
+// SetStatus sets the "status" field.
+func (rruo *RoleRequestsUpdateOne) SetStatus(r rolerequests.Status) *RoleRequestsUpdateOne {
+	rruo.mutation.SetStatus(r)
+	return rruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rruo *RoleRequestsUpdateOne) SetNillableStatus(r *rolerequests.Status) *RoleRequestsUpdateOne {
+	if r != nil {
+		rruo.SetStatus(*r)
+	}
+	return rruo
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (rruo *RoleRequestsUpdateOne) SetDecidedBy(u uuid.UUID) *RoleRequestsUpdateOne {
+	rruo.mutation.SetDecidedBy(u)
+	return rruo
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (rruo *RoleRequestsUpdateOne) SetNillableDecidedBy(u *uuid.UUID) *RoleRequestsUpdateOne {
+	if u != nil {
+		rruo.SetDecidedBy(*u)
+	}
+	return rruo
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (rruo *RoleRequestsUpdateOne) ClearDecidedBy() *RoleRequestsUpdateOne {
+	rruo.mutation.ClearDecidedBy()
+	return rruo
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (rruo *RoleRequestsUpdateOne) SetDecidedAt(t time.Time) *RoleRequestsUpdateOne {
+	rruo.mutation.SetDecidedAt(t)
+	return rruo
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (rruo *RoleRequestsUpdateOne) SetNillableDecidedAt(t *time.Time) *RoleRequestsUpdateOne {
+	if t != nil {
+		rruo.SetDecidedAt(*t)
+	}
+	return rruo
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (rruo *RoleRequestsUpdateOne) ClearDecidedAt() *RoleRequestsUpdateOne {
+	rruo.mutation.ClearDecidedAt()
+	return rruo
+}
+
+// SetDecisionNote sets the "decision_note" field.
+func (rruo *RoleRequestsUpdateOne) SetDecisionNote(s string) *RoleRequestsUpdateOne {
+	rruo.mutation.SetDecisionNote(s)
+	return rruo
+}
+
+// SetNillableDecisionNote sets the "decision_note" field if the given value is not nil.
+func (rruo *RoleRequestsUpdateOne) SetNillableDecisionNote(s *string) *RoleRequestsUpdateOne {
+	if s != nil {
+		rruo.SetDecisionNote(*s)
+	}
+	return rruo
+}
+
+// ClearDecisionNote clears the value of the "decision_note" field.
+func (rruo *RoleRequestsUpdateOne) ClearDecisionNote() *RoleRequestsUpdateOne {
+	rruo.mutation.ClearDecisionNote()
+	return rruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rruo *RoleRequestsUpdateOne) SetUpdatedAt(t time.Time) *RoleRequestsUpdateOne {
+	rruo.mutation.SetUpdatedAt(t)
+	return rruo
+}
+
+// Mutation returns the RoleRequestsMutation object of the builder.
+func (rruo *RoleRequestsUpdateOne) Mutation() *RoleRequestsMutation {
+	return rruo.mutation
+}
+
+// Where appends a list predicates to the RoleRequestsUpdate builder.
+func (rruo *RoleRequestsUpdateOne) Where(ps ...predicate.RoleRequests) *RoleRequestsUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *RoleRequestsUpdateOne) Select(field string, fields ...string) *RoleRequestsUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated RoleRequests entity.
+func (rruo *RoleRequestsUpdateOne) Save(ctx context.Context) (*RoleRequests, error) {
+	rruo.defaults()
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *RoleRequestsUpdateOne) SaveX(ctx context.Context) *RoleRequests {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *RoleRequestsUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *RoleRequestsUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rruo *RoleRequestsUpdateOne) defaults() {
+	if _, ok := rruo.mutation.UpdatedAt(); !ok {
+		v := rolerequests.UpdateDefaultUpdatedAt()
+		rruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rruo *RoleRequestsUpdateOne) check() error {
+	if v, ok := rruo.mutation.Reason(); ok {
+		if err := rolerequests.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "RoleRequests.reason": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.Status(); ok {
+		if err := rolerequests.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RoleRequests.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rruo *RoleRequestsUpdateOne) sqlSave(ctx context.Context) (_node *RoleRequests, err error) {
+	if err := rruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolerequests.Table, rolerequests.Columns, sqlgraph.NewFieldSpec(rolerequests.FieldID, field.TypeUUID))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleRequests.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rolerequests.FieldID)
+		for _, f := range fields {
+			if !rolerequests.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rolerequests.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.UserID(); ok {
+		_spec.SetField(rolerequests.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := rruo.mutation.RoleID(); ok {
+		_spec.SetField(rolerequests.FieldRoleID, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedRoleID(); ok {
+		_spec.AddField(rolerequests.FieldRoleID, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.Reason(); ok {
+		_spec.SetField(rolerequests.FieldReason, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.DurationHours(); ok {
+		_spec.SetField(rolerequests.FieldDurationHours, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedDurationHours(); ok {
+		_spec.AddField(rolerequests.FieldDurationHours, field.TypeInt, value)
+	}
+	if rruo.mutation.DurationHoursCleared() {
+		_spec.ClearField(rolerequests.FieldDurationHours, field.TypeInt)
+	}
+	if value, ok := rruo.mutation.BreakGlass(); ok {
+		_spec.SetField(rolerequests.FieldBreakGlass, field.TypeBool, value)
+	}
+	if value, ok := rruo.mutation.Status(); ok {
+		_spec.SetField(rolerequests.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rruo.mutation.DecidedBy(); ok {
+		_spec.SetField(rolerequests.FieldDecidedBy, field.TypeUUID, value)
+	}
+	if rruo.mutation.DecidedByCleared() {
+		_spec.ClearField(rolerequests.FieldDecidedBy, field.TypeUUID)
+	}
+	if value, ok := rruo.mutation.DecidedAt(); ok {
+		_spec.SetField(rolerequests.FieldDecidedAt, field.TypeTime, value)
+	}
+	if rruo.mutation.DecidedAtCleared() {
+		_spec.ClearField(rolerequests.FieldDecidedAt, field.TypeTime)
+	}
+	if value, ok := rruo.mutation.DecisionNote(); ok {
+		_spec.SetField(rolerequests.FieldDecisionNote, field.TypeString, value)
+	}
+	if rruo.mutation.DecisionNoteCleared() {
+		_spec.ClearField(rolerequests.FieldDecisionNote, field.TypeString)
+	}
+	if value, ok := rruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolerequests.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &RoleRequests{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolerequests.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
@@ -29,6 +29,12 @@ type Roles struct {
 	IsDefault bool `json:"is_default,omitempty"`
 	// Maximum users allowed for this role (null = unlimited)
 	MaxUsers *int `json:"max_users,omitempty"`
+	// Organization administrators may assign this role within their organization
+	OrgAssignable bool `json:"org_assignable,omitempty"`
+	// Whether users may request this role for emergencies, and if that needs approval
+	BreakGlass roles.BreakGlass `json:"break_glass,omitempty"`
+	// Longest break-glass grant (null = BREAK_GLASS_MAX_HOURS)
+	BreakGlassMaxHours *int `json:"break_glass_max_hours,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -45,9 +51,23 @@ type RolesEdges struct {
 	UserRoles []*UserRoles `json:"user_roles,omitempty"`
 	// RolePermissions holds the value of the role_permissions edge.
 	RolePermissions []*RolePermissions `json:"role_permissions,omitempty"`
+	// ParentLinks holds the value of the parent_links edge.
+	ParentLinks []*RoleParents `json:"parent_links,omitempty"`
+	// ChildLinks holds the value of the child_links edge.
+	ChildLinks []*RoleParents `json:"child_links,omitempty"`
+	// ApproverLinks holds the value of the approver_links edge.
+	ApproverLinks []*RoleApprovers `json:"approver_links,omitempty"`
+	// ApprovesLinks holds the value of the approves_links edge.
+	ApprovesLinks []*RoleApprovers `json:"approves_links,omitempty"`
+	// SodConstraintRoles holds the value of the sod_constraint_roles edge.
+	SodConstraintRoles []*SodConstraintRoles `json:"sod_constraint_roles,omitempty"`
+	// GroupRoles holds the value of the group_roles edge.
+	GroupRoles []*GroupRoles `json:"group_roles,omitempty"`
+	// OrgRoles holds the value of the org_roles edge.
+	OrgRoles []*OrgRoles `json:"org_roles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [9]bool
 }
 
 // UserRolesOrErr returns the UserRoles value or an error if the edge
@@ -68,16 +88,79 @@ func (e RolesEdges) RolePermissionsOrErr() ([]*RolePermissions, error) {
 	return nil, &NotLoadedError{edge: "role_permissions"}
 }
 
+// ParentLinksOrErr returns the ParentLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e RolesEdges) ParentLinksOrErr() ([]*RoleParents, error) {
+	if e.loadedTypes[2] {
+		return e.ParentLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "parent_links"}
+}
+
+// ChildLinksOrErr returns the ChildLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e RolesEdges) ChildLinksOrErr() ([]*RoleParents, error) {
+	if e.loadedTypes[3] {
+		return e.ChildLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "child_links"}
+}
+
+// ApproverLinksOrErr returns the ApproverLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e RolesEdges) ApproverLinksOrErr() ([]*RoleApprovers, error) {
+	if e.loadedTypes[4] {
+		return e.ApproverLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "approver_links"}
+}
+
+// ApprovesLinksOrErr returns the ApprovesLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e RolesEdges) ApprovesLinksOrErr() ([]*RoleApprovers, error) {
+	if e.loadedTypes[5] {
+		return e.ApprovesLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "approves_links"}
+}
+
+// SodConstraintRolesOrErr returns the SodConstraintRoles value or an error if the edge
+// was not loaded in eager-loading.
+func (e RolesEdges) SodConstraintRolesOrErr() ([]*SodConstraintRoles, error) {
+	if e.loadedTypes[6] {
+		return e.SodConstraintRoles, nil
+	}
+	return nil, &NotLoadedError{edge: "sod_constraint_roles"}
+}
+
+// GroupRolesOrErr returns the GroupRoles value or an error if the edge
+// was not loaded in eager-loading.
+func (e RolesEdges) GroupRolesOrErr() ([]*GroupRoles, error) {
+	if e.loadedTypes[7] {
+		return e.GroupRoles, nil
+	}
+	return nil, &NotLoadedError{edge: "group_roles"}
+}
+
+// OrgRolesOrErr returns the OrgRoles value or an error if the edge
+// was not loaded in eager-loading.
+func (e RolesEdges) OrgRolesOrErr() ([]*OrgRoles, error) {
+	if e.loadedTypes[8] {
+		return e.OrgRoles, nil
+	}
+	return nil, &NotLoadedError{edge: "org_roles"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Roles) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case roles.FieldIsSystem, roles.FieldIsDefault:
+		case roles.FieldIsSystem, roles.FieldIsDefault, roles.FieldOrgAssignable:
 			values[i] = new(sql.NullBool)
-		case roles.FieldID, roles.FieldMaxUsers:
+		case roles.FieldID, roles.FieldMaxUsers, roles.FieldBreakGlassMaxHours:
 			values[i] = new(sql.NullInt64)
-		case roles.FieldCode, roles.FieldName, roles.FieldDescription:
+		case roles.FieldCode, roles.FieldName, roles.FieldDescription, roles.FieldBreakGlass:
 			values[i] = new(sql.NullString)
 		case roles.FieldCreatedAt, roles.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -139,6 +222,25 @@ func (r *Roles) assignValues(columns []string, values []any) error {
 				r.MaxUsers = new(int)
 				*r.MaxUsers = int(value.Int64)
 			}
+		case roles.FieldOrgAssignable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field org_assignable", values[i])
+			} else if value.Valid {
+				r.OrgAssignable = value.Bool
+			}
+		case roles.FieldBreakGlass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field break_glass", values[i])
+			} else if value.Valid {
+				r.BreakGlass = roles.BreakGlass(value.String)
+			}
+		case roles.FieldBreakGlassMaxHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field break_glass_max_hours", values[i])
+			} else if value.Valid {
+				r.BreakGlassMaxHours = new(int)
+				*r.BreakGlassMaxHours = int(value.Int64)
+			}
 		case roles.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -174,6 +276,41 @@ func (r *Roles) QueryRolePermissions() *RolePermissionsQuery {
 	return NewRolesClient(r.config).QueryRolePermissions(r)
 }
 
+// QueryParentLinks queries the "parent_links" edge of the Roles entity.
+func (r *Roles) QueryParentLinks() *RoleParentsQuery {
+	return NewRolesClient(r.config).QueryParentLinks(r)
+}
+
+// QueryChildLinks queries the "child_links" edge of the Roles entity.
+func (r *Roles) QueryChildLinks() *RoleParentsQuery {
+	return NewRolesClient(r.config).QueryChildLinks(r)
+}
+
+// QueryApproverLinks queries the "approver_links" edge of the Roles entity.
+func (r *Roles) QueryApproverLinks() *RoleApproversQuery {
+	return NewRolesClient(r.config).QueryApproverLinks(r)
+}
+
+// QueryApprovesLinks queries the "approves_links" edge of the Roles entity.
+func (r *Roles) QueryApprovesLinks() *RoleApproversQuery {
+	return NewRolesClient(r.config).QueryApprovesLinks(r)
+}
+
+// QuerySodConstraintRoles queries the "sod_constraint_roles" edge of the Roles entity.
+func (r *Roles) QuerySodConstraintRoles() *SodConstraintRolesQuery {
+	return NewRolesClient(r.config).QuerySodConstraintRoles(r)
+}
+
+// QueryGroupRoles queries the "group_roles" edge of the Roles entity.
+func (r *Roles) QueryGroupRoles() *GroupRolesQuery {
+	return NewRolesClient(r.config).QueryGroupRoles(r)
+}
+
+// QueryOrgRoles queries the "org_roles" edge of the Roles entity.
+func (r *Roles) QueryOrgRoles() *OrgRolesQuery {
+	return NewRolesClient(r.config).QueryOrgRoles(r)
+}
+
 // Update returns a builder for updating this Roles.
 // Note that you need to call Roles.Unwrap() before calling this method if this Roles
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -217,6 +354,17 @@ func (r *Roles) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("org_assignable=")
+	builder.WriteString(fmt.Sprintf("%v", r.OrgAssignable))
+	builder.WriteString(", ")
+	builder.WriteString("break_glass=")
+	builder.WriteString(fmt.Sprintf("%v", r.BreakGlass))
+	builder.WriteString(", ")
+	if v := r.BreakGlassMaxHours; v != nil {
+		builder.WriteString("break_glass_max_hours=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
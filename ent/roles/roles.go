@@ -3,6 +3,7 @@
 package roles
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -26,6 +27,12 @@ const (
 	FieldIsDefault = "is_default"
 	// FieldMaxUsers holds the string denoting the max_users field in the database.
 	FieldMaxUsers = "max_users"
+	// FieldOrgAssignable holds the string denoting the org_assignable field in the database.
+	FieldOrgAssignable = "org_assignable"
+	// FieldBreakGlass holds the string denoting the break_glass field in the database.
+	FieldBreakGlass = "break_glass"
+	// FieldBreakGlassMaxHours holds the string denoting the break_glass_max_hours field in the database.
+	FieldBreakGlassMaxHours = "break_glass_max_hours"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -34,6 +41,20 @@ const (
 	EdgeUserRoles = "user_roles"
 	// EdgeRolePermissions holds the string denoting the role_permissions edge name in mutations.
 	EdgeRolePermissions = "role_permissions"
+	// EdgeParentLinks holds the string denoting the parent_links edge name in mutations.
+	EdgeParentLinks = "parent_links"
+	// EdgeChildLinks holds the string denoting the child_links edge name in mutations.
+	EdgeChildLinks = "child_links"
+	// EdgeApproverLinks holds the string denoting the approver_links edge name in mutations.
+	EdgeApproverLinks = "approver_links"
+	// EdgeApprovesLinks holds the string denoting the approves_links edge name in mutations.
+	EdgeApprovesLinks = "approves_links"
+	// EdgeSodConstraintRoles holds the string denoting the sod_constraint_roles edge name in mutations.
+	EdgeSodConstraintRoles = "sod_constraint_roles"
+	// EdgeGroupRoles holds the string denoting the group_roles edge name in mutations.
+	EdgeGroupRoles = "group_roles"
+	// EdgeOrgRoles holds the string denoting the org_roles edge name in mutations.
+	EdgeOrgRoles = "org_roles"
 	// Table holds the table name of the roles in the database.
 	Table = "roles"
 	// UserRolesTable is the table that holds the user_roles relation/edge.
@@ -50,6 +71,55 @@ const (
 	RolePermissionsInverseTable = "role_permissions"
 	// RolePermissionsColumn is the table column denoting the role_permissions relation/edge.
 	RolePermissionsColumn = "role_id"
+	// ParentLinksTable is the table that holds the parent_links relation/edge.
+	ParentLinksTable = "role_parents"
+	// ParentLinksInverseTable is the table name for the RoleParents entity.
+	// It exists in this package in order to avoid circular dependency with the "roleparents" package.
+	ParentLinksInverseTable = "role_parents"
+	// ParentLinksColumn is the table column denoting the parent_links relation/edge.
+	ParentLinksColumn = "role_id"
+	// ChildLinksTable is the table that holds the child_links relation/edge.
+	ChildLinksTable = "role_parents"
+	// ChildLinksInverseTable is the table name for the RoleParents entity.
+	// It exists in this package in order to avoid circular dependency with the "roleparents" package.
+	ChildLinksInverseTable = "role_parents"
+	// ChildLinksColumn is the table column denoting the child_links relation/edge.
+	ChildLinksColumn = "parent_role_id"
+	// ApproverLinksTable is the table that holds the approver_links relation/edge.
+	ApproverLinksTable = "role_approvers"
+	// ApproverLinksInverseTable is the table name for the RoleApprovers entity.
+	// It exists in this package in order to avoid circular dependency with the "roleapprovers" package.
+	ApproverLinksInverseTable = "role_approvers"
+	// ApproverLinksColumn is the table column denoting the approver_links relation/edge.
+	ApproverLinksColumn = "role_id"
+	// ApprovesLinksTable is the table that holds the approves_links relation/edge.
+	ApprovesLinksTable = "role_approvers"
+	// ApprovesLinksInverseTable is the table name for the RoleApprovers entity.
+	// It exists in this package in order to avoid circular dependency with the "roleapprovers" package.
+	ApprovesLinksInverseTable = "role_approvers"
+	// ApprovesLinksColumn is the table column denoting the approves_links relation/edge.
+	ApprovesLinksColumn = "approver_role_id"
+	// SodConstraintRolesTable is the table that holds the sod_constraint_roles relation/edge.
+	SodConstraintRolesTable = "sod_constraint_roles"
+	// SodConstraintRolesInverseTable is the table name for the SodConstraintRoles entity.
+	// It exists in this package in order to avoid circular dependency with the "sodconstraintroles" package.
+	SodConstraintRolesInverseTable = "sod_constraint_roles"
+	// SodConstraintRolesColumn is the table column denoting the sod_constraint_roles relation/edge.
+	SodConstraintRolesColumn = "role_id"
+	// GroupRolesTable is the table that holds the group_roles relation/edge.
+	GroupRolesTable = "group_roles"
+	// GroupRolesInverseTable is the table name for the GroupRoles entity.
+	// It exists in this package in order to avoid circular dependency with the "grouproles" package.
+	GroupRolesInverseTable = "group_roles"
+	// GroupRolesColumn is the table column denoting the group_roles relation/edge.
+	GroupRolesColumn = "role_id"
+	// OrgRolesTable is the table that holds the org_roles relation/edge.
+	OrgRolesTable = "org_roles"
+	// OrgRolesInverseTable is the table name for the OrgRoles entity.
+	// It exists in this package in order to avoid circular dependency with the "orgroles" package.
+	OrgRolesInverseTable = "org_roles"
+	// OrgRolesColumn is the table column denoting the org_roles relation/edge.
+	OrgRolesColumn = "role_id"
 )
 
 // Columns holds all SQL columns for roles fields.
@@ -61,6 +131,9 @@ var Columns = []string{
 	FieldIsSystem,
 	FieldIsDefault,
 	FieldMaxUsers,
+	FieldOrgAssignable,
+	FieldBreakGlass,
+	FieldBreakGlassMaxHours,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -84,6 +157,8 @@ var (
 	DefaultIsSystem bool
 	// DefaultIsDefault holds the default value on creation for the "is_default" field.
 	DefaultIsDefault bool
+	// DefaultOrgAssignable holds the default value on creation for the "org_assignable" field.
+	DefaultOrgAssignable bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -92,6 +167,33 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 )
 
+// BreakGlass defines the type for the "break_glass" enum field.
+type BreakGlass string
+
+// BreakGlassDisabled is the default value of the BreakGlass enum.
+const DefaultBreakGlass = BreakGlassDisabled
+
+// BreakGlass values.
+const (
+	BreakGlassDisabled BreakGlass = "disabled"
+	BreakGlassAuto     BreakGlass = "auto"
+	BreakGlassApproval BreakGlass = "approval"
+)
+
+func (bg BreakGlass) String() string {
+	return string(bg)
+}
+
+// BreakGlassValidator is a validator for the "break_glass" field enum values. It is called by the builders before save.
+func BreakGlassValidator(bg BreakGlass) error {
+	switch bg {
+	case BreakGlassDisabled, BreakGlassAuto, BreakGlassApproval:
+		return nil
+	default:
+		return fmt.Errorf("roles: invalid enum value for break_glass field: %q", bg)
+	}
+}
+
 // OrderOption defines the ordering options for the Roles queries.
 type OrderOption func(*sql.Selector)
 
@@ -130,6 +232,21 @@ func ByMaxUsers(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxUsers, opts...).ToFunc()
 }
 
+// ByOrgAssignable orders the results by the org_assignable field.
+func ByOrgAssignable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgAssignable, opts...).ToFunc()
+}
+
+// ByBreakGlass orders the results by the break_glass field.
+func ByBreakGlass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreakGlass, opts...).ToFunc()
+}
+
+// ByBreakGlassMaxHours orders the results by the break_glass_max_hours field.
+func ByBreakGlassMaxHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreakGlassMaxHours, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -167,6 +284,104 @@ func ByRolePermissions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRolePermissionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByParentLinksCount orders the results by parent_links count.
+func ByParentLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newParentLinksStep(), opts...)
+	}
+}
+
+// ByParentLinks orders the results by parent_links terms.
+func ByParentLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChildLinksCount orders the results by child_links count.
+func ByChildLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildLinksStep(), opts...)
+	}
+}
+
+// ByChildLinks orders the results by child_links terms.
+func ByChildLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByApproverLinksCount orders the results by approver_links count.
+func ByApproverLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newApproverLinksStep(), opts...)
+	}
+}
+
+// ByApproverLinks orders the results by approver_links terms.
+func ByApproverLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newApproverLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByApprovesLinksCount orders the results by approves_links count.
+func ByApprovesLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newApprovesLinksStep(), opts...)
+	}
+}
+
+// ByApprovesLinks orders the results by approves_links terms.
+func ByApprovesLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newApprovesLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySodConstraintRolesCount orders the results by sod_constraint_roles count.
+func BySodConstraintRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSodConstraintRolesStep(), opts...)
+	}
+}
+
+// BySodConstraintRoles orders the results by sod_constraint_roles terms.
+func BySodConstraintRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSodConstraintRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupRolesCount orders the results by group_roles count.
+func ByGroupRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupRolesStep(), opts...)
+	}
+}
+
+// ByGroupRoles orders the results by group_roles terms.
+func ByGroupRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOrgRolesCount orders the results by org_roles count.
+func ByOrgRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrgRolesStep(), opts...)
+	}
+}
+
+// ByOrgRoles orders the results by org_roles terms.
+func ByOrgRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrgRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserRolesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -181,3 +396,52 @@ func newRolePermissionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, RolePermissionsTable, RolePermissionsColumn),
 	)
 }
+func newParentLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ParentLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ParentLinksTable, ParentLinksColumn),
+	)
+}
+func newChildLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChildLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ChildLinksTable, ChildLinksColumn),
+	)
+}
+func newApproverLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ApproverLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ApproverLinksTable, ApproverLinksColumn),
+	)
+}
+func newApprovesLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ApprovesLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ApprovesLinksTable, ApprovesLinksColumn),
+	)
+}
+func newSodConstraintRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SodConstraintRolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SodConstraintRolesTable, SodConstraintRolesColumn),
+	)
+}
+func newGroupRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupRolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, GroupRolesTable, GroupRolesColumn),
+	)
+}
+func newOrgRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrgRolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, OrgRolesTable, OrgRolesColumn),
+	)
+}
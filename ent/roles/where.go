@@ -85,6 +85,16 @@ func MaxUsers(v int) predicate.Roles {
 	return predicate.Roles(sql.FieldEQ(FieldMaxUsers, v))
 }
 
+// OrgAssignable applies equality check predicate on the "org_assignable" field. It's identical to OrgAssignableEQ.
+func OrgAssignable(v bool) predicate.Roles {
+	return predicate.Roles(sql.FieldEQ(FieldOrgAssignable, v))
+}
+
+// BreakGlassMaxHours applies equality check predicate on the "break_glass_max_hours" field. It's identical to BreakGlassMaxHoursEQ.
+func BreakGlassMaxHours(v int) predicate.Roles {
+	return predicate.Roles(sql.FieldEQ(FieldBreakGlassMaxHours, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Roles {
 	return predicate.Roles(sql.FieldEQ(FieldCreatedAt, v))
@@ -370,6 +380,86 @@ func MaxUsersNotNil() predicate.Roles {
 	return predicate.Roles(sql.FieldNotNull(FieldMaxUsers))
 }
 
+// OrgAssignableEQ applies the EQ predicate on the "org_assignable" field.
+func OrgAssignableEQ(v bool) predicate.Roles {
+	return predicate.Roles(sql.FieldEQ(FieldOrgAssignable, v))
+}
+
+// OrgAssignableNEQ applies the NEQ predicate on the "org_assignable" field.
+func OrgAssignableNEQ(v bool) predicate.Roles {
+	return predicate.Roles(sql.FieldNEQ(FieldOrgAssignable, v))
+}
+
+// BreakGlassEQ applies the EQ predicate on the "break_glass" field.
+func BreakGlassEQ(v BreakGlass) predicate.Roles {
+	return predicate.Roles(sql.FieldEQ(FieldBreakGlass, v))
+}
+
+// BreakGlassNEQ applies the NEQ predicate on the "break_glass" field.
+func BreakGlassNEQ(v BreakGlass) predicate.Roles {
+	return predicate.Roles(sql.FieldNEQ(FieldBreakGlass, v))
+}
+
+// BreakGlassIn applies the In predicate on the "break_glass" field.
+func BreakGlassIn(vs ...BreakGlass) predicate.Roles {
+	return predicate.Roles(sql.FieldIn(FieldBreakGlass, vs...))
+}
+
+// BreakGlassNotIn applies the NotIn predicate on the "break_glass" field.
+func BreakGlassNotIn(vs ...BreakGlass) predicate.Roles {
+	return predicate.Roles(sql.FieldNotIn(FieldBreakGlass, vs...))
+}
+
+// BreakGlassMaxHoursEQ applies the EQ predicate on the "break_glass_max_hours" field.
+func BreakGlassMaxHoursEQ(v int) predicate.Roles {
+	return predicate.Roles(sql.FieldEQ(FieldBreakGlassMaxHours, v))
+}
+
+// BreakGlassMaxHoursNEQ applies the NEQ predicate on the "break_glass_max_hours" field.
+func BreakGlassMaxHoursNEQ(v int) predicate.Roles {
+	return predicate.Roles(sql.FieldNEQ(FieldBreakGlassMaxHours, v))
+}
+
+// BreakGlassMaxHoursIn applies the In predicate on the "break_glass_max_hours" field.
+func BreakGlassMaxHoursIn(vs ...int) predicate.Roles {
+	return predicate.Roles(sql.FieldIn(FieldBreakGlassMaxHours, vs...))
+}
+
+// BreakGlassMaxHoursNotIn applies the NotIn predicate on the "break_glass_max_hours" field.
+func BreakGlassMaxHoursNotIn(vs ...int) predicate.Roles {
+	return predicate.Roles(sql.FieldNotIn(FieldBreakGlassMaxHours, vs...))
+}
+
+// BreakGlassMaxHoursGT applies the GT predicate on the "break_glass_max_hours" field.
+func BreakGlassMaxHoursGT(v int) predicate.Roles {
+	return predicate.Roles(sql.FieldGT(FieldBreakGlassMaxHours, v))
+}
+
+// BreakGlassMaxHoursGTE applies the GTE predicate on the "break_glass_max_hours" field.
+func BreakGlassMaxHoursGTE(v int) predicate.Roles {
+	return predicate.Roles(sql.FieldGTE(FieldBreakGlassMaxHours, v))
+}
+
+// BreakGlassMaxHoursLT applies the LT predicate on the "break_glass_max_hours" field.
+func BreakGlassMaxHoursLT(v int) predicate.Roles {
+	return predicate.Roles(sql.FieldLT(FieldBreakGlassMaxHours, v))
+}
+
+// BreakGlassMaxHoursLTE applies the LTE predicate on the "break_glass_max_hours" field.
+func BreakGlassMaxHoursLTE(v int) predicate.Roles {
+	return predicate.Roles(sql.FieldLTE(FieldBreakGlassMaxHours, v))
+}
+
+// BreakGlassMaxHoursIsNil applies the IsNil predicate on the "break_glass_max_hours" field.
+func BreakGlassMaxHoursIsNil() predicate.Roles {
+	return predicate.Roles(sql.FieldIsNull(FieldBreakGlassMaxHours))
+}
+
+// BreakGlassMaxHoursNotNil applies the NotNil predicate on the "break_glass_max_hours" field.
+func BreakGlassMaxHoursNotNil() predicate.Roles {
+	return predicate.Roles(sql.FieldNotNull(FieldBreakGlassMaxHours))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Roles {
 	return predicate.Roles(sql.FieldEQ(FieldCreatedAt, v))
@@ -496,6 +586,167 @@ func HasRolePermissionsWith(preds ...predicate.RolePermissions) predicate.Roles
 	})
 }
 
+// HasParentLinks applies the HasEdge predicate on the "parent_links" edge.
+func HasParentLinks() predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ParentLinksTable, ParentLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentLinksWith applies the HasEdge predicate on the "parent_links" edge with a given conditions (other predicates).
+func HasParentLinksWith(preds ...predicate.RoleParents) predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := newParentLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildLinks applies the HasEdge predicate on the "child_links" edge.
+func HasChildLinks() predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ChildLinksTable, ChildLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildLinksWith applies the HasEdge predicate on the "child_links" edge with a given conditions (other predicates).
+func HasChildLinksWith(preds ...predicate.RoleParents) predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := newChildLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasApproverLinks applies the HasEdge predicate on the "approver_links" edge.
+func HasApproverLinks() predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ApproverLinksTable, ApproverLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasApproverLinksWith applies the HasEdge predicate on the "approver_links" edge with a given conditions (other predicates).
+func HasApproverLinksWith(preds ...predicate.RoleApprovers) predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := newApproverLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasApprovesLinks applies the HasEdge predicate on the "approves_links" edge.
+func HasApprovesLinks() predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ApprovesLinksTable, ApprovesLinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasApprovesLinksWith applies the HasEdge predicate on the "approves_links" edge with a given conditions (other predicates).
+func HasApprovesLinksWith(preds ...predicate.RoleApprovers) predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := newApprovesLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSodConstraintRoles applies the HasEdge predicate on the "sod_constraint_roles" edge.
+func HasSodConstraintRoles() predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SodConstraintRolesTable, SodConstraintRolesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSodConstraintRolesWith applies the HasEdge predicate on the "sod_constraint_roles" edge with a given conditions (other predicates).
+func HasSodConstraintRolesWith(preds ...predicate.SodConstraintRoles) predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := newSodConstraintRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroupRoles applies the HasEdge predicate on the "group_roles" edge.
+func HasGroupRoles() predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, GroupRolesTable, GroupRolesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupRolesWith applies the HasEdge predicate on the "group_roles" edge with a given conditions (other predicates).
+func HasGroupRolesWith(preds ...predicate.GroupRoles) predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := newGroupRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrgRoles applies the HasEdge predicate on the "org_roles" edge.
+func HasOrgRoles() predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, OrgRolesTable, OrgRolesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrgRolesWith applies the HasEdge predicate on the "org_roles" edge with a given conditions (other predicates).
+func HasOrgRolesWith(preds ...predicate.OrgRoles) predicate.Roles {
+	return predicate.Roles(func(s *sql.Selector) {
+		step := newOrgRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Roles) predicate.Roles {
 	return predicate.Roles(sql.AndPredicates(predicates...))
@@ -8,11 +8,17 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roleparents"
 	"github.com/shammianand/go-auth/ent/rolepermissions"
 	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
 	"github.com/shammianand/go-auth/ent/userroles"
 )
 
@@ -21,6 +27,7 @@ type RolesCreate struct {
 	config
 	mutation *RolesMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCode sets the "code" field.
@@ -91,6 +98,48 @@ func (rc *RolesCreate) SetNillableMaxUsers(i *int) *RolesCreate {
 	return rc
 }
 
+// SetOrgAssignable sets the "org_assignable" field.
+func (rc *RolesCreate) SetOrgAssignable(b bool) *RolesCreate {
+	rc.mutation.SetOrgAssignable(b)
+	return rc
+}
+
+// SetNillableOrgAssignable sets the "org_assignable" field if the given value is not nil.
+func (rc *RolesCreate) SetNillableOrgAssignable(b *bool) *RolesCreate {
+	if b != nil {
+		rc.SetOrgAssignable(*b)
+	}
+	return rc
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (rc *RolesCreate) SetBreakGlass(rg roles.BreakGlass) *RolesCreate {
+	rc.mutation.SetBreakGlass(rg)
+	return rc
+}
+
+// SetNillableBreakGlass sets the "break_glass" field if the given value is not nil.
+func (rc *RolesCreate) SetNillableBreakGlass(rg *roles.BreakGlass) *RolesCreate {
+	if rg != nil {
+		rc.SetBreakGlass(*rg)
+	}
+	return rc
+}
+
+// SetBreakGlassMaxHours sets the "break_glass_max_hours" field.
+func (rc *RolesCreate) SetBreakGlassMaxHours(i int) *RolesCreate {
+	rc.mutation.SetBreakGlassMaxHours(i)
+	return rc
+}
+
+// SetNillableBreakGlassMaxHours sets the "break_glass_max_hours" field if the given value is not nil.
+func (rc *RolesCreate) SetNillableBreakGlassMaxHours(i *int) *RolesCreate {
+	if i != nil {
+		rc.SetBreakGlassMaxHours(*i)
+	}
+	return rc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (rc *RolesCreate) SetCreatedAt(t time.Time) *RolesCreate {
 	rc.mutation.SetCreatedAt(t)
@@ -155,6 +204,111 @@ func (rc *RolesCreate) AddRolePermissions(r ...*RolePermissions) *RolesCreate {
 	return rc.AddRolePermissionIDs(ids...)
 }
 
+// AddParentLinkIDs adds the "parent_links" edge to the RoleParents entity by IDs.
+func (rc *RolesCreate) AddParentLinkIDs(ids ...int) *RolesCreate {
+	rc.mutation.AddParentLinkIDs(ids...)
+	return rc
+}
+
+// AddParentLinks adds the "parent_links" edges to the RoleParents entity.
+func (rc *RolesCreate) AddParentLinks(r ...*RoleParents) *RolesCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddParentLinkIDs(ids...)
+}
+
+// AddChildLinkIDs adds the "child_links" edge to the RoleParents entity by IDs.
+func (rc *RolesCreate) AddChildLinkIDs(ids ...int) *RolesCreate {
+	rc.mutation.AddChildLinkIDs(ids...)
+	return rc
+}
+
+// AddChildLinks adds the "child_links" edges to the RoleParents entity.
+func (rc *RolesCreate) AddChildLinks(r ...*RoleParents) *RolesCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddChildLinkIDs(ids...)
+}
+
+// AddApproverLinkIDs adds the "approver_links" edge to the RoleApprovers entity by IDs.
+func (rc *RolesCreate) AddApproverLinkIDs(ids ...int) *RolesCreate {
+	rc.mutation.AddApproverLinkIDs(ids...)
+	return rc
+}
+
+// AddApproverLinks adds the "approver_links" edges to the RoleApprovers entity.
+func (rc *RolesCreate) AddApproverLinks(r ...*RoleApprovers) *RolesCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddApproverLinkIDs(ids...)
+}
+
+// AddApprovesLinkIDs adds the "approves_links" edge to the RoleApprovers entity by IDs.
+func (rc *RolesCreate) AddApprovesLinkIDs(ids ...int) *RolesCreate {
+	rc.mutation.AddApprovesLinkIDs(ids...)
+	return rc
+}
+
+// AddApprovesLinks adds the "approves_links" edges to the RoleApprovers entity.
+func (rc *RolesCreate) AddApprovesLinks(r ...*RoleApprovers) *RolesCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddApprovesLinkIDs(ids...)
+}
+
+// AddSodConstraintRoleIDs adds the "sod_constraint_roles" edge to the SodConstraintRoles entity by IDs.
+func (rc *RolesCreate) AddSodConstraintRoleIDs(ids ...int) *RolesCreate {
+	rc.mutation.AddSodConstraintRoleIDs(ids...)
+	return rc
+}
+
+// AddSodConstraintRoles adds the "sod_constraint_roles" edges to the SodConstraintRoles entity.
+func (rc *RolesCreate) AddSodConstraintRoles(s ...*SodConstraintRoles) *RolesCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rc.AddSodConstraintRoleIDs(ids...)
+}
+
+// AddGroupRoleIDs adds the "group_roles" edge to the GroupRoles entity by IDs.
+func (rc *RolesCreate) AddGroupRoleIDs(ids ...int) *RolesCreate {
+	rc.mutation.AddGroupRoleIDs(ids...)
+	return rc
+}
+
+// AddGroupRoles adds the "group_roles" edges to the GroupRoles entity.
+func (rc *RolesCreate) AddGroupRoles(g ...*GroupRoles) *RolesCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return rc.AddGroupRoleIDs(ids...)
+}
+
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by IDs.
+func (rc *RolesCreate) AddOrgRoleIDs(ids ...int) *RolesCreate {
+	rc.mutation.AddOrgRoleIDs(ids...)
+	return rc
+}
+
+// AddOrgRoles adds the "org_roles" edges to the OrgRoles entity.
+func (rc *RolesCreate) AddOrgRoles(o ...*OrgRoles) *RolesCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return rc.AddOrgRoleIDs(ids...)
+}
+
 // Mutation returns the RolesMutation object of the builder.
 func (rc *RolesCreate) Mutation() *RolesMutation {
 	return rc.mutation
@@ -198,6 +352,14 @@ func (rc *RolesCreate) defaults() {
 		v := roles.DefaultIsDefault
 		rc.mutation.SetIsDefault(v)
 	}
+	if _, ok := rc.mutation.OrgAssignable(); !ok {
+		v := roles.DefaultOrgAssignable
+		rc.mutation.SetOrgAssignable(v)
+	}
+	if _, ok := rc.mutation.BreakGlass(); !ok {
+		v := roles.DefaultBreakGlass
+		rc.mutation.SetBreakGlass(v)
+	}
 	if _, ok := rc.mutation.CreatedAt(); !ok {
 		v := roles.DefaultCreatedAt()
 		rc.mutation.SetCreatedAt(v)
@@ -232,6 +394,17 @@ func (rc *RolesCreate) check() error {
 	if _, ok := rc.mutation.IsDefault(); !ok {
 		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "Roles.is_default"`)}
 	}
+	if _, ok := rc.mutation.OrgAssignable(); !ok {
+		return &ValidationError{Name: "org_assignable", err: errors.New(`ent: missing required field "Roles.org_assignable"`)}
+	}
+	if _, ok := rc.mutation.BreakGlass(); !ok {
+		return &ValidationError{Name: "break_glass", err: errors.New(`ent: missing required field "Roles.break_glass"`)}
+	}
+	if v, ok := rc.mutation.BreakGlass(); ok {
+		if err := roles.BreakGlassValidator(v); err != nil {
+			return &ValidationError{Name: "break_glass", err: fmt.Errorf(`ent: validator failed for field "Roles.break_glass": %w`, err)}
+		}
+	}
 	if _, ok := rc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Roles.created_at"`)}
 	}
@@ -266,6 +439,7 @@ func (rc *RolesCreate) createSpec() (*Roles, *sqlgraph.CreateSpec) {
 		_node = &Roles{config: rc.config}
 		_spec = sqlgraph.NewCreateSpec(roles.Table, sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = rc.conflict
 	if id, ok := rc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
@@ -294,6 +468,18 @@ func (rc *RolesCreate) createSpec() (*Roles, *sqlgraph.CreateSpec) {
 		_spec.SetField(roles.FieldMaxUsers, field.TypeInt, value)
 		_node.MaxUsers = &value
 	}
+	if value, ok := rc.mutation.OrgAssignable(); ok {
+		_spec.SetField(roles.FieldOrgAssignable, field.TypeBool, value)
+		_node.OrgAssignable = value
+	}
+	if value, ok := rc.mutation.BreakGlass(); ok {
+		_spec.SetField(roles.FieldBreakGlass, field.TypeEnum, value)
+		_node.BreakGlass = value
+	}
+	if value, ok := rc.mutation.BreakGlassMaxHours(); ok {
+		_spec.SetField(roles.FieldBreakGlassMaxHours, field.TypeInt, value)
+		_node.BreakGlassMaxHours = &value
+	}
 	if value, ok := rc.mutation.CreatedAt(); ok {
 		_spec.SetField(roles.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -334,14 +520,585 @@ func (rc *RolesCreate) createSpec() (*Roles, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.ParentLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ParentLinksTable,
+			Columns: []string{roles.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.ChildLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ChildLinksTable,
+			Columns: []string{roles.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.ApproverLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApproverLinksTable,
+			Columns: []string{roles.ApproverLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.ApprovesLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApprovesLinksTable,
+			Columns: []string{roles.ApprovesLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.SodConstraintRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.SodConstraintRolesTable,
+			Columns: []string{roles.SodConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.GroupRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.GroupRolesTable,
+			Columns: []string{roles.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.OrgRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.OrgRolesTable,
+			Columns: []string{roles.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Roles.Create().
+//		SetCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RolesUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *RolesCreate) OnConflict(opts ...sql.ConflictOption) *RolesUpsertOne {
+	rc.conflict = opts
+	return &RolesUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Roles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *RolesCreate) OnConflictColumns(columns ...string) *RolesUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &RolesUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// RolesUpsertOne is the builder for "upsert"-ing
+	//  one Roles node.
+	RolesUpsertOne struct {
+		create *RolesCreate
+	}
+
+	// RolesUpsert is the "OnConflict" setter.
+	RolesUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCode sets the "code" field.
+func (u *RolesUpsert) SetCode(v string) *RolesUpsert {
+	u.Set(roles.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *RolesUpsert) UpdateCode() *RolesUpsert {
+	u.SetExcluded(roles.FieldCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *RolesUpsert) SetName(v string) *RolesUpsert {
+	u.Set(roles.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RolesUpsert) UpdateName() *RolesUpsert {
+	u.SetExcluded(roles.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *RolesUpsert) SetDescription(v string) *RolesUpsert {
+	u.Set(roles.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RolesUpsert) UpdateDescription() *RolesUpsert {
+	u.SetExcluded(roles.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RolesUpsert) ClearDescription() *RolesUpsert {
+	u.SetNull(roles.FieldDescription)
+	return u
+}
+
+// SetIsSystem sets the "is_system" field.
+func (u *RolesUpsert) SetIsSystem(v bool) *RolesUpsert {
+	u.Set(roles.FieldIsSystem, v)
+	return u
+}
+
+// UpdateIsSystem sets the "is_system" field to the value that was provided on create.
+func (u *RolesUpsert) UpdateIsSystem() *RolesUpsert {
+	u.SetExcluded(roles.FieldIsSystem)
+	return u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *RolesUpsert) SetIsDefault(v bool) *RolesUpsert {
+	u.Set(roles.FieldIsDefault, v)
+	return u
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *RolesUpsert) UpdateIsDefault() *RolesUpsert {
+	u.SetExcluded(roles.FieldIsDefault)
+	return u
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *RolesUpsert) SetMaxUsers(v int) *RolesUpsert {
+	u.Set(roles.FieldMaxUsers, v)
+	return u
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *RolesUpsert) UpdateMaxUsers() *RolesUpsert {
+	u.SetExcluded(roles.FieldMaxUsers)
+	return u
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *RolesUpsert) AddMaxUsers(v int) *RolesUpsert {
+	u.Add(roles.FieldMaxUsers, v)
+	return u
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (u *RolesUpsert) ClearMaxUsers() *RolesUpsert {
+	u.SetNull(roles.FieldMaxUsers)
+	return u
+}
+
+// SetOrgAssignable sets the "org_assignable" field.
+func (u *RolesUpsert) SetOrgAssignable(v bool) *RolesUpsert {
+	u.Set(roles.FieldOrgAssignable, v)
+	return u
+}
+
+// UpdateOrgAssignable sets the "org_assignable" field to the value that was provided on create.
+func (u *RolesUpsert) UpdateOrgAssignable() *RolesUpsert {
+	u.SetExcluded(roles.FieldOrgAssignable)
+	return u
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (u *RolesUpsert) SetBreakGlass(v roles.BreakGlass) *RolesUpsert {
+	u.Set(roles.FieldBreakGlass, v)
+	return u
+}
+
+// UpdateBreakGlass sets the "break_glass" field to the value that was provided on create.
+func (u *RolesUpsert) UpdateBreakGlass() *RolesUpsert {
+	u.SetExcluded(roles.FieldBreakGlass)
+	return u
+}
+
+// SetBreakGlassMaxHours sets the "break_glass_max_hours" field.
+func (u *RolesUpsert) SetBreakGlassMaxHours(v int) *RolesUpsert {
+	u.Set(roles.FieldBreakGlassMaxHours, v)
+	return u
+}
+
+// UpdateBreakGlassMaxHours sets the "break_glass_max_hours" field to the value that was provided on create.
+func (u *RolesUpsert) UpdateBreakGlassMaxHours() *RolesUpsert {
+	u.SetExcluded(roles.FieldBreakGlassMaxHours)
+	return u
+}
+
+// AddBreakGlassMaxHours adds v to the "break_glass_max_hours" field.
+func (u *RolesUpsert) AddBreakGlassMaxHours(v int) *RolesUpsert {
+	u.Add(roles.FieldBreakGlassMaxHours, v)
+	return u
+}
+
+// ClearBreakGlassMaxHours clears the value of the "break_glass_max_hours" field.
+func (u *RolesUpsert) ClearBreakGlassMaxHours() *RolesUpsert {
+	u.SetNull(roles.FieldBreakGlassMaxHours)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RolesUpsert) SetUpdatedAt(v time.Time) *RolesUpsert {
+	u.Set(roles.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RolesUpsert) UpdateUpdatedAt() *RolesUpsert {
+	u.SetExcluded(roles.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Roles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(roles.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RolesUpsertOne) UpdateNewValues() *RolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(roles.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(roles.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Roles.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RolesUpsertOne) Ignore() *RolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RolesUpsertOne) DoNothing() *RolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RolesCreate.OnConflict
+// documentation for more info.
+func (u *RolesUpsertOne) Update(set func(*RolesUpsert)) *RolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RolesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *RolesUpsertOne) SetCode(v string) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *RolesUpsertOne) UpdateCode() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RolesUpsertOne) SetName(v string) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RolesUpsertOne) UpdateName() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RolesUpsertOne) SetDescription(v string) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RolesUpsertOne) UpdateDescription() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RolesUpsertOne) ClearDescription() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetIsSystem sets the "is_system" field.
+func (u *RolesUpsertOne) SetIsSystem(v bool) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetIsSystem(v)
+	})
+}
+
+// UpdateIsSystem sets the "is_system" field to the value that was provided on create.
+func (u *RolesUpsertOne) UpdateIsSystem() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateIsSystem()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *RolesUpsertOne) SetIsDefault(v bool) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *RolesUpsertOne) UpdateIsDefault() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *RolesUpsertOne) SetMaxUsers(v int) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetMaxUsers(v)
+	})
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *RolesUpsertOne) AddMaxUsers(v int) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.AddMaxUsers(v)
+	})
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *RolesUpsertOne) UpdateMaxUsers() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateMaxUsers()
+	})
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (u *RolesUpsertOne) ClearMaxUsers() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.ClearMaxUsers()
+	})
+}
+
+// SetOrgAssignable sets the "org_assignable" field.
+func (u *RolesUpsertOne) SetOrgAssignable(v bool) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetOrgAssignable(v)
+	})
+}
+
+// UpdateOrgAssignable sets the "org_assignable" field to the value that was provided on create.
+func (u *RolesUpsertOne) UpdateOrgAssignable() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateOrgAssignable()
+	})
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (u *RolesUpsertOne) SetBreakGlass(v roles.BreakGlass) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetBreakGlass(v)
+	})
+}
+
+// UpdateBreakGlass sets the "break_glass" field to the value that was provided on create.
+func (u *RolesUpsertOne) UpdateBreakGlass() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateBreakGlass()
+	})
+}
+
+// SetBreakGlassMaxHours sets the "break_glass_max_hours" field.
+func (u *RolesUpsertOne) SetBreakGlassMaxHours(v int) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetBreakGlassMaxHours(v)
+	})
+}
+
+// AddBreakGlassMaxHours adds v to the "break_glass_max_hours" field.
+func (u *RolesUpsertOne) AddBreakGlassMaxHours(v int) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.AddBreakGlassMaxHours(v)
+	})
+}
+
+// UpdateBreakGlassMaxHours sets the "break_glass_max_hours" field to the value that was provided on create.
+func (u *RolesUpsertOne) UpdateBreakGlassMaxHours() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateBreakGlassMaxHours()
+	})
+}
+
+// ClearBreakGlassMaxHours clears the value of the "break_glass_max_hours" field.
+func (u *RolesUpsertOne) ClearBreakGlassMaxHours() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.ClearBreakGlassMaxHours()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RolesUpsertOne) SetUpdatedAt(v time.Time) *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RolesUpsertOne) UpdateUpdatedAt() *RolesUpsertOne {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RolesUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RolesCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RolesUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RolesUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RolesUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // RolesCreateBulk is the builder for creating many Roles entities in bulk.
 type RolesCreateBulk struct {
 	config
 	err      error
 	builders []*RolesCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Roles entities in the database.
@@ -371,6 +1128,7 @@ func (rcb *RolesCreateBulk) Save(ctx context.Context) ([]*Roles, error) {
 					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -424,3 +1182,295 @@ func (rcb *RolesCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Roles.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RolesUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *RolesCreateBulk) OnConflict(opts ...sql.ConflictOption) *RolesUpsertBulk {
+	rcb.conflict = opts
+	return &RolesUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Roles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *RolesCreateBulk) OnConflictColumns(columns ...string) *RolesUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &RolesUpsertBulk{
+		create: rcb,
+	}
+}
+
+// RolesUpsertBulk is the builder for "upsert"-ing
+// a bulk of Roles nodes.
+type RolesUpsertBulk struct {
+	create *RolesCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Roles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(roles.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RolesUpsertBulk) UpdateNewValues() *RolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(roles.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(roles.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Roles.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RolesUpsertBulk) Ignore() *RolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RolesUpsertBulk) DoNothing() *RolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RolesCreateBulk.OnConflict
+// documentation for more info.
+func (u *RolesUpsertBulk) Update(set func(*RolesUpsert)) *RolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RolesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *RolesUpsertBulk) SetCode(v string) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *RolesUpsertBulk) UpdateCode() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RolesUpsertBulk) SetName(v string) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RolesUpsertBulk) UpdateName() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RolesUpsertBulk) SetDescription(v string) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RolesUpsertBulk) UpdateDescription() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RolesUpsertBulk) ClearDescription() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetIsSystem sets the "is_system" field.
+func (u *RolesUpsertBulk) SetIsSystem(v bool) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetIsSystem(v)
+	})
+}
+
+// UpdateIsSystem sets the "is_system" field to the value that was provided on create.
+func (u *RolesUpsertBulk) UpdateIsSystem() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateIsSystem()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *RolesUpsertBulk) SetIsDefault(v bool) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *RolesUpsertBulk) UpdateIsDefault() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *RolesUpsertBulk) SetMaxUsers(v int) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetMaxUsers(v)
+	})
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *RolesUpsertBulk) AddMaxUsers(v int) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.AddMaxUsers(v)
+	})
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *RolesUpsertBulk) UpdateMaxUsers() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateMaxUsers()
+	})
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (u *RolesUpsertBulk) ClearMaxUsers() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.ClearMaxUsers()
+	})
+}
+
+// SetOrgAssignable sets the "org_assignable" field.
+func (u *RolesUpsertBulk) SetOrgAssignable(v bool) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetOrgAssignable(v)
+	})
+}
+
+// UpdateOrgAssignable sets the "org_assignable" field to the value that was provided on create.
+func (u *RolesUpsertBulk) UpdateOrgAssignable() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateOrgAssignable()
+	})
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (u *RolesUpsertBulk) SetBreakGlass(v roles.BreakGlass) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetBreakGlass(v)
+	})
+}
+
+// UpdateBreakGlass sets the "break_glass" field to the value that was provided on create.
+func (u *RolesUpsertBulk) UpdateBreakGlass() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateBreakGlass()
+	})
+}
+
+// SetBreakGlassMaxHours sets the "break_glass_max_hours" field.
+func (u *RolesUpsertBulk) SetBreakGlassMaxHours(v int) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetBreakGlassMaxHours(v)
+	})
+}
+
+// AddBreakGlassMaxHours adds v to the "break_glass_max_hours" field.
+func (u *RolesUpsertBulk) AddBreakGlassMaxHours(v int) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.AddBreakGlassMaxHours(v)
+	})
+}
+
+// UpdateBreakGlassMaxHours sets the "break_glass_max_hours" field to the value that was provided on create.
+func (u *RolesUpsertBulk) UpdateBreakGlassMaxHours() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateBreakGlassMaxHours()
+	})
+}
+
+// ClearBreakGlassMaxHours clears the value of the "break_glass_max_hours" field.
+func (u *RolesUpsertBulk) ClearBreakGlassMaxHours() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.ClearBreakGlassMaxHours()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RolesUpsertBulk) SetUpdatedAt(v time.Time) *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RolesUpsertBulk) UpdateUpdatedAt() *RolesUpsertBulk {
+	return u.Update(func(s *RolesUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RolesUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RolesCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RolesCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RolesUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
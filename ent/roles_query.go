@@ -8,24 +8,38 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/orgroles"
 	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roleparents"
 	"github.com/shammianand/go-auth/ent/rolepermissions"
 	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
 	"github.com/shammianand/go-auth/ent/userroles"
 )
 
 // RolesQuery is the builder for querying Roles entities.
 type RolesQuery struct {
 	config
-	ctx                 *QueryContext
-	order               []roles.OrderOption
-	inters              []Interceptor
-	predicates          []predicate.Roles
-	withUserRoles       *UserRolesQuery
-	withRolePermissions *RolePermissionsQuery
+	ctx                    *QueryContext
+	order                  []roles.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Roles
+	withUserRoles          *UserRolesQuery
+	withRolePermissions    *RolePermissionsQuery
+	withParentLinks        *RoleParentsQuery
+	withChildLinks         *RoleParentsQuery
+	withApproverLinks      *RoleApproversQuery
+	withApprovesLinks      *RoleApproversQuery
+	withSodConstraintRoles *SodConstraintRolesQuery
+	withGroupRoles         *GroupRolesQuery
+	withOrgRoles           *OrgRolesQuery
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +120,160 @@ func (rq *RolesQuery) QueryRolePermissions() *RolePermissionsQuery {
 	return query
 }
 
+// QueryParentLinks chains the current query on the "parent_links" edge.
+func (rq *RolesQuery) QueryParentLinks() *RoleParentsQuery {
+	query := (&RoleParentsClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, selector),
+			sqlgraph.To(roleparents.Table, roleparents.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.ParentLinksTable, roles.ParentLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildLinks chains the current query on the "child_links" edge.
+func (rq *RolesQuery) QueryChildLinks() *RoleParentsQuery {
+	query := (&RoleParentsClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, selector),
+			sqlgraph.To(roleparents.Table, roleparents.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.ChildLinksTable, roles.ChildLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryApproverLinks chains the current query on the "approver_links" edge.
+func (rq *RolesQuery) QueryApproverLinks() *RoleApproversQuery {
+	query := (&RoleApproversClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, selector),
+			sqlgraph.To(roleapprovers.Table, roleapprovers.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.ApproverLinksTable, roles.ApproverLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryApprovesLinks chains the current query on the "approves_links" edge.
+func (rq *RolesQuery) QueryApprovesLinks() *RoleApproversQuery {
+	query := (&RoleApproversClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, selector),
+			sqlgraph.To(roleapprovers.Table, roleapprovers.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.ApprovesLinksTable, roles.ApprovesLinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySodConstraintRoles chains the current query on the "sod_constraint_roles" edge.
+func (rq *RolesQuery) QuerySodConstraintRoles() *SodConstraintRolesQuery {
+	query := (&SodConstraintRolesClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, selector),
+			sqlgraph.To(sodconstraintroles.Table, sodconstraintroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.SodConstraintRolesTable, roles.SodConstraintRolesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroupRoles chains the current query on the "group_roles" edge.
+func (rq *RolesQuery) QueryGroupRoles() *GroupRolesQuery {
+	query := (&GroupRolesClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, selector),
+			sqlgraph.To(grouproles.Table, grouproles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.GroupRolesTable, roles.GroupRolesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrgRoles chains the current query on the "org_roles" edge.
+func (rq *RolesQuery) QueryOrgRoles() *OrgRolesQuery {
+	query := (&OrgRolesClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(roles.Table, roles.FieldID, selector),
+			sqlgraph.To(orgroles.Table, orgroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, roles.OrgRolesTable, roles.OrgRolesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Roles entity from the query.
 // Returns a *NotFoundError when no Roles was found.
 func (rq *RolesQuery) First(ctx context.Context) (*Roles, error) {
@@ -293,13 +461,20 @@ func (rq *RolesQuery) Clone() *RolesQuery {
 		return nil
 	}
 	return &RolesQuery{
-		config:              rq.config,
-		ctx:                 rq.ctx.Clone(),
-		order:               append([]roles.OrderOption{}, rq.order...),
-		inters:              append([]Interceptor{}, rq.inters...),
-		predicates:          append([]predicate.Roles{}, rq.predicates...),
-		withUserRoles:       rq.withUserRoles.Clone(),
-		withRolePermissions: rq.withRolePermissions.Clone(),
+		config:                 rq.config,
+		ctx:                    rq.ctx.Clone(),
+		order:                  append([]roles.OrderOption{}, rq.order...),
+		inters:                 append([]Interceptor{}, rq.inters...),
+		predicates:             append([]predicate.Roles{}, rq.predicates...),
+		withUserRoles:          rq.withUserRoles.Clone(),
+		withRolePermissions:    rq.withRolePermissions.Clone(),
+		withParentLinks:        rq.withParentLinks.Clone(),
+		withChildLinks:         rq.withChildLinks.Clone(),
+		withApproverLinks:      rq.withApproverLinks.Clone(),
+		withApprovesLinks:      rq.withApprovesLinks.Clone(),
+		withSodConstraintRoles: rq.withSodConstraintRoles.Clone(),
+		withGroupRoles:         rq.withGroupRoles.Clone(),
+		withOrgRoles:           rq.withOrgRoles.Clone(),
 		// clone intermediate query.
 		sql:  rq.sql.Clone(),
 		path: rq.path,
@@ -328,6 +503,83 @@ func (rq *RolesQuery) WithRolePermissions(opts ...func(*RolePermissionsQuery)) *
 	return rq
 }
 
+// WithParentLinks tells the query-builder to eager-load the nodes that are connected to
+// the "parent_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RolesQuery) WithParentLinks(opts ...func(*RoleParentsQuery)) *RolesQuery {
+	query := (&RoleParentsClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withParentLinks = query
+	return rq
+}
+
+// WithChildLinks tells the query-builder to eager-load the nodes that are connected to
+// the "child_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RolesQuery) WithChildLinks(opts ...func(*RoleParentsQuery)) *RolesQuery {
+	query := (&RoleParentsClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withChildLinks = query
+	return rq
+}
+
+// WithApproverLinks tells the query-builder to eager-load the nodes that are connected to
+// the "approver_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RolesQuery) WithApproverLinks(opts ...func(*RoleApproversQuery)) *RolesQuery {
+	query := (&RoleApproversClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withApproverLinks = query
+	return rq
+}
+
+// WithApprovesLinks tells the query-builder to eager-load the nodes that are connected to
+// the "approves_links" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RolesQuery) WithApprovesLinks(opts ...func(*RoleApproversQuery)) *RolesQuery {
+	query := (&RoleApproversClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withApprovesLinks = query
+	return rq
+}
+
+// WithSodConstraintRoles tells the query-builder to eager-load the nodes that are connected to
+// the "sod_constraint_roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RolesQuery) WithSodConstraintRoles(opts ...func(*SodConstraintRolesQuery)) *RolesQuery {
+	query := (&SodConstraintRolesClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withSodConstraintRoles = query
+	return rq
+}
+
+// WithGroupRoles tells the query-builder to eager-load the nodes that are connected to
+// the "group_roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RolesQuery) WithGroupRoles(opts ...func(*GroupRolesQuery)) *RolesQuery {
+	query := (&GroupRolesClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withGroupRoles = query
+	return rq
+}
+
+// WithOrgRoles tells the query-builder to eager-load the nodes that are connected to
+// the "org_roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *RolesQuery) WithOrgRoles(opts ...func(*OrgRolesQuery)) *RolesQuery {
+	query := (&OrgRolesClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withOrgRoles = query
+	return rq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +658,16 @@ func (rq *RolesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Roles,
 	var (
 		nodes       = []*Roles{}
 		_spec       = rq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [9]bool{
 			rq.withUserRoles != nil,
 			rq.withRolePermissions != nil,
+			rq.withParentLinks != nil,
+			rq.withChildLinks != nil,
+			rq.withApproverLinks != nil,
+			rq.withApprovesLinks != nil,
+			rq.withSodConstraintRoles != nil,
+			rq.withGroupRoles != nil,
+			rq.withOrgRoles != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -420,6 +679,9 @@ func (rq *RolesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Roles,
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(rq.modifiers) > 0 {
+		_spec.Modifiers = rq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -443,6 +705,57 @@ func (rq *RolesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Roles,
 			return nil, err
 		}
 	}
+	if query := rq.withParentLinks; query != nil {
+		if err := rq.loadParentLinks(ctx, query, nodes,
+			func(n *Roles) { n.Edges.ParentLinks = []*RoleParents{} },
+			func(n *Roles, e *RoleParents) { n.Edges.ParentLinks = append(n.Edges.ParentLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withChildLinks; query != nil {
+		if err := rq.loadChildLinks(ctx, query, nodes,
+			func(n *Roles) { n.Edges.ChildLinks = []*RoleParents{} },
+			func(n *Roles, e *RoleParents) { n.Edges.ChildLinks = append(n.Edges.ChildLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withApproverLinks; query != nil {
+		if err := rq.loadApproverLinks(ctx, query, nodes,
+			func(n *Roles) { n.Edges.ApproverLinks = []*RoleApprovers{} },
+			func(n *Roles, e *RoleApprovers) { n.Edges.ApproverLinks = append(n.Edges.ApproverLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withApprovesLinks; query != nil {
+		if err := rq.loadApprovesLinks(ctx, query, nodes,
+			func(n *Roles) { n.Edges.ApprovesLinks = []*RoleApprovers{} },
+			func(n *Roles, e *RoleApprovers) { n.Edges.ApprovesLinks = append(n.Edges.ApprovesLinks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withSodConstraintRoles; query != nil {
+		if err := rq.loadSodConstraintRoles(ctx, query, nodes,
+			func(n *Roles) { n.Edges.SodConstraintRoles = []*SodConstraintRoles{} },
+			func(n *Roles, e *SodConstraintRoles) {
+				n.Edges.SodConstraintRoles = append(n.Edges.SodConstraintRoles, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withGroupRoles; query != nil {
+		if err := rq.loadGroupRoles(ctx, query, nodes,
+			func(n *Roles) { n.Edges.GroupRoles = []*GroupRoles{} },
+			func(n *Roles, e *GroupRoles) { n.Edges.GroupRoles = append(n.Edges.GroupRoles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withOrgRoles; query != nil {
+		if err := rq.loadOrgRoles(ctx, query, nodes,
+			func(n *Roles) { n.Edges.OrgRoles = []*OrgRoles{} },
+			func(n *Roles, e *OrgRoles) { n.Edges.OrgRoles = append(n.Edges.OrgRoles, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -506,9 +819,222 @@ func (rq *RolesQuery) loadRolePermissions(ctx context.Context, query *RolePermis
 	}
 	return nil
 }
+func (rq *RolesQuery) loadParentLinks(ctx context.Context, query *RoleParentsQuery, nodes []*Roles, init func(*Roles), assign func(*Roles, *RoleParents)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Roles)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(roleparents.FieldRoleID)
+	}
+	query.Where(predicate.RoleParents(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roles.ParentLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "role_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *RolesQuery) loadChildLinks(ctx context.Context, query *RoleParentsQuery, nodes []*Roles, init func(*Roles), assign func(*Roles, *RoleParents)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Roles)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(roleparents.FieldParentRoleID)
+	}
+	query.Where(predicate.RoleParents(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roles.ChildLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentRoleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_role_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *RolesQuery) loadApproverLinks(ctx context.Context, query *RoleApproversQuery, nodes []*Roles, init func(*Roles), assign func(*Roles, *RoleApprovers)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Roles)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(roleapprovers.FieldRoleID)
+	}
+	query.Where(predicate.RoleApprovers(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roles.ApproverLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "role_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *RolesQuery) loadApprovesLinks(ctx context.Context, query *RoleApproversQuery, nodes []*Roles, init func(*Roles), assign func(*Roles, *RoleApprovers)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Roles)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(roleapprovers.FieldApproverRoleID)
+	}
+	query.Where(predicate.RoleApprovers(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roles.ApprovesLinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ApproverRoleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "approver_role_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *RolesQuery) loadSodConstraintRoles(ctx context.Context, query *SodConstraintRolesQuery, nodes []*Roles, init func(*Roles), assign func(*Roles, *SodConstraintRoles)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Roles)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(sodconstraintroles.FieldRoleID)
+	}
+	query.Where(predicate.SodConstraintRoles(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roles.SodConstraintRolesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "role_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *RolesQuery) loadGroupRoles(ctx context.Context, query *GroupRolesQuery, nodes []*Roles, init func(*Roles), assign func(*Roles, *GroupRoles)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Roles)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(grouproles.FieldRoleID)
+	}
+	query.Where(predicate.GroupRoles(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roles.GroupRolesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "role_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *RolesQuery) loadOrgRoles(ctx context.Context, query *OrgRolesQuery, nodes []*Roles, init func(*Roles), assign func(*Roles, *OrgRoles)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Roles)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orgroles.FieldRoleID)
+	}
+	query.Where(predicate.OrgRoles(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(roles.OrgRolesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RoleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "role_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (rq *RolesQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rq.querySpec()
+	if len(rq.modifiers) > 0 {
+		_spec.Modifiers = rq.modifiers
+	}
 	_spec.Node.Columns = rq.ctx.Fields
 	if len(rq.ctx.Fields) > 0 {
 		_spec.Unique = rq.ctx.Unique != nil && *rq.ctx.Unique
@@ -571,6 +1097,9 @@ func (rq *RolesQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if rq.ctx.Unique != nil && *rq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range rq.modifiers {
+		m(selector)
+	}
 	for _, p := range rq.predicates {
 		p(selector)
 	}
@@ -588,6 +1117,32 @@ func (rq *RolesQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rq *RolesQuery) ForUpdate(opts ...sql.LockOption) *RolesQuery {
+	if rq.driver.Dialect() == dialect.Postgres {
+		rq.Unique(false)
+	}
+	rq.modifiers = append(rq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rq *RolesQuery) ForShare(opts ...sql.LockOption) *RolesQuery {
+	if rq.driver.Dialect() == dialect.Postgres {
+		rq.Unique(false)
+	}
+	rq.modifiers = append(rq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rq
+}
+
 // RolesGroupBy is the group-by builder for Roles entities.
 type RolesGroupBy struct {
 	selector
@@ -12,9 +12,14 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/orgroles"
 	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roleparents"
 	"github.com/shammianand/go-auth/ent/rolepermissions"
 	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
 	"github.com/shammianand/go-auth/ent/userroles"
 )
 
@@ -134,6 +139,61 @@ func (ru *RolesUpdate) ClearMaxUsers() *RolesUpdate {
 	return ru
 }
 
+// SetOrgAssignable sets the "org_assignable" field.
+func (ru *RolesUpdate) SetOrgAssignable(b bool) *RolesUpdate {
+	ru.mutation.SetOrgAssignable(b)
+	return ru
+}
+
+// SetNillableOrgAssignable sets the "org_assignable" field if the given value is not nil.
+func (ru *RolesUpdate) SetNillableOrgAssignable(b *bool) *RolesUpdate {
+	if b != nil {
+		ru.SetOrgAssignable(*b)
+	}
+	return ru
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (ru *RolesUpdate) SetBreakGlass(rg roles.BreakGlass) *RolesUpdate {
+	ru.mutation.SetBreakGlass(rg)
+	return ru
+}
+
+// SetNillableBreakGlass sets the "break_glass" field if the given value is not nil.
+func (ru *RolesUpdate) SetNillableBreakGlass(rg *roles.BreakGlass) *RolesUpdate {
+	if rg != nil {
+		ru.SetBreakGlass(*rg)
+	}
+	return ru
+}
+
+// SetBreakGlassMaxHours sets the "break_glass_max_hours" field.
+func (ru *RolesUpdate) SetBreakGlassMaxHours(i int) *RolesUpdate {
+	ru.mutation.ResetBreakGlassMaxHours()
+	ru.mutation.SetBreakGlassMaxHours(i)
+	return ru
+}
+
+// SetNillableBreakGlassMaxHours sets the "break_glass_max_hours" field if the given value is not nil.
+func (ru *RolesUpdate) SetNillableBreakGlassMaxHours(i *int) *RolesUpdate {
+	if i != nil {
+		ru.SetBreakGlassMaxHours(*i)
+	}
+	return ru
+}
+
+// AddBreakGlassMaxHours adds i to the "break_glass_max_hours" field.
+func (ru *RolesUpdate) AddBreakGlassMaxHours(i int) *RolesUpdate {
+	ru.mutation.AddBreakGlassMaxHours(i)
+	return ru
+}
+
+// ClearBreakGlassMaxHours clears the value of the "break_glass_max_hours" field.
+func (ru *RolesUpdate) ClearBreakGlassMaxHours() *RolesUpdate {
+	ru.mutation.ClearBreakGlassMaxHours()
+	return ru
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (ru *RolesUpdate) SetUpdatedAt(t time.Time) *RolesUpdate {
 	ru.mutation.SetUpdatedAt(t)
@@ -170,6 +230,111 @@ func (ru *RolesUpdate) AddRolePermissions(r ...*RolePermissions) *RolesUpdate {
 	return ru.AddRolePermissionIDs(ids...)
 }
 
+// AddParentLinkIDs adds the "parent_links" edge to the RoleParents entity by IDs.
+func (ru *RolesUpdate) AddParentLinkIDs(ids ...int) *RolesUpdate {
+	ru.mutation.AddParentLinkIDs(ids...)
+	return ru
+}
+
+// AddParentLinks adds the "parent_links" edges to the RoleParents entity.
+func (ru *RolesUpdate) AddParentLinks(r ...*RoleParents) *RolesUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddParentLinkIDs(ids...)
+}
+
+// AddChildLinkIDs adds the "child_links" edge to the RoleParents entity by IDs.
+func (ru *RolesUpdate) AddChildLinkIDs(ids ...int) *RolesUpdate {
+	ru.mutation.AddChildLinkIDs(ids...)
+	return ru
+}
+
+// AddChildLinks adds the "child_links" edges to the RoleParents entity.
+func (ru *RolesUpdate) AddChildLinks(r ...*RoleParents) *RolesUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddChildLinkIDs(ids...)
+}
+
+// AddApproverLinkIDs adds the "approver_links" edge to the RoleApprovers entity by IDs.
+func (ru *RolesUpdate) AddApproverLinkIDs(ids ...int) *RolesUpdate {
+	ru.mutation.AddApproverLinkIDs(ids...)
+	return ru
+}
+
+// AddApproverLinks adds the "approver_links" edges to the RoleApprovers entity.
+func (ru *RolesUpdate) AddApproverLinks(r ...*RoleApprovers) *RolesUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddApproverLinkIDs(ids...)
+}
+
+// AddApprovesLinkIDs adds the "approves_links" edge to the RoleApprovers entity by IDs.
+func (ru *RolesUpdate) AddApprovesLinkIDs(ids ...int) *RolesUpdate {
+	ru.mutation.AddApprovesLinkIDs(ids...)
+	return ru
+}
+
+// AddApprovesLinks adds the "approves_links" edges to the RoleApprovers entity.
+func (ru *RolesUpdate) AddApprovesLinks(r ...*RoleApprovers) *RolesUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddApprovesLinkIDs(ids...)
+}
+
+// AddSodConstraintRoleIDs adds the "sod_constraint_roles" edge to the SodConstraintRoles entity by IDs.
+func (ru *RolesUpdate) AddSodConstraintRoleIDs(ids ...int) *RolesUpdate {
+	ru.mutation.AddSodConstraintRoleIDs(ids...)
+	return ru
+}
+
+// AddSodConstraintRoles adds the "sod_constraint_roles" edges to the SodConstraintRoles entity.
+func (ru *RolesUpdate) AddSodConstraintRoles(s ...*SodConstraintRoles) *RolesUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ru.AddSodConstraintRoleIDs(ids...)
+}
+
+// AddGroupRoleIDs adds the "group_roles" edge to the GroupRoles entity by IDs.
+func (ru *RolesUpdate) AddGroupRoleIDs(ids ...int) *RolesUpdate {
+	ru.mutation.AddGroupRoleIDs(ids...)
+	return ru
+}
+
+// AddGroupRoles adds the "group_roles" edges to the GroupRoles entity.
+func (ru *RolesUpdate) AddGroupRoles(g ...*GroupRoles) *RolesUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ru.AddGroupRoleIDs(ids...)
+}
+
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by IDs.
+func (ru *RolesUpdate) AddOrgRoleIDs(ids ...int) *RolesUpdate {
+	ru.mutation.AddOrgRoleIDs(ids...)
+	return ru
+}
+
+// AddOrgRoles adds the "org_roles" edges to the OrgRoles entity.
+func (ru *RolesUpdate) AddOrgRoles(o ...*OrgRoles) *RolesUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ru.AddOrgRoleIDs(ids...)
+}
+
 // Mutation returns the RolesMutation object of the builder.
 func (ru *RolesUpdate) Mutation() *RolesMutation {
 	return ru.mutation
@@ -217,6 +382,153 @@ func (ru *RolesUpdate) RemoveRolePermissions(r ...*RolePermissions) *RolesUpdate
 	return ru.RemoveRolePermissionIDs(ids...)
 }
 
+// ClearParentLinks clears all "parent_links" edges to the RoleParents entity.
+func (ru *RolesUpdate) ClearParentLinks() *RolesUpdate {
+	ru.mutation.ClearParentLinks()
+	return ru
+}
+
+// RemoveParentLinkIDs removes the "parent_links" edge to RoleParents entities by IDs.
+func (ru *RolesUpdate) RemoveParentLinkIDs(ids ...int) *RolesUpdate {
+	ru.mutation.RemoveParentLinkIDs(ids...)
+	return ru
+}
+
+// RemoveParentLinks removes "parent_links" edges to RoleParents entities.
+func (ru *RolesUpdate) RemoveParentLinks(r ...*RoleParents) *RolesUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveParentLinkIDs(ids...)
+}
+
+// ClearChildLinks clears all "child_links" edges to the RoleParents entity.
+func (ru *RolesUpdate) ClearChildLinks() *RolesUpdate {
+	ru.mutation.ClearChildLinks()
+	return ru
+}
+
+// RemoveChildLinkIDs removes the "child_links" edge to RoleParents entities by IDs.
+func (ru *RolesUpdate) RemoveChildLinkIDs(ids ...int) *RolesUpdate {
+	ru.mutation.RemoveChildLinkIDs(ids...)
+	return ru
+}
+
+// RemoveChildLinks removes "child_links" edges to RoleParents entities.
+func (ru *RolesUpdate) RemoveChildLinks(r ...*RoleParents) *RolesUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveChildLinkIDs(ids...)
+}
+
+// ClearApproverLinks clears all "approver_links" edges to the RoleApprovers entity.
+func (ru *RolesUpdate) ClearApproverLinks() *RolesUpdate {
+	ru.mutation.ClearApproverLinks()
+	return ru
+}
+
+// RemoveApproverLinkIDs removes the "approver_links" edge to RoleApprovers entities by IDs.
+func (ru *RolesUpdate) RemoveApproverLinkIDs(ids ...int) *RolesUpdate {
+	ru.mutation.RemoveApproverLinkIDs(ids...)
+	return ru
+}
+
+// RemoveApproverLinks removes "approver_links" edges to RoleApprovers entities.
+func (ru *RolesUpdate) RemoveApproverLinks(r ...*RoleApprovers) *RolesUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveApproverLinkIDs(ids...)
+}
+
+// ClearApprovesLinks clears all "approves_links" edges to the RoleApprovers entity.
+func (ru *RolesUpdate) ClearApprovesLinks() *RolesUpdate {
+	ru.mutation.ClearApprovesLinks()
+	return ru
+}
+
+// RemoveApprovesLinkIDs removes the "approves_links" edge to RoleApprovers entities by IDs.
+func (ru *RolesUpdate) RemoveApprovesLinkIDs(ids ...int) *RolesUpdate {
+	ru.mutation.RemoveApprovesLinkIDs(ids...)
+	return ru
+}
+
+// RemoveApprovesLinks removes "approves_links" edges to RoleApprovers entities.
+func (ru *RolesUpdate) RemoveApprovesLinks(r ...*RoleApprovers) *RolesUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveApprovesLinkIDs(ids...)
+}
+
+// ClearSodConstraintRoles clears all "sod_constraint_roles" edges to the SodConstraintRoles entity.
+func (ru *RolesUpdate) ClearSodConstraintRoles() *RolesUpdate {
+	ru.mutation.ClearSodConstraintRoles()
+	return ru
+}
+
+// RemoveSodConstraintRoleIDs removes the "sod_constraint_roles" edge to SodConstraintRoles entities by IDs.
+func (ru *RolesUpdate) RemoveSodConstraintRoleIDs(ids ...int) *RolesUpdate {
+	ru.mutation.RemoveSodConstraintRoleIDs(ids...)
+	return ru
+}
+
+// RemoveSodConstraintRoles removes "sod_constraint_roles" edges to SodConstraintRoles entities.
+func (ru *RolesUpdate) RemoveSodConstraintRoles(s ...*SodConstraintRoles) *RolesUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ru.RemoveSodConstraintRoleIDs(ids...)
+}
+
+// ClearGroupRoles clears all "group_roles" edges to the GroupRoles entity.
+func (ru *RolesUpdate) ClearGroupRoles() *RolesUpdate {
+	ru.mutation.ClearGroupRoles()
+	return ru
+}
+
+// RemoveGroupRoleIDs removes the "group_roles" edge to GroupRoles entities by IDs.
+func (ru *RolesUpdate) RemoveGroupRoleIDs(ids ...int) *RolesUpdate {
+	ru.mutation.RemoveGroupRoleIDs(ids...)
+	return ru
+}
+
+// RemoveGroupRoles removes "group_roles" edges to GroupRoles entities.
+func (ru *RolesUpdate) RemoveGroupRoles(g ...*GroupRoles) *RolesUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ru.RemoveGroupRoleIDs(ids...)
+}
+
+// ClearOrgRoles clears all "org_roles" edges to the OrgRoles entity.
+func (ru *RolesUpdate) ClearOrgRoles() *RolesUpdate {
+	ru.mutation.ClearOrgRoles()
+	return ru
+}
+
+// RemoveOrgRoleIDs removes the "org_roles" edge to OrgRoles entities by IDs.
+func (ru *RolesUpdate) RemoveOrgRoleIDs(ids ...int) *RolesUpdate {
+	ru.mutation.RemoveOrgRoleIDs(ids...)
+	return ru
+}
+
+// RemoveOrgRoles removes "org_roles" edges to OrgRoles entities.
+func (ru *RolesUpdate) RemoveOrgRoles(o ...*OrgRoles) *RolesUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ru.RemoveOrgRoleIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *RolesUpdate) Save(ctx context.Context) (int, error) {
 	ru.defaults()
@@ -265,6 +577,11 @@ func (ru *RolesUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Roles.name": %w`, err)}
 		}
 	}
+	if v, ok := ru.mutation.BreakGlass(); ok {
+		if err := roles.BreakGlassValidator(v); err != nil {
+			return &ValidationError{Name: "break_glass", err: fmt.Errorf(`ent: validator failed for field "Roles.break_glass": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -307,6 +624,21 @@ func (ru *RolesUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ru.mutation.MaxUsersCleared() {
 		_spec.ClearField(roles.FieldMaxUsers, field.TypeInt)
 	}
+	if value, ok := ru.mutation.OrgAssignable(); ok {
+		_spec.SetField(roles.FieldOrgAssignable, field.TypeBool, value)
+	}
+	if value, ok := ru.mutation.BreakGlass(); ok {
+		_spec.SetField(roles.FieldBreakGlass, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.BreakGlassMaxHours(); ok {
+		_spec.SetField(roles.FieldBreakGlassMaxHours, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedBreakGlassMaxHours(); ok {
+		_spec.AddField(roles.FieldBreakGlassMaxHours, field.TypeInt, value)
+	}
+	if ru.mutation.BreakGlassMaxHoursCleared() {
+		_spec.ClearField(roles.FieldBreakGlassMaxHours, field.TypeInt)
+	}
 	if value, ok := ru.mutation.UpdatedAt(); ok {
 		_spec.SetField(roles.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -400,64 +732,379 @@ func (ru *RolesUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{roles.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if ru.mutation.ParentLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ParentLinksTable,
+			Columns: []string{roles.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	ru.mutation.done = true
-	return n, nil
-}
-
-// RolesUpdateOne is the builder for updating a single Roles entity.
-type RolesUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *RolesMutation
-}
-
-// SetCode sets the "code" field.
-func (ruo *RolesUpdateOne) SetCode(s string) *RolesUpdateOne {
-	ruo.mutation.SetCode(s)
-	return ruo
-}
-
-// SetNillableCode sets the "code" field if the given value is not nil.
-func (ruo *RolesUpdateOne) SetNillableCode(s *string) *RolesUpdateOne {
-	if s != nil {
-		ruo.SetCode(*s)
+	if nodes := ru.mutation.RemovedParentLinksIDs(); len(nodes) > 0 && !ru.mutation.ParentLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ParentLinksTable,
+			Columns: []string{roles.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return ruo
-}
-
-// SetName sets the "name" field.
-func (ruo *RolesUpdateOne) SetName(s string) *RolesUpdateOne {
-	ruo.mutation.SetName(s)
-	return ruo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (ruo *RolesUpdateOne) SetNillableName(s *string) *RolesUpdateOne {
-	if s != nil {
-		ruo.SetName(*s)
+	if nodes := ru.mutation.ParentLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ParentLinksTable,
+			Columns: []string{roles.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return ruo
-}
-
-// SetDescription sets the "description" field.
-func (ruo *RolesUpdateOne) SetDescription(s string) *RolesUpdateOne {
-	ruo.mutation.SetDescription(s)
-	return ruo
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (ruo *RolesUpdateOne) SetNillableDescription(s *string) *RolesUpdateOne {
-	if s != nil {
-		ruo.SetDescription(*s)
+	if ru.mutation.ChildLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ChildLinksTable,
+			Columns: []string{roles.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedChildLinksIDs(); len(nodes) > 0 && !ru.mutation.ChildLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ChildLinksTable,
+			Columns: []string{roles.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ChildLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ChildLinksTable,
+			Columns: []string{roles.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.ApproverLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApproverLinksTable,
+			Columns: []string{roles.ApproverLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedApproverLinksIDs(); len(nodes) > 0 && !ru.mutation.ApproverLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApproverLinksTable,
+			Columns: []string{roles.ApproverLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ApproverLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApproverLinksTable,
+			Columns: []string{roles.ApproverLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.ApprovesLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApprovesLinksTable,
+			Columns: []string{roles.ApprovesLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedApprovesLinksIDs(); len(nodes) > 0 && !ru.mutation.ApprovesLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApprovesLinksTable,
+			Columns: []string{roles.ApprovesLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ApprovesLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApprovesLinksTable,
+			Columns: []string{roles.ApprovesLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.SodConstraintRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.SodConstraintRolesTable,
+			Columns: []string{roles.SodConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedSodConstraintRolesIDs(); len(nodes) > 0 && !ru.mutation.SodConstraintRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.SodConstraintRolesTable,
+			Columns: []string{roles.SodConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.SodConstraintRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.SodConstraintRolesTable,
+			Columns: []string{roles.SodConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.GroupRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.GroupRolesTable,
+			Columns: []string{roles.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedGroupRolesIDs(); len(nodes) > 0 && !ru.mutation.GroupRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.GroupRolesTable,
+			Columns: []string{roles.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.GroupRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.GroupRolesTable,
+			Columns: []string{roles.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.OrgRolesTable,
+			Columns: []string{roles.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedOrgRolesIDs(); len(nodes) > 0 && !ru.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.OrgRolesTable,
+			Columns: []string{roles.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.OrgRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.OrgRolesTable,
+			Columns: []string{roles.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{roles.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// RolesUpdateOne is the builder for updating a single Roles entity.
+type RolesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RolesMutation
+}
+
+// SetCode sets the "code" field.
+func (ruo *RolesUpdateOne) SetCode(s string) *RolesUpdateOne {
+	ruo.mutation.SetCode(s)
+	return ruo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (ruo *RolesUpdateOne) SetNillableCode(s *string) *RolesUpdateOne {
+	if s != nil {
+		ruo.SetCode(*s)
+	}
+	return ruo
+}
+
+// SetName sets the "name" field.
+func (ruo *RolesUpdateOne) SetName(s string) *RolesUpdateOne {
+	ruo.mutation.SetName(s)
+	return ruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ruo *RolesUpdateOne) SetNillableName(s *string) *RolesUpdateOne {
+	if s != nil {
+		ruo.SetName(*s)
+	}
+	return ruo
+}
+
+// SetDescription sets the "description" field.
+func (ruo *RolesUpdateOne) SetDescription(s string) *RolesUpdateOne {
+	ruo.mutation.SetDescription(s)
+	return ruo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ruo *RolesUpdateOne) SetNillableDescription(s *string) *RolesUpdateOne {
+	if s != nil {
+		ruo.SetDescription(*s)
 	}
 	return ruo
 }
@@ -523,6 +1170,61 @@ func (ruo *RolesUpdateOne) ClearMaxUsers() *RolesUpdateOne {
 	return ruo
 }
 
+// SetOrgAssignable sets the "org_assignable" field.
+func (ruo *RolesUpdateOne) SetOrgAssignable(b bool) *RolesUpdateOne {
+	ruo.mutation.SetOrgAssignable(b)
+	return ruo
+}
+
+// SetNillableOrgAssignable sets the "org_assignable" field if the given value is not nil.
+func (ruo *RolesUpdateOne) SetNillableOrgAssignable(b *bool) *RolesUpdateOne {
+	if b != nil {
+		ruo.SetOrgAssignable(*b)
+	}
+	return ruo
+}
+
+// SetBreakGlass sets the "break_glass" field.
+func (ruo *RolesUpdateOne) SetBreakGlass(rg roles.BreakGlass) *RolesUpdateOne {
+	ruo.mutation.SetBreakGlass(rg)
+	return ruo
+}
+
+// SetNillableBreakGlass sets the "break_glass" field if the given value is not nil.
+func (ruo *RolesUpdateOne) SetNillableBreakGlass(rg *roles.BreakGlass) *RolesUpdateOne {
+	if rg != nil {
+		ruo.SetBreakGlass(*rg)
+	}
+	return ruo
+}
+
+// SetBreakGlassMaxHours sets the "break_glass_max_hours" field.
+func (ruo *RolesUpdateOne) SetBreakGlassMaxHours(i int) *RolesUpdateOne {
+	ruo.mutation.ResetBreakGlassMaxHours()
+	ruo.mutation.SetBreakGlassMaxHours(i)
+	return ruo
+}
+
+// SetNillableBreakGlassMaxHours sets the "break_glass_max_hours" field if the given value is not nil.
+func (ruo *RolesUpdateOne) SetNillableBreakGlassMaxHours(i *int) *RolesUpdateOne {
+	if i != nil {
+		ruo.SetBreakGlassMaxHours(*i)
+	}
+	return ruo
+}
+
+// AddBreakGlassMaxHours adds i to the "break_glass_max_hours" field.
+func (ruo *RolesUpdateOne) AddBreakGlassMaxHours(i int) *RolesUpdateOne {
+	ruo.mutation.AddBreakGlassMaxHours(i)
+	return ruo
+}
+
+// ClearBreakGlassMaxHours clears the value of the "break_glass_max_hours" field.
+func (ruo *RolesUpdateOne) ClearBreakGlassMaxHours() *RolesUpdateOne {
+	ruo.mutation.ClearBreakGlassMaxHours()
+	return ruo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (ruo *RolesUpdateOne) SetUpdatedAt(t time.Time) *RolesUpdateOne {
 	ruo.mutation.SetUpdatedAt(t)
@@ -559,6 +1261,111 @@ func (ruo *RolesUpdateOne) AddRolePermissions(r ...*RolePermissions) *RolesUpdat
 	return ruo.AddRolePermissionIDs(ids...)
 }
 
+// AddParentLinkIDs adds the "parent_links" edge to the RoleParents entity by IDs.
+func (ruo *RolesUpdateOne) AddParentLinkIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.AddParentLinkIDs(ids...)
+	return ruo
+}
+
+// AddParentLinks adds the "parent_links" edges to the RoleParents entity.
+func (ruo *RolesUpdateOne) AddParentLinks(r ...*RoleParents) *RolesUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddParentLinkIDs(ids...)
+}
+
+// AddChildLinkIDs adds the "child_links" edge to the RoleParents entity by IDs.
+func (ruo *RolesUpdateOne) AddChildLinkIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.AddChildLinkIDs(ids...)
+	return ruo
+}
+
+// AddChildLinks adds the "child_links" edges to the RoleParents entity.
+func (ruo *RolesUpdateOne) AddChildLinks(r ...*RoleParents) *RolesUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddChildLinkIDs(ids...)
+}
+
+// AddApproverLinkIDs adds the "approver_links" edge to the RoleApprovers entity by IDs.
+func (ruo *RolesUpdateOne) AddApproverLinkIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.AddApproverLinkIDs(ids...)
+	return ruo
+}
+
+// AddApproverLinks adds the "approver_links" edges to the RoleApprovers entity.
+func (ruo *RolesUpdateOne) AddApproverLinks(r ...*RoleApprovers) *RolesUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddApproverLinkIDs(ids...)
+}
+
+// AddApprovesLinkIDs adds the "approves_links" edge to the RoleApprovers entity by IDs.
+func (ruo *RolesUpdateOne) AddApprovesLinkIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.AddApprovesLinkIDs(ids...)
+	return ruo
+}
+
+// AddApprovesLinks adds the "approves_links" edges to the RoleApprovers entity.
+func (ruo *RolesUpdateOne) AddApprovesLinks(r ...*RoleApprovers) *RolesUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddApprovesLinkIDs(ids...)
+}
+
+// AddSodConstraintRoleIDs adds the "sod_constraint_roles" edge to the SodConstraintRoles entity by IDs.
+func (ruo *RolesUpdateOne) AddSodConstraintRoleIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.AddSodConstraintRoleIDs(ids...)
+	return ruo
+}
+
+// AddSodConstraintRoles adds the "sod_constraint_roles" edges to the SodConstraintRoles entity.
+func (ruo *RolesUpdateOne) AddSodConstraintRoles(s ...*SodConstraintRoles) *RolesUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ruo.AddSodConstraintRoleIDs(ids...)
+}
+
+// AddGroupRoleIDs adds the "group_roles" edge to the GroupRoles entity by IDs.
+func (ruo *RolesUpdateOne) AddGroupRoleIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.AddGroupRoleIDs(ids...)
+	return ruo
+}
+
+// AddGroupRoles adds the "group_roles" edges to the GroupRoles entity.
+func (ruo *RolesUpdateOne) AddGroupRoles(g ...*GroupRoles) *RolesUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ruo.AddGroupRoleIDs(ids...)
+}
+
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by IDs.
+func (ruo *RolesUpdateOne) AddOrgRoleIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.AddOrgRoleIDs(ids...)
+	return ruo
+}
+
+// AddOrgRoles adds the "org_roles" edges to the OrgRoles entity.
+func (ruo *RolesUpdateOne) AddOrgRoles(o ...*OrgRoles) *RolesUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ruo.AddOrgRoleIDs(ids...)
+}
+
 // Mutation returns the RolesMutation object of the builder.
 func (ruo *RolesUpdateOne) Mutation() *RolesMutation {
 	return ruo.mutation
@@ -576,34 +1383,181 @@ func (ruo *RolesUpdateOne) RemoveUserRoleIDs(ids ...uuid.UUID) *RolesUpdateOne {
 	return ruo
 }
 
-// RemoveUserRoles removes "user_roles" edges to UserRoles entities.
-func (ruo *RolesUpdateOne) RemoveUserRoles(u ...*UserRoles) *RolesUpdateOne {
-	ids := make([]uuid.UUID, len(u))
-	for i := range u {
-		ids[i] = u[i].ID
+// RemoveUserRoles removes "user_roles" edges to UserRoles entities.
+func (ruo *RolesUpdateOne) RemoveUserRoles(u ...*UserRoles) *RolesUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ruo.RemoveUserRoleIDs(ids...)
+}
+
+// ClearRolePermissions clears all "role_permissions" edges to the RolePermissions entity.
+func (ruo *RolesUpdateOne) ClearRolePermissions() *RolesUpdateOne {
+	ruo.mutation.ClearRolePermissions()
+	return ruo
+}
+
+// RemoveRolePermissionIDs removes the "role_permissions" edge to RolePermissions entities by IDs.
+func (ruo *RolesUpdateOne) RemoveRolePermissionIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.RemoveRolePermissionIDs(ids...)
+	return ruo
+}
+
+// RemoveRolePermissions removes "role_permissions" edges to RolePermissions entities.
+func (ruo *RolesUpdateOne) RemoveRolePermissions(r ...*RolePermissions) *RolesUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveRolePermissionIDs(ids...)
+}
+
+// ClearParentLinks clears all "parent_links" edges to the RoleParents entity.
+func (ruo *RolesUpdateOne) ClearParentLinks() *RolesUpdateOne {
+	ruo.mutation.ClearParentLinks()
+	return ruo
+}
+
+// RemoveParentLinkIDs removes the "parent_links" edge to RoleParents entities by IDs.
+func (ruo *RolesUpdateOne) RemoveParentLinkIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.RemoveParentLinkIDs(ids...)
+	return ruo
+}
+
+// RemoveParentLinks removes "parent_links" edges to RoleParents entities.
+func (ruo *RolesUpdateOne) RemoveParentLinks(r ...*RoleParents) *RolesUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveParentLinkIDs(ids...)
+}
+
+// ClearChildLinks clears all "child_links" edges to the RoleParents entity.
+func (ruo *RolesUpdateOne) ClearChildLinks() *RolesUpdateOne {
+	ruo.mutation.ClearChildLinks()
+	return ruo
+}
+
+// RemoveChildLinkIDs removes the "child_links" edge to RoleParents entities by IDs.
+func (ruo *RolesUpdateOne) RemoveChildLinkIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.RemoveChildLinkIDs(ids...)
+	return ruo
+}
+
+// RemoveChildLinks removes "child_links" edges to RoleParents entities.
+func (ruo *RolesUpdateOne) RemoveChildLinks(r ...*RoleParents) *RolesUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveChildLinkIDs(ids...)
+}
+
+// ClearApproverLinks clears all "approver_links" edges to the RoleApprovers entity.
+func (ruo *RolesUpdateOne) ClearApproverLinks() *RolesUpdateOne {
+	ruo.mutation.ClearApproverLinks()
+	return ruo
+}
+
+// RemoveApproverLinkIDs removes the "approver_links" edge to RoleApprovers entities by IDs.
+func (ruo *RolesUpdateOne) RemoveApproverLinkIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.RemoveApproverLinkIDs(ids...)
+	return ruo
+}
+
+// RemoveApproverLinks removes "approver_links" edges to RoleApprovers entities.
+func (ruo *RolesUpdateOne) RemoveApproverLinks(r ...*RoleApprovers) *RolesUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveApproverLinkIDs(ids...)
+}
+
+// ClearApprovesLinks clears all "approves_links" edges to the RoleApprovers entity.
+func (ruo *RolesUpdateOne) ClearApprovesLinks() *RolesUpdateOne {
+	ruo.mutation.ClearApprovesLinks()
+	return ruo
+}
+
+// RemoveApprovesLinkIDs removes the "approves_links" edge to RoleApprovers entities by IDs.
+func (ruo *RolesUpdateOne) RemoveApprovesLinkIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.RemoveApprovesLinkIDs(ids...)
+	return ruo
+}
+
+// RemoveApprovesLinks removes "approves_links" edges to RoleApprovers entities.
+func (ruo *RolesUpdateOne) RemoveApprovesLinks(r ...*RoleApprovers) *RolesUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveApprovesLinkIDs(ids...)
+}
+
+// ClearSodConstraintRoles clears all "sod_constraint_roles" edges to the SodConstraintRoles entity.
+func (ruo *RolesUpdateOne) ClearSodConstraintRoles() *RolesUpdateOne {
+	ruo.mutation.ClearSodConstraintRoles()
+	return ruo
+}
+
+// RemoveSodConstraintRoleIDs removes the "sod_constraint_roles" edge to SodConstraintRoles entities by IDs.
+func (ruo *RolesUpdateOne) RemoveSodConstraintRoleIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.RemoveSodConstraintRoleIDs(ids...)
+	return ruo
+}
+
+// RemoveSodConstraintRoles removes "sod_constraint_roles" edges to SodConstraintRoles entities.
+func (ruo *RolesUpdateOne) RemoveSodConstraintRoles(s ...*SodConstraintRoles) *RolesUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ruo.RemoveSodConstraintRoleIDs(ids...)
+}
+
+// ClearGroupRoles clears all "group_roles" edges to the GroupRoles entity.
+func (ruo *RolesUpdateOne) ClearGroupRoles() *RolesUpdateOne {
+	ruo.mutation.ClearGroupRoles()
+	return ruo
+}
+
+// RemoveGroupRoleIDs removes the "group_roles" edge to GroupRoles entities by IDs.
+func (ruo *RolesUpdateOne) RemoveGroupRoleIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.RemoveGroupRoleIDs(ids...)
+	return ruo
+}
+
+// RemoveGroupRoles removes "group_roles" edges to GroupRoles entities.
+func (ruo *RolesUpdateOne) RemoveGroupRoles(g ...*GroupRoles) *RolesUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
 	}
-	return ruo.RemoveUserRoleIDs(ids...)
+	return ruo.RemoveGroupRoleIDs(ids...)
 }
 
-// ClearRolePermissions clears all "role_permissions" edges to the RolePermissions entity.
-func (ruo *RolesUpdateOne) ClearRolePermissions() *RolesUpdateOne {
-	ruo.mutation.ClearRolePermissions()
+// ClearOrgRoles clears all "org_roles" edges to the OrgRoles entity.
+func (ruo *RolesUpdateOne) ClearOrgRoles() *RolesUpdateOne {
+	ruo.mutation.ClearOrgRoles()
 	return ruo
 }
 
-// RemoveRolePermissionIDs removes the "role_permissions" edge to RolePermissions entities by IDs.
-func (ruo *RolesUpdateOne) RemoveRolePermissionIDs(ids ...int) *RolesUpdateOne {
-	ruo.mutation.RemoveRolePermissionIDs(ids...)
+// RemoveOrgRoleIDs removes the "org_roles" edge to OrgRoles entities by IDs.
+func (ruo *RolesUpdateOne) RemoveOrgRoleIDs(ids ...int) *RolesUpdateOne {
+	ruo.mutation.RemoveOrgRoleIDs(ids...)
 	return ruo
 }
 
-// RemoveRolePermissions removes "role_permissions" edges to RolePermissions entities.
-func (ruo *RolesUpdateOne) RemoveRolePermissions(r ...*RolePermissions) *RolesUpdateOne {
-	ids := make([]int, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+// RemoveOrgRoles removes "org_roles" edges to OrgRoles entities.
+func (ruo *RolesUpdateOne) RemoveOrgRoles(o ...*OrgRoles) *RolesUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
 	}
-	return ruo.RemoveRolePermissionIDs(ids...)
+	return ruo.RemoveOrgRoleIDs(ids...)
 }
 
 // Where appends a list predicates to the RolesUpdate builder.
@@ -667,6 +1621,11 @@ func (ruo *RolesUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Roles.name": %w`, err)}
 		}
 	}
+	if v, ok := ruo.mutation.BreakGlass(); ok {
+		if err := roles.BreakGlassValidator(v); err != nil {
+			return &ValidationError{Name: "break_glass", err: fmt.Errorf(`ent: validator failed for field "Roles.break_glass": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -726,6 +1685,21 @@ func (ruo *RolesUpdateOne) sqlSave(ctx context.Context) (_node *Roles, err error
 	if ruo.mutation.MaxUsersCleared() {
 		_spec.ClearField(roles.FieldMaxUsers, field.TypeInt)
 	}
+	if value, ok := ruo.mutation.OrgAssignable(); ok {
+		_spec.SetField(roles.FieldOrgAssignable, field.TypeBool, value)
+	}
+	if value, ok := ruo.mutation.BreakGlass(); ok {
+		_spec.SetField(roles.FieldBreakGlass, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.BreakGlassMaxHours(); ok {
+		_spec.SetField(roles.FieldBreakGlassMaxHours, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedBreakGlassMaxHours(); ok {
+		_spec.AddField(roles.FieldBreakGlassMaxHours, field.TypeInt, value)
+	}
+	if ruo.mutation.BreakGlassMaxHoursCleared() {
+		_spec.ClearField(roles.FieldBreakGlassMaxHours, field.TypeInt)
+	}
 	if value, ok := ruo.mutation.UpdatedAt(); ok {
 		_spec.SetField(roles.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -819,6 +1793,321 @@ func (ruo *RolesUpdateOne) sqlSave(ctx context.Context) (_node *Roles, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.ParentLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ParentLinksTable,
+			Columns: []string{roles.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedParentLinksIDs(); len(nodes) > 0 && !ruo.mutation.ParentLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ParentLinksTable,
+			Columns: []string{roles.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ParentLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ParentLinksTable,
+			Columns: []string{roles.ParentLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.ChildLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ChildLinksTable,
+			Columns: []string{roles.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedChildLinksIDs(); len(nodes) > 0 && !ruo.mutation.ChildLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ChildLinksTable,
+			Columns: []string{roles.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ChildLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ChildLinksTable,
+			Columns: []string{roles.ChildLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleparents.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.ApproverLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApproverLinksTable,
+			Columns: []string{roles.ApproverLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedApproverLinksIDs(); len(nodes) > 0 && !ruo.mutation.ApproverLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApproverLinksTable,
+			Columns: []string{roles.ApproverLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ApproverLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApproverLinksTable,
+			Columns: []string{roles.ApproverLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.ApprovesLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApprovesLinksTable,
+			Columns: []string{roles.ApprovesLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedApprovesLinksIDs(); len(nodes) > 0 && !ruo.mutation.ApprovesLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApprovesLinksTable,
+			Columns: []string{roles.ApprovesLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ApprovesLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.ApprovesLinksTable,
+			Columns: []string{roles.ApprovesLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roleapprovers.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.SodConstraintRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.SodConstraintRolesTable,
+			Columns: []string{roles.SodConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedSodConstraintRolesIDs(); len(nodes) > 0 && !ruo.mutation.SodConstraintRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.SodConstraintRolesTable,
+			Columns: []string{roles.SodConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.SodConstraintRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.SodConstraintRolesTable,
+			Columns: []string{roles.SodConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.GroupRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.GroupRolesTable,
+			Columns: []string{roles.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedGroupRolesIDs(); len(nodes) > 0 && !ruo.mutation.GroupRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.GroupRolesTable,
+			Columns: []string{roles.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.GroupRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.GroupRolesTable,
+			Columns: []string{roles.GroupRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(grouproles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.OrgRolesTable,
+			Columns: []string{roles.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedOrgRolesIDs(); len(nodes) > 0 && !ruo.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.OrgRolesTable,
+			Columns: []string{roles.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.OrgRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   roles.OrgRolesTable,
+			Columns: []string{roles.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Roles{config: ruo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
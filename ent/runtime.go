@@ -6,14 +6,32 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/apikeys"
 	"github.com/shammianand/go-auth/ent/auditlogs"
+	"github.com/shammianand/go-auth/ent/consentacceptances"
+	"github.com/shammianand/go-auth/ent/consentdocuments"
+	"github.com/shammianand/go-auth/ent/emailchanges"
 	"github.com/shammianand/go-auth/ent/emaillogs"
 	"github.com/shammianand/go-auth/ent/emailverifications"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/invitations"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/passwordhistories"
 	"github.com/shammianand/go-auth/ent/passwordresets"
 	"github.com/shammianand/go-auth/ent/permissions"
+	"github.com/shammianand/go-auth/ent/relationtuples"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roleparents"
 	"github.com/shammianand/go-auth/ent/rolepermissions"
+	"github.com/shammianand/go-auth/ent/rolerequests"
 	"github.com/shammianand/go-auth/ent/roles"
 	"github.com/shammianand/go-auth/ent/schema"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
 	"github.com/shammianand/go-auth/ent/userroles"
 	"github.com/shammianand/go-auth/ent/users"
 )
@@ -22,6 +40,28 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeysFields := schema.APIKeys{}.Fields()
+	_ = apikeysFields
+	// apikeysDescName is the schema descriptor for name field.
+	apikeysDescName := apikeysFields[2].Descriptor()
+	// apikeys.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikeys.NameValidator = apikeysDescName.Validators[0].(func(string) error)
+	// apikeysDescPrefix is the schema descriptor for prefix field.
+	apikeysDescPrefix := apikeysFields[3].Descriptor()
+	// apikeys.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikeys.PrefixValidator = apikeysDescPrefix.Validators[0].(func(string) error)
+	// apikeysDescKeyHash is the schema descriptor for key_hash field.
+	apikeysDescKeyHash := apikeysFields[4].Descriptor()
+	// apikeys.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikeys.KeyHashValidator = apikeysDescKeyHash.Validators[0].(func(string) error)
+	// apikeysDescCreatedAt is the schema descriptor for created_at field.
+	apikeysDescCreatedAt := apikeysFields[11].Descriptor()
+	// apikeys.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikeys.DefaultCreatedAt = apikeysDescCreatedAt.Default.(func() time.Time)
+	// apikeysDescID is the schema descriptor for id field.
+	apikeysDescID := apikeysFields[0].Descriptor()
+	// apikeys.DefaultID holds the default value on creation for the id field.
+	apikeys.DefaultID = apikeysDescID.Default.(func() uuid.UUID)
 	auditlogsFields := schema.AuditLogs{}.Fields()
 	_ = auditlogsFields
 	// auditlogsDescActionType is the schema descriptor for action_type field.
@@ -33,13 +73,91 @@ func init() {
 	// auditlogs.ResourceTypeValidator is a validator for the "resource_type" field. It is called by the builders before save.
 	auditlogs.ResourceTypeValidator = auditlogsDescResourceType.Validators[0].(func(string) error)
 	// auditlogsDescCreatedAt is the schema descriptor for created_at field.
-	auditlogsDescCreatedAt := auditlogsFields[9].Descriptor()
+	auditlogsDescCreatedAt := auditlogsFields[10].Descriptor()
 	// auditlogs.DefaultCreatedAt holds the default value on creation for the created_at field.
 	auditlogs.DefaultCreatedAt = auditlogsDescCreatedAt.Default.(func() time.Time)
 	// auditlogsDescID is the schema descriptor for id field.
 	auditlogsDescID := auditlogsFields[0].Descriptor()
 	// auditlogs.DefaultID holds the default value on creation for the id field.
 	auditlogs.DefaultID = auditlogsDescID.Default.(func() uuid.UUID)
+	consentacceptancesFields := schema.ConsentAcceptances{}.Fields()
+	_ = consentacceptancesFields
+	// consentacceptancesDescDocumentType is the schema descriptor for document_type field.
+	consentacceptancesDescDocumentType := consentacceptancesFields[3].Descriptor()
+	// consentacceptances.DocumentTypeValidator is a validator for the "document_type" field. It is called by the builders before save.
+	consentacceptances.DocumentTypeValidator = consentacceptancesDescDocumentType.Validators[0].(func(string) error)
+	// consentacceptancesDescVersion is the schema descriptor for version field.
+	consentacceptancesDescVersion := consentacceptancesFields[4].Descriptor()
+	// consentacceptances.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	consentacceptances.VersionValidator = consentacceptancesDescVersion.Validators[0].(func(string) error)
+	// consentacceptancesDescAcceptedAt is the schema descriptor for accepted_at field.
+	consentacceptancesDescAcceptedAt := consentacceptancesFields[7].Descriptor()
+	// consentacceptances.DefaultAcceptedAt holds the default value on creation for the accepted_at field.
+	consentacceptances.DefaultAcceptedAt = consentacceptancesDescAcceptedAt.Default.(func() time.Time)
+	// consentacceptancesDescID is the schema descriptor for id field.
+	consentacceptancesDescID := consentacceptancesFields[0].Descriptor()
+	// consentacceptances.DefaultID holds the default value on creation for the id field.
+	consentacceptances.DefaultID = consentacceptancesDescID.Default.(func() uuid.UUID)
+	consentdocumentsFields := schema.ConsentDocuments{}.Fields()
+	_ = consentdocumentsFields
+	// consentdocumentsDescType is the schema descriptor for type field.
+	consentdocumentsDescType := consentdocumentsFields[1].Descriptor()
+	// consentdocuments.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	consentdocuments.TypeValidator = consentdocumentsDescType.Validators[0].(func(string) error)
+	// consentdocumentsDescVersion is the schema descriptor for version field.
+	consentdocumentsDescVersion := consentdocumentsFields[2].Descriptor()
+	// consentdocuments.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	consentdocuments.VersionValidator = consentdocumentsDescVersion.Validators[0].(func(string) error)
+	// consentdocumentsDescTitle is the schema descriptor for title field.
+	consentdocumentsDescTitle := consentdocumentsFields[3].Descriptor()
+	// consentdocuments.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	consentdocuments.TitleValidator = consentdocumentsDescTitle.Validators[0].(func(string) error)
+	// consentdocumentsDescMandatory is the schema descriptor for mandatory field.
+	consentdocumentsDescMandatory := consentdocumentsFields[6].Descriptor()
+	// consentdocuments.DefaultMandatory holds the default value on creation for the mandatory field.
+	consentdocuments.DefaultMandatory = consentdocumentsDescMandatory.Default.(bool)
+	// consentdocumentsDescPublishedAt is the schema descriptor for published_at field.
+	consentdocumentsDescPublishedAt := consentdocumentsFields[7].Descriptor()
+	// consentdocuments.DefaultPublishedAt holds the default value on creation for the published_at field.
+	consentdocuments.DefaultPublishedAt = consentdocumentsDescPublishedAt.Default.(func() time.Time)
+	// consentdocumentsDescCreatedAt is the schema descriptor for created_at field.
+	consentdocumentsDescCreatedAt := consentdocumentsFields[9].Descriptor()
+	// consentdocuments.DefaultCreatedAt holds the default value on creation for the created_at field.
+	consentdocuments.DefaultCreatedAt = consentdocumentsDescCreatedAt.Default.(func() time.Time)
+	// consentdocumentsDescID is the schema descriptor for id field.
+	consentdocumentsDescID := consentdocumentsFields[0].Descriptor()
+	// consentdocuments.DefaultID holds the default value on creation for the id field.
+	consentdocuments.DefaultID = consentdocumentsDescID.Default.(func() uuid.UUID)
+	emailchangesFields := schema.EmailChanges{}.Fields()
+	_ = emailchangesFields
+	// emailchangesDescOldEmail is the schema descriptor for old_email field.
+	emailchangesDescOldEmail := emailchangesFields[2].Descriptor()
+	// emailchanges.OldEmailValidator is a validator for the "old_email" field. It is called by the builders before save.
+	emailchanges.OldEmailValidator = emailchangesDescOldEmail.Validators[0].(func(string) error)
+	// emailchangesDescOldEmailVerified is the schema descriptor for old_email_verified field.
+	emailchangesDescOldEmailVerified := emailchangesFields[3].Descriptor()
+	// emailchanges.DefaultOldEmailVerified holds the default value on creation for the old_email_verified field.
+	emailchanges.DefaultOldEmailVerified = emailchangesDescOldEmailVerified.Default.(bool)
+	// emailchangesDescNewEmail is the schema descriptor for new_email field.
+	emailchangesDescNewEmail := emailchangesFields[4].Descriptor()
+	// emailchanges.NewEmailValidator is a validator for the "new_email" field. It is called by the builders before save.
+	emailchanges.NewEmailValidator = emailchangesDescNewEmail.Validators[0].(func(string) error)
+	// emailchangesDescToken is the schema descriptor for token field.
+	emailchangesDescToken := emailchangesFields[5].Descriptor()
+	// emailchanges.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	emailchanges.TokenValidator = emailchangesDescToken.Validators[0].(func(string) error)
+	// emailchangesDescUndoToken is the schema descriptor for undo_token field.
+	emailchangesDescUndoToken := emailchangesFields[6].Descriptor()
+	// emailchanges.UndoTokenValidator is a validator for the "undo_token" field. It is called by the builders before save.
+	emailchanges.UndoTokenValidator = emailchangesDescUndoToken.Validators[0].(func(string) error)
+	// emailchangesDescCreatedAt is the schema descriptor for created_at field.
+	emailchangesDescCreatedAt := emailchangesFields[13].Descriptor()
+	// emailchanges.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailchanges.DefaultCreatedAt = emailchangesDescCreatedAt.Default.(func() time.Time)
+	// emailchangesDescID is the schema descriptor for id field.
+	emailchangesDescID := emailchangesFields[0].Descriptor()
+	// emailchanges.DefaultID holds the default value on creation for the id field.
+	emailchanges.DefaultID = emailchangesDescID.Default.(func() uuid.UUID)
 	emaillogsFields := schema.EmailLogs{}.Fields()
 	_ = emaillogsFields
 	// emaillogsDescRecipient is the schema descriptor for recipient field.
@@ -88,6 +206,120 @@ func init() {
 	emailverificationsDescID := emailverificationsFields[0].Descriptor()
 	// emailverifications.DefaultID holds the default value on creation for the id field.
 	emailverifications.DefaultID = emailverificationsDescID.Default.(func() uuid.UUID)
+	groupmembersFields := schema.GroupMembers{}.Fields()
+	_ = groupmembersFields
+	// groupmembersDescAddedAt is the schema descriptor for added_at field.
+	groupmembersDescAddedAt := groupmembersFields[4].Descriptor()
+	// groupmembers.DefaultAddedAt holds the default value on creation for the added_at field.
+	groupmembers.DefaultAddedAt = groupmembersDescAddedAt.Default.(func() time.Time)
+	// groupmembersDescID is the schema descriptor for id field.
+	groupmembersDescID := groupmembersFields[0].Descriptor()
+	// groupmembers.DefaultID holds the default value on creation for the id field.
+	groupmembers.DefaultID = groupmembersDescID.Default.(func() uuid.UUID)
+	groupparentsFields := schema.GroupParents{}.Fields()
+	_ = groupparentsFields
+	// groupparentsDescCreatedAt is the schema descriptor for created_at field.
+	groupparentsDescCreatedAt := groupparentsFields[3].Descriptor()
+	// groupparents.DefaultCreatedAt holds the default value on creation for the created_at field.
+	groupparents.DefaultCreatedAt = groupparentsDescCreatedAt.Default.(func() time.Time)
+	grouprolesFields := schema.GroupRoles{}.Fields()
+	_ = grouprolesFields
+	// grouprolesDescAssignedAt is the schema descriptor for assigned_at field.
+	grouprolesDescAssignedAt := grouprolesFields[4].Descriptor()
+	// grouproles.DefaultAssignedAt holds the default value on creation for the assigned_at field.
+	grouproles.DefaultAssignedAt = grouprolesDescAssignedAt.Default.(func() time.Time)
+	groupsFields := schema.Groups{}.Fields()
+	_ = groupsFields
+	// groupsDescCode is the schema descriptor for code field.
+	groupsDescCode := groupsFields[1].Descriptor()
+	// groups.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	groups.CodeValidator = groupsDescCode.Validators[0].(func(string) error)
+	// groupsDescName is the schema descriptor for name field.
+	groupsDescName := groupsFields[2].Descriptor()
+	// groups.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	groups.NameValidator = groupsDescName.Validators[0].(func(string) error)
+	// groupsDescCreatedAt is the schema descriptor for created_at field.
+	groupsDescCreatedAt := groupsFields[5].Descriptor()
+	// groups.DefaultCreatedAt holds the default value on creation for the created_at field.
+	groups.DefaultCreatedAt = groupsDescCreatedAt.Default.(func() time.Time)
+	// groupsDescUpdatedAt is the schema descriptor for updated_at field.
+	groupsDescUpdatedAt := groupsFields[6].Descriptor()
+	// groups.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	groups.DefaultUpdatedAt = groupsDescUpdatedAt.Default.(func() time.Time)
+	// groups.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	groups.UpdateDefaultUpdatedAt = groupsDescUpdatedAt.UpdateDefault.(func() time.Time)
+	invitationsFields := schema.Invitations{}.Fields()
+	_ = invitationsFields
+	// invitationsDescEmail is the schema descriptor for email field.
+	invitationsDescEmail := invitationsFields[1].Descriptor()
+	// invitations.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	invitations.EmailValidator = invitationsDescEmail.Validators[0].(func(string) error)
+	// invitationsDescCreatedAt is the schema descriptor for created_at field.
+	invitationsDescCreatedAt := invitationsFields[10].Descriptor()
+	// invitations.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitations.DefaultCreatedAt = invitationsDescCreatedAt.Default.(func() time.Time)
+	// invitationsDescID is the schema descriptor for id field.
+	invitationsDescID := invitationsFields[0].Descriptor()
+	// invitations.DefaultID holds the default value on creation for the id field.
+	invitations.DefaultID = invitationsDescID.Default.(func() uuid.UUID)
+	orgmembersFields := schema.OrgMembers{}.Fields()
+	_ = orgmembersFields
+	// orgmembersDescJoinedAt is the schema descriptor for joined_at field.
+	orgmembersDescJoinedAt := orgmembersFields[4].Descriptor()
+	// orgmembers.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	orgmembers.DefaultJoinedAt = orgmembersDescJoinedAt.Default.(func() time.Time)
+	// orgmembersDescID is the schema descriptor for id field.
+	orgmembersDescID := orgmembersFields[0].Descriptor()
+	// orgmembers.DefaultID holds the default value on creation for the id field.
+	orgmembers.DefaultID = orgmembersDescID.Default.(func() uuid.UUID)
+	orgrolesFields := schema.OrgRoles{}.Fields()
+	_ = orgrolesFields
+	// orgrolesDescAssignedAt is the schema descriptor for assigned_at field.
+	orgrolesDescAssignedAt := orgrolesFields[5].Descriptor()
+	// orgroles.DefaultAssignedAt holds the default value on creation for the assigned_at field.
+	orgroles.DefaultAssignedAt = orgrolesDescAssignedAt.Default.(func() time.Time)
+	organizationsFields := schema.Organizations{}.Fields()
+	_ = organizationsFields
+	// organizationsDescSlug is the schema descriptor for slug field.
+	organizationsDescSlug := organizationsFields[1].Descriptor()
+	// organizations.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	organizations.SlugValidator = organizationsDescSlug.Validators[0].(func(string) error)
+	// organizationsDescName is the schema descriptor for name field.
+	organizationsDescName := organizationsFields[2].Descriptor()
+	// organizations.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organizations.NameValidator = organizationsDescName.Validators[0].(func(string) error)
+	// organizationsDescMfaRequired is the schema descriptor for mfa_required field.
+	organizationsDescMfaRequired := organizationsFields[4].Descriptor()
+	// organizations.DefaultMfaRequired holds the default value on creation for the mfa_required field.
+	organizations.DefaultMfaRequired = organizationsDescMfaRequired.Default.(bool)
+	// organizationsDescCreatedAt is the schema descriptor for created_at field.
+	organizationsDescCreatedAt := organizationsFields[6].Descriptor()
+	// organizations.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organizations.DefaultCreatedAt = organizationsDescCreatedAt.Default.(func() time.Time)
+	// organizationsDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationsDescUpdatedAt := organizationsFields[7].Descriptor()
+	// organizations.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organizations.DefaultUpdatedAt = organizationsDescUpdatedAt.Default.(func() time.Time)
+	// organizations.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organizations.UpdateDefaultUpdatedAt = organizationsDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationsDescID is the schema descriptor for id field.
+	organizationsDescID := organizationsFields[0].Descriptor()
+	// organizations.DefaultID holds the default value on creation for the id field.
+	organizations.DefaultID = organizationsDescID.Default.(func() uuid.UUID)
+	passwordhistoriesFields := schema.PasswordHistories{}.Fields()
+	_ = passwordhistoriesFields
+	// passwordhistoriesDescPasswordHash is the schema descriptor for password_hash field.
+	passwordhistoriesDescPasswordHash := passwordhistoriesFields[2].Descriptor()
+	// passwordhistories.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	passwordhistories.PasswordHashValidator = passwordhistoriesDescPasswordHash.Validators[0].(func(string) error)
+	// passwordhistoriesDescCreatedAt is the schema descriptor for created_at field.
+	passwordhistoriesDescCreatedAt := passwordhistoriesFields[3].Descriptor()
+	// passwordhistories.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordhistories.DefaultCreatedAt = passwordhistoriesDescCreatedAt.Default.(func() time.Time)
+	// passwordhistoriesDescID is the schema descriptor for id field.
+	passwordhistoriesDescID := passwordhistoriesFields[0].Descriptor()
+	// passwordhistories.DefaultID holds the default value on creation for the id field.
+	passwordhistories.DefaultID = passwordhistoriesDescID.Default.(func() uuid.UUID)
 	passwordresetsFields := schema.PasswordResets{}.Fields()
 	_ = passwordresetsFields
 	// passwordresetsDescEmail is the schema descriptor for email field.
@@ -120,22 +352,92 @@ func init() {
 	permissionsDescName := permissionsFields[2].Descriptor()
 	// permissions.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	permissions.NameValidator = permissionsDescName.Validators[0].(func(string) error)
+	// permissionsDescIsSystem is the schema descriptor for is_system field.
+	permissionsDescIsSystem := permissionsFields[6].Descriptor()
+	// permissions.DefaultIsSystem holds the default value on creation for the is_system field.
+	permissions.DefaultIsSystem = permissionsDescIsSystem.Default.(bool)
 	// permissionsDescCreatedAt is the schema descriptor for created_at field.
-	permissionsDescCreatedAt := permissionsFields[6].Descriptor()
+	permissionsDescCreatedAt := permissionsFields[7].Descriptor()
 	// permissions.DefaultCreatedAt holds the default value on creation for the created_at field.
 	permissions.DefaultCreatedAt = permissionsDescCreatedAt.Default.(func() time.Time)
 	// permissionsDescUpdatedAt is the schema descriptor for updated_at field.
-	permissionsDescUpdatedAt := permissionsFields[7].Descriptor()
+	permissionsDescUpdatedAt := permissionsFields[8].Descriptor()
 	// permissions.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	permissions.DefaultUpdatedAt = permissionsDescUpdatedAt.Default.(func() time.Time)
 	// permissions.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	permissions.UpdateDefaultUpdatedAt = permissionsDescUpdatedAt.UpdateDefault.(func() time.Time)
+	relationtuplesFields := schema.RelationTuples{}.Fields()
+	_ = relationtuplesFields
+	// relationtuplesDescObjectType is the schema descriptor for object_type field.
+	relationtuplesDescObjectType := relationtuplesFields[1].Descriptor()
+	// relationtuples.ObjectTypeValidator is a validator for the "object_type" field. It is called by the builders before save.
+	relationtuples.ObjectTypeValidator = relationtuplesDescObjectType.Validators[0].(func(string) error)
+	// relationtuplesDescObjectID is the schema descriptor for object_id field.
+	relationtuplesDescObjectID := relationtuplesFields[2].Descriptor()
+	// relationtuples.ObjectIDValidator is a validator for the "object_id" field. It is called by the builders before save.
+	relationtuples.ObjectIDValidator = relationtuplesDescObjectID.Validators[0].(func(string) error)
+	// relationtuplesDescRelation is the schema descriptor for relation field.
+	relationtuplesDescRelation := relationtuplesFields[3].Descriptor()
+	// relationtuples.RelationValidator is a validator for the "relation" field. It is called by the builders before save.
+	relationtuples.RelationValidator = relationtuplesDescRelation.Validators[0].(func(string) error)
+	// relationtuplesDescSubjectType is the schema descriptor for subject_type field.
+	relationtuplesDescSubjectType := relationtuplesFields[4].Descriptor()
+	// relationtuples.SubjectTypeValidator is a validator for the "subject_type" field. It is called by the builders before save.
+	relationtuples.SubjectTypeValidator = relationtuplesDescSubjectType.Validators[0].(func(string) error)
+	// relationtuplesDescSubjectID is the schema descriptor for subject_id field.
+	relationtuplesDescSubjectID := relationtuplesFields[5].Descriptor()
+	// relationtuples.SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	relationtuples.SubjectIDValidator = relationtuplesDescSubjectID.Validators[0].(func(string) error)
+	// relationtuplesDescSubjectRelation is the schema descriptor for subject_relation field.
+	relationtuplesDescSubjectRelation := relationtuplesFields[6].Descriptor()
+	// relationtuples.DefaultSubjectRelation holds the default value on creation for the subject_relation field.
+	relationtuples.DefaultSubjectRelation = relationtuplesDescSubjectRelation.Default.(string)
+	// relationtuplesDescCreatedAt is the schema descriptor for created_at field.
+	relationtuplesDescCreatedAt := relationtuplesFields[7].Descriptor()
+	// relationtuples.DefaultCreatedAt holds the default value on creation for the created_at field.
+	relationtuples.DefaultCreatedAt = relationtuplesDescCreatedAt.Default.(func() time.Time)
+	roleapproversFields := schema.RoleApprovers{}.Fields()
+	_ = roleapproversFields
+	// roleapproversDescCreatedAt is the schema descriptor for created_at field.
+	roleapproversDescCreatedAt := roleapproversFields[3].Descriptor()
+	// roleapprovers.DefaultCreatedAt holds the default value on creation for the created_at field.
+	roleapprovers.DefaultCreatedAt = roleapproversDescCreatedAt.Default.(func() time.Time)
+	roleparentsFields := schema.RoleParents{}.Fields()
+	_ = roleparentsFields
+	// roleparentsDescCreatedAt is the schema descriptor for created_at field.
+	roleparentsDescCreatedAt := roleparentsFields[3].Descriptor()
+	// roleparents.DefaultCreatedAt holds the default value on creation for the created_at field.
+	roleparents.DefaultCreatedAt = roleparentsDescCreatedAt.Default.(func() time.Time)
 	rolepermissionsFields := schema.RolePermissions{}.Fields()
 	_ = rolepermissionsFields
 	// rolepermissionsDescAssignedAt is the schema descriptor for assigned_at field.
-	rolepermissionsDescAssignedAt := rolepermissionsFields[3].Descriptor()
+	rolepermissionsDescAssignedAt := rolepermissionsFields[4].Descriptor()
 	// rolepermissions.DefaultAssignedAt holds the default value on creation for the assigned_at field.
 	rolepermissions.DefaultAssignedAt = rolepermissionsDescAssignedAt.Default.(func() time.Time)
+	rolerequestsFields := schema.RoleRequests{}.Fields()
+	_ = rolerequestsFields
+	// rolerequestsDescReason is the schema descriptor for reason field.
+	rolerequestsDescReason := rolerequestsFields[3].Descriptor()
+	// rolerequests.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	rolerequests.ReasonValidator = rolerequestsDescReason.Validators[0].(func(string) error)
+	// rolerequestsDescBreakGlass is the schema descriptor for break_glass field.
+	rolerequestsDescBreakGlass := rolerequestsFields[5].Descriptor()
+	// rolerequests.DefaultBreakGlass holds the default value on creation for the break_glass field.
+	rolerequests.DefaultBreakGlass = rolerequestsDescBreakGlass.Default.(bool)
+	// rolerequestsDescCreatedAt is the schema descriptor for created_at field.
+	rolerequestsDescCreatedAt := rolerequestsFields[10].Descriptor()
+	// rolerequests.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rolerequests.DefaultCreatedAt = rolerequestsDescCreatedAt.Default.(func() time.Time)
+	// rolerequestsDescUpdatedAt is the schema descriptor for updated_at field.
+	rolerequestsDescUpdatedAt := rolerequestsFields[11].Descriptor()
+	// rolerequests.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rolerequests.DefaultUpdatedAt = rolerequestsDescUpdatedAt.Default.(func() time.Time)
+	// rolerequests.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rolerequests.UpdateDefaultUpdatedAt = rolerequestsDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// rolerequestsDescID is the schema descriptor for id field.
+	rolerequestsDescID := rolerequestsFields[0].Descriptor()
+	// rolerequests.DefaultID holds the default value on creation for the id field.
+	rolerequests.DefaultID = rolerequestsDescID.Default.(func() uuid.UUID)
 	rolesFields := schema.Roles{}.Fields()
 	_ = rolesFields
 	// rolesDescCode is the schema descriptor for code field.
@@ -154,16 +456,44 @@ func init() {
 	rolesDescIsDefault := rolesFields[5].Descriptor()
 	// roles.DefaultIsDefault holds the default value on creation for the is_default field.
 	roles.DefaultIsDefault = rolesDescIsDefault.Default.(bool)
+	// rolesDescOrgAssignable is the schema descriptor for org_assignable field.
+	rolesDescOrgAssignable := rolesFields[7].Descriptor()
+	// roles.DefaultOrgAssignable holds the default value on creation for the org_assignable field.
+	roles.DefaultOrgAssignable = rolesDescOrgAssignable.Default.(bool)
 	// rolesDescCreatedAt is the schema descriptor for created_at field.
-	rolesDescCreatedAt := rolesFields[7].Descriptor()
+	rolesDescCreatedAt := rolesFields[10].Descriptor()
 	// roles.DefaultCreatedAt holds the default value on creation for the created_at field.
 	roles.DefaultCreatedAt = rolesDescCreatedAt.Default.(func() time.Time)
 	// rolesDescUpdatedAt is the schema descriptor for updated_at field.
-	rolesDescUpdatedAt := rolesFields[8].Descriptor()
+	rolesDescUpdatedAt := rolesFields[11].Descriptor()
 	// roles.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	roles.DefaultUpdatedAt = rolesDescUpdatedAt.Default.(func() time.Time)
 	// roles.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	roles.UpdateDefaultUpdatedAt = rolesDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sodconstraintsFields := schema.SodConstraints{}.Fields()
+	_ = sodconstraintsFields
+	// sodconstraintsDescCode is the schema descriptor for code field.
+	sodconstraintsDescCode := sodconstraintsFields[1].Descriptor()
+	// sodconstraints.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	sodconstraints.CodeValidator = sodconstraintsDescCode.Validators[0].(func(string) error)
+	// sodconstraintsDescName is the schema descriptor for name field.
+	sodconstraintsDescName := sodconstraintsFields[2].Descriptor()
+	// sodconstraints.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	sodconstraints.NameValidator = sodconstraintsDescName.Validators[0].(func(string) error)
+	// sodconstraintsDescIsSystem is the schema descriptor for is_system field.
+	sodconstraintsDescIsSystem := sodconstraintsFields[4].Descriptor()
+	// sodconstraints.DefaultIsSystem holds the default value on creation for the is_system field.
+	sodconstraints.DefaultIsSystem = sodconstraintsDescIsSystem.Default.(bool)
+	// sodconstraintsDescCreatedAt is the schema descriptor for created_at field.
+	sodconstraintsDescCreatedAt := sodconstraintsFields[5].Descriptor()
+	// sodconstraints.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sodconstraints.DefaultCreatedAt = sodconstraintsDescCreatedAt.Default.(func() time.Time)
+	// sodconstraintsDescUpdatedAt is the schema descriptor for updated_at field.
+	sodconstraintsDescUpdatedAt := sodconstraintsFields[6].Descriptor()
+	// sodconstraints.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sodconstraints.DefaultUpdatedAt = sodconstraintsDescUpdatedAt.Default.(func() time.Time)
+	// sodconstraints.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sodconstraints.UpdateDefaultUpdatedAt = sodconstraintsDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userrolesFields := schema.UserRoles{}.Fields()
 	_ = userrolesFields
 	// userrolesDescAssignedAt is the schema descriptor for assigned_at field.
@@ -210,6 +540,10 @@ func init() {
 	usersDescEmailVerified := usersFields[9].Descriptor()
 	// users.DefaultEmailVerified holds the default value on creation for the email_verified field.
 	users.DefaultEmailVerified = usersDescEmailVerified.Default.(bool)
+	// usersDescPasswordResetRequired is the schema descriptor for password_reset_required field.
+	usersDescPasswordResetRequired := usersFields[14].Descriptor()
+	// users.DefaultPasswordResetRequired holds the default value on creation for the password_reset_required field.
+	users.DefaultPasswordResetRequired = usersDescPasswordResetRequired.Default.(bool)
 	// usersDescID is the schema descriptor for id field.
 	usersDescID := usersFields[0].Descriptor()
 	// users.DefaultID holds the default value on creation for the id field.
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// APIKeys holds the schema definition for the APIKeys entity.
+// An API key is a personal access token for non-interactive clients. Only
+// a hash of the key is stored; the prefix identifies it on lookup.
+type APIKeys struct {
+	ent.Schema
+}
+
+// Fields of the APIKeys.
+func (APIKeys) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("User the key authenticates as"),
+		field.String("name").
+			NotEmpty(),
+		field.String("prefix").
+			NotEmpty().
+			Unique().
+			Comment("Public part of the key used to look it up"),
+		field.String("key_hash").
+			NotEmpty().
+			Sensitive().
+			Comment("SHA-256 of the full key"),
+		field.JSON("scopes", []string{}).
+			Comment("Permission codes the key is limited to"),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.String("last_used_ip").
+			Optional(),
+		field.UUID("revoked_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the APIKeys.
+func (APIKeys) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the APIKeys.
+func (APIKeys) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
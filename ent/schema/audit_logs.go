@@ -5,6 +5,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -37,6 +38,10 @@ func (AuditLogs) Fields() []ent.Field {
 		field.JSON("changes", map[string]interface{}{}).
 			Optional().
 			Comment("Before/after values for updates"),
+		field.UUID("org_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Organization the action was taken in"),
 		field.String("ip_address").
 			Optional(),
 		field.String("user_agent").
@@ -51,3 +56,10 @@ func (AuditLogs) Fields() []ent.Field {
 func (AuditLogs) Edges() []ent.Edge {
 	return nil
 }
+
+// Indexes of the AuditLogs.
+func (AuditLogs) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("org_id", "created_at"),
+	}
+}
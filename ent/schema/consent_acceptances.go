@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ConsentAcceptances holds the schema definition for the ConsentAcceptances entity.
+// An acceptance records that a user accepted one version of a consent
+// document, when and from where. The document's type and version are
+// copied so the record stands on its own.
+type ConsentAcceptances struct {
+	ent.Schema
+}
+
+// Fields of the ConsentAcceptances.
+func (ConsentAcceptances) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("document_id", uuid.UUID{}).
+			Immutable(),
+		field.String("document_type").
+			NotEmpty().
+			Immutable(),
+		field.String("version").
+			NotEmpty().
+			Immutable(),
+		field.String("ip_address").
+			Optional().
+			Immutable(),
+		field.String("user_agent").
+			Optional().
+			Immutable(),
+		field.Time("accepted_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ConsentAcceptances.
+func (ConsentAcceptances) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ConsentAcceptances.
+func (ConsentAcceptances) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "document_id").
+			Unique(),
+		index.Fields("document_id"),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ConsentDocuments holds the schema definition for the ConsentDocuments entity.
+// A consent document is one version of a policy users accept, such as the
+// terms of service or the privacy policy. Versions are never edited; a
+// change is published as a new version.
+type ConsentDocuments struct {
+	ent.Schema
+}
+
+// Fields of the ConsentDocuments.
+func (ConsentDocuments) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("type").
+			NotEmpty().
+			Immutable().
+			Comment("Kind of document, e.g. terms or privacy"),
+		field.String("version").
+			NotEmpty().
+			Immutable(),
+		field.String("title").
+			NotEmpty().
+			Immutable(),
+		field.String("url").
+			Optional().
+			Immutable().
+			Comment("Where the full text is published"),
+		field.Text("content").
+			Optional().
+			Immutable(),
+		field.Bool("mandatory").
+			Default(true).
+			Immutable().
+			Comment("Users must accept this version before tokens are issued"),
+		field.Time("published_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the version takes effect"),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ConsentDocuments.
+func (ConsentDocuments) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ConsentDocuments.
+func (ConsentDocuments) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("type", "version").
+			Unique(),
+		index.Fields("type", "published_at"),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EmailChanges holds the schema definition for the EmailChanges entity.
+// An email change applies once the new address is confirmed, and the old
+// address can undo it for a while through its own link.
+type EmailChanges struct {
+	ent.Schema
+}
+
+// Fields of the EmailChanges.
+func (EmailChanges) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("User changing their email"),
+		field.String("old_email").
+			NotEmpty(),
+		field.Bool("old_email_verified").
+			Default(false).
+			Comment("Restored when the change is undone"),
+		field.String("new_email").
+			NotEmpty().
+			Comment("Requested address, lowercased"),
+		field.String("token").
+			NotEmpty().
+			Unique().
+			Comment("Sent to the new address to confirm the change"),
+		field.String("undo_token").
+			NotEmpty().
+			Unique().
+			Comment("Sent to the old address to cancel or revert the change"),
+		field.Enum("status").
+			Values("pending", "confirmed", "cancelled", "reverted").
+			Default("pending"),
+		field.Time("expires_at").
+			Comment("When the confirmation link expires"),
+		field.Time("undo_expires_at").
+			Comment("When the undo link expires"),
+		field.Time("confirmed_at").
+			Optional().
+			Nillable(),
+		field.Time("reverted_at").
+			Optional().
+			Nillable(),
+		field.String("ip_address").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the EmailChanges.
+func (EmailChanges) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the EmailChanges.
+func (EmailChanges) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "status"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// GroupMembers holds the schema definition for the GroupMembers entity (join table).
+type GroupMembers struct {
+	ent.Schema
+}
+
+// Fields of the GroupMembers.
+func (GroupMembers) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Int("group_id"),
+		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("added_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("User who added the member"),
+		field.Time("added_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the GroupMembers.
+func (GroupMembers) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("group", Groups.Type).
+			Unique().
+			Required().
+			Field("group_id"),
+		edge.To("user", Users.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the GroupMembers.
+func (GroupMembers) Indexes() []ent.Index {
+	return []ent.Index{
+		// Unique constraint on group_id + user_id
+		index.Fields("group_id", "user_id").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
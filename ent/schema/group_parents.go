@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GroupParents holds the schema definition for the GroupParents entity (join table).
+// A group nested in a parent group makes its members members of the parent.
+type GroupParents struct {
+	ent.Schema
+}
+
+// Fields of the GroupParents.
+func (GroupParents) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("group_id").
+			Comment("Nested group"),
+		field.Int("parent_group_id").
+			Comment("Group the nested group's members also belong to"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the GroupParents.
+func (GroupParents) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("group", Groups.Type).
+			Unique().
+			Required().
+			Field("group_id"),
+		edge.To("parent", Groups.Type).
+			Unique().
+			Required().
+			Field("parent_group_id"),
+	}
+}
+
+// Indexes of the GroupParents.
+func (GroupParents) Indexes() []ent.Index {
+	return []ent.Index{
+		// Unique constraint on group_id + parent_group_id
+		index.Fields("group_id", "parent_group_id").
+			Unique(),
+	}
+}
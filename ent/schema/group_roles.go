@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// GroupRoles holds the schema definition for the GroupRoles entity (join table).
+type GroupRoles struct {
+	ent.Schema
+}
+
+// Fields of the GroupRoles.
+func (GroupRoles) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("group_id"),
+		field.Int("role_id"),
+		field.UUID("assigned_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("User who assigned this role to the group"),
+		field.Time("assigned_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the GroupRoles.
+func (GroupRoles) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("group", Groups.Type).
+			Unique().
+			Required().
+			Field("group_id"),
+		edge.To("role", Roles.Type).
+			Unique().
+			Required().
+			Field("role_id"),
+	}
+}
+
+// Indexes of the GroupRoles.
+func (GroupRoles) Indexes() []ent.Index {
+	return []ent.Index{
+		// Unique constraint on group_id + role_id
+		index.Fields("group_id", "role_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Groups holds the schema definition for the Groups entity.
+// Members of a group hold every role assigned to the group.
+type Groups struct {
+	ent.Schema
+}
+
+// Fields of the Groups.
+func (Groups) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.String("code").
+			Unique().
+			NotEmpty().
+			Comment("Unique code identifier (e.g., 'platform-team')"),
+		field.String("name").
+			NotEmpty(),
+		field.String("description").
+			Optional(),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("User who created the group"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Groups.
+func (Groups) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("members", GroupMembers.Type).
+			Ref("group"),
+		edge.From("group_roles", GroupRoles.Type).
+			Ref("group"),
+		edge.From("parent_links", GroupParents.Type).
+			Ref("group"),
+		edge.From("child_links", GroupParents.Type).
+			Ref("parent"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Invitations holds the schema definition for the Invitations entity.
+// An invitation lets the owner of an email address create or link an
+// account and receive a preset set of roles.
+type Invitations struct {
+	ent.Schema
+}
+
+// Fields of the Invitations.
+func (Invitations) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("email").
+			NotEmpty().
+			Comment("Address the invitation was sent to, lowercased"),
+		field.JSON("role_ids", []int{}).
+			Optional().
+			Comment("Roles assigned when the invitation is accepted"),
+		field.UUID("invited_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("User who sent the invitation"),
+		field.Enum("status").
+			Values("pending", "accepted", "revoked").
+			Default("pending"),
+		field.Time("expires_at"),
+		field.UUID("accepted_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Account the invitation was accepted with"),
+		field.Time("accepted_at").
+			Optional().
+			Nillable(),
+		field.UUID("revoked_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Invitations.
+func (Invitations) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Invitations.
+func (Invitations) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email", "status"),
+		index.Fields("status", "created_at"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OrgMembers holds the schema definition for the OrgMembers entity (join table).
+type OrgMembers struct {
+	ent.Schema
+}
+
+// Fields of the OrgMembers.
+func (OrgMembers) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("org_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("added_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("User who added the member"),
+		field.Time("joined_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the OrgMembers.
+func (OrgMembers) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("org", Organizations.Type).
+			Unique().
+			Required().
+			Field("org_id"),
+		edge.To("user", Users.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the OrgMembers.
+func (OrgMembers) Indexes() []ent.Index {
+	return []ent.Index{
+		// Unique constraint on org_id + user_id
+		index.Fields("org_id", "user_id").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OrgRoles holds the schema definition for the OrgRoles entity. Each row
+// assigns a role to a member within one organization.
+type OrgRoles struct {
+	ent.Schema
+}
+
+// Fields of the OrgRoles.
+func (OrgRoles) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.UUID("org_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Int("role_id"),
+		field.UUID("assigned_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("User who assigned this role"),
+		field.Time("assigned_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the OrgRoles.
+func (OrgRoles) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("org", Organizations.Type).
+			Unique().
+			Required().
+			Field("org_id"),
+		edge.To("user", Users.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+		edge.To("role", Roles.Type).
+			Unique().
+			Required().
+			Field("role_id"),
+	}
+}
+
+// Indexes of the OrgRoles.
+func (OrgRoles) Indexes() []ent.Index {
+	return []ent.Index{
+		// Unique constraint on org_id + user_id + role_id
+		index.Fields("org_id", "user_id", "role_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Organizations holds the schema definition for the Organizations entity.
+// Members hold the roles assigned to them in an organization only while
+// their token is scoped to it.
+type Organizations struct {
+	ent.Schema
+}
+
+// Fields of the Organizations.
+func (Organizations) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("slug").
+			Unique().
+			NotEmpty().
+			Comment("Unique slug identifier (e.g., 'acme-corp')"),
+		field.String("name").
+			NotEmpty(),
+		field.JSON("allowed_domains", []string{}).
+			Optional().
+			Comment("Email domains members must belong to; empty allows any"),
+		field.Bool("mfa_required").
+			Default(false).
+			Comment("Whether members must have completed MFA to act in the organization"),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("User who created the organization"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Organizations.
+func (Organizations) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("members", OrgMembers.Type).
+			Ref("org"),
+		edge.From("org_roles", OrgRoles.Type).
+			Ref("org"),
+	}
+}
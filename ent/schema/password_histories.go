@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PasswordHistories holds the schema definition for the PasswordHistories entity.
+type PasswordHistories struct {
+	ent.Schema
+}
+
+// Fields of the PasswordHistories.
+func (PasswordHistories) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("password_hash").
+			NotEmpty().
+			Sensitive().
+			Comment("Hash of a password previously set by the user"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PasswordHistories.
+func (PasswordHistories) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PasswordHistories.
+func (PasswordHistories) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
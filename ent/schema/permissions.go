@@ -31,6 +31,9 @@ func (Permissions) Fields() []ent.Field {
 		field.String("action").
 			Optional().
 			Comment("Action this permission allows (e.g., read, write, delete)"),
+		field.Bool("is_system").
+			Default(false).
+			Comment("System permissions come from the bootstrap config and cannot be deleted or modified via API"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
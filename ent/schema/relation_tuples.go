@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RelationTuples holds the schema definition for the RelationTuples entity.
+// A tuple "document:42#editor@user:abc" states that the subject user:abc has
+// the editor relation to the object document:42. A subject relation makes the
+// subject a userset, e.g. "document:42#viewer@group:eng#member".
+type RelationTuples struct {
+	ent.Schema
+}
+
+// Fields of the RelationTuples.
+func (RelationTuples) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.String("object_type").
+			NotEmpty(),
+		field.String("object_id").
+			NotEmpty(),
+		field.String("relation").
+			NotEmpty(),
+		field.String("subject_type").
+			NotEmpty(),
+		field.String("subject_id").
+			NotEmpty(),
+		field.String("subject_relation").
+			Default("").
+			Comment("Relation of the subject object for usersets; empty for direct subjects"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the RelationTuples.
+func (RelationTuples) Indexes() []ent.Index {
+	return []ent.Index{
+		// Forward lookups: who has a relation to an object
+		index.Fields("object_type", "object_id", "relation", "subject_type", "subject_id", "subject_relation").
+			Unique(),
+		// Reverse lookups: what a subject has relations to
+		index.Fields("subject_type", "subject_id", "subject_relation"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoleApprovers holds the schema definition for the RoleApprovers entity (join table).
+// Holders of the approver role may approve or deny requests for the role.
+type RoleApprovers struct {
+	ent.Schema
+}
+
+// Fields of the RoleApprovers.
+func (RoleApprovers) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("role_id").
+			Comment("Role being requested"),
+		field.Int("approver_role_id").
+			Comment("Role whose holders decide requests"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the RoleApprovers.
+func (RoleApprovers) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("role", Roles.Type).
+			Unique().
+			Required().
+			Field("role_id"),
+		edge.To("approver_role", Roles.Type).
+			Unique().
+			Required().
+			Field("approver_role_id"),
+	}
+}
+
+// Indexes of the RoleApprovers.
+func (RoleApprovers) Indexes() []ent.Index {
+	return []ent.Index{
+		// Unique constraint on role_id + approver_role_id
+		index.Fields("role_id", "approver_role_id").
+			Unique(),
+	}
+}
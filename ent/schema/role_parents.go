@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RoleParents holds the schema definition for the RoleParents entity (join table).
+// A role inherits every permission of its parent roles.
+type RoleParents struct {
+	ent.Schema
+}
+
+// Fields of the RoleParents.
+func (RoleParents) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("role_id").
+			Comment("Role that inherits"),
+		field.Int("parent_role_id").
+			Comment("Role whose permissions are inherited"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the RoleParents.
+func (RoleParents) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("role", Roles.Type).
+			Unique().
+			Required().
+			Field("role_id"),
+		edge.To("parent", Roles.Type).
+			Unique().
+			Required().
+			Field("parent_role_id"),
+	}
+}
+
+// Indexes of the RoleParents.
+func (RoleParents) Indexes() []ent.Index {
+	return []ent.Index{
+		// Unique constraint on role_id + parent_role_id
+		index.Fields("role_id", "parent_role_id").
+			Unique(),
+	}
+}
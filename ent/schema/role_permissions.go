@@ -20,6 +20,9 @@ func (RolePermissions) Fields() []ent.Field {
 		field.Int("id"),
 		field.Int("role_id"),
 		field.Int("permission_id"),
+		field.String("condition").
+			Optional().
+			Comment("Expression that must hold for the grant to apply; empty grants unconditionally"),
 		field.Time("assigned_at").
 			Default(time.Now).
 			Immutable(),
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RoleRequests holds the schema definition for the RoleRequests entity.
+// A request is granted as a UserRoles assignment once approved.
+type RoleRequests struct {
+	ent.Schema
+}
+
+// Fields of the RoleRequests.
+func (RoleRequests) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("User who requested the role"),
+		field.Int("role_id"),
+		field.String("reason").
+			NotEmpty().
+			Comment("Justification given by the requester"),
+		field.Int("duration_hours").
+			Optional().
+			Nillable().
+			Comment("How long the role is granted for once approved (null = permanent)"),
+		field.Bool("break_glass").
+			Default(false).
+			Comment("Emergency request for a privileged role"),
+		field.Enum("status").
+			Values("pending", "approved", "denied", "expired").
+			Default("pending"),
+		field.UUID("decided_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("User who approved or denied the request (null = automatic)"),
+		field.Time("decided_at").
+			Optional().
+			Nillable(),
+		field.String("decision_note").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the RoleRequests.
+func (RoleRequests) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the RoleRequests.
+func (RoleRequests) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+		index.Fields("user_id", "role_id", "status"),
+	}
+}
@@ -35,6 +35,17 @@ func (Roles) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Maximum users allowed for this role (null = unlimited)"),
+		field.Bool("org_assignable").
+			Default(false).
+			Comment("Organization administrators may assign this role within their organization"),
+		field.Enum("break_glass").
+			Values("disabled", "auto", "approval").
+			Default("disabled").
+			Comment("Whether users may request this role for emergencies, and if that needs approval"),
+		field.Int("break_glass_max_hours").
+			Optional().
+			Nillable().
+			Comment("Longest break-glass grant (null = BREAK_GLASS_MAX_HOURS)"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -51,5 +62,19 @@ func (Roles) Edges() []ent.Edge {
 			Ref("role"),
 		edge.From("role_permissions", RolePermissions.Type).
 			Ref("role"),
+		edge.From("parent_links", RoleParents.Type).
+			Ref("role"),
+		edge.From("child_links", RoleParents.Type).
+			Ref("parent"),
+		edge.From("approver_links", RoleApprovers.Type).
+			Ref("role"),
+		edge.From("approves_links", RoleApprovers.Type).
+			Ref("approver_role"),
+		edge.From("sod_constraint_roles", SodConstraintRoles.Type).
+			Ref("role"),
+		edge.From("group_roles", GroupRoles.Type).
+			Ref("role"),
+		edge.From("org_roles", OrgRoles.Type).
+			Ref("role"),
 	}
 }
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SodConstraintRoles holds the schema definition for the SodConstraintRoles entity (join table).
+type SodConstraintRoles struct {
+	ent.Schema
+}
+
+// Fields of the SodConstraintRoles.
+func (SodConstraintRoles) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("constraint_id"),
+		field.Int("role_id"),
+	}
+}
+
+// Edges of the SodConstraintRoles.
+func (SodConstraintRoles) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("sod_constraint", SodConstraints.Type).
+			Unique().
+			Required().
+			Field("constraint_id"),
+		edge.To("role", Roles.Type).
+			Unique().
+			Required().
+			Field("role_id"),
+	}
+}
+
+// Indexes of the SodConstraintRoles.
+func (SodConstraintRoles) Indexes() []ent.Index {
+	return []ent.Index{
+		// Unique constraint on constraint_id + role_id
+		index.Fields("constraint_id", "role_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SodConstraints holds the schema definition for the SodConstraints entity.
+// A separation-of-duties constraint names a set of mutually exclusive roles:
+// no user may hold more than one of them.
+type SodConstraints struct {
+	ent.Schema
+}
+
+// Fields of the SodConstraints.
+func (SodConstraints) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.String("code").
+			Unique().
+			NotEmpty().
+			Comment("Unique code identifier for the constraint"),
+		field.String("name").
+			NotEmpty(),
+		field.String("description").
+			Optional(),
+		field.Bool("is_system").
+			Default(false).
+			Comment("Constraints from the RBAC config cannot be modified via API"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SodConstraints.
+func (SodConstraints) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("constraint_roles", SodConstraintRoles.Type).
+			Ref("sod_constraint"),
+	}
+}
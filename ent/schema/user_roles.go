@@ -29,6 +29,13 @@ func (UserRoles) Fields() []ent.Field {
 		field.Time("assigned_at").
 			Default(time.Now).
 			Immutable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("When the assignment is revoked automatically (null = permanent)"),
+		field.String("reason").
+			Optional().
+			Comment("Why the role was assigned"),
 	}
 }
 
@@ -52,5 +59,6 @@ func (UserRoles) Indexes() []ent.Index {
 		// Unique constraint on user_id + role_id
 		index.Fields("user_id", "role_id").
 			Unique(),
+		index.Fields("expires_at"),
 	}
 }
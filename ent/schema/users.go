@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 
 	"github.com/google/uuid"
 )
@@ -48,15 +49,53 @@ func (Users) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.Bool("password_reset_required").
+			Default(false).
+			Comment("Set by an admin to block signin until the password is reset"),
+
+		field.Enum("approval_status").
+			Values("approved", "pending", "rejected").
+			Default("approved").
+			Comment("Admin review state of self-service signups when SIGNUP_MODE=approval"),
+		field.UUID("reviewed_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Admin who approved or rejected the signup"),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+
+		field.Time("deletion_requested_at").
+			Optional().
+			Nillable(),
+		field.Time("deletion_scheduled_at").
+			Optional().
+			Nillable().
+			Comment("When the account is deleted, unless the user cancels first"),
+
 		field.JSON("metadata", map[string]any{}).
 			Optional(),
 	}
 }
 
+// Indexes of the Users.
+func (Users) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("approval_status", "created_at"),
+		index.Fields("deletion_scheduled_at"),
+	}
+}
+
 // Edges of the Users.
 func (Users) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user_roles", UserRoles.Type).
 			Ref("user"),
+		edge.From("group_memberships", GroupMembers.Type).
+			Ref("user"),
+		edge.From("org_memberships", OrgMembers.Type).
+			Ref("user"),
+		edge.From("org_roles", OrgRoles.Type).
+			Ref("user"),
 	}
 }
@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
+)
+
+// SodConstraintRoles is the model entity for the SodConstraintRoles schema.
+type SodConstraintRoles struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ConstraintID holds the value of the "constraint_id" field.
+	ConstraintID int `json:"constraint_id,omitempty"`
+	// RoleID holds the value of the "role_id" field.
+	RoleID int `json:"role_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SodConstraintRolesQuery when eager-loading is set.
+	Edges        SodConstraintRolesEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SodConstraintRolesEdges holds the relations/edges for other nodes in the graph.
+type SodConstraintRolesEdges struct {
+	// SodConstraint holds the value of the sod_constraint edge.
+	SodConstraint *SodConstraints `json:"sod_constraint,omitempty"`
+	// Role holds the value of the role edge.
+	Role *Roles `json:"role,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SodConstraintOrErr returns the SodConstraint value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SodConstraintRolesEdges) SodConstraintOrErr() (*SodConstraints, error) {
+	if e.SodConstraint != nil {
+		return e.SodConstraint, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: sodconstraints.Label}
+	}
+	return nil, &NotLoadedError{edge: "sod_constraint"}
+}
+
+// RoleOrErr returns the Role value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SodConstraintRolesEdges) RoleOrErr() (*Roles, error) {
+	if e.Role != nil {
+		return e.Role, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: roles.Label}
+	}
+	return nil, &NotLoadedError{edge: "role"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SodConstraintRoles) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sodconstraintroles.FieldID, sodconstraintroles.FieldConstraintID, sodconstraintroles.FieldRoleID:
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SodConstraintRoles fields.
+func (scr *SodConstraintRoles) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sodconstraintroles.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			scr.ID = int(value.Int64)
+		case sodconstraintroles.FieldConstraintID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field constraint_id", values[i])
+			} else if value.Valid {
+				scr.ConstraintID = int(value.Int64)
+			}
+		case sodconstraintroles.FieldRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				scr.RoleID = int(value.Int64)
+			}
+		default:
+			scr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SodConstraintRoles.
+// This includes values selected through modifiers, order, etc.
+func (scr *SodConstraintRoles) Value(name string) (ent.Value, error) {
+	return scr.selectValues.Get(name)
+}
+
+// QuerySodConstraint queries the "sod_constraint" edge of the SodConstraintRoles entity.
+func (scr *SodConstraintRoles) QuerySodConstraint() *SodConstraintsQuery {
+	return NewSodConstraintRolesClient(scr.config).QuerySodConstraint(scr)
+}
+
+// QueryRole queries the "role" edge of the SodConstraintRoles entity.
+func (scr *SodConstraintRoles) QueryRole() *RolesQuery {
+	return NewSodConstraintRolesClient(scr.config).QueryRole(scr)
+}
+
+// Update returns a builder for updating this SodConstraintRoles.
+// Note that you need to call SodConstraintRoles.Unwrap() before calling this method if this SodConstraintRoles
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (scr *SodConstraintRoles) Update() *SodConstraintRolesUpdateOne {
+	return NewSodConstraintRolesClient(scr.config).UpdateOne(scr)
+}
+
+// Unwrap unwraps the SodConstraintRoles entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (scr *SodConstraintRoles) Unwrap() *SodConstraintRoles {
+	_tx, ok := scr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SodConstraintRoles is not a transactional entity")
+	}
+	scr.config.driver = _tx.drv
+	return scr
+}
+
+// String implements the fmt.Stringer.
+func (scr *SodConstraintRoles) String() string {
+	var builder strings.Builder
+	builder.WriteString("SodConstraintRoles(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", scr.ID))
+	builder.WriteString("constraint_id=")
+	builder.WriteString(fmt.Sprintf("%v", scr.ConstraintID))
+	builder.WriteString(", ")
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", scr.RoleID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SodConstraintRolesSlice is a parsable slice of SodConstraintRoles.
+type SodConstraintRolesSlice []*SodConstraintRoles
@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sodconstraintroles
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sodconstraintroles type in the database.
+	Label = "sod_constraint_roles"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldConstraintID holds the string denoting the constraint_id field in the database.
+	FieldConstraintID = "constraint_id"
+	// FieldRoleID holds the string denoting the role_id field in the database.
+	FieldRoleID = "role_id"
+	// EdgeSodConstraint holds the string denoting the sod_constraint edge name in mutations.
+	EdgeSodConstraint = "sod_constraint"
+	// EdgeRole holds the string denoting the role edge name in mutations.
+	EdgeRole = "role"
+	// Table holds the table name of the sodconstraintroles in the database.
+	Table = "sod_constraint_roles"
+	// SodConstraintTable is the table that holds the sod_constraint relation/edge.
+	SodConstraintTable = "sod_constraint_roles"
+	// SodConstraintInverseTable is the table name for the SodConstraints entity.
+	// It exists in this package in order to avoid circular dependency with the "sodconstraints" package.
+	SodConstraintInverseTable = "sod_constraints"
+	// SodConstraintColumn is the table column denoting the sod_constraint relation/edge.
+	SodConstraintColumn = "constraint_id"
+	// RoleTable is the table that holds the role relation/edge.
+	RoleTable = "sod_constraint_roles"
+	// RoleInverseTable is the table name for the Roles entity.
+	// It exists in this package in order to avoid circular dependency with the "roles" package.
+	RoleInverseTable = "roles"
+	// RoleColumn is the table column denoting the role relation/edge.
+	RoleColumn = "role_id"
+)
+
+// Columns holds all SQL columns for sodconstraintroles fields.
+var Columns = []string{
+	FieldID,
+	FieldConstraintID,
+	FieldRoleID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the SodConstraintRoles queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByConstraintID orders the results by the constraint_id field.
+func ByConstraintID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConstraintID, opts...).ToFunc()
+}
+
+// ByRoleID orders the results by the role_id field.
+func ByRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleID, opts...).ToFunc()
+}
+
+// BySodConstraintField orders the results by sod_constraint field.
+func BySodConstraintField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSodConstraintStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRoleField orders the results by role field.
+func ByRoleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRoleStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSodConstraintStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SodConstraintInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SodConstraintTable, SodConstraintColumn),
+	)
+}
+func newRoleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RoleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RoleTable, RoleColumn),
+	)
+}
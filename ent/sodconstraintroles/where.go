@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sodconstraintroles
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/shammianand/go-auth/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldLTE(FieldID, id))
+}
+
+// ConstraintID applies equality check predicate on the "constraint_id" field. It's identical to ConstraintIDEQ.
+func ConstraintID(v int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldEQ(FieldConstraintID, v))
+}
+
+// RoleID applies equality check predicate on the "role_id" field. It's identical to RoleIDEQ.
+func RoleID(v int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldEQ(FieldRoleID, v))
+}
+
+// ConstraintIDEQ applies the EQ predicate on the "constraint_id" field.
+func ConstraintIDEQ(v int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldEQ(FieldConstraintID, v))
+}
+
+// ConstraintIDNEQ applies the NEQ predicate on the "constraint_id" field.
+func ConstraintIDNEQ(v int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldNEQ(FieldConstraintID, v))
+}
+
+// ConstraintIDIn applies the In predicate on the "constraint_id" field.
+func ConstraintIDIn(vs ...int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldIn(FieldConstraintID, vs...))
+}
+
+// ConstraintIDNotIn applies the NotIn predicate on the "constraint_id" field.
+func ConstraintIDNotIn(vs ...int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldNotIn(FieldConstraintID, vs...))
+}
+
+// RoleIDEQ applies the EQ predicate on the "role_id" field.
+func RoleIDEQ(v int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldEQ(FieldRoleID, v))
+}
+
+// RoleIDNEQ applies the NEQ predicate on the "role_id" field.
+func RoleIDNEQ(v int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldNEQ(FieldRoleID, v))
+}
+
+// RoleIDIn applies the In predicate on the "role_id" field.
+func RoleIDIn(vs ...int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldIn(FieldRoleID, vs...))
+}
+
+// RoleIDNotIn applies the NotIn predicate on the "role_id" field.
+func RoleIDNotIn(vs ...int) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.FieldNotIn(FieldRoleID, vs...))
+}
+
+// HasSodConstraint applies the HasEdge predicate on the "sod_constraint" edge.
+func HasSodConstraint() predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SodConstraintTable, SodConstraintColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSodConstraintWith applies the HasEdge predicate on the "sod_constraint" edge with a given conditions (other predicates).
+func HasSodConstraintWith(preds ...predicate.SodConstraints) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(func(s *sql.Selector) {
+		step := newSodConstraintStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRole applies the HasEdge predicate on the "role" edge.
+func HasRole() predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RoleTable, RoleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRoleWith applies the HasEdge predicate on the "role" edge with a given conditions (other predicates).
+func HasRoleWith(preds ...predicate.Roles) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(func(s *sql.Selector) {
+		step := newRoleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SodConstraintRoles) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SodConstraintRoles) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SodConstraintRoles) predicate.SodConstraintRoles {
+	return predicate.SodConstraintRoles(sql.NotPredicates(p))
+}
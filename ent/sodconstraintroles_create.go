@@ -0,0 +1,587 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
+)
+
+// SodConstraintRolesCreate is the builder for creating a SodConstraintRoles entity.
+type SodConstraintRolesCreate struct {
+	config
+	mutation *SodConstraintRolesMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetConstraintID sets the "constraint_id" field.
+func (scrc *SodConstraintRolesCreate) SetConstraintID(i int) *SodConstraintRolesCreate {
+	scrc.mutation.SetConstraintID(i)
+	return scrc
+}
+
+// SetRoleID sets the "role_id" field.
+func (scrc *SodConstraintRolesCreate) SetRoleID(i int) *SodConstraintRolesCreate {
+	scrc.mutation.SetRoleID(i)
+	return scrc
+}
+
+// SetID sets the "id" field.
+func (scrc *SodConstraintRolesCreate) SetID(i int) *SodConstraintRolesCreate {
+	scrc.mutation.SetID(i)
+	return scrc
+}
+
+// SetSodConstraintID sets the "sod_constraint" edge to the SodConstraints entity by ID.
+func (scrc *SodConstraintRolesCreate) SetSodConstraintID(id int) *SodConstraintRolesCreate {
+	scrc.mutation.SetSodConstraintID(id)
+	return scrc
+}
+
+// SetSodConstraint sets the "sod_constraint" edge to the SodConstraints entity.
+func (scrc *SodConstraintRolesCreate) SetSodConstraint(s *SodConstraints) *SodConstraintRolesCreate {
+	return scrc.SetSodConstraintID(s.ID)
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (scrc *SodConstraintRolesCreate) SetRole(r *Roles) *SodConstraintRolesCreate {
+	return scrc.SetRoleID(r.ID)
+}
+
+// Mutation returns the SodConstraintRolesMutation object of the builder.
+func (scrc *SodConstraintRolesCreate) Mutation() *SodConstraintRolesMutation {
+	return scrc.mutation
+}
+
+// Save creates the SodConstraintRoles in the database.
+func (scrc *SodConstraintRolesCreate) Save(ctx context.Context) (*SodConstraintRoles, error) {
+	return withHooks(ctx, scrc.sqlSave, scrc.mutation, scrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scrc *SodConstraintRolesCreate) SaveX(ctx context.Context) *SodConstraintRoles {
+	v, err := scrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scrc *SodConstraintRolesCreate) Exec(ctx context.Context) error {
+	_, err := scrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scrc *SodConstraintRolesCreate) ExecX(ctx context.Context) {
+	if err := scrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scrc *SodConstraintRolesCreate) check() error {
+	if _, ok := scrc.mutation.ConstraintID(); !ok {
+		return &ValidationError{Name: "constraint_id", err: errors.New(`ent: missing required field "SodConstraintRoles.constraint_id"`)}
+	}
+	if _, ok := scrc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "SodConstraintRoles.role_id"`)}
+	}
+	if _, ok := scrc.mutation.SodConstraintID(); !ok {
+		return &ValidationError{Name: "sod_constraint", err: errors.New(`ent: missing required edge "SodConstraintRoles.sod_constraint"`)}
+	}
+	if _, ok := scrc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required edge "SodConstraintRoles.role"`)}
+	}
+	return nil
+}
+
+func (scrc *SodConstraintRolesCreate) sqlSave(ctx context.Context) (*SodConstraintRoles, error) {
+	if err := scrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	scrc.mutation.id = &_node.ID
+	scrc.mutation.done = true
+	return _node, nil
+}
+
+func (scrc *SodConstraintRolesCreate) createSpec() (*SodConstraintRoles, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SodConstraintRoles{config: scrc.config}
+		_spec = sqlgraph.NewCreateSpec(sodconstraintroles.Table, sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = scrc.conflict
+	if id, ok := scrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if nodes := scrc.mutation.SodConstraintIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sodconstraintroles.SodConstraintTable,
+			Columns: []string{sodconstraintroles.SodConstraintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraints.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConstraintID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := scrc.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sodconstraintroles.RoleTable,
+			Columns: []string{sodconstraintroles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RoleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SodConstraintRoles.Create().
+//		SetConstraintID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SodConstraintRolesUpsert) {
+//			SetConstraintID(v+v).
+//		}).
+//		Exec(ctx)
+func (scrc *SodConstraintRolesCreate) OnConflict(opts ...sql.ConflictOption) *SodConstraintRolesUpsertOne {
+	scrc.conflict = opts
+	return &SodConstraintRolesUpsertOne{
+		create: scrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SodConstraintRoles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scrc *SodConstraintRolesCreate) OnConflictColumns(columns ...string) *SodConstraintRolesUpsertOne {
+	scrc.conflict = append(scrc.conflict, sql.ConflictColumns(columns...))
+	return &SodConstraintRolesUpsertOne{
+		create: scrc,
+	}
+}
+
+type (
+	// SodConstraintRolesUpsertOne is the builder for "upsert"-ing
+	//  one SodConstraintRoles node.
+	SodConstraintRolesUpsertOne struct {
+		create *SodConstraintRolesCreate
+	}
+
+	// SodConstraintRolesUpsert is the "OnConflict" setter.
+	SodConstraintRolesUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetConstraintID sets the "constraint_id" field.
+func (u *SodConstraintRolesUpsert) SetConstraintID(v int) *SodConstraintRolesUpsert {
+	u.Set(sodconstraintroles.FieldConstraintID, v)
+	return u
+}
+
+// UpdateConstraintID sets the "constraint_id" field to the value that was provided on create.
+func (u *SodConstraintRolesUpsert) UpdateConstraintID() *SodConstraintRolesUpsert {
+	u.SetExcluded(sodconstraintroles.FieldConstraintID)
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *SodConstraintRolesUpsert) SetRoleID(v int) *SodConstraintRolesUpsert {
+	u.Set(sodconstraintroles.FieldRoleID, v)
+	return u
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *SodConstraintRolesUpsert) UpdateRoleID() *SodConstraintRolesUpsert {
+	u.SetExcluded(sodconstraintroles.FieldRoleID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SodConstraintRoles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sodconstraintroles.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SodConstraintRolesUpsertOne) UpdateNewValues() *SodConstraintRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(sodconstraintroles.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SodConstraintRoles.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SodConstraintRolesUpsertOne) Ignore() *SodConstraintRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SodConstraintRolesUpsertOne) DoNothing() *SodConstraintRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SodConstraintRolesCreate.OnConflict
+// documentation for more info.
+func (u *SodConstraintRolesUpsertOne) Update(set func(*SodConstraintRolesUpsert)) *SodConstraintRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SodConstraintRolesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConstraintID sets the "constraint_id" field.
+func (u *SodConstraintRolesUpsertOne) SetConstraintID(v int) *SodConstraintRolesUpsertOne {
+	return u.Update(func(s *SodConstraintRolesUpsert) {
+		s.SetConstraintID(v)
+	})
+}
+
+// UpdateConstraintID sets the "constraint_id" field to the value that was provided on create.
+func (u *SodConstraintRolesUpsertOne) UpdateConstraintID() *SodConstraintRolesUpsertOne {
+	return u.Update(func(s *SodConstraintRolesUpsert) {
+		s.UpdateConstraintID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *SodConstraintRolesUpsertOne) SetRoleID(v int) *SodConstraintRolesUpsertOne {
+	return u.Update(func(s *SodConstraintRolesUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *SodConstraintRolesUpsertOne) UpdateRoleID() *SodConstraintRolesUpsertOne {
+	return u.Update(func(s *SodConstraintRolesUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// Exec executes the query.
+func (u *SodConstraintRolesUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SodConstraintRolesCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SodConstraintRolesUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SodConstraintRolesUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SodConstraintRolesUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SodConstraintRolesCreateBulk is the builder for creating many SodConstraintRoles entities in bulk.
+type SodConstraintRolesCreateBulk struct {
+	config
+	err      error
+	builders []*SodConstraintRolesCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SodConstraintRoles entities in the database.
+func (scrcb *SodConstraintRolesCreateBulk) Save(ctx context.Context) ([]*SodConstraintRoles, error) {
+	if scrcb.err != nil {
+		return nil, scrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scrcb.builders))
+	nodes := make([]*SodConstraintRoles, len(scrcb.builders))
+	mutators := make([]Mutator, len(scrcb.builders))
+	for i := range scrcb.builders {
+		func(i int, root context.Context) {
+			builder := scrcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SodConstraintRolesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scrcb *SodConstraintRolesCreateBulk) SaveX(ctx context.Context) []*SodConstraintRoles {
+	v, err := scrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scrcb *SodConstraintRolesCreateBulk) Exec(ctx context.Context) error {
+	_, err := scrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scrcb *SodConstraintRolesCreateBulk) ExecX(ctx context.Context) {
+	if err := scrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SodConstraintRoles.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SodConstraintRolesUpsert) {
+//			SetConstraintID(v+v).
+//		}).
+//		Exec(ctx)
+func (scrcb *SodConstraintRolesCreateBulk) OnConflict(opts ...sql.ConflictOption) *SodConstraintRolesUpsertBulk {
+	scrcb.conflict = opts
+	return &SodConstraintRolesUpsertBulk{
+		create: scrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SodConstraintRoles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scrcb *SodConstraintRolesCreateBulk) OnConflictColumns(columns ...string) *SodConstraintRolesUpsertBulk {
+	scrcb.conflict = append(scrcb.conflict, sql.ConflictColumns(columns...))
+	return &SodConstraintRolesUpsertBulk{
+		create: scrcb,
+	}
+}
+
+// SodConstraintRolesUpsertBulk is the builder for "upsert"-ing
+// a bulk of SodConstraintRoles nodes.
+type SodConstraintRolesUpsertBulk struct {
+	create *SodConstraintRolesCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SodConstraintRoles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sodconstraintroles.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SodConstraintRolesUpsertBulk) UpdateNewValues() *SodConstraintRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(sodconstraintroles.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SodConstraintRoles.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SodConstraintRolesUpsertBulk) Ignore() *SodConstraintRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SodConstraintRolesUpsertBulk) DoNothing() *SodConstraintRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SodConstraintRolesCreateBulk.OnConflict
+// documentation for more info.
+func (u *SodConstraintRolesUpsertBulk) Update(set func(*SodConstraintRolesUpsert)) *SodConstraintRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SodConstraintRolesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetConstraintID sets the "constraint_id" field.
+func (u *SodConstraintRolesUpsertBulk) SetConstraintID(v int) *SodConstraintRolesUpsertBulk {
+	return u.Update(func(s *SodConstraintRolesUpsert) {
+		s.SetConstraintID(v)
+	})
+}
+
+// UpdateConstraintID sets the "constraint_id" field to the value that was provided on create.
+func (u *SodConstraintRolesUpsertBulk) UpdateConstraintID() *SodConstraintRolesUpsertBulk {
+	return u.Update(func(s *SodConstraintRolesUpsert) {
+		s.UpdateConstraintID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *SodConstraintRolesUpsertBulk) SetRoleID(v int) *SodConstraintRolesUpsertBulk {
+	return u.Update(func(s *SodConstraintRolesUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *SodConstraintRolesUpsertBulk) UpdateRoleID() *SodConstraintRolesUpsertBulk {
+	return u.Update(func(s *SodConstraintRolesUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// Exec executes the query.
+func (u *SodConstraintRolesUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SodConstraintRolesCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SodConstraintRolesCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SodConstraintRolesUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+)
+
+// SodConstraintRolesDelete is the builder for deleting a SodConstraintRoles entity.
+type SodConstraintRolesDelete struct {
+	config
+	hooks    []Hook
+	mutation *SodConstraintRolesMutation
+}
+
+// Where appends a list predicates to the SodConstraintRolesDelete builder.
+func (scrd *SodConstraintRolesDelete) Where(ps ...predicate.SodConstraintRoles) *SodConstraintRolesDelete {
+	scrd.mutation.Where(ps...)
+	return scrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (scrd *SodConstraintRolesDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, scrd.sqlExec, scrd.mutation, scrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scrd *SodConstraintRolesDelete) ExecX(ctx context.Context) int {
+	n, err := scrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (scrd *SodConstraintRolesDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sodconstraintroles.Table, sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt))
+	if ps := scrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, scrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	scrd.mutation.done = true
+	return affected, err
+}
+
+// SodConstraintRolesDeleteOne is the builder for deleting a single SodConstraintRoles entity.
+type SodConstraintRolesDeleteOne struct {
+	scrd *SodConstraintRolesDelete
+}
+
+// Where appends a list predicates to the SodConstraintRolesDelete builder.
+func (scrdo *SodConstraintRolesDeleteOne) Where(ps ...predicate.SodConstraintRoles) *SodConstraintRolesDeleteOne {
+	scrdo.scrd.mutation.Where(ps...)
+	return scrdo
+}
+
+// Exec executes the deletion query.
+func (scrdo *SodConstraintRolesDeleteOne) Exec(ctx context.Context) error {
+	n, err := scrdo.scrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sodconstraintroles.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scrdo *SodConstraintRolesDeleteOne) ExecX(ctx context.Context) {
+	if err := scrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,717 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
+)
+
+// SodConstraintRolesQuery is the builder for querying SodConstraintRoles entities.
+type SodConstraintRolesQuery struct {
+	config
+	ctx               *QueryContext
+	order             []sodconstraintroles.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.SodConstraintRoles
+	withSodConstraint *SodConstraintsQuery
+	withRole          *RolesQuery
+	modifiers         []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SodConstraintRolesQuery builder.
+func (scrq *SodConstraintRolesQuery) Where(ps ...predicate.SodConstraintRoles) *SodConstraintRolesQuery {
+	scrq.predicates = append(scrq.predicates, ps...)
+	return scrq
+}
+
+// Limit the number of records to be returned by this query.
+func (scrq *SodConstraintRolesQuery) Limit(limit int) *SodConstraintRolesQuery {
+	scrq.ctx.Limit = &limit
+	return scrq
+}
+
+// Offset to start from.
+func (scrq *SodConstraintRolesQuery) Offset(offset int) *SodConstraintRolesQuery {
+	scrq.ctx.Offset = &offset
+	return scrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (scrq *SodConstraintRolesQuery) Unique(unique bool) *SodConstraintRolesQuery {
+	scrq.ctx.Unique = &unique
+	return scrq
+}
+
+// Order specifies how the records should be ordered.
+func (scrq *SodConstraintRolesQuery) Order(o ...sodconstraintroles.OrderOption) *SodConstraintRolesQuery {
+	scrq.order = append(scrq.order, o...)
+	return scrq
+}
+
+// QuerySodConstraint chains the current query on the "sod_constraint" edge.
+func (scrq *SodConstraintRolesQuery) QuerySodConstraint() *SodConstraintsQuery {
+	query := (&SodConstraintsClient{config: scrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sodconstraintroles.Table, sodconstraintroles.FieldID, selector),
+			sqlgraph.To(sodconstraints.Table, sodconstraints.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, sodconstraintroles.SodConstraintTable, sodconstraintroles.SodConstraintColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRole chains the current query on the "role" edge.
+func (scrq *SodConstraintRolesQuery) QueryRole() *RolesQuery {
+	query := (&RolesClient{config: scrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sodconstraintroles.Table, sodconstraintroles.FieldID, selector),
+			sqlgraph.To(roles.Table, roles.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, sodconstraintroles.RoleTable, sodconstraintroles.RoleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SodConstraintRoles entity from the query.
+// Returns a *NotFoundError when no SodConstraintRoles was found.
+func (scrq *SodConstraintRolesQuery) First(ctx context.Context) (*SodConstraintRoles, error) {
+	nodes, err := scrq.Limit(1).All(setContextOp(ctx, scrq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sodconstraintroles.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (scrq *SodConstraintRolesQuery) FirstX(ctx context.Context) *SodConstraintRoles {
+	node, err := scrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SodConstraintRoles ID from the query.
+// Returns a *NotFoundError when no SodConstraintRoles ID was found.
+func (scrq *SodConstraintRolesQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = scrq.Limit(1).IDs(setContextOp(ctx, scrq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sodconstraintroles.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (scrq *SodConstraintRolesQuery) FirstIDX(ctx context.Context) int {
+	id, err := scrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SodConstraintRoles entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SodConstraintRoles entity is found.
+// Returns a *NotFoundError when no SodConstraintRoles entities are found.
+func (scrq *SodConstraintRolesQuery) Only(ctx context.Context) (*SodConstraintRoles, error) {
+	nodes, err := scrq.Limit(2).All(setContextOp(ctx, scrq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sodconstraintroles.Label}
+	default:
+		return nil, &NotSingularError{sodconstraintroles.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (scrq *SodConstraintRolesQuery) OnlyX(ctx context.Context) *SodConstraintRoles {
+	node, err := scrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SodConstraintRoles ID in the query.
+// Returns a *NotSingularError when more than one SodConstraintRoles ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (scrq *SodConstraintRolesQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = scrq.Limit(2).IDs(setContextOp(ctx, scrq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sodconstraintroles.Label}
+	default:
+		err = &NotSingularError{sodconstraintroles.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (scrq *SodConstraintRolesQuery) OnlyIDX(ctx context.Context) int {
+	id, err := scrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SodConstraintRolesSlice.
+func (scrq *SodConstraintRolesQuery) All(ctx context.Context) ([]*SodConstraintRoles, error) {
+	ctx = setContextOp(ctx, scrq.ctx, "All")
+	if err := scrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SodConstraintRoles, *SodConstraintRolesQuery]()
+	return withInterceptors[[]*SodConstraintRoles](ctx, scrq, qr, scrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (scrq *SodConstraintRolesQuery) AllX(ctx context.Context) []*SodConstraintRoles {
+	nodes, err := scrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SodConstraintRoles IDs.
+func (scrq *SodConstraintRolesQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if scrq.ctx.Unique == nil && scrq.path != nil {
+		scrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, scrq.ctx, "IDs")
+	if err = scrq.Select(sodconstraintroles.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (scrq *SodConstraintRolesQuery) IDsX(ctx context.Context) []int {
+	ids, err := scrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (scrq *SodConstraintRolesQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, scrq.ctx, "Count")
+	if err := scrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, scrq, querierCount[*SodConstraintRolesQuery](), scrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (scrq *SodConstraintRolesQuery) CountX(ctx context.Context) int {
+	count, err := scrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (scrq *SodConstraintRolesQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, scrq.ctx, "Exist")
+	switch _, err := scrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (scrq *SodConstraintRolesQuery) ExistX(ctx context.Context) bool {
+	exist, err := scrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SodConstraintRolesQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (scrq *SodConstraintRolesQuery) Clone() *SodConstraintRolesQuery {
+	if scrq == nil {
+		return nil
+	}
+	return &SodConstraintRolesQuery{
+		config:            scrq.config,
+		ctx:               scrq.ctx.Clone(),
+		order:             append([]sodconstraintroles.OrderOption{}, scrq.order...),
+		inters:            append([]Interceptor{}, scrq.inters...),
+		predicates:        append([]predicate.SodConstraintRoles{}, scrq.predicates...),
+		withSodConstraint: scrq.withSodConstraint.Clone(),
+		withRole:          scrq.withRole.Clone(),
+		// clone intermediate query.
+		sql:  scrq.sql.Clone(),
+		path: scrq.path,
+	}
+}
+
+// WithSodConstraint tells the query-builder to eager-load the nodes that are connected to
+// the "sod_constraint" edge. The optional arguments are used to configure the query builder of the edge.
+func (scrq *SodConstraintRolesQuery) WithSodConstraint(opts ...func(*SodConstraintsQuery)) *SodConstraintRolesQuery {
+	query := (&SodConstraintsClient{config: scrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scrq.withSodConstraint = query
+	return scrq
+}
+
+// WithRole tells the query-builder to eager-load the nodes that are connected to
+// the "role" edge. The optional arguments are used to configure the query builder of the edge.
+func (scrq *SodConstraintRolesQuery) WithRole(opts ...func(*RolesQuery)) *SodConstraintRolesQuery {
+	query := (&RolesClient{config: scrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scrq.withRole = query
+	return scrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ConstraintID int `json:"constraint_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SodConstraintRoles.Query().
+//		GroupBy(sodconstraintroles.FieldConstraintID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (scrq *SodConstraintRolesQuery) GroupBy(field string, fields ...string) *SodConstraintRolesGroupBy {
+	scrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SodConstraintRolesGroupBy{build: scrq}
+	grbuild.flds = &scrq.ctx.Fields
+	grbuild.label = sodconstraintroles.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ConstraintID int `json:"constraint_id,omitempty"`
+//	}
+//
+//	client.SodConstraintRoles.Query().
+//		Select(sodconstraintroles.FieldConstraintID).
+//		Scan(ctx, &v)
+func (scrq *SodConstraintRolesQuery) Select(fields ...string) *SodConstraintRolesSelect {
+	scrq.ctx.Fields = append(scrq.ctx.Fields, fields...)
+	sbuild := &SodConstraintRolesSelect{SodConstraintRolesQuery: scrq}
+	sbuild.label = sodconstraintroles.Label
+	sbuild.flds, sbuild.scan = &scrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SodConstraintRolesSelect configured with the given aggregations.
+func (scrq *SodConstraintRolesQuery) Aggregate(fns ...AggregateFunc) *SodConstraintRolesSelect {
+	return scrq.Select().Aggregate(fns...)
+}
+
+func (scrq *SodConstraintRolesQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range scrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, scrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range scrq.ctx.Fields {
+		if !sodconstraintroles.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if scrq.path != nil {
+		prev, err := scrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		scrq.sql = prev
+	}
+	return nil
+}
+
+func (scrq *SodConstraintRolesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SodConstraintRoles, error) {
+	var (
+		nodes       = []*SodConstraintRoles{}
+		_spec       = scrq.querySpec()
+		loadedTypes = [2]bool{
+			scrq.withSodConstraint != nil,
+			scrq.withRole != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SodConstraintRoles).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SodConstraintRoles{config: scrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(scrq.modifiers) > 0 {
+		_spec.Modifiers = scrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, scrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := scrq.withSodConstraint; query != nil {
+		if err := scrq.loadSodConstraint(ctx, query, nodes, nil,
+			func(n *SodConstraintRoles, e *SodConstraints) { n.Edges.SodConstraint = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := scrq.withRole; query != nil {
+		if err := scrq.loadRole(ctx, query, nodes, nil,
+			func(n *SodConstraintRoles, e *Roles) { n.Edges.Role = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (scrq *SodConstraintRolesQuery) loadSodConstraint(ctx context.Context, query *SodConstraintsQuery, nodes []*SodConstraintRoles, init func(*SodConstraintRoles), assign func(*SodConstraintRoles, *SodConstraints)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SodConstraintRoles)
+	for i := range nodes {
+		fk := nodes[i].ConstraintID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sodconstraints.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "constraint_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (scrq *SodConstraintRolesQuery) loadRole(ctx context.Context, query *RolesQuery, nodes []*SodConstraintRoles, init func(*SodConstraintRoles), assign func(*SodConstraintRoles, *Roles)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SodConstraintRoles)
+	for i := range nodes {
+		fk := nodes[i].RoleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(roles.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "role_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (scrq *SodConstraintRolesQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := scrq.querySpec()
+	if len(scrq.modifiers) > 0 {
+		_spec.Modifiers = scrq.modifiers
+	}
+	_spec.Node.Columns = scrq.ctx.Fields
+	if len(scrq.ctx.Fields) > 0 {
+		_spec.Unique = scrq.ctx.Unique != nil && *scrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, scrq.driver, _spec)
+}
+
+func (scrq *SodConstraintRolesQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(sodconstraintroles.Table, sodconstraintroles.Columns, sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt))
+	_spec.From = scrq.sql
+	if unique := scrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if scrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := scrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sodconstraintroles.FieldID)
+		for i := range fields {
+			if fields[i] != sodconstraintroles.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if scrq.withSodConstraint != nil {
+			_spec.Node.AddColumnOnce(sodconstraintroles.FieldConstraintID)
+		}
+		if scrq.withRole != nil {
+			_spec.Node.AddColumnOnce(sodconstraintroles.FieldRoleID)
+		}
+	}
+	if ps := scrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := scrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := scrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := scrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (scrq *SodConstraintRolesQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(scrq.driver.Dialect())
+	t1 := builder.Table(sodconstraintroles.Table)
+	columns := scrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = sodconstraintroles.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if scrq.sql != nil {
+		selector = scrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if scrq.ctx.Unique != nil && *scrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range scrq.modifiers {
+		m(selector)
+	}
+	for _, p := range scrq.predicates {
+		p(selector)
+	}
+	for _, p := range scrq.order {
+		p(selector)
+	}
+	if offset := scrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := scrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (scrq *SodConstraintRolesQuery) ForUpdate(opts ...sql.LockOption) *SodConstraintRolesQuery {
+	if scrq.driver.Dialect() == dialect.Postgres {
+		scrq.Unique(false)
+	}
+	scrq.modifiers = append(scrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return scrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (scrq *SodConstraintRolesQuery) ForShare(opts ...sql.LockOption) *SodConstraintRolesQuery {
+	if scrq.driver.Dialect() == dialect.Postgres {
+		scrq.Unique(false)
+	}
+	scrq.modifiers = append(scrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return scrq
+}
+
+// SodConstraintRolesGroupBy is the group-by builder for SodConstraintRoles entities.
+type SodConstraintRolesGroupBy struct {
+	selector
+	build *SodConstraintRolesQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (scrgb *SodConstraintRolesGroupBy) Aggregate(fns ...AggregateFunc) *SodConstraintRolesGroupBy {
+	scrgb.fns = append(scrgb.fns, fns...)
+	return scrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scrgb *SodConstraintRolesGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scrgb.build.ctx, "GroupBy")
+	if err := scrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SodConstraintRolesQuery, *SodConstraintRolesGroupBy](ctx, scrgb.build, scrgb, scrgb.build.inters, v)
+}
+
+func (scrgb *SodConstraintRolesGroupBy) sqlScan(ctx context.Context, root *SodConstraintRolesQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(scrgb.fns))
+	for _, fn := range scrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*scrgb.flds)+len(scrgb.fns))
+		for _, f := range *scrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*scrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SodConstraintRolesSelect is the builder for selecting fields of SodConstraintRoles entities.
+type SodConstraintRolesSelect struct {
+	*SodConstraintRolesQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (scrs *SodConstraintRolesSelect) Aggregate(fns ...AggregateFunc) *SodConstraintRolesSelect {
+	scrs.fns = append(scrs.fns, fns...)
+	return scrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scrs *SodConstraintRolesSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scrs.ctx, "Select")
+	if err := scrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SodConstraintRolesQuery, *SodConstraintRolesSelect](ctx, scrs.SodConstraintRolesQuery, scrs, scrs.inters, v)
+}
+
+func (scrs *SodConstraintRolesSelect) sqlScan(ctx context.Context, root *SodConstraintRolesQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(scrs.fns))
+	for _, fn := range scrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*scrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
+)
+
+// SodConstraintRolesUpdate is the builder for updating SodConstraintRoles entities.
+type SodConstraintRolesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SodConstraintRolesMutation
+}
+
+// Where appends a list predicates to the SodConstraintRolesUpdate builder.
+func (scru *SodConstraintRolesUpdate) Where(ps ...predicate.SodConstraintRoles) *SodConstraintRolesUpdate {
+	scru.mutation.Where(ps...)
+	return scru
+}
+
+// SetConstraintID sets the "constraint_id" field.
+func (scru *SodConstraintRolesUpdate) SetConstraintID(i int) *SodConstraintRolesUpdate {
+	scru.mutation.SetConstraintID(i)
+	return scru
+}
+
+// SetNillableConstraintID sets the "constraint_id" field if the given value is not nil.
+func (scru *SodConstraintRolesUpdate) SetNillableConstraintID(i *int) *SodConstraintRolesUpdate {
+	if i != nil {
+		scru.SetConstraintID(*i)
+	}
+	return scru
+}
+
+// SetRoleID sets the "role_id" field.
+func (scru *SodConstraintRolesUpdate) SetRoleID(i int) *SodConstraintRolesUpdate {
+	scru.mutation.SetRoleID(i)
+	return scru
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (scru *SodConstraintRolesUpdate) SetNillableRoleID(i *int) *SodConstraintRolesUpdate {
+	if i != nil {
+		scru.SetRoleID(*i)
+	}
+	return scru
+}
+
+// SetSodConstraintID sets the "sod_constraint" edge to the SodConstraints entity by ID.
+func (scru *SodConstraintRolesUpdate) SetSodConstraintID(id int) *SodConstraintRolesUpdate {
+	scru.mutation.SetSodConstraintID(id)
+	return scru
+}
+
+// SetSodConstraint sets the "sod_constraint" edge to the SodConstraints entity.
+func (scru *SodConstraintRolesUpdate) SetSodConstraint(s *SodConstraints) *SodConstraintRolesUpdate {
+	return scru.SetSodConstraintID(s.ID)
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (scru *SodConstraintRolesUpdate) SetRole(r *Roles) *SodConstraintRolesUpdate {
+	return scru.SetRoleID(r.ID)
+}
+
+// Mutation returns the SodConstraintRolesMutation object of the builder.
+func (scru *SodConstraintRolesUpdate) Mutation() *SodConstraintRolesMutation {
+	return scru.mutation
+}
+
+// ClearSodConstraint clears the "sod_constraint" edge to the SodConstraints entity.
+func (scru *SodConstraintRolesUpdate) ClearSodConstraint() *SodConstraintRolesUpdate {
+	scru.mutation.ClearSodConstraint()
+	return scru
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (scru *SodConstraintRolesUpdate) ClearRole() *SodConstraintRolesUpdate {
+	scru.mutation.ClearRole()
+	return scru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scru *SodConstraintRolesUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, scru.sqlSave, scru.mutation, scru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scru *SodConstraintRolesUpdate) SaveX(ctx context.Context) int {
+	affected, err := scru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scru *SodConstraintRolesUpdate) Exec(ctx context.Context) error {
+	_, err := scru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scru *SodConstraintRolesUpdate) ExecX(ctx context.Context) {
+	if err := scru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scru *SodConstraintRolesUpdate) check() error {
+	if _, ok := scru.mutation.SodConstraintID(); scru.mutation.SodConstraintCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SodConstraintRoles.sod_constraint"`)
+	}
+	if _, ok := scru.mutation.RoleID(); scru.mutation.RoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SodConstraintRoles.role"`)
+	}
+	return nil
+}
+
+func (scru *SodConstraintRolesUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sodconstraintroles.Table, sodconstraintroles.Columns, sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt))
+	if ps := scru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if scru.mutation.SodConstraintCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sodconstraintroles.SodConstraintTable,
+			Columns: []string{sodconstraintroles.SodConstraintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraints.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scru.mutation.SodConstraintIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sodconstraintroles.SodConstraintTable,
+			Columns: []string{sodconstraintroles.SodConstraintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraints.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scru.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sodconstraintroles.RoleTable,
+			Columns: []string{sodconstraintroles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scru.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sodconstraintroles.RoleTable,
+			Columns: []string{sodconstraintroles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, scru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sodconstraintroles.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scru.mutation.done = true
+	return n, nil
+}
+
+// SodConstraintRolesUpdateOne is the builder for updating a single SodConstraintRoles entity.
+type SodConstraintRolesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SodConstraintRolesMutation
+}
+
+// SetConstraintID sets the "constraint_id" field.
+func (scruo *SodConstraintRolesUpdateOne) SetConstraintID(i int) *SodConstraintRolesUpdateOne {
+	scruo.mutation.SetConstraintID(i)
+	return scruo
+}
+
+// SetNillableConstraintID sets the "constraint_id" field if the given value is not nil.
+func (scruo *SodConstraintRolesUpdateOne) SetNillableConstraintID(i *int) *SodConstraintRolesUpdateOne {
+	if i != nil {
+		scruo.SetConstraintID(*i)
+	}
+	return scruo
+}
+
+// SetRoleID sets the "role_id" field.
+func (scruo *SodConstraintRolesUpdateOne) SetRoleID(i int) *SodConstraintRolesUpdateOne {
+	scruo.mutation.SetRoleID(i)
+	return scruo
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (scruo *SodConstraintRolesUpdateOne) SetNillableRoleID(i *int) *SodConstraintRolesUpdateOne {
+	if i != nil {
+		scruo.SetRoleID(*i)
+	}
+	return scruo
+}
+
+// SetSodConstraintID sets the "sod_constraint" edge to the SodConstraints entity by ID.
+func (scruo *SodConstraintRolesUpdateOne) SetSodConstraintID(id int) *SodConstraintRolesUpdateOne {
+	scruo.mutation.SetSodConstraintID(id)
+	return scruo
+}
+
+// SetSodConstraint sets the "sod_constraint" edge to the SodConstraints entity.
+func (scruo *SodConstraintRolesUpdateOne) SetSodConstraint(s *SodConstraints) *SodConstraintRolesUpdateOne {
+	return scruo.SetSodConstraintID(s.ID)
+}
+
+// SetRole sets the "role" edge to the Roles entity.
+func (scruo *SodConstraintRolesUpdateOne) SetRole(r *Roles) *SodConstraintRolesUpdateOne {
+	return scruo.SetRoleID(r.ID)
+}
+
+// Mutation returns the SodConstraintRolesMutation object of the builder.
+func (scruo *SodConstraintRolesUpdateOne) Mutation() *SodConstraintRolesMutation {
+	return scruo.mutation
+}
+
+// ClearSodConstraint clears the "sod_constraint" edge to the SodConstraints entity.
+func (scruo *SodConstraintRolesUpdateOne) ClearSodConstraint() *SodConstraintRolesUpdateOne {
+	scruo.mutation.ClearSodConstraint()
+	return scruo
+}
+
+// ClearRole clears the "role" edge to the Roles entity.
+func (scruo *SodConstraintRolesUpdateOne) ClearRole() *SodConstraintRolesUpdateOne {
+	scruo.mutation.ClearRole()
+	return scruo
+}
+
+// Where appends a list predicates to the SodConstraintRolesUpdate builder.
+func (scruo *SodConstraintRolesUpdateOne) Where(ps ...predicate.SodConstraintRoles) *SodConstraintRolesUpdateOne {
+	scruo.mutation.Where(ps...)
+	return scruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scruo *SodConstraintRolesUpdateOne) Select(field string, fields ...string) *SodConstraintRolesUpdateOne {
+	scruo.fields = append([]string{field}, fields...)
+	return scruo
+}
+
+// Save executes the query and returns the updated SodConstraintRoles entity.
+func (scruo *SodConstraintRolesUpdateOne) Save(ctx context.Context) (*SodConstraintRoles, error) {
+	return withHooks(ctx, scruo.sqlSave, scruo.mutation, scruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scruo *SodConstraintRolesUpdateOne) SaveX(ctx context.Context) *SodConstraintRoles {
+	node, err := scruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scruo *SodConstraintRolesUpdateOne) Exec(ctx context.Context) error {
+	_, err := scruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scruo *SodConstraintRolesUpdateOne) ExecX(ctx context.Context) {
+	if err := scruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scruo *SodConstraintRolesUpdateOne) check() error {
+	if _, ok := scruo.mutation.SodConstraintID(); scruo.mutation.SodConstraintCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SodConstraintRoles.sod_constraint"`)
+	}
+	if _, ok := scruo.mutation.RoleID(); scruo.mutation.RoleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SodConstraintRoles.role"`)
+	}
+	return nil
+}
+
+func (scruo *SodConstraintRolesUpdateOne) sqlSave(ctx context.Context) (_node *SodConstraintRoles, err error) {
+	if err := scruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sodconstraintroles.Table, sodconstraintroles.Columns, sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt))
+	id, ok := scruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SodConstraintRoles.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sodconstraintroles.FieldID)
+		for _, f := range fields {
+			if !sodconstraintroles.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sodconstraintroles.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if scruo.mutation.SodConstraintCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sodconstraintroles.SodConstraintTable,
+			Columns: []string{sodconstraintroles.SodConstraintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraints.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scruo.mutation.SodConstraintIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sodconstraintroles.SodConstraintTable,
+			Columns: []string{sodconstraintroles.SodConstraintColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraints.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scruo.mutation.RoleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sodconstraintroles.RoleTable,
+			Columns: []string{sodconstraintroles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scruo.mutation.RoleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sodconstraintroles.RoleTable,
+			Columns: []string{sodconstraintroles.RoleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(roles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SodConstraintRoles{config: scruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sodconstraintroles.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
+)
+
+// SodConstraints is the model entity for the SodConstraints schema.
+type SodConstraints struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Unique code identifier for the constraint
+	Code string `json:"code,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Constraints from the RBAC config cannot be modified via API
+	IsSystem bool `json:"is_system,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SodConstraintsQuery when eager-loading is set.
+	Edges        SodConstraintsEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SodConstraintsEdges holds the relations/edges for other nodes in the graph.
+type SodConstraintsEdges struct {
+	// ConstraintRoles holds the value of the constraint_roles edge.
+	ConstraintRoles []*SodConstraintRoles `json:"constraint_roles,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ConstraintRolesOrErr returns the ConstraintRoles value or an error if the edge
+// was not loaded in eager-loading.
+func (e SodConstraintsEdges) ConstraintRolesOrErr() ([]*SodConstraintRoles, error) {
+	if e.loadedTypes[0] {
+		return e.ConstraintRoles, nil
+	}
+	return nil, &NotLoadedError{edge: "constraint_roles"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SodConstraints) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sodconstraints.FieldIsSystem:
+			values[i] = new(sql.NullBool)
+		case sodconstraints.FieldID:
+			values[i] = new(sql.NullInt64)
+		case sodconstraints.FieldCode, sodconstraints.FieldName, sodconstraints.FieldDescription:
+			values[i] = new(sql.NullString)
+		case sodconstraints.FieldCreatedAt, sodconstraints.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SodConstraints fields.
+func (sc *SodConstraints) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sodconstraints.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sc.ID = int(value.Int64)
+		case sodconstraints.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				sc.Code = value.String
+			}
+		case sodconstraints.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sc.Name = value.String
+			}
+		case sodconstraints.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				sc.Description = value.String
+			}
+		case sodconstraints.FieldIsSystem:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_system", values[i])
+			} else if value.Valid {
+				sc.IsSystem = value.Bool
+			}
+		case sodconstraints.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sc.CreatedAt = value.Time
+			}
+		case sodconstraints.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sc.UpdatedAt = value.Time
+			}
+		default:
+			sc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SodConstraints.
+// This includes values selected through modifiers, order, etc.
+func (sc *SodConstraints) Value(name string) (ent.Value, error) {
+	return sc.selectValues.Get(name)
+}
+
+// QueryConstraintRoles queries the "constraint_roles" edge of the SodConstraints entity.
+func (sc *SodConstraints) QueryConstraintRoles() *SodConstraintRolesQuery {
+	return NewSodConstraintsClient(sc.config).QueryConstraintRoles(sc)
+}
+
+// Update returns a builder for updating this SodConstraints.
+// Note that you need to call SodConstraints.Unwrap() before calling this method if this SodConstraints
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sc *SodConstraints) Update() *SodConstraintsUpdateOne {
+	return NewSodConstraintsClient(sc.config).UpdateOne(sc)
+}
+
+// Unwrap unwraps the SodConstraints entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sc *SodConstraints) Unwrap() *SodConstraints {
+	_tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SodConstraints is not a transactional entity")
+	}
+	sc.config.driver = _tx.drv
+	return sc
+}
+
+// String implements the fmt.Stringer.
+func (sc *SodConstraints) String() string {
+	var builder strings.Builder
+	builder.WriteString("SodConstraints(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sc.ID))
+	builder.WriteString("code=")
+	builder.WriteString(sc.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(sc.Description)
+	builder.WriteString(", ")
+	builder.WriteString("is_system=")
+	builder.WriteString(fmt.Sprintf("%v", sc.IsSystem))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SodConstraintsSlice is a parsable slice of SodConstraints.
+type SodConstraintsSlice []*SodConstraints
@@ -0,0 +1,825 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
+)
+
+// SodConstraintsCreate is the builder for creating a SodConstraints entity.
+type SodConstraintsCreate struct {
+	config
+	mutation *SodConstraintsMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCode sets the "code" field.
+func (scc *SodConstraintsCreate) SetCode(s string) *SodConstraintsCreate {
+	scc.mutation.SetCode(s)
+	return scc
+}
+
+// SetName sets the "name" field.
+func (scc *SodConstraintsCreate) SetName(s string) *SodConstraintsCreate {
+	scc.mutation.SetName(s)
+	return scc
+}
+
+// SetDescription sets the "description" field.
+func (scc *SodConstraintsCreate) SetDescription(s string) *SodConstraintsCreate {
+	scc.mutation.SetDescription(s)
+	return scc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (scc *SodConstraintsCreate) SetNillableDescription(s *string) *SodConstraintsCreate {
+	if s != nil {
+		scc.SetDescription(*s)
+	}
+	return scc
+}
+
+// SetIsSystem sets the "is_system" field.
+func (scc *SodConstraintsCreate) SetIsSystem(b bool) *SodConstraintsCreate {
+	scc.mutation.SetIsSystem(b)
+	return scc
+}
+
+// SetNillableIsSystem sets the "is_system" field if the given value is not nil.
+func (scc *SodConstraintsCreate) SetNillableIsSystem(b *bool) *SodConstraintsCreate {
+	if b != nil {
+		scc.SetIsSystem(*b)
+	}
+	return scc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (scc *SodConstraintsCreate) SetCreatedAt(t time.Time) *SodConstraintsCreate {
+	scc.mutation.SetCreatedAt(t)
+	return scc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (scc *SodConstraintsCreate) SetNillableCreatedAt(t *time.Time) *SodConstraintsCreate {
+	if t != nil {
+		scc.SetCreatedAt(*t)
+	}
+	return scc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (scc *SodConstraintsCreate) SetUpdatedAt(t time.Time) *SodConstraintsCreate {
+	scc.mutation.SetUpdatedAt(t)
+	return scc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (scc *SodConstraintsCreate) SetNillableUpdatedAt(t *time.Time) *SodConstraintsCreate {
+	if t != nil {
+		scc.SetUpdatedAt(*t)
+	}
+	return scc
+}
+
+// SetID sets the "id" field.
+func (scc *SodConstraintsCreate) SetID(i int) *SodConstraintsCreate {
+	scc.mutation.SetID(i)
+	return scc
+}
+
+// AddConstraintRoleIDs adds the "constraint_roles" edge to the SodConstraintRoles entity by IDs.
+func (scc *SodConstraintsCreate) AddConstraintRoleIDs(ids ...int) *SodConstraintsCreate {
+	scc.mutation.AddConstraintRoleIDs(ids...)
+	return scc
+}
+
+// AddConstraintRoles adds the "constraint_roles" edges to the SodConstraintRoles entity.
+func (scc *SodConstraintsCreate) AddConstraintRoles(s ...*SodConstraintRoles) *SodConstraintsCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scc.AddConstraintRoleIDs(ids...)
+}
+
+// Mutation returns the SodConstraintsMutation object of the builder.
+func (scc *SodConstraintsCreate) Mutation() *SodConstraintsMutation {
+	return scc.mutation
+}
+
+// Save creates the SodConstraints in the database.
+func (scc *SodConstraintsCreate) Save(ctx context.Context) (*SodConstraints, error) {
+	scc.defaults()
+	return withHooks(ctx, scc.sqlSave, scc.mutation, scc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scc *SodConstraintsCreate) SaveX(ctx context.Context) *SodConstraints {
+	v, err := scc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scc *SodConstraintsCreate) Exec(ctx context.Context) error {
+	_, err := scc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scc *SodConstraintsCreate) ExecX(ctx context.Context) {
+	if err := scc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scc *SodConstraintsCreate) defaults() {
+	if _, ok := scc.mutation.IsSystem(); !ok {
+		v := sodconstraints.DefaultIsSystem
+		scc.mutation.SetIsSystem(v)
+	}
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		v := sodconstraints.DefaultCreatedAt()
+		scc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := scc.mutation.UpdatedAt(); !ok {
+		v := sodconstraints.DefaultUpdatedAt()
+		scc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scc *SodConstraintsCreate) check() error {
+	if _, ok := scc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "SodConstraints.code"`)}
+	}
+	if v, ok := scc.mutation.Code(); ok {
+		if err := sodconstraints.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SodConstraints.code": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SodConstraints.name"`)}
+	}
+	if v, ok := scc.mutation.Name(); ok {
+		if err := sodconstraints.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SodConstraints.name": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.IsSystem(); !ok {
+		return &ValidationError{Name: "is_system", err: errors.New(`ent: missing required field "SodConstraints.is_system"`)}
+	}
+	if _, ok := scc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SodConstraints.created_at"`)}
+	}
+	if _, ok := scc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SodConstraints.updated_at"`)}
+	}
+	return nil
+}
+
+func (scc *SodConstraintsCreate) sqlSave(ctx context.Context) (*SodConstraints, error) {
+	if err := scc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	scc.mutation.id = &_node.ID
+	scc.mutation.done = true
+	return _node, nil
+}
+
+func (scc *SodConstraintsCreate) createSpec() (*SodConstraints, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SodConstraints{config: scc.config}
+		_spec = sqlgraph.NewCreateSpec(sodconstraints.Table, sqlgraph.NewFieldSpec(sodconstraints.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = scc.conflict
+	if id, ok := scc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := scc.mutation.Code(); ok {
+		_spec.SetField(sodconstraints.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := scc.mutation.Name(); ok {
+		_spec.SetField(sodconstraints.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := scc.mutation.Description(); ok {
+		_spec.SetField(sodconstraints.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := scc.mutation.IsSystem(); ok {
+		_spec.SetField(sodconstraints.FieldIsSystem, field.TypeBool, value)
+		_node.IsSystem = value
+	}
+	if value, ok := scc.mutation.CreatedAt(); ok {
+		_spec.SetField(sodconstraints.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := scc.mutation.UpdatedAt(); ok {
+		_spec.SetField(sodconstraints.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := scc.mutation.ConstraintRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   sodconstraints.ConstraintRolesTable,
+			Columns: []string{sodconstraints.ConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SodConstraints.Create().
+//		SetCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SodConstraintsUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (scc *SodConstraintsCreate) OnConflict(opts ...sql.ConflictOption) *SodConstraintsUpsertOne {
+	scc.conflict = opts
+	return &SodConstraintsUpsertOne{
+		create: scc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SodConstraints.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scc *SodConstraintsCreate) OnConflictColumns(columns ...string) *SodConstraintsUpsertOne {
+	scc.conflict = append(scc.conflict, sql.ConflictColumns(columns...))
+	return &SodConstraintsUpsertOne{
+		create: scc,
+	}
+}
+
+type (
+	// SodConstraintsUpsertOne is the builder for "upsert"-ing
+	//  one SodConstraints node.
+	SodConstraintsUpsertOne struct {
+		create *SodConstraintsCreate
+	}
+
+	// SodConstraintsUpsert is the "OnConflict" setter.
+	SodConstraintsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCode sets the "code" field.
+func (u *SodConstraintsUpsert) SetCode(v string) *SodConstraintsUpsert {
+	u.Set(sodconstraints.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *SodConstraintsUpsert) UpdateCode() *SodConstraintsUpsert {
+	u.SetExcluded(sodconstraints.FieldCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SodConstraintsUpsert) SetName(v string) *SodConstraintsUpsert {
+	u.Set(sodconstraints.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SodConstraintsUpsert) UpdateName() *SodConstraintsUpsert {
+	u.SetExcluded(sodconstraints.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *SodConstraintsUpsert) SetDescription(v string) *SodConstraintsUpsert {
+	u.Set(sodconstraints.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SodConstraintsUpsert) UpdateDescription() *SodConstraintsUpsert {
+	u.SetExcluded(sodconstraints.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SodConstraintsUpsert) ClearDescription() *SodConstraintsUpsert {
+	u.SetNull(sodconstraints.FieldDescription)
+	return u
+}
+
+// SetIsSystem sets the "is_system" field.
+func (u *SodConstraintsUpsert) SetIsSystem(v bool) *SodConstraintsUpsert {
+	u.Set(sodconstraints.FieldIsSystem, v)
+	return u
+}
+
+// UpdateIsSystem sets the "is_system" field to the value that was provided on create.
+func (u *SodConstraintsUpsert) UpdateIsSystem() *SodConstraintsUpsert {
+	u.SetExcluded(sodconstraints.FieldIsSystem)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SodConstraintsUpsert) SetUpdatedAt(v time.Time) *SodConstraintsUpsert {
+	u.Set(sodconstraints.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SodConstraintsUpsert) UpdateUpdatedAt() *SodConstraintsUpsert {
+	u.SetExcluded(sodconstraints.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SodConstraints.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sodconstraints.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SodConstraintsUpsertOne) UpdateNewValues() *SodConstraintsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(sodconstraints.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(sodconstraints.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SodConstraints.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SodConstraintsUpsertOne) Ignore() *SodConstraintsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SodConstraintsUpsertOne) DoNothing() *SodConstraintsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SodConstraintsCreate.OnConflict
+// documentation for more info.
+func (u *SodConstraintsUpsertOne) Update(set func(*SodConstraintsUpsert)) *SodConstraintsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SodConstraintsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *SodConstraintsUpsertOne) SetCode(v string) *SodConstraintsUpsertOne {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *SodConstraintsUpsertOne) UpdateCode() *SodConstraintsUpsertOne {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SodConstraintsUpsertOne) SetName(v string) *SodConstraintsUpsertOne {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SodConstraintsUpsertOne) UpdateName() *SodConstraintsUpsertOne {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SodConstraintsUpsertOne) SetDescription(v string) *SodConstraintsUpsertOne {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SodConstraintsUpsertOne) UpdateDescription() *SodConstraintsUpsertOne {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SodConstraintsUpsertOne) ClearDescription() *SodConstraintsUpsertOne {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetIsSystem sets the "is_system" field.
+func (u *SodConstraintsUpsertOne) SetIsSystem(v bool) *SodConstraintsUpsertOne {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.SetIsSystem(v)
+	})
+}
+
+// UpdateIsSystem sets the "is_system" field to the value that was provided on create.
+func (u *SodConstraintsUpsertOne) UpdateIsSystem() *SodConstraintsUpsertOne {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.UpdateIsSystem()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SodConstraintsUpsertOne) SetUpdatedAt(v time.Time) *SodConstraintsUpsertOne {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SodConstraintsUpsertOne) UpdateUpdatedAt() *SodConstraintsUpsertOne {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SodConstraintsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SodConstraintsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SodConstraintsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SodConstraintsUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SodConstraintsUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SodConstraintsCreateBulk is the builder for creating many SodConstraints entities in bulk.
+type SodConstraintsCreateBulk struct {
+	config
+	err      error
+	builders []*SodConstraintsCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SodConstraints entities in the database.
+func (sccb *SodConstraintsCreateBulk) Save(ctx context.Context) ([]*SodConstraints, error) {
+	if sccb.err != nil {
+		return nil, sccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sccb.builders))
+	nodes := make([]*SodConstraints, len(sccb.builders))
+	mutators := make([]Mutator, len(sccb.builders))
+	for i := range sccb.builders {
+		func(i int, root context.Context) {
+			builder := sccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SodConstraintsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sccb *SodConstraintsCreateBulk) SaveX(ctx context.Context) []*SodConstraints {
+	v, err := sccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sccb *SodConstraintsCreateBulk) Exec(ctx context.Context) error {
+	_, err := sccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sccb *SodConstraintsCreateBulk) ExecX(ctx context.Context) {
+	if err := sccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SodConstraints.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SodConstraintsUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (sccb *SodConstraintsCreateBulk) OnConflict(opts ...sql.ConflictOption) *SodConstraintsUpsertBulk {
+	sccb.conflict = opts
+	return &SodConstraintsUpsertBulk{
+		create: sccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SodConstraints.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sccb *SodConstraintsCreateBulk) OnConflictColumns(columns ...string) *SodConstraintsUpsertBulk {
+	sccb.conflict = append(sccb.conflict, sql.ConflictColumns(columns...))
+	return &SodConstraintsUpsertBulk{
+		create: sccb,
+	}
+}
+
+// SodConstraintsUpsertBulk is the builder for "upsert"-ing
+// a bulk of SodConstraints nodes.
+type SodConstraintsUpsertBulk struct {
+	create *SodConstraintsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SodConstraints.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(sodconstraints.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SodConstraintsUpsertBulk) UpdateNewValues() *SodConstraintsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(sodconstraints.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(sodconstraints.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SodConstraints.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SodConstraintsUpsertBulk) Ignore() *SodConstraintsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SodConstraintsUpsertBulk) DoNothing() *SodConstraintsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SodConstraintsCreateBulk.OnConflict
+// documentation for more info.
+func (u *SodConstraintsUpsertBulk) Update(set func(*SodConstraintsUpsert)) *SodConstraintsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SodConstraintsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *SodConstraintsUpsertBulk) SetCode(v string) *SodConstraintsUpsertBulk {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *SodConstraintsUpsertBulk) UpdateCode() *SodConstraintsUpsertBulk {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SodConstraintsUpsertBulk) SetName(v string) *SodConstraintsUpsertBulk {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SodConstraintsUpsertBulk) UpdateName() *SodConstraintsUpsertBulk {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SodConstraintsUpsertBulk) SetDescription(v string) *SodConstraintsUpsertBulk {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SodConstraintsUpsertBulk) UpdateDescription() *SodConstraintsUpsertBulk {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SodConstraintsUpsertBulk) ClearDescription() *SodConstraintsUpsertBulk {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetIsSystem sets the "is_system" field.
+func (u *SodConstraintsUpsertBulk) SetIsSystem(v bool) *SodConstraintsUpsertBulk {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.SetIsSystem(v)
+	})
+}
+
+// UpdateIsSystem sets the "is_system" field to the value that was provided on create.
+func (u *SodConstraintsUpsertBulk) UpdateIsSystem() *SodConstraintsUpsertBulk {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.UpdateIsSystem()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SodConstraintsUpsertBulk) SetUpdatedAt(v time.Time) *SodConstraintsUpsertBulk {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SodConstraintsUpsertBulk) UpdateUpdatedAt() *SodConstraintsUpsertBulk {
+	return u.Update(func(s *SodConstraintsUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SodConstraintsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SodConstraintsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SodConstraintsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SodConstraintsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
+)
+
+// SodConstraintsDelete is the builder for deleting a SodConstraints entity.
+type SodConstraintsDelete struct {
+	config
+	hooks    []Hook
+	mutation *SodConstraintsMutation
+}
+
+// Where appends a list predicates to the SodConstraintsDelete builder.
+func (scd *SodConstraintsDelete) Where(ps ...predicate.SodConstraints) *SodConstraintsDelete {
+	scd.mutation.Where(ps...)
+	return scd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (scd *SodConstraintsDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, scd.sqlExec, scd.mutation, scd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scd *SodConstraintsDelete) ExecX(ctx context.Context) int {
+	n, err := scd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (scd *SodConstraintsDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(sodconstraints.Table, sqlgraph.NewFieldSpec(sodconstraints.FieldID, field.TypeInt))
+	if ps := scd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, scd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	scd.mutation.done = true
+	return affected, err
+}
+
+// SodConstraintsDeleteOne is the builder for deleting a single SodConstraints entity.
+type SodConstraintsDeleteOne struct {
+	scd *SodConstraintsDelete
+}
+
+// Where appends a list predicates to the SodConstraintsDelete builder.
+func (scdo *SodConstraintsDeleteOne) Where(ps ...predicate.SodConstraints) *SodConstraintsDeleteOne {
+	scdo.scd.mutation.Where(ps...)
+	return scdo
+}
+
+// Exec executes the deletion query.
+func (scdo *SodConstraintsDeleteOne) Exec(ctx context.Context) error {
+	n, err := scdo.scd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sodconstraints.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scdo *SodConstraintsDeleteOne) ExecX(ctx context.Context) {
+	if err := scdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
+)
+
+// SodConstraintsQuery is the builder for querying SodConstraints entities.
+type SodConstraintsQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []sodconstraints.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.SodConstraints
+	withConstraintRoles *SodConstraintRolesQuery
+	modifiers           []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SodConstraintsQuery builder.
+func (scq *SodConstraintsQuery) Where(ps ...predicate.SodConstraints) *SodConstraintsQuery {
+	scq.predicates = append(scq.predicates, ps...)
+	return scq
+}
+
+// Limit the number of records to be returned by this query.
+func (scq *SodConstraintsQuery) Limit(limit int) *SodConstraintsQuery {
+	scq.ctx.Limit = &limit
+	return scq
+}
+
+// Offset to start from.
+func (scq *SodConstraintsQuery) Offset(offset int) *SodConstraintsQuery {
+	scq.ctx.Offset = &offset
+	return scq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (scq *SodConstraintsQuery) Unique(unique bool) *SodConstraintsQuery {
+	scq.ctx.Unique = &unique
+	return scq
+}
+
+// Order specifies how the records should be ordered.
+func (scq *SodConstraintsQuery) Order(o ...sodconstraints.OrderOption) *SodConstraintsQuery {
+	scq.order = append(scq.order, o...)
+	return scq
+}
+
+// QueryConstraintRoles chains the current query on the "constraint_roles" edge.
+func (scq *SodConstraintsQuery) QueryConstraintRoles() *SodConstraintRolesQuery {
+	query := (&SodConstraintRolesClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sodconstraints.Table, sodconstraints.FieldID, selector),
+			sqlgraph.To(sodconstraintroles.Table, sodconstraintroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, sodconstraints.ConstraintRolesTable, sodconstraints.ConstraintRolesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SodConstraints entity from the query.
+// Returns a *NotFoundError when no SodConstraints was found.
+func (scq *SodConstraintsQuery) First(ctx context.Context) (*SodConstraints, error) {
+	nodes, err := scq.Limit(1).All(setContextOp(ctx, scq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sodconstraints.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (scq *SodConstraintsQuery) FirstX(ctx context.Context) *SodConstraints {
+	node, err := scq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SodConstraints ID from the query.
+// Returns a *NotFoundError when no SodConstraints ID was found.
+func (scq *SodConstraintsQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = scq.Limit(1).IDs(setContextOp(ctx, scq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sodconstraints.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (scq *SodConstraintsQuery) FirstIDX(ctx context.Context) int {
+	id, err := scq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SodConstraints entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SodConstraints entity is found.
+// Returns a *NotFoundError when no SodConstraints entities are found.
+func (scq *SodConstraintsQuery) Only(ctx context.Context) (*SodConstraints, error) {
+	nodes, err := scq.Limit(2).All(setContextOp(ctx, scq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sodconstraints.Label}
+	default:
+		return nil, &NotSingularError{sodconstraints.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (scq *SodConstraintsQuery) OnlyX(ctx context.Context) *SodConstraints {
+	node, err := scq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SodConstraints ID in the query.
+// Returns a *NotSingularError when more than one SodConstraints ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (scq *SodConstraintsQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = scq.Limit(2).IDs(setContextOp(ctx, scq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sodconstraints.Label}
+	default:
+		err = &NotSingularError{sodconstraints.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (scq *SodConstraintsQuery) OnlyIDX(ctx context.Context) int {
+	id, err := scq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SodConstraintsSlice.
+func (scq *SodConstraintsQuery) All(ctx context.Context) ([]*SodConstraints, error) {
+	ctx = setContextOp(ctx, scq.ctx, "All")
+	if err := scq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SodConstraints, *SodConstraintsQuery]()
+	return withInterceptors[[]*SodConstraints](ctx, scq, qr, scq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (scq *SodConstraintsQuery) AllX(ctx context.Context) []*SodConstraints {
+	nodes, err := scq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SodConstraints IDs.
+func (scq *SodConstraintsQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if scq.ctx.Unique == nil && scq.path != nil {
+		scq.Unique(true)
+	}
+	ctx = setContextOp(ctx, scq.ctx, "IDs")
+	if err = scq.Select(sodconstraints.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (scq *SodConstraintsQuery) IDsX(ctx context.Context) []int {
+	ids, err := scq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (scq *SodConstraintsQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, scq.ctx, "Count")
+	if err := scq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, scq, querierCount[*SodConstraintsQuery](), scq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (scq *SodConstraintsQuery) CountX(ctx context.Context) int {
+	count, err := scq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (scq *SodConstraintsQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, scq.ctx, "Exist")
+	switch _, err := scq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (scq *SodConstraintsQuery) ExistX(ctx context.Context) bool {
+	exist, err := scq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SodConstraintsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (scq *SodConstraintsQuery) Clone() *SodConstraintsQuery {
+	if scq == nil {
+		return nil
+	}
+	return &SodConstraintsQuery{
+		config:              scq.config,
+		ctx:                 scq.ctx.Clone(),
+		order:               append([]sodconstraints.OrderOption{}, scq.order...),
+		inters:              append([]Interceptor{}, scq.inters...),
+		predicates:          append([]predicate.SodConstraints{}, scq.predicates...),
+		withConstraintRoles: scq.withConstraintRoles.Clone(),
+		// clone intermediate query.
+		sql:  scq.sql.Clone(),
+		path: scq.path,
+	}
+}
+
+// WithConstraintRoles tells the query-builder to eager-load the nodes that are connected to
+// the "constraint_roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SodConstraintsQuery) WithConstraintRoles(opts ...func(*SodConstraintRolesQuery)) *SodConstraintsQuery {
+	query := (&SodConstraintRolesClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withConstraintRoles = query
+	return scq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Code string `json:"code,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SodConstraints.Query().
+//		GroupBy(sodconstraints.FieldCode).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (scq *SodConstraintsQuery) GroupBy(field string, fields ...string) *SodConstraintsGroupBy {
+	scq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SodConstraintsGroupBy{build: scq}
+	grbuild.flds = &scq.ctx.Fields
+	grbuild.label = sodconstraints.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Code string `json:"code,omitempty"`
+//	}
+//
+//	client.SodConstraints.Query().
+//		Select(sodconstraints.FieldCode).
+//		Scan(ctx, &v)
+func (scq *SodConstraintsQuery) Select(fields ...string) *SodConstraintsSelect {
+	scq.ctx.Fields = append(scq.ctx.Fields, fields...)
+	sbuild := &SodConstraintsSelect{SodConstraintsQuery: scq}
+	sbuild.label = sodconstraints.Label
+	sbuild.flds, sbuild.scan = &scq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SodConstraintsSelect configured with the given aggregations.
+func (scq *SodConstraintsQuery) Aggregate(fns ...AggregateFunc) *SodConstraintsSelect {
+	return scq.Select().Aggregate(fns...)
+}
+
+func (scq *SodConstraintsQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range scq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, scq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range scq.ctx.Fields {
+		if !sodconstraints.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if scq.path != nil {
+		prev, err := scq.path(ctx)
+		if err != nil {
+			return err
+		}
+		scq.sql = prev
+	}
+	return nil
+}
+
+func (scq *SodConstraintsQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SodConstraints, error) {
+	var (
+		nodes       = []*SodConstraints{}
+		_spec       = scq.querySpec()
+		loadedTypes = [1]bool{
+			scq.withConstraintRoles != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SodConstraints).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SodConstraints{config: scq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(scq.modifiers) > 0 {
+		_spec.Modifiers = scq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, scq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := scq.withConstraintRoles; query != nil {
+		if err := scq.loadConstraintRoles(ctx, query, nodes,
+			func(n *SodConstraints) { n.Edges.ConstraintRoles = []*SodConstraintRoles{} },
+			func(n *SodConstraints, e *SodConstraintRoles) {
+				n.Edges.ConstraintRoles = append(n.Edges.ConstraintRoles, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (scq *SodConstraintsQuery) loadConstraintRoles(ctx context.Context, query *SodConstraintRolesQuery, nodes []*SodConstraints, init func(*SodConstraints), assign func(*SodConstraints, *SodConstraintRoles)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*SodConstraints)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(sodconstraintroles.FieldConstraintID)
+	}
+	query.Where(predicate.SodConstraintRoles(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(sodconstraints.ConstraintRolesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConstraintID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "constraint_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (scq *SodConstraintsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := scq.querySpec()
+	if len(scq.modifiers) > 0 {
+		_spec.Modifiers = scq.modifiers
+	}
+	_spec.Node.Columns = scq.ctx.Fields
+	if len(scq.ctx.Fields) > 0 {
+		_spec.Unique = scq.ctx.Unique != nil && *scq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, scq.driver, _spec)
+}
+
+func (scq *SodConstraintsQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(sodconstraints.Table, sodconstraints.Columns, sqlgraph.NewFieldSpec(sodconstraints.FieldID, field.TypeInt))
+	_spec.From = scq.sql
+	if unique := scq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if scq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := scq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sodconstraints.FieldID)
+		for i := range fields {
+			if fields[i] != sodconstraints.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := scq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := scq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (scq *SodConstraintsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(scq.driver.Dialect())
+	t1 := builder.Table(sodconstraints.Table)
+	columns := scq.ctx.Fields
+	if len(columns) == 0 {
+		columns = sodconstraints.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if scq.sql != nil {
+		selector = scq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if scq.ctx.Unique != nil && *scq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range scq.modifiers {
+		m(selector)
+	}
+	for _, p := range scq.predicates {
+		p(selector)
+	}
+	for _, p := range scq.order {
+		p(selector)
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (scq *SodConstraintsQuery) ForUpdate(opts ...sql.LockOption) *SodConstraintsQuery {
+	if scq.driver.Dialect() == dialect.Postgres {
+		scq.Unique(false)
+	}
+	scq.modifiers = append(scq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return scq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (scq *SodConstraintsQuery) ForShare(opts ...sql.LockOption) *SodConstraintsQuery {
+	if scq.driver.Dialect() == dialect.Postgres {
+		scq.Unique(false)
+	}
+	scq.modifiers = append(scq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return scq
+}
+
+// SodConstraintsGroupBy is the group-by builder for SodConstraints entities.
+type SodConstraintsGroupBy struct {
+	selector
+	build *SodConstraintsQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (scgb *SodConstraintsGroupBy) Aggregate(fns ...AggregateFunc) *SodConstraintsGroupBy {
+	scgb.fns = append(scgb.fns, fns...)
+	return scgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scgb *SodConstraintsGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scgb.build.ctx, "GroupBy")
+	if err := scgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SodConstraintsQuery, *SodConstraintsGroupBy](ctx, scgb.build, scgb, scgb.build.inters, v)
+}
+
+func (scgb *SodConstraintsGroupBy) sqlScan(ctx context.Context, root *SodConstraintsQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(scgb.fns))
+	for _, fn := range scgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*scgb.flds)+len(scgb.fns))
+		for _, f := range *scgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*scgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SodConstraintsSelect is the builder for selecting fields of SodConstraints entities.
+type SodConstraintsSelect struct {
+	*SodConstraintsQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (scs *SodConstraintsSelect) Aggregate(fns ...AggregateFunc) *SodConstraintsSelect {
+	scs.fns = append(scs.fns, fns...)
+	return scs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scs *SodConstraintsSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scs.ctx, "Select")
+	if err := scs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SodConstraintsQuery, *SodConstraintsSelect](ctx, scs.SodConstraintsQuery, scs, scs.inters, v)
+}
+
+func (scs *SodConstraintsSelect) sqlScan(ctx context.Context, root *SodConstraintsQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(scs.fns))
+	for _, fn := range scs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*scs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
+)
+
+// SodConstraintsUpdate is the builder for updating SodConstraints entities.
+type SodConstraintsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SodConstraintsMutation
+}
+
+// Where appends a list predicates to the SodConstraintsUpdate builder.
+func (scu *SodConstraintsUpdate) Where(ps ...predicate.SodConstraints) *SodConstraintsUpdate {
+	scu.mutation.Where(ps...)
+	return scu
+}
+
+// SetCode sets the "code" field.
+func (scu *SodConstraintsUpdate) SetCode(s string) *SodConstraintsUpdate {
+	scu.mutation.SetCode(s)
+	return scu
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (scu *SodConstraintsUpdate) SetNillableCode(s *string) *SodConstraintsUpdate {
+	if s != nil {
+		scu.SetCode(*s)
+	}
+	return scu
+}
+
+// SetName sets the "name" field.
+func (scu *SodConstraintsUpdate) SetName(s string) *SodConstraintsUpdate {
+	scu.mutation.SetName(s)
+	return scu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (scu *SodConstraintsUpdate) SetNillableName(s *string) *SodConstraintsUpdate {
+	if s != nil {
+		scu.SetName(*s)
+	}
+	return scu
+}
+
+// SetDescription sets the "description" field.
+func (scu *SodConstraintsUpdate) SetDescription(s string) *SodConstraintsUpdate {
+	scu.mutation.SetDescription(s)
+	return scu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (scu *SodConstraintsUpdate) SetNillableDescription(s *string) *SodConstraintsUpdate {
+	if s != nil {
+		scu.SetDescription(*s)
+	}
+	return scu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (scu *SodConstraintsUpdate) ClearDescription() *SodConstraintsUpdate {
+	scu.mutation.ClearDescription()
+	return scu
+}
+
+// SetIsSystem sets the "is_system" field.
+func (scu *SodConstraintsUpdate) SetIsSystem(b bool) *SodConstraintsUpdate {
+	scu.mutation.SetIsSystem(b)
+	return scu
+}
+
+// SetNillableIsSystem sets the "is_system" field if the given value is not nil.
+func (scu *SodConstraintsUpdate) SetNillableIsSystem(b *bool) *SodConstraintsUpdate {
+	if b != nil {
+		scu.SetIsSystem(*b)
+	}
+	return scu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (scu *SodConstraintsUpdate) SetUpdatedAt(t time.Time) *SodConstraintsUpdate {
+	scu.mutation.SetUpdatedAt(t)
+	return scu
+}
+
+// AddConstraintRoleIDs adds the "constraint_roles" edge to the SodConstraintRoles entity by IDs.
+func (scu *SodConstraintsUpdate) AddConstraintRoleIDs(ids ...int) *SodConstraintsUpdate {
+	scu.mutation.AddConstraintRoleIDs(ids...)
+	return scu
+}
+
+// AddConstraintRoles adds the "constraint_roles" edges to the SodConstraintRoles entity.
+func (scu *SodConstraintsUpdate) AddConstraintRoles(s ...*SodConstraintRoles) *SodConstraintsUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scu.AddConstraintRoleIDs(ids...)
+}
+
+// Mutation returns the SodConstraintsMutation object of the builder.
+func (scu *SodConstraintsUpdate) Mutation() *SodConstraintsMutation {
+	return scu.mutation
+}
+
+// ClearConstraintRoles clears all "constraint_roles" edges to the SodConstraintRoles entity.
+func (scu *SodConstraintsUpdate) ClearConstraintRoles() *SodConstraintsUpdate {
+	scu.mutation.ClearConstraintRoles()
+	return scu
+}
+
+// RemoveConstraintRoleIDs removes the "constraint_roles" edge to SodConstraintRoles entities by IDs.
+func (scu *SodConstraintsUpdate) RemoveConstraintRoleIDs(ids ...int) *SodConstraintsUpdate {
+	scu.mutation.RemoveConstraintRoleIDs(ids...)
+	return scu
+}
+
+// RemoveConstraintRoles removes "constraint_roles" edges to SodConstraintRoles entities.
+func (scu *SodConstraintsUpdate) RemoveConstraintRoles(s ...*SodConstraintRoles) *SodConstraintsUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scu.RemoveConstraintRoleIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scu *SodConstraintsUpdate) Save(ctx context.Context) (int, error) {
+	scu.defaults()
+	return withHooks(ctx, scu.sqlSave, scu.mutation, scu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scu *SodConstraintsUpdate) SaveX(ctx context.Context) int {
+	affected, err := scu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scu *SodConstraintsUpdate) Exec(ctx context.Context) error {
+	_, err := scu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scu *SodConstraintsUpdate) ExecX(ctx context.Context) {
+	if err := scu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scu *SodConstraintsUpdate) defaults() {
+	if _, ok := scu.mutation.UpdatedAt(); !ok {
+		v := sodconstraints.UpdateDefaultUpdatedAt()
+		scu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scu *SodConstraintsUpdate) check() error {
+	if v, ok := scu.mutation.Code(); ok {
+		if err := sodconstraints.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SodConstraints.code": %w`, err)}
+		}
+	}
+	if v, ok := scu.mutation.Name(); ok {
+		if err := sodconstraints.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SodConstraints.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (scu *SodConstraintsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sodconstraints.Table, sodconstraints.Columns, sqlgraph.NewFieldSpec(sodconstraints.FieldID, field.TypeInt))
+	if ps := scu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scu.mutation.Code(); ok {
+		_spec.SetField(sodconstraints.FieldCode, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.Name(); ok {
+		_spec.SetField(sodconstraints.FieldName, field.TypeString, value)
+	}
+	if value, ok := scu.mutation.Description(); ok {
+		_spec.SetField(sodconstraints.FieldDescription, field.TypeString, value)
+	}
+	if scu.mutation.DescriptionCleared() {
+		_spec.ClearField(sodconstraints.FieldDescription, field.TypeString)
+	}
+	if value, ok := scu.mutation.IsSystem(); ok {
+		_spec.SetField(sodconstraints.FieldIsSystem, field.TypeBool, value)
+	}
+	if value, ok := scu.mutation.UpdatedAt(); ok {
+		_spec.SetField(sodconstraints.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if scu.mutation.ConstraintRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   sodconstraints.ConstraintRolesTable,
+			Columns: []string{sodconstraints.ConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.RemovedConstraintRolesIDs(); len(nodes) > 0 && !scu.mutation.ConstraintRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   sodconstraints.ConstraintRolesTable,
+			Columns: []string{sodconstraints.ConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.ConstraintRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   sodconstraints.ConstraintRolesTable,
+			Columns: []string{sodconstraints.ConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sodconstraints.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scu.mutation.done = true
+	return n, nil
+}
+
+// SodConstraintsUpdateOne is the builder for updating a single SodConstraints entity.
+type SodConstraintsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SodConstraintsMutation
+}
+
+// SetCode sets the "code" field.
+func (scuo *SodConstraintsUpdateOne) SetCode(s string) *SodConstraintsUpdateOne {
+	scuo.mutation.SetCode(s)
+	return scuo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (scuo *SodConstraintsUpdateOne) SetNillableCode(s *string) *SodConstraintsUpdateOne {
+	if s != nil {
+		scuo.SetCode(*s)
+	}
+	return scuo
+}
+
+// SetName sets the "name" field.
+func (scuo *SodConstraintsUpdateOne) SetName(s string) *SodConstraintsUpdateOne {
+	scuo.mutation.SetName(s)
+	return scuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (scuo *SodConstraintsUpdateOne) SetNillableName(s *string) *SodConstraintsUpdateOne {
+	if s != nil {
+		scuo.SetName(*s)
+	}
+	return scuo
+}
+
+// SetDescription sets the "description" field.
+func (scuo *SodConstraintsUpdateOne) SetDescription(s string) *SodConstraintsUpdateOne {
+	scuo.mutation.SetDescription(s)
+	return scuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (scuo *SodConstraintsUpdateOne) SetNillableDescription(s *string) *SodConstraintsUpdateOne {
+	if s != nil {
+		scuo.SetDescription(*s)
+	}
+	return scuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (scuo *SodConstraintsUpdateOne) ClearDescription() *SodConstraintsUpdateOne {
+	scuo.mutation.ClearDescription()
+	return scuo
+}
+
+// SetIsSystem sets the "is_system" field.
+func (scuo *SodConstraintsUpdateOne) SetIsSystem(b bool) *SodConstraintsUpdateOne {
+	scuo.mutation.SetIsSystem(b)
+	return scuo
+}
+
+// SetNillableIsSystem sets the "is_system" field if the given value is not nil.
+func (scuo *SodConstraintsUpdateOne) SetNillableIsSystem(b *bool) *SodConstraintsUpdateOne {
+	if b != nil {
+		scuo.SetIsSystem(*b)
+	}
+	return scuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (scuo *SodConstraintsUpdateOne) SetUpdatedAt(t time.Time) *SodConstraintsUpdateOne {
+	scuo.mutation.SetUpdatedAt(t)
+	return scuo
+}
+
+// AddConstraintRoleIDs adds the "constraint_roles" edge to the SodConstraintRoles entity by IDs.
+func (scuo *SodConstraintsUpdateOne) AddConstraintRoleIDs(ids ...int) *SodConstraintsUpdateOne {
+	scuo.mutation.AddConstraintRoleIDs(ids...)
+	return scuo
+}
+
+// AddConstraintRoles adds the "constraint_roles" edges to the SodConstraintRoles entity.
+func (scuo *SodConstraintsUpdateOne) AddConstraintRoles(s ...*SodConstraintRoles) *SodConstraintsUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scuo.AddConstraintRoleIDs(ids...)
+}
+
+// Mutation returns the SodConstraintsMutation object of the builder.
+func (scuo *SodConstraintsUpdateOne) Mutation() *SodConstraintsMutation {
+	return scuo.mutation
+}
+
+// ClearConstraintRoles clears all "constraint_roles" edges to the SodConstraintRoles entity.
+func (scuo *SodConstraintsUpdateOne) ClearConstraintRoles() *SodConstraintsUpdateOne {
+	scuo.mutation.ClearConstraintRoles()
+	return scuo
+}
+
+// RemoveConstraintRoleIDs removes the "constraint_roles" edge to SodConstraintRoles entities by IDs.
+func (scuo *SodConstraintsUpdateOne) RemoveConstraintRoleIDs(ids ...int) *SodConstraintsUpdateOne {
+	scuo.mutation.RemoveConstraintRoleIDs(ids...)
+	return scuo
+}
+
+// RemoveConstraintRoles removes "constraint_roles" edges to SodConstraintRoles entities.
+func (scuo *SodConstraintsUpdateOne) RemoveConstraintRoles(s ...*SodConstraintRoles) *SodConstraintsUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return scuo.RemoveConstraintRoleIDs(ids...)
+}
+
+// Where appends a list predicates to the SodConstraintsUpdate builder.
+func (scuo *SodConstraintsUpdateOne) Where(ps ...predicate.SodConstraints) *SodConstraintsUpdateOne {
+	scuo.mutation.Where(ps...)
+	return scuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scuo *SodConstraintsUpdateOne) Select(field string, fields ...string) *SodConstraintsUpdateOne {
+	scuo.fields = append([]string{field}, fields...)
+	return scuo
+}
+
+// Save executes the query and returns the updated SodConstraints entity.
+func (scuo *SodConstraintsUpdateOne) Save(ctx context.Context) (*SodConstraints, error) {
+	scuo.defaults()
+	return withHooks(ctx, scuo.sqlSave, scuo.mutation, scuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scuo *SodConstraintsUpdateOne) SaveX(ctx context.Context) *SodConstraints {
+	node, err := scuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scuo *SodConstraintsUpdateOne) Exec(ctx context.Context) error {
+	_, err := scuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scuo *SodConstraintsUpdateOne) ExecX(ctx context.Context) {
+	if err := scuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scuo *SodConstraintsUpdateOne) defaults() {
+	if _, ok := scuo.mutation.UpdatedAt(); !ok {
+		v := sodconstraints.UpdateDefaultUpdatedAt()
+		scuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scuo *SodConstraintsUpdateOne) check() error {
+	if v, ok := scuo.mutation.Code(); ok {
+		if err := sodconstraints.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "SodConstraints.code": %w`, err)}
+		}
+	}
+	if v, ok := scuo.mutation.Name(); ok {
+		if err := sodconstraints.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SodConstraints.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (scuo *SodConstraintsUpdateOne) sqlSave(ctx context.Context) (_node *SodConstraints, err error) {
+	if err := scuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sodconstraints.Table, sodconstraints.Columns, sqlgraph.NewFieldSpec(sodconstraints.FieldID, field.TypeInt))
+	id, ok := scuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SodConstraints.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sodconstraints.FieldID)
+		for _, f := range fields {
+			if !sodconstraints.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sodconstraints.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scuo.mutation.Code(); ok {
+		_spec.SetField(sodconstraints.FieldCode, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.Name(); ok {
+		_spec.SetField(sodconstraints.FieldName, field.TypeString, value)
+	}
+	if value, ok := scuo.mutation.Description(); ok {
+		_spec.SetField(sodconstraints.FieldDescription, field.TypeString, value)
+	}
+	if scuo.mutation.DescriptionCleared() {
+		_spec.ClearField(sodconstraints.FieldDescription, field.TypeString)
+	}
+	if value, ok := scuo.mutation.IsSystem(); ok {
+		_spec.SetField(sodconstraints.FieldIsSystem, field.TypeBool, value)
+	}
+	if value, ok := scuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(sodconstraints.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if scuo.mutation.ConstraintRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   sodconstraints.ConstraintRolesTable,
+			Columns: []string{sodconstraints.ConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.RemovedConstraintRolesIDs(); len(nodes) > 0 && !scuo.mutation.ConstraintRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   sodconstraints.ConstraintRolesTable,
+			Columns: []string{sodconstraints.ConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.ConstraintRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   sodconstraints.ConstraintRolesTable,
+			Columns: []string{sodconstraints.ConstraintRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sodconstraintroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SodConstraints{config: scuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sodconstraints.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scuo.mutation.done = true
+	return _node, nil
+}
@@ -12,20 +12,58 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKeys is the client for interacting with the APIKeys builders.
+	APIKeys *APIKeysClient
 	// AuditLogs is the client for interacting with the AuditLogs builders.
 	AuditLogs *AuditLogsClient
+	// ConsentAcceptances is the client for interacting with the ConsentAcceptances builders.
+	ConsentAcceptances *ConsentAcceptancesClient
+	// ConsentDocuments is the client for interacting with the ConsentDocuments builders.
+	ConsentDocuments *ConsentDocumentsClient
+	// EmailChanges is the client for interacting with the EmailChanges builders.
+	EmailChanges *EmailChangesClient
 	// EmailLogs is the client for interacting with the EmailLogs builders.
 	EmailLogs *EmailLogsClient
 	// EmailVerifications is the client for interacting with the EmailVerifications builders.
 	EmailVerifications *EmailVerificationsClient
+	// GroupMembers is the client for interacting with the GroupMembers builders.
+	GroupMembers *GroupMembersClient
+	// GroupParents is the client for interacting with the GroupParents builders.
+	GroupParents *GroupParentsClient
+	// GroupRoles is the client for interacting with the GroupRoles builders.
+	GroupRoles *GroupRolesClient
+	// Groups is the client for interacting with the Groups builders.
+	Groups *GroupsClient
+	// Invitations is the client for interacting with the Invitations builders.
+	Invitations *InvitationsClient
+	// OrgMembers is the client for interacting with the OrgMembers builders.
+	OrgMembers *OrgMembersClient
+	// OrgRoles is the client for interacting with the OrgRoles builders.
+	OrgRoles *OrgRolesClient
+	// Organizations is the client for interacting with the Organizations builders.
+	Organizations *OrganizationsClient
+	// PasswordHistories is the client for interacting with the PasswordHistories builders.
+	PasswordHistories *PasswordHistoriesClient
 	// PasswordResets is the client for interacting with the PasswordResets builders.
 	PasswordResets *PasswordResetsClient
 	// Permissions is the client for interacting with the Permissions builders.
 	Permissions *PermissionsClient
+	// RelationTuples is the client for interacting with the RelationTuples builders.
+	RelationTuples *RelationTuplesClient
+	// RoleApprovers is the client for interacting with the RoleApprovers builders.
+	RoleApprovers *RoleApproversClient
+	// RoleParents is the client for interacting with the RoleParents builders.
+	RoleParents *RoleParentsClient
 	// RolePermissions is the client for interacting with the RolePermissions builders.
 	RolePermissions *RolePermissionsClient
+	// RoleRequests is the client for interacting with the RoleRequests builders.
+	RoleRequests *RoleRequestsClient
 	// Roles is the client for interacting with the Roles builders.
 	Roles *RolesClient
+	// SodConstraintRoles is the client for interacting with the SodConstraintRoles builders.
+	SodConstraintRoles *SodConstraintRolesClient
+	// SodConstraints is the client for interacting with the SodConstraints builders.
+	SodConstraints *SodConstraintsClient
 	// UserRoles is the client for interacting with the UserRoles builders.
 	UserRoles *UserRolesClient
 	// Users is the client for interacting with the Users builders.
@@ -161,13 +199,32 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKeys = NewAPIKeysClient(tx.config)
 	tx.AuditLogs = NewAuditLogsClient(tx.config)
+	tx.ConsentAcceptances = NewConsentAcceptancesClient(tx.config)
+	tx.ConsentDocuments = NewConsentDocumentsClient(tx.config)
+	tx.EmailChanges = NewEmailChangesClient(tx.config)
 	tx.EmailLogs = NewEmailLogsClient(tx.config)
 	tx.EmailVerifications = NewEmailVerificationsClient(tx.config)
+	tx.GroupMembers = NewGroupMembersClient(tx.config)
+	tx.GroupParents = NewGroupParentsClient(tx.config)
+	tx.GroupRoles = NewGroupRolesClient(tx.config)
+	tx.Groups = NewGroupsClient(tx.config)
+	tx.Invitations = NewInvitationsClient(tx.config)
+	tx.OrgMembers = NewOrgMembersClient(tx.config)
+	tx.OrgRoles = NewOrgRolesClient(tx.config)
+	tx.Organizations = NewOrganizationsClient(tx.config)
+	tx.PasswordHistories = NewPasswordHistoriesClient(tx.config)
 	tx.PasswordResets = NewPasswordResetsClient(tx.config)
 	tx.Permissions = NewPermissionsClient(tx.config)
+	tx.RelationTuples = NewRelationTuplesClient(tx.config)
+	tx.RoleApprovers = NewRoleApproversClient(tx.config)
+	tx.RoleParents = NewRoleParentsClient(tx.config)
 	tx.RolePermissions = NewRolePermissionsClient(tx.config)
+	tx.RoleRequests = NewRoleRequestsClient(tx.config)
 	tx.Roles = NewRolesClient(tx.config)
+	tx.SodConstraintRoles = NewSodConstraintRolesClient(tx.config)
+	tx.SodConstraints = NewSodConstraintsClient(tx.config)
 	tx.UserRoles = NewUserRolesClient(tx.config)
 	tx.Users = NewUsersClient(tx.config)
 }
@@ -179,7 +236,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AuditLogs.QueryXXX(), the query will be executed
+// applies a query, for example: APIKeys.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
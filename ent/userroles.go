@@ -28,6 +28,10 @@ type UserRoles struct {
 	AssignedBy *uuid.UUID `json:"assigned_by,omitempty"`
 	// AssignedAt holds the value of the "assigned_at" field.
 	AssignedAt time.Time `json:"assigned_at,omitempty"`
+	// When the assignment is revoked automatically (null = permanent)
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Why the role was assigned
+	Reason string `json:"reason,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserRolesQuery when eager-loading is set.
 	Edges        UserRolesEdges `json:"edges"`
@@ -76,7 +80,9 @@ func (*UserRoles) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case userroles.FieldRoleID:
 			values[i] = new(sql.NullInt64)
-		case userroles.FieldAssignedAt:
+		case userroles.FieldReason:
+			values[i] = new(sql.NullString)
+		case userroles.FieldAssignedAt, userroles.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
 		case userroles.FieldID, userroles.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -126,6 +132,19 @@ func (ur *UserRoles) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ur.AssignedAt = value.Time
 			}
+		case userroles.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ur.ExpiresAt = new(time.Time)
+				*ur.ExpiresAt = value.Time
+			}
+		case userroles.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				ur.Reason = value.String
+			}
 		default:
 			ur.selectValues.Set(columns[i], values[i])
 		}
@@ -185,6 +204,14 @@ func (ur *UserRoles) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("assigned_at=")
 	builder.WriteString(ur.AssignedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ur.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(ur.Reason)
 	builder.WriteByte(')')
 	return builder.String()
 }
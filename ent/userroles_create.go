@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +23,7 @@ type UserRolesCreate struct {
 	config
 	mutation *UserRolesMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetUserID sets the "user_id" field.
@@ -63,6 +66,34 @@ func (urc *UserRolesCreate) SetNillableAssignedAt(t *time.Time) *UserRolesCreate
 	return urc
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (urc *UserRolesCreate) SetExpiresAt(t time.Time) *UserRolesCreate {
+	urc.mutation.SetExpiresAt(t)
+	return urc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (urc *UserRolesCreate) SetNillableExpiresAt(t *time.Time) *UserRolesCreate {
+	if t != nil {
+		urc.SetExpiresAt(*t)
+	}
+	return urc
+}
+
+// SetReason sets the "reason" field.
+func (urc *UserRolesCreate) SetReason(s string) *UserRolesCreate {
+	urc.mutation.SetReason(s)
+	return urc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (urc *UserRolesCreate) SetNillableReason(s *string) *UserRolesCreate {
+	if s != nil {
+		urc.SetReason(*s)
+	}
+	return urc
+}
+
 // SetID sets the "id" field.
 func (urc *UserRolesCreate) SetID(u uuid.UUID) *UserRolesCreate {
 	urc.mutation.SetID(u)
@@ -180,6 +211,7 @@ func (urc *UserRolesCreate) createSpec() (*UserRoles, *sqlgraph.CreateSpec) {
 		_node = &UserRoles{config: urc.config}
 		_spec = sqlgraph.NewCreateSpec(userroles.Table, sqlgraph.NewFieldSpec(userroles.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = urc.conflict
 	if id, ok := urc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -192,6 +224,14 @@ func (urc *UserRolesCreate) createSpec() (*UserRoles, *sqlgraph.CreateSpec) {
 		_spec.SetField(userroles.FieldAssignedAt, field.TypeTime, value)
 		_node.AssignedAt = value
 	}
+	if value, ok := urc.mutation.ExpiresAt(); ok {
+		_spec.SetField(userroles.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := urc.mutation.Reason(); ok {
+		_spec.SetField(userroles.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
 	if nodes := urc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -229,11 +269,319 @@ func (urc *UserRolesCreate) createSpec() (*UserRoles, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserRoles.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserRolesUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (urc *UserRolesCreate) OnConflict(opts ...sql.ConflictOption) *UserRolesUpsertOne {
+	urc.conflict = opts
+	return &UserRolesUpsertOne{
+		create: urc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserRoles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (urc *UserRolesCreate) OnConflictColumns(columns ...string) *UserRolesUpsertOne {
+	urc.conflict = append(urc.conflict, sql.ConflictColumns(columns...))
+	return &UserRolesUpsertOne{
+		create: urc,
+	}
+}
+
+type (
+	// UserRolesUpsertOne is the builder for "upsert"-ing
+	//  one UserRoles node.
+	UserRolesUpsertOne struct {
+		create *UserRolesCreate
+	}
+
+	// UserRolesUpsert is the "OnConflict" setter.
+	UserRolesUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *UserRolesUpsert) SetUserID(v uuid.UUID) *UserRolesUpsert {
+	u.Set(userroles.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserRolesUpsert) UpdateUserID() *UserRolesUpsert {
+	u.SetExcluded(userroles.FieldUserID)
+	return u
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *UserRolesUpsert) SetRoleID(v int) *UserRolesUpsert {
+	u.Set(userroles.FieldRoleID, v)
+	return u
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *UserRolesUpsert) UpdateRoleID() *UserRolesUpsert {
+	u.SetExcluded(userroles.FieldRoleID)
+	return u
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *UserRolesUpsert) SetAssignedBy(v uuid.UUID) *UserRolesUpsert {
+	u.Set(userroles.FieldAssignedBy, v)
+	return u
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *UserRolesUpsert) UpdateAssignedBy() *UserRolesUpsert {
+	u.SetExcluded(userroles.FieldAssignedBy)
+	return u
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *UserRolesUpsert) ClearAssignedBy() *UserRolesUpsert {
+	u.SetNull(userroles.FieldAssignedBy)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UserRolesUpsert) SetExpiresAt(v time.Time) *UserRolesUpsert {
+	u.Set(userroles.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UserRolesUpsert) UpdateExpiresAt() *UserRolesUpsert {
+	u.SetExcluded(userroles.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *UserRolesUpsert) ClearExpiresAt() *UserRolesUpsert {
+	u.SetNull(userroles.FieldExpiresAt)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *UserRolesUpsert) SetReason(v string) *UserRolesUpsert {
+	u.Set(userroles.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *UserRolesUpsert) UpdateReason() *UserRolesUpsert {
+	u.SetExcluded(userroles.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *UserRolesUpsert) ClearReason() *UserRolesUpsert {
+	u.SetNull(userroles.FieldReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserRoles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userroles.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserRolesUpsertOne) UpdateNewValues() *UserRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(userroles.FieldID)
+		}
+		if _, exists := u.create.mutation.AssignedAt(); exists {
+			s.SetIgnore(userroles.FieldAssignedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserRoles.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserRolesUpsertOne) Ignore() *UserRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserRolesUpsertOne) DoNothing() *UserRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserRolesCreate.OnConflict
+// documentation for more info.
+func (u *UserRolesUpsertOne) Update(set func(*UserRolesUpsert)) *UserRolesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserRolesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserRolesUpsertOne) SetUserID(v uuid.UUID) *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserRolesUpsertOne) UpdateUserID() *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *UserRolesUpsertOne) SetRoleID(v int) *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *UserRolesUpsertOne) UpdateRoleID() *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *UserRolesUpsertOne) SetAssignedBy(v uuid.UUID) *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.SetAssignedBy(v)
+	})
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *UserRolesUpsertOne) UpdateAssignedBy() *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.UpdateAssignedBy()
+	})
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *UserRolesUpsertOne) ClearAssignedBy() *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.ClearAssignedBy()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UserRolesUpsertOne) SetExpiresAt(v time.Time) *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UserRolesUpsertOne) UpdateExpiresAt() *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *UserRolesUpsertOne) ClearExpiresAt() *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *UserRolesUpsertOne) SetReason(v string) *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *UserRolesUpsertOne) UpdateReason() *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *UserRolesUpsertOne) ClearReason() *UserRolesUpsertOne {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *UserRolesUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserRolesCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserRolesUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserRolesUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UserRolesUpsertOne.ID is not supported by MySQL driver. Use UserRolesUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserRolesUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserRolesCreateBulk is the builder for creating many UserRoles entities in bulk.
 type UserRolesCreateBulk struct {
 	config
 	err      error
 	builders []*UserRolesCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the UserRoles entities in the database.
@@ -263,6 +611,7 @@ func (urcb *UserRolesCreateBulk) Save(ctx context.Context) ([]*UserRoles, error)
 					_, err = mutators[i+1].Mutate(root, urcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = urcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, urcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -312,3 +661,211 @@ func (urcb *UserRolesCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserRoles.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserRolesUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (urcb *UserRolesCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserRolesUpsertBulk {
+	urcb.conflict = opts
+	return &UserRolesUpsertBulk{
+		create: urcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserRoles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (urcb *UserRolesCreateBulk) OnConflictColumns(columns ...string) *UserRolesUpsertBulk {
+	urcb.conflict = append(urcb.conflict, sql.ConflictColumns(columns...))
+	return &UserRolesUpsertBulk{
+		create: urcb,
+	}
+}
+
+// UserRolesUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserRoles nodes.
+type UserRolesUpsertBulk struct {
+	create *UserRolesCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserRoles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userroles.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserRolesUpsertBulk) UpdateNewValues() *UserRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(userroles.FieldID)
+			}
+			if _, exists := b.mutation.AssignedAt(); exists {
+				s.SetIgnore(userroles.FieldAssignedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserRoles.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserRolesUpsertBulk) Ignore() *UserRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserRolesUpsertBulk) DoNothing() *UserRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserRolesCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserRolesUpsertBulk) Update(set func(*UserRolesUpsert)) *UserRolesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserRolesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserRolesUpsertBulk) SetUserID(v uuid.UUID) *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserRolesUpsertBulk) UpdateUserID() *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetRoleID sets the "role_id" field.
+func (u *UserRolesUpsertBulk) SetRoleID(v int) *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.SetRoleID(v)
+	})
+}
+
+// UpdateRoleID sets the "role_id" field to the value that was provided on create.
+func (u *UserRolesUpsertBulk) UpdateRoleID() *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.UpdateRoleID()
+	})
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (u *UserRolesUpsertBulk) SetAssignedBy(v uuid.UUID) *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.SetAssignedBy(v)
+	})
+}
+
+// UpdateAssignedBy sets the "assigned_by" field to the value that was provided on create.
+func (u *UserRolesUpsertBulk) UpdateAssignedBy() *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.UpdateAssignedBy()
+	})
+}
+
+// ClearAssignedBy clears the value of the "assigned_by" field.
+func (u *UserRolesUpsertBulk) ClearAssignedBy() *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.ClearAssignedBy()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UserRolesUpsertBulk) SetExpiresAt(v time.Time) *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UserRolesUpsertBulk) UpdateExpiresAt() *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *UserRolesUpsertBulk) ClearExpiresAt() *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *UserRolesUpsertBulk) SetReason(v string) *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *UserRolesUpsertBulk) UpdateReason() *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *UserRolesUpsertBulk) ClearReason() *UserRolesUpsertBulk {
+	return u.Update(func(s *UserRolesUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *UserRolesUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserRolesCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserRolesCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserRolesUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
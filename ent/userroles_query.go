@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,6 +27,7 @@ type UserRolesQuery struct {
 	predicates []predicate.UserRoles
 	withUser   *UsersQuery
 	withRole   *RolesQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -420,6 +422,9 @@ func (urq *UserRolesQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*U
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(urq.modifiers) > 0 {
+		_spec.Modifiers = urq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -505,6 +510,9 @@ func (urq *UserRolesQuery) loadRole(ctx context.Context, query *RolesQuery, node
 
 func (urq *UserRolesQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := urq.querySpec()
+	if len(urq.modifiers) > 0 {
+		_spec.Modifiers = urq.modifiers
+	}
 	_spec.Node.Columns = urq.ctx.Fields
 	if len(urq.ctx.Fields) > 0 {
 		_spec.Unique = urq.ctx.Unique != nil && *urq.ctx.Unique
@@ -573,6 +581,9 @@ func (urq *UserRolesQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if urq.ctx.Unique != nil && *urq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range urq.modifiers {
+		m(selector)
+	}
 	for _, p := range urq.predicates {
 		p(selector)
 	}
@@ -590,6 +601,32 @@ func (urq *UserRolesQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (urq *UserRolesQuery) ForUpdate(opts ...sql.LockOption) *UserRolesQuery {
+	if urq.driver.Dialect() == dialect.Postgres {
+		urq.Unique(false)
+	}
+	urq.modifiers = append(urq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return urq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (urq *UserRolesQuery) ForShare(opts ...sql.LockOption) *UserRolesQuery {
+	if urq.driver.Dialect() == dialect.Postgres {
+		urq.Unique(false)
+	}
+	urq.modifiers = append(urq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return urq
+}
+
 // UserRolesGroupBy is the group-by builder for UserRoles entities.
 type UserRolesGroupBy struct {
 	selector
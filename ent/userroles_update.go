@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -78,6 +79,46 @@ func (uru *UserRolesUpdate) ClearAssignedBy() *UserRolesUpdate {
 	return uru
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (uru *UserRolesUpdate) SetExpiresAt(t time.Time) *UserRolesUpdate {
+	uru.mutation.SetExpiresAt(t)
+	return uru
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (uru *UserRolesUpdate) SetNillableExpiresAt(t *time.Time) *UserRolesUpdate {
+	if t != nil {
+		uru.SetExpiresAt(*t)
+	}
+	return uru
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (uru *UserRolesUpdate) ClearExpiresAt() *UserRolesUpdate {
+	uru.mutation.ClearExpiresAt()
+	return uru
+}
+
+// SetReason sets the "reason" field.
+func (uru *UserRolesUpdate) SetReason(s string) *UserRolesUpdate {
+	uru.mutation.SetReason(s)
+	return uru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (uru *UserRolesUpdate) SetNillableReason(s *string) *UserRolesUpdate {
+	if s != nil {
+		uru.SetReason(*s)
+	}
+	return uru
+}
+
+// ClearReason clears the value of the "reason" field.
+func (uru *UserRolesUpdate) ClearReason() *UserRolesUpdate {
+	uru.mutation.ClearReason()
+	return uru
+}
+
 // SetUser sets the "user" edge to the Users entity.
 func (uru *UserRolesUpdate) SetUser(u *Users) *UserRolesUpdate {
 	return uru.SetUserID(u.ID)
@@ -161,6 +202,18 @@ func (uru *UserRolesUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uru.mutation.AssignedByCleared() {
 		_spec.ClearField(userroles.FieldAssignedBy, field.TypeUUID)
 	}
+	if value, ok := uru.mutation.ExpiresAt(); ok {
+		_spec.SetField(userroles.FieldExpiresAt, field.TypeTime, value)
+	}
+	if uru.mutation.ExpiresAtCleared() {
+		_spec.ClearField(userroles.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := uru.mutation.Reason(); ok {
+		_spec.SetField(userroles.FieldReason, field.TypeString, value)
+	}
+	if uru.mutation.ReasonCleared() {
+		_spec.ClearField(userroles.FieldReason, field.TypeString)
+	}
 	if uru.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -287,6 +340,46 @@ func (uruo *UserRolesUpdateOne) ClearAssignedBy() *UserRolesUpdateOne {
 	return uruo
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (uruo *UserRolesUpdateOne) SetExpiresAt(t time.Time) *UserRolesUpdateOne {
+	uruo.mutation.SetExpiresAt(t)
+	return uruo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (uruo *UserRolesUpdateOne) SetNillableExpiresAt(t *time.Time) *UserRolesUpdateOne {
+	if t != nil {
+		uruo.SetExpiresAt(*t)
+	}
+	return uruo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (uruo *UserRolesUpdateOne) ClearExpiresAt() *UserRolesUpdateOne {
+	uruo.mutation.ClearExpiresAt()
+	return uruo
+}
+
+// SetReason sets the "reason" field.
+func (uruo *UserRolesUpdateOne) SetReason(s string) *UserRolesUpdateOne {
+	uruo.mutation.SetReason(s)
+	return uruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (uruo *UserRolesUpdateOne) SetNillableReason(s *string) *UserRolesUpdateOne {
+	if s != nil {
+		uruo.SetReason(*s)
+	}
+	return uruo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (uruo *UserRolesUpdateOne) ClearReason() *UserRolesUpdateOne {
+	uruo.mutation.ClearReason()
+	return uruo
+}
+
 // SetUser sets the "user" edge to the Users entity.
 func (uruo *UserRolesUpdateOne) SetUser(u *Users) *UserRolesUpdateOne {
 	return uruo.SetUserID(u.ID)
@@ -400,6 +493,18 @@ func (uruo *UserRolesUpdateOne) sqlSave(ctx context.Context) (_node *UserRoles,
 	if uruo.mutation.AssignedByCleared() {
 		_spec.ClearField(userroles.FieldAssignedBy, field.TypeUUID)
 	}
+	if value, ok := uruo.mutation.ExpiresAt(); ok {
+		_spec.SetField(userroles.FieldExpiresAt, field.TypeTime, value)
+	}
+	if uruo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(userroles.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := uruo.mutation.Reason(); ok {
+		_spec.SetField(userroles.FieldReason, field.TypeString, value)
+	}
+	if uruo.mutation.ReasonCleared() {
+		_spec.ClearField(userroles.FieldReason, field.TypeString)
+	}
 	if uruo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
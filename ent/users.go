@@ -45,6 +45,18 @@ type Users struct {
 	PasswordResetToken *string `json:"password_reset_token,omitempty"`
 	// PasswordResetTokenExpiry holds the value of the "password_reset_token_expiry" field.
 	PasswordResetTokenExpiry *time.Time `json:"password_reset_token_expiry,omitempty"`
+	// Set by an admin to block signin until the password is reset
+	PasswordResetRequired bool `json:"password_reset_required,omitempty"`
+	// Admin review state of self-service signups when SIGNUP_MODE=approval
+	ApprovalStatus users.ApprovalStatus `json:"approval_status,omitempty"`
+	// Admin who approved or rejected the signup
+	ReviewedBy *uuid.UUID `json:"reviewed_by,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// DeletionRequestedAt holds the value of the "deletion_requested_at" field.
+	DeletionRequestedAt *time.Time `json:"deletion_requested_at,omitempty"`
+	// When the account is deleted, unless the user cancels first
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -57,9 +69,15 @@ type Users struct {
 type UsersEdges struct {
 	// UserRoles holds the value of the user_roles edge.
 	UserRoles []*UserRoles `json:"user_roles,omitempty"`
+	// GroupMemberships holds the value of the group_memberships edge.
+	GroupMemberships []*GroupMembers `json:"group_memberships,omitempty"`
+	// OrgMemberships holds the value of the org_memberships edge.
+	OrgMemberships []*OrgMembers `json:"org_memberships,omitempty"`
+	// OrgRoles holds the value of the org_roles edge.
+	OrgRoles []*OrgRoles `json:"org_roles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // UserRolesOrErr returns the UserRoles value or an error if the edge
@@ -71,18 +89,47 @@ func (e UsersEdges) UserRolesOrErr() ([]*UserRoles, error) {
 	return nil, &NotLoadedError{edge: "user_roles"}
 }
 
+// GroupMembershipsOrErr returns the GroupMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UsersEdges) GroupMembershipsOrErr() ([]*GroupMembers, error) {
+	if e.loadedTypes[1] {
+		return e.GroupMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "group_memberships"}
+}
+
+// OrgMembershipsOrErr returns the OrgMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UsersEdges) OrgMembershipsOrErr() ([]*OrgMembers, error) {
+	if e.loadedTypes[2] {
+		return e.OrgMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "org_memberships"}
+}
+
+// OrgRolesOrErr returns the OrgRoles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UsersEdges) OrgRolesOrErr() ([]*OrgRoles, error) {
+	if e.loadedTypes[3] {
+		return e.OrgRoles, nil
+	}
+	return nil, &NotLoadedError{edge: "org_roles"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Users) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case users.FieldReviewedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case users.FieldMetadata:
 			values[i] = new([]byte)
-		case users.FieldIsActive, users.FieldEmailVerified:
+		case users.FieldIsActive, users.FieldEmailVerified, users.FieldPasswordResetRequired:
 			values[i] = new(sql.NullBool)
-		case users.FieldEmail, users.FieldPasswordHash, users.FieldFirstName, users.FieldLastName, users.FieldVerificationToken, users.FieldPasswordResetToken:
+		case users.FieldEmail, users.FieldPasswordHash, users.FieldFirstName, users.FieldLastName, users.FieldVerificationToken, users.FieldPasswordResetToken, users.FieldApprovalStatus:
 			values[i] = new(sql.NullString)
-		case users.FieldCreatedAt, users.FieldUpdatedAt, users.FieldLastLogin, users.FieldVerificationTokenExpiry, users.FieldPasswordResetTokenExpiry:
+		case users.FieldCreatedAt, users.FieldUpdatedAt, users.FieldLastLogin, users.FieldVerificationTokenExpiry, users.FieldPasswordResetTokenExpiry, users.FieldReviewedAt, users.FieldDeletionRequestedAt, users.FieldDeletionScheduledAt:
 			values[i] = new(sql.NullTime)
 		case users.FieldID:
 			values[i] = new(uuid.UUID)
@@ -189,6 +236,46 @@ func (u *Users) assignValues(columns []string, values []any) error {
 				u.PasswordResetTokenExpiry = new(time.Time)
 				*u.PasswordResetTokenExpiry = value.Time
 			}
+		case users.FieldPasswordResetRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field password_reset_required", values[i])
+			} else if value.Valid {
+				u.PasswordResetRequired = value.Bool
+			}
+		case users.FieldApprovalStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_status", values[i])
+			} else if value.Valid {
+				u.ApprovalStatus = users.ApprovalStatus(value.String)
+			}
+		case users.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				u.ReviewedBy = new(uuid.UUID)
+				*u.ReviewedBy = *value.S.(*uuid.UUID)
+			}
+		case users.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				u.ReviewedAt = new(time.Time)
+				*u.ReviewedAt = value.Time
+			}
+		case users.FieldDeletionRequestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_requested_at", values[i])
+			} else if value.Valid {
+				u.DeletionRequestedAt = new(time.Time)
+				*u.DeletionRequestedAt = value.Time
+			}
+		case users.FieldDeletionScheduledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_scheduled_at", values[i])
+			} else if value.Valid {
+				u.DeletionScheduledAt = new(time.Time)
+				*u.DeletionScheduledAt = value.Time
+			}
 		case users.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -215,6 +302,21 @@ func (u *Users) QueryUserRoles() *UserRolesQuery {
 	return NewUsersClient(u.config).QueryUserRoles(u)
 }
 
+// QueryGroupMemberships queries the "group_memberships" edge of the Users entity.
+func (u *Users) QueryGroupMemberships() *GroupMembersQuery {
+	return NewUsersClient(u.config).QueryGroupMemberships(u)
+}
+
+// QueryOrgMemberships queries the "org_memberships" edge of the Users entity.
+func (u *Users) QueryOrgMemberships() *OrgMembersQuery {
+	return NewUsersClient(u.config).QueryOrgMemberships(u)
+}
+
+// QueryOrgRoles queries the "org_roles" edge of the Users entity.
+func (u *Users) QueryOrgRoles() *OrgRolesQuery {
+	return NewUsersClient(u.config).QueryOrgRoles(u)
+}
+
 // Update returns a builder for updating this Users.
 // Note that you need to call Users.Unwrap() before calling this method if this Users
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -285,6 +387,32 @@ func (u *Users) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("password_reset_required=")
+	builder.WriteString(fmt.Sprintf("%v", u.PasswordResetRequired))
+	builder.WriteString(", ")
+	builder.WriteString("approval_status=")
+	builder.WriteString(fmt.Sprintf("%v", u.ApprovalStatus))
+	builder.WriteString(", ")
+	if v := u.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := u.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.DeletionRequestedAt; v != nil {
+		builder.WriteString("deletion_requested_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.DeletionScheduledAt; v != nil {
+		builder.WriteString("deletion_scheduled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", u.Metadata))
 	builder.WriteByte(')')
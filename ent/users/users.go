@@ -3,6 +3,7 @@
 package users
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -41,10 +42,28 @@ const (
 	FieldPasswordResetToken = "password_reset_token"
 	// FieldPasswordResetTokenExpiry holds the string denoting the password_reset_token_expiry field in the database.
 	FieldPasswordResetTokenExpiry = "password_reset_token_expiry"
+	// FieldPasswordResetRequired holds the string denoting the password_reset_required field in the database.
+	FieldPasswordResetRequired = "password_reset_required"
+	// FieldApprovalStatus holds the string denoting the approval_status field in the database.
+	FieldApprovalStatus = "approval_status"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldDeletionRequestedAt holds the string denoting the deletion_requested_at field in the database.
+	FieldDeletionRequestedAt = "deletion_requested_at"
+	// FieldDeletionScheduledAt holds the string denoting the deletion_scheduled_at field in the database.
+	FieldDeletionScheduledAt = "deletion_scheduled_at"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// EdgeUserRoles holds the string denoting the user_roles edge name in mutations.
 	EdgeUserRoles = "user_roles"
+	// EdgeGroupMemberships holds the string denoting the group_memberships edge name in mutations.
+	EdgeGroupMemberships = "group_memberships"
+	// EdgeOrgMemberships holds the string denoting the org_memberships edge name in mutations.
+	EdgeOrgMemberships = "org_memberships"
+	// EdgeOrgRoles holds the string denoting the org_roles edge name in mutations.
+	EdgeOrgRoles = "org_roles"
 	// Table holds the table name of the users in the database.
 	Table = "users"
 	// UserRolesTable is the table that holds the user_roles relation/edge.
@@ -54,6 +73,27 @@ const (
 	UserRolesInverseTable = "user_roles"
 	// UserRolesColumn is the table column denoting the user_roles relation/edge.
 	UserRolesColumn = "user_id"
+	// GroupMembershipsTable is the table that holds the group_memberships relation/edge.
+	GroupMembershipsTable = "group_members"
+	// GroupMembershipsInverseTable is the table name for the GroupMembers entity.
+	// It exists in this package in order to avoid circular dependency with the "groupmembers" package.
+	GroupMembershipsInverseTable = "group_members"
+	// GroupMembershipsColumn is the table column denoting the group_memberships relation/edge.
+	GroupMembershipsColumn = "user_id"
+	// OrgMembershipsTable is the table that holds the org_memberships relation/edge.
+	OrgMembershipsTable = "org_members"
+	// OrgMembershipsInverseTable is the table name for the OrgMembers entity.
+	// It exists in this package in order to avoid circular dependency with the "orgmembers" package.
+	OrgMembershipsInverseTable = "org_members"
+	// OrgMembershipsColumn is the table column denoting the org_memberships relation/edge.
+	OrgMembershipsColumn = "user_id"
+	// OrgRolesTable is the table that holds the org_roles relation/edge.
+	OrgRolesTable = "org_roles"
+	// OrgRolesInverseTable is the table name for the OrgRoles entity.
+	// It exists in this package in order to avoid circular dependency with the "orgroles" package.
+	OrgRolesInverseTable = "org_roles"
+	// OrgRolesColumn is the table column denoting the org_roles relation/edge.
+	OrgRolesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for users fields.
@@ -72,6 +112,12 @@ var Columns = []string{
 	FieldVerificationTokenExpiry,
 	FieldPasswordResetToken,
 	FieldPasswordResetTokenExpiry,
+	FieldPasswordResetRequired,
+	FieldApprovalStatus,
+	FieldReviewedBy,
+	FieldReviewedAt,
+	FieldDeletionRequestedAt,
+	FieldDeletionScheduledAt,
 	FieldMetadata,
 }
 
@@ -104,10 +150,39 @@ var (
 	DefaultIsActive bool
 	// DefaultEmailVerified holds the default value on creation for the "email_verified" field.
 	DefaultEmailVerified bool
+	// DefaultPasswordResetRequired holds the default value on creation for the "password_reset_required" field.
+	DefaultPasswordResetRequired bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// ApprovalStatus defines the type for the "approval_status" enum field.
+type ApprovalStatus string
+
+// ApprovalStatusApproved is the default value of the ApprovalStatus enum.
+const DefaultApprovalStatus = ApprovalStatusApproved
+
+// ApprovalStatus values.
+const (
+	ApprovalStatusApproved ApprovalStatus = "approved"
+	ApprovalStatusPending  ApprovalStatus = "pending"
+	ApprovalStatusRejected ApprovalStatus = "rejected"
+)
+
+func (as ApprovalStatus) String() string {
+	return string(as)
+}
+
+// ApprovalStatusValidator is a validator for the "approval_status" field enum values. It is called by the builders before save.
+func ApprovalStatusValidator(as ApprovalStatus) error {
+	switch as {
+	case ApprovalStatusApproved, ApprovalStatusPending, ApprovalStatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("users: invalid enum value for approval_status field: %q", as)
+	}
+}
+
 // OrderOption defines the ordering options for the Users queries.
 type OrderOption func(*sql.Selector)
 
@@ -181,6 +256,36 @@ func ByPasswordResetTokenExpiry(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPasswordResetTokenExpiry, opts...).ToFunc()
 }
 
+// ByPasswordResetRequired orders the results by the password_reset_required field.
+func ByPasswordResetRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordResetRequired, opts...).ToFunc()
+}
+
+// ByApprovalStatus orders the results by the approval_status field.
+func ByApprovalStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovalStatus, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByDeletionRequestedAt orders the results by the deletion_requested_at field.
+func ByDeletionRequestedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionRequestedAt, opts...).ToFunc()
+}
+
+// ByDeletionScheduledAt orders the results by the deletion_scheduled_at field.
+func ByDeletionScheduledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionScheduledAt, opts...).ToFunc()
+}
+
 // ByUserRolesCount orders the results by user_roles count.
 func ByUserRolesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -194,6 +299,48 @@ func ByUserRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByGroupMembershipsCount orders the results by group_memberships count.
+func ByGroupMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupMembershipsStep(), opts...)
+	}
+}
+
+// ByGroupMemberships orders the results by group_memberships terms.
+func ByGroupMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOrgMembershipsCount orders the results by org_memberships count.
+func ByOrgMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrgMembershipsStep(), opts...)
+	}
+}
+
+// ByOrgMemberships orders the results by org_memberships terms.
+func ByOrgMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrgMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOrgRolesCount orders the results by org_roles count.
+func ByOrgRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrgRolesStep(), opts...)
+	}
+}
+
+// ByOrgRoles orders the results by org_roles terms.
+func ByOrgRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrgRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserRolesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -201,3 +348,24 @@ func newUserRolesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, UserRolesTable, UserRolesColumn),
 	)
 }
+func newGroupMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupMembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, GroupMembershipsTable, GroupMembershipsColumn),
+	)
+}
+func newOrgMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrgMembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, OrgMembershipsTable, OrgMembershipsColumn),
+	)
+}
+func newOrgRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrgRolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, OrgRolesTable, OrgRolesColumn),
+	)
+}
@@ -8,9 +8,14 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/orgroles"
 	"github.com/shammianand/go-auth/ent/userroles"
 	"github.com/shammianand/go-auth/ent/users"
 )
@@ -20,6 +25,7 @@ type UsersCreate struct {
 	config
 	mutation *UsersMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetEmail sets the "email" field.
@@ -172,6 +178,90 @@ func (uc *UsersCreate) SetNillablePasswordResetTokenExpiry(t *time.Time) *UsersC
 	return uc
 }
 
+// SetPasswordResetRequired sets the "password_reset_required" field.
+func (uc *UsersCreate) SetPasswordResetRequired(b bool) *UsersCreate {
+	uc.mutation.SetPasswordResetRequired(b)
+	return uc
+}
+
+// SetNillablePasswordResetRequired sets the "password_reset_required" field if the given value is not nil.
+func (uc *UsersCreate) SetNillablePasswordResetRequired(b *bool) *UsersCreate {
+	if b != nil {
+		uc.SetPasswordResetRequired(*b)
+	}
+	return uc
+}
+
+// SetApprovalStatus sets the "approval_status" field.
+func (uc *UsersCreate) SetApprovalStatus(us users.ApprovalStatus) *UsersCreate {
+	uc.mutation.SetApprovalStatus(us)
+	return uc
+}
+
+// SetNillableApprovalStatus sets the "approval_status" field if the given value is not nil.
+func (uc *UsersCreate) SetNillableApprovalStatus(us *users.ApprovalStatus) *UsersCreate {
+	if us != nil {
+		uc.SetApprovalStatus(*us)
+	}
+	return uc
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (uc *UsersCreate) SetReviewedBy(u uuid.UUID) *UsersCreate {
+	uc.mutation.SetReviewedBy(u)
+	return uc
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (uc *UsersCreate) SetNillableReviewedBy(u *uuid.UUID) *UsersCreate {
+	if u != nil {
+		uc.SetReviewedBy(*u)
+	}
+	return uc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (uc *UsersCreate) SetReviewedAt(t time.Time) *UsersCreate {
+	uc.mutation.SetReviewedAt(t)
+	return uc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (uc *UsersCreate) SetNillableReviewedAt(t *time.Time) *UsersCreate {
+	if t != nil {
+		uc.SetReviewedAt(*t)
+	}
+	return uc
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (uc *UsersCreate) SetDeletionRequestedAt(t time.Time) *UsersCreate {
+	uc.mutation.SetDeletionRequestedAt(t)
+	return uc
+}
+
+// SetNillableDeletionRequestedAt sets the "deletion_requested_at" field if the given value is not nil.
+func (uc *UsersCreate) SetNillableDeletionRequestedAt(t *time.Time) *UsersCreate {
+	if t != nil {
+		uc.SetDeletionRequestedAt(*t)
+	}
+	return uc
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uc *UsersCreate) SetDeletionScheduledAt(t time.Time) *UsersCreate {
+	uc.mutation.SetDeletionScheduledAt(t)
+	return uc
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uc *UsersCreate) SetNillableDeletionScheduledAt(t *time.Time) *UsersCreate {
+	if t != nil {
+		uc.SetDeletionScheduledAt(*t)
+	}
+	return uc
+}
+
 // SetMetadata sets the "metadata" field.
 func (uc *UsersCreate) SetMetadata(m map[string]interface{}) *UsersCreate {
 	uc.mutation.SetMetadata(m)
@@ -207,6 +297,51 @@ func (uc *UsersCreate) AddUserRoles(u ...*UserRoles) *UsersCreate {
 	return uc.AddUserRoleIDs(ids...)
 }
 
+// AddGroupMembershipIDs adds the "group_memberships" edge to the GroupMembers entity by IDs.
+func (uc *UsersCreate) AddGroupMembershipIDs(ids ...uuid.UUID) *UsersCreate {
+	uc.mutation.AddGroupMembershipIDs(ids...)
+	return uc
+}
+
+// AddGroupMemberships adds the "group_memberships" edges to the GroupMembers entity.
+func (uc *UsersCreate) AddGroupMemberships(g ...*GroupMembers) *UsersCreate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uc.AddGroupMembershipIDs(ids...)
+}
+
+// AddOrgMembershipIDs adds the "org_memberships" edge to the OrgMembers entity by IDs.
+func (uc *UsersCreate) AddOrgMembershipIDs(ids ...uuid.UUID) *UsersCreate {
+	uc.mutation.AddOrgMembershipIDs(ids...)
+	return uc
+}
+
+// AddOrgMemberships adds the "org_memberships" edges to the OrgMembers entity.
+func (uc *UsersCreate) AddOrgMemberships(o ...*OrgMembers) *UsersCreate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uc.AddOrgMembershipIDs(ids...)
+}
+
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by IDs.
+func (uc *UsersCreate) AddOrgRoleIDs(ids ...int) *UsersCreate {
+	uc.mutation.AddOrgRoleIDs(ids...)
+	return uc
+}
+
+// AddOrgRoles adds the "org_roles" edges to the OrgRoles entity.
+func (uc *UsersCreate) AddOrgRoles(o ...*OrgRoles) *UsersCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uc.AddOrgRoleIDs(ids...)
+}
+
 // Mutation returns the UsersMutation object of the builder.
 func (uc *UsersCreate) Mutation() *UsersMutation {
 	return uc.mutation
@@ -258,6 +393,14 @@ func (uc *UsersCreate) defaults() {
 		v := users.DefaultEmailVerified
 		uc.mutation.SetEmailVerified(v)
 	}
+	if _, ok := uc.mutation.PasswordResetRequired(); !ok {
+		v := users.DefaultPasswordResetRequired
+		uc.mutation.SetPasswordResetRequired(v)
+	}
+	if _, ok := uc.mutation.ApprovalStatus(); !ok {
+		v := users.DefaultApprovalStatus
+		uc.mutation.SetApprovalStatus(v)
+	}
 	if _, ok := uc.mutation.ID(); !ok {
 		v := users.DefaultID()
 		uc.mutation.SetID(v)
@@ -310,6 +453,17 @@ func (uc *UsersCreate) check() error {
 	if _, ok := uc.mutation.EmailVerified(); !ok {
 		return &ValidationError{Name: "email_verified", err: errors.New(`ent: missing required field "Users.email_verified"`)}
 	}
+	if _, ok := uc.mutation.PasswordResetRequired(); !ok {
+		return &ValidationError{Name: "password_reset_required", err: errors.New(`ent: missing required field "Users.password_reset_required"`)}
+	}
+	if _, ok := uc.mutation.ApprovalStatus(); !ok {
+		return &ValidationError{Name: "approval_status", err: errors.New(`ent: missing required field "Users.approval_status"`)}
+	}
+	if v, ok := uc.mutation.ApprovalStatus(); ok {
+		if err := users.ApprovalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "approval_status", err: fmt.Errorf(`ent: validator failed for field "Users.approval_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -341,6 +495,7 @@ func (uc *UsersCreate) createSpec() (*Users, *sqlgraph.CreateSpec) {
 		_node = &Users{config: uc.config}
 		_spec = sqlgraph.NewCreateSpec(users.Table, sqlgraph.NewFieldSpec(users.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = uc.conflict
 	if id, ok := uc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -397,6 +552,30 @@ func (uc *UsersCreate) createSpec() (*Users, *sqlgraph.CreateSpec) {
 		_spec.SetField(users.FieldPasswordResetTokenExpiry, field.TypeTime, value)
 		_node.PasswordResetTokenExpiry = &value
 	}
+	if value, ok := uc.mutation.PasswordResetRequired(); ok {
+		_spec.SetField(users.FieldPasswordResetRequired, field.TypeBool, value)
+		_node.PasswordResetRequired = value
+	}
+	if value, ok := uc.mutation.ApprovalStatus(); ok {
+		_spec.SetField(users.FieldApprovalStatus, field.TypeEnum, value)
+		_node.ApprovalStatus = value
+	}
+	if value, ok := uc.mutation.ReviewedBy(); ok {
+		_spec.SetField(users.FieldReviewedBy, field.TypeUUID, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := uc.mutation.ReviewedAt(); ok {
+		_spec.SetField(users.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := uc.mutation.DeletionRequestedAt(); ok {
+		_spec.SetField(users.FieldDeletionRequestedAt, field.TypeTime, value)
+		_node.DeletionRequestedAt = &value
+	}
+	if value, ok := uc.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(users.FieldDeletionScheduledAt, field.TypeTime, value)
+		_node.DeletionScheduledAt = &value
+	}
 	if value, ok := uc.mutation.Metadata(); ok {
 		_spec.SetField(users.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
@@ -417,89 +596,1388 @@ func (uc *UsersCreate) createSpec() (*Users, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.GroupMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.GroupMembershipsTable,
+			Columns: []string{users.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.OrgMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgMembershipsTable,
+			Columns: []string{users.OrgMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.OrgRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgRolesTable,
+			Columns: []string{users.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
-// UsersCreateBulk is the builder for creating many Users entities in bulk.
-type UsersCreateBulk struct {
-	config
-	err      error
-	builders []*UsersCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Users.Create().
+//		SetEmail(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsersUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (uc *UsersCreate) OnConflict(opts ...sql.ConflictOption) *UsersUpsertOne {
+	uc.conflict = opts
+	return &UsersUpsertOne{
+		create: uc,
+	}
 }
 
-// Save creates the Users entities in the database.
-func (ucb *UsersCreateBulk) Save(ctx context.Context) ([]*Users, error) {
-	if ucb.err != nil {
-		return nil, ucb.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Users.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uc *UsersCreate) OnConflictColumns(columns ...string) *UsersUpsertOne {
+	uc.conflict = append(uc.conflict, sql.ConflictColumns(columns...))
+	return &UsersUpsertOne{
+		create: uc,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
-	nodes := make([]*Users, len(ucb.builders))
-	mutators := make([]Mutator, len(ucb.builders))
-	for i := range ucb.builders {
-		func(i int, root context.Context) {
-			builder := ucb.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*UsersMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// UsersUpsertOne is the builder for "upsert"-ing
+	//  one Users node.
+	UsersUpsertOne struct {
+		create *UsersCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, ucb.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// UsersUpsert is the "OnConflict" setter.
+	UsersUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetEmail sets the "email" field.
+func (u *UsersUpsert) SetEmail(v string) *UsersUpsert {
+	u.Set(users.FieldEmail, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (ucb *UsersCreateBulk) SaveX(ctx context.Context) []*Users {
-	v, err := ucb.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateEmail() *UsersUpsert {
+	u.SetExcluded(users.FieldEmail)
+	return u
 }
 
-// Exec executes the query.
-func (ucb *UsersCreateBulk) Exec(ctx context.Context) error {
-	_, err := ucb.Save(ctx)
-	return err
+// SetPasswordHash sets the "password_hash" field.
+func (u *UsersUpsert) SetPasswordHash(v string) *UsersUpsert {
+	u.Set(users.FieldPasswordHash, v)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (ucb *UsersCreateBulk) ExecX(ctx context.Context) {
-	if err := ucb.Exec(ctx); err != nil {
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *UsersUpsert) UpdatePasswordHash() *UsersUpsert {
+	u.SetExcluded(users.FieldPasswordHash)
+	return u
+}
+
+// SetFirstName sets the "first_name" field.
+func (u *UsersUpsert) SetFirstName(v string) *UsersUpsert {
+	u.Set(users.FieldFirstName, v)
+	return u
+}
+
+// UpdateFirstName sets the "first_name" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateFirstName() *UsersUpsert {
+	u.SetExcluded(users.FieldFirstName)
+	return u
+}
+
+// SetLastName sets the "last_name" field.
+func (u *UsersUpsert) SetLastName(v string) *UsersUpsert {
+	u.Set(users.FieldLastName, v)
+	return u
+}
+
+// UpdateLastName sets the "last_name" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateLastName() *UsersUpsert {
+	u.SetExcluded(users.FieldLastName)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UsersUpsert) SetCreatedAt(v time.Time) *UsersUpsert {
+	u.Set(users.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateCreatedAt() *UsersUpsert {
+	u.SetExcluded(users.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UsersUpsert) SetUpdatedAt(v time.Time) *UsersUpsert {
+	u.Set(users.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateUpdatedAt() *UsersUpsert {
+	u.SetExcluded(users.FieldUpdatedAt)
+	return u
+}
+
+// SetLastLogin sets the "last_login" field.
+func (u *UsersUpsert) SetLastLogin(v time.Time) *UsersUpsert {
+	u.Set(users.FieldLastLogin, v)
+	return u
+}
+
+// UpdateLastLogin sets the "last_login" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateLastLogin() *UsersUpsert {
+	u.SetExcluded(users.FieldLastLogin)
+	return u
+}
+
+// ClearLastLogin clears the value of the "last_login" field.
+func (u *UsersUpsert) ClearLastLogin() *UsersUpsert {
+	u.SetNull(users.FieldLastLogin)
+	return u
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *UsersUpsert) SetIsActive(v bool) *UsersUpsert {
+	u.Set(users.FieldIsActive, v)
+	return u
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateIsActive() *UsersUpsert {
+	u.SetExcluded(users.FieldIsActive)
+	return u
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (u *UsersUpsert) SetEmailVerified(v bool) *UsersUpsert {
+	u.Set(users.FieldEmailVerified, v)
+	return u
+}
+
+// UpdateEmailVerified sets the "email_verified" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateEmailVerified() *UsersUpsert {
+	u.SetExcluded(users.FieldEmailVerified)
+	return u
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (u *UsersUpsert) SetVerificationToken(v string) *UsersUpsert {
+	u.Set(users.FieldVerificationToken, v)
+	return u
+}
+
+// UpdateVerificationToken sets the "verification_token" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateVerificationToken() *UsersUpsert {
+	u.SetExcluded(users.FieldVerificationToken)
+	return u
+}
+
+// ClearVerificationToken clears the value of the "verification_token" field.
+func (u *UsersUpsert) ClearVerificationToken() *UsersUpsert {
+	u.SetNull(users.FieldVerificationToken)
+	return u
+}
+
+// SetVerificationTokenExpiry sets the "verification_token_expiry" field.
+func (u *UsersUpsert) SetVerificationTokenExpiry(v time.Time) *UsersUpsert {
+	u.Set(users.FieldVerificationTokenExpiry, v)
+	return u
+}
+
+// UpdateVerificationTokenExpiry sets the "verification_token_expiry" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateVerificationTokenExpiry() *UsersUpsert {
+	u.SetExcluded(users.FieldVerificationTokenExpiry)
+	return u
+}
+
+// ClearVerificationTokenExpiry clears the value of the "verification_token_expiry" field.
+func (u *UsersUpsert) ClearVerificationTokenExpiry() *UsersUpsert {
+	u.SetNull(users.FieldVerificationTokenExpiry)
+	return u
+}
+
+// SetPasswordResetToken sets the "password_reset_token" field.
+func (u *UsersUpsert) SetPasswordResetToken(v string) *UsersUpsert {
+	u.Set(users.FieldPasswordResetToken, v)
+	return u
+}
+
+// UpdatePasswordResetToken sets the "password_reset_token" field to the value that was provided on create.
+func (u *UsersUpsert) UpdatePasswordResetToken() *UsersUpsert {
+	u.SetExcluded(users.FieldPasswordResetToken)
+	return u
+}
+
+// ClearPasswordResetToken clears the value of the "password_reset_token" field.
+func (u *UsersUpsert) ClearPasswordResetToken() *UsersUpsert {
+	u.SetNull(users.FieldPasswordResetToken)
+	return u
+}
+
+// SetPasswordResetTokenExpiry sets the "password_reset_token_expiry" field.
+func (u *UsersUpsert) SetPasswordResetTokenExpiry(v time.Time) *UsersUpsert {
+	u.Set(users.FieldPasswordResetTokenExpiry, v)
+	return u
+}
+
+// UpdatePasswordResetTokenExpiry sets the "password_reset_token_expiry" field to the value that was provided on create.
+func (u *UsersUpsert) UpdatePasswordResetTokenExpiry() *UsersUpsert {
+	u.SetExcluded(users.FieldPasswordResetTokenExpiry)
+	return u
+}
+
+// ClearPasswordResetTokenExpiry clears the value of the "password_reset_token_expiry" field.
+func (u *UsersUpsert) ClearPasswordResetTokenExpiry() *UsersUpsert {
+	u.SetNull(users.FieldPasswordResetTokenExpiry)
+	return u
+}
+
+// SetPasswordResetRequired sets the "password_reset_required" field.
+func (u *UsersUpsert) SetPasswordResetRequired(v bool) *UsersUpsert {
+	u.Set(users.FieldPasswordResetRequired, v)
+	return u
+}
+
+// UpdatePasswordResetRequired sets the "password_reset_required" field to the value that was provided on create.
+func (u *UsersUpsert) UpdatePasswordResetRequired() *UsersUpsert {
+	u.SetExcluded(users.FieldPasswordResetRequired)
+	return u
+}
+
+// SetApprovalStatus sets the "approval_status" field.
+func (u *UsersUpsert) SetApprovalStatus(v users.ApprovalStatus) *UsersUpsert {
+	u.Set(users.FieldApprovalStatus, v)
+	return u
+}
+
+// UpdateApprovalStatus sets the "approval_status" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateApprovalStatus() *UsersUpsert {
+	u.SetExcluded(users.FieldApprovalStatus)
+	return u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *UsersUpsert) SetReviewedBy(v uuid.UUID) *UsersUpsert {
+	u.Set(users.FieldReviewedBy, v)
+	return u
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateReviewedBy() *UsersUpsert {
+	u.SetExcluded(users.FieldReviewedBy)
+	return u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *UsersUpsert) ClearReviewedBy() *UsersUpsert {
+	u.SetNull(users.FieldReviewedBy)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *UsersUpsert) SetReviewedAt(v time.Time) *UsersUpsert {
+	u.Set(users.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateReviewedAt() *UsersUpsert {
+	u.SetExcluded(users.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *UsersUpsert) ClearReviewedAt() *UsersUpsert {
+	u.SetNull(users.FieldReviewedAt)
+	return u
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (u *UsersUpsert) SetDeletionRequestedAt(v time.Time) *UsersUpsert {
+	u.Set(users.FieldDeletionRequestedAt, v)
+	return u
+}
+
+// UpdateDeletionRequestedAt sets the "deletion_requested_at" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateDeletionRequestedAt() *UsersUpsert {
+	u.SetExcluded(users.FieldDeletionRequestedAt)
+	return u
+}
+
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (u *UsersUpsert) ClearDeletionRequestedAt() *UsersUpsert {
+	u.SetNull(users.FieldDeletionRequestedAt)
+	return u
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (u *UsersUpsert) SetDeletionScheduledAt(v time.Time) *UsersUpsert {
+	u.Set(users.FieldDeletionScheduledAt, v)
+	return u
+}
+
+// UpdateDeletionScheduledAt sets the "deletion_scheduled_at" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateDeletionScheduledAt() *UsersUpsert {
+	u.SetExcluded(users.FieldDeletionScheduledAt)
+	return u
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (u *UsersUpsert) ClearDeletionScheduledAt() *UsersUpsert {
+	u.SetNull(users.FieldDeletionScheduledAt)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *UsersUpsert) SetMetadata(v map[string]interface{}) *UsersUpsert {
+	u.Set(users.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *UsersUpsert) UpdateMetadata() *UsersUpsert {
+	u.SetExcluded(users.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *UsersUpsert) ClearMetadata() *UsersUpsert {
+	u.SetNull(users.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Users.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(users.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UsersUpsertOne) UpdateNewValues() *UsersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(users.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Users.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UsersUpsertOne) Ignore() *UsersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsersUpsertOne) DoNothing() *UsersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsersCreate.OnConflict
+// documentation for more info.
+func (u *UsersUpsertOne) Update(set func(*UsersUpsert)) *UsersUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsersUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *UsersUpsertOne) SetEmail(v string) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateEmail() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *UsersUpsertOne) SetPasswordHash(v string) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdatePasswordHash() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetFirstName sets the "first_name" field.
+func (u *UsersUpsertOne) SetFirstName(v string) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetFirstName(v)
+	})
+}
+
+// UpdateFirstName sets the "first_name" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateFirstName() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateFirstName()
+	})
+}
+
+// SetLastName sets the "last_name" field.
+func (u *UsersUpsertOne) SetLastName(v string) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetLastName(v)
+	})
+}
+
+// UpdateLastName sets the "last_name" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateLastName() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateLastName()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UsersUpsertOne) SetCreatedAt(v time.Time) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateCreatedAt() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UsersUpsertOne) SetUpdatedAt(v time.Time) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateUpdatedAt() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastLogin sets the "last_login" field.
+func (u *UsersUpsertOne) SetLastLogin(v time.Time) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetLastLogin(v)
+	})
+}
+
+// UpdateLastLogin sets the "last_login" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateLastLogin() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateLastLogin()
+	})
+}
+
+// ClearLastLogin clears the value of the "last_login" field.
+func (u *UsersUpsertOne) ClearLastLogin() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearLastLogin()
+	})
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *UsersUpsertOne) SetIsActive(v bool) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetIsActive(v)
+	})
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateIsActive() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateIsActive()
+	})
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (u *UsersUpsertOne) SetEmailVerified(v bool) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetEmailVerified(v)
+	})
+}
+
+// UpdateEmailVerified sets the "email_verified" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateEmailVerified() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateEmailVerified()
+	})
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (u *UsersUpsertOne) SetVerificationToken(v string) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetVerificationToken(v)
+	})
+}
+
+// UpdateVerificationToken sets the "verification_token" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateVerificationToken() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateVerificationToken()
+	})
+}
+
+// ClearVerificationToken clears the value of the "verification_token" field.
+func (u *UsersUpsertOne) ClearVerificationToken() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearVerificationToken()
+	})
+}
+
+// SetVerificationTokenExpiry sets the "verification_token_expiry" field.
+func (u *UsersUpsertOne) SetVerificationTokenExpiry(v time.Time) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetVerificationTokenExpiry(v)
+	})
+}
+
+// UpdateVerificationTokenExpiry sets the "verification_token_expiry" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateVerificationTokenExpiry() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateVerificationTokenExpiry()
+	})
+}
+
+// ClearVerificationTokenExpiry clears the value of the "verification_token_expiry" field.
+func (u *UsersUpsertOne) ClearVerificationTokenExpiry() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearVerificationTokenExpiry()
+	})
+}
+
+// SetPasswordResetToken sets the "password_reset_token" field.
+func (u *UsersUpsertOne) SetPasswordResetToken(v string) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetPasswordResetToken(v)
+	})
+}
+
+// UpdatePasswordResetToken sets the "password_reset_token" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdatePasswordResetToken() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdatePasswordResetToken()
+	})
+}
+
+// ClearPasswordResetToken clears the value of the "password_reset_token" field.
+func (u *UsersUpsertOne) ClearPasswordResetToken() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearPasswordResetToken()
+	})
+}
+
+// SetPasswordResetTokenExpiry sets the "password_reset_token_expiry" field.
+func (u *UsersUpsertOne) SetPasswordResetTokenExpiry(v time.Time) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetPasswordResetTokenExpiry(v)
+	})
+}
+
+// UpdatePasswordResetTokenExpiry sets the "password_reset_token_expiry" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdatePasswordResetTokenExpiry() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdatePasswordResetTokenExpiry()
+	})
+}
+
+// ClearPasswordResetTokenExpiry clears the value of the "password_reset_token_expiry" field.
+func (u *UsersUpsertOne) ClearPasswordResetTokenExpiry() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearPasswordResetTokenExpiry()
+	})
+}
+
+// SetPasswordResetRequired sets the "password_reset_required" field.
+func (u *UsersUpsertOne) SetPasswordResetRequired(v bool) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetPasswordResetRequired(v)
+	})
+}
+
+// UpdatePasswordResetRequired sets the "password_reset_required" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdatePasswordResetRequired() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdatePasswordResetRequired()
+	})
+}
+
+// SetApprovalStatus sets the "approval_status" field.
+func (u *UsersUpsertOne) SetApprovalStatus(v users.ApprovalStatus) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetApprovalStatus(v)
+	})
+}
+
+// UpdateApprovalStatus sets the "approval_status" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateApprovalStatus() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateApprovalStatus()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *UsersUpsertOne) SetReviewedBy(v uuid.UUID) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateReviewedBy() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *UsersUpsertOne) ClearReviewedBy() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *UsersUpsertOne) SetReviewedAt(v time.Time) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateReviewedAt() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *UsersUpsertOne) ClearReviewedAt() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (u *UsersUpsertOne) SetDeletionRequestedAt(v time.Time) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetDeletionRequestedAt(v)
+	})
+}
+
+// UpdateDeletionRequestedAt sets the "deletion_requested_at" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateDeletionRequestedAt() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateDeletionRequestedAt()
+	})
+}
+
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (u *UsersUpsertOne) ClearDeletionRequestedAt() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearDeletionRequestedAt()
+	})
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (u *UsersUpsertOne) SetDeletionScheduledAt(v time.Time) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetDeletionScheduledAt(v)
+	})
+}
+
+// UpdateDeletionScheduledAt sets the "deletion_scheduled_at" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateDeletionScheduledAt() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateDeletionScheduledAt()
+	})
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (u *UsersUpsertOne) ClearDeletionScheduledAt() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearDeletionScheduledAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *UsersUpsertOne) SetMetadata(v map[string]interface{}) *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *UsersUpsertOne) UpdateMetadata() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *UsersUpsertOne) ClearMetadata() *UsersUpsertOne {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *UsersUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsersCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsersUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UsersUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UsersUpsertOne.ID is not supported by MySQL driver. Use UsersUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UsersUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UsersCreateBulk is the builder for creating many Users entities in bulk.
+type UsersCreateBulk struct {
+	config
+	err      error
+	builders []*UsersCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Users entities in the database.
+func (ucb *UsersCreateBulk) Save(ctx context.Context) ([]*Users, error) {
+	if ucb.err != nil {
+		return nil, ucb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
+	nodes := make([]*Users, len(ucb.builders))
+	mutators := make([]Mutator, len(ucb.builders))
+	for i := range ucb.builders {
+		func(i int, root context.Context) {
+			builder := ucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsersMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ucb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ucb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucb *UsersCreateBulk) SaveX(ctx context.Context) []*Users {
+	v, err := ucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ucb *UsersCreateBulk) Exec(ctx context.Context) error {
+	_, err := ucb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucb *UsersCreateBulk) ExecX(ctx context.Context) {
+	if err := ucb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Users.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsersUpsert) {
+//			SetEmail(v+v).
+//		}).
+//		Exec(ctx)
+func (ucb *UsersCreateBulk) OnConflict(opts ...sql.ConflictOption) *UsersUpsertBulk {
+	ucb.conflict = opts
+	return &UsersUpsertBulk{
+		create: ucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Users.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ucb *UsersCreateBulk) OnConflictColumns(columns ...string) *UsersUpsertBulk {
+	ucb.conflict = append(ucb.conflict, sql.ConflictColumns(columns...))
+	return &UsersUpsertBulk{
+		create: ucb,
+	}
+}
+
+// UsersUpsertBulk is the builder for "upsert"-ing
+// a bulk of Users nodes.
+type UsersUpsertBulk struct {
+	create *UsersCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Users.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(users.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UsersUpsertBulk) UpdateNewValues() *UsersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(users.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Users.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UsersUpsertBulk) Ignore() *UsersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsersUpsertBulk) DoNothing() *UsersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsersCreateBulk.OnConflict
+// documentation for more info.
+func (u *UsersUpsertBulk) Update(set func(*UsersUpsert)) *UsersUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsersUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *UsersUpsertBulk) SetEmail(v string) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateEmail() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *UsersUpsertBulk) SetPasswordHash(v string) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdatePasswordHash() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetFirstName sets the "first_name" field.
+func (u *UsersUpsertBulk) SetFirstName(v string) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetFirstName(v)
+	})
+}
+
+// UpdateFirstName sets the "first_name" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateFirstName() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateFirstName()
+	})
+}
+
+// SetLastName sets the "last_name" field.
+func (u *UsersUpsertBulk) SetLastName(v string) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetLastName(v)
+	})
+}
+
+// UpdateLastName sets the "last_name" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateLastName() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateLastName()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *UsersUpsertBulk) SetCreatedAt(v time.Time) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateCreatedAt() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UsersUpsertBulk) SetUpdatedAt(v time.Time) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateUpdatedAt() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastLogin sets the "last_login" field.
+func (u *UsersUpsertBulk) SetLastLogin(v time.Time) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetLastLogin(v)
+	})
+}
+
+// UpdateLastLogin sets the "last_login" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateLastLogin() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateLastLogin()
+	})
+}
+
+// ClearLastLogin clears the value of the "last_login" field.
+func (u *UsersUpsertBulk) ClearLastLogin() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearLastLogin()
+	})
+}
+
+// SetIsActive sets the "is_active" field.
+func (u *UsersUpsertBulk) SetIsActive(v bool) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetIsActive(v)
+	})
+}
+
+// UpdateIsActive sets the "is_active" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateIsActive() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateIsActive()
+	})
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (u *UsersUpsertBulk) SetEmailVerified(v bool) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetEmailVerified(v)
+	})
+}
+
+// UpdateEmailVerified sets the "email_verified" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateEmailVerified() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateEmailVerified()
+	})
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (u *UsersUpsertBulk) SetVerificationToken(v string) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetVerificationToken(v)
+	})
+}
+
+// UpdateVerificationToken sets the "verification_token" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateVerificationToken() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateVerificationToken()
+	})
+}
+
+// ClearVerificationToken clears the value of the "verification_token" field.
+func (u *UsersUpsertBulk) ClearVerificationToken() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearVerificationToken()
+	})
+}
+
+// SetVerificationTokenExpiry sets the "verification_token_expiry" field.
+func (u *UsersUpsertBulk) SetVerificationTokenExpiry(v time.Time) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetVerificationTokenExpiry(v)
+	})
+}
+
+// UpdateVerificationTokenExpiry sets the "verification_token_expiry" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateVerificationTokenExpiry() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateVerificationTokenExpiry()
+	})
+}
+
+// ClearVerificationTokenExpiry clears the value of the "verification_token_expiry" field.
+func (u *UsersUpsertBulk) ClearVerificationTokenExpiry() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearVerificationTokenExpiry()
+	})
+}
+
+// SetPasswordResetToken sets the "password_reset_token" field.
+func (u *UsersUpsertBulk) SetPasswordResetToken(v string) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetPasswordResetToken(v)
+	})
+}
+
+// UpdatePasswordResetToken sets the "password_reset_token" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdatePasswordResetToken() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdatePasswordResetToken()
+	})
+}
+
+// ClearPasswordResetToken clears the value of the "password_reset_token" field.
+func (u *UsersUpsertBulk) ClearPasswordResetToken() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearPasswordResetToken()
+	})
+}
+
+// SetPasswordResetTokenExpiry sets the "password_reset_token_expiry" field.
+func (u *UsersUpsertBulk) SetPasswordResetTokenExpiry(v time.Time) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetPasswordResetTokenExpiry(v)
+	})
+}
+
+// UpdatePasswordResetTokenExpiry sets the "password_reset_token_expiry" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdatePasswordResetTokenExpiry() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdatePasswordResetTokenExpiry()
+	})
+}
+
+// ClearPasswordResetTokenExpiry clears the value of the "password_reset_token_expiry" field.
+func (u *UsersUpsertBulk) ClearPasswordResetTokenExpiry() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearPasswordResetTokenExpiry()
+	})
+}
+
+// SetPasswordResetRequired sets the "password_reset_required" field.
+func (u *UsersUpsertBulk) SetPasswordResetRequired(v bool) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetPasswordResetRequired(v)
+	})
+}
+
+// UpdatePasswordResetRequired sets the "password_reset_required" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdatePasswordResetRequired() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdatePasswordResetRequired()
+	})
+}
+
+// SetApprovalStatus sets the "approval_status" field.
+func (u *UsersUpsertBulk) SetApprovalStatus(v users.ApprovalStatus) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetApprovalStatus(v)
+	})
+}
+
+// UpdateApprovalStatus sets the "approval_status" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateApprovalStatus() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateApprovalStatus()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *UsersUpsertBulk) SetReviewedBy(v uuid.UUID) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateReviewedBy() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *UsersUpsertBulk) ClearReviewedBy() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *UsersUpsertBulk) SetReviewedAt(v time.Time) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateReviewedAt() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *UsersUpsertBulk) ClearReviewedAt() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (u *UsersUpsertBulk) SetDeletionRequestedAt(v time.Time) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetDeletionRequestedAt(v)
+	})
+}
+
+// UpdateDeletionRequestedAt sets the "deletion_requested_at" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateDeletionRequestedAt() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateDeletionRequestedAt()
+	})
+}
+
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (u *UsersUpsertBulk) ClearDeletionRequestedAt() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearDeletionRequestedAt()
+	})
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (u *UsersUpsertBulk) SetDeletionScheduledAt(v time.Time) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetDeletionScheduledAt(v)
+	})
+}
+
+// UpdateDeletionScheduledAt sets the "deletion_scheduled_at" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateDeletionScheduledAt() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateDeletionScheduledAt()
+	})
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (u *UsersUpsertBulk) ClearDeletionScheduledAt() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearDeletionScheduledAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *UsersUpsertBulk) SetMetadata(v map[string]interface{}) *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *UsersUpsertBulk) UpdateMetadata() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *UsersUpsertBulk) ClearMetadata() *UsersUpsertBulk {
+	return u.Update(func(s *UsersUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *UsersUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UsersCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsersCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsersUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -8,10 +8,14 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/orgroles"
 	"github.com/shammianand/go-auth/ent/predicate"
 	"github.com/shammianand/go-auth/ent/userroles"
 	"github.com/shammianand/go-auth/ent/users"
@@ -20,11 +24,15 @@ import (
 // UsersQuery is the builder for querying Users entities.
 type UsersQuery struct {
 	config
-	ctx           *QueryContext
-	order         []users.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Users
-	withUserRoles *UserRolesQuery
+	ctx                  *QueryContext
+	order                []users.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Users
+	withUserRoles        *UserRolesQuery
+	withGroupMemberships *GroupMembersQuery
+	withOrgMemberships   *OrgMembersQuery
+	withOrgRoles         *OrgRolesQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +91,72 @@ func (uq *UsersQuery) QueryUserRoles() *UserRolesQuery {
 	return query
 }
 
+// QueryGroupMemberships chains the current query on the "group_memberships" edge.
+func (uq *UsersQuery) QueryGroupMemberships() *GroupMembersQuery {
+	query := (&GroupMembersClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(users.Table, users.FieldID, selector),
+			sqlgraph.To(groupmembers.Table, groupmembers.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, users.GroupMembershipsTable, users.GroupMembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrgMemberships chains the current query on the "org_memberships" edge.
+func (uq *UsersQuery) QueryOrgMemberships() *OrgMembersQuery {
+	query := (&OrgMembersClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(users.Table, users.FieldID, selector),
+			sqlgraph.To(orgmembers.Table, orgmembers.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, users.OrgMembershipsTable, users.OrgMembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrgRoles chains the current query on the "org_roles" edge.
+func (uq *UsersQuery) QueryOrgRoles() *OrgRolesQuery {
+	query := (&OrgRolesClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(users.Table, users.FieldID, selector),
+			sqlgraph.To(orgroles.Table, orgroles.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, users.OrgRolesTable, users.OrgRolesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Users entity from the query.
 // Returns a *NotFoundError when no Users was found.
 func (uq *UsersQuery) First(ctx context.Context) (*Users, error) {
@@ -270,12 +344,15 @@ func (uq *UsersQuery) Clone() *UsersQuery {
 		return nil
 	}
 	return &UsersQuery{
-		config:        uq.config,
-		ctx:           uq.ctx.Clone(),
-		order:         append([]users.OrderOption{}, uq.order...),
-		inters:        append([]Interceptor{}, uq.inters...),
-		predicates:    append([]predicate.Users{}, uq.predicates...),
-		withUserRoles: uq.withUserRoles.Clone(),
+		config:               uq.config,
+		ctx:                  uq.ctx.Clone(),
+		order:                append([]users.OrderOption{}, uq.order...),
+		inters:               append([]Interceptor{}, uq.inters...),
+		predicates:           append([]predicate.Users{}, uq.predicates...),
+		withUserRoles:        uq.withUserRoles.Clone(),
+		withGroupMemberships: uq.withGroupMemberships.Clone(),
+		withOrgMemberships:   uq.withOrgMemberships.Clone(),
+		withOrgRoles:         uq.withOrgRoles.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -293,6 +370,39 @@ func (uq *UsersQuery) WithUserRoles(opts ...func(*UserRolesQuery)) *UsersQuery {
 	return uq
 }
 
+// WithGroupMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "group_memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UsersQuery) WithGroupMemberships(opts ...func(*GroupMembersQuery)) *UsersQuery {
+	query := (&GroupMembersClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withGroupMemberships = query
+	return uq
+}
+
+// WithOrgMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "org_memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UsersQuery) WithOrgMemberships(opts ...func(*OrgMembersQuery)) *UsersQuery {
+	query := (&OrgMembersClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withOrgMemberships = query
+	return uq
+}
+
+// WithOrgRoles tells the query-builder to eager-load the nodes that are connected to
+// the "org_roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UsersQuery) WithOrgRoles(opts ...func(*OrgRolesQuery)) *UsersQuery {
+	query := (&OrgRolesClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withOrgRoles = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +481,11 @@ func (uq *UsersQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Users,
 	var (
 		nodes       = []*Users{}
 		_spec       = uq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			uq.withUserRoles != nil,
+			uq.withGroupMemberships != nil,
+			uq.withOrgMemberships != nil,
+			uq.withOrgRoles != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -384,6 +497,9 @@ func (uq *UsersQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Users,
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -400,6 +516,27 @@ func (uq *UsersQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Users,
 			return nil, err
 		}
 	}
+	if query := uq.withGroupMemberships; query != nil {
+		if err := uq.loadGroupMemberships(ctx, query, nodes,
+			func(n *Users) { n.Edges.GroupMemberships = []*GroupMembers{} },
+			func(n *Users, e *GroupMembers) { n.Edges.GroupMemberships = append(n.Edges.GroupMemberships, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withOrgMemberships; query != nil {
+		if err := uq.loadOrgMemberships(ctx, query, nodes,
+			func(n *Users) { n.Edges.OrgMemberships = []*OrgMembers{} },
+			func(n *Users, e *OrgMembers) { n.Edges.OrgMemberships = append(n.Edges.OrgMemberships, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withOrgRoles; query != nil {
+		if err := uq.loadOrgRoles(ctx, query, nodes,
+			func(n *Users) { n.Edges.OrgRoles = []*OrgRoles{} },
+			func(n *Users, e *OrgRoles) { n.Edges.OrgRoles = append(n.Edges.OrgRoles, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -433,9 +570,102 @@ func (uq *UsersQuery) loadUserRoles(ctx context.Context, query *UserRolesQuery,
 	}
 	return nil
 }
+func (uq *UsersQuery) loadGroupMemberships(ctx context.Context, query *GroupMembersQuery, nodes []*Users, init func(*Users), assign func(*Users, *GroupMembers)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Users)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(groupmembers.FieldUserID)
+	}
+	query.Where(predicate.GroupMembers(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(users.GroupMembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UsersQuery) loadOrgMemberships(ctx context.Context, query *OrgMembersQuery, nodes []*Users, init func(*Users), assign func(*Users, *OrgMembers)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Users)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orgmembers.FieldUserID)
+	}
+	query.Where(predicate.OrgMembers(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(users.OrgMembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UsersQuery) loadOrgRoles(ctx context.Context, query *OrgRolesQuery, nodes []*Users, init func(*Users), assign func(*Users, *OrgRoles)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Users)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orgroles.FieldUserID)
+	}
+	query.Where(predicate.OrgRoles(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(users.OrgRolesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UsersQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	_spec.Node.Columns = uq.ctx.Fields
 	if len(uq.ctx.Fields) > 0 {
 		_spec.Unique = uq.ctx.Unique != nil && *uq.ctx.Unique
@@ -498,6 +728,9 @@ func (uq *UsersQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if uq.ctx.Unique != nil && *uq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range uq.modifiers {
+		m(selector)
+	}
 	for _, p := range uq.predicates {
 		p(selector)
 	}
@@ -515,6 +748,32 @@ func (uq *UsersQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uq *UsersQuery) ForUpdate(opts ...sql.LockOption) *UsersQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return uq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uq *UsersQuery) ForShare(opts ...sql.LockOption) *UsersQuery {
+	if uq.driver.Dialect() == dialect.Postgres {
+		uq.Unique(false)
+	}
+	uq.modifiers = append(uq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return uq
+}
+
 // UsersGroupBy is the group-by builder for Users entities.
 type UsersGroupBy struct {
 	selector
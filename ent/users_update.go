@@ -12,6 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/orgroles"
 	"github.com/shammianand/go-auth/ent/predicate"
 	"github.com/shammianand/go-auth/ent/userroles"
 	"github.com/shammianand/go-auth/ent/users"
@@ -234,6 +237,114 @@ func (uu *UsersUpdate) ClearPasswordResetTokenExpiry() *UsersUpdate {
 	return uu
 }
 
+// SetPasswordResetRequired sets the "password_reset_required" field.
+func (uu *UsersUpdate) SetPasswordResetRequired(b bool) *UsersUpdate {
+	uu.mutation.SetPasswordResetRequired(b)
+	return uu
+}
+
+// SetNillablePasswordResetRequired sets the "password_reset_required" field if the given value is not nil.
+func (uu *UsersUpdate) SetNillablePasswordResetRequired(b *bool) *UsersUpdate {
+	if b != nil {
+		uu.SetPasswordResetRequired(*b)
+	}
+	return uu
+}
+
+// SetApprovalStatus sets the "approval_status" field.
+func (uu *UsersUpdate) SetApprovalStatus(us users.ApprovalStatus) *UsersUpdate {
+	uu.mutation.SetApprovalStatus(us)
+	return uu
+}
+
+// SetNillableApprovalStatus sets the "approval_status" field if the given value is not nil.
+func (uu *UsersUpdate) SetNillableApprovalStatus(us *users.ApprovalStatus) *UsersUpdate {
+	if us != nil {
+		uu.SetApprovalStatus(*us)
+	}
+	return uu
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (uu *UsersUpdate) SetReviewedBy(u uuid.UUID) *UsersUpdate {
+	uu.mutation.SetReviewedBy(u)
+	return uu
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (uu *UsersUpdate) SetNillableReviewedBy(u *uuid.UUID) *UsersUpdate {
+	if u != nil {
+		uu.SetReviewedBy(*u)
+	}
+	return uu
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (uu *UsersUpdate) ClearReviewedBy() *UsersUpdate {
+	uu.mutation.ClearReviewedBy()
+	return uu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (uu *UsersUpdate) SetReviewedAt(t time.Time) *UsersUpdate {
+	uu.mutation.SetReviewedAt(t)
+	return uu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (uu *UsersUpdate) SetNillableReviewedAt(t *time.Time) *UsersUpdate {
+	if t != nil {
+		uu.SetReviewedAt(*t)
+	}
+	return uu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (uu *UsersUpdate) ClearReviewedAt() *UsersUpdate {
+	uu.mutation.ClearReviewedAt()
+	return uu
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (uu *UsersUpdate) SetDeletionRequestedAt(t time.Time) *UsersUpdate {
+	uu.mutation.SetDeletionRequestedAt(t)
+	return uu
+}
+
+// SetNillableDeletionRequestedAt sets the "deletion_requested_at" field if the given value is not nil.
+func (uu *UsersUpdate) SetNillableDeletionRequestedAt(t *time.Time) *UsersUpdate {
+	if t != nil {
+		uu.SetDeletionRequestedAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (uu *UsersUpdate) ClearDeletionRequestedAt() *UsersUpdate {
+	uu.mutation.ClearDeletionRequestedAt()
+	return uu
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uu *UsersUpdate) SetDeletionScheduledAt(t time.Time) *UsersUpdate {
+	uu.mutation.SetDeletionScheduledAt(t)
+	return uu
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uu *UsersUpdate) SetNillableDeletionScheduledAt(t *time.Time) *UsersUpdate {
+	if t != nil {
+		uu.SetDeletionScheduledAt(*t)
+	}
+	return uu
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (uu *UsersUpdate) ClearDeletionScheduledAt() *UsersUpdate {
+	uu.mutation.ClearDeletionScheduledAt()
+	return uu
+}
+
 // SetMetadata sets the "metadata" field.
 func (uu *UsersUpdate) SetMetadata(m map[string]interface{}) *UsersUpdate {
 	uu.mutation.SetMetadata(m)
@@ -261,6 +372,51 @@ func (uu *UsersUpdate) AddUserRoles(u ...*UserRoles) *UsersUpdate {
 	return uu.AddUserRoleIDs(ids...)
 }
 
+// AddGroupMembershipIDs adds the "group_memberships" edge to the GroupMembers entity by IDs.
+func (uu *UsersUpdate) AddGroupMembershipIDs(ids ...uuid.UUID) *UsersUpdate {
+	uu.mutation.AddGroupMembershipIDs(ids...)
+	return uu
+}
+
+// AddGroupMemberships adds the "group_memberships" edges to the GroupMembers entity.
+func (uu *UsersUpdate) AddGroupMemberships(g ...*GroupMembers) *UsersUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.AddGroupMembershipIDs(ids...)
+}
+
+// AddOrgMembershipIDs adds the "org_memberships" edge to the OrgMembers entity by IDs.
+func (uu *UsersUpdate) AddOrgMembershipIDs(ids ...uuid.UUID) *UsersUpdate {
+	uu.mutation.AddOrgMembershipIDs(ids...)
+	return uu
+}
+
+// AddOrgMemberships adds the "org_memberships" edges to the OrgMembers entity.
+func (uu *UsersUpdate) AddOrgMemberships(o ...*OrgMembers) *UsersUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.AddOrgMembershipIDs(ids...)
+}
+
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by IDs.
+func (uu *UsersUpdate) AddOrgRoleIDs(ids ...int) *UsersUpdate {
+	uu.mutation.AddOrgRoleIDs(ids...)
+	return uu
+}
+
+// AddOrgRoles adds the "org_roles" edges to the OrgRoles entity.
+func (uu *UsersUpdate) AddOrgRoles(o ...*OrgRoles) *UsersUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.AddOrgRoleIDs(ids...)
+}
+
 // Mutation returns the UsersMutation object of the builder.
 func (uu *UsersUpdate) Mutation() *UsersMutation {
 	return uu.mutation
@@ -287,6 +443,69 @@ func (uu *UsersUpdate) RemoveUserRoles(u ...*UserRoles) *UsersUpdate {
 	return uu.RemoveUserRoleIDs(ids...)
 }
 
+// ClearGroupMemberships clears all "group_memberships" edges to the GroupMembers entity.
+func (uu *UsersUpdate) ClearGroupMemberships() *UsersUpdate {
+	uu.mutation.ClearGroupMemberships()
+	return uu
+}
+
+// RemoveGroupMembershipIDs removes the "group_memberships" edge to GroupMembers entities by IDs.
+func (uu *UsersUpdate) RemoveGroupMembershipIDs(ids ...uuid.UUID) *UsersUpdate {
+	uu.mutation.RemoveGroupMembershipIDs(ids...)
+	return uu
+}
+
+// RemoveGroupMemberships removes "group_memberships" edges to GroupMembers entities.
+func (uu *UsersUpdate) RemoveGroupMemberships(g ...*GroupMembers) *UsersUpdate {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uu.RemoveGroupMembershipIDs(ids...)
+}
+
+// ClearOrgMemberships clears all "org_memberships" edges to the OrgMembers entity.
+func (uu *UsersUpdate) ClearOrgMemberships() *UsersUpdate {
+	uu.mutation.ClearOrgMemberships()
+	return uu
+}
+
+// RemoveOrgMembershipIDs removes the "org_memberships" edge to OrgMembers entities by IDs.
+func (uu *UsersUpdate) RemoveOrgMembershipIDs(ids ...uuid.UUID) *UsersUpdate {
+	uu.mutation.RemoveOrgMembershipIDs(ids...)
+	return uu
+}
+
+// RemoveOrgMemberships removes "org_memberships" edges to OrgMembers entities.
+func (uu *UsersUpdate) RemoveOrgMemberships(o ...*OrgMembers) *UsersUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.RemoveOrgMembershipIDs(ids...)
+}
+
+// ClearOrgRoles clears all "org_roles" edges to the OrgRoles entity.
+func (uu *UsersUpdate) ClearOrgRoles() *UsersUpdate {
+	uu.mutation.ClearOrgRoles()
+	return uu
+}
+
+// RemoveOrgRoleIDs removes the "org_roles" edge to OrgRoles entities by IDs.
+func (uu *UsersUpdate) RemoveOrgRoleIDs(ids ...int) *UsersUpdate {
+	uu.mutation.RemoveOrgRoleIDs(ids...)
+	return uu
+}
+
+// RemoveOrgRoles removes "org_roles" edges to OrgRoles entities.
+func (uu *UsersUpdate) RemoveOrgRoles(o ...*OrgRoles) *UsersUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.RemoveOrgRoleIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UsersUpdate) Save(ctx context.Context) (int, error) {
 	uu.defaults()
@@ -345,6 +564,11 @@ func (uu *UsersUpdate) check() error {
 			return &ValidationError{Name: "last_name", err: fmt.Errorf(`ent: validator failed for field "Users.last_name": %w`, err)}
 		}
 	}
+	if v, ok := uu.mutation.ApprovalStatus(); ok {
+		if err := users.ApprovalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "approval_status", err: fmt.Errorf(`ent: validator failed for field "Users.approval_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -414,6 +638,36 @@ func (uu *UsersUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.PasswordResetTokenExpiryCleared() {
 		_spec.ClearField(users.FieldPasswordResetTokenExpiry, field.TypeTime)
 	}
+	if value, ok := uu.mutation.PasswordResetRequired(); ok {
+		_spec.SetField(users.FieldPasswordResetRequired, field.TypeBool, value)
+	}
+	if value, ok := uu.mutation.ApprovalStatus(); ok {
+		_spec.SetField(users.FieldApprovalStatus, field.TypeEnum, value)
+	}
+	if value, ok := uu.mutation.ReviewedBy(); ok {
+		_spec.SetField(users.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if uu.mutation.ReviewedByCleared() {
+		_spec.ClearField(users.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := uu.mutation.ReviewedAt(); ok {
+		_spec.SetField(users.FieldReviewedAt, field.TypeTime, value)
+	}
+	if uu.mutation.ReviewedAtCleared() {
+		_spec.ClearField(users.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.DeletionRequestedAt(); ok {
+		_spec.SetField(users.FieldDeletionRequestedAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletionRequestedAtCleared() {
+		_spec.ClearField(users.FieldDeletionRequestedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(users.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if uu.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(users.FieldDeletionScheduledAt, field.TypeTime)
+	}
 	if value, ok := uu.mutation.Metadata(); ok {
 		_spec.SetField(users.FieldMetadata, field.TypeJSON, value)
 	}
@@ -465,6 +719,141 @@ func (uu *UsersUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.GroupMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.GroupMembershipsTable,
+			Columns: []string{users.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedGroupMembershipsIDs(); len(nodes) > 0 && !uu.mutation.GroupMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.GroupMembershipsTable,
+			Columns: []string{users.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.GroupMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.GroupMembershipsTable,
+			Columns: []string{users.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.OrgMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgMembershipsTable,
+			Columns: []string{users.OrgMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedOrgMembershipsIDs(); len(nodes) > 0 && !uu.mutation.OrgMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgMembershipsTable,
+			Columns: []string{users.OrgMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.OrgMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgMembershipsTable,
+			Columns: []string{users.OrgMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgRolesTable,
+			Columns: []string{users.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedOrgRolesIDs(); len(nodes) > 0 && !uu.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgRolesTable,
+			Columns: []string{users.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.OrgRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgRolesTable,
+			Columns: []string{users.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{users.Label}
@@ -689,6 +1078,114 @@ func (uuo *UsersUpdateOne) ClearPasswordResetTokenExpiry() *UsersUpdateOne {
 	return uuo
 }
 
+// SetPasswordResetRequired sets the "password_reset_required" field.
+func (uuo *UsersUpdateOne) SetPasswordResetRequired(b bool) *UsersUpdateOne {
+	uuo.mutation.SetPasswordResetRequired(b)
+	return uuo
+}
+
+// SetNillablePasswordResetRequired sets the "password_reset_required" field if the given value is not nil.
+func (uuo *UsersUpdateOne) SetNillablePasswordResetRequired(b *bool) *UsersUpdateOne {
+	if b != nil {
+		uuo.SetPasswordResetRequired(*b)
+	}
+	return uuo
+}
+
+// SetApprovalStatus sets the "approval_status" field.
+func (uuo *UsersUpdateOne) SetApprovalStatus(us users.ApprovalStatus) *UsersUpdateOne {
+	uuo.mutation.SetApprovalStatus(us)
+	return uuo
+}
+
+// SetNillableApprovalStatus sets the "approval_status" field if the given value is not nil.
+func (uuo *UsersUpdateOne) SetNillableApprovalStatus(us *users.ApprovalStatus) *UsersUpdateOne {
+	if us != nil {
+		uuo.SetApprovalStatus(*us)
+	}
+	return uuo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (uuo *UsersUpdateOne) SetReviewedBy(u uuid.UUID) *UsersUpdateOne {
+	uuo.mutation.SetReviewedBy(u)
+	return uuo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (uuo *UsersUpdateOne) SetNillableReviewedBy(u *uuid.UUID) *UsersUpdateOne {
+	if u != nil {
+		uuo.SetReviewedBy(*u)
+	}
+	return uuo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (uuo *UsersUpdateOne) ClearReviewedBy() *UsersUpdateOne {
+	uuo.mutation.ClearReviewedBy()
+	return uuo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (uuo *UsersUpdateOne) SetReviewedAt(t time.Time) *UsersUpdateOne {
+	uuo.mutation.SetReviewedAt(t)
+	return uuo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (uuo *UsersUpdateOne) SetNillableReviewedAt(t *time.Time) *UsersUpdateOne {
+	if t != nil {
+		uuo.SetReviewedAt(*t)
+	}
+	return uuo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (uuo *UsersUpdateOne) ClearReviewedAt() *UsersUpdateOne {
+	uuo.mutation.ClearReviewedAt()
+	return uuo
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (uuo *UsersUpdateOne) SetDeletionRequestedAt(t time.Time) *UsersUpdateOne {
+	uuo.mutation.SetDeletionRequestedAt(t)
+	return uuo
+}
+
+// SetNillableDeletionRequestedAt sets the "deletion_requested_at" field if the given value is not nil.
+func (uuo *UsersUpdateOne) SetNillableDeletionRequestedAt(t *time.Time) *UsersUpdateOne {
+	if t != nil {
+		uuo.SetDeletionRequestedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (uuo *UsersUpdateOne) ClearDeletionRequestedAt() *UsersUpdateOne {
+	uuo.mutation.ClearDeletionRequestedAt()
+	return uuo
+}
+
+// SetDeletionScheduledAt sets the "deletion_scheduled_at" field.
+func (uuo *UsersUpdateOne) SetDeletionScheduledAt(t time.Time) *UsersUpdateOne {
+	uuo.mutation.SetDeletionScheduledAt(t)
+	return uuo
+}
+
+// SetNillableDeletionScheduledAt sets the "deletion_scheduled_at" field if the given value is not nil.
+func (uuo *UsersUpdateOne) SetNillableDeletionScheduledAt(t *time.Time) *UsersUpdateOne {
+	if t != nil {
+		uuo.SetDeletionScheduledAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletionScheduledAt clears the value of the "deletion_scheduled_at" field.
+func (uuo *UsersUpdateOne) ClearDeletionScheduledAt() *UsersUpdateOne {
+	uuo.mutation.ClearDeletionScheduledAt()
+	return uuo
+}
+
 // SetMetadata sets the "metadata" field.
 func (uuo *UsersUpdateOne) SetMetadata(m map[string]interface{}) *UsersUpdateOne {
 	uuo.mutation.SetMetadata(m)
@@ -716,6 +1213,51 @@ func (uuo *UsersUpdateOne) AddUserRoles(u ...*UserRoles) *UsersUpdateOne {
 	return uuo.AddUserRoleIDs(ids...)
 }
 
+// AddGroupMembershipIDs adds the "group_memberships" edge to the GroupMembers entity by IDs.
+func (uuo *UsersUpdateOne) AddGroupMembershipIDs(ids ...uuid.UUID) *UsersUpdateOne {
+	uuo.mutation.AddGroupMembershipIDs(ids...)
+	return uuo
+}
+
+// AddGroupMemberships adds the "group_memberships" edges to the GroupMembers entity.
+func (uuo *UsersUpdateOne) AddGroupMemberships(g ...*GroupMembers) *UsersUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.AddGroupMembershipIDs(ids...)
+}
+
+// AddOrgMembershipIDs adds the "org_memberships" edge to the OrgMembers entity by IDs.
+func (uuo *UsersUpdateOne) AddOrgMembershipIDs(ids ...uuid.UUID) *UsersUpdateOne {
+	uuo.mutation.AddOrgMembershipIDs(ids...)
+	return uuo
+}
+
+// AddOrgMemberships adds the "org_memberships" edges to the OrgMembers entity.
+func (uuo *UsersUpdateOne) AddOrgMemberships(o ...*OrgMembers) *UsersUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.AddOrgMembershipIDs(ids...)
+}
+
+// AddOrgRoleIDs adds the "org_roles" edge to the OrgRoles entity by IDs.
+func (uuo *UsersUpdateOne) AddOrgRoleIDs(ids ...int) *UsersUpdateOne {
+	uuo.mutation.AddOrgRoleIDs(ids...)
+	return uuo
+}
+
+// AddOrgRoles adds the "org_roles" edges to the OrgRoles entity.
+func (uuo *UsersUpdateOne) AddOrgRoles(o ...*OrgRoles) *UsersUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.AddOrgRoleIDs(ids...)
+}
+
 // Mutation returns the UsersMutation object of the builder.
 func (uuo *UsersUpdateOne) Mutation() *UsersMutation {
 	return uuo.mutation
@@ -742,6 +1284,69 @@ func (uuo *UsersUpdateOne) RemoveUserRoles(u ...*UserRoles) *UsersUpdateOne {
 	return uuo.RemoveUserRoleIDs(ids...)
 }
 
+// ClearGroupMemberships clears all "group_memberships" edges to the GroupMembers entity.
+func (uuo *UsersUpdateOne) ClearGroupMemberships() *UsersUpdateOne {
+	uuo.mutation.ClearGroupMemberships()
+	return uuo
+}
+
+// RemoveGroupMembershipIDs removes the "group_memberships" edge to GroupMembers entities by IDs.
+func (uuo *UsersUpdateOne) RemoveGroupMembershipIDs(ids ...uuid.UUID) *UsersUpdateOne {
+	uuo.mutation.RemoveGroupMembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveGroupMemberships removes "group_memberships" edges to GroupMembers entities.
+func (uuo *UsersUpdateOne) RemoveGroupMemberships(g ...*GroupMembers) *UsersUpdateOne {
+	ids := make([]uuid.UUID, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return uuo.RemoveGroupMembershipIDs(ids...)
+}
+
+// ClearOrgMemberships clears all "org_memberships" edges to the OrgMembers entity.
+func (uuo *UsersUpdateOne) ClearOrgMemberships() *UsersUpdateOne {
+	uuo.mutation.ClearOrgMemberships()
+	return uuo
+}
+
+// RemoveOrgMembershipIDs removes the "org_memberships" edge to OrgMembers entities by IDs.
+func (uuo *UsersUpdateOne) RemoveOrgMembershipIDs(ids ...uuid.UUID) *UsersUpdateOne {
+	uuo.mutation.RemoveOrgMembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveOrgMemberships removes "org_memberships" edges to OrgMembers entities.
+func (uuo *UsersUpdateOne) RemoveOrgMemberships(o ...*OrgMembers) *UsersUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.RemoveOrgMembershipIDs(ids...)
+}
+
+// ClearOrgRoles clears all "org_roles" edges to the OrgRoles entity.
+func (uuo *UsersUpdateOne) ClearOrgRoles() *UsersUpdateOne {
+	uuo.mutation.ClearOrgRoles()
+	return uuo
+}
+
+// RemoveOrgRoleIDs removes the "org_roles" edge to OrgRoles entities by IDs.
+func (uuo *UsersUpdateOne) RemoveOrgRoleIDs(ids ...int) *UsersUpdateOne {
+	uuo.mutation.RemoveOrgRoleIDs(ids...)
+	return uuo
+}
+
+// RemoveOrgRoles removes "org_roles" edges to OrgRoles entities.
+func (uuo *UsersUpdateOne) RemoveOrgRoles(o ...*OrgRoles) *UsersUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.RemoveOrgRoleIDs(ids...)
+}
+
 // Where appends a list predicates to the UsersUpdate builder.
 func (uuo *UsersUpdateOne) Where(ps ...predicate.Users) *UsersUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -813,6 +1418,11 @@ func (uuo *UsersUpdateOne) check() error {
 			return &ValidationError{Name: "last_name", err: fmt.Errorf(`ent: validator failed for field "Users.last_name": %w`, err)}
 		}
 	}
+	if v, ok := uuo.mutation.ApprovalStatus(); ok {
+		if err := users.ApprovalStatusValidator(v); err != nil {
+			return &ValidationError{Name: "approval_status", err: fmt.Errorf(`ent: validator failed for field "Users.approval_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -899,6 +1509,36 @@ func (uuo *UsersUpdateOne) sqlSave(ctx context.Context) (_node *Users, err error
 	if uuo.mutation.PasswordResetTokenExpiryCleared() {
 		_spec.ClearField(users.FieldPasswordResetTokenExpiry, field.TypeTime)
 	}
+	if value, ok := uuo.mutation.PasswordResetRequired(); ok {
+		_spec.SetField(users.FieldPasswordResetRequired, field.TypeBool, value)
+	}
+	if value, ok := uuo.mutation.ApprovalStatus(); ok {
+		_spec.SetField(users.FieldApprovalStatus, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.ReviewedBy(); ok {
+		_spec.SetField(users.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if uuo.mutation.ReviewedByCleared() {
+		_spec.ClearField(users.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := uuo.mutation.ReviewedAt(); ok {
+		_spec.SetField(users.FieldReviewedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(users.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.DeletionRequestedAt(); ok {
+		_spec.SetField(users.FieldDeletionRequestedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletionRequestedAtCleared() {
+		_spec.ClearField(users.FieldDeletionRequestedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.DeletionScheduledAt(); ok {
+		_spec.SetField(users.FieldDeletionScheduledAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletionScheduledAtCleared() {
+		_spec.ClearField(users.FieldDeletionScheduledAt, field.TypeTime)
+	}
 	if value, ok := uuo.mutation.Metadata(); ok {
 		_spec.SetField(users.FieldMetadata, field.TypeJSON, value)
 	}
@@ -950,6 +1590,141 @@ func (uuo *UsersUpdateOne) sqlSave(ctx context.Context) (_node *Users, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.GroupMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.GroupMembershipsTable,
+			Columns: []string{users.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedGroupMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.GroupMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.GroupMembershipsTable,
+			Columns: []string{users.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.GroupMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.GroupMembershipsTable,
+			Columns: []string{users.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.OrgMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgMembershipsTable,
+			Columns: []string{users.OrgMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedOrgMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.OrgMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgMembershipsTable,
+			Columns: []string{users.OrgMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.OrgMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgMembershipsTable,
+			Columns: []string{users.OrgMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgmembers.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgRolesTable,
+			Columns: []string{users.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedOrgRolesIDs(); len(nodes) > 0 && !uuo.mutation.OrgRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgRolesTable,
+			Columns: []string{users.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.OrgRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   users.OrgRolesTable,
+			Columns: []string{users.OrgRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orgroles.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Users{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
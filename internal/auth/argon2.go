@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/shammianand/go-auth/internal/config"
+	"golang.org/x/crypto/argon2"
+)
+
+// Limits on argon2id parameters, both configured and read from stored or
+// imported hashes, so hashing cannot panic or exhaust the server
+const (
+	maxArgon2Memory      = 1 << 20 // KiB (1 GiB)
+	maxArgon2Iterations  = 100
+	maxArgon2Parallelism = 64
+	minArgon2KeyLength   = 4
+	maxArgon2KeyLength   = 1024
+)
+
+// Argon2Params holds the argon2id cost parameters
+type Argon2Params struct {
+	Memory      uint32 // Memory in KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// CheckArgon2Params returns an error unless memory (KiB), iterations and
+// parallelism are each at least 1 and within the supported limits
+func CheckArgon2Params(memory, iterations, parallelism int) error {
+	if memory < 1 || memory > maxArgon2Memory {
+		return fmt.Errorf("argon2id memory must be between 1 and %d KiB, got %d", maxArgon2Memory, memory)
+	}
+	if iterations < 1 || iterations > maxArgon2Iterations {
+		return fmt.Errorf("argon2id iterations must be between 1 and %d, got %d", maxArgon2Iterations, iterations)
+	}
+	if parallelism < 1 || parallelism > maxArgon2Parallelism {
+		return fmt.Errorf("argon2id parallelism must be between 1 and %d, got %d", maxArgon2Parallelism, parallelism)
+	}
+	return nil
+}
+
+// CheckArgon2Config validates ARGON2_MEMORY_KIB, ARGON2_ITERATIONS and
+// ARGON2_PARALLELISM. Call it at startup, before anything is hashed.
+func CheckArgon2Config() error {
+	if err := CheckArgon2Params(config.Argon2Memory, config.Argon2Iterations, config.Argon2Parallelism); err != nil {
+		return fmt.Errorf("invalid ARGON2_* configuration: %w", err)
+	}
+	return nil
+}
+
+// DefaultArgon2Params returns the argon2id parameters from configuration.
+// They are only meaningful once CheckArgon2Config has passed.
+func DefaultArgon2Params() Argon2Params {
+	return Argon2Params{
+		Memory:      uint32(config.Argon2Memory),
+		Iterations:  uint32(config.Argon2Iterations),
+		Parallelism: uint8(config.Argon2Parallelism),
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+// Argon2idHasher hashes passwords with argon2id
+type Argon2idHasher struct {
+	params Argon2Params
+}
+
+// NewArgon2idHasher creates a new argon2id hasher
+func NewArgon2idHasher(params Argon2Params) *Argon2idHasher {
+	return &Argon2idHasher{params: params}
+}
+
+// Algorithm returns the PHC identifier
+func (h *Argon2idHasher) Algorithm() string {
+	return "argon2id"
+}
+
+// Hash returns a PHC encoded argon2id hash
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	if err := CheckArgon2Params(int(h.params.Memory), int(h.params.Iterations), int(h.params.Parallelism)); err != nil {
+		return "", err
+	}
+	if h.params.KeyLength < minArgon2KeyLength || h.params.KeyLength > maxArgon2KeyLength {
+		return "", fmt.Errorf("argon2id key length must be between %d and %d bytes", minArgon2KeyLength, maxArgon2KeyLength)
+	}
+
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify reports whether a password matches a PHC encoded argon2id hash
+func (h *Argon2idHasher) Verify(encoded string, password []byte) (bool, error) {
+	params, _, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	computed := argon2.IDKey(password, salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, computed) == 1, nil
+}
+
+// Check returns an error when an argon2id hash cannot be verified
+func (h *Argon2idHasher) Check(encoded string) error {
+	_, _, _, _, err := decodeArgon2id(encoded)
+	return err
+}
+
+// NeedsRehash reports whether a hash was produced with different parameters
+func (h *Argon2idHasher) NeedsRehash(encoded string) bool {
+	params, version, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return true
+	}
+
+	return version != argon2.Version ||
+		params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		uint32(len(salt)) != h.params.SaltLength ||
+		uint32(len(key)) != h.params.KeyLength
+}
+
+// decodeArgon2id parses $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>,
+// rejecting other versions and parameters outside the supported limits
+func decodeArgon2id(encoded string) (Argon2Params, int, []byte, []byte, error) {
+	var params Argon2Params
+	var version int
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return params, 0, nil, nil, fmt.Errorf("invalid argon2id hash format")
+	}
+
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, 0, nil, nil, fmt.Errorf("invalid argon2id version: %w", err)
+	}
+	if version != argon2.Version {
+		return params, 0, nil, nil, fmt.Errorf("unsupported argon2 version: %d", version)
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, 0, nil, nil, fmt.Errorf("invalid argon2id parameters: %w", err)
+	}
+	if err := CheckArgon2Params(int(params.Memory), int(params.Iterations), int(params.Parallelism)); err != nil {
+		return params, 0, nil, nil, fmt.Errorf("invalid argon2id parameters: %w", err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, 0, nil, nil, fmt.Errorf("invalid argon2id salt: %w", err)
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, 0, nil, nil, fmt.Errorf("invalid argon2id hash: %w", err)
+	}
+	if len(key) < minArgon2KeyLength || len(key) > maxArgon2KeyLength {
+		return params, 0, nil, nil, fmt.Errorf("invalid argon2id hash length: %d", len(key))
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, version, salt, key, nil
+}
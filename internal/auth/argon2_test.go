@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckArgon2Params(t *testing.T) {
+	tests := []struct {
+		memory, iterations, parallelism int
+		valid                           bool
+	}{
+		{64 * 1024, 3, 2, true},
+		{1, 1, 1, true},
+		{maxArgon2Memory, maxArgon2Iterations, maxArgon2Parallelism, true},
+		{0, 3, 2, false},
+		{-1, 3, 2, false},
+		{maxArgon2Memory + 1, 3, 2, false},
+		{64 * 1024, 0, 2, false},
+		{64 * 1024, maxArgon2Iterations + 1, 2, false},
+		{64 * 1024, 3, 0, false},
+		{64 * 1024, 3, 256, false},
+	}
+
+	for _, tt := range tests {
+		err := CheckArgon2Params(tt.memory, tt.iterations, tt.parallelism)
+		if (err == nil) != tt.valid {
+			t.Errorf("CheckArgon2Params(%d, %d, %d) error = %v, want valid %v", tt.memory, tt.iterations, tt.parallelism, err, tt.valid)
+		}
+	}
+}
+
+func TestArgon2idHasher(t *testing.T) {
+	params := Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+	hasher := NewArgon2idHasher(params)
+
+	encoded, err := hasher.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("Hash returned error: %v", err)
+	}
+	if !strings.HasPrefix(encoded, "$argon2id$v=19$m=1024,t=1,p=1$") {
+		t.Fatalf("Hash = %q, want argon2id PHC string", encoded)
+	}
+
+	if ok, err := hasher.Verify(encoded, []byte("correct horse")); err != nil || !ok {
+		t.Errorf("Verify(correct password) = %v, %v, want true", ok, err)
+	}
+	if ok, err := hasher.Verify(encoded, []byte("wrong horse")); err != nil || ok {
+		t.Errorf("Verify(wrong password) = %v, %v, want false", ok, err)
+	}
+	if hasher.NeedsRehash(encoded) {
+		t.Error("NeedsRehash = true for a hash with the current parameters")
+	}
+	if !NewArgon2idHasher(Argon2Params{Memory: 2048, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}).NeedsRehash(encoded) {
+		t.Error("NeedsRehash = false after the memory parameter changed")
+	}
+
+	if _, err := NewArgon2idHasher(Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 0, SaltLength: 16, KeyLength: 32}).Hash("x"); err == nil {
+		t.Error("Hash with parallelism 0 succeeded, want error")
+	}
+}
+
+func TestArgon2idRejectsBadHashes(t *testing.T) {
+	const salt, key = "c2FsdHNhbHRzYWx0c2FsdA", "aGFzaGhhc2hoYXNoaGFzaGhhc2hoYXNoaGFzaGhhc2g"
+
+	bad := []string{
+		"$argon2id$v=19$m=1024,t=1,p=1$" + salt,
+		"$argon2i$v=19$m=1024,t=1,p=1$" + salt + "$" + key,
+		"$argon2id$v=16$m=1024,t=1,p=1$" + salt + "$" + key,
+		"$argon2id$v=19$m=0,t=1,p=1$" + salt + "$" + key,
+		"$argon2id$v=19$m=1024,t=0,p=1$" + salt + "$" + key,
+		"$argon2id$v=19$m=1024,t=1,p=0$" + salt + "$" + key,
+		"$argon2id$v=19$m=1024,t=1,p=300$" + salt + "$" + key,
+		"$argon2id$v=19$m=4294967295,t=1,p=1$" + salt + "$" + key,
+		"$argon2id$v=19$m=1024,t=1000,p=1$" + salt + "$" + key,
+		"$argon2id$v=19$m=1024,t=1,p=1$" + salt + "$aGE",
+		"$argon2id$v=19$m=1024,t=1,p=1$!!!$" + key,
+	}
+
+	hasher := NewArgon2idHasher(DefaultArgon2Params())
+	for _, encoded := range bad {
+		if err := hasher.Check(encoded); err == nil {
+			t.Errorf("Check(%q) succeeded, want error", encoded)
+		}
+		if _, err := hasher.Verify(encoded, []byte("x")); err == nil {
+			t.Errorf("Verify(%q) succeeded, want error", encoded)
+		}
+	}
+}
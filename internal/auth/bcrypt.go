@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptHasher hashes passwords with bcrypt ($2a$/$2b$/$2y$ modular crypt format)
+type BcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher creates a new bcrypt hasher
+func NewBcryptHasher(cost int) *BcryptHasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
+	return &BcryptHasher{cost: cost}
+}
+
+// Algorithm returns the identifier used by bcrypt hashes
+func (h *BcryptHasher) Algorithm() string {
+	return "2a"
+}
+
+// Hash returns a bcrypt hash
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// Verify reports whether a password matches a bcrypt hash
+func (h *BcryptHasher) Verify(encoded string, password []byte) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), password)
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Check returns an error when a bcrypt hash is malformed
+func (h *BcryptHasher) Check(encoded string) error {
+	_, err := bcrypt.Cost([]byte(encoded))
+	return err
+}
+
+// NeedsRehash reports whether a hash was produced with a different cost
+func (h *BcryptHasher) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return true
+	}
+	return cost != h.cost
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ConsentClaim holds, keyed by document type, the version of each consent
+// document the token's user has accepted
+const ConsentClaim = "consent"
+
+// ConsentDocument is a document version a user has to accept
+type ConsentDocument struct {
+	ID      uuid.UUID `json:"id"`
+	Type    string    `json:"type"`
+	Version string    `json:"version"`
+	Title   string    `json:"title"`
+	URL     string    `json:"url,omitempty"`
+}
+
+// ConsentRequiredError is returned instead of a token while the user has
+// not accepted the current version of a mandatory document
+type ConsentRequiredError struct {
+	Documents []ConsentDocument
+}
+
+func (e *ConsentRequiredError) Error() string {
+	return "consent required: accept the current terms before signing in"
+}
+
+// ConsentTracker records consent and reports what a user has accepted
+type ConsentTracker interface {
+	AcceptConsents(ctx context.Context, userID uuid.UUID, documentIDs []uuid.UUID, ip, userAgent string) error
+	// AcceptedVersions returns the accepted version of each document type,
+	// or a *ConsentRequiredError while a mandatory document is pending
+	AcceptedVersions(ctx context.Context, userID uuid.UUID) (map[string]string, error)
+}
+
+// ConsentClaims records the documents accepted along with a token request
+// and returns the consent claims for the token, or a *ConsentRequiredError
+// when a mandatory document is still pending
+func ConsentClaims(ctx context.Context, tracker ConsentTracker, userID uuid.UUID, accept []uuid.UUID, ip, userAgent string) (map[string]interface{}, error) {
+	if len(accept) > 0 {
+		if err := tracker.AcceptConsents(ctx, userID, accept, ip, userAgent); err != nil {
+			return nil, err
+		}
+	}
+
+	versions, err := tracker.AcceptedVersions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{ConsentClaim: versions}, nil
+}
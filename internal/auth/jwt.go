@@ -23,12 +23,18 @@ const (
 	keyPrefix      = "auth:key:"
 	keySetKey      = "auth:keyset"
 	tokenPrefix    = "auth:token:"
+	revokedPrefix  = "auth:revoked:"
 	jwksPrefix     = "auth:jwks"
 	keyExpiryDays  = 30 // NOTE: adjust as needed
 	rsaKeyBits     = 2048
 	tokenCacheTime = time.Minute * 60 // NOTE: cache tokens for 1 hour
 )
 
+// IssuedAtMsClaim holds the token's issue time in unix milliseconds. iat only
+// has second precision, too coarse to tell a token issued right after a
+// revocation from one issued just before it.
+const IssuedAtMsClaim = "iat_ms"
+
 var (
 	// NOTE: later on think of an alternative to allow for high
 	// throughput request handling
@@ -131,6 +137,40 @@ func updateJWKSet(keys map[string]*Key, cache *redis.Client) error {
 }
 
 func CreateJWT(userID uuid.UUID, cache *redis.Client) (string, error) {
+	return CreateJWTWithClaims(userID, nil, cache)
+}
+
+// CreateJWTWithClaims creates a token carrying extra claims, such as the
+// organization the token is scoped to. Extra claims cannot override the
+// registered iss, sub, exp and iat claims or iat_ms.
+func CreateJWTWithClaims(userID uuid.UUID, extra map[string]interface{}, cache *redis.Client) (string, error) {
+	expiration := time.Second * time.Duration(config.TokenExpiry)
+
+	tokenString, err := signJWT(userID, extra, expiration, cache)
+	if err != nil {
+		return "", err
+	}
+
+	err = cache.Set(
+		context.Background(),
+		fmt.Sprintf("%s%s", tokenPrefix, userID.String()),
+		tokenString,
+		expiration,
+	).Err()
+	if err != nil {
+		return "", fmt.Errorf("failed to store token in Redis: %v", err)
+	}
+
+	return tokenString, nil
+}
+
+// CreateJWTWithExpiry creates a token with its own lifetime that is not
+// stored as the user's session, such as an impersonation token
+func CreateJWTWithExpiry(userID uuid.UUID, extra map[string]interface{}, expiration time.Duration, cache *redis.Client) (string, error) {
+	return signJWT(userID, extra, expiration, cache)
+}
+
+func signJWT(userID uuid.UUID, extra map[string]interface{}, expiration time.Duration, cache *redis.Client) (string, error) {
 	keyMutex.RLock()
 	defer keyMutex.RUnlock()
 
@@ -153,14 +193,16 @@ func CreateJWT(userID uuid.UUID, cache *redis.Client) (string, error) {
 		}
 	}
 
-	expiration := time.Second * time.Duration(config.TokenExpiry)
-
-	claims := jwt.MapClaims{
-		"iss": "github.com/shammianand/go-auth",
-		"sub": userID.String(),
-		"exp": time.Now().Add(expiration).Unix(),
-		"iat": time.Now().Unix(),
+	claims := jwt.MapClaims{}
+	for name, value := range extra {
+		claims[name] = value
 	}
+	now := time.Now()
+	claims["iss"] = "github.com/shammianand/go-auth"
+	claims["sub"] = userID.String()
+	claims["exp"] = now.Add(expiration).Unix()
+	claims["iat"] = now.Unix()
+	claims[IssuedAtMsClaim] = now.UnixMilli()
 
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
 	token.Header["kid"] = latestKey.Kid
@@ -170,17 +212,62 @@ func CreateJWT(userID uuid.UUID, cache *redis.Client) (string, error) {
 		return "", fmt.Errorf("failed to sign token: %v", err)
 	}
 
-	err = cache.Set(
-		context.Background(),
-		fmt.Sprintf("%s%s", tokenPrefix, userID.String()),
-		tokenString,
-		expiration,
-	).Err()
+	return tokenString, nil
+}
+
+// SessionExpiry returns when the user's stored session expires, or nil when
+// they have no session
+func SessionExpiry(ctx context.Context, cache *redis.Client, userID uuid.UUID) (*time.Time, error) {
+	ttl, err := cache.TTL(ctx, fmt.Sprintf("%s%s", tokenPrefix, userID.String())).Result()
 	if err != nil {
-		return "", fmt.Errorf("failed to store token in Redis: %v", err)
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if ttl <= 0 {
+		return nil, nil
 	}
 
-	return tokenString, nil
+	expiresAt := time.Now().Add(ttl)
+	return &expiresAt, nil
+}
+
+// APIKeyRevoker revokes every API key of a user
+type APIKeyRevoker interface {
+	RevokeUserAPIKeys(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// RevokeUserTokens invalidates every token issued to a user so far, and
+// revokes their API keys through apiKeys so a leaked key does not outlive
+// signing out everywhere. The revocation time is kept for as long as those
+// tokens could be valid.
+func RevokeUserTokens(ctx context.Context, cache *redis.Client, apiKeys APIKeyRevoker, userID uuid.UUID) error {
+	expiration := time.Second * time.Duration(config.TokenExpiry)
+
+	pipe := cache.TxPipeline()
+	pipe.Set(ctx, revokedPrefix+userID.String(), time.Now().UnixMilli(), expiration)
+	pipe.Del(ctx, tokenPrefix+userID.String())
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to revoke tokens: %w", err)
+	}
+
+	if _, err := apiKeys.RevokeUserAPIKeys(ctx, userID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TokenRevoked reports whether a token issued to a user at issuedAtMs (unix
+// milliseconds) was revoked by RevokeUserTokens. Tokens issued in the same
+// millisecond as the revocation count as revoked.
+func TokenRevoked(ctx context.Context, cache *redis.Client, userID uuid.UUID, issuedAtMs int64) (bool, error) {
+	revokedAt, err := cache.Get(ctx, revokedPrefix+userID.String()).Int64()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check token revocation: %w", err)
+	}
+
+	return issuedAtMs <= revokedAt, nil
 }
 
 func RefreshToken(cache *redis.Client) http.HandlerFunc {
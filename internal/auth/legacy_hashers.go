@@ -0,0 +1,313 @@
+package auth
+
+import (
+	"crypto/md5"
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Legacy verifiers accept hashes imported from other systems. They can only
+// verify passwords; a successful signin rehashes with the current hasher.
+func init() {
+	RegisterVerifier(&PBKDF2SHA256Verifier{}, "pbkdf2_sha256")
+	RegisterVerifier(&ScryptVerifier{}, "scrypt")
+	RegisterVerifier(&MD5CryptVerifier{}, "1")
+	RegisterVerifier(&DjangoBcryptVerifier{}, "bcrypt")
+	RegisterVerifier(&DjangoBcryptVerifier{sha256: true}, "bcrypt_sha256")
+}
+
+// Limits on the parameters of imported hashes, so a malformed or hostile
+// hash cannot stall or exhaust the server during verification
+const (
+	maxPBKDF2Iterations = 10_000_000
+	maxScryptN          = 1 << 20
+	maxScryptR          = 32
+	maxScryptP          = 16
+	maxScryptMemory     = 256 << 20 // Bytes, 128 * N * r
+	minLegacyKeyLength  = 16
+	maxLegacyKeyLength  = 128
+)
+
+// PBKDF2SHA256Verifier verifies Django hashes: pbkdf2_sha256$<iterations>$<salt>$<base64 hash>
+type PBKDF2SHA256Verifier struct{}
+
+// Algorithm returns the Django algorithm name
+func (v *PBKDF2SHA256Verifier) Algorithm() string {
+	return "pbkdf2_sha256"
+}
+
+// Verify reports whether a password matches a pbkdf2_sha256 hash
+func (v *PBKDF2SHA256Verifier) Verify(encoded string, password []byte) (bool, error) {
+	iterations, salt, expected, err := decodePBKDF2SHA256(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	computed, err := pbkdf2.Key(sha256.New, string(password), salt, iterations, len(expected))
+	if err != nil {
+		return false, fmt.Errorf("failed to compute pbkdf2_sha256 hash: %w", err)
+	}
+
+	return subtle.ConstantTimeCompare(expected, computed) == 1, nil
+}
+
+// Check returns an error when a pbkdf2_sha256 hash cannot be verified
+func (v *PBKDF2SHA256Verifier) Check(encoded string) error {
+	_, _, _, err := decodePBKDF2SHA256(encoded)
+	return err
+}
+
+// decodePBKDF2SHA256 parses a pbkdf2_sha256 hash into its iterations, salt
+// and derived key, rejecting parameters outside the supported limits
+func decodePBKDF2SHA256(encoded string) (int, []byte, []byte, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 4 {
+		return 0, nil, nil, fmt.Errorf("invalid pbkdf2_sha256 hash format")
+	}
+
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations < 1 || iterations > maxPBKDF2Iterations {
+		return 0, nil, nil, fmt.Errorf("invalid pbkdf2_sha256 iterations")
+	}
+
+	expected, err := base64.StdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("invalid pbkdf2_sha256 hash: %w", err)
+	}
+	if len(expected) < minLegacyKeyLength || len(expected) > maxLegacyKeyLength {
+		return 0, nil, nil, fmt.Errorf("invalid pbkdf2_sha256 hash length: %d", len(expected))
+	}
+
+	return iterations, []byte(parts[2]), expected, nil
+}
+
+// ScryptVerifier verifies Django hashes: scrypt$<n>$<salt>$<r>$<p>$<base64 hash>
+type ScryptVerifier struct{}
+
+// Algorithm returns the Django algorithm name
+func (v *ScryptVerifier) Algorithm() string {
+	return "scrypt"
+}
+
+// Verify reports whether a password matches a scrypt hash
+func (v *ScryptVerifier) Verify(encoded string, password []byte) (bool, error) {
+	params, err := decodeScrypt(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	computed, err := scrypt.Key(password, params.salt, params.n, params.r, params.p, len(params.key))
+	if err != nil {
+		return false, fmt.Errorf("failed to compute scrypt hash: %w", err)
+	}
+
+	return subtle.ConstantTimeCompare(params.key, computed) == 1, nil
+}
+
+// Check returns an error when a scrypt hash cannot be verified
+func (v *ScryptVerifier) Check(encoded string) error {
+	_, err := decodeScrypt(encoded)
+	return err
+}
+
+// scryptHash holds the parts of a decoded scrypt hash
+type scryptHash struct {
+	n, r, p int
+	salt    []byte
+	key     []byte
+}
+
+// decodeScrypt parses a scrypt hash, rejecting parameters outside the
+// supported limits. N must be a power of two greater than one.
+func decodeScrypt(encoded string) (scryptHash, error) {
+	var h scryptHash
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return h, fmt.Errorf("invalid scrypt hash format")
+	}
+
+	var errN, errR, errP error
+	h.n, errN = strconv.Atoi(parts[1])
+	h.r, errR = strconv.Atoi(parts[3])
+	h.p, errP = strconv.Atoi(parts[4])
+	if errN != nil || errR != nil || errP != nil {
+		return h, fmt.Errorf("invalid scrypt parameters")
+	}
+
+	if h.n < 2 || h.n > maxScryptN || h.n&(h.n-1) != 0 ||
+		h.r < 1 || h.r > maxScryptR ||
+		h.p < 1 || h.p > maxScryptP ||
+		128*h.n*h.r > maxScryptMemory {
+		return h, fmt.Errorf("scrypt parameters out of range: n=%d, r=%d, p=%d", h.n, h.r, h.p)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return h, fmt.Errorf("invalid scrypt hash: %w", err)
+	}
+	if len(key) < minLegacyKeyLength || len(key) > maxLegacyKeyLength {
+		return h, fmt.Errorf("invalid scrypt hash length: %d", len(key))
+	}
+
+	h.salt = []byte(parts[2])
+	h.key = key
+	return h, nil
+}
+
+// DjangoBcryptVerifier verifies Django's bcrypt wrappers: bcrypt$<bcrypt hash>
+// and bcrypt_sha256$<bcrypt hash>, where the latter pre-hashes the password
+// with hex encoded SHA-256.
+type DjangoBcryptVerifier struct {
+	sha256 bool
+}
+
+// Algorithm returns the Django algorithm name
+func (v *DjangoBcryptVerifier) Algorithm() string {
+	if v.sha256 {
+		return "bcrypt_sha256"
+	}
+	return "bcrypt"
+}
+
+// Verify reports whether a password matches a wrapped bcrypt hash
+func (v *DjangoBcryptVerifier) Verify(encoded string, password []byte) (bool, error) {
+	_, inner, found := strings.Cut(encoded, "$")
+	if !found {
+		return false, fmt.Errorf("invalid %s hash format", v.Algorithm())
+	}
+
+	if v.sha256 {
+		sum := sha256.Sum256(password)
+		password = []byte(hex.EncodeToString(sum[:]))
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(inner), password)
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Check returns an error when a wrapped bcrypt hash is malformed
+func (v *DjangoBcryptVerifier) Check(encoded string) error {
+	_, inner, found := strings.Cut(encoded, "$")
+	if !found {
+		return fmt.Errorf("invalid %s hash format", v.Algorithm())
+	}
+	_, err := bcrypt.Cost([]byte(inner))
+	return err
+}
+
+// MD5CryptVerifier verifies FreeBSD/PHP md5-crypt hashes: $1$<salt>$<hash>
+type MD5CryptVerifier struct{}
+
+// Algorithm returns the modular crypt identifier
+func (v *MD5CryptVerifier) Algorithm() string {
+	return "1"
+}
+
+// Verify reports whether a password matches an md5-crypt hash
+func (v *MD5CryptVerifier) Verify(encoded string, password []byte) (bool, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 4 || parts[1] != "1" {
+		return false, fmt.Errorf("invalid md5-crypt hash format")
+	}
+
+	computed := md5Crypt(password, []byte(parts[2]))
+	return subtle.ConstantTimeCompare([]byte(encoded), []byte(computed)) == 1, nil
+}
+
+// Check returns an error when an md5-crypt hash is malformed
+func (v *MD5CryptVerifier) Check(encoded string) error {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 4 || parts[1] != "1" || len(parts[3]) != 22 {
+		return fmt.Errorf("invalid md5-crypt hash format")
+	}
+	return nil
+}
+
+const cryptAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// md5Crypt implements the FreeBSD MD5 based crypt(3) algorithm
+func md5Crypt(password, salt []byte) string {
+	const magic = "$1$"
+	if len(salt) > 8 {
+		salt = salt[:8]
+	}
+
+	alt := md5.New()
+	alt.Write(password)
+	alt.Write(salt)
+	alt.Write(password)
+	altSum := alt.Sum(nil)
+
+	ctx := md5.New()
+	ctx.Write(password)
+	ctx.Write([]byte(magic))
+	ctx.Write(salt)
+	for i := len(password); i > 0; i -= 16 {
+		ctx.Write(altSum[:min(i, 16)])
+	}
+	for i := len(password); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			ctx.Write([]byte{0})
+		} else {
+			ctx.Write(password[:1])
+		}
+	}
+	final := ctx.Sum(nil)
+
+	for i := 0; i < 1000; i++ {
+		round := md5.New()
+		if i&1 != 0 {
+			round.Write(password)
+		} else {
+			round.Write(final)
+		}
+		if i%3 != 0 {
+			round.Write(salt)
+		}
+		if i%7 != 0 {
+			round.Write(password)
+		}
+		if i&1 != 0 {
+			round.Write(final)
+		} else {
+			round.Write(password)
+		}
+		final = round.Sum(nil)
+	}
+
+	var out strings.Builder
+	out.WriteString(magic)
+	out.Write(salt)
+	out.WriteByte('$')
+
+	encode := func(v uint32, n int) {
+		for ; n > 0; n-- {
+			out.WriteByte(cryptAlphabet[v&0x3f])
+			v >>= 6
+		}
+	}
+	encode(uint32(final[0])<<16|uint32(final[6])<<8|uint32(final[12]), 4)
+	encode(uint32(final[1])<<16|uint32(final[7])<<8|uint32(final[13]), 4)
+	encode(uint32(final[2])<<16|uint32(final[8])<<8|uint32(final[14]), 4)
+	encode(uint32(final[3])<<16|uint32(final[9])<<8|uint32(final[15]), 4)
+	encode(uint32(final[4])<<16|uint32(final[10])<<8|uint32(final[5]), 4)
+	encode(uint32(final[11]), 2)
+
+	return out.String()
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+// Known-answer vectors produced outside this package: pbkdf2_sha256 with
+// Python's hashlib, scrypt from RFC 7914, md5-crypt with `openssl passwd -1`
+// and bcrypt from the OpenBSD test suite.
+var legacyHashVectors = []struct {
+	name     string
+	encoded  string
+	password string
+}{
+	{"pbkdf2_sha256", "pbkdf2_sha256$1000$seasalt$YIWkt6M1JFXrHg5s0jZjBSc7C2Cz6QvchSJ0h8Y+i7c=", "password"},
+	{"scrypt", "scrypt$1024$NaCl$8$16$/bq+HJ00cgB4VucZDQHp/nxq18vII3gw53N2Y0s3MWIurzDZLiKjiG/xCSedmDDaxyevuUqD7m2DYMvfoswGQA==", "password"},
+	{"md5-crypt", "$1$saltsalt$qjXMvbEw8oaL.CzflDtaK/", "password"},
+	{"md5-crypt empty password", "$1$abc$Or2rbeUYTvt12aiVzMuS/.", ""},
+	{"md5-crypt password over 16 bytes", "$1$12345678$xp5UWmFrVKuEfrqQeMZhk1", "a much longer password that exceeds sixteen bytes"},
+	{"django bcrypt", "bcrypt$$2a$05$CCCCCCCCCCCCCCCCCCCCC.E5YPO9kmyuRGyh0XouQYb4YMJKvyOeW", "U*U"},
+	{"django bcrypt_sha256", "bcrypt_sha256$$2a$04$Ght6Hc3ydOTNZpkTWEaNy.t9yBAVInFMpyKYnWk6y1TwHOO.GkRMu", "password"},
+}
+
+func TestLegacyHashVectors(t *testing.T) {
+	for _, tt := range legacyHashVectors {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckHash(tt.encoded); err != nil {
+				t.Fatalf("CheckHash returned error: %v", err)
+			}
+			if !ComparePasswords(tt.encoded, []byte(tt.password)) {
+				t.Error("ComparePasswords(correct password) = false, want true")
+			}
+			if ComparePasswords(tt.encoded, []byte(tt.password+"x")) {
+				t.Error("ComparePasswords(wrong password) = true, want false")
+			}
+			if !PasswordNeedsRehash(tt.encoded) {
+				t.Error("PasswordNeedsRehash = false for a legacy hash")
+			}
+		})
+	}
+}
+
+func TestLegacyHashBounds(t *testing.T) {
+	const key = "YIWkt6M1JFXrHg5s0jZjBSc7C2Cz6QvchSJ0h8Y+i7c=" // 32 bytes
+	const shortKey = "AAAAAAAAAAA="                            // 8 bytes
+	longKey := strings.Repeat("AAAA", 43)                      // 129 bytes
+
+	tests := []struct {
+		name    string
+		encoded string
+		valid   bool
+	}{
+		{"pbkdf2 minimum iterations", "pbkdf2_sha256$1$salt$" + key, true},
+		{"pbkdf2 maximum iterations", "pbkdf2_sha256$10000000$salt$" + key, true},
+		{"pbkdf2 zero iterations", "pbkdf2_sha256$0$salt$" + key, false},
+		{"pbkdf2 too many iterations", "pbkdf2_sha256$10000001$salt$" + key, false},
+		{"pbkdf2 non-numeric iterations", "pbkdf2_sha256$many$salt$" + key, false},
+		{"pbkdf2 key too short", "pbkdf2_sha256$1000$salt$" + shortKey, false},
+		{"pbkdf2 key too long", "pbkdf2_sha256$1000$salt$" + longKey, false},
+		{"pbkdf2 invalid base64", "pbkdf2_sha256$1000$salt$not-base64!", false},
+		{"pbkdf2 missing part", "pbkdf2_sha256$1000$" + key, false},
+
+		{"scrypt minimum parameters", "scrypt$2$salt$1$1$" + key, true},
+		{"scrypt largest N within memory limit", "scrypt$1048576$salt$2$16$" + key, true},
+		{"scrypt N of one", "scrypt$1$salt$8$1$" + key, false},
+		{"scrypt N not a power of two", "scrypt$1000$salt$8$1$" + key, false},
+		{"scrypt N too large", "scrypt$2097152$salt$1$1$" + key, false},
+		{"scrypt memory over limit", "scrypt$1048576$salt$8$1$" + key, false},
+		{"scrypt zero r", "scrypt$16384$salt$0$1$" + key, false},
+		{"scrypt r too large", "scrypt$1024$salt$33$1$" + key, false},
+		{"scrypt zero p", "scrypt$16384$salt$8$0$" + key, false},
+		{"scrypt p too large", "scrypt$16384$salt$8$17$" + key, false},
+		{"scrypt negative N", "scrypt$-16384$salt$8$1$" + key, false},
+		{"scrypt key too short", "scrypt$16384$salt$8$1$" + shortKey, false},
+		{"scrypt missing part", "scrypt$16384$salt$8$" + key, false},
+
+		{"md5-crypt hash too short", "$1$saltsalt$qjXMvbEw8oaL", false},
+		{"md5-crypt missing hash", "$1$saltsalt", false},
+
+		{"django bcrypt invalid inner hash", "bcrypt$notahash", false},
+		{"django bcrypt missing inner hash", "bcrypt", false},
+		{"django bcrypt_sha256 invalid inner hash", "bcrypt_sha256$$2a$04$short", false},
+
+		{"unknown algorithm", "sha1$salt$abc", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckHash(tt.encoded)
+			if (err == nil) != tt.valid {
+				t.Errorf("CheckHash(%q) error = %v, want valid %v", tt.encoded, err, tt.valid)
+			}
+			if !tt.valid && ComparePasswords(tt.encoded, []byte("password")) {
+				t.Errorf("ComparePasswords(%q) = true for a rejected hash", tt.encoded)
+			}
+		})
+	}
+}
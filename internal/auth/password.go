@@ -1,16 +1,142 @@
 package auth
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"fmt"
+	"strings"
+	"sync"
 
+	"github.com/shammianand/go-auth/internal/config"
+)
+
+// PasswordVerifier checks passwords against self-describing encoded hashes
+type PasswordVerifier interface {
+	// Algorithm returns the identifier stored in encoded hashes (e.g., argon2id)
+	Algorithm() string
+
+	// Verify reports whether a password matches an encoded hash
+	Verify(encoded string, password []byte) (bool, error)
+
+	// Check returns an error when an encoded hash is malformed or its cost
+	// parameters are outside the supported limits
+	Check(encoded string) error
+}
+
+// PasswordHasher produces and verifies self-describing password hashes.
+// Encoded hashes use the PHC string format ($<id>$<params>$<salt>$<hash>)
+// so the algorithm and parameters can be recovered from the stored value.
+type PasswordHasher interface {
+	PasswordVerifier
+
+	// Hash returns the encoded hash of a password
+	Hash(password string) (string, error)
+
+	// NeedsRehash reports whether an encoded hash uses outdated parameters
+	NeedsRehash(encoded string) bool
+}
+
+var (
+	verifiersMutex sync.RWMutex
+	verifiers      = map[string]PasswordVerifier{}
+
+	currentHasherOnce sync.Once
+	currentHasher     PasswordHasher
+)
+
+func init() {
+	RegisterVerifier(NewArgon2idHasher(DefaultArgon2Params()), "argon2id")
+	RegisterVerifier(NewBcryptHasher(config.BcryptCost), "2a", "2b", "2y")
+}
+
+// RegisterVerifier makes a verifier available for encoded hashes with the given identifiers
+func RegisterVerifier(verifier PasswordVerifier, ids ...string) {
+	verifiersMutex.Lock()
+	defer verifiersMutex.Unlock()
+
+	for _, id := range ids {
+		verifiers[id] = verifier
+	}
+}
+
+// CurrentHasher returns the hasher configured for new passwords (PASSWORD_HASH_ALGORITHM)
+func CurrentHasher() PasswordHasher {
+	currentHasherOnce.Do(func() {
+		switch config.PasswordHashAlgorithm {
+		case "bcrypt":
+			currentHasher = NewBcryptHasher(config.BcryptCost)
+		default:
+			currentHasher = NewArgon2idHasher(DefaultArgon2Params())
+		}
+	})
+	return currentHasher
+}
+
+// HashPasswords hashes a password with the current hasher
 func HashPasswords(password string) (string, error) {
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return CurrentHasher().Hash(password)
+}
+
+// ComparePasswords verifies a password against an encoded hash of any registered algorithm
+func ComparePasswords(hashed string, plain []byte) bool {
+	verifier, err := verifierFor(hashed)
 	if err != nil {
-		return "", err
+		return false
 	}
-	return string(hash), nil
+
+	ok, err := verifier.Verify(hashed, plain)
+	return err == nil && ok
 }
 
-func ComparePasswords(hashed string, plain []byte) bool {
-	err := bcrypt.CompareHashAndPassword([]byte(hashed), plain)
-	return err == nil
+// IsSupportedHash reports whether an encoded hash can be verified by a registered algorithm
+func IsSupportedHash(hashed string) bool {
+	return CheckHash(hashed) == nil
+}
+
+// CheckHash returns an error when an encoded hash uses an unregistered
+// algorithm, is malformed or has parameters outside the supported limits
+func CheckHash(hashed string) error {
+	verifier, err := verifierFor(hashed)
+	if err != nil {
+		return err
+	}
+	return verifier.Check(hashed)
+}
+
+// HashAlgorithm returns the algorithm identifier of an encoded hash
+func HashAlgorithm(hashed string) string {
+	return hashID(hashed)
+}
+
+// PasswordNeedsRehash reports whether an encoded hash should be replaced with
+// one from the current hasher, either because the algorithm changed or
+// because its parameters are outdated.
+func PasswordNeedsRehash(hashed string) bool {
+	current := CurrentHasher()
+	if hashID(hashed) != current.Algorithm() {
+		return true
+	}
+	return current.NeedsRehash(hashed)
+}
+
+// verifierFor looks up the registered verifier for an encoded hash
+func verifierFor(encoded string) (PasswordVerifier, error) {
+	id := hashID(encoded)
+
+	verifiersMutex.RLock()
+	defer verifiersMutex.RUnlock()
+
+	verifier, ok := verifiers[id]
+	if !ok {
+		return nil, fmt.Errorf("unsupported password hash algorithm: %q", id)
+	}
+	return verifier, nil
+}
+
+// hashID extracts the algorithm identifier from an encoded hash. PHC and
+// modular crypt hashes start with $<id>$, other formats use <id>$.
+func hashID(encoded string) string {
+	if strings.HasPrefix(encoded, "$") {
+		encoded = encoded[1:]
+	}
+	id, _, _ := strings.Cut(encoded, "$")
+	return id
 }
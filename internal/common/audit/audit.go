@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+)
+
+// Entry is one audit log record
+type Entry struct {
+	ActorID      *uuid.UUID // Nil for actions taken by the server or the CLI
+	OrgID        *uuid.UUID // Set for actions taken within an organization
+	ActionType   string
+	ResourceType string
+	ResourceID   string
+	Metadata     map[string]interface{}
+	Changes      map[string]interface{} // Before/after values, if any
+}
+
+// Writer records audit log entries. Failures are logged and never fail the
+// operation being audited.
+type Writer struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+// NewWriter creates a new audit log writer
+func NewWriter(client *ent.Client, logger *slog.Logger) *Writer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &Writer{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Write records an entry
+func (w *Writer) Write(ctx context.Context, entry Entry) {
+	create := w.client.AuditLogs.Create().
+		SetNillableActorID(entry.ActorID).
+		SetNillableOrgID(entry.OrgID).
+		SetActionType(entry.ActionType).
+		SetResourceType(entry.ResourceType).
+		SetNillableResourceID(&entry.ResourceID).
+		SetMetadata(entry.Metadata)
+	if entry.Changes != nil {
+		create = create.SetChanges(entry.Changes)
+	}
+
+	if _, err := create.Save(ctx); err != nil {
+		w.logger.Error("Failed to create audit log",
+			"actor_id", entry.ActorID,
+			"action", entry.ActionType,
+			"error", err,
+		)
+	}
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import "testing"
+
+func TestScopeAllows(t *testing.T) {
+	tests := []struct {
+		name       string
+		scopes     []string
+		permission string
+		want       bool
+	}{
+		{"exact scope", []string{"users.read"}, "users.read", true},
+		{"other scope", []string{"users.read"}, "users.write", false},
+		{"no scopes", nil, "users.read", false},
+		{"any of several scopes", []string{"orgs.read", "users.read"}, "users.read", true},
+		{"global wildcard", []string{"*"}, "rbac.roles.write", true},
+		{"prefix wildcard", []string{"users.*"}, "users.read", true},
+		{"prefix wildcard nested", []string{"users.*"}, "users.write.self", true},
+		{"prefix wildcard covers the prefix itself", []string{"users.*"}, "users", true},
+		{"prefix wildcard stops at the segment boundary", []string{"users.*"}, "usersettings.read", false},
+		{"prefix wildcard does not match parents", []string{"users.write.*"}, "users.read", false},
+		{"wildcard is only a suffix", []string{"*.read"}, "users.read", false},
+		{"more specific scope does not cover broader permission", []string{"users.write.self"}, "users.write", false},
+		{"scopes are case sensitive", []string{"Users.read"}, "users.read", false},
+		{"empty scope", []string{""}, "users.read", false},
+		{"empty wildcard prefix", []string{".*"}, "users.read", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scopeAllows(tt.scopes, tt.permission); got != tt.want {
+				t.Errorf("scopeAllows(%v, %q) = %v, want %v", tt.scopes, tt.permission, got, tt.want)
+			}
+		})
+	}
+}
@@ -16,8 +16,12 @@ import (
 
 const UserIDKey = "user_id"
 
-// RequireAuth middleware validates JWT tokens and sets user_id in context
-func RequireAuth(cache *redis.Client) gin.HandlerFunc {
+// OrgIDKey holds the organization the request's token is scoped to
+const OrgIDKey = "org_id"
+
+// RequireAuth middleware validates JWT tokens, or API keys checked by keys,
+// and sets user_id in context. API keys are rejected when keys is nil.
+func RequireAuth(cache *redis.Client, keys APIKeyVerifier) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -36,22 +40,17 @@ func RequireAuth(cache *redis.Client) gin.HandlerFunc {
 
 		tokenString := parts[1]
 
-		// Parse and validate JWT
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Verify signing method
-			if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		if strings.HasPrefix(tokenString, APIKeyPrefix) {
+			if !authenticateAPIKey(c, keys, tokenString) {
+				c.Abort()
+				return
 			}
+			c.Next()
+			return
+		}
 
-			// Get public key from cache
-			kid, ok := token.Header["kid"].(string)
-			if !ok {
-				return nil, fmt.Errorf("missing kid in token header")
-			}
-
-			return auth.GetPublicKeyFromCache(cache, kid)
-		})
-
+		// Parse and validate JWT
+		token, err := parseToken(cache, tokenString)
 		if err != nil || !token.Valid {
 			utils.RespondError(c, types.HTTP.Unauthorized, "Invalid token", "INVALID_TOKEN", err.Error())
 			c.Abort()
@@ -82,12 +81,149 @@ func RequireAuth(cache *redis.Client) gin.HandlerFunc {
 			return
 		}
 
+		if err := checkRevoked(c.Request.Context(), cache, userID, claims); err != nil {
+			utils.RespondError(c, types.HTTP.Unauthorized, "Invalid token", "TOKEN_REVOKED", err.Error())
+			c.Abort()
+			return
+		}
+
+		orgID, err := orgFromClaims(claims)
+		if err != nil {
+			utils.RespondError(c, types.HTTP.Unauthorized, "Invalid organization in token", "INVALID_CLAIMS", err.Error())
+			c.Abort()
+			return
+		}
+
+		actorID, sessionID, err := actorFromClaims(claims)
+		if err != nil {
+			utils.RespondError(c, types.HTTP.Unauthorized, "Invalid impersonation claims", "INVALID_CLAIMS", err.Error())
+			c.Abort()
+			return
+		}
+
+		// Impersonation tokens die with the admin's tokens as well
+		if actorID != nil {
+			if err := checkRevoked(c.Request.Context(), cache, *actorID, claims); err != nil {
+				utils.RespondError(c, types.HTTP.Unauthorized, "Invalid token", "TOKEN_REVOKED", err.Error())
+				c.Abort()
+				return
+			}
+		}
+
 		// Set user ID in context
 		c.Set(UserIDKey, userID)
+		if orgID != nil {
+			c.Set(OrgIDKey, *orgID)
+		}
+		if actorID != nil {
+			c.Set(ImpersonatorIDKey, *actorID)
+			c.Set(ImpersonationIDKey, sessionID)
+		}
 		c.Next()
 	}
 }
 
+// SubjectFromToken validates an access token and returns the user ID in its
+// subject claim and the organization it is scoped to, if any. It lets
+// services pass on the token of the user they act for.
+func SubjectFromToken(cache *redis.Client, tokenString string) (uuid.UUID, *uuid.UUID, error) {
+	token, err := parseToken(cache, tokenString)
+	if err != nil {
+		return uuid.UUID{}, nil, err
+	}
+	if !token.Valid {
+		return uuid.UUID{}, nil, fmt.Errorf("token is not valid")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return uuid.UUID{}, nil, fmt.Errorf("could not parse token claims")
+	}
+
+	sub, ok := claims["sub"].(string)
+	if !ok {
+		return uuid.UUID{}, nil, fmt.Errorf("token subject is missing or invalid")
+	}
+
+	userID, err := uuid.Parse(sub)
+	if err != nil {
+		return uuid.UUID{}, nil, err
+	}
+
+	if err := checkRevoked(context.Background(), cache, userID, claims); err != nil {
+		return uuid.UUID{}, nil, err
+	}
+
+	orgID, err := orgFromClaims(claims)
+	if err != nil {
+		return uuid.UUID{}, nil, err
+	}
+
+	return userID, orgID, nil
+}
+
+// checkRevoked returns an error when the token was issued before the
+// user's tokens were revoked, e.g. by a forced logout. Tokens without the
+// millisecond issue time count as issued at the start of their iat second.
+func checkRevoked(ctx context.Context, cache *redis.Client, userID uuid.UUID, claims jwt.MapClaims) error {
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		return fmt.Errorf("token issue time is missing or invalid")
+	}
+
+	issuedAtMs := int64(iat) * 1000
+	if ms, ok := claims[auth.IssuedAtMsClaim].(float64); ok {
+		issuedAtMs = int64(ms)
+	}
+
+	revoked, err := auth.TokenRevoked(ctx, cache, userID, issuedAtMs)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return fmt.Errorf("token has been revoked")
+	}
+	return nil
+}
+
+// orgFromClaims returns the organization in the org_id claim, or nil when
+// the token is not scoped to one
+func orgFromClaims(claims jwt.MapClaims) (*uuid.UUID, error) {
+	value, ok := claims["org_id"]
+	if !ok {
+		return nil, nil
+	}
+
+	raw, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("org_id claim must be a string")
+	}
+
+	orgID, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid org_id claim: %w", err)
+	}
+	return &orgID, nil
+}
+
+// parseToken parses a JWT and verifies its signature against the JWKS keys
+func parseToken(cache *redis.Client, tokenString string) (*jwt.Token, error) {
+	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		// Verify signing method
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		// Get public key from cache
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, fmt.Errorf("missing kid in token header")
+		}
+
+		return auth.GetPublicKeyFromCache(cache, kid)
+	})
+}
+
 // GetUserID retrieves the authenticated user ID from the context
 func GetUserID(c *gin.Context) (uuid.UUID, error) {
 	userID, exists := c.Get(UserIDKey)
@@ -103,6 +239,18 @@ func GetUserID(c *gin.Context) (uuid.UUID, error) {
 	return uid, nil
 }
 
+// GetOrgID retrieves the organization the request's token is scoped to. It
+// returns false for tokens that are not scoped to an organization.
+func GetOrgID(c *gin.Context) (uuid.UUID, bool) {
+	orgID, exists := c.Get(OrgIDKey)
+	if !exists {
+		return uuid.UUID{}, false
+	}
+
+	oid, ok := orgID.(uuid.UUID)
+	return oid, ok
+}
+
 // GetUserIDString retrieves the authenticated user ID as a string
 func GetUserIDString(c *gin.Context) (string, error) {
 	uid, err := GetUserID(c)
@@ -112,9 +260,35 @@ func GetUserIDString(c *gin.Context) (string, error) {
 	return uid.String(), nil
 }
 
-// RequirePermission middleware checks if user has a specific permission
-// This is a placeholder for RBAC integration
-func RequirePermission(cache *redis.Client, permission string) gin.HandlerFunc {
+// PermissionChecker reports whether a user holds a permission
+type PermissionChecker interface {
+	HasPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error)
+}
+
+// RequirePermission middleware checks if user has a specific permission.
+// Must be used after RequireAuth.
+func RequirePermission(checker PermissionChecker, permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := GetUserID(c)
+		if err != nil {
+			utils.RespondError(c, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+			c.Abort()
+			return
+		}
+
+		if !checkPermission(c, checker, userID, permission) {
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequirePermissionOrSelf middleware lets users act on their own resources and
+// requires a permission when the user ID in the given path parameter belongs
+// to someone else. Must be used after RequireAuth.
+func RequirePermissionOrSelf(checker PermissionChecker, param, permission string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userID, err := GetUserID(c)
 		if err != nil {
@@ -123,18 +297,111 @@ func RequirePermission(cache *redis.Client, permission string) gin.HandlerFunc {
 			return
 		}
 
-		// TODO: Implement permission check from cache/database
-		// For now, just check if user exists
-		ctx := context.Background()
-		key := fmt.Sprintf("user:permissions:%s", userID.String())
+		if c.Param(param) == userID.String() {
+			c.Next()
+			return
+		}
 
-		// Check if user permissions are cached
-		exists, err := cache.Exists(ctx, key).Result()
-		if err != nil || exists == 0 {
-			// Permissions not cached - would fetch from DB and cache
-			// For now, allow all authenticated users
+		if !checkPermission(c, checker, userID, permission) {
+			c.Abort()
+			return
 		}
 
 		c.Next()
 	}
 }
+
+// OrgPermissionChecker reports whether a user holds a permission within an
+// organization, counting the roles assigned to them in it
+type OrgPermissionChecker interface {
+	PermissionChecker
+	HasOrgPermission(ctx context.Context, orgID, userID uuid.UUID, permission string) (bool, error)
+}
+
+// RequireOrgPermission middleware checks a permission for the organization
+// in the given path parameter. Roles assigned in the organization only count
+// when the token is scoped to it; otherwise the user needs the permission
+// outside any organization. Either way impersonated requests and API keys
+// are restricted as in RequirePermission. Must be used after RequireAuth.
+func RequireOrgPermission(checker OrgPermissionChecker, param, permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := GetUserID(c)
+		if err != nil {
+			utils.RespondError(c, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+			c.Abort()
+			return
+		}
+
+		orgID, scoped := GetOrgID(c)
+		if !scoped || c.Param(param) != orgID.String() {
+			if !checkPermission(c, checker, userID, permission) {
+				c.Abort()
+				return
+			}
+			c.Next()
+			return
+		}
+
+		if !checkPermission(c, orgScopedChecker{checker: checker, orgID: orgID}, userID, permission) {
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// orgScopedChecker checks permissions within one organization, so
+// organization routes get the same impersonation and API key restrictions
+// as checkPermission applies everywhere else
+type orgScopedChecker struct {
+	checker OrgPermissionChecker
+	orgID   uuid.UUID
+}
+
+func (o orgScopedChecker) HasPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error) {
+	return o.checker.HasOrgPermission(ctx, o.orgID, userID, permission)
+}
+
+// HasImpersonatedPermission denies when the checker cannot tell protected
+// roles apart within an organization
+func (o orgScopedChecker) HasImpersonatedPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error) {
+	restricted, ok := o.checker.(OrgImpersonationPermissionChecker)
+	if !ok {
+		return false, nil
+	}
+	return restricted.HasImpersonatedOrgPermission(ctx, o.orgID, userID, permission)
+}
+
+// checkPermission evaluates a permission and writes the error response when
+// the user lacks it or the check fails. Impersonated requests are checked
+// without the roles impersonation may not use, and are denied when the
+// checker cannot tell those roles apart.
+func checkPermission(c *gin.Context, checker PermissionChecker, userID uuid.UUID, permission string) bool {
+	var allowed bool
+	var err error
+	if _, impersonated := GetImpersonator(c); impersonated {
+		if restricted, ok := checker.(ImpersonationPermissionChecker); ok {
+			allowed, err = restricted.HasImpersonatedPermission(c.Request.Context(), userID, permission)
+		}
+	} else {
+		allowed, err = checker.HasPermission(c.Request.Context(), userID, permission)
+	}
+	if err != nil {
+		utils.RespondError(c, types.HTTP.InternalServerError, "Failed to check permissions", "PERMISSION_CHECK_FAILED", err.Error())
+		return false
+	}
+
+	// API keys are limited to their scopes on top of the user's permissions
+	if scopes, ok := GetAPIKeyScopes(c); ok && !scopeAllows(scopes, permission) {
+		utils.RespondError(c, types.HTTP.Forbidden, "Permission denied", "FORBIDDEN", fmt.Sprintf("API key is not scoped for: %s", permission))
+		return false
+	}
+
+	if !allowed {
+		utils.RespondError(c, types.HTTP.Forbidden, "Permission denied", "FORBIDDEN", fmt.Sprintf("missing required permission: %s", permission))
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// fakeOrgChecker grants the permissions in its maps; impersonated lists
+// what an impersonated session may use within the organization
+type fakeOrgChecker struct {
+	global       map[string]bool
+	org          map[string]bool
+	impersonated map[string]bool
+}
+
+func (f fakeOrgChecker) HasPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error) {
+	return f.global[permission], nil
+}
+
+func (f fakeOrgChecker) HasOrgPermission(ctx context.Context, orgID, userID uuid.UUID, permission string) (bool, error) {
+	return f.org[permission], nil
+}
+
+func (f fakeOrgChecker) HasImpersonatedOrgPermission(ctx context.Context, orgID, userID uuid.UUID, permission string) (bool, error) {
+	return f.impersonated[permission], nil
+}
+
+func TestRequireOrgPermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	orgID, otherOrgID := uuid.New(), uuid.New()
+	checker := fakeOrgChecker{
+		global:       map[string]bool{},
+		org:          map[string]bool{"orgs.members.write": true},
+		impersonated: map[string]bool{},
+	}
+
+	tests := []struct {
+		name     string
+		tokenOrg *uuid.UUID
+		scopes   []string
+		actor    bool
+		want     int
+	}{
+		{"scoped token with org role", &orgID, nil, false, http.StatusOK},
+		{"token scoped to another organization", &otherOrgID, nil, false, http.StatusForbidden},
+		{"unscoped token without global permission", nil, nil, false, http.StatusForbidden},
+		{"API key scoped for the permission", &orgID, []string{"orgs.*"}, false, http.StatusOK},
+		{"API key without the scope", &orgID, []string{"users.read"}, false, http.StatusForbidden},
+		{"impersonation without protected roles", &orgID, nil, true, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/orgs/:id/members", func(c *gin.Context) {
+				c.Set(UserIDKey, uuid.New())
+				if tt.tokenOrg != nil {
+					c.Set(OrgIDKey, *tt.tokenOrg)
+				}
+				if tt.scopes != nil {
+					c.Set(APIKeyScopesKey, tt.scopes)
+				}
+				if tt.actor {
+					c.Set(ImpersonatorIDKey, uuid.New())
+				}
+			}, RequireOrgPermission(checker, "id", "orgs.members.write"), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/orgs/"+orgID.String()+"/members", nil))
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
+
+// fakeAPIKeys accepts a single key
+type fakeAPIKeys struct {
+	key       string
+	principal APIKeyPrincipal
+}
+
+func (f fakeAPIKeys) VerifyAPIKey(ctx context.Context, key, ip string) (*APIKeyPrincipal, error) {
+	if key != f.key {
+		return nil, errors.New("invalid API key")
+	}
+	return &f.principal, nil
+}
+
+func TestRequireAuthAPIKeys(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	keys := fakeAPIKeys{
+		key:       APIKeyPrefix + "0123456789ab_secret",
+		principal: APIKeyPrincipal{KeyID: uuid.New(), UserID: uuid.New(), Scopes: []string{"users.read"}},
+	}
+
+	tests := []struct {
+		name string
+		keys APIKeyVerifier
+		key  string
+		want int
+	}{
+		{"valid key", keys, keys.key, http.StatusOK},
+		{"unknown key", keys, APIKeyPrefix + "0123456789ab_other", http.StatusUnauthorized},
+		{"API keys not accepted", nil, keys.key, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/me", RequireAuth(nil, tt.keys), func(c *gin.Context) {
+				userID, _ := c.Get(UserIDKey)
+				scopes, ok := GetAPIKeyScopes(c)
+				if userID != keys.principal.UserID || !ok || len(scopes) != 1 {
+					t.Errorf("context user = %v, scopes = %v, %v", userID, scopes, ok)
+				}
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.key)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
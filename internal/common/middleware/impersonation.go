@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+)
+
+// ImpersonatorIDKey holds the admin acting through an impersonation token
+const ImpersonatorIDKey = "impersonator_id"
+
+// ImpersonationIDKey holds the impersonation session of the request's token
+const ImpersonationIDKey = "impersonation_id"
+
+// ImpersonationPermissionChecker reports whether an impersonated user holds
+// a permission without counting the roles impersonation may not use
+type ImpersonationPermissionChecker interface {
+	HasImpersonatedPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error)
+}
+
+// OrgImpersonationPermissionChecker is ImpersonationPermissionChecker
+// within an organization
+type OrgImpersonationPermissionChecker interface {
+	HasImpersonatedOrgPermission(ctx context.Context, orgID, userID uuid.UUID, permission string) (bool, error)
+}
+
+// ImpersonatedRequest describes a request made with an impersonation token
+type ImpersonatedRequest struct {
+	ActorID   uuid.UUID
+	UserID    uuid.UUID
+	SessionID string
+	Method    string
+	Path      string
+	Status    int
+	IP        string
+}
+
+// ImpersonationRecorder records requests made with impersonation tokens
+type ImpersonationRecorder interface {
+	RecordImpersonatedRequest(ctx context.Context, req ImpersonatedRequest)
+}
+
+// actorFromClaims returns the admin named in the act claim and the
+// impersonation session in the jti claim, or nil for ordinary tokens
+func actorFromClaims(claims jwt.MapClaims) (*uuid.UUID, string, error) {
+	value, ok := claims["act"]
+	if !ok {
+		return nil, "", nil
+	}
+
+	act, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, "", fmt.Errorf("act claim must be an object")
+	}
+
+	raw, ok := act["sub"].(string)
+	if !ok {
+		return nil, "", fmt.Errorf("act claim is missing its subject")
+	}
+
+	actorID, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid act claim: %w", err)
+	}
+
+	sessionID, _ := claims["jti"].(string)
+	return &actorID, sessionID, nil
+}
+
+// GetImpersonator retrieves the admin impersonating the authenticated user.
+// It returns false for requests that are not impersonated.
+func GetImpersonator(c *gin.Context) (uuid.UUID, bool) {
+	actorID, exists := c.Get(ImpersonatorIDKey)
+	if !exists {
+		return uuid.UUID{}, false
+	}
+
+	aid, ok := actorID.(uuid.UUID)
+	return aid, ok
+}
+
+// DenyImpersonation middleware rejects impersonated requests, for actions
+// only the account owner may take. Must be used after RequireAuth.
+func DenyImpersonation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, impersonated := GetImpersonator(c); impersonated {
+			utils.RespondError(c, types.HTTP.Forbidden, "Not allowed while impersonating", "IMPERSONATION_NOT_ALLOWED", "this action cannot be performed with an impersonation token")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// AuditImpersonation middleware records every impersonated request once it
+// has been handled. It must be installed on the engine so that it wraps
+// every route, and it relies on RequireAuth to mark impersonated requests.
+func AuditImpersonation(recorder ImpersonationRecorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		actorID, impersonated := GetImpersonator(c)
+		if !impersonated {
+			return
+		}
+
+		userID, err := GetUserID(c)
+		if err != nil {
+			return
+		}
+
+		sessionID, _ := c.Get(ImpersonationIDKey)
+		sid, _ := sessionID.(string)
+
+		recorder.RecordImpersonatedRequest(c.Request.Context(), ImpersonatedRequest{
+			ActorID:   actorID,
+			UserID:    userID,
+			SessionID: sid,
+			Method:    c.Request.Method,
+			Path:      c.Request.URL.Path,
+			Status:    c.Writer.Status(),
+			IP:        c.ClientIP(),
+		})
+	}
+}
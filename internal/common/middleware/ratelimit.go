@@ -0,0 +1,168 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+)
+
+const rateLimitPrefix = "ratelimit:"
+
+// maxKeyedBodySize bounds how much of a request body KeyByJSONField reads
+const maxKeyedBodySize = 16 << 10
+
+// RateLimitKeyFunc extracts the identity a request is limited by.
+// Returning an empty string skips the limit for that request.
+type RateLimitKeyFunc func(c *gin.Context) string
+
+// RateLimitPolicy describes how many requests are allowed per window for a route
+type RateLimitPolicy struct {
+	Name   string           // Identifies the policy in Redis keys (e.g., signin:ip)
+	Limit  int              // Requests allowed per window
+	Window time.Duration    // Length of the sliding window
+	Key    RateLimitKeyFunc // Identity the limit applies to
+}
+
+// slidingWindowScript keeps one sorted set entry per request inside the window.
+// It returns {allowed, remaining, reset_ms}.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, 0, now - window)
+local count = redis.call('ZCARD', key)
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', key, window)
+
+local reset = window
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+
+return {allowed, limit - count, reset}
+`)
+
+// RateLimit middleware enforces a sliding-window limit stored in Redis.
+// It sets RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers,
+// plus Retry-After when the limit is exceeded. Redis errors fail open.
+func RateLimit(cache *redis.Client, policy RateLimitPolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		identity := policy.Key(c)
+		if identity == "" {
+			c.Next()
+			return
+		}
+
+		key := rateLimitPrefix + policy.Name + ":" + identity
+		now := time.Now().UnixMilli()
+
+		result, err := slidingWindowScript.Run(c.Request.Context(), cache, []string{key},
+			now,
+			policy.Window.Milliseconds(),
+			policy.Limit,
+			strconv.FormatInt(now, 10)+"-"+uuid.NewString(),
+		).Int64Slice()
+		if err != nil {
+			slog.Default().Error("Rate limit check failed", "policy", policy.Name, "error", err)
+			c.Next()
+			return
+		}
+
+		allowed, remaining := result[0] == 1, result[1]
+		reset := int64(math.Ceil(float64(result[2]) / 1000))
+
+		setRateLimitHeaders(c, policy.Limit, remaining, reset)
+
+		if !allowed {
+			c.Header("Retry-After", strconv.FormatInt(reset, 10))
+			utils.RespondError(c, types.HTTP.TooManyRequests, "Too many requests", "RATE_LIMITED",
+				"Rate limit exceeded, retry after "+strconv.FormatInt(reset, 10)+" seconds")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// setRateLimitHeaders writes the headers for the most restrictive policy seen so far
+func setRateLimitHeaders(c *gin.Context, limit int, remaining, reset int64) {
+	if current := c.Writer.Header().Get("RateLimit-Remaining"); current != "" {
+		if value, err := strconv.ParseInt(current, 10, 64); err == nil && value < remaining {
+			return
+		}
+	}
+
+	c.Header("RateLimit-Limit", strconv.Itoa(limit))
+	c.Header("RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+	c.Header("RateLimit-Reset", strconv.FormatInt(reset, 10))
+}
+
+// KeyByIP limits requests per client IP address
+func KeyByIP(c *gin.Context) string {
+	return c.ClientIP()
+}
+
+// KeyByUserID limits requests per authenticated user. It must run after RequireAuth.
+func KeyByUserID(c *gin.Context) string {
+	userID, err := GetUserIDString(c)
+	if err != nil {
+		return ""
+	}
+	return userID
+}
+
+// KeyByJSONField limits requests per value of a top-level string field in
+// the JSON body (e.g., email). The body is restored for the handler.
+// Requests whose field cannot be read, because the body is missing, invalid,
+// over maxKeyedBodySize or lacks a non-empty string field, are limited per
+// client IP instead so malformed requests cannot skip the limit.
+func KeyByJSONField(field string) RateLimitKeyFunc {
+	return func(c *gin.Context) string {
+		fallback := "ip:" + c.ClientIP()
+		if c.Request.Body == nil {
+			return fallback
+		}
+
+		original := c.Request.Body
+		body, err := io.ReadAll(io.LimitReader(original, maxKeyedBodySize+1))
+		c.Request.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), original), original}
+		if err != nil || len(body) > maxKeyedBodySize {
+			return fallback
+		}
+
+		var payload map[string]interface{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return fallback
+		}
+
+		value, ok := payload[field].(string)
+		value = strings.ToLower(strings.TrimSpace(value))
+		if !ok || value == "" {
+			return fallback
+		}
+		return field + ":" + value
+	}
+}
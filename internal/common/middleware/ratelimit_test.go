@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestKeyByJSONField(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	const ip = "ip:192.0.2.1"
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"string field", `{"email":"user@example.com"}`, "email:user@example.com"},
+		{"normalized", `{"email":"  User@Example.COM "}`, "email:user@example.com"},
+		{"empty body", ``, ip},
+		{"invalid JSON", `{"email":`, ip},
+		{"not an object", `["user@example.com"]`, ip},
+		{"missing field", `{"username":"user"}`, ip},
+		{"non-string field", `{"email":42}`, ip},
+		{"null field", `{"email":null}`, ip},
+		{"blank field", `{"email":"   "}`, ip},
+		{"field that looks like an IP key", `{"email":"ip:192.0.2.1"}`, "email:ip:192.0.2.1"},
+		{"oversized body", `{"email":"user@example.com","pad":"` + strings.Repeat("a", maxKeyedBodySize) + `"}`, ip},
+		{"body at the limit", `{"email":"user@example.com","pad":"` + strings.Repeat("a", maxKeyedBodySize-37) + `"}`, "email:user@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodPost, "/signin", strings.NewReader(tt.body))
+			c.Request.RemoteAddr = "192.0.2.1:1234"
+
+			if got := KeyByJSONField("email")(c); got != tt.want {
+				t.Errorf("key = %q, want %q", got, tt.want)
+			}
+
+			// The handler must still see the full body
+			rest, err := io.ReadAll(c.Request.Body)
+			if err != nil {
+				t.Fatalf("reading restored body: %v", err)
+			}
+			if string(rest) != tt.body {
+				t.Errorf("restored body has %d bytes, want %d", len(rest), len(tt.body))
+			}
+		})
+	}
+}
+
+// TestRateLimit needs a Redis server, e.g. TEST_REDIS_ADDR=localhost:6379
+func TestRateLimit(t *testing.T) {
+	addr := os.Getenv("TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("TEST_REDIS_ADDR not set")
+	}
+
+	gin.SetMode(gin.TestMode)
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	defer client.Close()
+
+	policy := RateLimitPolicy{
+		Name:   "test:" + uuid.NewString(),
+		Limit:  3,
+		Window: time.Minute,
+		Key:    KeyByIP,
+	}
+	router := gin.New()
+	router.GET("/", RateLimit(client, policy), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	request := func(remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	for i := 0; i < policy.Limit; i++ {
+		w := request("192.0.2.1:1234")
+		if w.Code != http.StatusOK {
+			t.Fatalf("request %d status = %d, want %d", i+1, w.Code, http.StatusOK)
+		}
+		if got, want := w.Header().Get("RateLimit-Remaining"), []string{"2", "1", "0"}[i]; got != want {
+			t.Fatalf("request %d RateLimit-Remaining = %q, want %q", i+1, got, want)
+		}
+	}
+
+	w := request("192.0.2.1:1234")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("request over the limit status = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+	if w.Header().Get("Retry-After") == "" {
+		t.Fatal("request over the limit has no Retry-After header")
+	}
+
+	// Limits are tracked per identity
+	if w := request("192.0.2.2:1234"); w.Code != http.StatusOK {
+		t.Fatalf("other client status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
@@ -12,6 +12,7 @@ var HTTP = struct {
 	Forbidden           int
 	NotFound            int
 	Conflict            int
+	TooManyRequests     int
 	InternalServerError int
 }{
 	Ok:                  http.StatusOK,
@@ -22,6 +23,7 @@ var HTTP = struct {
 	Forbidden:           http.StatusForbidden,
 	NotFound:            http.StatusNotFound,
 	Conflict:            http.StatusConflict,
+	TooManyRequests:     http.StatusTooManyRequests,
 	InternalServerError: http.StatusInternalServerError,
 }
 
@@ -15,6 +15,13 @@ type ErrorDetail struct {
 	Details   interface{} `json:"details,omitempty"`  // Additional error context
 }
 
+// FieldError describes a validation failure for a single request field
+type FieldError struct {
+	Field   string `json:"field"`   // Request field that failed validation
+	Code    string `json:"code"`    // Machine-readable violation code
+	Message string `json:"message"` // Human-readable explanation
+}
+
 // SuccessResponse creates a success API response
 func SuccessResponse(message string, data interface{}) ApiResponse {
 	return ApiResponse{
@@ -35,3 +42,10 @@ func ErrorResponse(message string, errorCode string, errorMsg string) ApiRespons
 		},
 	}
 }
+
+// ErrorResponseWithDetails creates an error API response with additional error context
+func ErrorResponseWithDetails(message string, errorCode string, errorMsg string, details interface{}) ApiResponse {
+	response := ErrorResponse(message, errorCode, errorMsg)
+	response.Error.Details = details
+	return response
+}
@@ -20,6 +20,11 @@ func RespondError(c *gin.Context, statusCode int, message string, errorCode stri
 	RespondJSON(c, statusCode, types.ErrorResponse(message, errorCode, errorMsg))
 }
 
+// RespondErrorWithDetails sends an error JSON response with additional error context
+func RespondErrorWithDetails(c *gin.Context, statusCode int, message string, errorCode string, errorMsg string, details interface{}) {
+	RespondJSON(c, statusCode, types.ErrorResponseWithDetails(message, errorCode, errorMsg, details))
+}
+
 // BindJSON binds request JSON to a struct and handles errors
 func BindJSON(c *gin.Context, obj interface{}) error {
 	if err := c.ShouldBindJSON(obj); err != nil {
@@ -1,6 +1,10 @@
 package config
 
-import "os"
+import (
+	"os"
+	"strconv"
+	"time"
+)
 
 var (
 	ENV_DB_USER            = os.Getenv("DB_USER")
@@ -18,3 +22,151 @@ var (
 var (
 	TokenExpiry = 1000000
 )
+
+// Account lockout settings for failed signin attempts
+var (
+	LockoutMaxAttempts   = getEnvInt("LOCKOUT_MAX_ATTEMPTS", 5)
+	LockoutIPMaxAttempts = getEnvInt("LOCKOUT_IP_MAX_ATTEMPTS", 20)
+	LockoutDuration      = getEnvDuration("LOCKOUT_DURATION", 15*time.Minute)
+	LockoutWindow        = getEnvDuration("LOCKOUT_WINDOW", 15*time.Minute)
+	LockoutBaseDelay     = getEnvDuration("LOCKOUT_BASE_DELAY", time.Second)
+	LockoutMaxDelay      = getEnvDuration("LOCKOUT_MAX_DELAY", 30*time.Second)
+)
+
+// Rate limits for auth endpoints (requests per window)
+var (
+	RateLimitSignupPerIP    = getEnvInt("RATE_LIMIT_SIGNUP_PER_IP", 5)
+	RateLimitSignupWindow   = getEnvDuration("RATE_LIMIT_SIGNUP_WINDOW", time.Hour)
+	RateLimitSigninPerIP    = getEnvInt("RATE_LIMIT_SIGNIN_PER_IP", 20)
+	RateLimitSigninPerEmail = getEnvInt("RATE_LIMIT_SIGNIN_PER_EMAIL", 10)
+	RateLimitSigninWindow   = getEnvDuration("RATE_LIMIT_SIGNIN_WINDOW", time.Minute)
+	RateLimitForgotPerIP    = getEnvInt("RATE_LIMIT_FORGOT_PASSWORD_PER_IP", 10)
+	RateLimitForgotPerEmail = getEnvInt("RATE_LIMIT_FORGOT_PASSWORD_PER_EMAIL", 3)
+	RateLimitForgotWindow   = getEnvDuration("RATE_LIMIT_FORGOT_PASSWORD_WINDOW", time.Hour)
+	RateLimitResendPerIP    = getEnvInt("RATE_LIMIT_RESEND_VERIFICATION_PER_IP", 10)
+	RateLimitResendPerEmail = getEnvInt("RATE_LIMIT_RESEND_VERIFICATION_PER_EMAIL", 3)
+	RateLimitResendWindow   = getEnvDuration("RATE_LIMIT_RESEND_VERIFICATION_WINDOW", time.Hour)
+
+	RateLimitEmailChangePerUser = getEnvInt("RATE_LIMIT_EMAIL_CHANGE_PER_USER", 3)
+	RateLimitEmailChangeWindow  = getEnvDuration("RATE_LIMIT_EMAIL_CHANGE_WINDOW", time.Hour)
+)
+
+// Password hashing settings. PASSWORD_HASH_ALGORITHM is argon2id or bcrypt.
+var (
+	PasswordHashAlgorithm = getEnv("PASSWORD_HASH_ALGORITHM", "argon2id")
+	Argon2Memory          = getEnvInt("ARGON2_MEMORY_KIB", 64*1024)
+	Argon2Iterations      = getEnvInt("ARGON2_ITERATIONS", 3)
+	Argon2Parallelism     = getEnvInt("ARGON2_PARALLELISM", 2)
+	BcryptCost            = getEnvInt("BCRYPT_COST", 10)
+)
+
+// Password policy settings. PASSWORD_MIN_STRENGTH is a 0-4 score.
+var (
+	PasswordMinLength      = getEnvInt("PASSWORD_MIN_LENGTH", 8)
+	PasswordMaxLength      = getEnvInt("PASSWORD_MAX_LENGTH", 128)
+	PasswordMinCharClasses = getEnvInt("PASSWORD_MIN_CHAR_CLASSES", 2)
+	PasswordMinStrength    = getEnvInt("PASSWORD_MIN_STRENGTH", 2)
+	PasswordHistorySize    = getEnvInt("PASSWORD_HISTORY_SIZE", 5)
+	BreachedPasswordsFile  = getEnv("BREACHED_PASSWORDS_FILE", "")
+)
+
+// Effective permission cache TTLs. A zero TTL disables that cache layer.
+var (
+	PermissionCacheTTL      = getEnvDuration("PERMISSION_CACHE_TTL", 10*time.Minute)
+	PermissionLocalCacheTTL = getEnvDuration("PERMISSION_LOCAL_CACHE_TTL", 30*time.Second)
+)
+
+// Time-bound role assignments and role requests. BREAK_GLASS_MAX_HOURS
+// applies to roles that do not set their own limit; pending role requests
+// expire after ROLE_REQUEST_TTL.
+var (
+	RoleExpiryCheckInterval = getEnvDuration("ROLE_EXPIRY_CHECK_INTERVAL", time.Minute)
+	BreakGlassMaxHours      = getEnvInt("BREAK_GLASS_MAX_HOURS", 8)
+	RoleRequestTTL          = getEnvDuration("ROLE_REQUEST_TTL", 7*24*time.Hour)
+)
+
+// Relationship-based authorization. RELATION_SCHEMA_FILE defines relations
+// implied by other relations; without it only stored tuples and usersets are
+// followed. Check results are cached for RELATION_CACHE_TTL (0 disables).
+var (
+	RelationSchemaFile = getEnv("RELATION_SCHEMA_FILE", "")
+	RelationCacheTTL   = getEnvDuration("RELATION_CACHE_TTL", time.Minute)
+)
+
+// Decision logging for the authorization check API: "off", "deny" to record
+// denied decisions only, or "all"
+var DecisionLog = getEnv("DECISION_LOG", "off")
+
+// Organizations. Whoever creates an organization is assigned ORG_ADMIN_ROLE
+// in it, when that role exists.
+var OrgAdminRole = getEnv("ORG_ADMIN_ROLE", "org-admin")
+
+// Signup and invitations. SIGNUP_MODE is "open", "invite_only",
+// "domain_allowlist" (SIGNUP_ALLOWED_DOMAINS, comma separated) or "approval".
+// Invitation links are signed with INVITATION_SECRET; without it a random
+// secret is used and pending links stop working when the server restarts.
+var (
+	SignupMode            = getEnv("SIGNUP_MODE", "open")
+	SignupAllowedDomains  = getEnv("SIGNUP_ALLOWED_DOMAINS", "")
+	DisposableDomainsFile = getEnv("DISPOSABLE_DOMAINS_FILE", "")
+	InvitationTTL         = getEnvDuration("INVITATION_TTL", 7*24*time.Hour)
+	InvitationSecret      = getEnv("INVITATION_SECRET", "")
+)
+
+// Impersonation. Tokens last IMPERSONATION_TTL; users holding a role in
+// IMPERSONATION_PROTECTED_ROLES (comma separated), or one inheriting from
+// it, cannot be impersonated and impersonated sessions never use those roles.
+var (
+	ImpersonationTTL            = getEnvDuration("IMPERSONATION_TTL", 15*time.Minute)
+	ImpersonationProtectedRoles = getEnv("IMPERSONATION_PROTECTED_ROLES", "super-admin,admin")
+)
+
+// Email changes. The confirmation link sent to the new address lasts
+// EMAIL_CHANGE_TTL and the undo link sent to the old address lasts
+// EMAIL_CHANGE_UNDO_WINDOW. EMAIL_CHANGE_REVOKE_SESSIONS revokes the user's
+// tokens once a change is confirmed.
+var (
+	EmailChangeTTL            = getEnvDuration("EMAIL_CHANGE_TTL", 24*time.Hour)
+	EmailChangeUndoWindow     = getEnvDuration("EMAIL_CHANGE_UNDO_WINDOW", 7*24*time.Hour)
+	EmailChangeRevokeSessions = getEnvBool("EMAIL_CHANGE_REVOKE_SESSIONS", true)
+)
+
+// Account self-deletion. Accounts are deleted ACCOUNT_DELETION_GRACE_PERIOD
+// after the user asks, unless they cancel; the server looks for due
+// deletions every ACCOUNT_DELETION_CHECK_INTERVAL (0 disables the check).
+var (
+	AccountDeletionGracePeriod   = getEnvDuration("ACCOUNT_DELETION_GRACE_PERIOD", 30*24*time.Hour)
+	AccountDeletionCheckInterval = getEnvDuration("ACCOUNT_DELETION_CHECK_INTERVAL", time.Hour)
+)
+
+func getEnv(key string, fallback string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+func getEnvInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+func getEnvBool(key string, fallback bool) bool {
+	value, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/apikeys/models"
+	"github.com/shammianand/go-auth/internal/modules/apikeys/service"
+)
+
+// APIKeyController handles API key HTTP requests
+type APIKeyController struct {
+	service *service.APIKeyService
+	logger  *slog.Logger
+}
+
+// NewAPIKeyController creates a new API key controller
+func NewAPIKeyController(service *service.APIKeyService, logger *slog.Logger) *APIKeyController {
+	return &APIKeyController{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// CreateAPIKey creates an API key for the authenticated user
+func (kc *APIKeyController) CreateAPIKey(c *gin.Context) {
+	var req models.CreateAPIKeyRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	apiKey, err := kc.service.CreateAPIKey(c.Request.Context(), userID, &req)
+	if err != nil {
+		respondAPIKeyError(c, "Failed to create API key", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Created, "API key created; store it now, it will not be shown again", apiKey)
+}
+
+// ListMyAPIKeys returns the authenticated user's API keys
+func (kc *APIKeyController) ListMyAPIKeys(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	keys, err := kc.service.ListUserAPIKeys(c.Request.Context(), userID)
+	if err != nil {
+		respondAPIKeyError(c, "Failed to list API keys", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "API keys retrieved successfully", keys)
+}
+
+// RevokeMyAPIKey revokes one of the authenticated user's API keys
+func (kc *APIKeyController) RevokeMyAPIKey(c *gin.Context) {
+	keyID, ok := bindKeyID(c)
+	if !ok {
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := kc.service.RevokeUserAPIKey(c.Request.Context(), keyID, userID); err != nil {
+		respondAPIKeyError(c, "Failed to revoke API key", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "API key revoked successfully", nil)
+}
+
+// ListAPIKeys returns API keys across users
+func (kc *APIKeyController) ListAPIKeys(c *gin.Context) {
+	var filter models.APIKeyFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid query parameters", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	keys, err := kc.service.ListAPIKeys(c.Request.Context(), &filter)
+	if err != nil {
+		respondAPIKeyError(c, "Failed to list API keys", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "API keys retrieved successfully", keys)
+}
+
+// RevokeAPIKey revokes any user's API key
+func (kc *APIKeyController) RevokeAPIKey(c *gin.Context) {
+	keyID, ok := bindKeyID(c)
+	if !ok {
+		return
+	}
+
+	actorID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := kc.service.RevokeAPIKey(c.Request.Context(), keyID, actorID); err != nil {
+		respondAPIKeyError(c, "Failed to revoke API key", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "API key revoked successfully", nil)
+}
+
+// bindKeyID parses the API key ID path parameter, responding when it is invalid
+func bindKeyID(c *gin.Context) (uuid.UUID, bool) {
+	keyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid API key ID", "VALIDATION_ERROR", err.Error())
+		return uuid.Nil, false
+	}
+	return keyID, true
+}
+
+// respondAPIKeyError maps API key errors to responses
+func respondAPIKeyError(c *gin.Context, message string, err error) {
+	msg := err.Error()
+	switch {
+	case msg == "api key not found":
+		utils.RespondError(c, types.HTTP.NotFound, message, "NOT_FOUND", msg)
+	case msg == "api key is already revoked":
+		utils.RespondError(c, types.HTTP.Conflict, message, "CONFLICT", msg)
+	case strings.HasPrefix(msg, "scope is not among your permissions"):
+		utils.RespondError(c, types.HTTP.Forbidden, message, "FORBIDDEN", msg)
+	case msg == "expires_at must be in the future", msg == "invalid user_id":
+		utils.RespondError(c, types.HTTP.BadRequest, message, "VALIDATION_ERROR", msg)
+	default:
+		utils.RespondError(c, types.HTTP.InternalServerError, message, "API_KEY_ERROR", msg)
+	}
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// CreateAPIKeyRequest creates an API key limited to the given permission
+// codes. Keys without expires_at never expire.
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1,dive,required"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// APIKeyFilter filters the admin API key listing
+type APIKeyFilter struct {
+	UserID         string `form:"user_id" binding:"omitempty,uuid"`
+	IncludeRevoked bool   `form:"include_revoked"`
+	Limit          int    `form:"limit"`
+	Offset         int    `form:"offset"`
+}
@@ -0,0 +1,35 @@
+package apikeys
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/modules/apikeys/controller"
+	"github.com/shammianand/go-auth/internal/modules/apikeys/service"
+)
+
+// RegisterRoutes registers API key routes. The service is built by the
+// caller because RequireAuth also uses it to verify keys.
+func RegisterRoutes(router *gin.RouterGroup, apiKeyService *service.APIKeyService, cache *redis.Client, rbac middleware.PermissionChecker, logger *slog.Logger) {
+	apiKeyController := controller.NewAPIKeyController(apiKeyService, logger)
+
+	// The user's own keys. Keys are only created or revoked from a signed-in
+	// session, never by another key or an impersonating admin.
+	myKeys := router.Group("/auth/api-keys")
+	myKeys.Use(middleware.RequireAuth(cache, apiKeyService))
+	{
+		myKeys.POST("", middleware.DenyImpersonation(), middleware.DenyAPIKey(), apiKeyController.CreateAPIKey)
+		myKeys.GET("", apiKeyController.ListMyAPIKeys)
+		myKeys.DELETE("/:id", middleware.DenyImpersonation(), middleware.DenyAPIKey(), apiKeyController.RevokeMyAPIKey)
+	}
+
+	// Every user's keys
+	adminKeys := router.Group("/admin/api-keys")
+	adminKeys.Use(middleware.RequireAuth(cache, apiKeyService))
+	{
+		adminKeys.GET("", middleware.RequirePermission(rbac, "users.read"), apiKeyController.ListAPIKeys)
+		adminKeys.DELETE("/:id", middleware.RequirePermission(rbac, "users.write"), apiKeyController.RevokeAPIKey)
+	}
+}
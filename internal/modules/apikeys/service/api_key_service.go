@@ -0,0 +1,385 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/apikeys"
+	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/common/audit"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/modules/apikeys/models"
+)
+
+// touchInterval limits how often last-used details are written for a key
+// that keeps being used from the same address
+const touchInterval = time.Minute
+
+// Random bytes in a key's lookup prefix and in its secret
+const (
+	keyPrefixBytes = 6
+	keySecretBytes = 32
+)
+
+// APIKeyService manages personal API keys and authenticates requests made
+// with them
+type APIKeyService struct {
+	client      *ent.Client
+	permissions middleware.PermissionChecker
+	auditLog    *audit.Writer
+	logger      *slog.Logger
+}
+
+// NewAPIKeyService creates a new API key service
+func NewAPIKeyService(client *ent.Client, permissions middleware.PermissionChecker, logger *slog.Logger) *APIKeyService {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &APIKeyService{
+		client:      client,
+		permissions: permissions,
+		auditLog:    audit.NewWriter(client, logger),
+		logger:      logger,
+	}
+}
+
+// CreateAPIKey creates an API key for a user. Every scope must be a
+// permission the user holds; the key is returned once and only its hash is
+// stored.
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, userID uuid.UUID, req *models.CreateAPIKeyRequest) (*models.CreatedAPIKeyResponse, error) {
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("expires_at must be in the future")
+	}
+
+	scopes := uniqueStrings(req.Scopes)
+	for _, scope := range scopes {
+		allowed, err := s.permissions.HasPermission(ctx, userID, scope)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, fmt.Errorf("scope is not among your permissions: %s", scope)
+		}
+	}
+
+	prefix, secret, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+	key := middleware.APIKeyPrefix + prefix + "_" + secret
+
+	apiKey, err := s.client.APIKeys.Create().
+		SetUserID(userID).
+		SetName(strings.TrimSpace(req.Name)).
+		SetPrefix(prefix).
+		SetKeyHash(hashKey(key)).
+		SetScopes(scopes).
+		SetNillableExpiresAt(req.ExpiresAt).
+		Save(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	s.audit(ctx, userID, "api_key.create", apiKey, map[string]interface{}{
+		"name":   apiKey.Name,
+		"prefix": apiKey.Prefix,
+		"scopes": apiKey.Scopes,
+	})
+
+	return &models.CreatedAPIKeyResponse{
+		APIKeyResponse: apiKeyToResponse(apiKey),
+		Key:            key,
+	}, nil
+}
+
+// ListUserAPIKeys returns a user's API keys that have not been revoked,
+// newest first
+func (s *APIKeyService) ListUserAPIKeys(ctx context.Context, userID uuid.UUID) ([]models.APIKeyResponse, error) {
+	keys, err := s.client.APIKeys.Query().
+		Where(apikeys.UserIDEQ(userID), apikeys.RevokedAtIsNil()).
+		Order(ent.Desc(apikeys.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	return apiKeysToResponses(keys), nil
+}
+
+// ListAPIKeys returns API keys across users for admins, newest first
+func (s *APIKeyService) ListAPIKeys(ctx context.Context, filter *models.APIKeyFilter) ([]models.APIKeyResponse, error) {
+	query := s.client.APIKeys.Query()
+
+	if filter.UserID != "" {
+		userID, err := uuid.Parse(filter.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user_id")
+		}
+		query = query.Where(apikeys.UserIDEQ(userID))
+	}
+	if !filter.IncludeRevoked {
+		query = query.Where(apikeys.RevokedAtIsNil())
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = 50
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	keys, err := query.
+		Order(ent.Desc(apikeys.FieldCreatedAt)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+
+	return apiKeysToResponses(keys), nil
+}
+
+// RevokeUserAPIKey revokes one of the user's own API keys
+func (s *APIKeyService) RevokeUserAPIKey(ctx context.Context, keyID, userID uuid.UUID) error {
+	return s.revoke(ctx, keyID, userID, &userID)
+}
+
+// RevokeAPIKey revokes any user's API key
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, keyID, actorID uuid.UUID) error {
+	return s.revoke(ctx, keyID, actorID, nil)
+}
+
+// revoke revokes a key, restricted to the owner's keys when owner is set.
+// The update is conditional so a key is only revoked once.
+func (s *APIKeyService) revoke(ctx context.Context, keyID, actorID uuid.UUID, owner *uuid.UUID) error {
+	query := s.client.APIKeys.Query().Where(apikeys.IDEQ(keyID))
+	if owner != nil {
+		query = query.Where(apikeys.UserIDEQ(*owner))
+	}
+
+	apiKey, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("api key not found")
+		}
+		return fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	updated, err := s.client.APIKeys.Update().
+		Where(apikeys.IDEQ(apiKey.ID), apikeys.RevokedAtIsNil()).
+		SetRevokedBy(actorID).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+	if updated == 0 {
+		return fmt.Errorf("api key is already revoked")
+	}
+
+	s.audit(ctx, actorID, "api_key.revoke", apiKey, map[string]interface{}{
+		"name":   apiKey.Name,
+		"prefix": apiKey.Prefix,
+	})
+
+	return nil
+}
+
+// RevokeUserAPIKeys revokes every active key of a user, for forced logouts
+// and other events that sign a user out everywhere
+func (s *APIKeyService) RevokeUserAPIKeys(ctx context.Context, userID uuid.UUID) (int, error) {
+	revoked, err := s.client.APIKeys.Update().
+		Where(apikeys.UserIDEQ(userID), apikeys.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke API keys: %w", err)
+	}
+
+	if revoked > 0 {
+		s.auditLog.Write(ctx, audit.Entry{
+			ActionType:   "api_key.revoke_all",
+			ResourceType: "user",
+			ResourceID:   userID.String(),
+			Metadata:     map[string]interface{}{"count": revoked},
+		})
+	}
+
+	return revoked, nil
+}
+
+// VerifyAPIKey authenticates a request made with an API key and records
+// when and from where the key was used
+func (s *APIKeyService) VerifyAPIKey(ctx context.Context, key, ip string) (*middleware.APIKeyPrincipal, error) {
+	prefix, ok := parseKey(key)
+	if !ok {
+		return nil, fmt.Errorf("invalid API key")
+	}
+
+	apiKey, err := s.client.APIKeys.Query().
+		Where(apikeys.PrefixEQ(prefix)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("invalid API key")
+		}
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashKey(key)), []byte(apiKey.KeyHash)) != 1 {
+		return nil, fmt.Errorf("invalid API key")
+	}
+	if apiKey.RevokedAt != nil {
+		return nil, fmt.Errorf("API key has been revoked")
+	}
+	if apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("API key has expired")
+	}
+
+	active, err := s.client.Users.Query().
+		Where(
+			users.IDEQ(apiKey.UserID),
+			users.IsActiveEQ(true),
+		).
+		Exist(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if !active {
+		return nil, fmt.Errorf("user account is inactive")
+	}
+
+	s.touch(ctx, apiKey, ip)
+
+	return &middleware.APIKeyPrincipal{
+		KeyID:  apiKey.ID,
+		UserID: apiKey.UserID,
+		Scopes: apiKey.Scopes,
+	}, nil
+}
+
+// touch records the key's last use, at most once per touchInterval unless
+// the address changes. Failures are logged and never fail the request.
+func (s *APIKeyService) touch(ctx context.Context, apiKey *ent.APIKeys, ip string) {
+	now := time.Now()
+	if apiKey.LastUsedAt != nil && apiKey.LastUsedIP == ip && now.Sub(*apiKey.LastUsedAt) < touchInterval {
+		return
+	}
+
+	err := s.client.APIKeys.UpdateOneID(apiKey.ID).
+		SetLastUsedAt(now).
+		SetLastUsedIP(ip).
+		Exec(ctx)
+
+	if err != nil {
+		s.logger.Error("Failed to record API key use", "api_key_id", apiKey.ID, "error", err)
+	}
+}
+
+// audit records an API key change
+func (s *APIKeyService) audit(ctx context.Context, actorID uuid.UUID, actionType string, apiKey *ent.APIKeys, metadata map[string]interface{}) {
+	metadata["user_id"] = apiKey.UserID.String()
+	s.auditLog.Write(ctx, audit.Entry{
+		ActorID:      &actorID,
+		ActionType:   actionType,
+		ResourceType: "api_key",
+		ResourceID:   apiKey.ID.String(),
+		Metadata:     metadata,
+	})
+}
+
+// generateKey returns a random lookup prefix and secret
+func generateKey() (string, string, error) {
+	prefix := make([]byte, keyPrefixBytes)
+	if _, err := rand.Read(prefix); err != nil {
+		return "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	secret := make([]byte, keySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	return hex.EncodeToString(prefix), base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// parseKey returns the lookup prefix of a key shaped like
+// gak_<hex prefix>_<secret>, reporting false for anything else
+func parseKey(key string) (string, bool) {
+	rest, ok := strings.CutPrefix(key, middleware.APIKeyPrefix)
+	if !ok {
+		return "", false
+	}
+
+	prefix, secret, ok := strings.Cut(rest, "_")
+	if !ok || secret == "" || len(prefix) != 2*keyPrefixBytes {
+		return "", false
+	}
+	if _, err := hex.DecodeString(prefix); err != nil {
+		return "", false
+	}
+	return prefix, true
+}
+
+// hashKey hashes a full API key. Keys carry 256 bits of randomness, so a
+// fast hash is enough.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
+	return result
+}
+
+func apiKeysToResponses(keys []*ent.APIKeys) []models.APIKeyResponse {
+	result := make([]models.APIKeyResponse, len(keys))
+	for i, apiKey := range keys {
+		result[i] = apiKeyToResponse(apiKey)
+	}
+	return result
+}
+
+func apiKeyToResponse(apiKey *ent.APIKeys) models.APIKeyResponse {
+	return models.APIKeyResponse{
+		ID:         apiKey.ID,
+		UserID:     apiKey.UserID,
+		Name:       apiKey.Name,
+		Prefix:     middleware.APIKeyPrefix + apiKey.Prefix,
+		Scopes:     apiKey.Scopes,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		LastUsedIP: apiKey.LastUsedIP,
+		RevokedAt:  apiKey.RevokedAt,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
@@ -0,0 +1,55 @@
+package service
+
+import "testing"
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		prefix string
+		ok     bool
+	}{
+		{"valid", "gak_0123456789ab_c2VjcmV0", "0123456789ab", true},
+		{"secret containing underscores", "gak_0123456789ab_se_cr_et", "0123456789ab", true},
+		{"missing key prefix", "0123456789ab_c2VjcmV0", "", false},
+		{"other key prefix", "sk_0123456789ab_c2VjcmV0", "", false},
+		{"key prefix repeated", "gak_gak_0123456789ab_c2VjcmV0", "", false},
+		{"missing secret", "gak_0123456789ab", "", false},
+		{"empty secret", "gak_0123456789ab_", "", false},
+		{"empty lookup prefix", "gak__c2VjcmV0", "", false},
+		{"lookup prefix too short", "gak_0123456789a_c2VjcmV0", "", false},
+		{"lookup prefix too long", "gak_0123456789abc_c2VjcmV0", "", false},
+		{"lookup prefix not hex", "gak_0123456789xy_c2VjcmV0", "", false},
+		{"JWT", "eyJhbGciOiJSUzI1NiJ9.e30.sig", "", false},
+		{"empty", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, ok := parseKey(tt.key)
+			if ok != tt.ok || prefix != tt.prefix {
+				t.Errorf("parseKey(%q) = %q, %v, want %q, %v", tt.key, prefix, ok, tt.prefix, tt.ok)
+			}
+		})
+	}
+}
+
+func TestGenerateKey(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		prefix, secret, err := generateKey()
+		if err != nil {
+			t.Fatalf("generateKey returned error: %v", err)
+		}
+
+		key := "gak_" + prefix + "_" + secret
+		parsed, ok := parseKey(key)
+		if !ok || parsed != prefix {
+			t.Fatalf("parseKey(%q) = %q, %v, want %q, true", key, parsed, ok, prefix)
+		}
+		if seen[prefix] {
+			t.Fatalf("generateKey repeated prefix %q", prefix)
+		}
+		seen[prefix] = true
+	}
+}
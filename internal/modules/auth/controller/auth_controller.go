@@ -1,13 +1,18 @@
 package controller
 
 import (
+	"errors"
 	"log/slog"
+	"math"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/shammianand/go-auth/internal/auth"
 	"github.com/shammianand/go-auth/internal/common/middleware"
 	"github.com/shammianand/go-auth/internal/common/types"
 	"github.com/shammianand/go-auth/internal/common/utils"
 	"github.com/shammianand/go-auth/internal/modules/auth/models"
+	"github.com/shammianand/go-auth/internal/modules/auth/policy"
 	"github.com/shammianand/go-auth/internal/modules/auth/service"
 )
 
@@ -34,11 +39,30 @@ func (ac *AuthController) Signup(c *gin.Context) {
 
 	resp, err := ac.service.Signup(c.Request.Context(), &req)
 	if err != nil {
+		if respondPasswordPolicyError(c, "Signup failed", err) {
+			return
+		}
+		switch {
+		case errors.Is(err, policy.ErrInviteOnly):
+			utils.RespondError(c, types.HTTP.Forbidden, "Signup failed", "INVITATION_REQUIRED", err.Error())
+			return
+		case errors.Is(err, policy.ErrDomainNotAllowed):
+			utils.RespondError(c, types.HTTP.Forbidden, "Signup failed", "DOMAIN_NOT_ALLOWED", err.Error())
+			return
+		case errors.Is(err, policy.ErrDisposableEmail):
+			utils.RespondError(c, types.HTTP.BadRequest, "Signup failed", "DISPOSABLE_EMAIL", err.Error())
+			return
+		}
 		utils.RespondError(c, types.HTTP.BadRequest, "Signup failed", "SIGNUP_ERROR", err.Error())
 		return
 	}
 
-	utils.RespondSuccess(c, types.HTTP.Created, "User created successfully. Please check your email to verify your account.", resp)
+	message := "User created successfully. Please check your email to verify your account."
+	if resp.PendingApproval {
+		message = "User created successfully. Please check your email to verify your account; an administrator must approve it before you can sign in."
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Created, message, resp)
 }
 
 // Signin handles user authentication
@@ -48,8 +72,24 @@ func (ac *AuthController) Signin(c *gin.Context) {
 		return
 	}
 
-	resp, err := ac.service.Signin(c.Request.Context(), &req)
+	resp, err := ac.service.Signin(c.Request.Context(), &req, c.ClientIP(), c.Request.UserAgent())
 	if err != nil {
+		var lockedErr *service.LockedError
+		if errors.As(err, &lockedErr) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(lockedErr.RetryAfter.Seconds()))))
+			utils.RespondError(c, types.HTTP.TooManyRequests, "Authentication failed", "TOO_MANY_ATTEMPTS", err.Error())
+			return
+		}
+		var consentErr *auth.ConsentRequiredError
+		if errors.As(err, &consentErr) {
+			utils.RespondErrorWithDetails(c, types.HTTP.Forbidden, "Consent required", "CONSENT_REQUIRED", err.Error(), consentErr.Documents)
+			return
+		}
+		switch err.Error() {
+		case "consent document not found", "consent document is not the current version":
+			utils.RespondError(c, types.HTTP.BadRequest, "Authentication failed", "VALIDATION_ERROR", err.Error())
+			return
+		}
 		utils.RespondError(c, types.HTTP.Unauthorized, "Authentication failed", "AUTH_ERROR", err.Error())
 		return
 	}
@@ -106,6 +146,9 @@ func (ac *AuthController) UpdateProfile(c *gin.Context) {
 
 	userInfo, err := ac.service.UpdateProfile(c.Request.Context(), userID, &req)
 	if err != nil {
+		if respondPasswordPolicyError(c, "Profile update failed", err) {
+			return
+		}
 		utils.RespondError(c, types.HTTP.BadRequest, "Profile update failed", "UPDATE_ERROR", err.Error())
 		return
 	}
@@ -138,6 +181,9 @@ func (ac *AuthController) ResetPassword(c *gin.Context) {
 
 	err := ac.service.ResetPassword(c.Request.Context(), &req)
 	if err != nil {
+		if respondPasswordPolicyError(c, "Password reset failed", err) {
+			return
+		}
 		utils.RespondError(c, types.HTTP.BadRequest, "Password reset failed", "RESET_PASSWORD_ERROR", err.Error())
 		return
 	}
@@ -177,3 +223,44 @@ func (ac *AuthController) ResendVerification(c *gin.Context) {
 
 	utils.RespondSuccess(c, types.HTTP.Ok, "Verification email sent", nil)
 }
+
+// UnlockAccount clears a signin lockout for an email and/or IP address
+func (ac *AuthController) UnlockAccount(c *gin.Context) {
+	var req models.UnlockAccountRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	if req.Email == "" && req.IPAddress == "" {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid request body", "VALIDATION_ERROR", "email or ip_address is required")
+		return
+	}
+
+	if req.Email != "" {
+		if err := ac.service.UnlockAccount(c.Request.Context(), req.Email); err != nil {
+			utils.RespondError(c, types.HTTP.InternalServerError, "Failed to unlock account", "UNLOCK_ERROR", err.Error())
+			return
+		}
+	}
+
+	if req.IPAddress != "" {
+		if err := ac.service.UnlockIP(c.Request.Context(), req.IPAddress); err != nil {
+			utils.RespondError(c, types.HTTP.InternalServerError, "Failed to unlock IP address", "UNLOCK_ERROR", err.Error())
+			return
+		}
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Lockout cleared successfully", nil)
+}
+
+// respondPasswordPolicyError responds with per-field violations when err is a
+// password policy failure and reports whether a response was written
+func respondPasswordPolicyError(c *gin.Context, message string, err error) bool {
+	var policyErr *policy.ValidationError
+	if !errors.As(err, &policyErr) {
+		return false
+	}
+
+	utils.RespondErrorWithDetails(c, types.HTTP.BadRequest, message, "PASSWORD_POLICY_VIOLATION", err.Error(), policyErr.Violations)
+	return true
+}
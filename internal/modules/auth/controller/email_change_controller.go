@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/auth/models"
+	"github.com/shammianand/go-auth/internal/modules/auth/policy"
+	"github.com/shammianand/go-auth/internal/modules/auth/service"
+)
+
+// RequestEmailChange sends a confirmation link to the new address and a
+// notice with an undo link to the current one
+func (ac *AuthController) RequestEmailChange(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	var req models.ChangeEmailRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	resp, err := ac.service.RequestEmailChange(c.Request.Context(), userID, &req, c.ClientIP())
+	if err != nil {
+		respondEmailChangeError(c, "Email change failed", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Confirmation link sent to the new email address", resp)
+}
+
+// ConfirmEmailChange applies an email change from the new address's link
+func (ac *AuthController) ConfirmEmailChange(c *gin.Context) {
+	var req models.EmailChangeTokenRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	if err := ac.service.ConfirmEmailChange(c.Request.Context(), req.Token); err != nil {
+		respondEmailChangeError(c, "Email change confirmation failed", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Email changed successfully", nil)
+}
+
+// UndoEmailChange cancels or reverts an email change from the old
+// address's link
+func (ac *AuthController) UndoEmailChange(c *gin.Context) {
+	var req models.EmailChangeTokenRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	if err := ac.service.UndoEmailChange(c.Request.Context(), req.Token); err != nil {
+		respondEmailChangeError(c, "Email change undo failed", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Email change undone successfully", nil)
+}
+
+// respondEmailChangeError maps email change errors to responses
+func respondEmailChangeError(c *gin.Context, message string, err error) {
+	var lockedErr *service.LockedError
+	if errors.As(err, &lockedErr) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(lockedErr.RetryAfter.Seconds()))))
+		utils.RespondError(c, types.HTTP.TooManyRequests, message, "TOO_MANY_ATTEMPTS", err.Error())
+		return
+	}
+
+	switch {
+	case errors.Is(err, policy.ErrDomainNotAllowed):
+		utils.RespondError(c, types.HTTP.Forbidden, message, "DOMAIN_NOT_ALLOWED", err.Error())
+		return
+	case errors.Is(err, policy.ErrDisposableEmail):
+		utils.RespondError(c, types.HTTP.BadRequest, message, "DISPOSABLE_EMAIL", err.Error())
+		return
+	}
+
+	msg := err.Error()
+	switch msg {
+	case "user not found":
+		utils.RespondError(c, types.HTTP.NotFound, message, "NOT_FOUND", msg)
+	case "invalid password":
+		utils.RespondError(c, types.HTTP.Forbidden, message, "INVALID_PASSWORD", msg)
+	case "email already in use", "email has changed since this link was sent":
+		utils.RespondError(c, types.HTTP.Conflict, message, "CONFLICT", msg)
+	case "new email is the same as the current email":
+		utils.RespondError(c, types.HTTP.BadRequest, message, "VALIDATION_ERROR", msg)
+	case "invalid or expired email change token":
+		utils.RespondError(c, types.HTTP.BadRequest, message, "INVALID_TOKEN", msg)
+	default:
+		utils.RespondError(c, types.HTTP.InternalServerError, message, "EMAIL_CHANGE_ERROR", msg)
+	}
+}
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/auth/models"
+)
+
+// CreateInvitation invites an email address, optionally with preset roles
+func (ac *AuthController) CreateInvitation(c *gin.Context) {
+	var req models.CreateInvitationRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	actorID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	invitation, err := ac.service.CreateInvitation(c.Request.Context(), &req, actorID)
+	if err != nil {
+		respondInvitationError(c, "Failed to create invitation", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Created, "Invitation sent successfully", invitation)
+}
+
+// ListInvitations returns invitations, optionally filtered by status
+func (ac *AuthController) ListInvitations(c *gin.Context) {
+	var filter models.InvitationFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid query parameters", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	invitations, err := ac.service.ListInvitations(c.Request.Context(), &filter)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.InternalServerError, "Failed to list invitations", "INVITATION_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Invitations retrieved successfully", invitations)
+}
+
+// RevokeInvitation revokes a pending invitation
+func (ac *AuthController) RevokeInvitation(c *gin.Context) {
+	invitationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid invitation ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	actorID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := ac.service.RevokeInvitation(c.Request.Context(), invitationID, actorID); err != nil {
+		respondInvitationError(c, "Failed to revoke invitation", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Invitation revoked successfully", nil)
+}
+
+// AcceptInvitation accepts an invitation, creating or linking the account
+func (ac *AuthController) AcceptInvitation(c *gin.Context) {
+	var req models.AcceptInvitationRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	resp, err := ac.service.AcceptInvitation(c.Request.Context(), &req)
+	if err != nil {
+		if respondPasswordPolicyError(c, "Failed to accept invitation", err) {
+			return
+		}
+		respondInvitationError(c, "Failed to accept invitation", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Invitation accepted successfully", resp)
+}
+
+// respondInvitationError maps invitation errors to responses
+func respondInvitationError(c *gin.Context, message string, err error) {
+	msg := err.Error()
+	switch msg {
+	case "invitation not found", "role not found":
+		utils.RespondError(c, types.HTTP.NotFound, message, "NOT_FOUND", msg)
+	case "a pending invitation already exists for this email", "invitation is not pending":
+		utils.RespondError(c, types.HTTP.Conflict, message, "CONFLICT", msg)
+	case "missing permission to assign roles", "user account is inactive":
+		utils.RespondError(c, types.HTTP.Forbidden, message, "FORBIDDEN", msg)
+	case "invalid or expired invitation":
+		utils.RespondError(c, types.HTTP.BadRequest, message, "INVALID_INVITATION", msg)
+	case "first_name, last_name and password are required to create an account":
+		utils.RespondError(c, types.HTTP.BadRequest, message, "VALIDATION_ERROR", msg)
+	default:
+		utils.RespondError(c, types.HTTP.InternalServerError, message, "INVITATION_ERROR", msg)
+	}
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/auth/models"
+)
+
+// ListSignups returns signups awaiting admin approval, or rejected ones
+func (ac *AuthController) ListSignups(c *gin.Context) {
+	var filter models.SignupFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid query parameters", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	signups, err := ac.service.ListSignups(c.Request.Context(), &filter)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.InternalServerError, "Failed to list signups", "SIGNUP_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Signups retrieved successfully", signups)
+}
+
+// ApproveSignup activates a signup awaiting approval
+func (ac *AuthController) ApproveSignup(c *gin.Context) {
+	userID, actorID, ok := bindSignupReview(c)
+	if !ok {
+		return
+	}
+
+	if err := ac.service.ApproveSignup(c.Request.Context(), userID, actorID); err != nil {
+		respondSignupReviewError(c, "Failed to approve signup", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Signup approved successfully", nil)
+}
+
+// RejectSignup rejects a signup awaiting approval
+func (ac *AuthController) RejectSignup(c *gin.Context) {
+	userID, actorID, ok := bindSignupReview(c)
+	if !ok {
+		return
+	}
+
+	var req models.RejectSignupRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	if err := ac.service.RejectSignup(c.Request.Context(), userID, actorID, req.Reason); err != nil {
+		respondSignupReviewError(c, "Failed to reject signup", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Signup rejected successfully", nil)
+}
+
+// bindSignupReview parses the user ID path parameter and the caller,
+// responding when either is missing
+func bindSignupReview(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid user ID", "VALIDATION_ERROR", err.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	actorID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, actorID, true
+}
+
+// respondSignupReviewError maps signup review errors to responses
+func respondSignupReviewError(c *gin.Context, message string, err error) {
+	msg := err.Error()
+	switch msg {
+	case "user not found":
+		utils.RespondError(c, types.HTTP.NotFound, message, "NOT_FOUND", msg)
+	case "signup is not awaiting review":
+		utils.RespondError(c, types.HTTP.Conflict, message, "CONFLICT", msg)
+	default:
+		utils.RespondError(c, types.HTTP.InternalServerError, message, "SIGNUP_ERROR", msg)
+	}
+}
@@ -0,0 +1,400 @@
+package importer
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/auth"
+)
+
+// Import result statuses
+const (
+	StatusCreated     = "created"
+	StatusWouldCreate = "would_create"
+	StatusSkipped     = "skipped"
+	StatusFailed      = "failed"
+)
+
+// Record is a single user to import with a pre-hashed password
+type Record struct {
+	Line          int                    `json:"-"`
+	Email         string                 `json:"email"`
+	PasswordHash  string                 `json:"password_hash"`
+	FirstName     string                 `json:"first_name"`
+	LastName      string                 `json:"last_name"`
+	Roles         []string               `json:"roles"`
+	EmailVerified *bool                  `json:"email_verified"`
+	IsActive      *bool                  `json:"is_active"`
+	Metadata      map[string]interface{} `json:"metadata"`
+}
+
+// Result records the outcome for one input line
+type Result struct {
+	Line      int    `json:"line"`
+	Email     string `json:"email,omitempty"`
+	Status    string `json:"status"`
+	Algorithm string `json:"algorithm,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Summary aggregates the results of an import run
+type Summary struct {
+	Total   int      `json:"total"`
+	Created int      `json:"created"`
+	Skipped int      `json:"skipped"`
+	Failed  int      `json:"failed"`
+	DryRun  bool     `json:"dry_run"`
+	Results []Result `json:"results"`
+}
+
+// Options controls an import run
+type Options struct {
+	DryRun bool   // Validate everything without writing
+	Source string // Stored in user metadata and audit logs
+}
+
+// ImportService imports users with foreign password hashes
+type ImportService struct {
+	client *ent.Client
+	logger *slog.Logger
+}
+
+// NewImportService creates a new import service
+func NewImportService(client *ent.Client, logger *slog.Logger) *ImportService {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &ImportService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ReadRecords parses CSV (with a header row) or JSONL input. Lines that cannot
+// be parsed are returned as failed results instead of aborting the import.
+func ReadRecords(r io.Reader, format string) ([]Record, []Result, error) {
+	switch format {
+	case "csv":
+		return readCSV(r)
+	case "jsonl":
+		return readJSONL(r)
+	default:
+		return nil, nil, fmt.Errorf("unsupported format %q (expected csv or jsonl)", format)
+	}
+}
+
+// CSV columns: email,password_hash,first_name,last_name,roles,email_verified,is_active,metadata
+// roles is a semicolon separated list of role codes and metadata is a JSON object.
+func readCSV(r io.Reader) ([]Record, []Result, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"email", "password_hash"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("CSV header is missing required column %q", required)
+		}
+	}
+
+	var records []Record
+	var failures []Result
+	line := 1
+
+	for {
+		row, err := reader.Read()
+		line++
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			failures = append(failures, Result{Line: line, Status: StatusFailed, Error: err.Error()})
+			continue
+		}
+
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+
+		record := Record{
+			Line:         line,
+			Email:        get("email"),
+			PasswordHash: get("password_hash"),
+			FirstName:    get("first_name"),
+			LastName:     get("last_name"),
+		}
+
+		for _, code := range strings.Split(get("roles"), ";") {
+			if code = strings.TrimSpace(code); code != "" {
+				record.Roles = append(record.Roles, code)
+			}
+		}
+
+		var parseErr error
+		record.EmailVerified, parseErr = parseOptionalBool(get("email_verified"))
+		if parseErr == nil {
+			record.IsActive, parseErr = parseOptionalBool(get("is_active"))
+		}
+		if parseErr == nil && get("metadata") != "" {
+			parseErr = json.Unmarshal([]byte(get("metadata")), &record.Metadata)
+		}
+		if parseErr != nil {
+			failures = append(failures, Result{Line: line, Email: record.Email, Status: StatusFailed, Error: parseErr.Error()})
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	return records, failures, nil
+}
+
+func readJSONL(r io.Reader) ([]Record, []Result, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var records []Record
+	var failures []Result
+	line := 0
+
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal([]byte(text), &record); err != nil {
+			failures = append(failures, Result{Line: line, Status: StatusFailed, Error: fmt.Sprintf("invalid JSON: %v", err)})
+			continue
+		}
+		record.Line = line
+		records = append(records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read JSONL input: %w", err)
+	}
+
+	return records, failures, nil
+}
+
+// Import validates and creates users. Password hashes are stored as-is and
+// upgraded to the current algorithm on the user's first successful signin.
+func (s *ImportService) Import(ctx context.Context, records []Record, opts Options) (*Summary, error) {
+	allRoles, err := s.client.Roles.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query roles: %w", err)
+	}
+
+	roleIDs := make(map[string]int)
+	var defaultRoleIDs []int
+	for _, role := range allRoles {
+		roleIDs[role.Code] = role.ID
+		if role.IsDefault {
+			defaultRoleIDs = append(defaultRoleIDs, role.ID)
+		}
+	}
+
+	summary := &Summary{DryRun: opts.DryRun}
+	seen := make(map[string]bool)
+
+	for _, record := range records {
+		result := s.importRecord(ctx, record, roleIDs, defaultRoleIDs, seen, opts)
+		summary.Results = append(summary.Results, result)
+
+		switch result.Status {
+		case StatusCreated, StatusWouldCreate:
+			summary.Created++
+		case StatusSkipped:
+			summary.Skipped++
+		default:
+			summary.Failed++
+		}
+	}
+
+	summary.Total = len(summary.Results)
+	return summary, nil
+}
+
+func (s *ImportService) importRecord(ctx context.Context, record Record, roleIDs map[string]int, defaultRoleIDs []int, seen map[string]bool, opts Options) Result {
+	result := Result{
+		Line:      record.Line,
+		Email:     record.Email,
+		Algorithm: auth.HashAlgorithm(record.PasswordHash),
+	}
+
+	fail := func(format string, args ...interface{}) Result {
+		result.Status = StatusFailed
+		result.Error = fmt.Sprintf(format, args...)
+		return result
+	}
+
+	// Validate the record
+	if _, err := mail.ParseAddress(record.Email); err != nil || record.Email == "" {
+		return fail("invalid email address")
+	}
+	if record.PasswordHash == "" {
+		return fail("password_hash is required")
+	}
+	if err := auth.CheckHash(record.PasswordHash); err != nil {
+		return fail("invalid password_hash: %v", err)
+	}
+	if record.FirstName == "" || record.LastName == "" {
+		return fail("first_name and last_name are required")
+	}
+
+	key := strings.ToLower(record.Email)
+	if seen[key] {
+		result.Status = StatusSkipped
+		result.Error = "duplicate email in input"
+		return result
+	}
+	seen[key] = true
+
+	assignRoleIDs := defaultRoleIDs
+	if len(record.Roles) > 0 {
+		assignRoleIDs = nil
+		for _, code := range record.Roles {
+			id, ok := roleIDs[code]
+			if !ok {
+				return fail("unknown role %q", code)
+			}
+			assignRoleIDs = append(assignRoleIDs, id)
+		}
+	}
+
+	exists, err := s.client.Users.Query().
+		Where(users.EmailEqualFold(record.Email)).
+		Exist(ctx)
+	if err != nil {
+		return fail("failed to check user existence: %v", err)
+	}
+	if exists {
+		result.Status = StatusSkipped
+		result.Error = "user already exists"
+		return result
+	}
+
+	if opts.DryRun {
+		result.Status = StatusWouldCreate
+		return result
+	}
+
+	if err := s.createUser(ctx, record, assignRoleIDs, result.Algorithm, opts.Source); err != nil {
+		return fail("%v", err)
+	}
+
+	result.Status = StatusCreated
+	return result
+}
+
+func (s *ImportService) createUser(ctx context.Context, record Record, roleIDs []int, algorithm, source string) error {
+	metadata := record.Metadata
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadata["import"] = map[string]interface{}{
+		"source":         source,
+		"hash_algorithm": algorithm,
+		"imported_at":    time.Now().UTC().Format(time.RFC3339),
+	}
+
+	emailVerified := false
+	if record.EmailVerified != nil {
+		emailVerified = *record.EmailVerified
+	}
+	isActive := true
+	if record.IsActive != nil {
+		isActive = *record.IsActive
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	user, err := tx.Users.Create().
+		SetEmail(record.Email).
+		SetPasswordHash(record.PasswordHash).
+		SetFirstName(record.FirstName).
+		SetLastName(record.LastName).
+		SetIsActive(isActive).
+		SetEmailVerified(emailVerified).
+		SetMetadata(metadata).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+
+	for _, roleID := range roleIDs {
+		_, err := tx.UserRoles.Create().
+			SetUserID(user.ID).
+			SetRoleID(roleID).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to assign role %d: %w", roleID, err)
+		}
+	}
+
+	resourceID := user.ID.String()
+	_, err = tx.AuditLogs.Create().
+		SetActionType("user.import").
+		SetResourceType("user").
+		SetNillableResourceID(&resourceID).
+		SetMetadata(map[string]interface{}{
+			"email":          user.Email,
+			"source":         source,
+			"hash_algorithm": algorithm,
+			"role_ids":       roleIDs,
+		}).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit import: %w", err)
+	}
+
+	s.logger.Info("User imported", "user_id", user.ID, "email", user.Email, "hash_algorithm", algorithm)
+	return nil
+}
+
+func parseOptionalBool(value string) (*bool, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid boolean %q", value)
+	}
+	return &parsed, nil
+}
@@ -1,17 +1,22 @@
 package models
 
+import "github.com/google/uuid"
+
 // SignupRequest represents a user signup request
 type SignupRequest struct {
 	Email     string `json:"email" binding:"required,email"`
-	Password  string `json:"password" binding:"required,min=8"`
+	Password  string `json:"password" binding:"required,max=1024"`
 	FirstName string `json:"first_name" binding:"required"`
 	LastName  string `json:"last_name" binding:"required"`
 }
 
-// SigninRequest represents a user signin request
+// SigninRequest represents a user signin request. accept_documents
+// records acceptance of consent documents, which is how a user with
+// mandatory documents pending gets a token.
 type SigninRequest struct {
-	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required"`
+	Email           string      `json:"email" binding:"required,email"`
+	Password        string      `json:"password" binding:"required,max=1024"`
+	AcceptDocuments []uuid.UUID `json:"accept_documents"`
 }
 
 // ForgotPasswordRequest represents a forgot password request
@@ -22,17 +27,76 @@ type ForgotPasswordRequest struct {
 // ResetPasswordRequest represents a password reset request
 type ResetPasswordRequest struct {
 	Token       string `json:"token" binding:"required"`
-	NewPassword string `json:"new_password" binding:"required,min=8"`
+	NewPassword string `json:"new_password" binding:"required,max=1024"`
 }
 
-// UpdateProfileRequest represents a profile update request
+// UpdateProfileRequest represents a profile update request. The email is
+// changed through ChangeEmailRequest instead.
 type UpdateProfileRequest struct {
 	FirstName *string `json:"first_name"`
 	LastName  *string `json:"last_name"`
-	Password  *string `json:"password" binding:"omitempty,min=8"`
+	Password  *string `json:"password" binding:"omitempty,max=1024"`
+}
+
+// ChangeEmailRequest starts an email change. The current password is
+// required so a stolen session cannot take over the account.
+type ChangeEmailRequest struct {
+	NewEmail string `json:"new_email" binding:"required,email"`
+	Password string `json:"password" binding:"required,max=1024"`
+}
+
+// EmailChangeTokenRequest confirms or undoes an email change
+type EmailChangeTokenRequest struct {
+	Token string `json:"token" binding:"required"`
 }
 
 // ResendVerificationRequest represents a resend verification request
 type ResendVerificationRequest struct {
 	Email string `json:"email" binding:"required,email"`
 }
+
+// UnlockAccountRequest represents an admin request to clear a signin lockout
+type UnlockAccountRequest struct {
+	Email     string `json:"email" binding:"omitempty,email"`
+	IPAddress string `json:"ip_address" binding:"omitempty,ip"`
+}
+
+// CreateInvitationRequest invites an email address. role_ids are assigned
+// when the invitation is accepted; without expires_in_hours the invitation
+// lasts INVITATION_TTL.
+type CreateInvitationRequest struct {
+	Email          string `json:"email" binding:"required,email"`
+	RoleIDs        []int  `json:"role_ids"`
+	ExpiresInHours *int   `json:"expires_in_hours" binding:"omitempty,min=1,max=720"`
+}
+
+// AcceptInvitationRequest accepts an invitation. The name and password are
+// required when no account exists for the invited email yet.
+type AcceptInvitationRequest struct {
+	Token     string `json:"token" binding:"required"`
+	Password  string `json:"password" binding:"max=1024"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+// InvitationFilter filters invitation listings by status (pending,
+// accepted, revoked or expired)
+type InvitationFilter struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending accepted revoked expired"`
+	Limit  int    `form:"limit"`
+	Offset int    `form:"offset"`
+}
+
+// SignupFilter filters signups awaiting review by status (pending by
+// default, or rejected)
+type SignupFilter struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending rejected"`
+	Limit  int    `form:"limit"`
+	Offset int    `form:"offset"`
+}
+
+// RejectSignupRequest rejects a pending signup. The reason is included in
+// the email sent to the user.
+type RejectSignupRequest struct {
+	Reason string `json:"reason" binding:"max=500"`
+}
@@ -13,6 +13,7 @@ type SignupResponse struct {
 	FirstName    string    `json:"first_name"`
 	LastName     string    `json:"last_name"`
 	EmailVerified bool     `json:"email_verified"`
+	PendingApproval bool   `json:"pending_approval"`
 	CreatedAt    time.Time `json:"created_at"`
 }
 
@@ -35,7 +36,50 @@ type UserInfo struct {
 	LastLogin     time.Time `json:"last_login"`
 }
 
+// InvitationResponse represents an invitation. Status is expired for
+// pending invitations past their expiry.
+type InvitationResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Email      string     `json:"email"`
+	RoleIDs    []int      `json:"role_ids"`
+	Status     string     `json:"status"`
+	InvitedBy  *uuid.UUID `json:"invited_by,omitempty"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	AcceptedBy *uuid.UUID `json:"accepted_by,omitempty"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	RevokedBy  *uuid.UUID `json:"revoked_by,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// AcceptInvitationResponse reports the account an invitation was accepted
+// with. AccountCreated is false when an existing account was linked.
+type AcceptInvitationResponse struct {
+	User           UserInfo `json:"user"`
+	AccountCreated bool     `json:"account_created"`
+	RoleIDs        []int    `json:"role_ids"`
+}
+
 // MessageResponse represents a simple message response
 type MessageResponse struct {
 	Message string `json:"message"`
 }
+
+// SignupReviewResponse represents a signup awaiting or after admin review
+type SignupReviewResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	Email          string     `json:"email"`
+	FirstName      string     `json:"first_name"`
+	LastName       string     `json:"last_name"`
+	EmailVerified  bool       `json:"email_verified"`
+	ApprovalStatus string     `json:"approval_status"`
+	ReviewedBy     *uuid.UUID `json:"reviewed_by,omitempty"`
+	ReviewedAt     *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// EmailChangeResponse describes a pending email change
+type EmailChangeResponse struct {
+	NewEmail  string    `json:"new_email"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
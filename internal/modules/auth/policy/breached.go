@@ -0,0 +1,123 @@
+package policy
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	sha1HexLength = 40  // Length of a hex encoded SHA-1 digest
+	maxLineLength = 128 // Upper bound for "<digest>:<count>\r\n"
+)
+
+// BreachedPasswords looks up passwords in a local corpus of breached password
+// hashes, such as the Have I Been Pwned "ordered by hash" download. The file
+// holds one uppercase hex SHA-1 digest per line in ascending order, optionally
+// followed by ":<count>". Lookups binary search the file without loading it
+// into memory.
+type BreachedPasswords struct {
+	file *os.File
+	size int64
+}
+
+// OpenBreachedPasswords opens a sorted SHA-1 corpus
+func OpenBreachedPasswords(path string) (*BreachedPasswords, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached password file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to stat breached password file: %w", err)
+	}
+
+	return &BreachedPasswords{file: file, size: info.Size()}, nil
+}
+
+// Contains reports whether a password appears in the corpus
+func (b *BreachedPasswords) Contains(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	target := []byte(strings.ToUpper(hex.EncodeToString(sum[:])))
+
+	// Binary search over byte offsets: each probe reads the first complete
+	// line starting at or after the midpoint, so no line starts between the
+	// midpoint and the probed line.
+	low, high := int64(0), b.size
+	for low < high {
+		mid := low + (high-low)/2
+
+		digest, next, err := b.lineAfter(mid)
+		if err != nil {
+			return false, err
+		}
+		if digest == nil {
+			high = mid
+			continue
+		}
+
+		switch cmp := bytes.Compare(digest, target); {
+		case cmp == 0:
+			return true, nil
+		case cmp < 0:
+			low = next
+		default:
+			high = mid
+		}
+	}
+
+	return false, nil
+}
+
+// Close releases the corpus file
+func (b *BreachedPasswords) Close() error {
+	return b.file.Close()
+}
+
+// lineAfter returns the digest on the first line starting at or after offset
+// and the offset of the line that follows it. digest is nil past the last line.
+func (b *BreachedPasswords) lineAfter(offset int64) ([]byte, int64, error) {
+	start := offset
+	if offset > 0 {
+		// Step back one byte so a line starting exactly at offset is found
+		buf := make([]byte, maxLineLength)
+		n, err := b.file.ReadAt(buf, offset-1)
+		if err != nil && err != io.EOF {
+			return nil, 0, fmt.Errorf("failed to read breached password file: %w", err)
+		}
+
+		newline := bytes.IndexByte(buf[:n], '\n')
+		if newline < 0 {
+			return nil, b.size, nil
+		}
+		start = offset + int64(newline)
+	}
+
+	if start >= b.size {
+		return nil, b.size, nil
+	}
+
+	buf := make([]byte, maxLineLength)
+	n, err := b.file.ReadAt(buf, start)
+	if err != nil && err != io.EOF {
+		return nil, 0, fmt.Errorf("failed to read breached password file: %w", err)
+	}
+
+	line := buf[:n]
+	next := b.size
+	if newline := bytes.IndexByte(line, '\n'); newline >= 0 {
+		line = line[:newline]
+		next = start + int64(newline) + 1
+	}
+
+	if len(line) < sha1HexLength {
+		return nil, 0, fmt.Errorf("malformed line at offset %d in breached password file", start)
+	}
+	return bytes.ToUpper(line[:sha1HexLength]), next, nil
+}
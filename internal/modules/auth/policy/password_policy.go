@@ -0,0 +1,220 @@
+package policy
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/config"
+)
+
+// Violation codes returned in FieldError.Code
+const (
+	CodeTooShort     = "PASSWORD_TOO_SHORT"
+	CodeTooLong      = "PASSWORD_TOO_LONG"
+	CodeCharClasses  = "PASSWORD_CHAR_CLASSES"
+	CodeTooWeak      = "PASSWORD_TOO_WEAK"
+	CodeContainsUser = "PASSWORD_CONTAINS_USER_INFO"
+	CodeBreached     = "PASSWORD_BREACHED"
+	CodeRecentlyUsed = "PASSWORD_RECENTLY_USED"
+)
+
+// minUserInfoFragment is the shortest name or email fragment checked for in passwords
+const minUserInfoFragment = 3
+
+// PasswordPolicy describes the rules a new password must satisfy
+type PasswordPolicy struct {
+	MinLength      int    // Minimum length in characters
+	MaxLength      int    // Maximum length in characters
+	MinCharClasses int    // Required classes out of lower, upper, digit, symbol
+	MinStrength    int    // Minimum strength score (0-4)
+	HistorySize    int    // Number of previous passwords that cannot be reused
+	BreachedFile   string // Sorted SHA-1 corpus of breached passwords (optional)
+}
+
+// DefaultPasswordPolicy returns the password policy from configuration
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:      config.PasswordMinLength,
+		MaxLength:      config.PasswordMaxLength,
+		MinCharClasses: config.PasswordMinCharClasses,
+		MinStrength:    config.PasswordMinStrength,
+		HistorySize:    config.PasswordHistorySize,
+		BreachedFile:   config.BreachedPasswordsFile,
+	}
+}
+
+// UserInfo holds personal details a password must not contain
+type UserInfo struct {
+	Email     string
+	FirstName string
+	LastName  string
+}
+
+// ValidationError is returned when a password violates the policy
+type ValidationError struct {
+	Violations []types.FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = v.Message
+	}
+	return "password does not meet policy: " + strings.Join(messages, "; ")
+}
+
+// Validator checks passwords against a policy
+type Validator struct {
+	policy   PasswordPolicy
+	breached *BreachedPasswords
+	logger   *slog.Logger
+}
+
+// NewValidator creates a password validator. If the breached password file
+// cannot be opened the check is disabled and a warning is logged.
+func NewValidator(policy PasswordPolicy, logger *slog.Logger) *Validator {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	v := &Validator{
+		policy: policy,
+		logger: logger,
+	}
+
+	if policy.BreachedFile != "" {
+		breached, err := OpenBreachedPasswords(policy.BreachedFile)
+		if err != nil {
+			logger.Warn("Breached password check disabled", "file", policy.BreachedFile, "error", err)
+		} else {
+			v.breached = breached
+		}
+	}
+
+	return v
+}
+
+// Policy returns the policy enforced by the validator
+func (v *Validator) Policy() PasswordPolicy {
+	return v.policy
+}
+
+// Validate checks a password against every stateless rule and returns a
+// ValidationError listing all violations. field names the request field the
+// violations are reported against. Passwords over the maximum length are
+// rejected before the strength estimate and breach lookup, whose cost grows
+// with the input.
+func (v *Validator) Validate(field, password string, user UserInfo) error {
+	var violations []types.FieldError
+	add := func(code, message string) {
+		violations = append(violations, types.FieldError{Field: field, Code: code, Message: message})
+	}
+
+	length := len([]rune(password))
+	if length < v.policy.MinLength {
+		add(CodeTooShort, fmt.Sprintf("must be at least %d characters long", v.policy.MinLength))
+	}
+	if v.policy.MaxLength > 0 && length > v.policy.MaxLength {
+		add(CodeTooLong, fmt.Sprintf("must be at most %d characters long", v.policy.MaxLength))
+		return &ValidationError{Violations: violations}
+	}
+
+	if classes := countCharClasses(password); classes < v.policy.MinCharClasses {
+		add(CodeCharClasses, fmt.Sprintf("must contain at least %d of: lowercase letters, uppercase letters, digits, symbols", v.policy.MinCharClasses))
+	}
+
+	if fragment := userInfoFragment(password, user); fragment != "" {
+		add(CodeContainsUser, "must not contain your name or email address")
+	}
+
+	strength := EstimateStrength(password, userInputs(user)...)
+	if strength.Score < v.policy.MinStrength {
+		message := fmt.Sprintf("is too easy to guess (strength %d of 4, at least %d required)", strength.Score, v.policy.MinStrength)
+		if len(strength.Feedback) > 0 {
+			message += ": " + strings.Join(strength.Feedback, ", ")
+		}
+		add(CodeTooWeak, message)
+	}
+
+	if v.breached != nil {
+		found, err := v.breached.Contains(password)
+		if err != nil {
+			v.logger.Error("Breached password lookup failed", "error", err)
+		} else if found {
+			add(CodeBreached, "has appeared in a known data breach")
+		}
+	}
+
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// RecentlyUsedError returns the ValidationError for a reused password
+func (v *Validator) RecentlyUsedError(field string) error {
+	return &ValidationError{Violations: []types.FieldError{{
+		Field:   field,
+		Code:    CodeRecentlyUsed,
+		Message: fmt.Sprintf("must not match any of your last %d passwords", v.policy.HistorySize),
+	}}}
+}
+
+func countCharClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	count := 0
+	for _, present := range []bool{lower, upper, digit, symbol} {
+		if present {
+			count++
+		}
+	}
+	return count
+}
+
+// userInputs splits the user's email and names into fragments worth checking
+func userInputs(user UserInfo) []string {
+	var inputs []string
+	local, domain, _ := strings.Cut(strings.ToLower(user.Email), "@")
+
+	candidates := []string{local, strings.ToLower(user.FirstName), strings.ToLower(user.LastName)}
+	candidates = append(candidates, strings.FieldsFunc(local, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})...)
+	if name, _, ok := strings.Cut(domain, "."); ok {
+		candidates = append(candidates, name)
+	}
+
+	for _, c := range candidates {
+		if len([]rune(c)) >= minUserInfoFragment {
+			inputs = append(inputs, c)
+		}
+	}
+	return inputs
+}
+
+// userInfoFragment returns the first piece of personal information found in the password
+func userInfoFragment(password string, user UserInfo) string {
+	lowered := strings.ToLower(password)
+	for _, input := range userInputs(user) {
+		if strings.Contains(lowered, input) {
+			return input
+		}
+	}
+	return ""
+}
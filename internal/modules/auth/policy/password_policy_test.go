@@ -0,0 +1,159 @@
+package policy
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func violationCodes(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Validate returned %T, want *ValidationError", err)
+	}
+	codes := make([]string, len(verr.Violations))
+	for i, v := range verr.Violations {
+		if v.Field != "password" {
+			t.Errorf("violation field = %q, want %q", v.Field, "password")
+		}
+		codes[i] = v.Code
+	}
+	return codes
+}
+
+func TestValidatorValidate(t *testing.T) {
+	policy := PasswordPolicy{MinLength: 8, MaxLength: 16, MinCharClasses: 3}
+	user := UserInfo{Email: "jane.doe@acme.io", FirstName: "Jane", LastName: "Doe"}
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		want     []string
+	}{
+		{"valid", policy, "Tr0mbone-kx", nil},
+		{"exactly min length", policy, "Tr0mbo!e", nil},
+		{"exactly max length", policy, "Tr0mbone-kxTr0mb", nil},
+		{"too short", policy, "Tr0mb!", []string{CodeTooShort}},
+		{"too long stops further checks", policy, "tr0mbone-kxtr0mbone", []string{CodeTooLong}},
+		{"length counts characters not bytes", policy, "Tr0mbøñé", nil},
+		{"too few character classes", policy, "trombonekx", []string{CodeCharClasses}},
+		{"two classes", policy, "trombone42", []string{CodeCharClasses}},
+		{"contains first name", policy, "xJANE-42kx", []string{CodeContainsUser}},
+		{"contains email local part", policy, "Jane.Doe-42", []string{CodeContainsUser}},
+		{"contains email domain", policy, "Acme-4242kx", []string{CodeContainsUser}},
+		{"contains last name", policy, "Doe-4242kx", []string{CodeContainsUser}},
+		{"several violations", policy, "jane", []string{CodeTooShort, CodeCharClasses, CodeContainsUser}},
+		{"no maximum", PasswordPolicy{MinLength: 1}, strings.Repeat("a", 200), nil},
+		{"too weak", PasswordPolicy{MinLength: 1, MinStrength: 3}, "password", []string{CodeTooWeak}},
+		{"strong enough", PasswordPolicy{MinLength: 1, MinStrength: 3}, "Vq7#mZ2!pL9@wX4k", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewValidator(tt.policy, nil)
+			got := violationCodes(t, v.Validate("password", tt.password, user))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserInputs(t *testing.T) {
+	tests := []struct {
+		user UserInfo
+		want []string
+	}{
+		{UserInfo{Email: "jane.doe@acme.io", FirstName: "Jane", LastName: "Doe"}, []string{"jane.doe", "jane", "doe", "jane", "doe", "acme"}},
+		{UserInfo{Email: "Al@x.com", FirstName: "Al", LastName: "Li"}, nil},
+		{UserInfo{Email: "not-an-email"}, []string{"not-an-email", "not", "email"}},
+	}
+
+	for _, tt := range tests {
+		if got := userInputs(tt.user); !slices.Equal(got, tt.want) {
+			t.Errorf("userInputs(%+v) = %v, want %v", tt.user, got, tt.want)
+		}
+	}
+}
+
+func TestEstimateStrength(t *testing.T) {
+	tests := []struct {
+		password   string
+		userInputs []string
+		minScore   int
+		maxScore   int
+	}{
+		{"", nil, 0, 0},
+		{"password", nil, 0, 1},
+		{"aaaaaaaaaaaaaaaa", nil, 0, 1},
+		{"abcdefghijklmnop", nil, 0, 1},
+		{"qwertyuiop", nil, 0, 1},
+		{"janedoe1234", []string{"jane", "doe"}, 0, 2},
+		{"Vq7#mZ2!pL9@wX4k", nil, 4, 4},
+	}
+
+	for _, tt := range tests {
+		got := EstimateStrength(tt.password, tt.userInputs...)
+		if got.Score < tt.minScore || got.Score > tt.maxScore {
+			t.Errorf("EstimateStrength(%q).Score = %d, want %d-%d", tt.password, got.Score, tt.minScore, tt.maxScore)
+		}
+	}
+}
+
+func TestBreachedPasswords(t *testing.T) {
+	breached := []string{"password", "123456", "letmein", "Tr0mbone-kx"}
+	lines := make([]string, len(breached))
+	for i, p := range breached {
+		sum := sha1.Sum([]byte(p))
+		lines[i] = strings.ToUpper(hex.EncodeToString(sum[:])) + ":42"
+	}
+	sort.Strings(lines)
+
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\r\n")+"\r\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	corpus, err := OpenBreachedPasswords(path)
+	if err != nil {
+		t.Fatalf("OpenBreachedPasswords returned error: %v", err)
+	}
+	defer corpus.Close()
+
+	for _, p := range append(breached, "not breached", "Password", "") {
+		want := slices.Contains(breached, p)
+		found, err := corpus.Contains(p)
+		if err != nil {
+			t.Fatalf("Contains(%q) returned error: %v", p, err)
+		}
+		if found != want {
+			t.Errorf("Contains(%q) = %v, want %v", p, found, want)
+		}
+	}
+
+	v := NewValidator(PasswordPolicy{MinLength: 1, BreachedFile: path}, nil)
+	if got := violationCodes(t, v.Validate("password", "Tr0mbone-kx", UserInfo{})); !slices.Equal(got, []string{CodeBreached}) {
+		t.Errorf("violations = %v, want [%s]", got, CodeBreached)
+	}
+
+	// A missing corpus disables the check instead of failing
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	v = NewValidator(PasswordPolicy{MinLength: 1, BreachedFile: filepath.Join(t.TempDir(), "missing.txt")}, logger)
+	if err := v.Validate("password", "Tr0mbone-kx", UserInfo{}); err != nil {
+		t.Errorf("Validate with missing corpus = %v, want nil", err)
+	}
+}
@@ -0,0 +1,172 @@
+package policy
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/shammianand/go-auth/internal/config"
+)
+
+// Signup modes selected by SIGNUP_MODE
+const (
+	SignupModeOpen            = "open"
+	SignupModeInviteOnly      = "invite_only"
+	SignupModeDomainAllowlist = "domain_allowlist"
+	SignupModeApproval        = "approval"
+)
+
+// Errors returned by SignupGate.Check
+var (
+	ErrInviteOnly       = errors.New("signup is by invitation only")
+	ErrDomainNotAllowed = errors.New("email domain is not allowed")
+	ErrDisposableEmail  = errors.New("disposable email addresses are not allowed")
+)
+
+// SignupPolicy describes who may create an account through public signup
+type SignupPolicy struct {
+	Mode           string   // One of the SignupMode constants
+	AllowedDomains []string // Domains accepted in domain_allowlist mode
+	DisposableFile string   // Blocklist of disposable email domains (optional)
+}
+
+// DefaultSignupPolicy returns the signup policy from configuration
+func DefaultSignupPolicy() SignupPolicy {
+	return SignupPolicy{
+		Mode:           config.SignupMode,
+		AllowedDomains: strings.Split(config.SignupAllowedDomains, ","),
+		DisposableFile: config.DisposableDomainsFile,
+	}
+}
+
+// SignupGate decides whether an email address may sign up
+type SignupGate struct {
+	mode       string
+	allowed    map[string]bool
+	disposable map[string]bool
+}
+
+// NewSignupGate creates a signup gate. An unknown mode falls back to
+// invite_only so a typo never opens registration. If the disposable domain
+// file cannot be read the blocklist is disabled and a warning is logged.
+func NewSignupGate(policy SignupPolicy, logger *slog.Logger) *SignupGate {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	g := &SignupGate{
+		mode:    policy.Mode,
+		allowed: make(map[string]bool),
+	}
+
+	switch policy.Mode {
+	case SignupModeOpen, SignupModeInviteOnly, SignupModeDomainAllowlist, SignupModeApproval:
+	default:
+		logger.Warn("Unknown signup mode, falling back to invite_only", "mode", policy.Mode)
+		g.mode = SignupModeInviteOnly
+	}
+
+	for _, domain := range policy.AllowedDomains {
+		if domain = normalizeDomain(domain); domain != "" {
+			g.allowed[domain] = true
+		}
+	}
+	if g.mode == SignupModeDomainAllowlist && len(g.allowed) == 0 {
+		logger.Warn("SIGNUP_ALLOWED_DOMAINS is empty; every signup will be rejected")
+	}
+
+	if policy.DisposableFile != "" {
+		disposable, err := loadDomainList(policy.DisposableFile)
+		if err != nil {
+			logger.Warn("Disposable email check disabled", "file", policy.DisposableFile, "error", err)
+		} else {
+			g.disposable = disposable
+		}
+	}
+
+	return g
+}
+
+// Mode returns the effective signup mode
+func (g *SignupGate) Mode() string {
+	return g.mode
+}
+
+// RequiresApproval reports whether new accounts wait for an admin
+func (g *SignupGate) RequiresApproval() bool {
+	return g.mode == SignupModeApproval
+}
+
+// Check returns an error when an email address may not sign up
+func (g *SignupGate) Check(email string) error {
+	if g.mode == SignupModeInviteOnly {
+		return ErrInviteOnly
+	}
+
+	return g.CheckDomain(email)
+}
+
+// CheckDomain returns an error when an email address is outside the domain
+// allowlist or disposable. It applies to addresses of existing accounts,
+// such as a new address in an email change.
+func (g *SignupGate) CheckDomain(email string) error {
+	domain := emailDomain(email)
+
+	if g.mode == SignupModeDomainAllowlist && !g.allowed[domain] {
+		return ErrDomainNotAllowed
+	}
+
+	// Subdomains of a disposable domain are disposable too
+	for d := domain; d != ""; {
+		if g.disposable[d] {
+			return ErrDisposableEmail
+		}
+		_, parent, ok := strings.Cut(d, ".")
+		if !ok {
+			break
+		}
+		d = parent
+	}
+
+	return nil
+}
+
+// loadDomainList reads one domain per line, skipping blank lines and
+// lines starting with #
+func loadDomainList(path string) (map[string]bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open domain list: %w", err)
+	}
+	defer file.Close()
+
+	domains := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		domains[normalizeDomain(line)] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read domain list: %w", err)
+	}
+
+	return domains, nil
+}
+
+func normalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
+
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return normalizeDomain(email[at+1:])
+}
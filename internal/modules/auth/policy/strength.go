@@ -0,0 +1,255 @@
+package policy
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Strength is a zxcvbn-style estimate of how hard a password is to guess
+type Strength struct {
+	Score    int      // 0 (too guessable) to 4 (very unguessable)
+	Bits     float64  // Estimated guesses as log2
+	Feedback []string // Patterns that weakened the password
+}
+
+// Score thresholds in log2 guesses, matching zxcvbn's 10^3, 10^6, 10^8 and 10^10
+var scoreThresholds = []float64{
+	3 * math.Log2(10),
+	6 * math.Log2(10),
+	8 * math.Log2(10),
+	10 * math.Log2(10),
+}
+
+var keyboardRows = []string{
+	"`1234567890-=",
+	"qwertyuiop[]\\",
+	"asdfghjkl;'",
+	"zxcvbnm,./",
+	"1qaz2wsx3edc4rfv5tgb6yhn7ujm8ik9ol0p",
+}
+
+// commonPasswords are ranked by frequency in public breach corpora. The rank
+// (index + 1) approximates the number of guesses an attacker needs.
+var commonPasswords = []string{
+	"password", "123456", "qwerty", "letmein", "welcome", "admin", "monkey",
+	"dragon", "football", "baseball", "iloveyou", "master", "sunshine",
+	"princess", "shadow", "superman", "michael", "trustno1", "login",
+	"starwars", "passw0rd", "whatever", "freedom", "hello", "charlie",
+	"donald", "qazwsx", "ninja", "mustang", "access", "batman", "secret",
+	"summer", "winter", "spring", "autumn", "flower", "pokemon", "jordan",
+	"hunter", "ranger", "buster", "soccer", "hockey", "killer", "george",
+	"andrew", "joshua", "pepper", "daniel", "cheese", "computer", "internet",
+	"service", "matrix", "silver", "golden", "orange", "purple", "yellow",
+	"banana", "cookie", "chocolate", "family", "friend", "lovely", "angel",
+	"jesus", "love", "god", "money", "test", "guest", "root", "user",
+	"default", "changeme", "temp", "company", "january", "february", "march",
+	"april", "august", "september", "october", "november", "december",
+	"monday", "friday", "london", "paris", "berlin", "google", "apple",
+	"samsung", "facebook", "linkedin", "twitter",
+}
+
+var commonPasswordRank = func() map[string]int {
+	ranks := make(map[string]int, len(commonPasswords))
+	for i, word := range commonPasswords {
+		ranks[word] = i + 1
+	}
+	return ranks
+}()
+
+type match struct {
+	length int
+	bits   float64
+	hint   string
+}
+
+// EstimateStrength scores a password by splitting it into the cheapest
+// sequence of guessable patterns (dictionary words, user inputs, keyboard
+// walks, sequences, repeats) and brute-forcing the remainder.
+func EstimateStrength(password string, userInputs ...string) Strength {
+	runes := []rune(strings.ToLower(password))
+	if len(runes) == 0 {
+		return Strength{Score: 0, Feedback: []string{"password is empty"}}
+	}
+
+	perChar := math.Log2(float64(charsetSize(password)))
+
+	// best[i] is the cheapest estimate for the first i characters
+	best := make([]float64, len(runes)+1)
+	hints := make([][]string, len(runes)+1)
+	for i := 1; i <= len(runes); i++ {
+		best[i] = math.Inf(1)
+	}
+
+	for i := 0; i < len(runes); i++ {
+		if math.IsInf(best[i], 1) {
+			continue
+		}
+
+		candidates := append(findMatches(runes[i:], userInputs), match{length: 1, bits: perChar})
+		for _, m := range candidates {
+			end := i + m.length
+			if cost := best[i] + m.bits; cost < best[end] {
+				best[end] = cost
+				hints[end] = hints[i]
+				if m.hint != "" {
+					hints[end] = append(append([]string{}, hints[i]...), m.hint)
+				}
+			}
+		}
+	}
+
+	bits := best[len(runes)]
+	score := 0
+	for _, threshold := range scoreThresholds {
+		if bits >= threshold {
+			score++
+		}
+	}
+
+	return Strength{
+		Score:    score,
+		Bits:     bits,
+		Feedback: uniqueStrings(hints[len(runes)]),
+	}
+}
+
+// findMatches returns the guessable patterns that start at the beginning of runes
+func findMatches(runes []rune, userInputs []string) []match {
+	var matches []match
+	text := string(runes)
+
+	for word, rank := range commonPasswordRank {
+		if strings.HasPrefix(text, word) {
+			matches = append(matches, match{length: len([]rune(word)), bits: math.Log2(float64(rank)) + 1, hint: "contains a common password"})
+		}
+	}
+
+	for _, input := range userInputs {
+		if input != "" && strings.HasPrefix(text, strings.ToLower(input)) {
+			matches = append(matches, match{length: len([]rune(input)), bits: 1, hint: "contains personal information"})
+		}
+	}
+
+	if n := repeatLength(runes); n >= 3 {
+		matches = append(matches, match{length: n, bits: math.Log2(float64(charsetSize(string(runes[0])) * n)), hint: "contains repeated characters"})
+	}
+
+	if n := sequenceLength(runes); n >= 3 {
+		matches = append(matches, match{length: n, bits: math.Log2(26*2) + math.Log2(float64(n)), hint: "contains a sequence like abc or 123"})
+	}
+
+	if n := keyboardLength(runes); n >= 4 {
+		matches = append(matches, match{length: n, bits: math.Log2(float64(len(keyboardRows)*2*10)) + math.Log2(float64(n)), hint: "contains a keyboard pattern"})
+	}
+
+	return matches
+}
+
+// repeatLength returns the length of the run of identical characters at the start
+func repeatLength(runes []rune) int {
+	n := 1
+	for n < len(runes) && runes[n] == runes[0] {
+		n++
+	}
+	return n
+}
+
+// sequenceLength returns the length of an ascending or descending sequence at the start
+func sequenceLength(runes []rune) int {
+	if len(runes) < 2 {
+		return len(runes)
+	}
+
+	step := runes[1] - runes[0]
+	if step != 1 && step != -1 {
+		return 1
+	}
+
+	n := 2
+	for n < len(runes) && runes[n]-runes[n-1] == step {
+		n++
+	}
+	return n
+}
+
+// keyboardLength returns the length of a keyboard row walk at the start
+func keyboardLength(runes []rune) int {
+	longest := 0
+	for _, row := range keyboardRows {
+		for _, r := range []string{row, reverse(row)} {
+			start := strings.IndexRune(r, runes[0])
+			if start < 0 {
+				continue
+			}
+			n := 0
+			for n < len(runes) && start+n < len(r) && rune(r[start+n]) == runes[n] {
+				n++
+			}
+			if n > longest {
+				longest = n
+			}
+		}
+	}
+	return longest
+}
+
+// charsetSize estimates the brute force alphabet size of a password
+func charsetSize(password string) int {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range password {
+		switch {
+		case r > unicode.MaxASCII:
+			other = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	size := 0
+	if lower {
+		size += 26
+	}
+	if upper {
+		size += 26
+	}
+	if digit {
+		size += 10
+	}
+	if symbol {
+		size += 33
+	}
+	if other {
+		size += 100
+	}
+	if size == 0 {
+		size = 1
+	}
+	return size
+}
+
+func reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -6,35 +6,83 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
 	"github.com/shammianand/go-auth/ent"
+	coreauth "github.com/shammianand/go-auth/internal/auth"
 	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/config"
 	"github.com/shammianand/go-auth/internal/modules/auth/controller"
 	"github.com/shammianand/go-auth/internal/modules/auth/service"
 	emailService "github.com/shammianand/go-auth/internal/modules/email/service"
 )
 
 // RegisterRoutes registers auth module routes
-func RegisterRoutes(router *gin.RouterGroup, client *ent.Client, cache *redis.Client, emailSvc *emailService.EmailService, logger *slog.Logger) {
+func RegisterRoutes(router *gin.RouterGroup, client *ent.Client, cache *redis.Client, emailSvc *emailService.EmailService, rbac service.RBAC, apiKeys service.APIKeys, consents coreauth.ConsentTracker, logger *slog.Logger) {
 	// Initialize auth service and controller
-	authService := service.NewAuthService(client, cache, emailSvc, logger)
+	authService := service.NewAuthService(client, cache, emailSvc, rbac, apiKeys, consents, logger)
 	authController := controller.NewAuthController(authService, logger)
 
 	// Public routes (no authentication required)
 	auth := router.Group("/auth")
 	{
-		auth.POST("/signup", authController.Signup)
-		auth.POST("/signin", authController.Signin)
-		auth.POST("/forgot-password", authController.ForgotPassword)
+		auth.POST("/signup",
+			middleware.RateLimit(cache, middleware.RateLimitPolicy{Name: "signup:ip", Limit: config.RateLimitSignupPerIP, Window: config.RateLimitSignupWindow, Key: middleware.KeyByIP}),
+			authController.Signup,
+		)
+		auth.POST("/signin",
+			middleware.RateLimit(cache, middleware.RateLimitPolicy{Name: "signin:ip", Limit: config.RateLimitSigninPerIP, Window: config.RateLimitSigninWindow, Key: middleware.KeyByIP}),
+			middleware.RateLimit(cache, middleware.RateLimitPolicy{Name: "signin:email", Limit: config.RateLimitSigninPerEmail, Window: config.RateLimitSigninWindow, Key: middleware.KeyByJSONField("email")}),
+			authController.Signin,
+		)
+		auth.POST("/forgot-password",
+			middleware.RateLimit(cache, middleware.RateLimitPolicy{Name: "forgot-password:ip", Limit: config.RateLimitForgotPerIP, Window: config.RateLimitForgotWindow, Key: middleware.KeyByIP}),
+			middleware.RateLimit(cache, middleware.RateLimitPolicy{Name: "forgot-password:email", Limit: config.RateLimitForgotPerEmail, Window: config.RateLimitForgotWindow, Key: middleware.KeyByJSONField("email")}),
+			authController.ForgotPassword,
+		)
 		auth.POST("/reset-password", authController.ResetPassword)
 		auth.GET("/verify-email", authController.VerifyEmail)
-		auth.POST("/resend-verification", authController.ResendVerification)
+		auth.POST("/resend-verification",
+			middleware.RateLimit(cache, middleware.RateLimitPolicy{Name: "resend-verification:ip", Limit: config.RateLimitResendPerIP, Window: config.RateLimitResendWindow, Key: middleware.KeyByIP}),
+			middleware.RateLimit(cache, middleware.RateLimitPolicy{Name: "resend-verification:email", Limit: config.RateLimitResendPerEmail, Window: config.RateLimitResendWindow, Key: middleware.KeyByJSONField("email")}),
+			authController.ResendVerification,
+		)
+		auth.POST("/email-change/confirm", authController.ConfirmEmailChange)
+		auth.POST("/email-change/undo", authController.UndoEmailChange)
+		auth.POST("/invitations/accept",
+			middleware.RateLimit(cache, middleware.RateLimitPolicy{Name: "invitation-accept:ip", Limit: config.RateLimitSignupPerIP, Window: config.RateLimitSignupWindow, Key: middleware.KeyByIP}),
+			authController.AcceptInvitation,
+		)
 	}
 
 	// Protected routes (authentication required)
 	authProtected := router.Group("/auth")
-	authProtected.Use(middleware.RequireAuth(cache))
+	authProtected.Use(middleware.RequireAuth(cache, apiKeys))
 	{
-		authProtected.POST("/logout", authController.Logout)
+		authProtected.POST("/logout", middleware.DenyImpersonation(), middleware.DenyAPIKey(), authController.Logout)
 		authProtected.GET("/me", authController.GetMe)
-		authProtected.PUT("/me", authController.UpdateProfile)
+		authProtected.PUT("/me", middleware.DenyImpersonation(), middleware.DenyAPIKey(), authController.UpdateProfile)
+		authProtected.POST("/me/email",
+			middleware.DenyImpersonation(),
+			middleware.DenyAPIKey(),
+			middleware.RateLimit(cache, middleware.RateLimitPolicy{Name: "email-change:user", Limit: config.RateLimitEmailChangePerUser, Window: config.RateLimitEmailChangeWindow, Key: middleware.KeyByUserID}),
+			authController.RequestEmailChange,
+		)
+	}
+
+	// Admin routes (require users.write permission)
+	authAdmin := router.Group("/auth/admin")
+	authAdmin.Use(middleware.RequireAuth(cache, apiKeys), middleware.RequirePermission(rbac, "users.write"))
+	{
+		authAdmin.POST("/unlock", authController.UnlockAccount)
+		authAdmin.GET("/signups", authController.ListSignups)
+		authAdmin.POST("/signups/:user_id/approve", authController.ApproveSignup)
+		authAdmin.POST("/signups/:user_id/reject", authController.RejectSignup)
+	}
+
+	// Invitation management (require users.invite permission)
+	invitations := router.Group("/invitations")
+	invitations.Use(middleware.RequireAuth(cache, apiKeys), middleware.RequirePermission(rbac, "users.invite"))
+	{
+		invitations.POST("", authController.CreateInvitation)
+		invitations.GET("", authController.ListInvitations)
+		invitations.DELETE("/:id", authController.RevokeInvitation)
 	}
 }
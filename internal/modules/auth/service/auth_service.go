@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,34 +15,86 @@ import (
 	"github.com/shammianand/go-auth/ent/roles"
 	"github.com/shammianand/go-auth/ent/users"
 	"github.com/shammianand/go-auth/internal/auth"
+	"github.com/shammianand/go-auth/internal/common/audit"
+	"github.com/shammianand/go-auth/internal/common/middleware"
 	"github.com/shammianand/go-auth/internal/modules/auth/models"
+	"github.com/shammianand/go-auth/internal/modules/auth/policy"
 	"github.com/shammianand/go-auth/internal/modules/email/service"
 )
 
+var (
+	dummyHashOnce sync.Once
+	dummyHash     string
+)
+
+// dummyPasswordHash returns the hash compared against when the signin email
+// is unknown. It is computed on first use so hashing never runs while the
+// package loads, before the hashing configuration has been checked.
+func dummyPasswordHash() string {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = auth.HashPasswords("go-auth-dummy-password")
+	})
+	return dummyHash
+}
+
+// RBAC is the part of the RBAC service the auth module relies on
+type RBAC interface {
+	HasPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error)
+	AssignRoles(ctx context.Context, userID uuid.UUID, roleIDs []int, actorID uuid.UUID) error
+}
+
+// APIKeys is the part of the API key service the auth module relies on:
+// verifying keys on protected routes and revoking them with a user's tokens
+type APIKeys interface {
+	middleware.APIKeyVerifier
+	auth.APIKeyRevoker
+}
+
 // AuthService handles authentication operations
 type AuthService struct {
-	client       *ent.Client
-	cache        *redis.Client
-	emailService *service.EmailService
-	logger       *slog.Logger
+	client            *ent.Client
+	cache             *redis.Client
+	emailService      *service.EmailService
+	rbac              RBAC
+	apiKeys           APIKeys
+	consents          auth.ConsentTracker
+	lockout           *LockoutService
+	passwordValidator *policy.Validator
+	signupGate        *policy.SignupGate
+	invitationKey     []byte
+	auditLog          *audit.Writer
+	logger            *slog.Logger
 }
 
-// NewAuthService creates a new auth service
-func NewAuthService(client *ent.Client, cache *redis.Client, emailService *service.EmailService, logger *slog.Logger) *AuthService {
+// NewAuthService creates a new auth service. Tokens are only issued once
+// the user has accepted the documents consents requires.
+func NewAuthService(client *ent.Client, cache *redis.Client, emailService *service.EmailService, rbac RBAC, apiKeys APIKeys, consents auth.ConsentTracker, logger *slog.Logger) *AuthService {
 	if logger == nil {
 		logger = slog.Default()
 	}
 
 	return &AuthService{
-		client:       client,
-		cache:        cache,
-		emailService: emailService,
-		logger:       logger,
+		client:            client,
+		cache:             cache,
+		emailService:      emailService,
+		rbac:              rbac,
+		apiKeys:           apiKeys,
+		consents:          consents,
+		lockout:           NewLockoutService(cache, emailService, DefaultLockoutPolicy(), logger),
+		passwordValidator: policy.NewValidator(policy.DefaultPasswordPolicy(), logger),
+		signupGate:        policy.NewSignupGate(policy.DefaultSignupPolicy(), logger),
+		invitationKey:     invitationKey(logger),
+		auditLog:          audit.NewWriter(client, logger),
+		logger:            logger,
 	}
 }
 
 // Signup creates a new user account
 func (s *AuthService) Signup(ctx context.Context, req *models.SignupRequest) (*models.SignupResponse, error) {
+	if err := s.signupGate.Check(req.Email); err != nil {
+		return nil, err
+	}
+
 	// Check if user already exists
 	exists, err := s.client.Users.Query().
 		Where(users.EmailEQ(req.Email)).
@@ -55,42 +108,16 @@ func (s *AuthService) Signup(ctx context.Context, req *models.SignupRequest) (*m
 		return nil, fmt.Errorf("user with email %s already exists", req.Email)
 	}
 
-	// Hash password
-	hashedPassword, err := auth.HashPasswords(req.Password)
+	pendingApproval := s.signupGate.RequiresApproval()
+	user, err := s.createUser(ctx, req, newAccount{pendingApproval: pendingApproval})
 	if err != nil {
-		return nil, fmt.Errorf("failed to hash password: %w", err)
+		return nil, err
 	}
 
-	// Create user
-	user, err := s.client.Users.Create().
-		SetEmail(req.Email).
-		SetPasswordHash(hashedPassword).
-		SetFirstName(req.FirstName).
-		SetLastName(req.LastName).
-		SetIsActive(true).
-		SetEmailVerified(false).
-		Save(ctx)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to create user: %w", err)
-	}
-
-	// Assign default role
-	defaultRole, err := s.client.Roles.Query().
-		Where(roles.IsDefaultEQ(true)).
-		First(ctx)
-
-	if err != nil {
-		s.logger.Warn("No default role found, user created without role", "user_id", user.ID)
-	} else {
-		_, err = s.client.UserRoles.Create().
-			SetUserID(user.ID).
-			SetRoleID(defaultRole.ID).
-			Save(ctx)
-
-		if err != nil {
-			s.logger.Error("Failed to assign default role", "user_id", user.ID, "error", err)
-		}
+	if pendingApproval {
+		s.audit(ctx, &user.ID, "signup.request", "user", user.ID.String(), map[string]interface{}{
+			"email": user.Email,
+		})
 	}
 
 	// Generate verification token
@@ -106,37 +133,70 @@ func (s *AuthService) Signup(ctx context.Context, req *models.SignupRequest) (*m
 	}
 
 	return &models.SignupResponse{
-		ID:            user.ID,
-		Email:         user.Email,
-		FirstName:     user.FirstName,
-		LastName:      user.LastName,
-		EmailVerified: user.EmailVerified,
-		CreatedAt:     user.CreatedAt,
+		ID:              user.ID,
+		Email:           user.Email,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		EmailVerified:   user.EmailVerified,
+		PendingApproval: pendingApproval,
+		CreatedAt:       user.CreatedAt,
 	}, nil
 }
 
 // Signin authenticates a user and returns a JWT token
-func (s *AuthService) Signin(ctx context.Context, req *models.SigninRequest) (*models.SigninResponse, error) {
+func (s *AuthService) Signin(ctx context.Context, req *models.SigninRequest, clientIP, userAgent string) (*models.SigninResponse, error) {
+	// Reject early while the account or IP is locked or backing off
+	if err := s.lockout.Check(ctx, req.Email, clientIP); err != nil {
+		return nil, err
+	}
+
 	// Find user by email
 	user, err := s.client.Users.Query().
 		Where(users.EmailEQ(req.Email)).
 		Only(ctx)
 
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("invalid credentials")
-		}
+	if err != nil && !ent.IsNotFound(err) {
 		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
 
+	// Verify password. Unknown emails are compared against a dummy hash so
+	// both cases take the same time and count towards the same lockout.
+	passwordHash := dummyPasswordHash()
+	if user != nil {
+		passwordHash = user.PasswordHash
+	}
+	if !auth.ComparePasswords(passwordHash, []byte(req.Password)) || user == nil {
+		if err := s.lockout.RegisterFailure(ctx, req.Email, clientIP, user); err != nil {
+			s.logger.Error("Failed to register failed signin", "error", err)
+		}
+		return nil, fmt.Errorf("invalid credentials")
+	}
+
+	if err := s.lockout.RegisterSuccess(ctx, req.Email); err != nil {
+		s.logger.Error("Failed to reset failed signin attempts", "user_id", user.ID, "error", err)
+	}
+
+	// Upgrade the stored hash if it uses an outdated algorithm or parameters
+	if auth.PasswordNeedsRehash(user.PasswordHash) {
+		s.rehashPassword(ctx, user, req.Password)
+	}
+
 	// Check if user is active
+	if user.ApprovalStatus == users.ApprovalStatusPending {
+		return nil, fmt.Errorf("user account is pending approval")
+	}
 	if !user.IsActive {
 		return nil, fmt.Errorf("user account is inactive")
 	}
+	if user.PasswordResetRequired {
+		return nil, fmt.Errorf("password reset required; check your email for a reset link")
+	}
 
-	// Verify password
-	if !auth.ComparePasswords(user.PasswordHash, []byte(req.Password)) {
-		return nil, fmt.Errorf("invalid credentials")
+	// Record documents accepted with this signin, then refuse a token while
+	// a mandatory one is still pending
+	consentClaims, err := auth.ConsentClaims(ctx, s.consents, user.ID, req.AcceptDocuments, clientIP, userAgent)
+	if err != nil {
+		return nil, err
 	}
 
 	// Update last login
@@ -149,7 +209,7 @@ func (s *AuthService) Signin(ctx context.Context, req *models.SigninRequest) (*m
 	}
 
 	// Generate JWT
-	token, err := auth.CreateJWT(user.ID, s.cache)
+	token, err := auth.CreateJWTWithClaims(user.ID, consentClaims, s.cache)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create token: %w", err)
 	}
@@ -173,6 +233,84 @@ func (s *AuthService) Signin(ctx context.Context, req *models.SigninRequest) (*m
 	}, nil
 }
 
+// newAccount sets the initial state of an account created by createUser
+type newAccount struct {
+	emailVerified   bool
+	pendingApproval bool // Inactive until an admin approves the signup
+}
+
+// createUser validates the password and creates an account with the
+// default role
+func (s *AuthService) createUser(ctx context.Context, req *models.SignupRequest, opts newAccount) (*ent.Users, error) {
+	// Enforce password policy
+	err := s.validateNewPassword(ctx, "password", req.Password, nil, policy.UserInfo{
+		Email:     req.Email,
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Hash password
+	hashedPassword, err := auth.HashPasswords(req.Password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	approvalStatus := users.ApprovalStatusApproved
+	if opts.pendingApproval {
+		approvalStatus = users.ApprovalStatusPending
+	}
+
+	// Create user
+	user, err := s.client.Users.Create().
+		SetEmail(req.Email).
+		SetPasswordHash(hashedPassword).
+		SetFirstName(req.FirstName).
+		SetLastName(req.LastName).
+		SetIsActive(!opts.pendingApproval).
+		SetEmailVerified(opts.emailVerified).
+		SetApprovalStatus(approvalStatus).
+		Save(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	s.recordPasswordHistory(ctx, user.ID, hashedPassword)
+
+	// Assign default role
+	defaultRole, err := s.client.Roles.Query().
+		Where(roles.IsDefaultEQ(true)).
+		First(ctx)
+
+	if err != nil {
+		s.logger.Warn("No default role found, user created without role", "user_id", user.ID)
+	} else {
+		_, err = s.client.UserRoles.Create().
+			SetUserID(user.ID).
+			SetRoleID(defaultRole.ID).
+			Save(ctx)
+
+		if err != nil {
+			s.logger.Error("Failed to assign default role", "user_id", user.ID, "error", err)
+		}
+	}
+
+	return user, nil
+}
+
+// UnlockAccount clears the signin lockout for an email address
+func (s *AuthService) UnlockAccount(ctx context.Context, email string) error {
+	return s.lockout.UnlockAccount(ctx, email)
+}
+
+// UnlockIP clears the signin lockout for an IP address
+func (s *AuthService) UnlockIP(ctx context.Context, ip string) error {
+	return s.lockout.UnlockIP(ctx, ip)
+}
+
 // Logout invalidates a user's token
 func (s *AuthService) Logout(ctx context.Context, userID uuid.UUID) error {
 	// Remove token from Redis
@@ -230,8 +368,21 @@ func (s *AuthService) UpdateProfile(ctx context.Context, userID uuid.UUID, req *
 		update = update.SetLastName(*req.LastName)
 	}
 
+	var hashedPassword string
 	if req.Password != nil {
-		hashedPassword, err := auth.HashPasswords(*req.Password)
+		info := policy.UserInfo{Email: user.Email, FirstName: user.FirstName, LastName: user.LastName}
+		if req.FirstName != nil {
+			info.FirstName = *req.FirstName
+		}
+		if req.LastName != nil {
+			info.LastName = *req.LastName
+		}
+
+		if err := s.validateNewPassword(ctx, "password", *req.Password, user, info); err != nil {
+			return nil, err
+		}
+
+		hashedPassword, err = auth.HashPasswords(*req.Password)
 		if err != nil {
 			return nil, fmt.Errorf("failed to hash password: %w", err)
 		}
@@ -243,6 +394,10 @@ func (s *AuthService) UpdateProfile(ctx context.Context, userID uuid.UUID, req *
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
+	if hashedPassword != "" {
+		s.recordPasswordHistory(ctx, user.ID, hashedPassword)
+	}
+
 	return s.GetUserInfo(ctx, userID)
 }
 
@@ -295,6 +450,21 @@ func (s *AuthService) ResetPassword(ctx context.Context, req *models.ResetPasswo
 		return fmt.Errorf("failed to find reset token: %w", err)
 	}
 
+	user, err := s.client.Users.Get(ctx, resetRecord.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// Enforce password policy
+	err = s.validateNewPassword(ctx, "new_password", req.NewPassword, user, policy.UserInfo{
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+	})
+	if err != nil {
+		return err
+	}
+
 	// Hash new password
 	hashedPassword, err := auth.HashPasswords(req.NewPassword)
 	if err != nil {
@@ -305,12 +475,15 @@ func (s *AuthService) ResetPassword(ctx context.Context, req *models.ResetPasswo
 	_, err = s.client.Users.Update().
 		Where(users.IDEQ(resetRecord.UserID)).
 		SetPasswordHash(hashedPassword).
+		SetPasswordResetRequired(false).
 		Save(ctx)
 
 	if err != nil {
 		return fmt.Errorf("failed to update password: %w", err)
 	}
 
+	s.recordPasswordHistory(ctx, user.ID, hashedPassword)
+
 	// Mark token as used
 	now := time.Now()
 	_, err = resetRecord.Update().
@@ -407,3 +580,35 @@ func (s *AuthService) ResendVerification(ctx context.Context, req *models.Resend
 
 	return nil
 }
+
+// rehashPassword replaces a user's stored hash with one from the current hasher.
+// Failures are logged and never block signin.
+func (s *AuthService) rehashPassword(ctx context.Context, user *ent.Users, password string) {
+	hashedPassword, err := auth.HashPasswords(password)
+	if err != nil {
+		s.logger.Error("Failed to rehash password", "user_id", user.ID, "error", err)
+		return
+	}
+
+	// Only replace the hash we verified, in case the password changed meanwhile
+	updated, err := s.client.Users.Update().
+		Where(
+			users.IDEQ(user.ID),
+			users.PasswordHashEQ(user.PasswordHash),
+		).
+		SetPasswordHash(hashedPassword).
+		Save(ctx)
+
+	if err != nil {
+		s.logger.Error("Failed to store rehashed password", "user_id", user.ID, "error", err)
+		return
+	}
+
+	if updated > 0 {
+		s.logger.Info("Password rehashed with current algorithm",
+			"user_id", user.ID,
+			"from", auth.HashAlgorithm(user.PasswordHash),
+			"to", auth.CurrentHasher().Algorithm(),
+		)
+	}
+}
@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/emailchanges"
+	"github.com/shammianand/go-auth/ent/emailverifications"
+	"github.com/shammianand/go-auth/ent/passwordresets"
+	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/auth"
+	"github.com/shammianand/go-auth/internal/config"
+	"github.com/shammianand/go-auth/internal/modules/auth/models"
+	emailmodels "github.com/shammianand/go-auth/internal/modules/email/models"
+)
+
+// RequestEmailChange starts an email change. Nothing changes until the new
+// address confirms through its link; the old address is told and gets a
+// link to cancel or undo the change. A new request replaces pending ones.
+// Wrong passwords count towards the signin lockout.
+func (s *AuthService) RequestEmailChange(ctx context.Context, userID uuid.UUID, req *models.ChangeEmailRequest, clientIP string) (*models.EmailChangeResponse, error) {
+	user, err := s.client.Users.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := s.lockout.Check(ctx, user.Email, clientIP); err != nil {
+		return nil, err
+	}
+
+	if !auth.ComparePasswords(user.PasswordHash, []byte(req.Password)) {
+		if err := s.lockout.RegisterFailure(ctx, user.Email, clientIP, user); err != nil {
+			s.logger.Error("Failed to register failed password check", "user_id", userID, "error", err)
+		}
+		return nil, fmt.Errorf("invalid password")
+	}
+
+	if err := s.lockout.RegisterSuccess(ctx, user.Email); err != nil {
+		s.logger.Error("Failed to reset failed password checks", "user_id", userID, "error", err)
+	}
+
+	newEmail := strings.ToLower(strings.TrimSpace(req.NewEmail))
+	if strings.EqualFold(newEmail, user.Email) {
+		return nil, fmt.Errorf("new email is the same as the current email")
+	}
+
+	if err := s.signupGate.CheckDomain(newEmail); err != nil {
+		return nil, err
+	}
+
+	taken, err := s.emailTaken(ctx, s.client, newEmail, userID)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, fmt.Errorf("email already in use")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	_, err = tx.EmailChanges.Update().
+		Where(
+			emailchanges.UserIDEQ(userID),
+			emailchanges.StatusEQ(emailchanges.StatusPending),
+		).
+		SetStatus(emailchanges.StatusCancelled).
+		Save(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to cancel pending email changes: %w", err)
+	}
+
+	now := time.Now()
+	change, err := tx.EmailChanges.Create().
+		SetUserID(userID).
+		SetOldEmail(user.Email).
+		SetOldEmailVerified(user.EmailVerified).
+		SetNewEmail(newEmail).
+		SetToken(uuid.New().String()).
+		SetUndoToken(uuid.New().String()).
+		SetExpiresAt(now.Add(config.EmailChangeTTL)).
+		SetUndoExpiresAt(now.Add(config.EmailChangeUndoWindow)).
+		SetIPAddress(clientIP).
+		Save(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to create email change: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	err = s.emailService.SendEmailChangeConfirmationEmail(ctx, user.ID, user.FirstName, emailmodels.EmailChangeNotification{
+		OldEmail:  change.OldEmail,
+		NewEmail:  change.NewEmail,
+		Token:     change.Token,
+		ExpiresAt: change.ExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send confirmation email: %w", err)
+	}
+
+	err = s.emailService.SendEmailChangeNoticeEmail(ctx, user.ID, user.FirstName, emailmodels.EmailChangeNotification{
+		OldEmail:  change.OldEmail,
+		NewEmail:  change.NewEmail,
+		Token:     change.UndoToken,
+		ExpiresAt: change.UndoExpiresAt,
+	})
+	if err != nil {
+		s.logger.Error("Failed to send email change notice", "user_id", user.ID, "error", err)
+	}
+
+	s.audit(ctx, &userID, "email.change_request", "user", userID.String(), map[string]interface{}{
+		"old_email":  change.OldEmail,
+		"new_email":  change.NewEmail,
+		"ip_address": clientIP,
+	})
+
+	return &models.EmailChangeResponse{
+		NewEmail:  change.NewEmail,
+		ExpiresAt: change.ExpiresAt,
+	}, nil
+}
+
+// ConfirmEmailChange applies an email change once the new address confirms
+// it. The new address is verified by the confirmation itself. Verification
+// and reset links sent to the old address stop working, and the user's
+// tokens are revoked when EMAIL_CHANGE_REVOKE_SESSIONS is set.
+func (s *AuthService) ConfirmEmailChange(ctx context.Context, token string) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	change, err := tx.EmailChanges.Query().
+		Where(
+			emailchanges.TokenEQ(token),
+			emailchanges.StatusEQ(emailchanges.StatusPending),
+			emailchanges.ExpiresAtGT(time.Now()),
+		).
+		ForUpdate().
+		Only(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("invalid or expired email change token")
+		}
+		return fmt.Errorf("failed to find email change: %w", err)
+	}
+
+	if err := s.switchEmail(ctx, tx, change.UserID, change.OldEmail, change.NewEmail, true); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.EmailChanges.UpdateOne(change).
+		SetStatus(emailchanges.StatusConfirmed).
+		SetConfirmedAt(time.Now()).
+		Save(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to confirm email change: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if config.EmailChangeRevokeSessions {
+		if err := auth.RevokeUserTokens(ctx, s.cache, s.apiKeys, change.UserID); err != nil {
+			s.logger.Error("Failed to revoke tokens after email change", "user_id", change.UserID, "error", err)
+		}
+	}
+
+	s.audit(ctx, &change.UserID, "email.change_confirm", "user", change.UserID.String(), map[string]interface{}{
+		"old_email": change.OldEmail,
+		"new_email": change.NewEmail,
+	})
+
+	return nil
+}
+
+// UndoEmailChange cancels a pending email change, or reverts a confirmed
+// one to the old address, from the link sent to the old address. Undoing
+// a confirmed change always revokes the user's tokens since it usually
+// means the account was taken over.
+func (s *AuthService) UndoEmailChange(ctx context.Context, token string) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	change, err := tx.EmailChanges.Query().
+		Where(
+			emailchanges.UndoTokenEQ(token),
+			emailchanges.StatusIn(emailchanges.StatusPending, emailchanges.StatusConfirmed),
+			emailchanges.UndoExpiresAtGT(time.Now()),
+		).
+		ForUpdate().
+		Only(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("invalid or expired email change token")
+		}
+		return fmt.Errorf("failed to find email change: %w", err)
+	}
+
+	if change.Status == emailchanges.StatusPending {
+		_, err = tx.EmailChanges.UpdateOne(change).
+			SetStatus(emailchanges.StatusCancelled).
+			Save(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to cancel email change: %w", err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+
+		s.audit(ctx, &change.UserID, "email.change_cancel", "user", change.UserID.String(), map[string]interface{}{
+			"old_email": change.OldEmail,
+			"new_email": change.NewEmail,
+		})
+		return nil
+	}
+
+	if err := s.switchEmail(ctx, tx, change.UserID, change.NewEmail, change.OldEmail, change.OldEmailVerified); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.EmailChanges.UpdateOne(change).
+		SetStatus(emailchanges.StatusReverted).
+		SetRevertedAt(time.Now()).
+		Save(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to revert email change: %w", err)
+	}
+
+	// Changes requested after this one came from the same session
+	_, err = tx.EmailChanges.Update().
+		Where(
+			emailchanges.UserIDEQ(change.UserID),
+			emailchanges.StatusEQ(emailchanges.StatusPending),
+		).
+		SetStatus(emailchanges.StatusCancelled).
+		Save(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to cancel pending email changes: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if err := auth.RevokeUserTokens(ctx, s.cache, s.apiKeys, change.UserID); err != nil {
+		s.logger.Error("Failed to revoke tokens after email change revert", "user_id", change.UserID, "error", err)
+	}
+
+	s.audit(ctx, &change.UserID, "email.change_revert", "user", change.UserID.String(), map[string]interface{}{
+		"old_email": change.OldEmail,
+		"new_email": change.NewEmail,
+	})
+
+	return nil
+}
+
+// switchEmail moves a user from one address to another within a
+// transaction, invalidating verification and reset links sent to the
+// address being left
+func (s *AuthService) switchEmail(ctx context.Context, tx *ent.Tx, userID uuid.UUID, from, to string, verified bool) error {
+	taken, err := s.emailTaken(ctx, tx.Client(), to, userID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return fmt.Errorf("email already in use")
+	}
+
+	updated, err := tx.Users.Update().
+		Where(
+			users.IDEQ(userID),
+			users.EmailEQ(from),
+		).
+		SetEmail(to).
+		SetEmailVerified(verified).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to update email: %w", err)
+	}
+	if updated == 0 {
+		return fmt.Errorf("email has changed since this link was sent")
+	}
+
+	now := time.Now()
+	_, err = tx.EmailVerifications.Update().
+		Where(
+			emailverifications.UserIDEQ(userID),
+			emailverifications.IsUsedEQ(false),
+		).
+		SetIsUsed(true).
+		SetUsedAt(now).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to invalidate verification tokens: %w", err)
+	}
+
+	_, err = tx.PasswordResets.Update().
+		Where(
+			passwordresets.UserIDEQ(userID),
+			passwordresets.IsUsedEQ(false),
+		).
+		SetIsUsed(true).
+		SetUsedAt(now).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to invalidate password reset tokens: %w", err)
+	}
+
+	return nil
+}
+
+// emailTaken reports whether another account uses an email, ignoring case
+func (s *AuthService) emailTaken(ctx context.Context, client *ent.Client, email string, userID uuid.UUID) (bool, error) {
+	taken, err := client.Users.Query().
+		Where(
+			users.EmailEqualFold(email),
+			users.IDNEQ(userID),
+		).
+		Exist(ctx)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to check email: %w", err)
+	}
+	return taken, nil
+}
@@ -0,0 +1,397 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/invitations"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/common/audit"
+	"github.com/shammianand/go-auth/internal/config"
+	"github.com/shammianand/go-auth/internal/modules/auth/models"
+	emailmodels "github.com/shammianand/go-auth/internal/modules/email/models"
+)
+
+// errInvalidInvitation is returned for every token that cannot be accepted,
+// so callers learn nothing about which invitations exist
+const errInvalidInvitation = "invalid or expired invitation"
+
+// invitationKey returns the key invitation tokens are signed with
+func invitationKey(logger *slog.Logger) []byte {
+	if config.InvitationSecret != "" {
+		return []byte(config.InvitationSecret)
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(fmt.Sprintf("failed to generate invitation key: %v", err))
+	}
+	logger.Warn("INVITATION_SECRET is not set; invitation links will stop working when the server restarts")
+	return key
+}
+
+// CreateInvitation invites an email address and sends it a signed link.
+// Presetting roles requires rbac.assign because accepting the invitation
+// assigns them on the inviter's behalf.
+func (s *AuthService) CreateInvitation(ctx context.Context, req *models.CreateInvitationRequest, actorID uuid.UUID) (*models.InvitationResponse, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	roleIDs := uniqueRoleIDs(req.RoleIDs)
+
+	var roleNames []string
+	if len(roleIDs) > 0 {
+		allowed, err := s.rbac.HasPermission(ctx, actorID, "rbac.assign")
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, fmt.Errorf("missing permission to assign roles")
+		}
+
+		entRoles, err := s.client.Roles.Query().
+			Where(roles.IDIn(roleIDs...)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get roles: %w", err)
+		}
+		if len(entRoles) != len(roleIDs) {
+			return nil, fmt.Errorf("role not found")
+		}
+		for _, role := range entRoles {
+			roleNames = append(roleNames, role.Name)
+		}
+	}
+
+	pending, err := s.client.Invitations.Query().
+		Where(
+			invitations.EmailEQ(email),
+			invitations.StatusEQ(invitations.StatusPending),
+			invitations.ExpiresAtGT(time.Now()),
+		).
+		Exist(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to check invitations: %w", err)
+	}
+	if pending {
+		return nil, fmt.Errorf("a pending invitation already exists for this email")
+	}
+
+	ttl := config.InvitationTTL
+	if req.ExpiresInHours != nil {
+		ttl = time.Duration(*req.ExpiresInHours) * time.Hour
+	}
+
+	invitation, err := s.client.Invitations.Create().
+		SetEmail(email).
+		SetRoleIds(roleIDs).
+		SetInvitedBy(actorID).
+		SetExpiresAt(time.Now().Add(ttl)).
+		Save(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	inviterEmail := ""
+	if inviter, err := s.client.Users.Get(ctx, actorID); err == nil {
+		inviterEmail = inviter.Email
+	}
+
+	err = s.emailService.SendInvitationEmail(ctx, email, emailmodels.InvitationNotification{
+		InvitationID: invitation.ID.String(),
+		InviterEmail: inviterEmail,
+		RoleNames:    roleNames,
+		Token:        s.signInvitation(invitation),
+		ExpiresAt:    invitation.ExpiresAt,
+	})
+	if err != nil {
+		s.logger.Error("Failed to send invitation email", "invitation_id", invitation.ID, "error", err)
+	}
+
+	s.audit(ctx, &actorID, "invitation.create", "invitation", invitation.ID.String(), map[string]interface{}{
+		"email":      email,
+		"role_ids":   roleIDs,
+		"expires_at": invitation.ExpiresAt,
+	})
+
+	response := invitationToResponse(invitation)
+	return &response, nil
+}
+
+// ListInvitations returns invitations, newest first
+func (s *AuthService) ListInvitations(ctx context.Context, filter *models.InvitationFilter) ([]models.InvitationResponse, error) {
+	query := s.client.Invitations.Query()
+
+	now := time.Now()
+	switch filter.Status {
+	case "pending":
+		query = query.Where(invitations.StatusEQ(invitations.StatusPending), invitations.ExpiresAtGT(now))
+	case "expired":
+		query = query.Where(invitations.StatusEQ(invitations.StatusPending), invitations.ExpiresAtLTE(now))
+	case "accepted", "revoked":
+		query = query.Where(invitations.StatusEQ(invitations.Status(filter.Status)))
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = 50
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	entInvitations, err := query.
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Order(ent.Desc(invitations.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	result := make([]models.InvitationResponse, len(entInvitations))
+	for i, invitation := range entInvitations {
+		result[i] = invitationToResponse(invitation)
+	}
+
+	return result, nil
+}
+
+// RevokeInvitation revokes a pending invitation so its link no longer works
+func (s *AuthService) RevokeInvitation(ctx context.Context, invitationID, actorID uuid.UUID) error {
+	now := time.Now()
+	updated, err := s.client.Invitations.Update().
+		Where(
+			invitations.IDEQ(invitationID),
+			invitations.StatusEQ(invitations.StatusPending),
+		).
+		SetStatus(invitations.StatusRevoked).
+		SetRevokedBy(actorID).
+		SetRevokedAt(now).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to revoke invitation: %w", err)
+	}
+
+	if updated == 0 {
+		exists, err := s.client.Invitations.Query().Where(invitations.IDEQ(invitationID)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check invitation: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("invitation not found")
+		}
+		return fmt.Errorf("invitation is not pending")
+	}
+
+	s.audit(ctx, &actorID, "invitation.revoke", "invitation", invitationID.String(), map[string]interface{}{
+		"invitation_id": invitationID.String(),
+	})
+
+	return nil
+}
+
+// AcceptInvitation accepts an invitation. Without an account for the
+// invited email one is created with the given name and password; otherwise
+// the existing account is linked. Either way the email counts as verified,
+// since the link was delivered to it, and the invited roles are assigned on
+// behalf of the inviter. When a role cannot be assigned the invitation stays
+// pending, so it can be accepted again once the conflict is resolved.
+func (s *AuthService) AcceptInvitation(ctx context.Context, req *models.AcceptInvitationRequest) (*models.AcceptInvitationResponse, error) {
+	invitation, err := s.verifyInvitation(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.client.Users.Query().
+		Where(users.EmailEqualFold(invitation.Email)).
+		Only(ctx)
+
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	created := user == nil
+	if created {
+		if req.Password == "" || req.FirstName == "" || req.LastName == "" {
+			return nil, fmt.Errorf("first_name, last_name and password are required to create an account")
+		}
+
+		user, err = s.createUser(ctx, &models.SignupRequest{
+			Email:     invitation.Email,
+			Password:  req.Password,
+			FirstName: req.FirstName,
+			LastName:  req.LastName,
+		}, newAccount{emailVerified: true})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if !user.IsActive {
+			return nil, fmt.Errorf("user account is inactive")
+		}
+		if !user.EmailVerified {
+			user, err = user.Update().SetEmailVerified(true).Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to verify email: %w", err)
+			}
+		}
+	}
+
+	if len(invitation.RoleIds) > 0 {
+		actorID := user.ID
+		if invitation.InvitedBy != nil {
+			actorID = *invitation.InvitedBy
+		}
+		if err := s.rbac.AssignRoles(ctx, user.ID, invitation.RoleIds, actorID); err != nil {
+			return nil, fmt.Errorf("failed to assign invited roles: %w", err)
+		}
+	}
+
+	// Only the first of concurrent accepts marks the invitation
+	now := time.Now()
+	_, err = s.client.Invitations.Update().
+		Where(
+			invitations.IDEQ(invitation.ID),
+			invitations.StatusEQ(invitations.StatusPending),
+		).
+		SetStatus(invitations.StatusAccepted).
+		SetAcceptedBy(user.ID).
+		SetAcceptedAt(now).
+		Save(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+
+	s.audit(ctx, &user.ID, "invitation.accept", "invitation", invitation.ID.String(), map[string]interface{}{
+		"invitation_id":   invitation.ID.String(),
+		"user_id":         user.ID.String(),
+		"account_created": created,
+		"role_ids":        invitation.RoleIds,
+	})
+
+	if created {
+		_ = s.emailService.SendWelcomeEmail(ctx, user.ID, user.Email, user.FirstName)
+	}
+
+	roleIDs := invitation.RoleIds
+	if roleIDs == nil {
+		roleIDs = []int{}
+	}
+
+	return &models.AcceptInvitationResponse{
+		User: models.UserInfo{
+			ID:            user.ID,
+			Email:         user.Email,
+			FirstName:     user.FirstName,
+			LastName:      user.LastName,
+			EmailVerified: user.EmailVerified,
+			IsActive:      user.IsActive,
+			CreatedAt:     user.CreatedAt,
+			LastLogin:     user.LastLogin,
+		},
+		AccountCreated: created,
+		RoleIDs:        roleIDs,
+	}, nil
+}
+
+// verifyInvitation checks a token's signature and returns its invitation
+// while it is pending and unexpired
+func (s *AuthService) verifyInvitation(ctx context.Context, token string) (*ent.Invitations, error) {
+	rawID, _, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, fmt.Errorf(errInvalidInvitation)
+	}
+
+	invitationID, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, fmt.Errorf(errInvalidInvitation)
+	}
+
+	invitation, err := s.client.Invitations.Get(ctx, invitationID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf(errInvalidInvitation)
+		}
+		return nil, fmt.Errorf("failed to find invitation: %w", err)
+	}
+
+	if !hmac.Equal([]byte(token), []byte(s.signInvitation(invitation))) {
+		return nil, fmt.Errorf(errInvalidInvitation)
+	}
+
+	if invitation.Status != invitations.StatusPending || !invitation.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf(errInvalidInvitation)
+	}
+
+	return invitation, nil
+}
+
+// signInvitation returns the token for an invitation: its ID and an HMAC
+// over the ID, email and expiry, so none of them can be altered
+func (s *AuthService) signInvitation(invitation *ent.Invitations) string {
+	mac := hmac.New(sha256.New, s.invitationKey)
+	fmt.Fprintf(mac, "%s\n%s\n%d", invitation.ID, invitation.Email, invitation.ExpiresAt.Unix())
+	return invitation.ID.String() + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// audit records an auth event in the audit log
+func (s *AuthService) audit(ctx context.Context, actorID *uuid.UUID, actionType, resourceType, resourceID string, metadata map[string]interface{}) {
+	s.auditLog.Write(ctx, audit.Entry{
+		ActorID:      actorID,
+		ActionType:   actionType,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Metadata:     metadata,
+	})
+}
+
+func uniqueRoleIDs(roleIDs []int) []int {
+	seen := make(map[int]bool)
+	result := make([]int, 0, len(roleIDs))
+	for _, id := range roleIDs {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func invitationToResponse(invitation *ent.Invitations) models.InvitationResponse {
+	status := string(invitation.Status)
+	if invitation.Status == invitations.StatusPending && !invitation.ExpiresAt.After(time.Now()) {
+		status = "expired"
+	}
+
+	roleIDs := invitation.RoleIds
+	if roleIDs == nil {
+		roleIDs = []int{}
+	}
+
+	return models.InvitationResponse{
+		ID:         invitation.ID,
+		Email:      invitation.Email,
+		RoleIDs:    roleIDs,
+		Status:     status,
+		InvitedBy:  invitation.InvitedBy,
+		ExpiresAt:  invitation.ExpiresAt,
+		AcceptedBy: invitation.AcceptedBy,
+		AcceptedAt: invitation.AcceptedAt,
+		RevokedBy:  invitation.RevokedBy,
+		RevokedAt:  invitation.RevokedAt,
+		CreatedAt:  invitation.CreatedAt,
+	}
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/internal/config"
+	"github.com/shammianand/go-auth/internal/modules/email/service"
+)
+
+const (
+	lockoutFailPrefix  = "auth:lockout:fail:"
+	lockoutLockPrefix  = "auth:lockout:lock:"
+	lockoutDelayPrefix = "auth:lockout:delay:"
+)
+
+// LockoutPolicy holds the thresholds used to throttle failed signins
+type LockoutPolicy struct {
+	MaxAttempts   int           // Failures per account before it is locked
+	IPMaxAttempts int           // Failures per IP before it is locked
+	Duration      time.Duration // How long a lock lasts
+	Window        time.Duration // How long failures are remembered
+	BaseDelay     time.Duration // Delay after the first failure, doubled for each subsequent one
+	MaxDelay      time.Duration // Upper bound for the progressive delay
+}
+
+// DefaultLockoutPolicy returns the lockout policy from configuration
+func DefaultLockoutPolicy() LockoutPolicy {
+	return LockoutPolicy{
+		MaxAttempts:   config.LockoutMaxAttempts,
+		IPMaxAttempts: config.LockoutIPMaxAttempts,
+		Duration:      config.LockoutDuration,
+		Window:        config.LockoutWindow,
+		BaseDelay:     config.LockoutBaseDelay,
+		MaxDelay:      config.LockoutMaxDelay,
+	}
+}
+
+// LockedError is returned when signin attempts are being throttled
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return "too many failed signin attempts, please try again later"
+}
+
+// LockoutService tracks failed signin attempts per account and per IP
+type LockoutService struct {
+	cache        *redis.Client
+	emailService *service.EmailService
+	policy       LockoutPolicy
+	logger       *slog.Logger
+}
+
+// NewLockoutService creates a new lockout service
+func NewLockoutService(cache *redis.Client, emailService *service.EmailService, policy LockoutPolicy, logger *slog.Logger) *LockoutService {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &LockoutService{
+		cache:        cache,
+		emailService: emailService,
+		policy:       policy,
+		logger:       logger,
+	}
+}
+
+// Check returns a LockedError if the account or IP is currently locked or
+// still inside its backoff delay. Counters are keyed by email so the result
+// is the same whether or not the account exists.
+func (s *LockoutService) Check(ctx context.Context, email, ip string) error {
+	keys := []string{
+		lockoutLockPrefix + accountKey(email),
+		lockoutDelayPrefix + accountKey(email),
+	}
+	if ip != "" {
+		keys = append(keys, lockoutLockPrefix+ipKey(ip))
+	}
+
+	var retryAfter time.Duration
+	for _, key := range keys {
+		ttl, err := s.cache.PTTL(ctx, key).Result()
+		if err != nil {
+			return fmt.Errorf("failed to check lockout state: %w", err)
+		}
+		if ttl > retryAfter {
+			retryAfter = ttl
+		}
+	}
+
+	if retryAfter > 0 {
+		return &LockedError{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// RegisterFailure records a failed signin attempt. user may be nil when the
+// email does not belong to an account.
+func (s *LockoutService) RegisterFailure(ctx context.Context, email, ip string, user *ent.Users) error {
+	failures, err := s.increment(ctx, lockoutFailPrefix+accountKey(email))
+	if err != nil {
+		return err
+	}
+
+	if failures >= int64(s.policy.MaxAttempts) {
+		locked, err := s.cache.SetNX(ctx, lockoutLockPrefix+accountKey(email), failures, s.policy.Duration).Result()
+		if err != nil {
+			return fmt.Errorf("failed to lock account: %w", err)
+		}
+		s.cache.Del(ctx, lockoutFailPrefix+accountKey(email), lockoutDelayPrefix+accountKey(email))
+
+		if locked {
+			s.logger.Warn("Account locked after failed signin attempts", "email", email, "failures", failures)
+			if user != nil && s.emailService != nil {
+				err := s.emailService.SendAccountLockedEmail(ctx, user.ID, user.Email, user.FirstName, time.Now().Add(s.policy.Duration))
+				if err != nil {
+					s.logger.Error("Failed to send account locked email", "user_id", user.ID, "error", err)
+				}
+			}
+		}
+	} else if delay := s.delayFor(failures); delay > 0 {
+		err := s.cache.Set(ctx, lockoutDelayPrefix+accountKey(email), failures, delay).Err()
+		if err != nil {
+			return fmt.Errorf("failed to set signin delay: %w", err)
+		}
+	}
+
+	if ip == "" {
+		return nil
+	}
+
+	ipFailures, err := s.increment(ctx, lockoutFailPrefix+ipKey(ip))
+	if err != nil {
+		return err
+	}
+
+	if ipFailures >= int64(s.policy.IPMaxAttempts) {
+		err := s.cache.Set(ctx, lockoutLockPrefix+ipKey(ip), ipFailures, s.policy.Duration).Err()
+		if err != nil {
+			return fmt.Errorf("failed to lock IP: %w", err)
+		}
+		s.cache.Del(ctx, lockoutFailPrefix+ipKey(ip))
+		s.logger.Warn("IP locked after failed signin attempts", "ip", ip, "failures", ipFailures)
+	}
+
+	return nil
+}
+
+// RegisterSuccess clears the failure counters for an account after a successful signin
+func (s *LockoutService) RegisterSuccess(ctx context.Context, email string) error {
+	err := s.cache.Del(ctx,
+		lockoutFailPrefix+accountKey(email),
+		lockoutDelayPrefix+accountKey(email),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("failed to reset failed attempts: %w", err)
+	}
+	return nil
+}
+
+// UnlockAccount removes any lock, delay and failure counters for an account
+func (s *LockoutService) UnlockAccount(ctx context.Context, email string) error {
+	err := s.cache.Del(ctx,
+		lockoutFailPrefix+accountKey(email),
+		lockoutLockPrefix+accountKey(email),
+		lockoutDelayPrefix+accountKey(email),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("failed to unlock account: %w", err)
+	}
+
+	s.logger.Info("Account unlocked", "email", email)
+	return nil
+}
+
+// UnlockIP removes any lock and failure counters for an IP address
+func (s *LockoutService) UnlockIP(ctx context.Context, ip string) error {
+	err := s.cache.Del(ctx,
+		lockoutFailPrefix+ipKey(ip),
+		lockoutLockPrefix+ipKey(ip),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("failed to unlock IP: %w", err)
+	}
+
+	s.logger.Info("IP unlocked", "ip", ip)
+	return nil
+}
+
+// failureCountScript increments a failure counter and starts its window on
+// the first failure, atomically so a counter can never be left without an
+// expiry. Later failures do not extend the window. It returns the count.
+var failureCountScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if redis.call('PTTL', KEYS[1]) < 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return count
+`)
+
+func (s *LockoutService) increment(ctx context.Context, key string) (int64, error) {
+	count, err := failureCountScript.Run(ctx, s.cache, []string{key}, s.policy.Window.Milliseconds()).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to record failed attempt: %w", err)
+	}
+	return count, nil
+}
+
+// delayFor returns the exponential backoff delay after the given number of failures
+func (s *LockoutService) delayFor(failures int64) time.Duration {
+	if s.policy.BaseDelay <= 0 || failures <= 0 {
+		return 0
+	}
+
+	delay := s.policy.BaseDelay
+	for i := int64(1); i < failures; i++ {
+		delay *= 2
+		if delay >= s.policy.MaxDelay {
+			return s.policy.MaxDelay
+		}
+	}
+	return delay
+}
+
+func accountKey(email string) string {
+	return "email:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
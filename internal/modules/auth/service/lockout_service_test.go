@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestLockoutDelayFor(t *testing.T) {
+	policy := LockoutPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	tests := []struct {
+		name     string
+		policy   LockoutPolicy
+		failures int64
+		want     time.Duration
+	}{
+		{"no failures", policy, 0, 0},
+		{"negative failures", policy, -1, 0},
+		{"first failure", policy, 1, time.Second},
+		{"second failure doubles", policy, 2, 2 * time.Second},
+		{"fourth failure", policy, 4, 8 * time.Second},
+		{"capped at max delay", policy, 5, 10 * time.Second},
+		{"stays capped", policy, 1000, 10 * time.Second},
+		{"exactly max delay", LockoutPolicy{BaseDelay: time.Second, MaxDelay: 4 * time.Second}, 3, 4 * time.Second},
+		{"delay disabled", LockoutPolicy{MaxDelay: time.Minute}, 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewLockoutService(nil, nil, tt.policy, nil)
+			if got := s.delayFor(tt.failures); got != tt.want {
+				t.Errorf("delayFor(%d) = %v, want %v", tt.failures, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLockoutIncrement needs a Redis server, e.g. TEST_REDIS_ADDR=localhost:6379
+func TestLockoutIncrement(t *testing.T) {
+	addr := os.Getenv("TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("TEST_REDIS_ADDR not set")
+	}
+
+	ctx := context.Background()
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	defer client.Close()
+
+	s := NewLockoutService(client, nil, LockoutPolicy{Window: time.Minute}, nil)
+	key := lockoutFailPrefix + "test:" + uuid.NewString()
+	defer client.Del(ctx, key)
+
+	for want := int64(1); want <= 3; want++ {
+		got, err := s.increment(ctx, key)
+		if err != nil {
+			t.Fatalf("increment returned error: %v", err)
+		}
+		if got != want {
+			t.Fatalf("increment = %d, want %d", got, want)
+		}
+	}
+
+	ttl, err := client.PTTL(ctx, key).Result()
+	if err != nil {
+		t.Fatalf("PTTL returned error: %v", err)
+	}
+	if ttl <= 0 || ttl > time.Minute {
+		t.Fatalf("counter TTL = %v, want within (0, 1m]", ttl)
+	}
+
+	// A counter that lost its expiry gets one on the next failure
+	if err := client.Persist(ctx, key).Err(); err != nil {
+		t.Fatalf("PERSIST returned error: %v", err)
+	}
+	if _, err := s.increment(ctx, key); err != nil {
+		t.Fatalf("increment returned error: %v", err)
+	}
+	if ttl := client.PTTL(ctx, key).Val(); ttl <= 0 {
+		t.Fatalf("counter TTL after increment = %v, want an expiry", ttl)
+	}
+}
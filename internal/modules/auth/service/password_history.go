@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/passwordhistories"
+	"github.com/shammianand/go-auth/internal/auth"
+	"github.com/shammianand/go-auth/internal/modules/auth/policy"
+)
+
+// validateNewPassword checks a password a user is about to set against the
+// password policy and, for existing users, their recent password history.
+func (s *AuthService) validateNewPassword(ctx context.Context, field, password string, user *ent.Users, info policy.UserInfo) error {
+	if err := s.passwordValidator.Validate(field, password, info); err != nil {
+		return err
+	}
+
+	if user == nil {
+		return nil
+	}
+
+	historySize := s.passwordValidator.Policy().HistorySize
+	if historySize <= 0 {
+		return nil
+	}
+
+	if auth.ComparePasswords(user.PasswordHash, []byte(password)) {
+		return s.passwordValidator.RecentlyUsedError(field)
+	}
+
+	history, err := s.client.PasswordHistories.Query().
+		Where(passwordhistories.UserIDEQ(user.ID)).
+		Order(ent.Desc(passwordhistories.FieldCreatedAt)).
+		Limit(historySize).
+		All(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to query password history: %w", err)
+	}
+
+	for _, entry := range history {
+		if auth.ComparePasswords(entry.PasswordHash, []byte(password)) {
+			return s.passwordValidator.RecentlyUsedError(field)
+		}
+	}
+
+	return nil
+}
+
+// recordPasswordHistory stores a newly set password hash and prunes entries
+// beyond the configured history size. Failures are logged and never block the
+// password change.
+func (s *AuthService) recordPasswordHistory(ctx context.Context, userID uuid.UUID, passwordHash string) {
+	historySize := s.passwordValidator.Policy().HistorySize
+	if historySize <= 0 {
+		return
+	}
+
+	_, err := s.client.PasswordHistories.Create().
+		SetUserID(userID).
+		SetPasswordHash(passwordHash).
+		Save(ctx)
+
+	if err != nil {
+		s.logger.Error("Failed to record password history", "user_id", userID, "error", err)
+		return
+	}
+
+	stale, err := s.client.PasswordHistories.Query().
+		Where(passwordhistories.UserIDEQ(userID)).
+		Order(ent.Desc(passwordhistories.FieldCreatedAt)).
+		Offset(historySize).
+		IDs(ctx)
+
+	if err != nil {
+		s.logger.Error("Failed to query stale password history", "user_id", userID, "error", err)
+		return
+	}
+
+	if len(stale) > 0 {
+		_, err = s.client.PasswordHistories.Delete().
+			Where(passwordhistories.IDIn(stale...)).
+			Exec(ctx)
+
+		if err != nil {
+			s.logger.Error("Failed to prune password history", "user_id", userID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/modules/auth/models"
+	emailmodels "github.com/shammianand/go-auth/internal/modules/email/models"
+)
+
+// ListSignups returns signups awaiting review, oldest first, or rejected
+// signups, newest first
+func (s *AuthService) ListSignups(ctx context.Context, filter *models.SignupFilter) ([]models.SignupReviewResponse, error) {
+	query := s.client.Users.Query()
+
+	if filter.Status == "rejected" {
+		query = query.
+			Where(users.ApprovalStatusEQ(users.ApprovalStatusRejected)).
+			Order(ent.Desc(users.FieldCreatedAt))
+	} else {
+		query = query.
+			Where(users.ApprovalStatusEQ(users.ApprovalStatusPending)).
+			Order(ent.Asc(users.FieldCreatedAt))
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = 50
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	entUsers, err := query.
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list signups: %w", err)
+	}
+
+	result := make([]models.SignupReviewResponse, len(entUsers))
+	for i, user := range entUsers {
+		result[i] = models.SignupReviewResponse{
+			ID:             user.ID,
+			Email:          user.Email,
+			FirstName:      user.FirstName,
+			LastName:       user.LastName,
+			EmailVerified:  user.EmailVerified,
+			ApprovalStatus: string(user.ApprovalStatus),
+			ReviewedBy:     user.ReviewedBy,
+			ReviewedAt:     user.ReviewedAt,
+			CreatedAt:      user.CreatedAt,
+		}
+	}
+
+	return result, nil
+}
+
+// ApproveSignup activates a pending signup. Rejected signups can be
+// approved too, in case an admin changes their mind.
+func (s *AuthService) ApproveSignup(ctx context.Context, userID, actorID uuid.UUID) error {
+	user, err := s.reviewSignup(ctx, userID, actorID, users.ApprovalStatusApproved,
+		users.ApprovalStatusPending, users.ApprovalStatusRejected)
+	if err != nil {
+		return err
+	}
+
+	err = s.emailService.SendSignupDecisionEmail(ctx, user.ID, user.Email, user.FirstName, emailmodels.SignupDecisionNotification{
+		Status: "approved",
+	})
+	if err != nil {
+		s.logger.Error("Failed to send signup decision email", "user_id", user.ID, "error", err)
+	}
+
+	s.audit(ctx, &actorID, "signup.approve", "user", user.ID.String(), map[string]interface{}{
+		"email": user.Email,
+	})
+
+	return nil
+}
+
+// RejectSignup rejects a pending signup. The account stays inactive and
+// keeps its email address until it is deleted.
+func (s *AuthService) RejectSignup(ctx context.Context, userID, actorID uuid.UUID, reason string) error {
+	user, err := s.reviewSignup(ctx, userID, actorID, users.ApprovalStatusRejected,
+		users.ApprovalStatusPending)
+	if err != nil {
+		return err
+	}
+
+	err = s.emailService.SendSignupDecisionEmail(ctx, user.ID, user.Email, user.FirstName, emailmodels.SignupDecisionNotification{
+		Status: "rejected",
+		Reason: reason,
+	})
+	if err != nil {
+		s.logger.Error("Failed to send signup decision email", "user_id", user.ID, "error", err)
+	}
+
+	s.audit(ctx, &actorID, "signup.reject", "user", user.ID.String(), map[string]interface{}{
+		"email":  user.Email,
+		"reason": reason,
+	})
+
+	return nil
+}
+
+// reviewSignup moves a signup from one of the given states to status.
+// The update is conditional so concurrent reviews cannot both succeed.
+func (s *AuthService) reviewSignup(ctx context.Context, userID, actorID uuid.UUID, status users.ApprovalStatus, from ...users.ApprovalStatus) (*ent.Users, error) {
+	updated, err := s.client.Users.Update().
+		Where(
+			users.IDEQ(userID),
+			users.ApprovalStatusIn(from...),
+		).
+		SetApprovalStatus(status).
+		SetIsActive(status == users.ApprovalStatusApproved).
+		SetReviewedBy(actorID).
+		SetReviewedAt(time.Now()).
+		Save(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update signup: %w", err)
+	}
+
+	user, err := s.client.Users.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if updated == 0 {
+		return nil, fmt.Errorf("signup is not awaiting review")
+	}
+
+	return user, nil
+}
@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/consent/models"
+	"github.com/shammianand/go-auth/internal/modules/consent/service"
+)
+
+// ConsentController handles consent document and acceptance HTTP requests
+type ConsentController struct {
+	service *service.ConsentService
+	logger  *slog.Logger
+}
+
+// NewConsentController creates a new consent controller
+func NewConsentController(service *service.ConsentService, logger *slog.Logger) *ConsentController {
+	return &ConsentController{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// CurrentDocuments returns the version of each document in effect, for
+// showing before signup or signin
+func (cc *ConsentController) CurrentDocuments(c *gin.Context) {
+	docs, err := cc.service.CurrentDocuments(c.Request.Context())
+	if err != nil {
+		respondConsentError(c, "Failed to get documents", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Documents retrieved successfully", docs)
+}
+
+// GetMyConsents returns the authenticated user's consent state
+func (cc *ConsentController) GetMyConsents(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	status, err := cc.service.Status(c.Request.Context(), userID)
+	if err != nil {
+		respondConsentError(c, "Failed to get consents", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Consents retrieved successfully", status)
+}
+
+// AcceptConsents records the authenticated user's acceptance of documents
+func (cc *ConsentController) AcceptConsents(c *gin.Context) {
+	var req models.AcceptConsentRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := cc.service.AcceptConsents(ctx, userID, req.DocumentIDs, c.ClientIP(), c.Request.UserAgent()); err != nil {
+		respondConsentError(c, "Failed to accept documents", err)
+		return
+	}
+
+	status, err := cc.service.Status(ctx, userID)
+	if err != nil {
+		respondConsentError(c, "Failed to get consents", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Documents accepted", status)
+}
+
+// PublishDocument publishes a new document version
+func (cc *ConsentController) PublishDocument(c *gin.Context) {
+	var req models.PublishDocumentRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	actorID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	doc, err := cc.service.PublishDocument(c.Request.Context(), &req, actorID)
+	if err != nil {
+		respondConsentError(c, "Failed to publish document", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Created, "Document published successfully", doc)
+}
+
+// ListDocuments returns every document version
+func (cc *ConsentController) ListDocuments(c *gin.Context) {
+	var filter models.DocumentFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid query parameters", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	docs, err := cc.service.ListDocuments(c.Request.Context(), &filter)
+	if err != nil {
+		respondConsentError(c, "Failed to list documents", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Documents retrieved successfully", docs)
+}
+
+// Report summarises acceptance of the current documents
+func (cc *ConsentController) Report(c *gin.Context) {
+	report, err := cc.service.Report(c.Request.Context())
+	if err != nil {
+		respondConsentError(c, "Failed to build consent report", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Consent report generated", report)
+}
+
+// PendingUsers lists active users who still have to accept a document type
+func (cc *ConsentController) PendingUsers(c *gin.Context) {
+	var filter models.PendingFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid query parameters", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	pending, err := cc.service.PendingUsers(c.Request.Context(), &filter)
+	if err != nil {
+		respondConsentError(c, "Failed to list pending users", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Pending users retrieved successfully", pending)
+}
+
+// GetUserConsents returns any user's consent state and acceptance history
+func (cc *ConsentController) GetUserConsents(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid user ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	status, err := cc.service.Status(c.Request.Context(), userID)
+	if err != nil {
+		respondConsentError(c, "Failed to get consents", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Consents retrieved successfully", status)
+}
+
+// respondConsentError maps consent errors to responses
+func respondConsentError(c *gin.Context, message string, err error) {
+	msg := err.Error()
+	switch msg {
+	case "user not found", "consent document not found":
+		utils.RespondError(c, types.HTTP.NotFound, message, "NOT_FOUND", msg)
+	case "document version already exists":
+		utils.RespondError(c, types.HTTP.Conflict, message, "CONFLICT", msg)
+	case "consent document is not the current version", "published_at must not be in the past", "type and version are required":
+		utils.RespondError(c, types.HTTP.BadRequest, message, "VALIDATION_ERROR", msg)
+	default:
+		utils.RespondError(c, types.HTTP.InternalServerError, message, "CONSENT_ERROR", msg)
+	}
+}
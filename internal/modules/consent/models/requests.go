@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PublishDocumentRequest publishes a new version of a consent document.
+// Without published_at the version takes effect immediately; mandatory
+// defaults to true.
+type PublishDocumentRequest struct {
+	Type        string     `json:"type" binding:"required,max=50"`
+	Version     string     `json:"version" binding:"required,max=50"`
+	Title       string     `json:"title" binding:"required,max=200"`
+	URL         string     `json:"url" binding:"omitempty,url"`
+	Content     string     `json:"content"`
+	Mandatory   *bool      `json:"mandatory"`
+	PublishedAt *time.Time `json:"published_at"`
+}
+
+// AcceptConsentRequest accepts the given document versions
+type AcceptConsentRequest struct {
+	DocumentIDs []uuid.UUID `json:"document_ids" binding:"required,min=1"`
+}
+
+// DocumentFilter filters the admin document listing
+type DocumentFilter struct {
+	Type string `form:"type"`
+}
+
+// PendingFilter selects the active users who have not accepted the
+// current mandatory version of a document type
+type PendingFilter struct {
+	Type   string `form:"type" binding:"required"`
+	Limit  int    `form:"limit"`
+	Offset int    `form:"offset"`
+}
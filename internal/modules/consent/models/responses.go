@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ConsentDocumentResponse represents one version of a consent document
+type ConsentDocumentResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Type        string     `json:"type"`
+	Version     string     `json:"version"`
+	Title       string     `json:"title"`
+	URL         string     `json:"url,omitempty"`
+	Content     string     `json:"content,omitempty"`
+	Mandatory   bool       `json:"mandatory"`
+	PublishedAt time.Time  `json:"published_at"`
+	CreatedBy   *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ConsentAcceptanceResponse records one accepted document version
+type ConsentAcceptanceResponse struct {
+	DocumentID   uuid.UUID `json:"document_id"`
+	DocumentType string    `json:"document_type"`
+	Version      string    `json:"version"`
+	IPAddress    string    `json:"ip_address,omitempty"`
+	UserAgent    string    `json:"user_agent,omitempty"`
+	AcceptedAt   time.Time `json:"accepted_at"`
+}
+
+// ConsentDocumentStatus is a current document and whether the user has
+// accepted it
+type ConsentDocumentStatus struct {
+	ConsentDocumentResponse
+	Accepted   bool       `json:"accepted"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+}
+
+// ConsentStatusResponse is a user's consent state: the current documents,
+// the mandatory ones they still have to accept and every acceptance on
+// record
+type ConsentStatusResponse struct {
+	UserID   uuid.UUID                   `json:"user_id"`
+	Current  []ConsentDocumentStatus     `json:"current"`
+	Required []ConsentDocumentResponse   `json:"required"`
+	History  []ConsentAcceptanceResponse `json:"history"`
+}
+
+// ConsentReportEntry summarises acceptance of a current document among
+// active users
+type ConsentReportEntry struct {
+	Document ConsentDocumentResponse `json:"document"`
+	Accepted int                     `json:"accepted"`
+	Pending  int                     `json:"pending"`
+}
+
+// ConsentReport summarises consent across active users
+type ConsentReport struct {
+	GeneratedAt time.Time            `json:"generated_at"`
+	ActiveUsers int                  `json:"active_users"`
+	Documents   []ConsentReportEntry `json:"documents"`
+}
+
+// PendingUser is an active user who has not accepted the current mandatory
+// version of a document
+type PendingUser struct {
+	ID        uuid.UUID  `json:"id"`
+	Email     string     `json:"email"`
+	LastLogin *time.Time `json:"last_login,omitempty"`
+}
@@ -0,0 +1,38 @@
+package consent
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/modules/consent/controller"
+	"github.com/shammianand/go-auth/internal/modules/consent/service"
+)
+
+// RegisterRoutes registers consent routes. The service is built by the
+// caller because token issuance also uses it to enforce consent.
+func RegisterRoutes(router *gin.RouterGroup, consentService *service.ConsentService, cache *redis.Client, keys middleware.APIKeyVerifier, rbac middleware.PermissionChecker, logger *slog.Logger) {
+	consentController := controller.NewConsentController(consentService, logger)
+
+	router.GET("/consents/documents", consentController.CurrentDocuments)
+
+	// Consent has to come from the user themselves, not an impersonating
+	// admin or a script
+	myConsents := router.Group("/auth/consents")
+	myConsents.Use(middleware.RequireAuth(cache, keys))
+	{
+		myConsents.GET("", consentController.GetMyConsents)
+		myConsents.POST("", middleware.DenyImpersonation(), middleware.DenyAPIKey(), consentController.AcceptConsents)
+	}
+
+	adminConsents := router.Group("/admin/consents")
+	adminConsents.Use(middleware.RequireAuth(cache, keys))
+	{
+		adminConsents.POST("/documents", middleware.RequirePermission(rbac, "consents.write"), consentController.PublishDocument)
+		adminConsents.GET("/documents", middleware.RequirePermission(rbac, "consents.read"), consentController.ListDocuments)
+		adminConsents.GET("/report", middleware.RequirePermission(rbac, "consents.read"), consentController.Report)
+		adminConsents.GET("/pending", middleware.RequirePermission(rbac, "consents.read"), consentController.PendingUsers)
+		adminConsents.GET("/users/:id", middleware.RequirePermission(rbac, "consents.read"), consentController.GetUserConsents)
+	}
+}
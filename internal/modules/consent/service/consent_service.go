@@ -0,0 +1,538 @@
+package service
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/consentacceptances"
+	"github.com/shammianand/go-auth/ent/consentdocuments"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/auth"
+	"github.com/shammianand/go-auth/internal/common/audit"
+	"github.com/shammianand/go-auth/internal/modules/consent/models"
+)
+
+// ConsentService publishes consent documents, records acceptances and
+// decides whether a user has accepted everything mandatory.
+//
+// For each document type the baseline is the newest published mandatory
+// version. A user satisfies a type by accepting the baseline or any version
+// published after it, so optional updates never lock anyone out.
+type ConsentService struct {
+	client   *ent.Client
+	auditLog *audit.Writer
+	logger   *slog.Logger
+}
+
+// NewConsentService creates a new consent service
+func NewConsentService(client *ent.Client, logger *slog.Logger) *ConsentService {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &ConsentService{
+		client:   client,
+		auditLog: audit.NewWriter(client, logger),
+		logger:   logger,
+	}
+}
+
+// PublishDocument publishes a new version of a consent document. Versions
+// cannot be backdated so acceptances recorded earlier keep their meaning.
+func (s *ConsentService) PublishDocument(ctx context.Context, req *models.PublishDocumentRequest, actorID uuid.UUID) (*models.ConsentDocumentResponse, error) {
+	docType := strings.ToLower(strings.TrimSpace(req.Type))
+	version := strings.TrimSpace(req.Version)
+	if docType == "" || version == "" {
+		return nil, fmt.Errorf("type and version are required")
+	}
+
+	publishedAt := time.Now()
+	if req.PublishedAt != nil {
+		if req.PublishedAt.Before(publishedAt) {
+			return nil, fmt.Errorf("published_at must not be in the past")
+		}
+		publishedAt = *req.PublishedAt
+	}
+
+	mandatory := true
+	if req.Mandatory != nil {
+		mandatory = *req.Mandatory
+	}
+
+	exists, err := s.client.ConsentDocuments.Query().
+		Where(
+			consentdocuments.TypeEQ(docType),
+			consentdocuments.VersionEQ(version),
+		).
+		Exist(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to check document: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("document version already exists")
+	}
+
+	doc, err := s.client.ConsentDocuments.Create().
+		SetType(docType).
+		SetVersion(version).
+		SetTitle(strings.TrimSpace(req.Title)).
+		SetURL(req.URL).
+		SetContent(req.Content).
+		SetMandatory(mandatory).
+		SetPublishedAt(publishedAt).
+		SetCreatedBy(actorID).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("document version already exists")
+		}
+		return nil, fmt.Errorf("failed to publish document: %w", err)
+	}
+
+	s.audit(ctx, actorID, "consent.document_publish", doc.ID.String(), map[string]interface{}{
+		"type":         doc.Type,
+		"version":      doc.Version,
+		"mandatory":    doc.Mandatory,
+		"published_at": doc.PublishedAt.UTC().Format(time.RFC3339),
+	})
+
+	resp := documentToResponse(doc)
+	return &resp, nil
+}
+
+// ListDocuments returns every version, including scheduled ones, newest
+// first within each type
+func (s *ConsentService) ListDocuments(ctx context.Context, filter *models.DocumentFilter) ([]models.ConsentDocumentResponse, error) {
+	query := s.client.ConsentDocuments.Query()
+	if filter.Type != "" {
+		query = query.Where(consentdocuments.TypeEQ(strings.ToLower(filter.Type)))
+	}
+
+	docs, err := query.
+		Order(ent.Asc(consentdocuments.FieldType), ent.Desc(consentdocuments.FieldPublishedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+
+	return documentsToResponses(docs), nil
+}
+
+// CurrentDocuments returns the version of each document type in effect now
+func (s *ConsentService) CurrentDocuments(ctx context.Context) ([]models.ConsentDocumentResponse, error) {
+	docs, err := s.published(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return documentsToResponses(current(docs)), nil
+}
+
+// AcceptConsents records that a user accepted documents. Only the version
+// in effect for each type can be accepted; accepting it again is a no-op.
+func (s *ConsentService) AcceptConsents(ctx context.Context, userID uuid.UUID, documentIDs []uuid.UUID, ip, userAgent string) error {
+	docs, err := s.published(ctx)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[uuid.UUID]bool, len(docs))
+	for _, doc := range docs {
+		known[doc.ID] = true
+	}
+	currentByID := make(map[uuid.UUID]*ent.ConsentDocuments)
+	for _, doc := range current(docs) {
+		currentByID[doc.ID] = doc
+	}
+
+	seen := make(map[uuid.UUID]bool, len(documentIDs))
+	for _, documentID := range documentIDs {
+		if seen[documentID] {
+			continue
+		}
+		seen[documentID] = true
+
+		doc, ok := currentByID[documentID]
+		if !ok {
+			if known[documentID] {
+				return fmt.Errorf("consent document is not the current version")
+			}
+			return fmt.Errorf("consent document not found")
+		}
+
+		accepted, err := s.client.ConsentAcceptances.Query().
+			Where(
+				consentacceptances.UserIDEQ(userID),
+				consentacceptances.DocumentIDEQ(doc.ID),
+			).
+			Exist(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to check consent: %w", err)
+		}
+		if accepted {
+			continue
+		}
+
+		_, err = s.client.ConsentAcceptances.Create().
+			SetUserID(userID).
+			SetDocumentID(doc.ID).
+			SetDocumentType(doc.Type).
+			SetVersion(doc.Version).
+			SetIPAddress(ip).
+			SetUserAgent(userAgent).
+			Save(ctx)
+
+		if err != nil {
+			// Accepted concurrently by another request
+			if ent.IsConstraintError(err) {
+				continue
+			}
+			return fmt.Errorf("failed to record consent: %w", err)
+		}
+
+		s.audit(ctx, userID, "consent.accept", doc.ID.String(), map[string]interface{}{
+			"type":       doc.Type,
+			"version":    doc.Version,
+			"ip_address": ip,
+		})
+	}
+
+	return nil
+}
+
+// AcceptedVersions returns the newest accepted version of each document
+// type, or a *auth.ConsentRequiredError listing the documents to accept
+// while a mandatory one is pending
+func (s *ConsentService) AcceptedVersions(ctx context.Context, userID uuid.UUID) (map[string]string, error) {
+	docs, err := s.published(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptances, err := s.acceptances(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if required := pending(docs, acceptances); len(required) > 0 {
+		consentErr := &auth.ConsentRequiredError{}
+		for _, doc := range required {
+			consentErr.Documents = append(consentErr.Documents, auth.ConsentDocument{
+				ID:      doc.ID,
+				Type:    doc.Type,
+				Version: doc.Version,
+				Title:   doc.Title,
+				URL:     doc.URL,
+			})
+		}
+		return nil, consentErr
+	}
+
+	// docs are newest first, so the first accepted version of a type wins
+	versions := make(map[string]string)
+	for _, doc := range docs {
+		if _, ok := acceptances[doc.ID]; ok {
+			if _, seen := versions[doc.Type]; !seen {
+				versions[doc.Type] = doc.Version
+			}
+		}
+	}
+	return versions, nil
+}
+
+// Status returns a user's consent state with every acceptance on record
+func (s *ConsentService) Status(ctx context.Context, userID uuid.UUID) (*models.ConsentStatusResponse, error) {
+	exists, err := s.client.Users.Query().Where(users.IDEQ(userID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	docs, err := s.published(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptances, err := s.acceptances(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &models.ConsentStatusResponse{
+		UserID:   userID,
+		Current:  []models.ConsentDocumentStatus{},
+		Required: documentsToResponses(pending(docs, acceptances)),
+		History:  make([]models.ConsentAcceptanceResponse, 0, len(acceptances)),
+	}
+
+	for _, doc := range current(docs) {
+		entry := models.ConsentDocumentStatus{ConsentDocumentResponse: documentToResponse(doc)}
+		if acceptance, ok := acceptances[doc.ID]; ok {
+			entry.Accepted = true
+			entry.AcceptedAt = &acceptance.AcceptedAt
+		}
+		status.Current = append(status.Current, entry)
+	}
+
+	for _, acceptance := range acceptances {
+		status.History = append(status.History, models.ConsentAcceptanceResponse{
+			DocumentID:   acceptance.DocumentID,
+			DocumentType: acceptance.DocumentType,
+			Version:      acceptance.Version,
+			IPAddress:    acceptance.IPAddress,
+			UserAgent:    acceptance.UserAgent,
+			AcceptedAt:   acceptance.AcceptedAt,
+		})
+	}
+	sort.Slice(status.History, func(i, j int) bool {
+		return status.History[i].AcceptedAt.Before(status.History[j].AcceptedAt)
+	})
+
+	return status, nil
+}
+
+// Report counts, for each current document, the active users who accepted
+// it and those who still have to accept a mandatory version of its type
+func (s *ConsentService) Report(ctx context.Context) (*models.ConsentReport, error) {
+	docs, err := s.published(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	activeUsers, err := s.client.Users.Query().Where(users.IsActiveEQ(true)).Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	report := &models.ConsentReport{
+		GeneratedAt: time.Now(),
+		ActiveUsers: activeUsers,
+		Documents:   []models.ConsentReportEntry{},
+	}
+
+	for _, doc := range current(docs) {
+		accepted, err := s.client.Users.Query().
+			Where(users.IsActiveEQ(true), acceptedAny([]uuid.UUID{doc.ID})).
+			Count(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to count acceptances: %w", err)
+		}
+
+		entry := models.ConsentReportEntry{
+			Document: documentToResponse(doc),
+			Accepted: accepted,
+		}
+
+		if acceptable := acceptableIDs(docs, doc.Type); len(acceptable) > 0 {
+			satisfied, err := s.client.Users.Query().
+				Where(users.IsActiveEQ(true), acceptedAny(acceptable)).
+				Count(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to count acceptances: %w", err)
+			}
+			entry.Pending = activeUsers - satisfied
+		}
+
+		report.Documents = append(report.Documents, entry)
+	}
+
+	return report, nil
+}
+
+// PendingUsers lists the active users who have not accepted the current
+// mandatory version of a document type
+func (s *ConsentService) PendingUsers(ctx context.Context, filter *models.PendingFilter) ([]models.PendingUser, error) {
+	docs, err := s.published(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptable := acceptableIDs(docs, strings.ToLower(filter.Type))
+	if len(acceptable) == 0 {
+		return []models.PendingUser{}, nil
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = 50
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	pendingUsers, err := s.client.Users.Query().
+		Where(users.IsActiveEQ(true), users.Not(acceptedAny(acceptable))).
+		Order(ent.Asc(users.FieldEmail)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	result := make([]models.PendingUser, len(pendingUsers))
+	for i, user := range pendingUsers {
+		result[i] = models.PendingUser{ID: user.ID, Email: user.Email}
+		if !user.LastLogin.IsZero() {
+			lastLogin := user.LastLogin
+			result[i].LastLogin = &lastLogin
+		}
+	}
+	return result, nil
+}
+
+// published returns the versions in effect now or earlier, newest first
+func (s *ConsentService) published(ctx context.Context) ([]*ent.ConsentDocuments, error) {
+	docs, err := s.client.ConsentDocuments.Query().
+		Where(consentdocuments.PublishedAtLTE(time.Now())).
+		Order(ent.Desc(consentdocuments.FieldPublishedAt), ent.Desc(consentdocuments.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+	return docs, nil
+}
+
+// acceptances returns a user's acceptances keyed by document
+func (s *ConsentService) acceptances(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]*ent.ConsentAcceptances, error) {
+	rows, err := s.client.ConsentAcceptances.Query().
+		Where(consentacceptances.UserIDEQ(userID)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consents: %w", err)
+	}
+
+	result := make(map[uuid.UUID]*ent.ConsentAcceptances, len(rows))
+	for _, row := range rows {
+		result[row.DocumentID] = row
+	}
+	return result, nil
+}
+
+// audit records a consent change
+func (s *ConsentService) audit(ctx context.Context, actorID uuid.UUID, actionType, documentID string, metadata map[string]interface{}) {
+	s.auditLog.Write(ctx, audit.Entry{
+		ActorID:      &actorID,
+		ActionType:   actionType,
+		ResourceType: "consent_document",
+		ResourceID:   documentID,
+		Metadata:     metadata,
+	})
+}
+
+// current returns the newest version of each type from published
+// documents sorted newest first, ordered by type
+func current(docs []*ent.ConsentDocuments) []*ent.ConsentDocuments {
+	seen := make(map[string]bool)
+	result := []*ent.ConsentDocuments{}
+	for _, doc := range docs {
+		if !seen[doc.Type] {
+			seen[doc.Type] = true
+			result = append(result, doc)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Type < result[j].Type
+	})
+	return result
+}
+
+// acceptableIDs returns the versions of a type that satisfy its newest
+// mandatory version, or nil when the type has no mandatory version
+func acceptableIDs(docs []*ent.ConsentDocuments, docType string) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, doc := range docs {
+		if doc.Type != docType {
+			continue
+		}
+		ids = append(ids, doc.ID)
+		if doc.Mandatory {
+			return ids
+		}
+	}
+	return nil
+}
+
+// pending returns the current version of each type whose mandatory
+// baseline the user has not satisfied
+func pending(docs []*ent.ConsentDocuments, acceptances map[uuid.UUID]*ent.ConsentAcceptances) []*ent.ConsentDocuments {
+	result := []*ent.ConsentDocuments{}
+	for _, doc := range current(docs) {
+		acceptable := acceptableIDs(docs, doc.Type)
+		if len(acceptable) == 0 {
+			continue
+		}
+
+		satisfied := false
+		for _, id := range acceptable {
+			if _, ok := acceptances[id]; ok {
+				satisfied = true
+				break
+			}
+		}
+		if !satisfied {
+			result = append(result, doc)
+		}
+	}
+	return result
+}
+
+// acceptedAny matches users who accepted any of the documents
+func acceptedAny(documentIDs []uuid.UUID) predicate.Users {
+	return predicate.Users(func(sel *sql.Selector) {
+		values := make([]driver.Value, len(documentIDs))
+		for i, id := range documentIDs {
+			values[i] = id
+		}
+
+		t := sql.Table(consentacceptances.Table)
+		sel.Where(sql.In(
+			sel.C(users.FieldID),
+			sql.Select(t.C(consentacceptances.FieldUserID)).
+				From(t).
+				Where(sql.InValues(t.C(consentacceptances.FieldDocumentID), values...)),
+		))
+	})
+}
+
+func documentsToResponses(docs []*ent.ConsentDocuments) []models.ConsentDocumentResponse {
+	result := make([]models.ConsentDocumentResponse, len(docs))
+	for i, doc := range docs {
+		result[i] = documentToResponse(doc)
+	}
+	return result
+}
+
+func documentToResponse(doc *ent.ConsentDocuments) models.ConsentDocumentResponse {
+	return models.ConsentDocumentResponse{
+		ID:          doc.ID,
+		Type:        doc.Type,
+		Version:     doc.Version,
+		Title:       doc.Title,
+		URL:         doc.URL,
+		Content:     doc.Content,
+		Mandatory:   doc.Mandatory,
+		PublishedAt: doc.PublishedAt,
+		CreatedBy:   doc.CreatedBy,
+		CreatedAt:   doc.CreatedAt,
+	}
+}
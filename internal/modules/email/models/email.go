@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // EmailMessage represents an email to be sent
 type EmailMessage struct {
 	To          []string          // Recipients
@@ -26,8 +28,75 @@ type Header struct {
 type EmailType string
 
 const (
-	EmailTypeVerification  EmailType = "verification"
-	EmailTypePasswordReset EmailType = "password_reset"
-	EmailTypeWelcome       EmailType = "welcome"
-	EmailTypeGeneral       EmailType = "general"
+	EmailTypeVerification   EmailType = "verification"
+	EmailTypePasswordReset  EmailType = "password_reset"
+	EmailTypeWelcome        EmailType = "welcome"
+	EmailTypeAccountLocked  EmailType = "account_locked"
+	EmailTypeBreakGlass     EmailType = "break_glass"
+	EmailTypeRoleRequest    EmailType = "role_request"
+	EmailTypeRoleDecision   EmailType = "role_request_decision"
+	EmailTypeInvitation     EmailType = "invitation"
+	EmailTypeSignupDecision EmailType = "signup_decision"
+	EmailTypeImpersonation  EmailType = "impersonation"
+	EmailTypeEmailChange    EmailType = "email_change"
+	EmailTypeDeletion       EmailType = "account_deletion"
+	EmailTypeGeneral        EmailType = "general"
 )
+
+// BreakGlassNotification describes a break-glass role request for approvers
+type BreakGlassNotification struct {
+	RequestID        string
+	RequesterEmail   string
+	RoleName         string
+	Reason           string
+	DurationHours    int
+	RequiresApproval bool // False when the role was granted automatically
+}
+
+// RoleRequestNotification describes a role request for its approvers
+type RoleRequestNotification struct {
+	RequestID      string
+	RequesterEmail string
+	RoleName       string
+	Reason         string
+	DurationHours  int // Zero for a permanent assignment
+}
+
+// RoleDecisionNotification tells a requester what happened to their request
+type RoleDecisionNotification struct {
+	RequestID string
+	RoleName  string
+	Status    string // approved, denied or expired
+	Note      string
+}
+
+// InvitationNotification invites someone to create or link an account
+type InvitationNotification struct {
+	InvitationID string
+	InviterEmail string
+	RoleNames    []string // Roles granted on acceptance
+	Token        string
+	ExpiresAt    time.Time
+}
+
+// ImpersonationNotification tells a user an admin started acting as them
+type ImpersonationNotification struct {
+	AdminEmail string
+	Reason     string
+	ExpiresAt  time.Time
+}
+
+// EmailChangeNotification describes a requested email change. The token is
+// the confirmation token for the new address or the undo token for the old.
+type EmailChangeNotification struct {
+	OldEmail  string
+	NewEmail  string
+	Token     string
+	ExpiresAt time.Time
+}
+
+// SignupDecisionNotification tells a user whether their signup was approved
+type SignupDecisionNotification struct {
+	Status string // approved or rejected
+	Reason string
+}
@@ -3,7 +3,10 @@ package service
 import (
 	"context"
 	"fmt"
+	"html"
 	"log/slog"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -177,6 +180,245 @@ func (s *EmailService) SendWelcomeEmail(ctx context.Context, userID uuid.UUID, e
 	return err
 }
 
+// SendAccountLockedEmail notifies a user that their account was temporarily locked
+func (s *EmailService) SendAccountLockedEmail(ctx context.Context, userID uuid.UUID, email, firstName string, lockedUntil time.Time) error {
+	msg := &models.EmailMessage{
+		To:        []string{email},
+		From:      s.fromEmail,
+		FromName:  s.fromName,
+		Subject:   "Your account has been temporarily locked",
+		Body:      s.buildAccountLockedHTML(firstName, lockedUntil),
+		TextBody:  s.buildAccountLockedText(firstName, lockedUntil),
+		MessageID: fmt.Sprintf("%s@go-auth", uuid.New().String()),
+		Metadata: map[string]string{
+			"user_id": userID.String(),
+			"type":    string(models.EmailTypeAccountLocked),
+		},
+	}
+
+	return s.deliver(ctx, &userID, models.EmailTypeAccountLocked, msg)
+}
+
+// SendBreakGlassEmail tells a user who can assign roles about a break-glass request
+func (s *EmailService) SendBreakGlassEmail(ctx context.Context, userID uuid.UUID, email, firstName string, n models.BreakGlassNotification) error {
+	subject := fmt.Sprintf("Break-glass access granted: %s", n.RoleName)
+	if n.RequiresApproval {
+		subject = fmt.Sprintf("Break-glass access requested: %s", n.RoleName)
+	}
+
+	msg := &models.EmailMessage{
+		To:        []string{email},
+		From:      s.fromEmail,
+		FromName:  s.fromName,
+		Subject:   subject,
+		Body:      s.buildBreakGlassHTML(firstName, n),
+		TextBody:  s.buildBreakGlassText(firstName, n),
+		MessageID: fmt.Sprintf("%s@go-auth", uuid.New().String()),
+		Metadata: map[string]string{
+			"user_id":    userID.String(),
+			"type":       string(models.EmailTypeBreakGlass),
+			"request_id": n.RequestID,
+		},
+	}
+
+	return s.deliver(ctx, &userID, models.EmailTypeBreakGlass, msg)
+}
+
+// SendRoleRequestEmail asks an approver to decide a role request
+func (s *EmailService) SendRoleRequestEmail(ctx context.Context, userID uuid.UUID, email, firstName string, n models.RoleRequestNotification) error {
+	msg := &models.EmailMessage{
+		To:        []string{email},
+		From:      s.fromEmail,
+		FromName:  s.fromName,
+		Subject:   fmt.Sprintf("Role request awaiting approval: %s", n.RoleName),
+		Body:      s.buildRoleRequestHTML(firstName, n),
+		TextBody:  s.buildRoleRequestText(firstName, n),
+		MessageID: fmt.Sprintf("%s@go-auth", uuid.New().String()),
+		Metadata: map[string]string{
+			"user_id":    userID.String(),
+			"type":       string(models.EmailTypeRoleRequest),
+			"request_id": n.RequestID,
+		},
+	}
+
+	return s.deliver(ctx, &userID, models.EmailTypeRoleRequest, msg)
+}
+
+// SendRoleDecisionEmail tells a requester their role request was approved, denied or expired
+func (s *EmailService) SendRoleDecisionEmail(ctx context.Context, userID uuid.UUID, email, firstName string, n models.RoleDecisionNotification) error {
+	msg := &models.EmailMessage{
+		To:        []string{email},
+		From:      s.fromEmail,
+		FromName:  s.fromName,
+		Subject:   fmt.Sprintf("Your request for %s was %s", n.RoleName, n.Status),
+		Body:      s.buildRoleDecisionHTML(firstName, n),
+		TextBody:  s.buildRoleDecisionText(firstName, n),
+		MessageID: fmt.Sprintf("%s@go-auth", uuid.New().String()),
+		Metadata: map[string]string{
+			"user_id":    userID.String(),
+			"type":       string(models.EmailTypeRoleDecision),
+			"request_id": n.RequestID,
+		},
+	}
+
+	return s.deliver(ctx, &userID, models.EmailTypeRoleDecision, msg)
+}
+
+// SendInvitationEmail sends an invitation link to an address that may not
+// belong to an account yet
+func (s *EmailService) SendInvitationEmail(ctx context.Context, email string, n models.InvitationNotification) error {
+	link := fmt.Sprintf("http://localhost:3000/accept-invitation?token=%s", url.QueryEscape(n.Token))
+
+	msg := &models.EmailMessage{
+		To:        []string{email},
+		From:      s.fromEmail,
+		FromName:  s.fromName,
+		Subject:   "You have been invited to Go-Auth",
+		Body:      s.buildInvitationHTML(n, link),
+		TextBody:  s.buildInvitationText(n, link),
+		MessageID: fmt.Sprintf("%s@go-auth", uuid.New().String()),
+		Metadata: map[string]string{
+			"type":          string(models.EmailTypeInvitation),
+			"invitation_id": n.InvitationID,
+		},
+	}
+
+	return s.deliver(ctx, nil, models.EmailTypeInvitation, msg)
+}
+
+// SendSignupDecisionEmail tells a user an admin approved or rejected their signup
+func (s *EmailService) SendSignupDecisionEmail(ctx context.Context, userID uuid.UUID, email, firstName string, n models.SignupDecisionNotification) error {
+	msg := &models.EmailMessage{
+		To:        []string{email},
+		From:      s.fromEmail,
+		FromName:  s.fromName,
+		Subject:   fmt.Sprintf("Your Go-Auth account was %s", n.Status),
+		Body:      s.buildSignupDecisionHTML(firstName, n),
+		TextBody:  s.buildSignupDecisionText(firstName, n),
+		MessageID: fmt.Sprintf("%s@go-auth", uuid.New().String()),
+		Metadata: map[string]string{
+			"user_id": userID.String(),
+			"type":    string(models.EmailTypeSignupDecision),
+		},
+	}
+
+	return s.deliver(ctx, &userID, models.EmailTypeSignupDecision, msg)
+}
+
+// SendImpersonationEmail tells a user an admin is signed in as them
+func (s *EmailService) SendImpersonationEmail(ctx context.Context, userID uuid.UUID, email, firstName string, n models.ImpersonationNotification) error {
+	msg := &models.EmailMessage{
+		To:        []string{email},
+		From:      s.fromEmail,
+		FromName:  s.fromName,
+		Subject:   "An administrator accessed your Go-Auth account",
+		Body:      s.buildImpersonationHTML(firstName, n),
+		TextBody:  s.buildImpersonationText(firstName, n),
+		MessageID: fmt.Sprintf("%s@go-auth", uuid.New().String()),
+		Metadata: map[string]string{
+			"user_id": userID.String(),
+			"type":    string(models.EmailTypeImpersonation),
+		},
+	}
+
+	return s.deliver(ctx, &userID, models.EmailTypeImpersonation, msg)
+}
+
+// SendEmailChangeConfirmationEmail sends the link that confirms an email
+// change to the new address
+func (s *EmailService) SendEmailChangeConfirmationEmail(ctx context.Context, userID uuid.UUID, firstName string, n models.EmailChangeNotification) error {
+	link := fmt.Sprintf("http://localhost:3000/confirm-email-change?token=%s", n.Token)
+
+	msg := &models.EmailMessage{
+		To:        []string{n.NewEmail},
+		From:      s.fromEmail,
+		FromName:  s.fromName,
+		Subject:   "Confirm your new email address",
+		Body:      s.buildEmailChangeConfirmationHTML(firstName, link, n),
+		TextBody:  s.buildEmailChangeConfirmationText(firstName, link, n),
+		MessageID: fmt.Sprintf("%s@go-auth", uuid.New().String()),
+		Metadata: map[string]string{
+			"user_id": userID.String(),
+			"type":    string(models.EmailTypeEmailChange),
+		},
+	}
+
+	return s.deliver(ctx, &userID, models.EmailTypeEmailChange, msg)
+}
+
+// SendEmailChangeNoticeEmail tells the old address about an email change,
+// with a link to cancel or undo it
+func (s *EmailService) SendEmailChangeNoticeEmail(ctx context.Context, userID uuid.UUID, firstName string, n models.EmailChangeNotification) error {
+	link := fmt.Sprintf("http://localhost:3000/undo-email-change?token=%s", n.Token)
+
+	msg := &models.EmailMessage{
+		To:        []string{n.OldEmail},
+		From:      s.fromEmail,
+		FromName:  s.fromName,
+		Subject:   "Your Go-Auth email address is being changed",
+		Body:      s.buildEmailChangeNoticeHTML(firstName, link, n),
+		TextBody:  s.buildEmailChangeNoticeText(firstName, link, n),
+		MessageID: fmt.Sprintf("%s@go-auth", uuid.New().String()),
+		Metadata: map[string]string{
+			"user_id": userID.String(),
+			"type":    string(models.EmailTypeEmailChange),
+		},
+	}
+
+	return s.deliver(ctx, &userID, models.EmailTypeEmailChange, msg)
+}
+
+// SendAccountDeletionEmail tells a user when their account will be deleted
+// and that signing in lets them cancel
+func (s *EmailService) SendAccountDeletionEmail(ctx context.Context, userID uuid.UUID, email, firstName string, scheduledAt time.Time) error {
+	msg := &models.EmailMessage{
+		To:        []string{email},
+		From:      s.fromEmail,
+		FromName:  s.fromName,
+		Subject:   "Your Go-Auth account is scheduled for deletion",
+		Body:      s.buildAccountDeletionHTML(firstName, scheduledAt),
+		TextBody:  s.buildAccountDeletionText(firstName, scheduledAt),
+		MessageID: fmt.Sprintf("%s@go-auth", uuid.New().String()),
+		Metadata: map[string]string{
+			"user_id": userID.String(),
+			"type":    string(models.EmailTypeDeletion),
+		},
+	}
+
+	return s.deliver(ctx, &userID, models.EmailTypeDeletion, msg)
+}
+
+// deliver sends a message through the provider and records the attempt in EmailLogs
+func (s *EmailService) deliver(ctx context.Context, userID *uuid.UUID, emailType models.EmailType, msg *models.EmailMessage) error {
+	err := s.provider.SendEmail(msg)
+
+	status := "sent"
+	errMsg := ""
+	if err != nil {
+		status = "failed"
+		errMsg = err.Error()
+	}
+
+	for _, recipient := range msg.To {
+		_, logErr := s.client.EmailLogs.Create().
+			SetNillableUserID(userID).
+			SetRecipient(recipient).
+			SetEmailType(string(emailType)).
+			SetSubject(msg.Subject).
+			SetStatus(status).
+			SetProvider(s.provider.GetProviderName()).
+			SetProviderMessageID(msg.MessageID).
+			SetNillableErrorMessage(&errMsg).
+			Save(ctx)
+
+		if logErr != nil {
+			s.logger.Error("Failed to log email", "error", logErr)
+		}
+	}
+
+	return err
+}
+
 // Template builders
 
 func (s *EmailService) buildVerificationHTML(firstName, link string) string {
@@ -347,3 +589,449 @@ func (s *EmailService) GeneratePasswordResetToken(ctx context.Context, userID uu
 
 	return token, nil
 }
+
+func (s *EmailService) buildAccountLockedHTML(firstName string, lockedUntil time.Time) string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2>Account Temporarily Locked</h2>
+        <p>Hi %s,</p>
+        <p>We detected several failed sign-in attempts on your account, so we have temporarily locked it to keep it safe.</p>
+        <p>You will be able to sign in again after <strong>%s</strong>.</p>
+        <p>If this wasn't you, we recommend resetting your password once the lock expires.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 20px 0;">
+        <p style="font-size: 12px; color: #999;">This is an automated message from Go-Auth.</p>
+    </div>
+</body>
+</html>
+`, firstName, lockedUntil.UTC().Format(time.RFC1123))
+}
+
+func (s *EmailService) buildAccountLockedText(firstName string, lockedUntil time.Time) string {
+	return fmt.Sprintf(`
+Account Temporarily Locked
+
+Hi %s,
+
+We detected several failed sign-in attempts on your account, so we have temporarily locked it to keep it safe.
+
+You will be able to sign in again after %s.
+
+If this wasn't you, we recommend resetting your password once the lock expires.
+
+---
+This is an automated message from Go-Auth.
+`, firstName, lockedUntil.UTC().Format(time.RFC1123))
+}
+
+func (s *EmailService) buildBreakGlassHTML(firstName string, n models.BreakGlassNotification) string {
+	action := "<p>The role was granted automatically. Review the request and revoke the role if it is not justified.</p>"
+	if n.RequiresApproval {
+		action = fmt.Sprintf("<p>The request is waiting for approval. Approve or deny it with request ID <strong>%s</strong>.</p>", n.RequestID)
+	}
+
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2>Break-Glass Access</h2>
+        <p>Hi %s,</p>
+        <p><strong>%s</strong> requested emergency access to the <strong>%s</strong> role for %d hours.</p>
+        <p>Reason: %s</p>
+        %s
+        <hr style="border: none; border-top: 1px solid #eee; margin: 20px 0;">
+        <p style="font-size: 12px; color: #999;">This is an automated message from Go-Auth.</p>
+    </div>
+</body>
+</html>
+`, html.EscapeString(firstName), html.EscapeString(n.RequesterEmail), html.EscapeString(n.RoleName), n.DurationHours, html.EscapeString(n.Reason), action)
+}
+
+func (s *EmailService) buildBreakGlassText(firstName string, n models.BreakGlassNotification) string {
+	action := "The role was granted automatically. Review the request and revoke the role if it is not justified."
+	if n.RequiresApproval {
+		action = fmt.Sprintf("The request is waiting for approval. Approve or deny it with request ID %s.", n.RequestID)
+	}
+
+	return fmt.Sprintf(`
+Break-Glass Access
+
+Hi %s,
+
+%s requested emergency access to the %s role for %d hours.
+
+Reason: %s
+
+%s
+
+---
+This is an automated message from Go-Auth.
+`, firstName, n.RequesterEmail, n.RoleName, n.DurationHours, n.Reason, action)
+}
+
+func roleRequestDuration(hours int) string {
+	if hours == 0 {
+		return "permanently"
+	}
+	return fmt.Sprintf("for %d hours", hours)
+}
+
+func (s *EmailService) buildRoleRequestHTML(firstName string, n models.RoleRequestNotification) string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2>Role Request Awaiting Approval</h2>
+        <p>Hi %s,</p>
+        <p><strong>%s</strong> requested the <strong>%s</strong> role %s.</p>
+        <p>Justification: %s</p>
+        <p>Approve or deny it with request ID <strong>%s</strong>.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 20px 0;">
+        <p style="font-size: 12px; color: #999;">This is an automated message from Go-Auth.</p>
+    </div>
+</body>
+</html>
+`, html.EscapeString(firstName), html.EscapeString(n.RequesterEmail), html.EscapeString(n.RoleName), roleRequestDuration(n.DurationHours), html.EscapeString(n.Reason), n.RequestID)
+}
+
+func (s *EmailService) buildRoleRequestText(firstName string, n models.RoleRequestNotification) string {
+	return fmt.Sprintf(`
+Role Request Awaiting Approval
+
+Hi %s,
+
+%s requested the %s role %s.
+
+Justification: %s
+
+Approve or deny it with request ID %s.
+
+---
+This is an automated message from Go-Auth.
+`, firstName, n.RequesterEmail, n.RoleName, roleRequestDuration(n.DurationHours), n.Reason, n.RequestID)
+}
+
+func (s *EmailService) buildRoleDecisionHTML(firstName string, n models.RoleDecisionNotification) string {
+	note := ""
+	if n.Note != "" {
+		note = fmt.Sprintf("<p>Note: %s</p>", html.EscapeString(n.Note))
+	}
+
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2>Role Request Update</h2>
+        <p>Hi %s,</p>
+        <p>Your request for the <strong>%s</strong> role was <strong>%s</strong>.</p>
+        %s
+        <hr style="border: none; border-top: 1px solid #eee; margin: 20px 0;">
+        <p style="font-size: 12px; color: #999;">This is an automated message from Go-Auth.</p>
+    </div>
+</body>
+</html>
+`, html.EscapeString(firstName), html.EscapeString(n.RoleName), n.Status, note)
+}
+
+func (s *EmailService) buildRoleDecisionText(firstName string, n models.RoleDecisionNotification) string {
+	note := ""
+	if n.Note != "" {
+		note = fmt.Sprintf("Note: %s\n", n.Note)
+	}
+
+	return fmt.Sprintf(`
+Role Request Update
+
+Hi %s,
+
+Your request for the %s role was %s.
+
+%s
+---
+This is an automated message from Go-Auth.
+`, firstName, n.RoleName, n.Status, note)
+}
+
+func invitationRoles(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("You will be granted: %s.", strings.Join(names, ", "))
+}
+
+func (s *EmailService) buildInvitationHTML(n models.InvitationNotification, link string) string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2>You're Invited</h2>
+        <p>Hi,</p>
+        <p><strong>%s</strong> invited you to Go-Auth. %s</p>
+        <div style="margin: 30px 0;">
+            <a href="%s" style="background-color: #4CAF50; color: white; padding: 12px 24px; text-decoration: none; border-radius: 4px; display: inline-block;">Accept Invitation</a>
+        </div>
+        <p>Or copy and paste this link into your browser:</p>
+        <p style="word-break: break-all; color: #666;">%s</p>
+        <p>This invitation expires on %s.</p>
+        <p>If you weren't expecting an invitation, you can safely ignore this email.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 20px 0;">
+        <p style="font-size: 12px; color: #999;">This is an automated message from Go-Auth.</p>
+    </div>
+</body>
+</html>
+`, html.EscapeString(n.InviterEmail), html.EscapeString(invitationRoles(n.RoleNames)), html.EscapeString(link), html.EscapeString(link), n.ExpiresAt.UTC().Format(time.RFC1123))
+}
+
+func (s *EmailService) buildInvitationText(n models.InvitationNotification, link string) string {
+	return fmt.Sprintf(`
+You're Invited
+
+Hi,
+
+%s invited you to Go-Auth. %s
+
+Accept the invitation by visiting:
+
+%s
+
+This invitation expires on %s.
+
+If you weren't expecting an invitation, you can safely ignore this email.
+
+---
+This is an automated message from Go-Auth.
+`, n.InviterEmail, invitationRoles(n.RoleNames), link, n.ExpiresAt.UTC().Format(time.RFC1123))
+}
+
+func signupDecisionMessage(status string) string {
+	if status == "approved" {
+		return "Your account has been approved. You can now sign in."
+	}
+	return "Your account request was not approved."
+}
+
+func (s *EmailService) buildSignupDecisionHTML(firstName string, n models.SignupDecisionNotification) string {
+	reason := ""
+	if n.Reason != "" {
+		reason = fmt.Sprintf("<p>Reason: %s</p>", html.EscapeString(n.Reason))
+	}
+
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2>Account Request Update</h2>
+        <p>Hi %s,</p>
+        <p>%s</p>
+        %s
+        <hr style="border: none; border-top: 1px solid #eee; margin: 20px 0;">
+        <p style="font-size: 12px; color: #999;">This is an automated message from Go-Auth.</p>
+    </div>
+</body>
+</html>
+`, html.EscapeString(firstName), signupDecisionMessage(n.Status), reason)
+}
+
+func (s *EmailService) buildSignupDecisionText(firstName string, n models.SignupDecisionNotification) string {
+	reason := ""
+	if n.Reason != "" {
+		reason = fmt.Sprintf("Reason: %s\n", n.Reason)
+	}
+
+	return fmt.Sprintf(`
+Account Request Update
+
+Hi %s,
+
+%s
+
+%s
+---
+This is an automated message from Go-Auth.
+`, firstName, signupDecisionMessage(n.Status), reason)
+}
+
+func (s *EmailService) buildImpersonationHTML(firstName string, n models.ImpersonationNotification) string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2>Administrator Access</h2>
+        <p>Hi %s,</p>
+        <p>The administrator <strong>%s</strong> is signed in as you until %s.</p>
+        <p>Reason: %s</p>
+        <p>Every action taken during this session is recorded. If you did not expect this, please contact support.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 20px 0;">
+        <p style="font-size: 12px; color: #999;">This is an automated message from Go-Auth.</p>
+    </div>
+</body>
+</html>
+`, html.EscapeString(firstName), html.EscapeString(n.AdminEmail), n.ExpiresAt.UTC().Format(time.RFC1123), html.EscapeString(n.Reason))
+}
+
+func (s *EmailService) buildImpersonationText(firstName string, n models.ImpersonationNotification) string {
+	return fmt.Sprintf(`
+Administrator Access
+
+Hi %s,
+
+The administrator %s is signed in as you until %s.
+
+Reason: %s
+
+Every action taken during this session is recorded. If you did not expect this, please contact support.
+
+---
+This is an automated message from Go-Auth.
+`, firstName, n.AdminEmail, n.ExpiresAt.UTC().Format(time.RFC1123), n.Reason)
+}
+
+func (s *EmailService) buildEmailChangeConfirmationHTML(firstName, link string, n models.EmailChangeNotification) string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2>Confirm Your New Email Address</h2>
+        <p>Hi %s,</p>
+        <p>You asked to change the email address of your Go-Auth account to <strong>%s</strong>. Confirm the change by clicking the button below:</p>
+        <p style="text-align: center; margin: 30px 0;">
+            <a href="%s" style="background-color: #4CAF50; color: white; padding: 12px 30px; text-decoration: none; border-radius: 4px; display: inline-block;">Confirm Email</a>
+        </p>
+        <p>This link expires on %s. If you did not ask for this change, ignore this email.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 20px 0;">
+        <p style="font-size: 12px; color: #999;">This is an automated message from Go-Auth.</p>
+    </div>
+</body>
+</html>
+`, html.EscapeString(firstName), html.EscapeString(n.NewEmail), link, n.ExpiresAt.UTC().Format(time.RFC1123))
+}
+
+func (s *EmailService) buildEmailChangeConfirmationText(firstName, link string, n models.EmailChangeNotification) string {
+	return fmt.Sprintf(`
+Confirm Your New Email Address
+
+Hi %s,
+
+You asked to change the email address of your Go-Auth account to %s. Confirm the change by visiting:
+
+%s
+
+This link expires on %s. If you did not ask for this change, ignore this email.
+
+---
+This is an automated message from Go-Auth.
+`, firstName, n.NewEmail, link, n.ExpiresAt.UTC().Format(time.RFC1123))
+}
+
+func (s *EmailService) buildEmailChangeNoticeHTML(firstName, link string, n models.EmailChangeNotification) string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2>Email Address Change Requested</h2>
+        <p>Hi %s,</p>
+        <p>Someone asked to change the email address of your Go-Auth account from %s to <strong>%s</strong>. The change applies once the new address is confirmed.</p>
+        <p>If this wasn't you, undo the change and sign out every session by clicking the button below:</p>
+        <p style="text-align: center; margin: 30px 0;">
+            <a href="%s" style="background-color: #f44336; color: white; padding: 12px 30px; text-decoration: none; border-radius: 4px; display: inline-block;">Undo Change</a>
+        </p>
+        <p>This link works until %s.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 20px 0;">
+        <p style="font-size: 12px; color: #999;">This is an automated message from Go-Auth.</p>
+    </div>
+</body>
+</html>
+`, html.EscapeString(firstName), html.EscapeString(n.OldEmail), html.EscapeString(n.NewEmail), link, n.ExpiresAt.UTC().Format(time.RFC1123))
+}
+
+func (s *EmailService) buildEmailChangeNoticeText(firstName, link string, n models.EmailChangeNotification) string {
+	return fmt.Sprintf(`
+Email Address Change Requested
+
+Hi %s,
+
+Someone asked to change the email address of your Go-Auth account from %s to %s. The change applies once the new address is confirmed.
+
+If this wasn't you, undo the change and sign out every session by visiting:
+
+%s
+
+This link works until %s.
+
+---
+This is an automated message from Go-Auth.
+`, firstName, n.OldEmail, n.NewEmail, link, n.ExpiresAt.UTC().Format(time.RFC1123))
+}
+
+func (s *EmailService) buildAccountDeletionHTML(firstName string, scheduledAt time.Time) string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h2>Account Deletion Scheduled</h2>
+        <p>Hi %s,</p>
+        <p>Your account and personal data will be permanently deleted on <strong>%s</strong>.</p>
+        <p>Changed your mind? Sign in and cancel the deletion from your account settings before then.</p>
+        <hr style="border: none; border-top: 1px solid #eee; margin: 20px 0;">
+        <p style="font-size: 12px; color: #999;">This is an automated message from Go-Auth.</p>
+    </div>
+</body>
+</html>
+`, html.EscapeString(firstName), scheduledAt.UTC().Format(time.RFC1123))
+}
+
+func (s *EmailService) buildAccountDeletionText(firstName string, scheduledAt time.Time) string {
+	return fmt.Sprintf(`
+Account Deletion Scheduled
+
+Hi %s,
+
+Your account and personal data will be permanently deleted on %s.
+
+Changed your mind? Sign in and cancel the deletion from your account settings before then.
+
+---
+This is an automated message from Go-Auth.
+`, firstName, scheduledAt.UTC().Format(time.RFC1123))
+}
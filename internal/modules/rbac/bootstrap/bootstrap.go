@@ -8,8 +8,13 @@ import (
 
 	"github.com/shammianand/go-auth/ent"
 	"github.com/shammianand/go-auth/ent/permissions"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roleparents"
 	"github.com/shammianand/go-auth/ent/rolepermissions"
 	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
+	"github.com/shammianand/go-auth/internal/modules/rbac/service"
 )
 
 // BootstrapService handles RBAC initialization
@@ -53,6 +58,7 @@ func (s *BootstrapService) BootstrapPermissions(ctx context.Context, perms []Per
 				SetNillableDescription(&perm.Description).
 				SetNillableResource(&perm.Resource).
 				SetNillableAction(&perm.Action).
+				SetIsSystem(true).
 				Save(ctx)
 
 			if err != nil {
@@ -68,6 +74,7 @@ func (s *BootstrapService) BootstrapPermissions(ctx context.Context, perms []Per
 				SetNillableDescription(&perm.Description).
 				SetNillableResource(&perm.Resource).
 				SetNillableAction(&perm.Action).
+				SetIsSystem(true).
 				Save(ctx)
 
 			if err != nil {
@@ -94,6 +101,11 @@ func (s *BootstrapService) BootstrapRoles(ctx context.Context, roleConfigs []Rol
 	}
 
 	for _, roleConfig := range roleConfigs {
+		breakGlass := roles.BreakGlassDisabled
+		if roleConfig.BreakGlass != "" {
+			breakGlass = roles.BreakGlass(roleConfig.BreakGlass)
+		}
+
 		// Check if role exists
 		existing, err := s.client.Roles.Query().
 			Where(roles.CodeEQ(roleConfig.Code)).
@@ -113,6 +125,9 @@ func (s *BootstrapService) BootstrapRoles(ctx context.Context, roleConfigs []Rol
 				SetIsSystem(roleConfig.IsSystem).
 				SetIsDefault(roleConfig.IsDefault).
 				SetNillableMaxUsers(roleConfig.MaxUsers).
+				SetOrgAssignable(roleConfig.OrgAssignable).
+				SetBreakGlass(breakGlass).
+				SetNillableBreakGlassMaxHours(roleConfig.BreakGlassMaxHours).
 				Save(ctx)
 
 			if err != nil {
@@ -129,6 +144,9 @@ func (s *BootstrapService) BootstrapRoles(ctx context.Context, roleConfigs []Rol
 				SetIsSystem(roleConfig.IsSystem).
 				SetIsDefault(roleConfig.IsDefault).
 				SetNillableMaxUsers(roleConfig.MaxUsers).
+				SetOrgAssignable(roleConfig.OrgAssignable).
+				SetBreakGlass(breakGlass).
+				SetNillableBreakGlassMaxHours(roleConfig.BreakGlassMaxHours).
 				Save(ctx)
 
 			if err != nil {
@@ -140,44 +158,322 @@ func (s *BootstrapService) BootstrapRoles(ctx context.Context, roleConfigs []Rol
 		}
 
 		// Assign permissions
-		err = s.assignPermissionsToRole(ctx, role, roleConfig.Permissions, allPermissions)
+		err = s.assignPermissionsToRole(ctx, role, roleConfig.Permissions, roleConfig.Conditions, allPermissions)
 		if err != nil {
 			return created, updated, fmt.Errorf("failed to assign permissions to role %s: %w", roleConfig.Code, err)
 		}
 	}
 
+	// Link parent and approver roles once every role exists
+	for _, roleConfig := range roleConfigs {
+		if err := s.assignParentsToRole(ctx, roleConfig); err != nil {
+			return created, updated, fmt.Errorf("failed to assign parent roles to role %s: %w", roleConfig.Code, err)
+		}
+		if err := s.assignApproversToRole(ctx, roleConfig); err != nil {
+			return created, updated, fmt.Errorf("failed to assign approver roles to role %s: %w", roleConfig.Code, err)
+		}
+	}
+
 	return created, updated, nil
 }
 
-// assignPermissionsToRole assigns permissions to a role based on permission codes/wildcards
-func (s *BootstrapService) assignPermissionsToRole(ctx context.Context, role *ent.Roles, permCodes []string, allPermissions []*ent.Permissions) error {
+// assignParentsToRole syncs a role's parent roles with its inherits codes
+func (s *BootstrapService) assignParentsToRole(ctx context.Context, roleConfig RoleConfig) error {
+	role, err := s.client.Roles.Query().
+		Where(roles.CodeEQ(roleConfig.Code)).
+		Only(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to query role: %w", err)
+	}
+
+	parentIDs := make([]int, 0, len(roleConfig.Inherits))
+	for _, code := range roleConfig.Inherits {
+		parent, err := s.client.Roles.Query().
+			Where(roles.CodeEQ(code)).
+			Only(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to query parent role %s: %w", code, err)
+		}
+		parentIDs = append(parentIDs, parent.ID)
+	}
+	parentIDs = uniqueInts(parentIDs)
+
+	// Roles created through the API may already link into this role
+	hierarchy, err := service.LoadRoleHierarchy(ctx, s.client)
+	if err != nil {
+		return err
+	}
+
+	if hierarchy.WouldCycle(role.ID, parentIDs) {
+		return fmt.Errorf("role inheritance would create a cycle")
+	}
+
+	existing := make(map[int]bool)
+	for _, parentID := range hierarchy[role.ID] {
+		existing[parentID] = true
+	}
+
+	target := make(map[int]bool)
+	for _, parentID := range parentIDs {
+		target[parentID] = true
+		if existing[parentID] {
+			continue
+		}
+
+		_, err := s.client.RoleParents.Create().
+			SetRoleID(role.ID).
+			SetParentRoleID(parentID).
+			Save(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to link parent role %d: %w", parentID, err)
+		}
+		s.logger.Info("Role parent linked", "code", roleConfig.Code, "parent_role_id", parentID)
+	}
+
+	// Remove parents not in config
+	for parentID := range existing {
+		if target[parentID] {
+			continue
+		}
+
+		_, err := s.client.RoleParents.Delete().
+			Where(
+				roleparents.RoleIDEQ(role.ID),
+				roleparents.ParentRoleIDEQ(parentID),
+			).
+			Exec(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to unlink parent role %d: %w", parentID, err)
+		}
+		s.logger.Info("Role parent unlinked", "code", roleConfig.Code, "parent_role_id", parentID)
+	}
+
+	return nil
+}
+
+// assignApproversToRole syncs a role's approver roles with its approvers codes
+func (s *BootstrapService) assignApproversToRole(ctx context.Context, roleConfig RoleConfig) error {
+	role, err := s.client.Roles.Query().
+		Where(roles.CodeEQ(roleConfig.Code)).
+		Only(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to query role: %w", err)
+	}
+
+	target := make(map[int]bool)
+	for _, code := range roleConfig.Approvers {
+		approver, err := s.client.Roles.Query().
+			Where(roles.CodeEQ(code)).
+			Only(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to query approver role %s: %w", code, err)
+		}
+		target[approver.ID] = true
+	}
+
+	existingLinks, err := s.client.RoleApprovers.Query().
+		Where(roleapprovers.RoleIDEQ(role.ID)).
+		All(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to query existing approver roles: %w", err)
+	}
+
+	existing := make(map[int]bool)
+	for _, link := range existingLinks {
+		existing[link.ApproverRoleID] = true
+	}
+
+	for approverID := range target {
+		if existing[approverID] {
+			continue
+		}
+
+		_, err := s.client.RoleApprovers.Create().
+			SetRoleID(role.ID).
+			SetApproverRoleID(approverID).
+			Save(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to link approver role %d: %w", approverID, err)
+		}
+		s.logger.Info("Role approver linked", "code", roleConfig.Code, "approver_role_id", approverID)
+	}
+
+	// Remove approvers not in config
+	for _, link := range existingLinks {
+		if target[link.ApproverRoleID] {
+			continue
+		}
+
+		if err := s.client.RoleApprovers.DeleteOne(link).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to unlink approver role %d: %w", link.ApproverRoleID, err)
+		}
+		s.logger.Info("Role approver unlinked", "code", roleConfig.Code, "approver_role_id", link.ApproverRoleID)
+	}
+
+	return nil
+}
+
+// BootstrapConstraints creates or updates separation-of-duties constraints
+// from config. Constraints from config are system constraints and cannot be
+// changed via the API.
+func (s *BootstrapService) BootstrapConstraints(ctx context.Context, constraintConfigs []SoDConfig) (int, int, error) {
+	created := 0
+	updated := 0
+
+	for _, constraintConfig := range constraintConfigs {
+		// Check if constraint exists
+		existing, err := s.client.SodConstraints.Query().
+			Where(sodconstraints.CodeEQ(constraintConfig.Code)).
+			Only(ctx)
+
+		if err != nil && !ent.IsNotFound(err) {
+			return created, updated, fmt.Errorf("failed to query constraint %s: %w", constraintConfig.Code, err)
+		}
+
+		var constraint *ent.SodConstraints
+		if existing == nil {
+			// Create new constraint
+			constraint, err = s.client.SodConstraints.Create().
+				SetCode(constraintConfig.Code).
+				SetName(constraintConfig.Name).
+				SetNillableDescription(&constraintConfig.Description).
+				SetIsSystem(true).
+				Save(ctx)
+
+			if err != nil {
+				return created, updated, fmt.Errorf("failed to create constraint %s: %w", constraintConfig.Code, err)
+			}
+
+			s.logger.Info("Constraint created", "code", constraintConfig.Code)
+			created++
+		} else {
+			// Update existing constraint
+			constraint, err = existing.Update().
+				SetName(constraintConfig.Name).
+				SetNillableDescription(&constraintConfig.Description).
+				SetIsSystem(true).
+				Save(ctx)
+
+			if err != nil {
+				return created, updated, fmt.Errorf("failed to update constraint %s: %w", constraintConfig.Code, err)
+			}
+
+			s.logger.Info("Constraint updated", "code", constraintConfig.Code)
+			updated++
+		}
+
+		if err := s.assignRolesToConstraint(ctx, constraint, constraintConfig.Roles); err != nil {
+			return created, updated, fmt.Errorf("failed to assign roles to constraint %s: %w", constraintConfig.Code, err)
+		}
+	}
+
+	return created, updated, nil
+}
+
+// assignRolesToConstraint syncs a constraint's roles with the given role codes
+func (s *BootstrapService) assignRolesToConstraint(ctx context.Context, constraint *ent.SodConstraints, roleCodes []string) error {
+	target := make(map[int]bool)
+	for _, code := range roleCodes {
+		role, err := s.client.Roles.Query().
+			Where(roles.CodeEQ(code)).
+			Only(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to query role %s: %w", code, err)
+		}
+		target[role.ID] = true
+	}
+
+	existingLinks, err := s.client.SodConstraintRoles.Query().
+		Where(sodconstraintroles.ConstraintIDEQ(constraint.ID)).
+		All(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to query existing constraint roles: %w", err)
+	}
+
+	existing := make(map[int]bool)
+	for _, link := range existingLinks {
+		existing[link.RoleID] = true
+	}
+
+	for roleID := range target {
+		if existing[roleID] {
+			continue
+		}
+
+		_, err := s.client.SodConstraintRoles.Create().
+			SetConstraintID(constraint.ID).
+			SetRoleID(roleID).
+			Save(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to link role %d: %w", roleID, err)
+		}
+	}
+
+	// Remove roles not in config
+	for _, link := range existingLinks {
+		if target[link.RoleID] {
+			continue
+		}
+
+		if err := s.client.SodConstraintRoles.DeleteOne(link).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to unlink role %d: %w", link.RoleID, err)
+		}
+	}
+
+	s.logger.Info("Constraint roles synced", "code", constraint.Code, "roles", len(target))
+	return nil
+}
+
+// assignPermissionsToRole assigns permissions to a role based on permission
+// codes/wildcards. conditions maps entries of permCodes to the condition set
+// on every permission the entry matches.
+func (s *BootstrapService) assignPermissionsToRole(ctx context.Context, role *ent.Roles, permCodes []string, conditions map[string]string, allPermissions []*ent.Permissions) error {
 	// Resolve permission IDs from codes and wildcards
 	permissionIDs := make([]int, 0)
+	permConditions := make(map[int]string)
 
 	for _, permCode := range permCodes {
+		matched := make([]int, 0)
 		if permCode == "*" {
 			// All permissions
 			for _, perm := range allPermissions {
-				permissionIDs = append(permissionIDs, perm.ID)
+				matched = append(matched, perm.ID)
 			}
-			break
 		} else if strings.HasSuffix(permCode, ".*") {
 			// Wildcard match (e.g., "users.*")
 			prefix := strings.TrimSuffix(permCode, ".*")
 			for _, perm := range allPermissions {
 				if strings.HasPrefix(perm.Code, prefix+".") || perm.Code == prefix {
-					permissionIDs = append(permissionIDs, perm.ID)
+					matched = append(matched, perm.ID)
 				}
 			}
 		} else {
 			// Exact match
 			for _, perm := range allPermissions {
 				if perm.Code == permCode {
-					permissionIDs = append(permissionIDs, perm.ID)
+					matched = append(matched, perm.ID)
 					break
 				}
 			}
 		}
+
+		for _, permID := range matched {
+			if condition := conditions[permCode]; condition != "" {
+				permConditions[permID] = condition
+			}
+		}
+		permissionIDs = append(permissionIDs, matched...)
 	}
 
 	// Remove duplicates
@@ -192,20 +488,25 @@ func (s *BootstrapService) assignPermissionsToRole(ctx context.Context, role *en
 		return fmt.Errorf("failed to query existing role permissions: %w", err)
 	}
 
-	existingPermIDs := make(map[int]bool)
+	existingByPermID := make(map[int]*ent.RolePermissions)
 	for _, assignment := range existingAssignments {
-		existingPermIDs[assignment.PermissionID] = true
+		existingByPermID[assignment.PermissionID] = assignment
 	}
 
-	// Add new permissions
+	// Add new permissions and sync conditions of existing ones
 	for _, permID := range permissionIDs {
-		if !existingPermIDs[permID] {
-			_, err := s.client.RolePermissions.Create().
+		condition := permConditions[permID]
+
+		existing, ok := existingByPermID[permID]
+		if !ok {
+			create := s.client.RolePermissions.Create().
 				SetRoleID(role.ID).
-				SetPermissionID(permID).
-				Save(ctx)
+				SetPermissionID(permID)
+			if condition != "" {
+				create = create.SetCondition(condition)
+			}
 
-			if err != nil {
+			if _, err := create.Save(ctx); err != nil {
 				s.logger.Error("Failed to assign permission to role",
 					"role_id", role.ID,
 					"permission_id", permID,
@@ -213,6 +514,20 @@ func (s *BootstrapService) assignPermissionsToRole(ctx context.Context, role *en
 				)
 				// Continue with other permissions
 			}
+			continue
+		}
+
+		if existing.Condition != condition {
+			update := existing.Update()
+			if condition == "" {
+				update = update.ClearCondition()
+			} else {
+				update = update.SetCondition(condition)
+			}
+
+			if _, err := update.Save(ctx); err != nil {
+				return fmt.Errorf("failed to update condition of permission %d: %w", permID, err)
+			}
 		}
 	}
 
@@ -2,8 +2,9 @@ package bootstrap
 
 // RBACConfig represents the RBAC configuration structure
 type RBACConfig struct {
-	Permissions []PermissionConfig `yaml:"permissions"`
-	Roles       []RoleConfig       `yaml:"roles"`
+	Permissions        []PermissionConfig `yaml:"permissions"`
+	Roles              []RoleConfig       `yaml:"roles"`
+	SeparationOfDuties []SoDConfig        `yaml:"separation_of_duties"`
 }
 
 // PermissionConfig represents a permission in the config
@@ -17,11 +18,26 @@ type PermissionConfig struct {
 
 // RoleConfig represents a role in the config
 type RoleConfig struct {
+	Code               string            `yaml:"code"`
+	Name               string            `yaml:"name"`
+	Description        string            `yaml:"description"`
+	IsSystem           bool              `yaml:"is_system"`
+	IsDefault          bool              `yaml:"is_default"`
+	MaxUsers           *int              `yaml:"max_users"`
+	OrgAssignable      bool              `yaml:"org_assignable"`        // Org admins may assign it within their organization
+	BreakGlass         string            `yaml:"break_glass"`           // disabled (default), auto or approval
+	BreakGlassMaxHours *int              `yaml:"break_glass_max_hours"` // Defaults to BREAK_GLASS_MAX_HOURS
+	Permissions        []string          `yaml:"permissions"`           // Permission codes or wildcards
+	Conditions         map[string]string `yaml:"conditions"`            // Maps entries of permissions to the condition their grants require
+	Inherits           []string          `yaml:"inherits"`              // Codes of parent roles
+	Approvers          []string          `yaml:"approvers"`             // Codes of roles whose holders decide requests for this role
+}
+
+// SoDConfig represents a separation-of-duties constraint in the config. No
+// user may hold more than one of its roles.
+type SoDConfig struct {
 	Code        string   `yaml:"code"`
 	Name        string   `yaml:"name"`
 	Description string   `yaml:"description"`
-	IsSystem    bool     `yaml:"is_system"`
-	IsDefault   bool     `yaml:"is_default"`
-	MaxUsers    *int     `yaml:"max_users"`
-	Permissions []string `yaml:"permissions"` // Permission codes or wildcards
+	Roles       []string `yaml:"roles"` // Codes of mutually exclusive roles
 }
@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+	"github.com/shammianand/go-auth/internal/modules/rbac/service"
+)
+
+// checkPermission lets a caller check permissions of other users
+const checkPermission = "rbac.check"
+
+// CheckController handles authorization decision requests from downstream
+// services
+type CheckController struct {
+	service *service.RBACService
+	cache   *redis.Client
+}
+
+// NewCheckController creates a new check controller
+func NewCheckController(service *service.RBACService, cache *redis.Client) *CheckController {
+	return &CheckController{
+		service: service,
+		cache:   cache,
+	}
+}
+
+// Check decides a single permission for a subject
+func (c *CheckController) Check(ctx *gin.Context) {
+	var req models.CheckRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, subject, ok := c.resolveSubject(ctx, req.Subject)
+	if !ok {
+		return
+	}
+
+	checks := []service.PermissionCheck{toPermissionCheck(req.Permission, req.Resource)}
+	decisions, err := c.service.Authorize(ctx.Request.Context(), actorUUID, subject, checks)
+	if err != nil {
+		respondCheckError(ctx, err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Permission checked successfully", decisions[0])
+}
+
+// BatchCheck decides several permissions for the same subject
+func (c *CheckController) BatchCheck(ctx *gin.Context) {
+	var req models.BatchCheckRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, subject, ok := c.resolveSubject(ctx, req.Subject)
+	if !ok {
+		return
+	}
+
+	checks := make([]service.PermissionCheck, 0, len(req.Checks))
+	for _, check := range req.Checks {
+		checks = append(checks, toPermissionCheck(check.Permission, check.Resource))
+	}
+
+	decisions, err := c.service.Authorize(ctx.Request.Context(), actorUUID, subject, checks)
+	if err != nil {
+		respondCheckError(ctx, err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Permissions checked successfully", models.BatchCheckResponse{
+		SubjectID: subject.UserID,
+		Decisions: decisions,
+	})
+}
+
+// resolveSubject returns the caller and the subject of a check. Checking
+// anyone but yourself requires rbac.check, and only then are the MFA and IP
+// of the request body used; self-checks take them from the caller's own
+// session, which never carries a second factor, and request. It responds
+// and returns false when the subject cannot be resolved or the caller may
+// not check it.
+func (c *CheckController) resolveSubject(ctx *gin.Context, req models.CheckSubject) (uuid.UUID, service.Subject, bool) {
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return uuid.UUID{}, service.Subject{}, false
+	}
+
+	subject := service.Subject{UserID: actorUUID, OrgID: req.OrgID}
+	switch {
+	case req.Token != "" && req.UserID != nil:
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Provide either a subject token or a user ID", "VALIDATION_ERROR", "subject token and user_id are mutually exclusive")
+		return uuid.UUID{}, service.Subject{}, false
+	case req.Token != "":
+		var tokenOrg *uuid.UUID
+		subject.UserID, tokenOrg, err = middleware.SubjectFromToken(c.cache, req.Token)
+		if err != nil {
+			utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid subject token", "INVALID_SUBJECT_TOKEN", err.Error())
+			return uuid.UUID{}, service.Subject{}, false
+		}
+		if tokenOrg != nil {
+			if req.OrgID != nil && *req.OrgID != *tokenOrg {
+				utils.RespondError(ctx, types.HTTP.BadRequest, "Organization does not match the subject token", "VALIDATION_ERROR", "org_id differs from the organization of the subject token")
+				return uuid.UUID{}, service.Subject{}, false
+			}
+			subject.OrgID = tokenOrg
+		}
+	case req.UserID != nil:
+		subject.UserID = *req.UserID
+	}
+
+	if subject.UserID == actorUUID {
+		subject.IP = ctx.ClientIP()
+		if subject.OrgID == nil {
+			if orgID, ok := middleware.GetOrgID(ctx); ok {
+				subject.OrgID = &orgID
+			}
+		}
+		return actorUUID, subject, true
+	}
+
+	allowed, err := c.service.HasPermission(ctx.Request.Context(), actorUUID, checkPermission)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to check permissions", "PERMISSION_CHECK_FAILED", err.Error())
+		return uuid.UUID{}, service.Subject{}, false
+	}
+	if !allowed {
+		utils.RespondError(ctx, types.HTTP.Forbidden, "Permission denied", "FORBIDDEN", fmt.Sprintf("missing required permission: %s", checkPermission))
+		return uuid.UUID{}, service.Subject{}, false
+	}
+
+	subject.MFA = req.MFA
+	subject.IP = req.IP
+	return actorUUID, subject, true
+}
+
+// toPermissionCheck converts a requested check for the service
+func toPermissionCheck(permission string, resource *models.CheckResource) service.PermissionCheck {
+	check := service.PermissionCheck{Permission: permission}
+	if resource != nil {
+		check.Resource = &service.Resource{
+			Type:       resource.Type,
+			ID:         resource.ID,
+			OwnerID:    resource.OwnerID,
+			Attributes: resource.Attributes,
+		}
+	}
+	return check
+}
+
+// respondCheckError maps check errors to responses
+func respondCheckError(ctx *gin.Context, err error) {
+	if err.Error() == "user not found" {
+		utils.RespondError(ctx, types.HTTP.NotFound, "Subject not found", "USER_NOT_FOUND", err.Error())
+		return
+	}
+	utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to check permissions", "RBAC_ERROR", err.Error())
+}
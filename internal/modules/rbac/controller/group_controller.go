@@ -0,0 +1,289 @@
+package controller
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+	"github.com/shammianand/go-auth/internal/modules/rbac/service"
+)
+
+// ListGroups returns all groups
+func (c *RBACController) ListGroups(ctx *gin.Context) {
+	groups, err := c.service.ListGroups(ctx.Request.Context())
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to list groups", "RBAC_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Groups retrieved successfully", groups)
+}
+
+// GetGroup returns a group with its parents, roles and members
+func (c *RBACController) GetGroup(ctx *gin.Context) {
+	groupID, ok := bindGroupID(ctx)
+	if !ok {
+		return
+	}
+
+	group, err := c.service.GetGroup(ctx.Request.Context(), groupID)
+	if err != nil {
+		respondGroupError(ctx, "Failed to get group", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Group retrieved successfully", group)
+}
+
+// CreateGroup creates a group
+func (c *RBACController) CreateGroup(ctx *gin.Context) {
+	var req models.CreateGroupRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	group, err := c.service.CreateGroup(ctx.Request.Context(), &req, actorUUID)
+	if err != nil {
+		respondGroupError(ctx, "Failed to create group", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Created, "Group created successfully", group)
+}
+
+// UpdateGroup updates a group's code, name or description
+func (c *RBACController) UpdateGroup(ctx *gin.Context) {
+	groupID, ok := bindGroupID(ctx)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateGroupRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	group, err := c.service.UpdateGroup(ctx.Request.Context(), groupID, &req, actorUUID)
+	if err != nil {
+		respondGroupError(ctx, "Failed to update group", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Group updated successfully", group)
+}
+
+// DeleteGroup deletes a group with its memberships and role assignments
+func (c *RBACController) DeleteGroup(ctx *gin.Context) {
+	groupID, ok := bindGroupID(ctx)
+	if !ok {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	result, err := c.service.DeleteGroup(ctx.Request.Context(), groupID, actorUUID)
+	if err != nil {
+		respondGroupError(ctx, "Failed to delete group", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Group deleted successfully", result)
+}
+
+// AddGroupMember adds a user to a group
+func (c *RBACController) AddGroupMember(ctx *gin.Context) {
+	groupID, ok := bindGroupID(ctx)
+	if !ok {
+		return
+	}
+
+	var req models.AddGroupMemberRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := c.service.AddGroupMember(ctx.Request.Context(), groupID, req.UserID, actorUUID); err != nil {
+		respondGroupError(ctx, "Failed to add group member", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Member added successfully", nil)
+}
+
+// RemoveGroupMember removes a user from a group
+func (c *RBACController) RemoveGroupMember(ctx *gin.Context) {
+	groupID, ok := bindGroupID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(ctx.Param("user_id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid user ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := c.service.RemoveGroupMember(ctx.Request.Context(), groupID, userID, actorUUID); err != nil {
+		respondGroupError(ctx, "Failed to remove group member", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Member removed successfully", nil)
+}
+
+// AssignGroupRole assigns a role to a group
+func (c *RBACController) AssignGroupRole(ctx *gin.Context) {
+	groupID, ok := bindGroupID(ctx)
+	if !ok {
+		return
+	}
+
+	var req models.AssignGroupRoleRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := c.service.AssignGroupRole(ctx.Request.Context(), groupID, req.RoleID, actorUUID); err != nil {
+		respondGroupError(ctx, "Failed to assign group role", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role assigned successfully", nil)
+}
+
+// RemoveGroupRole removes a role from a group
+func (c *RBACController) RemoveGroupRole(ctx *gin.Context) {
+	groupID, ok := bindGroupID(ctx)
+	if !ok {
+		return
+	}
+
+	roleID, err := strconv.Atoi(ctx.Param("role_id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid role ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := c.service.RemoveGroupRole(ctx.Request.Context(), groupID, roleID, actorUUID); err != nil {
+		respondGroupError(ctx, "Failed to remove group role", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role removed successfully", nil)
+}
+
+// UpdateGroupParents replaces the groups a group is nested in
+func (c *RBACController) UpdateGroupParents(ctx *gin.Context) {
+	groupID, ok := bindGroupID(ctx)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateGroupParentsRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := c.service.UpdateGroupParents(ctx.Request.Context(), groupID, req.ParentGroupIDs, actorUUID); err != nil {
+		respondGroupError(ctx, "Failed to update group parents", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Group parents updated successfully", nil)
+}
+
+// GetUserGroups returns the groups a user belongs to
+func (c *RBACController) GetUserGroups(ctx *gin.Context) {
+	userID, err := uuid.Parse(ctx.Param("user_id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid user ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	groups, err := c.service.GetUserGroups(ctx.Request.Context(), userID)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to get user groups", "RBAC_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "User groups retrieved successfully", groups)
+}
+
+// bindGroupID parses the group ID path parameter, responding when it is invalid
+func bindGroupID(ctx *gin.Context) (int, bool) {
+	groupID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid group ID", "VALIDATION_ERROR", err.Error())
+		return 0, false
+	}
+	return groupID, true
+}
+
+// respondGroupError maps group management errors to responses
+func respondGroupError(ctx *gin.Context, message string, err error) {
+	msg := err.Error()
+	switch {
+	case msg == "group not found", msg == "parent group not found", msg == "user not found",
+		msg == "group membership not found", msg == "group role assignment not found":
+		utils.RespondError(ctx, types.HTTP.NotFound, msg, "NOT_FOUND", msg)
+	case msg == "user is already a member of the group", msg == "role already assigned to group":
+		utils.RespondError(ctx, types.HTTP.Conflict, msg, "CONFLICT", msg)
+	case msg == "group cannot be nested in itself", msg == "group nesting would create a cycle":
+		utils.RespondError(ctx, types.HTTP.Conflict, msg, "GROUP_CYCLE", msg)
+	case strings.HasPrefix(msg, service.SoDViolationPrefix):
+		utils.RespondError(ctx, types.HTTP.Conflict, msg, "SOD_VIOLATION", msg)
+	default:
+		respondCatalogError(ctx, message, err)
+	}
+}
@@ -0,0 +1,359 @@
+package controller
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/internal/auth"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+	"github.com/shammianand/go-auth/internal/modules/rbac/service"
+)
+
+// ListOrganizations returns all organizations
+func (c *RBACController) ListOrganizations(ctx *gin.Context) {
+	orgs, err := c.service.ListOrganizations(ctx.Request.Context())
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to list organizations", "RBAC_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Organizations retrieved successfully", orgs)
+}
+
+// ListMyOrganizations returns the organizations the authenticated user
+// belongs to
+func (c *RBACController) ListMyOrganizations(ctx *gin.Context) {
+	userUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	orgs, err := c.service.GetUserOrganizations(ctx.Request.Context(), userUUID)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to get organizations", "RBAC_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Organizations retrieved successfully", orgs)
+}
+
+// GetOrganization returns an organization and its settings
+func (c *RBACController) GetOrganization(ctx *gin.Context) {
+	orgID, ok := bindOrgID(ctx)
+	if !ok {
+		return
+	}
+
+	org, err := c.service.GetOrganization(ctx.Request.Context(), orgID)
+	if err != nil {
+		respondOrgError(ctx, "Failed to get organization", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Organization retrieved successfully", org)
+}
+
+// CreateOrganization creates an organization with the caller as its first
+// member
+func (c *RBACController) CreateOrganization(ctx *gin.Context) {
+	var req models.CreateOrganizationRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	org, err := c.service.CreateOrganization(ctx.Request.Context(), &req, actorUUID)
+	if err != nil {
+		respondOrgError(ctx, "Failed to create organization", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Created, "Organization created successfully", org)
+}
+
+// UpdateOrganization updates an organization's details or settings
+func (c *RBACController) UpdateOrganization(ctx *gin.Context) {
+	orgID, ok := bindOrgID(ctx)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateOrganizationRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	org, err := c.service.UpdateOrganization(ctx.Request.Context(), orgID, &req, actorUUID)
+	if err != nil {
+		respondOrgError(ctx, "Failed to update organization", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Organization updated successfully", org)
+}
+
+// DeleteOrganization deletes an organization with its memberships and role
+// assignments
+func (c *RBACController) DeleteOrganization(ctx *gin.Context) {
+	orgID, ok := bindOrgID(ctx)
+	if !ok {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	result, err := c.service.DeleteOrganization(ctx.Request.Context(), orgID, actorUUID)
+	if err != nil {
+		respondOrgError(ctx, "Failed to delete organization", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Organization deleted successfully", result)
+}
+
+// ListOrgMembers returns the members of an organization
+func (c *RBACController) ListOrgMembers(ctx *gin.Context) {
+	orgID, ok := bindOrgID(ctx)
+	if !ok {
+		return
+	}
+
+	members, err := c.service.ListOrgMembers(ctx.Request.Context(), orgID)
+	if err != nil {
+		respondOrgError(ctx, "Failed to list organization members", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Organization members retrieved successfully", members)
+}
+
+// AddOrgMember adds a user to an organization
+func (c *RBACController) AddOrgMember(ctx *gin.Context) {
+	orgID, ok := bindOrgID(ctx)
+	if !ok {
+		return
+	}
+
+	var req models.AddOrgMemberRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := c.service.AddOrgMember(ctx.Request.Context(), orgID, req.UserID, actorUUID); err != nil {
+		respondOrgError(ctx, "Failed to add organization member", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Member added successfully", nil)
+}
+
+// RemoveOrgMember removes a user from an organization
+func (c *RBACController) RemoveOrgMember(ctx *gin.Context) {
+	orgID, ok := bindOrgID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(ctx.Param("user_id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid user ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := c.service.RemoveOrgMember(ctx.Request.Context(), orgID, userID, actorUUID); err != nil {
+		respondOrgError(ctx, "Failed to remove organization member", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Member removed successfully", nil)
+}
+
+// AssignOrgRole assigns a role to a member within an organization
+func (c *RBACController) AssignOrgRole(ctx *gin.Context) {
+	orgID, ok := bindOrgID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(ctx.Param("user_id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid user ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	var req models.AssignOrgRoleRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := c.service.AssignOrgRole(ctx.Request.Context(), orgID, userID, req.RoleID, actorUUID); err != nil {
+		respondOrgError(ctx, "Failed to assign organization role", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role assigned successfully", nil)
+}
+
+// RemoveOrgRole removes a role assigned to a member within an organization
+func (c *RBACController) RemoveOrgRole(ctx *gin.Context) {
+	orgID, ok := bindOrgID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, err := uuid.Parse(ctx.Param("user_id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid user ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	roleID, err := strconv.Atoi(ctx.Param("role_id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid role ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := c.service.RemoveOrgRole(ctx.Request.Context(), orgID, userID, roleID, actorUUID); err != nil {
+		respondOrgError(ctx, "Failed to remove organization role", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role removed successfully", nil)
+}
+
+// GetOrgAuditLogs returns the audit logs of an organization
+func (c *RBACController) GetOrgAuditLogs(ctx *gin.Context) {
+	orgID, ok := bindOrgID(ctx)
+	if !ok {
+		return
+	}
+
+	var filter models.AuditLogFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid query parameters", "VALIDATION_ERROR", err.Error())
+		return
+	}
+	filter.OrgID = orgID.String()
+
+	logs, err := c.service.GetAuditLogs(ctx.Request.Context(), &filter)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to get audit logs", "RBAC_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Audit logs retrieved successfully", logs)
+}
+
+// SwitchOrganization issues a token scoped to one of the caller's
+// organizations, or an unscoped token when no organization is given
+func (c *RBACController) SwitchOrganization(ctx *gin.Context) {
+	var req models.SwitchOrgRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	userUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	token, err := c.service.SwitchOrganization(ctx.Request.Context(), userUUID, req.OrgID)
+	if err != nil {
+		var consentErr *auth.ConsentRequiredError
+		if errors.As(err, &consentErr) {
+			utils.RespondErrorWithDetails(ctx, types.HTTP.Forbidden, "Consent required", "CONSENT_REQUIRED", err.Error(), consentErr.Documents)
+			return
+		}
+		if err.Error() == "organization membership not found" {
+			utils.RespondError(ctx, types.HTTP.Forbidden, "Not a member of the organization", "FORBIDDEN", err.Error())
+			return
+		}
+		respondOrgError(ctx, "Failed to switch organization", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Organization switched successfully", token)
+}
+
+// bindOrgID parses the organization ID path parameter, responding when it is
+// invalid
+func bindOrgID(ctx *gin.Context) (uuid.UUID, bool) {
+	orgID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid organization ID", "VALIDATION_ERROR", err.Error())
+		return uuid.UUID{}, false
+	}
+	return orgID, true
+}
+
+// respondOrgError maps organization management errors to responses
+func respondOrgError(ctx *gin.Context, message string, err error) {
+	msg := err.Error()
+	switch {
+	case msg == "organization not found", msg == "user not found", msg == "role not found",
+		msg == "organization membership not found", msg == "organization role assignment not found":
+		utils.RespondError(ctx, types.HTTP.NotFound, msg, "NOT_FOUND", msg)
+	case msg == "organization slug already exists", msg == "user is already a member of the organization",
+		msg == "role already assigned in organization":
+		utils.RespondError(ctx, types.HTTP.Conflict, msg, "CONFLICT", msg)
+	case msg == "email domain not allowed by organization":
+		utils.RespondError(ctx, types.HTTP.Forbidden, msg, "DOMAIN_NOT_ALLOWED", msg)
+	case msg == "invalid organization slug format", msg == "organization name cannot be empty",
+		strings.HasPrefix(msg, "invalid email domain"):
+		utils.RespondError(ctx, types.HTTP.BadRequest, msg, "VALIDATION_ERROR", msg)
+	case msg == "role cannot be assigned within an organization",
+		msg == "cannot grant a role with permissions you do not hold":
+		utils.RespondError(ctx, types.HTTP.Forbidden, msg, "FORBIDDEN", msg)
+	case strings.HasPrefix(msg, service.SoDViolationPrefix):
+		utils.RespondError(ctx, types.HTTP.Conflict, msg, "SOD_VIOLATION", msg)
+	default:
+		utils.RespondError(ctx, types.HTTP.InternalServerError, message, "RBAC_ERROR", msg)
+	}
+}
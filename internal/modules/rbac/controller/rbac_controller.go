@@ -1,7 +1,9 @@
 package controller
 
 import (
+	"errors"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -110,13 +112,31 @@ func (c *RBACController) AssignRole(ctx *gin.Context) {
 		return
 	}
 
-	err := c.service.AssignRole(ctx.Request.Context(), req.UserID, req.RoleID, actorUUID)
+	opts := service.AssignRoleOptions{
+		ExpiresAt:   req.ExpiresAt,
+		Reason:      req.Reason,
+		OverrideSoD: req.OverrideSoD,
+	}
+
+	err := c.service.AssignRole(ctx.Request.Context(), req.UserID, req.RoleID, actorUUID, opts)
 	if err != nil {
+		if err.Error() == "expires_at must be in the future" || err.Error() == "reason is required to override separation of duties" {
+			utils.RespondError(ctx, types.HTTP.BadRequest, err.Error(), "VALIDATION_ERROR", err.Error())
+			return
+		}
+		if err.Error() == "missing permission to override separation of duties" {
+			utils.RespondError(ctx, types.HTTP.Forbidden, err.Error(), "FORBIDDEN", err.Error())
+			return
+		}
+		if strings.HasPrefix(err.Error(), service.SoDViolationPrefix) {
+			utils.RespondError(ctx, types.HTTP.Conflict, err.Error(), "SOD_VIOLATION", err.Error())
+			return
+		}
 		if err.Error() == "user not found" || err.Error() == "role not found" {
 			utils.RespondError(ctx, types.HTTP.NotFound, err.Error(), "NOT_FOUND", err.Error())
 			return
 		}
-		if err.Error() == "role already assigned to user" {
+		if errors.Is(err, service.ErrRoleAlreadyAssigned) {
 			utils.RespondError(ctx, types.HTTP.Conflict, err.Error(), "CONFLICT", err.Error())
 			return
 		}
@@ -222,6 +242,290 @@ func (c *RBACController) UpdateRolePermissions(ctx *gin.Context) {
 	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role permissions updated successfully", nil)
 }
 
+// SetPermissionCondition sets or clears the condition on a role's grant of a permission
+func (c *RBACController) SetPermissionCondition(ctx *gin.Context) {
+	roleID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid role ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	permissionID, err := strconv.Atoi(ctx.Param("permission_id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid permission ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	var req models.SetPermissionConditionRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	err = c.service.SetPermissionCondition(ctx.Request.Context(), roleID, permissionID, req.Condition, actorUUID)
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case msg == "role not found", msg == "permission not granted to role":
+			utils.RespondError(ctx, types.HTTP.NotFound, msg, "NOT_FOUND", msg)
+		case msg == "cannot modify permissions of system role":
+			utils.RespondError(ctx, types.HTTP.Forbidden, msg, "FORBIDDEN", msg)
+		case strings.HasPrefix(msg, "invalid condition"):
+			utils.RespondError(ctx, types.HTTP.BadRequest, msg, "INVALID_CONDITION", msg)
+		default:
+			utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to update condition", "RBAC_ERROR", msg)
+		}
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Permission condition updated successfully", nil)
+}
+
+// CreateRole creates a custom role
+func (c *RBACController) CreateRole(ctx *gin.Context) {
+	var req models.CreateRoleRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	role, err := c.service.CreateRole(ctx.Request.Context(), &req, actorUUID)
+	if err != nil {
+		respondCatalogError(ctx, "Failed to create role", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Created, "Role created successfully", role)
+}
+
+// UpdateRole changes a custom role
+func (c *RBACController) UpdateRole(ctx *gin.Context) {
+	roleID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid role ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	var req models.UpdateRoleRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	role, err := c.service.UpdateRole(ctx.Request.Context(), roleID, &req, actorUUID)
+	if err != nil {
+		respondCatalogError(ctx, "Failed to update role", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role updated successfully", role)
+}
+
+// DeleteRole deletes a custom role. Pass ?force=true to also remove it from assigned users.
+func (c *RBACController) DeleteRole(ctx *gin.Context) {
+	roleID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid role ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	force, _ := strconv.ParseBool(ctx.Query("force"))
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	result, err := c.service.DeleteRole(ctx.Request.Context(), roleID, force, actorUUID)
+	if err != nil {
+		respondCatalogError(ctx, "Failed to delete role", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role deleted successfully", result)
+}
+
+// CreatePermission creates a custom permission
+func (c *RBACController) CreatePermission(ctx *gin.Context) {
+	var req models.CreatePermissionRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	perm, err := c.service.CreatePermission(ctx.Request.Context(), &req, actorUUID)
+	if err != nil {
+		respondCatalogError(ctx, "Failed to create permission", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Created, "Permission created successfully", perm)
+}
+
+// UpdatePermission changes a custom permission
+func (c *RBACController) UpdatePermission(ctx *gin.Context) {
+	permissionID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid permission ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	var req models.UpdatePermissionRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	perm, err := c.service.UpdatePermission(ctx.Request.Context(), permissionID, &req, actorUUID)
+	if err != nil {
+		respondCatalogError(ctx, "Failed to update permission", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Permission updated successfully", perm)
+}
+
+// DeletePermission deletes a custom permission and revokes it from every role
+func (c *RBACController) DeletePermission(ctx *gin.Context) {
+	permissionID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid permission ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	result, err := c.service.DeletePermission(ctx.Request.Context(), permissionID, actorUUID)
+	if err != nil {
+		respondCatalogError(ctx, "Failed to delete permission", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Permission deleted successfully", result)
+}
+
+// respondCatalogError maps role and permission management errors to responses
+func respondCatalogError(ctx *gin.Context, message string, err error) {
+	msg := err.Error()
+	switch {
+	case msg == "role not found", msg == "parent role not found", msg == "permission not found":
+		utils.RespondError(ctx, types.HTTP.NotFound, msg, "NOT_FOUND", msg)
+	case strings.HasPrefix(msg, "cannot modify system"), strings.HasPrefix(msg, "cannot delete system"):
+		utils.RespondError(ctx, types.HTTP.Forbidden, msg, "FORBIDDEN", msg)
+	case strings.HasSuffix(msg, "code already exists"):
+		utils.RespondError(ctx, types.HTTP.Conflict, msg, "CODE_EXISTS", msg)
+	case strings.HasPrefix(msg, "role is assigned to"):
+		utils.RespondError(ctx, types.HTTP.Conflict, msg, "ROLE_IN_USE", msg+"; pass force=true to remove those assignments")
+	case strings.HasPrefix(msg, "invalid") && strings.HasSuffix(msg, "code format"), strings.HasSuffix(msg, "name cannot be empty"):
+		utils.RespondError(ctx, types.HTTP.BadRequest, msg, "VALIDATION_ERROR", msg)
+	default:
+		utils.RespondError(ctx, types.HTTP.InternalServerError, message, "RBAC_ERROR", msg)
+	}
+}
+
+// UpdateRoleParents replaces the roles a role inherits from
+func (c *RBACController) UpdateRoleParents(ctx *gin.Context) {
+	roleIDStr := ctx.Param("id")
+	roleID, err := strconv.Atoi(roleIDStr)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid role ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	var req models.UpdateRoleParentsRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	err = c.service.UpdateRoleParents(ctx.Request.Context(), roleID, req.ParentRoleIDs, actorUUID)
+	if err != nil {
+		switch err.Error() {
+		case "role not found", "parent role not found":
+			utils.RespondError(ctx, types.HTTP.NotFound, err.Error(), "NOT_FOUND", err.Error())
+		case "cannot modify parents of system role":
+			utils.RespondError(ctx, types.HTTP.Forbidden, err.Error(), "FORBIDDEN", err.Error())
+		case "role cannot inherit from itself", "role inheritance would create a cycle":
+			utils.RespondError(ctx, types.HTTP.Conflict, err.Error(), "ROLE_CYCLE", err.Error())
+		default:
+			utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to update role parents", "RBAC_ERROR", err.Error())
+		}
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role parents updated successfully", nil)
+}
+
+// UpdateRoleApprovers replaces the roles whose holders decide requests for a role
+func (c *RBACController) UpdateRoleApprovers(ctx *gin.Context) {
+	roleID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid role ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	var req models.UpdateRoleApproversRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	err = c.service.UpdateRoleApprovers(ctx.Request.Context(), roleID, req.ApproverRoleIDs, actorUUID)
+	if err != nil {
+		switch err.Error() {
+		case "role not found", "approver role not found":
+			utils.RespondError(ctx, types.HTTP.NotFound, err.Error(), "NOT_FOUND", err.Error())
+		case "cannot modify approvers of system role":
+			utils.RespondError(ctx, types.HTTP.Forbidden, err.Error(), "FORBIDDEN", err.Error())
+		default:
+			utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to update role approvers", "RBAC_ERROR", err.Error())
+		}
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role approvers updated successfully", nil)
+}
+
 // GetAuditLogs returns audit logs with filters
 func (c *RBACController) GetAuditLogs(ctx *gin.Context) {
 	var filter models.AuditLogFilter
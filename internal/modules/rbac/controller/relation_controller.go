@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+)
+
+// WriteRelations adds and removes relation tuples
+func (c *RBACController) WriteRelations(ctx *gin.Context) {
+	var req models.WriteRelationsRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	result, err := c.service.WriteRelations(ctx.Request.Context(), &req, actorUUID)
+	if err != nil {
+		respondRelationError(ctx, "Failed to write relations", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Relations written successfully", result)
+}
+
+// ReadRelations lists stored relation tuples
+func (c *RBACController) ReadRelations(ctx *gin.Context) {
+	var filter models.RelationFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid query parameters", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	tuples, err := c.service.ReadRelations(ctx.Request.Context(), &filter)
+	if err != nil {
+		respondRelationError(ctx, "Failed to read relations", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Relations retrieved successfully", tuples)
+}
+
+// CheckRelation checks whether a subject has a relation to an object
+func (c *RBACController) CheckRelation(ctx *gin.Context) {
+	var req models.RelationCheckRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	result, err := c.service.CheckRelation(ctx.Request.Context(), &req)
+	if err != nil {
+		respondRelationError(ctx, "Failed to check relation", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Relation checked successfully", result)
+}
+
+// ListObjects lists the objects a subject has a relation to
+func (c *RBACController) ListObjects(ctx *gin.Context) {
+	var req models.ListObjectsRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	result, err := c.service.ListObjects(ctx.Request.Context(), &req)
+	if err != nil {
+		respondRelationError(ctx, "Failed to list objects", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Objects retrieved successfully", result)
+}
+
+// ExpandRelation returns the subjects holding a relation to an object
+func (c *RBACController) ExpandRelation(ctx *gin.Context) {
+	var req models.ExpandRelationRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	result, err := c.service.ExpandRelation(ctx.Request.Context(), &req)
+	if err != nil {
+		respondRelationError(ctx, "Failed to expand relation", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Relation expanded successfully", result)
+}
+
+// respondRelationError maps relation errors to responses
+func respondRelationError(ctx *gin.Context, message string, err error) {
+	msg := err.Error()
+	switch {
+	case strings.HasPrefix(msg, "invalid"), strings.HasPrefix(msg, "unknown"),
+		msg == "no relation changes", strings.HasPrefix(msg, "too many relation changes"):
+		utils.RespondError(ctx, types.HTTP.BadRequest, msg, "VALIDATION_ERROR", msg)
+	case msg == "relation graph exceeds the traversal limit":
+		utils.RespondError(ctx, types.HTTP.BadRequest, msg, "RELATION_LIMIT", msg)
+	default:
+		utils.RespondError(ctx, types.HTTP.InternalServerError, message, "RBAC_ERROR", msg)
+	}
+}
@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+	"github.com/shammianand/go-auth/internal/modules/rbac/service"
+)
+
+// RequestRole files a request for a role for the current user
+func (c *RBACController) RequestRole(ctx *gin.Context) {
+	var req models.RoleAccessRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	request, err := c.service.RequestRole(ctx.Request.Context(), userID, &req)
+	if err != nil {
+		respondRoleRequestError(ctx, "Failed to request role", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Created, "Role request submitted for approval", request)
+}
+
+// RequestBreakGlass files an emergency request for a role for the current user
+func (c *RBACController) RequestBreakGlass(ctx *gin.Context) {
+	var req models.BreakGlassRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	request, err := c.service.RequestBreakGlass(ctx.Request.Context(), userID, &req)
+	if err != nil {
+		respondRoleRequestError(ctx, "Failed to request break-glass access", err)
+		return
+	}
+
+	message := "Break-glass request submitted for approval"
+	if request.Status == "approved" {
+		message = "Break-glass access granted"
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Created, message, request)
+}
+
+// ListRoleRequests returns role requests, optionally filtered by status
+func (c *RBACController) ListRoleRequests(ctx *gin.Context) {
+	var filter models.RoleRequestFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid query parameters", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	requests, err := c.service.ListRoleRequests(ctx.Request.Context(), &filter)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to list role requests", "RBAC_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role requests retrieved successfully", requests)
+}
+
+// ListMyRoleRequests returns the current user's role requests
+func (c *RBACController) ListMyRoleRequests(ctx *gin.Context) {
+	var filter models.RoleRequestFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid query parameters", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	requests, err := c.service.ListUserRoleRequests(ctx.Request.Context(), userID, &filter)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to list role requests", "RBAC_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role requests retrieved successfully", requests)
+}
+
+// ListPendingApprovals returns the pending requests the current user may decide
+func (c *RBACController) ListPendingApprovals(ctx *gin.Context) {
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	requests, err := c.service.ListPendingApprovals(ctx.Request.Context(), userID)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to list role requests", "RBAC_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role requests retrieved successfully", requests)
+}
+
+// ApproveRoleRequest grants the role of a pending request
+func (c *RBACController) ApproveRoleRequest(ctx *gin.Context) {
+	requestID, approverID, note, ok := c.bindRoleRequestDecision(ctx)
+	if !ok {
+		return
+	}
+
+	request, err := c.service.ApproveRoleRequest(ctx.Request.Context(), requestID, approverID, note)
+	if err != nil {
+		respondRoleRequestError(ctx, "Failed to approve role request", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role request approved", request)
+}
+
+// DenyRoleRequest rejects a pending request
+func (c *RBACController) DenyRoleRequest(ctx *gin.Context) {
+	requestID, approverID, note, ok := c.bindRoleRequestDecision(ctx)
+	if !ok {
+		return
+	}
+
+	request, err := c.service.DenyRoleRequest(ctx.Request.Context(), requestID, approverID, note)
+	if err != nil {
+		respondRoleRequestError(ctx, "Failed to deny role request", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Role request denied", request)
+}
+
+// bindRoleRequestDecision reads the request ID, the deciding user and the
+// optional note, responding with an error when any is invalid
+func (c *RBACController) bindRoleRequestDecision(ctx *gin.Context) (uuid.UUID, uuid.UUID, string, bool) {
+	requestID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid request ID", "VALIDATION_ERROR", err.Error())
+		return uuid.Nil, uuid.Nil, "", false
+	}
+
+	var req models.DecideRoleRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := utils.BindJSON(ctx, &req); err != nil {
+			return uuid.Nil, uuid.Nil, "", false
+		}
+	}
+
+	approverID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return uuid.Nil, uuid.Nil, "", false
+	}
+
+	return requestID, approverID, req.Note, true
+}
+
+// respondRoleRequestError maps role request errors to responses
+func respondRoleRequestError(ctx *gin.Context, message string, err error) {
+	msg := err.Error()
+	switch {
+	case msg == "role not found", msg == "user not found", msg == "role request not found":
+		utils.RespondError(ctx, types.HTTP.NotFound, msg, "NOT_FOUND", msg)
+	case msg == "role is not available for break-glass access", msg == "cannot decide your own role request",
+		msg == "not an approver for this role":
+		utils.RespondError(ctx, types.HTTP.Forbidden, msg, "FORBIDDEN", msg)
+	case strings.HasPrefix(msg, "duration exceeds"):
+		utils.RespondError(ctx, types.HTTP.BadRequest, msg, "VALIDATION_ERROR", msg)
+	case strings.HasPrefix(msg, service.SoDViolationPrefix):
+		utils.RespondError(ctx, types.HTTP.Conflict, msg, "SOD_VIOLATION", msg)
+	case errors.Is(err, service.ErrRoleAlreadyAssigned), msg == "a request for this role is already pending",
+		msg == "role request is not pending", strings.HasPrefix(msg, "role has reached maximum users limit"):
+		utils.RespondError(ctx, types.HTTP.Conflict, msg, "CONFLICT", msg)
+	default:
+		utils.RespondError(ctx, types.HTTP.InternalServerError, message, "RBAC_ERROR", msg)
+	}
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+)
+
+// ListSoDConstraints returns all separation-of-duties constraints
+func (c *RBACController) ListSoDConstraints(ctx *gin.Context) {
+	constraints, err := c.service.ListSoDConstraints(ctx.Request.Context())
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to list constraints", "RBAC_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Constraints retrieved successfully", constraints)
+}
+
+// GetSoDViolations reports users who currently break a constraint
+func (c *RBACController) GetSoDViolations(ctx *gin.Context) {
+	violations, err := c.service.GetSoDViolations(ctx.Request.Context())
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.InternalServerError, "Failed to compute violations", "RBAC_ERROR", err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Violations retrieved successfully", violations)
+}
+
+// CreateSoDConstraint creates a separation-of-duties constraint
+func (c *RBACController) CreateSoDConstraint(ctx *gin.Context) {
+	var req models.CreateSoDConstraintRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	constraint, err := c.service.CreateSoDConstraint(ctx.Request.Context(), &req, actorUUID)
+	if err != nil {
+		respondSoDError(ctx, "Failed to create constraint", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Created, "Constraint created successfully", constraint)
+}
+
+// UpdateSoDConstraint updates a constraint's name, description or roles
+func (c *RBACController) UpdateSoDConstraint(ctx *gin.Context) {
+	constraintID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid constraint ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	var req models.UpdateSoDConstraintRequest
+	if err := utils.BindJSON(ctx, &req); err != nil {
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	constraint, err := c.service.UpdateSoDConstraint(ctx.Request.Context(), constraintID, &req, actorUUID)
+	if err != nil {
+		respondSoDError(ctx, "Failed to update constraint", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Constraint updated successfully", constraint)
+}
+
+// DeleteSoDConstraint deletes a custom constraint
+func (c *RBACController) DeleteSoDConstraint(ctx *gin.Context) {
+	constraintID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.BadRequest, "Invalid constraint ID", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	actorUUID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		utils.RespondError(ctx, types.HTTP.Unauthorized, "Authentication required", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := c.service.DeleteSoDConstraint(ctx.Request.Context(), constraintID, actorUUID); err != nil {
+		respondSoDError(ctx, "Failed to delete constraint", err)
+		return
+	}
+
+	utils.RespondSuccess(ctx, types.HTTP.Ok, "Constraint deleted successfully", nil)
+}
+
+// respondSoDError maps constraint management errors to responses
+func respondSoDError(ctx *gin.Context, message string, err error) {
+	msg := err.Error()
+	switch {
+	case msg == "constraint not found":
+		utils.RespondError(ctx, types.HTTP.NotFound, msg, "NOT_FOUND", msg)
+	case strings.HasPrefix(msg, "constraint needs"):
+		utils.RespondError(ctx, types.HTTP.BadRequest, msg, "VALIDATION_ERROR", msg)
+	default:
+		respondCatalogError(ctx, message, err)
+	}
+}
@@ -1,11 +1,20 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
 
-// AssignRoleRequest represents a request to assign a role to a user
+	"github.com/google/uuid"
+)
+
+// AssignRoleRequest represents a request to assign a role to a user.
+// Without expires_at the assignment is permanent. override_sod assigns the
+// role despite separation-of-duties constraints and requires a reason.
 type AssignRoleRequest struct {
-	UserID uuid.UUID `json:"user_id" binding:"required"`
-	RoleID int       `json:"role_id" binding:"required"`
+	UserID      uuid.UUID  `json:"user_id" binding:"required"`
+	RoleID      int        `json:"role_id" binding:"required"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	Reason      string     `json:"reason" binding:"max=500"`
+	OverrideSoD bool       `json:"override_sod"`
 }
 
 // RemoveRoleRequest represents a request to remove a role from a user
@@ -14,17 +23,274 @@ type RemoveRoleRequest struct {
 	RoleID int       `json:"role_id" binding:"required"`
 }
 
+// CreateRoleRequest creates a custom role
+type CreateRoleRequest struct {
+	Code          string `json:"code" binding:"required"`
+	Name          string `json:"name" binding:"required"`
+	Description   string `json:"description"`
+	IsDefault     bool   `json:"is_default"`
+	MaxUsers      *int   `json:"max_users" binding:"omitempty,min=1"`
+	OrgAssignable bool   `json:"org_assignable"`
+	BreakGlass    string `json:"break_glass" binding:"omitempty,oneof=disabled auto approval"`
+	ParentRoleIDs []int  `json:"parent_role_ids"`
+	PermissionIDs []int  `json:"permission_ids"`
+}
+
+// UpdateRoleRequest changes a custom role. Omitted fields are left unchanged;
+// a max_users of 0 removes the limit.
+type UpdateRoleRequest struct {
+	Code          *string `json:"code"`
+	Name          *string `json:"name"`
+	Description   *string `json:"description"`
+	IsDefault     *bool   `json:"is_default"`
+	MaxUsers      *int    `json:"max_users" binding:"omitempty,min=0"`
+	OrgAssignable *bool   `json:"org_assignable"`
+	BreakGlass    *string `json:"break_glass" binding:"omitempty,oneof=disabled auto approval"`
+}
+
+// CreatePermissionRequest creates a custom permission
+type CreatePermissionRequest struct {
+	Code        string `json:"code" binding:"required"`
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	Resource    string `json:"resource"`
+	Action      string `json:"action"`
+}
+
+// UpdatePermissionRequest changes a custom permission. Omitted fields are left unchanged.
+type UpdatePermissionRequest struct {
+	Code        *string `json:"code"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	Resource    *string `json:"resource"`
+	Action      *string `json:"action"`
+}
+
 // UpdateRolePermissionsRequest updates permissions for a role
 type UpdateRolePermissionsRequest struct {
 	PermissionIDs []int `json:"permission_ids" binding:"required"`
 }
 
+// UpdateRoleParentsRequest replaces the roles a role inherits from
+type UpdateRoleParentsRequest struct {
+	ParentRoleIDs []int `json:"parent_role_ids" binding:"required"`
+}
+
+// CreateGroupRequest creates a group
+type CreateGroupRequest struct {
+	Code        string `json:"code" binding:"required"`
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+// UpdateGroupRequest changes a group. Omitted fields are left unchanged.
+type UpdateGroupRequest struct {
+	Code        *string `json:"code"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
+
+// AddGroupMemberRequest adds a user to a group
+type AddGroupMemberRequest struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
+}
+
+// AssignGroupRoleRequest assigns a role to a group
+type AssignGroupRoleRequest struct {
+	RoleID int `json:"role_id" binding:"required"`
+}
+
+// UpdateGroupParentsRequest replaces the groups a group is nested in
+type UpdateGroupParentsRequest struct {
+	ParentGroupIDs []int `json:"parent_group_ids" binding:"required"`
+}
+
+// CreateOrganizationRequest creates an organization. allowed_domains limits
+// membership to users with those email domains; empty allows any.
+type CreateOrganizationRequest struct {
+	Slug           string   `json:"slug" binding:"required"`
+	Name           string   `json:"name" binding:"required"`
+	AllowedDomains []string `json:"allowed_domains"`
+	MFARequired    bool     `json:"mfa_required"`
+}
+
+// UpdateOrganizationRequest changes an organization's details or settings.
+// Omitted fields are left unchanged.
+type UpdateOrganizationRequest struct {
+	Slug           *string   `json:"slug"`
+	Name           *string   `json:"name"`
+	AllowedDomains *[]string `json:"allowed_domains"`
+	MFARequired    *bool     `json:"mfa_required"`
+}
+
+// AddOrgMemberRequest adds a user to an organization
+type AddOrgMemberRequest struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
+}
+
+// AssignOrgRoleRequest assigns a role to a member within an organization
+type AssignOrgRoleRequest struct {
+	RoleID int `json:"role_id" binding:"required"`
+}
+
+// SwitchOrgRequest asks for a token scoped to an organization. Without
+// org_id the new token is not scoped to any organization.
+type SwitchOrgRequest struct {
+	OrgID *uuid.UUID `json:"org_id"`
+}
+
+// RoleAccessRequest asks the role's approvers for a role. Reason is the
+// justification shown to approvers; without duration_hours the role is
+// granted permanently.
+type RoleAccessRequest struct {
+	RoleID        int    `json:"role_id" binding:"required"`
+	Reason        string `json:"reason" binding:"required,max=500"`
+	DurationHours *int   `json:"duration_hours" binding:"omitempty,min=1"`
+}
+
+// BreakGlassRequest asks for emergency, time-bound access to a role
+type BreakGlassRequest struct {
+	RoleID        int    `json:"role_id" binding:"required"`
+	DurationHours int    `json:"duration_hours" binding:"required,min=1"`
+	Reason        string `json:"reason" binding:"required,max=500"`
+}
+
+// DecideRoleRequest approves or denies a role request
+type DecideRoleRequest struct {
+	Note string `json:"note" binding:"max=500"`
+}
+
+// RoleRequestFilter represents filters for listing role requests
+type RoleRequestFilter struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending approved denied expired"`
+	Limit  int    `form:"limit"`
+	Offset int    `form:"offset"`
+}
+
+// UpdateRoleApproversRequest replaces the roles whose holders decide requests for a role
+type UpdateRoleApproversRequest struct {
+	ApproverRoleIDs []int `json:"approver_role_ids" binding:"required"`
+}
+
+// CreateSoDConstraintRequest creates a set of mutually exclusive roles
+type CreateSoDConstraintRequest struct {
+	Code        string `json:"code" binding:"required"`
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	RoleIDs     []int  `json:"role_ids" binding:"required,min=2"`
+}
+
+// UpdateSoDConstraintRequest changes a constraint. Omitted fields are left unchanged.
+type UpdateSoDConstraintRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	RoleIDs     []int   `json:"role_ids" binding:"omitempty,min=2"`
+}
+
+// SetPermissionConditionRequest sets the condition on a role's grant of a
+// permission. An empty condition makes the grant unconditional.
+type SetPermissionConditionRequest struct {
+	Condition string `json:"condition"`
+}
+
+// RelationTupleRequest is a relation tuple such as
+// {"object": "document:42", "relation": "editor", "subject": "user:abc"}.
+// A subject with a relation is a userset, e.g. "group:eng#member".
+type RelationTupleRequest struct {
+	Object   string `json:"object" binding:"required"`
+	Relation string `json:"relation" binding:"required"`
+	Subject  string `json:"subject" binding:"required"`
+}
+
+// WriteRelationsRequest adds and removes relation tuples in one transaction
+type WriteRelationsRequest struct {
+	Writes  []RelationTupleRequest `json:"writes" binding:"dive"`
+	Deletes []RelationTupleRequest `json:"deletes" binding:"dive"`
+}
+
+// RelationFilter filters stored relation tuples. Object may be a type
+// ("document") or an object ("document:42").
+type RelationFilter struct {
+	Object   string `form:"object"`
+	Relation string `form:"relation"`
+	Subject  string `form:"subject"`
+	Limit    int    `form:"limit"`
+	Offset   int    `form:"offset"`
+}
+
+// RelationCheckRequest asks whether a subject has a relation to an object.
+// ConsistencyToken, from an earlier write, makes the check at least as fresh
+// as that write.
+type RelationCheckRequest struct {
+	Object           string `json:"object" binding:"required"`
+	Relation         string `json:"relation" binding:"required"`
+	Subject          string `json:"subject" binding:"required"`
+	ConsistencyToken string `json:"consistency_token"`
+}
+
+// ListObjectsRequest asks for the objects of a type a subject has a relation to
+type ListObjectsRequest struct {
+	ObjectType       string `json:"object_type" binding:"required"`
+	Relation         string `json:"relation" binding:"required"`
+	Subject          string `json:"subject" binding:"required"`
+	ConsistencyToken string `json:"consistency_token"`
+}
+
+// ExpandRelationRequest asks for the tree of subjects holding a relation
+type ExpandRelationRequest struct {
+	Object           string `json:"object" binding:"required"`
+	Relation         string `json:"relation" binding:"required"`
+	ConsistencyToken string `json:"consistency_token"`
+}
+
+// CheckSubject identifies the user a check is made for, either by an access
+// token or by ID. When both are empty the caller is the subject. MFA and IP
+// describe the subject's request and feed permission conditions; they are
+// ignored when the caller is the subject. OrgID
+// scopes the check to an organization; it defaults to the organization of
+// the subject token.
+type CheckSubject struct {
+	Token  string     `json:"token"`
+	UserID *uuid.UUID `json:"user_id"`
+	OrgID  *uuid.UUID `json:"org_id"`
+	MFA    bool       `json:"mfa"`
+	IP     string     `json:"ip"`
+}
+
+// CheckResource is the resource a permission is checked against
+type CheckResource struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	OwnerID    string                 `json:"owner_id"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// CheckRequest asks whether a subject may use a permission
+type CheckRequest struct {
+	Subject    CheckSubject   `json:"subject"`
+	Permission string         `json:"permission" binding:"required"`
+	Resource   *CheckResource `json:"resource"`
+}
+
+// PermissionCheckRequest is one permission and resource pair of a batch check
+type PermissionCheckRequest struct {
+	Permission string         `json:"permission" binding:"required"`
+	Resource   *CheckResource `json:"resource"`
+}
+
+// BatchCheckRequest asks for several decisions for the same subject
+type BatchCheckRequest struct {
+	Subject CheckSubject             `json:"subject"`
+	Checks  []PermissionCheckRequest `json:"checks" binding:"required,min=1,max=100,dive"`
+}
+
 // AuditLogFilter represents filters for querying audit logs
 type AuditLogFilter struct {
 	ActorID      string `form:"actor_id"`
 	ActionType   string `form:"action_type"`
 	ResourceType string `form:"resource_type"`
 	ResourceID   string `form:"resource_id"`
+	OrgID        string `form:"org_id"`
 	Limit        int    `form:"limit"`
 	Offset       int    `form:"offset"`
 }
@@ -8,20 +8,24 @@ import (
 
 // RoleResponse represents a role
 type RoleResponse struct {
-	ID          int       `json:"id"`
-	Code        string    `json:"code"`
-	Name        string    `json:"name"`
-	Description string    `json:"description,omitempty"`
-	IsSystem    bool      `json:"is_system"`
-	IsDefault   bool      `json:"is_default"`
-	MaxUsers    *int      `json:"max_users,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID            int       `json:"id"`
+	Code          string    `json:"code"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description,omitempty"`
+	IsSystem      bool      `json:"is_system"`
+	IsDefault     bool      `json:"is_default"`
+	MaxUsers      *int      `json:"max_users,omitempty"`
+	OrgAssignable bool      `json:"org_assignable"`
+	BreakGlass    string    `json:"break_glass"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
-// RoleWithPermissionsResponse includes permissions
+// RoleWithPermissionsResponse includes permissions, own and inherited
 type RoleWithPermissionsResponse struct {
 	RoleResponse
+	Parents     []RoleResponse       `json:"parents"`
+	Approvers   []RoleResponse       `json:"approvers"` // Roles whose holders decide requests for this role
 	Permissions []PermissionResponse `json:"permissions"`
 }
 
@@ -33,15 +37,188 @@ type PermissionResponse struct {
 	Description string    `json:"description,omitempty"`
 	Resource    string    `json:"resource,omitempty"`
 	Action      string    `json:"action,omitempty"`
+	IsSystem    bool      `json:"is_system"`
 	CreatedAt   time.Time `json:"created_at"`
+	GrantedBy   []string  `json:"granted_by,omitempty"` // Codes of the roles in the inheritance chain that grant it
+
+	// Conditions lists the grants that only apply while their condition
+	// holds. Conditional is set when no grant is unconditional; such
+	// permissions are only honoured by Check.
+	Conditions  []PermissionCondition `json:"conditions,omitempty"`
+	Conditional bool                  `json:"conditional,omitempty"`
+}
+
+// PermissionCondition is a condition on a role's grant of a permission
+type PermissionCondition struct {
+	Role      string `json:"role"`
+	Condition string `json:"condition"`
+}
+
+// DeleteRoleResponse reports what was removed with a role
+type DeleteRoleResponse struct {
+	RoleID             int `json:"role_id"`
+	RemovedUserRoles   int `json:"removed_user_roles"`
+	RemovedPermissions int `json:"removed_permissions"`
+	RemovedRoleLinks   int `json:"removed_role_links"`
+	RemovedGroupRoles  int `json:"removed_group_roles"`
+}
+
+// DeletePermissionResponse reports what was removed with a permission
+type DeletePermissionResponse struct {
+	PermissionID     int `json:"permission_id"`
+	RemovedFromRoles int `json:"removed_from_roles"`
+}
+
+// SoDConstraintResponse represents a set of mutually exclusive roles
+type SoDConstraintResponse struct {
+	ID          int            `json:"id"`
+	Code        string         `json:"code"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	IsSystem    bool           `json:"is_system"`
+	Roles       []RoleResponse `json:"roles"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// GroupResponse represents a group of users
+type GroupResponse struct {
+	ID          int        `json:"id"`
+	Code        string     `json:"code"`
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	CreatedBy   *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// GroupDetailResponse is a group with its parents, roles and direct members
+type GroupDetailResponse struct {
+	GroupResponse
+	Parents        []GroupResponse       `json:"parents"`
+	Roles          []RoleResponse        `json:"roles"`           // Roles assigned to the group itself
+	InheritedRoles []RoleResponse        `json:"inherited_roles"` // Roles of the groups it is nested in
+	Members        []GroupMemberResponse `json:"members"`
+}
+
+// GroupMemberResponse represents a direct member of a group
+type GroupMemberResponse struct {
+	UserID  uuid.UUID  `json:"user_id"`
+	Email   string     `json:"email"`
+	AddedBy *uuid.UUID `json:"added_by,omitempty"`
+	AddedAt time.Time  `json:"added_at"`
+}
+
+// UserGroupResponse represents a group a user belongs to. Direct is false
+// for groups the user belongs to through a nested group.
+type UserGroupResponse struct {
+	GroupResponse
+	Direct bool `json:"direct"`
+}
+
+// DeleteGroupResponse reports what was removed with a group
+type DeleteGroupResponse struct {
+	GroupID           int `json:"group_id"`
+	RemovedMembers    int `json:"removed_members"`
+	RemovedGroupRoles int `json:"removed_group_roles"`
+	RemovedGroupLinks int `json:"removed_group_links"`
+}
+
+// OrganizationResponse represents an organization and its settings
+type OrganizationResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	Slug           string     `json:"slug"`
+	Name           string     `json:"name"`
+	AllowedDomains []string   `json:"allowed_domains"`
+	MFARequired    bool       `json:"mfa_required"`
+	CreatedBy      *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// OrgMemberResponse represents a member of an organization with the roles
+// assigned to them in it
+type OrgMemberResponse struct {
+	UserID   uuid.UUID      `json:"user_id"`
+	Email    string         `json:"email"`
+	Roles    []RoleResponse `json:"roles"`
+	AddedBy  *uuid.UUID     `json:"added_by,omitempty"`
+	JoinedAt time.Time      `json:"joined_at"`
+}
+
+// UserOrgResponse represents an organization a user belongs to with the
+// roles assigned to them in it
+type UserOrgResponse struct {
+	OrganizationResponse
+	Roles    []RoleResponse `json:"roles"`
+	JoinedAt time.Time      `json:"joined_at"`
+}
+
+// DeleteOrganizationResponse reports what was removed with an organization
+type DeleteOrganizationResponse struct {
+	OrgID          uuid.UUID `json:"org_id"`
+	RemovedMembers int       `json:"removed_members"`
+	RemovedRoles   int       `json:"removed_roles"`
+}
+
+// OrgTokenResponse is an access token scoped to an organization, or to none
+// when OrgID is empty
+type OrgTokenResponse struct {
+	Token     string     `json:"token"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	OrgID     *uuid.UUID `json:"org_id,omitempty"`
+}
+
+// SoDViolationResponse reports a user holding more than one role of a constraint
+type SoDViolationResponse struct {
+	UserID         uuid.UUID `json:"user_id"`
+	Email          string    `json:"email"`
+	ConstraintID   int       `json:"constraint_id"`
+	ConstraintCode string    `json:"constraint_code"`
+	RoleCodes      []string  `json:"role_codes"` // Conflicting roles the user holds, directly or through inheritance
 }
 
 // UserRolesResponse represents user's roles
 type UserRolesResponse struct {
-	UserID      uuid.UUID      `json:"user_id"`
-	Email       string         `json:"email"`
-	Roles       []RoleResponse `json:"roles"`
-	AssignedAt  time.Time      `json:"assigned_at"`
+	UserID      uuid.UUID                `json:"user_id"`
+	Email       string                   `json:"email"`
+	Roles       []RoleResponse           `json:"roles"`
+	Assignments []RoleAssignmentResponse `json:"assignments"`
+	GroupRoles  []GroupRoleGrant         `json:"group_roles"` // Roles held through groups
+	AssignedAt  time.Time                `json:"assigned_at"`
+}
+
+// GroupRoleGrant names a role a user holds through a group
+type GroupRoleGrant struct {
+	GroupID   int    `json:"group_id"`
+	GroupCode string `json:"group_code"`
+	RoleID    int    `json:"role_id"`
+	RoleCode  string `json:"role_code"`
+}
+
+// RoleAssignmentResponse describes how and until when a user holds a role
+type RoleAssignmentResponse struct {
+	RoleID     int        `json:"role_id"`
+	RoleCode   string     `json:"role_code"`
+	AssignedBy *uuid.UUID `json:"assigned_by,omitempty"`
+	AssignedAt time.Time  `json:"assigned_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	Reason     string     `json:"reason,omitempty"`
+}
+
+// RoleRequestResponse represents a request for a role
+type RoleRequestResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	UserID        uuid.UUID  `json:"user_id"`
+	RoleID        int        `json:"role_id"`
+	Reason        string     `json:"reason"`
+	DurationHours *int       `json:"duration_hours,omitempty"`
+	BreakGlass    bool       `json:"break_glass"`
+	Status        string     `json:"status"`
+	DecidedBy     *uuid.UUID `json:"decided_by,omitempty"`
+	DecidedAt     *time.Time `json:"decided_at,omitempty"`
+	DecisionNote  string     `json:"decision_note,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
 }
 
 // UserPermissionsResponse represents computed user permissions
@@ -50,6 +227,74 @@ type UserPermissionsResponse struct {
 	Permissions []PermissionResponse `json:"permissions"`
 }
 
+// AuthorizationDecision is the outcome of checking a permission for a subject
+type AuthorizationDecision struct {
+	SubjectID  uuid.UUID         `json:"subject_id"`
+	OrgID      *uuid.UUID        `json:"org_id,omitempty"` // Organization the check was scoped to
+	Allowed    bool              `json:"allowed"`
+	Permission string            `json:"permission"`
+	Reason     string            `json:"reason"`
+	GrantedBy  string            `json:"granted_by,omitempty"` // Code of the role whose grant allowed it
+	Condition  string            `json:"condition,omitempty"`  // Condition that held, if the grant was conditional
+	Evaluated  []ConditionResult `json:"evaluated,omitempty"`  // Conditions that were tried
+}
+
+// BatchCheckResponse lists the decisions of a batch check in request order
+type BatchCheckResponse struct {
+	SubjectID uuid.UUID               `json:"subject_id"`
+	Decisions []AuthorizationDecision `json:"decisions"`
+}
+
+// ConditionResult records the evaluation of one conditional grant
+type ConditionResult struct {
+	Role      string `json:"role"`
+	Condition string `json:"condition"`
+	Result    bool   `json:"result"`
+	Error     string `json:"error,omitempty"`
+}
+
+// RelationTupleResponse represents a stored relation tuple
+type RelationTupleResponse struct {
+	Tuple     string    `json:"tuple"` // e.g. document:42#editor@user:abc
+	Object    string    `json:"object"`
+	Relation  string    `json:"relation"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WriteRelationsResponse reports a relation write and its consistency token
+type WriteRelationsResponse struct {
+	Written          int    `json:"written"`
+	Deleted          int    `json:"deleted"`
+	ConsistencyToken string `json:"consistency_token"`
+}
+
+// RelationCheckResponse is the outcome of a relation check
+type RelationCheckResponse struct {
+	Allowed          bool   `json:"allowed"`
+	ConsistencyToken string `json:"consistency_token"`
+}
+
+// ListObjectsResponse lists the objects a subject has a relation to
+type ListObjectsResponse struct {
+	Objects          []string `json:"objects"`
+	ConsistencyToken string   `json:"consistency_token"`
+}
+
+// RelationTree is the expansion of a userset: its direct subjects and the
+// usersets whose members it includes
+type RelationTree struct {
+	Userset  string         `json:"userset"` // e.g. document:42#viewer
+	Subjects []string       `json:"subjects,omitempty"`
+	Children []RelationTree `json:"children,omitempty"`
+}
+
+// ExpandRelationResponse is the expansion of a relation
+type ExpandRelationResponse struct {
+	Tree             RelationTree `json:"tree"`
+	ConsistencyToken string       `json:"consistency_token"`
+}
+
 // AuditLogResponse represents an audit log entry
 type AuditLogResponse struct {
 	ID           uuid.UUID              `json:"id"`
@@ -61,5 +306,6 @@ type AuditLogResponse struct {
 	Changes      map[string]interface{} `json:"changes,omitempty"`
 	IPAddress    string                 `json:"ip_address,omitempty"`
 	UserAgent    string                 `json:"user_agent,omitempty"`
+	OrgID        *uuid.UUID             `json:"org_id,omitempty"`
 	CreatedAt    time.Time              `json:"created_at"`
 }
@@ -5,7 +5,6 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
-	"github.com/shammianand/go-auth/ent"
 	"github.com/shammianand/go-auth/internal/common/middleware"
 	"github.com/shammianand/go-auth/internal/modules/rbac/controller"
 	"github.com/shammianand/go-auth/internal/modules/rbac/service"
@@ -14,13 +13,14 @@ import (
 // RegisterRoutes registers RBAC routes
 func RegisterRoutes(
 	router *gin.RouterGroup,
-	client *ent.Client,
+	rbacService *service.RBACService,
 	redisClient *redis.Client,
+	keys middleware.APIKeyVerifier,
 	logger *slog.Logger,
 ) {
-	// Initialize service and controller
-	rbacService := service.NewRBACService(client, logger)
+	// Initialize controller
 	rbacController := controller.NewRBACController(rbacService)
+	checkController := controller.NewCheckController(rbacService, redisClient)
 
 	// Create rbac group under /api/v1/rbac
 	rbac := router.Group("/rbac")
@@ -32,20 +32,105 @@ func RegisterRoutes(
 
 	// Protected routes (require authentication)
 	authenticated := rbac.Group("")
-	authenticated.Use(middleware.RequireAuth(redisClient))
+	authenticated.Use(middleware.RequireAuth(redisClient, keys))
 	{
-		// User roles and permissions (any authenticated user can view)
-		authenticated.GET("/users/:user_id/roles", rbacController.GetUserRoles)
-		authenticated.GET("/users/:user_id/permissions", rbacController.GetUserPermissions)
+		// User roles and permissions (own, or any user with users.read)
+		authenticated.GET("/users/:user_id/roles",
+			middleware.RequirePermissionOrSelf(rbacService, "user_id", "users.read"),
+			rbacController.GetUserRoles,
+		)
+		authenticated.GET("/users/:user_id/permissions",
+			middleware.RequirePermissionOrSelf(rbacService, "user_id", "users.read"),
+			rbacController.GetUserPermissions,
+		)
 
-		// Role assignment (require admin permissions)
-		authenticated.POST("/users/assign-role", rbacController.AssignRole)
-		authenticated.POST("/users/remove-role", rbacController.RemoveRole)
+		authenticated.GET("/users/:user_id/groups",
+			middleware.RequirePermissionOrSelf(rbacService, "user_id", "users.read"),
+			rbacController.GetUserGroups,
+		)
 
-		// Role permission management (require admin permissions)
-		authenticated.PUT("/roles/:id/permissions", rbacController.UpdateRolePermissions)
+		// Role assignment
+		authenticated.POST("/users/assign-role", middleware.RequirePermission(rbacService, "rbac.assign"), rbacController.AssignRole)
+		authenticated.POST("/users/remove-role", middleware.RequirePermission(rbacService, "rbac.assign"), rbacController.RemoveRole)
 
-		// Audit logs (require admin permissions)
-		authenticated.GET("/audit-logs", rbacController.GetAuditLogs)
+		// Role and permission management
+		authenticated.POST("/roles", middleware.RequirePermission(rbacService, "rbac.roles.write"), rbacController.CreateRole)
+		authenticated.PATCH("/roles/:id", middleware.RequirePermission(rbacService, "rbac.roles.write"), rbacController.UpdateRole)
+		authenticated.DELETE("/roles/:id", middleware.RequirePermission(rbacService, "rbac.roles.write"), rbacController.DeleteRole)
+		authenticated.POST("/permissions", middleware.RequirePermission(rbacService, "rbac.permissions.write"), rbacController.CreatePermission)
+		authenticated.PATCH("/permissions/:id", middleware.RequirePermission(rbacService, "rbac.permissions.write"), rbacController.UpdatePermission)
+		authenticated.DELETE("/permissions/:id", middleware.RequirePermission(rbacService, "rbac.permissions.write"), rbacController.DeletePermission)
+
+		// Role permission assignments
+		authenticated.PUT("/roles/:id/permissions", middleware.RequirePermission(rbacService, "rbac.permissions.write"), rbacController.UpdateRolePermissions)
+		authenticated.PUT("/roles/:id/permissions/:permission_id/condition", middleware.RequirePermission(rbacService, "rbac.permissions.write"), rbacController.SetPermissionCondition)
+		authenticated.PUT("/roles/:id/parents", middleware.RequirePermission(rbacService, "rbac.roles.write"), rbacController.UpdateRoleParents)
+		authenticated.PUT("/roles/:id/approvers", middleware.RequirePermission(rbacService, "rbac.roles.write"), rbacController.UpdateRoleApprovers)
+
+		// Groups. Membership, group roles and nesting grant roles, so they
+		// need rbac.assign like direct assignments.
+		authenticated.GET("/groups", middleware.RequirePermission(rbacService, "rbac.groups.read"), rbacController.ListGroups)
+		authenticated.GET("/groups/:id", middleware.RequirePermission(rbacService, "rbac.groups.read"), rbacController.GetGroup)
+		authenticated.POST("/groups", middleware.RequirePermission(rbacService, "rbac.groups.write"), rbacController.CreateGroup)
+		authenticated.PATCH("/groups/:id", middleware.RequirePermission(rbacService, "rbac.groups.write"), rbacController.UpdateGroup)
+		authenticated.DELETE("/groups/:id", middleware.RequirePermission(rbacService, "rbac.groups.write"), rbacController.DeleteGroup)
+		authenticated.POST("/groups/:id/members", middleware.RequirePermission(rbacService, "rbac.assign"), rbacController.AddGroupMember)
+		authenticated.DELETE("/groups/:id/members/:user_id", middleware.RequirePermission(rbacService, "rbac.assign"), rbacController.RemoveGroupMember)
+		authenticated.POST("/groups/:id/roles", middleware.RequirePermission(rbacService, "rbac.assign"), rbacController.AssignGroupRole)
+		authenticated.DELETE("/groups/:id/roles/:role_id", middleware.RequirePermission(rbacService, "rbac.assign"), rbacController.RemoveGroupRole)
+		authenticated.PUT("/groups/:id/parents", middleware.RequirePermission(rbacService, "rbac.assign"), rbacController.UpdateGroupParents)
+
+		// Separation-of-duties constraints
+		authenticated.GET("/sod-constraints", middleware.RequirePermission(rbacService, "rbac.roles.read"), rbacController.ListSoDConstraints)
+		authenticated.GET("/sod-constraints/violations", middleware.RequirePermission(rbacService, "rbac.audit.read"), rbacController.GetSoDViolations)
+		authenticated.POST("/sod-constraints", middleware.RequirePermission(rbacService, "rbac.roles.write"), rbacController.CreateSoDConstraint)
+		authenticated.PATCH("/sod-constraints/:id", middleware.RequirePermission(rbacService, "rbac.roles.write"), rbacController.UpdateSoDConstraint)
+		authenticated.DELETE("/sod-constraints/:id", middleware.RequirePermission(rbacService, "rbac.roles.write"), rbacController.DeleteSoDConstraint)
+
+		// Role requests and break-glass access. Approvers are checked per role
+		// by the service.
+		authenticated.POST("/role-requests", rbacController.RequestRole)
+		authenticated.POST("/break-glass", rbacController.RequestBreakGlass)
+		authenticated.GET("/role-requests", middleware.RequirePermission(rbacService, "rbac.assign"), rbacController.ListRoleRequests)
+		authenticated.GET("/role-requests/mine", rbacController.ListMyRoleRequests)
+		authenticated.GET("/role-requests/approvals", rbacController.ListPendingApprovals)
+		authenticated.POST("/role-requests/:id/approve", rbacController.ApproveRoleRequest)
+		authenticated.POST("/role-requests/:id/deny", rbacController.DenyRoleRequest)
+
+		// Authorization decisions for downstream services. Checking another
+		// subject requires rbac.check, which the controller verifies.
+		authenticated.POST("/check", checkController.Check)
+		authenticated.POST("/check/batch", checkController.BatchCheck)
+
+		// Relationship-based authorization tuples
+		authenticated.POST("/relations", middleware.RequirePermission(rbacService, "rbac.relations.write"), rbacController.WriteRelations)
+		authenticated.GET("/relations", middleware.RequirePermission(rbacService, "rbac.relations.read"), rbacController.ReadRelations)
+		authenticated.POST("/relations/check", middleware.RequirePermission(rbacService, "rbac.relations.read"), rbacController.CheckRelation)
+		authenticated.POST("/relations/list-objects", middleware.RequirePermission(rbacService, "rbac.relations.read"), rbacController.ListObjects)
+		authenticated.POST("/relations/expand", middleware.RequirePermission(rbacService, "rbac.relations.read"), rbacController.ExpandRelation)
+
+		// Audit logs
+		authenticated.GET("/audit-logs", middleware.RequirePermission(rbacService, "rbac.audit.read"), rbacController.GetAuditLogs)
+	}
+
+	// Organizations under /api/v1/orgs. Roles assigned in an organization
+	// count for its routes once the token is switched to it.
+	orgs := router.Group("/orgs")
+	orgs.Use(middleware.RequireAuth(redisClient, keys))
+	{
+		orgs.GET("", middleware.RequirePermission(rbacService, "orgs.read"), rbacController.ListOrganizations)
+		orgs.POST("", middleware.RequirePermission(rbacService, "orgs.create"), rbacController.CreateOrganization)
+		orgs.GET("/mine", rbacController.ListMyOrganizations)
+		orgs.POST("/switch", middleware.DenyImpersonation(), middleware.DenyAPIKey(), rbacController.SwitchOrganization)
+
+		orgs.GET("/:id", middleware.RequireOrgPermission(rbacService, "id", "orgs.read"), rbacController.GetOrganization)
+		orgs.PATCH("/:id", middleware.RequireOrgPermission(rbacService, "id", "orgs.write"), rbacController.UpdateOrganization)
+		orgs.DELETE("/:id", middleware.RequireOrgPermission(rbacService, "id", "orgs.write"), rbacController.DeleteOrganization)
+		orgs.GET("/:id/members", middleware.RequireOrgPermission(rbacService, "id", "orgs.read"), rbacController.ListOrgMembers)
+		orgs.POST("/:id/members", middleware.RequireOrgPermission(rbacService, "id", "orgs.members.write"), rbacController.AddOrgMember)
+		orgs.DELETE("/:id/members/:user_id", middleware.RequireOrgPermission(rbacService, "id", "orgs.members.write"), rbacController.RemoveOrgMember)
+		orgs.POST("/:id/members/:user_id/roles", middleware.RequireOrgPermission(rbacService, "id", "orgs.members.write"), rbacController.AssignOrgRole)
+		orgs.DELETE("/:id/members/:user_id/roles/:role_id", middleware.RequireOrgPermission(rbacService, "id", "orgs.members.write"), rbacController.RemoveOrgRole)
+		orgs.GET("/:id/audit-logs", middleware.RequireOrgPermission(rbacService, "id", "orgs.audit.read"), rbacController.GetOrgAuditLogs)
 	}
 }
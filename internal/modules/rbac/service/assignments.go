@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/userroles"
+	"github.com/shammianand/go-auth/ent/users"
+)
+
+// expiryBatchSize bounds how many expired assignments are loaded at once
+const expiryBatchSize = 500
+
+// ErrRoleAlreadyAssigned is returned when a user already holds a role that
+// has not expired
+var ErrRoleAlreadyAssigned = errors.New("role already assigned to user")
+
+// AssignRoleOptions are optional settings for a role assignment
+type AssignRoleOptions struct {
+	ExpiresAt   *time.Time // Nil assigns the role permanently
+	Reason      string
+	OverrideSoD bool // Assign despite separation-of-duties constraints; needs a reason
+}
+
+// activeAssignment matches assignments that have not expired yet. Expired
+// assignments stop granting permissions immediately, even before the expiry
+// job deletes them.
+func activeAssignment() predicate.UserRoles {
+	return userroles.Or(
+		userroles.ExpiresAtIsNil(),
+		userroles.ExpiresAtGT(time.Now()),
+	)
+}
+
+// grantRole checks and inserts a role assignment using client, which should
+// be bound to a transaction. It returns the codes of the separation-of-duties
+// constraints that were overridden. The caller invalidates caches and audits
+// once the assignment is committed.
+func (s *RBACService) grantRole(ctx context.Context, client *ent.Client, userID uuid.UUID, roleID int, actorID uuid.UUID, opts AssignRoleOptions) (*ent.UserRoles, []string, error) {
+	// Lock the user so concurrent grants cannot both pass the
+	// separation-of-duties check
+	_, err := client.Users.Query().
+		Where(users.IDEQ(userID)).
+		ForUpdate().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("user not found")
+		}
+		return nil, nil, fmt.Errorf("failed to check user: %w", err)
+	}
+
+	// Check if role exists
+	role, err := client.Roles.Get(ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("role not found")
+		}
+		return nil, nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	// Check if already assigned; an expired assignment the job has not
+	// removed yet is revoked now
+	existing, err := client.UserRoles.Query().
+		Where(
+			userroles.UserIDEQ(userID),
+			userroles.RoleIDEQ(roleID),
+		).
+		Only(ctx)
+
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("failed to check existing assignment: %w", err)
+	}
+
+	if existing != nil {
+		if existing.ExpiresAt == nil || existing.ExpiresAt.After(time.Now()) {
+			return nil, nil, ErrRoleAlreadyAssigned
+		}
+		if _, err := s.expireAssignment(ctx, client, existing); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Check max_users constraint
+	if role.MaxUsers != nil {
+		count, err := client.UserRoles.Query().
+			Where(userroles.RoleIDEQ(roleID), activeAssignment()).
+			Count(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to count role users: %w", err)
+		}
+		if count >= *role.MaxUsers {
+			return nil, nil, fmt.Errorf("role has reached maximum users limit (%d)", *role.MaxUsers)
+		}
+	}
+
+	violated, err := s.checkSeparationOfDuties(ctx, client, userID, roleID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(violated) > 0 && !opts.OverrideSoD {
+		return nil, nil, sodViolationError(violated)
+	}
+
+	create := client.UserRoles.Create().
+		SetUserID(userID).
+		SetRoleID(roleID).
+		SetNillableAssignedBy(&actorID).
+		SetNillableExpiresAt(opts.ExpiresAt)
+	if opts.Reason != "" {
+		create = create.SetReason(opts.Reason)
+	}
+
+	assignment, err := create.Save(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to assign role: %w", err)
+	}
+
+	return assignment, violated, nil
+}
+
+// RevokeExpiredRoles deletes every role assignment past its expiry and
+// returns how many were revoked. Each revocation is audited.
+func (s *RBACService) RevokeExpiredRoles(ctx context.Context) (int, error) {
+	revoked := 0
+
+	for {
+		expired, err := s.client.UserRoles.Query().
+			Where(userroles.ExpiresAtLTE(time.Now())).
+			Limit(expiryBatchSize).
+			All(ctx)
+
+		if err != nil {
+			return revoked, fmt.Errorf("failed to query expired role assignments: %w", err)
+		}
+
+		for _, assignment := range expired {
+			ok, err := s.expireAssignment(ctx, s.client, assignment)
+			if err != nil {
+				return revoked, err
+			}
+			if ok {
+				revoked++
+			}
+		}
+
+		if len(expired) < expiryBatchSize {
+			return revoked, nil
+		}
+	}
+}
+
+// RunRoleExpiry revokes expired role assignments and expires stale role
+// requests every interval until ctx is done
+func (s *RBACService) RunRoleExpiry(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		revoked, err := s.RevokeExpiredRoles(ctx)
+		if err != nil {
+			s.logger.Error("Failed to revoke expired roles", "error", err)
+		} else if revoked > 0 {
+			s.logger.Info("Expired roles revoked", "count", revoked)
+		}
+
+		expired, err := s.ExpireStaleRoleRequests(ctx)
+		if err != nil {
+			s.logger.Error("Failed to expire stale role requests", "error", err)
+		} else if expired > 0 {
+			s.logger.Info("Stale role requests expired", "count", expired)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// expireAssignment deletes an expired assignment and audits it. It reports
+// false when another instance already revoked it.
+func (s *RBACService) expireAssignment(ctx context.Context, client *ent.Client, assignment *ent.UserRoles) (bool, error) {
+	deleted, err := client.UserRoles.Delete().
+		Where(
+			userroles.IDEQ(assignment.ID),
+			userroles.ExpiresAtLTE(time.Now()),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke expired role: %w", err)
+	}
+	if deleted == 0 {
+		return false, nil
+	}
+
+	s.permissions.InvalidateUser(ctx, assignment.UserID)
+	s.createSystemAuditLog(ctx, "role.expire", "user_role", assignment.UserID.String(), assignmentMetadata(assignment))
+
+	return true, nil
+}
+
+// usersWithPermission returns the active users whose roles, directly or
+// through inheritance, grant a permission unconditionally
+func (s *RBACService) usersWithPermission(ctx context.Context, permission string) ([]*ent.Users, error) {
+	allPerms, err := s.client.Permissions.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list permissions: %w", err)
+	}
+
+	matching := make(map[int]bool)
+	for _, perm := range allPerms {
+		if PermissionMatches(perm.Code, permission) {
+			matching[perm.ID] = true
+		}
+	}
+	if len(matching) == 0 {
+		return []*ent.Users{}, nil
+	}
+
+	grants, err := s.client.RolePermissions.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role permissions: %w", err)
+	}
+
+	granting := make(map[int]bool)
+	for _, grant := range grants {
+		if matching[grant.PermissionID] && grant.Condition == "" {
+			granting[grant.RoleID] = true
+		}
+	}
+
+	return s.usersHoldingRoles(ctx, granting)
+}
+
+// usersHoldingRoles returns the active users who hold any of the given roles,
+// directly, through a group or through a role that inherits from one of them
+func (s *RBACService) usersHoldingRoles(ctx context.Context, target map[int]bool) ([]*ent.Users, error) {
+	if len(target) == 0 {
+		return []*ent.Users{}, nil
+	}
+
+	hierarchy, err := LoadRoleHierarchy(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	roleIDs, err := s.client.Roles.Query().IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	holding := make([]int, 0)
+	for _, roleID := range roleIDs {
+		for _, ancestor := range hierarchy.Ancestors(roleID) {
+			if target[ancestor] {
+				holding = append(holding, roleID)
+				break
+			}
+		}
+	}
+	if len(holding) == 0 {
+		return []*ent.Users{}, nil
+	}
+
+	holds := users.HasUserRolesWith(userroles.RoleIDIn(holding...), activeAssignment())
+
+	groupIDs, err := s.groupsHoldingRoles(ctx, holding)
+	if err != nil {
+		return nil, err
+	}
+	if len(groupIDs) > 0 {
+		holds = users.Or(holds, users.HasGroupMembershipsWith(groupmembers.GroupIDIn(groupIDs...)))
+	}
+
+	holders, err := s.client.Users.Query().
+		Where(users.IsActive(true), holds).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role holders: %w", err)
+	}
+
+	return holders, nil
+}
+
+// effectiveRoles returns the IDs of a user's active roles, including those
+// held through groups, and every role they inherit from
+func (s *RBACService) effectiveRoles(ctx context.Context, userID uuid.UUID) (map[int]bool, error) {
+	roleIDs, err := userRoleIDs(ctx, s.client, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	hierarchy, err := LoadRoleHierarchy(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int]bool)
+	for _, roleID := range hierarchy.Ancestors(roleIDs...) {
+		result[roleID] = true
+	}
+	return result, nil
+}
+
+// assignmentMetadata describes an assignment for the audit log
+func assignmentMetadata(assignment *ent.UserRoles) map[string]interface{} {
+	metadata := map[string]interface{}{
+		"user_id": assignment.UserID.String(),
+		"role_id": assignment.RoleID,
+	}
+	if assignment.ExpiresAt != nil {
+		metadata["expires_at"] = assignment.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	if assignment.Reason != "" {
+		metadata["reason"] = assignment.Reason
+	}
+	if assignment.AssignedBy != nil {
+		metadata["assigned_by"] = assignment.AssignedBy.String()
+	}
+	return metadata
+}
@@ -0,0 +1,553 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/permissions"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roleparents"
+	"github.com/shammianand/go-auth/ent/rolepermissions"
+	"github.com/shammianand/go-auth/ent/rolerequests"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/userroles"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+)
+
+var (
+	// Role codes are kebab-case, e.g. "support-agent"
+	roleCodePattern = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+
+	// Permission codes are dot separated segments, e.g. "billing.invoices.read".
+	// Wildcards are reserved for role grants and cannot be used as codes.
+	permissionCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*(\.[a-z][a-z0-9_-]*)*$`)
+)
+
+const maxCodeLength = 64
+
+// CreateRole creates a custom role with optional parents and permissions
+func (s *RBACService) CreateRole(ctx context.Context, req *models.CreateRoleRequest, actorID uuid.UUID) (*models.RoleWithPermissionsResponse, error) {
+	if err := validateCode(req.Code, roleCodePattern, "role"); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.client.Roles.Query().Where(roles.CodeEQ(req.Code)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check role code: %w", err)
+	}
+	if exists {
+		return nil, fmt.Errorf("role code already exists")
+	}
+
+	parentIDs := uniqueInts(req.ParentRoleIDs)
+	if err := s.checkRolesExist(ctx, parentIDs, "parent role not found"); err != nil {
+		return nil, err
+	}
+
+	permissionIDs := uniqueInts(req.PermissionIDs)
+	if err := s.checkPermissionsExist(ctx, permissionIDs); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	create := tx.Roles.Create().
+		SetCode(req.Code).
+		SetName(req.Name).
+		SetIsSystem(false).
+		SetIsDefault(req.IsDefault).
+		SetNillableMaxUsers(req.MaxUsers).
+		SetOrgAssignable(req.OrgAssignable)
+	if req.Description != "" {
+		create = create.SetDescription(req.Description)
+	}
+	if req.BreakGlass != "" {
+		create = create.SetBreakGlass(roles.BreakGlass(req.BreakGlass))
+	}
+
+	role, err := create.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("role code already exists")
+		}
+		return nil, fmt.Errorf("failed to create role: %w", err)
+	}
+
+	// A new role has no children, so its parents cannot form a cycle
+	for _, parentID := range parentIDs {
+		_, err := tx.RoleParents.Create().
+			SetRoleID(role.ID).
+			SetParentRoleID(parentID).
+			Save(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to add parent role %d: %w", parentID, err)
+		}
+	}
+
+	for _, permID := range permissionIDs {
+		_, err := tx.RolePermissions.Create().
+			SetRoleID(role.ID).
+			SetPermissionID(permID).
+			Save(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to add permission %d: %w", permID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to create role: %w", err)
+	}
+
+	s.createAuditLogWithChanges(ctx, actorID, "role.create", "role", fmt.Sprintf("%d", role.ID), map[string]interface{}{
+		"role_id":         role.ID,
+		"parent_role_ids": parentIDs,
+		"permission_ids":  permissionIDs,
+	}, map[string]interface{}{
+		"before": nil,
+		"after":  s.roleToResponse(role),
+	})
+
+	return s.GetRole(ctx, role.ID)
+}
+
+// UpdateRole changes a custom role's code, name, description, default flag,
+// user limit or break-glass policy
+func (s *RBACService) UpdateRole(ctx context.Context, roleID int, req *models.UpdateRoleRequest, actorID uuid.UUID) (*models.RoleResponse, error) {
+	role, err := s.client.Roles.Get(ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("role not found")
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	if role.IsSystem {
+		return nil, fmt.Errorf("cannot modify system role")
+	}
+
+	before := s.roleToResponse(role)
+	update := role.Update()
+
+	if req.Code != nil && *req.Code != role.Code {
+		if err := validateCode(*req.Code, roleCodePattern, "role"); err != nil {
+			return nil, err
+		}
+		update = update.SetCode(*req.Code)
+	}
+	if req.Name != nil {
+		if *req.Name == "" {
+			return nil, fmt.Errorf("role name cannot be empty")
+		}
+		update = update.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		update = update.SetDescription(*req.Description)
+	}
+	if req.IsDefault != nil {
+		update = update.SetIsDefault(*req.IsDefault)
+	}
+	if req.MaxUsers != nil {
+		if *req.MaxUsers == 0 {
+			update = update.ClearMaxUsers()
+		} else {
+			update = update.SetMaxUsers(*req.MaxUsers)
+		}
+	}
+	if req.OrgAssignable != nil {
+		update = update.SetOrgAssignable(*req.OrgAssignable)
+	}
+	if req.BreakGlass != nil {
+		update = update.SetBreakGlass(roles.BreakGlass(*req.BreakGlass))
+	}
+
+	role, err = update.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("role code already exists")
+		}
+		return nil, fmt.Errorf("failed to update role: %w", err)
+	}
+
+	after := s.roleToResponse(role)
+
+	// Cached permissions name their granting roles by code
+	if before.Code != after.Code {
+		s.permissions.InvalidateAll(ctx)
+	}
+
+	s.createAuditLogWithChanges(ctx, actorID, "role.update", "role", fmt.Sprintf("%d", roleID), map[string]interface{}{
+		"role_id": roleID,
+	}, map[string]interface{}{
+		"before": before,
+		"after":  after,
+	})
+
+	return &after, nil
+}
+
+// DeleteRole deletes a custom role together with its user and group
+// assignments, permission grants, inheritance and approver links, and expires
+// its pending requests. Roles that are still assigned to users or groups are
+// only deleted when force is set.
+func (s *RBACService) DeleteRole(ctx context.Context, roleID int, force bool, actorID uuid.UUID) (*models.DeleteRoleResponse, error) {
+	role, err := s.client.Roles.Get(ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("role not found")
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	if role.IsSystem {
+		return nil, fmt.Errorf("cannot delete system role")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	assignments, err := tx.UserRoles.Query().
+		Where(userroles.RoleIDEQ(roleID)).
+		All(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to check role assignments: %w", err)
+	}
+
+	assignedUsers := make([]string, len(assignments))
+	for i, assignment := range assignments {
+		assignedUsers[i] = assignment.UserID.String()
+	}
+
+	if len(assignedUsers) > 0 && !force {
+		tx.Rollback()
+		return nil, fmt.Errorf("role is assigned to %d users", len(assignedUsers))
+	}
+
+	assignedGroups, err := tx.GroupRoles.Query().
+		Where(grouproles.RoleIDEQ(roleID)).
+		Count(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to check group assignments: %w", err)
+	}
+
+	if assignedGroups > 0 && !force {
+		tx.Rollback()
+		return nil, fmt.Errorf("role is assigned to %d groups", assignedGroups)
+	}
+
+	result := &models.DeleteRoleResponse{RoleID: roleID}
+
+	result.RemovedUserRoles, err = tx.UserRoles.Delete().
+		Where(userroles.RoleIDEQ(roleID)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove role assignments: %w", err)
+	}
+
+	result.RemovedGroupRoles, err = tx.GroupRoles.Delete().
+		Where(grouproles.RoleIDEQ(roleID)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove group role assignments: %w", err)
+	}
+
+	result.RemovedPermissions, err = tx.RolePermissions.Delete().
+		Where(rolepermissions.RoleIDEQ(roleID)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove role permissions: %w", err)
+	}
+
+	result.RemovedRoleLinks, err = tx.RoleParents.Delete().
+		Where(roleparents.Or(
+			roleparents.RoleIDEQ(roleID),
+			roleparents.ParentRoleIDEQ(roleID),
+		)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove role inheritance: %w", err)
+	}
+
+	removedApprovers, err := tx.RoleApprovers.Delete().
+		Where(roleapprovers.Or(
+			roleapprovers.RoleIDEQ(roleID),
+			roleapprovers.ApproverRoleIDEQ(roleID),
+		)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove role approvers: %w", err)
+	}
+	result.RemovedRoleLinks += removedApprovers
+
+	// A constraint left with fewer than two roles can no longer be violated
+	removedConstraints, err := tx.SodConstraintRoles.Delete().
+		Where(sodconstraintroles.RoleIDEQ(roleID)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove role from constraints: %w", err)
+	}
+	result.RemovedRoleLinks += removedConstraints
+
+	_, err = tx.RoleRequests.Update().
+		Where(
+			rolerequests.RoleIDEQ(roleID),
+			rolerequests.StatusEQ(rolerequests.StatusPending),
+		).
+		SetStatus(rolerequests.StatusExpired).
+		SetDecisionNote("role deleted").
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to close role requests: %w", err)
+	}
+
+	if err := tx.Roles.DeleteOneID(roleID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	s.permissions.InvalidateAll(ctx)
+
+	s.createAuditLogWithChanges(ctx, actorID, "role.delete", "role", fmt.Sprintf("%d", roleID), map[string]interface{}{
+		"role_id":             roleID,
+		"affected_user_ids":   assignedUsers,
+		"removed_user_roles":  result.RemovedUserRoles,
+		"removed_group_roles": result.RemovedGroupRoles,
+		"removed_permissions": result.RemovedPermissions,
+		"removed_role_links":  result.RemovedRoleLinks,
+	}, map[string]interface{}{
+		"before": s.roleToResponse(role),
+		"after":  nil,
+	})
+
+	return result, nil
+}
+
+// CreatePermission creates a custom permission
+func (s *RBACService) CreatePermission(ctx context.Context, req *models.CreatePermissionRequest, actorID uuid.UUID) (*models.PermissionResponse, error) {
+	if err := validateCode(req.Code, permissionCodePattern, "permission"); err != nil {
+		return nil, err
+	}
+
+	create := s.client.Permissions.Create().
+		SetCode(req.Code).
+		SetName(req.Name).
+		SetIsSystem(false)
+	if req.Description != "" {
+		create = create.SetDescription(req.Description)
+	}
+	if req.Resource != "" {
+		create = create.SetResource(req.Resource)
+	}
+	if req.Action != "" {
+		create = create.SetAction(req.Action)
+	}
+
+	perm, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("permission code already exists")
+		}
+		return nil, fmt.Errorf("failed to create permission: %w", err)
+	}
+
+	resp := s.permissionToResponse(perm)
+
+	s.createAuditLogWithChanges(ctx, actorID, "permission.create", "permission", fmt.Sprintf("%d", perm.ID), map[string]interface{}{
+		"permission_id": perm.ID,
+	}, map[string]interface{}{
+		"before": nil,
+		"after":  resp,
+	})
+
+	return &resp, nil
+}
+
+// UpdatePermission changes a custom permission
+func (s *RBACService) UpdatePermission(ctx context.Context, permissionID int, req *models.UpdatePermissionRequest, actorID uuid.UUID) (*models.PermissionResponse, error) {
+	perm, err := s.client.Permissions.Get(ctx, permissionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("permission not found")
+		}
+		return nil, fmt.Errorf("failed to get permission: %w", err)
+	}
+
+	if perm.IsSystem {
+		return nil, fmt.Errorf("cannot modify system permission")
+	}
+
+	before := s.permissionToResponse(perm)
+	update := perm.Update()
+
+	if req.Code != nil && *req.Code != perm.Code {
+		if err := validateCode(*req.Code, permissionCodePattern, "permission"); err != nil {
+			return nil, err
+		}
+		update = update.SetCode(*req.Code)
+	}
+	if req.Name != nil {
+		if *req.Name == "" {
+			return nil, fmt.Errorf("permission name cannot be empty")
+		}
+		update = update.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		update = update.SetDescription(*req.Description)
+	}
+	if req.Resource != nil {
+		update = update.SetResource(*req.Resource)
+	}
+	if req.Action != nil {
+		update = update.SetAction(*req.Action)
+	}
+
+	perm, err = update.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("permission code already exists")
+		}
+		return nil, fmt.Errorf("failed to update permission: %w", err)
+	}
+
+	after := s.permissionToResponse(perm)
+
+	// Cached permissions are stored with their codes
+	s.permissions.InvalidateAll(ctx)
+
+	s.createAuditLogWithChanges(ctx, actorID, "permission.update", "permission", fmt.Sprintf("%d", permissionID), map[string]interface{}{
+		"permission_id": permissionID,
+	}, map[string]interface{}{
+		"before": before,
+		"after":  after,
+	})
+
+	return &after, nil
+}
+
+// DeletePermission deletes a custom permission and revokes it from every role
+func (s *RBACService) DeletePermission(ctx context.Context, permissionID int, actorID uuid.UUID) (*models.DeletePermissionResponse, error) {
+	perm, err := s.client.Permissions.Get(ctx, permissionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("permission not found")
+		}
+		return nil, fmt.Errorf("failed to get permission: %w", err)
+	}
+
+	if perm.IsSystem {
+		return nil, fmt.Errorf("cannot delete system permission")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	roleIDs, err := tx.RolePermissions.Query().
+		Where(rolepermissions.PermissionIDEQ(permissionID)).
+		Select(rolepermissions.FieldRoleID).
+		Ints(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to find roles with permission: %w", err)
+	}
+
+	if _, err := tx.RolePermissions.Delete().
+		Where(rolepermissions.PermissionIDEQ(permissionID)).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to revoke permission from roles: %w", err)
+	}
+
+	if err := tx.Permissions.DeleteOneID(permissionID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete permission: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to delete permission: %w", err)
+	}
+
+	s.permissions.InvalidateAll(ctx)
+
+	s.createAuditLogWithChanges(ctx, actorID, "permission.delete", "permission", fmt.Sprintf("%d", permissionID), map[string]interface{}{
+		"permission_id": permissionID,
+		"role_ids":      roleIDs,
+	}, map[string]interface{}{
+		"before": s.permissionToResponse(perm),
+		"after":  nil,
+	})
+
+	return &models.DeletePermissionResponse{
+		PermissionID:     permissionID,
+		RemovedFromRoles: len(roleIDs),
+	}, nil
+}
+
+// checkRolesExist returns notFound as an error unless every role ID exists
+func (s *RBACService) checkRolesExist(ctx context.Context, roleIDs []int, notFound string) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	count, err := s.client.Roles.Query().Where(roles.IDIn(roleIDs...)).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check roles: %w", err)
+	}
+	if count != len(roleIDs) {
+		return fmt.Errorf("%s", notFound)
+	}
+	return nil
+}
+
+// checkPermissionsExist fails unless every permission ID exists
+func (s *RBACService) checkPermissionsExist(ctx context.Context, permissionIDs []int) error {
+	if len(permissionIDs) == 0 {
+		return nil
+	}
+
+	count, err := s.client.Permissions.Query().Where(permissions.IDIn(permissionIDs...)).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check permissions: %w", err)
+	}
+	if count != len(permissionIDs) {
+		return fmt.Errorf("permission not found")
+	}
+	return nil
+}
+
+func validateCode(code string, pattern *regexp.Regexp, kind string) error {
+	if len(code) > maxCodeLength || !pattern.MatchString(code) {
+		return fmt.Errorf("invalid %s code format", kind)
+	}
+	return nil
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/rolepermissions"
+	"github.com/shammianand/go-auth/internal/config"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+)
+
+// Subject is the user a permission is checked for, with the request the
+// check is made on behalf of
+type Subject struct {
+	UserID uuid.UUID
+	OrgID  *uuid.UUID // Organization the check is scoped to, if any
+	MFA    bool       // The session was authenticated with a second factor
+	IP     string     // Client IP address
+	Time   time.Time  // Time of the request; zero means now
+}
+
+// Resource is the object a permission is checked against. Every field is
+// optional.
+type Resource struct {
+	Type       string
+	ID         string
+	OwnerID    string
+	Attributes map[string]interface{}
+}
+
+// PermissionCheck is one permission and resource pair of a batch check
+type PermissionCheck struct {
+	Permission string
+	Resource   *Resource
+}
+
+// Check decides whether subject may use permission on resource and explains
+// the decision. Unconditional grants allow at once; otherwise the conditions
+// of every matching grant are evaluated until one holds. A condition that
+// fails to evaluate counts as not holding.
+func (s *RBACService) Check(ctx context.Context, subject Subject, permission string, resource *Resource) (*models.AuthorizationDecision, error) {
+	decisions, err := s.CheckBatch(ctx, subject, []PermissionCheck{{Permission: permission, Resource: resource}})
+	if err != nil {
+		return nil, err
+	}
+	return &decisions[0], nil
+}
+
+// CheckBatch decides a list of checks for one subject. The subject's
+// permissions are resolved once, so checks that need no condition are served
+// from the permission cache without touching the database. Checks scoped to
+// an organization also count the roles assigned to the subject in it.
+func (s *RBACService) CheckBatch(ctx context.Context, subject Subject, checks []PermissionCheck) ([]models.AuthorizationDecision, error) {
+	var perms []models.PermissionResponse
+	var orgNote string
+	var err error
+	if subject.OrgID != nil {
+		perms, orgNote, err = s.orgPermissions(ctx, *subject.OrgID, subject.UserID, subject.MFA)
+	} else {
+		var userPerms *models.UserPermissionsResponse
+		userPerms, err = s.GetUserPermissions(ctx, subject.UserID)
+		if userPerms != nil {
+			perms = userPerms.Permissions
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The user is only loaded once a conditional grant needs evaluating
+	var user *ent.Users
+	decisions := make([]models.AuthorizationDecision, 0, len(checks))
+	for _, check := range checks {
+		decision := models.AuthorizationDecision{SubjectID: subject.UserID, OrgID: subject.OrgID, Permission: check.Permission}
+
+		conditional, granted := matchGrants(perms, check.Permission)
+		switch {
+		case granted != nil:
+			decision.Allowed = true
+			decision.GrantedBy = unconditionalGrant(*granted)
+			decision.Reason = fmt.Sprintf("granted by role %q through permission %q", decision.GrantedBy, granted.Code)
+		case len(conditional) == 0:
+			decision.Reason = fmt.Sprintf("no role grants %q", check.Permission)
+			if orgNote != "" {
+				decision.Reason += "; " + orgNote
+			}
+		default:
+			if user == nil {
+				user, err = s.client.Users.Get(ctx, subject.UserID)
+				if err != nil {
+					if ent.IsNotFound(err) {
+						return nil, fmt.Errorf("user not found")
+					}
+					return nil, fmt.Errorf("failed to get user: %w", err)
+				}
+			}
+			evaluateGrants(&decision, conditional, conditionEnv(user, subject, check.Resource))
+		}
+
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, nil
+}
+
+// Authorize decides checks made through the check API by a calling user or
+// service and records the decisions in the audit log according to
+// DECISION_LOG
+func (s *RBACService) Authorize(ctx context.Context, actorID uuid.UUID, subject Subject, checks []PermissionCheck) ([]models.AuthorizationDecision, error) {
+	decisions, err := s.CheckBatch(ctx, subject, checks)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logDecisions(ctx, actorID, checks, decisions)
+	return decisions, nil
+}
+
+// logDecisions writes decision audit logs in the background so logging does
+// not slow down the check
+func (s *RBACService) logDecisions(ctx context.Context, actorID uuid.UUID, checks []PermissionCheck, decisions []models.AuthorizationDecision) {
+	mode := config.DecisionLog
+	if mode != "deny" && mode != "all" {
+		return
+	}
+
+	type entry struct {
+		metadata map[string]interface{}
+		resource string
+		orgID    *uuid.UUID
+	}
+	var entries []entry
+	for i, decision := range decisions {
+		if decision.Allowed && mode == "deny" {
+			continue
+		}
+
+		metadata := map[string]interface{}{
+			"subject_id": decision.SubjectID.String(),
+			"permission": decision.Permission,
+			"allowed":    decision.Allowed,
+			"reason":     decision.Reason,
+		}
+		if decision.GrantedBy != "" {
+			metadata["granted_by"] = decision.GrantedBy
+		}
+		if decision.Condition != "" {
+			metadata["condition"] = decision.Condition
+		}
+		if resource := checks[i].Resource; resource != nil {
+			metadata["resource_type"] = resource.Type
+			metadata["resource_id"] = resource.ID
+		}
+		entries = append(entries, entry{metadata: metadata, resource: decision.Permission, orgID: decision.OrgID})
+	}
+
+	if len(entries) == 0 {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		for _, e := range entries {
+			if e.orgID != nil {
+				s.createOrgAuditLog(ctx, *e.orgID, actorID, "authz.decision", "permission", e.resource, e.metadata, nil)
+				continue
+			}
+			s.createAuditLog(ctx, actorID, "authz.decision", "permission", e.resource, e.metadata)
+		}
+	}()
+}
+
+// matchGrants returns the first permission granting code unconditionally,
+// or else the conditional grants matching it
+func matchGrants(perms []models.PermissionResponse, code string) ([]models.PermissionCondition, *models.PermissionResponse) {
+	var conditional []models.PermissionCondition
+	for i, perm := range perms {
+		if !PermissionMatches(perm.Code, code) {
+			continue
+		}
+		if !perm.Conditional {
+			return nil, &perms[i]
+		}
+		conditional = append(conditional, perm.Conditions...)
+	}
+	return conditional, nil
+}
+
+// evaluateGrants evaluates conditional grants until one holds
+func evaluateGrants(decision *models.AuthorizationDecision, grants []models.PermissionCondition, env map[string]interface{}) {
+	for _, grant := range grants {
+		result := models.ConditionResult{Role: grant.Role, Condition: grant.Condition}
+
+		condition, err := CompileCondition(grant.Condition)
+		if err == nil {
+			result.Result, err = condition.Evaluate(env)
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		decision.Evaluated = append(decision.Evaluated, result)
+
+		if result.Result {
+			decision.Allowed = true
+			decision.GrantedBy = grant.Role
+			decision.Condition = grant.Condition
+			decision.Reason = fmt.Sprintf("granted by role %q because its condition holds", grant.Role)
+			return
+		}
+	}
+
+	decision.Reason = fmt.Sprintf("no condition on the grants of %q holds", decision.Permission)
+}
+
+// SetPermissionCondition sets the condition on a role's grant of a
+// permission. An empty condition makes the grant unconditional.
+func (s *RBACService) SetPermissionCondition(ctx context.Context, roleID, permissionID int, condition string, actorID uuid.UUID) error {
+	condition = strings.TrimSpace(condition)
+	if condition != "" {
+		if _, err := CompileCondition(condition); err != nil {
+			return err
+		}
+	}
+
+	role, err := s.client.Roles.Get(ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("role not found")
+		}
+		return fmt.Errorf("failed to get role: %w", err)
+	}
+
+	if role.IsSystem {
+		return fmt.Errorf("cannot modify permissions of system role")
+	}
+
+	grant, err := s.client.RolePermissions.Query().
+		Where(
+			rolepermissions.RoleIDEQ(roleID),
+			rolepermissions.PermissionIDEQ(permissionID),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("permission not granted to role")
+		}
+		return fmt.Errorf("failed to get role permission: %w", err)
+	}
+
+	if grant.Condition == condition {
+		return nil
+	}
+
+	update := grant.Update()
+	if condition == "" {
+		update = update.ClearCondition()
+	} else {
+		update = update.SetCondition(condition)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("failed to update condition: %w", err)
+	}
+
+	s.permissions.InvalidateAll(ctx)
+
+	s.createAuditLogWithChanges(ctx, actorID, "role.permission.condition", "role", fmt.Sprintf("%d", roleID), map[string]interface{}{
+		"role_id":       roleID,
+		"permission_id": permissionID,
+	}, map[string]interface{}{
+		"before": grant.Condition,
+		"after":  condition,
+	})
+
+	return nil
+}
+
+// conditionEnv builds the attributes conditions are evaluated against
+func conditionEnv(user *ent.Users, subject Subject, resource *Resource) map[string]interface{} {
+	domain := ""
+	if at := strings.LastIndex(user.Email, "@"); at >= 0 {
+		domain = strings.ToLower(user.Email[at+1:])
+	}
+
+	metadata := make(map[string]interface{}, len(user.Metadata))
+	for key, value := range user.Metadata {
+		metadata[key] = value
+	}
+
+	at := subject.Time
+	if at.IsZero() {
+		at = time.Now()
+	}
+	at = at.UTC()
+
+	env := map[string]interface{}{
+		"subject": map[string]interface{}{
+			"id":             user.ID.String(),
+			"email":          user.Email,
+			"email_domain":   domain,
+			"email_verified": user.EmailVerified,
+			"mfa":            subject.MFA,
+			"metadata":       metadata,
+		},
+		"request": map[string]interface{}{
+			"ip":      optionalString(subject.IP),
+			"time":    at.Format("15:04"),
+			"hour":    float64(at.Hour()),
+			"weekday": strings.ToLower(at.Weekday().String()[:3]),
+		},
+		"resource": map[string]interface{}{},
+	}
+
+	if resource != nil {
+		env["resource"] = map[string]interface{}{
+			"type":       optionalString(resource.Type),
+			"id":         optionalString(resource.ID),
+			"owner":      optionalString(resource.OwnerID),
+			"attributes": resource.Attributes,
+		}
+	}
+
+	return env
+}
+
+// unconditionalGrant returns the nearest role granting perm without a
+// condition
+func unconditionalGrant(perm models.PermissionResponse) string {
+	conditional := make(map[string]bool, len(perm.Conditions))
+	for _, grant := range perm.Conditions {
+		conditional[grant.Role] = true
+	}
+
+	for _, role := range perm.GrantedBy {
+		if !conditional[role] {
+			return role
+		}
+	}
+	return ""
+}
+
+// optionalString maps an empty string to null so unset attributes compare
+// as null in conditions
+func optionalString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
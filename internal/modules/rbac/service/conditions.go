@@ -0,0 +1,682 @@
+package service
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Conditions are boolean expressions attached to a role's permission. The
+// grant only applies while its condition holds, e.g.
+//
+//	subject.email_domain == "example.com" && subject.mfa
+//	request.hour >= 9 && request.hour < 17 && request.weekday in ["mon", "tue", "wed", "thu", "fri"]
+//	resource.owner == subject.id || ip_in(request.ip, "10.0.0.0/8")
+//
+// Grammar:
+//
+//	expr    = and { "||" and }
+//	and     = unary { "&&" unary }
+//	unary   = "!" unary | compare
+//	compare = operand [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" | "in" ) operand ]
+//	operand = string | number | "true" | "false" | "null" | list | attribute | call | "(" expr ")"
+//	list    = "[" [ expr { "," expr } ] "]"
+//	call    = name "(" [ expr { "," expr } ] ")"
+//
+// Unset attributes are null. Using null where a boolean is expected is an
+// error, and a condition that fails to evaluate denies the grant.
+
+// maxConditionLength bounds the size of a condition expression
+const maxConditionLength = 1024
+
+// conditionAttributes lists the attributes a condition may read. Attributes
+// ending in ".*" accept any nested key.
+var conditionAttributes = map[string]bool{
+	"subject.id":             true,
+	"subject.email":          true,
+	"subject.email_domain":   true,
+	"subject.email_verified": true,
+	"subject.mfa":            true,
+	"subject.metadata.*":     true,
+	"request.ip":             true,
+	"request.time":           true, // "HH:MM" in UTC
+	"request.hour":           true, // 0-23 in UTC
+	"request.weekday":        true, // "mon" ... "sun"
+	"resource.type":          true,
+	"resource.id":            true,
+	"resource.owner":         true,
+	"resource.attributes.*":  true,
+}
+
+// conditionFunctions maps function names to their minimum and maximum
+// number of arguments; -1 means unbounded
+var conditionFunctions = map[string][2]int{
+	"ip_in":       {2, -1}, // ip_in(ip, cidr, ...)
+	"starts_with": {2, 2},
+	"ends_with":   {2, 2},
+}
+
+// compiledConditions caches parsed conditions by source
+var compiledConditions sync.Map
+
+// Condition is a parsed condition expression
+type Condition struct {
+	source string
+	root   conditionNode
+}
+
+// CompileCondition parses and validates a condition expression
+func CompileCondition(source string) (*Condition, error) {
+	if cached, ok := compiledConditions.Load(source); ok {
+		return cached.(*Condition), nil
+	}
+
+	if len(source) > maxConditionLength {
+		return nil, fmt.Errorf("invalid condition: longer than %d characters", maxConditionLength)
+	}
+
+	tokens, err := tokenizeCondition(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition: %w", err)
+	}
+
+	p := &conditionParser{tokens: tokens}
+	root, err := p.parseExpr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition: %w", err)
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("invalid condition: unexpected %q at position %d", tok.text, tok.pos)
+	}
+
+	condition := &Condition{source: source, root: root}
+	compiledConditions.Store(source, condition)
+	return condition, nil
+}
+
+// String returns the condition's source
+func (c *Condition) String() string {
+	return c.source
+}
+
+// Evaluate reports whether the condition holds for env, a tree of maps
+// keyed by attribute path segments
+func (c *Condition) Evaluate(env map[string]interface{}) (bool, error) {
+	value, err := c.root.eval(env)
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("condition evaluated to %s, not a boolean", describeValue(value))
+	}
+	return result, nil
+}
+
+// Tokenizer
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+)
+
+type conditionToken struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func tokenizeCondition(source string) ([]conditionToken, error) {
+	var tokens []conditionToken
+
+	for i := 0; i < len(source); {
+		ch := rune(source[i])
+
+		switch {
+		case unicode.IsSpace(ch):
+			i++
+
+		case ch == '"' || ch == '\'':
+			var b strings.Builder
+			start := i
+			i++
+			for {
+				if i >= len(source) {
+					return nil, fmt.Errorf("unterminated string at position %d", start)
+				}
+				if source[i] == '\\' && i+1 < len(source) {
+					b.WriteByte(source[i+1])
+					i += 2
+					continue
+				}
+				if rune(source[i]) == ch {
+					i++
+					break
+				}
+				b.WriteByte(source[i])
+				i++
+			}
+			tokens = append(tokens, conditionToken{kind: tokenString, text: b.String(), pos: start})
+
+		case unicode.IsDigit(ch) || (ch == '-' && i+1 < len(source) && unicode.IsDigit(rune(source[i+1]))):
+			start := i
+			i++
+			for i < len(source) && (unicode.IsDigit(rune(source[i])) || source[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, conditionToken{kind: tokenNumber, text: source[start:i], pos: start})
+
+		case unicode.IsLetter(ch) || ch == '_':
+			start := i
+			for i < len(source) && (unicode.IsLetter(rune(source[i])) || unicode.IsDigit(rune(source[i])) || source[i] == '_' || source[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, conditionToken{kind: tokenIdent, text: source[start:i], pos: start})
+
+		default:
+			op := ""
+			for _, candidate := range []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", "[", "]", ","} {
+				if strings.HasPrefix(source[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", ch, i)
+			}
+			tokens = append(tokens, conditionToken{kind: tokenOperator, text: op, pos: i})
+			i += len(op)
+		}
+	}
+
+	return append(tokens, conditionToken{kind: tokenEOF, text: "end of condition", pos: len(source)}), nil
+}
+
+// Parser
+
+type conditionParser struct {
+	tokens []conditionToken
+	pos    int
+}
+
+func (p *conditionParser) peek() conditionToken {
+	return p.tokens[p.pos]
+}
+
+func (p *conditionParser) next() conditionToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// accept consumes the next token if it is the given operator
+func (p *conditionParser) accept(op string) bool {
+	if tok := p.peek(); tok.kind == tokenOperator && tok.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *conditionParser) expect(op string) error {
+	if !p.accept(op) {
+		tok := p.peek()
+		return fmt.Errorf("expected %q at position %d, found %q", op, tok.pos, tok.text)
+	}
+	return nil
+}
+
+func (p *conditionParser) parseExpr() (conditionNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicNode{op: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseAnd() (conditionNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = logicNode{op: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseUnary() (conditionNode, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+	return p.parseCompare()
+}
+
+func (p *conditionParser) parseCompare() (conditionNode, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	tok := p.peek()
+	isCompare := tok.kind == tokenOperator && strings.Contains(" == != < <= > >= ", " "+tok.text+" ")
+	if !isCompare && !(tok.kind == tokenIdent && tok.text == "in") {
+		return left, nil
+	}
+	p.next()
+
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return compareNode{op: tok.text, left: left, right: right}, nil
+}
+
+func (p *conditionParser) parseOperand() (conditionNode, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenString:
+		return literalNode{value: tok.text}, nil
+
+	case tokenNumber:
+		value, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", tok.text, tok.pos)
+		}
+		return literalNode{value: value}, nil
+
+	case tokenIdent:
+		switch tok.text {
+		case "true":
+			return literalNode{value: true}, nil
+		case "false":
+			return literalNode{value: false}, nil
+		case "null":
+			return literalNode{value: nil}, nil
+		}
+
+		if p.accept("(") {
+			return p.parseCall(tok)
+		}
+		return parseAttribute(tok)
+
+	case tokenOperator:
+		switch tok.text {
+		case "(":
+			inner, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return inner, nil
+		case "[":
+			items, err := p.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return listNode{items: items}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected %q at position %d", tok.text, tok.pos)
+}
+
+// parseList parses comma separated expressions up to the closing token
+func (p *conditionParser) parseList(closing string) ([]conditionNode, error) {
+	items := make([]conditionNode, 0)
+	if p.accept(closing) {
+		return items, nil
+	}
+
+	for {
+		item, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+
+		if p.accept(closing) {
+			return items, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *conditionParser) parseCall(name conditionToken) (conditionNode, error) {
+	arity, ok := conditionFunctions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %d", name.text, name.pos)
+	}
+
+	args, err := p.parseList(")")
+	if err != nil {
+		return nil, err
+	}
+	if len(args) < arity[0] || (arity[1] >= 0 && len(args) > arity[1]) {
+		return nil, fmt.Errorf("wrong number of arguments to %s at position %d", name.text, name.pos)
+	}
+
+	// Reject malformed CIDR literals when the condition is saved
+	if name.text == "ip_in" {
+		for _, arg := range args[1:] {
+			if lit, ok := arg.(literalNode); ok {
+				cidr, isString := lit.value.(string)
+				if !isString {
+					return nil, fmt.Errorf("ip_in expects CIDR strings at position %d", name.pos)
+				}
+				if _, _, err := net.ParseCIDR(cidr); err != nil {
+					return nil, fmt.Errorf("invalid CIDR %q at position %d", cidr, name.pos)
+				}
+			}
+		}
+	}
+
+	return callNode{name: name.text, args: args}, nil
+}
+
+func parseAttribute(tok conditionToken) (conditionNode, error) {
+	path := strings.Split(tok.text, ".")
+	for _, segment := range path {
+		if segment == "" {
+			return nil, fmt.Errorf("invalid attribute %q at position %d", tok.text, tok.pos)
+		}
+	}
+
+	known := conditionAttributes[tok.text]
+	if !known && len(path) >= 3 {
+		known = conditionAttributes[path[0]+"."+path[1]+".*"]
+	}
+	if !known {
+		return nil, fmt.Errorf("unknown attribute %q at position %d", tok.text, tok.pos)
+	}
+
+	return attributeNode{path: path}, nil
+}
+
+// Evaluation
+
+type conditionNode interface {
+	eval(env map[string]interface{}) (interface{}, error)
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n literalNode) eval(map[string]interface{}) (interface{}, error) {
+	return n.value, nil
+}
+
+type attributeNode struct {
+	path []string
+}
+
+func (n attributeNode) eval(env map[string]interface{}) (interface{}, error) {
+	var current interface{} = env
+	for _, segment := range n.path {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, nil
+		}
+		current = m[segment]
+	}
+	return normalizeValue(current), nil
+}
+
+type listNode struct {
+	items []conditionNode
+}
+
+func (n listNode) eval(env map[string]interface{}) (interface{}, error) {
+	values := make([]interface{}, len(n.items))
+	for i, item := range n.items {
+		value, err := item.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+type notNode struct {
+	operand conditionNode
+}
+
+func (n notNode) eval(env map[string]interface{}) (interface{}, error) {
+	value, err := evalBool(n.operand, env)
+	if err != nil {
+		return nil, err
+	}
+	return !value, nil
+}
+
+type logicNode struct {
+	op          string
+	left, right conditionNode
+}
+
+func (n logicNode) eval(env map[string]interface{}) (interface{}, error) {
+	left, err := evalBool(n.left, env)
+	if err != nil {
+		return nil, err
+	}
+
+	// Short-circuit
+	if (n.op == "&&" && !left) || (n.op == "||" && left) {
+		return left, nil
+	}
+	return evalBool(n.right, env)
+}
+
+type compareNode struct {
+	op          string
+	left, right conditionNode
+}
+
+func (n compareNode) eval(env map[string]interface{}) (interface{}, error) {
+	left, err := n.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(env)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return valuesEqual(left, right), nil
+	case "!=":
+		return !valuesEqual(left, right), nil
+	case "in":
+		list, ok := right.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("right side of in must be a list, got %s", describeValue(right))
+		}
+		for _, item := range list {
+			if valuesEqual(left, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	// Ordering works on two numbers or two strings
+	var cmp int
+	switch l := left.(type) {
+	case float64:
+		r, ok := right.(float64)
+		if !ok {
+			return nil, fmt.Errorf("cannot compare %s with %s", describeValue(left), describeValue(right))
+		}
+		switch {
+		case l < r:
+			cmp = -1
+		case l > r:
+			cmp = 1
+		}
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot compare %s with %s", describeValue(left), describeValue(right))
+		}
+		cmp = strings.Compare(l, r)
+	default:
+		return nil, fmt.Errorf("cannot compare %s with %s", describeValue(left), describeValue(right))
+	}
+
+	switch n.op {
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	default:
+		return cmp >= 0, nil
+	}
+}
+
+type callNode struct {
+	name string
+	args []conditionNode
+}
+
+func (n callNode) eval(env map[string]interface{}) (interface{}, error) {
+	args := make([]string, len(n.args))
+	for i, arg := range n.args {
+		value, err := arg.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s expects string arguments, got %s", n.name, describeValue(value))
+		}
+		args[i] = s
+	}
+
+	switch n.name {
+	case "starts_with":
+		return strings.HasPrefix(args[0], args[1]), nil
+	case "ends_with":
+		return strings.HasSuffix(args[0], args[1]), nil
+	default: // ip_in
+		ip := net.ParseIP(args[0])
+		if ip == nil {
+			return nil, fmt.Errorf("ip_in: invalid IP address %q", args[0])
+		}
+		for _, cidr := range args[1:] {
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("ip_in: invalid CIDR %q", cidr)
+			}
+			if network.Contains(ip) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+func evalBool(node conditionNode, env map[string]interface{}) (bool, error) {
+	value, err := node.eval(env)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected a boolean, got %s", describeValue(value))
+	}
+	return b, nil
+}
+
+// normalizeValue converts attribute values to the types conditions compare:
+// float64 for numbers and []interface{} for lists
+func normalizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case []string:
+		list := make([]interface{}, len(v))
+		for i, s := range v {
+			list[i] = s
+		}
+		return list
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			list[i] = normalizeValue(item)
+		}
+		return list
+	}
+	return value
+}
+
+func valuesEqual(a, b interface{}) bool {
+	switch a.(type) {
+	case nil, bool, string, float64:
+		switch b.(type) {
+		case nil, bool, string, float64:
+			return a == b
+		}
+	}
+	return false
+}
+
+func describeValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return strconv.Quote(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		return "a list"
+	}
+	return fmt.Sprintf("%T", value)
+}
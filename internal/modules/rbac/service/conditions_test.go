@@ -0,0 +1,149 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompileCondition(t *testing.T) {
+	valid := []string{
+		`subject.mfa`,
+		`subject.email_domain == "example.com" && subject.mfa`,
+		`request.hour >= 9 && request.hour < 17 && request.weekday in ["mon", "tue", "wed", "thu", "fri"]`,
+		`resource.owner == subject.id || ip_in(request.ip, "10.0.0.0/8", "192.168.0.0/16")`,
+		`!(subject.metadata.team == 'ops')`,
+		`starts_with(resource.attributes.path, "/public/")`,
+		`resource.attributes.size <= -1.5`,
+		`request.weekday in []`,
+	}
+	for _, source := range valid {
+		condition, err := CompileCondition(source)
+		if err != nil {
+			t.Errorf("CompileCondition(%q) returned error: %v", source, err)
+			continue
+		}
+		if condition.String() != source {
+			t.Errorf("String() = %q, want %q", condition.String(), source)
+		}
+	}
+
+	invalid := []struct {
+		source string
+		err    string
+	}{
+		{``, "unexpected"},
+		{`subject.mfa &&`, "unexpected"},
+		{`subject.password == "x"`, "unknown attribute"},
+		{`subject..mfa`, "invalid attribute"},
+		{`subject.mfa ^ true`, "unexpected character"},
+		{`subject.email == "unterminated`, "unterminated string"},
+		{`(subject.mfa`, `expected ")"`},
+		{`subject.mfa subject.mfa`, "unexpected"},
+		{`contains(subject.email, "x")`, "unknown function"},
+		{`starts_with(subject.email)`, "wrong number of arguments"},
+		{`ip_in(request.ip, "10.0.0.0/33")`, "invalid CIDR"},
+		{`ip_in(request.ip, 10)`, "expects CIDR strings"},
+		{strings.Repeat("a", maxConditionLength+1), "longer than"},
+	}
+	for _, tt := range invalid {
+		_, err := CompileCondition(tt.source)
+		if err == nil {
+			t.Errorf("CompileCondition(%q) succeeded, want error containing %q", tt.source, tt.err)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("CompileCondition(%q) error = %q, want it to contain %q", tt.source, err, tt.err)
+		}
+	}
+}
+
+func TestConditionEvaluate(t *testing.T) {
+	env := map[string]interface{}{
+		"subject": map[string]interface{}{
+			"id":             "u1",
+			"email":          "ada@example.com",
+			"email_domain":   "example.com",
+			"email_verified": true,
+			"mfa":            false,
+			"metadata": map[string]interface{}{
+				"team":  "ops",
+				"level": 3,
+				"tags":  []string{"oncall", "eu"},
+			},
+		},
+		"request": map[string]interface{}{
+			"ip":      "10.1.2.3",
+			"time":    "09:30",
+			"hour":    float64(9),
+			"weekday": "mon",
+		},
+		"resource": map[string]interface{}{
+			"type":  "document",
+			"id":    "42",
+			"owner": "u1",
+			"attributes": map[string]interface{}{
+				"state": "draft",
+			},
+		},
+	}
+
+	tests := []struct {
+		source string
+		want   bool
+	}{
+		{`subject.email_verified`, true},
+		{`subject.mfa`, false},
+		{`!subject.mfa`, true},
+		{`subject.email_domain == "example.com"`, true},
+		{`subject.email_domain != "example.com"`, false},
+		{`resource.owner == subject.id`, true},
+		{`request.hour >= 9 && request.hour < 17`, true},
+		{`request.time < "09:00"`, false},
+		{`request.weekday in ["sat", "sun"]`, false},
+		{`"oncall" in subject.metadata.tags`, true},
+		{`subject.metadata.level > 2`, true},
+		{`subject.metadata.missing == null`, true},
+		{`resource.attributes.state == "draft" || subject.mfa`, true},
+		{`ip_in(request.ip, "192.168.0.0/16", "10.0.0.0/8")`, true},
+		{`ip_in(request.ip, "192.168.0.0/16")`, false},
+		{`starts_with(subject.email, "ada@")`, true},
+		{`ends_with(subject.email, "@example.org")`, false},
+		// Short-circuiting skips the right side, which would fail
+		{`subject.mfa && subject.metadata.missing`, false},
+		{`!subject.mfa || subject.metadata.missing`, true},
+	}
+
+	for _, tt := range tests {
+		condition, err := CompileCondition(tt.source)
+		if err != nil {
+			t.Fatalf("CompileCondition(%q) returned error: %v", tt.source, err)
+		}
+		got, err := condition.Evaluate(env)
+		if err != nil {
+			t.Errorf("Evaluate(%q) returned error: %v", tt.source, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Evaluate(%q) = %v, want %v", tt.source, got, tt.want)
+		}
+	}
+
+	failing := []string{
+		`subject.metadata.missing`,
+		`subject.email`,
+		`subject.metadata.missing && true`,
+		`request.hour < "10"`,
+		`request.weekday in "mon"`,
+		`ip_in(subject.email, "10.0.0.0/8")`,
+		`starts_with(request.hour, "9")`,
+	}
+	for _, source := range failing {
+		condition, err := CompileCondition(source)
+		if err != nil {
+			t.Fatalf("CompileCondition(%q) returned error: %v", source, err)
+		}
+		if got, err := condition.Evaluate(env); err == nil {
+			t.Errorf("Evaluate(%q) = %v, want error", source, got)
+		}
+	}
+}
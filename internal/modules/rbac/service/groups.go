@@ -0,0 +1,930 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/groupparents"
+	"github.com/shammianand/go-auth/ent/grouproles"
+	"github.com/shammianand/go-auth/ent/groups"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/userroles"
+	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+)
+
+// GroupHierarchy maps each group ID to the IDs of the groups it is nested in
+type GroupHierarchy map[int][]int
+
+// LoadGroupHierarchy reads every nesting link using client, which may be
+// bound to a transaction
+func LoadGroupHierarchy(ctx context.Context, client *ent.Client) (GroupHierarchy, error) {
+	links, err := client.GroupParents.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load group hierarchy: %w", err)
+	}
+
+	hierarchy := make(GroupHierarchy)
+	for _, link := range links {
+		hierarchy[link.GroupID] = append(hierarchy[link.GroupID], link.ParentGroupID)
+	}
+	return hierarchy, nil
+}
+
+// Ancestors returns the given groups followed by every group they are nested
+// in, nearest first, without duplicates
+func (h GroupHierarchy) Ancestors(groupIDs ...int) []int {
+	return RoleHierarchy(h).Ancestors(groupIDs...)
+}
+
+// Descendants returns the group and every group nested in it, directly or
+// through other groups
+func (h GroupHierarchy) Descendants(groupID int) []int {
+	result := []int{groupID}
+	for id := range h {
+		if id != groupID && slices.Contains(h.Ancestors(id), groupID) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// WouldCycle reports whether nesting groupID in the parents would make a
+// group contain itself
+func (h GroupHierarchy) WouldCycle(groupID int, parentIDs []int) bool {
+	return RoleHierarchy(h).WouldCycle(groupID, parentIDs)
+}
+
+// ListGroups returns all groups
+func (s *RBACService) ListGroups(ctx context.Context) ([]models.GroupResponse, error) {
+	entGroups, err := s.client.Groups.Query().
+		Order(ent.Asc(groups.FieldCode)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	result := make([]models.GroupResponse, len(entGroups))
+	for i, group := range entGroups {
+		result[i] = groupToResponse(group)
+	}
+
+	return result, nil
+}
+
+// GetGroup returns a group with its parents, roles and direct members
+func (s *RBACService) GetGroup(ctx context.Context, groupID int) (*models.GroupDetailResponse, error) {
+	group, err := s.client.Groups.Get(ctx, groupID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("group not found")
+		}
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	hierarchy, err := LoadGroupHierarchy(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	parents, err := s.client.Groups.Query().
+		Where(groups.IDIn(hierarchy[groupID]...)).
+		Order(ent.Asc(groups.FieldCode)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parent groups: %w", err)
+	}
+
+	parentResponses := make([]models.GroupResponse, len(parents))
+	for i, parent := range parents {
+		parentResponses[i] = groupToResponse(parent)
+	}
+
+	ownRoles, err := s.client.Roles.Query().
+		Where(roles.HasGroupRolesWith(grouproles.GroupIDEQ(groupID))).
+		Order(ent.Asc(roles.FieldCode)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group roles: %w", err)
+	}
+
+	inheritedRoles, err := s.client.Roles.Query().
+		Where(
+			roles.HasGroupRolesWith(grouproles.GroupIDIn(hierarchy.Ancestors(groupID)[1:]...)),
+			roles.Not(roles.HasGroupRolesWith(grouproles.GroupIDEQ(groupID))),
+		).
+		Order(ent.Asc(roles.FieldCode)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inherited group roles: %w", err)
+	}
+
+	members, err := s.client.GroupMembers.Query().
+		Where(groupmembers.GroupIDEQ(groupID)).
+		WithUser().
+		Order(ent.Asc(groupmembers.FieldAddedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group members: %w", err)
+	}
+
+	memberResponses := make([]models.GroupMemberResponse, 0, len(members))
+	for _, member := range members {
+		response := models.GroupMemberResponse{
+			UserID:  member.UserID,
+			AddedBy: member.AddedBy,
+			AddedAt: member.AddedAt,
+		}
+		if member.Edges.User != nil {
+			response.Email = member.Edges.User.Email
+		}
+		memberResponses = append(memberResponses, response)
+	}
+
+	return &models.GroupDetailResponse{
+		GroupResponse:  groupToResponse(group),
+		Parents:        parentResponses,
+		Roles:          s.rolesToResponses(ownRoles),
+		InheritedRoles: s.rolesToResponses(inheritedRoles),
+		Members:        memberResponses,
+	}, nil
+}
+
+// CreateGroup creates an empty group
+func (s *RBACService) CreateGroup(ctx context.Context, req *models.CreateGroupRequest, actorID uuid.UUID) (*models.GroupResponse, error) {
+	if err := validateCode(req.Code, roleCodePattern, "group"); err != nil {
+		return nil, err
+	}
+
+	create := s.client.Groups.Create().
+		SetCode(req.Code).
+		SetName(req.Name).
+		SetCreatedBy(actorID)
+	if req.Description != "" {
+		create = create.SetDescription(req.Description)
+	}
+
+	group, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("group code already exists")
+		}
+		return nil, fmt.Errorf("failed to create group: %w", err)
+	}
+
+	response := groupToResponse(group)
+	s.createAuditLogWithChanges(ctx, actorID, "group.create", "group", fmt.Sprintf("%d", group.ID), map[string]interface{}{
+		"group_id": group.ID,
+	}, map[string]interface{}{
+		"before": nil,
+		"after":  response,
+	})
+
+	return &response, nil
+}
+
+// UpdateGroup changes a group's code, name or description
+func (s *RBACService) UpdateGroup(ctx context.Context, groupID int, req *models.UpdateGroupRequest, actorID uuid.UUID) (*models.GroupResponse, error) {
+	group, err := s.client.Groups.Get(ctx, groupID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("group not found")
+		}
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	before := groupToResponse(group)
+	update := group.Update()
+
+	if req.Code != nil && *req.Code != group.Code {
+		if err := validateCode(*req.Code, roleCodePattern, "group"); err != nil {
+			return nil, err
+		}
+		update = update.SetCode(*req.Code)
+	}
+	if req.Name != nil {
+		if *req.Name == "" {
+			return nil, fmt.Errorf("group name cannot be empty")
+		}
+		update = update.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		update = update.SetDescription(*req.Description)
+	}
+
+	group, err = update.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("group code already exists")
+		}
+		return nil, fmt.Errorf("failed to update group: %w", err)
+	}
+
+	after := groupToResponse(group)
+	s.createAuditLogWithChanges(ctx, actorID, "group.update", "group", fmt.Sprintf("%d", groupID), map[string]interface{}{
+		"group_id": groupID,
+	}, map[string]interface{}{
+		"before": before,
+		"after":  after,
+	})
+
+	return &after, nil
+}
+
+// DeleteGroup deletes a group with its memberships, role assignments and
+// nesting links. Its former members lose the roles they held through it.
+func (s *RBACService) DeleteGroup(ctx context.Context, groupID int, actorID uuid.UUID) (*models.DeleteGroupResponse, error) {
+	group, err := s.client.Groups.Get(ctx, groupID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("group not found")
+		}
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	result := &models.DeleteGroupResponse{GroupID: groupID}
+
+	result.RemovedMembers, err = tx.GroupMembers.Delete().
+		Where(groupmembers.GroupIDEQ(groupID)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove group members: %w", err)
+	}
+
+	result.RemovedGroupRoles, err = tx.GroupRoles.Delete().
+		Where(grouproles.GroupIDEQ(groupID)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove group roles: %w", err)
+	}
+
+	result.RemovedGroupLinks, err = tx.GroupParents.Delete().
+		Where(groupparents.Or(
+			groupparents.GroupIDEQ(groupID),
+			groupparents.ParentGroupIDEQ(groupID),
+		)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove group nesting: %w", err)
+	}
+
+	if err := tx.Groups.DeleteOneID(groupID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete group: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to delete group: %w", err)
+	}
+
+	s.permissions.InvalidateAll(ctx)
+
+	s.createAuditLogWithChanges(ctx, actorID, "group.delete", "group", fmt.Sprintf("%d", groupID), map[string]interface{}{
+		"group_id":            groupID,
+		"removed_members":     result.RemovedMembers,
+		"removed_group_roles": result.RemovedGroupRoles,
+		"removed_group_links": result.RemovedGroupLinks,
+	}, map[string]interface{}{
+		"before": groupToResponse(group),
+		"after":  nil,
+	})
+
+	return result, nil
+}
+
+// AddGroupMember adds a user to a group. The user must be able to hold every
+// role of the group and of the groups it is nested in without breaking a
+// separation-of-duties constraint.
+func (s *RBACService) AddGroupMember(ctx context.Context, groupID int, userID, actorID uuid.UUID) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if err := s.addGroupMember(ctx, tx.Client(), groupID, userID, actorID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.permissions.InvalidateUser(ctx, userID)
+
+	s.createAuditLog(ctx, actorID, "group.member.add", "group", fmt.Sprintf("%d", groupID), map[string]interface{}{
+		"group_id": groupID,
+		"user_id":  userID.String(),
+	})
+
+	return nil
+}
+
+// addGroupMember checks and inserts a membership using client, which should
+// be bound to a transaction
+func (s *RBACService) addGroupMember(ctx context.Context, client *ent.Client, groupID int, userID, actorID uuid.UUID) error {
+	// Lock the user so a concurrent grant cannot slip past the
+	// separation-of-duties check
+	_, err := client.Users.Query().
+		Where(users.IDEQ(userID)).
+		ForUpdate().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("user not found")
+		}
+		return fmt.Errorf("failed to check user: %w", err)
+	}
+
+	exists, err := client.Groups.Query().Where(groups.IDEQ(groupID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check group: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("group not found")
+	}
+
+	member, err := client.GroupMembers.Query().
+		Where(
+			groupmembers.GroupIDEQ(groupID),
+			groupmembers.UserIDEQ(userID),
+		).
+		Exist(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to check membership: %w", err)
+	}
+	if member {
+		return fmt.Errorf("user is already a member of the group")
+	}
+
+	hierarchy, err := LoadGroupHierarchy(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	roleIDs, err := client.GroupRoles.Query().
+		Where(grouproles.GroupIDIn(hierarchy.Ancestors(groupID)...)).
+		Select(grouproles.FieldRoleID).
+		Ints(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to get group roles: %w", err)
+	}
+
+	if len(roleIDs) > 0 {
+		violated, err := s.checkSeparationOfDuties(ctx, client, userID, roleIDs...)
+		if err != nil {
+			return err
+		}
+		if len(violated) > 0 {
+			return sodViolationError(violated)
+		}
+	}
+
+	_, err = client.GroupMembers.Create().
+		SetGroupID(groupID).
+		SetUserID(userID).
+		SetAddedBy(actorID).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to add group member: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveGroupMember removes a user from a group
+func (s *RBACService) RemoveGroupMember(ctx context.Context, groupID int, userID, actorID uuid.UUID) error {
+	deleted, err := s.client.GroupMembers.Delete().
+		Where(
+			groupmembers.GroupIDEQ(groupID),
+			groupmembers.UserIDEQ(userID),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to remove group member: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("group membership not found")
+	}
+
+	s.permissions.InvalidateUser(ctx, userID)
+
+	s.createAuditLog(ctx, actorID, "group.member.remove", "group", fmt.Sprintf("%d", groupID), map[string]interface{}{
+		"group_id": groupID,
+		"user_id":  userID.String(),
+	})
+
+	return nil
+}
+
+// AssignGroupRole assigns a role to a group. No member of the group or of
+// the groups nested in it may break a separation-of-duties constraint by
+// gaining the role.
+func (s *RBACService) AssignGroupRole(ctx context.Context, groupID, roleID int, actorID uuid.UUID) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	group, err := tx.Groups.Get(ctx, groupID)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("group not found")
+		}
+		return fmt.Errorf("failed to get group: %w", err)
+	}
+
+	exists, err := tx.Roles.Query().Where(roles.IDEQ(roleID)).Exist(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to check role: %w", err)
+	}
+	if !exists {
+		tx.Rollback()
+		return fmt.Errorf("role not found")
+	}
+
+	assigned, err := tx.GroupRoles.Query().
+		Where(
+			grouproles.GroupIDEQ(groupID),
+			grouproles.RoleIDEQ(roleID),
+		).
+		Exist(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to check existing assignment: %w", err)
+	}
+	if assigned {
+		tx.Rollback()
+		return fmt.Errorf("role already assigned to group")
+	}
+
+	hierarchy, err := LoadGroupHierarchy(ctx, tx.Client())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := s.checkGroupSeparationOfDuties(ctx, tx.Client(), hierarchy, groupID, []int{roleID}); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.GroupRoles.Create().
+		SetGroupID(groupID).
+		SetRoleID(roleID).
+		SetAssignedBy(actorID).
+		Save(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to assign role to group: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.permissions.InvalidateAll(ctx)
+
+	s.createAuditLog(ctx, actorID, "group.role.assign", "group", fmt.Sprintf("%d", groupID), map[string]interface{}{
+		"group_id":   groupID,
+		"group_code": group.Code,
+		"role_id":    roleID,
+	})
+
+	return nil
+}
+
+// RemoveGroupRole removes a role from a group
+func (s *RBACService) RemoveGroupRole(ctx context.Context, groupID, roleID int, actorID uuid.UUID) error {
+	deleted, err := s.client.GroupRoles.Delete().
+		Where(
+			grouproles.GroupIDEQ(groupID),
+			grouproles.RoleIDEQ(roleID),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to remove group role: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("group role assignment not found")
+	}
+
+	s.permissions.InvalidateAll(ctx)
+
+	s.createAuditLog(ctx, actorID, "group.role.remove", "group", fmt.Sprintf("%d", groupID), map[string]interface{}{
+		"group_id": groupID,
+		"role_id":  roleID,
+	})
+
+	return nil
+}
+
+// UpdateGroupParents replaces the groups a group is nested in. Members of
+// the group become members of every parent and hold their roles.
+func (s *RBACService) UpdateGroupParents(ctx context.Context, groupID int, parentIDs []int, actorID uuid.UUID) error {
+	exists, err := s.client.Groups.Query().Where(groups.IDEQ(groupID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check group: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("group not found")
+	}
+
+	parentIDs = uniqueInts(parentIDs)
+	for _, parentID := range parentIDs {
+		if parentID == groupID {
+			return fmt.Errorf("group cannot be nested in itself")
+		}
+	}
+
+	if len(parentIDs) > 0 {
+		count, err := s.client.Groups.Query().Where(groups.IDIn(parentIDs...)).Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check groups: %w", err)
+		}
+		if count != len(parentIDs) {
+			return fmt.Errorf("parent group not found")
+		}
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Check for cycles against the hierarchy inside the transaction
+	hierarchy, err := LoadGroupHierarchy(ctx, tx.Client())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if hierarchy.WouldCycle(groupID, parentIDs) {
+		tx.Rollback()
+		return fmt.Errorf("group nesting would create a cycle")
+	}
+
+	previous := hierarchy[groupID]
+
+	// Members gain the roles of the new ancestors
+	roleIDs, err := tx.GroupRoles.Query().
+		Where(grouproles.GroupIDIn(hierarchy.Ancestors(parentIDs...)...)).
+		Select(grouproles.FieldRoleID).
+		Ints(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to get group roles: %w", err)
+	}
+
+	if err := s.checkGroupSeparationOfDuties(ctx, tx.Client(), hierarchy, groupID, uniqueInts(roleIDs)); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.GroupParents.Delete().
+		Where(groupparents.GroupIDEQ(groupID)).
+		Exec(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to clear parent groups: %w", err)
+	}
+
+	for _, parentID := range parentIDs {
+		_, err := tx.GroupParents.Create().
+			SetGroupID(groupID).
+			SetParentGroupID(parentID).
+			Save(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to add parent group %d: %w", parentID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to update parent groups: %w", err)
+	}
+
+	s.permissions.InvalidateAll(ctx)
+
+	s.createAuditLog(ctx, actorID, "group.parents.update", "group", fmt.Sprintf("%d", groupID), map[string]interface{}{
+		"group_id":            groupID,
+		"parent_group_ids":    parentIDs,
+		"previous_parent_ids": previous,
+	})
+
+	return nil
+}
+
+// GetUserGroups returns the groups a user belongs to, directly or through
+// nested groups
+func (s *RBACService) GetUserGroups(ctx context.Context, userID uuid.UUID) ([]models.UserGroupResponse, error) {
+	directIDs, err := s.client.GroupMembers.Query().
+		Where(groupmembers.UserIDEQ(userID)).
+		Select(groupmembers.FieldGroupID).
+		Ints(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+
+	result := make([]models.UserGroupResponse, 0)
+	if len(directIDs) == 0 {
+		return result, nil
+	}
+
+	hierarchy, err := LoadGroupHierarchy(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	entGroups, err := s.client.Groups.Query().
+		Where(groups.IDIn(hierarchy.Ancestors(directIDs...)...)).
+		Order(ent.Asc(groups.FieldCode)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get groups: %w", err)
+	}
+
+	for _, group := range entGroups {
+		result = append(result, models.UserGroupResponse{
+			GroupResponse: groupToResponse(group),
+			Direct:        slices.Contains(directIDs, group.ID),
+		})
+	}
+
+	return result, nil
+}
+
+// checkGroupSeparationOfDuties fails when any member of the group or of the
+// groups nested in it would break a constraint by gaining the roles. The
+// members are locked for the rest of the transaction.
+func (s *RBACService) checkGroupSeparationOfDuties(ctx context.Context, client *ent.Client, hierarchy GroupHierarchy, groupID int, roleIDs []int) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	memberships, err := client.GroupMembers.Query().
+		Where(groupmembers.GroupIDIn(hierarchy.Descendants(groupID)...)).
+		All(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to get group members: %w", err)
+	}
+	if len(memberships) == 0 {
+		return nil
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	members := make([]uuid.UUID, 0, len(memberships))
+	for _, membership := range memberships {
+		if !seen[membership.UserID] {
+			seen[membership.UserID] = true
+			members = append(members, membership.UserID)
+		}
+	}
+
+	_, err = client.Users.Query().
+		Where(users.IDIn(members...)).
+		ForUpdate().
+		IDs(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to lock group members: %w", err)
+	}
+
+	for _, memberID := range members {
+		violated, err := s.checkSeparationOfDuties(ctx, client, memberID, roleIDs...)
+		if err != nil {
+			return err
+		}
+		if len(violated) > 0 {
+			return fmt.Errorf("%w (group member %s)", sodViolationError(violated), memberID)
+		}
+	}
+
+	return nil
+}
+
+// groupRoleIDs returns the roles a user holds through the groups they
+// belong to, directly or through nested groups
+func groupRoleIDs(ctx context.Context, client *ent.Client, userID uuid.UUID) ([]int, error) {
+	groupIDs, err := client.GroupMembers.Query().
+		Where(groupmembers.UserIDEQ(userID)).
+		Select(groupmembers.FieldGroupID).
+		Ints(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+
+	hierarchy, err := LoadGroupHierarchy(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	roleIDs, err := client.GroupRoles.Query().
+		Where(grouproles.GroupIDIn(hierarchy.Ancestors(groupIDs...)...)).
+		Select(grouproles.FieldRoleID).
+		Ints(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group roles: %w", err)
+	}
+
+	return uniqueInts(roleIDs), nil
+}
+
+// userRoleIDs returns the IDs of the roles a user holds through active
+// assignments and through groups, before inheritance
+func userRoleIDs(ctx context.Context, client *ent.Client, userID uuid.UUID) ([]int, error) {
+	roleIDs, err := client.UserRoles.Query().
+		Where(userroles.UserIDEQ(userID), activeAssignment()).
+		Select(userroles.FieldRoleID).
+		Ints(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+
+	viaGroups, err := groupRoleIDs(ctx, client, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uniqueInts(append(roleIDs, viaGroups...)), nil
+}
+
+// groupRolesByUser returns the roles every group member holds through groups
+func groupRolesByUser(ctx context.Context, client *ent.Client) (map[uuid.UUID][]int, error) {
+	memberships, err := client.GroupMembers.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list group members: %w", err)
+	}
+
+	result := make(map[uuid.UUID][]int)
+	if len(memberships) == 0 {
+		return result, nil
+	}
+
+	grants, err := client.GroupRoles.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list group roles: %w", err)
+	}
+
+	rolesByGroup := make(map[int][]int)
+	for _, grant := range grants {
+		rolesByGroup[grant.GroupID] = append(rolesByGroup[grant.GroupID], grant.RoleID)
+	}
+
+	hierarchy, err := LoadGroupHierarchy(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, membership := range memberships {
+		for _, groupID := range hierarchy.Ancestors(membership.GroupID) {
+			result[membership.UserID] = append(result[membership.UserID], rolesByGroup[groupID]...)
+		}
+	}
+
+	for userID, roleIDs := range result {
+		result[userID] = uniqueInts(roleIDs)
+	}
+	return result, nil
+}
+
+// groupsHoldingRoles returns the groups that hold any of the roles, directly
+// or through a group they are nested in
+func (s *RBACService) groupsHoldingRoles(ctx context.Context, roleIDs []int) ([]int, error) {
+	holders, err := s.client.GroupRoles.Query().
+		Where(grouproles.RoleIDIn(roleIDs...)).
+		Select(grouproles.FieldGroupID).
+		Ints(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list group roles: %w", err)
+	}
+	if len(holders) == 0 {
+		return nil, nil
+	}
+
+	hierarchy, err := LoadGroupHierarchy(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []int
+	for _, groupID := range uniqueInts(holders) {
+		result = append(result, hierarchy.Descendants(groupID)...)
+	}
+	return uniqueInts(result), nil
+}
+
+// userGroupRoleGrants lists the roles a user holds through groups with the
+// group each comes from
+func (s *RBACService) userGroupRoleGrants(ctx context.Context, userID uuid.UUID) ([]models.GroupRoleGrant, error) {
+	result := make([]models.GroupRoleGrant, 0)
+
+	groupIDs, err := s.client.GroupMembers.Query().
+		Where(groupmembers.UserIDEQ(userID)).
+		Select(groupmembers.FieldGroupID).
+		Ints(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+	if len(groupIDs) == 0 {
+		return result, nil
+	}
+
+	hierarchy, err := LoadGroupHierarchy(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := s.client.GroupRoles.Query().
+		Where(grouproles.GroupIDIn(hierarchy.Ancestors(groupIDs...)...)).
+		WithGroup().
+		WithRole().
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group roles: %w", err)
+	}
+
+	for _, grant := range grants {
+		if grant.Edges.Group == nil || grant.Edges.Role == nil {
+			continue
+		}
+		result = append(result, models.GroupRoleGrant{
+			GroupID:   grant.GroupID,
+			GroupCode: grant.Edges.Group.Code,
+			RoleID:    grant.RoleID,
+			RoleCode:  grant.Edges.Role.Code,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].GroupCode != result[j].GroupCode {
+			return result[i].GroupCode < result[j].GroupCode
+		}
+		return result[i].RoleCode < result[j].RoleCode
+	})
+
+	return result, nil
+}
+
+func (s *RBACService) rolesToResponses(entRoles []*ent.Roles) []models.RoleResponse {
+	result := make([]models.RoleResponse, len(entRoles))
+	for i, role := range entRoles {
+		result[i] = s.roleToResponse(role)
+	}
+	return result
+}
+
+func groupToResponse(group *ent.Groups) models.GroupResponse {
+	return models.GroupResponse{
+		ID:          group.ID,
+		Code:        group.Code,
+		Name:        group.Name,
+		Description: group.Description,
+		CreatedBy:   group.CreatedBy,
+		CreatedAt:   group.CreatedAt,
+		UpdatedAt:   group.UpdatedAt,
+	}
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shammianand/go-auth/ent"
+)
+
+// RoleHierarchy maps each role ID to the IDs of the roles it inherits from
+type RoleHierarchy map[int][]int
+
+// LoadRoleHierarchy reads every inheritance link
+func LoadRoleHierarchy(ctx context.Context, client *ent.Client) (RoleHierarchy, error) {
+	links, err := client.RoleParents.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load role hierarchy: %w", err)
+	}
+
+	hierarchy := make(RoleHierarchy)
+	for _, link := range links {
+		hierarchy[link.RoleID] = append(hierarchy[link.RoleID], link.ParentRoleID)
+	}
+	return hierarchy, nil
+}
+
+// Ancestors returns the given roles followed by every role they inherit from,
+// nearest first, without duplicates
+func (h RoleHierarchy) Ancestors(roleIDs ...int) []int {
+	seen := make(map[int]bool)
+	var result []int
+
+	queue := append([]int{}, roleIDs...)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+		queue = append(queue, h[id]...)
+	}
+
+	return result
+}
+
+// WouldCycle reports whether giving roleID the parents would make a role
+// inherit from itself
+func (h RoleHierarchy) WouldCycle(roleID int, parentIDs []int) bool {
+	for _, ancestor := range h.Ancestors(parentIDs...) {
+		if ancestor == roleID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRoleHierarchyAncestors(t *testing.T) {
+	// 1 inherits from 2 and 3, both of which inherit from 4; 5 and 6
+	// inherit from each other
+	hierarchy := RoleHierarchy{
+		1: {2, 3},
+		2: {4},
+		3: {4},
+		5: {6},
+		6: {5},
+	}
+
+	tests := []struct {
+		name  string
+		roles []int
+		want  []int
+	}{
+		{"no roles", nil, nil},
+		{"role without parents", []int{4}, []int{4}},
+		{"unknown role", []int{99}, []int{99}},
+		{"nearest first", []int{1}, []int{1, 2, 3, 4}},
+		{"shared ancestor once", []int{2, 3}, []int{2, 3, 4}},
+		{"given role inherited by another", []int{2, 1}, []int{2, 1, 4, 3}},
+		{"duplicate input", []int{4, 4}, []int{4}},
+		{"cycle terminates", []int{5}, []int{5, 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hierarchy.Ancestors(tt.roles...); !slices.Equal(got, tt.want) {
+				t.Errorf("Ancestors(%v) = %v, want %v", tt.roles, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoleHierarchyWouldCycle(t *testing.T) {
+	hierarchy := RoleHierarchy{
+		1: {2},
+		2: {3},
+	}
+
+	tests := []struct {
+		role    int
+		parents []int
+		want    bool
+	}{
+		{3, []int{1}, true},
+		{3, []int{2}, true},
+		{1, []int{1}, true},
+		{1, []int{3}, false},
+		{4, []int{1, 2}, false},
+		{3, nil, false},
+	}
+
+	for _, tt := range tests {
+		if got := hierarchy.WouldCycle(tt.role, tt.parents); got != tt.want {
+			t.Errorf("WouldCycle(%d, %v) = %v, want %v", tt.role, tt.parents, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/internal/config"
+)
+
+// ImpersonationBlockedRoles returns the codes of the user's roles that are,
+// or inherit from, a role protected from impersonation
+func (s *RBACService) ImpersonationBlockedRoles(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	roleIDs, err := userRoleIDs(ctx, s.client, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, _, err := s.splitImpersonationRoles(ctx, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(blocked) == 0 {
+		return nil, nil
+	}
+
+	codes, err := s.client.Roles.Query().
+		Where(roles.IDIn(blocked...)).
+		Select(roles.FieldCode).
+		Strings(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+
+	return codes, nil
+}
+
+// HasImpersonatedPermission checks a permission for an impersonated user,
+// counting only the roles that are not protected from impersonation. The
+// result is not cached because impersonation sessions are short.
+func (s *RBACService) HasImpersonatedPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error) {
+	roleIDs, err := userRoleIDs(ctx, s.client, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return s.hasImpersonatedPermission(ctx, roleIDs, permission)
+}
+
+// HasImpersonatedOrgPermission is HasImpersonatedPermission within an
+// organization, counting the roles the user holds in it as HasOrgPermission
+// does
+func (s *RBACService) HasImpersonatedOrgPermission(ctx context.Context, orgID, userID uuid.UUID, permission string) (bool, error) {
+	roleIDs, err := userRoleIDs(ctx, s.client, userID)
+	if err != nil {
+		return false, err
+	}
+
+	orgRoleIDs, _, err := s.activeOrgRoleIDs(ctx, orgID, userID, false)
+	if err != nil {
+		return false, err
+	}
+
+	return s.hasImpersonatedPermission(ctx, uniqueInts(append(roleIDs, orgRoleIDs...)), permission)
+}
+
+// hasImpersonatedPermission reports whether the roles, less those protected
+// from impersonation, grant a permission without conditions
+func (s *RBACService) hasImpersonatedPermission(ctx context.Context, roleIDs []int, permission string) (bool, error) {
+	_, allowed, err := s.splitImpersonationRoles(ctx, roleIDs)
+	if err != nil {
+		return false, err
+	}
+
+	perms, err := s.resolvePermissions(ctx, allowed)
+	if err != nil {
+		return false, err
+	}
+
+	for _, perm := range perms {
+		if !perm.Conditional && PermissionMatches(perm.Code, permission) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// splitImpersonationRoles separates roles that are, or inherit from, a
+// protected role from the ones an impersonated session may use
+func (s *RBACService) splitImpersonationRoles(ctx context.Context, roleIDs []int) ([]int, []int, error) {
+	var codes []string
+	for _, code := range strings.Split(config.ImpersonationProtectedRoles, ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+
+	if len(codes) == 0 || len(roleIDs) == 0 {
+		return nil, roleIDs, nil
+	}
+
+	protectedIDs, err := s.client.Roles.Query().
+		Where(roles.CodeIn(codes...)).
+		IDs(ctx)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get protected roles: %w", err)
+	}
+
+	protected := make(map[int]bool, len(protectedIDs))
+	for _, id := range protectedIDs {
+		protected[id] = true
+	}
+
+	hierarchy, err := LoadRoleHierarchy(ctx, s.client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var blocked, allowed []int
+	for _, roleID := range roleIDs {
+		isBlocked := false
+		for _, id := range hierarchy.Ancestors(roleID) {
+			if protected[id] {
+				isBlocked = true
+				break
+			}
+		}
+
+		if isBlocked {
+			blocked = append(blocked, roleID)
+		} else {
+			allowed = append(allowed, roleID)
+		}
+	}
+
+	return blocked, allowed, nil
+}
@@ -0,0 +1,841 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/organizations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/auth"
+	"github.com/shammianand/go-auth/internal/config"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+)
+
+// ListOrganizations returns all organizations
+func (s *RBACService) ListOrganizations(ctx context.Context) ([]models.OrganizationResponse, error) {
+	orgs, err := s.client.Organizations.Query().
+		Order(ent.Asc(organizations.FieldSlug)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	result := make([]models.OrganizationResponse, len(orgs))
+	for i, org := range orgs {
+		result[i] = orgToResponse(org)
+	}
+
+	return result, nil
+}
+
+// GetOrganization returns an organization and its settings
+func (s *RBACService) GetOrganization(ctx context.Context, orgID uuid.UUID) (*models.OrganizationResponse, error) {
+	org, err := s.getOrganization(ctx, s.client, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := orgToResponse(org)
+	return &response, nil
+}
+
+// CreateOrganization creates an organization with the actor as its first
+// member. The actor is assigned ORG_ADMIN_ROLE in it when that role exists.
+func (s *RBACService) CreateOrganization(ctx context.Context, req *models.CreateOrganizationRequest, actorID uuid.UUID) (*models.OrganizationResponse, error) {
+	if err := validateOrgSlug(req.Slug); err != nil {
+		return nil, err
+	}
+
+	domains, err := normalizeDomains(req.AllowedDomains)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := s.client.Users.Get(ctx, actorID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if !emailDomainAllowed(actor.Email, domains) {
+		return nil, fmt.Errorf("email domain not allowed by organization")
+	}
+
+	adminRole, err := s.client.Roles.Query().
+		Where(roles.CodeEQ(config.OrgAdminRole)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get organization admin role: %w", err)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	org, err := tx.Organizations.Create().
+		SetSlug(req.Slug).
+		SetName(req.Name).
+		SetAllowedDomains(domains).
+		SetMfaRequired(req.MFARequired).
+		SetCreatedBy(actorID).
+		Save(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("organization slug already exists")
+		}
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	_, err = tx.OrgMembers.Create().
+		SetOrgID(org.ID).
+		SetUserID(actorID).
+		SetAddedBy(actorID).
+		Save(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to add organization member: %w", err)
+	}
+
+	if adminRole != nil {
+		_, err = tx.OrgRoles.Create().
+			SetOrgID(org.ID).
+			SetUserID(actorID).
+			SetRoleID(adminRole.ID).
+			SetAssignedBy(actorID).
+			Save(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to assign organization admin role: %w", err)
+		}
+	} else {
+		s.logger.Warn("Organization admin role not found; creator is a plain member",
+			"role", config.OrgAdminRole,
+			"org_id", org.ID,
+		)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	response := orgToResponse(org)
+	s.createOrgAuditLog(ctx, org.ID, actorID, "org.create", "organization", org.ID.String(), map[string]interface{}{
+		"org_id": org.ID.String(),
+		"slug":   org.Slug,
+	}, map[string]interface{}{
+		"before": nil,
+		"after":  response,
+	})
+
+	return &response, nil
+}
+
+// UpdateOrganization changes an organization's details or settings.
+// Narrowing the allowed domains does not remove existing members, but they
+// can no longer switch into the organization.
+func (s *RBACService) UpdateOrganization(ctx context.Context, orgID uuid.UUID, req *models.UpdateOrganizationRequest, actorID uuid.UUID) (*models.OrganizationResponse, error) {
+	org, err := s.getOrganization(ctx, s.client, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := orgToResponse(org)
+	update := org.Update()
+
+	if req.Slug != nil && *req.Slug != org.Slug {
+		if err := validateOrgSlug(*req.Slug); err != nil {
+			return nil, err
+		}
+		update = update.SetSlug(*req.Slug)
+	}
+	if req.Name != nil {
+		if *req.Name == "" {
+			return nil, fmt.Errorf("organization name cannot be empty")
+		}
+		update = update.SetName(*req.Name)
+	}
+	if req.AllowedDomains != nil {
+		domains, err := normalizeDomains(*req.AllowedDomains)
+		if err != nil {
+			return nil, err
+		}
+		update = update.SetAllowedDomains(domains)
+	}
+	if req.MFARequired != nil {
+		update = update.SetMfaRequired(*req.MFARequired)
+	}
+
+	org, err = update.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("organization slug already exists")
+		}
+		return nil, fmt.Errorf("failed to update organization: %w", err)
+	}
+
+	after := orgToResponse(org)
+	s.createOrgAuditLog(ctx, orgID, actorID, "org.update", "organization", orgID.String(), map[string]interface{}{
+		"org_id": orgID.String(),
+	}, map[string]interface{}{
+		"before": before,
+		"after":  after,
+	})
+
+	return &after, nil
+}
+
+// DeleteOrganization deletes an organization with its memberships and role
+// assignments. Its audit logs are kept.
+func (s *RBACService) DeleteOrganization(ctx context.Context, orgID, actorID uuid.UUID) (*models.DeleteOrganizationResponse, error) {
+	org, err := s.getOrganization(ctx, s.client, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	result := &models.DeleteOrganizationResponse{OrgID: orgID}
+
+	result.RemovedRoles, err = tx.OrgRoles.Delete().
+		Where(orgroles.OrgIDEQ(orgID)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove organization roles: %w", err)
+	}
+
+	result.RemovedMembers, err = tx.OrgMembers.Delete().
+		Where(orgmembers.OrgIDEQ(orgID)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove organization members: %w", err)
+	}
+
+	if err := tx.Organizations.DeleteOneID(orgID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete organization: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to delete organization: %w", err)
+	}
+
+	s.createOrgAuditLog(ctx, orgID, actorID, "org.delete", "organization", orgID.String(), map[string]interface{}{
+		"org_id":          orgID.String(),
+		"removed_members": result.RemovedMembers,
+		"removed_roles":   result.RemovedRoles,
+	}, map[string]interface{}{
+		"before": orgToResponse(org),
+		"after":  nil,
+	})
+
+	return result, nil
+}
+
+// ListOrgMembers returns the members of an organization with the roles
+// assigned to them in it
+func (s *RBACService) ListOrgMembers(ctx context.Context, orgID uuid.UUID) ([]models.OrgMemberResponse, error) {
+	if _, err := s.getOrganization(ctx, s.client, orgID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.client.OrgMembers.Query().
+		Where(orgmembers.OrgIDEQ(orgID)).
+		WithUser().
+		Order(ent.Asc(orgmembers.FieldJoinedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization members: %w", err)
+	}
+
+	grants, err := s.client.OrgRoles.Query().
+		Where(orgroles.OrgIDEQ(orgID)).
+		WithRole().
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization roles: %w", err)
+	}
+
+	rolesByUser := make(map[uuid.UUID][]*ent.Roles)
+	for _, grant := range grants {
+		if grant.Edges.Role != nil {
+			rolesByUser[grant.UserID] = append(rolesByUser[grant.UserID], grant.Edges.Role)
+		}
+	}
+
+	result := make([]models.OrgMemberResponse, 0, len(members))
+	for _, member := range members {
+		response := models.OrgMemberResponse{
+			UserID:   member.UserID,
+			Roles:    s.rolesToResponses(sortRolesByCode(rolesByUser[member.UserID])),
+			AddedBy:  member.AddedBy,
+			JoinedAt: member.JoinedAt,
+		}
+		if member.Edges.User != nil {
+			response.Email = member.Edges.User.Email
+		}
+		result = append(result, response)
+	}
+
+	return result, nil
+}
+
+// AddOrgMember adds a user to an organization. The user's email domain must
+// be one the organization allows.
+func (s *RBACService) AddOrgMember(ctx context.Context, orgID, userID, actorID uuid.UUID) error {
+	org, err := s.getOrganization(ctx, s.client, orgID)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.client.Users.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("user not found")
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !emailDomainAllowed(user.Email, org.AllowedDomains) {
+		return fmt.Errorf("email domain not allowed by organization")
+	}
+
+	_, err = s.client.OrgMembers.Create().
+		SetOrgID(orgID).
+		SetUserID(userID).
+		SetAddedBy(actorID).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return fmt.Errorf("user is already a member of the organization")
+		}
+		return fmt.Errorf("failed to add organization member: %w", err)
+	}
+
+	s.createOrgAuditLog(ctx, orgID, actorID, "org.member.add", "organization", orgID.String(), map[string]interface{}{
+		"org_id":  orgID.String(),
+		"user_id": userID.String(),
+	}, nil)
+
+	return nil
+}
+
+// RemoveOrgMember removes a user and the roles assigned to them from an
+// organization
+func (s *RBACService) RemoveOrgMember(ctx context.Context, orgID, userID, actorID uuid.UUID) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	removedRoles, err := tx.OrgRoles.Delete().
+		Where(
+			orgroles.OrgIDEQ(orgID),
+			orgroles.UserIDEQ(userID),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to remove organization roles: %w", err)
+	}
+
+	deleted, err := tx.OrgMembers.Delete().
+		Where(
+			orgmembers.OrgIDEQ(orgID),
+			orgmembers.UserIDEQ(userID),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+	if deleted == 0 {
+		tx.Rollback()
+		return fmt.Errorf("organization membership not found")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.createOrgAuditLog(ctx, orgID, actorID, "org.member.remove", "organization", orgID.String(), map[string]interface{}{
+		"org_id":        orgID.String(),
+		"user_id":       userID.String(),
+		"removed_roles": removedRoles,
+	}, nil)
+
+	return nil
+}
+
+// AssignOrgRole assigns a role to a member within an organization. The role
+// must be one organizations may assign and the actor must hold its
+// permissions; together with the roles the user holds everywhere, it must
+// not break a separation-of-duties constraint.
+func (s *RBACService) AssignOrgRole(ctx context.Context, orgID, userID uuid.UUID, roleID int, actorID uuid.UUID) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if err := s.assignOrgRole(ctx, tx.Client(), orgID, userID, roleID, actorID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.createOrgAuditLog(ctx, orgID, actorID, "org.role.assign", "organization", orgID.String(), map[string]interface{}{
+		"org_id":  orgID.String(),
+		"user_id": userID.String(),
+		"role_id": roleID,
+	}, nil)
+
+	return nil
+}
+
+// assignOrgRole checks and inserts an organization role assignment using
+// client, which should be bound to a transaction
+func (s *RBACService) assignOrgRole(ctx context.Context, client *ent.Client, orgID, userID uuid.UUID, roleID int, actorID uuid.UUID) error {
+	// Lock the user so a concurrent grant cannot slip past the
+	// separation-of-duties check
+	_, err := client.Users.Query().
+		Where(users.IDEQ(userID)).
+		ForUpdate().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("user not found")
+		}
+		return fmt.Errorf("failed to check user: %w", err)
+	}
+
+	member, err := client.OrgMembers.Query().
+		Where(
+			orgmembers.OrgIDEQ(orgID),
+			orgmembers.UserIDEQ(userID),
+		).
+		Exist(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to check membership: %w", err)
+	}
+	if !member {
+		return fmt.Errorf("organization membership not found")
+	}
+
+	role, err := client.Roles.Get(ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("role not found")
+		}
+		return fmt.Errorf("failed to get role: %w", err)
+	}
+
+	if err := s.checkOrgGrantable(ctx, orgID, role, actorID); err != nil {
+		return err
+	}
+
+	orgRoleIDs, err := client.OrgRoles.Query().
+		Where(
+			orgroles.OrgIDEQ(orgID),
+			orgroles.UserIDEQ(userID),
+		).
+		Select(orgroles.FieldRoleID).
+		Ints(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to get organization roles: %w", err)
+	}
+
+	for _, id := range orgRoleIDs {
+		if id == roleID {
+			return fmt.Errorf("role already assigned in organization")
+		}
+	}
+
+	violated, err := s.checkSeparationOfDuties(ctx, client, userID, append(orgRoleIDs, roleID)...)
+	if err != nil {
+		return err
+	}
+	if len(violated) > 0 {
+		return sodViolationError(violated)
+	}
+
+	_, err = client.OrgRoles.Create().
+		SetOrgID(orgID).
+		SetUserID(userID).
+		SetRoleID(roleID).
+		SetAssignedBy(actorID).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to assign organization role: %w", err)
+	}
+
+	return nil
+}
+
+// checkOrgGrantable reports whether actorID may assign role within an
+// organization. Only the organization admin role and custom roles marked
+// org-assignable qualify, never roles with a user limit, and the actor must
+// already hold every permission the role grants, inherited ones included,
+// without conditions.
+func (s *RBACService) checkOrgGrantable(ctx context.Context, orgID uuid.UUID, role *ent.Roles, actorID uuid.UUID) error {
+	scoped := role.Code == config.OrgAdminRole || (role.OrgAssignable && !role.IsSystem)
+	if !scoped || role.MaxUsers != nil {
+		return fmt.Errorf("role cannot be assigned within an organization")
+	}
+
+	granted, err := s.resolvePermissions(ctx, []int{role.ID})
+	if err != nil {
+		return err
+	}
+
+	held, _, err := s.orgPermissions(ctx, orgID, actorID, false)
+	if err != nil {
+		return err
+	}
+
+	for _, perm := range granted {
+		allowed := false
+		for _, h := range held {
+			if !h.Conditional && PermissionMatches(h.Code, perm.Code) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("cannot grant a role with permissions you do not hold")
+		}
+	}
+
+	return nil
+}
+
+// RemoveOrgRole removes a role assigned to a member within an organization
+func (s *RBACService) RemoveOrgRole(ctx context.Context, orgID, userID uuid.UUID, roleID int, actorID uuid.UUID) error {
+	deleted, err := s.client.OrgRoles.Delete().
+		Where(
+			orgroles.OrgIDEQ(orgID),
+			orgroles.UserIDEQ(userID),
+			orgroles.RoleIDEQ(roleID),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to remove organization role: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("organization role assignment not found")
+	}
+
+	s.createOrgAuditLog(ctx, orgID, actorID, "org.role.remove", "organization", orgID.String(), map[string]interface{}{
+		"org_id":  orgID.String(),
+		"user_id": userID.String(),
+		"role_id": roleID,
+	}, nil)
+
+	return nil
+}
+
+// GetUserOrganizations returns the organizations a user belongs to with the
+// roles assigned to them in each
+func (s *RBACService) GetUserOrganizations(ctx context.Context, userID uuid.UUID) ([]models.UserOrgResponse, error) {
+	memberships, err := s.client.OrgMembers.Query().
+		Where(orgmembers.UserIDEQ(userID)).
+		WithOrg().
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user organizations: %w", err)
+	}
+
+	grants, err := s.client.OrgRoles.Query().
+		Where(orgroles.UserIDEQ(userID)).
+		WithRole().
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization roles: %w", err)
+	}
+
+	rolesByOrg := make(map[uuid.UUID][]*ent.Roles)
+	for _, grant := range grants {
+		if grant.Edges.Role != nil {
+			rolesByOrg[grant.OrgID] = append(rolesByOrg[grant.OrgID], grant.Edges.Role)
+		}
+	}
+
+	result := make([]models.UserOrgResponse, 0, len(memberships))
+	for _, membership := range memberships {
+		if membership.Edges.Org == nil {
+			continue
+		}
+		result = append(result, models.UserOrgResponse{
+			OrganizationResponse: orgToResponse(membership.Edges.Org),
+			Roles:                s.rolesToResponses(sortRolesByCode(rolesByOrg[membership.OrgID])),
+			JoinedAt:             membership.JoinedAt,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Slug < result[j].Slug
+	})
+
+	return result, nil
+}
+
+// SwitchOrganization issues a token scoped to an organization the user
+// belongs to, or an unscoped token when orgID is nil. Membership and the
+// allowed email domains are checked again on every switch, so narrowing the
+// domains locks out members who no longer match. Like signin, no token is
+// issued while the user has mandatory documents to accept.
+func (s *RBACService) SwitchOrganization(ctx context.Context, userID uuid.UUID, orgID *uuid.UUID) (*models.OrgTokenResponse, error) {
+	extra, err := auth.ConsentClaims(ctx, s.consents, userID, nil, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	if orgID != nil {
+		org, err := s.getOrganization(ctx, s.client, *orgID)
+		if err != nil {
+			return nil, err
+		}
+
+		member, err := s.client.OrgMembers.Query().
+			Where(
+				orgmembers.OrgIDEQ(*orgID),
+				orgmembers.UserIDEQ(userID),
+			).
+			Exist(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to check membership: %w", err)
+		}
+		if !member {
+			return nil, fmt.Errorf("organization membership not found")
+		}
+
+		user, err := s.client.Users.Get(ctx, userID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("user not found")
+			}
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		if !emailDomainAllowed(user.Email, org.AllowedDomains) {
+			return nil, fmt.Errorf("email domain not allowed by organization")
+		}
+
+		extra["org_id"] = orgID.String()
+	}
+
+	token, err := auth.CreateJWTWithClaims(userID, extra, s.cache)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+
+	if orgID != nil {
+		s.createOrgAuditLog(ctx, *orgID, userID, "org.switch", "organization", orgID.String(), map[string]interface{}{
+			"org_id": orgID.String(),
+		}, nil)
+	}
+
+	return &models.OrgTokenResponse{
+		Token:     token,
+		ExpiresAt: time.Now().Add(time.Second * time.Duration(config.TokenExpiry)),
+		OrgID:     orgID,
+	}, nil
+}
+
+// HasOrgPermission reports whether a user holds a permission within an
+// organization, through their own roles or the roles assigned to them in
+// it. Conditional grants are ignored as in HasPermission. Sessions carry no
+// second factor here, so organizations requiring MFA only honour the user's
+// own roles.
+func (s *RBACService) HasOrgPermission(ctx context.Context, orgID, userID uuid.UUID, permission string) (bool, error) {
+	perms, _, err := s.orgPermissions(ctx, orgID, userID, false)
+	if err != nil {
+		return false, err
+	}
+
+	for _, perm := range perms {
+		if !perm.Conditional && PermissionMatches(perm.Code, permission) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// orgPermissions returns a user's permissions within an organization. Users
+// without roles in the organization are served from the permission cache;
+// otherwise their own and organization roles are resolved together. When
+// the organization requires MFA and mfa is false, organization roles are
+// left out and the returned note says why.
+func (s *RBACService) orgPermissions(ctx context.Context, orgID, userID uuid.UUID, mfa bool) ([]models.PermissionResponse, string, error) {
+	orgRoleIDs, note, err := s.activeOrgRoleIDs(ctx, orgID, userID, mfa)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(orgRoleIDs) == 0 {
+		userPerms, err := s.GetUserPermissions(ctx, userID)
+		if err != nil {
+			return nil, "", err
+		}
+		return userPerms.Permissions, note, nil
+	}
+
+	roleIDs, err := userRoleIDs(ctx, s.client, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	perms, err := s.resolvePermissions(ctx, uniqueInts(append(roleIDs, orgRoleIDs...)))
+	if err != nil {
+		return nil, "", err
+	}
+	return perms, note, nil
+}
+
+// activeOrgRoleIDs returns the IDs of the roles a user holds within an
+// organization. When the organization requires MFA and mfa is false, none
+// apply and the returned note says why.
+func (s *RBACService) activeOrgRoleIDs(ctx context.Context, orgID, userID uuid.UUID, mfa bool) ([]int, string, error) {
+	orgRoleIDs, err := s.client.OrgRoles.Query().
+		Where(
+			orgroles.OrgIDEQ(orgID),
+			orgroles.UserIDEQ(userID),
+		).
+		Select(orgroles.FieldRoleID).
+		Ints(ctx)
+
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get organization roles: %w", err)
+	}
+
+	if len(orgRoleIDs) > 0 && !mfa {
+		org, err := s.getOrganization(ctx, s.client, orgID)
+		if err != nil {
+			return nil, "", err
+		}
+		if org.MfaRequired {
+			return nil, "organization roles require multi-factor authentication", nil
+		}
+	}
+
+	return orgRoleIDs, "", nil
+}
+
+// getOrganization loads an organization using client, which may be bound to
+// a transaction
+func (s *RBACService) getOrganization(ctx context.Context, client *ent.Client, orgID uuid.UUID) (*ent.Organizations, error) {
+	org, err := client.Organizations.Get(ctx, orgID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("organization not found")
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return org, nil
+}
+
+// validateOrgSlug checks that a slug is lowercase kebab-case
+func validateOrgSlug(slug string) error {
+	if len(slug) > maxCodeLength || !roleCodePattern.MatchString(slug) {
+		return fmt.Errorf("invalid organization slug format")
+	}
+	return nil
+}
+
+// normalizeDomains lowercases and deduplicates email domains, rejecting
+// anything that is not a bare domain
+func normalizeDomains(domains []string) ([]string, error) {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if domain == "" || strings.ContainsAny(domain, "@ /") || !strings.Contains(domain, ".") {
+			return nil, fmt.Errorf("invalid email domain: %q", domain)
+		}
+		if !seen[domain] {
+			seen[domain] = true
+			result = append(result, domain)
+		}
+	}
+	return result, nil
+}
+
+// emailDomainAllowed reports whether an email address belongs to one of the
+// domains. An empty list allows every address.
+func emailDomainAllowed(email string, domains []string) bool {
+	if len(domains) == 0 {
+		return true
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+
+	domain := strings.ToLower(email[at+1:])
+	for _, allowed := range domains {
+		if domain == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func sortRolesByCode(entRoles []*ent.Roles) []*ent.Roles {
+	sort.Slice(entRoles, func(i, j int) bool {
+		return entRoles[i].Code < entRoles[j].Code
+	})
+	return entRoles
+}
+
+func orgToResponse(org *ent.Organizations) models.OrganizationResponse {
+	domains := org.AllowedDomains
+	if domains == nil {
+		domains = []string{}
+	}
+
+	return models.OrganizationResponse{
+		ID:             org.ID,
+		Slug:           org.Slug,
+		Name:           org.Name,
+		AllowedDomains: domains,
+		MFARequired:    org.MfaRequired,
+		CreatedBy:      org.CreatedBy,
+		CreatedAt:      org.CreatedAt,
+		UpdatedAt:      org.UpdatedAt,
+	}
+}
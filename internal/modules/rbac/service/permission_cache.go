@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+)
+
+const (
+	// permissionGlobalVersionKey is bumped when a change can affect any user
+	permissionGlobalVersionKey = "rbac:permissions:version"
+
+	// permissionInvalidationChannel carries a user ID, or "*" for everyone
+	permissionInvalidationChannel = "rbac:permissions:invalidate"
+
+	invalidateAll = "*"
+
+	// maxLocalEntries bounds the in-process layer; expired entries are swept
+	// once it is reached
+	maxLocalEntries = 10000
+)
+
+// PermissionCache caches each user's effective permissions in process and in
+// Redis. Redis entries are keyed by a global and a per-user version, which are
+// bumped after every change, so a reader that loaded stale data can only ever
+// write it under a key nobody reads any more. Other instances drop their
+// in-process entries when the change is published on a Redis channel.
+type PermissionCache struct {
+	cache    *redis.Client
+	redisTTL time.Duration
+	localTTL time.Duration
+	logger   *slog.Logger
+
+	mu         sync.Mutex
+	entries    map[uuid.UUID]localPermissions
+	generation uint64
+	userGens   map[uuid.UUID]uint64
+}
+
+// cacheGeneration identifies the invalidations a load started after. A load
+// may only store its result while both counters are unchanged.
+type cacheGeneration struct {
+	global uint64
+	user   uint64
+}
+
+type localPermissions struct {
+	permissions []models.PermissionResponse
+	expiresAt   time.Time
+}
+
+// NewPermissionCache creates a permission cache. A nil Redis client or a zero
+// TTL disables the corresponding layer.
+func NewPermissionCache(cache *redis.Client, redisTTL, localTTL time.Duration, logger *slog.Logger) *PermissionCache {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &PermissionCache{
+		cache:    cache,
+		redisTTL: redisTTL,
+		localTTL: localTTL,
+		logger:   logger,
+		entries:  make(map[uuid.UUID]localPermissions),
+		userGens: make(map[uuid.UUID]uint64),
+	}
+}
+
+// Get returns a user's cached permissions, calling load on a miss
+func (c *PermissionCache) Get(ctx context.Context, userID uuid.UUID, load func(context.Context) ([]models.PermissionResponse, error)) ([]models.PermissionResponse, error) {
+	if perms, ok := c.getLocal(userID); ok {
+		return perms, nil
+	}
+
+	// Remember the local generation so an invalidation that arrives while
+	// loading prevents the stale result from being stored
+	generation := c.localGeneration(userID)
+
+	key, err := c.redisKey(ctx, userID)
+	if err != nil {
+		c.logger.Warn("Permission cache unavailable", "user_id", userID, "error", err)
+	}
+
+	if key != "" {
+		if perms, ok := c.getRedis(ctx, key); ok {
+			c.setLocal(userID, generation, perms)
+			return perms, nil
+		}
+	}
+
+	perms, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if key != "" {
+		c.setRedis(ctx, key, perms)
+	}
+	c.setLocal(userID, generation, perms)
+
+	return perms, nil
+}
+
+// InvalidateUser drops a user's cached permissions on every instance
+func (c *PermissionCache) InvalidateUser(ctx context.Context, userID uuid.UUID) {
+	c.invalidateLocal(userID.String())
+	if c.cache == nil {
+		return
+	}
+
+	if err := c.cache.Incr(ctx, userVersionKey(userID)).Err(); err != nil {
+		c.logger.Error("Failed to bump permission cache version", "user_id", userID, "error", err)
+	}
+	c.publish(ctx, userID.String())
+}
+
+// InvalidateAll drops every user's cached permissions on every instance
+func (c *PermissionCache) InvalidateAll(ctx context.Context) {
+	c.invalidateLocal(invalidateAll)
+	if c.cache == nil {
+		return
+	}
+
+	if err := c.cache.Incr(ctx, permissionGlobalVersionKey).Err(); err != nil {
+		c.logger.Error("Failed to bump permission cache version", "error", err)
+	}
+	c.publish(ctx, invalidateAll)
+}
+
+// Listen applies invalidations published by other instances until ctx is done
+func (c *PermissionCache) Listen(ctx context.Context) {
+	if c.cache == nil {
+		return
+	}
+
+	pubsub := c.cache.Subscribe(ctx, permissionInvalidationChannel)
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			c.invalidateLocal(msg.Payload)
+		}
+	}
+}
+
+func (c *PermissionCache) publish(ctx context.Context, payload string) {
+	if err := c.cache.Publish(ctx, permissionInvalidationChannel, payload).Err(); err != nil {
+		c.logger.Error("Failed to publish permission invalidation", "payload", payload, "error", err)
+	}
+}
+
+// redisKey builds the versioned cache key for a user
+func (c *PermissionCache) redisKey(ctx context.Context, userID uuid.UUID) (string, error) {
+	if c.cache == nil || c.redisTTL <= 0 {
+		return "", nil
+	}
+
+	versions, err := c.cache.MGet(ctx, permissionGlobalVersionKey, userVersionKey(userID)).Result()
+	if err != nil {
+		return "", fmt.Errorf("failed to read permission cache versions: %w", err)
+	}
+
+	return fmt.Sprintf("user:permissions:%s:%s:%s", userID, versionString(versions[0]), versionString(versions[1])), nil
+}
+
+func (c *PermissionCache) getRedis(ctx context.Context, key string) ([]models.PermissionResponse, bool) {
+	data, err := c.cache.Get(ctx, key).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			c.logger.Warn("Failed to read permission cache", "key", key, "error", err)
+		}
+		return nil, false
+	}
+
+	var perms []models.PermissionResponse
+	if err := json.Unmarshal(data, &perms); err != nil {
+		c.logger.Warn("Discarding corrupt permission cache entry", "key", key, "error", err)
+		return nil, false
+	}
+	return perms, true
+}
+
+func (c *PermissionCache) setRedis(ctx context.Context, key string, perms []models.PermissionResponse) {
+	data, err := json.Marshal(perms)
+	if err != nil {
+		c.logger.Error("Failed to encode permissions for cache", "error", err)
+		return
+	}
+
+	if err := c.cache.Set(ctx, key, data, c.redisTTL).Err(); err != nil {
+		c.logger.Warn("Failed to write permission cache", "key", key, "error", err)
+	}
+}
+
+func (c *PermissionCache) getLocal(userID uuid.UUID) ([]models.PermissionResponse, bool) {
+	if c.localTTL <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.permissions, true
+}
+
+func (c *PermissionCache) localGeneration(userID uuid.UUID) cacheGeneration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return cacheGeneration{global: c.generation, user: c.userGens[userID]}
+}
+
+func (c *PermissionCache) setLocal(userID uuid.UUID, generation cacheGeneration, perms []models.PermissionResponse) {
+	if c.localTTL <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != (cacheGeneration{global: c.generation, user: c.userGens[userID]}) {
+		return
+	}
+
+	if len(c.entries) >= maxLocalEntries {
+		now := time.Now()
+		for id, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+		if len(c.entries) >= maxLocalEntries {
+			c.entries = make(map[uuid.UUID]localPermissions)
+		}
+	}
+
+	c.entries[userID] = localPermissions{
+		permissions: perms,
+		expiresAt:   time.Now().Add(c.localTTL),
+	}
+}
+
+func (c *PermissionCache) invalidateLocal(target string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if target == invalidateAll {
+		c.generation++
+		c.entries = make(map[uuid.UUID]localPermissions)
+		return
+	}
+
+	userID, err := uuid.Parse(target)
+	if err != nil {
+		c.logger.Warn("Ignoring invalid permission invalidation", "payload", target)
+		return
+	}
+	c.userGens[userID]++
+	delete(c.entries, userID)
+
+	// Per-user counters only guard loads in flight. Rather than keep one for
+	// every user ever invalidated, start over once there are too many; the
+	// global bump stops every load in flight from storing, but cached
+	// entries stay valid.
+	if len(c.userGens) > maxLocalEntries {
+		c.generation++
+		c.userGens = make(map[uuid.UUID]uint64)
+	}
+}
+
+func userVersionKey(userID uuid.UUID) string {
+	return fmt.Sprintf("rbac:permissions:version:%s", userID)
+}
+
+// versionString normalises an MGET result; missing keys are version 0
+func versionString(value interface{}) string {
+	s, ok := value.(string)
+	if !ok {
+		return "0"
+	}
+	if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+		return "0"
+	}
+	return s
+}
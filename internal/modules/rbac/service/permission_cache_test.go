@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+)
+
+// newTestPermissionCache returns a cache with only the in-process layer
+func newTestPermissionCache(localTTL time.Duration) *PermissionCache {
+	return NewPermissionCache(nil, 0, localTTL, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+// testPermissionLoader returns a loader that counts its calls
+func testPermissionLoader(calls *int) func(context.Context) ([]models.PermissionResponse, error) {
+	perms := []models.PermissionResponse{
+		{ID: 1, Code: "users.read", GrantedBy: []string{"user"}},
+		{ID: 2, Code: "users.write.self", GrantedBy: []string{"user"}},
+		{ID: 3, Code: "orgs.*", GrantedBy: []string{"admin"}},
+	}
+	return func(context.Context) ([]models.PermissionResponse, error) {
+		*calls++
+		return perms, nil
+	}
+}
+
+func TestPermissionCacheInvalidation(t *testing.T) {
+	ctx := context.Background()
+	cache := newTestPermissionCache(time.Minute)
+	userID, otherID := uuid.New(), uuid.New()
+
+	calls := 0
+	load := testPermissionLoader(&calls)
+
+	for _, id := range []uuid.UUID{userID, userID, otherID} {
+		if _, err := cache.Get(ctx, id, load); err != nil {
+			t.Fatalf("Get returned error: %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("loads after warming = %d, want 2", calls)
+	}
+
+	cache.InvalidateUser(ctx, userID)
+	cache.Get(ctx, userID, load)
+	cache.Get(ctx, otherID, load)
+	if calls != 3 {
+		t.Fatalf("loads after InvalidateUser = %d, want 3", calls)
+	}
+
+	cache.InvalidateAll(ctx)
+	cache.Get(ctx, userID, load)
+	cache.Get(ctx, otherID, load)
+	if calls != 5 {
+		t.Fatalf("loads after InvalidateAll = %d, want 5", calls)
+	}
+}
+
+func TestPermissionCacheBoundsUserGenerations(t *testing.T) {
+	ctx := context.Background()
+	cache := newTestPermissionCache(time.Minute)
+	userID := uuid.New()
+
+	calls := 0
+	load := testPermissionLoader(&calls)
+	cache.Get(ctx, userID, load)
+
+	stale := cache.localGeneration(userID)
+	for i := 0; i <= maxLocalEntries; i++ {
+		cache.InvalidateUser(ctx, uuid.New())
+	}
+	if n := len(cache.userGens); n > maxLocalEntries {
+		t.Fatalf("userGens holds %d entries, want at most %d", n, maxLocalEntries)
+	}
+
+	// Entries for users that were not invalidated survive the reset
+	cache.Get(ctx, userID, load)
+	if calls != 1 {
+		t.Fatalf("loads after reset = %d, want 1", calls)
+	}
+
+	// A load that started before the reset must not store its result
+	other := uuid.New()
+	cache.setLocal(other, stale, nil)
+	if _, ok := cache.entries[other]; ok {
+		t.Fatal("setLocal stored a result loaded before the reset")
+	}
+}
+
+func BenchmarkPermissionCacheHit(b *testing.B) {
+	ctx := context.Background()
+	cache := newTestPermissionCache(time.Hour)
+	userID := uuid.New()
+
+	calls := 0
+	load := testPermissionLoader(&calls)
+	cache.Get(ctx, userID, load)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := cache.Get(ctx, userID, load); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+
+	if calls != 1 {
+		b.Fatalf("loads = %d, want 1", calls)
+	}
+}
+
+func BenchmarkPermissionCacheMiss(b *testing.B) {
+	ctx := context.Background()
+	cache := newTestPermissionCache(time.Hour)
+
+	userIDs := make([]uuid.UUID, b.N)
+	for i := range userIDs {
+		userIDs[i] = uuid.New()
+	}
+
+	calls := 0
+	load := testPermissionLoader(&calls)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := cache.Get(ctx, userIDs[i], load); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+
+	if calls != b.N {
+		b.Fatalf("loads = %d, want %d", calls, b.N)
+	}
+}
+
+func BenchmarkPermissionCacheInvalidation(b *testing.B) {
+	ctx := context.Background()
+	cache := newTestPermissionCache(time.Hour)
+	userID := uuid.New()
+
+	calls := 0
+	load := testPermissionLoader(&calls)
+	cache.Get(ctx, userID, load)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cache.InvalidateUser(ctx, userID)
+		if _, err := cache.Get(ctx, userID, load); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.StopTimer()
+
+	if calls != b.N+1 {
+		b.Fatalf("loads = %d, want %d", calls, b.N+1)
+	}
+}
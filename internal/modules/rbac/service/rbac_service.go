@@ -2,37 +2,75 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"github.com/shammianand/go-auth/ent"
 	"github.com/shammianand/go-auth/ent/auditlogs"
+	"github.com/shammianand/go-auth/ent/roleapprovers"
+	"github.com/shammianand/go-auth/ent/roleparents"
 	"github.com/shammianand/go-auth/ent/rolepermissions"
+	"github.com/shammianand/go-auth/ent/roles"
 	"github.com/shammianand/go-auth/ent/userroles"
-	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/auth"
+	"github.com/shammianand/go-auth/internal/common/audit"
+	"github.com/shammianand/go-auth/internal/config"
+	emailservice "github.com/shammianand/go-auth/internal/modules/email/service"
 	"github.com/shammianand/go-auth/internal/modules/rbac/models"
 )
 
 // RBACService handles RBAC operations
 type RBACService struct {
-	client *ent.Client
-	logger *slog.Logger
+	client         *ent.Client
+	cache          *redis.Client
+	permissions    *PermissionCache
+	relationSchema RelationSchema
+	emailService   *emailservice.EmailService
+	consents       auth.ConsentTracker
+	auditLog       *audit.Writer
+	logger         *slog.Logger
 }
 
-// NewRBACService creates a new RBAC service
-func NewRBACService(client *ent.Client, logger *slog.Logger) *RBACService {
+// NewRBACService creates a new RBAC service. Effective permissions are cached
+// in Redis when cache is non-nil; call ListenForInvalidations to keep the
+// in-process cache in sync with other instances. A nil emailSvc disables
+// notifications. Organization tokens are only issued once the user has
+// accepted the documents consents requires; consents may be nil where no
+// tokens are issued, such as admin commands.
+func NewRBACService(client *ent.Client, cache *redis.Client, emailSvc *emailservice.EmailService, consents auth.ConsentTracker, logger *slog.Logger) *RBACService {
 	if logger == nil {
 		logger = slog.Default()
 	}
 
 	return &RBACService{
-		client: client,
-		logger: logger,
+		client:       client,
+		cache:        cache,
+		permissions:  NewPermissionCache(cache, config.PermissionCacheTTL, config.PermissionLocalCacheTTL, logger),
+		emailService: emailSvc,
+		consents:     consents,
+		auditLog:     audit.NewWriter(client, logger),
+		logger:       logger,
 	}
 }
 
+// ListenForInvalidations applies permission cache invalidations published by
+// other instances until ctx is done
+func (s *RBACService) ListenForInvalidations(ctx context.Context) {
+	s.permissions.Listen(ctx)
+}
+
+// InvalidateUserPermissions drops a user's cached permissions on every
+// instance, for changes made outside the RBAC service such as deleting the
+// user
+func (s *RBACService) InvalidateUserPermissions(ctx context.Context, userID uuid.UUID) {
+	s.permissions.InvalidateUser(ctx, userID)
+}
+
 // ListRoles returns all roles
 func (s *RBACService) ListRoles(ctx context.Context) ([]models.RoleResponse, error) {
 	entRoles, err := s.client.Roles.Query().All(ctx)
@@ -48,7 +86,7 @@ func (s *RBACService) ListRoles(ctx context.Context) ([]models.RoleResponse, err
 	return result, nil
 }
 
-// GetRole returns a role with its permissions
+// GetRole returns a role with its own and inherited permissions
 func (s *RBACService) GetRole(ctx context.Context, roleID int) (*models.RoleWithPermissionsResponse, error) {
 	role, err := s.client.Roles.Get(ctx, roleID)
 	if err != nil {
@@ -58,25 +96,41 @@ func (s *RBACService) GetRole(ctx context.Context, roleID int) (*models.RoleWith
 		return nil, fmt.Errorf("failed to get role: %w", err)
 	}
 
-	// Get role permissions
-	rolePerms, err := s.client.RolePermissions.Query().
-		Where(rolepermissions.RoleIDEQ(roleID)).
-		WithPermission().
+	parents, err := s.client.Roles.Query().
+		Where(roles.HasChildLinksWith(roleparents.RoleIDEQ(roleID))).
 		All(ctx)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+		return nil, fmt.Errorf("failed to get parent roles: %w", err)
 	}
 
-	perms := make([]models.PermissionResponse, len(rolePerms))
-	for i, rp := range rolePerms {
-		if rp.Edges.Permission != nil {
-			perms[i] = s.permissionToResponse(rp.Edges.Permission)
-		}
+	parentResponses := make([]models.RoleResponse, len(parents))
+	for i, parent := range parents {
+		parentResponses[i] = s.roleToResponse(parent)
+	}
+
+	approvers, err := s.client.Roles.Query().
+		Where(roles.HasApprovesLinksWith(roleapprovers.RoleIDEQ(roleID))).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get approver roles: %w", err)
+	}
+
+	approverResponses := make([]models.RoleResponse, len(approvers))
+	for i, approver := range approvers {
+		approverResponses[i] = s.roleToResponse(approver)
+	}
+
+	perms, err := s.resolvePermissions(ctx, []int{roleID})
+	if err != nil {
+		return nil, err
 	}
 
 	return &models.RoleWithPermissionsResponse{
 		RoleResponse: s.roleToResponse(role),
+		Parents:      parentResponses,
+		Approvers:    approverResponses,
 		Permissions:  perms,
 	}, nil
 }
@@ -107,7 +161,7 @@ func (s *RBACService) GetUserRoles(ctx context.Context, userID uuid.UUID) (*mode
 	}
 
 	userRolesList, err := s.client.UserRoles.Query().
-		Where(userroles.UserIDEQ(userID)).
+		Where(userroles.UserIDEQ(userID), activeAssignment()).
 		WithRole().
 		All(ctx)
 
@@ -116,94 +170,111 @@ func (s *RBACService) GetUserRoles(ctx context.Context, userID uuid.UUID) (*mode
 	}
 
 	roleResponses := make([]models.RoleResponse, 0, len(userRolesList))
+	assignments := make([]models.RoleAssignmentResponse, 0, len(userRolesList))
 	var latestAssignment time.Time
 
 	for _, ur := range userRolesList {
 		if ur.Edges.Role != nil {
 			roleResponses = append(roleResponses, s.roleToResponse(ur.Edges.Role))
+			assignments = append(assignments, models.RoleAssignmentResponse{
+				RoleID:     ur.RoleID,
+				RoleCode:   ur.Edges.Role.Code,
+				AssignedBy: ur.AssignedBy,
+				AssignedAt: ur.AssignedAt,
+				ExpiresAt:  ur.ExpiresAt,
+				Reason:     ur.Reason,
+			})
 			if ur.AssignedAt.After(latestAssignment) {
 				latestAssignment = ur.AssignedAt
 			}
 		}
 	}
 
+	groupRoles, err := s.userGroupRoleGrants(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &models.UserRolesResponse{
-		UserID:     userID,
-		Email:      user.Email,
-		Roles:      roleResponses,
-		AssignedAt: latestAssignment,
+		UserID:      userID,
+		Email:       user.Email,
+		Roles:       roleResponses,
+		Assignments: assignments,
+		GroupRoles:  groupRoles,
+		AssignedAt:  latestAssignment,
 	}, nil
 }
 
-// AssignRole assigns a role to a user
-func (s *RBACService) AssignRole(ctx context.Context, userID uuid.UUID, roleID int, actorID uuid.UUID) error {
-	// Check if user exists
-	exists, err := s.client.Users.Query().Where(users.IDEQ(userID)).Exist(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to check user: %w", err)
-	}
-	if !exists {
-		return fmt.Errorf("user not found")
+// AssignRole assigns a role to a user. Separation-of-duties constraints are
+// checked in the same transaction as the insert; overriding them requires
+// rbac.sod.override and a reason, and is audited as sod.override.
+func (s *RBACService) AssignRole(ctx context.Context, userID uuid.UUID, roleID int, actorID uuid.UUID, opts AssignRoleOptions) error {
+	if opts.ExpiresAt != nil && !opts.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("expires_at must be in the future")
 	}
 
-	// Check if role exists
-	role, err := s.client.Roles.Get(ctx, roleID)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return fmt.Errorf("role not found")
+	if opts.OverrideSoD {
+		if strings.TrimSpace(opts.Reason) == "" {
+			return fmt.Errorf("reason is required to override separation of duties")
 		}
-		return fmt.Errorf("failed to get role: %w", err)
-	}
 
-	// Check max_users constraint
-	if role.MaxUsers != nil {
-		count, err := s.client.UserRoles.Query().
-			Where(userroles.RoleIDEQ(roleID)).
-			Count(ctx)
+		allowed, err := s.HasPermission(ctx, actorID, "rbac.sod.override")
 		if err != nil {
-			return fmt.Errorf("failed to count role users: %w", err)
+			return err
 		}
-		if count >= *role.MaxUsers {
-			return fmt.Errorf("role has reached maximum users limit (%d)", *role.MaxUsers)
+		if !allowed {
+			return fmt.Errorf("missing permission to override separation of duties")
 		}
 	}
 
-	// Check if already assigned
-	exists, err = s.client.UserRoles.Query().
-		Where(
-			userroles.UserIDEQ(userID),
-			userroles.RoleIDEQ(roleID),
-		).
-		Exist(ctx)
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
 
+	assignment, overridden, err := s.grantRole(ctx, tx.Client(), userID, roleID, actorID, opts)
 	if err != nil {
-		return fmt.Errorf("failed to check existing assignment: %w", err)
+		tx.Rollback()
+		return err
 	}
 
-	if exists {
-		return fmt.Errorf("role already assigned to user")
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	// Create assignment
-	_, err = s.client.UserRoles.Create().
-		SetUserID(userID).
-		SetRoleID(roleID).
-		SetNillableAssignedBy(&actorID).
-		Save(ctx)
+	s.permissions.InvalidateUser(ctx, userID)
 
-	if err != nil {
-		return fmt.Errorf("failed to assign role: %w", err)
+	metadata := assignmentMetadata(assignment)
+	if len(overridden) > 0 {
+		metadata["sod_override"] = overridden
 	}
+	s.createAuditLog(ctx, actorID, "role.assign", "user_role", userID.String(), metadata)
 
-	// Create audit log
-	s.createAuditLog(ctx, actorID, "role.assign", "user_role", userID.String(), map[string]interface{}{
-		"user_id": userID.String(),
-		"role_id": roleID,
-	})
+	if len(overridden) > 0 {
+		s.createAuditLog(ctx, actorID, "sod.override", "user_role", userID.String(), map[string]interface{}{
+			"user_id":     userID.String(),
+			"role_id":     roleID,
+			"constraints": overridden,
+			"reason":      opts.Reason,
+		})
+	}
 
 	return nil
 }
 
+// AssignRoles assigns several permanent roles to a user as AssignRole does,
+// skipping roles the user already holds directly. It stops at the first role
+// that cannot be assigned.
+func (s *RBACService) AssignRoles(ctx context.Context, userID uuid.UUID, roleIDs []int, actorID uuid.UUID) error {
+	for _, roleID := range uniqueInts(roleIDs) {
+		err := s.AssignRole(ctx, userID, roleID, actorID, AssignRoleOptions{})
+		if err != nil && !errors.Is(err, ErrRoleAlreadyAssigned) {
+			return err
+		}
+	}
+	return nil
+}
+
 // RemoveRole removes a role from a user
 func (s *RBACService) RemoveRole(ctx context.Context, userID uuid.UUID, roleID int, actorID uuid.UUID) error {
 	// Find the assignment
@@ -227,6 +298,8 @@ func (s *RBACService) RemoveRole(ctx context.Context, userID uuid.UUID, roleID i
 		return fmt.Errorf("failed to remove role: %w", err)
 	}
 
+	s.permissions.InvalidateUser(ctx, userID)
+
 	// Create audit log
 	s.createAuditLog(ctx, actorID, "role.remove", "user_role", userID.String(), map[string]interface{}{
 		"user_id": userID.String(),
@@ -238,55 +311,285 @@ func (s *RBACService) RemoveRole(ctx context.Context, userID uuid.UUID, roleID i
 
 // GetUserPermissions returns computed permissions for a user
 func (s *RBACService) GetUserPermissions(ctx context.Context, userID uuid.UUID) (*models.UserPermissionsResponse, error) {
-	// Get all user roles
-	userRolesList, err := s.client.UserRoles.Query().
-		Where(userroles.UserIDEQ(userID)).
-		All(ctx)
+	perms, err := s.permissions.Get(ctx, userID, func(ctx context.Context) ([]models.PermissionResponse, error) {
+		return s.LoadUserPermissions(ctx, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.UserPermissionsResponse{
+		UserID:      userID,
+		Permissions: perms,
+	}, nil
+}
 
+// LoadUserPermissions computes a user's permissions from the database,
+// bypassing the cache. Roles held through groups count like assigned roles.
+func (s *RBACService) LoadUserPermissions(ctx context.Context, userID uuid.UUID) ([]models.PermissionResponse, error) {
+	roleIDs, err := userRoleIDs(ctx, s.client, userID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user roles: %w", err)
+		return nil, err
 	}
 
-	if len(userRolesList) == 0 {
-		return &models.UserPermissionsResponse{
-			UserID:      userID,
-			Permissions: []models.PermissionResponse{},
-		}, nil
+	if len(roleIDs) == 0 {
+		return []models.PermissionResponse{}, nil
 	}
 
-	// Get role IDs
-	roleIDs := make([]int, len(userRolesList))
-	for i, ur := range userRolesList {
-		roleIDs[i] = ur.RoleID
+	return s.resolvePermissions(ctx, roleIDs)
+}
+
+// resolvePermissions returns the union of the permissions granted by the
+// given roles and every role they inherit from. Each permission lists the
+// roles that grant it, nearest in the inheritance chain first.
+func (s *RBACService) resolvePermissions(ctx context.Context, roleIDs []int) ([]models.PermissionResponse, error) {
+	hierarchy, err := LoadRoleHierarchy(ctx, s.client)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get all permissions for these roles
-	rolePerms, err := s.client.RolePermissions.Query().
-		Where(rolepermissions.RoleIDIn(roleIDs...)).
-		WithPermission().
+	chain := hierarchy.Ancestors(roleIDs...)
+
+	chainRoles, err := s.client.Roles.Query().
+		Where(roles.IDIn(chain...)).
+		WithRolePermissions(func(q *ent.RolePermissionsQuery) {
+			q.WithPermission()
+		}).
 		All(ctx)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get role permissions: %w", err)
 	}
 
-	// Deduplicate permissions
-	permMap := make(map[int]*ent.Permissions)
-	for _, rp := range rolePerms {
-		if rp.Edges.Permission != nil {
-			permMap[rp.PermissionID] = rp.Edges.Permission
+	rolesByID := make(map[int]*ent.Roles, len(chainRoles))
+	for _, role := range chainRoles {
+		rolesByID[role.ID] = role
+	}
+
+	// Walk the chain in order so GrantedBy lists the nearest role first
+	index := make(map[int]int)
+	unconditional := make(map[int]bool)
+	perms := make([]models.PermissionResponse, 0)
+	for _, id := range chain {
+		role, ok := rolesByID[id]
+		if !ok {
+			continue
+		}
+
+		for _, rp := range role.Edges.RolePermissions {
+			perm := rp.Edges.Permission
+			if perm == nil {
+				continue
+			}
+
+			i, ok := index[perm.ID]
+			if !ok {
+				i = len(perms)
+				index[perm.ID] = i
+				perms = append(perms, s.permissionToResponse(perm))
+			}
+			perms[i].GrantedBy = append(perms[i].GrantedBy, role.Code)
+
+			if rp.Condition == "" {
+				unconditional[perm.ID] = true
+			} else {
+				perms[i].Conditions = append(perms[i].Conditions, models.PermissionCondition{
+					Role:      role.Code,
+					Condition: rp.Condition,
+				})
+			}
 		}
 	}
 
-	perms := make([]models.PermissionResponse, 0, len(permMap))
-	for _, perm := range permMap {
-		perms = append(perms, s.permissionToResponse(perm))
+	for i := range perms {
+		perms[i].Conditional = !unconditional[perms[i].ID]
 	}
 
-	return &models.UserPermissionsResponse{
-		UserID:      userID,
-		Permissions: perms,
-	}, nil
+	return perms, nil
+}
+
+// UpdateRoleParents replaces the roles a role inherits permissions from
+func (s *RBACService) UpdateRoleParents(ctx context.Context, roleID int, parentIDs []int, actorID uuid.UUID) error {
+	role, err := s.client.Roles.Get(ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("role not found")
+		}
+		return fmt.Errorf("failed to get role: %w", err)
+	}
+
+	if role.IsSystem {
+		return fmt.Errorf("cannot modify parents of system role")
+	}
+
+	parentIDs = uniqueInts(parentIDs)
+	for _, parentID := range parentIDs {
+		if parentID == roleID {
+			return fmt.Errorf("role cannot inherit from itself")
+		}
+	}
+
+	if err := s.checkRolesExist(ctx, parentIDs, "parent role not found"); err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Check for cycles against the hierarchy inside the transaction
+	hierarchy, err := LoadRoleHierarchy(ctx, tx.Client())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if hierarchy.WouldCycle(roleID, parentIDs) {
+		tx.Rollback()
+		return fmt.Errorf("role inheritance would create a cycle")
+	}
+
+	previous := hierarchy[roleID]
+
+	_, err = tx.RoleParents.Delete().
+		Where(roleparents.RoleIDEQ(roleID)).
+		Exec(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to clear parent roles: %w", err)
+	}
+
+	for _, parentID := range parentIDs {
+		_, err := tx.RoleParents.Create().
+			SetRoleID(roleID).
+			SetParentRoleID(parentID).
+			Save(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to add parent role %d: %w", parentID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to update parent roles: %w", err)
+	}
+
+	s.permissions.InvalidateAll(ctx)
+
+	// Create audit log
+	s.createAuditLog(ctx, actorID, "role.parents.update", "role", fmt.Sprintf("%d", roleID), map[string]interface{}{
+		"role_id":             roleID,
+		"parent_role_ids":     parentIDs,
+		"previous_parent_ids": previous,
+	})
+
+	return nil
+}
+
+// UpdateRoleApprovers replaces the roles whose holders approve or deny
+// requests for a role
+func (s *RBACService) UpdateRoleApprovers(ctx context.Context, roleID int, approverIDs []int, actorID uuid.UUID) error {
+	role, err := s.client.Roles.Get(ctx, roleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("role not found")
+		}
+		return fmt.Errorf("failed to get role: %w", err)
+	}
+
+	if role.IsSystem {
+		return fmt.Errorf("cannot modify approvers of system role")
+	}
+
+	approverIDs = uniqueInts(approverIDs)
+	if err := s.checkRolesExist(ctx, approverIDs, "approver role not found"); err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	previous, err := tx.RoleApprovers.Query().
+		Where(roleapprovers.RoleIDEQ(roleID)).
+		Select(roleapprovers.FieldApproverRoleID).
+		Ints(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to get approver roles: %w", err)
+	}
+
+	_, err = tx.RoleApprovers.Delete().
+		Where(roleapprovers.RoleIDEQ(roleID)).
+		Exec(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to clear approver roles: %w", err)
+	}
+
+	for _, approverID := range approverIDs {
+		_, err := tx.RoleApprovers.Create().
+			SetRoleID(roleID).
+			SetApproverRoleID(approverID).
+			Save(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to add approver role %d: %w", approverID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to update approver roles: %w", err)
+	}
+
+	s.createAuditLog(ctx, actorID, "role.approvers.update", "role", fmt.Sprintf("%d", roleID), map[string]interface{}{
+		"role_id":               roleID,
+		"approver_role_ids":     approverIDs,
+		"previous_approver_ids": previous,
+	})
+
+	return nil
+}
+
+// HasPermission reports whether any of a user's roles grants a permission,
+// honouring the "*" and "prefix.*" wildcard codes. Grants with a condition
+// are ignored because there is no request to evaluate them against; use
+// Check for those.
+func (s *RBACService) HasPermission(ctx context.Context, userID uuid.UUID, permission string) (bool, error) {
+	userPerms, err := s.GetUserPermissions(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, perm := range userPerms.Permissions {
+		if !perm.Conditional && PermissionMatches(perm.Code, permission) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// PermissionMatches reports whether a granted permission code satisfies a
+// required one. "*" matches everything and "users.*" matches "users" and any
+// code starting with "users.".
+func PermissionMatches(granted, required string) bool {
+	if granted == "*" || granted == required {
+		return true
+	}
+
+	if prefix, ok := strings.CutSuffix(granted, ".*"); ok {
+		return required == prefix || strings.HasPrefix(required, prefix+".")
+	}
+
+	return false
 }
 
 // UpdateRolePermissions updates permissions for a role
@@ -346,6 +649,8 @@ func (s *RBACService) UpdateRolePermissions(ctx context.Context, roleID int, per
 		}
 	}
 
+	s.permissions.InvalidateAll(ctx)
+
 	// Create audit log
 	s.createAuditLog(ctx, actorID, "role.permissions.update", "role", fmt.Sprintf("%d", roleID), map[string]interface{}{
 		"role_id":        roleID,
@@ -379,6 +684,13 @@ func (s *RBACService) GetAuditLogs(ctx context.Context, filter *models.AuditLogF
 		query = query.Where(auditlogs.ResourceIDEQ(filter.ResourceID))
 	}
 
+	if filter.OrgID != "" {
+		orgUUID, err := uuid.Parse(filter.OrgID)
+		if err == nil {
+			query = query.Where(auditlogs.OrgIDEQ(orgUUID))
+		}
+	}
+
 	// Pagination
 	if filter.Limit == 0 {
 		filter.Limit = 50
@@ -407,17 +719,31 @@ func (s *RBACService) GetAuditLogs(ctx context.Context, filter *models.AuditLogF
 
 // Helper functions
 
+func uniqueInts(values []int) []int {
+	seen := make(map[int]bool)
+	result := make([]int, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
 func (s *RBACService) roleToResponse(role *ent.Roles) models.RoleResponse {
 	return models.RoleResponse{
-		ID:          role.ID,
-		Code:        role.Code,
-		Name:        role.Name,
-		Description: role.Description,
-		IsSystem:    role.IsSystem,
-		IsDefault:   role.IsDefault,
-		MaxUsers:    role.MaxUsers,
-		CreatedAt:   role.CreatedAt,
-		UpdatedAt:   role.UpdatedAt,
+		ID:            role.ID,
+		Code:          role.Code,
+		Name:          role.Name,
+		Description:   role.Description,
+		IsSystem:      role.IsSystem,
+		IsDefault:     role.IsDefault,
+		MaxUsers:      role.MaxUsers,
+		OrgAssignable: role.OrgAssignable,
+		BreakGlass:    string(role.BreakGlass),
+		CreatedAt:     role.CreatedAt,
+		UpdatedAt:     role.UpdatedAt,
 	}
 }
 
@@ -429,6 +755,7 @@ func (s *RBACService) permissionToResponse(perm *ent.Permissions) models.Permiss
 		Description: perm.Description,
 		Resource:    perm.Resource,
 		Action:      perm.Action,
+		IsSystem:    perm.IsSystem,
 		CreatedAt:   perm.CreatedAt,
 	}
 }
@@ -444,24 +771,39 @@ func (s *RBACService) auditLogToResponse(log *ent.AuditLogs) models.AuditLogResp
 		Changes:      log.Changes,
 		IPAddress:    log.IPAddress,
 		UserAgent:    log.UserAgent,
+		OrgID:        log.OrgID,
 		CreatedAt:    log.CreatedAt,
 	}
 }
 
 func (s *RBACService) createAuditLog(ctx context.Context, actorID uuid.UUID, actionType, resourceType, resourceID string, metadata map[string]interface{}) {
-	_, err := s.client.AuditLogs.Create().
-		SetActorID(actorID).
-		SetActionType(actionType).
-		SetResourceType(resourceType).
-		SetNillableResourceID(&resourceID).
-		SetMetadata(metadata).
-		Save(ctx)
+	s.saveAuditLog(ctx, &actorID, nil, actionType, resourceType, resourceID, metadata, nil)
+}
 
-	if err != nil {
-		s.logger.Error("Failed to create audit log",
-			"actor_id", actorID,
-			"action", actionType,
-			"error", err,
-		)
-	}
+// createAuditLogWithChanges records an audit log with before/after values in changes
+func (s *RBACService) createAuditLogWithChanges(ctx context.Context, actorID uuid.UUID, actionType, resourceType, resourceID string, metadata, changes map[string]interface{}) {
+	s.saveAuditLog(ctx, &actorID, nil, actionType, resourceType, resourceID, metadata, changes)
+}
+
+// createSystemAuditLog records an action taken by the server itself, without an actor
+func (s *RBACService) createSystemAuditLog(ctx context.Context, actionType, resourceType, resourceID string, metadata map[string]interface{}) {
+	s.saveAuditLog(ctx, nil, nil, actionType, resourceType, resourceID, metadata, nil)
+}
+
+// createOrgAuditLog records an action taken within an organization so it
+// shows up in the organization's audit log
+func (s *RBACService) createOrgAuditLog(ctx context.Context, orgID, actorID uuid.UUID, actionType, resourceType, resourceID string, metadata, changes map[string]interface{}) {
+	s.saveAuditLog(ctx, &actorID, &orgID, actionType, resourceType, resourceID, metadata, changes)
+}
+
+func (s *RBACService) saveAuditLog(ctx context.Context, actorID, orgID *uuid.UUID, actionType, resourceType, resourceID string, metadata, changes map[string]interface{}) {
+	s.auditLog.Write(ctx, audit.Entry{
+		ActorID:      actorID,
+		OrgID:        orgID,
+		ActionType:   actionType,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Metadata:     metadata,
+		Changes:      changes,
+	})
 }
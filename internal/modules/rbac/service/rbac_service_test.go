@@ -0,0 +1,33 @@
+package service
+
+import "testing"
+
+func TestPermissionMatches(t *testing.T) {
+	tests := []struct {
+		granted  string
+		required string
+		want     bool
+	}{
+		{"*", "users.read", true},
+		{"*", "*", true},
+		{"users.read", "users.read", true},
+		{"users.read", "users.write", false},
+		{"users.*", "users", true},
+		{"users.*", "users.read", true},
+		{"users.*", "users.read.self", true},
+		{"users.*", "usersx.read", false},
+		{"users.*", "roles.read", false},
+		{"users.read.*", "users.read.self", true},
+		{"users.read.*", "users.write", false},
+		{"users", "users.read", false},
+		{"users.read", "users.*", false},
+		{"users.read", "*", false},
+		{"", "users.read", false},
+	}
+
+	for _, tt := range tests {
+		if got := PermissionMatches(tt.granted, tt.required); got != tt.want {
+			t.Errorf("PermissionMatches(%q, %q) = %v, want %v", tt.granted, tt.required, got, tt.want)
+		}
+	}
+}
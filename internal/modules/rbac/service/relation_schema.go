@@ -0,0 +1,156 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Object types and relations are snake_case, e.g. "document" or "can_edit"
+var relationNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// RelationSchema maps object types to their relations. It is loaded from
+// RELATION_SCHEMA_FILE, e.g.
+//
+//	document:
+//	  owner: {}
+//	  parent: {}
+//	  editor:
+//	    implied_by: [owner]
+//	  viewer:
+//	    implied_by: [editor]
+//	    from:
+//	      - via: parent
+//	        relation: viewer
+//	group:
+//	  member: {}
+//
+// Here owners are editors, editors are viewers, and viewers of a document's
+// parent folder are viewers of the document. With a schema loaded, tuples may
+// only use the types and relations it defines.
+type RelationSchema map[string]map[string]RelationRule
+
+// RelationRule lists how a relation is computed besides its own tuples
+type RelationRule struct {
+	ImpliedBy []string       `yaml:"implied_by"` // Relations on the same object that include this one
+	From      []RelationFrom `yaml:"from"`       // Relations inherited through related objects
+}
+
+// RelationFrom includes the subjects holding Relation on every object the
+// object is related to through Via
+type RelationFrom struct {
+	Via      string `yaml:"via"`
+	Relation string `yaml:"relation"`
+}
+
+// LoadRelationSchema reads and validates a relation schema file
+func LoadRelationSchema(path string) (RelationSchema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read relation schema: %w", err)
+	}
+
+	var schema RelationSchema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse relation schema: %w", err)
+	}
+
+	if err := schema.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid relation schema: %w", err)
+	}
+	return schema, nil
+}
+
+// Validate checks names and that rules only refer to defined relations
+func (s RelationSchema) Validate() error {
+	for objectType, relations := range s {
+		if !relationNamePattern.MatchString(objectType) {
+			return fmt.Errorf("invalid object type %q", objectType)
+		}
+
+		for relation, rule := range relations {
+			if !relationNamePattern.MatchString(relation) {
+				return fmt.Errorf("invalid relation %q on %s", relation, objectType)
+			}
+
+			for _, implied := range rule.ImpliedBy {
+				if _, ok := relations[implied]; !ok {
+					return fmt.Errorf("%s#%s is implied by undefined relation %q", objectType, relation, implied)
+				}
+			}
+
+			for _, from := range rule.From {
+				if _, ok := relations[from.Via]; !ok {
+					return fmt.Errorf("%s#%s inherits through undefined relation %q", objectType, relation, from.Via)
+				}
+				if !relationNamePattern.MatchString(from.Relation) {
+					return fmt.Errorf("%s#%s inherits invalid relation %q", objectType, relation, from.Relation)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// rule returns the rule of a relation; undefined relations have none
+func (s RelationSchema) rule(objectType, relation string) RelationRule {
+	return s[objectType][relation]
+}
+
+// checkRelation rejects types and relations the schema does not define. Any
+// name is accepted when no schema is loaded.
+func (s RelationSchema) checkRelation(objectType, relation string) error {
+	if s == nil {
+		return nil
+	}
+
+	relations, ok := s[objectType]
+	if !ok {
+		return fmt.Errorf("unknown object type %q", objectType)
+	}
+	if _, ok := relations[relation]; !ok {
+		return fmt.Errorf("unknown relation %q on %s", relation, objectType)
+	}
+	return nil
+}
+
+// implying returns the relations on objectType that relation implies, i.e.
+// those listing it in implied_by
+func (s RelationSchema) implying(objectType, relation string) []string {
+	var result []string
+	for name, rule := range s[objectType] {
+		if slices.Contains(rule.ImpliedBy, relation) {
+			result = append(result, name)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// inheriting returns the object types and relations that inherit relation
+// through a from rule
+func (s RelationSchema) inheriting(relation string) []relationInheritance {
+	var result []relationInheritance
+	for objectType, relations := range s {
+		for name, rule := range relations {
+			for _, from := range rule.From {
+				if from.Relation == relation {
+					result = append(result, relationInheritance{objectType: objectType, relation: name, via: from.Via})
+				}
+			}
+		}
+	}
+	return result
+}
+
+// relationInheritance says objectType#relation includes holders of a
+// relation on the objects related through via
+type relationInheritance struct {
+	objectType string
+	relation   string
+	via        string
+}
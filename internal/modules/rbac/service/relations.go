@@ -0,0 +1,777 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/relationtuples"
+	"github.com/shammianand/go-auth/internal/config"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+)
+
+const (
+	// relationRevisionKey is bumped after every relation write. Consistency
+	// tokens carry the revision and cached checks are keyed by it.
+	relationRevisionKey = "rbac:relations:revision"
+
+	relationCheckCachePrefix = "rbac:relations:check:"
+
+	// maxRelationWriteBatch bounds the tuples changed in one write
+	maxRelationWriteBatch = 100
+
+	// maxRelationDepth and maxRelationQueries bound a single traversal
+	maxRelationDepth   = 25
+	maxRelationQueries = 1000
+)
+
+// Object IDs may not contain the ":", "#" and "@" separators
+var relationIDPattern = regexp.MustCompile(`^[A-Za-z0-9_\-.|=+/]+$`)
+
+// relationObject is an object such as document:42
+type relationObject struct {
+	Type string
+	ID   string
+}
+
+func (o relationObject) String() string {
+	return o.Type + ":" + o.ID
+}
+
+// relationSubject is an object, or a userset such as group:eng#member when
+// Relation is set
+type relationSubject struct {
+	relationObject
+	Relation string
+}
+
+func (s relationSubject) String() string {
+	if s.Relation == "" {
+		return s.relationObject.String()
+	}
+	return s.relationObject.String() + "#" + s.Relation
+}
+
+// relationTuple states that Subject has Relation to Object
+type relationTuple struct {
+	Object   relationObject
+	Relation string
+	Subject  relationSubject
+}
+
+func (t relationTuple) String() string {
+	return t.Object.String() + "#" + t.Relation + "@" + t.Subject.String()
+}
+
+// WriteRelations adds and removes relation tuples in one transaction and
+// returns a consistency token for the new state. Adding an existing tuple or
+// removing a missing one is not an error.
+func (s *RBACService) WriteRelations(ctx context.Context, req *models.WriteRelationsRequest, actorID uuid.UUID) (*models.WriteRelationsResponse, error) {
+	total := len(req.Writes) + len(req.Deletes)
+	if total == 0 {
+		return nil, fmt.Errorf("no relation changes")
+	}
+	if total > maxRelationWriteBatch {
+		return nil, fmt.Errorf("too many relation changes (max %d)", maxRelationWriteBatch)
+	}
+
+	writes, err := s.parseRelationTuples(req.Writes)
+	if err != nil {
+		return nil, err
+	}
+	deletes, err := s.parseRelationTuples(req.Deletes)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if len(writes) > 0 {
+		creates := make([]*ent.RelationTuplesCreate, len(writes))
+		for i, tuple := range writes {
+			creates[i] = tx.RelationTuples.Create().
+				SetObjectType(tuple.Object.Type).
+				SetObjectID(tuple.Object.ID).
+				SetRelation(tuple.Relation).
+				SetSubjectType(tuple.Subject.Type).
+				SetSubjectID(tuple.Subject.ID).
+				SetSubjectRelation(tuple.Subject.Relation)
+		}
+
+		err := tx.RelationTuples.CreateBulk(creates...).
+			OnConflict().
+			DoNothing().
+			Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to write relations: %w", err)
+		}
+	}
+
+	deleted := 0
+	for _, tuple := range deletes {
+		n, err := tx.RelationTuples.Delete().
+			Where(tupleEquals(tuple)).
+			Exec(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to delete relation: %w", err)
+		}
+		deleted += n
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	revision := s.bumpRelationRevision(ctx)
+
+	s.createAuditLog(ctx, actorID, "relation.write", "relation", "", map[string]interface{}{
+		"writes":   tupleStrings(writes),
+		"deletes":  tupleStrings(deletes),
+		"revision": revision,
+	})
+
+	return &models.WriteRelationsResponse{
+		Written:          len(writes),
+		Deleted:          deleted,
+		ConsistencyToken: encodeRelationToken(revision),
+	}, nil
+}
+
+// ReadRelations lists stored relation tuples
+func (s *RBACService) ReadRelations(ctx context.Context, filter *models.RelationFilter) ([]models.RelationTupleResponse, error) {
+	query := s.client.RelationTuples.Query()
+
+	if filter.Object != "" {
+		if strings.Contains(filter.Object, ":") {
+			object, err := parseRelationObject(filter.Object)
+			if err != nil {
+				return nil, err
+			}
+			query = query.Where(
+				relationtuples.ObjectTypeEQ(object.Type),
+				relationtuples.ObjectIDEQ(object.ID),
+			)
+		} else {
+			query = query.Where(relationtuples.ObjectTypeEQ(filter.Object))
+		}
+	}
+
+	if filter.Relation != "" {
+		query = query.Where(relationtuples.RelationEQ(filter.Relation))
+	}
+
+	if filter.Subject != "" {
+		subject, err := parseRelationSubject(filter.Subject)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(subjectEquals(subject))
+	}
+
+	limit := filter.Limit
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+
+	tuples, err := query.
+		Order(ent.Asc(relationtuples.FieldObjectType), ent.Asc(relationtuples.FieldObjectID), ent.Asc(relationtuples.FieldID)).
+		Limit(limit).
+		Offset(filter.Offset).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query relations: %w", err)
+	}
+
+	result := make([]models.RelationTupleResponse, len(tuples))
+	for i, row := range tuples {
+		tuple := tupleFromRow(row)
+		result[i] = models.RelationTupleResponse{
+			Tuple:     tuple.String(),
+			Object:    tuple.Object.String(),
+			Relation:  tuple.Relation,
+			Subject:   tuple.Subject.String(),
+			CreatedAt: row.CreatedAt,
+		}
+	}
+
+	return result, nil
+}
+
+// CheckRelation reports whether a subject has a relation to an object,
+// directly, through usersets, or through the relation schema
+func (s *RBACService) CheckRelation(ctx context.Context, req *models.RelationCheckRequest) (*models.RelationCheckResponse, error) {
+	object, err := parseRelationObject(req.Object)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateRelationName(object.Type, req.Relation); err != nil {
+		return nil, err
+	}
+	subject, err := parseRelationSubject(req.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, cacheable, err := s.relationSnapshot(ctx, req.ConsistencyToken)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("%s%d:%s#%s@%s", relationCheckCachePrefix, revision, object, req.Relation, subject)
+	if cacheable {
+		if cached, err := s.cache.Get(ctx, cacheKey).Result(); err == nil {
+			return &models.RelationCheckResponse{
+				Allowed:          cached == "1",
+				ConsistencyToken: encodeRelationToken(revision),
+			}, nil
+		}
+	}
+
+	e := s.newRelationEvaluator()
+	allowed, err := e.check(ctx, object, req.Relation, subject, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if cacheable {
+		value := "0"
+		if allowed {
+			value = "1"
+		}
+		if err := s.cache.Set(ctx, cacheKey, value, config.RelationCacheTTL).Err(); err != nil {
+			s.logger.Warn("Failed to cache relation check", "error", err)
+		}
+	}
+
+	return &models.RelationCheckResponse{
+		Allowed:          allowed,
+		ConsistencyToken: encodeRelationToken(revision),
+	}, nil
+}
+
+// ListObjects returns the IDs of the objects of a type that a subject has a
+// relation to. It walks the tuple graph backwards from the subject, so its
+// cost depends on what the subject can reach rather than on the number of
+// objects.
+func (s *RBACService) ListObjects(ctx context.Context, req *models.ListObjectsRequest) (*models.ListObjectsResponse, error) {
+	if err := s.validateRelationName(req.ObjectType, req.Relation); err != nil {
+		return nil, err
+	}
+	subject, err := parseRelationSubject(req.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, _, err := s.relationSnapshot(ctx, req.ConsistencyToken)
+	if err != nil {
+		return nil, err
+	}
+
+	e := s.newRelationEvaluator()
+	objects, err := e.reachable(ctx, subject, req.ObjectType, req.Relation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ListObjectsResponse{
+		Objects:          objects,
+		ConsistencyToken: encodeRelationToken(revision),
+	}, nil
+}
+
+// ExpandRelation returns the tree of subjects and usersets holding a relation
+// to an object
+func (s *RBACService) ExpandRelation(ctx context.Context, req *models.ExpandRelationRequest) (*models.ExpandRelationResponse, error) {
+	object, err := parseRelationObject(req.Object)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateRelationName(object.Type, req.Relation); err != nil {
+		return nil, err
+	}
+
+	revision, _, err := s.relationSnapshot(ctx, req.ConsistencyToken)
+	if err != nil {
+		return nil, err
+	}
+
+	e := s.newRelationEvaluator()
+	tree, err := e.expand(ctx, object, req.Relation, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ExpandRelationResponse{
+		Tree:             tree,
+		ConsistencyToken: encodeRelationToken(revision),
+	}, nil
+}
+
+// SetRelationSchema sets the schema used to validate tuples and compute
+// implied relations. A nil schema accepts any type and relation.
+func (s *RBACService) SetRelationSchema(schema RelationSchema) {
+	s.relationSchema = schema
+}
+
+// relationSnapshot returns the current relation revision and whether cached
+// checks may be used. A consistency token newer than the revision known to
+// Redis, e.g. after Redis lost its data, bypasses the cache.
+func (s *RBACService) relationSnapshot(ctx context.Context, token string) (int64, bool, error) {
+	minimum := int64(0)
+	if token != "" {
+		var err error
+		minimum, err = decodeRelationToken(token)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
+	if s.cache == nil {
+		return minimum, false, nil
+	}
+
+	revision, err := s.cache.Get(ctx, relationRevisionKey).Int64()
+	if err != nil && err != redis.Nil {
+		s.logger.Warn("Failed to read relation revision", "error", err)
+		return minimum, false, nil
+	}
+
+	if revision < minimum {
+		return minimum, false, nil
+	}
+	return revision, config.RelationCacheTTL > 0, nil
+}
+
+// bumpRelationRevision advances the relation revision after a write
+func (s *RBACService) bumpRelationRevision(ctx context.Context) int64 {
+	if s.cache == nil {
+		return 0
+	}
+
+	revision, err := s.cache.Incr(ctx, relationRevisionKey).Result()
+	if err != nil {
+		s.logger.Error("Failed to bump relation revision", "error", err)
+		return 0
+	}
+	return revision
+}
+
+func encodeRelationToken(revision int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("rv:" + strconv.FormatInt(revision, 10)))
+}
+
+func decodeRelationToken(token string) (int64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("invalid consistency token")
+	}
+
+	value, ok := strings.CutPrefix(string(data), "rv:")
+	if !ok {
+		return 0, fmt.Errorf("invalid consistency token")
+	}
+
+	revision, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || revision < 0 {
+		return 0, fmt.Errorf("invalid consistency token")
+	}
+	return revision, nil
+}
+
+// parseRelationTuples parses and validates tuples against the schema
+func (s *RBACService) parseRelationTuples(requests []models.RelationTupleRequest) ([]relationTuple, error) {
+	tuples := make([]relationTuple, len(requests))
+	for i, req := range requests {
+		object, err := parseRelationObject(req.Object)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.validateRelationName(object.Type, req.Relation); err != nil {
+			return nil, err
+		}
+
+		subject, err := parseRelationSubject(req.Subject)
+		if err != nil {
+			return nil, err
+		}
+		if subject.Relation != "" {
+			if err := s.relationSchema.checkRelation(subject.Type, subject.Relation); err != nil {
+				return nil, err
+			}
+		}
+
+		tuples[i] = relationTuple{Object: object, Relation: req.Relation, Subject: subject}
+	}
+	return tuples, nil
+}
+
+// validateRelationName checks a relation name and, with a schema loaded,
+// that the object type defines it
+func (s *RBACService) validateRelationName(objectType, relation string) error {
+	if !relationNamePattern.MatchString(objectType) {
+		return fmt.Errorf("invalid object type %q", objectType)
+	}
+	if !relationNamePattern.MatchString(relation) {
+		return fmt.Errorf("invalid relation %q", relation)
+	}
+	return s.relationSchema.checkRelation(objectType, relation)
+}
+
+// parseRelationObject parses "type:id"
+func parseRelationObject(value string) (relationObject, error) {
+	objectType, id, ok := strings.Cut(value, ":")
+	if !ok || !relationNamePattern.MatchString(objectType) || !relationIDPattern.MatchString(id) {
+		return relationObject{}, fmt.Errorf("invalid object %q, expected type:id", value)
+	}
+	return relationObject{Type: objectType, ID: id}, nil
+}
+
+// parseRelationSubject parses "type:id" or a userset "type:id#relation"
+func parseRelationSubject(value string) (relationSubject, error) {
+	objectPart, relation, hasRelation := strings.Cut(value, "#")
+
+	object, err := parseRelationObject(objectPart)
+	if err != nil || (hasRelation && !relationNamePattern.MatchString(relation)) {
+		return relationSubject{}, fmt.Errorf("invalid subject %q, expected type:id or type:id#relation", value)
+	}
+	return relationSubject{relationObject: object, Relation: relation}, nil
+}
+
+func tupleFromRow(row *ent.RelationTuples) relationTuple {
+	return relationTuple{
+		Object:   relationObject{Type: row.ObjectType, ID: row.ObjectID},
+		Relation: row.Relation,
+		Subject: relationSubject{
+			relationObject: relationObject{Type: row.SubjectType, ID: row.SubjectID},
+			Relation:       row.SubjectRelation,
+		},
+	}
+}
+
+func tupleEquals(tuple relationTuple) predicate.RelationTuples {
+	return relationtuples.And(
+		relationtuples.ObjectTypeEQ(tuple.Object.Type),
+		relationtuples.ObjectIDEQ(tuple.Object.ID),
+		relationtuples.RelationEQ(tuple.Relation),
+		subjectEquals(tuple.Subject),
+	)
+}
+
+func subjectEquals(subject relationSubject) predicate.RelationTuples {
+	return relationtuples.And(
+		relationtuples.SubjectTypeEQ(subject.Type),
+		relationtuples.SubjectIDEQ(subject.ID),
+		relationtuples.SubjectRelationEQ(subject.Relation),
+	)
+}
+
+func tupleStrings(tuples []relationTuple) []string {
+	result := make([]string, len(tuples))
+	for i, tuple := range tuples {
+		result[i] = tuple.String()
+	}
+	return result
+}
+
+// relationQuery selects tuples for the evaluator. Empty fields match
+// anything; Subject, when set, must match exactly.
+type relationQuery struct {
+	ObjectType string
+	ObjectID   string
+	Relation   string
+	Subject    *relationSubject
+}
+
+// relationStore loads the tuples the evaluator walks
+type relationStore interface {
+	findRelations(ctx context.Context, query relationQuery) ([]relationTuple, error)
+}
+
+// entRelationStore loads tuples from the database
+type entRelationStore struct {
+	client *ent.Client
+}
+
+func (s entRelationStore) findRelations(ctx context.Context, query relationQuery) ([]relationTuple, error) {
+	var where []predicate.RelationTuples
+	if query.ObjectType != "" {
+		where = append(where, relationtuples.ObjectTypeEQ(query.ObjectType))
+	}
+	if query.ObjectID != "" {
+		where = append(where, relationtuples.ObjectIDEQ(query.ObjectID))
+	}
+	if query.Relation != "" {
+		where = append(where, relationtuples.RelationEQ(query.Relation))
+	}
+	if query.Subject != nil {
+		where = append(where, subjectEquals(*query.Subject))
+	}
+
+	rows, err := s.client.RelationTuples.Query().
+		Where(where...).
+		Order(ent.Asc(relationtuples.FieldID)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query relations: %w", err)
+	}
+
+	result := make([]relationTuple, len(rows))
+	for i, row := range rows {
+		result[i] = tupleFromRow(row)
+	}
+	return result, nil
+}
+
+// relationEvaluator walks the tuple graph for one request, bounding the
+// number of queries so a deep or cyclic graph cannot run away
+type relationEvaluator struct {
+	store   relationStore
+	schema  RelationSchema
+	queries int
+
+	// visiting holds the usersets on the current path; revisiting one is a
+	// cycle and contributes nothing
+	visiting map[string]bool
+}
+
+func (s *RBACService) newRelationEvaluator() *relationEvaluator {
+	return newRelationEvaluator(entRelationStore{client: s.client}, s.relationSchema)
+}
+
+func newRelationEvaluator(store relationStore, schema RelationSchema) *relationEvaluator {
+	return &relationEvaluator{
+		store:    store,
+		schema:   schema,
+		visiting: make(map[string]bool),
+	}
+}
+
+// enter marks a userset as on the current path, reporting false if it
+// already is
+func (e *relationEvaluator) enter(object relationObject, relation string) bool {
+	key := object.String() + "#" + relation
+	if e.visiting[key] {
+		return false
+	}
+	e.visiting[key] = true
+	return true
+}
+
+func (e *relationEvaluator) leave(object relationObject, relation string) {
+	delete(e.visiting, object.String()+"#"+relation)
+}
+
+// tuples loads the tuples matching query, counting towards the query limit
+func (e *relationEvaluator) tuples(ctx context.Context, query relationQuery) ([]relationTuple, error) {
+	e.queries++
+	if e.queries > maxRelationQueries {
+		return nil, fmt.Errorf("relation graph exceeds the traversal limit")
+	}
+	return e.store.findRelations(ctx, query)
+}
+
+func (e *relationEvaluator) objectTuples(ctx context.Context, object relationObject, relation string) ([]relationTuple, error) {
+	return e.tuples(ctx, relationQuery{ObjectType: object.Type, ObjectID: object.ID, Relation: relation})
+}
+
+// check reports whether subject is in the userset object#relation
+func (e *relationEvaluator) check(ctx context.Context, object relationObject, relation string, subject relationSubject, depth int) (bool, error) {
+	if depth > maxRelationDepth {
+		return false, fmt.Errorf("relation graph exceeds the traversal limit")
+	}
+
+	// A userset contains itself
+	if subject.relationObject == object && subject.Relation == relation {
+		return true, nil
+	}
+
+	if !e.enter(object, relation) {
+		return false, nil
+	}
+	defer e.leave(object, relation)
+
+	tuples, err := e.objectTuples(ctx, object, relation)
+	if err != nil {
+		return false, err
+	}
+
+	for _, tuple := range tuples {
+		if tuple.Subject == subject {
+			return true, nil
+		}
+	}
+
+	for _, tuple := range tuples {
+		if tuple.Subject.Relation == "" {
+			continue
+		}
+		ok, err := e.check(ctx, tuple.Subject.relationObject, tuple.Subject.Relation, subject, depth+1)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+
+	rule := e.schema.rule(object.Type, relation)
+	for _, implied := range rule.ImpliedBy {
+		ok, err := e.check(ctx, object, implied, subject, depth+1)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+
+	for _, from := range rule.From {
+		related, err := e.objectTuples(ctx, object, from.Via)
+		if err != nil {
+			return false, err
+		}
+		for _, tuple := range related {
+			if tuple.Subject.Relation != "" {
+				continue
+			}
+			ok, err := e.check(ctx, tuple.Subject.relationObject, from.Relation, subject, depth+1)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// reachable returns the IDs of the objects of objectType whose relation
+// includes subject, following tuples and schema rules from the subject up
+func (e *relationEvaluator) reachable(ctx context.Context, subject relationSubject, objectType, relation string) ([]string, error) {
+	seen := map[relationSubject]bool{subject: true}
+	queue := []relationSubject{subject}
+	found := make(map[string]bool)
+
+	push := func(next relationSubject) {
+		if !seen[next] {
+			seen[next] = true
+			queue = append(queue, next)
+		}
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		if node.Type == objectType && node.Relation == relation {
+			found[node.ID] = true
+		}
+
+		// Usersets the node is a direct member of
+		memberships, err := e.tuples(ctx, relationQuery{Subject: &node})
+		if err != nil {
+			return nil, err
+		}
+		for _, tuple := range memberships {
+			push(relationSubject{relationObject: tuple.Object, Relation: tuple.Relation})
+		}
+
+		if node.Relation == "" {
+			continue
+		}
+
+		// Relations the node's relation implies on the same object
+		for _, implied := range e.schema.implying(node.Type, node.Relation) {
+			push(relationSubject{relationObject: node.relationObject, Relation: implied})
+		}
+
+		// Relations inherited by objects related to the node's object
+		for _, inheritance := range e.schema.inheriting(node.Relation) {
+			related, err := e.tuples(ctx, relationQuery{
+				ObjectType: inheritance.objectType,
+				Relation:   inheritance.via,
+				Subject:    &relationSubject{relationObject: node.relationObject},
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, tuple := range related {
+				push(relationSubject{relationObject: tuple.Object, Relation: inheritance.relation})
+			}
+		}
+	}
+
+	objects := make([]string, 0, len(found))
+	for id := range found {
+		objects = append(objects, id)
+	}
+	sort.Strings(objects)
+	return objects, nil
+}
+
+// expand builds the tree of subjects holding object#relation
+func (e *relationEvaluator) expand(ctx context.Context, object relationObject, relation string, depth int) (models.RelationTree, error) {
+	tree := models.RelationTree{Userset: object.String() + "#" + relation}
+	if depth > maxRelationDepth {
+		return tree, fmt.Errorf("relation graph exceeds the traversal limit")
+	}
+
+	if !e.enter(object, relation) {
+		return tree, nil
+	}
+	defer e.leave(object, relation)
+
+	tuples, err := e.objectTuples(ctx, object, relation)
+	if err != nil {
+		return tree, err
+	}
+
+	for _, tuple := range tuples {
+		if tuple.Subject.Relation == "" {
+			tree.Subjects = append(tree.Subjects, tuple.Subject.String())
+			continue
+		}
+		child, err := e.expand(ctx, tuple.Subject.relationObject, tuple.Subject.Relation, depth+1)
+		if err != nil {
+			return tree, err
+		}
+		tree.Children = append(tree.Children, child)
+	}
+
+	rule := e.schema.rule(object.Type, relation)
+	for _, implied := range rule.ImpliedBy {
+		child, err := e.expand(ctx, object, implied, depth+1)
+		if err != nil {
+			return tree, err
+		}
+		tree.Children = append(tree.Children, child)
+	}
+
+	for _, from := range rule.From {
+		related, err := e.objectTuples(ctx, object, from.Via)
+		if err != nil {
+			return tree, err
+		}
+		for _, tuple := range related {
+			if tuple.Subject.Relation != "" {
+				continue
+			}
+			child, err := e.expand(ctx, tuple.Subject.relationObject, from.Relation, depth+1)
+			if err != nil {
+				return tree, err
+			}
+			tree.Children = append(tree.Children, child)
+		}
+	}
+
+	return tree, nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// memoryRelationStore serves tuples from memory
+type memoryRelationStore struct {
+	tuples []relationTuple
+}
+
+func (s *memoryRelationStore) findRelations(ctx context.Context, query relationQuery) ([]relationTuple, error) {
+	var result []relationTuple
+	for _, tuple := range s.tuples {
+		if (query.ObjectType == "" || tuple.Object.Type == query.ObjectType) &&
+			(query.ObjectID == "" || tuple.Object.ID == query.ObjectID) &&
+			(query.Relation == "" || tuple.Relation == query.Relation) &&
+			(query.Subject == nil || tuple.Subject == *query.Subject) {
+			result = append(result, tuple)
+		}
+	}
+	return result, nil
+}
+
+// newMemoryRelationStore parses tuples written as object#relation@subject
+func newMemoryRelationStore(t *testing.T, tuples ...string) *memoryRelationStore {
+	t.Helper()
+	store := &memoryRelationStore{}
+	for _, value := range tuples {
+		userset, subjectValue, _ := strings.Cut(value, "@")
+		objectValue, relation, _ := strings.Cut(userset, "#")
+
+		object, err := parseRelationObject(objectValue)
+		if err != nil {
+			t.Fatalf("invalid tuple %q: %v", value, err)
+		}
+		subject, err := parseRelationSubject(subjectValue)
+		if err != nil {
+			t.Fatalf("invalid tuple %q: %v", value, err)
+		}
+		store.tuples = append(store.tuples, relationTuple{Object: object, Relation: relation, Subject: subject})
+	}
+	return store
+}
+
+// nestedGroups returns tuples nesting group:0 through group:n, with user:alice in group:n
+func nestedGroups(n int) []string {
+	var tuples []string
+	for i := 0; i < n; i++ {
+		tuples = append(tuples, fmt.Sprintf("group:%d#member@group:%d#member", i, i+1))
+	}
+	return append(tuples, fmt.Sprintf("group:%d#member@user:alice", n))
+}
+
+var testRelationSchema = RelationSchema{
+	"document": {
+		"owner":  {},
+		"parent": {},
+		"editor": {ImpliedBy: []string{"owner"}},
+		"viewer": {ImpliedBy: []string{"editor"}, From: []RelationFrom{{Via: "parent", Relation: "viewer"}}},
+	},
+	"folder": {
+		"parent": {},
+		"viewer": {From: []RelationFrom{{Via: "parent", Relation: "viewer"}}},
+	},
+	"group": {
+		"member": {},
+	},
+}
+
+func TestRelationEvaluatorCheck(t *testing.T) {
+	wide := []string{"group:root#member@user:alice"}
+	for i := 0; i < maxRelationQueries; i++ {
+		wide = append(wide, fmt.Sprintf("group:root#member@group:%d#member", i))
+	}
+
+	tests := []struct {
+		name     string
+		tuples   []string
+		object   string
+		relation string
+		subject  string
+		want     bool
+		wantErr  bool
+	}{
+		{"direct tuple", []string{"document:1#viewer@user:alice"}, "document:1", "viewer", "user:alice", true, false},
+		{"no tuple", []string{"document:1#viewer@user:bob"}, "document:1", "viewer", "user:alice", false, false},
+		{"through userset", []string{"document:1#viewer@group:eng#member", "group:eng#member@user:alice"}, "document:1", "viewer", "user:alice", true, false},
+		{"userset subject", []string{"document:1#viewer@group:eng#member"}, "document:1", "viewer", "group:eng#member", true, false},
+		{"implied relation", []string{"document:1#owner@user:alice"}, "document:1", "viewer", "user:alice", true, false},
+		{"implication is one way", []string{"document:1#viewer@user:alice"}, "document:1", "owner", "user:alice", false, false},
+		{"inherited through parent", []string{"document:1#parent@folder:a", "folder:a#parent@folder:b", "folder:b#viewer@user:alice"}, "document:1", "viewer", "user:alice", true, false},
+		{"two group cycle", []string{"group:a#member@group:b#member", "group:b#member@group:a#member"}, "group:a", "member", "user:alice", false, false},
+		{"cycle with a member", []string{"group:a#member@group:b#member", "group:b#member@group:a#member", "group:b#member@user:alice"}, "group:a", "member", "user:alice", true, false},
+		{"self cycle", []string{"group:a#member@group:a#member"}, "group:a", "member", "user:alice", false, false},
+		{"parent cycle", []string{"folder:a#parent@folder:b", "folder:b#parent@folder:a"}, "folder:a", "viewer", "user:alice", false, false},
+		{"nesting at the depth limit", nestedGroups(maxRelationDepth), "group:0", "member", "user:alice", true, false},
+		{"nesting beyond the depth limit", nestedGroups(maxRelationDepth + 1), "group:0", "member", "user:alice", false, true},
+		{"graph beyond the query limit", wide, "group:root", "member", "user:bob", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newRelationEvaluator(newMemoryRelationStore(t, tt.tuples...), testRelationSchema)
+			object, _ := parseRelationObject(tt.object)
+			subject, _ := parseRelationSubject(tt.subject)
+
+			got, err := e.check(context.Background(), object, tt.relation, subject, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("check error = %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("check = %v, want %v", got, tt.want)
+			}
+			if len(e.visiting) != 0 {
+				t.Errorf("visiting still holds %d usersets after check", len(e.visiting))
+			}
+		})
+	}
+}
+
+func TestRelationEvaluatorReachable(t *testing.T) {
+	store := newMemoryRelationStore(t,
+		"group:a#member@group:b#member",
+		"group:b#member@group:a#member",
+		"group:b#member@user:alice",
+		"document:1#viewer@group:a#member",
+		"document:2#owner@user:alice",
+		"document:3#parent@folder:x",
+		"folder:x#viewer@user:alice",
+		"document:4#viewer@user:bob",
+	)
+	e := newRelationEvaluator(store, testRelationSchema)
+	subject, _ := parseRelationSubject("user:alice")
+
+	got, err := e.reachable(context.Background(), subject, "document", "viewer")
+	if err != nil {
+		t.Fatalf("reachable returned error: %v", err)
+	}
+	if want := []string{"1", "2", "3"}; !slices.Equal(got, want) {
+		t.Errorf("reachable = %v, want %v", got, want)
+	}
+}
+
+func TestRelationEvaluatorExpand(t *testing.T) {
+	t.Run("cycle", func(t *testing.T) {
+		store := newMemoryRelationStore(t,
+			"group:a#member@group:b#member",
+			"group:b#member@group:a#member",
+			"group:b#member@user:alice",
+		)
+		e := newRelationEvaluator(store, testRelationSchema)
+		object, _ := parseRelationObject("group:a")
+
+		tree, err := e.expand(context.Background(), object, "member", 0)
+		if err != nil {
+			t.Fatalf("expand returned error: %v", err)
+		}
+		if len(tree.Children) != 1 || !slices.Equal(tree.Children[0].Subjects, []string{"user:alice"}) {
+			t.Fatalf("expand = %+v, want group:b with user:alice", tree)
+		}
+		if cycle := tree.Children[0].Children; len(cycle) != 1 || cycle[0].Userset != "group:a#member" || len(cycle[0].Children) != 0 {
+			t.Errorf("cyclic userset expanded to %+v, want an empty group:a#member leaf", cycle)
+		}
+	})
+
+	t.Run("depth limit", func(t *testing.T) {
+		e := newRelationEvaluator(newMemoryRelationStore(t, nestedGroups(maxRelationDepth+1)...), testRelationSchema)
+		object, _ := parseRelationObject("group:0")
+
+		if _, err := e.expand(context.Background(), object, "member", 0); err == nil {
+			t.Error("expand beyond the depth limit succeeded, want error")
+		}
+	})
+}
@@ -0,0 +1,647 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/rolerequests"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/userroles"
+	"github.com/shammianand/go-auth/internal/config"
+	emailmodels "github.com/shammianand/go-auth/internal/modules/email/models"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+)
+
+// RequestRole files a request for a role on behalf of userID. The role's
+// approvers, or everyone with rbac.assign when it has none, are notified.
+func (s *RBACService) RequestRole(ctx context.Context, userID uuid.UUID, req *models.RoleAccessRequest) (*models.RoleRequestResponse, error) {
+	role, err := s.client.Roles.Get(ctx, req.RoleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("role not found")
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	if err := s.checkNoOpenRequest(ctx, userID, role.ID); err != nil {
+		return nil, err
+	}
+
+	request, err := s.client.RoleRequests.Create().
+		SetUserID(userID).
+		SetRoleID(role.ID).
+		SetReason(req.Reason).
+		SetNillableDurationHours(req.DurationHours).
+		Save(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create role request: %w", err)
+	}
+
+	s.createAuditLog(ctx, userID, "role_request.create", "role_request", request.ID.String(), roleRequestMetadata(request))
+
+	s.notifyApprovers(ctx, request, role)
+
+	response := roleRequestToResponse(request)
+	return &response, nil
+}
+
+// RequestBreakGlass files an emergency request for a role on behalf of
+// userID. Roles with the "auto" policy are granted at once for the requested
+// duration; "approval" roles wait for an approver or anyone with rbac.assign.
+// Either way everyone with rbac.assign is notified.
+func (s *RBACService) RequestBreakGlass(ctx context.Context, userID uuid.UUID, req *models.BreakGlassRequest) (*models.RoleRequestResponse, error) {
+	role, err := s.client.Roles.Get(ctx, req.RoleID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("role not found")
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	if role.BreakGlass == roles.BreakGlassDisabled {
+		return nil, fmt.Errorf("role is not available for break-glass access")
+	}
+
+	maxHours := config.BreakGlassMaxHours
+	if role.BreakGlassMaxHours != nil {
+		maxHours = *role.BreakGlassMaxHours
+	}
+	if req.DurationHours > maxHours {
+		return nil, fmt.Errorf("duration exceeds the break-glass limit of %d hours", maxHours)
+	}
+
+	if err := s.checkNoOpenRequest(ctx, userID, role.ID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	create := tx.RoleRequests.Create().
+		SetUserID(userID).
+		SetRoleID(role.ID).
+		SetReason(req.Reason).
+		SetDurationHours(req.DurationHours).
+		SetBreakGlass(true)
+
+	autoGrant := role.BreakGlass == roles.BreakGlassAuto
+	if autoGrant {
+		create = create.
+			SetStatus(rolerequests.StatusApproved).
+			SetDecidedAt(time.Now()).
+			SetDecisionNote("granted automatically")
+	}
+
+	request, err := create.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to create role request: %w", err)
+	}
+
+	var assignment *ent.UserRoles
+	if autoGrant {
+		assignment, _, err = s.grantRole(ctx, tx.Client(), userID, role.ID, userID, requestGrantOptions(request))
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.createAuditLog(ctx, userID, "role_request.create", "role_request", request.ID.String(), roleRequestMetadata(request))
+
+	if assignment != nil {
+		s.permissions.InvalidateUser(ctx, userID)
+
+		metadata := assignmentMetadata(assignment)
+		metadata["request_id"] = request.ID.String()
+		metadata["break_glass"] = true
+		s.createAuditLog(ctx, userID, "role.assign", "user_role", userID.String(), metadata)
+	}
+
+	s.notifyBreakGlass(ctx, request, role, !autoGrant)
+
+	response := roleRequestToResponse(request)
+	return &response, nil
+}
+
+// ListRoleRequests returns role requests, newest first
+func (s *RBACService) ListRoleRequests(ctx context.Context, filter *models.RoleRequestFilter) ([]models.RoleRequestResponse, error) {
+	return s.listRoleRequests(ctx, s.client.RoleRequests.Query(), filter)
+}
+
+// ListUserRoleRequests returns the requests a user filed, newest first
+func (s *RBACService) ListUserRoleRequests(ctx context.Context, userID uuid.UUID, filter *models.RoleRequestFilter) ([]models.RoleRequestResponse, error) {
+	query := s.client.RoleRequests.Query().
+		Where(rolerequests.UserIDEQ(userID))
+
+	return s.listRoleRequests(ctx, query, filter)
+}
+
+// ListPendingApprovals returns the pending requests a user may decide, oldest first
+func (s *RBACService) ListPendingApprovals(ctx context.Context, approverID uuid.UUID) ([]models.RoleRequestResponse, error) {
+	requests, err := s.client.RoleRequests.Query().
+		Where(
+			rolerequests.StatusEQ(rolerequests.StatusPending),
+			rolerequests.UserIDNEQ(approverID),
+		).
+		Order(ent.Asc(rolerequests.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role requests: %w", err)
+	}
+
+	decider, err := s.newRequestDecider(ctx, approverID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.RoleRequestResponse, 0)
+	for _, request := range requests {
+		if decider.canDecide(request) {
+			result = append(result, roleRequestToResponse(request))
+		}
+	}
+
+	return result, nil
+}
+
+func (s *RBACService) listRoleRequests(ctx context.Context, query *ent.RoleRequestsQuery, filter *models.RoleRequestFilter) ([]models.RoleRequestResponse, error) {
+	if filter.Status != "" {
+		query = query.Where(rolerequests.StatusEQ(rolerequests.Status(filter.Status)))
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = 50
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	requests, err := query.
+		Order(ent.Desc(rolerequests.FieldCreatedAt)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role requests: %w", err)
+	}
+
+	result := make([]models.RoleRequestResponse, len(requests))
+	for i, request := range requests {
+		result[i] = roleRequestToResponse(request)
+	}
+
+	return result, nil
+}
+
+// ApproveRoleRequest grants a pending request's role with the approver as
+// assigned_by. Time-bound requests expire duration_hours after approval.
+func (s *RBACService) ApproveRoleRequest(ctx context.Context, requestID, approverID uuid.UUID, note string) (*models.RoleRequestResponse, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	request, err := s.decideRoleRequest(ctx, tx, requestID, approverID, rolerequests.StatusApproved, note)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	assignment, _, err := s.grantRole(ctx, tx.Client(), request.UserID, request.RoleID, approverID, requestGrantOptions(request))
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.permissions.InvalidateUser(ctx, request.UserID)
+
+	s.createAuditLog(ctx, approverID, "role_request.approve", "role_request", request.ID.String(), roleRequestMetadata(request))
+
+	metadata := assignmentMetadata(assignment)
+	metadata["request_id"] = request.ID.String()
+	metadata["break_glass"] = request.BreakGlass
+	s.createAuditLog(ctx, approverID, "role.assign", "user_role", request.UserID.String(), metadata)
+
+	s.notifyDecision(ctx, request)
+
+	response := roleRequestToResponse(request)
+	return &response, nil
+}
+
+// DenyRoleRequest rejects a pending request
+func (s *RBACService) DenyRoleRequest(ctx context.Context, requestID, approverID uuid.UUID, note string) (*models.RoleRequestResponse, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	request, err := s.decideRoleRequest(ctx, tx, requestID, approverID, rolerequests.StatusDenied, note)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.createAuditLog(ctx, approverID, "role_request.deny", "role_request", request.ID.String(), roleRequestMetadata(request))
+
+	s.notifyDecision(ctx, request)
+
+	response := roleRequestToResponse(request)
+	return &response, nil
+}
+
+// ExpireStaleRoleRequests expires requests that stayed pending longer than
+// ROLE_REQUEST_TTL, audits each one and tells the requester
+func (s *RBACService) ExpireStaleRoleRequests(ctx context.Context) (int, error) {
+	if config.RoleRequestTTL <= 0 {
+		return 0, nil
+	}
+
+	expired := 0
+	cutoff := time.Now().Add(-config.RoleRequestTTL)
+
+	for {
+		stale, err := s.client.RoleRequests.Query().
+			Where(
+				rolerequests.StatusEQ(rolerequests.StatusPending),
+				rolerequests.CreatedAtLT(cutoff),
+			).
+			Limit(expiryBatchSize).
+			All(ctx)
+
+		if err != nil {
+			return expired, fmt.Errorf("failed to query stale role requests: %w", err)
+		}
+
+		for _, request := range stale {
+			// Only pending rows match, so each request expires on one instance
+			updated, err := s.client.RoleRequests.Update().
+				Where(
+					rolerequests.IDEQ(request.ID),
+					rolerequests.StatusEQ(rolerequests.StatusPending),
+				).
+				SetStatus(rolerequests.StatusExpired).
+				SetDecidedAt(time.Now()).
+				SetDecisionNote("no decision before the request expired").
+				Save(ctx)
+
+			if err != nil {
+				return expired, fmt.Errorf("failed to expire role request: %w", err)
+			}
+			if updated == 0 {
+				continue
+			}
+
+			request.Status = rolerequests.StatusExpired
+			request.DecisionNote = "no decision before the request expired"
+			s.createSystemAuditLog(ctx, "role_request.expire", "role_request", request.ID.String(), roleRequestMetadata(request))
+			s.notifyDecision(ctx, request)
+			expired++
+		}
+
+		if len(stale) < expiryBatchSize {
+			return expired, nil
+		}
+	}
+}
+
+// decideRoleRequest moves a pending request to status. The update only
+// matches pending rows, so two approvers racing cannot both decide it.
+func (s *RBACService) decideRoleRequest(ctx context.Context, tx *ent.Tx, requestID, approverID uuid.UUID, status rolerequests.Status, note string) (*ent.RoleRequests, error) {
+	request, err := tx.RoleRequests.Get(ctx, requestID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("role request not found")
+		}
+		return nil, fmt.Errorf("failed to get role request: %w", err)
+	}
+
+	if request.UserID == approverID {
+		return nil, fmt.Errorf("cannot decide your own role request")
+	}
+
+	decider, err := s.newRequestDecider(ctx, approverID)
+	if err != nil {
+		return nil, err
+	}
+	if !decider.canDecide(request) {
+		return nil, fmt.Errorf("not an approver for this role")
+	}
+
+	updated, err := tx.RoleRequests.Update().
+		Where(
+			rolerequests.IDEQ(requestID),
+			rolerequests.StatusEQ(rolerequests.StatusPending),
+		).
+		SetStatus(status).
+		SetDecidedBy(approverID).
+		SetDecidedAt(time.Now()).
+		SetDecisionNote(note).
+		Save(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update role request: %w", err)
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("role request is not pending")
+	}
+
+	return tx.RoleRequests.Get(ctx, requestID)
+}
+
+// checkNoOpenRequest rejects a request for a role the user already holds or
+// is already waiting for
+func (s *RBACService) checkNoOpenRequest(ctx context.Context, userID uuid.UUID, roleID int) error {
+	assigned, err := s.client.UserRoles.Query().
+		Where(
+			userroles.UserIDEQ(userID),
+			userroles.RoleIDEQ(roleID),
+			activeAssignment(),
+		).
+		Exist(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to check existing assignment: %w", err)
+	}
+	if assigned {
+		return ErrRoleAlreadyAssigned
+	}
+
+	pending, err := s.client.RoleRequests.Query().
+		Where(
+			rolerequests.UserIDEQ(userID),
+			rolerequests.RoleIDEQ(roleID),
+			rolerequests.StatusEQ(rolerequests.StatusPending),
+		).
+		Exist(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to check pending requests: %w", err)
+	}
+	if pending {
+		return fmt.Errorf("a request for this role is already pending")
+	}
+
+	return nil
+}
+
+// requestDecider reports whether a user may approve or deny requests. A
+// role's approvers are the holders of its approver roles; roles without
+// approvers, and every break-glass request, can also be decided by anyone
+// with rbac.assign.
+type requestDecider struct {
+	approvers map[int][]int
+	roles     map[int]bool
+	canAssign bool
+}
+
+func (s *RBACService) newRequestDecider(ctx context.Context, userID uuid.UUID) (*requestDecider, error) {
+	approvers, err := s.loadRoleApprovers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := s.effectiveRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	canAssign, err := s.HasPermission(ctx, userID, "rbac.assign")
+	if err != nil {
+		return nil, err
+	}
+
+	return &requestDecider{
+		approvers: approvers,
+		roles:     roles,
+		canAssign: canAssign,
+	}, nil
+}
+
+func (d *requestDecider) canDecide(request *ent.RoleRequests) bool {
+	approverRoles := d.approvers[request.RoleID]
+	if d.canAssign && (request.BreakGlass || len(approverRoles) == 0) {
+		return true
+	}
+
+	for _, roleID := range approverRoles {
+		if d.roles[roleID] {
+			return true
+		}
+	}
+	return false
+}
+
+// loadRoleApprovers maps each role ID to the IDs of its approver roles
+func (s *RBACService) loadRoleApprovers(ctx context.Context) (map[int][]int, error) {
+	links, err := s.client.RoleApprovers.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load role approvers: %w", err)
+	}
+
+	approvers := make(map[int][]int)
+	for _, link := range links {
+		approvers[link.RoleID] = append(approvers[link.RoleID], link.ApproverRoleID)
+	}
+	return approvers, nil
+}
+
+// notifyApprovers emails everyone who can decide a request, except the requester
+func (s *RBACService) notifyApprovers(ctx context.Context, request *ent.RoleRequests, role *ent.Roles) {
+	if s.emailService == nil {
+		return
+	}
+
+	requester, err := s.client.Users.Get(ctx, request.UserID)
+	if err != nil {
+		s.logger.Error("Failed to load role requester", "request_id", request.ID, "error", err)
+		return
+	}
+
+	approvers, err := s.loadRoleApprovers(ctx)
+	if err != nil {
+		s.logger.Error("Failed to find role request approvers", "request_id", request.ID, "error", err)
+		return
+	}
+
+	var recipients []*ent.Users
+	if approverRoles := approvers[role.ID]; len(approverRoles) > 0 {
+		target := make(map[int]bool)
+		for _, roleID := range approverRoles {
+			target[roleID] = true
+		}
+		recipients, err = s.usersHoldingRoles(ctx, target)
+	} else {
+		recipients, err = s.usersWithPermission(ctx, "rbac.assign")
+	}
+	if err != nil {
+		s.logger.Error("Failed to find role request approvers", "request_id", request.ID, "error", err)
+		return
+	}
+
+	notification := emailmodels.RoleRequestNotification{
+		RequestID:      request.ID.String(),
+		RequesterEmail: requester.Email,
+		RoleName:       role.Name,
+		Reason:         request.Reason,
+	}
+	if request.DurationHours != nil {
+		notification.DurationHours = *request.DurationHours
+	}
+
+	for _, recipient := range recipients {
+		if recipient.ID == request.UserID {
+			continue
+		}
+
+		err := s.emailService.SendRoleRequestEmail(ctx, recipient.ID, recipient.Email, recipient.FirstName, notification)
+		if err != nil {
+			s.logger.Error("Failed to send role request email",
+				"request_id", request.ID,
+				"recipient_id", recipient.ID,
+				"error", err,
+			)
+		}
+	}
+}
+
+// notifyDecision tells the requester their request was decided or expired
+func (s *RBACService) notifyDecision(ctx context.Context, request *ent.RoleRequests) {
+	if s.emailService == nil {
+		return
+	}
+
+	requester, err := s.client.Users.Get(ctx, request.UserID)
+	if err != nil {
+		s.logger.Error("Failed to load role requester", "request_id", request.ID, "error", err)
+		return
+	}
+
+	roleName := fmt.Sprintf("#%d", request.RoleID)
+	if role, err := s.client.Roles.Get(ctx, request.RoleID); err == nil {
+		roleName = role.Name
+	}
+
+	notification := emailmodels.RoleDecisionNotification{
+		RequestID: request.ID.String(),
+		RoleName:  roleName,
+		Status:    string(request.Status),
+		Note:      request.DecisionNote,
+	}
+
+	err = s.emailService.SendRoleDecisionEmail(ctx, requester.ID, requester.Email, requester.FirstName, notification)
+	if err != nil {
+		s.logger.Error("Failed to send role decision email", "request_id", request.ID, "error", err)
+	}
+}
+
+// notifyBreakGlass emails everyone who can assign roles, except the requester
+func (s *RBACService) notifyBreakGlass(ctx context.Context, request *ent.RoleRequests, role *ent.Roles, requiresApproval bool) {
+	if s.emailService == nil {
+		return
+	}
+
+	requester, err := s.client.Users.Get(ctx, request.UserID)
+	if err != nil {
+		s.logger.Error("Failed to load break-glass requester", "request_id", request.ID, "error", err)
+		return
+	}
+
+	recipients, err := s.usersWithPermission(ctx, "rbac.assign")
+	if err != nil {
+		s.logger.Error("Failed to find break-glass approvers", "request_id", request.ID, "error", err)
+		return
+	}
+
+	notification := emailmodels.BreakGlassNotification{
+		RequestID:        request.ID.String(),
+		RequesterEmail:   requester.Email,
+		RoleName:         role.Name,
+		Reason:           request.Reason,
+		RequiresApproval: requiresApproval,
+	}
+	if request.DurationHours != nil {
+		notification.DurationHours = *request.DurationHours
+	}
+
+	for _, recipient := range recipients {
+		if recipient.ID == request.UserID {
+			continue
+		}
+
+		err := s.emailService.SendBreakGlassEmail(ctx, recipient.ID, recipient.Email, recipient.FirstName, notification)
+		if err != nil {
+			s.logger.Error("Failed to send break-glass email",
+				"request_id", request.ID,
+				"recipient_id", recipient.ID,
+				"error", err,
+			)
+		}
+	}
+}
+
+// requestGrantOptions builds the assignment for an approved request
+func requestGrantOptions(request *ent.RoleRequests) AssignRoleOptions {
+	opts := AssignRoleOptions{Reason: request.Reason}
+	if request.BreakGlass {
+		opts.Reason = "break-glass: " + request.Reason
+	}
+	if request.DurationHours != nil {
+		expiresAt := time.Now().Add(time.Duration(*request.DurationHours) * time.Hour)
+		opts.ExpiresAt = &expiresAt
+	}
+	return opts
+}
+
+func roleRequestMetadata(request *ent.RoleRequests) map[string]interface{} {
+	metadata := map[string]interface{}{
+		"user_id":     request.UserID.String(),
+		"role_id":     request.RoleID,
+		"reason":      request.Reason,
+		"status":      string(request.Status),
+		"break_glass": request.BreakGlass,
+	}
+	if request.DurationHours != nil {
+		metadata["duration_hours"] = *request.DurationHours
+	}
+	if request.DecisionNote != "" {
+		metadata["decision_note"] = request.DecisionNote
+	}
+	return metadata
+}
+
+func roleRequestToResponse(request *ent.RoleRequests) models.RoleRequestResponse {
+	return models.RoleRequestResponse{
+		ID:            request.ID,
+		UserID:        request.UserID,
+		RoleID:        request.RoleID,
+		Reason:        request.Reason,
+		DurationHours: request.DurationHours,
+		BreakGlass:    request.BreakGlass,
+		Status:        string(request.Status),
+		DecidedBy:     request.DecidedBy,
+		DecidedAt:     request.DecidedAt,
+		DecisionNote:  request.DecisionNote,
+		CreatedAt:     request.CreatedAt,
+	}
+}
@@ -0,0 +1,484 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/sodconstraintroles"
+	"github.com/shammianand/go-auth/ent/sodconstraints"
+	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/modules/rbac/models"
+)
+
+// SoDViolationPrefix starts every error for an assignment blocked by a
+// separation-of-duties constraint
+const SoDViolationPrefix = "role assignment violates separation of duties constraint"
+
+// sodConstraint is a constraint with the IDs of its mutually exclusive roles
+type sodConstraint struct {
+	constraint *ent.SodConstraints
+	roleIDs    []int
+}
+
+// loadSoDConstraints reads every constraint and its roles using client,
+// which may be bound to a transaction
+func loadSoDConstraints(ctx context.Context, client *ent.Client) ([]sodConstraint, error) {
+	constraints, err := client.SodConstraints.Query().
+		WithConstraintRoles().
+		Order(ent.Asc(sodconstraints.FieldCode)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to load separation of duties constraints: %w", err)
+	}
+
+	result := make([]sodConstraint, len(constraints))
+	for i, constraint := range constraints {
+		roleIDs := make([]int, len(constraint.Edges.ConstraintRoles))
+		for j, member := range constraint.Edges.ConstraintRoles {
+			roleIDs[j] = member.RoleID
+		}
+		result[i] = sodConstraint{constraint: constraint, roleIDs: roleIDs}
+	}
+	return result, nil
+}
+
+// held returns the constraint's roles found in effective
+func (c sodConstraint) held(effective map[int]bool) []int {
+	var result []int
+	for _, roleID := range c.roleIDs {
+		if effective[roleID] {
+			result = append(result, roleID)
+		}
+	}
+	return result
+}
+
+// checkSeparationOfDuties returns the codes of the constraints that giving
+// userID the roles would break. Roles are expanded through inheritance and
+// include those held through groups, and constraints the user already breaks
+// without the new roles are ignored.
+func (s *RBACService) checkSeparationOfDuties(ctx context.Context, client *ent.Client, userID uuid.UUID, roleIDs ...int) ([]string, error) {
+	constraints, err := loadSoDConstraints(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if len(constraints) == 0 {
+		return nil, nil
+	}
+
+	currentIDs, err := userRoleIDs(ctx, client, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	hierarchy, err := LoadRoleHierarchy(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	before := make(map[int]bool)
+	for _, id := range hierarchy.Ancestors(currentIDs...) {
+		before[id] = true
+	}
+
+	after := make(map[int]bool)
+	for _, id := range hierarchy.Ancestors(append(currentIDs, roleIDs...)...) {
+		after[id] = true
+	}
+
+	var violated []string
+	for _, c := range constraints {
+		heldAfter := len(c.held(after))
+		if heldAfter > 1 && heldAfter > len(c.held(before)) {
+			violated = append(violated, c.constraint.Code)
+		}
+	}
+
+	return violated, nil
+}
+
+// ListSoDConstraints returns every separation-of-duties constraint
+func (s *RBACService) ListSoDConstraints(ctx context.Context) ([]models.SoDConstraintResponse, error) {
+	constraints, err := loadSoDConstraints(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	allRoles, err := s.client.Roles.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	roleByID := make(map[int]*ent.Roles)
+	for _, role := range allRoles {
+		roleByID[role.ID] = role
+	}
+
+	result := make([]models.SoDConstraintResponse, len(constraints))
+	for i, c := range constraints {
+		result[i] = s.sodConstraintToResponse(c.constraint, c.roleIDs, roleByID)
+	}
+
+	return result, nil
+}
+
+// CreateSoDConstraint creates a set of mutually exclusive roles. Users who
+// already hold several of them are reported by GetSoDViolations.
+func (s *RBACService) CreateSoDConstraint(ctx context.Context, req *models.CreateSoDConstraintRequest, actorID uuid.UUID) (*models.SoDConstraintResponse, error) {
+	if err := validateCode(req.Code, roleCodePattern, "constraint"); err != nil {
+		return nil, err
+	}
+
+	roleIDs := uniqueInts(req.RoleIDs)
+	if len(roleIDs) < 2 {
+		return nil, fmt.Errorf("constraint needs at least two roles")
+	}
+	if err := s.checkRolesExist(ctx, roleIDs, "role not found"); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	create := tx.SodConstraints.Create().
+		SetCode(req.Code).
+		SetName(req.Name)
+	if req.Description != "" {
+		create = create.SetDescription(req.Description)
+	}
+
+	constraint, err := create.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("constraint code already exists")
+		}
+		return nil, fmt.Errorf("failed to create constraint: %w", err)
+	}
+
+	if err := setSoDConstraintRoles(ctx, tx, constraint.ID, roleIDs); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to create constraint: %w", err)
+	}
+
+	s.createAuditLog(ctx, actorID, "sod.create", "sod_constraint", fmt.Sprintf("%d", constraint.ID), map[string]interface{}{
+		"constraint_id": constraint.ID,
+		"code":          constraint.Code,
+		"role_ids":      roleIDs,
+	})
+
+	return s.getSoDConstraint(ctx, constraint.ID)
+}
+
+// UpdateSoDConstraint changes a constraint's name, description or roles
+func (s *RBACService) UpdateSoDConstraint(ctx context.Context, constraintID int, req *models.UpdateSoDConstraintRequest, actorID uuid.UUID) (*models.SoDConstraintResponse, error) {
+	before, err := s.getSoDConstraint(ctx, constraintID)
+	if err != nil {
+		return nil, err
+	}
+
+	if before.IsSystem {
+		return nil, fmt.Errorf("cannot modify system constraint")
+	}
+
+	var roleIDs []int
+	if req.RoleIDs != nil {
+		roleIDs = uniqueInts(req.RoleIDs)
+		if len(roleIDs) < 2 {
+			return nil, fmt.Errorf("constraint needs at least two roles")
+		}
+		if err := s.checkRolesExist(ctx, roleIDs, "role not found"); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	update := tx.SodConstraints.UpdateOneID(constraintID)
+	if req.Name != nil {
+		if *req.Name == "" {
+			tx.Rollback()
+			return nil, fmt.Errorf("constraint name cannot be empty")
+		}
+		update = update.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		update = update.SetDescription(*req.Description)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to update constraint: %w", err)
+	}
+
+	if roleIDs != nil {
+		if err := setSoDConstraintRoles(ctx, tx, constraintID, roleIDs); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to update constraint: %w", err)
+	}
+
+	after, err := s.getSoDConstraint(ctx, constraintID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.createAuditLogWithChanges(ctx, actorID, "sod.update", "sod_constraint", fmt.Sprintf("%d", constraintID), map[string]interface{}{
+		"constraint_id": constraintID,
+	}, map[string]interface{}{
+		"before": before,
+		"after":  after,
+	})
+
+	return after, nil
+}
+
+// DeleteSoDConstraint deletes a custom constraint
+func (s *RBACService) DeleteSoDConstraint(ctx context.Context, constraintID int, actorID uuid.UUID) error {
+	before, err := s.getSoDConstraint(ctx, constraintID)
+	if err != nil {
+		return err
+	}
+
+	if before.IsSystem {
+		return fmt.Errorf("cannot delete system constraint")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	_, err = tx.SodConstraintRoles.Delete().
+		Where(sodconstraintroles.ConstraintIDEQ(constraintID)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to remove constraint roles: %w", err)
+	}
+
+	if err := tx.SodConstraints.DeleteOneID(constraintID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete constraint: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to delete constraint: %w", err)
+	}
+
+	s.createAuditLogWithChanges(ctx, actorID, "sod.delete", "sod_constraint", fmt.Sprintf("%d", constraintID), map[string]interface{}{
+		"constraint_id": constraintID,
+	}, map[string]interface{}{
+		"before": before,
+		"after":  nil,
+	})
+
+	return nil
+}
+
+// GetSoDViolations reports every user who currently holds more than one role
+// of a constraint, directly, through groups or through inheritance. Violations arise from
+// overrides, from constraints created after the roles were assigned, and
+// from changes to role inheritance.
+func (s *RBACService) GetSoDViolations(ctx context.Context) ([]models.SoDViolationResponse, error) {
+	constraints, err := loadSoDConstraints(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.SoDViolationResponse, 0)
+	if len(constraints) == 0 {
+		return result, nil
+	}
+
+	assignments, err := s.client.UserRoles.Query().
+		Where(activeAssignment()).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role assignments: %w", err)
+	}
+
+	hierarchy, err := LoadRoleHierarchy(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	allRoles, err := s.client.Roles.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	roleCodes := make(map[int]string)
+	for _, role := range allRoles {
+		roleCodes[role.ID] = role.Code
+	}
+
+	heldRoles, err := groupRolesByUser(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, assignment := range assignments {
+		heldRoles[assignment.UserID] = append(heldRoles[assignment.UserID], assignment.RoleID)
+	}
+
+	for userID, roleIDs := range heldRoles {
+		effective := make(map[int]bool)
+		for _, id := range hierarchy.Ancestors(roleIDs...) {
+			effective[id] = true
+		}
+
+		for _, c := range constraints {
+			held := c.held(effective)
+			if len(held) < 2 {
+				continue
+			}
+
+			codes := make([]string, len(held))
+			for i, roleID := range held {
+				codes[i] = roleCodes[roleID]
+			}
+			sort.Strings(codes)
+
+			result = append(result, models.SoDViolationResponse{
+				UserID:         userID,
+				ConstraintID:   c.constraint.ID,
+				ConstraintCode: c.constraint.Code,
+				RoleCodes:      codes,
+			})
+		}
+	}
+
+	if len(result) == 0 {
+		return result, nil
+	}
+
+	violators := make([]uuid.UUID, 0, len(result))
+	for _, violation := range result {
+		violators = append(violators, violation.UserID)
+	}
+
+	entUsers, err := s.client.Users.Query().
+		Where(users.IDIn(violators...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	emails := make(map[uuid.UUID]string)
+	for _, user := range entUsers {
+		emails[user.ID] = user.Email
+	}
+
+	for i := range result {
+		result[i].Email = emails[result[i].UserID]
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].ConstraintCode != result[j].ConstraintCode {
+			return result[i].ConstraintCode < result[j].ConstraintCode
+		}
+		return result[i].Email < result[j].Email
+	})
+
+	return result, nil
+}
+
+// getSoDConstraint returns one constraint with its roles
+func (s *RBACService) getSoDConstraint(ctx context.Context, constraintID int) (*models.SoDConstraintResponse, error) {
+	constraint, err := s.client.SodConstraints.Get(ctx, constraintID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("constraint not found")
+		}
+		return nil, fmt.Errorf("failed to get constraint: %w", err)
+	}
+
+	constraintRoles, err := s.client.Roles.Query().
+		Where(roles.HasSodConstraintRolesWith(sodconstraintroles.ConstraintIDEQ(constraintID))).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get constraint roles: %w", err)
+	}
+
+	roleIDs := make([]int, len(constraintRoles))
+	roleByID := make(map[int]*ent.Roles)
+	for i, role := range constraintRoles {
+		roleIDs[i] = role.ID
+		roleByID[role.ID] = role
+	}
+
+	response := s.sodConstraintToResponse(constraint, roleIDs, roleByID)
+	return &response, nil
+}
+
+// setSoDConstraintRoles replaces a constraint's roles inside tx
+func setSoDConstraintRoles(ctx context.Context, tx *ent.Tx, constraintID int, roleIDs []int) error {
+	_, err := tx.SodConstraintRoles.Delete().
+		Where(sodconstraintroles.ConstraintIDEQ(constraintID)).
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to clear constraint roles: %w", err)
+	}
+
+	for _, roleID := range roleIDs {
+		_, err := tx.SodConstraintRoles.Create().
+			SetConstraintID(constraintID).
+			SetRoleID(roleID).
+			Save(ctx)
+
+		if err != nil {
+			return fmt.Errorf("failed to add constraint role %d: %w", roleID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *RBACService) sodConstraintToResponse(constraint *ent.SodConstraints, roleIDs []int, roleByID map[int]*ent.Roles) models.SoDConstraintResponse {
+	roleResponses := make([]models.RoleResponse, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		if role, ok := roleByID[roleID]; ok {
+			roleResponses = append(roleResponses, s.roleToResponse(role))
+		}
+	}
+
+	return models.SoDConstraintResponse{
+		ID:          constraint.ID,
+		Code:        constraint.Code,
+		Name:        constraint.Name,
+		Description: constraint.Description,
+		IsSystem:    constraint.IsSystem,
+		Roles:       roleResponses,
+		CreatedAt:   constraint.CreatedAt,
+		UpdatedAt:   constraint.UpdatedAt,
+	}
+}
+
+// sodViolationError describes the constraints an assignment would break
+func sodViolationError(codes []string) error {
+	return fmt.Errorf("%s: %s", SoDViolationPrefix, strings.Join(codes, ", "))
+}
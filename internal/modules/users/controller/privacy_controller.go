@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	authservice "github.com/shammianand/go-auth/internal/modules/auth/service"
+	"github.com/shammianand/go-auth/internal/modules/users/models"
+)
+
+// ExportMe returns everything stored about the caller as JSON, served as
+// a download
+func (uc *UsersController) ExportMe(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	export, err := uc.service.ExportUser(c.Request.Context(), userID)
+	if err != nil {
+		respondUserError(c, "Failed to export data", err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="go-auth-export-%s.json"`, userID))
+	utils.RespondSuccess(c, types.HTTP.Ok, "Data exported successfully", export)
+}
+
+// RequestDeletion schedules the caller's account for deletion after the
+// grace period
+func (uc *UsersController) RequestDeletion(c *gin.Context) {
+	var req models.DeleteAccountRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	resp, err := uc.service.RequestDeletion(c.Request.Context(), userID, &req, c.ClientIP())
+	if err != nil {
+		var lockedErr *authservice.LockedError
+		if errors.As(err, &lockedErr) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(lockedErr.RetryAfter.Seconds()))))
+			utils.RespondError(c, types.HTTP.TooManyRequests, "Failed to request account deletion", "TOO_MANY_ATTEMPTS", err.Error())
+			return
+		}
+		respondUserError(c, "Failed to request account deletion", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Account deletion scheduled", resp)
+}
+
+// CancelDeletion cancels the caller's scheduled account deletion
+func (uc *UsersController) CancelDeletion(c *gin.Context) {
+	userID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := uc.service.CancelDeletion(c.Request.Context(), userID, &userID); err != nil {
+		respondUserError(c, "Failed to cancel account deletion", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Account deletion cancelled", nil)
+}
@@ -0,0 +1,189 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/common/types"
+	"github.com/shammianand/go-auth/internal/common/utils"
+	"github.com/shammianand/go-auth/internal/modules/users/models"
+	"github.com/shammianand/go-auth/internal/modules/users/service"
+)
+
+// UsersController handles admin user management HTTP requests
+type UsersController struct {
+	service *service.UsersService
+	logger  *slog.Logger
+}
+
+// NewUsersController creates a new users controller
+func NewUsersController(service *service.UsersService, logger *slog.Logger) *UsersController {
+	return &UsersController{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ListUsers returns a filtered page of users
+func (uc *UsersController) ListUsers(c *gin.Context) {
+	var filter models.UserFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid query parameters", "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	users, err := uc.service.ListUsers(c.Request.Context(), &filter)
+	if err != nil {
+		respondUserError(c, "Failed to list users", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Users retrieved successfully", users)
+}
+
+// GetUser returns a user with their roles
+func (uc *UsersController) GetUser(c *gin.Context) {
+	userID, ok := bindUserID(c)
+	if !ok {
+		return
+	}
+
+	user, err := uc.service.GetUser(c.Request.Context(), userID)
+	if err != nil {
+		respondUserError(c, "Failed to get user", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "User retrieved successfully", user)
+}
+
+// DeactivateUser blocks a user from signing in and revokes their tokens
+func (uc *UsersController) DeactivateUser(c *gin.Context) {
+	uc.runAction(c, "deactivate user", "User deactivated successfully", uc.service.DeactivateUser)
+}
+
+// ReactivateUser lets a deactivated user sign in again
+func (uc *UsersController) ReactivateUser(c *gin.Context) {
+	uc.runAction(c, "reactivate user", "User reactivated successfully", uc.service.ReactivateUser)
+}
+
+// ForcePasswordReset requires a user to reset their password before signing in
+func (uc *UsersController) ForcePasswordReset(c *gin.Context) {
+	uc.runAction(c, "force password reset", "Password reset required and reset email sent", uc.service.ForcePasswordReset)
+}
+
+// ForceLogout revokes every token issued to a user
+func (uc *UsersController) ForceLogout(c *gin.Context) {
+	uc.runAction(c, "force logout", "User logged out successfully", uc.service.ForceLogout)
+}
+
+// DeleteUser deletes a user and the records tied to them
+func (uc *UsersController) DeleteUser(c *gin.Context) {
+	uc.runAction(c, "delete user", "User deleted successfully", uc.service.DeleteUser)
+}
+
+// ResendVerification sends a new verification email to a user
+func (uc *UsersController) ResendVerification(c *gin.Context) {
+	userID, ok := bindUserID(c)
+	if !ok {
+		return
+	}
+
+	actorID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := uc.service.ResendVerification(c.Request.Context(), userID, actorID); err != nil {
+		respondUserError(c, "Failed to resend verification", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Verification email sent", nil)
+}
+
+// Impersonate issues a short-lived token to act as another user
+func (uc *UsersController) Impersonate(c *gin.Context) {
+	var req models.ImpersonateRequest
+	if err := utils.BindJSON(c, &req); err != nil {
+		return
+	}
+
+	actorID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	resp, err := uc.service.Impersonate(c.Request.Context(), &req, actorID)
+	if err != nil {
+		respondUserError(c, "Failed to impersonate user", err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, "Impersonation started", resp)
+}
+
+// runAction handles the admin actions that take the target user from the
+// path and an optional reason from the body
+func (uc *UsersController) runAction(c *gin.Context, action, message string, run func(ctx context.Context, userID, actorID uuid.UUID, reason string) error) {
+	userID, ok := bindUserID(c)
+	if !ok {
+		return
+	}
+
+	var req models.UserActionRequest
+	if c.Request.ContentLength > 0 {
+		if err := utils.BindJSON(c, &req); err != nil {
+			return
+		}
+	}
+
+	actorID, err := middleware.GetUserID(c)
+	if err != nil {
+		utils.RespondError(c, types.HTTP.Unauthorized, "Not authenticated", "UNAUTHORIZED", err.Error())
+		return
+	}
+
+	if err := run(c.Request.Context(), userID, actorID, req.Reason); err != nil {
+		respondUserError(c, "Failed to "+action, err)
+		return
+	}
+
+	utils.RespondSuccess(c, types.HTTP.Ok, message, nil)
+}
+
+// bindUserID parses the user ID path parameter, responding when it is invalid
+func bindUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		utils.RespondError(c, types.HTTP.BadRequest, "Invalid user ID", "VALIDATION_ERROR", err.Error())
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// respondUserError maps user management errors to responses
+func respondUserError(c *gin.Context, message string, err error) {
+	msg := err.Error()
+	switch msg {
+	case "user not found":
+		utils.RespondError(c, types.HTTP.NotFound, message, "NOT_FOUND", msg)
+	case "user is already inactive", "user is already active", "email already verified", "user signup has not been approved",
+		"account deletion is already scheduled", "account deletion is not scheduled":
+		utils.RespondError(c, types.HTTP.Conflict, message, "CONFLICT", msg)
+	case "cannot deactivate your own account", "cannot delete your own account",
+		"cannot impersonate yourself", "cannot impersonate a user with privileged roles", "user account is inactive":
+		utils.RespondError(c, types.HTTP.Forbidden, message, "FORBIDDEN", msg)
+	case "invalid password":
+		utils.RespondError(c, types.HTTP.Forbidden, message, "INVALID_PASSWORD", msg)
+	case "invalid cursor":
+		utils.RespondError(c, types.HTTP.BadRequest, message, "VALIDATION_ERROR", msg)
+	default:
+		utils.RespondError(c, types.HTTP.InternalServerError, message, "USER_ERROR", msg)
+	}
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserFilter filters and pages the admin user listing. Pass next_cursor
+// from the previous page as cursor to continue.
+type UserFilter struct {
+	Cursor          string     `form:"cursor"`
+	Limit           int        `form:"limit" binding:"omitempty,min=1,max=100"`
+	EmailPrefix     string     `form:"email_prefix"`
+	IsActive        *bool      `form:"is_active"`
+	EmailVerified   *bool      `form:"email_verified"`
+	Role            string     `form:"role"` // Role code, direct assignments only
+	CreatedAfter    *time.Time `form:"created_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedBefore   *time.Time `form:"created_before" time_format:"2006-01-02T15:04:05Z07:00"`
+	LastLoginAfter  *time.Time `form:"last_login_after" time_format:"2006-01-02T15:04:05Z07:00"`
+	LastLoginBefore *time.Time `form:"last_login_before" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// UserActionRequest carries the optional reason recorded in the audit log
+// for an admin action on a user
+type UserActionRequest struct {
+	Reason string `json:"reason" binding:"max=500"`
+}
+
+// ImpersonateRequest starts acting as another user. The reason is recorded
+// in the audit log and sent to the user.
+type ImpersonateRequest struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
+	Reason string    `json:"reason" binding:"required,min=10,max=500"`
+}
+
+// DeleteAccountRequest asks for the caller's account to be deleted once the
+// grace period ends. The current password is required.
+type DeleteAccountRequest struct {
+	Password string `json:"password" binding:"required,max=1024"`
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserResponse represents a user in admin listings
+type UserResponse struct {
+	ID                    uuid.UUID  `json:"id"`
+	Email                 string     `json:"email"`
+	FirstName             string     `json:"first_name"`
+	LastName              string     `json:"last_name"`
+	IsActive              bool       `json:"is_active"`
+	EmailVerified         bool       `json:"email_verified"`
+	ApprovalStatus        string     `json:"approval_status"`
+	PasswordResetRequired bool       `json:"password_reset_required"`
+	LastLogin             *time.Time `json:"last_login,omitempty"`
+	DeletionScheduledAt   *time.Time `json:"deletion_scheduled_at,omitempty"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+}
+
+// UserRoleSummary is a role held by a user
+type UserRoleSummary struct {
+	ID        int        `json:"id"`
+	Code      string     `json:"code"`
+	Name      string     `json:"name"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// UserDetailResponse represents a user with their direct roles
+type UserDetailResponse struct {
+	UserResponse
+	Roles []UserRoleSummary `json:"roles"`
+}
+
+// UserListResponse is one page of users
+type UserListResponse struct {
+	Users      []UserResponse `json:"users"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// ImpersonationResponse carries a short-lived token for the impersonated user
+type ImpersonationResponse struct {
+	Token     string       `json:"token"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	SessionID string       `json:"session_id"`
+	User      UserResponse `json:"user"`
+}
+
+// AccountDeletionResponse describes a scheduled account deletion
+type AccountDeletionResponse struct {
+	ScheduledAt time.Time `json:"scheduled_at"`
+}
+
+// UserExport is everything stored about a user, for data export requests
+type UserExport struct {
+	GeneratedAt   time.Time                `json:"generated_at"`
+	Profile       UserExportProfile        `json:"profile"`
+	Roles         []UserRoleSummary        `json:"roles"`
+	Groups        []UserExportMembership   `json:"groups"`
+	Organizations []UserExportMembership   `json:"organizations"`
+	Sessions      UserExportSessions       `json:"sessions"`
+	Verifications []UserExportVerification `json:"verifications"`
+	EmailChanges  []UserExportEmailChange  `json:"email_changes"`
+	Consents      []UserExportConsent      `json:"consents"`
+	EmailLogs     []UserExportEmailLog     `json:"email_logs"`
+	AuditLogs     []UserExportAuditLog     `json:"audit_logs"`
+}
+
+// UserExportProfile is the user's account record without credentials
+type UserExportProfile struct {
+	UserResponse
+	DeletionRequestedAt *time.Time             `json:"deletion_requested_at,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// UserExportMembership is a group or organization the user belongs to
+type UserExportMembership struct {
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// UserExportSessions describes the user's sign-in session and API keys
+type UserExportSessions struct {
+	SessionExpiresAt *time.Time         `json:"session_expires_at,omitempty"`
+	APIKeys          []UserExportAPIKey `json:"api_keys"`
+}
+
+// UserExportAPIKey is an API key without its secret
+type UserExportAPIKey struct {
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP string     `json:"last_used_ip,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// UserExportVerification is an email verification or password reset link
+// sent to the user, without the token
+type UserExportVerification struct {
+	Type      string     `json:"type"` // email_verification or password_reset
+	Email     string     `json:"email"`
+	Used      bool       `json:"used"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	IPAddress string     `json:"ip_address,omitempty"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// UserExportConsent is a consent document version the user accepted
+type UserExportConsent struct {
+	DocumentType string    `json:"document_type"`
+	Version      string    `json:"version"`
+	IPAddress    string    `json:"ip_address,omitempty"`
+	UserAgent    string    `json:"user_agent,omitempty"`
+	AcceptedAt   time.Time `json:"accepted_at"`
+}
+
+// UserExportEmailChange is an email change the user requested
+type UserExportEmailChange struct {
+	OldEmail    string     `json:"old_email"`
+	NewEmail    string     `json:"new_email"`
+	Status      string     `json:"status"`
+	IPAddress   string     `json:"ip_address,omitempty"`
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+	RevertedAt  *time.Time `json:"reverted_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// UserExportEmailLog is an email sent to the user
+type UserExportEmailLog struct {
+	Recipient string    `json:"recipient"`
+	EmailType string    `json:"email_type"`
+	Subject   string    `json:"subject,omitempty"`
+	Status    string    `json:"status"`
+	SentAt    time.Time `json:"sent_at"`
+}
+
+// UserExportAuditLog is an action the user performed
+type UserExportAuditLog struct {
+	ActionType   string                 `json:"action_type"`
+	ResourceType string                 `json:"resource_type"`
+	ResourceID   string                 `json:"resource_id,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	IPAddress    string                 `json:"ip_address,omitempty"`
+	UserAgent    string                 `json:"user_agent,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+}
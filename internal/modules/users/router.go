@@ -0,0 +1,58 @@
+package users
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/modules/users/controller"
+	"github.com/shammianand/go-auth/internal/modules/users/service"
+)
+
+// RBAC is the part of the RBAC service the users module relies on
+type RBAC interface {
+	middleware.PermissionChecker
+	service.Permissions
+}
+
+// RegisterRoutes registers admin user management, impersonation and
+// self-service data export and deletion routes.
+// The service is built by the caller because it also records impersonated
+// requests for the whole server.
+func RegisterRoutes(router *gin.RouterGroup, usersService *service.UsersService, cache *redis.Client, keys middleware.APIKeyVerifier, rbac RBAC, logger *slog.Logger) {
+	usersController := controller.NewUsersController(usersService, logger)
+
+	adminUsers := router.Group("/admin/users")
+	adminUsers.Use(middleware.RequireAuth(cache, keys))
+	{
+		adminUsers.GET("", middleware.RequirePermission(rbac, "users.read"), usersController.ListUsers)
+		adminUsers.GET("/:id", middleware.RequirePermission(rbac, "users.read"), usersController.GetUser)
+		adminUsers.POST("/:id/deactivate", middleware.RequirePermission(rbac, "users.write"), usersController.DeactivateUser)
+		adminUsers.POST("/:id/reactivate", middleware.RequirePermission(rbac, "users.write"), usersController.ReactivateUser)
+		adminUsers.POST("/:id/force-password-reset", middleware.RequirePermission(rbac, "users.write"), usersController.ForcePasswordReset)
+		adminUsers.POST("/:id/force-logout", middleware.RequirePermission(rbac, "users.write"), usersController.ForceLogout)
+		adminUsers.POST("/:id/resend-verification", middleware.RequirePermission(rbac, "users.write"), usersController.ResendVerification)
+		adminUsers.DELETE("/:id", middleware.RequirePermission(rbac, "users.write"), usersController.DeleteUser)
+	}
+
+	// Impersonation cannot be chained, and API keys cannot start one since
+	// the token would escape the key's scopes
+	router.POST("/admin/impersonate",
+		middleware.RequireAuth(cache, keys),
+		middleware.DenyImpersonation(),
+		middleware.DenyAPIKey(),
+		middleware.RequirePermission(rbac, "users.impersonate"),
+		usersController.Impersonate,
+	)
+
+	// Exports and deletion belong to the account owner, not to someone
+	// impersonating them or a scoped key
+	me := router.Group("/auth/me")
+	me.Use(middleware.RequireAuth(cache, keys), middleware.DenyImpersonation(), middleware.DenyAPIKey())
+	{
+		me.GET("/export", usersController.ExportMe)
+		me.POST("/deletion", usersController.RequestDeletion)
+		me.DELETE("/deletion", usersController.CancelDeletion)
+	}
+}
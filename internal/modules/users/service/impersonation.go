@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/internal/auth"
+	"github.com/shammianand/go-auth/internal/common/middleware"
+	"github.com/shammianand/go-auth/internal/config"
+	emailmodels "github.com/shammianand/go-auth/internal/modules/email/models"
+	"github.com/shammianand/go-auth/internal/modules/users/models"
+)
+
+// Impersonate issues a short-lived token for another user. The token's act
+// claim names the admin, so every request made with it is attributed to
+// both. Users holding a protected role cannot be impersonated.
+func (s *UsersService) Impersonate(ctx context.Context, req *models.ImpersonateRequest, actorID uuid.UUID) (*models.ImpersonationResponse, error) {
+	if req.UserID == actorID {
+		return nil, fmt.Errorf("cannot impersonate yourself")
+	}
+
+	user, err := s.getUser(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive {
+		return nil, fmt.Errorf("user account is inactive")
+	}
+
+	blocked, err := s.permissions.ImpersonationBlockedRoles(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocked) > 0 {
+		return nil, fmt.Errorf("cannot impersonate a user with privileged roles")
+	}
+
+	actor, err := s.getUser(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID := uuid.New().String()
+	expiresAt := time.Now().Add(config.ImpersonationTTL)
+
+	token, err := auth.CreateJWTWithExpiry(user.ID, map[string]interface{}{
+		"act": map[string]interface{}{"sub": actorID.String()},
+		"jti": sessionID,
+	}, config.ImpersonationTTL, s.cache)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+
+	err = s.emailService.SendImpersonationEmail(ctx, user.ID, user.Email, user.FirstName, emailmodels.ImpersonationNotification{
+		AdminEmail: actor.Email,
+		Reason:     req.Reason,
+		ExpiresAt:  expiresAt,
+	})
+	if err != nil {
+		s.logger.Error("Failed to send impersonation email", "user_id", user.ID, "error", err)
+	}
+
+	s.audit(ctx, actorID, "impersonation.start", user.ID, map[string]interface{}{
+		"email":        user.Email,
+		"actor_email":  actor.Email,
+		"reason":       strings.TrimSpace(req.Reason),
+		"session_id":   sessionID,
+		"expires_at":   expiresAt.UTC().Format(time.RFC3339),
+		"impersonator": actorID.String(),
+	})
+
+	return &models.ImpersonationResponse{
+		Token:     token,
+		ExpiresAt: expiresAt,
+		SessionID: sessionID,
+		User:      userToResponse(user),
+	}, nil
+}
+
+// RecordImpersonatedRequest records a request made with an impersonation
+// token. The admin is the actor and the impersonated user the resource.
+func (s *UsersService) RecordImpersonatedRequest(ctx context.Context, req middleware.ImpersonatedRequest) {
+	s.audit(ctx, req.ActorID, "impersonation.request", req.UserID, map[string]interface{}{
+		"impersonator": req.ActorID.String(),
+		"user_id":      req.UserID.String(),
+		"session_id":   req.SessionID,
+		"method":       req.Method,
+		"path":         req.Path,
+		"status":       req.Status,
+		"ip_address":   req.IP,
+	})
+}
@@ -0,0 +1,683 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/apikeys"
+	"github.com/shammianand/go-auth/ent/auditlogs"
+	"github.com/shammianand/go-auth/ent/consentacceptances"
+	"github.com/shammianand/go-auth/ent/emailchanges"
+	"github.com/shammianand/go-auth/ent/emaillogs"
+	"github.com/shammianand/go-auth/ent/emailverifications"
+	"github.com/shammianand/go-auth/ent/groupmembers"
+	"github.com/shammianand/go-auth/ent/invitations"
+	"github.com/shammianand/go-auth/ent/orgmembers"
+	"github.com/shammianand/go-auth/ent/orgroles"
+	"github.com/shammianand/go-auth/ent/passwordhistories"
+	"github.com/shammianand/go-auth/ent/passwordresets"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/relationtuples"
+	"github.com/shammianand/go-auth/ent/rolerequests"
+	"github.com/shammianand/go-auth/ent/userroles"
+	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/auth"
+	"github.com/shammianand/go-auth/internal/config"
+	"github.com/shammianand/go-auth/internal/modules/users/models"
+)
+
+// redacted replaces personal data in logs kept after an account is deleted
+const redacted = "[redacted]"
+
+// deletionBatchSize is how many due deletions are loaded at a time
+const deletionBatchSize = 100
+
+// ExportUser returns everything stored about a user: profile, roles,
+// memberships, session and API keys, verification history, email changes,
+// accepted consent documents, emails sent to them and actions they
+// performed. Tokens, secrets and password hashes are left out.
+func (s *UsersService) ExportUser(ctx context.Context, userID uuid.UUID) (*models.UserExport, error) {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	detail, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &models.UserExport{
+		GeneratedAt: time.Now(),
+		Profile: models.UserExportProfile{
+			UserResponse:        userToResponse(user),
+			DeletionRequestedAt: user.DeletionRequestedAt,
+			Metadata:            user.Metadata,
+		},
+		Roles:         detail.Roles,
+		Groups:        []models.UserExportMembership{},
+		Organizations: []models.UserExportMembership{},
+		Sessions:      models.UserExportSessions{APIKeys: []models.UserExportAPIKey{}},
+		Verifications: []models.UserExportVerification{},
+		EmailChanges:  []models.UserExportEmailChange{},
+		Consents:      []models.UserExportConsent{},
+		EmailLogs:     []models.UserExportEmailLog{},
+		AuditLogs:     []models.UserExportAuditLog{},
+	}
+
+	groupMemberships, err := s.client.GroupMembers.Query().
+		Where(groupmembers.UserIDEQ(userID)).
+		WithGroup().
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group memberships: %w", err)
+	}
+	for _, membership := range groupMemberships {
+		if membership.Edges.Group == nil {
+			continue
+		}
+		export.Groups = append(export.Groups, models.UserExportMembership{
+			ID:       fmt.Sprint(membership.GroupID),
+			Name:     membership.Edges.Group.Name,
+			JoinedAt: membership.AddedAt,
+		})
+	}
+
+	orgMemberships, err := s.client.OrgMembers.Query().
+		Where(orgmembers.UserIDEQ(userID)).
+		WithOrg().
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization memberships: %w", err)
+	}
+	for _, membership := range orgMemberships {
+		if membership.Edges.Org == nil {
+			continue
+		}
+		export.Organizations = append(export.Organizations, models.UserExportMembership{
+			ID:       membership.OrgID.String(),
+			Name:     membership.Edges.Org.Name,
+			JoinedAt: membership.JoinedAt,
+		})
+	}
+
+	export.Sessions.SessionExpiresAt, err = auth.SessionExpiry(ctx, s.cache, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.client.APIKeys.Query().
+		Where(apikeys.UserIDEQ(userID)).
+		Order(ent.Asc(apikeys.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API keys: %w", err)
+	}
+	for _, key := range keys {
+		export.Sessions.APIKeys = append(export.Sessions.APIKeys, models.UserExportAPIKey{
+			Name:       key.Name,
+			Prefix:     key.Prefix,
+			Scopes:     key.Scopes,
+			ExpiresAt:  key.ExpiresAt,
+			LastUsedAt: key.LastUsedAt,
+			LastUsedIP: key.LastUsedIP,
+			RevokedAt:  key.RevokedAt,
+			CreatedAt:  key.CreatedAt,
+		})
+	}
+
+	verifications, err := s.client.EmailVerifications.Query().
+		Where(emailverifications.UserIDEQ(userID)).
+		Order(ent.Asc(emailverifications.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email verifications: %w", err)
+	}
+	for _, v := range verifications {
+		export.Verifications = append(export.Verifications, models.UserExportVerification{
+			Type:      "email_verification",
+			Email:     v.Email,
+			Used:      v.IsUsed,
+			UsedAt:    v.UsedAt,
+			IPAddress: v.IPAddress,
+			ExpiresAt: v.ExpiresAt,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	resets, err := s.client.PasswordResets.Query().
+		Where(passwordresets.UserIDEQ(userID)).
+		Order(ent.Asc(passwordresets.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password resets: %w", err)
+	}
+	for _, r := range resets {
+		export.Verifications = append(export.Verifications, models.UserExportVerification{
+			Type:      "password_reset",
+			Email:     r.Email,
+			Used:      r.IsUsed,
+			UsedAt:    r.UsedAt,
+			IPAddress: r.IPAddress,
+			ExpiresAt: r.ExpiresAt,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+
+	changes, err := s.client.EmailChanges.Query().
+		Where(emailchanges.UserIDEQ(userID)).
+		Order(ent.Asc(emailchanges.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email changes: %w", err)
+	}
+	for _, change := range changes {
+		export.EmailChanges = append(export.EmailChanges, models.UserExportEmailChange{
+			OldEmail:    change.OldEmail,
+			NewEmail:    change.NewEmail,
+			Status:      string(change.Status),
+			IPAddress:   change.IPAddress,
+			ConfirmedAt: change.ConfirmedAt,
+			RevertedAt:  change.RevertedAt,
+			CreatedAt:   change.CreatedAt,
+		})
+	}
+
+	consents, err := s.client.ConsentAcceptances.Query().
+		Where(consentacceptances.UserIDEQ(userID)).
+		Order(ent.Asc(consentacceptances.FieldAcceptedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consents: %w", err)
+	}
+	for _, consent := range consents {
+		export.Consents = append(export.Consents, models.UserExportConsent{
+			DocumentType: consent.DocumentType,
+			Version:      consent.Version,
+			IPAddress:    consent.IPAddress,
+			UserAgent:    consent.UserAgent,
+			AcceptedAt:   consent.AcceptedAt,
+		})
+	}
+
+	emailLogs, err := s.client.EmailLogs.Query().
+		Where(emaillogs.UserIDEQ(userID)).
+		Order(ent.Asc(emaillogs.FieldSentAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email logs: %w", err)
+	}
+	for _, log := range emailLogs {
+		export.EmailLogs = append(export.EmailLogs, models.UserExportEmailLog{
+			Recipient: log.Recipient,
+			EmailType: log.EmailType,
+			Subject:   log.Subject,
+			Status:    log.Status,
+			SentAt:    log.SentAt,
+		})
+	}
+
+	auditLogs, err := s.client.AuditLogs.Query().
+		Where(auditlogs.ActorIDEQ(userID)).
+		Order(ent.Asc(auditlogs.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit logs: %w", err)
+	}
+	for _, log := range auditLogs {
+		export.AuditLogs = append(export.AuditLogs, models.UserExportAuditLog{
+			ActionType:   log.ActionType,
+			ResourceType: log.ResourceType,
+			ResourceID:   log.ResourceID,
+			Metadata:     log.Metadata,
+			IPAddress:    log.IPAddress,
+			UserAgent:    log.UserAgent,
+			CreatedAt:    log.CreatedAt,
+		})
+	}
+
+	s.auditAs(ctx, &userID, "user.export", userID, map[string]interface{}{})
+
+	return export, nil
+}
+
+// RequestDeletion schedules the caller's account for deletion after
+// ACCOUNT_DELETION_GRACE_PERIOD. The account keeps working until then so
+// the user can sign in and cancel. Wrong passwords count towards the
+// signin lockout.
+func (s *UsersService) RequestDeletion(ctx context.Context, userID uuid.UUID, req *models.DeleteAccountRequest, clientIP string) (*models.AccountDeletionResponse, error) {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.lockout.Check(ctx, user.Email, clientIP); err != nil {
+		return nil, err
+	}
+
+	if !auth.ComparePasswords(user.PasswordHash, []byte(req.Password)) {
+		if err := s.lockout.RegisterFailure(ctx, user.Email, clientIP, user); err != nil {
+			s.logger.Error("Failed to register failed password check", "user_id", userID, "error", err)
+		}
+		return nil, fmt.Errorf("invalid password")
+	}
+
+	if err := s.lockout.RegisterSuccess(ctx, user.Email); err != nil {
+		s.logger.Error("Failed to reset failed password checks", "user_id", userID, "error", err)
+	}
+
+	return s.ScheduleDeletion(ctx, userID, &userID)
+}
+
+// ScheduleDeletion schedules an account for deletion after the grace
+// period and emails the user. actorID is nil when run from the CLI.
+func (s *UsersService) ScheduleDeletion(ctx context.Context, userID uuid.UUID, actorID *uuid.UUID) (*models.AccountDeletionResponse, error) {
+	now := time.Now()
+	scheduledAt := now.Add(config.AccountDeletionGracePeriod)
+
+	updated, err := s.client.Users.Update().
+		Where(
+			users.IDEQ(userID),
+			users.DeletionScheduledAtIsNil(),
+		).
+		SetDeletionRequestedAt(now).
+		SetDeletionScheduledAt(scheduledAt).
+		Save(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule deletion: %w", err)
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("account deletion is already scheduled")
+	}
+
+	if err := s.emailService.SendAccountDeletionEmail(ctx, user.ID, user.Email, user.FirstName, scheduledAt); err != nil {
+		s.logger.Error("Failed to send account deletion email", "user_id", user.ID, "error", err)
+	}
+
+	s.auditAs(ctx, actorID, "user.deletion_request", userID, map[string]interface{}{
+		"scheduled_at": scheduledAt.UTC().Format(time.RFC3339),
+	})
+
+	return &models.AccountDeletionResponse{ScheduledAt: scheduledAt}, nil
+}
+
+// CancelDeletion cancels a scheduled account deletion. actorID is nil when
+// run from the CLI.
+func (s *UsersService) CancelDeletion(ctx context.Context, userID uuid.UUID, actorID *uuid.UUID) error {
+	updated, err := s.client.Users.Update().
+		Where(
+			users.IDEQ(userID),
+			users.DeletionScheduledAtNotNil(),
+		).
+		ClearDeletionRequestedAt().
+		ClearDeletionScheduledAt().
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to cancel deletion: %w", err)
+	}
+
+	if _, err := s.getUser(ctx, userID); err != nil {
+		return err
+	}
+	if updated == 0 {
+		return fmt.Errorf("account deletion is not scheduled")
+	}
+
+	s.auditAs(ctx, actorID, "user.deletion_cancel", userID, map[string]interface{}{})
+
+	return nil
+}
+
+// PurgeUser deletes an account immediately, for the CLI. actorID is nil
+// when there is no acting user.
+func (s *UsersService) PurgeUser(ctx context.Context, userID uuid.UUID, actorID *uuid.UUID, reason string) error {
+	return s.purgeUser(ctx, userID, actorID, reason)
+}
+
+// PurgeDueDeletions deletes every account whose grace period has ended and
+// returns how many were deleted
+func (s *UsersService) PurgeDueDeletions(ctx context.Context) (int, error) {
+	purged := 0
+
+	for {
+		due, err := s.client.Users.Query().
+			Where(users.DeletionScheduledAtLTE(time.Now())).
+			Limit(deletionBatchSize).
+			IDs(ctx)
+
+		if err != nil {
+			return purged, fmt.Errorf("failed to query due deletions: %w", err)
+		}
+
+		for _, userID := range due {
+			if err := s.purgeUser(ctx, userID, nil, "scheduled self-deletion"); err != nil {
+				if err.Error() == "user not found" {
+					continue
+				}
+				return purged, err
+			}
+			purged++
+		}
+
+		if len(due) < deletionBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// RunDeletionPurge deletes accounts whose grace period has ended every
+// interval until ctx is done
+func (s *UsersService) RunDeletionPurge(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := s.PurgeDueDeletions(ctx)
+		if err != nil {
+			s.logger.Error("Failed to purge scheduled account deletions", "error", err)
+		} else if purged > 0 {
+			s.logger.Info("Scheduled account deletions purged", "count", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeUser deletes a user with their role assignments, group and
+// organization memberships, credentials, pending tokens, email changes, API
+// keys, consents, role requests and relation tuples in one transaction. Audit and
+// email logs are kept, with the user's email addresses, IP addresses and
+// user agents redacted.
+func (s *UsersService) purgeUser(ctx context.Context, userID uuid.UUID, actorID *uuid.UUID, reason string) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	user, err := tx.Users.Query().Where(users.IDEQ(userID)).ForUpdate().Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("user not found")
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	emails, err := knownEmails(ctx, tx.Client(), user)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	deletes := []struct {
+		name string
+		run  func(context.Context) (int, error)
+	}{
+		{"role assignments", tx.UserRoles.Delete().Where(userroles.UserIDEQ(userID)).Exec},
+		{"group memberships", tx.GroupMembers.Delete().Where(groupmembers.UserIDEQ(userID)).Exec},
+		{"organization roles", tx.OrgRoles.Delete().Where(orgroles.UserIDEQ(userID)).Exec},
+		{"organization memberships", tx.OrgMembers.Delete().Where(orgmembers.UserIDEQ(userID)).Exec},
+		{"password history", tx.PasswordHistories.Delete().Where(passwordhistories.UserIDEQ(userID)).Exec},
+		{"password resets", tx.PasswordResets.Delete().Where(passwordresets.UserIDEQ(userID)).Exec},
+		{"email verifications", tx.EmailVerifications.Delete().Where(emailverifications.UserIDEQ(userID)).Exec},
+		{"email changes", tx.EmailChanges.Delete().Where(emailchanges.UserIDEQ(userID)).Exec},
+		{"API keys", tx.APIKeys.Delete().Where(apikeys.UserIDEQ(userID)).Exec},
+		{"consents", tx.ConsentAcceptances.Delete().Where(consentacceptances.UserIDEQ(userID)).Exec},
+		{"role requests", tx.RoleRequests.Delete().Where(rolerequests.UserIDEQ(userID)).Exec},
+		{"relation tuples", tx.RelationTuples.Delete().Where(
+			relationtuples.SubjectTypeEQ("user"),
+			relationtuples.SubjectIDEQ(userID.String()),
+		).Exec},
+	}
+
+	removed := make(map[string]interface{})
+	for _, d := range deletes {
+		count, err := d.run(ctx)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete %s: %w", d.name, err)
+		}
+		if count > 0 {
+			removed[d.name] = count
+		}
+	}
+
+	anonymized, err := anonymizeLogs(ctx, tx, userID, emails)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Users.DeleteOne(user).Exec(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if err := auth.RevokeUserTokens(ctx, s.cache, s.apiKeys, userID); err != nil {
+		s.logger.Error("Failed to revoke tokens of deleted user", "user_id", userID, "error", err)
+	}
+	s.permissions.InvalidateUserPermissions(ctx, userID)
+
+	s.auditAs(ctx, actorID, "user.delete", userID, map[string]interface{}{
+		"reason":     reason,
+		"removed":    removed,
+		"anonymized": anonymized,
+	})
+
+	return nil
+}
+
+// knownEmails returns every address the user has had, lowercased
+func knownEmails(ctx context.Context, client *ent.Client, user *ent.Users) ([]string, error) {
+	changes, err := client.EmailChanges.Query().
+		Where(emailchanges.UserIDEQ(user.ID)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email changes: %w", err)
+	}
+
+	seen := map[string]bool{strings.ToLower(user.Email): true}
+	emails := []string{strings.ToLower(user.Email)}
+	for _, change := range changes {
+		for _, email := range []string{change.OldEmail, change.NewEmail} {
+			email = strings.ToLower(email)
+			if !seen[email] {
+				seen[email] = true
+				emails = append(emails, email)
+			}
+		}
+	}
+	return emails, nil
+}
+
+// anonymizeLogs redacts a deleted user's personal data from the audit
+// logs, email logs and invitations that are kept. It returns how many rows
+// of each were changed.
+func anonymizeLogs(ctx context.Context, tx *ent.Tx, userID uuid.UUID, emails []string) (map[string]interface{}, error) {
+	anonymized := make(map[string]interface{})
+
+	mentions := make([]predicate.AuditLogs, 0, len(emails)+2)
+	mentions = append(mentions,
+		auditlogs.ActorIDEQ(userID),
+		auditlogs.ResourceIDEQ(userID.String()),
+	)
+	for _, email := range emails {
+		mentions = append(mentions, auditMentions(email))
+	}
+
+	auditRows, err := tx.AuditLogs.Query().
+		Where(auditlogs.Or(mentions...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit logs: %w", err)
+	}
+
+	auditCount := 0
+	for _, row := range auditRows {
+		ownRow := (row.ActorID != nil && *row.ActorID == userID) || row.ResourceID == userID.String()
+
+		metadata, metadataChanged := redactPII(row.Metadata, emails, ownRow)
+		changes, changesChanged := redactPII(row.Changes, emails, ownRow)
+		clearClient := ownRow && (row.IPAddress != "" || row.UserAgent != "")
+		if !metadataChanged && !changesChanged && !clearClient {
+			continue
+		}
+
+		update := tx.AuditLogs.UpdateOne(row).
+			SetMetadata(metadata).
+			SetChanges(changes)
+		if clearClient {
+			update = update.ClearIPAddress().ClearUserAgent()
+		}
+		if err := update.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to anonymize audit log: %w", err)
+		}
+		auditCount++
+	}
+	if auditCount > 0 {
+		anonymized["audit logs"] = auditCount
+	}
+
+	recipients := make([]predicate.EmailLogs, 0, len(emails)+1)
+	recipients = append(recipients, emaillogs.UserIDEQ(userID))
+	for _, email := range emails {
+		recipients = append(recipients, emaillogs.RecipientEqualFold(email))
+	}
+
+	emailRows, err := tx.EmailLogs.Query().
+		Where(emaillogs.Or(recipients...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email logs: %w", err)
+	}
+
+	for _, row := range emailRows {
+		metadata, _ := redactPII(row.Metadata, emails, true)
+		err := tx.EmailLogs.UpdateOne(row).
+			SetRecipient(redacted).
+			SetMetadata(metadata).
+			Exec(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to anonymize email log: %w", err)
+		}
+	}
+	if len(emailRows) > 0 {
+		anonymized["email logs"] = len(emailRows)
+	}
+
+	invitationPredicates := make([]predicate.Invitations, 0, len(emails))
+	for _, email := range emails {
+		invitationPredicates = append(invitationPredicates, invitations.EmailEqualFold(email))
+	}
+
+	invited, err := tx.Invitations.Update().
+		Where(invitations.Or(invitationPredicates...)).
+		SetEmail(redacted).
+		Save(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to anonymize invitations: %w", err)
+	}
+	if invited > 0 {
+		anonymized["invitations"] = invited
+	}
+
+	return anonymized, nil
+}
+
+// auditMentions matches audit logs whose metadata mentions an email,
+// ignoring case. It may match more rows than needed; redactPII decides.
+func auditMentions(email string) predicate.AuditLogs {
+	return predicate.AuditLogs(func(sel *sql.Selector) {
+		sel.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("CAST(" + sel.C(auditlogs.FieldMetadata) + " AS TEXT) ILIKE ").
+				Arg("%" + email + "%")
+		}))
+	})
+}
+
+// redactPII replaces strings mentioning any of the emails. When ownRow is
+// set the row belongs to the deleted user, so IP addresses and user agents
+// in it are removed as well. It reports whether anything changed.
+func redactPII(data map[string]interface{}, emails []string, ownRow bool) (map[string]interface{}, bool) {
+	if data == nil {
+		return nil, false
+	}
+
+	changed := false
+	result := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		if ownRow && (key == "ip_address" || key == "user_agent") {
+			changed = true
+			continue
+		}
+
+		redactedValue, valueChanged := redactValue(value, emails, ownRow)
+		result[key] = redactedValue
+		changed = changed || valueChanged
+	}
+	return result, changed
+}
+
+func redactValue(value interface{}, emails []string, ownRow bool) (interface{}, bool) {
+	switch v := value.(type) {
+	case string:
+		lower := strings.ToLower(v)
+		for _, email := range emails {
+			if strings.Contains(lower, email) {
+				return redacted, true
+			}
+		}
+		return v, false
+	case map[string]interface{}:
+		return redactPII(v, emails, ownRow)
+	case []interface{}:
+		changed := false
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			var itemChanged bool
+			result[i], itemChanged = redactValue(item, emails, ownRow)
+			changed = changed || itemChanged
+		}
+		return result, changed
+	default:
+		return value, false
+	}
+}
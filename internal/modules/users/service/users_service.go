@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/shammianand/go-auth/ent"
+	"github.com/shammianand/go-auth/ent/predicate"
+	"github.com/shammianand/go-auth/ent/roles"
+	"github.com/shammianand/go-auth/ent/userroles"
+	"github.com/shammianand/go-auth/ent/users"
+	"github.com/shammianand/go-auth/internal/auth"
+	"github.com/shammianand/go-auth/internal/common/audit"
+	emailservice "github.com/shammianand/go-auth/internal/modules/email/service"
+	"github.com/shammianand/go-auth/internal/modules/users/models"
+)
+
+// Permissions is the part of the RBAC service user management relies on
+type Permissions interface {
+	// InvalidateUserPermissions drops cached permissions for a user
+	InvalidateUserPermissions(ctx context.Context, userID uuid.UUID)
+	// ImpersonationBlockedRoles returns the user's roles that are protected
+	// from impersonation
+	ImpersonationBlockedRoles(ctx context.Context, userID uuid.UUID) ([]string, error)
+}
+
+// Lockout throttles password checks, shared with signin so both count
+// towards the same lockout
+type Lockout interface {
+	// Check returns an error while the account or IP is locked
+	Check(ctx context.Context, email, ip string) error
+	// RegisterFailure records a wrong password
+	RegisterFailure(ctx context.Context, email, ip string, user *ent.Users) error
+	// RegisterSuccess clears the account's failed attempts
+	RegisterSuccess(ctx context.Context, email string) error
+}
+
+// UsersService handles admin user management
+type UsersService struct {
+	client       *ent.Client
+	cache        *redis.Client
+	emailService *emailservice.EmailService
+	permissions  Permissions
+	lockout      Lockout
+	apiKeys      auth.APIKeyRevoker
+	auditLog     *audit.Writer
+	logger       *slog.Logger
+}
+
+// NewUsersService creates a new users service. apiKeys revokes a user's API
+// keys along with their tokens.
+func NewUsersService(client *ent.Client, cache *redis.Client, emailService *emailservice.EmailService, permissions Permissions, lockout Lockout, apiKeys auth.APIKeyRevoker, logger *slog.Logger) *UsersService {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &UsersService{
+		client:       client,
+		cache:        cache,
+		emailService: emailService,
+		permissions:  permissions,
+		lockout:      lockout,
+		apiKeys:      apiKeys,
+		auditLog:     audit.NewWriter(client, logger),
+		logger:       logger,
+	}
+}
+
+// ListUsers returns a page of users, newest first. Pages are keyed by a
+// cursor on (created_at, id) so they stay stable while users sign up.
+func (s *UsersService) ListUsers(ctx context.Context, filter *models.UserFilter) (*models.UserListResponse, error) {
+	query := s.client.Users.Query()
+
+	if filter.Cursor != "" {
+		createdAt, id, err := decodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(users.Or(
+			users.CreatedAtLT(createdAt),
+			users.And(users.CreatedAtEQ(createdAt), users.IDLT(id)),
+		))
+	}
+
+	if filter.EmailPrefix != "" {
+		query = query.Where(emailHasPrefixFold(filter.EmailPrefix))
+	}
+	if filter.IsActive != nil {
+		query = query.Where(users.IsActiveEQ(*filter.IsActive))
+	}
+	if filter.EmailVerified != nil {
+		query = query.Where(users.EmailVerifiedEQ(*filter.EmailVerified))
+	}
+	if filter.Role != "" {
+		query = query.Where(users.HasUserRolesWith(
+			activeAssignment(),
+			userroles.HasRoleWith(roles.CodeEQ(filter.Role)),
+		))
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where(users.CreatedAtGTE(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where(users.CreatedAtLT(*filter.CreatedBefore))
+	}
+	if filter.LastLoginAfter != nil {
+		query = query.Where(users.LastLoginGTE(*filter.LastLoginAfter))
+	}
+	if filter.LastLoginBefore != nil {
+		query = query.Where(users.LastLoginLT(*filter.LastLoginBefore))
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = 50
+	}
+
+	entUsers, err := query.
+		Order(ent.Desc(users.FieldCreatedAt), ent.Desc(users.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	response := &models.UserListResponse{Users: []models.UserResponse{}}
+	if len(entUsers) > limit {
+		entUsers = entUsers[:limit]
+		last := entUsers[limit-1]
+		response.NextCursor = encodeCursor(last.CreatedAt, last.ID)
+	}
+
+	for _, user := range entUsers {
+		response.Users = append(response.Users, userToResponse(user))
+	}
+
+	return response, nil
+}
+
+// GetUser returns a user with their active direct role assignments
+func (s *UsersService) GetUser(ctx context.Context, userID uuid.UUID) (*models.UserDetailResponse, error) {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.client.UserRoles.Query().
+		Where(userroles.UserIDEQ(userID), activeAssignment()).
+		WithRole().
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+
+	response := &models.UserDetailResponse{
+		UserResponse: userToResponse(user),
+		Roles:        []models.UserRoleSummary{},
+	}
+	for _, assignment := range assignments {
+		if assignment.Edges.Role == nil {
+			continue
+		}
+		response.Roles = append(response.Roles, models.UserRoleSummary{
+			ID:        assignment.Edges.Role.ID,
+			Code:      assignment.Edges.Role.Code,
+			Name:      assignment.Edges.Role.Name,
+			ExpiresAt: assignment.ExpiresAt,
+		})
+	}
+
+	return response, nil
+}
+
+// DeactivateUser blocks signin and revokes the user's tokens
+func (s *UsersService) DeactivateUser(ctx context.Context, userID, actorID uuid.UUID, reason string) error {
+	if userID == actorID {
+		return fmt.Errorf("cannot deactivate your own account")
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsActive {
+		return fmt.Errorf("user is already inactive")
+	}
+
+	if _, err := user.Update().SetIsActive(false).Save(ctx); err != nil {
+		return fmt.Errorf("failed to deactivate user: %w", err)
+	}
+
+	if err := auth.RevokeUserTokens(ctx, s.cache, s.apiKeys, userID); err != nil {
+		return err
+	}
+
+	s.audit(ctx, actorID, "user.deactivate", userID, map[string]interface{}{
+		"email":  user.Email,
+		"reason": reason,
+	})
+
+	return nil
+}
+
+// ReactivateUser lets a deactivated user sign in again. Signups awaiting
+// approval must be approved instead.
+func (s *UsersService) ReactivateUser(ctx context.Context, userID, actorID uuid.UUID, reason string) error {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.IsActive {
+		return fmt.Errorf("user is already active")
+	}
+	if user.ApprovalStatus != users.ApprovalStatusApproved {
+		return fmt.Errorf("user signup has not been approved")
+	}
+
+	if _, err := user.Update().SetIsActive(true).Save(ctx); err != nil {
+		return fmt.Errorf("failed to reactivate user: %w", err)
+	}
+
+	s.audit(ctx, actorID, "user.reactivate", userID, map[string]interface{}{
+		"email":  user.Email,
+		"reason": reason,
+	})
+
+	return nil
+}
+
+// ForcePasswordReset blocks signin until the user resets their password,
+// revokes their tokens and emails them a reset link
+func (s *UsersService) ForcePasswordReset(ctx context.Context, userID, actorID uuid.UUID, reason string) error {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := user.Update().SetPasswordResetRequired(true).Save(ctx); err != nil {
+		return fmt.Errorf("failed to require password reset: %w", err)
+	}
+
+	if err := auth.RevokeUserTokens(ctx, s.cache, s.apiKeys, userID); err != nil {
+		return err
+	}
+
+	token, err := s.emailService.GeneratePasswordResetToken(ctx, user.ID, user.Email)
+	if err != nil {
+		return fmt.Errorf("failed to generate reset token: %w", err)
+	}
+
+	if err := s.emailService.SendPasswordResetEmail(ctx, user.ID, user.Email, user.FirstName, token); err != nil {
+		s.logger.Error("Failed to send reset email", "user_id", user.ID, "error", err)
+	}
+
+	s.audit(ctx, actorID, "user.force_password_reset", userID, map[string]interface{}{
+		"email":  user.Email,
+		"reason": reason,
+	})
+
+	return nil
+}
+
+// ForceLogout revokes every token issued to the user
+func (s *UsersService) ForceLogout(ctx context.Context, userID, actorID uuid.UUID, reason string) error {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := auth.RevokeUserTokens(ctx, s.cache, s.apiKeys, userID); err != nil {
+		return err
+	}
+
+	s.audit(ctx, actorID, "user.force_logout", userID, map[string]interface{}{
+		"email":  user.Email,
+		"reason": reason,
+	})
+
+	return nil
+}
+
+// ResendVerification sends a new verification link to an unverified user
+func (s *UsersService) ResendVerification(ctx context.Context, userID, actorID uuid.UUID) error {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.EmailVerified {
+		return fmt.Errorf("email already verified")
+	}
+
+	token, err := s.emailService.GenerateVerificationToken(ctx, user.ID, user.Email)
+	if err != nil {
+		return fmt.Errorf("failed to generate verification token: %w", err)
+	}
+
+	if err := s.emailService.SendVerificationEmail(ctx, user.ID, user.Email, user.FirstName, token); err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+
+	s.audit(ctx, actorID, "user.resend_verification", userID, map[string]interface{}{
+		"email": user.Email,
+	})
+
+	return nil
+}
+
+// DeleteUser deletes a user immediately. See purgeUser for what is removed
+// and what is kept.
+func (s *UsersService) DeleteUser(ctx context.Context, userID, actorID uuid.UUID, reason string) error {
+	if userID == actorID {
+		return fmt.Errorf("cannot delete your own account")
+	}
+
+	return s.purgeUser(ctx, userID, &actorID, reason)
+}
+
+func (s *UsersService) getUser(ctx context.Context, userID uuid.UUID) (*ent.Users, error) {
+	user, err := s.client.Users.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
+
+// audit records an admin action on a user
+func (s *UsersService) audit(ctx context.Context, actorID uuid.UUID, actionType string, userID uuid.UUID, metadata map[string]interface{}) {
+	s.auditAs(ctx, &actorID, actionType, userID, metadata)
+}
+
+// auditAs records an action on a user by an optional actor, such as a
+// scheduled job or the CLI when actorID is nil
+func (s *UsersService) auditAs(ctx context.Context, actorID *uuid.UUID, actionType string, userID uuid.UUID, metadata map[string]interface{}) {
+	s.auditLog.Write(ctx, audit.Entry{
+		ActorID:      actorID,
+		ActionType:   actionType,
+		ResourceType: "user",
+		ResourceID:   userID.String(),
+		Metadata:     metadata,
+	})
+}
+
+// emailHasPrefixFold matches emails starting with prefix, ignoring case,
+// since signup stores addresses as entered
+func emailHasPrefixFold(prefix string) predicate.Users {
+	return predicate.Users(func(sel *sql.Selector) {
+		sel.Where(sql.HasPrefix(sql.Lower(sel.C(users.FieldEmail)), strings.ToLower(prefix)))
+	})
+}
+
+// activeAssignment matches role assignments that have not expired
+func activeAssignment() predicate.UserRoles {
+	return userroles.Or(
+		userroles.ExpiresAtIsNil(),
+		userroles.ExpiresAtGT(time.Now()),
+	)
+}
+
+func encodeCursor(createdAt time.Time, id uuid.UUID) string {
+	raw := createdAt.UTC().Format(time.RFC3339Nano) + "|" + id.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (time.Time, uuid.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.UUID{}, fmt.Errorf("invalid cursor")
+	}
+
+	rawTime, rawID, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, uuid.UUID{}, fmt.Errorf("invalid cursor")
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, rawTime)
+	if err != nil {
+		return time.Time{}, uuid.UUID{}, fmt.Errorf("invalid cursor")
+	}
+
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return time.Time{}, uuid.UUID{}, fmt.Errorf("invalid cursor")
+	}
+
+	return createdAt, id, nil
+}
+
+func userToResponse(user *ent.Users) models.UserResponse {
+	response := models.UserResponse{
+		ID:                    user.ID,
+		Email:                 user.Email,
+		FirstName:             user.FirstName,
+		LastName:              user.LastName,
+		IsActive:              user.IsActive,
+		EmailVerified:         user.EmailVerified,
+		ApprovalStatus:        string(user.ApprovalStatus),
+		PasswordResetRequired: user.PasswordResetRequired,
+		DeletionScheduledAt:   user.DeletionScheduledAt,
+		CreatedAt:             user.CreatedAt,
+		UpdatedAt:             user.UpdatedAt,
+	}
+	if !user.LastLogin.IsZero() {
+		lastLogin := user.LastLogin
+		response.LastLogin = &lastLogin
+	}
+	return response
+}